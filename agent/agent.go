@@ -14,6 +14,7 @@ import (
 	"net/http"
 	"net/netip"
 	"os"
+	"os/exec"
 	"path/filepath"
 	"slices"
 	"strconv"
@@ -44,11 +45,14 @@ import (
 	"github.com/coder/coder/v2/agent/agentexec"
 	"github.com/coder/coder/v2/agent/agentfiles"
 	"github.com/coder/coder/v2/agent/agentgit"
+	"github.com/coder/coder/v2/agent/agentlogtail"
 	"github.com/coder/coder/v2/agent/agentproc"
 	"github.com/coder/coder/v2/agent/agentscripts"
 	"github.com/coder/coder/v2/agent/agentsocket"
 	"github.com/coder/coder/v2/agent/agentssh"
 	"github.com/coder/coder/v2/agent/boundarylogproxy"
+	"github.com/coder/coder/v2/agent/filefinder"
+	"github.com/coder/coder/v2/agent/immortalstreams"
 	"github.com/coder/coder/v2/agent/proto"
 	"github.com/coder/coder/v2/agent/proto/resourcesmonitor"
 	"github.com/coder/coder/v2/agent/reconnectingpty"
@@ -316,6 +320,7 @@ type agent struct {
 	reportMetadataInterval             time.Duration
 	statsReportInterval                time.Duration
 	scriptRunner                       *agentscripts.Runner
+	logTailer                          *agentlogtail.Tailer
 	announcementBanners                atomic.Pointer[[]codersdk.BannerConfig] // announcementBanners is atomic because it is periodically updated.
 	announcementBannersRefreshInterval time.Duration
 	sshServer                          *agentssh.Server
@@ -355,6 +360,7 @@ type agent struct {
 	gitAPIOptions       []agentgit.Option
 
 	filesAPI         *agentfiles.API
+	fileFinder       *filefinder.Engine
 	gitAPI           *agentgit.API
 	processAPI       *agentproc.API
 	desktopAPI       *agentdesktop.API
@@ -364,10 +370,23 @@ type agent struct {
 	contextManager   *agentcontext.Manager
 	contextAPI       *agentcontext.API
 
+	// immortalStreams holds connections to ports in the workspace that
+	// survive the client's connection to the agent breaking.
+	immortalStreams      *immortalstreams.Manager
+	immortalStreamsAPI   *immortalstreams.API
+	immortalSSHListener  *immortalstreams.PipeListener
+	immortalSSHServeOnce sync.Once
+
 	socketServerEnabled bool
 	socketPath          string
 	socketServer        *agentsocket.Server
 
+	// appStatusAPI is the agent API client used to report app
+	// statuses for supervised services. It is nil while the agent
+	// is disconnected from coderd.
+	appStatusMu  sync.Mutex
+	appStatusAPI proto.DRPCAgentClient28
+
 	derpTLSConfig *tls.Config
 }
 
@@ -377,6 +396,30 @@ func (a *agent) TailnetConn() *tailnet.Conn {
 	return a.network
 }
 
+func (a *agent) setAppStatusAPI(api proto.DRPCAgentClient28) {
+	a.appStatusMu.Lock()
+	defer a.appStatusMu.Unlock()
+	a.appStatusAPI = api
+}
+
+// reportAppStatus reports the status of a workspace app to coderd.
+// It fails while the agent is disconnected.
+func (a *agent) reportAppStatus(ctx context.Context, status agentsdk.PatchAppStatus) error {
+	a.appStatusMu.Lock()
+	api := a.appStatusAPI
+	a.appStatusMu.Unlock()
+	if api == nil {
+		return xerrors.New("agent is not connected to coderd")
+	}
+
+	req, err := agentsdk.ProtoFromPatchAppStatus(status)
+	if err != nil {
+		return xerrors.Errorf("convert app status: %w", err)
+	}
+	_, err = api.UpdateAppStatus(ctx, req)
+	return err
+}
+
 // initialContextSources translates the boot-time
 // CODER_AGENT_EXP_*_DIRS env vars into agentcontext.Source
 // entries. This preserves the "set it on the template" workflow
@@ -461,6 +504,9 @@ func (a *agent) init() {
 	// Register runner metrics. If the prom registry is nil, the metrics
 	// will not report anywhere.
 	a.scriptRunner.RegisterMetrics(a.prometheusRegistry)
+	a.logTailer = agentlogtail.New(a.logger.Named("logtail"), func(logSourceID uuid.UUID) agentlogtail.Logger {
+		return a.logSender.GetScriptLogger(logSourceID)
+	}, agentlogtail.WithExecer(a.execer))
 
 	containerAPIOpts := []agentcontainers.Option{
 		agentcontainers.WithExecer(a.execer),
@@ -474,19 +520,41 @@ func (a *agent) init() {
 	a.containerAPI = agentcontainers.NewAPI(a.logger.Named("containers"), containerAPIOpts...)
 
 	pathStore := agentgit.NewPathStore()
-	a.filesAPI = agentfiles.NewAPI(a.logger.Named("files"), a.filesystem, pathStore, agentfiles.WithEnvInfo(a.envInfo))
+	a.fileFinder = filefinder.NewEngine(a.logger.Named("filefinder"))
+	a.filesAPI = agentfiles.NewAPI(a.logger.Named("files"), a.filesystem, pathStore,
+		agentfiles.WithEnvInfo(a.envInfo),
+		agentfiles.WithBlockFileTransfer(a.blockFileTransfer),
+		agentfiles.WithFileFinder(a.fileFinder, func() string {
+			if m := a.manifest.Load(); m != nil {
+				return m.Directory
+			}
+			return ""
+		}),
+	)
 	a.processAPI = agentproc.NewAPI(a.logger.Named("processes"), a.execer, a.filesystem, pathStore, a.envInfo, a.updateCommandEnv, func() string {
 		if m := a.manifest.Load(); m != nil {
 			return m.Directory
 		}
 		return ""
-	})
+	}, agentproc.WithClock(a.clock), agentproc.WithAppStatusReporter(a.reportAppStatus))
 	gitOpts := append([]agentgit.Option{agentgit.WithClock(a.clock)}, a.gitAPIOptions...)
 	a.gitAPI = agentgit.NewAPI(a.logger.Named("git"), pathStore, gitOpts...)
 	desktop := agentdesktop.NewPortableDesktop(
 		a.logger.Named("desktop"), a.execer, a.scriptRunner.ScriptBinDir(), nil,
 	)
 	a.desktopAPI = agentdesktop.NewAPI(a.logger.Named("desktop"), desktop, a.clock)
+	a.immortalSSHListener = immortalstreams.NewPipeListener()
+	a.immortalStreams = immortalstreams.NewManager(a.logger.Named("immortal-streams"), immortalstreams.Options{
+		Dialer: func(ctx context.Context, port uint16) (net.Conn, error) {
+			// The SSH server only listens on tailnet.
+			if port == workspacesdk.AgentSSHPort {
+				return a.immortalSSHListener.Dial(ctx)
+			}
+			return immortalstreams.DialLocalhost(ctx, port)
+		},
+		Clock: a.clock,
+	})
+	a.immortalStreamsAPI = immortalstreams.NewAPI(a.logger.Named("immortal-streams"), a.immortalStreams)
 	a.mcpManager = agentmcp.NewManager(a.gracefulCtx, a.logger.Named("mcp"), a.execer, a.updateCommandEnv)
 	a.contextConfigAPI = agentcontextconfig.NewAPI(func() string {
 		if m := a.manifest.Load(); m != nil {
@@ -630,6 +698,16 @@ func (a *agent) runLoop() {
 	}
 }
 
+// createHealthcheckCommand runs exec app healthchecks in the user's shell,
+// like metadata scripts.
+func (a *agent) createHealthcheckCommand(ctx context.Context, script string) (*exec.Cmd, error) {
+	cmdPty, err := a.sshServer.CreateCommand(ctx, script, nil, nil)
+	if err != nil {
+		return nil, err
+	}
+	return cmdPty.AsExec(), nil
+}
+
 func (a *agent) collectMetadata(ctx context.Context, md codersdk.WorkspaceAgentMetadataDescription, now time.Time) *codersdk.WorkspaceAgentMetadataResult {
 	var out bytes.Buffer
 	result := &codersdk.WorkspaceAgentMetadataResult{
@@ -1192,6 +1270,8 @@ func (a *agent) run() (retErr error) {
 		a.socketServer.SetAgentAPI(aAPI)
 		defer a.socketServer.ClearAgentAPI()
 	}
+	a.setAppStatusAPI(aAPI)
+	defer a.setAppStatusAPI(nil)
 
 	// A lot of routines need the agent API / tailnet API connection.  We run them in their own
 	// goroutines in parallel, but errors in any routine will cause them all to exit so we can
@@ -1313,7 +1393,7 @@ func (a *agent) run() (retErr error) {
 			}
 			manifest := a.manifest.Load()
 			NewWorkspaceAppHealthReporter(
-				a.logger, manifest.Apps, agentsdk.AppHealthPoster(aAPI),
+				a.logger, manifest.Apps, agentsdk.AppHealthPoster(aAPI), a.createHealthcheckCommand,
 			)(ctx)
 			return nil
 		})
@@ -1506,6 +1586,10 @@ func (a *agent) handleManifest(manifestOK *checkpoint) func(ctx context.Context,
 			if err != nil {
 				return xerrors.Errorf("init script runner: %w", err)
 			}
+			err = a.logTailer.Start(manifest.LogTails)
+			if err != nil {
+				return xerrors.Errorf("start log tailer: %w", err)
+			}
 			err = a.trackGoroutine(func() {
 				start := time.Now()
 				// Here we use the graceful context because the script runner is
@@ -1916,6 +2000,17 @@ func (a *agent) createTailnet(
 			return nil, err
 		}
 	}
+	// Serving requires host keys, so this cannot happen sooner. Unlike the
+	// tailnet listeners, this one outlives a failed attempt to create the
+	// tailnet.
+	a.immortalSSHServeOnce.Do(func() {
+		err = a.trackGoroutine(func() {
+			_ = a.sshServer.Serve(a.immortalSSHListener)
+		})
+	})
+	if err != nil {
+		return nil, err
+	}
 
 	reconnectingPTYListener, err := network.Listen("tcp", ":"+strconv.Itoa(workspacesdk.AgentReconnectingPTYPort))
 	if err != nil {
@@ -2386,6 +2481,10 @@ func (a *agent) Close() error {
 		a.logger.Error(a.hardCtx, "script runner close", slog.Error(err))
 	}
 
+	if err := a.logTailer.Close(); err != nil {
+		a.logger.Error(a.hardCtx, "log tailer close", slog.Error(err))
+	}
+
 	if a.socketServer != nil {
 		if err := a.socketServer.Close(); err != nil {
 			a.logger.Error(a.hardCtx, "socket server close", slog.Error(err))
@@ -2404,6 +2503,15 @@ func (a *agent) Close() error {
 		a.logger.Error(a.hardCtx, "desktop API close", slog.Error(err))
 	}
 
+	if err := a.fileFinder.Close(); err != nil {
+		a.logger.Error(a.hardCtx, "file finder close", slog.Error(err))
+	}
+
+	if err := a.immortalStreams.Close(); err != nil {
+		a.logger.Error(a.hardCtx, "immortal streams close", slog.Error(err))
+	}
+	_ = a.immortalSSHListener.Close()
+
 	if err := a.mcpManager.Close(); err != nil {
 		a.logger.Error(a.hardCtx, "mcp manager close", slog.Error(err))
 	}
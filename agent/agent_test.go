@@ -2436,6 +2436,63 @@ func TestAgent_ReconnectingPTY(t *testing.T) {
 	}
 }
 
+func TestAgent_ReconnectingPTYReadOnly(t *testing.T) {
+	t.Parallel()
+	if runtime.GOOS == "windows" {
+		t.Skip("ConPTY appears to be inconsistent on Windows.")
+	}
+
+	ctx := testutil.Context(t, testutil.WaitLong)
+
+	//nolint:dogsled
+	conn, _, _, _, _ := setupAgent(t, agentsdk.Manifest{}, 0)
+	id := uuid.New()
+
+	// Viewers cannot start a session.
+	viewerConn, err := conn.ReconnectingPTY(ctx, id, 80, 80, "bash --norc", workspacesdk.AgentReconnectingPTYInitWithReadOnly())
+	require.NoError(t, err)
+	require.ErrorIs(t, testutil.NewTerminalReader(t, viewerConn).ReadUntil(ctx, nil), io.EOF)
+	_ = viewerConn.Close()
+
+	ownerConn, err := conn.ReconnectingPTY(ctx, id, 80, 80, "bash --norc")
+	require.NoError(t, err)
+	defer ownerConn.Close()
+	ownerReader := testutil.NewTerminalReader(t, ownerConn)
+
+	matchPrompt := func(line string) bool {
+		return strings.Contains(line, "$ ") || strings.Contains(line, "# ")
+	}
+	require.NoError(t, ownerReader.ReadUntil(ctx, matchPrompt), "find prompt")
+
+	viewerConn, err = conn.ReconnectingPTY(ctx, id, 80, 80, "", workspacesdk.AgentReconnectingPTYInitWithReadOnly())
+	require.NoError(t, err)
+	defer viewerConn.Close()
+	viewerReader := testutil.NewTerminalReader(t, viewerConn)
+	require.NoError(t, viewerReader.ReadUntil(ctx, matchPrompt), "find prompt")
+
+	// Input from the viewer must never reach the PTY.
+	data, err := json.Marshal(workspacesdk.ReconnectingPTYRequest{
+		Data: "echo viewer-input\r",
+	})
+	require.NoError(t, err)
+	_, err = viewerConn.Write(data)
+	require.NoError(t, err)
+
+	data, err = json.Marshal(workspacesdk.ReconnectingPTYRequest{
+		Data: "echo owner-input\r",
+	})
+	require.NoError(t, err)
+	_, err = ownerConn.Write(data)
+	require.NoError(t, err)
+
+	var output strings.Builder
+	require.NoError(t, viewerReader.ReadUntil(ctx, func(line string) bool {
+		_, _ = output.WriteString(line)
+		return strings.Contains(line, "owner-input") && !strings.Contains(line, "echo")
+	}), "find owner output")
+	require.NotContains(t, output.String(), "viewer-input")
+}
+
 // This tests end-to-end functionality of connecting to a running container
 // and executing a command. It creates a real Docker container and runs a
 // command. As such, it does not run by default in CI.
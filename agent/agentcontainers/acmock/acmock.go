@@ -121,6 +121,20 @@ func (mr *MockContainerCLIMockRecorder) Remove(ctx, containerName any) *gomock.C
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Remove", reflect.TypeOf((*MockContainerCLI)(nil).Remove), ctx, containerName)
 }
 
+// RemoveProject mocks base method.
+func (m *MockContainerCLI) RemoveProject(ctx context.Context, project string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RemoveProject", ctx, project)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// RemoveProject indicates an expected call of RemoveProject.
+func (mr *MockContainerCLIMockRecorder) RemoveProject(ctx, project any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RemoveProject", reflect.TypeOf((*MockContainerCLI)(nil).RemoveProject), ctx, project)
+}
+
 // Stop mocks base method.
 func (m *MockContainerCLI) Stop(ctx context.Context, containerName string) error {
 	m.ctrl.T.Helper()
@@ -135,6 +149,20 @@ func (mr *MockContainerCLIMockRecorder) Stop(ctx, containerName any) *gomock.Cal
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Stop", reflect.TypeOf((*MockContainerCLI)(nil).Stop), ctx, containerName)
 }
 
+// StopProject mocks base method.
+func (m *MockContainerCLI) StopProject(ctx context.Context, project string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "StopProject", ctx, project)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// StopProject indicates an expected call of StopProject.
+func (mr *MockContainerCLIMockRecorder) StopProject(ctx, project any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "StopProject", reflect.TypeOf((*MockContainerCLI)(nil).StopProject), ctx, project)
+}
+
 // MockDevcontainerCLI is a mock of DevcontainerCLI interface.
 type MockDevcontainerCLI struct {
 	ctrl     *gomock.Controller
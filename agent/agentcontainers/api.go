@@ -40,6 +40,11 @@ import (
 	"github.com/coder/websocket"
 )
 
+// errNotPrimaryComposeService is returned when attempting to inject the
+// agent into a container that is not the primary service of a Docker
+// Compose devcontainer.
+var errNotPrimaryComposeService = xerrors.New("container is not the primary compose service")
+
 const (
 	defaultUpdateInterval   = 10 * time.Second
 	defaultOperationTimeout = 15 * time.Second
@@ -103,6 +108,7 @@ type API struct {
 	injectedSubAgentProcs    map[string]subAgentProcess                     // By workspace folder.
 	usingWorkspaceFolderName map[string]bool                                // By workspace folder.
 	ignoredDevcontainers     map[string]bool                                // By workspace folder. Tracks three states (true, false and not checked).
+	composeServices          map[string]string                              // By workspace folder. Primary service of Docker Compose devcontainers.
 	asyncWg                  sync.WaitGroup
 }
 
@@ -157,7 +163,8 @@ func WithCommandEnv(ce CommandEnv) Option {
 }
 
 // WithContainerCLI sets the agentcontainers.ContainerCLI implementation
-// to use. The default implementation uses the Docker CLI.
+// to use. The default implementation uses the Docker or Podman CLI,
+// depending on which container runtime is available.
 func WithContainerCLI(ccli ContainerCLI) Option {
 	return func(api *API) {
 		api.ccli = ccli
@@ -338,6 +345,7 @@ func NewAPI(logger slog.Logger, options ...Option) *API {
 		knownDevcontainers:          make(map[string]codersdk.WorkspaceAgentDevcontainer),
 		configFileModifiedTimes:     make(map[string]time.Time),
 		ignoredDevcontainers:        make(map[string]bool),
+		composeServices:             make(map[string]string),
 		recreateSuccessTimes:        make(map[string]time.Time),
 		recreateErrorTimes:          make(map[string]time.Time),
 		scriptLogger:                func(uuid.UUID) ScriptLogger { return noopScriptLogger{} },
@@ -358,11 +366,17 @@ func NewAPI(logger slog.Logger, options ...Option) *API {
 			api.execer,
 		)
 	}
+	// The container runtime is detected lazily on first use, since the
+	// runtime may not be on the PATH until the agent environment has
+	// been set up.
+	runtimeDetector := newContainerRuntimeDetector(api.execer)
 	if api.ccli == nil {
-		api.ccli = NewDockerCLI(api.execer)
+		api.ccli = newDetectedContainerCLI(runtimeDetector, api.execer)
 	}
 	if api.dccli == nil {
-		api.dccli = NewDevcontainerCLI(logger.Named("devcontainer-cli"), api.execer)
+		api.dccli = NewDevcontainerCLI(logger.Named("devcontainer-cli"), api.execer,
+			WithDevcontainerCLIRuntime(runtimeDetector.Runtime),
+		)
 	}
 	if api.watcher == nil {
 		var err error
@@ -967,6 +981,14 @@ func (api *API) processUpdatedContainersLocked(ctx context.Context, updated code
 			logger.Debug(ctx, "container matches include filter, processing devcontainer", slog.F("container_labels", container.Labels), slog.F("include_filter", api.containerLabelIncludeFilter))
 		}
 
+		// Only the container of the primary service of a Docker
+		// Compose devcontainer represents the devcontainer. The other
+		// services are shown as its sidecars.
+		if service, primary := container.Labels[DockerComposeServiceLabel], api.composeServices[workspaceFolder]; service != "" && primary != "" && service != primary {
+			logger.Debug(ctx, "container is not the primary compose service, ignoring", slog.F("service", service), slog.F("primary_service", primary))
+			continue
+		}
+
 		if dc, ok := api.knownDevcontainers[workspaceFolder]; ok {
 			// If no config path is set, this devcontainer was defined
 			// in Terraform without the optional config file. Assume the
@@ -1018,6 +1040,7 @@ func (api *API) processUpdatedContainersLocked(ctx context.Context, updated code
 				dc.Name, api.usingWorkspaceFolderName[dc.WorkspaceFolder] = api.makeAgentName(dc.WorkspaceFolder, dc.Container.FriendlyName)
 			}
 		}
+		dc.Compose = composeForContainer(dc.Container, updated.Containers)
 
 		switch {
 		case dc.Status == codersdk.WorkspaceAgentDevcontainerStatusStarting:
@@ -1073,6 +1096,36 @@ func (api *API) processUpdatedContainersLocked(ctx context.Context, updated code
 	api.containersErr = nil
 }
 
+// composeForContainer returns the Docker Compose project of the given
+// devcontainer container, with the containers of the other services of
+// the project as sidecars. It returns nil if the container is not part
+// of a Compose project.
+func composeForContainer(container *codersdk.WorkspaceAgentContainer, containers []codersdk.WorkspaceAgentContainer) *codersdk.WorkspaceAgentDevcontainerCompose {
+	if container == nil {
+		return nil
+	}
+	project := container.Labels[DockerComposeProjectLabel]
+	if project == "" {
+		return nil
+	}
+
+	compose := &codersdk.WorkspaceAgentDevcontainerCompose{
+		Project:  project,
+		Service:  container.Labels[DockerComposeServiceLabel],
+		Sidecars: []codersdk.WorkspaceAgentContainer{},
+	}
+	for _, c := range containers {
+		if c.ID == container.ID || c.Labels[DockerComposeProjectLabel] != project {
+			continue
+		}
+		compose.Sidecars = append(compose.Sidecars, c)
+	}
+	slices.SortFunc(compose.Sidecars, func(a, b codersdk.WorkspaceAgentContainer) int {
+		return strings.Compare(a.Labels[DockerComposeServiceLabel], b.Labels[DockerComposeServiceLabel])
+	})
+	return compose
+}
+
 var consecutiveHyphenRegex = regexp.MustCompile("-+")
 
 // `safeAgentName` returns a safe agent name derived from a folder name,
@@ -1285,12 +1338,18 @@ func (api *API) handleDevcontainerDelete(w http.ResponseWriter, r *http.Request)
 	}
 
 	var (
-		containerID string
-		subAgentID  uuid.UUID
+		containerID    string
+		composeProject string
+		subAgentID     uuid.UUID
 	)
 	if dc.Container != nil {
 		containerID = dc.Container.ID
 	}
+	// Docker Compose devcontainers are stopped and removed together
+	// with their sidecars.
+	if dc.Compose != nil {
+		composeProject = dc.Compose.Project
+	}
 	if proc, hasSubAgent := api.injectedSubAgentProcs[dc.WorkspaceFolder]; hasSubAgent && proc.agent.ID != uuid.Nil {
 		subAgentID = proc.agent.ID
 		proc.stop()
@@ -1304,7 +1363,11 @@ func (api *API) handleDevcontainerDelete(w http.ResponseWriter, r *http.Request)
 
 	// Stop and remove the container if it exists.
 	if containerID != "" {
-		if err := api.ccli.Stop(ctx, containerID); err != nil {
+		stop := func() error { return api.ccli.Stop(ctx, containerID) }
+		if composeProject != "" {
+			stop = func() error { return api.ccli.StopProject(ctx, composeProject) }
+		}
+		if err := stop(); err != nil {
 			api.logger.Error(ctx, "unable to stop container", slog.Error(err))
 
 			api.mu.Lock()
@@ -1330,7 +1393,11 @@ func (api *API) handleDevcontainerDelete(w http.ResponseWriter, r *http.Request)
 	api.mu.Unlock()
 
 	if containerID != "" {
-		if err := api.ccli.Remove(ctx, containerID); err != nil {
+		remove := func() error { return api.ccli.Remove(ctx, containerID) }
+		if composeProject != "" {
+			remove = func() error { return api.ccli.RemoveProject(ctx, composeProject) }
+		}
+		if err := remove(); err != nil {
 			api.logger.Error(ctx, "unable to remove container", slog.Error(err))
 
 			api.mu.Lock()
@@ -1377,6 +1444,7 @@ func (api *API) handleDevcontainerDelete(w http.ResponseWriter, r *http.Request)
 	delete(api.recreateErrorTimes, dc.WorkspaceFolder)
 	delete(api.usingWorkspaceFolderName, dc.WorkspaceFolder)
 	delete(api.injectedSubAgentProcs, dc.WorkspaceFolder)
+	delete(api.composeServices, dc.WorkspaceFolder)
 	api.broadcastUpdatesLocked()
 	api.mu.Unlock()
 
@@ -1415,6 +1483,11 @@ func (api *API) handleDevcontainerRecreate(w http.ResponseWriter, r *http.Reques
 		return
 	}
 
+	var composeProject string
+	if dc.Compose != nil {
+		composeProject = dc.Compose.Project
+	}
+
 	// Update the status so that we don't try to recreate the
 	// devcontainer multiple times in parallel.
 	dc.Status = codersdk.WorkspaceAgentDevcontainerStatusStarting
@@ -1424,6 +1497,17 @@ func (api *API) handleDevcontainerRecreate(w http.ResponseWriter, r *http.Reques
 	api.broadcastUpdatesLocked()
 
 	go func() {
+		// Take down the whole Docker Compose project first so that
+		// the sidecars are recreated along with the primary service.
+		if composeProject != "" {
+			if err := api.ccli.RemoveProject(api.ctx, composeProject); err != nil {
+				api.logger.Warn(api.ctx, "remove compose project before recreation failed",
+					slog.F("devcontainer_id", dc.ID),
+					slog.F("compose_project", composeProject),
+					slog.Error(err),
+				)
+			}
+		}
 		_ = api.CreateDevcontainer(dc.WorkspaceFolder, dc.ConfigPath, WithRemoveExistingContainer())
 	}()
 
@@ -1770,11 +1854,17 @@ func (api *API) maybeInjectSubAgentIntoContainerLocked(ctx context.Context, dc c
 	ranSubAgent := false
 
 	// Clean up if injection fails.
-	var dcIgnored, setDCIgnored bool
+	var (
+		dcIgnored, setDCIgnored bool
+		composeService          string
+	)
 	defer func() {
 		if setDCIgnored {
 			api.ignoredDevcontainers[dc.WorkspaceFolder] = dcIgnored
 		}
+		if composeService != "" {
+			api.composeServices[dc.WorkspaceFolder] = composeService
+		}
 		if !ranSubAgent {
 			proc.stop()
 			if !api.closed {
@@ -1863,6 +1953,15 @@ func (api *API) maybeInjectSubAgentIntoContainerLocked(ctx context.Context, dc c
 				return nil
 			}
 
+			// The agent only runs in the primary service of a Docker
+			// Compose devcontainer.
+			if config.Configuration.IsCompose() {
+				composeService = config.Configuration.Service
+				if service := container.Labels[DockerComposeServiceLabel]; service != "" && service != composeService {
+					return errNotPrimaryComposeService
+				}
+			}
+
 			workspaceFolder = config.Workspace.WorkspaceFolder
 
 			featureOptionsAsEnvs = config.MergedConfiguration.Features.OptionsAsEnvs()
@@ -1913,6 +2012,15 @@ func (api *API) maybeInjectSubAgentIntoContainerLocked(ctx context.Context, dc c
 
 			return nil
 		}(); err != nil {
+			if errors.Is(err, errNotPrimaryComposeService) {
+				// The next update picks the container of the primary
+				// service now that it is known.
+				logger.Info(ctx, "skipping subagent injection into non-primary compose service",
+					slog.F("service", container.Labels[DockerComposeServiceLabel]),
+					slog.F("primary_service", composeService),
+				)
+				return nil
+			}
 			api.logger.Error(ctx, "unable to read devcontainer config", slog.Error(err))
 		}
 
@@ -54,6 +54,9 @@ type fakeContainerCLI struct {
 	execErr    error
 	stopErr    error
 	removeErr  error
+
+	stoppedProjects []string
+	removedProjects []string
 }
 
 func (f *fakeContainerCLI) List(_ context.Context) (codersdk.WorkspaceAgentListContainersResponse, error) {
@@ -106,6 +109,33 @@ func (f *fakeContainerCLI) Remove(ctx context.Context, name string) error {
 	return f.removeErr
 }
 
+func (f *fakeContainerCLI) StopProject(_ context.Context, project string) error {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	f.stoppedProjects = append(f.stoppedProjects, project)
+	for i, container := range f.containers.Containers {
+		if container.Labels[agentcontainers.DockerComposeProjectLabel] == project {
+			container.Running = false
+			f.containers.Containers[i] = container
+		}
+	}
+
+	return f.stopErr
+}
+
+func (f *fakeContainerCLI) RemoveProject(_ context.Context, project string) error {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	f.removedProjects = append(f.removedProjects, project)
+	f.containers.Containers = slice.Filter(f.containers.Containers, func(container codersdk.WorkspaceAgentContainer) bool {
+		return container.Labels[agentcontainers.DockerComposeProjectLabel] != project
+	})
+
+	return f.removeErr
+}
+
 // fakeDevcontainerCLI implements the agentcontainers.DevcontainerCLI
 // interface for testing.
 type fakeDevcontainerCLI struct {
@@ -3925,16 +3955,23 @@ func TestAPI(t *testing.T) {
 		_ = api.RefreshContainers(ctx) // Ignore error since docker commands will fail.
 
 		// Verify commands were executed through the custom shell and environment.
-		require.NotEmpty(t, fakeExec.commands, "commands should be executed")
+		// The container runtime is detected first, followed by listing
+		// the containers.
+		require.GreaterOrEqual(t, len(fakeExec.commands), 2, "commands should be executed")
 
 		// Want: /bin/custom-shell -c "$@" "" docker ps --all --quiet --no-trunc
 		// The command is passed as positional parameters and run via "$@" so
 		// the shell forwards argv without re-parsing it.
-		require.Equal(t, testShell, fakeExec.commands[0][0], "custom shell should be used")
-		require.Equal(t, "-c", fakeExec.commands[0][1], "shell should be called with -c")
-		require.Equal(t, `"$@"`, fakeExec.commands[0][2], "script should run argv via \"$@\"")
-		require.Equal(t, "", fakeExec.commands[0][3], "$0 slot should be an empty placeholder")
-		require.Equal(t, []string{"docker", "ps", "--all", "--quiet", "--no-trunc"}, fakeExec.commands[0][4:], "argv should be passed through unquoted")
+		for i, wantArgv := range [][]string{
+			{"docker", "--version"},
+			{"docker", "ps", "--all", "--quiet", "--no-trunc"},
+		} {
+			require.Equal(t, testShell, fakeExec.commands[i][0], "custom shell should be used")
+			require.Equal(t, "-c", fakeExec.commands[i][1], "shell should be called with -c")
+			require.Equal(t, `"$@"`, fakeExec.commands[i][2], "script should run argv via \"$@\"")
+			require.Equal(t, "", fakeExec.commands[i][3], "$0 slot should be an empty placeholder")
+			require.Equal(t, wantArgv, fakeExec.commands[i][4:], "argv should be passed through unquoted")
+		}
 
 		// Verify the environment was set on the command.
 		lastCmd := fakeExec.getLastCommand()
@@ -5185,3 +5222,245 @@ func TestDevcontainerPrebuildSupport(t *testing.T) {
 	// And: We expect this app to have the post-claim URL.
 	require.Equal(t, userAppURL, secondApp.URL)
 }
+
+func TestDevcontainerCompose(t *testing.T) {
+	t.Parallel()
+
+	if runtime.GOOS == "windows" {
+		t.Skip("Dev Container tests are not supported on Windows (this test uses mocks but fails due to Windows paths)")
+	}
+
+	const (
+		workspaceFolder = "/workspaces/myproject"
+		configPath      = "/workspaces/myproject/.devcontainer/devcontainer.json"
+		project         = "myproject"
+	)
+
+	composeConfig := agentcontainers.DevcontainerConfig{
+		Configuration: agentcontainers.DevcontainerConfiguration{
+			DockerComposeFile: agentcontainers.DevcontainerComposeFiles{"docker-compose.yml"},
+			Service:           "app",
+			RunServices:       []string{"app", "db"},
+		},
+		Workspace: agentcontainers.DevcontainerWorkspace{
+			WorkspaceFolder: "/workspaces/myproject",
+		},
+	}
+
+	newContainers := func() (app, db codersdk.WorkspaceAgentContainer) {
+		app = codersdk.WorkspaceAgentContainer{
+			ID:           "app-container",
+			FriendlyName: "myproject-app-1",
+			Running:      true,
+			CreatedAt:    time.Now(),
+			Labels: map[string]string{
+				agentcontainers.DevcontainerLocalFolderLabel: workspaceFolder,
+				agentcontainers.DevcontainerConfigFileLabel:  configPath,
+				agentcontainers.DockerComposeProjectLabel:    project,
+				agentcontainers.DockerComposeServiceLabel:    "app",
+			},
+		}
+		db = codersdk.WorkspaceAgentContainer{
+			ID:           "db-container",
+			FriendlyName: "myproject-db-1",
+			Running:      true,
+			Health:       "healthy",
+			CreatedAt:    time.Now(),
+			Labels: map[string]string{
+				agentcontainers.DockerComposeProjectLabel: project,
+				agentcontainers.DockerComposeServiceLabel: "db",
+			},
+		}
+		return app, db
+	}
+
+	// startAPI starts the API and waits for the initial update.
+	startAPI := func(ctx context.Context, t *testing.T, opts ...agentcontainers.Option) (*agentcontainers.API, http.Handler) {
+		t.Helper()
+
+		mClock := quartz.NewMock(t)
+		mClock.Set(time.Now()).MustWait(ctx)
+		tickerTrap := mClock.Trap().TickerFunc("updaterLoop")
+
+		api := agentcontainers.NewAPI(testutil.Logger(t), append([]agentcontainers.Option{
+			agentcontainers.WithClock(mClock),
+			agentcontainers.WithWatcher(watcher.NewNoop()),
+		}, opts...)...)
+		api.Start()
+		t.Cleanup(func() { _ = api.Close() })
+
+		tickerTrap.MustWait(ctx).MustRelease(ctx)
+		tickerTrap.Close()
+
+		r := chi.NewRouter()
+		r.Mount("/", api.Routes())
+		return api, r
+	}
+
+	listDevcontainers := func(ctx context.Context, t *testing.T, handler http.Handler) []codersdk.WorkspaceAgentDevcontainer {
+		t.Helper()
+
+		req := httptest.NewRequest(http.MethodGet, "/", nil).WithContext(ctx)
+		rec := httptest.NewRecorder()
+		handler.ServeHTTP(rec, req)
+		require.Equal(t, http.StatusOK, rec.Code)
+		var resp codersdk.WorkspaceAgentListContainersResponse
+		require.NoError(t, json.NewDecoder(rec.Body).Decode(&resp))
+		return resp.Devcontainers
+	}
+
+	t.Run("ListShowsSidecars", func(t *testing.T) {
+		t.Parallel()
+
+		ctx := testutil.Context(t, testutil.WaitShort)
+		app, db := newContainers()
+		_, handler := startAPI(ctx, t,
+			agentcontainers.WithContainerCLI(&fakeContainerCLI{
+				containers: codersdk.WorkspaceAgentListContainersResponse{
+					Containers: []codersdk.WorkspaceAgentContainer{app, db},
+				},
+				arch: "<none>",
+			}),
+			agentcontainers.WithDevcontainerCLI(&fakeDevcontainerCLI{readConfig: composeConfig}),
+		)
+
+		devcontainers := listDevcontainers(ctx, t, handler)
+		require.Len(t, devcontainers, 1, "sidecars must not be listed as devcontainers")
+		dc := devcontainers[0]
+		require.NotNil(t, dc.Container)
+		assert.Equal(t, app.ID, dc.Container.ID)
+		require.NotNil(t, dc.Compose)
+		assert.Equal(t, project, dc.Compose.Project)
+		assert.Equal(t, "app", dc.Compose.Service)
+		require.Len(t, dc.Compose.Sidecars, 1)
+		assert.Equal(t, db.ID, dc.Compose.Sidecars[0].ID)
+		assert.Equal(t, "healthy", dc.Compose.Sidecars[0].Health)
+	})
+
+	t.Run("DeleteActsOnProject", func(t *testing.T) {
+		t.Parallel()
+
+		ctx := testutil.Context(t, testutil.WaitShort)
+		app, db := newContainers()
+		fCCLI := &fakeContainerCLI{
+			containers: codersdk.WorkspaceAgentListContainersResponse{
+				Containers: []codersdk.WorkspaceAgentContainer{app, db},
+			},
+			arch: "<none>",
+		}
+		_, handler := startAPI(ctx, t,
+			agentcontainers.WithContainerCLI(fCCLI),
+			agentcontainers.WithDevcontainerCLI(&fakeDevcontainerCLI{readConfig: composeConfig}),
+		)
+
+		devcontainers := listDevcontainers(ctx, t, handler)
+		require.Len(t, devcontainers, 1)
+
+		req := httptest.NewRequest(http.MethodDelete, "/devcontainers/"+devcontainers[0].ID.String()+"/", nil).WithContext(ctx)
+		rec := httptest.NewRecorder()
+		handler.ServeHTTP(rec, req)
+		require.Equal(t, http.StatusNoContent, rec.Code, rec.Body.String())
+
+		fCCLI.mu.Lock()
+		defer fCCLI.mu.Unlock()
+		assert.Equal(t, []string{project}, fCCLI.stoppedProjects)
+		assert.Equal(t, []string{project}, fCCLI.removedProjects)
+		assert.Empty(t, fCCLI.containers.Containers, "all compose containers should be removed")
+	})
+
+	t.Run("RecreateRemovesProject", func(t *testing.T) {
+		t.Parallel()
+
+		ctx := testutil.Context(t, testutil.WaitShort)
+		app, db := newContainers()
+		fCCLI := &fakeContainerCLI{
+			containers: codersdk.WorkspaceAgentListContainersResponse{
+				Containers: []codersdk.WorkspaceAgentContainer{app, db},
+			},
+			arch: "<none>",
+		}
+		upCalled := make(chan struct{})
+		_, handler := startAPI(ctx, t,
+			agentcontainers.WithContainerCLI(fCCLI),
+			agentcontainers.WithDevcontainerCLI(&fakeDevcontainerCLI{
+				readConfig: composeConfig,
+				up: func(_, _ string) (string, error) {
+					// The project is removed before the devcontainer
+					// is brought back up.
+					fCCLI.mu.Lock()
+					assert.Equal(t, []string{project}, fCCLI.removedProjects)
+					fCCLI.mu.Unlock()
+					close(upCalled)
+					return app.ID, nil
+				},
+			}),
+		)
+
+		devcontainers := listDevcontainers(ctx, t, handler)
+		require.Len(t, devcontainers, 1)
+
+		req := httptest.NewRequest(http.MethodPost, "/devcontainers/"+devcontainers[0].ID.String()+"/recreate", nil).WithContext(ctx)
+		rec := httptest.NewRecorder()
+		handler.ServeHTTP(rec, req)
+		require.Equal(t, http.StatusAccepted, rec.Code, rec.Body.String())
+
+		testutil.TryReceive(ctx, t, upCalled)
+	})
+
+	t.Run("InjectsOnlyIntoPrimaryService", func(t *testing.T) {
+		t.Parallel()
+
+		ctx := testutil.Context(t, testutil.WaitMedium)
+		app, db := newContainers()
+		// The sidecar also carries the devcontainer labels, e.g.
+		// because the services share a YAML anchor. It is listed last
+		// so that it is picked before the primary service is known.
+		db.Labels[agentcontainers.DevcontainerLocalFolderLabel] = workspaceFolder
+		db.Labels[agentcontainers.DevcontainerConfigFileLabel] = configPath
+
+		coderBin, err := os.Executable()
+		require.NoError(t, err)
+		coderBin, err = filepath.EvalSymlinks(coderBin)
+		require.NoError(t, err)
+
+		mCCLI := acmock.NewMockContainerCLI(gomock.NewController(t))
+		mCCLI.EXPECT().List(gomock.Any()).Return(codersdk.WorkspaceAgentListContainersResponse{
+			Containers: []codersdk.WorkspaceAgentContainer{app, db},
+		}, nil).AnyTimes()
+		// Injection into the sidecar stops after reading the config.
+		mCCLI.EXPECT().DetectArchitecture(gomock.Any(), db.ID).Return(runtime.GOARCH, nil).Times(1)
+		expectSubAgentInjection(mCCLI, app.ID, runtime.GOARCH, coderBin)
+
+		fDCCLI := &fakeDevcontainerCLI{readConfig: composeConfig}
+		agentRunning := make(chan struct{})
+		stopAgent := make(chan struct{})
+		requireDevcontainerExec(ctx, t, fDCCLI, agentRunning, stopAgent)
+
+		fSAC := &fakeSubAgentClient{logger: testutil.Logger(t).Named("fakeSubAgentClient")}
+		api, handler := startAPI(ctx, t,
+			agentcontainers.WithContainerCLI(mCCLI),
+			agentcontainers.WithDevcontainerCLI(fDCCLI),
+			agentcontainers.WithSubAgentClient(fSAC),
+			agentcontainers.WithSubAgentURL("test-subagent-url"),
+		)
+		defer close(stopAgent)
+
+		// The first update learns the primary service from the config
+		// and the next one switches to its container.
+		require.NoError(t, api.RefreshContainers(ctx))
+		testutil.TryReceive(ctx, t, agentRunning)
+
+		devcontainers := listDevcontainers(ctx, t, handler)
+		require.Len(t, devcontainers, 1)
+		require.NotNil(t, devcontainers[0].Container)
+		assert.Equal(t, app.ID, devcontainers[0].Container.ID)
+		assert.Empty(t, devcontainers[0].Error)
+		require.NotNil(t, devcontainers[0].Compose)
+		require.Len(t, devcontainers[0].Compose.Sidecars, 1)
+		assert.Equal(t, db.ID, devcontainers[0].Compose.Sidecars[0].ID)
+
+		fSAC.mu.Lock()
+		defer fSAC.mu.Unlock()
+		require.Len(t, fSAC.created, 1)
+	})
+}
@@ -1,8 +1,13 @@
 package agentcontainers
 
 import (
+	"bytes"
 	"context"
+	"sync"
 
+	"golang.org/x/xerrors"
+
+	"github.com/coder/coder/v2/agent/agentexec"
 	"github.com/coder/coder/v2/codersdk"
 )
 
@@ -21,6 +26,10 @@ type ContainerCLI interface {
 	Stop(ctx context.Context, containerName string) error
 	// Remove removes the container
 	Remove(ctx context.Context, containerName string) error
+	// StopProject stops all containers of a Docker Compose project.
+	StopProject(ctx context.Context, project string) error
+	// RemoveProject removes all containers of a Docker Compose project.
+	RemoveProject(ctx context.Context, project string) error
 }
 
 // noopContainerCLI is a ContainerCLI that does nothing.
@@ -39,5 +48,122 @@ func (noopContainerCLI) Copy(_ context.Context, _ string, _ string, _ string) er
 func (noopContainerCLI) ExecAs(_ context.Context, _ string, _ string, _ ...string) ([]byte, error) {
 	return nil, nil
 }
-func (noopContainerCLI) Stop(_ context.Context, _ string) error   { return nil }
-func (noopContainerCLI) Remove(_ context.Context, _ string) error { return nil }
+func (noopContainerCLI) Stop(_ context.Context, _ string) error          { return nil }
+func (noopContainerCLI) Remove(_ context.Context, _ string) error        { return nil }
+func (noopContainerCLI) StopProject(_ context.Context, _ string) error   { return nil }
+func (noopContainerCLI) RemoveProject(_ context.Context, _ string) error { return nil }
+
+// ContainerRuntime is the container engine used to manage containers in
+// the workspace.
+type ContainerRuntime string
+
+const (
+	ContainerRuntimeDocker ContainerRuntime = "docker"
+	ContainerRuntimePodman ContainerRuntime = "podman"
+)
+
+// DetectContainerRuntime detects which container runtime is available.
+// Docker is preferred when both are installed, unless the docker binary
+// is the Podman compatibility wrapper (podman-docker), in which case
+// Podman is used directly.
+func DetectContainerRuntime(ctx context.Context, execer agentexec.Execer) (ContainerRuntime, error) {
+	stdout, _, err := runCmd(ctx, execer, "docker", "--version")
+	if err == nil {
+		if bytes.Contains(bytes.ToLower(stdout), []byte("podman")) {
+			return ContainerRuntimePodman, nil
+		}
+		return ContainerRuntimeDocker, nil
+	}
+	dockerErr := err
+	if _, _, err := runCmd(ctx, execer, "podman", "--version"); err == nil {
+		return ContainerRuntimePodman, nil
+	}
+	return "", xerrors.Errorf("no container runtime found: %w", dockerErr)
+}
+
+// containerRuntimeDetector lazily detects the container runtime and
+// caches the result once a runtime has been found.
+type containerRuntimeDetector struct {
+	execer agentexec.Execer
+
+	mu      sync.Mutex
+	runtime ContainerRuntime
+}
+
+func newContainerRuntimeDetector(execer agentexec.Execer) *containerRuntimeDetector {
+	return &containerRuntimeDetector{execer: execer}
+}
+
+// Runtime returns the detected container runtime. If no runtime could be
+// detected, Docker is returned and detection is retried on the next call,
+// since a runtime may be installed after the agent has started.
+func (d *containerRuntimeDetector) Runtime(ctx context.Context) ContainerRuntime {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	if d.runtime != "" {
+		return d.runtime
+	}
+	rt, err := DetectContainerRuntime(ctx, d.execer)
+	if err != nil {
+		return ContainerRuntimeDocker
+	}
+	d.runtime = rt
+	return rt
+}
+
+// detectedContainerCLI is a ContainerCLI that delegates to the Docker or
+// Podman implementation depending on the detected container runtime.
+type detectedContainerCLI struct {
+	detector *containerRuntimeDetector
+	docker   ContainerCLI
+	podman   ContainerCLI
+}
+
+var _ ContainerCLI = (*detectedContainerCLI)(nil)
+
+func newDetectedContainerCLI(detector *containerRuntimeDetector, execer agentexec.Execer) *detectedContainerCLI {
+	return &detectedContainerCLI{
+		detector: detector,
+		docker:   NewDockerCLI(execer),
+		podman:   NewPodmanCLI(execer),
+	}
+}
+
+func (d *detectedContainerCLI) cli(ctx context.Context) ContainerCLI {
+	if d.detector.Runtime(ctx) == ContainerRuntimePodman {
+		return d.podman
+	}
+	return d.docker
+}
+
+func (d *detectedContainerCLI) List(ctx context.Context) (codersdk.WorkspaceAgentListContainersResponse, error) {
+	return d.cli(ctx).List(ctx)
+}
+
+func (d *detectedContainerCLI) DetectArchitecture(ctx context.Context, containerName string) (string, error) {
+	return d.cli(ctx).DetectArchitecture(ctx, containerName)
+}
+
+func (d *detectedContainerCLI) Copy(ctx context.Context, containerName, src, dst string) error {
+	return d.cli(ctx).Copy(ctx, containerName, src, dst)
+}
+
+func (d *detectedContainerCLI) ExecAs(ctx context.Context, containerName, user string, args ...string) ([]byte, error) {
+	return d.cli(ctx).ExecAs(ctx, containerName, user, args...)
+}
+
+func (d *detectedContainerCLI) Stop(ctx context.Context, containerName string) error {
+	return d.cli(ctx).Stop(ctx, containerName)
+}
+
+func (d *detectedContainerCLI) Remove(ctx context.Context, containerName string) error {
+	return d.cli(ctx).Remove(ctx, containerName)
+}
+
+func (d *detectedContainerCLI) StopProject(ctx context.Context, project string) error {
+	return d.cli(ctx).StopProject(ctx, project)
+}
+
+func (d *detectedContainerCLI) RemoveProject(ctx context.Context, project string) error {
+	return d.cli(ctx).RemoveProject(ctx, project)
+}
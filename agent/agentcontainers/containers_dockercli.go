@@ -28,6 +28,7 @@ import (
 // information about a container.
 type DockerEnvInfoer struct {
 	usershell.SystemEnvInfo
+	runtime   ContainerRuntime
 	container string
 	user      *user.User
 	userShell string
@@ -38,11 +39,14 @@ type DockerEnvInfoer struct {
 func EnvInfo(ctx context.Context, execer agentexec.Execer, container, containerUser string) (*DockerEnvInfoer, error) {
 	var dei DockerEnvInfoer
 	dei.container = container
+	dei.runtime = ContainerRuntimeDocker
+	if rt, err := DetectContainerRuntime(ctx, execer); err == nil {
+		dei.runtime = rt
+	}
 
 	if containerUser == "" {
 		// Get the "default" user of the container if no user is specified.
-		// TODO: handle different container runtimes.
-		cmd, args := wrapDockerExec(container, "", "whoami")
+		cmd, args := wrapContainerExec(dei.runtime, container, "", "whoami")
 		stdout, stderr, err := run(ctx, execer, cmd, args...)
 		if err != nil {
 			return nil, xerrors.Errorf("get container user: run whoami: %w: %s", err, stderr)
@@ -54,7 +58,7 @@ func EnvInfo(ctx context.Context, execer agentexec.Execer, container, containerU
 	}
 	// Now that we know the username, get the required info from the container.
 	// We can't assume the presence of `getent` so we'll just have to sniff /etc/passwd.
-	cmd, args := wrapDockerExec(container, containerUser, "cat", "/etc/passwd")
+	cmd, args := wrapContainerExec(dei.runtime, container, containerUser, "cat", "/etc/passwd")
 	stdout, stderr, err := run(ctx, execer, cmd, args...)
 	if err != nil {
 		return nil, xerrors.Errorf("get container user: read /etc/passwd: %w: %q", err, stderr)
@@ -104,7 +108,7 @@ func EnvInfo(ctx context.Context, execer agentexec.Execer, container, containerU
 	// We need to inspect the container labels for remoteEnv and append these to
 	// the resulting docker exec command.
 	// ref: https://code.visualstudio.com/docs/devcontainers/attach-container
-	env, err := devcontainerEnv(ctx, execer, container)
+	env, err := devcontainerEnv(ctx, execer, dei.runtime, container)
 	if err != nil { // best effort.
 		return nil, xerrors.Errorf("read devcontainer remoteEnv: %w", err)
 	}
@@ -124,7 +128,8 @@ func (dei *DockerEnvInfoer) Shell(string) (string, error) {
 }
 
 func (dei *DockerEnvInfoer) ModifyCommand(cmd string, args ...string) (string, []string) {
-	// Wrap the command with `docker exec` and run it as the container user.
+	// Wrap the command with `docker exec` (or `podman exec`) and run it as
+	// the container user.
 	// There is some additional munging here regarding the container user and environment.
 	dockerArgs := []string{
 		"exec",
@@ -146,18 +151,22 @@ func (dei *DockerEnvInfoer) ModifyCommand(cmd string, args ...string) (string, [
 
 	// Append the container name and the command.
 	dockerArgs = append(dockerArgs, dei.container, cmd)
-	return "docker", append(dockerArgs, args...)
+	return string(dei.runtime), append(dockerArgs, args...)
 }
 
 // devcontainerEnv is a helper function that inspects the container labels to
 // find the required environment variables for running a command in the container.
-func devcontainerEnv(ctx context.Context, execer agentexec.Execer, container string) ([]string, error) {
-	stdout, stderr, err := runDockerInspect(ctx, execer, container)
+func devcontainerEnv(ctx context.Context, execer agentexec.Execer, runtime ContainerRuntime, container string) ([]string, error) {
+	inspect, convert := runDockerInspect, convertDockerInspect
+	if runtime == ContainerRuntimePodman {
+		inspect, convert = runPodmanInspect, convertPodmanInspect
+	}
+	stdout, stderr, err := inspect(ctx, execer, container)
 	if err != nil {
 		return nil, xerrors.Errorf("inspect container: %w: %q", err, stderr)
 	}
 
-	ins, _, err := convertDockerInspect(stdout)
+	ins, _, err := convert(stdout)
 	if err != nil {
 		return nil, xerrors.Errorf("inspect container: %w", err)
 	}
@@ -200,17 +209,17 @@ func devcontainerEnv(ctx context.Context, execer agentexec.Execer, container str
 	return env, nil
 }
 
-// wrapDockerExec is a helper function that wraps the given command and arguments
-// with a docker exec command that runs as the given user in the given
-// container. This is used to fetch information about a container prior to
-// running the actual command.
-func wrapDockerExec(containerName, userName, cmd string, args ...string) (string, []string) {
+// wrapContainerExec is a helper function that wraps the given command and
+// arguments with a docker (or podman) exec command that runs as the given
+// user in the given container. This is used to fetch information about a
+// container prior to running the actual command.
+func wrapContainerExec(runtime ContainerRuntime, containerName, userName, cmd string, args ...string) (string, []string) {
 	dockerArgs := []string{"exec", "--interactive"}
 	if userName != "" {
 		dockerArgs = append(dockerArgs, "--user", userName)
 	}
 	dockerArgs = append(dockerArgs, containerName, cmd)
-	return "docker", append(dockerArgs, args...)
+	return string(runtime), append(dockerArgs, args...)
 }
 
 // Helper function to run a command and return its stdout and stderr.
@@ -242,9 +251,21 @@ func NewDockerCLI(execer agentexec.Execer) ContainerCLI {
 }
 
 func (dcli *dockerCLI) List(ctx context.Context) (codersdk.WorkspaceAgentListContainersResponse, error) {
+	return listContainers(ctx, dcli.execer, ContainerRuntimeDocker)
+}
+
+// listContainers lists all containers of the given container runtime,
+// running and stopped.
+func listContainers(ctx context.Context, execer agentexec.Execer, runtime ContainerRuntime) (codersdk.WorkspaceAgentListContainersResponse, error) {
+	binary := string(runtime)
+	inspect, convert := runDockerInspect, convertDockerInspect
+	if runtime == ContainerRuntimePodman {
+		inspect, convert = runPodmanInspect, convertPodmanInspect
+	}
+
 	var stdoutBuf, stderrBuf bytes.Buffer
 	// List all container IDs, one per line, with no truncation
-	cmd := dcli.execer.CommandContext(ctx, "docker", "ps", "--all", "--quiet", "--no-trunc")
+	cmd := execer.CommandContext(ctx, binary, "ps", "--all", "--quiet", "--no-trunc")
 	cmd.Stdout = &stdoutBuf
 	cmd.Stderr = &stderrBuf
 	if err := cmd.Run(); err != nil {
@@ -252,7 +273,7 @@ func (dcli *dockerCLI) List(ctx context.Context) (codersdk.WorkspaceAgentListCon
 		// - docker not installed
 		// - docker not running
 		// - no permissions to talk to docker
-		return codersdk.WorkspaceAgentListContainersResponse{}, xerrors.Errorf("run docker ps: %w: %q", err, strings.TrimSpace(stderrBuf.String()))
+		return codersdk.WorkspaceAgentListContainersResponse{}, xerrors.Errorf("run %s ps: %w: %q", binary, err, strings.TrimSpace(stderrBuf.String()))
 	}
 
 	ids := make([]string, 0)
@@ -265,7 +286,7 @@ func (dcli *dockerCLI) List(ctx context.Context) (codersdk.WorkspaceAgentListCon
 		ids = append(ids, tmp)
 	}
 	if err := scanner.Err(); err != nil {
-		return codersdk.WorkspaceAgentListContainersResponse{}, xerrors.Errorf("scan docker ps output: %w", err)
+		return codersdk.WorkspaceAgentListContainersResponse{}, xerrors.Errorf("scan %s ps output: %w", binary, err)
 	}
 
 	res := codersdk.WorkspaceAgentListContainersResponse{
@@ -288,18 +309,18 @@ func (dcli *dockerCLI) List(ctx context.Context) (codersdk.WorkspaceAgentListCon
 	// will still contain valid JSON. We will just end up missing
 	// information about the removed container. We could potentially
 	// log this error, but I'm not sure it's worth it.
-	dockerInspectStdout, dockerInspectStderr, err := runDockerInspect(ctx, dcli.execer, ids...)
+	dockerInspectStdout, dockerInspectStderr, err := inspect(ctx, execer, ids...)
 	if err != nil {
-		return codersdk.WorkspaceAgentListContainersResponse{}, xerrors.Errorf("run docker inspect: %w: %s", err, dockerInspectStderr)
+		return codersdk.WorkspaceAgentListContainersResponse{}, xerrors.Errorf("run %s inspect: %w: %s", binary, err, dockerInspectStderr)
 	}
 
 	if len(dockerInspectStderr) > 0 {
 		res.Warnings = append(res.Warnings, string(dockerInspectStderr))
 	}
 
-	outs, warns, err := convertDockerInspect(dockerInspectStdout)
+	outs, warns, err := convert(dockerInspectStdout)
 	if err != nil {
-		return codersdk.WorkspaceAgentListContainersResponse{}, xerrors.Errorf("convert docker inspect output: %w", err)
+		return codersdk.WorkspaceAgentListContainersResponse{}, xerrors.Errorf("convert %s inspect output: %w", binary, err)
 	}
 	res.Warnings = append(res.Warnings, warns...)
 	res.Containers = append(res.Containers, outs...)
@@ -311,12 +332,19 @@ func (dcli *dockerCLI) List(ctx context.Context) (codersdk.WorkspaceAgentListCon
 // container IDs and returns the parsed output.
 // The stderr output is also returned for logging purposes.
 func runDockerInspect(ctx context.Context, execer agentexec.Execer, ids ...string) (stdout, stderr []byte, err error) {
+	return runInspect(ctx, execer, "docker", []string{"inspect"}, "No such object:", ids...)
+}
+
+// runInspect runs the inspect command of the given container runtime
+// binary on the given container IDs. Errors about missing containers,
+// identified by notFound in stderr, are ignored.
+func runInspect(ctx context.Context, execer agentexec.Execer, binary string, inspectArgs []string, notFound string, ids ...string) (stdout, stderr []byte, err error) {
 	if ctx.Err() != nil {
 		// If the context is done, we don't want to run the command.
 		return []byte{}, []byte{}, ctx.Err()
 	}
 	var stdoutBuf, stderrBuf bytes.Buffer
-	cmd := execer.CommandContext(ctx, "docker", append([]string{"inspect"}, ids...)...)
+	cmd := execer.CommandContext(ctx, binary, append(slices.Clone(inspectArgs), ids...)...)
 	cmd.Stdout = &stdoutBuf
 	cmd.Stderr = &stderrBuf
 	err = cmd.Run()
@@ -329,7 +357,7 @@ func runDockerInspect(ctx context.Context, execer agentexec.Execer, ids ...strin
 			// which is likely to be "signal: killed".
 			return stdout, stderr, ctx.Err()
 		}
-		if bytes.Contains(stderr, []byte("No such object:")) {
+		if bytes.Contains(stderr, []byte(notFound)) {
 			// This can happen if a container is deleted between the time we check for its existence and the time we inspect it.
 			return stdout, stderr, nil
 		}
@@ -367,9 +395,14 @@ type dockerInspectMount struct {
 }
 
 type dockerInspectState struct {
-	Running  bool   `json:"Running"`
-	ExitCode int    `json:"ExitCode"`
-	Error    string `json:"Error"`
+	Running  bool                 `json:"Running"`
+	ExitCode int                  `json:"ExitCode"`
+	Error    string               `json:"Error"`
+	Health   *dockerInspectHealth `json:"Health"`
+}
+
+type dockerInspectHealth struct {
+	Status string `json:"Status"`
 }
 
 type dockerInspectNetworkSettings struct {
@@ -394,11 +427,18 @@ func (dis dockerInspectState) String() string {
 }
 
 func convertDockerInspect(raw []byte) ([]codersdk.WorkspaceAgentContainer, []string, error) {
-	var warns []string
 	var ins []dockerInspect
 	if err := json.NewDecoder(bytes.NewReader(raw)).Decode(&ins); err != nil {
 		return nil, nil, xerrors.Errorf("decode docker inspect output: %w", err)
 	}
+	outs, warns := convertInspect(ins)
+	return outs, warns, nil
+}
+
+// convertInspect converts the decoded inspect output of a container
+// runtime into containers, returning any warnings encountered.
+func convertInspect(ins []dockerInspect) ([]codersdk.WorkspaceAgentContainer, []string) {
+	var warns []string
 	outs := make([]codersdk.WorkspaceAgentContainer, 0, len(ins))
 
 	// Say you have two containers:
@@ -427,6 +467,9 @@ func convertDockerInspect(raw []byte) ([]codersdk.WorkspaceAgentContainer, []str
 			Status:       in.State.String(),
 			Volumes:      make(map[string]string, len(in.Mounts)),
 		}
+		if in.State.Health != nil {
+			out.Health = in.State.Health.Status
+		}
 
 		if in.NetworkSettings.Ports == nil {
 			in.NetworkSettings.Ports = make(map[string][]dockerInspectPort)
@@ -495,7 +538,7 @@ func convertDockerInspect(raw []byte) ([]codersdk.WorkspaceAgentContainer, []str
 		}
 	}
 
-	return outs, warns, nil
+	return outs, warns
 }
 
 // convertDockerPort converts a Docker port string to a port number and network
@@ -599,6 +642,25 @@ func (dcli *dockerCLI) Remove(ctx context.Context, containerName string) error {
 	return nil
 }
 
+// StopProject stops all containers of a Docker Compose project.
+func (dcli *dockerCLI) StopProject(ctx context.Context, project string) error {
+	_, stderr, err := runCmd(ctx, dcli.execer, "docker", "compose", "--project-name", project, "stop")
+	if err != nil {
+		return xerrors.Errorf("stop compose project %s: %w: %s", project, err, stderr)
+	}
+	return nil
+}
+
+// RemoveProject removes all containers and networks of a Docker Compose
+// project. Volumes are kept.
+func (dcli *dockerCLI) RemoveProject(ctx context.Context, project string) error {
+	_, stderr, err := runCmd(ctx, dcli.execer, "docker", "compose", "--project-name", project, "down")
+	if err != nil {
+		return xerrors.Errorf("remove compose project %s: %w: %s", project, err, stderr)
+	}
+	return nil
+}
+
 // runCmd is a helper function that runs a command with the given
 // arguments and returns the stdout and stderr output.
 func runCmd(ctx context.Context, execer agentexec.Execer, cmd string, args ...string) (stdout, stderr []byte, err error) {
@@ -1,8 +1,11 @@
 package agentcontainers
 
 import (
+	"context"
 	"os"
+	"os/exec"
 	"path/filepath"
+	"runtime"
 	"testing"
 	"time"
 
@@ -11,39 +14,52 @@ import (
 	"github.com/stretchr/testify/require"
 
 	"github.com/coder/coder/v2/codersdk"
+	"github.com/coder/coder/v2/pty"
+	"github.com/coder/coder/v2/testutil"
 )
 
-func TestWrapDockerExec(t *testing.T) {
+func TestWrapContainerExec(t *testing.T) {
 	t.Parallel()
 	tests := []struct {
 		name          string
+		runtime       ContainerRuntime
 		containerUser string
 		cmdArgs       []string
 		wantCmd       []string
 	}{
 		{
 			name:          "cmd with no args",
+			runtime:       ContainerRuntimeDocker,
 			containerUser: "my-user",
 			cmdArgs:       []string{"my-cmd"},
 			wantCmd:       []string{"docker", "exec", "--interactive", "--user", "my-user", "my-container", "my-cmd"},
 		},
 		{
 			name:          "cmd with args",
+			runtime:       ContainerRuntimeDocker,
 			containerUser: "my-user",
 			cmdArgs:       []string{"my-cmd", "arg1", "--arg2", "arg3", "--arg4"},
 			wantCmd:       []string{"docker", "exec", "--interactive", "--user", "my-user", "my-container", "my-cmd", "arg1", "--arg2", "arg3", "--arg4"},
 		},
 		{
 			name:          "no user specified",
+			runtime:       ContainerRuntimeDocker,
 			containerUser: "",
 			cmdArgs:       []string{"my-cmd"},
 			wantCmd:       []string{"docker", "exec", "--interactive", "my-container", "my-cmd"},
 		},
+		{
+			name:          "podman",
+			runtime:       ContainerRuntimePodman,
+			containerUser: "my-user",
+			cmdArgs:       []string{"my-cmd"},
+			wantCmd:       []string{"podman", "exec", "--interactive", "--user", "my-user", "my-container", "my-cmd"},
+		},
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
 			t.Parallel()
-			actualCmd, actualArgs := wrapDockerExec("my-container", tt.containerUser, tt.cmdArgs[0], tt.cmdArgs[1:]...)
+			actualCmd, actualArgs := wrapContainerExec(tt.runtime, "my-container", tt.containerUser, tt.cmdArgs[0], tt.cmdArgs[1:]...)
 			assert.Equal(t, tt.wantCmd[0], actualCmd)
 			assert.Equal(t, tt.wantCmd[1:], actualArgs)
 		})
@@ -386,6 +402,42 @@ func TestConvertDockerInspect(t *testing.T) {
 				},
 			},
 		},
+		{
+			name: "devcontainer_compose",
+			expect: []codersdk.WorkspaceAgentContainer{
+				{
+					CreatedAt:    time.Date(2025, 3, 11, 17, 56, 34, 842164541, time.UTC),
+					ID:           "app",
+					FriendlyName: "myproject-app-1",
+					Image:        "mcr.microsoft.com/devcontainers/base:ubuntu",
+					Labels: map[string]string{
+						"com.docker.compose.project": "myproject",
+						"com.docker.compose.service": "app",
+						"devcontainer.config_file":   "/workspaces/myproject/.devcontainer/devcontainer.json",
+						"devcontainer.local_folder":  "/workspaces/myproject",
+					},
+					Running: true,
+					Status:  "running",
+					Ports:   []codersdk.WorkspaceAgentContainerPort{},
+					Volumes: map[string]string{},
+				},
+				{
+					CreatedAt:    time.Date(2025, 3, 11, 17, 56, 33, 842164541, time.UTC),
+					ID:           "db",
+					FriendlyName: "myproject-db-1",
+					Image:        "postgres:16",
+					Labels: map[string]string{
+						"com.docker.compose.project": "myproject",
+						"com.docker.compose.service": "db",
+					},
+					Running: true,
+					Status:  "running",
+					Health:  "healthy",
+					Ports:   []codersdk.WorkspaceAgentContainerPort{},
+					Volumes: map[string]string{},
+				},
+			},
+		},
 	} {
 		t.Run(tt.name, func(t *testing.T) {
 			t.Parallel()
@@ -410,3 +462,162 @@ func TestConvertDockerInspect(t *testing.T) {
 		})
 	}
 }
+
+// TestConvertPodmanInspect tests the convertPodmanInspect function using
+// fixtures from ./testdata.
+func TestConvertPodmanInspect(t *testing.T) {
+	t.Parallel()
+
+	for _, tt := range []struct {
+		name   string
+		expect []codersdk.WorkspaceAgentContainer
+	}{
+		{
+			// A rootless container with ports published on all
+			// interfaces, and the infra container of a pod that
+			// should be skipped.
+			name: "podman_simple",
+			expect: []codersdk.WorkspaceAgentContainer{
+				{
+					CreatedAt:    time.Date(2025, 6, 2, 10, 14, 7, 906312077, time.UTC),
+					ID:           "0d9d8ad5ab3a1fe7a9b1e03c1c1c7e18ef3f04fcd2d6a8a8f98c1e0c9f1f2b6d",
+					FriendlyName: "relaxed_turing",
+					Image:        "docker.io/library/debian:bookworm",
+					Labels: map[string]string{
+						"devcontainer.config_file":  "/home/coder/project/.devcontainer/devcontainer.json",
+						"devcontainer.local_folder": "/home/coder/project",
+					},
+					Running: true,
+					Status:  "running",
+					Ports: []codersdk.WorkspaceAgentContainerPort{
+						{
+							Network:  "tcp",
+							Port:     8080,
+							HostPort: 8080,
+							HostIP:   "0.0.0.0",
+						},
+						{
+							Network:  "tcp",
+							Port:     9090,
+							HostPort: 9090,
+							HostIP:   "127.0.0.1",
+						},
+					},
+					Volumes: map[string]string{
+						"/home/coder/.local/share/containers/storage/volumes/cache/_data": "/cache",
+						"/home/coder/project": "/workspaces/project",
+					},
+				},
+			},
+		},
+		{
+			// Older Podman versions report the health status in
+			// State.Healthcheck and may omit labels.
+			name: "podman_healthcheck",
+			expect: []codersdk.WorkspaceAgentContainer{
+				{
+					CreatedAt:    time.Date(2025, 6, 2, 10, 20, 31, 554310891, time.UTC),
+					ID:           "c3f1e8d5a2b74f6e9d0c1b2a3f4e5d6c7b8a9f0e1d2c3b4a5f6e7d8c9b0a1f2e",
+					FriendlyName: "myproject_db_1",
+					Image:        "docker.io/library/postgres:16",
+					Labels:       map[string]string{},
+					Running:      true,
+					Status:       "running",
+					Health:       "healthy",
+					Ports:        []codersdk.WorkspaceAgentContainerPort{},
+					Volumes:      map[string]string{},
+				},
+			},
+		},
+	} {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			bs, err := os.ReadFile(filepath.Join("testdata", tt.name, "podman_inspect.json"))
+			require.NoError(t, err, "failed to read testdata file")
+			actual, warns, err := convertPodmanInspect(bs)
+			require.NoError(t, err, "expected no error")
+			assert.Empty(t, warns, "expected no warnings")
+			if diff := cmp.Diff(tt.expect, actual); diff != "" {
+				t.Errorf("unexpected diff (-want +got):\n%s", diff)
+			}
+		})
+	}
+}
+
+// runtimeExecer is an agentexec.Execer that simulates the output of
+// `<binary> --version` for the given binaries. Binaries that are not
+// present fail to run.
+type runtimeExecer struct {
+	versions map[string]string
+}
+
+func (e runtimeExecer) CommandContext(ctx context.Context, cmd string, _ ...string) *exec.Cmd {
+	version, ok := e.versions[cmd]
+	if !ok {
+		return exec.CommandContext(ctx, "false")
+	}
+	return exec.CommandContext(ctx, "echo", version)
+}
+
+func (runtimeExecer) PTYCommandContext(context.Context, string, ...string) *pty.Cmd {
+	panic("not implemented")
+}
+
+func TestDetectContainerRuntime(t *testing.T) {
+	t.Parallel()
+
+	if runtime.GOOS == "windows" {
+		t.Skip("test uses echo and false")
+	}
+
+	for _, tt := range []struct {
+		name     string
+		versions map[string]string
+		want     ContainerRuntime
+		wantErr  bool
+	}{
+		{
+			name:     "Docker",
+			versions: map[string]string{"docker": "Docker version 28.1.1, build 4eba377"},
+			want:     ContainerRuntimeDocker,
+		},
+		{
+			name:     "Podman",
+			versions: map[string]string{"podman": "podman version 5.2.2"},
+			want:     ContainerRuntimePodman,
+		},
+		{
+			name: "PreferDocker",
+			versions: map[string]string{
+				"docker": "Docker version 28.1.1, build 4eba377",
+				"podman": "podman version 5.2.2",
+			},
+			want: ContainerRuntimeDocker,
+		},
+		{
+			name: "PodmanDockerWrapper",
+			versions: map[string]string{
+				"docker": "podman version 5.2.2",
+				"podman": "podman version 5.2.2",
+			},
+			want: ContainerRuntimePodman,
+		},
+		{
+			name:    "None",
+			wantErr: true,
+		},
+	} {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			ctx := testutil.Context(t, testutil.WaitShort)
+			got, err := DetectContainerRuntime(ctx, runtimeExecer{versions: tt.versions})
+			if tt.wantErr {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tt.want, got)
+		})
+	}
+}
@@ -0,0 +1,221 @@
+package agentcontainers
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"time"
+
+	"golang.org/x/xerrors"
+
+	"github.com/coder/coder/v2/agent/agentexec"
+	"github.com/coder/coder/v2/codersdk"
+)
+
+// podmanCLI is an implementation for Podman CLI that lists containers.
+// It supports both rootful and rootless Podman. With rootless Podman,
+// the container runs in a user namespace where UID 0 maps to the user
+// running the agent, so running commands as root inside the container
+// requires no privileges on the host.
+type podmanCLI struct {
+	execer agentexec.Execer
+}
+
+var _ ContainerCLI = (*podmanCLI)(nil)
+
+func NewPodmanCLI(execer agentexec.Execer) ContainerCLI {
+	return &podmanCLI{
+		execer: execer,
+	}
+}
+
+func (pcli *podmanCLI) List(ctx context.Context) (codersdk.WorkspaceAgentListContainersResponse, error) {
+	return listContainers(ctx, pcli.execer, ContainerRuntimePodman)
+}
+
+// runPodmanInspect is a helper function that runs `podman container
+// inspect` on the given container IDs. Unlike `podman inspect`, this
+// never matches images, volumes or pods that share a name with a
+// container.
+func runPodmanInspect(ctx context.Context, execer agentexec.Execer, ids ...string) (stdout, stderr []byte, err error) {
+	return runInspect(ctx, execer, "podman", []string{"container", "inspect"}, "no such container", ids...)
+}
+
+// podmanInspect is the subset of the `podman container inspect` output
+// that we use. It mostly mirrors the Docker output, with a few
+// differences that are normalized in convertPodmanInspect.
+type podmanInspect struct {
+	ID              string                       `json:"Id"`
+	Created         time.Time                    `json:"Created"`
+	ImageName       string                       `json:"ImageName"`
+	Config          dockerInspectConfig          `json:"Config"`
+	Name            string                       `json:"Name"`
+	Mounts          []dockerInspectMount         `json:"Mounts"`
+	State           podmanInspectState           `json:"State"`
+	NetworkSettings dockerInspectNetworkSettings `json:"NetworkSettings"`
+	// IsInfra is true for the infra container of a pod, which only
+	// holds the pod namespaces and is not of interest to users.
+	IsInfra bool `json:"IsInfra"`
+}
+
+type podmanInspectState struct {
+	Running  bool                 `json:"Running"`
+	ExitCode int                  `json:"ExitCode"`
+	Error    string               `json:"Error"`
+	Health   *dockerInspectHealth `json:"Health"`
+	// Healthcheck is the name of the Health field in older Podman
+	// versions.
+	Healthcheck *dockerInspectHealth `json:"Healthcheck"`
+}
+
+func convertPodmanInspect(raw []byte) ([]codersdk.WorkspaceAgentContainer, []string, error) {
+	var pins []podmanInspect
+	if err := json.NewDecoder(bytes.NewReader(raw)).Decode(&pins); err != nil {
+		return nil, nil, xerrors.Errorf("decode podman inspect output: %w", err)
+	}
+
+	ins := make([]dockerInspect, 0, len(pins))
+	for _, pin := range pins {
+		if pin.IsInfra {
+			continue
+		}
+		in := dockerInspect{
+			ID:      pin.ID,
+			Created: pin.Created,
+			Config:  pin.Config,
+			Name:    pin.Name,
+			Mounts:  pin.Mounts,
+			State: dockerInspectState{
+				Running:  pin.State.Running,
+				ExitCode: pin.State.ExitCode,
+				Error:    pin.State.Error,
+				Health:   pin.State.Health,
+			},
+			NetworkSettings: pin.NetworkSettings,
+		}
+		if in.Config.Image == "" {
+			in.Config.Image = pin.ImageName
+		}
+		if in.Config.Labels == nil {
+			in.Config.Labels = map[string]string{}
+		}
+		if in.State.Health == nil {
+			in.State.Health = pin.State.Healthcheck
+		}
+		// Podman reports an empty status for containers without a
+		// healthcheck rather than omitting the field.
+		if in.State.Health != nil && in.State.Health.Status == "" {
+			in.State.Health = nil
+		}
+		// Podman leaves the host IP empty for ports published on all
+		// interfaces, where Docker uses the unspecified address.
+		for port, bindings := range in.NetworkSettings.Ports {
+			for i := range bindings {
+				if bindings[i].HostIP == "" {
+					bindings[i].HostIP = "0.0.0.0"
+				}
+			}
+			in.NetworkSettings.Ports[port] = bindings
+		}
+		ins = append(ins, in)
+	}
+
+	outs, warns := convertInspect(ins)
+	return outs, warns, nil
+}
+
+// DetectArchitecture detects the architecture of a container by inspecting its
+// image.
+func (pcli *podmanCLI) DetectArchitecture(ctx context.Context, containerName string) (string, error) {
+	stdout, stderr, err := runCmd(ctx, pcli.execer, "podman", "container", "inspect", "--format", "{{.Image}}", containerName)
+	if err != nil {
+		return "", xerrors.Errorf("inspect container %s: %w: %s", containerName, err, stderr)
+	}
+	imageID := string(stdout)
+	if imageID == "" {
+		return "", xerrors.Errorf("no image found for container %s", containerName)
+	}
+
+	stdout, stderr, err = runCmd(ctx, pcli.execer, "podman", "image", "inspect", "--format", "{{.Architecture}}", imageID)
+	if err != nil {
+		return "", xerrors.Errorf("inspect image %s: %w: %s", imageID, err, stderr)
+	}
+	arch := string(stdout)
+	if arch == "" {
+		return "", xerrors.Errorf("no architecture found for image %s", imageID)
+	}
+	return arch, nil
+}
+
+// Copy copies a file from the host to a container.
+func (pcli *podmanCLI) Copy(ctx context.Context, containerName, src, dst string) error {
+	// With rootless Podman, the UID of the file on the host may not be
+	// mapped into the container user namespace, in which case it would
+	// be owned by "nobody" and could not be changed from inside the
+	// container. Archive mode chowns the copied file to the container
+	// user instead.
+	_, stderr, err := runCmd(ctx, pcli.execer, "podman", "cp", "--archive", src, containerName+":"+dst)
+	if err != nil {
+		return xerrors.Errorf("copy %s to %s:%s: %w: %s", src, containerName, dst, err, stderr)
+	}
+	return nil
+}
+
+// ExecAs executes a command in a container as a specific user.
+func (pcli *podmanCLI) ExecAs(ctx context.Context, containerName, uid string, args ...string) ([]byte, error) {
+	execArgs := []string{"exec"}
+	if uid != "" {
+		altUID := uid
+		if uid == "root" {
+			// UID 0 is more portable than the name root, and is always
+			// mapped in the container user namespace, even when the
+			// container runs rootless with --userns=keep-id.
+			altUID = "0"
+		}
+		execArgs = append(execArgs, "--user", altUID)
+	}
+	execArgs = append(execArgs, containerName)
+	execArgs = append(execArgs, args...)
+
+	stdout, stderr, err := runCmd(ctx, pcli.execer, "podman", execArgs...)
+	if err != nil {
+		return nil, xerrors.Errorf("exec in container %s as user %s: %w: %s", containerName, uid, err, stderr)
+	}
+	return stdout, nil
+}
+
+func (pcli *podmanCLI) Stop(ctx context.Context, containerName string) error {
+	_, stderr, err := runCmd(ctx, pcli.execer, "podman", "stop", containerName)
+	if err != nil {
+		return xerrors.Errorf("stop %s: %w: %s", containerName, err, stderr)
+	}
+	return nil
+}
+
+func (pcli *podmanCLI) Remove(ctx context.Context, containerName string) error {
+	_, stderr, err := runCmd(ctx, pcli.execer, "podman", "rm", containerName)
+	if err != nil {
+		return xerrors.Errorf("remove %s: %w: %s", containerName, err, stderr)
+	}
+	return nil
+}
+
+// StopProject stops all containers of a Compose project using the
+// compose provider configured for Podman.
+func (pcli *podmanCLI) StopProject(ctx context.Context, project string) error {
+	_, stderr, err := runCmd(ctx, pcli.execer, "podman", "compose", "--project-name", project, "stop")
+	if err != nil {
+		return xerrors.Errorf("stop compose project %s: %w: %s", project, err, stderr)
+	}
+	return nil
+}
+
+// RemoveProject removes all containers and networks of a Compose
+// project. Volumes are kept.
+func (pcli *podmanCLI) RemoveProject(ctx context.Context, project string) error {
+	_, stderr, err := runCmd(ctx, pcli.execer, "podman", "compose", "--project-name", project, "down")
+	if err != nil {
+		return xerrors.Errorf("remove compose project %s: %w: %s", project, err, stderr)
+	}
+	return nil
+}
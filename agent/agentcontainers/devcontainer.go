@@ -23,6 +23,12 @@ const (
 	DevcontainerIsTestRunLabel = "devcontainer.is_test_run"
 	// The default workspace folder inside the devcontainer.
 	DevcontainerDefaultContainerWorkspaceFolder = "/workspaces"
+	// DockerComposeProjectLabel is the label Docker Compose sets to the
+	// name of the project a container belongs to.
+	DockerComposeProjectLabel = "com.docker.compose.project"
+	// DockerComposeServiceLabel is the label Docker Compose sets to the
+	// name of the service a container runs.
+	DockerComposeServiceLabel = "com.docker.compose.service"
 )
 
 func ExtractDevcontainerScripts(
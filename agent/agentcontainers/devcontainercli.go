@@ -80,6 +80,39 @@ func (f DevcontainerFeatures) OptionsAsEnvs() []string {
 
 type DevcontainerConfiguration struct {
 	Customizations DevcontainerCustomizations `json:"customizations,omitempty"`
+	// DockerComposeFile, Service and RunServices are set for
+	// devcontainers defined with Docker Compose. Service is the primary
+	// service, the one the devcontainer runs in.
+	DockerComposeFile DevcontainerComposeFiles `json:"dockerComposeFile,omitempty"`
+	Service           string                   `json:"service,omitempty"`
+	RunServices       []string                 `json:"runServices,omitempty"`
+}
+
+// IsCompose returns true if the devcontainer is defined with Docker
+// Compose.
+func (c DevcontainerConfiguration) IsCompose() bool {
+	return len(c.DockerComposeFile) > 0 && c.Service != ""
+}
+
+// DevcontainerComposeFiles holds the dockerComposeFile property, which
+// may be either a single path or a list of paths.
+type DevcontainerComposeFiles []string
+
+func (f *DevcontainerComposeFiles) UnmarshalJSON(data []byte) error {
+	var file string
+	if err := json.Unmarshal(data, &file); err == nil {
+		*f = nil
+		if file != "" {
+			*f = DevcontainerComposeFiles{file}
+		}
+		return nil
+	}
+	var files []string
+	if err := json.Unmarshal(data, &files); err != nil {
+		return xerrors.Errorf("dockerComposeFile must be a string or a list of strings: %w", err)
+	}
+	*f = files
+	return nil
 }
 
 type DevcontainerCustomizations struct {
@@ -217,17 +250,43 @@ func applyDevcontainerCLIReadConfigOptions(opts []DevcontainerCLIReadConfigOptio
 }
 
 type devcontainerCLI struct {
-	logger slog.Logger
-	execer agentexec.Execer
+	logger  slog.Logger
+	execer  agentexec.Execer
+	runtime func(context.Context) ContainerRuntime
 }
 
 var _ DevcontainerCLI = &devcontainerCLI{}
 
-func NewDevcontainerCLI(logger slog.Logger, execer agentexec.Execer) DevcontainerCLI {
-	return &devcontainerCLI{
+// DevcontainerCLIOption is an option for NewDevcontainerCLI.
+type DevcontainerCLIOption func(*devcontainerCLI)
+
+// WithDevcontainerCLIRuntime sets the function used to determine the
+// container runtime. When it returns ContainerRuntimePodman, the
+// devcontainer CLI is told to use the podman binary instead of docker.
+func WithDevcontainerCLIRuntime(runtime func(context.Context) ContainerRuntime) DevcontainerCLIOption {
+	return func(d *devcontainerCLI) {
+		d.runtime = runtime
+	}
+}
+
+func NewDevcontainerCLI(logger slog.Logger, execer agentexec.Execer, opts ...DevcontainerCLIOption) DevcontainerCLI {
+	d := &devcontainerCLI{
 		execer: execer,
 		logger: logger,
 	}
+	for _, opt := range opts {
+		opt(d)
+	}
+	return d
+}
+
+// runtimeArgs returns the arguments that select the container runtime
+// binary used by the devcontainer CLI.
+func (d *devcontainerCLI) runtimeArgs(ctx context.Context) []string {
+	if d.runtime == nil || d.runtime(ctx) != ContainerRuntimePodman {
+		return nil
+	}
+	return []string{"--docker-path", string(ContainerRuntimePodman)}
 }
 
 func (d *devcontainerCLI) Up(ctx context.Context, workspaceFolder, configPath string, opts ...DevcontainerCLIUpOptions) (string, error) {
@@ -242,6 +301,7 @@ func (d *devcontainerCLI) Up(ctx context.Context, workspaceFolder, configPath st
 	if configPath != "" {
 		args = append(args, "--config", configPath)
 	}
+	args = append(args, d.runtimeArgs(ctx)...)
 	args = append(args, conf.Args...)
 	cmd := d.execer.CommandContext(ctx, "devcontainer", args...)
 
@@ -304,6 +364,7 @@ func (d *devcontainerCLI) Exec(ctx context.Context, workspaceFolder, configPath
 	if configPath != "" {
 		args = append(args, "--config", configPath)
 	}
+	args = append(args, d.runtimeArgs(ctx)...)
 	args = append(args, conf.Args...)
 	args = append(args, cmd)
 	args = append(args, cmdArgs...)
@@ -338,6 +399,7 @@ func (d *devcontainerCLI) ReadConfig(ctx context.Context, workspaceFolder, confi
 	if configPath != "" {
 		args = append(args, "--config", configPath)
 	}
+	args = append(args, d.runtimeArgs(ctx)...)
 
 	c := d.execer.CommandContext(ctx, "devcontainer", args...)
 	c.Env = append(c.Env, env...)
@@ -317,6 +317,24 @@ func TestDevcontainerCLI_ArgsAndParsing(t *testing.T) {
 					},
 				},
 			},
+			{
+				name:            "WithDockerCompose",
+				logFile:         "read-config-with-docker-compose.log",
+				workspaceFolder: "/home/coder/myproject",
+				configPath:      "",
+				wantArgs:        "read-configuration --include-merged-configuration --workspace-folder /home/coder/myproject",
+				wantError:       false,
+				wantConfig: agentcontainers.DevcontainerConfig{
+					Configuration: agentcontainers.DevcontainerConfiguration{
+						DockerComposeFile: agentcontainers.DevcontainerComposeFiles{"docker-compose.yml"},
+						Service:           "app",
+						RunServices:       []string{"app", "db"},
+					},
+					Workspace: agentcontainers.DevcontainerWorkspace{
+						WorkspaceFolder: "/workspaces/myproject",
+					},
+				},
+			},
 			{
 				name:            "FileNotFound",
 				logFile:         "read-config-error-not-found.log",
@@ -353,6 +371,56 @@ func TestDevcontainerCLI_ArgsAndParsing(t *testing.T) {
 			})
 		}
 	})
+
+	t.Run("Podman", func(t *testing.T) {
+		t.Parallel()
+
+		podman := agentcontainers.WithDevcontainerCLIRuntime(func(context.Context) agentcontainers.ContainerRuntime {
+			return agentcontainers.ContainerRuntimePodman
+		})
+
+		t.Run("Up", func(t *testing.T) {
+			t.Parallel()
+
+			ctx := testutil.Context(t, testutil.WaitMedium)
+			testExecer := &testDevcontainerExecer{
+				testExePath: testExePath,
+				wantArgs:    "up --log-format json --workspace-folder /test/workspace --docker-path podman --remove-existing-container",
+				logFile:     filepath.Join("testdata", "devcontainercli", "parse", "up.log"),
+			}
+			dccli := agentcontainers.NewDevcontainerCLI(logger, testExecer, podman)
+			containerID, err := dccli.Up(ctx, "/test/workspace", "", agentcontainers.WithRemoveExistingContainer())
+			require.NoError(t, err)
+			assert.NotEmpty(t, containerID)
+		})
+
+		t.Run("Exec", func(t *testing.T) {
+			t.Parallel()
+
+			ctx := testutil.Context(t, testutil.WaitMedium)
+			testExecer := &testDevcontainerExecer{
+				testExePath: testExePath,
+				wantArgs:    "exec --workspace-folder /test/workspace --docker-path podman --container-id test-container-123 echo hello",
+			}
+			dccli := agentcontainers.NewDevcontainerCLI(logger, testExecer, podman)
+			err := dccli.Exec(ctx, "/test/workspace", "", "echo", []string{"hello"}, agentcontainers.WithExecContainerID("test-container-123"))
+			require.NoError(t, err)
+		})
+
+		t.Run("ReadConfig", func(t *testing.T) {
+			t.Parallel()
+
+			ctx := testutil.Context(t, testutil.WaitMedium)
+			testExecer := &testDevcontainerExecer{
+				testExePath: testExePath,
+				wantArgs:    "read-configuration --include-merged-configuration --workspace-folder /test/workspace --docker-path podman",
+				logFile:     filepath.Join("testdata", "devcontainercli", "readconfig", "read-config-without-coder-customization.log"),
+			}
+			dccli := agentcontainers.NewDevcontainerCLI(logger, testExecer, podman)
+			_, err := dccli.ReadConfig(ctx, "/test/workspace", "", []string{})
+			require.NoError(t, err)
+		})
+	})
 }
 
 // TestDevcontainerCLI_WithOutput tests that WithUpOutput and WithExecOutput capture CLI
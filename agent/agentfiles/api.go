@@ -2,14 +2,21 @@ package agentfiles
 
 import (
 	"net/http"
+	"sync"
+	"time"
 
 	"github.com/go-chi/chi/v5"
 	"github.com/spf13/afero"
 
 	"cdr.dev/slog/v3"
 	"github.com/coder/coder/v2/agent/agentgit"
+	"github.com/coder/coder/v2/agent/agentssh"
+	"github.com/coder/coder/v2/agent/filefinder"
 	"github.com/coder/coder/v2/agent/usershell"
+	"github.com/coder/coder/v2/coderd/httpapi"
+	"github.com/coder/coder/v2/codersdk"
 	"github.com/coder/coder/v2/codersdk/workspacesdk"
+	"github.com/coder/quartz"
 )
 
 // API exposes file-related operations performed through the agent.
@@ -19,6 +26,15 @@ type API struct {
 	pathStore         *agentgit.PathStore
 	envInfo           usershell.EnvInfoer
 	bundleFilesLimits workspacesdk.BundleFilesLimits
+	finder            *filefinder.Engine
+	workingDir        func() string
+	blockFileTransfer bool
+	clock             quartz.Clock
+
+	findMu              sync.Mutex
+	findRoots           map[string]*findRoot
+	maxFindRoots        int
+	findRootIdleTimeout time.Duration
 }
 
 // Option configures the API.
@@ -38,6 +54,15 @@ func WithEnvInfo(envInfo usershell.EnvInfoer) Option {
 	}
 }
 
+// WithBlockFileTransfer rejects every route that reads or writes file
+// contents, including the ones used by chat file tools, so that the agent's
+// block file transfer setting cannot be bypassed with these routes.
+func WithBlockFileTransfer(block bool) Option {
+	return func(api *API) {
+		api.blockFileTransfer = block
+	}
+}
+
 func NewAPI(logger slog.Logger, filesystem afero.Fs, pathStore *agentgit.PathStore, opts ...Option) *API {
 	api := &API{
 		logger:            logger,
@@ -45,6 +70,11 @@ func NewAPI(logger slog.Logger, filesystem afero.Fs, pathStore *agentgit.PathSto
 		pathStore:         pathStore,
 		envInfo:           usershell.SystemEnvInfo{},
 		bundleFilesLimits: defaultBundleFilesLimits,
+		clock:             quartz.NewReal(),
+
+		findRoots:           make(map[string]*findRoot),
+		maxFindRoots:        defaultMaxFindRoots,
+		findRootIdleTimeout: defaultFindRootIdleTimeout,
 	}
 	for _, opt := range opts {
 		opt(api)
@@ -58,11 +88,33 @@ func (api *API) Routes() http.Handler {
 
 	r.Post("/list-directory", api.HandleLS)
 	r.Get("/resolve-path", api.HandleResolvePath)
-	r.Get("/read-file", api.HandleReadFile)
-	r.Get("/read-file-lines", api.HandleReadFileLines)
-	r.Post("/write-file", api.HandleWriteFile)
-	r.Post("/edit-files", api.HandleEditFiles)
-	r.Post("/bundle-files", api.HandleBundleFiles)
+	r.Post("/remove-path", api.HandleRemovePath)
+	r.Get("/find", api.HandleFind)
+	r.Get("/find/watch", api.HandleWatchFind)
+
+	// These routes move file contents in or out of the workspace.
+	r.Group(func(r chi.Router) {
+		r.Use(api.blockFileTransferMW)
+		r.Get("/read-file", api.HandleReadFile)
+		r.Get("/read-file-lines", api.HandleReadFileLines)
+		r.Post("/write-file", api.HandleWriteFile)
+		r.Post("/edit-files", api.HandleEditFiles)
+		r.Post("/bundle-files", api.HandleBundleFiles)
+		r.Get("/sync/watch", api.HandleWatchSync)
+	})
 
 	return r
 }
+
+// blockFileTransferMW rejects requests when file transfers are blocked.
+func (api *API) blockFileTransferMW(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(rw http.ResponseWriter, r *http.Request) {
+		if api.blockFileTransfer {
+			httpapi.Write(r.Context(), rw, http.StatusForbidden, codersdk.Response{
+				Message: agentssh.BlockedFileTransferErrorMessage,
+			})
+			return
+		}
+		next.ServeHTTP(rw, r)
+	})
+}
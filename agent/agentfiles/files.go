@@ -372,6 +372,62 @@ func (api *API) writeFile(ctx context.Context, r *http.Request, path string) (HT
 	return api.atomicWrite(ctx, path, mode, r.Body)
 }
 
+func (api *API) HandleRemovePath(rw http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	query := r.URL.Query()
+	parser := httpapi.NewQueryParamParser().RequiredNotEmpty("path")
+	path := parser.String(query, "", "path")
+	parser.ErrorExcessParams(query)
+	if len(parser.Errors) > 0 {
+		httpapi.Write(ctx, rw, http.StatusBadRequest, codersdk.Response{
+			Message:     "Query parameters have invalid values.",
+			Validations: parser.Errors,
+		})
+		return
+	}
+
+	status, err := api.removePath(path)
+	if err != nil {
+		httpapi.Write(ctx, rw, status, codersdk.Response{
+			Message: err.Error(),
+		})
+		return
+	}
+
+	// Track removed path for git watch.
+	if api.pathStore != nil {
+		if chatContext, ok := agentchat.FromContext(ctx); ok {
+			api.pathStore.AddPaths(append([]uuid.UUID{chatContext.ID}, chatContext.AncestorIDs...), []string{path})
+		}
+	}
+
+	httpapi.Write(ctx, rw, http.StatusOK, codersdk.Response{
+		Message: fmt.Sprintf("Successfully removed %q", path),
+	})
+}
+
+// removePath removes path and, if it is a directory, everything in it.
+// Symlinks are removed rather than followed.
+func (api *API) removePath(path string) (HTTPResponseCode, error) {
+	if !filepath.IsAbs(path) {
+		return http.StatusBadRequest, xerrors.Errorf("file path must be absolute: %q", path)
+	}
+	path = filepath.Clean(path)
+	if filepath.Dir(path) == path {
+		return http.StatusBadRequest, xerrors.Errorf("refusing to remove %q", path)
+	}
+
+	if err := api.filesystem.RemoveAll(path); err != nil {
+		status := http.StatusInternalServerError
+		if errors.Is(err, os.ErrPermission) {
+			status = http.StatusForbidden
+		}
+		return status, err
+	}
+	return 0, nil
+}
+
 func (api *API) HandleEditFiles(rw http.ResponseWriter, r *http.Request) {
 	ctx := r.Context()
 
@@ -567,6 +623,30 @@ func (api *API) prepareFileEdit(path string, edits []workspacesdk.FileEdit) (int
 	}, nil
 }
 
+const atomicWriteTempInfix = ".tmp."
+
+// isAtomicWriteTemp reports whether name is the base name of a temp file
+// created by atomicWrite.
+func isAtomicWriteTemp(name string) bool {
+	if !strings.HasPrefix(name, ".") {
+		return false
+	}
+	i := strings.LastIndex(name, atomicWriteTempInfix)
+	if i < 1 {
+		return false
+	}
+	suffix := name[i+len(atomicWriteTempInfix):]
+	if len(suffix) != 8 {
+		return false
+	}
+	for _, r := range suffix {
+		if !strings.ContainsRune("0123456789abcdef", r) {
+			return false
+		}
+	}
+	return true
+}
+
 // atomicWrite writes content from r to path via a temp file in the
 // same directory. If the target exists, its permissions are preserved.
 // On failure the temp file is cleaned up and the original is
@@ -575,7 +655,7 @@ func (api *API) atomicWrite(ctx context.Context, path string, mode *os.FileMode,
 	logger := api.logger.With(agentchat.Fields(ctx)...)
 
 	dir := filepath.Dir(path)
-	tmpName := filepath.Join(dir, fmt.Sprintf(".%s.tmp.%s", filepath.Base(path), uuid.New().String()[:8]))
+	tmpName := filepath.Join(dir, fmt.Sprintf(".%s%s%s", filepath.Base(path), atomicWriteTempInfix, uuid.New().String()[:8]))
 
 	tmpfile, err := api.filesystem.OpenFile(tmpName, os.O_WRONLY|os.O_CREATE|os.O_EXCL, 0o666)
 	if err != nil {
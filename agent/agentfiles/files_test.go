@@ -27,6 +27,7 @@ import (
 	"github.com/coder/coder/v2/agent/agentchat"
 	"github.com/coder/coder/v2/agent/agentfiles"
 	"github.com/coder/coder/v2/agent/agentgit"
+	"github.com/coder/coder/v2/agent/agentssh"
 	"github.com/coder/coder/v2/codersdk"
 	"github.com/coder/coder/v2/codersdk/workspacesdk"
 	"github.com/coder/coder/v2/testutil"
@@ -109,6 +110,13 @@ func (fs *testFs) Rename(oldName, newName string) error {
 	return fs.Fs.Rename(oldName, newName)
 }
 
+func (fs *testFs) RemoveAll(name string) error {
+	if err := fs.intercept("removeall", name); err != nil {
+		return err
+	}
+	return fs.Fs.RemoveAll(name)
+}
+
 func TestReadFile(t *testing.T) {
 	t.Parallel()
 
@@ -478,6 +486,131 @@ func TestWriteFile_PreservesPermissions(t *testing.T) {
 		"write_file should preserve the original file's permissions")
 }
 
+func TestBlockFileTransfer(t *testing.T) {
+	t.Parallel()
+
+	logger := slogtest.Make(t, &slogtest.Options{IgnoreErrors: true})
+	fs := afero.NewMemMapFs()
+	api := agentfiles.NewAPI(logger, fs, nil, agentfiles.WithBlockFileTransfer(true))
+
+	path := filepath.Join(os.TempDir(), "blocked.txt")
+	require.NoError(t, afero.WriteFile(fs, path, []byte("secret"), 0o600))
+
+	tests := []struct {
+		method string
+		target string
+		body   string
+	}{
+		{method: http.MethodGet, target: "/read-file?path=" + path},
+		{method: http.MethodGet, target: "/read-file-lines?path=" + path},
+		{method: http.MethodPost, target: "/write-file?path=" + path, body: "data"},
+		{method: http.MethodPost, target: "/edit-files", body: "{}"},
+		{method: http.MethodPost, target: "/bundle-files", body: "{}"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.target, func(t *testing.T) {
+			t.Parallel()
+
+			ctx := testutil.Context(t, testutil.WaitShort)
+			w := httptest.NewRecorder()
+			r := httptest.NewRequestWithContext(ctx, tt.method, tt.target, strings.NewReader(tt.body))
+			api.Routes().ServeHTTP(w, r)
+
+			got := &codersdk.Error{}
+			require.NoError(t, json.NewDecoder(w.Body).Decode(got))
+			require.Equal(t, agentssh.BlockedFileTransferErrorMessage, got.Message)
+			require.Equal(t, http.StatusForbidden, w.Code)
+		})
+	}
+}
+
+func TestRemovePath(t *testing.T) {
+	t.Parallel()
+
+	tmpdir := os.TempDir()
+	noPermsPath := filepath.Join(tmpdir, "no-perms-remove")
+	logger := slogtest.Make(t, &slogtest.Options{IgnoreErrors: true}).Leveled(slog.LevelDebug)
+	fs := newTestFs(afero.NewMemMapFs(), func(call, file string) error {
+		if file == noPermsPath {
+			return os.ErrPermission
+		}
+		return nil
+	})
+	api := agentfiles.NewAPI(logger, fs, nil)
+
+	filePath := filepath.Join(tmpdir, "remove-file")
+	require.NoError(t, afero.WriteFile(fs, filePath, []byte("content"), 0o644))
+	dirPath := filepath.Join(tmpdir, "remove-dir")
+	require.NoError(t, afero.WriteFile(fs, filepath.Join(dirPath, "nested", "file"), []byte("content"), 0o644))
+
+	tests := []struct {
+		name    string
+		path    string
+		errCode int
+		error   string
+	}{
+		{
+			name:    "NoPath",
+			path:    "",
+			errCode: http.StatusBadRequest,
+			error:   "\"path\" is required",
+		},
+		{
+			name:    "RelativePath",
+			path:    "relative",
+			errCode: http.StatusBadRequest,
+			error:   "file path must be absolute",
+		},
+		{
+			name:    "Root",
+			path:    "/",
+			errCode: http.StatusBadRequest,
+			error:   "refusing to remove",
+		},
+		{
+			name: "File",
+			path: filePath,
+		},
+		{
+			name: "Directory",
+			path: dirPath,
+		},
+		{
+			name: "NonExistent",
+			path: filepath.Join(tmpdir, "does-not-exist"),
+		},
+		{
+			name:    "NoPermissions",
+			path:    noPermsPath,
+			errCode: http.StatusForbidden,
+			error:   "permission denied",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			ctx := testutil.Context(t, testutil.WaitShort)
+			w := httptest.NewRecorder()
+			r := httptest.NewRequestWithContext(ctx, http.MethodPost, fmt.Sprintf("/remove-path?path=%s", tt.path), nil)
+			api.Routes().ServeHTTP(w, r)
+
+			if tt.errCode != 0 {
+				got := &codersdk.Error{}
+				err := json.NewDecoder(w.Body).Decode(got)
+				require.NoError(t, err)
+				require.ErrorContains(t, got, tt.error)
+				require.Equal(t, tt.errCode, w.Code)
+				return
+			}
+			require.Equal(t, http.StatusOK, w.Code)
+			_, err := fs.Stat(tt.path)
+			require.ErrorIs(t, err, os.ErrNotExist)
+		})
+	}
+}
+
 func TestEditFiles(t *testing.T) {
 	t.Parallel()
 
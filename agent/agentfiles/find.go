@@ -0,0 +1,425 @@
+package agentfiles
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"os"
+	"path/filepath"
+	"slices"
+	"sync"
+	"time"
+
+	"github.com/coder/websocket"
+	"golang.org/x/xerrors"
+
+	"cdr.dev/slog/v3"
+	"github.com/coder/coder/v2/agent/filefinder"
+	"github.com/coder/coder/v2/agent/usershell"
+	"github.com/coder/coder/v2/coderd/httpapi"
+	"github.com/coder/coder/v2/codersdk"
+	"github.com/coder/coder/v2/codersdk/workspacesdk"
+	"github.com/coder/quartz"
+)
+
+const (
+	defaultFindLimit = 50
+	maxFindLimit     = 1000
+
+	// defaultMaxFindRoots bounds how many directories are indexed and
+	// watched at once. The least recently used idle directory is evicted
+	// to make room for a new one.
+	defaultMaxFindRoots = 8
+	// defaultFindRootIdleTimeout is how long a directory stays indexed
+	// after it was last searched.
+	defaultFindRootIdleTimeout = 30 * time.Minute
+)
+
+var (
+	errInvalidFindRoot    = xerrors.New("invalid root")
+	errFindRootNotAllowed = xerrors.New("root not allowed")
+	errTooManyFindRoots   = xerrors.New("too many directories are being searched, try again later")
+)
+
+// WithFileFinder enables the find routes, which search the file index of
+// engine. Directories are indexed the first time they are searched, and
+// removed from the index once they have not been searched for a while.
+// workingDir returns the directory searched when a request does not name
+// one.
+func WithFileFinder(engine *filefinder.Engine, workingDir func() string) Option {
+	return func(api *API) {
+		api.finder = engine
+		api.workingDir = workingDir
+	}
+}
+
+// WithFindRootLimits overrides how many directories the find routes index
+// at once, and how long a directory stays indexed after it was last
+// searched.
+func WithFindRootLimits(maxRoots int, idleTimeout time.Duration) Option {
+	return func(api *API) {
+		api.maxFindRoots = maxRoots
+		api.findRootIdleTimeout = idleTimeout
+	}
+}
+
+// WithClock sets the clock used to evict idle directories from the file
+// index. Defaults to the real clock.
+func WithClock(clock quartz.Clock) Option {
+	return func(api *API) {
+		api.clock = clock
+	}
+}
+
+// findRoot tracks the use of a directory in the file index.
+type findRoot struct {
+	// users is the number of searches and watches using the directory.
+	users    int
+	lastUsed time.Time
+	// evict removes the directory from the index once it has been idle
+	// for long enough.
+	evict *quartz.Timer
+}
+
+// HandleFind fuzzy searches for files and directories.
+func (api *API) HandleFind(rw http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	req, ok := api.parseFindRequest(rw, r)
+	if !ok {
+		return
+	}
+	resp, err := api.find(ctx, req)
+	if err != nil {
+		api.writeFindError(ctx, rw, err)
+		return
+	}
+	httpapi.Write(ctx, rw, http.StatusOK, resp)
+}
+
+// HandleWatchFind fuzzy searches for files and directories, and sends the
+// results again over a websocket whenever they change.
+func (api *API) HandleWatchFind(rw http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	req, ok := api.parseFindRequest(rw, r)
+	if !ok {
+		return
+	}
+	// Searches are resolved against the same root for the lifetime of the
+	// watch, even if the working directory changes.
+	root, err := api.resolveAllowedFindRoot(req.Root)
+	if err != nil {
+		api.writeFindError(ctx, rw, err)
+		return
+	}
+	release, err := api.acquireFindRoot(ctx, root)
+	if err != nil {
+		api.writeFindError(ctx, rw, err)
+		return
+	}
+	defer release()
+
+	// Subscribe before the first search so that changes made while it runs
+	// are not missed.
+	updates, unsubscribe := api.finder.Subscribe()
+	defer unsubscribe()
+
+	resp, err := api.search(ctx, root, req)
+	if err != nil {
+		api.writeFindError(ctx, rw, err)
+		return
+	}
+
+	conn, err := websocket.Accept(rw, r, &websocket.AcceptOptions{
+		CompressionMode: websocket.CompressionNoContextTakeover,
+	})
+	if err != nil {
+		httpapi.Write(ctx, rw, http.StatusInternalServerError, codersdk.Response{
+			Message: "Failed to upgrade connection to websocket.",
+			Detail:  err.Error(),
+		})
+		return
+	}
+	// Close the websocket for reading, so that the websocket library
+	// handles pings and close frames.
+	_ = conn.CloseRead(context.Background())
+
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+	ctx, wsNetConn := codersdk.WebsocketNetConn(ctx, conn, websocket.MessageText)
+	defer wsNetConn.Close()
+
+	encoder := json.NewEncoder(wsNetConn)
+	if err := encoder.Encode(resp); err != nil {
+		api.logger.Debug(ctx, "encode find results", slog.Error(err))
+		return
+	}
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case _, ok := <-updates:
+			if !ok {
+				return
+			}
+		}
+		next, err := api.search(ctx, root, req)
+		if err != nil {
+			api.logger.Debug(ctx, "find", slog.Error(err))
+			return
+		}
+		if slices.Equal(next.Results, resp.Results) {
+			continue
+		}
+		resp = next
+		if err := encoder.Encode(resp); err != nil {
+			api.logger.Debug(ctx, "encode find results", slog.Error(err))
+			return
+		}
+	}
+}
+
+func (api *API) parseFindRequest(rw http.ResponseWriter, r *http.Request) (workspacesdk.FindRequest, bool) {
+	ctx := r.Context()
+
+	query := r.URL.Query()
+	parser := httpapi.NewQueryParamParser().RequiredNotEmpty("query")
+	req := workspacesdk.FindRequest{
+		Query: parser.String(query, "", "query"),
+		Root:  parser.String(query, "", "root"),
+		Limit: parser.Int(query, defaultFindLimit, "limit"),
+	}
+	parser.ErrorExcessParams(query)
+	if req.Limit < 1 || req.Limit > maxFindLimit {
+		parser.Errors = append(parser.Errors, codersdk.ValidationError{
+			Field:  "limit",
+			Detail: "Query param \"limit\" must be between 1 and 1000.",
+		})
+	}
+	if len(parser.Errors) > 0 {
+		httpapi.Write(ctx, rw, http.StatusBadRequest, codersdk.Response{
+			Message:     "Query parameters have invalid values.",
+			Validations: parser.Errors,
+		})
+		return workspacesdk.FindRequest{}, false
+	}
+	if api.finder == nil {
+		httpapi.Write(ctx, rw, http.StatusNotImplemented, codersdk.Response{
+			Message: "File search is not enabled on this agent.",
+		})
+		return workspacesdk.FindRequest{}, false
+	}
+	return req, true
+}
+
+func (api *API) find(ctx context.Context, req workspacesdk.FindRequest) (workspacesdk.FindResponse, error) {
+	root, err := api.resolveAllowedFindRoot(req.Root)
+	if err != nil {
+		return workspacesdk.FindResponse{}, err
+	}
+	release, err := api.acquireFindRoot(ctx, root)
+	if err != nil {
+		return workspacesdk.FindResponse{}, err
+	}
+	defer release()
+	return api.search(ctx, root, req)
+}
+
+// search searches a directory that has been acquired with acquireFindRoot.
+func (api *API) search(ctx context.Context, root string, req workspacesdk.FindRequest) (workspacesdk.FindResponse, error) {
+	opts := filefinder.DefaultSearchOptions()
+	opts.Limit = req.Limit
+	opts.Roots = []string{root}
+	results, err := api.finder.Search(ctx, req.Query, opts)
+	if err != nil {
+		return workspacesdk.FindResponse{}, xerrors.Errorf("search: %w", err)
+	}
+
+	resp := workspacesdk.FindResponse{
+		Root:    root,
+		Results: make([]workspacesdk.FindResult, 0, len(results)),
+	}
+	for _, result := range results {
+		resp.Results = append(resp.Results, workspacesdk.FindResult{
+			Path:         result.Path,
+			AbsolutePath: filepath.Join(root, filepath.FromSlash(result.Path)),
+			IsDir:        result.IsDir,
+			Score:        result.Score,
+		})
+	}
+	return resp, nil
+}
+
+// acquireFindRoot indexes root unless it already is, and keeps it indexed
+// until the returned function is called. Roots are evicted once they have
+// been idle for the idle timeout, or to make room for another root when
+// the maximum number of roots is indexed.
+func (api *API) acquireFindRoot(ctx context.Context, root string) (func(), error) {
+	api.findMu.Lock()
+	defer api.findMu.Unlock()
+
+	fr, ok := api.findRoots[root]
+	if !ok {
+		if len(api.findRoots) >= api.maxFindRoots && !api.evictOldestFindRootLocked() {
+			return nil, errTooManyFindRoots
+		}
+		// Indexing holds the lock so that concurrent requests cannot
+		// index more roots than allowed.
+		if err := api.finder.AddRoot(ctx, root); err != nil {
+			return nil, xerrors.Errorf("index %q: %w", root, err)
+		}
+		fr = &findRoot{}
+		api.findRoots[root] = fr
+	}
+	if fr.evict != nil {
+		fr.evict.Stop()
+		fr.evict = nil
+	}
+	fr.users++
+
+	var once sync.Once
+	return func() {
+		once.Do(func() {
+			api.releaseFindRoot(root, fr)
+		})
+	}, nil
+}
+
+func (api *API) releaseFindRoot(root string, fr *findRoot) {
+	api.findMu.Lock()
+	defer api.findMu.Unlock()
+
+	fr.users--
+	fr.lastUsed = api.clock.Now()
+	if fr.users > 0 {
+		return
+	}
+	var timer *quartz.Timer
+	timer = api.clock.AfterFunc(api.findRootIdleTimeout, func() {
+		api.findMu.Lock()
+		defer api.findMu.Unlock()
+		// The root may have been used or evicted while the timer fired.
+		if api.findRoots[root] != fr || fr.evict != timer {
+			return
+		}
+		api.removeFindRootLocked(root)
+	}, "agentfiles", "find", "evict")
+	fr.evict = timer
+}
+
+// evictOldestFindRootLocked removes the least recently used root that is
+// not in use, and reports whether one was removed.
+func (api *API) evictOldestFindRootLocked() bool {
+	var (
+		oldest string
+		found  bool
+	)
+	for root, fr := range api.findRoots {
+		if fr.users > 0 {
+			continue
+		}
+		if !found || fr.lastUsed.Before(api.findRoots[oldest].lastUsed) {
+			oldest, found = root, true
+		}
+	}
+	if found {
+		api.removeFindRootLocked(oldest)
+	}
+	return found
+}
+
+func (api *API) removeFindRootLocked(root string) {
+	if fr := api.findRoots[root]; fr.evict != nil {
+		fr.evict.Stop()
+	}
+	delete(api.findRoots, root)
+	if err := api.finder.RemoveRoot(root); err != nil {
+		api.logger.Debug(context.Background(), "remove find root", slog.F("root", root), slog.Error(err))
+	}
+}
+
+// resolveAllowedFindRoot resolves the directory to search, which must be
+// the working directory or the home directory, or below them. Otherwise a
+// single request could make the agent index and watch the whole
+// filesystem.
+func (api *API) resolveAllowedFindRoot(root string) (string, error) {
+	root, err := api.resolveFindRoot(root)
+	if err != nil {
+		return "", err
+	}
+	root, err = api.resolvePath(root)
+	if err != nil {
+		return "", xerrors.Errorf("resolve symlink %q: %w", root, err)
+	}
+
+	var allowed []string
+	if dir, err := api.resolveFindRoot(""); err == nil {
+		allowed = append(allowed, dir)
+	}
+	if home, err := api.envInfo.HomeDir(); err == nil && filepath.IsAbs(home) {
+		allowed = append(allowed, home)
+	}
+	for _, dir := range allowed {
+		dir, err := api.resolvePath(dir)
+		if err != nil {
+			continue
+		}
+		if rel, err := filepath.Rel(dir, root); err == nil && filepath.IsLocal(rel) {
+			return root, nil
+		}
+	}
+	return "", xerrors.Errorf("%w: %q must be within the working directory or the home directory", errFindRootNotAllowed, root)
+}
+
+// resolveFindRoot returns the cleaned, absolute directory to search.
+func (api *API) resolveFindRoot(root string) (string, error) {
+	if root == "" {
+		var configured string
+		if api.workingDir != nil {
+			configured = api.workingDir()
+		}
+		dir, err := usershell.ResolveWorkingDirectory(api.filesystem, api.envInfo, configured)
+		if err != nil {
+			return "", xerrors.Errorf("resolve working directory: %w", err)
+		}
+		root = dir
+	}
+	if !filepath.IsAbs(root) {
+		return "", xerrors.Errorf("%w: %q must be absolute", errInvalidFindRoot, root)
+	}
+	root = filepath.Clean(root)
+	stat, err := api.filesystem.Stat(root)
+	if err != nil {
+		return "", err
+	}
+	if !stat.IsDir() {
+		return "", xerrors.Errorf("%w: %q must be a directory", errInvalidFindRoot, root)
+	}
+	return root, nil
+}
+
+func (api *API) writeFindError(ctx context.Context, rw http.ResponseWriter, err error) {
+	status := http.StatusInternalServerError
+	switch {
+	case errors.Is(err, errInvalidFindRoot), errors.Is(err, filefinder.ErrTooManyFiles):
+		status = http.StatusBadRequest
+	case errors.Is(err, errFindRootNotAllowed):
+		status = http.StatusForbidden
+	case errors.Is(err, errTooManyFindRoots):
+		status = http.StatusServiceUnavailable
+	case errors.Is(err, os.ErrNotExist):
+		status = http.StatusNotFound
+	case errors.Is(err, os.ErrPermission):
+		status = http.StatusForbidden
+	case errors.Is(err, filefinder.ErrClosed):
+		status = http.StatusServiceUnavailable
+	default:
+	}
+	httpapi.Write(ctx, rw, status, codersdk.Response{
+		Message: "Failed to search files.",
+		Detail:  err.Error(),
+	})
+}
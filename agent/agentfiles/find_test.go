@@ -0,0 +1,277 @@
+package agentfiles_test
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/coder/websocket"
+	"github.com/spf13/afero"
+	"github.com/stretchr/testify/require"
+
+	"cdr.dev/slog/v3"
+	"cdr.dev/slog/v3/sloggers/slogtest"
+	"github.com/coder/coder/v2/agent/agentfiles"
+	"github.com/coder/coder/v2/agent/filefinder"
+	"github.com/coder/coder/v2/codersdk"
+	"github.com/coder/coder/v2/codersdk/workspacesdk"
+	"github.com/coder/coder/v2/codersdk/wsjson"
+	"github.com/coder/coder/v2/testutil"
+	"github.com/coder/quartz"
+)
+
+func newFindAPI(t *testing.T, workingDir string, opts ...agentfiles.Option) *agentfiles.API {
+	t.Helper()
+	api, _ := newFindAPIWithEngine(t, workingDir, opts...)
+	return api
+}
+
+func newFindAPIWithEngine(t *testing.T, workingDir string, opts ...agentfiles.Option) (*agentfiles.API, *filefinder.Engine) {
+	t.Helper()
+	logger := slogtest.Make(t, &slogtest.Options{IgnoreErrors: true}).Leveled(slog.LevelDebug)
+	engine := filefinder.NewEngine(logger)
+	t.Cleanup(func() { _ = engine.Close() })
+	opts = append([]agentfiles.Option{agentfiles.WithFileFinder(engine, func() string {
+		return workingDir
+	})}, opts...)
+	return agentfiles.NewAPI(logger, afero.NewOsFs(), nil, opts...), engine
+}
+
+func requestFind(t *testing.T, api *agentfiles.API, query url.Values) *httptest.ResponseRecorder {
+	t.Helper()
+	ctx := testutil.Context(t, testutil.WaitLong)
+	w := httptest.NewRecorder()
+	r := httptest.NewRequestWithContext(ctx, http.MethodGet, "/find?"+query.Encode(), nil)
+	api.Routes().ServeHTTP(w, r)
+	return w
+}
+
+func writeFindFile(t *testing.T, dir, relPath string) {
+	t.Helper()
+	full := filepath.Join(dir, filepath.FromSlash(relPath))
+	require.NoError(t, os.MkdirAll(filepath.Dir(full), 0o755))
+	require.NoError(t, os.WriteFile(full, []byte("data"), 0o600))
+}
+
+func TestFind(t *testing.T) {
+	t.Parallel()
+
+	workingDir := t.TempDir()
+	writeFindFile(t, workingDir, "cmd/server/Main.go")
+	writeFindFile(t, workingDir, "docs/readme.md")
+	homeDir := t.TempDir()
+	writeFindFile(t, homeDir, "other/main.go")
+	api := newFindAPI(t, workingDir, agentfiles.WithEnvInfo(fakeBundleEnvInfo{home: homeDir}))
+
+	tests := []struct {
+		name    string
+		query   url.Values
+		root    string
+		paths   []string
+		errCode int
+		error   string
+	}{
+		{
+			name:  "WorkingDirectory",
+			query: url.Values{"query": {"main"}},
+			root:  workingDir,
+			paths: []string{"cmd/server/Main.go"},
+		},
+		{
+			name:  "Root",
+			query: url.Values{"query": {"main"}, "root": {homeDir}},
+			root:  homeDir,
+			paths: []string{"other/main.go"},
+		},
+		{
+			name:  "Subdirectory",
+			query: url.Values{"query": {"main"}, "root": {filepath.Join(workingDir, "cmd")}},
+			root:  filepath.Join(workingDir, "cmd"),
+			paths: []string{"server/Main.go"},
+		},
+		{
+			name:  "Limit",
+			query: url.Values{"query": {"m"}, "limit": {"1"}},
+			root:  workingDir,
+			paths: []string{"cmd/server/Main.go"},
+		},
+		{
+			name:    "NoQuery",
+			query:   url.Values{},
+			errCode: http.StatusBadRequest,
+			error:   "query",
+		},
+		{
+			name:    "InvalidLimit",
+			query:   url.Values{"query": {"main"}, "limit": {"0"}},
+			errCode: http.StatusBadRequest,
+			error:   "limit",
+		},
+		{
+			name:    "RelativeRoot",
+			query:   url.Values{"query": {"main"}, "root": {"relative"}},
+			errCode: http.StatusBadRequest,
+			error:   "must be absolute",
+		},
+		{
+			name:    "FileRoot",
+			query:   url.Values{"query": {"main"}, "root": {filepath.Join(workingDir, "docs", "readme.md")}},
+			errCode: http.StatusBadRequest,
+			error:   "must be a directory",
+		},
+		{
+			name:    "MissingRoot",
+			query:   url.Values{"query": {"main"}, "root": {filepath.Join(workingDir, "missing")}},
+			errCode: http.StatusNotFound,
+		},
+		{
+			name:    "FilesystemRoot",
+			query:   url.Values{"query": {"main"}, "root": {"/"}},
+			errCode: http.StatusForbidden,
+			error:   "must be within the working directory or the home directory",
+		},
+		{
+			name:    "OutsideRoot",
+			query:   url.Values{"query": {"main"}, "root": {t.TempDir()}},
+			errCode: http.StatusForbidden,
+			error:   "must be within the working directory or the home directory",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			w := requestFind(t, api, tt.query)
+			if tt.errCode != 0 {
+				got := &codersdk.Error{}
+				require.NoError(t, json.NewDecoder(w.Body).Decode(got))
+				require.ErrorContains(t, got, tt.error)
+				require.Equal(t, tt.errCode, w.Code)
+				return
+			}
+			require.Equal(t, http.StatusOK, w.Code)
+			var resp workspacesdk.FindResponse
+			require.NoError(t, json.NewDecoder(w.Body).Decode(&resp))
+			require.Equal(t, tt.root, resp.Root)
+			paths := make([]string, 0, len(resp.Results))
+			for _, result := range resp.Results {
+				paths = append(paths, result.Path)
+				require.Equal(t, filepath.Join(tt.root, filepath.FromSlash(result.Path)), result.AbsolutePath)
+			}
+			require.Equal(t, tt.paths, paths)
+		})
+	}
+}
+
+func TestFindNotEnabled(t *testing.T) {
+	t.Parallel()
+
+	logger := slogtest.Make(t, nil)
+	api := agentfiles.NewAPI(logger, afero.NewOsFs(), nil)
+
+	ctx := testutil.Context(t, testutil.WaitShort)
+	w := httptest.NewRecorder()
+	r := httptest.NewRequestWithContext(ctx, http.MethodGet, "/find?query=main", nil)
+	api.Routes().ServeHTTP(w, r)
+	require.Equal(t, http.StatusNotImplemented, w.Code)
+}
+
+func TestWatchFind(t *testing.T) {
+	t.Parallel()
+
+	ctx := testutil.Context(t, testutil.WaitLong)
+	logger := slogtest.Make(t, &slogtest.Options{IgnoreErrors: true}).Leveled(slog.LevelDebug)
+	workingDir := t.TempDir()
+	writeFindFile(t, workingDir, "first_watched.go")
+	srv := httptest.NewServer(newFindAPI(t, workingDir).Routes())
+	t.Cleanup(srv.Close)
+
+	wsURL := fmt.Sprintf("ws%s/find/watch?query=watched", strings.TrimPrefix(srv.URL, "http"))
+	conn, res, err := websocket.Dial(ctx, wsURL, nil)
+	require.NoError(t, err)
+	if res != nil && res.Body != nil {
+		defer res.Body.Close()
+	}
+	decoder := wsjson.NewDecoder[workspacesdk.FindResponse](conn, websocket.MessageText, logger)
+	defer decoder.Close()
+	updates := decoder.Chan()
+
+	resp := testutil.RequireReceive(ctx, t, updates)
+	require.Len(t, resp.Results, 1)
+	require.Equal(t, "first_watched.go", resp.Results[0].Path)
+
+	writeFindFile(t, workingDir, "second_watched.go")
+	resp = testutil.RequireReceive(ctx, t, updates)
+	require.Len(t, resp.Results, 2)
+}
+
+func TestFindRootEviction(t *testing.T) {
+	t.Parallel()
+
+	workingDir := t.TempDir()
+	writeFindFile(t, workingDir, "main.go")
+	homeDir := t.TempDir()
+	writeFindFile(t, homeDir, "main.go")
+	mClock := quartz.NewMock(t)
+	api, engine := newFindAPIWithEngine(t, workingDir,
+		agentfiles.WithEnvInfo(fakeBundleEnvInfo{home: homeDir}),
+		agentfiles.WithFindRootLimits(1, time.Minute),
+		agentfiles.WithClock(mClock),
+	)
+
+	w := requestFind(t, api, url.Values{"query": {"main"}})
+	require.Equal(t, http.StatusOK, w.Code)
+	require.True(t, engine.HasRoot(workingDir))
+
+	// Only one root is indexed at a time, so the idle working directory
+	// makes room for the home directory.
+	w = requestFind(t, api, url.Values{"query": {"main"}, "root": {homeDir}})
+	require.Equal(t, http.StatusOK, w.Code)
+	require.False(t, engine.HasRoot(workingDir))
+	require.True(t, engine.HasRoot(homeDir))
+
+	// The home directory is removed once it has been idle for long enough.
+	mClock.Advance(time.Minute).MustWait(testutil.Context(t, testutil.WaitShort))
+	require.False(t, engine.HasRoot(homeDir))
+}
+
+func TestFindRootInUse(t *testing.T) {
+	t.Parallel()
+
+	ctx := testutil.Context(t, testutil.WaitLong)
+	logger := slogtest.Make(t, &slogtest.Options{IgnoreErrors: true}).Leveled(slog.LevelDebug)
+	workingDir := t.TempDir()
+	writeFindFile(t, workingDir, "main.go")
+	homeDir := t.TempDir()
+	api, engine := newFindAPIWithEngine(t, workingDir,
+		agentfiles.WithEnvInfo(fakeBundleEnvInfo{home: homeDir}),
+		agentfiles.WithFindRootLimits(1, time.Minute),
+		agentfiles.WithClock(quartz.NewMock(t)),
+	)
+	srv := httptest.NewServer(api.Routes())
+	t.Cleanup(srv.Close)
+
+	// A watch keeps the working directory in use.
+	wsURL := fmt.Sprintf("ws%s/find/watch?query=main", strings.TrimPrefix(srv.URL, "http"))
+	conn, res, err := websocket.Dial(ctx, wsURL, nil)
+	require.NoError(t, err)
+	if res != nil && res.Body != nil {
+		defer res.Body.Close()
+	}
+	decoder := wsjson.NewDecoder[workspacesdk.FindResponse](conn, websocket.MessageText, logger)
+	defer decoder.Close()
+	testutil.RequireReceive(ctx, t, decoder.Chan())
+
+	w := requestFind(t, api, url.Values{"query": {"main"}, "root": {homeDir}})
+	require.Equal(t, http.StatusServiceUnavailable, w.Code)
+	require.True(t, engine.HasRoot(workingDir))
+	require.False(t, engine.HasRoot(homeDir))
+}
@@ -0,0 +1,213 @@
+package agentfiles
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"io"
+	"net/http"
+	"os"
+	"path/filepath"
+	"syscall"
+
+	"github.com/coder/websocket"
+	"golang.org/x/xerrors"
+
+	"cdr.dev/slog/v3"
+	"github.com/coder/coder/v2/agent/filefinder"
+	"github.com/coder/coder/v2/coderd/httpapi"
+	"github.com/coder/coder/v2/codersdk"
+	"github.com/coder/coder/v2/codersdk/workspacesdk"
+)
+
+// HandleWatchSync sends the regular files below a directory over a
+// websocket, and then sends the files that change so that a client can
+// keep a copy of the directory in sync. Clients make changes with the
+// write-file and remove-path routes.
+func (api *API) HandleWatchSync(rw http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	query := r.URL.Query()
+	parser := httpapi.NewQueryParamParser()
+	root := parser.String(query, "", "root")
+	parser.ErrorExcessParams(query)
+	if len(parser.Errors) > 0 {
+		httpapi.Write(ctx, rw, http.StatusBadRequest, codersdk.Response{
+			Message:     "Query parameters have invalid values.",
+			Validations: parser.Errors,
+		})
+		return
+	}
+
+	root, err := api.resolveSyncRoot(root)
+	if err != nil {
+		status := http.StatusInternalServerError
+		switch {
+		case errors.Is(err, os.ErrPermission):
+			status = http.StatusForbidden
+		case errors.Is(err, syscall.ENOTDIR):
+			status = http.StatusBadRequest
+		}
+		httpapi.Write(ctx, rw, status, codersdk.Response{
+			Message: "Failed to resolve the directory to sync.",
+			Detail:  err.Error(),
+		})
+		return
+	}
+
+	watcher, err := filefinder.NewFSWatcher(root, api.logger.Named("sync"))
+	if err != nil {
+		httpapi.Write(ctx, rw, http.StatusInternalServerError, codersdk.Response{
+			Message: "Failed to watch the directory to sync.",
+			Detail:  err.Error(),
+		})
+		return
+	}
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+	// Start sends the existing files as the first batch, so the initial
+	// update is complete once it returns.
+	watcher.Start(ctx)
+	defer watcher.Close()
+	var existing []filefinder.FSEvent
+	select {
+	case existing = <-watcher.Events():
+	default:
+	}
+
+	conn, err := websocket.Accept(rw, r, &websocket.AcceptOptions{
+		CompressionMode: websocket.CompressionNoContextTakeover,
+	})
+	if err != nil {
+		httpapi.Write(ctx, rw, http.StatusInternalServerError, codersdk.Response{
+			Message: "Failed to upgrade connection to websocket.",
+			Detail:  err.Error(),
+		})
+		return
+	}
+	// Close the websocket for reading, so that the websocket library
+	// handles pings and close frames.
+	_ = conn.CloseRead(context.Background())
+
+	ctx, wsNetConn := codersdk.WebsocketNetConn(ctx, conn, websocket.MessageText)
+	defer wsNetConn.Close()
+
+	encoder := json.NewEncoder(wsNetConn)
+	update := workspacesdk.SyncUpdate{
+		Root:    root,
+		Initial: true,
+		Entries: api.syncEntries(ctx, root, existing),
+	}
+	if err := encoder.Encode(update); err != nil {
+		api.logger.Debug(ctx, "encode sync update", slog.Error(err))
+		return
+	}
+
+	for {
+		var events []filefinder.FSEvent
+		select {
+		case <-ctx.Done():
+			return
+		case events = <-watcher.Events():
+		}
+		update := workspacesdk.SyncUpdate{
+			Root:    root,
+			Entries: api.syncEntries(ctx, root, events),
+		}
+		if len(update.Entries) == 0 {
+			continue
+		}
+		if err := encoder.Encode(update); err != nil {
+			api.logger.Debug(ctx, "encode sync update", slog.Error(err))
+			return
+		}
+	}
+}
+
+// resolveSyncRoot returns the absolute, symlink-free directory to sync,
+// creating it if it does not exist. Relative paths are resolved against
+// the working directory.
+func (api *API) resolveSyncRoot(root string) (string, error) {
+	if !filepath.IsAbs(root) {
+		dir, err := api.resolveFindRoot("")
+		if err != nil {
+			return "", err
+		}
+		root = filepath.Join(dir, root)
+	}
+	root, err := api.resolvePath(root)
+	if err != nil {
+		return "", xerrors.Errorf("resolve symlink %q: %w", root, err)
+	}
+	if err := api.filesystem.MkdirAll(root, 0o755); err != nil {
+		return "", err
+	}
+	stat, err := api.filesystem.Stat(root)
+	if err != nil {
+		return "", err
+	}
+	if !stat.IsDir() {
+		return "", xerrors.Errorf("%q: %w", root, syscall.ENOTDIR)
+	}
+	return root, nil
+}
+
+// syncEntries describes the current state of the paths in events.
+// Directories are left out since the files in them have events of their
+// own, except when they are deleted.
+func (api *API) syncEntries(ctx context.Context, root string, events []filefinder.FSEvent) []workspacesdk.SyncEntry {
+	entries := make([]workspacesdk.SyncEntry, 0, len(events))
+	for _, ev := range events {
+		if isAtomicWriteTemp(filepath.Base(ev.Path)) {
+			continue
+		}
+		rel, err := filepath.Rel(root, ev.Path)
+		if err != nil || rel == "." || !filepath.IsLocal(rel) {
+			continue
+		}
+		entry := workspacesdk.SyncEntry{Path: filepath.ToSlash(rel)}
+
+		stat, err := api.filesystem.Stat(ev.Path)
+		switch {
+		case errors.Is(err, os.ErrNotExist):
+			entry.Deleted = true
+			entries = append(entries, entry)
+			continue
+		case err != nil:
+			api.logger.Debug(ctx, "stat synced file", slog.F("path", ev.Path), slog.Error(err))
+			continue
+		case !stat.Mode().IsRegular():
+			continue
+		}
+
+		sum, err := api.hashFile(ev.Path)
+		if err != nil {
+			if errors.Is(err, os.ErrNotExist) {
+				entry.Deleted = true
+				entries = append(entries, entry)
+				continue
+			}
+			api.logger.Debug(ctx, "hash synced file", slog.F("path", ev.Path), slog.Error(err))
+			continue
+		}
+		entry.Size = stat.Size()
+		entry.SHA256 = sum
+		entries = append(entries, entry)
+	}
+	return entries
+}
+
+func (api *API) hashFile(path string) (string, error) {
+	f, err := api.filesystem.Open(path)
+	if err != nil {
+		return "", err
+	}
+	defer f.Close()
+	h := sha256.New()
+	if _, err := io.Copy(h, f); err != nil {
+		return "", err
+	}
+	return hex.EncodeToString(h.Sum(nil)), nil
+}
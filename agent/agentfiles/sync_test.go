@@ -0,0 +1,143 @@
+package agentfiles_test
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"slices"
+	"strings"
+	"testing"
+
+	"github.com/coder/websocket"
+	"github.com/spf13/afero"
+	"github.com/stretchr/testify/require"
+
+	"cdr.dev/slog/v3"
+	"cdr.dev/slog/v3/sloggers/slogtest"
+	"github.com/coder/coder/v2/agent/agentfiles"
+	"github.com/coder/coder/v2/agent/agentssh"
+	"github.com/coder/coder/v2/codersdk"
+	"github.com/coder/coder/v2/codersdk/workspacesdk"
+	"github.com/coder/coder/v2/codersdk/wsjson"
+	"github.com/coder/coder/v2/testutil"
+)
+
+func sha256Hex(data string) string {
+	sum := sha256.Sum256([]byte(data))
+	return hex.EncodeToString(sum[:])
+}
+
+func dialWatchSync(t *testing.T, srv *httptest.Server, root string) <-chan workspacesdk.SyncUpdate {
+	t.Helper()
+	ctx := testutil.Context(t, testutil.WaitLong)
+	logger := slogtest.Make(t, &slogtest.Options{IgnoreErrors: true}).Leveled(slog.LevelDebug)
+	wsURL := fmt.Sprintf("ws%s/sync/watch?root=%s", strings.TrimPrefix(srv.URL, "http"), root)
+	conn, res, err := websocket.Dial(ctx, wsURL, nil)
+	if res != nil && res.Body != nil {
+		defer res.Body.Close()
+	}
+	require.NoError(t, err)
+	decoder := wsjson.NewDecoder[workspacesdk.SyncUpdate](conn, websocket.MessageText, logger)
+	t.Cleanup(func() { _ = decoder.Close() })
+	return decoder.Chan()
+}
+
+func TestWatchSync(t *testing.T) {
+	t.Parallel()
+
+	ctx := testutil.Context(t, testutil.WaitLong)
+	logger := slogtest.Make(t, &slogtest.Options{IgnoreErrors: true}).Leveled(slog.LevelDebug)
+	workingDir := t.TempDir()
+	root := filepath.Join(workingDir, "project")
+	writeFindFile(t, root, "src/main.go")
+	writeFindFile(t, root, ".git/HEAD")
+	api := agentfiles.NewAPI(logger, afero.NewOsFs(), nil, agentfiles.WithFileFinder(nil, func() string {
+		return workingDir
+	}))
+	srv := httptest.NewServer(api.Routes())
+	t.Cleanup(srv.Close)
+
+	// Relative roots are resolved against the working directory.
+	updates := dialWatchSync(t, srv, "project")
+	update := testutil.RequireReceive(ctx, t, updates)
+	require.True(t, update.Initial)
+	require.Equal(t, root, update.Root)
+	require.Equal(t, []workspacesdk.SyncEntry{{
+		Path:   "src/main.go",
+		Size:   4,
+		SHA256: sha256Hex("data"),
+	}}, update.Entries)
+
+	require.NoError(t, os.WriteFile(filepath.Join(root, "src", "main.go"), []byte("changed"), 0o600))
+	update = testutil.RequireReceive(ctx, t, updates)
+	require.False(t, update.Initial)
+	require.Equal(t, []workspacesdk.SyncEntry{{
+		Path:   "src/main.go",
+		Size:   7,
+		SHA256: sha256Hex("changed"),
+	}}, update.Entries)
+
+	require.NoError(t, os.RemoveAll(filepath.Join(root, "src")))
+	for {
+		update := testutil.RequireReceive(ctx, t, updates)
+		if slices.Contains(update.Entries, workspacesdk.SyncEntry{Path: "src", Deleted: true}) {
+			break
+		}
+	}
+}
+
+func TestWatchSyncCreatesRoot(t *testing.T) {
+	t.Parallel()
+
+	ctx := testutil.Context(t, testutil.WaitLong)
+	logger := slogtest.Make(t, &slogtest.Options{IgnoreErrors: true}).Leveled(slog.LevelDebug)
+	srv := httptest.NewServer(agentfiles.NewAPI(logger, afero.NewOsFs(), nil).Routes())
+	t.Cleanup(srv.Close)
+
+	root := filepath.Join(t.TempDir(), "new", "dir")
+	updates := dialWatchSync(t, srv, root)
+	update := testutil.RequireReceive(ctx, t, updates)
+	require.True(t, update.Initial)
+	require.Empty(t, update.Entries)
+	require.DirExists(t, root)
+}
+
+func TestWatchSyncBlockFileTransfer(t *testing.T) {
+	t.Parallel()
+
+	ctx := testutil.Context(t, testutil.WaitShort)
+	logger := slogtest.Make(t, &slogtest.Options{IgnoreErrors: true}).Leveled(slog.LevelDebug)
+	api := agentfiles.NewAPI(logger, afero.NewOsFs(), nil, agentfiles.WithBlockFileTransfer(true))
+
+	dir := t.TempDir()
+	w := httptest.NewRecorder()
+	r := httptest.NewRequestWithContext(ctx, http.MethodGet, "/sync/watch?root="+dir, nil)
+	api.Routes().ServeHTTP(w, r)
+	got := &codersdk.Error{}
+	require.NoError(t, json.NewDecoder(w.Body).Decode(got))
+	require.Equal(t, agentssh.BlockedFileTransferErrorMessage, got.Message)
+	require.Equal(t, http.StatusForbidden, w.Code)
+}
+
+func TestWatchSyncNotDirectory(t *testing.T) {
+	t.Parallel()
+
+	ctx := testutil.Context(t, testutil.WaitShort)
+	logger := slogtest.Make(t, &slogtest.Options{IgnoreErrors: true}).Leveled(slog.LevelDebug)
+	api := agentfiles.NewAPI(logger, afero.NewOsFs(), nil)
+
+	dir := t.TempDir()
+	writeFindFile(t, dir, "file")
+	w := httptest.NewRecorder()
+	r := httptest.NewRequestWithContext(ctx, http.MethodGet, "/sync/watch?root="+filepath.Join(dir, "file"), nil)
+	api.Routes().ServeHTTP(w, r)
+	got := &codersdk.Error{}
+	require.NoError(t, json.NewDecoder(w.Body).Decode(got))
+	require.ErrorContains(t, got, "not a directory")
+	require.Equal(t, http.StatusBadRequest, w.Code)
+}
@@ -0,0 +1,509 @@
+// Package agentlogtail tails log files and journald units in the workspace
+// into agent log sources, so that they are shown alongside the startup logs
+// in the dashboard and `coder logs`.
+package agentlogtail
+
+import (
+	"bufio"
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"slices"
+	"sync"
+	"time"
+
+	"github.com/google/uuid"
+	"golang.org/x/xerrors"
+
+	"cdr.dev/slog/v3"
+	"github.com/coder/coder/v2/agent/agentexec"
+	"github.com/coder/coder/v2/codersdk"
+	"github.com/coder/coder/v2/codersdk/agentsdk"
+	"github.com/coder/quartz"
+)
+
+const (
+	// defaultPollInterval is how often log files are checked for new
+	// lines, and globs are re-evaluated for new files.
+	defaultPollInterval = time.Second
+
+	// maxLineLength is the maximum length of a single log line. Longer
+	// lines are split, matching the limit on agent log output.
+	maxLineLength = 1024
+
+	// maxReadPerPoll bounds how much of a file is read on each poll so
+	// that a single busy file cannot starve the others.
+	maxReadPerPoll = 1 << 20 // 1MiB
+
+	// defaultRateLimit bounds the output sent by all log tails together
+	// in each rateLimitWindow, so that a noisy log cannot use up the agent
+	// log limit shared with the startup script logs in one burst.
+	defaultRateLimit = 64 << 10 // 64KiB
+
+	// defaultRateLimitWindow is the window that the rate limit applies to.
+	defaultRateLimitWindow = time.Minute
+)
+
+// Logger sends lines to a log source.
+type Logger interface {
+	Send(ctx context.Context, log ...agentsdk.Log) error
+	Flush(context.Context) error
+}
+
+// Option configures a Tailer.
+type Option func(*Tailer)
+
+// WithClock sets the clock used for polling files. Defaults to the
+// real clock.
+func WithClock(clock quartz.Clock) Option {
+	return func(t *Tailer) {
+		t.clock = clock
+	}
+}
+
+// WithExecer sets the execer used to run journalctl. Defaults to
+// agentexec.DefaultExecer.
+func WithExecer(execer agentexec.Execer) Option {
+	return func(t *Tailer) {
+		t.execer = execer
+	}
+}
+
+// WithPollInterval sets how often log files are polled.
+func WithPollInterval(interval time.Duration) Option {
+	return func(t *Tailer) {
+		t.pollInterval = interval
+	}
+}
+
+// WithRateLimit sets how many bytes of output all log tails may send
+// together in each window. Each log tail gets an equal share, and lines
+// over it are dropped until the next window.
+func WithRateLimit(bytesPerWindow int, window time.Duration) Option {
+	return func(t *Tailer) {
+		t.rateLimit = bytesPerWindow
+		t.rateLimitWindow = window
+	}
+}
+
+// Tailer follows the log files and journald units of a set of log tails
+// and sends new lines to the corresponding log source.
+type Tailer struct {
+	logger          slog.Logger
+	getLogger       func(logSourceID uuid.UUID) Logger
+	clock           quartz.Clock
+	execer          agentexec.Execer
+	pollInterval    time.Duration
+	rateLimit       int
+	rateLimitWindow time.Duration
+
+	ctx    context.Context
+	cancel context.CancelFunc
+	wg     sync.WaitGroup
+
+	mu      sync.Mutex
+	started bool
+	closed  bool
+}
+
+// New creates a Tailer. Lines are sent to the logger returned by
+// getLogger for the log source of each log tail.
+func New(logger slog.Logger, getLogger func(logSourceID uuid.UUID) Logger, opts ...Option) *Tailer {
+	ctx, cancel := context.WithCancel(context.Background())
+	t := &Tailer{
+		logger:          logger,
+		getLogger:       getLogger,
+		clock:           quartz.NewReal(),
+		execer:          agentexec.DefaultExecer,
+		pollInterval:    defaultPollInterval,
+		rateLimit:       defaultRateLimit,
+		rateLimitWindow: defaultRateLimitWindow,
+		ctx:             ctx,
+		cancel:          cancel,
+	}
+	for _, opt := range opts {
+		opt(t)
+	}
+	return t
+}
+
+// Start begins tailing the given log tails. Files that already exist are
+// tailed from their end so that lines written before the agent started
+// are not sent again on restart. Start may only be called once.
+func (t *Tailer) Start(logTails []agentsdk.LogTail) error {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	if t.closed {
+		return xerrors.New("tailer is closed")
+	}
+	if t.started {
+		return xerrors.New("tailer already started")
+	}
+	t.started = true
+
+	for _, lt := range logTails {
+		logger := t.logger.With(slog.F("log_source_id", lt.LogSourceID))
+		sender := t.getLogger(lt.LogSourceID)
+		limit := &limiter{
+			clock:  t.clock,
+			limit:  t.rateLimit / len(logTails),
+			window: t.rateLimitWindow,
+		}
+		if len(lt.Paths) > 0 {
+			ft := &fileTailer{
+				logger:   logger,
+				sender:   sender,
+				limit:    limit,
+				clock:    t.clock,
+				patterns: lt.Paths,
+				files:    make(map[string]*tailedFile),
+			}
+			// Skip the existing content before returning so that
+			// lines written right after Start are not missed.
+			ft.poll(t.ctx, true)
+			t.wg.Add(1)
+			go func() {
+				defer t.wg.Done()
+				ft.run(t.ctx, t.pollInterval)
+			}()
+		}
+		if len(lt.JournaldUnits) > 0 {
+			t.wg.Add(1)
+			go func() {
+				defer t.wg.Done()
+				t.tailJournald(t.ctx, logger, sender, limit, lt.JournaldUnits)
+			}()
+		}
+	}
+	return nil
+}
+
+// Close stops tailing and waits for all lines read so far to be sent.
+func (t *Tailer) Close() error {
+	t.mu.Lock()
+	if t.closed {
+		t.mu.Unlock()
+		return nil
+	}
+	t.closed = true
+	t.mu.Unlock()
+
+	t.cancel()
+	t.wg.Wait()
+	return nil
+}
+
+// tailedFile is the state of a single file matched by a glob.
+type tailedFile struct {
+	info    os.FileInfo
+	offset  int64
+	partial []byte
+}
+
+// fileTailer polls the files matched by a set of glob patterns.
+type fileTailer struct {
+	logger   slog.Logger
+	sender   Logger
+	limit    *limiter
+	clock    quartz.Clock
+	patterns []string
+	files    map[string]*tailedFile
+}
+
+func (ft *fileTailer) run(ctx context.Context, interval time.Duration) {
+	waiter := ft.clock.TickerFunc(ctx, interval, func() error {
+		ft.poll(ctx, false)
+		return nil
+	}, "logtail", "poll")
+	_ = waiter.Wait()
+
+	// Send whatever is left of partial lines so nothing is lost on
+	// shutdown. The context is canceled at this point, so use a fresh
+	// one bounded by a short timeout.
+	flushCtx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+	var logs []agentsdk.Log
+	for _, path := range ft.sortedPaths() {
+		f := ft.files[path]
+		if len(f.partial) > 0 {
+			logs = append(logs, ft.newLog(string(f.partial)))
+			f.partial = nil
+		}
+	}
+	ft.send(flushCtx, logs)
+}
+
+// poll re-evaluates the globs and reads new lines from every matched
+// file. On the initial poll, existing files are skipped to their end.
+func (ft *fileTailer) poll(ctx context.Context, initial bool) {
+	matched := make(map[string]struct{})
+	for _, pattern := range ft.patterns {
+		paths, err := filepath.Glob(pattern)
+		if err != nil {
+			// Only ErrBadPattern is returned, logging it on every poll
+			// would be noisy, so only log it once.
+			if initial {
+				ft.logger.Warn(ctx, "invalid log tail pattern", slog.F("pattern", pattern), slog.Error(err))
+			}
+			continue
+		}
+		for _, path := range paths {
+			matched[path] = struct{}{}
+		}
+	}
+
+	// Forget files that no longer match, e.g. removed after rotation.
+	for path := range ft.files {
+		if _, ok := matched[path]; !ok {
+			delete(ft.files, path)
+		}
+	}
+
+	var logs []agentsdk.Log
+	for path := range matched {
+		info, err := os.Stat(path)
+		if err != nil || !info.Mode().IsRegular() {
+			continue
+		}
+		f, ok := ft.files[path]
+		switch {
+		case !ok:
+			f = &tailedFile{info: info}
+			if initial {
+				f.offset = info.Size()
+			}
+			ft.files[path] = f
+		case !os.SameFile(f.info, info):
+			// The file was replaced, e.g. by logrotate moving it
+			// away and creating a new one. Read the new file from
+			// the start.
+			f.info = info
+			f.offset = 0
+			f.partial = nil
+		case info.Size() < f.offset:
+			// The file was truncated, e.g. by logrotate with
+			// copytruncate.
+			f.info = info
+			f.offset = 0
+			f.partial = nil
+		default:
+			f.info = info
+		}
+		if info.Size() == f.offset {
+			continue
+		}
+		lines, err := ft.read(path, f)
+		if err != nil {
+			ft.logger.Debug(ctx, "read log tail file", slog.F("path", path), slog.Error(err))
+			continue
+		}
+		for _, line := range lines {
+			logs = append(logs, ft.newLog(line))
+		}
+	}
+	ft.send(ctx, logs)
+}
+
+// read reads new complete lines from the file, starting at the current
+// offset. Incomplete trailing lines are kept until they are completed.
+func (ft *fileTailer) read(path string, f *tailedFile) ([]string, error) {
+	file, err := os.Open(path)
+	if err != nil {
+		return nil, err
+	}
+	defer file.Close()
+
+	if _, err := file.Seek(f.offset, io.SeekStart); err != nil {
+		return nil, xerrors.Errorf("seek: %w", err)
+	}
+	data, err := io.ReadAll(io.LimitReader(file, maxReadPerPoll))
+	if err != nil {
+		return nil, xerrors.Errorf("read: %w", err)
+	}
+	f.offset += int64(len(data))
+
+	data = append(f.partial, data...)
+	f.partial = nil
+	var lines []string
+	for {
+		i := bytes.IndexByte(data, '\n')
+		if i < 0 {
+			break
+		}
+		lines = append(lines, splitLine(string(bytes.TrimSuffix(data[:i], []byte{'\r'})))...)
+		data = data[i+1:]
+	}
+	// Keep the incomplete line for the next poll, unless it is already
+	// too long to be a single log line.
+	for len(data) >= maxLineLength {
+		lines = append(lines, string(data[:maxLineLength]))
+		data = data[maxLineLength:]
+	}
+	if len(data) > 0 {
+		f.partial = slices.Clone(data)
+	}
+	return lines, nil
+}
+
+func (ft *fileTailer) newLog(output string) agentsdk.Log {
+	return agentsdk.Log{
+		CreatedAt: ft.clock.Now(),
+		Output:    output,
+		Level:     codersdk.LogLevelInfo,
+	}
+}
+
+func (ft *fileTailer) send(ctx context.Context, logs []agentsdk.Log) {
+	logs = ft.limit.apply(logs)
+	if len(logs) == 0 {
+		return
+	}
+	if err := ft.sender.Send(ctx, logs...); err != nil {
+		ft.logger.Warn(ctx, "send log tail lines", slog.Error(err))
+		return
+	}
+	if err := ft.sender.Flush(ctx); err != nil {
+		ft.logger.Warn(ctx, "flush log tail lines", slog.Error(err))
+	}
+}
+
+func (ft *fileTailer) sortedPaths() []string {
+	paths := make([]string, 0, len(ft.files))
+	for path := range ft.files {
+		paths = append(paths, path)
+	}
+	slices.Sort(paths)
+	return paths
+}
+
+// tailJournald follows the journal of the given systemd units until the
+// context is canceled. Only entries written after the agent started are
+// sent.
+func (t *Tailer) tailJournald(ctx context.Context, logger slog.Logger, sender Logger, limit *limiter, units []string) {
+	args := []string{"--follow", "--output", "cat", "--lines", "0"}
+	for _, unit := range units {
+		args = append(args, "--unit", unit)
+	}
+	cmd := t.execer.CommandContext(ctx, "journalctl", args...)
+	stdout, err := cmd.StdoutPipe()
+	if err != nil {
+		logger.Warn(ctx, "create journalctl stdout pipe", slog.Error(err))
+		return
+	}
+	if err := cmd.Start(); err != nil {
+		logger.Warn(ctx, "start journalctl", slog.F("units", units), slog.Error(err))
+		return
+	}
+
+	scanner := bufio.NewScanner(stdout)
+	scanner.Buffer(make([]byte, 0, 64*1024), maxReadPerPoll)
+	for scanner.Scan() {
+		var logs []agentsdk.Log
+		for _, line := range splitLine(scanner.Text()) {
+			logs = append(logs, agentsdk.Log{
+				CreatedAt: t.clock.Now(),
+				Output:    line,
+				Level:     codersdk.LogLevelInfo,
+			})
+		}
+		logs = limit.apply(logs)
+		if len(logs) > 0 {
+			if err := sender.Send(ctx, logs...); err != nil {
+				logger.Warn(ctx, "send journald lines", slog.Error(err))
+			}
+		}
+	}
+	err = cmd.Wait()
+	if err != nil && ctx.Err() == nil {
+		var exitErr interface{ ExitCode() int }
+		if errors.As(err, &exitErr) {
+			logger.Warn(ctx, "journalctl exited", slog.F("units", units), slog.F("exit_code", exitErr.ExitCode()))
+		} else {
+			logger.Warn(ctx, "wait for journalctl", slog.F("units", units), slog.Error(err))
+		}
+	}
+
+	flushCtx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+	if err := sender.Flush(flushCtx); err != nil {
+		logger.Warn(ctx, "flush journald lines", slog.Error(err))
+	}
+}
+
+// limiter bounds the rate of output sent for a log source. The file and
+// journald tailers of a log source share a limiter.
+type limiter struct {
+	clock  quartz.Clock
+	limit  int
+	window time.Duration
+
+	mu          sync.Mutex
+	windowStart time.Time
+	sent        int
+	dropped     int
+}
+
+// limitDroppingOutput is sent when a log source starts dropping lines in a
+// window, so that users know why lines are missing.
+const limitDroppingOutput = "Dropping lines from this log source, it exceeded its share of the log tail rate limit."
+
+// apply returns the logs that fit within the limit of the current window.
+// Once a log does not fit, it and the rest of the window's logs are
+// dropped, and the number of dropped lines is reported when the next
+// window starts. Tailing is never stopped.
+func (l *limiter) apply(logs []agentsdk.Log) []agentsdk.Log {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	now := l.clock.Now()
+	var out []agentsdk.Log
+	if now.Sub(l.windowStart) >= l.window {
+		if l.dropped > 0 {
+			out = append(out, agentsdk.Log{
+				CreatedAt: now,
+				Output:    fmt.Sprintf("Dropped %d lines from this log source, it exceeded its share of the log tail rate limit.", l.dropped),
+				Level:     codersdk.LogLevelWarn,
+			})
+		}
+		l.windowStart = now
+		l.sent = 0
+		l.dropped = 0
+	}
+	for _, log := range logs {
+		if l.dropped > 0 || l.sent+len(log.Output) > l.limit {
+			if l.dropped == 0 {
+				out = append(out, agentsdk.Log{
+					CreatedAt: now,
+					Output:    limitDroppingOutput,
+					Level:     codersdk.LogLevelWarn,
+				})
+			}
+			l.dropped++
+			continue
+		}
+		l.sent += len(log.Output)
+		out = append(out, log)
+	}
+	return out
+}
+
+// splitLine splits a line that is longer than the maximum log line
+// length into several lines.
+func splitLine(line string) []string {
+	if len(line) <= maxLineLength {
+		return []string{line}
+	}
+	var lines []string
+	for len(line) > maxLineLength {
+		lines = append(lines, line[:maxLineLength])
+		line = line[maxLineLength:]
+	}
+	if line != "" {
+		lines = append(lines, line)
+	}
+	return lines
+}
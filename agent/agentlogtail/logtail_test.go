@@ -0,0 +1,224 @@
+package agentlogtail_test
+
+import (
+	"context"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"runtime"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/require"
+
+	"cdr.dev/slog/v3"
+	"cdr.dev/slog/v3/sloggers/slogtest"
+	"github.com/coder/coder/v2/agent/agentlogtail"
+	"github.com/coder/coder/v2/codersdk/agentsdk"
+	"github.com/coder/coder/v2/pty"
+	"github.com/coder/coder/v2/testutil"
+	"github.com/coder/quartz"
+)
+
+// fakeLogger sends the output of every log it receives on a channel.
+type fakeLogger struct {
+	lines chan string
+}
+
+func (l *fakeLogger) Send(_ context.Context, logs ...agentsdk.Log) error {
+	for _, log := range logs {
+		l.lines <- log.Output
+	}
+	return nil
+}
+
+func (*fakeLogger) Flush(context.Context) error {
+	return nil
+}
+
+func appendFile(t *testing.T, path, content string) {
+	t.Helper()
+	f, err := os.OpenFile(path, os.O_APPEND|os.O_CREATE|os.O_WRONLY, 0o600)
+	require.NoError(t, err)
+	_, err = f.WriteString(content)
+	require.NoError(t, err)
+	require.NoError(t, f.Close())
+}
+
+func requireLines(ctx context.Context, t *testing.T, lines <-chan string, want ...string) {
+	t.Helper()
+	for _, line := range want {
+		require.Equal(t, line, testutil.RequireReceive(ctx, t, lines))
+	}
+}
+
+func TestTailer_Files(t *testing.T) {
+	t.Parallel()
+
+	ctx := testutil.Context(t, testutil.WaitShort)
+	logger := slogtest.Make(t, nil).Leveled(slog.LevelDebug)
+	clk := quartz.NewMock(t)
+	tickerTrap := clk.Trap().TickerFunc("logtail", "poll")
+	defer tickerTrap.Close()
+
+	dir := t.TempDir()
+	appPath := filepath.Join(dir, "app.log")
+	appendFile(t, appPath, "written before start\n")
+
+	sourceID := uuid.New()
+	sender := &fakeLogger{lines: make(chan string, 64)}
+	tailer := agentlogtail.New(logger, func(logSourceID uuid.UUID) agentlogtail.Logger {
+		require.Equal(t, sourceID, logSourceID)
+		return sender
+	}, agentlogtail.WithClock(clk))
+	defer tailer.Close()
+
+	err := tailer.Start([]agentsdk.LogTail{{
+		LogSourceID: sourceID,
+		Paths:       []string{filepath.Join(dir, "*.log")},
+	}})
+	require.NoError(t, err)
+	tickerTrap.MustWait(ctx).MustRelease(ctx)
+
+	poll := func() {
+		t.Helper()
+		_, w := clk.AdvanceNext()
+		w.MustWait(ctx)
+	}
+
+	// Lines written before the tailer started are skipped, and
+	// incomplete lines are held back until they are completed.
+	appendFile(t, appPath, "first\nsec")
+	poll()
+	requireLines(ctx, t, sender.lines, "first")
+	appendFile(t, appPath, "ond\n")
+	poll()
+	requireLines(ctx, t, sender.lines, "second")
+
+	// Files created after the tailer started are read from the start.
+	otherPath := filepath.Join(dir, "other.log")
+	appendFile(t, otherPath, "other\n")
+	poll()
+	requireLines(ctx, t, sender.lines, "other")
+
+	// A truncated file is read from the start.
+	require.NoError(t, os.Truncate(appPath, 0))
+	appendFile(t, appPath, "truncated\n")
+	poll()
+	requireLines(ctx, t, sender.lines, "truncated")
+
+	// A rotated file is read from the start.
+	require.NoError(t, os.Rename(otherPath, otherPath+".1"))
+	appendFile(t, otherPath, "rotated\n")
+	poll()
+	requireLines(ctx, t, sender.lines, "rotated")
+
+	// Long lines are split.
+	appendFile(t, appPath, strings.Repeat("a", 1500)+"\n")
+	poll()
+	requireLines(ctx, t, sender.lines, strings.Repeat("a", 1024), strings.Repeat("a", 476))
+
+	// Partial lines are sent on close.
+	appendFile(t, appPath, "partial")
+	poll()
+	require.NoError(t, tailer.Close())
+	requireLines(ctx, t, sender.lines, "partial")
+}
+
+func TestTailer_RateLimit(t *testing.T) {
+	t.Parallel()
+
+	ctx := testutil.Context(t, testutil.WaitShort)
+	logger := slogtest.Make(t, &slogtest.Options{IgnoreErrors: true}).Leveled(slog.LevelDebug)
+	clk := quartz.NewMock(t)
+	tickerTrap := clk.Trap().TickerFunc("logtail", "poll")
+	defer tickerTrap.Close()
+
+	dir := t.TempDir()
+	path := filepath.Join(dir, "busy.log")
+	sender := &fakeLogger{lines: make(chan string, 64)}
+	// Poll once per window, so that every poll starts a new window.
+	tailer := agentlogtail.New(logger, func(uuid.UUID) agentlogtail.Logger {
+		return sender
+	}, agentlogtail.WithClock(clk),
+		agentlogtail.WithPollInterval(time.Minute),
+		agentlogtail.WithRateLimit(200, time.Minute))
+	defer tailer.Close()
+
+	err := tailer.Start([]agentsdk.LogTail{{
+		LogSourceID: uuid.New(),
+		Paths:       []string{path},
+	}})
+	require.NoError(t, err)
+	tickerTrap.MustWait(ctx).MustRelease(ctx)
+
+	poll := func() {
+		t.Helper()
+		_, w := clk.AdvanceNext()
+		w.MustWait(ctx)
+	}
+
+	// Only the lines within the limit are sent, followed by a line
+	// explaining why the rest are dropped.
+	line := strings.Repeat("a", 50)
+	appendFile(t, path, strings.Repeat(line+"\n", 5))
+	poll()
+	requireLines(ctx, t, sender.lines, line, line, line, line,
+		"Dropping lines from this log source, it exceeded its share of the log tail rate limit.")
+
+	// Tailing continues in the next window, after reporting how many lines
+	// were dropped.
+	appendFile(t, path, "after\n")
+	poll()
+	requireLines(ctx, t, sender.lines,
+		"Dropped 1 lines from this log source, it exceeded its share of the log tail rate limit.",
+		"after")
+
+	require.NoError(t, tailer.Close())
+	require.Empty(t, sender.lines)
+}
+
+// journalctlExecer runs a shell script in place of journalctl that
+// prints its arguments followed by a few lines.
+type journalctlExecer struct{}
+
+func (journalctlExecer) CommandContext(ctx context.Context, cmd string, args ...string) *exec.Cmd {
+	if cmd != "journalctl" {
+		return exec.CommandContext(ctx, "false")
+	}
+	return exec.CommandContext(ctx, "sh", append([]string{"-c", `echo "$@"; echo started; echo listening`, "journalctl"}, args...)...)
+}
+
+func (journalctlExecer) PTYCommandContext(context.Context, string, ...string) *pty.Cmd {
+	panic("not implemented")
+}
+
+func TestTailer_Journald(t *testing.T) {
+	t.Parallel()
+
+	if runtime.GOOS == "windows" {
+		t.Skip("journald is only available on Linux")
+	}
+
+	ctx := testutil.Context(t, testutil.WaitShort)
+	logger := slogtest.Make(t, nil).Leveled(slog.LevelDebug)
+	sender := &fakeLogger{lines: make(chan string, 64)}
+	tailer := agentlogtail.New(logger, func(uuid.UUID) agentlogtail.Logger {
+		return sender
+	}, agentlogtail.WithExecer(journalctlExecer{}), agentlogtail.WithPollInterval(time.Hour))
+	defer tailer.Close()
+
+	err := tailer.Start([]agentsdk.LogTail{{
+		LogSourceID:   uuid.New(),
+		JournaldUnits: []string{"docker.service", "containerd.service"},
+	}})
+	require.NoError(t, err)
+
+	requireLines(ctx, t, sender.lines,
+		"--follow --output cat --lines 0 --unit docker.service --unit containerd.service",
+		"started",
+		"listening",
+	)
+}
@@ -21,6 +21,7 @@ import (
 	"github.com/coder/coder/v2/coderd/httpapi"
 	"github.com/coder/coder/v2/codersdk"
 	"github.com/coder/coder/v2/codersdk/workspacesdk"
+	"github.com/coder/quartz"
 )
 
 const (
@@ -37,13 +38,36 @@ type API struct {
 	pathStore *agentgit.PathStore
 }
 
+// Option configures the process API.
+type Option func(*API)
+
+// WithClock sets the clock used for timestamps, service restart
+// backoff and readiness probes.
+func WithClock(clock quartz.Clock) Option {
+	return func(api *API) {
+		api.manager.clock = clock
+	}
+}
+
+// WithAppStatusReporter sets the function used to report the state
+// of services with a readiness probe to their workspace app.
+func WithAppStatusReporter(reporter AppStatusReporter) Option {
+	return func(api *API) {
+		api.manager.reportAppStatus = reporter
+	}
+}
+
 // NewAPI creates a new process API handler.
-func NewAPI(logger slog.Logger, execer agentexec.Execer, fs afero.Fs, pathStore *agentgit.PathStore, envInfo usershell.EnvInfoer, updateEnv func(current []string) (updated []string, err error), workingDir func() string) *API {
-	return &API{
+func NewAPI(logger slog.Logger, execer agentexec.Execer, fs afero.Fs, pathStore *agentgit.PathStore, envInfo usershell.EnvInfoer, updateEnv func(current []string) (updated []string, err error), workingDir func() string, opts ...Option) *API {
+	api := &API{
 		logger:    logger,
 		manager:   newManager(logger, execer, fs, envInfo, updateEnv, workingDir),
 		pathStore: pathStore,
 	}
+	for _, opt := range opts {
+		opt(api)
+	}
+	return api
 }
 
 // Close shuts down the process manager, killing all running
@@ -59,6 +83,12 @@ func (api *API) Routes() http.Handler {
 	r.Get("/list", api.handleListProcesses)
 	r.Get("/{id}/output", api.handleProcessOutput)
 	r.Post("/{id}/signal", api.handleSignalProcess)
+	r.Route("/services", func(r chi.Router) {
+		r.Post("/", api.handleStartService)
+		r.Get("/", api.handleListServices)
+		r.Get("/{name}/output", api.handleServiceOutput)
+		r.Post("/{name}/stop", api.handleStopService)
+	})
 	return r
 }
 
@@ -289,3 +319,124 @@ func (api *API) handleSignalProcess(rw http.ResponseWriter, r *http.Request) {
 		),
 	})
 }
+
+// handleStartService starts a supervised service.
+func (api *API) handleStartService(rw http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	var req workspacesdk.StartServiceRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		httpapi.Write(ctx, rw, http.StatusBadRequest, codersdk.Response{
+			Message: "Request body must be valid JSON.",
+			Detail:  err.Error(),
+		})
+		return
+	}
+
+	var validations []codersdk.ValidationError
+	if req.Name == "" {
+		validations = append(validations, codersdk.ValidationError{Field: "name", Detail: "Name is required."})
+	}
+	if req.Command == "" {
+		validations = append(validations, codersdk.ValidationError{Field: "command", Detail: "Command is required."})
+	}
+	switch req.Restart {
+	case "", workspacesdk.ServiceRestartAlways, workspacesdk.ServiceRestartOnFailure, workspacesdk.ServiceRestartNever:
+	default:
+		validations = append(validations, codersdk.ValidationError{
+			Field:  "restart",
+			Detail: fmt.Sprintf("Unsupported restart policy %q. Use \"always\", \"on-failure\" or \"never\".", req.Restart),
+		})
+	}
+	if req.MaxRestarts < 0 {
+		validations = append(validations, codersdk.ValidationError{Field: "max_restarts", Detail: "Max restarts must not be negative."})
+	}
+	if req.Readiness != nil {
+		hc := req.Readiness.Healthcheck
+		if hc.URL == "" && hc.Address == "" && hc.Command == "" {
+			validations = append(validations, codersdk.ValidationError{Field: "readiness", Detail: "Readiness healthcheck must set a url, address or command."})
+		}
+		if hc.Interval <= 0 {
+			validations = append(validations, codersdk.ValidationError{Field: "readiness", Detail: "Readiness healthcheck interval must be positive."})
+		}
+	}
+	if len(validations) > 0 {
+		httpapi.Write(ctx, rw, http.StatusBadRequest, codersdk.Response{
+			Message:     "Invalid service request.",
+			Validations: validations,
+		})
+		return
+	}
+
+	svc, err := api.manager.startService(req)
+	if err != nil {
+		if errors.Is(err, errServiceActive) {
+			httpapi.Write(ctx, rw, http.StatusConflict, codersdk.Response{
+				Message: fmt.Sprintf("Service %q is already active.", req.Name),
+			})
+			return
+		}
+		httpapi.Write(ctx, rw, http.StatusInternalServerError, codersdk.Response{
+			Message: "Failed to start service.",
+			Detail:  err.Error(),
+		})
+		return
+	}
+
+	httpapi.Write(ctx, rw, http.StatusOK, svc.info())
+}
+
+// handleListServices lists all supervised services.
+func (api *API) handleListServices(rw http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	infos := api.manager.listServices()
+	sort.Slice(infos, func(i, j int) bool {
+		return infos[i].Name < infos[j].Name
+	})
+
+	httpapi.Write(ctx, rw, http.StatusOK, workspacesdk.ListServicesResponse{
+		Services: infos,
+	})
+}
+
+// handleServiceOutput returns the retained output of a service.
+func (api *API) handleServiceOutput(rw http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	name := chi.URLParam(r, "name")
+	svc, ok := api.manager.getService(name)
+	if !ok {
+		httpapi.Write(ctx, rw, http.StatusNotFound, codersdk.Response{
+			Message: fmt.Sprintf("Service %q not found.", name),
+		})
+		return
+	}
+
+	httpapi.Write(ctx, rw, http.StatusOK, svc.output())
+}
+
+// handleStopService stops a service and waits for its process to
+// exit.
+func (api *API) handleStopService(rw http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	name := chi.URLParam(r, "name")
+	if err := api.manager.stopService(name); err != nil {
+		if errors.Is(err, errServiceNotFound) {
+			httpapi.Write(ctx, rw, http.StatusNotFound, codersdk.Response{
+				Message: fmt.Sprintf("Service %q not found.", name),
+			})
+			return
+		}
+		httpapi.Write(ctx, rw, http.StatusInternalServerError, codersdk.Response{
+			Message: "Failed to stop service.",
+			Detail:  err.Error(),
+		})
+		return
+	}
+
+	httpapi.Write(ctx, rw, http.StatusOK, codersdk.Response{
+		Message: fmt.Sprintf("Service %q stopped.", name),
+	})
+}
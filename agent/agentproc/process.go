@@ -79,10 +79,13 @@ type manager struct {
 	fs         afero.Fs
 	clock      quartz.Clock
 	procs      map[string]*process
+	services   map[string]*service
 	closed     bool
 	updateEnv  func(current []string) (updated []string, err error)
 	workingDir func() string
 	envInfo    usershell.EnvInfoer
+
+	reportAppStatus AppStatusReporter
 }
 
 // newManager creates a new process manager.
@@ -99,6 +102,7 @@ func newManager(logger slog.Logger, execer agentexec.Execer, fs afero.Fs, envInf
 		fs:         fs,
 		clock:      quartz.NewReal(),
 		procs:      make(map[string]*process),
+		services:   make(map[string]*service),
 		updateEnv:  updateEnv,
 		workingDir: workingDir,
 		envInfo:    envInfo,
@@ -117,6 +121,29 @@ func (m *manager) start(req workspacesdk.StartProcessRequest, chatID string) (*p
 	}
 	m.mu.Unlock()
 
+	proc, err := m.spawn(req, chatID)
+	if err != nil {
+		return nil, err
+	}
+
+	m.mu.Lock()
+	if m.closed {
+		m.mu.Unlock()
+		// Manager closed between our check and now. Kill the
+		// process we just started.
+		proc.cancel()
+		<-proc.done
+		return nil, xerrors.New("manager is closed")
+	}
+	m.procs[proc.id] = proc
+	m.mu.Unlock()
+
+	return proc, nil
+}
+
+// spawn starts a process without tracking it in the manager.
+// The returned process's done channel is closed once it exits.
+func (m *manager) spawn(req workspacesdk.StartProcessRequest, chatID string) (*process, error) {
 	id := uuid.New().String()
 	logger := m.logger
 	if chatID != "" {
@@ -140,36 +167,7 @@ func (m *manager) start(req workspacesdk.StartProcessRequest, chatID string) (*p
 	buf := NewHeadTailBuffer()
 	cmd.Stdout = buf
 	cmd.Stderr = buf
-
-	// Build the process environment. If the manager has an
-	// updateEnv hook (provided by the agent), use it to get the
-	// full agent environment including GIT_ASKPASS, CODER_* vars,
-	// etc. Otherwise fall back to the current process env.
-	baseEnv := os.Environ()
-	if m.updateEnv != nil {
-		updated, err := m.updateEnv(baseEnv)
-		if err != nil {
-			logger.Warn(
-				context.Background(),
-				"failed to update command environment, falling back to os env",
-				slog.Error(err),
-			)
-		} else {
-			baseEnv = updated
-		}
-	}
-
-	// Always set cmd.Env explicitly so that req.Env overrides
-	// are applied on top of the full agent environment.
-	cmd.Env = baseEnv
-	for k, v := range req.Env {
-		cmd.Env = append(cmd.Env, fmt.Sprintf("%s=%s", k, v))
-	}
-	// Propagate the chat ID so child processes (e.g.
-	// GIT_ASKPASS) can send it back to the server.
-	if chatID != "" {
-		cmd.Env = append(cmd.Env, fmt.Sprintf("CODER_CHAT_ID=%s", chatID))
-	}
+	cmd.Env = m.commandEnv(logger, req.Env, chatID)
 
 	if err := cmd.Start(); err != nil {
 		cancel()
@@ -192,18 +190,6 @@ func (m *manager) start(req workspacesdk.StartProcessRequest, chatID string) (*p
 		done:       make(chan struct{}),
 	}
 
-	m.mu.Lock()
-	if m.closed {
-		m.mu.Unlock()
-		// Manager closed between our check and now. Kill the
-		// process we just started.
-		cancel()
-		_ = cmd.Wait()
-		return nil, xerrors.New("manager is closed")
-	}
-	m.procs[id] = proc
-	m.mu.Unlock()
-
 	go func() {
 		err := cmd.Wait()
 		exitedAt := m.clock.Now().Unix()
@@ -240,6 +226,38 @@ func (m *manager) start(req workspacesdk.StartProcessRequest, chatID string) (*p
 	return proc, nil
 }
 
+// commandEnv builds the environment for a spawned command. If the
+// manager has an updateEnv hook (provided by the agent), use it to
+// get the full agent environment including GIT_ASKPASS, CODER_*
+// vars, etc. Otherwise fall back to the current process env. The
+// result is always set explicitly so that env overrides are applied
+// on top of the full agent environment.
+func (m *manager) commandEnv(logger slog.Logger, env map[string]string, chatID string) []string {
+	baseEnv := os.Environ()
+	if m.updateEnv != nil {
+		updated, err := m.updateEnv(baseEnv)
+		if err != nil {
+			logger.Warn(
+				context.Background(),
+				"failed to update command environment, falling back to os env",
+				slog.Error(err),
+			)
+		} else {
+			baseEnv = updated
+		}
+	}
+
+	for k, v := range env {
+		baseEnv = append(baseEnv, fmt.Sprintf("%s=%s", k, v))
+	}
+	// Propagate the chat ID so child processes (e.g.
+	// GIT_ASKPASS) can send it back to the server.
+	if chatID != "" {
+		baseEnv = append(baseEnv, fmt.Sprintf("CODER_CHAT_ID=%s", chatID))
+	}
+	return baseEnv
+}
+
 // get returns a process by ID.
 func (m *manager) get(id string) (*process, bool) {
 	m.mu.Lock()
@@ -317,10 +335,10 @@ func (m *manager) signal(id string, sig string) error {
 	return nil
 }
 
-// Close kills all running processes and prevents new ones from
-// starting. It cancels each process's context, which causes
-// CommandContext to kill the process and its pipe goroutines to
-// drain.
+// Close stops all services, kills all running processes and
+// prevents new ones from starting. It cancels each process's
+// context, which causes CommandContext to kill the process and its
+// pipe goroutines to drain.
 func (m *manager) Close() error {
 	m.mu.Lock()
 	if m.closed {
@@ -328,12 +346,25 @@ func (m *manager) Close() error {
 		return nil
 	}
 	m.closed = true
+	services := make([]*service, 0, len(m.services))
+	for _, s := range m.services {
+		services = append(services, s)
+	}
 	procs := make([]*process, 0, len(m.procs))
 	for _, p := range m.procs {
 		procs = append(procs, p)
 	}
 	m.mu.Unlock()
 
+	// Stop supervisors first so they do not restart the processes
+	// being killed.
+	for _, s := range services {
+		s.cancel()
+	}
+	for _, s := range services {
+		<-s.done
+	}
+
 	for _, p := range procs {
 		p.cancel()
 	}
@@ -0,0 +1,418 @@
+package agentproc
+
+import (
+	"context"
+	"fmt"
+	"net"
+	"net/http"
+	"sync"
+	"time"
+
+	"golang.org/x/xerrors"
+
+	"cdr.dev/slog/v3"
+	"github.com/coder/coder/v2/codersdk"
+	"github.com/coder/coder/v2/codersdk/agentsdk"
+	"github.com/coder/coder/v2/codersdk/workspacesdk"
+)
+
+var (
+	errServiceNotFound = xerrors.New("service not found")
+	errServiceActive   = xerrors.New("service is already active")
+)
+
+const (
+	// serviceInitialBackoff is the delay before the first restart
+	// of a service. It doubles on each consecutive restart up to
+	// serviceMaxBackoff.
+	serviceInitialBackoff = time.Second
+	serviceMaxBackoff     = time.Minute
+
+	// serviceStableUptime is how long a run must stay up for the
+	// backoff and the consecutive restart count to reset.
+	serviceStableUptime = time.Minute
+
+	// defaultServiceLogRetention is the number of runs whose output
+	// is kept when the request does not specify one.
+	defaultServiceLogRetention = 3
+
+	// maxRecentRestarts is the number of restarts reported in
+	// ServiceInfo.RecentRestarts.
+	maxRecentRestarts = 10
+
+	// appStatusReportTimeout bounds each app status report so a
+	// slow control plane cannot stall the supervisor.
+	appStatusReportTimeout = 10 * time.Second
+)
+
+// AppStatusReporter reports the status of a workspace app to the
+// control plane. Services with a readiness probe for an app report
+// their state through it.
+type AppStatusReporter func(ctx context.Context, status agentsdk.PatchAppStatus) error
+
+// service is a process supervised by the manager. The supervisor
+// goroutine restarts the process according to the restart policy.
+type service struct {
+	mu             sync.Mutex
+	req            workspacesdk.StartServiceRequest
+	state          workspacesdk.ServiceState
+	ready          bool
+	restarts       int
+	recentRestarts []workspacesdk.ServiceRestart
+	// runs holds the retained runs, oldest first. The last run is
+	// the current one.
+	runs   []*process
+	ctx    context.Context
+	cancel context.CancelFunc
+	done   chan struct{} // closed when the supervisor exits
+}
+
+// info returns a snapshot of the service state.
+func (s *service) info() workspacesdk.ServiceInfo {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	info := workspacesdk.ServiceInfo{
+		Name:           s.req.Name,
+		Command:        s.req.Command,
+		Restart:        s.req.Restart,
+		State:          s.state,
+		Ready:          s.ready,
+		Restarts:       s.restarts,
+		RecentRestarts: append([]workspacesdk.ServiceRestart{}, s.recentRestarts...),
+	}
+	if len(s.runs) > 0 {
+		last := s.runs[len(s.runs)-1].info()
+		info.StartedAt = last.StartedAt
+		info.ExitCode = last.ExitCode
+	}
+	return info
+}
+
+// output returns the output of the retained runs, oldest first.
+func (s *service) output() workspacesdk.ServiceOutputResponse {
+	s.mu.Lock()
+	runs := append([]*process{}, s.runs...)
+	s.mu.Unlock()
+
+	resp := workspacesdk.ServiceOutputResponse{
+		Runs: make([]workspacesdk.ServiceRun, 0, len(runs)),
+	}
+	for _, run := range runs {
+		// Read info before output, see handleProcessOutput.
+		info := run.info()
+		output, truncated := run.output()
+		resp.Runs = append(resp.Runs, workspacesdk.ServiceRun{
+			StartedAt: info.StartedAt,
+			ExitedAt:  info.ExitedAt,
+			ExitCode:  info.ExitCode,
+			Output:    output,
+			Truncated: truncated,
+		})
+	}
+	return resp
+}
+
+func (s *service) setState(state workspacesdk.ServiceState) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.state = state
+	if state != workspacesdk.ServiceStateRunning {
+		s.ready = false
+	}
+}
+
+// startService starts supervising a new service. A service whose
+// supervisor has exited may be replaced by starting it again.
+func (m *manager) startService(req workspacesdk.StartServiceRequest) (*service, error) {
+	if req.Restart == "" {
+		req.Restart = workspacesdk.ServiceRestartOnFailure
+	}
+	if req.LogRetention <= 0 {
+		req.LogRetention = defaultServiceLogRetention
+	}
+
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	if m.closed {
+		return nil, xerrors.New("manager is closed")
+	}
+	if existing, ok := m.services[req.Name]; ok {
+		select {
+		case <-existing.done:
+		default:
+			return nil, errServiceActive
+		}
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	svc := &service{
+		req:    req,
+		state:  workspacesdk.ServiceStateRunning,
+		ctx:    ctx,
+		cancel: cancel,
+		done:   make(chan struct{}),
+	}
+	m.services[req.Name] = svc
+	go m.supervise(svc)
+	return svc, nil
+}
+
+// getService returns a service by name.
+func (m *manager) getService(name string) (*service, bool) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	svc, ok := m.services[name]
+	return svc, ok
+}
+
+// listServices returns info about all services.
+func (m *manager) listServices() []workspacesdk.ServiceInfo {
+	m.mu.Lock()
+	services := make([]*service, 0, len(m.services))
+	for _, svc := range m.services {
+		services = append(services, svc)
+	}
+	m.mu.Unlock()
+
+	infos := make([]workspacesdk.ServiceInfo, 0, len(services))
+	for _, svc := range services {
+		infos = append(infos, svc.info())
+	}
+	return infos
+}
+
+// stopService stops a service and waits for its process to exit.
+// The service stays listed so its output can still be read.
+func (m *manager) stopService(name string) error {
+	svc, ok := m.getService(name)
+	if !ok {
+		return errServiceNotFound
+	}
+	svc.cancel()
+	<-svc.done
+	return nil
+}
+
+// supervise runs the service until its restart policy or the
+// maximum number of restarts stops it, or it is stopped.
+func (m *manager) supervise(svc *service) {
+	defer close(svc.done)
+
+	req := svc.req
+	logger := m.logger.With(slog.F("service", req.Name))
+	backoff := serviceInitialBackoff
+	consecutive := 0
+	for {
+		if svc.ctx.Err() != nil {
+			svc.setState(workspacesdk.ServiceStateStopped)
+			return
+		}
+
+		startedAt := m.clock.Now()
+		code := -1
+		proc, err := m.spawn(workspacesdk.StartProcessRequest{
+			Command:    req.Command,
+			WorkDir:    req.WorkDir,
+			Env:        req.Env,
+			Background: true,
+		}, "")
+		if err != nil {
+			logger.Warn(svc.ctx, "failed to start service", slog.Error(err))
+		} else {
+			svc.mu.Lock()
+			svc.state = workspacesdk.ServiceStateRunning
+			svc.ready = false
+			svc.runs = append(svc.runs, proc)
+			if len(svc.runs) > req.LogRetention {
+				svc.runs = svc.runs[len(svc.runs)-req.LogRetention:]
+			}
+			svc.mu.Unlock()
+			m.reportServiceStatus(svc, codersdk.WorkspaceAppStatusStateWorking, fmt.Sprintf("Service %q is starting.", req.Name))
+
+			stopProbe := m.probeService(svc, proc)
+			select {
+			case <-proc.done:
+			case <-svc.ctx.Done():
+				proc.cancel()
+				<-proc.done
+			}
+			stopProbe()
+
+			proc.mu.Lock()
+			code = *proc.exitCode
+			proc.mu.Unlock()
+		}
+
+		if svc.ctx.Err() != nil {
+			svc.setState(workspacesdk.ServiceStateStopped)
+			return
+		}
+
+		if m.clock.Since(startedAt) >= serviceStableUptime {
+			backoff = serviceInitialBackoff
+			consecutive = 0
+		}
+
+		restart := req.Restart == workspacesdk.ServiceRestartAlways ||
+			(req.Restart == workspacesdk.ServiceRestartOnFailure && code != 0)
+		if !restart {
+			svc.setState(workspacesdk.ServiceStateExited)
+			if code == 0 {
+				m.reportServiceStatus(svc, codersdk.WorkspaceAppStatusStateComplete, fmt.Sprintf("Service %q exited.", req.Name))
+			} else {
+				m.reportServiceStatus(svc, codersdk.WorkspaceAppStatusStateFailure, fmt.Sprintf("Service %q exited with code %d.", req.Name, code))
+			}
+			return
+		}
+		if req.MaxRestarts > 0 && consecutive >= req.MaxRestarts {
+			logger.Warn(svc.ctx, "service reached maximum restarts", slog.F("max_restarts", req.MaxRestarts), slog.F("exit_code", code))
+			svc.setState(workspacesdk.ServiceStateFailed)
+			m.reportServiceStatus(svc, codersdk.WorkspaceAppStatusStateFailure, fmt.Sprintf("Service %q exited with code %d after %d restarts.", req.Name, code, consecutive))
+			return
+		}
+
+		consecutive++
+		svc.mu.Lock()
+		svc.state = workspacesdk.ServiceStateBackoff
+		svc.ready = false
+		svc.restarts++
+		svc.recentRestarts = append(svc.recentRestarts, workspacesdk.ServiceRestart{
+			RestartedAt: m.clock.Now().Unix(),
+			ExitCode:    code,
+		})
+		if len(svc.recentRestarts) > maxRecentRestarts {
+			svc.recentRestarts = svc.recentRestarts[len(svc.recentRestarts)-maxRecentRestarts:]
+		}
+		svc.mu.Unlock()
+		logger.Info(svc.ctx, "restarting service", slog.F("exit_code", code), slog.F("backoff", backoff))
+		m.reportServiceStatus(svc, codersdk.WorkspaceAppStatusStateFailure, fmt.Sprintf("Service %q exited with code %d, restarting in %s.", req.Name, code, backoff))
+
+		timer := m.clock.NewTimer(backoff, "service", "backoff")
+		select {
+		case <-timer.C:
+		case <-svc.ctx.Done():
+			timer.Stop()
+		}
+		backoff = min(backoff*2, serviceMaxBackoff)
+	}
+}
+
+// probeService runs the readiness probe of the service against the
+// given run, if it has one. The returned function stops the probe
+// and waits for it to exit.
+func (m *manager) probeService(svc *service, proc *process) func() {
+	readiness := svc.req.Readiness
+	if readiness == nil || readiness.Healthcheck.Interval <= 0 {
+		return func() {}
+	}
+
+	ctx, cancel := context.WithCancel(svc.ctx)
+	go func() {
+		select {
+		case <-proc.done:
+			cancel()
+		case <-ctx.Done():
+		}
+	}()
+
+	hc := readiness.Healthcheck
+	threshold := max(int(hc.Threshold), 1)
+	interval := time.Duration(hc.Interval) * time.Second
+	failures := 0
+	waiter := m.clock.TickerFunc(ctx, interval, func() error {
+		checkCtx, checkCancel := context.WithTimeout(ctx, interval)
+		defer checkCancel()
+		err := m.checkReadiness(checkCtx, svc, hc)
+		if ctx.Err() != nil {
+			return nil
+		}
+
+		svc.mu.Lock()
+		wasReady := svc.ready
+		switch {
+		case err == nil:
+			failures = 0
+			svc.ready = true
+		default:
+			failures++
+			if failures >= threshold {
+				svc.ready = false
+			}
+		}
+		ready := svc.ready
+		svc.mu.Unlock()
+
+		switch {
+		case ready && !wasReady:
+			m.reportServiceStatus(svc, codersdk.WorkspaceAppStatusStateIdle, fmt.Sprintf("Service %q is ready.", svc.req.Name))
+		case !ready && wasReady:
+			m.reportServiceStatus(svc, codersdk.WorkspaceAppStatusStateFailure, fmt.Sprintf("Service %q is not ready: %s", svc.req.Name, err))
+		}
+		return nil
+	}, "service", "readiness")
+
+	return func() {
+		cancel()
+		_ = waiter.Wait()
+	}
+}
+
+// checkReadiness runs a single readiness check.
+func (m *manager) checkReadiness(ctx context.Context, svc *service, hc codersdk.Healthcheck) error {
+	switch hc.Type() {
+	case codersdk.HealthcheckTypeTCP:
+		var d net.Dialer
+		conn, err := d.DialContext(ctx, "tcp", hc.Address)
+		if err != nil {
+			return err
+		}
+		_ = conn.Close()
+		return nil
+	case codersdk.HealthcheckTypeExec:
+		cmd := m.execer.CommandContext(ctx, "sh", "-c", hc.Command)
+		cmd.Dir = m.resolveWorkingDirectory(svc.req.WorkDir)
+		cmd.Env = m.commandEnv(m.logger, svc.req.Env, "")
+		cmd.WaitDelay = time.Second
+		if out, err := cmd.CombinedOutput(); err != nil {
+			return xerrors.Errorf("%w: %s", err, out)
+		}
+		return nil
+	default:
+		req, err := http.NewRequestWithContext(ctx, http.MethodGet, hc.URL, nil)
+		if err != nil {
+			return err
+		}
+		res, err := http.DefaultClient.Do(req)
+		if err != nil {
+			return err
+		}
+		_ = res.Body.Close()
+		if res.StatusCode >= http.StatusInternalServerError {
+			return xerrors.Errorf("received status code %d", res.StatusCode)
+		}
+		return nil
+	}
+}
+
+// reportServiceStatus reports the state of a service to the app
+// its readiness probe is attached to, if any.
+func (m *manager) reportServiceStatus(svc *service, state codersdk.WorkspaceAppStatusState, message string) {
+	if m.reportAppStatus == nil || svc.req.Readiness == nil || svc.req.Readiness.AppSlug == "" {
+		return
+	}
+	ctx, cancel := context.WithTimeout(context.Background(), appStatusReportTimeout)
+	defer cancel()
+	err := m.reportAppStatus(ctx, agentsdk.PatchAppStatus{
+		AppSlug: svc.req.Readiness.AppSlug,
+		State:   state,
+		Message: message,
+	})
+	if err != nil {
+		m.logger.Warn(ctx, "failed to report service app status",
+			slog.F("service", svc.req.Name),
+			slog.F("app_slug", svc.req.Readiness.AppSlug),
+			slog.Error(err),
+		)
+	}
+}
@@ -0,0 +1,312 @@
+package agentproc_test
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"runtime"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"cdr.dev/slog/v3"
+	"cdr.dev/slog/v3/sloggers/slogtest"
+	"github.com/coder/coder/v2/agent/agentexec"
+	"github.com/coder/coder/v2/agent/agentproc"
+	"github.com/coder/coder/v2/codersdk"
+	"github.com/coder/coder/v2/codersdk/agentsdk"
+	"github.com/coder/coder/v2/codersdk/workspacesdk"
+	"github.com/coder/coder/v2/testutil"
+	"github.com/coder/quartz"
+)
+
+// newServiceTestAPI creates a new API using the given clock. App
+// statuses reported by services are sent on the returned channel.
+func newServiceTestAPI(t *testing.T, clk quartz.Clock) (http.Handler, <-chan agentsdk.PatchAppStatus) {
+	t.Helper()
+
+	logger := slogtest.Make(t, &slogtest.Options{
+		IgnoreErrors: true,
+	}).Leveled(slog.LevelDebug)
+	statuses := make(chan agentsdk.PatchAppStatus, 64)
+	api := agentproc.NewAPI(logger, agentexec.DefaultExecer, nil, nil, nil, nil, nil,
+		agentproc.WithClock(clk),
+		agentproc.WithAppStatusReporter(func(_ context.Context, status agentsdk.PatchAppStatus) error {
+			statuses <- status
+			return nil
+		}),
+	)
+	t.Cleanup(func() {
+		_ = api.Close()
+	})
+	return api.Routes(), statuses
+}
+
+// serviceRequest sends a request to the services routes and returns
+// the recorder.
+func serviceRequest(t *testing.T, handler http.Handler, method, path string, body any) *httptest.ResponseRecorder {
+	t.Helper()
+
+	ctx, cancel := context.WithTimeout(context.Background(), testutil.WaitLong)
+	defer cancel()
+
+	var reader *bytes.Reader
+	if body != nil {
+		b, err := json.Marshal(body)
+		require.NoError(t, err)
+		reader = bytes.NewReader(b)
+	} else {
+		reader = bytes.NewReader(nil)
+	}
+
+	w := httptest.NewRecorder()
+	r := httptest.NewRequestWithContext(ctx, method, "/services"+path, reader)
+	handler.ServeHTTP(w, r)
+	return w
+}
+
+// waitForServiceState polls the service list until the named
+// service reaches the given state.
+func waitForServiceState(ctx context.Context, t *testing.T, handler http.Handler, name string, state workspacesdk.ServiceState) workspacesdk.ServiceInfo {
+	t.Helper()
+
+	var info workspacesdk.ServiceInfo
+	testutil.Eventually(ctx, t, func(context.Context) bool {
+		w := serviceRequest(t, handler, http.MethodGet, "", nil)
+		require.Equal(t, http.StatusOK, w.Code)
+		var resp workspacesdk.ListServicesResponse
+		require.NoError(t, json.NewDecoder(w.Body).Decode(&resp))
+		for _, svc := range resp.Services {
+			if svc.Name == name {
+				info = svc
+				return svc.State == state
+			}
+		}
+		return false
+	}, testutil.IntervalFast)
+	return info
+}
+
+func TestService(t *testing.T) {
+	t.Parallel()
+
+	if runtime.GOOS == "windows" {
+		t.Skip("services run commands with sh")
+	}
+
+	t.Run("OnFailureMaxRestarts", func(t *testing.T) {
+		t.Parallel()
+
+		ctx := testutil.Context(t, testutil.WaitLong)
+		clk := quartz.NewMock(t)
+		backoffTrap := clk.Trap().NewTimer("service", "backoff")
+		defer backoffTrap.Close()
+		handler, _ := newServiceTestAPI(t, clk)
+
+		w := serviceRequest(t, handler, http.MethodPost, "", workspacesdk.StartServiceRequest{
+			Name:         "crasher",
+			Command:      "echo crashing && exit 3",
+			MaxRestarts:  2,
+			LogRetention: 2,
+		})
+		require.Equal(t, http.StatusOK, w.Code, w.Body.String())
+
+		// The backoff doubles on each consecutive restart.
+		for i := range 2 {
+			call := backoffTrap.MustWait(ctx)
+			require.Equal(t, time.Second<<i, call.Duration)
+			call.MustRelease(ctx)
+			clk.Advance(call.Duration).MustWait(ctx)
+		}
+
+		info := waitForServiceState(ctx, t, handler, "crasher", workspacesdk.ServiceStateFailed)
+		require.Equal(t, workspacesdk.ServiceRestartOnFailure, info.Restart)
+		require.Equal(t, 2, info.Restarts)
+		require.Len(t, info.RecentRestarts, 2)
+		for _, restart := range info.RecentRestarts {
+			require.Equal(t, 3, restart.ExitCode)
+		}
+		require.NotNil(t, info.ExitCode)
+		require.Equal(t, 3, *info.ExitCode)
+
+		// Only the last two of the three runs are retained.
+		w = serviceRequest(t, handler, http.MethodGet, "/crasher/output", nil)
+		require.Equal(t, http.StatusOK, w.Code)
+		var output workspacesdk.ServiceOutputResponse
+		require.NoError(t, json.NewDecoder(w.Body).Decode(&output))
+		require.Len(t, output.Runs, 2)
+		for _, run := range output.Runs {
+			require.Contains(t, run.Output, "crashing")
+			require.NotNil(t, run.ExitCode)
+			require.Equal(t, 3, *run.ExitCode)
+		}
+	})
+
+	t.Run("AlwaysRestartsCleanExit", func(t *testing.T) {
+		t.Parallel()
+
+		ctx := testutil.Context(t, testutil.WaitLong)
+		clk := quartz.NewMock(t)
+		backoffTrap := clk.Trap().NewTimer("service", "backoff")
+		defer backoffTrap.Close()
+		handler, _ := newServiceTestAPI(t, clk)
+
+		w := serviceRequest(t, handler, http.MethodPost, "", workspacesdk.StartServiceRequest{
+			Name:        "oneshot",
+			Command:     "true",
+			Restart:     workspacesdk.ServiceRestartAlways,
+			MaxRestarts: 1,
+		})
+		require.Equal(t, http.StatusOK, w.Code, w.Body.String())
+
+		call := backoffTrap.MustWait(ctx)
+		call.MustRelease(ctx)
+		clk.Advance(call.Duration).MustWait(ctx)
+
+		info := waitForServiceState(ctx, t, handler, "oneshot", workspacesdk.ServiceStateFailed)
+		require.Equal(t, 1, info.Restarts)
+		require.Equal(t, 0, info.RecentRestarts[0].ExitCode)
+	})
+
+	t.Run("NeverRestarts", func(t *testing.T) {
+		t.Parallel()
+
+		ctx := testutil.Context(t, testutil.WaitLong)
+		handler, _ := newServiceTestAPI(t, quartz.NewMock(t))
+
+		w := serviceRequest(t, handler, http.MethodPost, "", workspacesdk.StartServiceRequest{
+			Name:    "once",
+			Command: "exit 1",
+			Restart: workspacesdk.ServiceRestartNever,
+		})
+		require.Equal(t, http.StatusOK, w.Code, w.Body.String())
+
+		info := waitForServiceState(ctx, t, handler, "once", workspacesdk.ServiceStateExited)
+		require.Zero(t, info.Restarts)
+		require.NotNil(t, info.ExitCode)
+		require.Equal(t, 1, *info.ExitCode)
+
+		// An exited service can be started again under the same
+		// name.
+		w = serviceRequest(t, handler, http.MethodPost, "", workspacesdk.StartServiceRequest{
+			Name:    "once",
+			Command: "exit 0",
+			Restart: workspacesdk.ServiceRestartNever,
+		})
+		require.Equal(t, http.StatusOK, w.Code, w.Body.String())
+		info = waitForServiceState(ctx, t, handler, "once", workspacesdk.ServiceStateExited)
+		require.Equal(t, 0, *info.ExitCode)
+	})
+
+	t.Run("ReadinessReportsAppStatus", func(t *testing.T) {
+		t.Parallel()
+
+		ctx := testutil.Context(t, testutil.WaitLong)
+		clk := quartz.NewMock(t)
+		tickerTrap := clk.Trap().TickerFunc("service", "readiness")
+		defer tickerTrap.Close()
+		handler, statuses := newServiceTestAPI(t, clk)
+
+		ln, err := net.Listen("tcp", "127.0.0.1:0")
+		require.NoError(t, err)
+		defer ln.Close()
+
+		w := serviceRequest(t, handler, http.MethodPost, "", workspacesdk.StartServiceRequest{
+			Name:    "server",
+			Command: "sleep 300",
+			Readiness: &workspacesdk.ServiceReadiness{
+				Healthcheck: codersdk.Healthcheck{
+					Address:  ln.Addr().String(),
+					Interval: 5,
+				},
+				AppSlug: "web",
+			},
+		})
+		require.Equal(t, http.StatusOK, w.Code, w.Body.String())
+
+		status := testutil.RequireReceive(ctx, t, statuses)
+		require.Equal(t, "web", status.AppSlug)
+		require.Equal(t, codersdk.WorkspaceAppStatusStateWorking, status.State)
+
+		tickerTrap.MustWait(ctx).MustRelease(ctx)
+		clk.Advance(5 * time.Second).MustWait(ctx)
+		status = testutil.RequireReceive(ctx, t, statuses)
+		require.Equal(t, codersdk.WorkspaceAppStatusStateIdle, status.State)
+		info := waitForServiceState(ctx, t, handler, "server", workspacesdk.ServiceStateRunning)
+		require.True(t, info.Ready)
+
+		// Once the port stops accepting connections the service is
+		// no longer ready.
+		require.NoError(t, ln.Close())
+		clk.Advance(5 * time.Second).MustWait(ctx)
+		status = testutil.RequireReceive(ctx, t, statuses)
+		require.Equal(t, codersdk.WorkspaceAppStatusStateFailure, status.State)
+		info = waitForServiceState(ctx, t, handler, "server", workspacesdk.ServiceStateRunning)
+		require.False(t, info.Ready)
+	})
+
+	t.Run("Stop", func(t *testing.T) {
+		t.Parallel()
+
+		ctx := testutil.Context(t, testutil.WaitLong)
+		handler, _ := newServiceTestAPI(t, quartz.NewMock(t))
+
+		w := serviceRequest(t, handler, http.MethodPost, "", workspacesdk.StartServiceRequest{
+			Name:    "sleeper",
+			Command: "sleep 300",
+			Restart: workspacesdk.ServiceRestartAlways,
+		})
+		require.Equal(t, http.StatusOK, w.Code, w.Body.String())
+
+		// Starting an active service again conflicts.
+		w = serviceRequest(t, handler, http.MethodPost, "", workspacesdk.StartServiceRequest{
+			Name:    "sleeper",
+			Command: "sleep 300",
+		})
+		require.Equal(t, http.StatusConflict, w.Code, w.Body.String())
+
+		w = serviceRequest(t, handler, http.MethodPost, "/sleeper/stop", nil)
+		require.Equal(t, http.StatusOK, w.Code, w.Body.String())
+		info := waitForServiceState(ctx, t, handler, "sleeper", workspacesdk.ServiceStateStopped)
+		require.Zero(t, info.Restarts)
+		require.False(t, info.Ready)
+	})
+
+	t.Run("NotFound", func(t *testing.T) {
+		t.Parallel()
+
+		handler, _ := newServiceTestAPI(t, quartz.NewMock(t))
+
+		w := serviceRequest(t, handler, http.MethodGet, "/missing/output", nil)
+		require.Equal(t, http.StatusNotFound, w.Code)
+		w = serviceRequest(t, handler, http.MethodPost, "/missing/stop", nil)
+		require.Equal(t, http.StatusNotFound, w.Code)
+	})
+
+	t.Run("Validation", func(t *testing.T) {
+		t.Parallel()
+
+		handler, _ := newServiceTestAPI(t, quartz.NewMock(t))
+
+		for _, req := range []workspacesdk.StartServiceRequest{
+			{Command: "true"},
+			{Name: "svc"},
+			{Name: "svc", Command: "true", Restart: "sometimes"},
+			{Name: "svc", Command: "true", MaxRestarts: -1},
+			{Name: "svc", Command: "true", Readiness: &workspacesdk.ServiceReadiness{
+				Healthcheck: codersdk.Healthcheck{Interval: 5},
+			}},
+			{Name: "svc", Command: "true", Readiness: &workspacesdk.ServiceReadiness{
+				Healthcheck: codersdk.Healthcheck{Address: "localhost:8080"},
+			}},
+		} {
+			w := serviceRequest(t, handler, http.MethodPost, "", req)
+			require.Equal(t, http.StatusBadRequest, w.Code, fmt.Sprintf("%+v", req))
+		}
+	})
+}
@@ -2,7 +2,11 @@ package agent
 
 import (
 	"context"
+	"errors"
+	"io"
+	"net"
 	"net/http"
+	"os/exec"
 	"sync"
 	"time"
 
@@ -18,12 +22,16 @@ import (
 // PostWorkspaceAgentAppHealth updates the workspace app health.
 type PostWorkspaceAgentAppHealth func(context.Context, agentsdk.PostAppHealthsRequest) error
 
+// CreateHealthcheckCommand creates the command run by an exec healthcheck.
+// The command must be canceled when the passed context is.
+type CreateHealthcheckCommand func(ctx context.Context, script string) (*exec.Cmd, error)
+
 // WorkspaceAppHealthReporter is a function that checks and reports the health of the workspace apps until the passed context is canceled.
 type WorkspaceAppHealthReporter func(ctx context.Context)
 
 // NewWorkspaceAppHealthReporter creates a WorkspaceAppHealthReporter that reports app health to coderd.
-func NewWorkspaceAppHealthReporter(logger slog.Logger, apps []codersdk.WorkspaceApp, postWorkspaceAgentAppHealth PostWorkspaceAgentAppHealth) WorkspaceAppHealthReporter {
-	return NewAppHealthReporterWithClock(logger, apps, postWorkspaceAgentAppHealth, quartz.NewReal())
+func NewWorkspaceAppHealthReporter(logger slog.Logger, apps []codersdk.WorkspaceApp, postWorkspaceAgentAppHealth PostWorkspaceAgentAppHealth, createCommand CreateHealthcheckCommand) WorkspaceAppHealthReporter {
+	return NewAppHealthReporterWithClock(logger, apps, postWorkspaceAgentAppHealth, createCommand, quartz.NewReal())
 }
 
 // NewAppHealthReporterWithClock is only called directly by test code.  Product code should call
@@ -32,6 +40,7 @@ func NewAppHealthReporterWithClock(
 	logger slog.Logger,
 	apps []codersdk.WorkspaceApp,
 	postWorkspaceAgentAppHealth PostWorkspaceAgentAppHealth,
+	createCommand CreateHealthcheckCommand,
 	clk quartz.Clock,
 ) WorkspaceAppHealthReporter {
 	logger = logger.Named("apphealth")
@@ -76,10 +85,10 @@ func NewAppHealthReporterWithClock(
 					// which makes the test easier to understand.
 					//
 					// It would be idiomatic to use the http.Client.Timeout or a context.WithTimeout,
-					// but we are passing this off to the native http library, which is not aware
-					// of the clock library we are using. That means in testing, with a mock clock
-					// it will compare mocked times with real times, and we will get strange results.
-					// So, we just implement the timeout as a context we cancel with an AfterFunc
+					// but we are passing this off to the native http, net and exec libraries, which
+					// are not aware of the clock library we are using. That means in testing, with a
+					// mock clock it will compare mocked times with real times, and we will get strange
+					// results. So, we just implement the timeout as a context we cancel with an AfterFunc
 					reqCtx, reqCancel := context.WithCancel(ctx)
 					timeout := clk.AfterFunc(
 						time.Duration(app.Healthcheck.Interval)*time.Second-time.Millisecond,
@@ -87,23 +96,15 @@ func NewAppHealthReporterWithClock(
 						"timeout", app.Slug)
 					defer timeout.Stop()
 
-					err := func() error {
-						req, err := http.NewRequestWithContext(reqCtx, http.MethodGet, app.Healthcheck.URL, nil)
-						if err != nil {
-							return err
-						}
-						res, err := client.Do(req)
-						if err != nil {
-							return err
-						}
-						// successful healthcheck is a non-5XX status code
-						_ = res.Body.Close()
-						if res.StatusCode >= http.StatusInternalServerError {
-							return xerrors.Errorf("error status code: %d", res.StatusCode)
-						}
-
-						return nil
-					}()
+					var err error
+					switch app.Healthcheck.Type() {
+					case codersdk.HealthcheckTypeHTTP:
+						err = checkHTTPHealth(reqCtx, client, app.Healthcheck.URL)
+					case codersdk.HealthcheckTypeTCP:
+						err = checkTCPHealth(reqCtx, app.Healthcheck.Address)
+					case codersdk.HealthcheckTypeExec:
+						err = checkExecHealth(reqCtx, createCommand, app.Healthcheck.Command)
+					}
 					if err != nil {
 						nowUnhealthy := false
 						mu.Lock()
@@ -165,7 +166,55 @@ func NewAppHealthReporterWithClock(
 }
 
 func shouldStartTicker(app codersdk.WorkspaceApp) bool {
-	return app.Healthcheck.URL != "" && app.Healthcheck.Interval > 0 && app.Healthcheck.Threshold > 0
+	return app.Healthcheck.Type() != "" && app.Healthcheck.Interval > 0 && app.Healthcheck.Threshold > 0
+}
+
+// checkHTTPHealth considers any response that isn't a 5XX status code
+// healthy.
+func checkHTTPHealth(ctx context.Context, client *http.Client, url string) error {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, url, nil)
+	if err != nil {
+		return err
+	}
+	res, err := client.Do(req)
+	if err != nil {
+		return err
+	}
+	_ = res.Body.Close()
+	if res.StatusCode >= http.StatusInternalServerError {
+		return xerrors.Errorf("error status code: %d", res.StatusCode)
+	}
+	return nil
+}
+
+// checkTCPHealth considers the app healthy if a connection to the address
+// can be established.
+func checkTCPHealth(ctx context.Context, address string) error {
+	var d net.Dialer
+	conn, err := d.DialContext(ctx, "tcp", address)
+	if err != nil {
+		return err
+	}
+	return conn.Close()
+}
+
+// checkExecHealth considers the app healthy if the command exits with
+// status 0.
+func checkExecHealth(ctx context.Context, createCommand CreateHealthcheckCommand, script string) error {
+	if createCommand == nil {
+		return xerrors.New("exec healthchecks are not supported")
+	}
+	cmd, err := createCommand(ctx, script)
+	if err != nil {
+		return xerrors.Errorf("create command: %w", err)
+	}
+	cmd.Stdin = io.LimitReader(nil, 0)
+	if err := cmd.Run(); err != nil {
+		// A canceled command exits with a misleading signal error, so
+		// include the context error.
+		return xerrors.Errorf("run command: %w", errors.Join(err, ctx.Err()))
+	}
+	return nil
 }
 
 func healthChanged(old map[uuid.UUID]codersdk.WorkspaceAppHealth, updated map[uuid.UUID]codersdk.WorkspaceAppHealth) bool {
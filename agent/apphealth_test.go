@@ -2,8 +2,11 @@ package agent_test
 
 import (
 	"context"
+	"net"
 	"net/http"
 	"net/http/httptest"
+	"os/exec"
+	"runtime"
 	"slices"
 	"strings"
 	"testing"
@@ -229,6 +232,125 @@ func TestAppHealth_Timeout(t *testing.T) {
 	require.Equal(t, codersdk.WorkspaceAppHealthUnhealthy, apps[0].Health)
 }
 
+func TestAppHealth_TCP(t *testing.T) {
+	t.Parallel()
+	ctx, cancel := context.WithTimeout(context.Background(), testutil.WaitShort)
+	defer cancel()
+
+	listening, err := net.Listen("tcp", "127.0.0.1:0")
+	require.NoError(t, err)
+	defer listening.Close()
+	go func() {
+		for {
+			conn, err := listening.Accept()
+			if err != nil {
+				return
+			}
+			_ = conn.Close()
+		}
+	}()
+	closed, err := net.Listen("tcp", "127.0.0.1:0")
+	require.NoError(t, err)
+	require.NoError(t, closed.Close())
+
+	apps := []codersdk.WorkspaceApp{
+		{
+			ID:   uuid.UUID{1},
+			Slug: "listening",
+			Healthcheck: codersdk.Healthcheck{
+				Address:   listening.Addr().String(),
+				Interval:  1,
+				Threshold: 1,
+			},
+			Health: codersdk.WorkspaceAppHealthInitializing,
+		},
+		{
+			ID:   uuid.UUID{2},
+			Slug: "closed",
+			Healthcheck: codersdk.Healthcheck{
+				Address:   closed.Addr().String(),
+				Interval:  1,
+				Threshold: 1,
+			},
+			Health: codersdk.WorkspaceAppHealthInitializing,
+		},
+	}
+	testAppHealthHealthyAndUnhealthy(ctx, t, apps)
+}
+
+func TestAppHealth_Exec(t *testing.T) {
+	t.Parallel()
+	if runtime.GOOS == "windows" {
+		t.Skip("The test commands use sh")
+	}
+	ctx, cancel := context.WithTimeout(context.Background(), testutil.WaitShort)
+	defer cancel()
+
+	apps := []codersdk.WorkspaceApp{
+		{
+			ID:   uuid.UUID{1},
+			Slug: "succeeds",
+			Healthcheck: codersdk.Healthcheck{
+				Command:   "exit 0",
+				Interval:  1,
+				Threshold: 1,
+			},
+			Health: codersdk.WorkspaceAppHealthInitializing,
+		},
+		{
+			ID:   uuid.UUID{2},
+			Slug: "fails",
+			Healthcheck: codersdk.Healthcheck{
+				Command:   "exit 1",
+				Interval:  1,
+				Threshold: 1,
+			},
+			Health: codersdk.WorkspaceAppHealthInitializing,
+		},
+	}
+	testAppHealthHealthyAndUnhealthy(ctx, t, apps)
+}
+
+// testAppHealthHealthyAndUnhealthy checks that the first app becomes healthy
+// after one check, and the second app becomes unhealthy once it crosses the
+// failure threshold of 1.
+func testAppHealthHealthyAndUnhealthy(ctx context.Context, t *testing.T, apps []codersdk.WorkspaceApp) {
+	t.Helper()
+	mClock := quartz.NewMock(t)
+	healthcheckTrap := mClock.Trap().TickerFunc("healthcheck")
+	defer healthcheckTrap.Close()
+	reportTrap := mClock.Trap().TickerFunc("report")
+	defer reportTrap.Close()
+
+	fakeAPI, closeFn := setupAppReporter(ctx, t, slices.Clone(apps), nil, mClock)
+	defer closeFn()
+	for range apps {
+		healthcheckTrap.MustWait(ctx).MustRelease(ctx)
+	}
+	// advance the clock 1ms before the report ticker starts, so that it's not
+	// simultaneous with the checks.
+	mClock.Advance(time.Millisecond).MustWait(ctx)
+	reportTrap.MustWait(ctx).MustRelease(ctx)
+
+	mClock.Advance(999 * time.Millisecond).MustWait(ctx) // 1st checks, first app is now healthy
+	mClock.Advance(time.Millisecond).MustWait(ctx)       // report gets triggered
+	update := testutil.TryReceive(ctx, t, fakeAPI.AppHealthCh())
+	applyUpdate(t, apps, update)
+	require.Equal(t, codersdk.WorkspaceAppHealthHealthy, apps[0].Health)
+	require.Equal(t, codersdk.WorkspaceAppHealthInitializing, apps[1].Health)
+
+	mClock.Advance(999 * time.Millisecond).MustWait(ctx) // 2nd checks, second app crosses threshold
+	mClock.Advance(time.Millisecond).MustWait(ctx)       // report gets triggered
+	update = testutil.TryReceive(ctx, t, fakeAPI.AppHealthCh())
+	applyUpdate(t, apps, update)
+	require.Equal(t, codersdk.WorkspaceAppHealthHealthy, apps[0].Health)
+	require.Equal(t, codersdk.WorkspaceAppHealthUnhealthy, apps[1].Health)
+}
+
+func shellHealthcheckCommand(ctx context.Context, script string) (*exec.Cmd, error) {
+	return exec.CommandContext(ctx, "sh", "-c", script), nil
+}
+
 func setupAppReporter(
 	ctx context.Context, t *testing.T,
 	apps []codersdk.WorkspaceApp,
@@ -260,7 +382,7 @@ func setupAppReporter(
 
 	go agent.NewAppHealthReporterWithClock(
 		testutil.Logger(t),
-		apps, agentsdk.AppHealthPoster(fakeAAPI), clk,
+		apps, agentsdk.AppHealthPoster(fakeAAPI), shellHealthcheckCommand, clk,
 	)(ctx)
 
 	return fakeAAPI, func() {
@@ -12,7 +12,9 @@ const (
 )
 
 type doc struct {
+	// path is normalized for matching, name keeps the original case.
 	path    string
+	name    string
 	baseOff int
 	baseLen int
 	depth   int
@@ -57,7 +59,7 @@ func (idx *Index) Add(path string, flags uint16) uint32 {
 	id := uint32(len(idx.docs)) //nolint:gosec // Index will never exceed 2^32 docs.
 	baseOff, baseLen := extractBasename([]byte(norm))
 	idx.docs = append(idx.docs, doc{
-		path: norm, baseOff: baseOff, baseLen: baseLen,
+		path: norm, name: strings.ReplaceAll(path, "\\", "/"), baseOff: baseOff, baseLen: baseLen,
 		depth: strings.Count(norm, "/"), flags: flags,
 	})
 	idx.byPath[norm] = id
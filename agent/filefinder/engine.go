@@ -21,6 +21,9 @@ import (
 type SearchOptions struct {
 	Limit         int
 	MaxCandidates int
+	// Roots restricts the search to the given roots. All roots are
+	// searched when empty.
+	Roots []string
 }
 
 // DefaultSearchOptions returns sensible default search options.
@@ -33,21 +36,29 @@ type rootSnapshot struct {
 	snap *Snapshot
 }
 
+// defaultMaxFiles bounds the size of a single root, so that adding a
+// huge directory such as "/" cannot exhaust the agent's memory.
+const defaultMaxFiles = 500_000
+
 // Engine is the main file finder. Safe for concurrent use.
 type Engine struct {
-	snap    atomic.Pointer[[]*rootSnapshot]
-	logger  slog.Logger
-	mu      sync.Mutex
-	roots   map[string]*rootState
-	eventCh chan rootEvent
-	closeCh chan struct{}
-	closed  atomic.Bool
-	wg      sync.WaitGroup
+	snap     atomic.Pointer[[]*rootSnapshot]
+	logger   slog.Logger
+	maxFiles int
+	mu       sync.Mutex
+	roots    map[string]*rootState
+	eventCh  chan rootEvent
+	closeCh  chan struct{}
+	closed   atomic.Bool
+	wg       sync.WaitGroup
+
+	subsMu sync.Mutex
+	subs   map[chan struct{}]struct{}
 }
 type rootState struct {
 	root    string
 	index   *Index
-	watcher *fsWatcher
+	watcher *FSWatcher
 	cancel  context.CancelFunc
 }
 type rootEvent struct {
@@ -55,14 +66,23 @@ type rootEvent struct {
 	events []FSEvent
 }
 
+// ErrTooManyFiles is returned when a root contains more files and
+// directories than the engine indexes.
+var ErrTooManyFiles = xerrors.New("too many files")
+
 // walkRoot performs a full filesystem walk of absRoot and returns
 // a populated Index containing all discovered files and directories.
-func walkRoot(absRoot string) (*Index, error) {
+// The walk fails with ErrTooManyFiles once more than maxFiles entries
+// are found, unless maxFiles is zero.
+func walkRoot(absRoot string, maxFiles int) (*Index, error) {
 	idx := NewIndex()
 	err := filepath.Walk(absRoot, func(path string, info os.FileInfo, walkErr error) error {
 		if walkErr != nil {
 			return nil //nolint:nilerr
 		}
+		if maxFiles > 0 && idx.Len() >= maxFiles {
+			return xerrors.Errorf("%w: %q has more than %d", ErrTooManyFiles, absRoot, maxFiles)
+		}
 		base := filepath.Base(path)
 		if _, skip := skipDirs[base]; skip && info.IsDir() {
 			return filepath.SkipDir
@@ -87,13 +107,29 @@ func walkRoot(absRoot string) (*Index, error) {
 	return idx, err
 }
 
+// EngineOption configures an Engine.
+type EngineOption func(*Engine)
+
+// WithMaxFiles sets how many files and directories a root may contain.
+// Adding or rebuilding a larger root fails with ErrTooManyFiles.
+func WithMaxFiles(maxFiles int) EngineOption {
+	return func(e *Engine) {
+		e.maxFiles = maxFiles
+	}
+}
+
 // NewEngine creates a new Engine.
-func NewEngine(logger slog.Logger) *Engine {
+func NewEngine(logger slog.Logger, opts ...EngineOption) *Engine {
 	e := &Engine{
-		logger:  logger,
-		roots:   make(map[string]*rootState),
-		eventCh: make(chan rootEvent, 256),
-		closeCh: make(chan struct{}),
+		logger:   logger,
+		maxFiles: defaultMaxFiles,
+		roots:    make(map[string]*rootState),
+		eventCh:  make(chan rootEvent, 256),
+		closeCh:  make(chan struct{}),
+		subs:     make(map[chan struct{}]struct{}),
+	}
+	for _, opt := range opts {
+		opt(e)
 	}
 	empty := make([]*rootSnapshot, 0)
 	e.snap.Store(&empty)
@@ -125,12 +161,12 @@ func (e *Engine) AddRoot(ctx context.Context, root string) error {
 
 	// Walk and create the watcher outside the lock to avoid
 	// blocking the event pipeline on filesystem I/O.
-	idx, walkErr := walkRoot(absRoot)
+	idx, walkErr := walkRoot(absRoot, e.maxFiles)
 	if walkErr != nil {
 		return xerrors.Errorf("walk root: %w", walkErr)
 	}
 	wCtx, wCancel := context.WithCancel(context.Background())
-	w, wErr := newFSWatcher(absRoot, e.logger)
+	w, wErr := NewFSWatcher(absRoot, e.logger)
 	if wErr != nil {
 		wCancel()
 		return xerrors.Errorf("create watcher: %w", wErr)
@@ -206,15 +242,70 @@ func (e *Engine) Search(_ context.Context, query string, opts SearchOptions) ([]
 	if opts.MaxCandidates <= 0 {
 		opts.MaxCandidates = 10000
 	}
+	var only map[string]struct{}
+	if len(opts.Roots) > 0 {
+		only = make(map[string]struct{}, len(opts.Roots))
+		for _, root := range opts.Roots {
+			absRoot, err := filepath.Abs(root)
+			if err != nil {
+				return nil, xerrors.Errorf("resolve root: %w", err)
+			}
+			only[absRoot] = struct{}{}
+		}
+	}
 	params := defaultScoreParams()
 	var allCands []candidate
 	for _, rs := range roots {
-		allCands = append(allCands, searchSnapshot(plan, rs.snap, opts.MaxCandidates)...)
+		if only != nil {
+			if _, ok := only[rs.root]; !ok {
+				continue
+			}
+		}
+		cands := searchSnapshot(plan, rs.snap, opts.MaxCandidates)
+		for i := range cands {
+			cands[i].Root = rs.root
+		}
+		allCands = append(allCands, cands...)
 	}
 	results := mergeAndScore(allCands, plan, params, opts.Limit)
 	return results, nil
 }
 
+// HasRoot reports whether root has been added to the engine.
+func (e *Engine) HasRoot(root string) bool {
+	absRoot, err := filepath.Abs(root)
+	if err != nil {
+		return false
+	}
+	e.mu.Lock()
+	defer e.mu.Unlock()
+	_, exists := e.roots[absRoot]
+	return exists
+}
+
+// Subscribe returns a channel that receives a value whenever the
+// index changes, and a function to unsubscribe. Notifications are
+// coalesced, so a slow receiver sees at most one pending value. The
+// channel is closed when the engine is closed.
+func (e *Engine) Subscribe() (<-chan struct{}, func()) {
+	ch := make(chan struct{}, 1)
+	e.subsMu.Lock()
+	if e.closed.Load() {
+		close(ch)
+	} else {
+		e.subs[ch] = struct{}{}
+	}
+	e.subsMu.Unlock()
+	var once sync.Once
+	return ch, func() {
+		once.Do(func() {
+			e.subsMu.Lock()
+			delete(e.subs, ch)
+			e.subsMu.Unlock()
+		})
+	}
+}
+
 // Close shuts down the engine.
 func (e *Engine) Close() error {
 	if e.closed.Swap(true) {
@@ -228,6 +319,12 @@ func (e *Engine) Close() error {
 	}
 	e.roots = make(map[string]*rootState)
 	e.mu.Unlock()
+	e.subsMu.Lock()
+	for ch := range e.subs {
+		close(ch)
+	}
+	e.subs = make(map[chan struct{}]struct{})
+	e.subsMu.Unlock()
 	e.wg.Wait()
 	return nil
 }
@@ -241,7 +338,7 @@ func (e *Engine) Rebuild(ctx context.Context, root string) error {
 
 	// Walk outside the lock to avoid blocking the event
 	// pipeline on potentially slow filesystem I/O.
-	idx, walkErr := walkRoot(absRoot)
+	idx, walkErr := walkRoot(absRoot, e.maxFiles)
 	if walkErr != nil {
 		return xerrors.Errorf("rebuild walk: %w", walkErr)
 	}
@@ -278,7 +375,7 @@ func (e *Engine) start() {
 	}
 }
 
-func (e *Engine) forwardEvents(ctx context.Context, root string, w *fsWatcher) {
+func (e *Engine) forwardEvents(ctx context.Context, root string, w *FSWatcher) {
 	defer e.wg.Done()
 	for {
 		select {
@@ -361,4 +458,13 @@ func (e *Engine) publishSnapshot() {
 		return strings.Compare(a.root, b.root)
 	})
 	e.snap.Store(&roots)
+
+	e.subsMu.Lock()
+	for ch := range e.subs {
+		select {
+		case ch <- struct{}{}:
+		default:
+		}
+	}
+	e.subsMu.Unlock()
 }
@@ -180,6 +180,23 @@ func TestEngine_AddRootIdempotent(t *testing.T) {
 	require.Equal(t, 1, snapLen, "expected exactly one root after duplicate add")
 }
 
+func TestEngine_MaxFiles(t *testing.T) {
+	t.Parallel()
+	dir := t.TempDir()
+	createFile(t, dir, "a.txt", "data")
+	createFile(t, dir, "b.txt", "data")
+	createFile(t, dir, "c.txt", "data")
+
+	logger := slogtest.Make(t, &slogtest.Options{IgnoreErrors: true}).Leveled(slog.LevelDebug)
+	ctx := context.Background()
+	eng := filefinder.NewEngine(logger, filefinder.WithMaxFiles(2))
+	t.Cleanup(func() { _ = eng.Close() })
+
+	err := eng.AddRoot(ctx, dir)
+	require.ErrorIs(t, err, filefinder.ErrTooManyFiles)
+	require.False(t, eng.HasRoot(dir))
+}
+
 func TestEngine_RemoveRoot(t *testing.T) {
 	t.Parallel()
 	dir := t.TempDir()
@@ -215,6 +232,64 @@ func TestEngine_Rebuild(t *testing.T) {
 	requireResultHasPath(t, results, "sneaky_rebuild.txt")
 }
 
+func TestEngine_ResultsKeepOriginalCase(t *testing.T) {
+	t.Parallel()
+	dir := t.TempDir()
+	createFile(t, dir, "Docs/ReadMe_Unique.md", "# hello")
+
+	eng, ctx := newTestEngine(t)
+	require.NoError(t, eng.AddRoot(ctx, dir))
+
+	results, err := eng.Search(ctx, "readme_unique", filefinder.DefaultSearchOptions())
+	require.NoError(t, err)
+	requireResultHasPath(t, results, "Docs/ReadMe_Unique.md")
+}
+
+func TestEngine_SearchRoots(t *testing.T) {
+	t.Parallel()
+	dir1 := t.TempDir()
+	dir2 := t.TempDir()
+	createFile(t, dir1, "shared_unique.go", "package one")
+	createFile(t, dir2, "shared_unique.go", "package two")
+
+	eng, ctx := newTestEngine(t)
+	require.NoError(t, eng.AddRoot(ctx, dir1))
+	require.NoError(t, eng.AddRoot(ctx, dir2))
+	require.True(t, eng.HasRoot(dir1))
+	require.False(t, eng.HasRoot(t.TempDir()))
+
+	results, err := eng.Search(ctx, "shared_unique", filefinder.DefaultSearchOptions())
+	require.NoError(t, err)
+	require.Len(t, results, 2)
+
+	opts := filefinder.DefaultSearchOptions()
+	opts.Roots = []string{dir2}
+	results, err = eng.Search(ctx, "shared_unique", opts)
+	require.NoError(t, err)
+	require.Len(t, results, 1)
+	require.Equal(t, dir2, results[0].Root)
+	require.Equal(t, "shared_unique.go", results[0].Path)
+}
+
+func TestEngine_Subscribe(t *testing.T) {
+	t.Parallel()
+	dir := t.TempDir()
+
+	eng, ctx := newTestEngine(t)
+	updates, unsubscribe := eng.Subscribe()
+	defer unsubscribe()
+
+	require.NoError(t, eng.AddRoot(ctx, dir))
+	testutil.TryReceive(testutil.Context(t, testutil.WaitShort), t, updates)
+
+	createFile(t, dir, "subscribed_unique.txt", "data")
+	require.Eventually(t, func() bool {
+		results, err := eng.Search(ctx, "subscribed_unique", filefinder.DefaultSearchOptions())
+		return err == nil && len(results) == 1
+	}, testutil.WaitShort, testutil.IntervalFast, "expected subscribed_unique.txt to appear via watcher")
+	testutil.TryReceive(testutil.Context(t, testutil.WaitShort), t, updates)
+}
+
 // createFile creates a file (and parent dirs) at relPath under dir.
 func createFile(t *testing.T, dir, relPath, content string) {
 	t.Helper()
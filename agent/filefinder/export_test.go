@@ -15,7 +15,7 @@ func MakeTestSnapshot(paths []string) *Snapshot {
 // BuildTestIndex walks root and returns a populated Index, the same
 // way Engine.AddRoot does but without starting a watcher.
 func BuildTestIndex(root string) (*Index, error) {
-	return walkRoot(root)
+	return walkRoot(root, 0)
 }
 
 // IndexIsDeleted reports whether the document at id is tombstoned.
@@ -7,8 +7,10 @@ import (
 )
 
 type candidate struct {
+	Root    string
 	DocID   uint32
 	Path    string
+	Name    string
 	BaseOff int
 	BaseLen int
 	Depth   int
@@ -17,6 +19,9 @@ type candidate struct {
 
 // Result is a scored search result returned to callers.
 type Result struct {
+	// Root is the absolute root the result was found in.
+	Root string
+	// Path is slash separated and relative to Root.
 	Path  string
 	Score float32
 	IsDir bool
@@ -116,7 +121,7 @@ func searchSnapshot(plan *queryPlan, snap *Snapshot, limit int) []candidate {
 		}
 		d := snap.docs[id]
 		cands = append(cands, candidate{
-			DocID: id, Path: d.path, BaseOff: d.baseOff,
+			DocID: id, Path: d.path, Name: d.name, BaseOff: d.baseOff,
 			BaseLen: d.baseLen, Depth: d.depth, Flags: d.flags,
 		})
 		if len(cands) >= limit {
@@ -259,7 +264,11 @@ func mergeAndScore(cands []candidate, plan *queryPlan, params scoreParams, topK
 				}
 			}
 		}
-		r := Result{Path: c.Path, Score: s, IsDir: c.Flags == uint16(FlagDir)}
+		name := c.Name
+		if name == "" {
+			name = c.Path
+		}
+		r := Result{Root: c.Root, Path: name, Score: s, IsDir: c.Flags == uint16(FlagDir)}
 		if h.Len() < topK {
 			heap.Push(h, r)
 		} else if s > (*h)[0].Score {
@@ -35,7 +35,10 @@ var skipDirs = map[string]struct{}{
 	"__pycache__": {}, ".cache": {}, ".venv": {}, "vendor": {}, ".terraform": {},
 }
 
-type fsWatcher struct {
+// FSWatcher watches a directory tree and reports changes in batches.
+// Well-known dependency and VCS directories such as .git and
+// node_modules are not watched.
+type FSWatcher struct {
 	w      *fsnotify.Watcher
 	root   string
 	events chan []FSEvent
@@ -45,12 +48,13 @@ type fsWatcher struct {
 	done   chan struct{}
 }
 
-func newFSWatcher(root string, logger slog.Logger) (*fsWatcher, error) {
+// NewFSWatcher creates a watcher for root. Call Start to begin watching.
+func NewFSWatcher(root string, logger slog.Logger) (*FSWatcher, error) {
 	w, err := fsnotify.NewWatcher()
 	if err != nil {
 		return nil, err
 	}
-	return &fsWatcher{
+	return &FSWatcher{
 		w:      w,
 		root:   root,
 		events: make(chan []FSEvent, 64),
@@ -59,7 +63,10 @@ func newFSWatcher(root string, logger slog.Logger) (*fsWatcher, error) {
 	}, nil
 }
 
-func (fw *fsWatcher) Start(ctx context.Context) {
+// Start watches root until ctx is canceled or the watcher is closed. The
+// existing files and directories are sent as a batch of OpCreate events
+// before Start returns, unless root is empty.
+func (fw *FSWatcher) Start(ctx context.Context) {
 	initEvents := fw.addRecursive(fw.root)
 	if len(initEvents) > 0 {
 		select {
@@ -71,8 +78,12 @@ func (fw *fsWatcher) Start(ctx context.Context) {
 	fw.logger.Debug(ctx, "fs watcher started", slog.F("root", fw.root))
 	go fw.loop(ctx)
 }
-func (fw *fsWatcher) Events() <-chan []FSEvent { return fw.events }
-func (fw *fsWatcher) Close() error {
+
+// Events returns the channel that batches of events are sent on.
+func (fw *FSWatcher) Events() <-chan []FSEvent { return fw.events }
+
+// Close stops the watcher. It must only be called after Start.
+func (fw *FSWatcher) Close() error {
 	fw.mu.Lock()
 	if fw.closed {
 		fw.mu.Unlock()
@@ -85,7 +96,7 @@ func (fw *fsWatcher) Close() error {
 	return err
 }
 
-func (fw *fsWatcher) loop(ctx context.Context) {
+func (fw *FSWatcher) loop(ctx context.Context) {
 	defer close(fw.done)
 	const batchWindow = 50 * time.Millisecond
 	var (
@@ -154,7 +165,7 @@ func (fw *fsWatcher) loop(ctx context.Context) {
 	}
 }
 
-func (fw *fsWatcher) addRecursive(dir string) []FSEvent {
+func (fw *FSWatcher) addRecursive(dir string) []FSEvent {
 	var events []FSEvent
 	if walkErr := filepath.Walk(dir, func(path string, info os.FileInfo, err error) error {
 		if err != nil {
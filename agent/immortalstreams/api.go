@@ -0,0 +1,179 @@
+package immortalstreams
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"io"
+	"net/http"
+	"strconv"
+
+	"github.com/go-chi/chi/v5"
+	"github.com/google/uuid"
+
+	"cdr.dev/slog/v3"
+	"github.com/coder/coder/v2/coderd/httpapi"
+	"github.com/coder/coder/v2/codersdk"
+	"github.com/coder/coder/v2/codersdk/workspacesdk"
+	"github.com/coder/websocket"
+)
+
+// API exposes immortal streams through the agent.
+type API struct {
+	logger  slog.Logger
+	manager *Manager
+}
+
+// NewAPI creates a new immortal streams API handler.
+func NewAPI(logger slog.Logger, manager *Manager) *API {
+	return &API{
+		logger:  logger,
+		manager: manager,
+	}
+}
+
+// Routes returns the HTTP handler for immortal stream routes.
+func (api *API) Routes() http.Handler {
+	r := chi.NewRouter()
+	r.Post("/", api.handleCreateStream)
+	r.Get("/", api.handleListStreams)
+	r.Get("/{id}", api.handleConnectStream)
+	r.Delete("/{id}", api.handleDeleteStream)
+	return r
+}
+
+func (api *API) handleCreateStream(rw http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	var req workspacesdk.CreateImmortalStreamRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		httpapi.Write(ctx, rw, http.StatusBadRequest, codersdk.Response{
+			Message: "Request body must be valid JSON.",
+			Detail:  err.Error(),
+		})
+		return
+	}
+	if req.TCPPort == 0 {
+		httpapi.Write(ctx, rw, http.StatusBadRequest, codersdk.Response{
+			Message: "TCP port is required.",
+		})
+		return
+	}
+
+	stream, err := api.manager.CreateStream(ctx, req.TCPPort)
+	if err != nil {
+		status := http.StatusBadGateway
+		switch {
+		case errors.Is(err, ErrTooManyStreams):
+			status = http.StatusServiceUnavailable
+		case errors.Is(err, ErrManagerIsClosed):
+			status = http.StatusGone
+		}
+		httpapi.Write(ctx, rw, status, codersdk.Response{
+			Message: "Failed to create immortal stream.",
+			Detail:  err.Error(),
+		})
+		return
+	}
+
+	httpapi.Write(ctx, rw, http.StatusCreated, stream.SDK())
+}
+
+func (api *API) handleListStreams(rw http.ResponseWriter, r *http.Request) {
+	httpapi.Write(r.Context(), rw, http.StatusOK, api.manager.ListStreams())
+}
+
+func (api *API) handleConnectStream(rw http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	id, ok := parseStreamID(rw, r)
+	if !ok {
+		return
+	}
+	remoteReaderSeqNum, err := strconv.ParseUint(r.Header.Get(workspacesdk.ImmortalStreamSequenceNumHeader), 10, 64)
+	if err != nil {
+		httpapi.Write(ctx, rw, http.StatusBadRequest, codersdk.Response{
+			Message: "Invalid sequence number header.",
+			Detail:  err.Error(),
+		})
+		return
+	}
+
+	stream, err := api.manager.GetStream(id)
+	if err != nil {
+		httpapi.Write(ctx, rw, http.StatusNotFound, codersdk.Response{
+			Message: "Immortal stream not found.",
+		})
+		return
+	}
+
+	accepted := false
+	done, err := stream.HandleConnection(remoteReaderSeqNum, func(streamCtx context.Context, readerSeqNum uint64) (io.ReadWriteCloser, error) {
+		rw.Header().Set(workspacesdk.ImmortalStreamSequenceNumHeader, strconv.FormatUint(readerSeqNum, 10))
+		accepted = true
+		conn, err := websocket.Accept(rw, r, &websocket.AcceptOptions{
+			CompressionMode: websocket.CompressionDisabled,
+		})
+		if err != nil {
+			return nil, err
+		}
+		conn.SetReadLimit(-1)
+		return websocket.NetConn(streamCtx, conn, websocket.MessageBinary), nil
+	})
+	if errors.Is(err, ErrStreamClosed) && !accepted {
+		// The client has everything the stream will ever send, so it is of no
+		// further use.
+		_ = api.manager.DeleteStream(id)
+		httpapi.Write(ctx, rw, http.StatusGone, codersdk.Response{
+			Message: "Immortal stream closed.",
+		})
+		return
+	}
+	if err != nil {
+		// If the WebSocket was accepted, the response has already been
+		// written.
+		if !accepted {
+			httpapi.Write(ctx, rw, http.StatusInternalServerError, codersdk.Response{
+				Message: "Failed to connect to immortal stream.",
+				Detail:  err.Error(),
+			})
+		}
+		api.logger.Debug(ctx, "connect to immortal stream", slog.F("stream_id", id), slog.Error(err))
+		return
+	}
+
+	// The connection is hijacked, so the handler only needs to stay alive
+	// until the stream is done with it.
+	<-done
+}
+
+func (api *API) handleDeleteStream(rw http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	id, ok := parseStreamID(rw, r)
+	if !ok {
+		return
+	}
+	if err := api.manager.DeleteStream(id); err != nil {
+		if errors.Is(err, ErrStreamNotFound) {
+			httpapi.Write(ctx, rw, http.StatusNotFound, codersdk.Response{
+				Message: "Immortal stream not found.",
+			})
+			return
+		}
+		api.logger.Debug(ctx, "close immortal stream", slog.F("stream_id", id), slog.Error(err))
+	}
+	rw.WriteHeader(http.StatusNoContent)
+}
+
+func parseStreamID(rw http.ResponseWriter, r *http.Request) (uuid.UUID, bool) {
+	id, err := uuid.Parse(chi.URLParam(r, "id"))
+	if err != nil {
+		httpapi.Write(r.Context(), rw, http.StatusBadRequest, codersdk.Response{
+			Message: "Invalid immortal stream ID.",
+			Detail:  err.Error(),
+		})
+		return uuid.Nil, false
+	}
+	return id, true
+}
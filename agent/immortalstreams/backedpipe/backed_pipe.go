@@ -95,6 +95,14 @@ type BackedPipe struct {
 // NewBackedPipe creates a new BackedPipe with default options and the specified reconnector.
 // The pipe starts disconnected and must be connected using Connect().
 func NewBackedPipe(ctx context.Context, reconnector Reconnector) *BackedPipe {
+	return NewBackedPipeWithBufferSize(ctx, reconnector, DefaultBufferSize)
+}
+
+// NewBackedPipeWithBufferSize creates a new BackedPipe whose writer keeps up to
+// bufferSize bytes for replay. Outbound data that has not been read by the
+// remote when more than bufferSize bytes have been written since cannot be
+// replayed, and reconnecting will fail.
+func NewBackedPipeWithBufferSize(ctx context.Context, reconnector Reconnector, bufferSize int) *BackedPipe {
 	pipeCtx, cancel := context.WithCancel(ctx)
 
 	errChan := make(chan ErrorEvent, 1)
@@ -110,7 +118,7 @@ func NewBackedPipe(ctx context.Context, reconnector Reconnector) *BackedPipe {
 
 	// Create reader and writer with typed error channel for generation-aware error reporting
 	bp.reader = NewBackedReader(errChan)
-	bp.writer = NewBackedWriter(DefaultBufferSize, errChan)
+	bp.writer = NewBackedWriter(bufferSize, errChan)
 
 	// Start error handler goroutine
 	go bp.handleErrors()
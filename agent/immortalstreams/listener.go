@@ -0,0 +1,72 @@
+package immortalstreams
+
+import (
+	"context"
+	"net"
+	"sync"
+)
+
+// PipeListener is an in-memory net.Listener. It lets streams connect to
+// servers in the agent, such as the SSH server, which are otherwise only
+// reachable over tailnet.
+type PipeListener struct {
+	conns     chan net.Conn
+	closed    chan struct{}
+	closeOnce sync.Once
+}
+
+var _ net.Listener = (*PipeListener)(nil)
+
+func NewPipeListener() *PipeListener {
+	return &PipeListener{
+		conns:  make(chan net.Conn),
+		closed: make(chan struct{}),
+	}
+}
+
+// Dial connects to the listener. It blocks until the connection is accepted.
+func (l *PipeListener) Dial(ctx context.Context) (net.Conn, error) {
+	client, server := net.Pipe()
+	select {
+	case <-ctx.Done():
+	case <-l.closed:
+	case l.conns <- server:
+		return client, nil
+	}
+	_ = client.Close()
+	_ = server.Close()
+	if ctx.Err() != nil {
+		return nil, ctx.Err()
+	}
+	return nil, net.ErrClosed
+}
+
+func (l *PipeListener) Accept() (net.Conn, error) {
+	select {
+	case <-l.closed:
+		return nil, net.ErrClosed
+	case conn := <-l.conns:
+		return conn, nil
+	}
+}
+
+func (l *PipeListener) Close() error {
+	l.closeOnce.Do(func() {
+		close(l.closed)
+	})
+	return nil
+}
+
+func (*PipeListener) Addr() net.Addr {
+	return pipeAddr{}
+}
+
+type pipeAddr struct{}
+
+func (pipeAddr) Network() string {
+	return "pipe"
+}
+
+func (pipeAddr) String() string {
+	return "immortal-streams"
+}
@@ -0,0 +1,220 @@
+// Package immortalstreams holds TCP connections to ports in the workspace open
+// on behalf of clients whose own connections to the agent may break, for
+// example when a laptop sleeps or changes networks. Clients reconnect to a
+// stream and both sides replay the bytes the other has not received.
+package immortalstreams
+
+import (
+	"context"
+	"net"
+	"sort"
+	"strconv"
+	"sync"
+	"time"
+
+	"github.com/google/uuid"
+	"golang.org/x/xerrors"
+
+	"cdr.dev/slog/v3"
+	"github.com/coder/coder/v2/codersdk/workspacesdk"
+	"github.com/coder/quartz"
+)
+
+const (
+	// DefaultMaxStreams is the default number of streams that may be open at
+	// once.
+	DefaultMaxStreams = 64
+	// DefaultDisconnectedTimeout is the default time a stream is kept without
+	// a client connection before it is closed.
+	DefaultDisconnectedTimeout = 8 * time.Hour
+
+	reapInterval = time.Minute
+)
+
+var (
+	ErrStreamNotFound  = xerrors.New("stream not found")
+	ErrTooManyStreams  = xerrors.New("too many streams")
+	ErrManagerIsClosed = xerrors.New("manager is closed")
+)
+
+// Dialer connects to a TCP port in the workspace.
+type Dialer func(ctx context.Context, port uint16) (net.Conn, error)
+
+// Options configures a Manager.
+type Options struct {
+	// Dialer connects streams to their ports. Defaults to dialing
+	// localhost.
+	Dialer Dialer
+	// MaxStreams defaults to DefaultMaxStreams.
+	MaxStreams int
+	// DisconnectedTimeout defaults to DefaultDisconnectedTimeout.
+	DisconnectedTimeout time.Duration
+	Clock               quartz.Clock
+}
+
+// Manager tracks the agent's immortal streams.
+type Manager struct {
+	logger slog.Logger
+	opts   Options
+
+	ctx    context.Context
+	cancel context.CancelFunc
+
+	mu      sync.Mutex
+	streams map[uuid.UUID]*Stream
+	closed  bool
+}
+
+// NewManager creates a Manager and starts closing streams that have been
+// disconnected for longer than the configured timeout.
+func NewManager(logger slog.Logger, opts Options) *Manager {
+	if opts.Dialer == nil {
+		opts.Dialer = DialLocalhost
+	}
+	if opts.MaxStreams <= 0 {
+		opts.MaxStreams = DefaultMaxStreams
+	}
+	if opts.DisconnectedTimeout <= 0 {
+		opts.DisconnectedTimeout = DefaultDisconnectedTimeout
+	}
+	if opts.Clock == nil {
+		opts.Clock = quartz.NewReal()
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	m := &Manager{
+		logger:  logger,
+		opts:    opts,
+		ctx:     ctx,
+		cancel:  cancel,
+		streams: make(map[uuid.UUID]*Stream),
+	}
+	opts.Clock.TickerFunc(ctx, reapInterval, func() error {
+		m.reapDisconnected()
+		return nil
+	}, "immortalstreams", "reap")
+	return m
+}
+
+// DialLocalhost dials a TCP port on the loopback interface.
+func DialLocalhost(ctx context.Context, port uint16) (net.Conn, error) {
+	var d net.Dialer
+	return d.DialContext(ctx, "tcp", net.JoinHostPort("127.0.0.1", strconv.Itoa(int(port))))
+}
+
+// CreateStream connects to a port and starts holding the connection open as
+// a stream.
+func (m *Manager) CreateStream(ctx context.Context, port uint16) (*Stream, error) {
+	m.mu.Lock()
+	if m.closed {
+		m.mu.Unlock()
+		return nil, ErrManagerIsClosed
+	}
+	if len(m.streams) >= m.opts.MaxStreams {
+		m.mu.Unlock()
+		return nil, ErrTooManyStreams
+	}
+	m.mu.Unlock()
+
+	local, err := m.opts.Dialer(ctx, port)
+	if err != nil {
+		return nil, xerrors.Errorf("dial port %d: %w", port, err)
+	}
+
+	id := uuid.New()
+	stream := newStream(id, port, local, m.logger.With(slog.F("stream_id", id), slog.F("tcp_port", port)), m.opts.Clock)
+
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	// Checked again, since the lock is not held while dialing.
+	if m.closed || len(m.streams) >= m.opts.MaxStreams {
+		_ = stream.Close()
+		if m.closed {
+			return nil, ErrManagerIsClosed
+		}
+		return nil, ErrTooManyStreams
+	}
+	m.streams[id] = stream
+	m.logger.Debug(ctx, "created stream", slog.F("stream_id", id), slog.F("tcp_port", port))
+	return stream, nil
+}
+
+// GetStream returns a stream by ID.
+func (m *Manager) GetStream(id uuid.UUID) (*Stream, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	stream, ok := m.streams[id]
+	if !ok {
+		return nil, ErrStreamNotFound
+	}
+	return stream, nil
+}
+
+// ListStreams returns all streams, oldest first.
+func (m *Manager) ListStreams() []workspacesdk.ImmortalStream {
+	m.mu.Lock()
+	streams := make([]*Stream, 0, len(m.streams))
+	for _, stream := range m.streams {
+		streams = append(streams, stream)
+	}
+	m.mu.Unlock()
+
+	out := make([]workspacesdk.ImmortalStream, 0, len(streams))
+	for _, stream := range streams {
+		out = append(out, stream.SDK())
+	}
+	sort.Slice(out, func(i, j int) bool {
+		return out[i].CreatedAt.Before(out[j].CreatedAt)
+	})
+	return out
+}
+
+// DeleteStream closes a stream and forgets it.
+func (m *Manager) DeleteStream(id uuid.UUID) error {
+	m.mu.Lock()
+	stream, ok := m.streams[id]
+	delete(m.streams, id)
+	m.mu.Unlock()
+	if !ok {
+		return ErrStreamNotFound
+	}
+	return stream.Close()
+}
+
+func (m *Manager) reapDisconnected() {
+	now := m.opts.Clock.Now()
+	m.mu.Lock()
+	var expired []*Stream
+	for id, stream := range m.streams {
+		since, disconnected := stream.disconnectedSince()
+		if disconnected && now.Sub(since) >= m.opts.DisconnectedTimeout {
+			expired = append(expired, stream)
+			delete(m.streams, id)
+		}
+	}
+	m.mu.Unlock()
+
+	for _, stream := range expired {
+		m.logger.Info(m.ctx, "closing disconnected stream", slog.F("stream_id", stream.ID()))
+		_ = stream.Close()
+	}
+}
+
+// Close closes all streams.
+func (m *Manager) Close() error {
+	m.mu.Lock()
+	if m.closed {
+		m.mu.Unlock()
+		return nil
+	}
+	m.closed = true
+	streams := m.streams
+	m.streams = make(map[uuid.UUID]*Stream)
+	m.mu.Unlock()
+
+	m.cancel()
+	for _, stream := range streams {
+		_ = stream.Close()
+	}
+	return nil
+}
@@ -0,0 +1,309 @@
+package immortalstreams
+
+import (
+	"context"
+	"io"
+	"net"
+	"sync"
+	"time"
+
+	"github.com/google/uuid"
+	"golang.org/x/xerrors"
+
+	"cdr.dev/slog/v3"
+	"github.com/coder/coder/v2/agent/immortalstreams/backedpipe"
+	"github.com/coder/coder/v2/codersdk/workspacesdk"
+	"github.com/coder/quartz"
+)
+
+// ErrStreamClosed is returned when connecting to a stream that has been
+// closed, or whose local connection has ended and whose output has been fully
+// received by the client.
+var ErrStreamClosed = xerrors.New("stream closed")
+
+// AcceptFunc completes a client's connection to a stream. It is given the
+// stream's reader sequence number, which must be sent to the client so it can
+// replay the bytes the stream has not received. The returned connection must
+// not be bound to a request context, since it outlives the request.
+type AcceptFunc func(ctx context.Context, readerSeqNum uint64) (io.ReadWriteCloser, error)
+
+// Stream is a connection to a local TCP port that outlives the client
+// connections carrying it. Bytes sent to the client are buffered so that they
+// can be replayed when it reconnects, and the client does the same in the other
+// direction.
+//
+// Unlike the client, which drives reconnection with a BackedPipe, the stream is
+// passive: it waits for the client to come back, so it orchestrates the backed
+// reader and writer itself.
+type Stream struct {
+	id        uuid.UUID
+	port      uint16
+	createdAt time.Time
+	logger    slog.Logger
+	clock     quartz.Clock
+
+	ctx    context.Context
+	cancel context.CancelFunc
+	local  net.Conn
+	reader *backedpipe.BackedReader
+	writer *backedpipe.BackedWriter
+	errs   chan backedpipe.ErrorEvent
+
+	// connectMu serializes client connections.
+	connectMu sync.Mutex
+
+	mu sync.Mutex
+	// conn is the current client connection, nil when disconnected.
+	conn *clientConn
+	// gen is incremented for every client connection, so errors from old
+	// connections can be told apart.
+	gen            uint64
+	disconnectedAt time.Time
+	// ended is set when the local connection has closed. The stream is kept
+	// until the client has received everything that was read from it.
+	ended  bool
+	closed bool
+}
+
+func newStream(id uuid.UUID, port uint16, local net.Conn, logger slog.Logger, clock quartz.Clock) *Stream {
+	errs := make(chan backedpipe.ErrorEvent, 1)
+	ctx, cancel := context.WithCancel(context.Background())
+	s := &Stream{
+		id:             id,
+		port:           port,
+		createdAt:      clock.Now(),
+		logger:         logger,
+		clock:          clock,
+		ctx:            ctx,
+		cancel:         cancel,
+		local:          local,
+		reader:         backedpipe.NewBackedReader(errs),
+		writer:         backedpipe.NewBackedWriter(workspacesdk.ImmortalStreamBufferSize, errs),
+		errs:           errs,
+		disconnectedAt: clock.Now(),
+	}
+	go s.handleErrors()
+	go s.copyToLocal()
+	go s.copyFromLocal()
+	return s
+}
+
+// ID returns the stream's ID.
+func (s *Stream) ID() uuid.UUID {
+	return s.id
+}
+
+// SDK returns the stream's state as an SDK type.
+func (s *Stream) SDK() workspacesdk.ImmortalStream {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	stream := workspacesdk.ImmortalStream{
+		ID:        s.id,
+		TCPPort:   s.port,
+		CreatedAt: s.createdAt,
+		Connected: s.conn != nil,
+	}
+	if s.conn == nil {
+		disconnectedAt := s.disconnectedAt
+		stream.LastDisconnectedAt = &disconnectedAt
+	}
+	return stream
+}
+
+// disconnectedSince returns when the stream lost its client, and whether it
+// is disconnected at all.
+func (s *Stream) disconnectedSince() (time.Time, bool) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.disconnectedAt, s.conn == nil
+}
+
+// HandleConnection replaces the stream's client connection. The reader
+// sequence number sent by the client determines which buffered bytes are
+// replayed to it. accept is called once the stream is ready to use the new
+// connection. The returned channel is closed when the connection is replaced,
+// fails, or the stream is closed.
+func (s *Stream) HandleConnection(remoteReaderSeqNum uint64, accept AcceptFunc) (<-chan struct{}, error) {
+	s.connectMu.Lock()
+	defer s.connectMu.Unlock()
+
+	s.mu.Lock()
+	if s.closed {
+		s.mu.Unlock()
+		return nil, ErrStreamClosed
+	}
+	// Nothing is written once the local connection has ended, so reading the
+	// writer's sequence number cannot block.
+	if s.ended && remoteReaderSeqNum >= s.writer.SequenceNum() {
+		s.mu.Unlock()
+		return nil, ErrStreamClosed
+	}
+	old := s.conn
+	s.conn = nil
+	s.gen++
+	gen := s.gen
+	s.mu.Unlock()
+
+	// The old connection must be closed before reconnecting the reader and
+	// writer, since either may be blocked on it while holding its lock.
+	if old != nil {
+		_ = old.Close()
+	}
+	s.reader.SetGeneration(gen)
+	s.writer.SetGeneration(gen)
+
+	seqNum := make(chan uint64, 1)
+	newReader := make(chan io.Reader, 1)
+	go s.reader.Reconnect(seqNum, newReader)
+	readerSeqNum, ok := <-seqNum
+	if !ok {
+		return nil, ErrStreamClosed
+	}
+
+	rwc, err := accept(s.ctx, readerSeqNum)
+	if err != nil {
+		newReader <- nil
+		return nil, xerrors.Errorf("accept connection: %w", err)
+	}
+	conn := newClientConn(rwc)
+
+	// Set the connection before handing it to the reader and writer, so
+	// errors they report on it are not ignored.
+	s.mu.Lock()
+	if s.closed {
+		s.mu.Unlock()
+		newReader <- nil
+		_ = conn.Close()
+		return nil, ErrStreamClosed
+	}
+	s.conn = conn
+	ended := s.ended
+	s.mu.Unlock()
+	newReader <- conn
+
+	err = s.writer.Reconnect(remoteReaderSeqNum, conn)
+	if err != nil {
+		s.mu.Lock()
+		if s.conn == conn {
+			s.conn = nil
+			s.disconnectedAt = s.clock.Now()
+		}
+		s.mu.Unlock()
+		_ = conn.Close()
+		return nil, xerrors.Errorf("replay to client: %w", err)
+	}
+
+	if ended {
+		// The replay was the last of the output. Closing tells the client
+		// to reconnect, at which point it learns the stream is closed.
+		_ = conn.Close()
+	}
+	s.logger.Debug(s.ctx, "client connected",
+		slog.F("generation", gen),
+		slog.F("reader_seq_num", readerSeqNum),
+		slog.F("remote_reader_seq_num", remoteReaderSeqNum),
+	)
+	return conn.done, nil
+}
+
+// handleErrors drops the client connection when reading from or writing to it
+// fails. The stream then waits for the client to reconnect.
+func (s *Stream) handleErrors() {
+	for {
+		select {
+		case <-s.ctx.Done():
+			return
+		case ev := <-s.errs:
+			s.mu.Lock()
+			if ev.Generation != s.gen || s.conn == nil {
+				s.mu.Unlock()
+				continue
+			}
+			conn := s.conn
+			s.conn = nil
+			s.disconnectedAt = s.clock.Now()
+			s.mu.Unlock()
+
+			_ = conn.Close()
+			s.logger.Debug(s.ctx, "client disconnected",
+				slog.F("component", ev.Component),
+				slog.F("generation", ev.Generation),
+				slog.Error(ev.Err),
+			)
+		}
+	}
+}
+
+// copyToLocal copies bytes from the client to the local connection.
+func (s *Stream) copyToLocal() {
+	_, err := io.Copy(s.local, s.reader)
+	if err != nil && s.ctx.Err() == nil {
+		s.logger.Debug(s.ctx, "copy to local connection", slog.Error(err))
+	}
+	// Unblock copyFromLocal so the stream ends.
+	_ = s.local.Close()
+}
+
+// copyFromLocal copies bytes from the local connection to the client. Writes
+// block while the client is disconnected.
+func (s *Stream) copyFromLocal() {
+	_, err := io.Copy(s.writer, s.local)
+	if err != nil && s.ctx.Err() == nil {
+		s.logger.Debug(s.ctx, "copy from local connection", slog.Error(err))
+	}
+
+	s.mu.Lock()
+	s.ended = true
+	conn := s.conn
+	s.mu.Unlock()
+	// Everything has been written to the connection, so closing it gracefully
+	// delivers the rest of the output before the client sees the end.
+	if conn != nil {
+		_ = conn.Close()
+	}
+	s.logger.Debug(s.ctx, "local connection ended")
+}
+
+// Close closes the stream, its local connection and any client connection.
+func (s *Stream) Close() error {
+	s.mu.Lock()
+	if s.closed {
+		s.mu.Unlock()
+		return nil
+	}
+	s.closed = true
+	conn := s.conn
+	s.conn = nil
+	s.mu.Unlock()
+
+	s.cancel()
+	// Close connections first to unblock reads and writes holding the
+	// reader's and writer's locks.
+	if conn != nil {
+		_ = conn.Close()
+	}
+	err := s.local.Close()
+	_ = s.reader.Close()
+	_ = s.writer.Close()
+	return err
+}
+
+// clientConn signals when a client connection is closed.
+type clientConn struct {
+	io.ReadWriteCloser
+	once sync.Once
+	done chan struct{}
+}
+
+func newClientConn(rwc io.ReadWriteCloser) *clientConn {
+	return &clientConn{ReadWriteCloser: rwc, done: make(chan struct{})}
+}
+
+func (c *clientConn) Close() error {
+	var err error
+	c.once.Do(func() {
+		err = c.ReadWriteCloser.Close()
+		close(c.done)
+	})
+	return err
+}
@@ -0,0 +1,233 @@
+package immortalstreams_test
+
+import (
+	"context"
+	"io"
+	"net"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"cdr.dev/slog/v3/sloggers/slogtest"
+	"github.com/coder/coder/v2/agent/immortalstreams"
+	"github.com/coder/coder/v2/agent/immortalstreams/backedpipe"
+	"github.com/coder/coder/v2/testutil"
+	"github.com/coder/quartz"
+)
+
+// lossyConn silently discards writes while dropping is set, like a network
+// that has failed without either side noticing yet.
+type lossyConn struct {
+	net.Conn
+
+	mu       sync.Mutex
+	dropping bool
+}
+
+func (c *lossyConn) Write(p []byte) (int, error) {
+	c.mu.Lock()
+	dropping := c.dropping
+	c.mu.Unlock()
+	if dropping {
+		return len(p), nil
+	}
+	return c.Conn.Write(p)
+}
+
+func (c *lossyConn) drop() {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.dropping = true
+}
+
+// streamReconnector connects a client pipe directly to a stream, in place of
+// the agent's HTTP API.
+type streamReconnector struct {
+	stream *immortalstreams.Stream
+	conns  chan *lossyConn
+}
+
+func (r *streamReconnector) Reconnect(ctx context.Context, readerSeqNum uint64) (io.ReadWriteCloser, uint64, error) {
+	client, server := net.Pipe()
+	agentSide := &lossyConn{Conn: server}
+	remoteSeqNum := make(chan uint64, 1)
+	errCh := make(chan error, 1)
+	go func() {
+		_, err := r.stream.HandleConnection(readerSeqNum, func(_ context.Context, seqNum uint64) (io.ReadWriteCloser, error) {
+			remoteSeqNum <- seqNum
+			return agentSide, nil
+		})
+		errCh <- err
+	}()
+	select {
+	case <-ctx.Done():
+		return nil, 0, ctx.Err()
+	case err := <-errCh:
+		return nil, 0, err
+	case seqNum := <-remoteSeqNum:
+		r.conns <- agentSide
+		return client, seqNum, nil
+	}
+}
+
+func newTestStream(t *testing.T) (*immortalstreams.Manager, *immortalstreams.Stream, net.Conn) {
+	t.Helper()
+	ctx := testutil.Context(t, testutil.WaitShort)
+	logger := slogtest.Make(t, nil)
+
+	local, workspace := net.Pipe()
+	mgr := immortalstreams.NewManager(logger, immortalstreams.Options{
+		Dialer: func(context.Context, uint16) (net.Conn, error) {
+			return local, nil
+		},
+		Clock: quartz.NewMock(t),
+	})
+	t.Cleanup(func() { _ = mgr.Close() })
+
+	stream, err := mgr.CreateStream(ctx, 8080)
+	require.NoError(t, err)
+	return mgr, stream, workspace
+}
+
+func readN(t *testing.T, r io.Reader, n int) string {
+	t.Helper()
+	buf := make([]byte, n)
+	_, err := io.ReadFull(r, buf)
+	require.NoError(t, err)
+	return string(buf)
+}
+
+func TestStream(t *testing.T) {
+	t.Parallel()
+
+	t.Run("ReplayAfterReconnect", func(t *testing.T) {
+		t.Parallel()
+		ctx := testutil.Context(t, testutil.WaitShort)
+		_, stream, workspace := newTestStream(t)
+
+		reconnector := &streamReconnector{stream: stream, conns: make(chan *lossyConn, 2)}
+		pipe := backedpipe.NewBackedPipe(ctx, reconnector)
+		defer pipe.Close()
+		require.NoError(t, pipe.Connect())
+		agentSide := testutil.RequireReceive(ctx, t, reconnector.conns)
+
+		_, err := pipe.Write([]byte("hello"))
+		require.NoError(t, err)
+		require.Equal(t, "hello", readN(t, workspace, 5))
+
+		_, err = workspace.Write([]byte("world"))
+		require.NoError(t, err)
+		require.Equal(t, "world", readN(t, pipe, 5))
+		require.True(t, stream.SDK().Connected)
+
+		// Lose output in transit, then break the connection. The pipe only
+		// notices while reading or writing.
+		agentSide.drop()
+		_, err = workspace.Write([]byte("lost"))
+		require.NoError(t, err)
+		read := make(chan string, 1)
+		go func() {
+			buf := make([]byte, 4)
+			_, _ = io.ReadFull(pipe, buf)
+			read <- string(buf)
+		}()
+		_ = agentSide.Close()
+
+		// The pipe reconnects and the stream replays what was lost.
+		_ = testutil.RequireReceive(ctx, t, reconnector.conns)
+		require.Equal(t, "lost", testutil.RequireReceive(ctx, t, read))
+
+		_, err = pipe.Write([]byte("again"))
+		require.NoError(t, err)
+		require.Equal(t, "again", readN(t, workspace, 5))
+	})
+
+	t.Run("ClosedAfterDrain", func(t *testing.T) {
+		t.Parallel()
+		_, stream, workspace := newTestStream(t)
+
+		client, server := net.Pipe()
+		go func() {
+			_, _ = stream.HandleConnection(0, func(context.Context, uint64) (io.ReadWriteCloser, error) {
+				return server, nil
+			})
+		}()
+
+		_, err := workspace.Write([]byte("bye"))
+		require.NoError(t, err)
+		require.NoError(t, workspace.Close())
+
+		// The stream closes the connection once the output is delivered.
+		out, err := io.ReadAll(client)
+		require.NoError(t, err)
+		require.Equal(t, "bye", string(out))
+
+		// Reconnecting with everything received ends the stream.
+		_, err = stream.HandleConnection(3, func(context.Context, uint64) (io.ReadWriteCloser, error) {
+			t.Error("connection should not be accepted")
+			return nil, io.EOF
+		})
+		require.ErrorIs(t, err, immortalstreams.ErrStreamClosed)
+	})
+}
+
+func TestManager(t *testing.T) {
+	t.Parallel()
+
+	t.Run("MaxStreams", func(t *testing.T) {
+		t.Parallel()
+		ctx := testutil.Context(t, testutil.WaitShort)
+		logger := slogtest.Make(t, nil)
+
+		mgr := immortalstreams.NewManager(logger, immortalstreams.Options{
+			Dialer: func(context.Context, uint16) (net.Conn, error) {
+				local, _ := net.Pipe()
+				return local, nil
+			},
+			MaxStreams: 1,
+			Clock:      quartz.NewMock(t),
+		})
+		defer mgr.Close()
+
+		stream, err := mgr.CreateStream(ctx, 8080)
+		require.NoError(t, err)
+		_, err = mgr.CreateStream(ctx, 8080)
+		require.ErrorIs(t, err, immortalstreams.ErrTooManyStreams)
+
+		require.Len(t, mgr.ListStreams(), 1)
+		require.NoError(t, mgr.DeleteStream(stream.ID()))
+		require.ErrorIs(t, mgr.DeleteStream(stream.ID()), immortalstreams.ErrStreamNotFound)
+		_, err = mgr.CreateStream(ctx, 8080)
+		require.NoError(t, err)
+	})
+
+	t.Run("ReapDisconnected", func(t *testing.T) {
+		t.Parallel()
+		ctx := testutil.Context(t, testutil.WaitShort)
+		logger := slogtest.Make(t, nil)
+		clock := quartz.NewMock(t)
+
+		mgr := immortalstreams.NewManager(logger, immortalstreams.Options{
+			Dialer: func(context.Context, uint16) (net.Conn, error) {
+				local, _ := net.Pipe()
+				return local, nil
+			},
+			DisconnectedTimeout: 2 * time.Minute,
+			Clock:               clock,
+		})
+		defer mgr.Close()
+
+		stream, err := mgr.CreateStream(ctx, 8080)
+		require.NoError(t, err)
+
+		clock.Advance(time.Minute).MustWait(ctx)
+		_, err = mgr.GetStream(stream.ID())
+		require.NoError(t, err)
+
+		clock.Advance(time.Minute).MustWait(ctx)
+		_, err = mgr.GetStream(stream.ID())
+		require.ErrorIs(t, err, immortalstreams.ErrStreamNotFound)
+	})
+}
@@ -1,6 +1,10 @@
 package resourcesmonitor
 
 import (
+	"strconv"
+	"strings"
+
+	"github.com/spf13/afero"
 	"golang.org/x/xerrors"
 
 	"github.com/coder/clistat"
@@ -10,27 +14,52 @@ type Statter interface {
 	IsContainerized() (bool, error)
 	ContainerMemory(p clistat.Prefix) (*clistat.Result, error)
 	HostMemory(p clistat.Prefix) (*clistat.Result, error)
+	ContainerCPU() (*clistat.Result, error)
+	HostCPU() (*clistat.Result, error)
 	Disk(p clistat.Prefix, path string) (*clistat.Result, error)
 }
 
 type Fetcher interface {
 	FetchMemory() (total int64, used int64, err error)
 	FetchVolume(volume string) (total int64, used int64, err error)
+	// FetchCPU returns the number of cores available and in use.
+	FetchCPU() (total float64, used float64, err error)
+	// FetchProcesses returns the maximum and current number of tasks
+	// (processes and threads).
+	FetchProcesses() (total int64, used int64, err error)
+	// FetchInodes returns the total and used number of inodes of the
+	// filesystem of the volume.
+	FetchInodes(volume string) (total int64, used int64, err error)
+}
+
+type FetcherOption func(*fetcher)
+
+// WithFS sets the filesystem that process counts and limits are read
+// from. Defaults to the OS filesystem.
+func WithFS(fs afero.Fs) FetcherOption {
+	return func(f *fetcher) {
+		f.fs = fs
+	}
 }
 
 type fetcher struct {
 	Statter
 	isContainerized bool
+	fs              afero.Fs
 }
 
 //nolint:revive
-func NewFetcher(f Statter) (*fetcher, error) {
+func NewFetcher(f Statter, opts ...FetcherOption) (*fetcher, error) {
 	isContainerized, err := f.IsContainerized()
 	if err != nil {
 		return nil, xerrors.Errorf("check is containerized: %w", err)
 	}
 
-	return &fetcher{f, isContainerized}, nil
+	ft := &fetcher{Statter: f, isContainerized: isContainerized, fs: afero.NewOsFs()}
+	for _, opt := range opts {
+		opt(ft)
+	}
+	return ft, nil
 }
 
 func (f *fetcher) FetchMemory() (total int64, used int64, err error) {
@@ -79,3 +108,108 @@ func (f *fetcher) FetchVolume(volume string) (total int64, used int64, err error
 
 	return int64(*vol.Total), int64(vol.Used), nil
 }
+
+func (f *fetcher) FetchCPU() (total float64, used float64, err error) {
+	var cpu *clistat.Result
+
+	if f.isContainerized {
+		cpu, err = f.ContainerCPU()
+		if err != nil {
+			return 0, 0, xerrors.Errorf("fetch container cpu: %w", err)
+		}
+
+		// As with memory, fallback to the number of host cores when
+		// the container does not have a CPU limit.
+		if cpu != nil && cpu.Total == nil {
+			hostCPU, err := f.HostCPU()
+			if err != nil {
+				return 0, 0, xerrors.Errorf("fetch host cpu: %w", err)
+			}
+
+			cpu.Total = hostCPU.Total
+		}
+	}
+
+	if cpu == nil {
+		cpu, err = f.HostCPU()
+		if err != nil {
+			return 0, 0, xerrors.Errorf("fetch host cpu: %w", err)
+		}
+	}
+
+	if cpu.Total == nil {
+		return 0, 0, xerrors.New("cpu total is nil - can not fetch cpu")
+	}
+
+	return *cpu.Total, cpu.Used, nil
+}
+
+const (
+	cgroupV2PidsDir = "/sys/fs/cgroup"
+	cgroupV1PidsDir = "/sys/fs/cgroup/pids"
+	procLoadavg     = "/proc/loadavg"
+	procPidMax      = "/proc/sys/kernel/pid_max"
+)
+
+func (f *fetcher) FetchProcesses() (total int64, used int64, err error) {
+	if f.isContainerized {
+		// The pids controller counts every task in the cgroup, and
+		// enforces its limit on top of the limit of the host.
+		for _, dir := range []string{cgroupV2PidsDir, cgroupV1PidsDir} {
+			used, err = f.readInt(dir + "/pids.current")
+			if err != nil {
+				continue
+			}
+			total, err = f.readInt(dir + "/pids.max")
+			if err == nil {
+				return total, used, nil
+			}
+			// The limit is "max" when not set, in which case only
+			// the host limit applies.
+			total, err = f.readInt(procPidMax)
+			if err != nil {
+				return 0, 0, xerrors.Errorf("read host process limit: %w", err)
+			}
+			return total, used, nil
+		}
+	}
+
+	// The fourth field of /proc/loadavg is the number of currently
+	// runnable and existing tasks, e.g. "1/523".
+	loadavg, err := afero.ReadFile(f.fs, procLoadavg)
+	if err != nil {
+		return 0, 0, xerrors.Errorf("read loadavg: %w", err)
+	}
+	fields := strings.Fields(string(loadavg))
+	if len(fields) < 4 {
+		return 0, 0, xerrors.Errorf("unexpected loadavg format: %q", string(loadavg))
+	}
+	_, tasks, ok := strings.Cut(fields[3], "/")
+	if !ok {
+		return 0, 0, xerrors.Errorf("unexpected loadavg format: %q", string(loadavg))
+	}
+	used, err = strconv.ParseInt(tasks, 10, 64)
+	if err != nil {
+		return 0, 0, xerrors.Errorf("parse task count: %w", err)
+	}
+
+	total, err = f.readInt(procPidMax)
+	if err != nil {
+		return 0, 0, xerrors.Errorf("read host process limit: %w", err)
+	}
+
+	return total, used, nil
+}
+
+func (f *fetcher) FetchInodes(volume string) (total int64, used int64, err error) {
+	return fetchInodes(volume)
+}
+
+// readInt reads a file that contains a single integer.
+func (f *fetcher) readInt(path string) (int64, error) {
+	data, err := afero.ReadFile(f.fs, path)
+	if err != nil {
+		return 0, err
+	}
+	return strconv.ParseInt(strings.TrimSpace(string(data)), 10, 64)
+}
@@ -0,0 +1,19 @@
+//go:build !windows
+
+package resourcesmonitor
+
+import (
+	"syscall"
+)
+
+func fetchInodes(volume string) (total int64, used int64, err error) {
+	var stat syscall.Statfs_t
+	if err := syscall.Statfs(volume, &stat); err != nil {
+		return 0, 0, err
+	}
+
+	// Filesystems that allocate inodes dynamically, such as btrfs,
+	// report zero inodes. The server treats this as unknown usage.
+	//nolint:gosec // Inode counts fit in an int64.
+	return int64(stat.Files), int64(stat.Files) - int64(stat.Ffree), nil
+}
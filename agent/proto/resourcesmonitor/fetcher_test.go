@@ -1,8 +1,10 @@
 package resourcesmonitor_test
 
 import (
+	"runtime"
 	"testing"
 
+	"github.com/spf13/afero"
 	"github.com/stretchr/testify/require"
 	"golang.org/x/xerrors"
 
@@ -15,6 +17,8 @@ type mockStatter struct {
 	isContainerized bool
 	containerMemory clistat.Result
 	hostMemory      clistat.Result
+	containerCPU    *clistat.Result
+	hostCPU         clistat.Result
 	disk            map[string]clistat.Result
 }
 
@@ -30,6 +34,14 @@ func (s *mockStatter) HostMemory(_ clistat.Prefix) (*clistat.Result, error) {
 	return &s.hostMemory, nil
 }
 
+func (s *mockStatter) ContainerCPU() (*clistat.Result, error) {
+	return s.containerCPU, nil
+}
+
+func (s *mockStatter) HostCPU() (*clistat.Result, error) {
+	return &s.hostCPU, nil
+}
+
 func (s *mockStatter) Disk(_ clistat.Prefix, path string) (*clistat.Result, error) {
 	disk, ok := s.disk[path]
 	if !ok {
@@ -107,3 +119,193 @@ func TestFetchMemory(t *testing.T) {
 		require.Equal(t, int64(30), total)
 	})
 }
+
+func TestFetchCPU(t *testing.T) {
+	t.Parallel()
+
+	t.Run("IsContainerized", func(t *testing.T) {
+		t.Parallel()
+
+		t.Run("WithCPULimit", func(t *testing.T) {
+			t.Parallel()
+
+			fetcher, err := resourcesmonitor.NewFetcher(&mockStatter{
+				isContainerized: true,
+				containerCPU: &clistat.Result{
+					Used:  1.5,
+					Total: ptr.Ref(2.0),
+				},
+				hostCPU: clistat.Result{
+					Used:  3.0,
+					Total: ptr.Ref(8.0),
+				},
+			})
+			require.NoError(t, err)
+
+			total, used, err := fetcher.FetchCPU()
+			require.NoError(t, err)
+			require.Equal(t, 1.5, used)
+			require.Equal(t, 2.0, total)
+		})
+
+		t.Run("WithoutCPULimit", func(t *testing.T) {
+			t.Parallel()
+
+			fetcher, err := resourcesmonitor.NewFetcher(&mockStatter{
+				isContainerized: true,
+				containerCPU: &clistat.Result{
+					Used:  1.5,
+					Total: nil,
+				},
+				hostCPU: clistat.Result{
+					Used:  3.0,
+					Total: ptr.Ref(8.0),
+				},
+			})
+			require.NoError(t, err)
+
+			total, used, err := fetcher.FetchCPU()
+			require.NoError(t, err)
+			require.Equal(t, 1.5, used)
+			require.Equal(t, 8.0, total)
+		})
+	})
+
+	t.Run("IsHost", func(t *testing.T) {
+		t.Parallel()
+
+		fetcher, err := resourcesmonitor.NewFetcher(&mockStatter{
+			isContainerized: false,
+			hostCPU: clistat.Result{
+				Used:  3.0,
+				Total: ptr.Ref(8.0),
+			},
+		})
+		require.NoError(t, err)
+
+		total, used, err := fetcher.FetchCPU()
+		require.NoError(t, err)
+		require.Equal(t, 3.0, used)
+		require.Equal(t, 8.0, total)
+	})
+}
+
+func TestFetchProcesses(t *testing.T) {
+	t.Parallel()
+
+	writeFiles := func(t *testing.T, files map[string]string) afero.Fs {
+		t.Helper()
+		fs := afero.NewMemMapFs()
+		for path, content := range files {
+			require.NoError(t, afero.WriteFile(fs, path, []byte(content), 0o644))
+		}
+		return fs
+	}
+
+	tests := []struct {
+		name            string
+		isContainerized bool
+		files           map[string]string
+		total           int64
+		used            int64
+		errContains     string
+	}{
+		{
+			name: "IsHost",
+			files: map[string]string{
+				"/proc/loadavg":            "0.52 0.58 0.59 3/523 12345\n",
+				"/proc/sys/kernel/pid_max": "4194304\n",
+			},
+			total: 4194304,
+			used:  523,
+		},
+		{
+			name:            "IsContainerized/CgroupV2",
+			isContainerized: true,
+			files: map[string]string{
+				"/sys/fs/cgroup/pids.current": "42\n",
+				"/sys/fs/cgroup/pids.max":     "1024\n",
+				"/proc/loadavg":               "0.52 0.58 0.59 3/523 12345\n",
+				"/proc/sys/kernel/pid_max":    "4194304\n",
+			},
+			total: 1024,
+			used:  42,
+		},
+		{
+			name:            "IsContainerized/CgroupV1",
+			isContainerized: true,
+			files: map[string]string{
+				"/sys/fs/cgroup/pids/pids.current": "42\n",
+				"/sys/fs/cgroup/pids/pids.max":     "2048\n",
+				"/proc/sys/kernel/pid_max":         "4194304\n",
+			},
+			total: 2048,
+			used:  42,
+		},
+		{
+			name:            "IsContainerized/WithoutLimit",
+			isContainerized: true,
+			files: map[string]string{
+				"/sys/fs/cgroup/pids.current": "42\n",
+				"/sys/fs/cgroup/pids.max":     "max\n",
+				"/proc/sys/kernel/pid_max":    "4194304\n",
+			},
+			total: 4194304,
+			used:  42,
+		},
+		{
+			name:            "IsContainerized/WithoutPidsController",
+			isContainerized: true,
+			files: map[string]string{
+				"/proc/loadavg":            "0.52 0.58 0.59 3/523 12345\n",
+				"/proc/sys/kernel/pid_max": "4194304\n",
+			},
+			total: 4194304,
+			used:  523,
+		},
+		{
+			name: "InvalidLoadavg",
+			files: map[string]string{
+				"/proc/loadavg":            "0.52 0.58\n",
+				"/proc/sys/kernel/pid_max": "4194304\n",
+			},
+			errContains: "unexpected loadavg format",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			fetcher, err := resourcesmonitor.NewFetcher(&mockStatter{
+				isContainerized: tt.isContainerized,
+			}, resourcesmonitor.WithFS(writeFiles(t, tt.files)))
+			require.NoError(t, err)
+
+			total, used, err := fetcher.FetchProcesses()
+			if tt.errContains != "" {
+				require.ErrorContains(t, err, tt.errContains)
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tt.total, total)
+			require.Equal(t, tt.used, used)
+		})
+	}
+}
+
+func TestFetchInodes(t *testing.T) {
+	t.Parallel()
+
+	if runtime.GOOS == "windows" {
+		t.Skip("inode usage is not supported on Windows")
+	}
+
+	fetcher, err := resourcesmonitor.NewFetcher(&mockStatter{})
+	require.NoError(t, err)
+
+	total, used, err := fetcher.FetchInodes(t.TempDir())
+	require.NoError(t, err)
+	require.GreaterOrEqual(t, total, used)
+	require.GreaterOrEqual(t, used, int64(0))
+}
@@ -12,6 +12,9 @@ type Datapoint struct {
 	CollectedAt time.Time
 	Memory      *MemoryDatapoint
 	Volumes     []*VolumeDatapoint
+	CPU         *CPUDatapoint
+	Processes   *ProcessDatapoint
+	Inodes      []*VolumeDatapoint
 }
 
 type MemoryDatapoint struct {
@@ -25,6 +28,17 @@ type VolumeDatapoint struct {
 	Used  int64
 }
 
+// CPUDatapoint is measured in cores.
+type CPUDatapoint struct {
+	Total float64
+	Used  float64
+}
+
+type ProcessDatapoint struct {
+	Total int64
+	Used  int64
+}
+
 // Queue represents a FIFO queue with a fixed size
 type Queue struct {
 	items []Datapoint
@@ -78,6 +92,28 @@ func (q *Queue) ItemsAsProto() []*proto.PushResourcesMonitoringUsageRequest_Data
 			})
 		}
 
+		if item.CPU != nil {
+			protoItem.Cpu = &proto.PushResourcesMonitoringUsageRequest_Datapoint_CPUUsage{
+				Total: item.CPU.Total,
+				Used:  item.CPU.Used,
+			}
+		}
+
+		if item.Processes != nil {
+			protoItem.Processes = &proto.PushResourcesMonitoringUsageRequest_Datapoint_ProcessUsage{
+				Total: item.Processes.Total,
+				Used:  item.Processes.Used,
+			}
+		}
+
+		for _, inode := range item.Inodes {
+			protoItem.Inodes = append(protoItem.Inodes, &proto.PushResourcesMonitoringUsageRequest_Datapoint_VolumeUsage{
+				Volume: inode.Path,
+				Total:  inode.Total,
+				Used:   inode.Used,
+			})
+		}
+
 		items = append(items, protoItem)
 	}
 
@@ -71,6 +71,48 @@ func (m *monitor) Start(ctx context.Context) error {
 			})
 		}
 
+		if m.config.Cpu != nil && m.config.Cpu.Enabled {
+			cpuTotal, cpuUsed, err := m.resourcesFetcher.FetchCPU()
+			if err != nil {
+				m.logger.Error(ctx, "failed to fetch cpu", slog.Error(err))
+			} else {
+				datapoint.CPU = &CPUDatapoint{
+					Total: cpuTotal,
+					Used:  cpuUsed,
+				}
+			}
+		}
+
+		if m.config.Processes != nil && m.config.Processes.Enabled {
+			procTotal, procUsed, err := m.resourcesFetcher.FetchProcesses()
+			if err != nil {
+				m.logger.Error(ctx, "failed to fetch processes", slog.Error(err))
+			} else {
+				datapoint.Processes = &ProcessDatapoint{
+					Total: procTotal,
+					Used:  procUsed,
+				}
+			}
+		}
+
+		for _, inode := range m.config.Inodes {
+			if !inode.Enabled {
+				continue
+			}
+
+			inodeTotal, inodeUsed, err := m.resourcesFetcher.FetchInodes(inode.Path)
+			if err != nil {
+				m.logger.Error(ctx, "failed to fetch inodes", slog.F("path", inode.Path), slog.Error(err))
+				continue
+			}
+
+			datapoint.Inodes = append(datapoint.Inodes, &VolumeDatapoint{
+				Path:  inode.Path,
+				Total: inodeTotal,
+				Used:  inodeUsed,
+			})
+		}
+
 		m.queue.Push(datapoint)
 
 		if m.queue.IsFull() {
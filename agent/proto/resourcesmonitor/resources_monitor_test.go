@@ -28,9 +28,18 @@ type fetcher struct {
 	usedMemory  int64
 	totalVolume int64
 	usedVolume  int64
+	totalCPU    float64
+	usedCPU     float64
+	totalProcs  int64
+	usedProcs   int64
+	totalInodes int64
+	usedInodes  int64
 
 	errMemory error
 	errVolume error
+	errCPU    error
+	errProcs  error
+	errInodes error
 }
 
 func (r *fetcher) FetchMemory() (total int64, used int64, err error) {
@@ -41,6 +50,18 @@ func (r *fetcher) FetchVolume(_ string) (total int64, used int64, err error) {
 	return r.totalVolume, r.usedVolume, r.errVolume
 }
 
+func (r *fetcher) FetchCPU() (total float64, used float64, err error) {
+	return r.totalCPU, r.usedCPU, r.errCPU
+}
+
+func (r *fetcher) FetchProcesses() (total int64, used int64, err error) {
+	return r.totalProcs, r.usedProcs, r.errProcs
+}
+
+func (r *fetcher) FetchInodes(_ string) (total int64, used int64, err error) {
+	return r.totalInodes, r.usedInodes, r.errInodes
+}
+
 func TestPushResourcesMonitoringWithConfig(t *testing.T) {
 	t.Parallel()
 	tests := []struct {
@@ -126,6 +147,60 @@ func TestPushResourcesMonitoringWithConfig(t *testing.T) {
 			},
 			numTicks: 60,
 		},
+		{
+			name: "CPUProcessesAndInodes",
+			config: &proto.GetResourcesMonitoringConfigurationResponse{
+				Config: &proto.GetResourcesMonitoringConfigurationResponse_Config{
+					NumDatapoints:             20,
+					CollectionIntervalSeconds: 1,
+				},
+				Cpu: &proto.GetResourcesMonitoringConfigurationResponse_CPU{
+					Enabled: true,
+				},
+				Processes: &proto.GetResourcesMonitoringConfigurationResponse_Processes{
+					Enabled: true,
+				},
+				Inodes: []*proto.GetResourcesMonitoringConfigurationResponse_Volume{
+					{
+						Enabled: true,
+						Path:    "/home/coder",
+					},
+					{
+						Enabled: false,
+						Path:    "/tmp",
+					},
+				},
+			},
+			datapointsPusher: func(_ context.Context, req *proto.PushResourcesMonitoringUsageRequest) (*proto.PushResourcesMonitoringUsageResponse, error) {
+				require.Len(t, req.Datapoints, 20)
+				require.Nil(t, req.Datapoints[0].Memory)
+				require.Empty(t, req.Datapoints[0].Volumes)
+				require.Equal(t, &proto.PushResourcesMonitoringUsageRequest_Datapoint_CPUUsage{
+					Total: 4,
+					Used:  1.5,
+				}, req.Datapoints[0].Cpu)
+				require.Equal(t, &proto.PushResourcesMonitoringUsageRequest_Datapoint_ProcessUsage{
+					Total: 4096,
+					Used:  128,
+				}, req.Datapoints[0].Processes)
+				require.Equal(t, []*proto.PushResourcesMonitoringUsageRequest_Datapoint_VolumeUsage{{
+					Volume: "/home/coder",
+					Total:  1000,
+					Used:   10,
+				}}, req.Datapoints[0].Inodes)
+
+				return &proto.PushResourcesMonitoringUsageResponse{}, nil
+			},
+			fetcher: &fetcher{
+				totalCPU:    4,
+				usedCPU:     1.5,
+				totalProcs:  4096,
+				usedProcs:   128,
+				totalInodes: 1000,
+				usedInodes:  10,
+			},
+			numTicks: 20,
+		},
 		{
 			// If one of the resources fails to be fetched, the datapoints still should be pushed with the other resources.
 			name: "ErrorFetchingMemory",
@@ -171,7 +171,7 @@ func (rpty *bufferedReconnectingPTY) lifecycle(ctx context.Context, logger slog.
 	rpty.state.setState(StateDone, reasonErr)
 }
 
-func (rpty *bufferedReconnectingPTY) Attach(ctx context.Context, connID string, conn net.Conn, height, width uint16, logger slog.Logger) error {
+func (rpty *bufferedReconnectingPTY) Attach(ctx context.Context, connID string, conn net.Conn, height, width uint16, readOnly bool, logger slog.Logger) error {
 	logger.Info(ctx, "attach to reconnecting pty")
 
 	// This will kill the heartbeat once we hit EOF or an error.
@@ -196,6 +196,12 @@ func (rpty *bufferedReconnectingPTY) Attach(ctx context.Context, connID string,
 
 	go heartbeat(ctx, rpty.timer, rpty.timeout)
 
+	if readOnly {
+		// Viewers share the size of the session they are watching.
+		discardConnLoop(ctx, conn, logger)
+		return nil
+	}
+
 	// Resize the PTY to initial height + width.
 	err = rpty.ptty.Resize(height, width)
 	if err != nil {
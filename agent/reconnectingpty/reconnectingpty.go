@@ -107,8 +107,9 @@ type ReconnectingPTY interface {
 	// history, then blocks until EOF, an error, or the context's end.  The
 	// connection is expected to send JSON-encoded messages and accept raw output
 	// from the ptty.  If the context ends or the process dies the connection will
-	// be detached.
-	Attach(ctx context.Context, connID string, conn net.Conn, height, width uint16, logger slog.Logger) error
+	// be detached.  Read-only connections receive output but their input and
+	// resizes are discarded.
+	Attach(ctx context.Context, connID string, conn net.Conn, height, width uint16, readOnly bool, logger slog.Logger) error
 	// Wait waits for the reconnecting pty to close.  The underlying process might
 	// still be exiting.
 	Wait()
@@ -296,3 +297,13 @@ func readConnLoop(ctx context.Context, conn net.Conn, ptty pty.PTYCmd, metrics *
 		}
 	}
 }
+
+// discardConnLoop reads and discards everything sent on conn so that
+// read-only connections cannot write to or resize the ptty.  Blocks until EOF
+// or an error reading from conn.
+func discardConnLoop(ctx context.Context, conn net.Conn, logger slog.Logger) {
+	_, err := io.Copy(io.Discard, conn)
+	if err != nil {
+		logger.Debug(ctx, "read-only reconnecting pty connection failed with read error", slog.Error(err))
+	}
+}
@@ -166,7 +166,7 @@ func (rpty *screenReconnectingPTY) lifecycle(ctx context.Context, logger slog.Lo
 	rpty.state.setState(StateDone, reasonErr)
 }
 
-func (rpty *screenReconnectingPTY) Attach(ctx context.Context, _ string, conn net.Conn, height, width uint16, logger slog.Logger) error {
+func (rpty *screenReconnectingPTY) Attach(ctx context.Context, _ string, conn net.Conn, height, width uint16, readOnly bool, logger slog.Logger) error {
 	logger.Info(ctx, "attach to reconnecting pty")
 
 	// This will kill the heartbeat once we hit EOF or an error.
@@ -207,6 +207,13 @@ func (rpty *screenReconnectingPTY) Attach(ctx context.Context, _ string, conn ne
 		}
 	}()
 
+	if readOnly {
+		// The screen client of a viewer has its own display, so only input
+		// needs to be dropped.
+		discardConnLoop(ctx, conn, logger)
+		return nil
+	}
+
 	// Pipe conn -> pty and block.
 	readConnLoop(ctx, conn, ptty, rpty.metrics, logger)
 	return nil
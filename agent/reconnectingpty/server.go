@@ -187,6 +187,20 @@ func (s *Server) handleConn(ctx context.Context, logger slog.Logger, conn net.Co
 		connLogger.Info(ctx, "reconnecting pty connection closed")
 	}()
 
+	if msg.ReadOnly {
+		// Viewers can only watch a session that is already running.
+		waitReady, ok := s.reconnectingPTYs.Load(msg.ID)
+		if !ok {
+			return xerrors.Errorf("reconnecting pty %s does not exist", msg.ID)
+		}
+		connLogger.Debug(ctx, "connecting to existing reconnecting pty as a viewer")
+		rpty, err := waitForReconnectingPTY(waitReady)
+		if err != nil {
+			return err
+		}
+		return rpty.Attach(ctx, connectionID, conn, msg.Height, msg.Width, true, connLogger)
+	}
+
 	var rpty ReconnectingPTY
 	sendConnected := make(chan ReconnectingPTY, 1)
 	// On store, reserve this ID to prevent multiple concurrent new connections.
@@ -194,15 +208,10 @@ func (s *Server) handleConn(ctx context.Context, logger slog.Logger, conn net.Co
 	if ok {
 		close(sendConnected) // Unused.
 		connLogger.Debug(ctx, "connecting to existing reconnecting pty")
-		c, ok := waitReady.(chan ReconnectingPTY)
-		if !ok {
-			return xerrors.Errorf("found invalid type in reconnecting pty map: %T", waitReady)
-		}
-		rpty, ok = <-c
-		if !ok || rpty == nil {
-			return xerrors.Errorf("reconnecting pty closed before connection")
+		rpty, err = waitForReconnectingPTY(waitReady)
+		if err != nil {
+			return err
 		}
-		c <- rpty // Put it back for the next reconnect.
 	} else {
 		connLogger.Debug(ctx, "creating new reconnecting pty")
 
@@ -258,5 +267,20 @@ func (s *Server) handleConn(ctx context.Context, logger slog.Logger, conn net.Co
 		connected = true
 		sendConnected <- rpty
 	}
-	return rpty.Attach(ctx, connectionID, conn, msg.Height, msg.Width, connLogger)
+	return rpty.Attach(ctx, connectionID, conn, msg.Height, msg.Width, false, connLogger)
+}
+
+// waitForReconnectingPTY waits for the reconnecting pty reserved in the map to
+// be ready and returns it.
+func waitForReconnectingPTY(waitReady any) (ReconnectingPTY, error) {
+	c, ok := waitReady.(chan ReconnectingPTY)
+	if !ok {
+		return nil, xerrors.Errorf("found invalid type in reconnecting pty map: %T", waitReady)
+	}
+	rpty, ok := <-c
+	if !ok || rpty == nil {
+		return nil, xerrors.Errorf("reconnecting pty closed before connection")
+	}
+	c <- rpty // Put it back for the next reconnect.
+	return rpty, nil
 }
@@ -1,14 +1,20 @@
 package cliui
 
 import (
+	"bytes"
 	"context"
+	"encoding/csv"
 	"encoding/json"
 	"fmt"
 	"reflect"
+	"sort"
 	"strings"
+	"text/template"
 
 	"github.com/jedib0t/go-pretty/v6/table"
+	"github.com/spf13/pflag"
 	"golang.org/x/xerrors"
+	"gopkg.in/yaml.v3"
 
 	"github.com/coder/serpent"
 )
@@ -26,6 +32,11 @@ type OutputFormatter struct {
 
 // NewOutputFormatter creates a new OutputFormatter with the given formats. The
 // first format is the default format. At least two formats must be provided.
+//
+// If a JSON format is provided, YAML and template formats are added that
+// render the same data. If a table format is provided, a CSV format is added
+// that renders the same rows and columns. Any ChangeFormatterData wrapping of
+// the JSON or table format is kept.
 func NewOutputFormatter(formats ...OutputFormat) *OutputFormatter {
 	if len(formats) < 2 {
 		panic("at least two output formats must be provided")
@@ -42,6 +53,41 @@ func NewOutputFormatter(formats ...OutputFormat) *OutputFormatter {
 		formatIDs[format.ID()] = struct{}{}
 	}
 
+	for _, derived := range []struct {
+		id     string
+		derive func(OutputFormat) OutputFormat
+	}{
+		{id: "yaml", derive: func(f OutputFormat) OutputFormat {
+			if _, ok := f.(jsonFormat); ok {
+				return YAMLFormat()
+			}
+			return nil
+		}},
+		{id: "csv", derive: func(f OutputFormat) OutputFormat {
+			if tf, ok := f.(*tableFormat); ok {
+				return &csvFormat{table: tf}
+			}
+			return nil
+		}},
+		{id: "template", derive: func(f OutputFormat) OutputFormat {
+			if _, ok := f.(jsonFormat); ok {
+				return TemplateFormat()
+			}
+			return nil
+		}},
+	} {
+		if _, ok := formatIDs[derived.id]; ok {
+			continue
+		}
+		for _, format := range formats {
+			if d := deriveFormat(format, derived.derive); d != nil {
+				formats = append(formats, d)
+				formatIDs[derived.id] = struct{}{}
+				break
+			}
+		}
+	}
+
 	return &OutputFormatter{
 		formats:  formats,
 		formatID: formats[0].ID(),
@@ -55,9 +101,11 @@ func (f *OutputFormatter) AttachOptions(opts *serpent.OptionSet) {
 		format.AttachOptions(opts)
 	}
 
-	formatNames := make([]string, 0, len(f.formats))
+	description := "Output format."
 	for _, format := range f.formats {
-		formatNames = append(formatNames, format.ID())
+		if format.ID() == "template" {
+			description += " Use template=TEMPLATE to render each item with a Go template, referring to fields by their JSON names."
+		}
 	}
 
 	*opts = append(*opts,
@@ -65,8 +113,8 @@ func (f *OutputFormatter) AttachOptions(opts *serpent.OptionSet) {
 			Flag:          "output",
 			FlagShorthand: "o",
 			Default:       f.formats[0].ID(),
-			Value:         serpent.EnumOf(&f.formatID, formatNames...),
-			Description:   "Output format.",
+			Value:         &outputFormatValue{f: f},
+			Description:   description,
 		},
 	)
 }
@@ -83,7 +131,8 @@ func (f *OutputFormatter) Format(ctx context.Context, data any) (string, error)
 	return "", xerrors.Errorf("unknown output format %q", f.formatID)
 }
 
-// FormatID will return the ID of the format selected by `--output`.
+// FormatID will return the ID of the format selected by `--output`, without
+// any argument.
 // If no flag is present, it returns the 'default' formatter.
 func (f *OutputFormatter) FormatID() string {
 	return f.formatID
@@ -238,3 +287,288 @@ func (d *DataChangeFormat) Format(ctx context.Context, data any) (string, error)
 	}
 	return d.format.Format(ctx, newData)
 }
+
+// argumentFormat is implemented by formats that take an argument, which is
+// passed as `--output <id>=<argument>`.
+type argumentFormat interface {
+	setArgument(arg string) error
+}
+
+// argumentFormatOf returns the argumentFormat of format, looking through any
+// ChangeFormatterData wrapping.
+func argumentFormatOf(format OutputFormat) (argumentFormat, bool) {
+	for {
+		switch f := format.(type) {
+		case *DataChangeFormat:
+			format = f.format
+		case argumentFormat:
+			return f, true
+		default:
+			return nil, false
+		}
+	}
+}
+
+// outputFormatValue is the value of the --output flag. It is an enum of the
+// format IDs, except that formats taking an argument are selected with
+// "<id>=<argument>".
+type outputFormatValue struct {
+	f   *OutputFormatter
+	raw string
+}
+
+var _ pflag.Value = &outputFormatValue{}
+
+func (v *outputFormatValue) Set(s string) error {
+	id, arg, hasArg := strings.Cut(s, "=")
+	for _, format := range v.f.formats {
+		if !strings.EqualFold(format.ID(), id) {
+			continue
+		}
+		af, takesArg := argumentFormatOf(format)
+		switch {
+		case takesArg && !hasArg:
+			return xerrors.Errorf("output format %q requires an argument, e.g. %s=...", format.ID(), format.ID())
+		case !takesArg && hasArg:
+			return xerrors.Errorf("output format %q does not take an argument", format.ID())
+		case takesArg:
+			if err := af.setArgument(arg); err != nil {
+				return xerrors.Errorf("output format %q: %w", format.ID(), err)
+			}
+		}
+		v.f.formatID = format.ID()
+		v.raw = s
+		return nil
+	}
+	return xerrors.Errorf("invalid choice: %s, should be one of %v", s, v.choices())
+}
+
+func (v *outputFormatValue) String() string {
+	if v.raw == "" {
+		return v.f.formatID
+	}
+	return v.raw
+}
+
+func (v *outputFormatValue) Type() string {
+	return strings.Join(v.choices(), "|")
+}
+
+func (v *outputFormatValue) choices() []string {
+	choices := make([]string, 0, len(v.f.formats))
+	for _, format := range v.f.formats {
+		choices = append(choices, format.ID())
+	}
+	return choices
+}
+
+// deriveFormat calls derive with format, or with the format wrapped by
+// ChangeFormatterData, in which case the result is wrapped with the same
+// change. It returns nil if derive does.
+func deriveFormat(format OutputFormat, derive func(OutputFormat) OutputFormat) OutputFormat {
+	if d, ok := format.(*DataChangeFormat); ok {
+		inner := deriveFormat(d.format, derive)
+		if inner == nil {
+			return nil
+		}
+		return ChangeFormatterData(inner, d.change)
+	}
+	return derive(format)
+}
+
+type yamlFormat struct{}
+
+var _ OutputFormat = yamlFormat{}
+
+// YAMLFormat creates a YAML formatter. Data is marshaled to JSON first, so
+// field names and order match the JSON output.
+func YAMLFormat() OutputFormat {
+	return yamlFormat{}
+}
+
+// ID implements OutputFormat.
+func (yamlFormat) ID() string {
+	return "yaml"
+}
+
+// AttachOptions implements OutputFormat.
+func (yamlFormat) AttachOptions(_ *serpent.OptionSet) {}
+
+// Format implements OutputFormat.
+func (yamlFormat) Format(_ context.Context, data any) (string, error) {
+	outBytes, err := json.Marshal(data)
+	if err != nil {
+		return "", xerrors.Errorf("marshal output to JSON: %w", err)
+	}
+	// JSON is valid YAML, so decoding it into a node keeps the field order
+	// and the exact numbers.
+	var node yaml.Node
+	if err := yaml.Unmarshal(outBytes, &node); err != nil {
+		return "", xerrors.Errorf("convert JSON to YAML: %w", err)
+	}
+	resetYAMLStyle(&node)
+
+	var buf bytes.Buffer
+	enc := yaml.NewEncoder(&buf)
+	enc.SetIndent(2)
+	if err := enc.Encode(&node); err != nil {
+		return "", xerrors.Errorf("marshal output to YAML: %w", err)
+	}
+	if err := enc.Close(); err != nil {
+		return "", xerrors.Errorf("marshal output to YAML: %w", err)
+	}
+	return strings.TrimSuffix(buf.String(), "\n"), nil
+}
+
+// resetYAMLStyle replaces the flow style of nodes decoded from JSON with the
+// default block style.
+func resetYAMLStyle(node *yaml.Node) {
+	node.Style = 0
+	for _, child := range node.Content {
+		resetYAMLStyle(child)
+	}
+}
+
+type csvFormat struct {
+	table *tableFormat
+}
+
+var _ OutputFormat = &csvFormat{}
+
+// ID implements OutputFormat.
+func (*csvFormat) ID() string {
+	return "csv"
+}
+
+// AttachOptions implements OutputFormat. The columns are selected with the
+// table format's --column flag.
+func (*csvFormat) AttachOptions(_ *serpent.OptionSet) {}
+
+// Format implements OutputFormat. Like the table format, it returns an empty
+// string if the data is empty.
+func (f *csvFormat) Format(_ context.Context, data any) (string, error) {
+	v := reflect.Indirect(reflect.ValueOf(data))
+	if v.Kind() != reflect.Slice {
+		return "", xerrors.New("csv format called with a non-slice type")
+	}
+	if v.Len() == 0 {
+		return "", nil
+	}
+
+	allHeaders := make(table.Row, len(f.table.allColumns))
+	for i, header := range f.table.allColumns {
+		allHeaders[i] = header
+	}
+	headers := filterHeaders(allHeaders, f.table.columns)
+	sortIndex := -1
+	header := make([]string, len(headers))
+	for i, h := range headers {
+		header[i], _ = h.(string)
+		if f.table.sort != "" && strings.EqualFold(header[i], f.table.sort) {
+			sortIndex = i
+		}
+	}
+
+	records := make([][]string, 0, v.Len())
+	for i := 0; i < v.Len(); i++ {
+		cur := v.Index(i).Interface()
+		if _, ok := cur.(TableSeparator); ok {
+			continue
+		}
+		row, err := tableRowCells(cur, headers)
+		if err != nil {
+			return "", xerrors.Errorf("get table row map %v: %w", i, err)
+		}
+		record := make([]string, len(row))
+		for j, cell := range row {
+			if cell != nil {
+				record[j] = fmt.Sprint(cell)
+			}
+		}
+		records = append(records, record)
+	}
+	// Sort the same way as the table format.
+	if sortIndex >= 0 {
+		sort.SliceStable(records, func(i, j int) bool {
+			return records[i][sortIndex] < records[j][sortIndex]
+		})
+	}
+
+	var buf bytes.Buffer
+	w := csv.NewWriter(&buf)
+	if err := w.Write(header); err != nil {
+		return "", xerrors.Errorf("write csv header: %w", err)
+	}
+	if err := w.WriteAll(records); err != nil {
+		return "", xerrors.Errorf("write csv: %w", err)
+	}
+	return strings.TrimSuffix(buf.String(), "\n"), nil
+}
+
+type templateFormat struct {
+	tmpl *template.Template
+}
+
+var _ OutputFormat = &templateFormat{}
+
+// TemplateFormat creates a formatter that renders data with a Go template,
+// which is set with `--output template=<template>`. Data is marshaled to
+// JSON first, so templates refer to fields by their JSON names. If the data
+// is a list, the template is rendered once for each item, one per line.
+func TemplateFormat() OutputFormat {
+	return &templateFormat{}
+}
+
+// ID implements OutputFormat.
+func (*templateFormat) ID() string {
+	return "template"
+}
+
+// AttachOptions implements OutputFormat.
+func (*templateFormat) AttachOptions(_ *serpent.OptionSet) {}
+
+func (f *templateFormat) setArgument(arg string) error {
+	tmpl, err := template.New("output").Option("missingkey=zero").Funcs(template.FuncMap{
+		"json": func(v any) (string, error) {
+			b, err := json.Marshal(v)
+			return string(b), err
+		},
+	}).Parse(arg)
+	if err != nil {
+		return xerrors.Errorf("parse template: %w", err)
+	}
+	f.tmpl = tmpl
+	return nil
+}
+
+// Format implements OutputFormat.
+func (f *templateFormat) Format(_ context.Context, data any) (string, error) {
+	if f.tmpl == nil {
+		return "", xerrors.New("no template specified, use --output template=<template>")
+	}
+	outBytes, err := json.Marshal(data)
+	if err != nil {
+		return "", xerrors.Errorf("marshal output to JSON: %w", err)
+	}
+	var value any
+	dec := json.NewDecoder(bytes.NewReader(outBytes))
+	// Keep large integers, such as byte counts, exact.
+	dec.UseNumber()
+	if err := dec.Decode(&value); err != nil {
+		return "", xerrors.Errorf("decode JSON: %w", err)
+	}
+
+	items, ok := value.([]any)
+	if !ok {
+		items = []any{value}
+	}
+	lines := make([]string, 0, len(items))
+	for _, item := range items {
+		var buf bytes.Buffer
+		if err := f.tmpl.Execute(&buf, item); err != nil {
+			return "", xerrors.Errorf("execute template: %w", err)
+		}
+		lines = append(lines, buf.String())
+	}
+	return strings.Join(lines, "\n"), nil
+}
@@ -136,3 +136,132 @@ func Test_OutputFormatter(t *testing.T) {
 		require.EqualValues(t, 2, called.Load())
 	})
 }
+
+type outputRow struct {
+	Name    string `json:"name" table:"name,default_sort"`
+	Age     int    `json:"age" table:"age"`
+	Comment string `json:"comment" table:"comment"`
+}
+
+func Test_DerivedOutputFormats(t *testing.T) {
+	t.Parallel()
+
+	data := []outputRow{
+		{Name: "foo", Age: 10, Comment: "hello, world"},
+		{Name: "bar", Age: 20, Comment: `say "hi"`},
+	}
+	newFormatter := func(t *testing.T) (*cliui.OutputFormatter, *serpent.Command) {
+		t.Helper()
+		f := cliui.NewOutputFormatter(
+			cliui.TableFormat([]outputRow{}, []string{"name", "age"}),
+			cliui.JSONFormat(),
+		)
+		cmd := &serpent.Command{}
+		f.AttachOptions(&cmd.Options)
+		return f, cmd
+	}
+
+	t.Run("Choices", func(t *testing.T) {
+		t.Parallel()
+
+		_, cmd := newFormatter(t)
+		output := cmd.Options.ByFlag("output")
+		require.NotNil(t, output)
+		require.Equal(t, "table|json|yaml|csv|template", output.Value.Type())
+		require.Contains(t, output.Description, "template=TEMPLATE")
+	})
+
+	t.Run("YAML", func(t *testing.T) {
+		t.Parallel()
+
+		f, cmd := newFormatter(t)
+		require.NoError(t, cmd.Options.FlagSet().Set("output", "yaml"))
+		out, err := f.Format(context.Background(), data)
+		require.NoError(t, err)
+		require.Equal(t, `- name: foo
+  age: 10
+  comment: hello, world
+- name: bar
+  age: 20
+  comment: say "hi"`, out)
+	})
+
+	t.Run("CSV", func(t *testing.T) {
+		t.Parallel()
+
+		f, cmd := newFormatter(t)
+		require.NoError(t, cmd.Options.SetDefaults())
+		require.NoError(t, cmd.Options.FlagSet().Set("output", "csv"))
+		out, err := f.Format(context.Background(), data)
+		require.NoError(t, err)
+		// Uses the default table columns, sorted like the table.
+		require.Equal(t, "name,age\nbar,20\nfoo,10", out)
+
+		f, cmd = newFormatter(t)
+		fs := cmd.Options.FlagSet()
+		require.NoError(t, fs.Set("output", "csv"))
+		require.NoError(t, fs.Set("column", "comment,name"))
+		out, err = f.Format(context.Background(), data)
+		require.NoError(t, err)
+		require.Equal(t, "comment,name\n\"say \"\"hi\"\"\",bar\n\"hello, world\",foo", out)
+
+		out, err = f.Format(context.Background(), []outputRow{})
+		require.NoError(t, err)
+		require.Empty(t, out)
+
+		require.Error(t, fs.Set("output", "csv=foo"))
+	})
+
+	t.Run("Template", func(t *testing.T) {
+		t.Parallel()
+
+		f, cmd := newFormatter(t)
+		fs := cmd.Options.FlagSet()
+		require.Error(t, fs.Set("output", "template"))
+		require.Error(t, fs.Set("output", "template={{.name"))
+
+		require.NoError(t, fs.Set("output", "template={{.name}} is {{.age}}"))
+		require.Equal(t, "template", f.FormatID())
+		out, err := f.Format(context.Background(), data)
+		require.NoError(t, err)
+		require.Equal(t, "foo is 10\nbar is 20", out)
+
+		// Single values are rendered once.
+		out, err = f.Format(context.Background(), data[0])
+		require.NoError(t, err)
+		require.Equal(t, "foo is 10", out)
+	})
+
+	t.Run("KeepsChangedData", func(t *testing.T) {
+		t.Parallel()
+
+		f := cliui.NewOutputFormatter(
+			cliui.ChangeFormatterData(cliui.TableFormat([]outputRow{}, nil), func(data any) (any, error) {
+				names, ok := data.([]string)
+				require.True(t, ok)
+				rows := make([]outputRow, 0, len(names))
+				for _, name := range names {
+					rows = append(rows, outputRow{Name: name})
+				}
+				return rows, nil
+			}),
+			cliui.ChangeFormatterData(cliui.JSONFormat(), func(data any) (any, error) {
+				return map[string]any{"names": data}, nil
+			}),
+		)
+		cmd := &serpent.Command{}
+		f.AttachOptions(&cmd.Options)
+		fs := cmd.Options.FlagSet()
+		data := []string{"foo"}
+
+		require.NoError(t, fs.Set("output", "csv"))
+		out, err := f.Format(context.Background(), data)
+		require.NoError(t, err)
+		require.Equal(t, "name,age,comment\nfoo,0,", out)
+
+		require.NoError(t, fs.Set("output", "yaml"))
+		out, err = f.Format(context.Background(), data)
+		require.NoError(t, err)
+		require.Equal(t, "names:\n  - foo", out)
+	})
+}
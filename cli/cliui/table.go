@@ -208,93 +208,103 @@ func renderTable(out any, sort string, headers table.Row, filterColumns []string
 			continue
 		}
 		// Format the row as a slice.
-		// ValueToTableMap does what `reflect.Indirect` does
-		rowMap, err := valueToTableMap(reflect.ValueOf(cur))
+		row, err := tableRowCells(cur, headers)
 		if err != nil {
 			return "", xerrors.Errorf("get table row map %v: %w", i, err)
 		}
+		tw.AppendRow(row)
+	}
 
-		rowSlice := make([]any, len(headers))
-		for i, h := range headers {
-			v, ok := rowMap[h.(string)]
-			if !ok {
-				v = nil
-			}
+	return tw.Render(), nil
+}
 
-			// Special type formatting.
-			switch val := v.(type) {
-			case time.Time:
+// tableRowCells returns the cells of a row for the given headers, formatting
+// values the same way for every output format that renders table rows.
+func tableRowCells(cur any, headers table.Row) (table.Row, error) {
+	// ValueToTableMap does what `reflect.Indirect` does
+	rowMap, err := valueToTableMap(reflect.ValueOf(cur))
+	if err != nil {
+		return nil, err
+	}
+
+	rowSlice := make(table.Row, len(headers))
+	for i, h := range headers {
+		v, ok := rowMap[h.(string)]
+		if !ok {
+			v = nil
+		}
+
+		// Special type formatting.
+		switch val := v.(type) {
+		case time.Time:
+			v = val.Format(time.RFC3339)
+		case *time.Time:
+			if val != nil {
 				v = val.Format(time.RFC3339)
-			case *time.Time:
-				if val != nil {
-					v = val.Format(time.RFC3339)
-				}
-			case codersdk.NullTime:
-				if val.Valid {
-					v = val.Time.Format(time.RFC3339)
-				} else {
-					v = nil
-				}
-			case *string:
-				if val != nil {
-					v = *val
-				}
-			case *int64:
-				if val != nil {
-					v = *val
-				}
-			case *time.Duration:
-				if val != nil {
-					v = val.String()
-				}
-			case fmt.Stringer:
-				// Protect against typed nils since fmt.Stringer is an interface.
-				vv := reflect.ValueOf(v)
-				nilPtr := vv.Kind() == reflect.Ptr && vv.IsNil()
-				if val != nil && !nilPtr {
-					v = val.String()
-				} else if nilPtr {
-					v = nil
-				}
 			}
-
-			// Guard against nil dereferences
-			if v != nil {
-				rt := reflect.TypeOf(v)
-				switch rt.Kind() {
-				case reflect.Slice:
-					// By default, the behavior is '%v', which just returns a string like
-					// '[a b c]'. This will add commas in between each value.
-					strs := make([]string, 0)
-					vt := reflect.ValueOf(v)
-					for i := 0; i < vt.Len(); i++ {
-						strs = append(strs, fmt.Sprintf("%v", vt.Index(i).Interface()))
-					}
-					v = "[" + strings.Join(strs, ", ") + "]"
-				default:
-					// Leave it as it is
-				}
+		case codersdk.NullTime:
+			if val.Valid {
+				v = val.Time.Format(time.RFC3339)
+			} else {
+				v = nil
+			}
+		case *string:
+			if val != nil {
+				v = *val
+			}
+		case *int64:
+			if val != nil {
+				v = *val
 			}
+		case *time.Duration:
+			if val != nil {
+				v = val.String()
+			}
+		case fmt.Stringer:
+			// Protect against typed nils since fmt.Stringer is an interface.
+			vv := reflect.ValueOf(v)
+			nilPtr := vv.Kind() == reflect.Ptr && vv.IsNil()
+			if val != nil && !nilPtr {
+				v = val.String()
+			} else if nilPtr {
+				v = nil
+			}
+		}
 
-			// Last resort, just get the interface value to avoid printing
-			// pointer values. For example, if we have a `*MyType("value")`
-			// which is defined as `type MyType string`, we want to print
-			// the string value, not the pointer.
-			if v != nil {
-				vv := reflect.ValueOf(v)
-				for vv.Kind() == reflect.Ptr && !vv.IsNil() {
-					vv = vv.Elem()
+		// Guard against nil dereferences
+		if v != nil {
+			rt := reflect.TypeOf(v)
+			switch rt.Kind() {
+			case reflect.Slice:
+				// By default, the behavior is '%v', which just returns a string like
+				// '[a b c]'. This will add commas in between each value.
+				strs := make([]string, 0)
+				vt := reflect.ValueOf(v)
+				for i := 0; i < vt.Len(); i++ {
+					strs = append(strs, fmt.Sprintf("%v", vt.Index(i).Interface()))
 				}
-				v = vv.Interface()
+				v = "[" + strings.Join(strs, ", ") + "]"
+			default:
+				// Leave it as it is
 			}
+		}
 
-			rowSlice[i] = v
+		// Last resort, just get the interface value to avoid printing
+		// pointer values. For example, if we have a `*MyType("value")`
+		// which is defined as `type MyType string`, we want to print
+		// the string value, not the pointer.
+		if v != nil {
+			vv := reflect.ValueOf(v)
+			for vv.Kind() == reflect.Ptr && !vv.IsNil() {
+				vv = vv.Elem()
+			}
+			v = vv.Interface()
 		}
 
-		tw.AppendRow(table.Row(rowSlice))
+		rowSlice[i] = v
 	}
 
-	return tw.Render(), nil
+	return rowSlice, nil
 }
 
 // parseTableStructTag returns the name of the field according to the `table`
@@ -0,0 +1,569 @@
+package cli
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"io/fs"
+	"os"
+	"path"
+	"path/filepath"
+	"regexp"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/dustin/go-humanize"
+	"github.com/pkg/sftp"
+	"golang.org/x/crypto/ssh"
+	"golang.org/x/xerrors"
+
+	"cdr.dev/slog/v3"
+	"cdr.dev/slog/v3/sloggers/sloghuman"
+	"github.com/coder/coder/v2/agent/agentssh"
+	"github.com/coder/coder/v2/cli/cliui"
+	"github.com/coder/coder/v2/codersdk"
+	"github.com/coder/coder/v2/codersdk/workspacesdk"
+	"github.com/coder/serpent"
+)
+
+// cpBufferSize is large enough for the SFTP client to pipeline requests in
+// both directions.
+const cpBufferSize = 1 << 20
+
+// cpRemoteRegex matches the workspace part of a remote path, as
+// [owner/]workspace[.agent].
+var cpRemoteRegex = regexp.MustCompile(`^([a-zA-Z0-9_-]+/)?[a-zA-Z0-9_-]+(\.[a-zA-Z0-9_-]+)?$`)
+
+// errFileTransferBlocked is returned when the agent was started with file
+// transfers blocked.
+var errFileTransferBlocked = xerrors.New("file transfers are blocked for this workspace")
+
+// cpLocation is a path on the local machine, or in a workspace if
+// workspace is set.
+type cpLocation struct {
+	workspace string
+	path      string
+}
+
+func (l cpLocation) String() string {
+	if l.workspace == "" {
+		return l.path
+	}
+	return l.workspace + ":" + l.path
+}
+
+// parseCpLocation parses a "[workspace:]path" argument. Windows drive
+// letters are never treated as workspaces, and local paths that contain a
+// colon can be prefixed with "./".
+func parseCpLocation(arg string) cpLocation {
+	if filepath.VolumeName(arg) != "" {
+		return cpLocation{path: arg}
+	}
+	workspace, p, ok := strings.Cut(arg, ":")
+	if !ok || !cpRemoteRegex.MatchString(workspace) {
+		return cpLocation{path: arg}
+	}
+	if p == "" {
+		// "workspace:" refers to the working directory of the agent.
+		p = "."
+	}
+	return cpLocation{workspace: workspace, path: p}
+}
+
+// cpFile is implemented by both *os.File and *sftp.File.
+type cpFile interface {
+	io.Reader
+	io.Writer
+	io.Seeker
+	io.Closer
+}
+
+// cpFS is a file system that files are copied to or from.
+type cpFS interface {
+	Stat(name string) (fs.FileInfo, error)
+	ReadDir(name string) ([]fs.FileInfo, error)
+	Open(name string) (cpFile, error)
+	OpenFile(name string, flag int, perm fs.FileMode) (cpFile, error)
+	MkdirAll(name string, perm fs.FileMode) error
+	Chmod(name string, perm fs.FileMode) error
+	Glob(pattern string) ([]string, error)
+	Join(elem ...string) string
+	Base(name string) string
+}
+
+type localCpFS struct{}
+
+func (localCpFS) Stat(name string) (fs.FileInfo, error) { return os.Stat(name) }
+
+func (localCpFS) ReadDir(name string) ([]fs.FileInfo, error) {
+	entries, err := os.ReadDir(name)
+	if err != nil {
+		return nil, err
+	}
+	infos := make([]fs.FileInfo, 0, len(entries))
+	for _, entry := range entries {
+		info, err := entry.Info()
+		if err != nil {
+			return nil, err
+		}
+		infos = append(infos, info)
+	}
+	return infos, nil
+}
+
+func (localCpFS) Open(name string) (cpFile, error) { return os.Open(name) }
+
+func (localCpFS) OpenFile(name string, flag int, perm fs.FileMode) (cpFile, error) {
+	return os.OpenFile(name, flag, perm)
+}
+
+func (localCpFS) MkdirAll(name string, perm fs.FileMode) error { return os.MkdirAll(name, perm) }
+func (localCpFS) Chmod(name string, perm fs.FileMode) error    { return os.Chmod(name, perm) }
+func (localCpFS) Glob(pattern string) ([]string, error)        { return filepath.Glob(pattern) }
+func (localCpFS) Join(elem ...string) string                   { return filepath.Join(elem...) }
+func (localCpFS) Base(name string) string                      { return filepath.Base(name) }
+
+// sftpCpFS is a file system in a workspace. Paths are always slash
+// separated, and relative paths are relative to the agent's working
+// directory.
+type sftpCpFS struct {
+	client *sftp.Client
+}
+
+func (s sftpCpFS) Stat(name string) (fs.FileInfo, error)      { return s.client.Stat(name) }
+func (s sftpCpFS) ReadDir(name string) ([]fs.FileInfo, error) { return s.client.ReadDir(name) }
+func (s sftpCpFS) Open(name string) (cpFile, error)           { return s.client.Open(name) }
+
+func (s sftpCpFS) OpenFile(name string, flag int, perm fs.FileMode) (cpFile, error) {
+	f, err := s.client.OpenFile(name, flag)
+	if err != nil {
+		return nil, err
+	}
+	if flag&os.O_CREATE != 0 {
+		// SFTP creates files with the server's default mode.
+		if err := f.Chmod(perm); err != nil {
+			_ = f.Close()
+			return nil, err
+		}
+	}
+	return f, nil
+}
+
+func (s sftpCpFS) MkdirAll(name string, _ fs.FileMode) error { return s.client.MkdirAll(name) }
+func (s sftpCpFS) Chmod(name string, perm fs.FileMode) error { return s.client.Chmod(name, perm) }
+func (s sftpCpFS) Glob(pattern string) ([]string, error)     { return s.client.Glob(pattern) }
+func (sftpCpFS) Join(elem ...string) string                  { return path.Join(elem...) }
+func (sftpCpFS) Base(name string) string                     { return path.Base(name) }
+
+func (r *RootCmd) cp() *serpent.Command {
+	var (
+		recursive bool
+		resume    bool
+		quiet     bool
+	)
+	cmd := &serpent.Command{
+		Annotations: workspaceCommand,
+		Use:         "cp <source>... <destination>",
+		Short:       "Copy files between your machine and workspaces",
+		Long: "Paths in a workspace are written as [owner/]workspace[.agent]:path, and relative paths are relative " +
+			"to the agent's working directory. Local paths that contain a colon must be prefixed with ./. " +
+			"Sources may be glob patterns, which are expanded on the machine that holds the files. " +
+			"Files are transferred over the workspace's SFTP server, so copies fail if the template blocks file transfers.\n\n" +
+			FormatExamples(
+				Example{
+					Description: "Copy a file to the working directory of a workspace",
+					Command:     "coder cp ./notes.txt my-workspace:",
+				},
+				Example{
+					Description: "Download a directory, resuming files that were partially copied before",
+					Command:     "coder cp -r --resume my-workspace:project/dist ./dist",
+				},
+				Example{
+					Description: "Copy log files from one workspace to another",
+					Command:     "coder cp 'src-workspace:/var/log/app/*.log' dst-workspace:/tmp/logs/",
+				},
+			),
+		Middleware: serpent.Chain(
+			serpent.RequireRangeArgs(2, -1),
+		),
+		Handler: func(inv *serpent.Invocation) error {
+			ctx, cancel := context.WithCancel(inv.Context())
+			defer cancel()
+
+			locations := make([]cpLocation, 0, len(inv.Args))
+			var workspaces []string
+			for _, arg := range inv.Args {
+				loc := parseCpLocation(arg)
+				locations = append(locations, loc)
+				if loc.workspace != "" && !slices.Contains(workspaces, loc.workspace) {
+					workspaces = append(workspaces, loc.workspace)
+				}
+			}
+			sources, dest := locations[:len(locations)-1], locations[len(locations)-1]
+
+			filesystems := map[string]cpFS{"": localCpFS{}}
+			if len(workspaces) > 0 {
+				client, err := r.InitClient(inv)
+				if err != nil {
+					return err
+				}
+				for _, workspace := range workspaces {
+					sftpClient, closeSFTP, err := r.dialCpSFTP(ctx, inv, client, workspace)
+					if err != nil {
+						return xerrors.Errorf("connect to %q: %w", workspace, err)
+					}
+					defer closeSFTP()
+					filesystems[workspace] = sftpCpFS{client: sftpClient}
+				}
+			}
+
+			c := &copier{
+				recursive: recursive,
+				resume:    resume,
+				progress:  !quiet && isTTYErr(inv),
+				stderr:    inv.Stderr,
+				buf:       make([]byte, cpBufferSize),
+			}
+
+			// Expand globs on the machine that holds the files.
+			type source struct {
+				fs   cpFS
+				path string
+				name string
+			}
+			var expanded []source
+			for _, src := range sources {
+				srcFS := filesystems[src.workspace]
+				paths := []string{src.path}
+				if hasGlobMeta(src.path) {
+					matches, err := srcFS.Glob(src.path)
+					if err != nil {
+						return xerrors.Errorf("expand %q: %w", src, err)
+					}
+					if len(matches) == 0 {
+						return xerrors.Errorf("no files match %q", src)
+					}
+					paths = matches
+				}
+				for _, p := range paths {
+					expanded = append(expanded, source{
+						fs:   srcFS,
+						path: p,
+						name: cpLocation{workspace: src.workspace, path: p}.String(),
+					})
+				}
+			}
+
+			destFS := filesystems[dest.workspace]
+			destInfo, err := destFS.Stat(dest.path)
+			switch {
+			case err == nil:
+			case errors.Is(err, fs.ErrNotExist):
+				destInfo = nil
+			default:
+				return xerrors.Errorf("stat %q: %w", dest, err)
+			}
+			intoDir := destInfo != nil && destInfo.IsDir()
+			if !intoDir && (len(expanded) > 1 || strings.HasSuffix(dest.path, "/")) {
+				if destInfo != nil {
+					return xerrors.Errorf("%q is not a directory", dest)
+				}
+				if err := destFS.MkdirAll(dest.path, 0o755); err != nil {
+					return xerrors.Errorf("create %q: %w", dest, err)
+				}
+				intoDir = true
+			}
+
+			for _, src := range expanded {
+				target := dest.path
+				if intoDir {
+					target = destFS.Join(dest.path, src.fs.Base(src.path))
+				}
+				err := c.copy(ctx, src.fs, src.path, src.name, destFS, target)
+				if err != nil {
+					return err
+				}
+			}
+			return nil
+		},
+	}
+
+	cmd.Options = serpent.OptionSet{
+		{
+			Flag:          "recursive",
+			FlagShorthand: "r",
+			Description:   "Copy directories and their contents.",
+			Value:         serpent.BoolOf(&recursive),
+		},
+		{
+			Flag:        "resume",
+			Description: "Continue copying files that were partially copied before, instead of copying them again. Files are assumed to be unchanged since the earlier copy.",
+			Value:       serpent.BoolOf(&resume),
+		},
+		{
+			Flag:          "quiet",
+			FlagShorthand: "q",
+			Description:   "Do not show progress.",
+			Value:         serpent.BoolOf(&quiet),
+		},
+	}
+	return cmd
+}
+
+// dialCpSFTP connects to the SFTP server of the agent of a workspace.
+func (r *RootCmd) dialCpSFTP(ctx context.Context, inv *serpent.Invocation, client *codersdk.Client, workspace string) (*sftp.Client, func(), error) {
+	_, workspaceAgent, _, err := GetWorkspaceAndAgent(ctx, inv, client, false, workspace)
+	if err != nil {
+		return nil, nil, err
+	}
+	appearanceConfig := initAppearance(ctx, client)
+	err = cliui.Agent(ctx, inv.Stderr, workspaceAgent.ID, cliui.AgentOptions{
+		Fetch:   client.WorkspaceAgent,
+		Wait:    false,
+		DocsURL: appearanceConfig.DocsURL,
+	})
+	if err != nil {
+		return nil, nil, xerrors.Errorf("await agent: %w", err)
+	}
+
+	opts := &workspacesdk.DialAgentOptions{}
+	if r.verbose {
+		opts.Logger = inv.Logger.AppendSinks(sloghuman.Sink(inv.Stderr)).Leveled(slog.LevelDebug)
+	}
+	if r.disableDirect {
+		opts.BlockEndpoints = true
+	}
+	if !r.disableNetworkTelemetry {
+		opts.EnableTelemetry = true
+	}
+	conn, err := workspacesdk.New(client).DialAgent(ctx, workspaceAgent.ID, opts)
+	if err != nil {
+		return nil, nil, err
+	}
+	sshClient, err := conn.SSHClient(ctx)
+	if err != nil {
+		_ = conn.Close()
+		return nil, nil, xerrors.Errorf("ssh client: %w", err)
+	}
+	session, err := sshClient.NewSession()
+	if err != nil {
+		_ = sshClient.Close()
+		_ = conn.Close()
+		return nil, nil, xerrors.Errorf("ssh session: %w", err)
+	}
+	closeAll := func() {
+		_ = session.Close()
+		_ = sshClient.Close()
+		_ = conn.Close()
+	}
+
+	stdin, err := session.StdinPipe()
+	if err != nil {
+		closeAll()
+		return nil, nil, xerrors.Errorf("stdin pipe: %w", err)
+	}
+	stdout, err := session.StdoutPipe()
+	if err != nil {
+		closeAll()
+		return nil, nil, xerrors.Errorf("stdout pipe: %w", err)
+	}
+	if err := session.RequestSubsystem("sftp"); err != nil {
+		closeAll()
+		return nil, nil, xerrors.Errorf("request sftp: %w", err)
+	}
+	sftpClient, err := sftp.NewClientPipe(stdout, stdin)
+	if err != nil {
+		// The agent ends the session with a dedicated exit code when the
+		// template blocks file transfers.
+		var exitErr *ssh.ExitError
+		if waitErr := session.Wait(); errors.As(waitErr, &exitErr) && exitErr.ExitStatus() == agentssh.BlockedFileTransferErrorCode {
+			closeAll()
+			return nil, nil, errFileTransferBlocked
+		}
+		closeAll()
+		return nil, nil, xerrors.Errorf("start sftp: %w", err)
+	}
+	return sftpClient, func() {
+		_ = sftpClient.Close()
+		closeAll()
+	}, nil
+}
+
+// copier copies files and directories between file systems.
+type copier struct {
+	recursive bool
+	resume    bool
+	progress  bool
+	stderr    io.Writer
+	buf       []byte
+}
+
+func (c *copier) copy(ctx context.Context, srcFS cpFS, src, name string, dstFS cpFS, dst string) error {
+	info, err := srcFS.Stat(src)
+	if err != nil {
+		return xerrors.Errorf("stat %q: %w", name, err)
+	}
+	if !info.IsDir() {
+		return c.copyFile(ctx, srcFS, src, name, info, dstFS, dst)
+	}
+	if !c.recursive {
+		return xerrors.Errorf("%q is a directory, use --recursive to copy it", name)
+	}
+
+	if err := dstFS.MkdirAll(dst, info.Mode().Perm()|0o700); err != nil {
+		return xerrors.Errorf("create directory %q: %w", dst, err)
+	}
+	entries, err := srcFS.ReadDir(src)
+	if err != nil {
+		return xerrors.Errorf("read directory %q: %w", name, err)
+	}
+	for _, entry := range entries {
+		if err := ctx.Err(); err != nil {
+			return err
+		}
+		childName := strings.TrimSuffix(name, "/") + "/" + entry.Name()
+		if entry.Mode()&fs.ModeSymlink != 0 {
+			// Follow symlinks to files, but not to directories, which could
+			// cause loops.
+			target, err := srcFS.Stat(srcFS.Join(src, entry.Name()))
+			if err != nil || target.IsDir() {
+				_, _ = fmt.Fprintf(c.stderr, "Skipping symlink %s\n", childName)
+				continue
+			}
+		} else if !entry.IsDir() && !entry.Mode().IsRegular() {
+			_, _ = fmt.Fprintf(c.stderr, "Skipping %s, not a regular file\n", childName)
+			continue
+		}
+		err := c.copy(ctx, srcFS, srcFS.Join(src, entry.Name()), childName, dstFS, dstFS.Join(dst, entry.Name()))
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (c *copier) copyFile(ctx context.Context, srcFS cpFS, src, name string, info fs.FileInfo, dstFS cpFS, dst string) error {
+	var offset int64
+	flag := os.O_WRONLY | os.O_CREATE | os.O_TRUNC
+	if c.resume {
+		dstInfo, err := dstFS.Stat(dst)
+		if err == nil && dstInfo.Mode().IsRegular() && dstInfo.Size() <= info.Size() {
+			if dstInfo.Size() == info.Size() {
+				return nil
+			}
+			offset = dstInfo.Size()
+			flag = os.O_WRONLY
+		}
+	}
+
+	in, err := srcFS.Open(src)
+	if err != nil {
+		return xerrors.Errorf("open %q: %w", name, err)
+	}
+	defer in.Close()
+	out, err := dstFS.OpenFile(dst, flag, info.Mode().Perm())
+	if err != nil {
+		return xerrors.Errorf("create %q: %w", dst, err)
+	}
+	defer out.Close()
+	if offset > 0 {
+		if _, err := in.Seek(offset, io.SeekStart); err != nil {
+			return xerrors.Errorf("seek %q: %w", name, err)
+		}
+		if _, err := out.Seek(offset, io.SeekStart); err != nil {
+			return xerrors.Errorf("seek %q: %w", dst, err)
+		}
+	}
+
+	var progress *cpProgress
+	var r io.Reader = in
+	if c.progress {
+		progress = newCpProgress(c.stderr, name, offset, info.Size())
+		r = io.TeeReader(in, progress)
+	}
+	// Hide ReadFrom and WriteTo so that the large buffer is used.
+	_, err = io.CopyBuffer(struct{ io.Writer }{out}, struct{ io.Reader }{readerWithContext(ctx, r)}, c.buf)
+	if progress != nil {
+		progress.done()
+	}
+	if err != nil {
+		return xerrors.Errorf("copy %q: %w", name, err)
+	}
+	if err := out.Close(); err != nil {
+		return xerrors.Errorf("close %q: %w", dst, err)
+	}
+	// The mode of an existing file is not changed by opening it.
+	if err := dstFS.Chmod(dst, info.Mode().Perm()); err != nil {
+		return xerrors.Errorf("chmod %q: %w", dst, err)
+	}
+	return nil
+}
+
+// readerWithContext stops reads once ctx is canceled.
+func readerWithContext(ctx context.Context, r io.Reader) io.Reader {
+	return readerFunc(func(p []byte) (int, error) {
+		if err := ctx.Err(); err != nil {
+			return 0, err
+		}
+		return r.Read(p)
+	})
+}
+
+type readerFunc func(p []byte) (int, error)
+
+func (f readerFunc) Read(p []byte) (int, error) { return f(p) }
+
+// cpProgress renders a progress bar for a single file.
+type cpProgress struct {
+	w        io.Writer
+	name     string
+	start    time.Time
+	initial  int64
+	copied   int64
+	total    int64
+	rendered time.Time
+}
+
+func newCpProgress(w io.Writer, name string, copied, total int64) *cpProgress {
+	p := &cpProgress{w: w, name: name, start: time.Now(), initial: copied, copied: copied, total: total}
+	p.render()
+	return p
+}
+
+func (p *cpProgress) Write(b []byte) (int, error) {
+	p.copied += int64(len(b))
+	if time.Since(p.rendered) >= 100*time.Millisecond {
+		p.render()
+	}
+	return len(b), nil
+}
+
+func (p *cpProgress) render() {
+	p.rendered = time.Now()
+	const width = 30
+	fraction := 1.0
+	if p.total > 0 {
+		fraction = float64(p.copied) / float64(p.total)
+	}
+	// Files may grow while they are copied, such as logs.
+	fraction = min(fraction, 1)
+	filled := int(fraction * width)
+	bar := strings.Repeat("=", filled) + strings.Repeat(" ", width-filled)
+	var rate string
+	if elapsed := time.Since(p.start).Seconds(); elapsed > 0 {
+		rate = humanize.Bytes(uint64(float64(p.copied-p.initial)/elapsed)) + "/s" //nolint:gosec // copied is never less than initial.
+	}
+	_, _ = fmt.Fprintf(p.w, "\r\033[K%s [%s] %3.0f%% %s/%s %s",
+		p.name, bar, fraction*100, humanize.Bytes(uint64(p.copied)), humanize.Bytes(uint64(p.total)), rate) //nolint:gosec // Sizes are never negative.
+}
+
+func (p *cpProgress) done() {
+	p.render()
+	_, _ = fmt.Fprintln(p.w)
+}
+
+func hasGlobMeta(p string) bool {
+	return strings.ContainsAny(p, "*?[")
+}
@@ -0,0 +1,47 @@
+package cli
+
+import (
+	"bytes"
+	"runtime"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseCpLocation(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		arg  string
+		want cpLocation
+	}{
+		{arg: "file.txt", want: cpLocation{path: "file.txt"}},
+		{arg: "/tmp/file.txt", want: cpLocation{path: "/tmp/file.txt"}},
+		{arg: "my-workspace:file.txt", want: cpLocation{workspace: "my-workspace", path: "file.txt"}},
+		{arg: "my-workspace:", want: cpLocation{workspace: "my-workspace", path: "."}},
+		{arg: "alice/my-workspace.main:/tmp/*.log", want: cpLocation{workspace: "alice/my-workspace.main", path: "/tmp/*.log"}},
+		{arg: "./file:with:colons", want: cpLocation{path: "./file:with:colons"}},
+		{arg: "dir/sub/file:name", want: cpLocation{path: "dir/sub/file:name"}},
+	}
+	if runtime.GOOS == "windows" {
+		tests = append(tests, struct {
+			arg  string
+			want cpLocation
+		}{arg: `C:\Users\coder\file.txt`, want: cpLocation{path: `C:\Users\coder\file.txt`}})
+	}
+	for _, tt := range tests {
+		require.Equal(t, tt.want, parseCpLocation(tt.arg), tt.arg)
+	}
+}
+
+func TestCpProgressGrowingFile(t *testing.T) {
+	t.Parallel()
+
+	var buf bytes.Buffer
+	p := newCpProgress(&buf, "app.log", 0, 10)
+	// The file grew after its size was read.
+	_, err := p.Write(make([]byte, 25))
+	require.NoError(t, err)
+	require.NotPanics(t, p.done)
+	require.Contains(t, buf.String(), "[==============================] 100%")
+}
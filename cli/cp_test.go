@@ -0,0 +1,141 @@
+package cli_test
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"runtime"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/coder/coder/v2/agent"
+	"github.com/coder/coder/v2/agent/agenttest"
+	"github.com/coder/coder/v2/cli/clitest"
+	"github.com/coder/coder/v2/coderd/coderdtest"
+	"github.com/coder/coder/v2/testutil"
+)
+
+func TestCp(t *testing.T) {
+	t.Parallel()
+	if runtime.GOOS == "windows" {
+		t.Skip("Remote paths are slash separated, which the agent running locally does not understand on Windows")
+	}
+
+	client, workspace, agentToken := setupWorkspaceForAgent(t)
+	_ = agenttest.New(t, client.URL, agentToken)
+	coderdtest.AwaitWorkspaceAgents(t, client, workspace.ID)
+
+	writeFile := func(t *testing.T, name, content string) {
+		t.Helper()
+		require.NoError(t, os.MkdirAll(filepath.Dir(name), 0o755))
+		require.NoError(t, os.WriteFile(name, []byte(content), 0o600))
+	}
+	runCp := func(t *testing.T, args ...string) error {
+		t.Helper()
+		ctx, cancel := context.WithTimeout(context.Background(), testutil.WaitLong)
+		defer cancel()
+		inv, root := clitest.New(t, append([]string{"cp"}, args...)...)
+		clitest.SetupConfig(t, client, root)
+		return inv.WithContext(ctx).Run()
+	}
+
+	t.Run("Upload", func(t *testing.T) {
+		t.Parallel()
+
+		local := filepath.Join(t.TempDir(), "upload.txt")
+		writeFile(t, local, "hello")
+		remoteDir := t.TempDir()
+
+		require.NoError(t, runCp(t, local, workspace.Name+":"+remoteDir))
+		got, err := os.ReadFile(filepath.Join(remoteDir, "upload.txt"))
+		require.NoError(t, err)
+		require.Equal(t, "hello", string(got))
+	})
+
+	t.Run("DownloadDirectory", func(t *testing.T) {
+		t.Parallel()
+
+		remote := t.TempDir()
+		writeFile(t, filepath.Join(remote, "a.txt"), "a")
+		writeFile(t, filepath.Join(remote, "nested", "b.txt"), "b")
+		dest := filepath.Join(t.TempDir(), "download")
+
+		err := runCp(t, workspace.Name+":"+remote, dest)
+		require.ErrorContains(t, err, "--recursive")
+
+		require.NoError(t, runCp(t, "-r", workspace.Name+":"+remote, dest))
+		got, err := os.ReadFile(filepath.Join(dest, "nested", "b.txt"))
+		require.NoError(t, err)
+		require.Equal(t, "b", string(got))
+	})
+
+	t.Run("Glob", func(t *testing.T) {
+		t.Parallel()
+
+		remote := t.TempDir()
+		writeFile(t, filepath.Join(remote, "one.log"), "1")
+		writeFile(t, filepath.Join(remote, "two.log"), "2")
+		writeFile(t, filepath.Join(remote, "three.txt"), "3")
+		dest := filepath.Join(t.TempDir(), "logs")
+
+		require.NoError(t, runCp(t, workspace.Name+":"+filepath.Join(remote, "*.log"), dest))
+		entries, err := os.ReadDir(dest)
+		require.NoError(t, err)
+		require.Len(t, entries, 2)
+	})
+
+	t.Run("WorkspaceToWorkspace", func(t *testing.T) {
+		t.Parallel()
+
+		src := filepath.Join(t.TempDir(), "src.txt")
+		writeFile(t, src, "between workspaces")
+		dst := filepath.Join(t.TempDir(), "dst.txt")
+
+		require.NoError(t, runCp(t, workspace.Name+":"+src, workspace.Name+":"+dst))
+		got, err := os.ReadFile(dst)
+		require.NoError(t, err)
+		require.Equal(t, "between workspaces", string(got))
+	})
+
+	t.Run("Resume", func(t *testing.T) {
+		t.Parallel()
+
+		local := filepath.Join(t.TempDir(), "resume.txt")
+		writeFile(t, local, "0123456789")
+		remote := filepath.Join(t.TempDir(), "resume.txt")
+		// Resuming continues from the end of the existing file, so a
+		// different prefix shows that it was not copied again.
+		writeFile(t, remote, "abcde")
+
+		require.NoError(t, runCp(t, "--resume", local, workspace.Name+":"+remote))
+		got, err := os.ReadFile(remote)
+		require.NoError(t, err)
+		require.Equal(t, "abcde56789", string(got))
+
+		require.NoError(t, runCp(t, local, workspace.Name+":"+remote))
+		got, err = os.ReadFile(remote)
+		require.NoError(t, err)
+		require.Equal(t, "0123456789", string(got))
+	})
+}
+
+func TestCpBlockedFileTransfer(t *testing.T) {
+	t.Parallel()
+
+	client, workspace, agentToken := setupWorkspaceForAgent(t)
+	_ = agenttest.New(t, client.URL, agentToken, func(o *agent.Options) {
+		o.BlockFileTransfer = true
+	})
+	coderdtest.AwaitWorkspaceAgents(t, client, workspace.ID)
+
+	local := filepath.Join(t.TempDir(), "blocked.txt")
+	require.NoError(t, os.WriteFile(local, []byte("blocked"), 0o600))
+
+	ctx, cancel := context.WithTimeout(context.Background(), testutil.WaitLong)
+	defer cancel()
+	inv, root := clitest.New(t, "cp", local, workspace.Name+":"+t.TempDir())
+	clitest.SetupConfig(t, client, root)
+	err := inv.WithContext(ctx).Run()
+	require.ErrorContains(t, err, "file transfers are blocked")
+}
@@ -0,0 +1,196 @@
+package cli
+
+import (
+	"fmt"
+	"strings"
+
+	"golang.org/x/xerrors"
+
+	"cdr.dev/slog/v3"
+	"cdr.dev/slog/v3/sloggers/sloghuman"
+	"github.com/coder/coder/v2/cli/cliui"
+	"github.com/coder/coder/v2/codersdk/workspacesdk"
+	"github.com/coder/serpent"
+)
+
+type findTableRow struct {
+	Path         string  `table:"path,nosort"`
+	AbsolutePath string  `table:"absolute path"`
+	Type         string  `table:"type"`
+	Score        float32 `table:"score"`
+}
+
+func (r *RootCmd) find() *serpent.Command {
+	var (
+		root      string
+		limit     int64
+		watch     bool
+		formatter = cliui.NewOutputFormatter(
+			cliui.ChangeFormatterData(cliui.TextFormat(), func(data any) (any, error) {
+				resp, ok := data.(workspacesdk.FindResponse)
+				if !ok {
+					return nil, xerrors.Errorf("expected workspacesdk.FindResponse, got %T", data)
+				}
+				paths := make([]string, 0, len(resp.Results))
+				for _, result := range resp.Results {
+					paths = append(paths, result.AbsolutePath)
+				}
+				return strings.Join(paths, "\n"), nil
+			}),
+			cliui.ChangeFormatterData(
+				cliui.TableFormat([]findTableRow{}, []string{"path", "type"}),
+				func(data any) (any, error) {
+					resp, ok := data.(workspacesdk.FindResponse)
+					if !ok {
+						return nil, xerrors.Errorf("expected workspacesdk.FindResponse, got %T", data)
+					}
+					rows := make([]findTableRow, 0, len(resp.Results))
+					for _, result := range resp.Results {
+						typ := "file"
+						if result.IsDir {
+							typ = "directory"
+						}
+						rows = append(rows, findTableRow{
+							Path:         result.Path,
+							AbsolutePath: result.AbsolutePath,
+							Type:         typ,
+							Score:        result.Score,
+						})
+					}
+					return rows, nil
+				},
+			),
+			cliui.JSONFormat(),
+		)
+	)
+	cmd := &serpent.Command{
+		Annotations: workspaceCommand,
+		Use:         "find <workspace> <query>",
+		Short:       "Fuzzy search for files in a workspace",
+		Long: "Matches are ranked by how well their path matches the query, best match first. " +
+			"The first search in a directory indexes it, so it can take a while in large repositories; " +
+			"the agent keeps the index up to date afterwards.\n\n" +
+			FormatExamples(
+				Example{
+					Description: "Find files whose path matches \"handler\" in the agent's working directory",
+					Command:     "coder find my-workspace handler",
+				},
+				Example{
+					Description: "Search another directory and print updated results as files change",
+					Command:     "coder find my-workspace api/routes --root /home/coder/monorepo --watch",
+				},
+			),
+		Middleware: serpent.Chain(
+			serpent.RequireNArgs(2),
+		),
+		Handler: func(inv *serpent.Invocation) error {
+			ctx := inv.Context()
+			client, err := r.InitClient(inv)
+			if err != nil {
+				return err
+			}
+			appearanceConfig := initAppearance(ctx, client)
+
+			if limit < 1 {
+				return xerrors.New("--limit must be at least 1")
+			}
+
+			_, workspaceAgent, _, err := GetWorkspaceAndAgent(ctx, inv, client, false, inv.Args[0])
+			if err != nil {
+				return err
+			}
+			err = cliui.Agent(ctx, inv.Stderr, workspaceAgent.ID, cliui.AgentOptions{
+				Fetch:   client.WorkspaceAgent,
+				Wait:    false,
+				DocsURL: appearanceConfig.DocsURL,
+			})
+			if err != nil {
+				return xerrors.Errorf("await agent: %w", err)
+			}
+
+			logger := inv.Logger
+			opts := &workspacesdk.DialAgentOptions{}
+			if r.verbose {
+				logger = logger.AppendSinks(sloghuman.Sink(inv.Stderr)).Leveled(slog.LevelDebug)
+				opts.Logger = logger
+			}
+			if r.disableDirect {
+				opts.BlockEndpoints = true
+			}
+			if !r.disableNetworkTelemetry {
+				opts.EnableTelemetry = true
+			}
+			conn, err := workspacesdk.New(client).DialAgent(ctx, workspaceAgent.ID, opts)
+			if err != nil {
+				return err
+			}
+			defer conn.Close()
+
+			req := workspacesdk.FindRequest{
+				Query: inv.Args[1],
+				Root:  root,
+				Limit: int(limit),
+			}
+			write := func(resp workspacesdk.FindResponse) error {
+				out, err := formatter.Format(ctx, resp)
+				if err != nil {
+					return err
+				}
+				if out == "" {
+					return nil
+				}
+				_, err = fmt.Fprintln(inv.Stdout, out)
+				return err
+			}
+
+			if !watch {
+				resp, err := conn.Find(ctx, req)
+				if err != nil {
+					return xerrors.Errorf("find: %w", err)
+				}
+				return write(resp)
+			}
+
+			updates, closer, err := conn.WatchFind(ctx, logger, req)
+			if err != nil {
+				return xerrors.Errorf("watch find: %w", err)
+			}
+			defer closer.Close()
+			for {
+				select {
+				case <-ctx.Done():
+					return nil
+				case resp, ok := <-updates:
+					if !ok {
+						return xerrors.New("connection to the agent closed")
+					}
+					if err := write(resp); err != nil {
+						return err
+					}
+				}
+			}
+		},
+	}
+
+	cmd.Options = serpent.OptionSet{
+		{
+			Flag:        "root",
+			Description: "Absolute path of the directory in the workspace to search, which must be within the agent's working directory or home directory. Defaults to the agent's working directory.",
+			Value:       serpent.StringOf(&root),
+		},
+		{
+			Flag:        "limit",
+			Description: "Maximum number of results.",
+			Default:     "50",
+			Value:       serpent.Int64Of(&limit),
+		},
+		{
+			Flag:          "watch",
+			FlagShorthand: "w",
+			Description:   "Keep running and print the results again whenever files in the workspace change.",
+			Value:         serpent.BoolOf(&watch),
+		},
+	}
+	formatter.AttachOptions(&cmd.Options)
+	return cmd
+}
@@ -0,0 +1,69 @@
+package cli_test
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/coder/coder/v2/agent/agenttest"
+	"github.com/coder/coder/v2/cli/clitest"
+	"github.com/coder/coder/v2/coderd/coderdtest"
+	"github.com/coder/coder/v2/codersdk/workspacesdk"
+	"github.com/coder/coder/v2/testutil"
+)
+
+func TestFind(t *testing.T) {
+	t.Parallel()
+
+	client, workspace, agentToken := setupWorkspaceForAgent(t)
+	_ = agenttest.New(t, client.URL, agentToken)
+	coderdtest.AwaitWorkspaceAgents(t, client, workspace.ID)
+
+	dir := t.TempDir()
+	for _, name := range []string{"cmd/server/main.go", "site/src/main.tsx", "README.md"} {
+		full := filepath.Join(dir, filepath.FromSlash(name))
+		require.NoError(t, os.MkdirAll(filepath.Dir(full), 0o755))
+		require.NoError(t, os.WriteFile(full, []byte("data"), 0o600))
+	}
+
+	t.Run("Text", func(t *testing.T) {
+		t.Parallel()
+
+		ctx, cancel := context.WithTimeout(context.Background(), testutil.WaitLong)
+		defer cancel()
+
+		inv, root := clitest.New(t, "find", workspace.Name, "server/main", "--root", dir)
+		clitest.SetupConfig(t, client, root)
+		var stdout bytes.Buffer
+		inv.Stdout = &stdout
+		require.NoError(t, inv.WithContext(ctx).Run())
+
+		lines := strings.Split(strings.TrimSpace(stdout.String()), "\n")
+		require.NotEmpty(t, lines)
+		require.Equal(t, filepath.Join(dir, "cmd", "server", "main.go"), lines[0])
+	})
+
+	t.Run("JSON", func(t *testing.T) {
+		t.Parallel()
+
+		ctx, cancel := context.WithTimeout(context.Background(), testutil.WaitLong)
+		defer cancel()
+
+		inv, root := clitest.New(t, "find", workspace.Name, "main", "--root", dir, "--limit", "1", "-o", "json")
+		clitest.SetupConfig(t, client, root)
+		var stdout bytes.Buffer
+		inv.Stdout = &stdout
+		require.NoError(t, inv.WithContext(ctx).Run())
+
+		var resp workspacesdk.FindResponse
+		require.NoError(t, json.Unmarshal(stdout.Bytes(), &resp))
+		require.Equal(t, dir, resp.Root)
+		require.Len(t, resp.Results, 1)
+	})
+}
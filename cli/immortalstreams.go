@@ -0,0 +1,55 @@
+package cli
+
+import (
+	"context"
+	"errors"
+	"net/http"
+
+	gossh "golang.org/x/crypto/ssh"
+	"golang.org/x/xerrors"
+
+	"cdr.dev/slog/v3"
+	"github.com/coder/coder/v2/cli/cliui"
+	"github.com/coder/coder/v2/codersdk"
+	"github.com/coder/coder/v2/codersdk/workspacesdk"
+	"github.com/coder/serpent"
+)
+
+// immortalStreamsSupported reports whether the agent supports immortal
+// streams. Older agents do not, in which case a warning is printed and the
+// caller should fall back to regular connections.
+func immortalStreamsSupported(ctx context.Context, inv *serpent.Invocation, conn workspacesdk.AgentConn) (bool, error) {
+	_, err := conn.ListImmortalStreams(ctx)
+	if err == nil {
+		return true, nil
+	}
+	var sdkErr *codersdk.Error
+	if errors.As(err, &sdkErr) && sdkErr.StatusCode() == http.StatusNotFound {
+		cliui.Warn(inv.Stderr,
+			"The workspace agent does not support immortal streams, so the connection will not survive network changes.",
+			"Update the workspace to use the latest agent version.",
+		)
+		return false, nil
+	}
+	return false, xerrors.Errorf("check immortal stream support: %w", err)
+}
+
+// immortalSSHClient creates an SSH client over an immortal stream to the
+// agent's SSH server.
+func immortalSSHClient(ctx context.Context, conn workspacesdk.AgentConn, logger slog.Logger) (*gossh.Client, error) {
+	netConn, err := workspacesdk.DialImmortalStream(ctx, conn, workspacesdk.AgentSSHPort, logger)
+	if err != nil {
+		return nil, xerrors.Errorf("dial immortal stream: %w", err)
+	}
+	sshConn, channels, requests, err := gossh.NewClientConn(netConn, "localhost:22", &gossh.ClientConfig{
+		// SSH host validation isn't helpful, because obtaining a peer
+		// connection already signifies user-intent to dial a workspace.
+		// #nosec
+		HostKeyCallback: gossh.InsecureIgnoreHostKey(),
+	})
+	if err != nil {
+		_ = netConn.Close()
+		return nil, xerrors.Errorf("ssh conn: %w", err)
+	}
+	return gossh.NewClient(sshConn, channels, requests), nil
+}
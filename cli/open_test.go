@@ -322,6 +322,14 @@ func (*fakeContainerCLI) Remove(ctx context.Context, containerID string) error {
 	return nil
 }
 
+func (*fakeContainerCLI) StopProject(ctx context.Context, project string) error {
+	return nil
+}
+
+func (*fakeContainerCLI) RemoveProject(ctx context.Context, project string) error {
+	return nil
+}
+
 type fakeDevcontainerCLI struct {
 	config    agentcontainers.DevcontainerConfig
 	execAgent func(ctx context.Context, token string) error
@@ -38,6 +38,7 @@ func (r *RootCmd) portForward() *serpent.Command {
 		tcpForwards      []string // <port>:<port>
 		udpForwards      []string // <port>:<port>
 		disableAutostart bool
+		immortal         bool
 	)
 	cmd := &serpent.Command{
 		Use:     "port-forward <workspace>",
@@ -128,6 +129,13 @@ func (r *RootCmd) portForward() *serpent.Command {
 			}
 			defer conn.Close()
 
+			if immortal {
+				immortal, err = immortalStreamsSupported(ctx, inv, conn)
+				if err != nil {
+					return err
+				}
+			}
+
 			// Start all listeners.
 			var (
 				wg                = new(sync.WaitGroup)
@@ -149,7 +157,7 @@ func (r *RootCmd) portForward() *serpent.Command {
 					// first, opportunistically try to listen on IPv6
 					spec6 := spec
 					spec6.listenHost = ipv6Loopback
-					l6, err6 := listenAndPortForward(ctx, inv, conn, wg, spec6, logger)
+					l6, err6 := listenAndPortForward(ctx, inv, conn, wg, spec6, immortal, logger)
 					if err6 != nil {
 						logger.Info(ctx, "failed to opportunistically listen on IPv6", slog.F("spec", spec), slog.Error(err6))
 					} else {
@@ -157,7 +165,7 @@ func (r *RootCmd) portForward() *serpent.Command {
 					}
 					spec.listenHost = ipv4Loopback
 				}
-				l, err := listenAndPortForward(ctx, inv, conn, wg, spec, logger)
+				l, err := listenAndPortForward(ctx, inv, conn, wg, spec, immortal, logger)
 				if err != nil {
 					logger.Error(ctx, "failed to listen", slog.F("spec", spec), slog.Error(err))
 					return err
@@ -213,6 +221,12 @@ func (r *RootCmd) portForward() *serpent.Command {
 			Description: "Forward UDP port(s) from the workspace to the local machine. The UDP connection has TCP-like semantics to support stateful UDP protocols.",
 			Value:       serpent.StringArrayOf(&udpForwards),
 		},
+		{
+			Flag:        "immortal",
+			Env:         "CODER_PORT_FORWARD_IMMORTAL",
+			Description: "Keep forwarded TCP connections alive through network changes and laptop sleep by reconnecting to them and replaying any data lost in transit. Requires a workspace agent that supports immortal streams.",
+			Value:       serpent.BoolOf(&immortal),
+		},
 		sshDisableAutostartOption(serpent.BoolOf(&disableAutostart)),
 	}
 
@@ -225,6 +239,7 @@ func listenAndPortForward(
 	conn workspacesdk.AgentConn,
 	wg *sync.WaitGroup,
 	spec portForwardSpec,
+	immortal bool,
 	logger slog.Logger,
 ) (net.Listener, error) {
 	logger = logger.With(
@@ -265,7 +280,15 @@ func listenAndPortForward(
 
 			go func(netConn net.Conn) {
 				defer netConn.Close()
-				remoteConn, err := conn.DialContext(ctx, spec.network, dialAddress)
+				var (
+					remoteConn net.Conn
+					err        error
+				)
+				if immortal && spec.network == "tcp" {
+					remoteConn, err = workspacesdk.DialImmortalStream(ctx, conn, spec.dialPort, logger)
+				} else {
+					remoteConn, err = conn.DialContext(ctx, spec.network, dialAddress)
+				}
 				if err != nil {
 					_, _ = fmt.Fprintf(inv.Stderr,
 						"Failed to dial '%s://%s' in workspace: %s\n",
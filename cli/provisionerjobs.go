@@ -3,6 +3,8 @@ package cli
 import (
 	"fmt"
 	"slices"
+	"strconv"
+	"time"
 
 	"github.com/google/uuid"
 	"golang.org/x/xerrors"
@@ -25,6 +27,7 @@ func (r *RootCmd) provisionerJobs() *serpent.Command {
 		Children: []*serpent.Command{
 			r.provisionerJobsCancel(),
 			r.provisionerJobsList(),
+			r.provisionerJobsPriorities(),
 		},
 	}
 	return cmd
@@ -40,7 +43,7 @@ func (r *RootCmd) provisionerJobsList() *serpent.Command {
 	var (
 		orgContext = NewOrganizationContext()
 		formatter  = cliui.NewOutputFormatter(
-			cliui.TableFormat([]provisionerJobRow{}, []string{"created at", "id", "type", "template display name", "status", "queue", "tags"}),
+			cliui.TableFormat([]provisionerJobRow{}, []string{"created at", "id", "type", "template display name", "status", "priority", "queue", "tags"}),
 			cliui.JSONFormat(),
 		)
 		status    []string
@@ -191,6 +194,10 @@ func (r *RootCmd) provisionerJobsCancel() *serpent.Command {
 			case codersdk.ProvisionerJobTypeWorkspaceBuild:
 				_, _ = fmt.Fprintf(inv.Stdout, "Canceling workspace build job %s...\n", job.ID)
 				err = client.CancelWorkspaceBuild(ctx, ptr.NilToEmpty(job.Input.WorkspaceBuildID), codersdk.CancelWorkspaceBuildParams{})
+			case codersdk.ProvisionerJobTypeWorkspaceDriftCheck:
+				// Drift checks never modify the workspace, so there is
+				// nothing to gain from canceling one.
+				return xerrors.Errorf("workspace drift check job %s can not be canceled", job.ID)
 			}
 			if err != nil {
 				return xerrors.Errorf("cancel provisioner job: %w", err)
@@ -206,3 +213,178 @@ func (r *RootCmd) provisionerJobsCancel() *serpent.Command {
 
 	return cmd
 }
+
+func (r *RootCmd) provisionerJobsPriorities() *serpent.Command {
+	cmd := &serpent.Command{
+		Use:   "priorities",
+		Short: "Manage priority boosts of provisioner jobs",
+		Long: "Jobs are queued by priority class: interactive builds first, then template imports, then automated builds such as autostart and prebuilds. " +
+			"A boost is added to the priority of every job in the organization, or of a single template, to reorder jobs within a class.",
+		Handler: func(inv *serpent.Invocation) error {
+			return inv.Command.HelpHandler(inv)
+		},
+		Aliases: []string{"priority"},
+		Children: []*serpent.Command{
+			r.provisionerJobsPrioritiesList(),
+			r.provisionerJobsPrioritiesSet(),
+		},
+	}
+	return cmd
+}
+
+func (r *RootCmd) provisionerJobsPrioritiesList() *serpent.Command {
+	type priorityRow struct {
+		Template  string    `json:"template" table:"template,default_sort"`
+		Priority  int32     `json:"priority" table:"priority"`
+		UpdatedAt time.Time `json:"updated_at" table:"updated at"`
+	}
+
+	var (
+		orgContext = NewOrganizationContext()
+		formatter  = cliui.NewOutputFormatter(
+			cliui.TableFormat([]priorityRow{}, []string{"template", "priority", "updated at"}),
+			cliui.JSONFormat(),
+		)
+	)
+
+	cmd := &serpent.Command{
+		Use:     "list",
+		Short:   "List priority boosts of provisioner jobs",
+		Aliases: []string{"ls"},
+		Middleware: serpent.Chain(
+			serpent.RequireNArgs(0),
+		),
+		Handler: func(inv *serpent.Invocation) error {
+			ctx := inv.Context()
+			client, err := r.InitClient(inv)
+			if err != nil {
+				return err
+			}
+			org, err := orgContext.Selected(inv, client)
+			if err != nil {
+				return xerrors.Errorf("current organization: %w", err)
+			}
+
+			priorities, err := client.OrganizationProvisionerJobPriorities(ctx, org.ID)
+			if err != nil {
+				return xerrors.Errorf("list provisioner job priorities: %w", err)
+			}
+			if len(priorities) == 0 {
+				cliui.Infof(inv.Stderr, "No provisioner job priorities found.")
+				return nil
+			}
+
+			templates, err := client.TemplatesByOrganization(ctx, org.ID)
+			if err != nil {
+				return xerrors.Errorf("list templates: %w", err)
+			}
+			templateNames := make(map[uuid.UUID]string, len(templates))
+			for _, template := range templates {
+				templateNames[template.ID] = template.Name
+			}
+
+			rows := make([]priorityRow, 0, len(priorities))
+			for _, priority := range priorities {
+				row := priorityRow{
+					Template:  "(organization)",
+					Priority:  priority.Priority,
+					UpdatedAt: priority.UpdatedAt,
+				}
+				if priority.TemplateID != nil {
+					row.Template = templateNames[*priority.TemplateID]
+					if row.Template == "" {
+						row.Template = priority.TemplateID.String()
+					}
+				}
+				rows = append(rows, row)
+			}
+
+			out, err := formatter.Format(ctx, rows)
+			if err != nil {
+				return xerrors.Errorf("display provisioner job priorities: %w", err)
+			}
+			_, _ = fmt.Fprintln(inv.Stdout, out)
+			return nil
+		},
+	}
+
+	orgContext.AttachOptions(cmd)
+	formatter.AttachOptions(&cmd.Options)
+
+	return cmd
+}
+
+func (r *RootCmd) provisionerJobsPrioritiesSet() *serpent.Command {
+	var (
+		orgContext   = NewOrganizationContext()
+		templateName string
+	)
+
+	cmd := &serpent.Command{
+		Use:   "set <priority>",
+		Short: "Set the priority boost of an organization or template",
+		Long: fmt.Sprintf("The boost must be between %d and %d. A boost of 0 removes it.\n\n",
+			-codersdk.MaxProvisionerJobPriorityBoost, codersdk.MaxProvisionerJobPriorityBoost) +
+			FormatExamples(
+				Example{
+					Description: "Queue jobs of the organization ahead of other jobs of the same class",
+					Command:     "coder provisioner jobs priorities set 10",
+				},
+				Example{
+					Description: "Queue jobs of a template behind other jobs of the same class",
+					Command:     "coder provisioner jobs priorities set --template nightly -- -20",
+				},
+			),
+		Middleware: serpent.Chain(
+			serpent.RequireNArgs(1),
+		),
+		Handler: func(inv *serpent.Invocation) error {
+			ctx := inv.Context()
+			client, err := r.InitClient(inv)
+			if err != nil {
+				return err
+			}
+			org, err := orgContext.Selected(inv, client)
+			if err != nil {
+				return xerrors.Errorf("current organization: %w", err)
+			}
+
+			priority, err := strconv.ParseInt(inv.Args[0], 10, 32)
+			if err != nil {
+				return xerrors.Errorf("invalid priority %q: %w", inv.Args[0], err)
+			}
+			req := codersdk.UpdateProvisionerJobPriorityRequest{
+				Priority: int32(priority),
+			}
+			target := "organization " + org.HumanName()
+			if templateName != "" {
+				template, err := client.TemplateByName(ctx, org.ID, templateName)
+				if err != nil {
+					return xerrors.Errorf("get template: %w", err)
+				}
+				req.TemplateID = &template.ID
+				target = "template " + template.Name
+			}
+
+			_, err = client.UpdateOrganizationProvisionerJobPriority(ctx, org.ID, req)
+			if err != nil {
+				return xerrors.Errorf("update provisioner job priority: %w", err)
+			}
+
+			_, _ = fmt.Fprintf(inv.Stdout, "Set the provisioner job priority boost of %s to %d\n", target, req.Priority)
+			return nil
+		},
+	}
+
+	cmd.Options = serpent.OptionSet{
+		{
+			Flag:          "template",
+			FlagShorthand: "t",
+			Description:   "Set the boost of a single template instead of the whole organization.",
+			Value:         serpent.StringOf(&templateName),
+		},
+	}
+	orgContext.AttachOptions(cmd)
+
+	return cmd
+}
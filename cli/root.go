@@ -126,9 +126,11 @@ func (r *RootCmd) CoreSubcommands() []*serpent.Command {
 		// Workspace Commands
 		r.autoupdate(),
 		r.configSSH(),
+		r.cp(),
 		r.Create(CreateOptions{}),
 		r.deleteWorkspace(),
 		r.favorite(),
+		r.find(),
 		r.list(),
 		r.logs(),
 		r.open(),
@@ -142,9 +144,11 @@ func (r *RootCmd) CoreSubcommands() []*serpent.Command {
 		r.start(),
 		r.stat(),
 		r.stop(),
+		r.syncWorkspace(),
 		r.unfavorite(),
 		r.update(),
 		r.whoami(),
+		r.workspaces(),
 
 		// Hidden
 		r.connectCmd(),
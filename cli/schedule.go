@@ -20,6 +20,7 @@ const (
 	scheduleShowDescriptionLong = `Shows the following information for the given workspace(s):
   * The automatic start schedule
   * The next scheduled start time
+  * The next scheduled start that will be skipped due to an exception date
   * The duration after which it will stop
   * The next scheduled stop time
 `
@@ -83,6 +84,7 @@ func (r *RootCmd) scheduleShow() *serpent.Command {
 					"workspace",
 					"starts at",
 					"starts next",
+					"skips next",
 					"stops after",
 					"stops next",
 				},
@@ -353,6 +355,7 @@ type scheduleListRow struct {
 	WorkspaceName string `json:"workspace" table:"workspace,default_sort"`
 	StartsAt      string `json:"starts_at" table:"starts at"`
 	StartsNext    string `json:"starts_next" table:"starts next"`
+	SkipsNext     string `json:"skips_next" table:"skips next"`
 	StopsAfter    string `json:"stops_after" table:"stops after"`
 	StopsNext     string `json:"stops_next" table:"stops next"`
 }
@@ -360,12 +363,23 @@ type scheduleListRow struct {
 func scheduleListRowFromWorkspace(now time.Time, workspace codersdk.Workspace) scheduleListRow {
 	autostartDisplay := ""
 	nextStartDisplay := ""
+	nextSkipDisplay := ""
 	if !ptr.NilOrEmpty(workspace.AutostartSchedule) {
 		if sched, err := cron.Weekly(*workspace.AutostartSchedule); err == nil {
 			autostartDisplay = sched.Humanize()
-			nextStartDisplay = timeDisplay(sched.Next(now))
+			next := sched.Next(now)
+			// If the next autostart falls on an exception date, the
+			// workspace starts at the next one that does not.
+			if workspace.NextSkippedAutostart != nil && next.Equal(*workspace.NextSkippedAutostart) &&
+				workspace.NextStartAt != nil && workspace.NextStartAt.After(next) {
+				next = workspace.NextStartAt.In(next.Location())
+			}
+			nextStartDisplay = timeDisplay(next)
 		}
 	}
+	if workspace.NextSkippedAutostart != nil {
+		nextSkipDisplay = timeDisplay(*workspace.NextSkippedAutostart)
+	}
 
 	autostopDisplay := ""
 	nextStopDisplay := ""
@@ -380,6 +394,7 @@ func scheduleListRowFromWorkspace(now time.Time, workspace codersdk.Workspace) s
 		WorkspaceName: workspace.OwnerName + "/" + workspace.Name,
 		StartsAt:      autostartDisplay,
 		StartsNext:    nextStartDisplay,
+		SkipsNext:     nextSkipDisplay,
 		StopsAfter:    autostopDisplay,
 		StopsNext:     nextStopDisplay,
 	}
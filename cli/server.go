@@ -11,6 +11,7 @@ import (
 	"crypto/tls"
 	"crypto/x509"
 	"database/sql"
+	"encoding/json"
 	"errors"
 	"flag"
 	"fmt"
@@ -71,16 +72,19 @@ import (
 	"github.com/coder/coder/v2/coderd/cryptokeys"
 	"github.com/coder/coder/v2/coderd/database"
 	"github.com/coder/coder/v2/coderd/database/awsiamrds"
+	"github.com/coder/coder/v2/coderd/database/dbarchive"
 	"github.com/coder/coder/v2/coderd/database/dbauthz"
 	"github.com/coder/coder/v2/coderd/database/dbmetrics"
 	"github.com/coder/coder/v2/coderd/database/dbpurge"
 	"github.com/coder/coder/v2/coderd/database/migrations"
 	"github.com/coder/coder/v2/coderd/database/pubsub"
 	"github.com/coder/coder/v2/coderd/devtunnel"
+	"github.com/coder/coder/v2/coderd/driftdetect"
 	"github.com/coder/coder/v2/coderd/entitlements"
 	"github.com/coder/coder/v2/coderd/externalauth"
 	"github.com/coder/coder/v2/coderd/gitsshkey"
 	"github.com/coder/coder/v2/coderd/httpmw"
+	"github.com/coder/coder/v2/coderd/idpsync"
 	"github.com/coder/coder/v2/coderd/jobreaper"
 	"github.com/coder/coder/v2/coderd/notifications"
 	"github.com/coder/coder/v2/coderd/notifications/reports"
@@ -93,6 +97,7 @@ import (
 	"github.com/coder/coder/v2/coderd/runtimeconfig"
 	"github.com/coder/coder/v2/coderd/schedule"
 	"github.com/coder/coder/v2/coderd/telemetry"
+	"github.com/coder/coder/v2/coderd/templaterollout"
 	"github.com/coder/coder/v2/coderd/tracing"
 	"github.com/coder/coder/v2/coderd/updatecheck"
 	"github.com/coder/coder/v2/coderd/util/ptr"
@@ -173,37 +178,70 @@ func oidcAuthLinks(ctx context.Context, logger slog.Logger, cli *http.Client, va
 }
 
 func createOIDCConfig(ctx context.Context, logger slog.Logger, vals *codersdk.DeploymentValues) (*coderd.OIDCConfig, error) {
-	if vals.OIDC.ClientID == "" {
+	return newOIDCConfig(ctx, logger, vals.AccessURL.Value(), &vals.OIDC, "/api/v2/users/oidc/callback")
+}
+
+// createOIDCProviderConfigs sets up the named OIDC providers. Each provider
+// gets its own callback path and IdP sync settings.
+func createOIDCProviderConfigs(ctx context.Context, logger slog.Logger, vals *codersdk.DeploymentValues, providers []codersdk.OIDCProviderConfig) ([]*coderd.OIDCConfig, error) {
+	configs := make([]*coderd.OIDCConfig, 0, len(providers))
+	ids := make(map[string]struct{}, len(providers))
+	for _, provider := range providers {
+		if err := codersdk.NameValid(provider.ID); err != nil {
+			return nil, xerrors.Errorf("invalid oidc provider id %q: %w", provider.ID, err)
+		}
+		if _, ok := ids[provider.ID]; ok {
+			return nil, xerrors.Errorf("multiple oidc providers with id %q", provider.ID)
+		}
+		ids[provider.ID] = struct{}{}
+
+		oidcVals, err := provider.OIDCConfig()
+		if err != nil {
+			return nil, xerrors.Errorf("oidc provider %q: %w", provider.ID, err)
+		}
+		cfg, err := newOIDCConfig(ctx, logger.With(slog.F("oidc_provider", provider.ID)), vals.AccessURL.Value(), &oidcVals, fmt.Sprintf("/api/v2/users/oidc/%s/callback", provider.ID))
+		if err != nil {
+			return nil, xerrors.Errorf("oidc provider %q: %w", provider.ID, err)
+		}
+		cfg.ID = provider.ID
+		cfg.SyncSettings = idpsync.FromOIDCConfig(oidcVals)
+		configs = append(configs, cfg)
+	}
+	return configs, nil
+}
+
+func newOIDCConfig(ctx context.Context, logger slog.Logger, accessURL *url.URL, vals *codersdk.OIDCConfig, callbackPath string) (*coderd.OIDCConfig, error) {
+	if vals.ClientID == "" {
 		return nil, xerrors.Errorf("OIDC client ID must be set!")
 	}
-	if vals.OIDC.IssuerURL == "" {
+	if vals.IssuerURL == "" {
 		return nil, xerrors.Errorf("OIDC issuer URL must be set!")
 	}
 
 	// Skipping issuer checks is not recommended.
-	if vals.OIDC.SkipIssuerChecks {
+	if vals.SkipIssuerChecks {
 		logger.Warn(ctx, "issuer checks with OIDC is disabled. This is not recommended as it can compromise the security of the authentication")
-		ctx = oidc.InsecureIssuerURLContext(ctx, vals.OIDC.IssuerURL.String())
+		ctx = oidc.InsecureIssuerURLContext(ctx, vals.IssuerURL.String())
 	}
 
 	oidcProvider, err := oidc.NewProvider(
-		ctx, vals.OIDC.IssuerURL.String(),
+		ctx, vals.IssuerURL.String(),
 	)
 	if err != nil {
 		return nil, xerrors.Errorf("configure oidc provider: %w", err)
 	}
-	redirectURL, err := vals.AccessURL.Value().Parse("/api/v2/users/oidc/callback")
+	redirectURL, err := accessURL.Parse(callbackPath)
 	if err != nil {
 		return nil, xerrors.Errorf("parse oidc oauth callback url: %w", err)
 	}
 
-	if vals.OIDC.RedirectURL.String() != "" {
-		redirectURL, err = vals.OIDC.RedirectURL.Value().Parse("/api/v2/users/oidc/callback")
+	if vals.RedirectURL.String() != "" {
+		redirectURL, err = vals.RedirectURL.Value().Parse(callbackPath)
 		if err != nil {
 			return nil, xerrors.Errorf("parse oidc redirect url %q", err)
 		}
 		logger.Warn(ctx, "custom OIDC redirect URL used instead of 'access_url', ensure this matches the value configured in your OIDC provider")
-		if len(vals.OIDC.RedirectAllowedHosts.Value()) > 0 {
+		if len(vals.RedirectAllowedHosts.Value()) > 0 {
 			// Static override takes precedence; keep the behavior explicit and
 			// loud rather than silently mixing the two modes.
 			logger.Warn(ctx, "ignoring CODER_OIDC_REDIRECT_ALLOWED_HOSTS because CODER_OIDC_REDIRECT_URL is set")
@@ -216,50 +254,50 @@ func createOIDCConfig(ctx context.Context, logger slog.Logger, vals *codersdk.De
 
 	// If the scopes contain 'groups', we enable group support.
 	// Do not override any custom value set by the user.
-	if slice.Contains(vals.OIDC.Scopes, "groups") && vals.OIDC.GroupField == "" {
-		vals.OIDC.GroupField = "groups"
+	if slice.Contains(vals.Scopes, "groups") && vals.GroupField == "" {
+		vals.GroupField = "groups"
 	}
 	oauthCfg := &oauth2.Config{
-		ClientID:     vals.OIDC.ClientID.String(),
-		ClientSecret: vals.OIDC.ClientSecret.String(),
+		ClientID:     vals.ClientID.String(),
+		ClientSecret: vals.ClientSecret.String(),
 		RedirectURL:  redirectURL.String(),
 		Endpoint:     oidcProvider.Endpoint(),
-		Scopes:       vals.OIDC.Scopes,
+		Scopes:       vals.Scopes,
 	}
 
 	var useCfg promoauth.OAuth2Config = oauthCfg
-	if vals.OIDC.ClientKeyFile != "" {
+	if vals.ClientKeyFile != "" {
 		// PKI authentication is done in the params. If a
 		// counter example is found, we can add a config option to
 		// change this.
 		oauthCfg.Endpoint.AuthStyle = oauth2.AuthStyleInParams
-		if vals.OIDC.ClientSecret != "" {
+		if vals.ClientSecret != "" {
 			return nil, xerrors.Errorf("cannot specify both oidc client secret and oidc client key file")
 		}
 
-		pkiCfg, err := configureOIDCPKI(oauthCfg, vals.OIDC.ClientKeyFile.Value(), vals.OIDC.ClientCertFile.Value())
+		pkiCfg, err := configureOIDCPKI(oauthCfg, vals.ClientKeyFile.Value(), vals.ClientCertFile.Value())
 		if err != nil {
 			return nil, xerrors.Errorf("configure oauth pki authentication: %w", err)
 		}
 		useCfg = pkiCfg
 	}
-	if len(vals.OIDC.GroupAllowList) > 0 && vals.OIDC.GroupField == "" {
+	if len(vals.GroupAllowList) > 0 && vals.GroupField == "" {
 		return nil, xerrors.Errorf("'oidc-group-field' must be set if 'oidc-allowed-groups' is set. Either unset 'oidc-allowed-groups' or set 'oidc-group-field'")
 	}
 
 	groupAllowList := make(map[string]bool)
-	for _, group := range vals.OIDC.GroupAllowList.Value() {
+	for _, group := range vals.GroupAllowList.Value() {
 		groupAllowList[group] = true
 	}
 
 	secondaryClaimsSrc := coderd.MergedClaimsSourceUserInfo
-	if !vals.OIDC.IgnoreUserInfo && vals.OIDC.UserInfoFromAccessToken {
+	if !vals.IgnoreUserInfo && vals.UserInfoFromAccessToken {
 		return nil, xerrors.Errorf("to use 'oidc-access-token-claims', 'oidc-ignore-userinfo' must be set to 'false'")
 	}
-	if vals.OIDC.IgnoreUserInfo {
+	if vals.IgnoreUserInfo {
 		secondaryClaimsSrc = coderd.MergedClaimsSourceNone
 	}
-	if vals.OIDC.UserInfoFromAccessToken {
+	if vals.UserInfoFromAccessToken {
 		secondaryClaimsSrc = coderd.MergedClaimsSourceAccessToken
 	}
 
@@ -275,8 +313,8 @@ func createOIDCConfig(ctx context.Context, logger slog.Logger, vals *codersdk.De
 	// is fixed at startup and dynamic-host selection is disabled. Otherwise,
 	// surface the allowlist to the middleware.
 	var redirectAllowedHosts []string
-	if vals.OIDC.RedirectURL.String() == "" {
-		redirectAllowedHosts = vals.OIDC.RedirectAllowedHosts.Value()
+	if vals.RedirectURL.String() == "" {
+		redirectAllowedHosts = vals.RedirectAllowedHosts.Value()
 	} else {
 		// Static-override mode does not need the dynamic default scheme.
 		redirectDefaultScheme = ""
@@ -286,24 +324,24 @@ func createOIDCConfig(ctx context.Context, logger slog.Logger, vals *codersdk.De
 		OAuth2Config: useCfg,
 		Provider:     oidcProvider,
 		Verifier: oidcProvider.Verifier(&oidc.Config{
-			ClientID: vals.OIDC.ClientID.String(),
+			ClientID: vals.ClientID.String(),
 			// Enabling this skips checking the "iss" claim in the token
 			// matches the issuer URL. This is not recommended.
-			SkipIssuerCheck: vals.OIDC.SkipIssuerChecks.Value(),
+			SkipIssuerCheck: vals.SkipIssuerChecks.Value(),
 		}),
-		EmailDomain:           vals.OIDC.EmailDomain,
-		AllowSignups:          vals.OIDC.AllowSignups.Value(),
-		UsernameField:         vals.OIDC.UsernameField.String(),
-		NameField:             vals.OIDC.NameField.String(),
-		EmailField:            vals.OIDC.EmailField.String(),
-		AuthURLParams:         vals.OIDC.AuthURLParams.Value,
+		EmailDomain:           vals.EmailDomain,
+		AllowSignups:          vals.AllowSignups.Value(),
+		UsernameField:         vals.UsernameField.String(),
+		NameField:             vals.NameField.String(),
+		EmailField:            vals.EmailField.String(),
+		AuthURLParams:         vals.AuthURLParams.Value,
 		SecondaryClaims:       secondaryClaimsSrc,
-		SignInText:            vals.OIDC.SignInText.String(),
-		SignupsDisabledText:   vals.OIDC.SignupsDisabledText.String(),
-		IconURL:               vals.OIDC.IconURL.String(),
-		IgnoreEmailVerified:   vals.OIDC.IgnoreEmailVerified.Value(),
+		SignInText:            vals.SignInText.String(),
+		SignupsDisabledText:   vals.SignupsDisabledText.String(),
+		IconURL:               vals.IconURL.String(),
+		IgnoreEmailVerified:   vals.IgnoreEmailVerified.Value(),
 		PKCEMethods:           pkceSupport.CodeChallengeMethodsSupported,
-		EmailFallback:         vals.OIDC.EmailFallback.Value(),
+		EmailFallback:         vals.EmailFallback.Value(),
 		RedirectAllowedHosts:  redirectAllowedHosts,
 		RedirectDefaultScheme: redirectDefaultScheme,
 	}, nil
@@ -954,6 +992,16 @@ func (r *RootCmd) Server(newAPI func(context.Context, *coderd.Options) (*coderd.
 				}
 			}
 
+			oidcProvidersEnv, err := ReadOIDCProvidersFromEnv(os.Environ())
+			if err != nil {
+				return xerrors.Errorf("read oidc providers from env: %w", err)
+			}
+			vals.OIDCProviders.Value = append(vals.OIDCProviders.Value, oidcProvidersEnv...)
+			options.OIDCProviders, err = createOIDCProviderConfigs(ctx, options.Logger, vals, vals.OIDCProviders.Value)
+			if err != nil {
+				return xerrors.Errorf("create oidc provider configs: %w", err)
+			}
+
 			extAuthEnv, err := ReadExternalAuthProvidersFromEnv(os.Environ())
 			if err != nil {
 				return xerrors.Errorf("read external auth providers from env: %w", err)
@@ -1287,7 +1335,15 @@ func (r *RootCmd) Server(newAPI func(context.Context, *coderd.Options) (*coderd.
 			defer shutdownConns()
 
 			// Ensures that old database entries are cleaned up over time!
-			purger := dbpurge.New(ctx, logger.Named("dbpurge"), options.Database, options.DeploymentValues, options.PrometheusRegistry)
+			var purgeOpts []dbpurge.Option
+			if archiveURL := vals.Retention.ArchiveURL.String(); archiveURL != "" {
+				archiveSink, err := dbarchive.OpenSink(ctx, archiveURL)
+				if err != nil {
+					return xerrors.Errorf("open retention archive: %w", err)
+				}
+				purgeOpts = append(purgeOpts, dbpurge.WithArchiveSink(archiveSink))
+			}
+			purger := dbpurge.New(ctx, logger.Named("dbpurge"), options.Database, options.DeploymentValues, options.PrometheusRegistry, purgeOpts...)
 			defer purger.Close()
 
 			// Updates workspace usage
@@ -1368,6 +1424,18 @@ func (r *RootCmd) Server(newAPI func(context.Context, *coderd.Options) (*coderd.
 			jobReaper.Start()
 			defer jobReaper.Close()
 
+			driftDetectorTicker := time.NewTicker(driftdetect.Interval)
+			defer driftDetectorTicker.Stop()
+			driftDetector := driftdetect.New(ctx, options.Database, options.Pubsub, logger.Named("drift_detector"), driftDetectorTicker.C)
+			driftDetector.Start()
+			defer driftDetector.Close()
+
+			rolloutEvaluatorTicker := time.NewTicker(templaterollout.EvaluationInterval)
+			defer rolloutEvaluatorTicker.Stop()
+			rolloutEvaluator := templaterollout.NewEvaluator(ctx, options.Database, logger.Named("template_rollout_evaluator"), rolloutEvaluatorTicker.C)
+			rolloutEvaluator.Start()
+			defer rolloutEvaluator.Close()
+
 			waitForProvisionerJobs := false
 			// Currently there is no way to ask the server to shut
 			// itself down, so any exit signal will result in a non-zero
@@ -1557,6 +1625,7 @@ func (r *RootCmd) Server(newAPI func(context.Context, *coderd.Options) (*coderd.
 	createAdminUserCmd := r.newCreateAdminUserCommand()
 	regenerateVapidKeypairCmd := r.newRegenerateVapidKeypairCommand()
 	fixOIDCLinksCmd := r.newFixOIDCLinksCommand()
+	importArchiveCmd := r.newImportArchiveCommand()
 
 	rawURLOpt := serpent.Option{
 		Flag: "raw-url",
@@ -1570,7 +1639,7 @@ func (r *RootCmd) Server(newAPI func(context.Context, *coderd.Options) (*coderd.
 
 	serverCmd.Children = append(
 		serverCmd.Children,
-		createAdminUserCmd, postgresBuiltinURLCmd, postgresBuiltinServeCmd, regenerateVapidKeypairCmd, fixOIDCLinksCmd,
+		createAdminUserCmd, postgresBuiltinURLCmd, postgresBuiltinServeCmd, regenerateVapidKeypairCmd, fixOIDCLinksCmd, importArchiveCmd,
 	)
 
 	return serverCmd
@@ -3171,6 +3240,139 @@ func parseExternalAuthProvidersFromEnv(prefix string, environ []string) ([]coder
 	return providers, nil
 }
 
+// ReadOIDCProvidersFromEnv parses CODER_OIDC_PROVIDER_<N>_<KEY> environment
+// variables into named OIDC providers. This follows the same indexed pattern
+// as ReadExternalAuthProvidersFromEnv.
+func ReadOIDCProvidersFromEnv(environ []string) ([]codersdk.OIDCProviderConfig, error) {
+	parsed := serpent.ParseEnviron(environ, "CODER_OIDC_PROVIDER_")
+
+	// Sort by numeric index so that PROVIDER_2 comes before PROVIDER_10.
+	slices.SortFunc(parsed, func(a, b serpent.EnvVar) int {
+		aIdx, _ := strconv.Atoi(strings.SplitN(a.Name, "_", 2)[0])
+		bIdx, _ := strconv.Atoi(strings.SplitN(b.Name, "_", 2)[0])
+		if aIdx != bIdx {
+			return aIdx - bIdx
+		}
+		return strings.Compare(a.Name, b.Name)
+	})
+
+	var providers []codersdk.OIDCProviderConfig
+	for _, v := range parsed {
+		tokens := strings.SplitN(v.Name, "_", 2)
+		if len(tokens) != 2 {
+			return nil, xerrors.Errorf("invalid env var: %s", v.Name)
+		}
+
+		providerNum, err := strconv.Atoi(tokens[0])
+		if err != nil {
+			return nil, xerrors.Errorf("parse number: %s", v.Name)
+		}
+
+		var provider codersdk.OIDCProviderConfig
+		switch {
+		case len(providers) < providerNum:
+			return nil, xerrors.Errorf(
+				"provider num %v skipped: %s",
+				len(providers),
+				v.Name,
+			)
+		case len(providers) == providerNum:
+			// At the next next provider.
+			providers = append(providers, provider)
+		case len(providers) == providerNum+1:
+			// At the current provider.
+			provider = providers[providerNum]
+		}
+
+		parseBool := func() (bool, error) {
+			b, err := strconv.ParseBool(v.Value)
+			if err != nil {
+				return false, xerrors.Errorf("parse bool: %s", v.Value)
+			}
+			return b, nil
+		}
+		parseJSON := func(dst any) error {
+			if err := json.Unmarshal([]byte(v.Value), dst); err != nil {
+				return xerrors.Errorf("parse json %s: %w", v.Name, err)
+			}
+			return nil
+		}
+
+		key := tokens[1]
+		switch key {
+		case "ID":
+			provider.ID = v.Value
+		case "CLIENT_ID":
+			provider.ClientID = v.Value
+		case "CLIENT_SECRET":
+			provider.ClientSecret = v.Value
+		case "CLIENT_KEY_FILE":
+			provider.ClientKeyFile = v.Value
+		case "CLIENT_CERT_FILE":
+			provider.ClientCertFile = v.Value
+		case "ISSUER_URL":
+			provider.IssuerURL = v.Value
+		case "SCOPES":
+			provider.Scopes = strings.Split(v.Value, ",")
+		case "EMAIL_DOMAIN":
+			provider.EmailDomain = strings.Split(v.Value, ",")
+		case "ALLOW_SIGNUPS":
+			provider.AllowSignups, err = parseBool()
+		case "IGNORE_EMAIL_VERIFIED":
+			provider.IgnoreEmailVerified, err = parseBool()
+		case "USERNAME_FIELD":
+			provider.UsernameField = v.Value
+		case "NAME_FIELD":
+			provider.NameField = v.Value
+		case "EMAIL_FIELD":
+			provider.EmailField = v.Value
+		case "AUTH_URL_PARAMS":
+			err = parseJSON(&provider.AuthURLParams)
+		case "IGNORE_USERINFO":
+			provider.IgnoreUserInfo, err = parseBool()
+		case "USERINFO_FROM_ACCESS_TOKEN":
+			provider.UserInfoFromAccessToken, err = parseBool()
+		case "SKIP_ISSUER_CHECKS":
+			provider.SkipIssuerChecks, err = parseBool()
+		case "SIGN_IN_TEXT":
+			provider.SignInText = v.Value
+		case "ICON_URL":
+			provider.IconURL = v.Value
+		case "SIGNUPS_DISABLED_TEXT":
+			provider.SignupsDisabledText = v.Value
+		case "EMAIL_FALLBACK":
+			provider.EmailFallback, err = parseBool()
+		case "ORGANIZATION_FIELD":
+			provider.OrganizationField = v.Value
+		case "ORGANIZATION_MAPPING":
+			err = parseJSON(&provider.OrganizationMapping)
+		case "ORGANIZATION_ASSIGN_DEFAULT":
+			provider.OrganizationAssignDefault, err = parseBool()
+		case "GROUP_AUTO_CREATE":
+			provider.GroupAutoCreate, err = parseBool()
+		case "GROUP_REGEX_FILTER":
+			provider.GroupRegexFilter = v.Value
+		case "ALLOWED_GROUPS":
+			provider.GroupAllowList = strings.Split(v.Value, ",")
+		case "GROUP_FIELD":
+			provider.GroupField = v.Value
+		case "GROUP_MAPPING":
+			err = parseJSON(&provider.GroupMapping)
+		case "USER_ROLE_FIELD":
+			provider.UserRoleField = v.Value
+		case "USER_ROLE_MAPPING":
+			err = parseJSON(&provider.UserRoleMapping)
+		case "USER_ROLE_DEFAULT":
+			provider.UserRolesDefault = strings.Split(v.Value, ",")
+		}
+		if err != nil {
+			return nil, err
+		}
+		providers[providerNum] = provider
+	}
+	return providers, nil
+}
+
 const (
 	aiGatewayProviderEnvPrefix = "CODER_AI_GATEWAY_PROVIDER_"
 	aiBridgeProviderEnvPrefix  = "CODER_AIBRIDGE_PROVIDER_"
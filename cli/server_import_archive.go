@@ -0,0 +1,200 @@
+//go:build !slim
+
+package cli
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+
+	"github.com/google/uuid"
+	"golang.org/x/xerrors"
+
+	"cdr.dev/slog/v3"
+	"cdr.dev/slog/v3/sloggers/sloghuman"
+	"github.com/coder/coder/v2/cli/cliui"
+	"github.com/coder/coder/v2/coderd/database"
+	"github.com/coder/coder/v2/coderd/database/awsiamrds"
+	"github.com/coder/coder/v2/coderd/database/dbarchive"
+	"github.com/coder/coder/v2/coderd/database/dbtime"
+	"github.com/coder/coder/v2/codersdk"
+	"github.com/coder/serpent"
+)
+
+// importArchiveBatchSize is the number of records inserted per query.
+const importArchiveBatchSize = 1000
+
+func (r *RootCmd) newImportArchiveCommand() *serpent.Command {
+	var (
+		pgURL      string
+		pgAuth     string
+		archiveURL string
+	)
+	importArchiveCmd := &serpent.Command{
+		Use:   "import-archive [name...]",
+		Short: "Import archives of purged records into read-only views for querying.",
+		Long: "Archives written when --retention-archive-url is set are imported into the " +
+			"archived_audit_logs, archived_connection_logs, archived_workspace_agent_logs and " +
+			"archived_aibridge_interceptions views. Without arguments, every archive that has " +
+			"not been imported yet is imported.",
+		Handler: func(inv *serpent.Invocation) error {
+			var (
+				ctx, cancel = inv.SignalNotifyContext(inv.Context(), StopSignals...)
+				cfg         = r.createConfig()
+				logger      = inv.Logger.AppendSinks(sloghuman.Sink(inv.Stderr))
+			)
+			if r.verbose {
+				logger = logger.Leveled(slog.LevelDebug)
+			}
+			defer cancel()
+
+			if archiveURL == "" {
+				return xerrors.New("the --archive-url flag is required")
+			}
+			sink, err := dbarchive.OpenSink(ctx, archiveURL)
+			if err != nil {
+				return xerrors.Errorf("open archive: %w", err)
+			}
+
+			var manifests []dbarchive.Manifest
+			if len(inv.Args) == 0 {
+				manifests, err = dbarchive.ReadManifests(ctx, sink)
+				if err != nil {
+					return xerrors.Errorf("read manifests: %w", err)
+				}
+			} else {
+				for _, name := range inv.Args {
+					manifest, err := dbarchive.ReadManifest(ctx, sink, name)
+					if err != nil {
+						return err
+					}
+					manifests = append(manifests, manifest)
+				}
+			}
+
+			if pgURL == "" {
+				cliui.Infof(inv.Stdout, "Using built-in PostgreSQL (%s)", cfg.PostgresPath())
+				url, closePg, err := startBuiltinPostgres(ctx, cfg, logger, "")
+				if err != nil {
+					return err
+				}
+				defer func() {
+					_ = closePg()
+				}()
+				pgURL = url
+			}
+
+			sqlDriver := "postgres"
+			if codersdk.PostgresAuth(pgAuth) == codersdk.PostgresAuthAWSIAMRDS {
+				sqlDriver, err = awsiamrds.Register(inv.Context(), sqlDriver)
+				if err != nil {
+					return xerrors.Errorf("register aws rds iam auth: %w", err)
+				}
+			}
+
+			sqlDB, err := ConnectToPostgres(ctx, logger, sqlDriver, pgURL, nil)
+			if err != nil {
+				return xerrors.Errorf("connect to postgres: %w", err)
+			}
+			defer func() {
+				_ = sqlDB.Close()
+			}()
+			db := database.New(sqlDB)
+
+			imports, err := db.GetPurgeArchiveImports(ctx)
+			if err != nil {
+				return xerrors.Errorf("get imported archives: %w", err)
+			}
+			imported := make(map[string]bool, len(imports))
+			for _, imp := range imports {
+				imported[imp.Name] = true
+			}
+
+			var count int
+			for _, manifest := range manifests {
+				if imported[manifest.Name] {
+					_, _ = fmt.Fprintf(inv.Stdout, "Skipping %s, already imported\n", manifest.Name)
+					continue
+				}
+
+				// Import each archive in its own transaction, so that it is
+				// either fully imported or not at all.
+				err := db.InTx(func(tx database.Store) error {
+					imp, err := tx.InsertPurgeArchiveImport(ctx, database.InsertPurgeArchiveImportParams{
+						ID:         uuid.New(),
+						Name:       manifest.Name,
+						RecordType: string(manifest.RecordType),
+						RowCount:   manifest.Rows,
+						ImportedAt: dbtime.Now(),
+					})
+					if err != nil {
+						return xerrors.Errorf("insert import: %w", err)
+					}
+
+					batch := make([]string, 0, importArchiveBatchSize)
+					flush := func() error {
+						if len(batch) == 0 {
+							return nil
+						}
+						err := tx.InsertPurgeArchiveRecords(ctx, database.InsertPurgeArchiveRecordsParams{
+							ImportID: imp.ID,
+							Records:  batch,
+						})
+						if err != nil {
+							return xerrors.Errorf("insert records: %w", err)
+						}
+						batch = batch[:0]
+						return nil
+					}
+					// ReadRecords verifies the archive after the last
+					// record, so a corrupt archive rolls back the import.
+					err = dbarchive.ReadRecords(ctx, sink, manifest, func(record json.RawMessage) error {
+						batch = append(batch, string(record))
+						if len(batch) < importArchiveBatchSize {
+							return nil
+						}
+						return flush()
+					})
+					if err != nil {
+						return err
+					}
+					return flush()
+				}, nil)
+				if err != nil {
+					return xerrors.Errorf("import %s: %w", manifest.Name, err)
+				}
+				_, _ = fmt.Fprintf(inv.Stdout, "Imported %s (%d %s)\n", manifest.Name, manifest.Rows, strings.ReplaceAll(string(manifest.RecordType), "_", " "))
+				count++
+			}
+
+			cliui.Infof(inv.Stdout, "Imported %d archives. Query them through the archived_audit_logs, archived_connection_logs, archived_workspace_agent_logs and archived_aibridge_interceptions views.", count)
+			return nil
+		},
+	}
+
+	importArchiveCmd.Options.Add(
+		serpent.Option{
+			Env:         "CODER_PG_CONNECTION_URL",
+			Flag:        "postgres-url",
+			Description: "URL of a PostgreSQL database. If empty, the built-in PostgreSQL deployment will be used (Coder must not be already running in this case).",
+			Value:       serpent.StringOf(&pgURL),
+		},
+		serpent.Option{
+			Name:        "Postgres Connection Auth",
+			Description: "Type of auth to use when connecting to postgres.",
+			Flag:        "postgres-connection-auth",
+			Env:         "CODER_PG_CONNECTION_AUTH",
+			Default:     "password",
+			Value:       serpent.EnumOf(&pgAuth, codersdk.PostgresAuthDrivers...),
+		},
+		serpent.Option{
+			Name:        "Archive URL",
+			Description: "The directory or S3 URL that archives are read from. See --retention-archive-url on the server command.",
+			Flag:        "archive-url",
+			Env:         "CODER_RETENTION_ARCHIVE_URL",
+			Value:       serpent.StringOf(&archiveURL),
+		},
+	)
+
+	return importArchiveCmd
+}
@@ -0,0 +1,94 @@
+package cli_test
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/require"
+
+	"github.com/coder/coder/v2/cli/clitest"
+	"github.com/coder/coder/v2/coderd/database"
+	"github.com/coder/coder/v2/coderd/database/dbarchive"
+	"github.com/coder/coder/v2/coderd/database/dbtestutil"
+	"github.com/coder/coder/v2/testutil"
+	"github.com/coder/coder/v2/testutil/expecter"
+)
+
+func TestImportArchive(t *testing.T) {
+	t.Parallel()
+
+	ctx, cancel := context.WithTimeout(context.Background(), testutil.WaitLong)
+	t.Cleanup(cancel)
+
+	connectionURL, err := dbtestutil.Open(t)
+	require.NoError(t, err)
+	sqlDB, err := sql.Open("postgres", connectionURL)
+	require.NoError(t, err)
+	defer sqlDB.Close()
+	db := database.New(sqlDB)
+
+	archiveDir := t.TempDir()
+	sink, err := dbarchive.NewDirSink(archiveDir)
+	require.NoError(t, err)
+
+	logID := uuid.New()
+	logTime := time.Date(2019, time.March, 4, 12, 0, 0, 0, time.UTC)
+	w, err := dbarchive.NewWriter(dbarchive.RecordTypeAuditLogs)
+	require.NoError(t, err)
+	defer w.Close()
+	record, err := json.Marshal(map[string]any{
+		"id":              logID,
+		"time":            logTime,
+		"user_id":         uuid.New(),
+		"organization_id": uuid.New(),
+		"ip":              "127.0.0.1",
+		"user_agent":      "curl",
+		"resource_type":   "workspace",
+		"resource_id":     uuid.New(),
+		"resource_target": "my-workspace",
+		"action":          "create",
+		"diff":            map[string]any{},
+		"status_code":     200,
+		"request_id":      uuid.New(),
+	})
+	require.NoError(t, err)
+	require.NoError(t, w.Write(record))
+	manifest, err := w.Commit(ctx, sink, logTime.Add(time.Hour), logTime.Add(2*time.Hour))
+	require.NoError(t, err)
+
+	inv, _ := clitest.New(t, "server", "import-archive", "--postgres-url", connectionURL, "--archive-url", archiveDir)
+	stdout := expecter.NewAttachedToInvocation(t, inv)
+	clitest.Start(t, inv)
+	stdout.ExpectMatch(ctx, "Imported "+manifest.Name+" (1 audit logs)")
+	stdout.ExpectMatch(ctx, "Imported 1 archives.")
+
+	var (
+		archivedID     uuid.UUID
+		archivedTime   time.Time
+		archivedAction string
+		archiveName    string
+	)
+	err = sqlDB.QueryRowContext(ctx, `SELECT id, "time", action, archive_name FROM archived_audit_logs`).
+		Scan(&archivedID, &archivedTime, &archivedAction, &archiveName)
+	require.NoError(t, err)
+	require.Equal(t, logID, archivedID)
+	require.True(t, logTime.Equal(archivedTime))
+	require.Equal(t, "create", archivedAction)
+	require.Equal(t, manifest.Name, archiveName)
+
+	// Archives are only imported once.
+	inv, _ = clitest.New(t, "server", "import-archive", "--postgres-url", connectionURL, "--archive-url", archiveDir)
+	stdout = expecter.NewAttachedToInvocation(t, inv)
+	clitest.Start(t, inv)
+	stdout.ExpectMatch(ctx, "Skipping "+manifest.Name+", already imported")
+	stdout.ExpectMatch(ctx, "Imported 0 archives.")
+
+	imports, err := db.GetPurgeArchiveImports(ctx)
+	require.NoError(t, err)
+	require.Len(t, imports, 1)
+	require.EqualValues(t, 1, imports[0].RowCount)
+}
@@ -32,6 +32,7 @@ import (
 	"time"
 
 	"github.com/go-chi/chi/v5"
+	"github.com/google/uuid"
 	"github.com/spf13/pflag"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
@@ -133,6 +134,63 @@ func TestReadExternalAuthProvidersFromEnv(t *testing.T) {
 	})
 }
 
+func TestReadOIDCProvidersFromEnv(t *testing.T) {
+	t.Parallel()
+	t.Run("Valid", func(t *testing.T) {
+		t.Parallel()
+		orgID := uuid.New()
+		providers, err := cli.ReadOIDCProvidersFromEnv([]string{
+			"CODER_OIDC_PROVIDER_0_ID=employees",
+			"CODER_OIDC_PROVIDER_0_CLIENT_ID=employees-client",
+			"CODER_OIDC_PROVIDER_0_CLIENT_SECRET=hunter12",
+			"CODER_OIDC_PROVIDER_0_ISSUER_URL=https://idp.coder.com",
+			"CODER_OIDC_PROVIDER_1_ID=contractors",
+			"CODER_OIDC_PROVIDER_1_ISSUER_URL=https://contractors.example.com",
+			"CODER_OIDC_PROVIDER_1_SCOPES=openid,email",
+			"CODER_OIDC_PROVIDER_1_ALLOW_SIGNUPS=true",
+			"CODER_OIDC_PROVIDER_1_SIGN_IN_TEXT=Contractors",
+			"CODER_OIDC_PROVIDER_1_GROUP_FIELD=groups",
+			`CODER_OIDC_PROVIDER_1_GROUP_MAPPING={"admins":"contractor-admins"}`,
+			"CODER_OIDC_PROVIDER_1_ORGANIZATION_FIELD=orgs",
+			fmt.Sprintf(`CODER_OIDC_PROVIDER_1_ORGANIZATION_MAPPING={"acme":["%s"]}`, orgID),
+			"CODER_OIDC_PROVIDER_1_USER_ROLE_DEFAULT=auditor",
+		})
+		require.NoError(t, err)
+		require.Len(t, providers, 2)
+
+		assert.Equal(t, "employees", providers[0].ID)
+		assert.Equal(t, "employees-client", providers[0].ClientID)
+		assert.Equal(t, "hunter12", providers[0].ClientSecret)
+		assert.Equal(t, "https://idp.coder.com", providers[0].IssuerURL)
+
+		assert.Equal(t, "contractors", providers[1].ID)
+		assert.Equal(t, []string{"openid", "email"}, providers[1].Scopes)
+		assert.True(t, providers[1].AllowSignups)
+		assert.Equal(t, "Contractors", providers[1].SignInText)
+		assert.Equal(t, "groups", providers[1].GroupField)
+		assert.Equal(t, map[string]string{"admins": "contractor-admins"}, providers[1].GroupMapping)
+		assert.Equal(t, "orgs", providers[1].OrganizationField)
+		assert.Equal(t, map[string][]uuid.UUID{"acme": {orgID}}, providers[1].OrganizationMapping)
+		assert.Equal(t, []string{"auditor"}, providers[1].UserRolesDefault)
+	})
+
+	t.Run("Skipped", func(t *testing.T) {
+		t.Parallel()
+		_, err := cli.ReadOIDCProvidersFromEnv([]string{
+			"CODER_OIDC_PROVIDER_1_ID=contractors",
+		})
+		require.ErrorContains(t, err, "provider num 0 skipped")
+	})
+
+	t.Run("InvalidBool", func(t *testing.T) {
+		t.Parallel()
+		_, err := cli.ReadOIDCProvidersFromEnv([]string{
+			"CODER_OIDC_PROVIDER_0_ALLOW_SIGNUPS=maybe",
+		})
+		require.ErrorContains(t, err, "parse bool")
+	})
+}
+
 func TestReadExternalAuthProvidersFromEnv_APIBaseURL(t *testing.T) {
 	t.Parallel()
 	providers, err := cli.ReadExternalAuthProvidersFromEnv([]string{
@@ -2,7 +2,9 @@ package cli
 
 import (
 	"fmt"
+	"io"
 	"sort"
+	"strings"
 	"sync"
 	"time"
 
@@ -12,6 +14,7 @@ import (
 	"github.com/coder/coder/v2/agent/agentcontainers"
 	"github.com/coder/coder/v2/cli/cliui"
 	"github.com/coder/coder/v2/codersdk"
+	"github.com/coder/pretty"
 	"github.com/coder/serpent"
 )
 
@@ -51,17 +54,45 @@ func (r *RootCmd) show() *serpent.Command {
 				ShowDetails:   details,
 				Title:         fmt.Sprintf("%s/%s (%s since %s) %s:%s", workspace.OwnerName, workspace.Name, workspace.LatestBuild.Status, time.Since(workspace.LatestBuild.CreatedAt).Round(time.Second).String(), workspace.TemplateName, workspace.LatestBuild.TemplateVersionName),
 			}
+			drifted := workspace.LatestDriftCheck != nil && workspace.LatestDriftCheck.Drifted
+			if drifted {
+				options.Title += " " + pretty.Sprint(cliui.DefaultStyles.Warn, "drifted")
+			}
 			if workspace.LatestBuild.Status == codersdk.WorkspaceStatusRunning {
 				// Get listening ports for each agent.
 				ports, devcontainers := fetchRuntimeResources(inv, client, workspace.LatestBuild.Resources...)
 				options.ListeningPorts = ports
 				options.Devcontainers = devcontainers
 			}
-			return cliui.WorkspaceResources(inv.Stdout, workspace.LatestBuild.Resources, options)
+			err = cliui.WorkspaceResources(inv.Stdout, workspace.LatestBuild.Resources, options)
+			if err != nil {
+				return err
+			}
+			if drifted {
+				writeWorkspaceDrift(inv.Stdout, workspace.Name, *workspace.LatestDriftCheck)
+			}
+			return nil
 		},
 	}
 }
 
+// writeWorkspaceDrift lists the resources that a drift check found to have
+// changed outside of Coder.
+func writeWorkspaceDrift(w io.Writer, workspaceName string, check codersdk.WorkspaceDriftCheck) {
+	_, _ = fmt.Fprintf(w, "\n%s\n", pretty.Sprint(cliui.DefaultStyles.Warn, fmt.Sprintf(
+		"Drift detected %s ago, the following resources were changed outside of Coder:",
+		time.Since(check.CheckedAt).Round(time.Second).String(),
+	)))
+	for _, resource := range check.Resources {
+		line := fmt.Sprintf("  %s (%s)", resource.Address, resource.Action)
+		if len(resource.Attributes) > 0 {
+			line += ": " + strings.Join(resource.Attributes, ", ")
+		}
+		_, _ = fmt.Fprintln(w, line)
+	}
+	_, _ = fmt.Fprintf(w, "Run %s to restore them.\n", cliui.Code("coder restart "+workspaceName))
+}
+
 func fetchRuntimeResources(inv *serpent.Invocation, client *codersdk.Client, resources ...codersdk.WorkspaceResource) (map[uuid.UUID]codersdk.WorkspaceAgentListeningPortsResponse, map[uuid.UUID]codersdk.WorkspaceAgentListContainersResponse) {
 	ports := make(map[uuid.UUID]codersdk.WorkspaceAgentListeningPortsResponse)
 	devcontainers := make(map[uuid.UUID]codersdk.WorkspaceAgentListContainersResponse)
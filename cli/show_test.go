@@ -2,6 +2,7 @@ package cli_test
 
 import (
 	"bytes"
+	"database/sql"
 	"fmt"
 	"testing"
 	"time"
@@ -14,6 +15,10 @@ import (
 	"github.com/coder/coder/v2/cli/clitest"
 	"github.com/coder/coder/v2/cli/cliui"
 	"github.com/coder/coder/v2/coderd/coderdtest"
+	"github.com/coder/coder/v2/coderd/database"
+	"github.com/coder/coder/v2/coderd/database/dbfake"
+	"github.com/coder/coder/v2/coderd/database/dbgen"
+	"github.com/coder/coder/v2/coderd/database/dbtime"
 	"github.com/coder/coder/v2/codersdk"
 	"github.com/coder/coder/v2/testutil"
 	"github.com/coder/coder/v2/testutil/expecter"
@@ -122,6 +127,43 @@ func TestShow(t *testing.T) {
 		}
 		_ = testutil.TryReceive(ctx, t, doneChan)
 	})
+
+	t.Run("Drifted", func(t *testing.T) {
+		t.Parallel()
+		client, db := coderdtest.NewWithDatabase(t, nil)
+		owner := coderdtest.CreateFirstUser(t, client)
+		member, memberUser := coderdtest.CreateAnotherUser(t, client, owner.OrganizationID)
+		r := dbfake.WorkspaceBuild(t, db, database.WorkspaceTable{
+			OrganizationID: owner.OrganizationID,
+			OwnerID:        memberUser.ID,
+		}).Do()
+		driftJob := dbgen.ProvisionerJob(t, db, nil, database.ProvisionerJob{
+			OrganizationID: owner.OrganizationID,
+			InitiatorID:    memberUser.ID,
+			Type:           database.ProvisionerJobTypeWorkspaceDriftCheck,
+			CompletedAt:    sql.NullTime{Time: dbtime.Now(), Valid: true},
+		})
+		_ = dbgen.WorkspaceDriftCheck(t, db, database.WorkspaceDriftCheck{
+			WorkspaceID:      r.Workspace.ID,
+			WorkspaceBuildID: r.Build.ID,
+			JobID:            driftJob.ID,
+			CheckedAt:        sql.NullTime{Time: dbtime.Now(), Valid: true},
+			Drifted:          true,
+			ResourceDrift:    []byte(`[{"address":"docker_container.workspace[0]","type":"docker_container","name":"workspace","action":"update","attributes":["memory"]}]`),
+		})
+
+		inv, root := clitest.New(t, "show", r.Workspace.Name)
+		clitest.SetupConfig(t, member, root)
+		var buf bytes.Buffer
+		inv.Stdout = &buf
+		err := inv.WithContext(testutil.Context(t, testutil.WaitShort)).Run()
+		require.NoError(t, err)
+
+		out := buf.String()
+		require.Contains(t, out, "drifted")
+		require.Contains(t, out, "docker_container.workspace[0] (update): memory")
+		require.Contains(t, out, "coder restart "+r.Workspace.Name)
+	})
 }
 
 func TestShowDevcontainers_Golden(t *testing.T) {
@@ -29,7 +29,6 @@ import (
 	gosshagent "golang.org/x/crypto/ssh/agent"
 	"golang.org/x/term"
 	"golang.org/x/xerrors"
-	"gvisor.dev/gvisor/pkg/tcpip/adapters/gonet"
 	"tailscale.com/types/netlogtype"
 
 	"cdr.dev/slog/v3"
@@ -124,6 +123,7 @@ func (r *RootCmd) ssh() *serpent.Command {
 		hostPrefix          string
 		hostnameSuffix      string
 		forceNewTunnel      bool
+		immortal            bool
 		forwardAgent        bool
 		forwardGPG          bool
 		identityAgent       string
@@ -523,8 +523,20 @@ func (r *RootCmd) ssh() *serpent.Command {
 				defer closeUsage()
 			}
 
+			if immortal {
+				immortal, err = immortalStreamsSupported(ctx, inv, conn)
+				if err != nil {
+					return err
+				}
+			}
+
 			if stdio {
-				rawSSH, err := conn.SSH(ctx)
+				var rawSSH net.Conn
+				if immortal {
+					rawSSH, err = workspacesdk.DialImmortalStream(ctx, conn, workspacesdk.AgentSSHPort, logger)
+				} else {
+					rawSSH, err = conn.SSH(ctx)
+				}
 				if err != nil {
 					return xerrors.Errorf("connect SSH: %w", err)
 				}
@@ -553,7 +565,12 @@ func (r *RootCmd) ssh() *serpent.Command {
 				return nil
 			}
 
-			sshClient, err := conn.SSHClient(ctx)
+			var sshClient *gossh.Client
+			if immortal {
+				sshClient, err = immortalSSHClient(ctx, conn, logger)
+			} else {
+				sshClient, err = conn.SSHClient(ctx)
+			}
 			if err != nil {
 				return xerrors.Errorf("ssh client: %w", err)
 			}
@@ -883,6 +900,12 @@ func (r *RootCmd) ssh() *serpent.Command {
 			Value:       serpent.StringOf(&containerUser),
 			Hidden:      true, // Hidden until this features is at least in beta.
 		},
+		{
+			Flag:        "immortal",
+			Env:         "CODER_SSH_IMMORTAL",
+			Description: "Keep the session alive through network changes and laptop sleep by reconnecting to it and replaying any data lost in transit. Requires a workspace agent that supports immortal streams.",
+			Value:       serpent.BoolOf(&immortal),
+		},
 		{
 			Flag:        "force-new-tunnel",
 			Description: "Force the creation of a new tunnel to the workspace, even if the Coder Connect tunnel is available.",
@@ -1447,7 +1470,7 @@ func (c *closerStack) push(name string, closer io.Closer) error {
 
 // rawSSHCopier handles copying raw SSH data between the conn and the pair (r, w).
 type rawSSHCopier struct {
-	conn   *gonet.TCPConn
+	conn   net.Conn
 	logger slog.Logger
 	r      io.Reader
 	w      io.Writer
@@ -1455,7 +1478,7 @@ type rawSSHCopier struct {
 	done chan struct{}
 }
 
-func newRawSSHCopier(logger slog.Logger, conn *gonet.TCPConn, r io.Reader, w io.Writer) *rawSSHCopier {
+func newRawSSHCopier(logger slog.Logger, conn net.Conn, r io.Reader, w io.Writer) *rawSSHCopier {
 	return &rawSSHCopier{conn: conn, logger: logger, r: r, w: w, done: make(chan struct{})}
 }
 
@@ -1475,7 +1498,7 @@ func (c *rawSSHCopier) copy(wg *sync.WaitGroup) {
 		//
 		// Of course, if the underlying transport is broken, io.Copy will still return.
 		defer func() {
-			cwErr := c.conn.CloseWrite()
+			cwErr := c.closeWrite()
 			c.logger.Debug(logCtx, "closed raw SSH connection for writing", slog.Error(cwErr))
 		}()
 
@@ -1494,8 +1517,17 @@ func (c *rawSSHCopier) copy(wg *sync.WaitGroup) {
 	}
 }
 
+// closeWrite half-closes the connection if it supports it. Immortal streams
+// do not, so they are closed entirely.
+func (c *rawSSHCopier) closeWrite() error {
+	if cw, ok := c.conn.(interface{ CloseWrite() error }); ok {
+		return cw.CloseWrite()
+	}
+	return c.conn.Close()
+}
+
 func (c *rawSSHCopier) Close() error {
-	err := c.conn.CloseWrite()
+	err := c.closeWrite()
 
 	// give the copy() call a chance to return on a timeout, so that we don't
 	// continue tearing down and close the underlying netstack before the SSH
@@ -0,0 +1,644 @@
+package cli
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"io"
+	"io/fs"
+	"net/http"
+	"os"
+	"path"
+	"path/filepath"
+	"slices"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/go-git/go-git/v5/plumbing/format/gitignore"
+	"github.com/spf13/afero"
+	"golang.org/x/xerrors"
+
+	"cdr.dev/slog/v3"
+	"cdr.dev/slog/v3/sloggers/sloghuman"
+	"github.com/coder/coder/v2/agent/agentcontainers/ignore"
+	"github.com/coder/coder/v2/agent/filefinder"
+	"github.com/coder/coder/v2/cli/cliui"
+	"github.com/coder/coder/v2/codersdk"
+	"github.com/coder/coder/v2/codersdk/workspacesdk"
+	"github.com/coder/serpent"
+)
+
+// syncFilesBatchDelay is how long changes are collected before they are
+// synced, so that a burst of changes, such as a branch checkout, is
+// handled in one pass.
+const syncFilesBatchDelay = 250 * time.Millisecond
+
+const (
+	syncConflictKeepBoth = "keep-both"
+	syncConflictLocal    = "local"
+	syncConflictRemote   = "remote"
+)
+
+// syncTempInfix is part of the names of the temporary files that downloads
+// are written to before they are renamed into place.
+const syncTempInfix = ".coder-sync-"
+
+func (r *RootCmd) syncWorkspace() *serpent.Command {
+	return &serpent.Command{
+		Annotations: workspaceCommand,
+		Use:         "sync",
+		Short:       "Keep files on your machine in sync with a workspace",
+		Handler: func(inv *serpent.Invocation) error {
+			return inv.Command.HelpHandler(inv)
+		},
+		Children: []*serpent.Command{
+			r.syncFiles(),
+		},
+	}
+}
+
+func (r *RootCmd) syncFiles() *serpent.Command {
+	var (
+		conflict string
+		ignores  []string
+	)
+	cmd := &serpent.Command{
+		Use:   "files <local-directory> <workspace>:<directory>",
+		Short: "Keep a local directory and a workspace directory in sync",
+		Long: "Changes on either side are copied to the other side until the command is stopped. " +
+			"Paths ignored by the .gitignore files in the local directory, by .git/info/exclude and by your global Git " +
+			"excludes file are not synced, and neither are .git, node_modules and other directories that the agent does not watch. " +
+			"Relative workspace paths are relative to the agent's working directory, and either directory is created if it does not exist.\n\n" +
+			"A conflict happens when a file changes on both sides before it is synced, including when the command starts and the two versions differ. " +
+			"By default the local version is kept and the workspace version is saved next to it with \".conflict-<time>\" in its name. " +
+			"A change always wins over a deletion. Only file contents are synced; empty directories and file permissions are not.\n\n" +
+			FormatExamples(
+				Example{
+					Description: "Sync the current directory with the project directory in a workspace",
+					Command:     "coder sync files . my-workspace:project",
+				},
+				Example{
+					Description: "Let the workspace win conflicts, and skip the build directory",
+					Command:     "coder sync files . my-workspace:app --conflict remote --ignore build/",
+				},
+			),
+		Middleware: serpent.Chain(
+			serpent.RequireNArgs(2),
+		),
+		Handler: func(inv *serpent.Invocation) error {
+			ctx, cancel := context.WithCancel(inv.Context())
+			defer cancel()
+
+			remote := parseCpLocation(inv.Args[1])
+			if remote.workspace == "" {
+				return xerrors.Errorf("%q is not a workspace path, use <workspace>:<directory>", inv.Args[1])
+			}
+			localRoot, err := filepath.Abs(inv.Args[0])
+			if err != nil {
+				return xerrors.Errorf("resolve %q: %w", inv.Args[0], err)
+			}
+			if err := os.MkdirAll(localRoot, 0o755); err != nil {
+				return xerrors.Errorf("create %q: %w", localRoot, err)
+			}
+			// Watch the real directory so that paths in events match it.
+			localRoot, err = filepath.EvalSymlinks(localRoot)
+			if err != nil {
+				return xerrors.Errorf("resolve %q: %w", inv.Args[0], err)
+			}
+
+			client, err := r.InitClient(inv)
+			if err != nil {
+				return err
+			}
+			appearanceConfig := initAppearance(ctx, client)
+
+			_, workspaceAgent, _, err := GetWorkspaceAndAgent(ctx, inv, client, false, remote.workspace)
+			if err != nil {
+				return err
+			}
+			err = cliui.Agent(ctx, inv.Stderr, workspaceAgent.ID, cliui.AgentOptions{
+				Fetch:   client.WorkspaceAgent,
+				Wait:    false,
+				DocsURL: appearanceConfig.DocsURL,
+			})
+			if err != nil {
+				return xerrors.Errorf("await agent: %w", err)
+			}
+
+			logger := inv.Logger
+			opts := &workspacesdk.DialAgentOptions{}
+			if r.verbose {
+				logger = logger.AppendSinks(sloghuman.Sink(inv.Stderr)).Leveled(slog.LevelDebug)
+				opts.Logger = logger
+			}
+			if r.disableDirect {
+				opts.BlockEndpoints = true
+			}
+			if !r.disableNetworkTelemetry {
+				opts.EnableTelemetry = true
+			}
+			conn, err := workspacesdk.New(client).DialAgent(ctx, workspaceAgent.ID, opts)
+			if err != nil {
+				return err
+			}
+			defer conn.Close()
+
+			ignored, err := newSyncIgnore(ctx, logger, localRoot, ignores)
+			if err != nil {
+				return err
+			}
+
+			watcher, err := filefinder.NewFSWatcher(localRoot, logger.Named("sync"))
+			if err != nil {
+				return xerrors.Errorf("watch %q: %w", localRoot, err)
+			}
+			// Start sends the existing files as the first batch.
+			watcher.Start(ctx)
+			defer watcher.Close()
+			var existing []filefinder.FSEvent
+			select {
+			case existing = <-watcher.Events():
+			default:
+			}
+
+			updates, closer, err := conn.WatchSync(ctx, logger, remote.path)
+			var sdkErr *codersdk.Error
+			if errors.As(err, &sdkErr) && sdkErr.StatusCode() == http.StatusForbidden {
+				return errFileTransferBlocked
+			}
+			if err != nil {
+				return xerrors.Errorf("watch %q: %w", remote, err)
+			}
+			defer closer.Close()
+			var initial workspacesdk.SyncUpdate
+			select {
+			case <-ctx.Done():
+				return ctx.Err()
+			case update, ok := <-updates:
+				if !ok {
+					return xerrors.New("connection to the agent closed")
+				}
+				initial = update
+			}
+
+			local := localSyncSide{root: localRoot}
+			s := newFileSyncer(local, remoteSyncSide{conn: conn, root: initial.Root}, conflict, inv.Stdout)
+			s.ignored = ignored
+			s.reloadIgnore = func() (func(string) bool, error) {
+				return newSyncIgnore(ctx, logger, localRoot, ignores)
+			}
+			// Collect changes from both sides while files are copied, including
+			// during the initial sync, so that neither watcher drops any.
+			changes := newSyncChanges()
+			remoteClosed := make(chan struct{})
+			go func() {
+				defer close(remoteClosed)
+				for update := range updates {
+					changes.add(nil, update.Entries)
+				}
+			}()
+			go func() {
+				for {
+					select {
+					case <-ctx.Done():
+						return
+					case events := <-watcher.Events():
+						changes.add(local.entries(ctx, logger, events), nil)
+					}
+				}
+			}()
+
+			s.sync(ctx, local.entries(ctx, logger, existing), initial.Entries)
+			cliui.Infof(inv.Stderr, "Syncing %s with %s:%s. Press Ctrl+C to stop.", localRoot, remote.workspace, initial.Root)
+
+			for {
+				select {
+				case <-ctx.Done():
+					return nil
+				case <-remoteClosed:
+					if ctx.Err() != nil {
+						return nil
+					}
+					return xerrors.New("connection to the agent closed")
+				case <-changes.notify:
+				}
+				// Give related changes a moment to arrive.
+				select {
+				case <-ctx.Done():
+					return nil
+				case <-time.After(syncFilesBatchDelay):
+				}
+				localEntries, remoteEntries := changes.take()
+				s.sync(ctx, localEntries, remoteEntries)
+			}
+		},
+	}
+
+	cmd.Options = serpent.OptionSet{
+		{
+			Flag:        "conflict",
+			Description: "What to do when a file changed on both sides: keep-both keeps the local version and saves the workspace version next to it, local and remote overwrite the other side.",
+			Default:     syncConflictKeepBoth,
+			Value:       serpent.EnumOf(&conflict, syncConflictKeepBoth, syncConflictLocal, syncConflictRemote),
+		},
+		{
+			Flag:        "ignore",
+			Description: "Additional paths not to sync, in .gitignore syntax and relative to the local directory.",
+			Value:       serpent.StringArrayOf(&ignores),
+		},
+	}
+	return cmd
+}
+
+// newSyncIgnore returns a function that reports whether a slash-separated
+// path relative to root should not be synced.
+func newSyncIgnore(ctx context.Context, logger slog.Logger, root string, extra []string) (func(string) bool, error) {
+	osFS := afero.NewOsFs()
+	globalPatterns, err := ignore.LoadGlobalPatterns(osFS)
+	if err != nil {
+		return nil, xerrors.Errorf("read global git ignore patterns: %w", err)
+	}
+	patterns, err := ignore.ReadPatterns(ctx, logger, osFS, root)
+	if err != nil {
+		return nil, xerrors.Errorf("read git ignore patterns: %w", err)
+	}
+	patterns = append(globalPatterns, patterns...)
+	rootParts := ignore.FilePathToParts(root)
+	for _, p := range extra {
+		patterns = append(patterns, gitignore.ParsePattern(p, rootParts))
+	}
+	matcher := gitignore.NewMatcher(patterns)
+
+	return func(rel string) bool {
+		parts := slices.Clone(rootParts)
+		segments := strings.Split(rel, "/")
+		for i, segment := range segments {
+			parts = append(parts, segment)
+			if matcher.Match(parts, i < len(segments)-1) {
+				return true
+			}
+		}
+		return false
+	}, nil
+}
+
+// syncSide is one of the two copies of a synced directory. Paths are
+// slash separated and relative to the directory.
+type syncSide interface {
+	Open(ctx context.Context, rel string) (io.ReadCloser, error)
+	Write(ctx context.Context, rel string, r io.Reader) error
+	Remove(ctx context.Context, rel string) error
+}
+
+type localSyncSide struct {
+	root string
+}
+
+func (l localSyncSide) path(rel string) string {
+	return filepath.Join(l.root, filepath.FromSlash(rel))
+}
+
+func (l localSyncSide) Open(_ context.Context, rel string) (io.ReadCloser, error) {
+	return os.Open(l.path(rel))
+}
+
+// Write replaces the file through a temporary file, so that editors never
+// see a partially written file.
+func (l localSyncSide) Write(_ context.Context, rel string, r io.Reader) error {
+	name := l.path(rel)
+	if err := os.MkdirAll(filepath.Dir(name), 0o755); err != nil {
+		return err
+	}
+	mode := fs.FileMode(0o644)
+	if info, err := os.Stat(name); err == nil {
+		mode = info.Mode().Perm()
+	}
+	tmp, err := os.CreateTemp(filepath.Dir(name), "."+filepath.Base(name)+syncTempInfix+"*")
+	if err != nil {
+		return err
+	}
+	defer os.Remove(tmp.Name())
+	if _, err := io.Copy(tmp, r); err != nil {
+		_ = tmp.Close()
+		return err
+	}
+	if err := tmp.Chmod(mode); err != nil {
+		_ = tmp.Close()
+		return err
+	}
+	if err := tmp.Close(); err != nil {
+		return err
+	}
+	return os.Rename(tmp.Name(), name)
+}
+
+func (l localSyncSide) Remove(_ context.Context, rel string) error {
+	return os.RemoveAll(l.path(rel))
+}
+
+// entries describes the current state of the paths in events, like the
+// agent does for the workspace side.
+func (l localSyncSide) entries(ctx context.Context, logger slog.Logger, events []filefinder.FSEvent) []workspacesdk.SyncEntry {
+	entries := make([]workspacesdk.SyncEntry, 0, len(events))
+	for _, ev := range events {
+		if strings.Contains(filepath.Base(ev.Path), syncTempInfix) {
+			continue
+		}
+		rel, err := filepath.Rel(l.root, ev.Path)
+		if err != nil || rel == "." || !filepath.IsLocal(rel) {
+			continue
+		}
+		entry := workspacesdk.SyncEntry{Path: filepath.ToSlash(rel)}
+		info, err := os.Stat(ev.Path)
+		switch {
+		case errors.Is(err, fs.ErrNotExist):
+			entry.Deleted = true
+			entries = append(entries, entry)
+			continue
+		case err != nil:
+			logger.Debug(ctx, "stat synced file", slog.F("path", ev.Path), slog.Error(err))
+			continue
+		case !info.Mode().IsRegular():
+			continue
+		}
+		f, err := os.Open(ev.Path)
+		if err != nil {
+			if errors.Is(err, fs.ErrNotExist) {
+				entry.Deleted = true
+				entries = append(entries, entry)
+			}
+			continue
+		}
+		h := sha256.New()
+		_, err = io.Copy(h, f)
+		_ = f.Close()
+		if err != nil {
+			logger.Debug(ctx, "hash synced file", slog.F("path", ev.Path), slog.Error(err))
+			continue
+		}
+		entry.Size = info.Size()
+		entry.SHA256 = hex.EncodeToString(h.Sum(nil))
+		entries = append(entries, entry)
+	}
+	return entries
+}
+
+type remoteSyncSide struct {
+	conn workspacesdk.AgentConn
+	root string
+}
+
+func (r remoteSyncSide) path(rel string) string {
+	return path.Join(r.root, rel)
+}
+
+func (r remoteSyncSide) Open(ctx context.Context, rel string) (io.ReadCloser, error) {
+	rc, _, err := r.conn.ReadFile(ctx, r.path(rel), 0, 0)
+	var sdkErr *codersdk.Error
+	if errors.As(err, &sdkErr) && sdkErr.StatusCode() == http.StatusNotFound {
+		return nil, xerrors.Errorf("open %s: %w", r.path(rel), fs.ErrNotExist)
+	}
+	return rc, err
+}
+
+func (r remoteSyncSide) Write(ctx context.Context, rel string, reader io.Reader) error {
+	return r.conn.WriteFile(ctx, r.path(rel), reader)
+}
+
+func (r remoteSyncSide) Remove(ctx context.Context, rel string) error {
+	return r.conn.RemovePath(ctx, r.path(rel))
+}
+
+// syncChanges collects the changes reported by both sides until they are
+// synced.
+type syncChanges struct {
+	mu     sync.Mutex
+	local  []workspacesdk.SyncEntry
+	remote []workspacesdk.SyncEntry
+	notify chan struct{}
+}
+
+func newSyncChanges() *syncChanges {
+	return &syncChanges{notify: make(chan struct{}, 1)}
+}
+
+func (c *syncChanges) add(local, remote []workspacesdk.SyncEntry) {
+	if len(local) == 0 && len(remote) == 0 {
+		return
+	}
+	c.mu.Lock()
+	c.local = append(c.local, local...)
+	c.remote = append(c.remote, remote...)
+	c.mu.Unlock()
+	select {
+	case c.notify <- struct{}{}:
+	default:
+	}
+}
+
+func (c *syncChanges) take() (local, remote []workspacesdk.SyncEntry) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	local, remote = c.local, c.remote
+	c.local, c.remote = nil, nil
+	return local, remote
+}
+
+// fileSyncer keeps two copies of a directory in sync. Each side is
+// tracked as a map from path to the SHA-256 of the file's contents. A
+// file that differs between the sides is copied from the side that
+// changed since both sides last agreed on it, which is recorded in base.
+type fileSyncer struct {
+	local    syncSide
+	remote   syncSide
+	conflict string
+	out      io.Writer
+	now      func() time.Time
+	// ignored reports whether a path should not be synced.
+	ignored func(rel string) bool
+	// reloadIgnore is called when a local .gitignore file changes.
+	reloadIgnore func() (func(rel string) bool, error)
+
+	localFiles  map[string]string
+	remoteFiles map[string]string
+	base        map[string]string
+}
+
+func newFileSyncer(local, remote syncSide, conflict string, out io.Writer) *fileSyncer {
+	return &fileSyncer{
+		local:       local,
+		remote:      remote,
+		conflict:    conflict,
+		out:         out,
+		now:         time.Now,
+		ignored:     func(string) bool { return false },
+		localFiles:  map[string]string{},
+		remoteFiles: map[string]string{},
+		base:        map[string]string{},
+	}
+}
+
+// sync applies the changes reported by both sides, and then copies every
+// changed file that is not ignored to the other side.
+func (s *fileSyncer) sync(ctx context.Context, local, remote []workspacesdk.SyncEntry) {
+	changed := map[string]struct{}{}
+	reload := false
+	for _, p := range applySyncEntries(s.localFiles, local) {
+		changed[p] = struct{}{}
+		if path.Base(p) == ".gitignore" {
+			reload = true
+		}
+	}
+	for _, p := range applySyncEntries(s.remoteFiles, remote) {
+		changed[p] = struct{}{}
+	}
+	if reload && s.reloadIgnore != nil {
+		ignored, err := s.reloadIgnore()
+		if err != nil {
+			cliui.Warnf(s.out, "Failed to reload ignored paths: %s", err)
+		} else {
+			s.ignored = ignored
+		}
+	}
+
+	paths := make([]string, 0, len(changed))
+	for p := range changed {
+		paths = append(paths, p)
+	}
+	slices.Sort(paths)
+	for _, p := range paths {
+		if ctx.Err() != nil {
+			return
+		}
+		if s.ignored(p) {
+			continue
+		}
+		if err := s.syncPath(ctx, p); err != nil {
+			cliui.Warnf(s.out, "Failed to sync %s: %s", p, err)
+		}
+	}
+}
+
+// applySyncEntries updates files with entries and returns the paths that
+// were affected.
+func applySyncEntries(files map[string]string, entries []workspacesdk.SyncEntry) []string {
+	var changed []string
+	for _, entry := range entries {
+		if !entry.Deleted {
+			files[entry.Path] = entry.SHA256
+			changed = append(changed, entry.Path)
+			continue
+		}
+		// A deleted directory deletes everything in it.
+		prefix := entry.Path + "/"
+		for p := range files {
+			if p == entry.Path || strings.HasPrefix(p, prefix) {
+				delete(files, p)
+				changed = append(changed, p)
+			}
+		}
+	}
+	return changed
+}
+
+func (s *fileSyncer) syncPath(ctx context.Context, p string) error {
+	localHash, remoteHash, baseHash := s.localFiles[p], s.remoteFiles[p], s.base[p]
+	switch {
+	case localHash == remoteHash:
+		s.setBase(p, localHash)
+		return nil
+	case remoteHash == baseHash:
+		return s.upload(ctx, p)
+	case localHash == baseHash:
+		return s.download(ctx, p)
+	}
+
+	// Both sides changed since they last agreed.
+	switch s.conflict {
+	case syncConflictLocal:
+		return s.upload(ctx, p)
+	case syncConflictRemote:
+		return s.download(ctx, p)
+	}
+	switch {
+	case localHash == "":
+		return s.download(ctx, p)
+	case remoteHash == "":
+		return s.upload(ctx, p)
+	}
+	conflictPath := s.conflictPath(p)
+	rc, err := s.remote.Open(ctx, p)
+	if err != nil {
+		return xerrors.Errorf("open workspace version: %w", err)
+	}
+	defer rc.Close()
+	if err := s.local.Write(ctx, conflictPath, rc); err != nil {
+		return xerrors.Errorf("save workspace version: %w", err)
+	}
+	_, _ = fmt.Fprintf(s.out, "Conflict: %s changed on both sides, saved the workspace version as %s\n", p, conflictPath)
+	return s.upload(ctx, p)
+}
+
+// conflictPath returns the path that the workspace version of p is saved
+// as when it conflicts, such as "main.conflict-20060102-150405.go".
+func (s *fileSyncer) conflictPath(p string) string {
+	ext := path.Ext(p)
+	if ext == path.Base(p) {
+		// Dotfiles such as .bashrc have no extension.
+		ext = ""
+	}
+	return strings.TrimSuffix(p, ext) + ".conflict-" + s.now().Format("20060102-150405") + ext
+}
+
+func (s *fileSyncer) upload(ctx context.Context, p string) error {
+	return s.transfer(ctx, p, s.local, s.localFiles, s.remote, s.remoteFiles, "Uploaded", "Removed %s from the workspace\n")
+}
+
+func (s *fileSyncer) download(ctx context.Context, p string) error {
+	return s.transfer(ctx, p, s.remote, s.remoteFiles, s.local, s.localFiles, "Downloaded", "Removed %s locally\n")
+}
+
+func (s *fileSyncer) transfer(ctx context.Context, p string, from syncSide, fromFiles map[string]string, to syncSide, toFiles map[string]string, copied, removedFormat string) error {
+	if fromFiles[p] == "" {
+		if err := to.Remove(ctx, p); err != nil {
+			return err
+		}
+		delete(toFiles, p)
+		s.setBase(p, "")
+		_, _ = fmt.Fprintf(s.out, removedFormat, p)
+		return nil
+	}
+
+	rc, err := from.Open(ctx, p)
+	if err != nil {
+		if errors.Is(err, fs.ErrNotExist) {
+			// It was deleted after it was reported, so the deletion is
+			// synced once it is reported.
+			return nil
+		}
+		return err
+	}
+	defer rc.Close()
+	// The file may have changed since it was reported, so record what was
+	// actually copied. Later changes are reported separately.
+	h := sha256.New()
+	if err := to.Write(ctx, p, io.TeeReader(rc, h)); err != nil {
+		return err
+	}
+	sum := hex.EncodeToString(h.Sum(nil))
+	toFiles[p] = sum
+	s.setBase(p, sum)
+	_, _ = fmt.Fprintf(s.out, "%s %s\n", copied, p)
+	return nil
+}
+
+func (s *fileSyncer) setBase(p, hash string) {
+	if hash == "" {
+		delete(s.base, p)
+		return
+	}
+	s.base[p] = hash
+}
@@ -0,0 +1,241 @@
+package cli
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"io"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"cdr.dev/slog/v3/sloggers/slogtest"
+	"github.com/coder/coder/v2/codersdk/workspacesdk"
+)
+
+type fakeSyncSide struct {
+	files map[string]string
+}
+
+func (f *fakeSyncSide) Open(_ context.Context, rel string) (io.ReadCloser, error) {
+	content, ok := f.files[rel]
+	if !ok {
+		return nil, fs.ErrNotExist
+	}
+	return io.NopCloser(strings.NewReader(content)), nil
+}
+
+func (f *fakeSyncSide) Write(_ context.Context, rel string, r io.Reader) error {
+	content, err := io.ReadAll(r)
+	if err != nil {
+		return err
+	}
+	f.files[rel] = string(content)
+	return nil
+}
+
+func (f *fakeSyncSide) Remove(_ context.Context, rel string) error {
+	for p := range f.files {
+		if p == rel || strings.HasPrefix(p, rel+"/") {
+			delete(f.files, p)
+		}
+	}
+	return nil
+}
+
+func syncFileEntry(p, content string) workspacesdk.SyncEntry {
+	sum := sha256.Sum256([]byte(content))
+	return workspacesdk.SyncEntry{Path: p, Size: int64(len(content)), SHA256: hex.EncodeToString(sum[:])}
+}
+
+func syncFileEntries(files map[string]string) []workspacesdk.SyncEntry {
+	entries := make([]workspacesdk.SyncEntry, 0, len(files))
+	for p, content := range files {
+		entries = append(entries, syncFileEntry(p, content))
+	}
+	return entries
+}
+
+// newTestFileSyncer returns a syncer that has done the initial sync of
+// the given files.
+func newTestFileSyncer(t *testing.T, conflict string, local, remote map[string]string) (*fileSyncer, *fakeSyncSide, *fakeSyncSide) {
+	t.Helper()
+	localSide := &fakeSyncSide{files: local}
+	remoteSide := &fakeSyncSide{files: remote}
+	s := newFileSyncer(localSide, remoteSide, conflict, io.Discard)
+	s.now = func() time.Time { return time.Date(2024, 1, 2, 3, 4, 5, 0, time.UTC) }
+	s.sync(context.Background(), syncFileEntries(local), syncFileEntries(remote))
+	return s, localSide, remoteSide
+}
+
+func TestFileSyncer(t *testing.T) {
+	t.Parallel()
+
+	t.Run("Initial", func(t *testing.T) {
+		t.Parallel()
+		_, local, remote := newTestFileSyncer(t, syncConflictKeepBoth,
+			map[string]string{"local.txt": "local", "same.txt": "same"},
+			map[string]string{"dir/remote.txt": "remote", "same.txt": "same"},
+		)
+		want := map[string]string{"local.txt": "local", "dir/remote.txt": "remote", "same.txt": "same"}
+		require.Equal(t, want, local.files)
+		require.Equal(t, want, remote.files)
+	})
+
+	t.Run("Changes", func(t *testing.T) {
+		t.Parallel()
+		ctx := context.Background()
+		s, local, remote := newTestFileSyncer(t, syncConflictKeepBoth,
+			map[string]string{"a.txt": "a", "dir/b.txt": "b", "dir/c.txt": "c"},
+			map[string]string{},
+		)
+
+		local.files["a.txt"] = "changed"
+		s.sync(ctx, []workspacesdk.SyncEntry{syncFileEntry("a.txt", "changed")}, nil)
+		require.Equal(t, "changed", remote.files["a.txt"])
+
+		// Echoes of the copy that was just made do not change anything.
+		s.sync(ctx, nil, []workspacesdk.SyncEntry{syncFileEntry("a.txt", "changed")})
+		require.Equal(t, "changed", local.files["a.txt"])
+
+		delete(remote.files, "dir/b.txt")
+		delete(remote.files, "dir/c.txt")
+		s.sync(ctx, nil, []workspacesdk.SyncEntry{{Path: "dir", Deleted: true}})
+		require.Equal(t, map[string]string{"a.txt": "changed"}, local.files)
+	})
+
+	t.Run("Ignored", func(t *testing.T) {
+		t.Parallel()
+		local := &fakeSyncSide{files: map[string]string{"dist/out.js": "out", "main.go": "main"}}
+		remote := &fakeSyncSide{files: map[string]string{}}
+		s := newFileSyncer(local, remote, syncConflictKeepBoth, io.Discard)
+		s.ignored = func(rel string) bool { return strings.HasPrefix(rel, "dist/") }
+		s.sync(context.Background(), syncFileEntries(local.files), nil)
+		require.Equal(t, map[string]string{"main.go": "main"}, remote.files)
+	})
+
+	conflicts := []struct {
+		name     string
+		conflict string
+		// local and remote are the contents after the change on each side,
+		// and empty for a deletion.
+		local, remote            string
+		wantLocal, wantRemote    string
+		wantConflictCopyOfRemote bool
+	}{
+		{
+			name:                     "KeepBoth",
+			conflict:                 syncConflictKeepBoth,
+			local:                    "local",
+			remote:                   "remote",
+			wantLocal:                "local",
+			wantRemote:               "local",
+			wantConflictCopyOfRemote: true,
+		},
+		{
+			name:       "KeepBothLocalDeleted",
+			conflict:   syncConflictKeepBoth,
+			remote:     "remote",
+			wantLocal:  "remote",
+			wantRemote: "remote",
+		},
+		{
+			name:       "KeepBothRemoteDeleted",
+			conflict:   syncConflictKeepBoth,
+			local:      "local",
+			wantLocal:  "local",
+			wantRemote: "local",
+		},
+		{
+			name:       "Local",
+			conflict:   syncConflictLocal,
+			local:      "local",
+			remote:     "remote",
+			wantLocal:  "local",
+			wantRemote: "local",
+		},
+		{
+			name:       "LocalDeleted",
+			conflict:   syncConflictLocal,
+			remote:     "remote",
+			wantLocal:  "",
+			wantRemote: "",
+		},
+		{
+			name:       "Remote",
+			conflict:   syncConflictRemote,
+			local:      "local",
+			remote:     "remote",
+			wantLocal:  "remote",
+			wantRemote: "remote",
+		},
+	}
+	for _, tt := range conflicts {
+		t.Run("Conflict"+tt.name, func(t *testing.T) {
+			t.Parallel()
+			s, local, remote := newTestFileSyncer(t, tt.conflict,
+				map[string]string{"file.txt": "base"},
+				map[string]string{"file.txt": "base"},
+			)
+
+			change := func(side *fakeSyncSide, content string) []workspacesdk.SyncEntry {
+				if content == "" {
+					delete(side.files, "file.txt")
+					return []workspacesdk.SyncEntry{{Path: "file.txt", Deleted: true}}
+				}
+				side.files["file.txt"] = content
+				return []workspacesdk.SyncEntry{syncFileEntry("file.txt", content)}
+			}
+			s.sync(context.Background(), change(local, tt.local), change(remote, tt.remote))
+
+			require.Equal(t, tt.wantLocal, local.files["file.txt"])
+			require.Equal(t, tt.wantRemote, remote.files["file.txt"])
+			conflictCopy, ok := local.files["file.conflict-20240102-030405.txt"]
+			require.Equal(t, tt.wantConflictCopyOfRemote, ok)
+			if ok {
+				require.Equal(t, tt.remote, conflictCopy)
+			}
+		})
+	}
+}
+
+func TestFileSyncerConflictPath(t *testing.T) {
+	t.Parallel()
+
+	s := newFileSyncer(nil, nil, syncConflictKeepBoth, io.Discard)
+	s.now = func() time.Time { return time.Date(2024, 1, 2, 3, 4, 5, 0, time.UTC) }
+	require.Equal(t, "src/main.conflict-20240102-030405.go", s.conflictPath("src/main.go"))
+	require.Equal(t, "Makefile.conflict-20240102-030405", s.conflictPath("Makefile"))
+	require.Equal(t, ".bashrc.conflict-20240102-030405", s.conflictPath(".bashrc"))
+}
+
+func TestSyncIgnore(t *testing.T) {
+	t.Parallel()
+
+	root := t.TempDir()
+	require.NoError(t, os.WriteFile(filepath.Join(root, ".gitignore"), []byte("dist/\n"), 0o600))
+	require.NoError(t, os.MkdirAll(filepath.Join(root, "web"), 0o755))
+	require.NoError(t, os.WriteFile(filepath.Join(root, "web", ".gitignore"), []byte("*.map\n"), 0o600))
+
+	logger := slogtest.Make(t, nil)
+	ignored, err := newSyncIgnore(context.Background(), logger, root, []string{"*.log"})
+	require.NoError(t, err)
+
+	for p, want := range map[string]bool{
+		"main.go":           false,
+		".gitignore":        false,
+		"dist/app.js":       true,
+		"web/dist/app.js":   true,
+		"web/app.js.map":    true,
+		"app.js.map":        false,
+		"logs/server.log":   true,
+		"logs/server.log.1": false,
+	} {
+		require.Equal(t, want, ignored(p), p)
+	}
+}
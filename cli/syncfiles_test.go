@@ -0,0 +1,70 @@
+package cli_test
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"runtime"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/coder/coder/v2/agent/agenttest"
+	"github.com/coder/coder/v2/cli/clitest"
+	"github.com/coder/coder/v2/coderd/coderdtest"
+	"github.com/coder/coder/v2/testutil"
+)
+
+func TestSyncFiles(t *testing.T) {
+	t.Parallel()
+	if runtime.GOOS == "windows" {
+		t.Skip("Remote paths are slash separated, which the agent running locally does not understand on Windows")
+	}
+
+	client, workspace, agentToken := setupWorkspaceForAgent(t)
+	_ = agenttest.New(t, client.URL, agentToken)
+	coderdtest.AwaitWorkspaceAgents(t, client, workspace.ID)
+
+	writeFile := func(t *testing.T, name, content string) {
+		t.Helper()
+		require.NoError(t, os.MkdirAll(filepath.Dir(name), 0o755))
+		require.NoError(t, os.WriteFile(name, []byte(content), 0o600))
+	}
+	hasContent := func(name, content string) func() bool {
+		return func() bool {
+			got, err := os.ReadFile(name)
+			return err == nil && string(got) == content
+		}
+	}
+
+	localDir := t.TempDir()
+	remoteDir := t.TempDir()
+	writeFile(t, filepath.Join(localDir, "local.txt"), "local")
+	writeFile(t, filepath.Join(localDir, ".gitignore"), "*.log\n")
+	writeFile(t, filepath.Join(localDir, "debug.log"), "ignored")
+	writeFile(t, filepath.Join(remoteDir, "nested", "remote.txt"), "remote")
+
+	ctx, cancel := context.WithTimeout(context.Background(), testutil.WaitLong)
+	defer cancel()
+	inv, root := clitest.New(t, "sync", "files", localDir, workspace.Name+":"+remoteDir)
+	clitest.SetupConfig(t, client, root)
+	clitest.Start(t, inv.WithContext(ctx))
+
+	require.Eventually(t, hasContent(filepath.Join(remoteDir, "local.txt"), "local"), testutil.WaitLong, testutil.IntervalFast)
+	require.Eventually(t, hasContent(filepath.Join(localDir, "nested", "remote.txt"), "remote"), testutil.WaitLong, testutil.IntervalFast)
+	require.FileExists(t, filepath.Join(remoteDir, ".gitignore"))
+	require.NoFileExists(t, filepath.Join(remoteDir, "debug.log"))
+
+	writeFile(t, filepath.Join(localDir, "local.txt"), "changed locally")
+	require.Eventually(t, hasContent(filepath.Join(remoteDir, "local.txt"), "changed locally"), testutil.WaitLong, testutil.IntervalFast)
+
+	writeFile(t, filepath.Join(remoteDir, "nested", "new.txt"), "new")
+	require.Eventually(t, hasContent(filepath.Join(localDir, "nested", "new.txt"), "new"), testutil.WaitLong, testutil.IntervalFast)
+
+	require.NoError(t, os.RemoveAll(filepath.Join(remoteDir, "nested")))
+	require.Eventually(t, func() bool {
+		_, remoteErr := os.Stat(filepath.Join(localDir, "nested", "remote.txt"))
+		_, newErr := os.Stat(filepath.Join(localDir, "nested", "new.txt"))
+		return os.IsNotExist(remoteErr) && os.IsNotExist(newErr)
+	}, testutil.WaitLong, testutil.IntervalFast)
+}
@@ -24,6 +24,7 @@ func (r *RootCmd) templateEdit() *serpent.Command {
 		defaultTTL                     time.Duration
 		activityBump                   time.Duration
 		timeTilAutostopNotify          time.Duration
+		driftCheckInterval             time.Duration
 		autostopRequirementDaysOfWeek  []string
 		autostopRequirementWeeks       int64
 		autostartRequirementDaysOfWeek []string
@@ -119,6 +120,10 @@ func (r *RootCmd) templateEdit() *serpent.Command {
 				timeTilAutostopNotify = time.Duration(template.TimeTilAutostopNotifyMillis) * time.Millisecond
 			}
 
+			if !userSetOption(inv, "drift-check-interval") {
+				driftCheckInterval = time.Duration(template.DriftCheckIntervalMillis) * time.Millisecond
+			}
+
 			if !userSetOption(inv, "allow-user-autostop") {
 				allowUserAutostop = template.AllowUserAutostop
 			}
@@ -191,6 +196,7 @@ func (r *RootCmd) templateEdit() *serpent.Command {
 				DefaultTTLMillis:            ptr.Ref(defaultTTL.Milliseconds()),
 				ActivityBumpMillis:          ptr.Ref(activityBump.Milliseconds()),
 				TimeTilAutostopNotifyMillis: ptr.Ref(timeTilAutostopNotify.Milliseconds()),
+				DriftCheckIntervalMillis:    ptr.Ref(driftCheckInterval.Milliseconds()),
 				AutostopRequirement: &codersdk.TemplateAutostopRequirement{
 					DaysOfWeek: autostopRequirementDaysOfWeek,
 					Weeks:      autostopRequirementWeeks,
@@ -265,6 +271,11 @@ func (r *RootCmd) templateEdit() *serpent.Command {
 			Description: "Edit how long before the autostop deadline a reminder notification is sent for workspaces created from this template, in Go duration format (e.g. 1h, 30m). Set to 0 to disable.",
 			Value:       serpent.DurationOf(&timeTilAutostopNotify),
 		},
+		{
+			Flag:        "drift-check-interval",
+			Description: "Edit how often running workspaces created from this template are checked for resources that were changed outside of Coder, in Go duration format (e.g. 24h). Must be at least 1h. Set to 0 to disable drift detection.",
+			Value:       serpent.DurationOf(&driftCheckInterval),
+		},
 		{
 			Flag:        "autostart-requirement-weekdays",
 			Description: "Edit the template autostart requirement weekdays - workspaces created from this template can only autostart on the given weekdays. To unset this value for the template (and allow autostart on all days), pass 'all'.",
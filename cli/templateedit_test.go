@@ -45,6 +45,7 @@ func TestTemplateEdit(t *testing.T) {
 		icon := "/icon/new-icon.png"
 		defaultTTL := 12 * time.Hour
 		timeTilAutostopNotify := 5 * time.Minute
+		driftCheckInterval := 6 * time.Hour
 		allowUserCancelWorkspaceJobs := false
 
 		cmdArgs := []string{
@@ -57,6 +58,7 @@ func TestTemplateEdit(t *testing.T) {
 			"--icon", icon,
 			"--default-ttl", defaultTTL.String(),
 			"--autostop-reminder", timeTilAutostopNotify.String(),
+			"--drift-check-interval", driftCheckInterval.String(),
 			"--allow-user-cancel-workspace-jobs=" + strconv.FormatBool(allowUserCancelWorkspaceJobs),
 		}
 		inv, root := clitest.New(t, cmdArgs...)
@@ -76,6 +78,7 @@ func TestTemplateEdit(t *testing.T) {
 		assert.Equal(t, icon, updated.Icon)
 		assert.Equal(t, defaultTTL.Milliseconds(), updated.DefaultTTLMillis)
 		assert.Equal(t, timeTilAutostopNotify.Milliseconds(), updated.TimeTilAutostopNotifyMillis)
+		assert.Equal(t, driftCheckInterval.Milliseconds(), updated.DriftCheckIntervalMillis)
 		assert.Equal(t, allowUserCancelWorkspaceJobs, updated.AllowUserCancelWorkspaceJobs)
 	})
 	t.Run("FirstEmptyThenNotModified", func(t *testing.T) {
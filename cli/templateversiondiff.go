@@ -0,0 +1,230 @@
+package cli
+
+import (
+	"encoding/json"
+	"fmt"
+	"reflect"
+	"slices"
+	"strings"
+
+	"github.com/google/uuid"
+	"golang.org/x/xerrors"
+
+	"github.com/coder/coder/v2/cli/cliui"
+	"github.com/coder/coder/v2/codersdk"
+	"github.com/coder/pretty"
+	"github.com/coder/serpent"
+)
+
+func (r *RootCmd) templateVersionsDiff() *serpent.Command {
+	var (
+		orgContext = NewOrganizationContext()
+		formatter  = cliui.NewOutputFormatter(
+			cliui.ChangeFormatterData(cliui.TextFormat(), func(data any) (any, error) {
+				diff, ok := data.(codersdk.TemplateVersionDiff)
+				if !ok {
+					return nil, xerrors.Errorf("expected type %T, got %T", diff, data)
+				}
+				return formatTemplateVersionDiff(diff), nil
+			}),
+			cliui.JSONFormat(),
+		)
+	)
+	cmd := &serpent.Command{
+		Use:   "diff <template> <version> [base-version]",
+		Short: "Show what changes between two versions of a template",
+		Long: FormatExamples(
+			Example{
+				Description: "Compare a version against the active version of the template",
+				Command:     "coder templates versions diff my-template v2",
+			},
+			Example{
+				Description: "Compare two specific versions",
+				Command:     "coder templates versions diff my-template v2 v1",
+			},
+		),
+		Middleware: serpent.Chain(
+			serpent.RequireRangeArgs(2, 3),
+		),
+		Handler: func(inv *serpent.Invocation) error {
+			ctx := inv.Context()
+			client, err := r.InitClient(inv)
+			if err != nil {
+				return err
+			}
+			organization, err := orgContext.Selected(inv, client)
+			if err != nil {
+				return err
+			}
+
+			template, err := client.TemplateByName(ctx, organization.ID, inv.Args[0])
+			if err != nil {
+				return xerrors.Errorf("get template by name: %w", err)
+			}
+			version, err := client.TemplateVersionByName(ctx, template.ID, inv.Args[1])
+			if err != nil {
+				return xerrors.Errorf("get template version by name %q: %w", inv.Args[1], err)
+			}
+			baseID := uuid.Nil
+			if len(inv.Args) > 2 {
+				base, err := client.TemplateVersionByName(ctx, template.ID, inv.Args[2])
+				if err != nil {
+					return xerrors.Errorf("get template version by name %q: %w", inv.Args[2], err)
+				}
+				baseID = base.ID
+			}
+
+			diff, err := client.TemplateVersionDiff(ctx, version.ID, baseID)
+			if err != nil {
+				return xerrors.Errorf("get template version diff: %w", err)
+			}
+
+			out, err := formatter.Format(ctx, diff)
+			if err != nil {
+				return xerrors.Errorf("format diff: %w", err)
+			}
+			_, _ = fmt.Fprintln(inv.Stdout, out)
+			return nil
+		},
+	}
+
+	orgContext.AttachOptions(cmd)
+	formatter.AttachOptions(&cmd.Options)
+	return cmd
+}
+
+func formatTemplateVersionDiff(diff codersdk.TemplateVersionDiff) string {
+	if diff.Empty() {
+		return "No changes"
+	}
+
+	var sb strings.Builder
+	if len(diff.Files) > 0 {
+		_, _ = fmt.Fprintln(&sb, pretty.Sprint(cliui.DefaultStyles.Field, "Files"))
+		for _, file := range diff.Files {
+			if file.Binary {
+				_, _ = fmt.Fprintf(&sb, "%s %s (binary)\n", diffChangeMarker(file.Change), file.Path)
+				continue
+			}
+			_, _ = fmt.Fprint(&sb, file.Diff)
+		}
+		_, _ = fmt.Fprintln(&sb)
+	}
+
+	section := func(title string, lines []string) {
+		if len(lines) == 0 {
+			return
+		}
+		_, _ = fmt.Fprintln(&sb, pretty.Sprint(cliui.DefaultStyles.Field, title))
+		for _, line := range lines {
+			_, _ = fmt.Fprintln(&sb, "  "+line)
+		}
+		_, _ = fmt.Fprintln(&sb)
+	}
+
+	var lines []string
+	for _, p := range diff.RichParameters {
+		lines = append(lines, diffItemLine(p.Name, p.Change, p.Old, p.New))
+	}
+	section("Rich parameters", lines)
+
+	lines = nil
+	for _, v := range diff.Variables {
+		lines = append(lines, diffItemLine(v.Name, v.Change, v.Old, v.New))
+	}
+	section("Variables", lines)
+
+	lines = nil
+	for _, p := range diff.Presets {
+		lines = append(lines, diffItemLine(p.Name, p.Change, p.Old, p.New))
+	}
+	section("Presets", lines)
+
+	lines = nil
+	for _, tag := range diff.WorkspaceTags {
+		line := diffChangeMarker(tag.Change) + " " + tag.Key
+		switch tag.Change {
+		case codersdk.TemplateVersionDiffChangeAdded:
+			line += fmt.Sprintf(" = %q", tag.NewValue)
+		case codersdk.TemplateVersionDiffChangeRemoved:
+			line += fmt.Sprintf(" = %q", tag.OldValue)
+		default:
+			line += fmt.Sprintf(": %q -> %q", tag.OldValue, tag.NewValue)
+		}
+		lines = append(lines, line)
+	}
+	section("Workspace tags", lines)
+
+	lines = nil
+	for _, provider := range diff.ExternalAuth {
+		line := diffChangeMarker(provider.Change) + " " + provider.ID
+		if provider.Change == codersdk.TemplateVersionDiffChangeChanged && provider.NewOptional != nil {
+			if *provider.NewOptional {
+				line += " (now optional)"
+			} else {
+				line += " (now required)"
+			}
+		}
+		lines = append(lines, line)
+	}
+	section("External auth", lines)
+
+	return strings.TrimRight(sb.String(), "\n")
+}
+
+func diffChangeMarker(change codersdk.TemplateVersionDiffChange) string {
+	switch change {
+	case codersdk.TemplateVersionDiffChangeAdded:
+		return pretty.Sprint(cliui.DefaultStyles.Keyword, "+")
+	case codersdk.TemplateVersionDiffChangeRemoved:
+		return pretty.Sprint(cliui.DefaultStyles.Error, "-")
+	default:
+		return pretty.Sprint(cliui.DefaultStyles.Warn, "~")
+	}
+}
+
+// diffItemLine renders a single changed item. For changed items, the names of
+// the fields that differ are listed.
+func diffItemLine(name string, change codersdk.TemplateVersionDiffChange, oldItem, newItem any) string {
+	line := diffChangeMarker(change) + " " + name
+	if change != codersdk.TemplateVersionDiffChangeChanged {
+		return line
+	}
+	fields := changedFields(oldItem, newItem)
+	if len(fields) > 0 {
+		line += " (" + strings.Join(fields, ", ") + ")"
+	}
+	return line
+}
+
+// changedFields returns the sorted JSON field names that differ between two
+// values of the same type.
+func changedFields(oldItem, newItem any) []string {
+	toMap := func(v any) map[string]any {
+		m := map[string]any{}
+		data, err := json.Marshal(v)
+		if err != nil {
+			return m
+		}
+		_ = json.Unmarshal(data, &m)
+		return m
+	}
+	oldFields, newFields := toMap(oldItem), toMap(newItem)
+
+	var fields []string
+	for key, oldValue := range oldFields {
+		if key == "id" || key == "ID" {
+			continue
+		}
+		if !reflect.DeepEqual(oldValue, newFields[key]) {
+			fields = append(fields, key)
+		}
+	}
+	for key := range newFields {
+		if _, ok := oldFields[key]; !ok {
+			fields = append(fields, key)
+		}
+	}
+	slices.Sort(fields)
+	return fields
+}
@@ -0,0 +1,298 @@
+package cli
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/google/uuid"
+	"golang.org/x/xerrors"
+
+	"github.com/coder/coder/v2/cli/cliui"
+	"github.com/coder/coder/v2/coderd/util/ptr"
+	"github.com/coder/coder/v2/codersdk"
+	"github.com/coder/pretty"
+	"github.com/coder/serpent"
+)
+
+func (r *RootCmd) templateVersionsRollout() *serpent.Command {
+	return &serpent.Command{
+		Use:   "rollout",
+		Short: "Manage staged rollouts of template versions",
+		Long: "A rollout updates a subset of the workspaces that follow the active version of a template to a new version, " +
+			"before the version is promoted to all of them. Start a rollout with `coder templates versions promote --percentage`.\n\n" + FormatExamples(
+			Example{
+				Description: "Show the progress of the rollout of a template",
+				Command:     "coder templates versions rollout status my-template",
+			},
+		),
+		Handler: func(inv *serpent.Invocation) error {
+			return inv.Command.HelpHandler(inv)
+		},
+		Children: []*serpent.Command{
+			r.templateVersionsRolloutStatus(),
+			r.templateVersionsRolloutPause(),
+			r.templateVersionsRolloutResume(),
+			r.templateVersionsRolloutRollback(),
+		},
+	}
+}
+
+func (r *RootCmd) templateVersionsRolloutStatus() *serpent.Command {
+	var (
+		orgContext = NewOrganizationContext()
+		formatter  = cliui.NewOutputFormatter(
+			cliui.ChangeFormatterData(cliui.TextFormat(), func(data any) (any, error) {
+				rollout, ok := data.(codersdk.TemplateVersionRollout)
+				if !ok {
+					return nil, xerrors.Errorf("expected type %T, got %T", rollout, data)
+				}
+				return formatTemplateVersionRollout(rollout), nil
+			}),
+			cliui.JSONFormat(),
+		)
+	)
+	cmd := &serpent.Command{
+		Use:   "status <template>",
+		Short: "Show the progress of the latest rollout of a template",
+		Middleware: serpent.Chain(
+			serpent.RequireNArgs(1),
+		),
+		Handler: func(inv *serpent.Invocation) error {
+			ctx := inv.Context()
+			client, err := r.InitClient(inv)
+			if err != nil {
+				return err
+			}
+			organization, err := orgContext.Selected(inv, client)
+			if err != nil {
+				return err
+			}
+			template, err := client.TemplateByName(ctx, organization.ID, inv.Args[0])
+			if err != nil {
+				return xerrors.Errorf("get template by name: %w", err)
+			}
+
+			rollouts, err := client.TemplateVersionRollouts(ctx, template.ID)
+			if err != nil {
+				return xerrors.Errorf("get template version rollouts: %w", err)
+			}
+			if len(rollouts) == 0 {
+				return xerrors.Errorf("template %q has no rollouts", template.Name)
+			}
+
+			out, err := formatter.Format(ctx, rollouts[0])
+			if err != nil {
+				return xerrors.Errorf("format rollout: %w", err)
+			}
+			_, _ = fmt.Fprintln(inv.Stdout, out)
+			return nil
+		},
+	}
+
+	orgContext.AttachOptions(cmd)
+	formatter.AttachOptions(&cmd.Options)
+	return cmd
+}
+
+func (r *RootCmd) templateVersionsRolloutPause() *serpent.Command {
+	return r.templateVersionsRolloutAction(
+		"pause",
+		"Stop the rollout of a template from reaching more workspaces",
+		"Paused",
+		func(ctx context.Context, client *codersdk.Client, rollout codersdk.TemplateVersionRollout) (codersdk.TemplateVersionRollout, error) {
+			return client.UpdateTemplateVersionRollout(ctx, rollout.TemplateID, rollout.ID, codersdk.UpdateTemplateVersionRolloutRequest{
+				Status: ptr.Ref(codersdk.TemplateVersionRolloutStatusPaused),
+			})
+		},
+	)
+}
+
+func (r *RootCmd) templateVersionsRolloutResume() *serpent.Command {
+	return r.templateVersionsRolloutAction(
+		"resume",
+		"Resume a paused rollout of a template",
+		"Resumed",
+		func(ctx context.Context, client *codersdk.Client, rollout codersdk.TemplateVersionRollout) (codersdk.TemplateVersionRollout, error) {
+			return client.UpdateTemplateVersionRollout(ctx, rollout.TemplateID, rollout.ID, codersdk.UpdateTemplateVersionRolloutRequest{
+				Status: ptr.Ref(codersdk.TemplateVersionRolloutStatusInProgress),
+			})
+		},
+	)
+}
+
+func (r *RootCmd) templateVersionsRolloutRollback() *serpent.Command {
+	return r.templateVersionsRolloutAction(
+		"rollback",
+		"End the rollout of a template and move its workspaces back to the active version on their next start",
+		"Rolled back",
+		func(ctx context.Context, client *codersdk.Client, rollout codersdk.TemplateVersionRollout) (codersdk.TemplateVersionRollout, error) {
+			return client.RollbackTemplateVersionRollout(ctx, rollout.TemplateID, rollout.ID)
+		},
+	)
+}
+
+// templateVersionsRolloutAction returns a command that applies an action to
+// the rollout of a template that is in progress or paused.
+func (r *RootCmd) templateVersionsRolloutAction(
+	use, short, done string,
+	action func(context.Context, *codersdk.Client, codersdk.TemplateVersionRollout) (codersdk.TemplateVersionRollout, error),
+) *serpent.Command {
+	orgContext := NewOrganizationContext()
+	cmd := &serpent.Command{
+		Use:   use + " <template>",
+		Short: short,
+		Middleware: serpent.Chain(
+			serpent.RequireNArgs(1),
+		),
+		Handler: func(inv *serpent.Invocation) error {
+			ctx := inv.Context()
+			client, err := r.InitClient(inv)
+			if err != nil {
+				return err
+			}
+			organization, err := orgContext.Selected(inv, client)
+			if err != nil {
+				return err
+			}
+			template, err := client.TemplateByName(ctx, organization.ID, inv.Args[0])
+			if err != nil {
+				return xerrors.Errorf("get template by name: %w", err)
+			}
+
+			rollout, ok, err := activeTemplateVersionRollout(ctx, client, template.ID)
+			if err != nil {
+				return err
+			}
+			if !ok {
+				return xerrors.Errorf("template %q has no rollout in progress", template.Name)
+			}
+			rollout, err = action(ctx, client, rollout)
+			if err != nil {
+				return xerrors.Errorf("%s rollout: %w", use, err)
+			}
+
+			_, _ = fmt.Fprintf(inv.Stdout, "%s the rollout of version %q for template %q\n", done, rollout.TemplateVersionName, template.Name)
+			return nil
+		},
+	}
+	orgContext.AttachOptions(cmd)
+	return cmd
+}
+
+// activeTemplateVersionRollout returns the rollout of the template that is in
+// progress or paused, if any.
+func activeTemplateVersionRollout(ctx context.Context, client *codersdk.Client, templateID uuid.UUID) (codersdk.TemplateVersionRollout, bool, error) {
+	rollouts, err := client.TemplateVersionRollouts(ctx, templateID)
+	if err != nil {
+		return codersdk.TemplateVersionRollout{}, false, xerrors.Errorf("get template version rollouts: %w", err)
+	}
+	for _, rollout := range rollouts {
+		if rollout.Status.Active() {
+			return rollout, true, nil
+		}
+	}
+	return codersdk.TemplateVersionRollout{}, false, nil
+}
+
+// startTemplateVersionRollout starts a rollout of the version, or updates the
+// rollout of the version if it is already in progress or paused. Settings that
+// were not set on the command line are left to their defaults, or unchanged.
+func startTemplateVersionRollout(
+	inv *serpent.Invocation,
+	client *codersdk.Client,
+	template codersdk.Template,
+	version codersdk.TemplateVersion,
+	percentage int64,
+	groupIDs []uuid.UUID,
+	failureThreshold float64,
+	onFailure string,
+) (codersdk.TemplateVersionRollout, bool, error) {
+	ctx := inv.Context()
+	if percentage < 0 || percentage > 100 {
+		return codersdk.TemplateVersionRollout{}, false, xerrors.Errorf("percentage must be between 0 and 100, got %d", percentage)
+	}
+
+	existing, ok, err := activeTemplateVersionRollout(ctx, client, template.ID)
+	if err != nil {
+		return codersdk.TemplateVersionRollout{}, false, err
+	}
+	if ok && existing.TemplateVersionID != version.ID {
+		return codersdk.TemplateVersionRollout{}, false, xerrors.Errorf(
+			"version %q of template %q is already being rolled out, promote or roll it back first",
+			existing.TemplateVersionName, template.Name,
+		)
+	}
+
+	if ok {
+		var req codersdk.UpdateTemplateVersionRolloutRequest
+		if inv.ParsedFlags().Changed("percentage") {
+			//nolint:gosec // The percentage was checked above.
+			req.Percentage = ptr.Ref(int32(percentage))
+		}
+		if inv.ParsedFlags().Changed("group") {
+			req.GroupIDs = &groupIDs
+		}
+		if inv.ParsedFlags().Changed("failure-threshold") {
+			req.FailureThreshold = &failureThreshold
+		}
+		if onFailure != "" {
+			action := codersdk.TemplateVersionRolloutFailureAction(onFailure)
+			req.FailureAction = &action
+		}
+		rollout, err := client.UpdateTemplateVersionRollout(ctx, template.ID, existing.ID, req)
+		if err != nil {
+			return codersdk.TemplateVersionRollout{}, false, xerrors.Errorf("update template version rollout: %w", err)
+		}
+		return rollout, false, nil
+	}
+
+	rollout, err := client.CreateTemplateVersionRollout(ctx, template.ID, codersdk.CreateTemplateVersionRolloutRequest{
+		TemplateVersionID: version.ID,
+		//nolint:gosec // The percentage was checked above.
+		Percentage:       int32(percentage),
+		GroupIDs:         groupIDs,
+		FailureThreshold: failureThreshold,
+		FailureAction:    codersdk.TemplateVersionRolloutFailureAction(onFailure),
+	})
+	if err != nil {
+		return codersdk.TemplateVersionRollout{}, false, xerrors.Errorf("create template version rollout: %w", err)
+	}
+	return rollout, true, nil
+}
+
+// templateVersionRolloutTargets describes the workspaces that a rollout
+// targets.
+func templateVersionRolloutTargets(rollout codersdk.TemplateVersionRollout) string {
+	var targets []string
+	if rollout.Percentage > 0 {
+		targets = append(targets, fmt.Sprintf("%d%% of workspaces", rollout.Percentage))
+	}
+	switch len(rollout.GroupIDs) {
+	case 0:
+	case 1:
+		targets = append(targets, "the workspaces of 1 group")
+	default:
+		targets = append(targets, fmt.Sprintf("the workspaces of %d groups", len(rollout.GroupIDs)))
+	}
+	return strings.Join(targets, " and ")
+}
+
+func formatTemplateVersionRollout(rollout codersdk.TemplateVersionRollout) string {
+	status := string(rollout.Status)
+	if rollout.StatusMessage != "" {
+		status += " (" + rollout.StatusMessage + ")"
+	}
+
+	var sb strings.Builder
+	field := func(name, value string) {
+		_, _ = fmt.Fprintf(&sb, "%s %s\n", pretty.Sprint(cliui.DefaultStyles.Field, fmt.Sprintf("%-10s", name+":")), value)
+	}
+	field("Version", rollout.TemplateVersionName)
+	field("Status", status)
+	field("Targets", templateVersionRolloutTargets(rollout))
+	field("Builds", fmt.Sprintf("%d succeeded, %d failed", rollout.Progress.BuildsSucceeded, rollout.Progress.BuildsFailed))
+	field("Agents", fmt.Sprintf("%d ready, %d failed", rollout.Progress.AgentsReady, rollout.Progress.AgentsFailed))
+	field("On failure", fmt.Sprintf("%s above %g%% failures after %d samples", rollout.FailureAction, rollout.FailureThreshold*100, rollout.MinSamples))
+	return strings.TrimRight(sb.String(), "\n")
+}
@@ -33,6 +33,8 @@ func (r *RootCmd) templateVersions() *serpent.Command {
 			r.archiveTemplateVersion(),
 			r.unarchiveTemplateVersion(),
 			r.templateVersionsPromote(),
+			r.templateVersionsDiff(),
+			r.templateVersionsRollout(),
 		},
 	}
 
@@ -186,13 +188,30 @@ func (r *RootCmd) templateVersionsPromote() *serpent.Command {
 	var (
 		templateName        string
 		templateVersionName string
+		percentage          int64
+		groupNames          []string
+		failureThreshold    float64
+		onFailure           string
 		orgContext          = NewOrganizationContext()
 	)
 	cmd := &serpent.Command{
 		Use:   "promote --template=<template_name> --template-version=<template_version_name>",
 		Short: "Promote a template version to active.",
-		Long:  "Promote an existing template version to be the active version for the specified template.",
+		Long: "Promote an existing template version to be the active version for the specified template.\n\n" +
+			"Use --percentage or --group to roll the version out to a subset of the workspaces that follow the active version first. " +
+			"The rollout is paused or rolled back automatically when too many of its builds or agents fail. " +
+			"Run the command again without these flags to promote the version to all workspaces.\n\n" + FormatExamples(
+			Example{
+				Description: "Roll out a version to 10% of workspaces",
+				Command:     "coder templates versions promote --template=my-template --template-version=v2 --percentage=10",
+			},
+			Example{
+				Description: "Roll out a version to a group, rolling back on more than 10% failures",
+				Command:     "coder templates versions promote --template=my-template --template-version=v2 --group=canary --failure-threshold=0.1 --on-failure=rollback",
+			},
+		),
 		Handler: func(inv *serpent.Invocation) error {
+			ctx := inv.Context()
 			client, err := r.InitClient(inv)
 			if err != nil {
 				return err
@@ -202,17 +221,40 @@ func (r *RootCmd) templateVersionsPromote() *serpent.Command {
 				return err
 			}
 
-			template, err := client.TemplateByName(inv.Context(), organization.ID, templateName)
+			template, err := client.TemplateByName(ctx, organization.ID, templateName)
 			if err != nil {
 				return xerrors.Errorf("get template by name: %w", err)
 			}
 
-			version, err := client.TemplateVersionByName(inv.Context(), template.ID, templateVersionName)
+			version, err := client.TemplateVersionByName(ctx, template.ID, templateVersionName)
 			if err != nil {
 				return xerrors.Errorf("get template version by name: %w", err)
 			}
 
-			err = client.UpdateActiveTemplateVersion(inv.Context(), template.ID, codersdk.UpdateActiveTemplateVersion{
+			if percentage > 0 || len(groupNames) > 0 {
+				groupIDs := make([]uuid.UUID, 0, len(groupNames))
+				for _, name := range groupNames {
+					group, err := client.GroupByOrgAndName(ctx, organization.ID, name)
+					if err != nil {
+						return xerrors.Errorf("get group %q: %w", name, err)
+					}
+					groupIDs = append(groupIDs, group.ID)
+				}
+
+				rollout, created, err := startTemplateVersionRollout(inv, client, template, version, percentage, groupIDs, failureThreshold, onFailure)
+				if err != nil {
+					return err
+				}
+				verb := "Updated the rollout of"
+				if created {
+					verb = "Started rolling out"
+				}
+				_, _ = fmt.Fprintf(inv.Stdout, "%s version %q for template %q to %s\n", verb, templateVersionName, templateName, templateVersionRolloutTargets(rollout))
+				_, _ = fmt.Fprintf(inv.Stdout, "Run %s to follow its progress.\n", pretty.Sprint(cliui.DefaultStyles.Code, "coder templates versions rollout status "+templateName))
+				return nil
+			}
+
+			err = client.UpdateActiveTemplateVersion(ctx, template.ID, codersdk.UpdateActiveTemplateVersion{
 				ID: version.ID,
 			})
 			if err != nil {
@@ -240,6 +282,29 @@ func (r *RootCmd) templateVersionsPromote() *serpent.Command {
 			Required:    true,
 			Value:       serpent.StringOf(&templateVersionName),
 		},
+		{
+			Flag:        "percentage",
+			Description: "Roll the version out to this percentage of the workspaces that follow the active version, instead of promoting it to all of them.",
+			Value:       serpent.Int64Of(&percentage),
+		},
+		{
+			Flag:        "group",
+			Description: "Roll the version out to the workspaces of the members of these groups, instead of promoting it to all workspaces.",
+			Value:       serpent.StringArrayOf(&groupNames),
+		},
+		{
+			Flag:        "failure-threshold",
+			Description: "The ratio of failed builds or agents of a rollout, between 0 and 1, above which the rollout is paused or rolled back. Defaults to 0.2.",
+			Value:       serpent.Float64Of(&failureThreshold),
+		},
+		{
+			Flag:        "on-failure",
+			Description: "What happens to a rollout when its failure threshold is exceeded. Defaults to pause.",
+			Value: serpent.EnumOf(&onFailure,
+				string(codersdk.TemplateVersionRolloutFailureActionPause),
+				string(codersdk.TemplateVersionRolloutFailureActionRollback),
+			),
+		},
 	}
 	orgContext.AttachOptions(cmd)
 	return cmd
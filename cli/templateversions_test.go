@@ -11,7 +11,10 @@ import (
 
 	"github.com/coder/coder/v2/cli/clitest"
 	"github.com/coder/coder/v2/coderd/coderdtest"
+	"github.com/coder/coder/v2/coderd/rbac"
 	"github.com/coder/coder/v2/codersdk"
+	"github.com/coder/coder/v2/provisioner/echo"
+	"github.com/coder/coder/v2/provisionersdk/proto"
 	"github.com/coder/coder/v2/testutil"
 	"github.com/coder/coder/v2/testutil/expecter"
 )
@@ -154,3 +157,151 @@ func TestTemplateVersionsPromote(t *testing.T) {
 		require.Contains(t, err.Error(), "get template by name")
 	})
 }
+
+func TestTemplateVersionsDiff(t *testing.T) {
+	t.Parallel()
+
+	client := coderdtest.New(t, &coderdtest.Options{IncludeProvisionerDaemon: true})
+	owner := coderdtest.CreateFirstUser(t, client)
+	templateAdmin, _ := coderdtest.CreateAnotherUser(t, client, owner.OrganizationID, rbac.RoleTemplateAdmin())
+
+	version1 := coderdtest.CreateTemplateVersion(t, templateAdmin, owner.OrganizationID, &echo.Responses{
+		Parse:          echo.ParseComplete,
+		ProvisionApply: echo.ApplyComplete,
+		ExtraFiles:     map[string][]byte{"main.tf": []byte("locals {\n  name = \"a\"\n}\n")},
+	}, func(ctvr *codersdk.CreateTemplateVersionRequest) {
+		ctvr.Name = "1.0.0"
+	})
+	coderdtest.AwaitTemplateVersionJobCompleted(t, templateAdmin, version1.ID)
+	template := coderdtest.CreateTemplate(t, templateAdmin, owner.OrganizationID, version1.ID)
+
+	version2 := coderdtest.CreateTemplateVersion(t, templateAdmin, owner.OrganizationID, &echo.Responses{
+		Parse: echo.ParseComplete,
+		ProvisionGraph: []*proto.Response{{
+			Type: &proto.Response_Graph{
+				Graph: &proto.GraphComplete{
+					Parameters: []*proto.RichParameter{{Name: "region", Type: "string"}},
+				},
+			},
+		}},
+		ProvisionApply: echo.ApplyComplete,
+		ExtraFiles:     map[string][]byte{"main.tf": []byte("locals {\n  name = \"b\"\n}\n")},
+	}, func(ctvr *codersdk.CreateTemplateVersionRequest) {
+		ctvr.TemplateID = template.ID
+		ctvr.Name = "2.0.0"
+	})
+	coderdtest.AwaitTemplateVersionJobCompleted(t, templateAdmin, version2.ID)
+
+	t.Run("ActiveVersion", func(t *testing.T) {
+		t.Parallel()
+
+		inv, root := clitest.New(t, "templates", "versions", "diff", template.Name, version2.Name)
+		clitest.SetupConfig(t, templateAdmin, root)
+		var stdout bytes.Buffer
+		inv.Stdout = &stdout
+
+		err := inv.WithContext(testutil.Context(t, testutil.WaitLong)).Run()
+		require.NoError(t, err)
+		require.Contains(t, stdout.String(), "--- a/main.tf")
+		require.Contains(t, stdout.String(), "+  name = \"b\"")
+		require.Contains(t, stdout.String(), "Rich parameters")
+		require.Contains(t, stdout.String(), "+ region")
+	})
+
+	t.Run("NoChanges", func(t *testing.T) {
+		t.Parallel()
+
+		inv, root := clitest.New(t, "templates", "versions", "diff", template.Name, version1.Name, version1.Name)
+		clitest.SetupConfig(t, templateAdmin, root)
+		var stdout bytes.Buffer
+		inv.Stdout = &stdout
+
+		err := inv.WithContext(testutil.Context(t, testutil.WaitLong)).Run()
+		require.NoError(t, err)
+		require.Equal(t, "No changes\n", stdout.String())
+	})
+
+	t.Run("JSON", func(t *testing.T) {
+		t.Parallel()
+
+		inv, root := clitest.New(t, "templates", "versions", "diff", template.Name, version2.Name, version1.Name, "--output", "json")
+		clitest.SetupConfig(t, templateAdmin, root)
+		var stdout bytes.Buffer
+		inv.Stdout = &stdout
+
+		err := inv.WithContext(testutil.Context(t, testutil.WaitLong)).Run()
+		require.NoError(t, err)
+
+		var diff codersdk.TemplateVersionDiff
+		require.NoError(t, json.Unmarshal(stdout.Bytes(), &diff))
+		require.Equal(t, version1.ID, diff.BaseTemplateVersionID)
+		require.Equal(t, version2.ID, diff.TemplateVersionID)
+		require.Len(t, diff.RichParameters, 1)
+		require.Equal(t, codersdk.TemplateVersionDiffChangeAdded, diff.RichParameters[0].Change)
+	})
+}
+
+func TestTemplateVersionsRollout(t *testing.T) {
+	t.Parallel()
+
+	client := coderdtest.New(t, &coderdtest.Options{IncludeProvisionerDaemon: true})
+	owner := coderdtest.CreateFirstUser(t, client)
+	templateAdmin, _ := coderdtest.CreateAnotherUser(t, client, owner.OrganizationID, rbac.RoleTemplateAdmin())
+
+	version1 := coderdtest.CreateTemplateVersion(t, templateAdmin, owner.OrganizationID, nil)
+	coderdtest.AwaitTemplateVersionJobCompleted(t, templateAdmin, version1.ID)
+	template := coderdtest.CreateTemplate(t, templateAdmin, owner.OrganizationID, version1.ID)
+	version2 := coderdtest.CreateTemplateVersion(t, templateAdmin, owner.OrganizationID, nil, func(ctvr *codersdk.CreateTemplateVersionRequest) {
+		ctvr.TemplateID = template.ID
+		ctvr.Name = "2.0.0"
+	})
+	coderdtest.AwaitTemplateVersionJobCompleted(t, templateAdmin, version2.ID)
+
+	run := func(t *testing.T, args ...string) string {
+		t.Helper()
+
+		inv, root := clitest.New(t, args...)
+		clitest.SetupConfig(t, templateAdmin, root)
+		var stdout bytes.Buffer
+		inv.Stdout = &stdout
+		err := inv.WithContext(testutil.Context(t, testutil.WaitLong)).Run()
+		require.NoError(t, err)
+		return stdout.String()
+	}
+
+	out := run(t, "templates", "versions", "promote", "--template", template.Name, "--template-version", version2.Name, "--percentage", "10", "--on-failure", "rollback")
+	require.Contains(t, out, "Started rolling out version \"2.0.0\"")
+	require.Contains(t, out, "10% of workspaces")
+
+	// Promoting the same version with a new percentage updates the rollout.
+	out = run(t, "templates", "versions", "promote", "--template", template.Name, "--template-version", version2.Name, "--percentage", "50")
+	require.Contains(t, out, "Updated the rollout of version \"2.0.0\"")
+
+	out = run(t, "templates", "versions", "rollout", "pause", template.Name)
+	require.Contains(t, out, "Paused the rollout")
+
+	out = run(t, "templates", "versions", "rollout", "status", template.Name, "--output", "json")
+	var rollout codersdk.TemplateVersionRollout
+	require.NoError(t, json.Unmarshal([]byte(out), &rollout))
+	require.Equal(t, version2.ID, rollout.TemplateVersionID)
+	require.EqualValues(t, 50, rollout.Percentage)
+	require.Equal(t, codersdk.TemplateVersionRolloutFailureActionRollback, rollout.FailureAction)
+	require.Equal(t, codersdk.TemplateVersionRolloutStatusPaused, rollout.Status)
+
+	out = run(t, "templates", "versions", "rollout", "resume", template.Name)
+	require.Contains(t, out, "Resumed the rollout")
+
+	out = run(t, "templates", "versions", "rollout", "status", template.Name)
+	require.Contains(t, out, "in_progress")
+	require.Contains(t, out, "0 succeeded, 0 failed")
+
+	// Promoting without a percentage completes the rollout.
+	run(t, "templates", "versions", "promote", "--template", template.Name, "--template-version", version2.Name)
+	out = run(t, "templates", "versions", "rollout", "status", template.Name)
+	require.Contains(t, out, "completed")
+
+	inv, root := clitest.New(t, "templates", "versions", "rollout", "rollback", template.Name)
+	clitest.SetupConfig(t, templateAdmin, root)
+	err := inv.WithContext(testutil.Context(t, testutil.WaitLong)).Run()
+	require.ErrorContains(t, err, "has no rollout in progress")
+}
@@ -13,17 +13,22 @@ import (
 func (r *RootCmd) userOIDCClaims() *serpent.Command {
 	formatter := cliui.NewOutputFormatter(
 		cliui.ChangeFormatterData(
-			cliui.TableFormat([]claimRow{}, []string{"key", "value"}),
+			cliui.TableFormat([]claimRow{}, []string{"provider", "key", "value"}),
 			func(data any) (any, error) {
 				resp, ok := data.(codersdk.OIDCClaimsResponse)
 				if !ok {
 					return nil, xerrors.Errorf("expected type %T, got %T", resp, data)
 				}
+				provider := resp.Provider
+				if provider == "" {
+					provider = "primary"
+				}
 				rows := make([]claimRow, 0, len(resp.Claims))
 				for k, v := range resp.Claims {
 					rows = append(rows, claimRow{
-						Key:   k,
-						Value: fmt.Sprintf("%v", v),
+						Provider: provider,
+						Key:      k,
+						Value:    fmt.Sprintf("%v", v),
 					})
 				}
 				return rows, nil
@@ -74,6 +79,7 @@ func (r *RootCmd) userOIDCClaims() *serpent.Command {
 }
 
 type claimRow struct {
-	Key   string `json:"-" table:"key,default_sort"`
-	Value string `json:"-" table:"value"`
+	Provider string `json:"-" table:"provider"`
+	Key      string `json:"-" table:"key,default_sort"`
+	Value    string `json:"-" table:"value"`
 }
@@ -0,0 +1,409 @@
+package cli
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+	"golang.org/x/xerrors"
+
+	"github.com/coder/coder/v2/cli/cliui"
+	"github.com/coder/coder/v2/codersdk"
+	"github.com/coder/pretty"
+	"github.com/coder/serpent"
+)
+
+// workspacesBulkPollInterval is how often the progress of a bulk operation is
+// checked while waiting for it.
+const workspacesBulkPollInterval = time.Second
+
+func (r *RootCmd) workspaces() *serpent.Command {
+	return &serpent.Command{
+		Annotations: workspaceCommand,
+		Use:         "workspaces",
+		Short:       "Manage many workspaces at once",
+		Handler: func(inv *serpent.Invocation) error {
+			return inv.Command.HelpHandler(inv)
+		},
+		Children: []*serpent.Command{
+			r.workspacesBulk(),
+		},
+	}
+}
+
+func (r *RootCmd) workspacesBulk() *serpent.Command {
+	return &serpent.Command{
+		Use:   "bulk",
+		Short: "Apply an action to every workspace that matches a search query",
+		Long: "Bulk operations run on the server, a few workspaces at a time, and record the outcome for each workspace. " +
+			"The search query uses the same syntax as `coder list --search`, and is evaluated once, when the operation is created.\n\n" + FormatExamples(
+			Example{
+				Description: "Stop every workspace of a template",
+				Command:     `coder workspaces bulk stop --search template:docker`,
+			},
+			Example{
+				Description: "Update every outdated workspace of an organization",
+				Command:     `coder workspaces bulk update --search "organization:eng outdated:true"`,
+			},
+			Example{
+				Description: "Show the progress of your latest bulk operation",
+				Command:     "coder workspaces bulk status",
+			},
+		),
+		Handler: func(inv *serpent.Invocation) error {
+			return inv.Command.HelpHandler(inv)
+		},
+		Children: []*serpent.Command{
+			r.workspacesBulkBuild("start", "Start every matching workspace that is not running", codersdk.WorkspaceBulkOperationActionStart),
+			r.workspacesBulkBuild("stop", "Stop every matching workspace that is running", codersdk.WorkspaceBulkOperationActionStop),
+			r.workspacesBulkBuild("update", "Update every matching workspace to the active version of its template", codersdk.WorkspaceBulkOperationActionUpdate),
+			r.workspacesBulkBuild("delete", "Delete every matching workspace", codersdk.WorkspaceBulkOperationActionDelete),
+			r.workspacesBulkAutostart(),
+			r.workspacesBulkAutostop(),
+			r.workspacesBulkAutoupdate(),
+			r.workspacesBulkStatus(),
+			r.workspacesBulkCancel(),
+		},
+	}
+}
+
+func (r *RootCmd) workspacesBulkBuild(use, short string, action codersdk.WorkspaceBulkOperationAction) *serpent.Command {
+	return r.workspacesBulkAction(use, short, serpent.RequireNArgs(0), func(*serpent.Invocation) (codersdk.CreateWorkspaceBulkOperationRequest, error) {
+		return codersdk.CreateWorkspaceBulkOperationRequest{Action: action}, nil
+	})
+}
+
+func (r *RootCmd) workspacesBulkAutostart() *serpent.Command {
+	cmd := r.workspacesBulkAction(
+		"autostart { <start-time> [day-of-week] [location] | manual }",
+		"Set the autostart schedule of every matching workspace",
+		serpent.RequireRangeArgs(1, 3),
+		func(inv *serpent.Invocation) (codersdk.CreateWorkspaceBulkOperationRequest, error) {
+			req := codersdk.CreateWorkspaceBulkOperationRequest{Action: codersdk.WorkspaceBulkOperationActionAutostart}
+			if inv.Args[0] != "manual" {
+				sched, err := parseCLISchedule(inv.Args...)
+				if err != nil {
+					return codersdk.CreateWorkspaceBulkOperationRequest{}, err
+				}
+				req.AutostartSchedule = sched.String()
+			}
+			return req, nil
+		},
+	)
+	cmd.Long = "The schedule has the same format as `coder schedule start`.\n\n" + FormatExamples(
+		Example{
+			Description: "Start the workspaces of a template at 9:30am on weekdays",
+			Command:     `coder workspaces bulk autostart --search template:docker 9:30AM Mon-Fri`,
+		},
+	)
+	return cmd
+}
+
+func (r *RootCmd) workspacesBulkAutostop() *serpent.Command {
+	cmd := r.workspacesBulkAction(
+		"autostop { <duration> | manual }",
+		"Set the autostop duration of every matching workspace",
+		serpent.RequireNArgs(1),
+		func(inv *serpent.Invocation) (codersdk.CreateWorkspaceBulkOperationRequest, error) {
+			req := codersdk.CreateWorkspaceBulkOperationRequest{Action: codersdk.WorkspaceBulkOperationActionTTL}
+			if inv.Args[0] != "manual" {
+				dur, err := parseDuration(inv.Args[0])
+				if err != nil {
+					return codersdk.CreateWorkspaceBulkOperationRequest{}, err
+				}
+				req.TTLMillis = dur.Milliseconds()
+			}
+			return req, nil
+		},
+	)
+	cmd.Long = "The duration has the same format as `coder schedule stop`.\n\n" + FormatExamples(
+		Example{
+			Command: `coder workspaces bulk autostop --search owner:me 8h`,
+		},
+	)
+	return cmd
+}
+
+func (r *RootCmd) workspacesBulkAutoupdate() *serpent.Command {
+	return r.workspacesBulkAction(
+		"autoupdate <always|never>",
+		"Set the auto-update policy of every matching workspace",
+		serpent.RequireNArgs(1),
+		func(inv *serpent.Invocation) (codersdk.CreateWorkspaceBulkOperationRequest, error) {
+			policy := strings.ToLower(inv.Args[0])
+			if err := validateAutoUpdatePolicy(policy); err != nil {
+				return codersdk.CreateWorkspaceBulkOperationRequest{}, xerrors.Errorf("validate policy: %w", err)
+			}
+			return codersdk.CreateWorkspaceBulkOperationRequest{
+				Action:           codersdk.WorkspaceBulkOperationActionAutomaticUpdates,
+				AutomaticUpdates: codersdk.AutomaticUpdates(policy),
+			}, nil
+		},
+	)
+}
+
+// workspacesBulkAction returns a command that creates a bulk operation for
+// the workspaces that match --search, and waits for it unless --detach is
+// set.
+func (r *RootCmd) workspacesBulkAction(
+	use, short string,
+	args serpent.MiddlewareFunc,
+	request func(*serpent.Invocation) (codersdk.CreateWorkspaceBulkOperationRequest, error),
+) *serpent.Command {
+	var (
+		search      string
+		concurrency int64
+		detach      bool
+	)
+	cmd := &serpent.Command{
+		Use:        use,
+		Short:      short,
+		Middleware: serpent.Chain(args),
+		Handler: func(inv *serpent.Invocation) error {
+			ctx := inv.Context()
+			client, err := r.InitClient(inv)
+			if err != nil {
+				return err
+			}
+			if concurrency < 1 || concurrency > codersdk.MaxWorkspaceBulkOperationConcurrency {
+				return xerrors.Errorf("concurrency must be between 1 and %d, got %d", codersdk.MaxWorkspaceBulkOperationConcurrency, concurrency)
+			}
+			req, err := request(inv)
+			if err != nil {
+				return err
+			}
+			req.Query = search
+			//nolint:gosec // The concurrency was checked above.
+			req.Concurrency = int32(concurrency)
+
+			matches, err := client.Workspaces(ctx, codersdk.WorkspaceFilter{FilterQuery: search})
+			if err != nil {
+				return xerrors.Errorf("query workspaces: %w", err)
+			}
+			if matches.Count == 0 {
+				return xerrors.Errorf("no workspaces match %q", search)
+			}
+			_, err = cliui.Prompt(inv, cliui.PromptOptions{
+				Text:      fmt.Sprintf("Apply %s to %d workspaces matching %q?", req.Action, matches.Count, search),
+				IsConfirm: true,
+				Default:   cliui.ConfirmNo,
+			})
+			if err != nil {
+				return err
+			}
+
+			op, err := client.CreateWorkspaceBulkOperation(ctx, req)
+			if err != nil {
+				return xerrors.Errorf("create bulk operation: %w", err)
+			}
+			_, _ = fmt.Fprintf(inv.Stdout, "Created bulk operation %s for %d workspaces\n", op.ID, op.Progress.Total)
+			if detach {
+				_, _ = fmt.Fprintf(inv.Stdout, "Run %s to follow its progress.\n", pretty.Sprint(cliui.DefaultStyles.Code, "coder workspaces bulk status "+op.ID.String()))
+				return nil
+			}
+
+			op, err = r.waitForWorkspaceBulkOperation(inv, client, op)
+			if err != nil {
+				return err
+			}
+			workspaces, err := client.WorkspaceBulkOperationWorkspaces(ctx, op.ID)
+			if err != nil {
+				return xerrors.Errorf("get bulk operation workspaces: %w", err)
+			}
+			out, err := formatWorkspaceBulkOperation(op, workspaces)
+			if err != nil {
+				return err
+			}
+			_, _ = fmt.Fprintln(inv.Stdout, out)
+			if op.Progress.Failed > 0 {
+				return xerrors.Errorf("%d of %d workspaces failed", op.Progress.Failed, op.Progress.Total)
+			}
+			return nil
+		},
+	}
+	cmd.Options = serpent.OptionSet{
+		{
+			Flag:        "search",
+			Description: "The search query that selects the workspaces, e.g. \"template:docker owner:alice\".",
+			Required:    true,
+			Value:       serpent.StringOf(&search),
+		},
+		{
+			Flag:        "concurrency",
+			Description: "The number of workspaces that are processed at the same time.",
+			Default:     fmt.Sprint(codersdk.DefaultWorkspaceBulkOperationConcurrency),
+			Value:       serpent.Int64Of(&concurrency),
+		},
+		{
+			Flag:        "detach",
+			Description: "Return once the operation is created instead of waiting for it to finish.",
+			Value:       serpent.BoolOf(&detach),
+		},
+		cliui.SkipPromptOption(),
+	}
+	return cmd
+}
+
+// waitForWorkspaceBulkOperation polls a bulk operation until none of its
+// workspaces are pending or running, printing its progress as it changes.
+func (r *RootCmd) waitForWorkspaceBulkOperation(inv *serpent.Invocation, client *codersdk.Client, op codersdk.WorkspaceBulkOperation) (codersdk.WorkspaceBulkOperation, error) {
+	ctx := inv.Context()
+	ticker := r.clock.NewTicker(workspacesBulkPollInterval)
+	defer ticker.Stop()
+
+	var last codersdk.WorkspaceBulkOperationProgress
+	for {
+		if op.Progress != last {
+			_, _ = fmt.Fprintln(inv.Stdout, formatWorkspaceBulkOperationProgress(op.Progress))
+			last = op.Progress
+		}
+		if op.Status != codersdk.WorkspaceBulkOperationStatusRunning && op.Progress.Done() {
+			return op, nil
+		}
+
+		select {
+		case <-ctx.Done():
+			return op, ctx.Err()
+		case <-ticker.C:
+		}
+		var err error
+		op, err = client.WorkspaceBulkOperation(ctx, op.ID)
+		if err != nil {
+			return op, xerrors.Errorf("get bulk operation: %w", err)
+		}
+	}
+}
+
+func (r *RootCmd) workspacesBulkStatus() *serpent.Command {
+	formatter := cliui.NewOutputFormatter(
+		cliui.ChangeFormatterData(cliui.TextFormat(), func(data any) (any, error) {
+			status, ok := data.(workspaceBulkOperationStatus)
+			if !ok {
+				return nil, xerrors.Errorf("expected type %T, got %T", status, data)
+			}
+			return formatWorkspaceBulkOperation(status.Operation, status.Workspaces)
+		}),
+		cliui.JSONFormat(),
+	)
+	cmd := &serpent.Command{
+		Use:   "status [operation-id]",
+		Short: "Show the progress of a bulk operation, or of your latest one",
+		Middleware: serpent.Chain(
+			serpent.RequireRangeArgs(0, 1),
+		),
+		Handler: func(inv *serpent.Invocation) error {
+			ctx := inv.Context()
+			client, err := r.InitClient(inv)
+			if err != nil {
+				return err
+			}
+
+			var op codersdk.WorkspaceBulkOperation
+			if len(inv.Args) == 1 {
+				id, err := uuid.Parse(inv.Args[0])
+				if err != nil {
+					return xerrors.Errorf("invalid operation ID %q: %w", inv.Args[0], err)
+				}
+				op, err = client.WorkspaceBulkOperation(ctx, id)
+				if err != nil {
+					return xerrors.Errorf("get bulk operation: %w", err)
+				}
+			} else {
+				ops, err := client.WorkspaceBulkOperations(ctx)
+				if err != nil {
+					return xerrors.Errorf("list bulk operations: %w", err)
+				}
+				if len(ops) == 0 {
+					return xerrors.New("you have not created any bulk operations")
+				}
+				op = ops[0]
+			}
+			workspaces, err := client.WorkspaceBulkOperationWorkspaces(ctx, op.ID)
+			if err != nil {
+				return xerrors.Errorf("get bulk operation workspaces: %w", err)
+			}
+
+			out, err := formatter.Format(ctx, workspaceBulkOperationStatus{Operation: op, Workspaces: workspaces})
+			if err != nil {
+				return xerrors.Errorf("format bulk operation: %w", err)
+			}
+			_, _ = fmt.Fprintln(inv.Stdout, out)
+			return nil
+		},
+	}
+	formatter.AttachOptions(&cmd.Options)
+	return cmd
+}
+
+func (r *RootCmd) workspacesBulkCancel() *serpent.Command {
+	return &serpent.Command{
+		Use:   "cancel <operation-id>",
+		Short: "Stop a bulk operation from processing more workspaces",
+		Long:  "Workspaces that are already being processed are left to finish, and the remaining workspaces are skipped.",
+		Middleware: serpent.Chain(
+			serpent.RequireNArgs(1),
+		),
+		Handler: func(inv *serpent.Invocation) error {
+			client, err := r.InitClient(inv)
+			if err != nil {
+				return err
+			}
+			id, err := uuid.Parse(inv.Args[0])
+			if err != nil {
+				return xerrors.Errorf("invalid operation ID %q: %w", inv.Args[0], err)
+			}
+			op, err := client.CancelWorkspaceBulkOperation(inv.Context(), id)
+			if err != nil {
+				return xerrors.Errorf("cancel bulk operation: %w", err)
+			}
+			_, _ = fmt.Fprintf(inv.Stdout, "Canceled bulk operation %s, %d workspaces were skipped\n", op.ID, op.Progress.Skipped)
+			return nil
+		},
+	}
+}
+
+type workspaceBulkOperationStatus struct {
+	Operation  codersdk.WorkspaceBulkOperation            `json:"operation"`
+	Workspaces []codersdk.WorkspaceBulkOperationWorkspace `json:"workspaces"`
+}
+
+type workspaceBulkOperationRow struct {
+	Workspace string `table:"workspace,default_sort"`
+	Status    string `table:"status"`
+	Error     string `table:"error"`
+}
+
+func formatWorkspaceBulkOperationProgress(progress codersdk.WorkspaceBulkOperationProgress) string {
+	return fmt.Sprintf("%d/%d done: %d succeeded, %d failed, %d skipped, %d running",
+		progress.Succeeded+progress.Failed+progress.Skipped, progress.Total,
+		progress.Succeeded, progress.Failed, progress.Skipped, progress.Running)
+}
+
+func formatWorkspaceBulkOperation(op codersdk.WorkspaceBulkOperation, workspaces []codersdk.WorkspaceBulkOperationWorkspace) (string, error) {
+	var sb strings.Builder
+	field := func(name, value string) {
+		_, _ = fmt.Fprintf(&sb, "%s %s\n", pretty.Sprint(cliui.DefaultStyles.Field, fmt.Sprintf("%-9s", name+":")), value)
+	}
+	field("ID", op.ID.String())
+	field("Action", string(op.Action))
+	field("Query", op.Query)
+	field("Status", string(op.Status))
+	field("Progress", formatWorkspaceBulkOperationProgress(op.Progress))
+
+	rows := make([]workspaceBulkOperationRow, 0, len(workspaces))
+	for _, workspace := range workspaces {
+		rows = append(rows, workspaceBulkOperationRow{
+			Workspace: workspace.WorkspaceOwnerName + "/" + workspace.WorkspaceName,
+			Status:    string(workspace.Status),
+			Error:     workspace.Error,
+		})
+	}
+	table, err := cliui.DisplayTable(rows, "workspace", nil)
+	if err != nil {
+		return "", xerrors.Errorf("display table: %w", err)
+	}
+	sb.WriteString("\n")
+	sb.WriteString(table)
+	return sb.String(), nil
+}
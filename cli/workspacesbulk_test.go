@@ -0,0 +1,75 @@
+package cli_test
+
+import (
+	"bytes"
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/coder/coder/v2/cli/clitest"
+	"github.com/coder/coder/v2/coderd/coderdtest"
+	"github.com/coder/coder/v2/codersdk"
+	"github.com/coder/coder/v2/testutil"
+)
+
+func TestWorkspacesBulk(t *testing.T) {
+	t.Parallel()
+
+	client := coderdtest.New(t, &coderdtest.Options{IncludeProvisionerDaemon: true})
+	owner := coderdtest.CreateFirstUser(t, client)
+	version := coderdtest.CreateTemplateVersion(t, client, owner.OrganizationID, nil)
+	coderdtest.AwaitTemplateVersionJobCompleted(t, client, version.ID)
+	template := coderdtest.CreateTemplate(t, client, owner.OrganizationID, version.ID)
+	for range 3 {
+		workspace := coderdtest.CreateWorkspace(t, client, template.ID)
+		coderdtest.AwaitWorkspaceBuildJobCompleted(t, client, workspace.LatestBuild.ID)
+	}
+
+	run := func(t *testing.T, args ...string) (string, error) {
+		t.Helper()
+
+		inv, root := clitest.New(t, args...)
+		clitest.SetupConfig(t, client, root)
+		var stdout bytes.Buffer
+		inv.Stdout = &stdout
+		err := inv.WithContext(testutil.Context(t, testutil.WaitLong)).Run()
+		return stdout.String(), err
+	}
+
+	out, err := run(t, "workspaces", "bulk", "stop", "--search", "template:"+template.Name, "--concurrency", "2", "--yes")
+	require.NoError(t, err)
+	require.Contains(t, out, "3/3 done: 3 succeeded, 0 failed, 0 skipped")
+
+	// The workspaces are already stopped, so they are skipped.
+	out, err = run(t, "workspaces", "bulk", "stop", "--search", "template:"+template.Name, "--yes")
+	require.NoError(t, err)
+	require.Contains(t, out, "3/3 done: 0 succeeded, 0 failed, 3 skipped")
+	require.Contains(t, out, "Workspace is already stopped.")
+
+	out, err = run(t, "workspaces", "bulk", "autoupdate", "--search", "template:"+template.Name, "always", "--yes", "--detach")
+	require.NoError(t, err)
+	require.Contains(t, out, "coder workspaces bulk status")
+
+	require.Eventually(t, func() bool {
+		out, err := run(t, "workspaces", "bulk", "status", "--output", "json")
+		if err != nil {
+			return false
+		}
+		var status struct {
+			Operation codersdk.WorkspaceBulkOperation `json:"operation"`
+		}
+		require.NoError(t, json.Unmarshal([]byte(out), &status))
+		require.Equal(t, codersdk.WorkspaceBulkOperationActionAutomaticUpdates, status.Operation.Action)
+		return status.Operation.Status == codersdk.WorkspaceBulkOperationStatusCompleted
+	}, testutil.WaitLong, testutil.IntervalMedium)
+
+	workspaces, err := client.Workspaces(testutil.Context(t, testutil.WaitShort), codersdk.WorkspaceFilter{FilterQuery: "template:" + template.Name})
+	require.NoError(t, err)
+	for _, workspace := range workspaces.Workspaces {
+		require.Equal(t, codersdk.AutomaticUpdatesAlways, workspace.AutomaticUpdates)
+	}
+
+	_, err = run(t, "workspaces", "bulk", "start", "--search", "template:does-not-exist", "--yes")
+	require.ErrorContains(t, err, "no workspaces match")
+}
@@ -44,6 +44,7 @@ func (a *ManifestAPI) GetManifest(ctx context.Context, _ *agentproto.GetManifest
 		metadata      []database.WorkspaceAgentMetadatum
 		workspace     database.Workspace
 		devcontainers []database.WorkspaceAgentDevcontainer
+		logTails      []database.WorkspaceAgentLogTail
 	)
 
 	workspaceAgent, err := a.AgentFn(ctx)
@@ -64,6 +65,11 @@ func (a *ManifestAPI) GetManifest(ctx context.Context, _ *agentproto.GetManifest
 		scripts, err = a.Database.GetWorkspaceAgentScriptsByAgentIDs(dbauthz.AsSystemRestricted(ctx), []uuid.UUID{workspaceAgent.ID})
 		return err
 	})
+	eg.Go(func() (err error) {
+		// nolint:gocritic // This is necessary to fetch agent log tails!
+		logTails, err = a.Database.GetWorkspaceAgentLogTailsByAgentIDs(dbauthz.AsSystemRestricted(ctx), []uuid.UUID{workspaceAgent.ID})
+		return err
+	})
 	eg.Go(func() (err error) {
 		metadata, err = a.Database.GetWorkspaceAgentMetadata(ctx, database.GetWorkspaceAgentMetadataParams{
 			WorkspaceAgentID: workspaceAgent.ID,
@@ -150,6 +156,7 @@ func (a *ManifestAPI) GetManifest(ctx context.Context, _ *agentproto.GetManifest
 		Metadata:      dbAgentMetadataToProtoDescription(metadata),
 		Devcontainers: dbAgentDevcontainersToProto(devcontainers),
 		Secrets:       dbUserSecretsToProto(userSecrets),
+		LogTails:      dbAgentLogTailsToProto(logTails),
 	}, nil
 }
 
@@ -250,6 +257,8 @@ func dbAppToProto(dbApp database.WorkspaceApp, agent database.WorkspaceAgent, ow
 			Url:       dbApp.HealthcheckUrl,
 			Interval:  durationpb.New(time.Duration(dbApp.HealthcheckInterval) * time.Second),
 			Threshold: dbApp.HealthcheckThreshold,
+			Address:   dbApp.HealthcheckAddress,
+			Command:   dbApp.HealthcheckCommand,
 		},
 		Health: agentproto.WorkspaceApp_Health(healthRaw),
 		Hidden: dbApp.Hidden,
@@ -275,6 +284,18 @@ func dbAgentDevcontainersToProto(devcontainers []database.WorkspaceAgentDevconta
 	return ret
 }
 
+func dbAgentLogTailsToProto(logTails []database.WorkspaceAgentLogTail) []*agentproto.WorkspaceAgentLogTail {
+	ret := make([]*agentproto.WorkspaceAgentLogTail, len(logTails))
+	for i, lt := range logTails {
+		ret[i] = &agentproto.WorkspaceAgentLogTail{
+			LogSourceId:   lt.LogSourceID[:],
+			Paths:         lt.Paths,
+			JournaldUnits: lt.JournaldUnits,
+		}
+	}
+	return ret
+}
+
 func dbUserSecretsToProto(secrets []database.UserSecret) []*agentproto.WorkspaceSecret {
 	ret := make([]*agentproto.WorkspaceSecret, 0, len(secrets))
 	for _, s := range secrets {
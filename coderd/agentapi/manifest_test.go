@@ -231,6 +231,32 @@ func TestGetManifest(t *testing.T) {
 				Timeout:     durationpb.New(time.Duration(metadata[1].Timeout)),
 			},
 		}
+		logTails = []database.WorkspaceAgentLogTail{
+			{
+				WorkspaceAgentID: agent.ID,
+				LogSourceID:      uuid.New(),
+				Paths:            []string{"/var/log/nginx/*.log"},
+				JournaldUnits:    []string{},
+			},
+			{
+				WorkspaceAgentID: agent.ID,
+				LogSourceID:      uuid.New(),
+				Paths:            []string{},
+				JournaldUnits:    []string{"docker.service"},
+			},
+		}
+		protoLogTails = []*agentproto.WorkspaceAgentLogTail{
+			{
+				LogSourceId:   logTails[0].LogSourceID[:],
+				Paths:         logTails[0].Paths,
+				JournaldUnits: logTails[0].JournaldUnits,
+			},
+			{
+				LogSourceId:   logTails[1].LogSourceID[:],
+				Paths:         logTails[1].Paths,
+				JournaldUnits: logTails[1].JournaldUnits,
+			},
+		}
 		protoDevcontainers = []*agentproto.WorkspaceAgentDevcontainer{
 			{
 				Id:              devcontainers[0].ID[:],
@@ -330,6 +356,7 @@ func TestGetManifest(t *testing.T) {
 
 		mDB.EXPECT().GetWorkspaceAppsByAgentID(gomock.Any(), agent.ID).Return(apps, nil)
 		mDB.EXPECT().GetWorkspaceAgentScriptsByAgentIDs(gomock.Any(), []uuid.UUID{agent.ID}).Return(scripts, nil)
+		mDB.EXPECT().GetWorkspaceAgentLogTailsByAgentIDs(gomock.Any(), []uuid.UUID{agent.ID}).Return(logTails, nil)
 		mDB.EXPECT().GetWorkspaceAgentMetadata(gomock.Any(), database.GetWorkspaceAgentMetadataParams{
 			WorkspaceAgentID: agent.ID,
 			Keys:             nil, // all
@@ -364,6 +391,7 @@ func TestGetManifest(t *testing.T) {
 			Metadata:      protoMetadata,
 			Devcontainers: protoDevcontainers,
 			Secrets:       []*agentproto.WorkspaceSecret{},
+			LogTails:      protoLogTails,
 		}
 
 		// Log got and expected with spew.
@@ -397,6 +425,7 @@ func TestGetManifest(t *testing.T) {
 
 		mDB.EXPECT().GetWorkspaceAppsByAgentID(gomock.Any(), childAgent.ID).Return([]database.WorkspaceApp{}, nil)
 		mDB.EXPECT().GetWorkspaceAgentScriptsByAgentIDs(gomock.Any(), []uuid.UUID{childAgent.ID}).Return([]database.GetWorkspaceAgentScriptsByAgentIDsRow{}, nil)
+		mDB.EXPECT().GetWorkspaceAgentLogTailsByAgentIDs(gomock.Any(), []uuid.UUID{childAgent.ID}).Return([]database.WorkspaceAgentLogTail{}, nil)
 		mDB.EXPECT().GetWorkspaceAgentMetadata(gomock.Any(), database.GetWorkspaceAgentMetadataParams{
 			WorkspaceAgentID: childAgent.ID,
 			Keys:             nil, // all
@@ -431,6 +460,7 @@ func TestGetManifest(t *testing.T) {
 			Metadata:      []*agentproto.WorkspaceAgentMetadata_Description{},
 			Devcontainers: []*agentproto.WorkspaceAgentDevcontainer{},
 			Secrets:       []*agentproto.WorkspaceSecret{},
+			LogTails:      []*agentproto.WorkspaceAgentLogTail{},
 		}
 
 		require.Equal(t, expected, got)
@@ -460,6 +490,7 @@ func TestGetManifest(t *testing.T) {
 
 		mDB.EXPECT().GetWorkspaceAppsByAgentID(gomock.Any(), childAgent.ID).Return([]database.WorkspaceApp{}, nil)
 		mDB.EXPECT().GetWorkspaceAgentScriptsByAgentIDs(gomock.Any(), []uuid.UUID{childAgent.ID}).Return([]database.GetWorkspaceAgentScriptsByAgentIDsRow{}, nil)
+		mDB.EXPECT().GetWorkspaceAgentLogTailsByAgentIDs(gomock.Any(), []uuid.UUID{childAgent.ID}).Return([]database.WorkspaceAgentLogTail{}, nil)
 		mDB.EXPECT().GetWorkspaceAgentMetadata(gomock.Any(), database.GetWorkspaceAgentMetadataParams{
 			WorkspaceAgentID: childAgent.ID,
 			Keys:             nil,
@@ -580,6 +611,7 @@ func TestGetManifest(t *testing.T) {
 
 		mDB.EXPECT().GetWorkspaceAppsByAgentID(gomock.Any(), agent.ID).Return(apps, nil)
 		mDB.EXPECT().GetWorkspaceAgentScriptsByAgentIDs(gomock.Any(), []uuid.UUID{agent.ID}).Return(scripts, nil)
+		mDB.EXPECT().GetWorkspaceAgentLogTailsByAgentIDs(gomock.Any(), []uuid.UUID{agent.ID}).Return(logTails, nil)
 		mDB.EXPECT().GetWorkspaceAgentMetadata(gomock.Any(), database.GetWorkspaceAgentMetadataParams{
 			WorkspaceAgentID: agent.ID,
 			Keys:             nil, // all
@@ -613,6 +645,7 @@ func TestGetManifest(t *testing.T) {
 			Metadata:      protoMetadata,
 			Devcontainers: protoDevcontainers,
 			Secrets:       []*agentproto.WorkspaceSecret{},
+			LogTails:      protoLogTails,
 		}
 
 		// Log got and expected with spew.
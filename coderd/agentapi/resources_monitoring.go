@@ -36,6 +36,9 @@ type ResourcesMonitoringAPI struct {
 	// Cache resource monitors on first call to avoid millions of DB queries per day.
 	memoryMonitor  database.WorkspaceAgentMemoryResourceMonitor
 	volumeMonitors []database.WorkspaceAgentVolumeResourceMonitor
+	cpuMonitor     database.WorkspaceAgentResourceMonitor
+	processMonitor database.WorkspaceAgentResourceMonitor
+	inodeMonitors  []database.WorkspaceAgentResourceMonitor
 	monitorsLock   sync.RWMutex
 }
 
@@ -61,6 +64,23 @@ func (a *ResourcesMonitoringAPI) InitMonitors(ctx context.Context) error {
 	// 0 length is valid, indicating none configured, since the volume monitors in the DB can be many.
 	a.volumeMonitors = volMons
 
+	monitors, err := a.Database.FetchResourceMonitorsByAgentID(ctx, a.AgentID)
+	if err != nil {
+		return xerrors.Errorf("fetch resource monitors: %w", err)
+	}
+	// As with memory, the CPU and process monitors stay as zero values
+	// (CreatedAt.IsZero() = true) when none are configured.
+	for _, monitor := range monitors {
+		switch monitor.Type {
+		case database.WorkspaceAgentResourceMonitorTypeCpu:
+			a.cpuMonitor = monitor
+		case database.WorkspaceAgentResourceMonitorTypeProcesses:
+			a.processMonitor = monitor
+		case database.WorkspaceAgentResourceMonitorTypeInodes:
+			a.inodeMonitors = append(a.inodeMonitors, monitor)
+		}
+	}
+
 	return nil
 }
 
@@ -88,6 +108,32 @@ func (a *ResourcesMonitoringAPI) GetResourcesMonitoringConfiguration(_ context.C
 			}
 			return volumes
 		}(),
+		Cpu: func() *proto.GetResourcesMonitoringConfigurationResponse_CPU {
+			if a.cpuMonitor.CreatedAt.IsZero() {
+				return nil
+			}
+			return &proto.GetResourcesMonitoringConfigurationResponse_CPU{
+				Enabled: a.cpuMonitor.Enabled,
+			}
+		}(),
+		Processes: func() *proto.GetResourcesMonitoringConfigurationResponse_Processes {
+			if a.processMonitor.CreatedAt.IsZero() {
+				return nil
+			}
+			return &proto.GetResourcesMonitoringConfigurationResponse_Processes{
+				Enabled: a.processMonitor.Enabled,
+			}
+		}(),
+		Inodes: func() []*proto.GetResourcesMonitoringConfigurationResponse_Volume {
+			inodes := make([]*proto.GetResourcesMonitoringConfigurationResponse_Volume, 0, len(a.inodeMonitors))
+			for _, monitor := range a.inodeMonitors {
+				inodes = append(inodes, &proto.GetResourcesMonitoringConfigurationResponse_Volume{
+					Enabled: monitor.Enabled,
+					Path:    monitor.Path,
+				})
+			}
+			return inodes
+		}(),
 	}, nil
 }
 
@@ -106,6 +152,18 @@ func (a *ResourcesMonitoringAPI) PushResourcesMonitoringUsage(ctx context.Contex
 		err = errors.Join(err, xerrors.Errorf("monitor volume: %w", volumeErr))
 	}
 
+	if cpuErr := a.monitorCPU(ctx, req.Datapoints); cpuErr != nil {
+		err = errors.Join(err, xerrors.Errorf("monitor cpu: %w", cpuErr))
+	}
+
+	if processErr := a.monitorProcesses(ctx, req.Datapoints); processErr != nil {
+		err = errors.Join(err, xerrors.Errorf("monitor processes: %w", processErr))
+	}
+
+	if inodeErr := a.monitorInodes(ctx, req.Datapoints); inodeErr != nil {
+		err = errors.Join(err, xerrors.Errorf("monitor inodes: %w", inodeErr))
+	}
+
 	return &proto.PushResourcesMonitoringUsageResponse{}, err
 }
 
@@ -275,3 +333,188 @@ func (a *ResourcesMonitoringAPI) monitorVolumes(ctx context.Context, datapoints
 
 	return nil
 }
+
+func (a *ResourcesMonitoringAPI) monitorCPU(ctx context.Context, datapoints []*proto.PushResourcesMonitoringUsageRequest_Datapoint) error {
+	if !a.cpuMonitor.Enabled {
+		return nil
+	}
+
+	usageDatapoints := make([]*proto.PushResourcesMonitoringUsageRequest_Datapoint_CPUUsage, 0, len(datapoints))
+	for _, datapoint := range datapoints {
+		usageDatapoints = append(usageDatapoints, datapoint.Cpu)
+	}
+
+	usageStates := resourcesmonitor.CalculateCPUUsageStates(a.cpuMonitor, usageDatapoints)
+
+	return a.monitorThreshold(ctx, &a.cpuMonitor, usageStates, notifications.TemplateWorkspaceHighCPU, "workspace-monitor-cpu")
+}
+
+func (a *ResourcesMonitoringAPI) monitorProcesses(ctx context.Context, datapoints []*proto.PushResourcesMonitoringUsageRequest_Datapoint) error {
+	if !a.processMonitor.Enabled {
+		return nil
+	}
+
+	usageDatapoints := make([]*proto.PushResourcesMonitoringUsageRequest_Datapoint_ProcessUsage, 0, len(datapoints))
+	for _, datapoint := range datapoints {
+		usageDatapoints = append(usageDatapoints, datapoint.Processes)
+	}
+
+	usageStates := resourcesmonitor.CalculateProcessUsageStates(a.processMonitor, usageDatapoints)
+
+	return a.monitorThreshold(ctx, &a.processMonitor, usageStates, notifications.TemplateWorkspaceProcessLimit, "workspace-monitor-processes")
+}
+
+// monitorThreshold moves a monitor that applies to the whole workspace,
+// such as the CPU or process monitor, to its next state and notifies the
+// workspace owner when it goes into an alert state.
+func (a *ResourcesMonitoringAPI) monitorThreshold(
+	ctx context.Context,
+	monitor *database.WorkspaceAgentResourceMonitor,
+	usageStates []resourcesmonitor.State,
+	templateID uuid.UUID,
+	createdBy string,
+) error {
+	oldState := monitor.State
+	newState := resourcesmonitor.NextState(a.Config, oldState, usageStates)
+
+	debouncedUntil, shouldNotify := monitor.Debounce(a.Debounce, a.Clock.Now(), oldState, newState)
+
+	if err := a.updateResourceMonitor(ctx, monitor, newState, debouncedUntil); err != nil {
+		return err
+	}
+
+	if !shouldNotify {
+		return nil
+	}
+
+	workspace, err := a.Database.GetWorkspaceByID(ctx, a.WorkspaceID)
+	if err != nil {
+		return xerrors.Errorf("get workspace by id: %w", err)
+	}
+
+	_, err = a.NotificationsEnqueuer.EnqueueWithData(
+		// nolint:gocritic // We need to be able to send the notification.
+		dbauthz.AsNotifier(ctx),
+		workspace.OwnerID,
+		templateID,
+		map[string]string{
+			"workspace": workspace.Name,
+			"threshold": fmt.Sprintf("%d%%", monitor.Threshold),
+		},
+		map[string]any{
+			// See monitorMemory for why a timestamp is injected.
+			"timestamp": a.Clock.Now(),
+		},
+		createdBy,
+		workspace.ID,
+		workspace.OwnerID,
+		workspace.OrganizationID,
+	)
+	if err != nil {
+		return xerrors.Errorf("notify workspace %s: %w", monitor.Type, err)
+	}
+
+	return nil
+}
+
+func (a *ResourcesMonitoringAPI) monitorInodes(ctx context.Context, datapoints []*proto.PushResourcesMonitoringUsageRequest_Datapoint) error {
+	outOfInodesVolumes := make([]map[string]any, 0)
+
+	for i := range a.inodeMonitors {
+		monitor := &a.inodeMonitors[i]
+		if !monitor.Enabled {
+			continue
+		}
+
+		usageDatapoints := make([]*proto.PushResourcesMonitoringUsageRequest_Datapoint_VolumeUsage, 0, len(datapoints))
+		for _, datapoint := range datapoints {
+			var usage *proto.PushResourcesMonitoringUsageRequest_Datapoint_VolumeUsage
+
+			for _, volume := range datapoint.Inodes {
+				if volume.Volume == monitor.Path {
+					usage = volume
+					break
+				}
+			}
+
+			usageDatapoints = append(usageDatapoints, usage)
+		}
+
+		usageStates := resourcesmonitor.CalculateInodeUsageStates(*monitor, usageDatapoints)
+
+		oldState := monitor.State
+		newState := resourcesmonitor.NextState(a.Config, oldState, usageStates)
+
+		debouncedUntil, shouldNotify := monitor.Debounce(a.Debounce, a.Clock.Now(), oldState, newState)
+
+		if shouldNotify {
+			outOfInodesVolumes = append(outOfInodesVolumes, map[string]any{
+				"path":      monitor.Path,
+				"threshold": fmt.Sprintf("%d%%", monitor.Threshold),
+			})
+		}
+
+		if err := a.updateResourceMonitor(ctx, monitor, newState, debouncedUntil); err != nil {
+			return err
+		}
+	}
+
+	if len(outOfInodesVolumes) == 0 {
+		return nil
+	}
+
+	workspace, err := a.Database.GetWorkspaceByID(ctx, a.WorkspaceID)
+	if err != nil {
+		return xerrors.Errorf("get workspace by id: %w", err)
+	}
+
+	if _, err := a.NotificationsEnqueuer.EnqueueWithData(
+		// nolint:gocritic // We need to be able to send the notification.
+		dbauthz.AsNotifier(ctx),
+		workspace.OwnerID,
+		notifications.TemplateWorkspaceOutOfInodes,
+		map[string]string{
+			"workspace": workspace.Name,
+		},
+		map[string]any{
+			"volumes": outOfInodesVolumes,
+			// See monitorMemory for why a timestamp is injected.
+			"timestamp": a.Clock.Now(),
+		},
+		"workspace-monitor-inodes",
+		workspace.ID,
+		workspace.OwnerID,
+		workspace.OrganizationID,
+	); err != nil {
+		return xerrors.Errorf("notify workspace out of inodes: %w", err)
+	}
+
+	return nil
+}
+
+// updateResourceMonitor stores the new state of a monitor, and updates
+// the cached monitor to match.
+func (a *ResourcesMonitoringAPI) updateResourceMonitor(
+	ctx context.Context,
+	monitor *database.WorkspaceAgentResourceMonitor,
+	newState database.WorkspaceAgentMonitorState,
+	debouncedUntil time.Time,
+) error {
+	//nolint:gocritic // We need to be able to update the resource monitor here.
+	err := a.Database.UpdateResourceMonitor(dbauthz.AsResourceMonitor(ctx), database.UpdateResourceMonitorParams{
+		AgentID:        a.AgentID,
+		Type:           monitor.Type,
+		Path:           monitor.Path,
+		State:          newState,
+		UpdatedAt:      dbtime.Time(a.Clock.Now()),
+		DebouncedUntil: dbtime.Time(debouncedUntil),
+	})
+	if err != nil {
+		return xerrors.Errorf("update workspace monitor: %w", err)
+	}
+
+	monitor.State = newState
+	monitor.DebouncedUntil = dbtime.Time(debouncedUntil)
+	monitor.UpdatedAt = dbtime.Time(a.Clock.Now())
+	return nil
+}
@@ -964,3 +964,282 @@ func requireVolumeData(t *testing.T, notif *notificationstest.FakeNotification)
 
 	return volumesData.([]map[string]any)
 }
+
+func TestResourcesMonitoringConfiguration(t *testing.T) {
+	t.Parallel()
+
+	api, _, _, _ := resourceMonitorAPI(t)
+
+	// Given: CPU, process and inode monitors
+	dbgen.WorkspaceAgentResourceMonitor(t, api.Database, database.WorkspaceAgentResourceMonitor{
+		AgentID: api.AgentID,
+		Type:    database.WorkspaceAgentResourceMonitorTypeCpu,
+	})
+	dbgen.WorkspaceAgentResourceMonitor(t, api.Database, database.WorkspaceAgentResourceMonitor{
+		AgentID: api.AgentID,
+		Type:    database.WorkspaceAgentResourceMonitorTypeProcesses,
+	})
+	dbgen.WorkspaceAgentResourceMonitor(t, api.Database, database.WorkspaceAgentResourceMonitor{
+		AgentID: api.AgentID,
+		Type:    database.WorkspaceAgentResourceMonitorTypeInodes,
+		Path:    "/home/coder",
+	})
+	require.NoError(t, api.InitMonitors(context.Background()))
+
+	// When: The agent fetches its configuration
+	config, err := api.GetResourcesMonitoringConfiguration(context.Background(), &agentproto.GetResourcesMonitoringConfigurationRequest{})
+	require.NoError(t, err)
+
+	// Then: The monitors are included, and memory is not
+	require.Nil(t, config.Memory)
+	require.Empty(t, config.Volumes)
+	require.NotNil(t, config.Cpu)
+	require.True(t, config.Cpu.Enabled)
+	require.NotNil(t, config.Processes)
+	require.True(t, config.Processes.Enabled)
+	require.Len(t, config.Inodes, 1)
+	require.Equal(t, "/home/coder", config.Inodes[0].Path)
+	require.True(t, config.Inodes[0].Enabled)
+}
+
+func TestCPUResourceMonitor(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name          string
+		cpuUsage      []float64
+		cpuTotal      float64
+		previousState database.WorkspaceAgentMonitorState
+		expectState   database.WorkspaceAgentMonitorState
+		shouldNotify  bool
+	}{
+		{
+			name:          "WhenOK/NeverExceedsThreshold",
+			cpuUsage:      []float64{0.5, 1, 1.5, 0.2, 1, 0.5, 1, 1.5, 0.2, 1},
+			cpuTotal:      4,
+			previousState: database.WorkspaceAgentMonitorStateOK,
+			expectState:   database.WorkspaceAgentMonitorStateOK,
+			shouldNotify:  false,
+		},
+		{
+			name:          "WhenOK/BriefSpike",
+			cpuUsage:      []float64{4, 1, 1.5, 0.2, 1, 0.5, 1, 1.5, 0.2, 1},
+			cpuTotal:      4,
+			previousState: database.WorkspaceAgentMonitorStateOK,
+			expectState:   database.WorkspaceAgentMonitorStateOK,
+			shouldNotify:  false,
+		},
+		{
+			name:          "WhenOK/SustainedSaturation",
+			cpuUsage:      []float64{0.5, 1, 1.5, 0.2, 1, 3.9, 4, 3.8, 4, 3.9},
+			cpuTotal:      4,
+			previousState: database.WorkspaceAgentMonitorStateOK,
+			expectState:   database.WorkspaceAgentMonitorStateNOK,
+			shouldNotify:  true,
+		},
+		{
+			name:          "WhenNOK/SustainedSaturation",
+			cpuUsage:      []float64{0.5, 1, 1.5, 0.2, 1, 3.9, 4, 3.8, 4, 3.9},
+			cpuTotal:      4,
+			previousState: database.WorkspaceAgentMonitorStateNOK,
+			expectState:   database.WorkspaceAgentMonitorStateNOK,
+			shouldNotify:  false,
+		},
+		{
+			name:          "WhenNOK/NeverExceedsThreshold",
+			cpuUsage:      []float64{0.5, 1, 1.5, 0.2, 1, 0.5, 1, 1.5, 0.2, 1},
+			cpuTotal:      4,
+			previousState: database.WorkspaceAgentMonitorStateNOK,
+			expectState:   database.WorkspaceAgentMonitorStateOK,
+			shouldNotify:  false,
+		},
+		{
+			name:          "WhenOK/UnknownTotal",
+			cpuUsage:      []float64{4, 4, 4, 4, 4, 4, 4, 4, 4, 4},
+			cpuTotal:      0,
+			previousState: database.WorkspaceAgentMonitorStateOK,
+			expectState:   database.WorkspaceAgentMonitorStateOK,
+			shouldNotify:  false,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			api, user, clock, notifyEnq := resourceMonitorAPI(t)
+
+			datapoints := make([]*agentproto.PushResourcesMonitoringUsageRequest_Datapoint, 0, len(tt.cpuUsage))
+			collectedAt := clock.Now()
+			for _, usage := range tt.cpuUsage {
+				collectedAt = collectedAt.Add(15 * time.Second)
+				datapoints = append(datapoints, &agentproto.PushResourcesMonitoringUsageRequest_Datapoint{
+					CollectedAt: timestamppb.New(collectedAt),
+					Cpu: &agentproto.PushResourcesMonitoringUsageRequest_Datapoint_CPUUsage{
+						Used:  usage,
+						Total: tt.cpuTotal,
+					},
+				})
+			}
+
+			dbgen.WorkspaceAgentResourceMonitor(t, api.Database, database.WorkspaceAgentResourceMonitor{
+				AgentID:   api.AgentID,
+				Type:      database.WorkspaceAgentResourceMonitorTypeCpu,
+				State:     tt.previousState,
+				Threshold: 90,
+			})
+
+			// Initialize API to fetch and cache the monitors
+			require.NoError(t, api.InitMonitors(context.Background()))
+
+			clock.Set(collectedAt)
+			_, err := api.PushResourcesMonitoringUsage(context.Background(), &agentproto.PushResourcesMonitoringUsageRequest{
+				Datapoints: datapoints,
+			})
+			require.NoError(t, err)
+
+			sent := notifyEnq.Sent(notificationstest.WithTemplateID(notifications.TemplateWorkspaceHighCPU))
+			if tt.shouldNotify {
+				require.Len(t, sent, 1)
+				require.Equal(t, user.ID, sent[0].UserID)
+				require.Equal(t, "90%", sent[0].Labels["threshold"])
+			} else {
+				require.Len(t, sent, 0)
+			}
+
+			monitors, err := api.Database.FetchResourceMonitorsByAgentID(context.Background(), api.AgentID)
+			require.NoError(t, err)
+			require.Len(t, monitors, 1)
+			require.Equal(t, tt.expectState, monitors[0].State)
+		})
+	}
+}
+
+func TestProcessResourceMonitorDebounce(t *testing.T) {
+	t.Parallel()
+
+	api, user, clock, notifyEnq := resourceMonitorAPI(t)
+	api.Config.Alert.ConsecutiveNOKsPercent = 100
+
+	// Given: A process monitor in an OK state
+	dbgen.WorkspaceAgentResourceMonitor(t, api.Database, database.WorkspaceAgentResourceMonitor{
+		AgentID:   api.AgentID,
+		Type:      database.WorkspaceAgentResourceMonitorTypeProcesses,
+		State:     database.WorkspaceAgentMonitorStateOK,
+		Threshold: 80,
+	})
+	require.NoError(t, api.InitMonitors(context.Background()))
+
+	push := func(used int64) {
+		t.Helper()
+		_, err := api.PushResourcesMonitoringUsage(context.Background(), &agentproto.PushResourcesMonitoringUsageRequest{
+			Datapoints: []*agentproto.PushResourcesMonitoringUsageRequest_Datapoint{
+				{
+					CollectedAt: timestamppb.New(clock.Now()),
+					Processes: &agentproto.PushResourcesMonitoringUsageRequest_Datapoint_ProcessUsage{
+						Used:  used,
+						Total: 1000,
+					},
+				},
+			},
+		})
+		require.NoError(t, err)
+	}
+
+	// When: The process count goes over the threshold
+	push(900)
+
+	// Then: We expect a notification
+	sent := notifyEnq.Sent(notificationstest.WithTemplateID(notifications.TemplateWorkspaceProcessLimit))
+	require.Len(t, sent, 1)
+	require.Equal(t, user.ID, sent[0].UserID)
+	require.Equal(t, "80%", sent[0].Labels["threshold"])
+	notifyEnq.Clear()
+
+	// When: The process count drops and rises again within the debounce period
+	clock.Advance(api.Debounce / 4)
+	push(100)
+	clock.Advance(api.Debounce / 4)
+	push(900)
+
+	// Then: We expect no new notification
+	sent = notifyEnq.Sent(notificationstest.WithTemplateID(notifications.TemplateWorkspaceProcessLimit))
+	require.Len(t, sent, 0)
+
+	// When: The process count drops and rises again after the debounce period
+	clock.Advance(api.Debounce / 4)
+	push(100)
+	clock.Advance(api.Debounce/4 + time.Second)
+	push(900)
+
+	// Then: We expect a notification
+	sent = notifyEnq.Sent(notificationstest.WithTemplateID(notifications.TemplateWorkspaceProcessLimit))
+	require.Len(t, sent, 1)
+}
+
+func TestInodeResourceMonitorMultiple(t *testing.T) {
+	t.Parallel()
+
+	api, _, clock, notifyEnq := resourceMonitorAPI(t)
+	api.Config.Alert.ConsecutiveNOKsPercent = 100
+
+	// Given: two inode monitors and a volume monitor on the same path
+	dbgen.WorkspaceAgentResourceMonitor(t, api.Database, database.WorkspaceAgentResourceMonitor{
+		AgentID:   api.AgentID,
+		Type:      database.WorkspaceAgentResourceMonitorTypeInodes,
+		Path:      "/home/coder",
+		Threshold: 80,
+	})
+	dbgen.WorkspaceAgentResourceMonitor(t, api.Database, database.WorkspaceAgentResourceMonitor{
+		AgentID:   api.AgentID,
+		Type:      database.WorkspaceAgentResourceMonitorTypeInodes,
+		Path:      "/dev/coder",
+		Threshold: 80,
+	})
+	dbgen.WorkspaceAgentVolumeResourceMonitor(t, api.Database, database.WorkspaceAgentVolumeResourceMonitor{
+		AgentID:   api.AgentID,
+		Path:      "/home/coder",
+		Threshold: 80,
+	})
+	require.NoError(t, api.InitMonitors(context.Background()))
+
+	// When: both volumes run out of inodes, but not out of space
+	_, err := api.PushResourcesMonitoringUsage(context.Background(), &agentproto.PushResourcesMonitoringUsageRequest{
+		Datapoints: []*agentproto.PushResourcesMonitoringUsageRequest_Datapoint{
+			{
+				CollectedAt: timestamppb.New(clock.Now()),
+				Volumes: []*agentproto.PushResourcesMonitoringUsageRequest_Datapoint_VolumeUsage{
+					{
+						Volume: "/home/coder",
+						Used:   1,
+						Total:  10,
+					},
+				},
+				Inodes: []*agentproto.PushResourcesMonitoringUsageRequest_Datapoint_VolumeUsage{
+					{
+						Volume: "/home/coder",
+						Used:   10,
+						Total:  10,
+					},
+					{
+						Volume: "/dev/coder",
+						Used:   9,
+						Total:  10,
+					},
+				},
+			},
+		},
+	})
+	require.NoError(t, err)
+
+	// Then: We expect a single inode notification about both volumes
+	sent := notifyEnq.Sent(notificationstest.WithTemplateID(notifications.TemplateWorkspaceOutOfDisk))
+	require.Len(t, sent, 0)
+	sent = notifyEnq.Sent(notificationstest.WithTemplateID(notifications.TemplateWorkspaceOutOfInodes))
+	require.Len(t, sent, 1)
+
+	volumes := requireVolumeData(t, sent[0])
+	require.Len(t, volumes, 2)
+	require.Equal(t, "/home/coder", volumes[0]["path"])
+	require.Equal(t, "/dev/coder", volumes[1]["path"])
+}
@@ -88,6 +88,89 @@ func CalculateVolumeUsageStates(
 	return states
 }
 
+// CalculateCPUUsageStates returns the state of each CPU usage datapoint.
+// Datapoints without a known number of cores are in an unknown state.
+func CalculateCPUUsageStates(
+	monitor database.WorkspaceAgentResourceMonitor,
+	datapoints []*proto.PushResourcesMonitoringUsageRequest_Datapoint_CPUUsage,
+) []State {
+	states := make([]State, 0, len(datapoints))
+
+	for _, datapoint := range datapoints {
+		state := StateUnknown
+
+		if datapoint != nil && datapoint.Total > 0 {
+			percent := int32(datapoint.Used / datapoint.Total * 100)
+
+			if percent < monitor.Threshold {
+				state = StateOK
+			} else {
+				state = StateNOK
+			}
+		}
+
+		states = append(states, state)
+	}
+
+	return states
+}
+
+// CalculateProcessUsageStates returns the state of each process count
+// datapoint. Datapoints without a known process limit are in an unknown
+// state.
+func CalculateProcessUsageStates(
+	monitor database.WorkspaceAgentResourceMonitor,
+	datapoints []*proto.PushResourcesMonitoringUsageRequest_Datapoint_ProcessUsage,
+) []State {
+	states := make([]State, 0, len(datapoints))
+
+	for _, datapoint := range datapoints {
+		state := StateUnknown
+
+		if datapoint != nil && datapoint.Total > 0 {
+			percent := int32(float64(datapoint.Used) / float64(datapoint.Total) * 100)
+
+			if percent < monitor.Threshold {
+				state = StateOK
+			} else {
+				state = StateNOK
+			}
+		}
+
+		states = append(states, state)
+	}
+
+	return states
+}
+
+// CalculateInodeUsageStates returns the state of each inode usage
+// datapoint of a volume. Filesystems without a fixed number of inodes
+// report a total of zero and are in an unknown state.
+func CalculateInodeUsageStates(
+	monitor database.WorkspaceAgentResourceMonitor,
+	datapoints []*proto.PushResourcesMonitoringUsageRequest_Datapoint_VolumeUsage,
+) []State {
+	states := make([]State, 0, len(datapoints))
+
+	for _, datapoint := range datapoints {
+		state := StateUnknown
+
+		if datapoint != nil && datapoint.Total > 0 {
+			percent := int32(float64(datapoint.Used) / float64(datapoint.Total) * 100)
+
+			if percent < monitor.Threshold {
+				state = StateOK
+			} else {
+				state = StateNOK
+			}
+		}
+
+		states = append(states, state)
+	}
+
+	return states
+}
+
 func NextState(c Config, oldState database.WorkspaceAgentMonitorState, states []State) database.WorkspaceAgentMonitorState {
 	// If there are enough consecutive NOK states, we should be in an
 	// alert state.
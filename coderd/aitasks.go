@@ -574,6 +574,8 @@ func (api *API) taskGet(rw http.ResponseWriter, r *http.Request) {
 		data.templates[0],
 		api.AllowWorkspaceRenames,
 		appStatus,
+		data.driftCheck(workspace.ID),
+		data.nextSkippedAutostart(workspace.ID),
 	)
 	if err != nil {
 		httpapi.Write(ctx, rw, http.StatusInternalServerError, codersdk.Response{
@@ -6810,6 +6810,89 @@ const docTemplate = `{
                 ]
             }
         },
+        "/api/v2/organizations/{organization}/provisionerjobs/priorities": {
+            "get": {
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Organizations"
+                ],
+                "summary": "Get provisioner job priorities",
+                "operationId": "get-provisioner-job-priorities",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "format": "uuid",
+                        "description": "Organization ID",
+                        "name": "organization",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "type": "array",
+                            "items": {
+                                "$ref": "#/definitions/codersdk.ProvisionerJobPriority"
+                            }
+                        }
+                    }
+                },
+                "security": [
+                    {
+                        "CoderSessionToken": []
+                    }
+                ]
+            },
+            "put": {
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Organizations"
+                ],
+                "summary": "Update provisioner job priority",
+                "operationId": "update-provisioner-job-priority",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "format": "uuid",
+                        "description": "Organization ID",
+                        "name": "organization",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Update priority request",
+                        "name": "request",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/codersdk.UpdateProvisionerJobPriorityRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/codersdk.ProvisionerJobPriority"
+                        }
+                    }
+                },
+                "security": [
+                    {
+                        "CoderSessionToken": []
+                    }
+                ]
+            }
+        },
         "/api/v2/organizations/{organization}/provisionerjobs/{job}": {
             "get": {
                 "produces": [
@@ -6994,16 +7077,16 @@ const docTemplate = `{
                 ]
             }
         },
-        "/api/v2/organizations/{organization}/settings/idpsync/available-fields": {
+        "/api/v2/organizations/{organization}/schedule-exception-calendars": {
             "get": {
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "Enterprise"
+                    "Templates"
                 ],
-                "summary": "Get the available organization idp sync claim fields",
-                "operationId": "get-the-available-organization-idp-sync-claim-fields",
+                "summary": "Get schedule exception calendars by organization",
+                "operationId": "get-schedule-exception-calendars-by-organization",
                 "parameters": [
                     {
                         "type": "string",
@@ -7020,7 +7103,7 @@ const docTemplate = `{
                         "schema": {
                             "type": "array",
                             "items": {
-                                "type": "string"
+                                "$ref": "#/definitions/codersdk.ScheduleExceptionCalendar"
                             }
                         }
                     }
@@ -7030,18 +7113,19 @@ const docTemplate = `{
                         "CoderSessionToken": []
                     }
                 ]
-            }
-        },
-        "/api/v2/organizations/{organization}/settings/idpsync/field-values": {
-            "get": {
+            },
+            "post": {
+                "consumes": [
+                    "application/json"
+                ],
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "Enterprise"
+                    "Templates"
                 ],
-                "summary": "Get the organization idp sync claim field values",
-                "operationId": "get-the-organization-idp-sync-claim-field-values",
+                "summary": "Create schedule exception calendar",
+                "operationId": "create-schedule-exception-calendar",
                 "parameters": [
                     {
                         "type": "string",
@@ -7052,22 +7136,20 @@ const docTemplate = `{
                         "required": true
                     },
                     {
-                        "type": "string",
-                        "format": "string",
-                        "description": "Claim Field",
-                        "name": "claimField",
-                        "in": "query",
-                        "required": true
+                        "description": "Create calendar request",
+                        "name": "request",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/codersdk.CreateScheduleExceptionCalendarRequest"
+                        }
                     }
                 ],
                 "responses": {
-                    "200": {
-                        "description": "OK",
+                    "201": {
+                        "description": "Created",
                         "schema": {
-                            "type": "array",
-                            "items": {
-                                "type": "string"
-                            }
+                            "$ref": "#/definitions/codersdk.ScheduleExceptionCalendar"
                         }
                     }
                 },
@@ -7078,16 +7160,16 @@ const docTemplate = `{
                 ]
             }
         },
-        "/api/v2/organizations/{organization}/settings/idpsync/groups": {
+        "/api/v2/organizations/{organization}/schedule-exception-calendars/{calendar}": {
             "get": {
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "Enterprise"
+                    "Templates"
                 ],
-                "summary": "Get group IdP Sync settings by organization",
-                "operationId": "get-group-idp-sync-settings-by-organization",
+                "summary": "Get schedule exception calendar",
+                "operationId": "get-schedule-exception-calendar",
                 "parameters": [
                     {
                         "type": "string",
@@ -7096,13 +7178,21 @@ const docTemplate = `{
                         "name": "organization",
                         "in": "path",
                         "required": true
+                    },
+                    {
+                        "type": "string",
+                        "format": "uuid",
+                        "description": "Calendar ID",
+                        "name": "calendar",
+                        "in": "path",
+                        "required": true
                     }
                 ],
                 "responses": {
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/codersdk.GroupSyncSettings"
+                            "$ref": "#/definitions/codersdk.ScheduleExceptionCalendar"
                         }
                     }
                 },
@@ -7112,7 +7202,7 @@ const docTemplate = `{
                     }
                 ]
             },
-            "patch": {
+            "put": {
                 "consumes": [
                     "application/json"
                 ],
@@ -7120,10 +7210,10 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "Enterprise"
+                    "Templates"
                 ],
-                "summary": "Update group IdP Sync settings by organization",
-                "operationId": "update-group-idp-sync-settings-by-organization",
+                "summary": "Update schedule exception calendar",
+                "operationId": "update-schedule-exception-calendar",
                 "parameters": [
                     {
                         "type": "string",
@@ -7134,12 +7224,20 @@ const docTemplate = `{
                         "required": true
                     },
                     {
-                        "description": "New settings",
+                        "type": "string",
+                        "format": "uuid",
+                        "description": "Calendar ID",
+                        "name": "calendar",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Update calendar request",
                         "name": "request",
                         "in": "body",
                         "required": true,
                         "schema": {
-                            "$ref": "#/definitions/codersdk.GroupSyncSettings"
+                            "$ref": "#/definitions/codersdk.UpdateScheduleExceptionCalendarRequest"
                         }
                     }
                 ],
@@ -7147,7 +7245,7 @@ const docTemplate = `{
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/codersdk.GroupSyncSettings"
+                            "$ref": "#/definitions/codersdk.ScheduleExceptionCalendar"
                         }
                     }
                 },
@@ -7156,45 +7254,71 @@ const docTemplate = `{
                         "CoderSessionToken": []
                     }
                 ]
+            },
+            "delete": {
+                "tags": [
+                    "Templates"
+                ],
+                "summary": "Delete schedule exception calendar",
+                "operationId": "delete-schedule-exception-calendar",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "format": "uuid",
+                        "description": "Organization ID",
+                        "name": "organization",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "format": "uuid",
+                        "description": "Calendar ID",
+                        "name": "calendar",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "204": {
+                        "description": "No Content"
+                    }
+                },
+                "security": [
+                    {
+                        "CoderSessionToken": []
+                    }
+                ]
             }
         },
-        "/api/v2/organizations/{organization}/settings/idpsync/groups/config": {
-            "patch": {
-                "consumes": [
-                    "application/json"
-                ],
+        "/api/v2/organizations/{organization}/settings/idpsync/available-fields": {
+            "get": {
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
                     "Enterprise"
                 ],
-                "summary": "Update group IdP Sync config",
-                "operationId": "update-group-idp-sync-config",
+                "summary": "Get the available organization idp sync claim fields",
+                "operationId": "get-the-available-organization-idp-sync-claim-fields",
                 "parameters": [
                     {
                         "type": "string",
                         "format": "uuid",
-                        "description": "Organization ID or name",
+                        "description": "Organization ID",
                         "name": "organization",
                         "in": "path",
                         "required": true
-                    },
-                    {
-                        "description": "New config values",
-                        "name": "request",
-                        "in": "body",
-                        "required": true,
-                        "schema": {
-                            "$ref": "#/definitions/codersdk.PatchGroupIDPSyncConfigRequest"
-                        }
                     }
                 ],
                 "responses": {
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/codersdk.GroupSyncSettings"
+                            "type": "array",
+                            "items": {
+                                "type": "string"
+                            }
                         }
                     }
                 },
@@ -7205,43 +7329,42 @@ const docTemplate = `{
                 ]
             }
         },
-        "/api/v2/organizations/{organization}/settings/idpsync/groups/mapping": {
-            "patch": {
-                "consumes": [
-                    "application/json"
-                ],
+        "/api/v2/organizations/{organization}/settings/idpsync/field-values": {
+            "get": {
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
                     "Enterprise"
                 ],
-                "summary": "Update group IdP Sync mapping",
-                "operationId": "update-group-idp-sync-mapping",
+                "summary": "Get the organization idp sync claim field values",
+                "operationId": "get-the-organization-idp-sync-claim-field-values",
                 "parameters": [
                     {
                         "type": "string",
                         "format": "uuid",
-                        "description": "Organization ID or name",
+                        "description": "Organization ID",
                         "name": "organization",
                         "in": "path",
                         "required": true
                     },
                     {
-                        "description": "Description of the mappings to add and remove",
-                        "name": "request",
-                        "in": "body",
-                        "required": true,
-                        "schema": {
-                            "$ref": "#/definitions/codersdk.PatchGroupIDPSyncMappingRequest"
-                        }
+                        "type": "string",
+                        "format": "string",
+                        "description": "Claim Field",
+                        "name": "claimField",
+                        "in": "query",
+                        "required": true
                     }
                 ],
                 "responses": {
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/codersdk.GroupSyncSettings"
+                            "type": "array",
+                            "items": {
+                                "type": "string"
+                            }
                         }
                     }
                 },
@@ -7252,7 +7375,7 @@ const docTemplate = `{
                 ]
             }
         },
-        "/api/v2/organizations/{organization}/settings/idpsync/roles": {
+        "/api/v2/organizations/{organization}/settings/idpsync/groups": {
             "get": {
                 "produces": [
                     "application/json"
@@ -7260,8 +7383,8 @@ const docTemplate = `{
                 "tags": [
                     "Enterprise"
                 ],
-                "summary": "Get role IdP Sync settings by organization",
-                "operationId": "get-role-idp-sync-settings-by-organization",
+                "summary": "Get group IdP Sync settings by organization",
+                "operationId": "get-group-idp-sync-settings-by-organization",
                 "parameters": [
                     {
                         "type": "string",
@@ -7276,7 +7399,7 @@ const docTemplate = `{
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/codersdk.RoleSyncSettings"
+                            "$ref": "#/definitions/codersdk.GroupSyncSettings"
                         }
                     }
                 },
@@ -7296,8 +7419,8 @@ const docTemplate = `{
                 "tags": [
                     "Enterprise"
                 ],
-                "summary": "Update role IdP Sync settings by organization",
-                "operationId": "update-role-idp-sync-settings-by-organization",
+                "summary": "Update group IdP Sync settings by organization",
+                "operationId": "update-group-idp-sync-settings-by-organization",
                 "parameters": [
                     {
                         "type": "string",
@@ -7313,7 +7436,7 @@ const docTemplate = `{
                         "in": "body",
                         "required": true,
                         "schema": {
-                            "$ref": "#/definitions/codersdk.RoleSyncSettings"
+                            "$ref": "#/definitions/codersdk.GroupSyncSettings"
                         }
                     }
                 ],
@@ -7321,7 +7444,7 @@ const docTemplate = `{
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/codersdk.RoleSyncSettings"
+                            "$ref": "#/definitions/codersdk.GroupSyncSettings"
                         }
                     }
                 },
@@ -7332,7 +7455,7 @@ const docTemplate = `{
                 ]
             }
         },
-        "/api/v2/organizations/{organization}/settings/idpsync/roles/config": {
+        "/api/v2/organizations/{organization}/settings/idpsync/groups/config": {
             "patch": {
                 "consumes": [
                     "application/json"
@@ -7343,8 +7466,8 @@ const docTemplate = `{
                 "tags": [
                     "Enterprise"
                 ],
-                "summary": "Update role IdP Sync config",
-                "operationId": "update-role-idp-sync-config",
+                "summary": "Update group IdP Sync config",
+                "operationId": "update-group-idp-sync-config",
                 "parameters": [
                     {
                         "type": "string",
@@ -7360,7 +7483,7 @@ const docTemplate = `{
                         "in": "body",
                         "required": true,
                         "schema": {
-                            "$ref": "#/definitions/codersdk.PatchRoleIDPSyncConfigRequest"
+                            "$ref": "#/definitions/codersdk.PatchGroupIDPSyncConfigRequest"
                         }
                     }
                 ],
@@ -7368,7 +7491,7 @@ const docTemplate = `{
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/codersdk.RoleSyncSettings"
+                            "$ref": "#/definitions/codersdk.GroupSyncSettings"
                         }
                     }
                 },
@@ -7379,7 +7502,181 @@ const docTemplate = `{
                 ]
             }
         },
-        "/api/v2/organizations/{organization}/settings/idpsync/roles/mapping": {
+        "/api/v2/organizations/{organization}/settings/idpsync/groups/mapping": {
+            "patch": {
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Enterprise"
+                ],
+                "summary": "Update group IdP Sync mapping",
+                "operationId": "update-group-idp-sync-mapping",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "format": "uuid",
+                        "description": "Organization ID or name",
+                        "name": "organization",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Description of the mappings to add and remove",
+                        "name": "request",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/codersdk.PatchGroupIDPSyncMappingRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/codersdk.GroupSyncSettings"
+                        }
+                    }
+                },
+                "security": [
+                    {
+                        "CoderSessionToken": []
+                    }
+                ]
+            }
+        },
+        "/api/v2/organizations/{organization}/settings/idpsync/roles": {
+            "get": {
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Enterprise"
+                ],
+                "summary": "Get role IdP Sync settings by organization",
+                "operationId": "get-role-idp-sync-settings-by-organization",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "format": "uuid",
+                        "description": "Organization ID",
+                        "name": "organization",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/codersdk.RoleSyncSettings"
+                        }
+                    }
+                },
+                "security": [
+                    {
+                        "CoderSessionToken": []
+                    }
+                ]
+            },
+            "patch": {
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Enterprise"
+                ],
+                "summary": "Update role IdP Sync settings by organization",
+                "operationId": "update-role-idp-sync-settings-by-organization",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "format": "uuid",
+                        "description": "Organization ID",
+                        "name": "organization",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "New settings",
+                        "name": "request",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/codersdk.RoleSyncSettings"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/codersdk.RoleSyncSettings"
+                        }
+                    }
+                },
+                "security": [
+                    {
+                        "CoderSessionToken": []
+                    }
+                ]
+            }
+        },
+        "/api/v2/organizations/{organization}/settings/idpsync/roles/config": {
+            "patch": {
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Enterprise"
+                ],
+                "summary": "Update role IdP Sync config",
+                "operationId": "update-role-idp-sync-config",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "format": "uuid",
+                        "description": "Organization ID or name",
+                        "name": "organization",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "New config values",
+                        "name": "request",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/codersdk.PatchRoleIDPSyncConfigRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/codersdk.RoleSyncSettings"
+                        }
+                    }
+                },
+                "security": [
+                    {
+                        "CoderSessionToken": []
+                    }
+                ]
+            }
+        },
+        "/api/v2/organizations/{organization}/settings/idpsync/roles/mapping": {
             "patch": {
                 "consumes": [
                     "application/json"
@@ -7917,6 +8214,46 @@ const docTemplate = `{
                 ]
             }
         },
+        "/api/v2/pty-shares/watch": {
+            "get": {
+                "tags": [
+                    "Agents"
+                ],
+                "summary": "Watch shared reconnecting PTY session",
+                "operationId": "watch-shared-reconnecting-pty-session",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Share token",
+                        "name": "token",
+                        "in": "query",
+                        "required": true
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Terminal width",
+                        "name": "width",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Terminal height",
+                        "name": "height",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "101": {
+                        "description": "Switching Protocols"
+                    }
+                },
+                "security": [
+                    {
+                        "CoderSessionToken": []
+                    }
+                ]
+            }
+        },
         "/api/v2/regions": {
             "get": {
                 "produces": [
@@ -8761,7 +9098,7 @@ const docTemplate = `{
                 ]
             }
         },
-        "/api/v2/templates/{template}/versions": {
+        "/api/v2/templates/{template}/rollouts": {
             "get": {
                 "produces": [
                     "application/json"
@@ -8769,8 +9106,8 @@ const docTemplate = `{
                 "tags": [
                     "Templates"
                 ],
-                "summary": "List template versions by template ID",
-                "operationId": "list-template-versions-by-template-id",
+                "summary": "Get template version rollouts",
+                "operationId": "get-template-version-rollouts",
                 "parameters": [
                     {
                         "type": "string",
@@ -8779,31 +9116,6 @@ const docTemplate = `{
                         "name": "template",
                         "in": "path",
                         "required": true
-                    },
-                    {
-                        "type": "string",
-                        "format": "uuid",
-                        "description": "After ID",
-                        "name": "after_id",
-                        "in": "query"
-                    },
-                    {
-                        "type": "boolean",
-                        "description": "Include archived versions in the list",
-                        "name": "include_archived",
-                        "in": "query"
-                    },
-                    {
-                        "type": "integer",
-                        "description": "Page limit",
-                        "name": "limit",
-                        "in": "query"
-                    },
-                    {
-                        "type": "integer",
-                        "description": "Page offset",
-                        "name": "offset",
-                        "in": "query"
                     }
                 ],
                 "responses": {
@@ -8812,7 +9124,7 @@ const docTemplate = `{
                         "schema": {
                             "type": "array",
                             "items": {
-                                "$ref": "#/definitions/codersdk.TemplateVersion"
+                                "$ref": "#/definitions/codersdk.TemplateVersionRollout"
                             }
                         }
                     }
@@ -8823,7 +9135,7 @@ const docTemplate = `{
                     }
                 ]
             },
-            "patch": {
+            "post": {
                 "consumes": [
                     "application/json"
                 ],
@@ -8833,18 +9145,9 @@ const docTemplate = `{
                 "tags": [
                     "Templates"
                 ],
-                "summary": "Update active template version by template ID",
-                "operationId": "update-active-template-version-by-template-id",
+                "summary": "Create template version rollout",
+                "operationId": "create-template-version-rollout",
                 "parameters": [
-                    {
-                        "description": "Modified template version",
-                        "name": "request",
-                        "in": "body",
-                        "required": true,
-                        "schema": {
-                            "$ref": "#/definitions/codersdk.UpdateActiveTemplateVersion"
-                        }
-                    },
                     {
                         "type": "string",
                         "format": "uuid",
@@ -8852,10 +9155,352 @@ const docTemplate = `{
                         "name": "template",
                         "in": "path",
                         "required": true
+                    },
+                    {
+                        "description": "Rollout request",
+                        "name": "request",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/codersdk.CreateTemplateVersionRolloutRequest"
+                        }
                     }
                 ],
                 "responses": {
-                    "200": {
+                    "201": {
+                        "description": "Created",
+                        "schema": {
+                            "$ref": "#/definitions/codersdk.TemplateVersionRollout"
+                        }
+                    }
+                },
+                "security": [
+                    {
+                        "CoderSessionToken": []
+                    }
+                ]
+            }
+        },
+        "/api/v2/templates/{template}/rollouts/{rollout}": {
+            "get": {
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Templates"
+                ],
+                "summary": "Get template version rollout",
+                "operationId": "get-template-version-rollout",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "format": "uuid",
+                        "description": "Template ID",
+                        "name": "template",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "format": "uuid",
+                        "description": "Rollout ID",
+                        "name": "rollout",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/codersdk.TemplateVersionRollout"
+                        }
+                    }
+                },
+                "security": [
+                    {
+                        "CoderSessionToken": []
+                    }
+                ]
+            },
+            "patch": {
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Templates"
+                ],
+                "summary": "Update template version rollout",
+                "operationId": "update-template-version-rollout",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "format": "uuid",
+                        "description": "Template ID",
+                        "name": "template",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "format": "uuid",
+                        "description": "Rollout ID",
+                        "name": "rollout",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Update request",
+                        "name": "request",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/codersdk.UpdateTemplateVersionRolloutRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/codersdk.TemplateVersionRollout"
+                        }
+                    }
+                },
+                "security": [
+                    {
+                        "CoderSessionToken": []
+                    }
+                ]
+            }
+        },
+        "/api/v2/templates/{template}/rollouts/{rollout}/rollback": {
+            "post": {
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Templates"
+                ],
+                "summary": "Roll back template version rollout",
+                "operationId": "roll-back-template-version-rollout",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "format": "uuid",
+                        "description": "Template ID",
+                        "name": "template",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "format": "uuid",
+                        "description": "Rollout ID",
+                        "name": "rollout",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/codersdk.TemplateVersionRollout"
+                        }
+                    }
+                },
+                "security": [
+                    {
+                        "CoderSessionToken": []
+                    }
+                ]
+            }
+        },
+        "/api/v2/templates/{template}/schedule-exception-calendars": {
+            "get": {
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Templates"
+                ],
+                "summary": "Get template schedule exception calendars",
+                "operationId": "get-template-schedule-exception-calendars",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "format": "uuid",
+                        "description": "Template ID",
+                        "name": "template",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "type": "array",
+                            "items": {
+                                "$ref": "#/definitions/codersdk.ScheduleExceptionCalendar"
+                            }
+                        }
+                    }
+                },
+                "security": [
+                    {
+                        "CoderSessionToken": []
+                    }
+                ]
+            },
+            "put": {
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Templates"
+                ],
+                "summary": "Update template schedule exception calendars",
+                "operationId": "update-template-schedule-exception-calendars",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "format": "uuid",
+                        "description": "Template ID",
+                        "name": "template",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Calendars to attach",
+                        "name": "request",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/codersdk.UpdateTemplateScheduleExceptionCalendarsRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "type": "array",
+                            "items": {
+                                "$ref": "#/definitions/codersdk.ScheduleExceptionCalendar"
+                            }
+                        }
+                    }
+                },
+                "security": [
+                    {
+                        "CoderSessionToken": []
+                    }
+                ]
+            }
+        },
+        "/api/v2/templates/{template}/versions": {
+            "get": {
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Templates"
+                ],
+                "summary": "List template versions by template ID",
+                "operationId": "list-template-versions-by-template-id",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "format": "uuid",
+                        "description": "Template ID",
+                        "name": "template",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "format": "uuid",
+                        "description": "After ID",
+                        "name": "after_id",
+                        "in": "query"
+                    },
+                    {
+                        "type": "boolean",
+                        "description": "Include archived versions in the list",
+                        "name": "include_archived",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Page limit",
+                        "name": "limit",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Page offset",
+                        "name": "offset",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "type": "array",
+                            "items": {
+                                "$ref": "#/definitions/codersdk.TemplateVersion"
+                            }
+                        }
+                    }
+                },
+                "security": [
+                    {
+                        "CoderSessionToken": []
+                    }
+                ]
+            },
+            "patch": {
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Templates"
+                ],
+                "summary": "Update active template version by template ID",
+                "operationId": "update-active-template-version-by-template-id",
+                "parameters": [
+                    {
+                        "description": "Modified template version",
+                        "name": "request",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/codersdk.UpdateActiveTemplateVersion"
+                        }
+                    },
+                    {
+                        "type": "string",
+                        "format": "uuid",
+                        "description": "Template ID",
+                        "name": "template",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
                         "description": "OK",
                         "schema": {
                             "$ref": "#/definitions/codersdk.Response"
@@ -9111,6 +9756,48 @@ const docTemplate = `{
                 ]
             }
         },
+        "/api/v2/templateversions/{templateversion}/diff": {
+            "get": {
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Templates"
+                ],
+                "summary": "Compare template versions",
+                "operationId": "compare-template-versions",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "format": "uuid",
+                        "description": "Template version ID",
+                        "name": "templateversion",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "format": "uuid",
+                        "description": "Template version ID to compare against. Defaults to the active version of the template.",
+                        "name": "base",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/codersdk.TemplateVersionDiff"
+                        }
+                    }
+                },
+                "security": [
+                    {
+                        "CoderSessionToken": []
+                    }
+                ]
+            }
+        },
         "/api/v2/templateversions/{templateversion}/dry-run": {
             "post": {
                 "consumes": [
@@ -10146,14 +10833,33 @@ const docTemplate = `{
                 "tags": [
                     "Users"
                 ],
-                "summary": "Get OIDC claims for the authenticated user",
-                "operationId": "get-oidc-claims-for-the-authenticated-user",
+                "summary": "Get OIDC claims for the authenticated user",
+                "operationId": "get-oidc-claims-for-the-authenticated-user",
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/codersdk.OIDCClaimsResponse"
+                        }
+                    }
+                },
+                "security": [
+                    {
+                        "CoderSessionToken": []
+                    }
+                ]
+            }
+        },
+        "/api/v2/users/oidc/callback": {
+            "get": {
+                "tags": [
+                    "Users"
+                ],
+                "summary": "OpenID Connect Callback",
+                "operationId": "openid-connect-callback",
                 "responses": {
-                    "200": {
-                        "description": "OK",
-                        "schema": {
-                            "$ref": "#/definitions/codersdk.OIDCClaimsResponse"
-                        }
+                    "307": {
+                        "description": "Temporary Redirect"
                     }
                 },
                 "security": [
@@ -10163,13 +10869,22 @@ const docTemplate = `{
                 ]
             }
         },
-        "/api/v2/users/oidc/callback": {
+        "/api/v2/users/oidc/{provider}/callback": {
             "get": {
                 "tags": [
                     "Users"
                 ],
-                "summary": "OpenID Connect Callback",
-                "operationId": "openid-connect-callback",
+                "summary": "OpenID Connect Callback for a named provider",
+                "operationId": "openid-connect-callback-for-a-named-provider",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "OIDC provider ID",
+                        "name": "provider",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
                 "responses": {
                     "307": {
                         "description": "Temporary Redirect"
@@ -11254,6 +11969,123 @@ const docTemplate = `{
                 ]
             }
         },
+        "/api/v2/users/{user}/out-of-office": {
+            "get": {
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Users"
+                ],
+                "summary": "Get user out of office ranges",
+                "operationId": "get-user-out-of-office-ranges",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "User ID, name, or me",
+                        "name": "user",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "type": "array",
+                            "items": {
+                                "$ref": "#/definitions/codersdk.OutOfOfficeRange"
+                            }
+                        }
+                    }
+                },
+                "security": [
+                    {
+                        "CoderSessionToken": []
+                    }
+                ]
+            },
+            "post": {
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Users"
+                ],
+                "summary": "Create user out of office range",
+                "operationId": "create-user-out-of-office-range",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "User ID, name, or me",
+                        "name": "user",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Out of office range",
+                        "name": "request",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/codersdk.CreateOutOfOfficeRangeRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "201": {
+                        "description": "Created",
+                        "schema": {
+                            "$ref": "#/definitions/codersdk.OutOfOfficeRange"
+                        }
+                    }
+                },
+                "security": [
+                    {
+                        "CoderSessionToken": []
+                    }
+                ]
+            }
+        },
+        "/api/v2/users/{user}/out-of-office/{range}": {
+            "delete": {
+                "tags": [
+                    "Users"
+                ],
+                "summary": "Delete user out of office range",
+                "operationId": "delete-user-out-of-office-range",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "User ID, name, or me",
+                        "name": "user",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "format": "uuid",
+                        "description": "Out of office range ID",
+                        "name": "range",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "204": {
+                        "description": "No Content"
+                    }
+                },
+                "security": [
+                    {
+                        "CoderSessionToken": []
+                    }
+                ]
+            }
+        },
         "/api/v2/users/{user}/password": {
             "put": {
                 "consumes": [
@@ -12869,18 +13701,154 @@ const docTemplate = `{
                 "parameters": [
                     {
                         "type": "string",
-                        "format": "uuid",
-                        "description": "Workspace agent ID",
-                        "name": "workspaceagent",
-                        "in": "path",
-                        "required": true
+                        "format": "uuid",
+                        "description": "Workspace agent ID",
+                        "name": "workspaceagent",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/codersdk.WorkspaceAgentListContainersResponse"
+                        }
+                    }
+                },
+                "security": [
+                    {
+                        "CoderSessionToken": []
+                    }
+                ]
+            }
+        },
+        "/api/v2/workspaceagents/{workspaceagent}/coordinate": {
+            "get": {
+                "tags": [
+                    "Agents"
+                ],
+                "summary": "Coordinate workspace agent",
+                "operationId": "coordinate-workspace-agent",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "format": "uuid",
+                        "description": "Workspace agent ID",
+                        "name": "workspaceagent",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "101": {
+                        "description": "Switching Protocols"
+                    }
+                },
+                "security": [
+                    {
+                        "CoderSessionToken": []
+                    }
+                ]
+            }
+        },
+        "/api/v2/workspaceagents/{workspaceagent}/listening-ports": {
+            "get": {
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Agents"
+                ],
+                "summary": "Get listening ports for workspace agent",
+                "operationId": "get-listening-ports-for-workspace-agent",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "format": "uuid",
+                        "description": "Workspace agent ID",
+                        "name": "workspaceagent",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/codersdk.WorkspaceAgentListeningPortsResponse"
+                        }
+                    }
+                },
+                "security": [
+                    {
+                        "CoderSessionToken": []
+                    }
+                ]
+            }
+        },
+        "/api/v2/workspaceagents/{workspaceagent}/logs": {
+            "get": {
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Agents"
+                ],
+                "summary": "Get logs by workspace agent",
+                "operationId": "get-logs-by-workspace-agent",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "format": "uuid",
+                        "description": "Workspace agent ID",
+                        "name": "workspaceagent",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Before log id",
+                        "name": "before",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "After log id",
+                        "name": "after",
+                        "in": "query"
+                    },
+                    {
+                        "type": "boolean",
+                        "description": "Follow log stream",
+                        "name": "follow",
+                        "in": "query"
+                    },
+                    {
+                        "type": "boolean",
+                        "description": "Disable compression for WebSocket connection",
+                        "name": "no_compression",
+                        "in": "query"
+                    },
+                    {
+                        "enum": [
+                            "json",
+                            "text"
+                        ],
+                        "type": "string",
+                        "description": "Log output format. Accepted: 'json' (default), 'text' (plain text with RFC3339 timestamps and ANSI colors). Not supported with follow=true.",
+                        "name": "format",
+                        "in": "query"
                     }
                 ],
                 "responses": {
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/codersdk.WorkspaceAgentListContainersResponse"
+                            "type": "array",
+                            "items": {
+                                "$ref": "#/definitions/codersdk.WorkspaceAgentLog"
+                            }
                         }
                     }
                 },
@@ -12891,13 +13859,13 @@ const docTemplate = `{
                 ]
             }
         },
-        "/api/v2/workspaceagents/{workspaceagent}/coordinate": {
+        "/api/v2/workspaceagents/{workspaceagent}/pty": {
             "get": {
                 "tags": [
                     "Agents"
                 ],
-                "summary": "Coordinate workspace agent",
-                "operationId": "coordinate-workspace-agent",
+                "summary": "Open PTY to workspace agent",
+                "operationId": "open-pty-to-workspace-agent",
                 "parameters": [
                     {
                         "type": "string",
@@ -12920,7 +13888,7 @@ const docTemplate = `{
                 ]
             }
         },
-        "/api/v2/workspaceagents/{workspaceagent}/listening-ports": {
+        "/api/v2/workspaceagents/{workspaceagent}/pty-shares": {
             "get": {
                 "produces": [
                     "application/json"
@@ -12928,8 +13896,8 @@ const docTemplate = `{
                 "tags": [
                     "Agents"
                 ],
-                "summary": "Get listening ports for workspace agent",
-                "operationId": "get-listening-ports-for-workspace-agent",
+                "summary": "Get workspace agent reconnecting PTY shares",
+                "operationId": "get-workspace-agent-reconnecting-pty-shares",
                 "parameters": [
                     {
                         "type": "string",
@@ -12944,7 +13912,10 @@ const docTemplate = `{
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/codersdk.WorkspaceAgentListeningPortsResponse"
+                            "type": "array",
+                            "items": {
+                                "$ref": "#/definitions/codersdk.WorkspaceAgentPTYShare"
+                            }
                         }
                     }
                 },
@@ -12953,18 +13924,19 @@ const docTemplate = `{
                         "CoderSessionToken": []
                     }
                 ]
-            }
-        },
-        "/api/v2/workspaceagents/{workspaceagent}/logs": {
-            "get": {
+            },
+            "post": {
+                "consumes": [
+                    "application/json"
+                ],
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
                     "Agents"
                 ],
-                "summary": "Get logs by workspace agent",
-                "operationId": "get-logs-by-workspace-agent",
+                "summary": "Share workspace agent reconnecting PTY session",
+                "operationId": "share-workspace-agent-reconnecting-pty-session",
                 "parameters": [
                     {
                         "type": "string",
@@ -12975,48 +13947,20 @@ const docTemplate = `{
                         "required": true
                     },
                     {
-                        "type": "integer",
-                        "description": "Before log id",
-                        "name": "before",
-                        "in": "query"
-                    },
-                    {
-                        "type": "integer",
-                        "description": "After log id",
-                        "name": "after",
-                        "in": "query"
-                    },
-                    {
-                        "type": "boolean",
-                        "description": "Follow log stream",
-                        "name": "follow",
-                        "in": "query"
-                    },
-                    {
-                        "type": "boolean",
-                        "description": "Disable compression for WebSocket connection",
-                        "name": "no_compression",
-                        "in": "query"
-                    },
-                    {
-                        "enum": [
-                            "json",
-                            "text"
-                        ],
-                        "type": "string",
-                        "description": "Log output format. Accepted: 'json' (default), 'text' (plain text with RFC3339 timestamps and ANSI colors). Not supported with follow=true.",
-                        "name": "format",
-                        "in": "query"
+                        "description": "Share request",
+                        "name": "request",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/codersdk.CreateWorkspaceAgentPTYShareRequest"
+                        }
                     }
                 ],
                 "responses": {
-                    "200": {
-                        "description": "OK",
+                    "201": {
+                        "description": "Created",
                         "schema": {
-                            "type": "array",
-                            "items": {
-                                "$ref": "#/definitions/codersdk.WorkspaceAgentLog"
-                            }
+                            "$ref": "#/definitions/codersdk.CreateWorkspaceAgentPTYShareResponse"
                         }
                     }
                 },
@@ -13027,13 +13971,13 @@ const docTemplate = `{
                 ]
             }
         },
-        "/api/v2/workspaceagents/{workspaceagent}/pty": {
-            "get": {
+        "/api/v2/workspaceagents/{workspaceagent}/pty-shares/{ptyshare}": {
+            "delete": {
                 "tags": [
                     "Agents"
                 ],
-                "summary": "Open PTY to workspace agent",
-                "operationId": "open-pty-to-workspace-agent",
+                "summary": "Revoke workspace agent reconnecting PTY share",
+                "operationId": "revoke-workspace-agent-reconnecting-pty-share",
                 "parameters": [
                     {
                         "type": "string",
@@ -13042,11 +13986,19 @@ const docTemplate = `{
                         "name": "workspaceagent",
                         "in": "path",
                         "required": true
+                    },
+                    {
+                        "type": "string",
+                        "format": "uuid",
+                        "description": "Share ID",
+                        "name": "ptyshare",
+                        "in": "path",
+                        "required": true
                     }
                 ],
                 "responses": {
-                    "101": {
-                        "description": "Switching Protocols"
+                    "204": {
+                        "description": "No Content"
                     }
                 },
                 "security": [
@@ -13315,14 +14267,162 @@ const docTemplate = `{
                     }
                 ],
                 "responses": {
-                    "200": {
-                        "description": "OK",
-                        "schema": {
-                            "type": "array",
-                            "items": {
-                                "$ref": "#/definitions/codersdk.ProvisionerJobLog"
-                            }
-                        }
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "type": "array",
+                            "items": {
+                                "$ref": "#/definitions/codersdk.ProvisionerJobLog"
+                            }
+                        }
+                    }
+                },
+                "security": [
+                    {
+                        "CoderSessionToken": []
+                    }
+                ]
+            }
+        },
+        "/api/v2/workspacebuilds/{workspacebuild}/parameters": {
+            "get": {
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Builds"
+                ],
+                "summary": "Get build parameters for workspace build",
+                "operationId": "get-build-parameters-for-workspace-build",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Workspace build ID",
+                        "name": "workspacebuild",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "type": "array",
+                            "items": {
+                                "$ref": "#/definitions/codersdk.WorkspaceBuildParameter"
+                            }
+                        }
+                    }
+                },
+                "security": [
+                    {
+                        "CoderSessionToken": []
+                    }
+                ]
+            }
+        },
+        "/api/v2/workspacebuilds/{workspacebuild}/resources": {
+            "get": {
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Builds"
+                ],
+                "summary": "Removed: Get workspace resources for workspace build",
+                "operationId": "removed-get-workspace-resources-for-workspace-build",
+                "deprecated": true,
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Workspace build ID",
+                        "name": "workspacebuild",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "type": "array",
+                            "items": {
+                                "$ref": "#/definitions/codersdk.WorkspaceResource"
+                            }
+                        }
+                    }
+                },
+                "security": [
+                    {
+                        "CoderSessionToken": []
+                    }
+                ]
+            }
+        },
+        "/api/v2/workspacebuilds/{workspacebuild}/state": {
+            "get": {
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Builds"
+                ],
+                "summary": "Get provisioner state for workspace build",
+                "operationId": "get-provisioner-state-for-workspace-build",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Workspace build ID",
+                        "name": "workspacebuild",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/codersdk.WorkspaceBuild"
+                        }
+                    }
+                },
+                "security": [
+                    {
+                        "CoderSessionToken": []
+                    }
+                ]
+            },
+            "put": {
+                "consumes": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Builds"
+                ],
+                "summary": "Update workspace build state",
+                "operationId": "update-workspace-build-state",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "format": "uuid",
+                        "description": "Workspace build ID",
+                        "name": "workspacebuild",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Request body",
+                        "name": "request",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/codersdk.UpdateWorkspaceBuildStateRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "204": {
+                        "description": "No Content"
                     }
                 },
                 "security": [
@@ -13332,7 +14432,7 @@ const docTemplate = `{
                 ]
             }
         },
-        "/api/v2/workspacebuilds/{workspacebuild}/parameters": {
+        "/api/v2/workspacebuilds/{workspacebuild}/timings": {
             "get": {
                 "produces": [
                     "application/json"
@@ -13340,11 +14440,12 @@ const docTemplate = `{
                 "tags": [
                     "Builds"
                 ],
-                "summary": "Get build parameters for workspace build",
-                "operationId": "get-build-parameters-for-workspace-build",
+                "summary": "Get workspace build timings by ID",
+                "operationId": "get-workspace-build-timings-by-id",
                 "parameters": [
                     {
                         "type": "string",
+                        "format": "uuid",
                         "description": "Workspace build ID",
                         "name": "workspacebuild",
                         "in": "path",
@@ -13355,10 +14456,7 @@ const docTemplate = `{
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "type": "array",
-                            "items": {
-                                "$ref": "#/definitions/codersdk.WorkspaceBuildParameter"
-                            }
+                            "$ref": "#/definitions/codersdk.WorkspaceBuildTimings"
                         }
                     }
                 },
@@ -13369,33 +14467,23 @@ const docTemplate = `{
                 ]
             }
         },
-        "/api/v2/workspacebuilds/{workspacebuild}/resources": {
+        "/api/v2/workspacebulkoperations": {
             "get": {
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "Builds"
-                ],
-                "summary": "Removed: Get workspace resources for workspace build",
-                "operationId": "removed-get-workspace-resources-for-workspace-build",
-                "deprecated": true,
-                "parameters": [
-                    {
-                        "type": "string",
-                        "description": "Workspace build ID",
-                        "name": "workspacebuild",
-                        "in": "path",
-                        "required": true
-                    }
+                    "Workspaces"
                 ],
+                "summary": "Get workspace bulk operations",
+                "operationId": "get-workspace-bulk-operations",
                 "responses": {
                     "200": {
                         "description": "OK",
                         "schema": {
                             "type": "array",
                             "items": {
-                                "$ref": "#/definitions/codersdk.WorkspaceResource"
+                                "$ref": "#/definitions/codersdk.WorkspaceBulkOperation"
                             }
                         }
                     }
@@ -13405,23 +14493,61 @@ const docTemplate = `{
                         "CoderSessionToken": []
                     }
                 ]
+            },
+            "post": {
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Workspaces"
+                ],
+                "summary": "Create workspace bulk operation",
+                "operationId": "create-workspace-bulk-operation",
+                "parameters": [
+                    {
+                        "description": "Bulk operation request",
+                        "name": "request",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/codersdk.CreateWorkspaceBulkOperationRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "201": {
+                        "description": "Created",
+                        "schema": {
+                            "$ref": "#/definitions/codersdk.WorkspaceBulkOperation"
+                        }
+                    }
+                },
+                "security": [
+                    {
+                        "CoderSessionToken": []
+                    }
+                ]
             }
         },
-        "/api/v2/workspacebuilds/{workspacebuild}/state": {
+        "/api/v2/workspacebulkoperations/{operation}": {
             "get": {
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "Builds"
+                    "Workspaces"
                 ],
-                "summary": "Get provisioner state for workspace build",
-                "operationId": "get-provisioner-state-for-workspace-build",
+                "summary": "Get workspace bulk operation",
+                "operationId": "get-workspace-bulk-operation",
                 "parameters": [
                     {
                         "type": "string",
-                        "description": "Workspace build ID",
-                        "name": "workspacebuild",
+                        "format": "uuid",
+                        "description": "Bulk operation ID",
+                        "name": "operation",
                         "in": "path",
                         "required": true
                     }
@@ -13430,7 +14556,7 @@ const docTemplate = `{
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/codersdk.WorkspaceBuild"
+                            "$ref": "#/definitions/codersdk.WorkspaceBulkOperation"
                         }
                     }
                 },
@@ -13439,38 +14565,34 @@ const docTemplate = `{
                         "CoderSessionToken": []
                     }
                 ]
-            },
-            "put": {
-                "consumes": [
+            }
+        },
+        "/api/v2/workspacebulkoperations/{operation}/cancel": {
+            "post": {
+                "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "Builds"
+                    "Workspaces"
                 ],
-                "summary": "Update workspace build state",
-                "operationId": "update-workspace-build-state",
+                "summary": "Cancel workspace bulk operation",
+                "operationId": "cancel-workspace-bulk-operation",
                 "parameters": [
                     {
                         "type": "string",
                         "format": "uuid",
-                        "description": "Workspace build ID",
-                        "name": "workspacebuild",
+                        "description": "Bulk operation ID",
+                        "name": "operation",
                         "in": "path",
                         "required": true
-                    },
-                    {
-                        "description": "Request body",
-                        "name": "request",
-                        "in": "body",
-                        "required": true,
-                        "schema": {
-                            "$ref": "#/definitions/codersdk.UpdateWorkspaceBuildStateRequest"
-                        }
                     }
                 ],
                 "responses": {
-                    "204": {
-                        "description": "No Content"
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/codersdk.WorkspaceBulkOperation"
+                        }
                     }
                 },
                 "security": [
@@ -13480,22 +14602,22 @@ const docTemplate = `{
                 ]
             }
         },
-        "/api/v2/workspacebuilds/{workspacebuild}/timings": {
+        "/api/v2/workspacebulkoperations/{operation}/workspaces": {
             "get": {
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "Builds"
+                    "Workspaces"
                 ],
-                "summary": "Get workspace build timings by ID",
-                "operationId": "get-workspace-build-timings-by-id",
+                "summary": "Get workspace bulk operation workspaces",
+                "operationId": "get-workspace-bulk-operation-workspaces",
                 "parameters": [
                     {
                         "type": "string",
                         "format": "uuid",
-                        "description": "Workspace build ID",
-                        "name": "workspacebuild",
+                        "description": "Bulk operation ID",
+                        "name": "operation",
                         "in": "path",
                         "required": true
                     }
@@ -13504,7 +14626,10 @@ const docTemplate = `{
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/codersdk.WorkspaceBuildTimings"
+                            "type": "array",
+                            "items": {
+                                "$ref": "#/definitions/codersdk.WorkspaceBulkOperationWorkspace"
+                            }
                         }
                     }
                 },
@@ -14213,6 +15338,41 @@ const docTemplate = `{
                 ]
             }
         },
+        "/api/v2/workspaces/{workspace}/autostart-exceptions": {
+            "get": {
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Workspaces"
+                ],
+                "summary": "Get workspace autostart exceptions",
+                "operationId": "get-workspace-autostart-exceptions",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "format": "uuid",
+                        "description": "Workspace ID",
+                        "name": "workspace",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/codersdk.WorkspaceAutostartExceptions"
+                        }
+                    }
+                },
+                "security": [
+                    {
+                        "CoderSessionToken": []
+                    }
+                ]
+            }
+        },
         "/api/v2/workspaces/{workspace}/autoupdates": {
             "put": {
                 "consumes": [
@@ -15034,16 +16194,141 @@ const docTemplate = `{
                 ],
                 "responses": {
                     "200": {
-                        "description": "OK",
-                        "schema": {
-                            "$ref": "#/definitions/codersdk.OAuth2ClientConfiguration"
-                        }
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/codersdk.OAuth2ClientConfiguration"
+                        }
+                    }
+                }
+            },
+            "put": {
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Enterprise"
+                ],
+                "summary": "Update OAuth2 client configuration (RFC 7592)",
+                "operationId": "put-oauth2-client-configuration",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Client ID",
+                        "name": "client_id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Client update request",
+                        "name": "request",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/codersdk.OAuth2ClientRegistrationRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/codersdk.OAuth2ClientConfiguration"
+                        }
+                    }
+                }
+            },
+            "delete": {
+                "tags": [
+                    "Enterprise"
+                ],
+                "summary": "Delete OAuth2 client registration (RFC 7592)",
+                "operationId": "delete-oauth2-client-configuration",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Client ID",
+                        "name": "client_id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "204": {
+                        "description": "No Content"
+                    }
+                }
+            }
+        },
+        "/oauth2/device": {
+            "get": {
+                "tags": [
+                    "Enterprise"
+                ],
+                "summary": "OAuth2 device verification (GET - show verification page).",
+                "operationId": "oauth2-device-verification-get",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "User code displayed on the device",
+                        "name": "user_code",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Returns HTML device verification page"
+                    }
+                },
+                "security": [
+                    {
+                        "CoderSessionToken": []
+                    }
+                ]
+            },
+            "post": {
+                "consumes": [
+                    "application/x-www-form-urlencoded"
+                ],
+                "tags": [
+                    "Enterprise"
+                ],
+                "summary": "OAuth2 device verification (POST - process verification).",
+                "operationId": "oauth2-device-verification-post",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "User code displayed on the device",
+                        "name": "user_code",
+                        "in": "formData",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Either allow or deny",
+                        "name": "action",
+                        "in": "formData",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Returns HTML device verification result"
                     }
-                }
-            },
-            "put": {
+                },
+                "security": [
+                    {
+                        "CoderSessionToken": []
+                    }
+                ]
+            }
+        },
+        "/oauth2/device/code": {
+            "post": {
                 "consumes": [
-                    "application/json"
+                    "application/x-www-form-urlencoded"
                 ],
                 "produces": [
                     "application/json"
@@ -15051,55 +16336,43 @@ const docTemplate = `{
                 "tags": [
                     "Enterprise"
                 ],
-                "summary": "Update OAuth2 client configuration (RFC 7592)",
-                "operationId": "put-oauth2-client-configuration",
+                "summary": "OAuth2 device authorization request (RFC 8628).",
+                "operationId": "oauth2-device-authorization-request",
                 "parameters": [
                     {
                         "type": "string",
                         "description": "Client ID",
                         "name": "client_id",
-                        "in": "path",
+                        "in": "formData",
                         "required": true
                     },
                     {
-                        "description": "Client update request",
-                        "name": "request",
-                        "in": "body",
-                        "required": true,
-                        "schema": {
-                            "$ref": "#/definitions/codersdk.OAuth2ClientRegistrationRequest"
-                        }
+                        "type": "string",
+                        "description": "Client secret, for confidential clients",
+                        "name": "client_secret",
+                        "in": "formData"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Token scopes (currently ignored)",
+                        "name": "scope",
+                        "in": "formData"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Resource indicator (RFC 8707)",
+                        "name": "resource",
+                        "in": "formData"
                     }
                 ],
                 "responses": {
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/codersdk.OAuth2ClientConfiguration"
+                            "$ref": "#/definitions/codersdk.OAuth2DeviceAuthorizationResponse"
                         }
                     }
                 }
-            },
-            "delete": {
-                "tags": [
-                    "Enterprise"
-                ],
-                "summary": "Delete OAuth2 client registration (RFC 7592)",
-                "operationId": "delete-oauth2-client-configuration",
-                "parameters": [
-                    {
-                        "type": "string",
-                        "description": "Client ID",
-                        "name": "client_id",
-                        "in": "path",
-                        "required": true
-                    }
-                ],
-                "responses": {
-                    "204": {
-                        "description": "No Content"
-                    }
-                }
             }
         },
         "/oauth2/register": {
@@ -15210,13 +16483,20 @@ const docTemplate = `{
                         "name": "refresh_token",
                         "in": "formData"
                     },
+                    {
+                        "type": "string",
+                        "description": "Device code, required if grant_type=urn:ietf:params:oauth:grant-type:device_code",
+                        "name": "device_code",
+                        "in": "formData"
+                    },
                     {
                         "enum": [
                             "authorization_code",
                             "refresh_token",
                             "password",
                             "client_credentials",
-                            "implicit"
+                            "implicit",
+                            "urn:ietf:params:oauth:grant-type:device_code"
                         ],
                         "type": "string",
                         "description": "Grant type",
@@ -17441,6 +18721,68 @@ const docTemplate = `{
                 }
             }
         },
+        "codersdk.AuditLogStreamingConfig": {
+            "type": "object",
+            "properties": {
+                "file_filter": {
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
+                },
+                "file_max_backups": {
+                    "type": "integer"
+                },
+                "file_max_size_mb": {
+                    "type": "integer"
+                },
+                "file_path": {
+                    "description": "FilePath is the JSONL file audit logs are appended to.",
+                    "type": "string"
+                },
+                "syslog_address": {
+                    "description": "SyslogAddress is the host:port of an RFC 5424 syslog receiver.",
+                    "type": "string"
+                },
+                "syslog_facility": {
+                    "type": "integer"
+                },
+                "syslog_filter": {
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
+                },
+                "syslog_tls": {
+                    "type": "boolean"
+                },
+                "syslog_tls_ca_file": {
+                    "type": "string"
+                },
+                "webhook_batch_size": {
+                    "type": "integer"
+                },
+                "webhook_filter": {
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
+                },
+                "webhook_flush_interval": {
+                    "description": "WebhookFlushInterval is how long audit logs wait for a batch to fill.",
+                    "type": "integer"
+                },
+                "webhook_max_retries": {
+                    "type": "integer"
+                },
+                "webhook_secret": {
+                    "type": "string"
+                },
+                "webhook_url": {
+                    "$ref": "#/definitions/serpent.URL"
+                }
+            }
+        },
         "codersdk.AuthMethod": {
             "type": "object",
             "properties": {
@@ -17458,6 +18800,13 @@ const docTemplate = `{
                 "oidc": {
                     "$ref": "#/definitions/codersdk.OIDCAuthMethod"
                 },
+                "oidc_providers": {
+                    "description": "OIDCProviders are the named OIDC providers. Each gets its own login\nbutton that starts at /api/v2/users/oidc/{id}/callback.",
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/codersdk.OIDCProviderAuthMethod"
+                    }
+                },
                 "password": {
                     "$ref": "#/definitions/codersdk.AuthMethod"
                 },
@@ -19616,6 +20965,24 @@ const docTemplate = `{
                 }
             }
         },
+        "codersdk.CreateOutOfOfficeRangeRequest": {
+            "type": "object",
+            "required": [
+                "start_date"
+            ],
+            "properties": {
+                "end_date": {
+                    "description": "EndDate is inclusive. It defaults to StartDate.",
+                    "type": "string"
+                },
+                "reason": {
+                    "type": "string"
+                },
+                "start_date": {
+                    "type": "string"
+                }
+            }
+        },
         "codersdk.CreateProvisionerKeyResponse": {
             "type": "object",
             "properties": {
@@ -19624,6 +20991,30 @@ const docTemplate = `{
                 }
             }
         },
+        "codersdk.CreateScheduleExceptionCalendarRequest": {
+            "type": "object",
+            "required": [
+                "name"
+            ],
+            "properties": {
+                "dates": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/codersdk.ScheduleExceptionDateRange"
+                    }
+                },
+                "description": {
+                    "type": "string"
+                },
+                "ical": {
+                    "description": "ICal is the contents of an iCalendar (.ics) file. All-day events and\nyearly recurring events are supported.",
+                    "type": "string"
+                },
+                "name": {
+                    "type": "string"
+                }
+            }
+        },
         "codersdk.CreateTemplateRequest": {
             "type": "object",
             "required": [
@@ -19814,6 +21205,48 @@ const docTemplate = `{
                 }
             }
         },
+        "codersdk.CreateTemplateVersionRolloutRequest": {
+            "type": "object",
+            "required": [
+                "template_version_id"
+            ],
+            "properties": {
+                "failure_action": {
+                    "description": "FailureAction defaults to pause.",
+                    "enum": [
+                        "pause",
+                        "rollback"
+                    ],
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/codersdk.TemplateVersionRolloutFailureAction"
+                        }
+                    ]
+                },
+                "failure_threshold": {
+                    "description": "FailureThreshold defaults to 0.2.",
+                    "type": "number"
+                },
+                "group_ids": {
+                    "type": "array",
+                    "items": {
+                        "type": "string",
+                        "format": "uuid"
+                    }
+                },
+                "min_samples": {
+                    "description": "MinSamples defaults to 5.",
+                    "type": "integer"
+                },
+                "percentage": {
+                    "type": "integer"
+                },
+                "template_version_id": {
+                    "type": "string",
+                    "format": "uuid"
+                }
+            }
+        },
         "codersdk.CreateTestAuditLogRequest": {
             "type": "object",
             "properties": {
@@ -20073,6 +21506,43 @@ const docTemplate = `{
                 }
             }
         },
+        "codersdk.CreateWorkspaceAgentPTYShareRequest": {
+            "type": "object",
+            "required": [
+                "reconnect_id",
+                "user_ids"
+            ],
+            "properties": {
+                "lifetime": {
+                    "description": "Lifetime is how long the share is valid for. Defaults to one hour.",
+                    "type": "integer"
+                },
+                "reconnect_id": {
+                    "type": "string",
+                    "format": "uuid"
+                },
+                "user_ids": {
+                    "type": "array",
+                    "minItems": 1,
+                    "items": {
+                        "type": "string",
+                        "format": "uuid"
+                    }
+                }
+            }
+        },
+        "codersdk.CreateWorkspaceAgentPTYShareResponse": {
+            "type": "object",
+            "properties": {
+                "share": {
+                    "$ref": "#/definitions/codersdk.WorkspaceAgentPTYShare"
+                },
+                "token": {
+                    "description": "Token is only returned once and must be passed to the invited users.",
+                    "type": "string"
+                }
+            }
+        },
         "codersdk.CreateWorkspaceBuildOnSuccessRequest": {
             "type": "object",
             "required": [
@@ -20214,6 +21684,59 @@ const docTemplate = `{
                 }
             }
         },
+        "codersdk.CreateWorkspaceBulkOperationRequest": {
+            "type": "object",
+            "required": [
+                "action",
+                "query"
+            ],
+            "properties": {
+                "action": {
+                    "enum": [
+                        "start",
+                        "stop",
+                        "update",
+                        "delete",
+                        "autostart",
+                        "ttl",
+                        "automatic_updates"
+                    ],
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/codersdk.WorkspaceBulkOperationAction"
+                        }
+                    ]
+                },
+                "automatic_updates": {
+                    "description": "AutomaticUpdates is required by the automatic_updates action.",
+                    "enum": [
+                        "always",
+                        "never"
+                    ],
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/codersdk.AutomaticUpdates"
+                        }
+                    ]
+                },
+                "autostart_schedule": {
+                    "description": "AutostartSchedule is used by the autostart action. It has the same\nform as UpdateWorkspaceAutostartRequest.Schedule. Empty disables\nautostart.",
+                    "type": "string"
+                },
+                "concurrency": {
+                    "description": "Concurrency is the number of workspaces that are processed at the same\ntime. Defaults to 5.",
+                    "type": "integer"
+                },
+                "query": {
+                    "description": "Query uses the same syntax as the workspaces search query, e.g.\n` + "`" + `template:docker owner:alice` + "`" + `.",
+                    "type": "string"
+                },
+                "ttl_ms": {
+                    "description": "TTLMillis is used by the ttl action. Zero disables autostop.",
+                    "type": "integer"
+                }
+            }
+        },
         "codersdk.CreateWorkspaceProxyRequest": {
             "type": "object",
             "required": [
@@ -20578,6 +22101,9 @@ const docTemplate = `{
                 "allow_workspace_renames": {
                     "type": "boolean"
                 },
+                "audit_log_streaming": {
+                    "$ref": "#/definitions/codersdk.AuditLogStreamingConfig"
+                },
                 "autobuild_poll_interval": {
                     "type": "integer"
                 },
@@ -20681,6 +22207,9 @@ const docTemplate = `{
                 "oidc": {
                     "$ref": "#/definitions/codersdk.OIDCConfig"
                 },
+                "oidc_providers": {
+                    "$ref": "#/definitions/serpent.Struct-array_codersdk_OIDCProviderConfig"
+                },
                 "pg_auth": {
                     "type": "string"
                 },
@@ -21648,6 +23177,14 @@ const docTemplate = `{
         "codersdk.Healthcheck": {
             "type": "object",
             "properties": {
+                "address": {
+                    "description": "Address specifies a host:port that must accept TCP connections for\nthe app to be healthy.",
+                    "type": "string"
+                },
+                "command": {
+                    "description": "Command specifies a command run in the workspace that must exit with\nstatus 0 for the app to be healthy.",
+                    "type": "string"
+                },
                 "interval": {
                     "description": "Interval specifies the seconds between each health check.",
                     "type": "integer"
@@ -22375,10 +23912,26 @@ const docTemplate = `{
                     "description": "Which delivery method to use (available options: 'smtp', 'webhook').",
                     "type": "string"
                 },
+                "msteams": {
+                    "description": "Microsoft Teams settings.",
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/codersdk.NotificationsTeamsConfig"
+                        }
+                    ]
+                },
                 "retry_interval": {
                     "description": "The minimum time between retries.",
                     "type": "integer"
                 },
+                "slack": {
+                    "description": "Slack settings.",
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/codersdk.NotificationsSlackConfig"
+                        }
+                    ]
+                },
                 "sync_buffer_size": {
                     "description": "The notifications system buffers message updates in memory to ease pressure on the database.\nThis option controls how many updates are kept in memory. The lower this value the\nlower the change of state inconsistency in a non-graceful shutdown - but it also increases load on the\ndatabase. It is recommended to keep this option at its default value.",
                     "type": "integer"
@@ -22500,6 +24053,44 @@ const docTemplate = `{
                 }
             }
         },
+        "codersdk.NotificationsSlackConfig": {
+            "type": "object",
+            "properties": {
+                "bot_token": {
+                    "description": "The bot token used to send direct messages via chat.postMessage.",
+                    "type": "string"
+                },
+                "user_id_claim": {
+                    "description": "The claim from a user's login which holds their Slack user ID.",
+                    "type": "string"
+                },
+                "webhook_url": {
+                    "description": "The incoming webhook to post to when a user's Slack ID is unknown.",
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/serpent.URL"
+                        }
+                    ]
+                }
+            }
+        },
+        "codersdk.NotificationsTeamsConfig": {
+            "type": "object",
+            "properties": {
+                "user_id_claim": {
+                    "description": "The claim from a user's login which identifies them in Microsoft Teams.",
+                    "type": "string"
+                },
+                "webhook_url": {
+                    "description": "The incoming webhook or Workflows URL to which Adaptive Cards are posted.",
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/serpent.URL"
+                        }
+                    ]
+                }
+            }
+        },
         "codersdk.NotificationsWebhookConfig": {
             "type": "object",
             "properties": {
@@ -22554,6 +24145,9 @@ const docTemplate = `{
                         "$ref": "#/definitions/codersdk.OAuth2PKCECodeChallengeMethod"
                     }
                 },
+                "device_authorization_endpoint": {
+                    "type": "string"
+                },
                 "grant_types_supported": {
                     "type": "array",
                     "items": {
@@ -22822,6 +24416,29 @@ const docTemplate = `{
                 }
             }
         },
+        "codersdk.OAuth2DeviceAuthorizationResponse": {
+            "type": "object",
+            "properties": {
+                "device_code": {
+                    "type": "string"
+                },
+                "expires_in": {
+                    "type": "integer"
+                },
+                "interval": {
+                    "type": "integer"
+                },
+                "user_code": {
+                    "type": "string"
+                },
+                "verification_uri": {
+                    "type": "string"
+                },
+                "verification_uri_complete": {
+                    "type": "string"
+                }
+            }
+        },
         "codersdk.OAuth2GithubConfig": {
             "type": "object",
             "properties": {
@@ -22903,6 +24520,11 @@ const docTemplate = `{
                 "callback_url": {
                     "type": "string"
                 },
+                "client_credentials_user_id": {
+                    "description": "ClientCredentialsUserID is the service account that tokens issued by\nthe client_credentials grant act as. The grant is disabled when unset.",
+                    "type": "string",
+                    "format": "uuid"
+                },
                 "endpoints": {
                     "description": "Endpoints are included in the app response for easier discovery. The OAuth2\nspec does not have a defined place to find these (for comparison, OIDC has\na '/.well-known/openid-configuration' endpoint).",
                     "allOf": [
@@ -22957,14 +24579,16 @@ const docTemplate = `{
                 "refresh_token",
                 "password",
                 "client_credentials",
-                "implicit"
+                "implicit",
+                "urn:ietf:params:oauth:grant-type:device_code"
             ],
             "x-enum-varnames": [
                 "OAuth2ProviderGrantTypeAuthorizationCode",
                 "OAuth2ProviderGrantTypeRefreshToken",
                 "OAuth2ProviderGrantTypePassword",
                 "OAuth2ProviderGrantTypeClientCredentials",
-                "OAuth2ProviderGrantTypeImplicit"
+                "OAuth2ProviderGrantTypeImplicit",
+                "OAuth2ProviderGrantTypeDeviceCode"
             ]
         },
         "codersdk.OAuth2ProviderResponseType": {
@@ -23039,6 +24663,10 @@ const docTemplate = `{
                     "description": "Claims are the merged claims from the OIDC provider. These\nare the union of the ID token claims and the userinfo claims,\nwhere userinfo claims take precedence on conflict.",
                     "type": "object",
                     "additionalProperties": true
+                },
+                "provider": {
+                    "description": "Provider is the ID of the named OIDC provider the user is linked\nto. It is empty for the deployment's primary OIDC provider.",
+                    "type": "string"
                 }
             }
         },
@@ -23174,6 +24802,150 @@ const docTemplate = `{
                 }
             }
         },
+        "codersdk.OIDCProviderAuthMethod": {
+            "type": "object",
+            "properties": {
+                "iconUrl": {
+                    "type": "string"
+                },
+                "id": {
+                    "type": "string"
+                },
+                "signInText": {
+                    "type": "string"
+                }
+            }
+        },
+        "codersdk.OIDCProviderConfig": {
+            "type": "object",
+            "properties": {
+                "allow_signups": {
+                    "type": "boolean"
+                },
+                "auth_url_params": {
+                    "type": "object",
+                    "additionalProperties": {
+                        "type": "string"
+                    }
+                },
+                "client_cert_file": {
+                    "type": "string"
+                },
+                "client_id": {
+                    "type": "string"
+                },
+                "client_key_file": {
+                    "description": "ClientKeyFile \u0026 ClientCertFile are used in place of ClientSecret for PKI auth.",
+                    "type": "string"
+                },
+                "email_domain": {
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
+                },
+                "email_fallback": {
+                    "type": "boolean"
+                },
+                "email_field": {
+                    "type": "string"
+                },
+                "group_allow_list": {
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
+                },
+                "group_auto_create": {
+                    "type": "boolean"
+                },
+                "group_mapping": {
+                    "type": "object",
+                    "additionalProperties": {
+                        "type": "string"
+                    }
+                },
+                "group_regex_filter": {
+                    "type": "string"
+                },
+                "groups_field": {
+                    "type": "string"
+                },
+                "icon_url": {
+                    "type": "string"
+                },
+                "id": {
+                    "description": "ID is a unique identifier for the provider. It is used in the\ncallback URL: /api/v2/users/oidc/{id}/callback.",
+                    "type": "string"
+                },
+                "ignore_email_verified": {
+                    "type": "boolean"
+                },
+                "ignore_user_info": {
+                    "type": "boolean"
+                },
+                "issuer_url": {
+                    "type": "string"
+                },
+                "name_field": {
+                    "type": "string"
+                },
+                "organization_assign_default": {
+                    "type": "boolean"
+                },
+                "organization_field": {
+                    "type": "string"
+                },
+                "organization_mapping": {
+                    "type": "object",
+                    "additionalProperties": {
+                        "type": "array",
+                        "items": {
+                            "type": "string"
+                        }
+                    }
+                },
+                "scopes": {
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
+                },
+                "sign_in_text": {
+                    "type": "string"
+                },
+                "signups_disabled_text": {
+                    "type": "string"
+                },
+                "skip_issuer_checks": {
+                    "type": "boolean"
+                },
+                "source_user_info_from_access_token": {
+                    "type": "boolean"
+                },
+                "user_role_field": {
+                    "type": "string"
+                },
+                "user_role_mapping": {
+                    "type": "object",
+                    "additionalProperties": {
+                        "type": "array",
+                        "items": {
+                            "type": "string"
+                        }
+                    }
+                },
+                "user_roles_default": {
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
+                },
+                "username_field": {
+                    "type": "string"
+                }
+            }
+        },
         "codersdk.OptionType": {
             "type": "string",
             "enum": [
@@ -23404,6 +25176,32 @@ const docTemplate = `{
                 }
             }
         },
+        "codersdk.OutOfOfficeRange": {
+            "type": "object",
+            "properties": {
+                "created_at": {
+                    "type": "string",
+                    "format": "date-time"
+                },
+                "end_date": {
+                    "type": "string"
+                },
+                "id": {
+                    "type": "string",
+                    "format": "uuid"
+                },
+                "reason": {
+                    "type": "string"
+                },
+                "start_date": {
+                    "type": "string"
+                },
+                "user_id": {
+                    "type": "string",
+                    "format": "uuid"
+                }
+            }
+        },
         "codersdk.PaginatedGroup": {
             "type": "object",
             "properties": {
@@ -23736,6 +25534,11 @@ const docTemplate = `{
                 "callback_url": {
                     "type": "string"
                 },
+                "client_credentials_user_id": {
+                    "description": "ClientCredentialsUserID enables the client_credentials grant, issuing\ntokens for the given service account.",
+                    "type": "string",
+                    "format": "uuid"
+                },
                 "icon": {
                     "type": "string"
                 },
@@ -24260,6 +26063,9 @@ const docTemplate = `{
                     "type": "string",
                     "format": "uuid"
                 },
+                "priority": {
+                    "type": "integer"
+                },
                 "queue_position": {
                     "type": "integer"
                 },
@@ -24367,21 +26173,42 @@ const docTemplate = `{
                     "type": "string",
                     "format": "uuid"
                 },
-                "template_name": {
-                    "type": "string"
-                },
-                "template_version_name": {
-                    "type": "string"
-                },
-                "workspace_build_transition": {
-                    "$ref": "#/definitions/codersdk.WorkspaceTransition"
+                "template_name": {
+                    "type": "string"
+                },
+                "template_version_name": {
+                    "type": "string"
+                },
+                "workspace_build_transition": {
+                    "$ref": "#/definitions/codersdk.WorkspaceTransition"
+                },
+                "workspace_id": {
+                    "type": "string",
+                    "format": "uuid"
+                },
+                "workspace_name": {
+                    "type": "string"
+                }
+            }
+        },
+        "codersdk.ProvisionerJobPriority": {
+            "type": "object",
+            "properties": {
+                "organization_id": {
+                    "type": "string",
+                    "format": "uuid"
+                },
+                "priority": {
+                    "type": "integer"
                 },
-                "workspace_id": {
+                "template_id": {
+                    "description": "TemplateID is empty if the boost applies to the whole organization.",
                     "type": "string",
                     "format": "uuid"
                 },
-                "workspace_name": {
-                    "type": "string"
+                "updated_at": {
+                    "type": "string",
+                    "format": "date-time"
                 }
             }
         },
@@ -24411,12 +26238,14 @@ const docTemplate = `{
             "enum": [
                 "template_version_import",
                 "workspace_build",
-                "template_version_dry_run"
+                "template_version_dry_run",
+                "workspace_drift_check"
             ],
             "x-enum-varnames": [
                 "ProvisionerJobTypeTemplateVersionImport",
                 "ProvisionerJobTypeWorkspaceBuild",
-                "ProvisionerJobTypeTemplateVersionDryRun"
+                "ProvisionerJobTypeTemplateVersionDryRun",
+                "ProvisionerJobTypeWorkspaceDriftCheck"
             ]
         },
         "codersdk.ProvisionerKey": {
@@ -24565,6 +26394,11 @@ const docTemplate = `{
                 "callback_url": {
                     "type": "string"
                 },
+                "client_credentials_user_id": {
+                    "description": "ClientCredentialsUserID enables the client_credentials grant, issuing\ntokens for the given service account. Omitting it keeps the current\nservice account, and the nil UUID disables the grant.",
+                    "type": "string",
+                    "format": "uuid"
+                },
                 "icon": {
                     "type": "string"
                 },
@@ -24946,7 +26780,8 @@ const docTemplate = `{
                 "mcp_server_config",
                 "user_secret",
                 "user_skill",
-                "chat_instruction_settings"
+                "chat_instruction_settings",
+                "workspace_bulk_operation"
             ],
             "x-enum-varnames": [
                 "ResourceTypeTemplate",
@@ -24986,7 +26821,8 @@ const docTemplate = `{
                 "ResourceTypeMCPServerConfig",
                 "ResourceTypeUserSecret",
                 "ResourceTypeUserSkill",
-                "ResourceTypeChatInstructionSettings"
+                "ResourceTypeChatInstructionSettings",
+                "ResourceTypeWorkspaceBulkOperation"
             ]
         },
         "codersdk.Response": {
@@ -25016,6 +26852,10 @@ const docTemplate = `{
                     "description": "APIKeys controls how long expired API keys are retained before being deleted.\nKeys are only deleted if they have been expired for at least this duration.\nDefaults to 7 days to preserve existing behavior.",
                     "type": "integer"
                 },
+                "archive_url": {
+                    "description": "ArchiveURL is a local directory or S3 URL that audit logs, connection\nlogs, workspace agent logs and AI Bridge records are archived to\nbefore they are purged. Unset to disable archiving.",
+                    "type": "string"
+                },
                 "audit_logs": {
                     "description": "AuditLogs controls how long audit log entries are retained.\nSet to 0 to disable (keep indefinitely).",
                     "type": "integer"
@@ -25129,6 +26969,55 @@ const docTemplate = `{
                 }
             }
         },
+        "codersdk.ScheduleExceptionCalendar": {
+            "type": "object",
+            "properties": {
+                "created_at": {
+                    "type": "string",
+                    "format": "date-time"
+                },
+                "dates": {
+                    "description": "Dates is only included when fetching a single calendar.",
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/codersdk.ScheduleExceptionDateRange"
+                    }
+                },
+                "description": {
+                    "type": "string"
+                },
+                "id": {
+                    "type": "string",
+                    "format": "uuid"
+                },
+                "name": {
+                    "type": "string"
+                },
+                "organization_id": {
+                    "type": "string",
+                    "format": "uuid"
+                },
+                "updated_at": {
+                    "type": "string",
+                    "format": "date-time"
+                }
+            }
+        },
+        "codersdk.ScheduleExceptionDateRange": {
+            "type": "object",
+            "properties": {
+                "end_date": {
+                    "description": "EndDate is inclusive. It defaults to StartDate.",
+                    "type": "string"
+                },
+                "start_date": {
+                    "type": "string"
+                },
+                "summary": {
+                    "type": "string"
+                }
+            }
+        },
         "codersdk.SecretsFileFormat": {
             "type": "string",
             "enum": [
@@ -25443,6 +27332,10 @@ const docTemplate = `{
                 "display_name": {
                     "type": "string"
                 },
+                "drift_check_interval_ms": {
+                    "description": "DriftCheckIntervalMillis is how often running workspaces are checked\nfor resources that changed outside of Coder. Zero disables drift\ndetection for the template.",
+                    "type": "integer"
+                },
                 "failure_ttl_ms": {
                     "description": "FailureTTLMillis, TimeTilDormantMillis, and TimeTilDormantAutoDeleteMillis are enterprise-only. Their\nvalues are used if your license is entitled to use the advanced\ntemplate scheduling feature.",
                     "type": "integer"
@@ -26278,6 +28171,68 @@ const docTemplate = `{
                 }
             }
         },
+        "codersdk.TemplateVersionDiff": {
+            "type": "object",
+            "properties": {
+                "base_template_version_id": {
+                    "type": "string",
+                    "format": "uuid"
+                },
+                "external_auth": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/codersdk.TemplateVersionExternalAuthDiff"
+                    }
+                },
+                "files": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/codersdk.TemplateVersionFileDiff"
+                    }
+                },
+                "presets": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/codersdk.TemplateVersionPresetDiff"
+                    }
+                },
+                "rich_parameters": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/codersdk.TemplateVersionParameterDiff"
+                    }
+                },
+                "template_version_id": {
+                    "type": "string",
+                    "format": "uuid"
+                },
+                "variables": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/codersdk.TemplateVersionVariableDiff"
+                    }
+                },
+                "workspace_tags": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/codersdk.TemplateVersionWorkspaceTagDiff"
+                    }
+                }
+            }
+        },
+        "codersdk.TemplateVersionDiffChange": {
+            "type": "string",
+            "enum": [
+                "added",
+                "removed",
+                "changed"
+            ],
+            "x-enum-varnames": [
+                "TemplateVersionDiffChangeAdded",
+                "TemplateVersionDiffChangeRemoved",
+                "TemplateVersionDiffChangeChanged"
+            ]
+        },
         "codersdk.TemplateVersionExternalAuth": {
             "type": "object",
             "properties": {
@@ -26304,6 +28259,61 @@ const docTemplate = `{
                 }
             }
         },
+        "codersdk.TemplateVersionExternalAuthDiff": {
+            "type": "object",
+            "properties": {
+                "change": {
+                    "enum": [
+                        "added",
+                        "removed",
+                        "changed"
+                    ],
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/codersdk.TemplateVersionDiffChange"
+                        }
+                    ]
+                },
+                "id": {
+                    "type": "string"
+                },
+                "new_optional": {
+                    "type": "boolean"
+                },
+                "old_optional": {
+                    "description": "OldOptional and NewOptional report whether the provider is optional in\nthe base and compared version. They are nil for the version that does\nnot require the provider.",
+                    "type": "boolean"
+                }
+            }
+        },
+        "codersdk.TemplateVersionFileDiff": {
+            "type": "object",
+            "properties": {
+                "binary": {
+                    "description": "Binary is true if either version of the file is not valid UTF-8 text.\nNo diff is computed for binary files.",
+                    "type": "boolean"
+                },
+                "change": {
+                    "enum": [
+                        "added",
+                        "removed",
+                        "changed"
+                    ],
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/codersdk.TemplateVersionDiffChange"
+                        }
+                    ]
+                },
+                "diff": {
+                    "description": "Diff is the unified diff of the file contents.",
+                    "type": "string"
+                },
+                "path": {
+                    "type": "string"
+                }
+            }
+        },
         "codersdk.TemplateVersionParameter": {
             "type": "object",
             "properties": {
@@ -26357,57 +28367,251 @@ const docTemplate = `{
                 "required": {
                     "type": "boolean"
                 },
-                "type": {
+                "type": {
+                    "type": "string",
+                    "enum": [
+                        "string",
+                        "number",
+                        "bool",
+                        "list(string)"
+                    ]
+                },
+                "validation_error": {
+                    "type": "string"
+                },
+                "validation_max": {
+                    "type": "integer"
+                },
+                "validation_min": {
+                    "type": "integer"
+                },
+                "validation_monotonic": {
+                    "enum": [
+                        "increasing",
+                        "decreasing"
+                    ],
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/codersdk.ValidationMonotonicOrder"
+                        }
+                    ]
+                },
+                "validation_regex": {
+                    "type": "string"
+                }
+            }
+        },
+        "codersdk.TemplateVersionParameterDiff": {
+            "type": "object",
+            "properties": {
+                "change": {
+                    "enum": [
+                        "added",
+                        "removed",
+                        "changed"
+                    ],
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/codersdk.TemplateVersionDiffChange"
+                        }
+                    ]
+                },
+                "name": {
+                    "type": "string"
+                },
+                "new": {
+                    "$ref": "#/definitions/codersdk.TemplateVersionParameter"
+                },
+                "old": {
+                    "$ref": "#/definitions/codersdk.TemplateVersionParameter"
+                }
+            }
+        },
+        "codersdk.TemplateVersionParameterOption": {
+            "type": "object",
+            "properties": {
+                "description": {
+                    "type": "string"
+                },
+                "icon": {
+                    "type": "string"
+                },
+                "name": {
+                    "type": "string"
+                },
+                "value": {
+                    "type": "string"
+                }
+            }
+        },
+        "codersdk.TemplateVersionPresetDiff": {
+            "type": "object",
+            "properties": {
+                "change": {
+                    "enum": [
+                        "added",
+                        "removed",
+                        "changed"
+                    ],
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/codersdk.TemplateVersionDiffChange"
+                        }
+                    ]
+                },
+                "name": {
+                    "type": "string"
+                },
+                "new": {
+                    "$ref": "#/definitions/codersdk.Preset"
+                },
+                "old": {
+                    "$ref": "#/definitions/codersdk.Preset"
+                }
+            }
+        },
+        "codersdk.TemplateVersionRollout": {
+            "type": "object",
+            "properties": {
+                "created_at": {
+                    "type": "string",
+                    "format": "date-time"
+                },
+                "created_by": {
+                    "type": "string",
+                    "format": "uuid"
+                },
+                "failure_action": {
+                    "enum": [
+                        "pause",
+                        "rollback"
+                    ],
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/codersdk.TemplateVersionRolloutFailureAction"
+                        }
+                    ]
+                },
+                "failure_threshold": {
+                    "description": "FailureThreshold is the ratio of failed builds or agents, between 0 and\n1, above which the failure action is taken.",
+                    "type": "number"
+                },
+                "finished_at": {
+                    "type": "string",
+                    "format": "date-time"
+                },
+                "group_ids": {
+                    "description": "GroupIDs are the groups whose members' workspaces are updated to the\nrollout version, regardless of the percentage.",
+                    "type": "array",
+                    "items": {
+                        "type": "string",
+                        "format": "uuid"
+                    }
+                },
+                "id": {
                     "type": "string",
-                    "enum": [
-                        "string",
-                        "number",
-                        "bool",
-                        "list(string)"
-                    ]
-                },
-                "validation_error": {
-                    "type": "string"
+                    "format": "uuid"
                 },
-                "validation_max": {
+                "min_samples": {
+                    "description": "MinSamples is the minimum number of finished builds or agents before\nthe failure ratio is evaluated.",
                     "type": "integer"
                 },
-                "validation_min": {
+                "percentage": {
+                    "description": "Percentage is the percentage of workspaces that are updated to the\nrollout version.",
                     "type": "integer"
                 },
-                "validation_monotonic": {
+                "previous_template_version_id": {
+                    "type": "string",
+                    "format": "uuid"
+                },
+                "progress": {
+                    "$ref": "#/definitions/codersdk.TemplateVersionRolloutProgress"
+                },
+                "started_at": {
+                    "description": "StartedAt is the time since which builds and agents are counted\ntowards the progress. It is reset when a paused rollout is resumed.",
+                    "type": "string",
+                    "format": "date-time"
+                },
+                "status": {
                     "enum": [
-                        "increasing",
-                        "decreasing"
+                        "in_progress",
+                        "paused",
+                        "completed",
+                        "rolled_back",
+                        "canceled"
                     ],
                     "allOf": [
                         {
-                            "$ref": "#/definitions/codersdk.ValidationMonotonicOrder"
+                            "$ref": "#/definitions/codersdk.TemplateVersionRolloutStatus"
                         }
                     ]
                 },
-                "validation_regex": {
+                "status_message": {
+                    "description": "StatusMessage explains why the rollout was paused or rolled back\nautomatically.",
+                    "type": "string"
+                },
+                "template_id": {
+                    "type": "string",
+                    "format": "uuid"
+                },
+                "template_version_id": {
+                    "type": "string",
+                    "format": "uuid"
+                },
+                "template_version_name": {
                     "type": "string"
+                },
+                "updated_at": {
+                    "type": "string",
+                    "format": "date-time"
                 }
             }
         },
-        "codersdk.TemplateVersionParameterOption": {
+        "codersdk.TemplateVersionRolloutFailureAction": {
+            "type": "string",
+            "enum": [
+                "pause",
+                "rollback"
+            ],
+            "x-enum-varnames": [
+                "TemplateVersionRolloutFailureActionPause",
+                "TemplateVersionRolloutFailureActionRollback"
+            ]
+        },
+        "codersdk.TemplateVersionRolloutProgress": {
             "type": "object",
             "properties": {
-                "description": {
-                    "type": "string"
+                "agents_failed": {
+                    "type": "integer"
                 },
-                "icon": {
-                    "type": "string"
+                "agents_ready": {
+                    "type": "integer"
                 },
-                "name": {
-                    "type": "string"
+                "builds_failed": {
+                    "type": "integer"
                 },
-                "value": {
-                    "type": "string"
+                "builds_succeeded": {
+                    "type": "integer"
                 }
             }
         },
+        "codersdk.TemplateVersionRolloutStatus": {
+            "type": "string",
+            "enum": [
+                "in_progress",
+                "paused",
+                "completed",
+                "rolled_back",
+                "canceled"
+            ],
+            "x-enum-varnames": [
+                "TemplateVersionRolloutStatusInProgress",
+                "TemplateVersionRolloutStatusPaused",
+                "TemplateVersionRolloutStatusCompleted",
+                "TemplateVersionRolloutStatusRolledBack",
+                "TemplateVersionRolloutStatusCanceled"
+            ]
+        },
         "codersdk.TemplateVersionVariable": {
             "type": "object",
             "properties": {
@@ -26439,6 +28643,32 @@ const docTemplate = `{
                 }
             }
         },
+        "codersdk.TemplateVersionVariableDiff": {
+            "type": "object",
+            "properties": {
+                "change": {
+                    "enum": [
+                        "added",
+                        "removed",
+                        "changed"
+                    ],
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/codersdk.TemplateVersionDiffChange"
+                        }
+                    ]
+                },
+                "name": {
+                    "type": "string"
+                },
+                "new": {
+                    "$ref": "#/definitions/codersdk.TemplateVersionVariable"
+                },
+                "old": {
+                    "$ref": "#/definitions/codersdk.TemplateVersionVariable"
+                }
+            }
+        },
         "codersdk.TemplateVersionWarning": {
             "type": "string",
             "enum": [
@@ -26448,6 +28678,32 @@ const docTemplate = `{
                 "TemplateVersionWarningUnsupportedWorkspaces"
             ]
         },
+        "codersdk.TemplateVersionWorkspaceTagDiff": {
+            "type": "object",
+            "properties": {
+                "change": {
+                    "enum": [
+                        "added",
+                        "removed",
+                        "changed"
+                    ],
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/codersdk.TemplateVersionDiffChange"
+                        }
+                    ]
+                },
+                "key": {
+                    "type": "string"
+                },
+                "new_value": {
+                    "type": "string"
+                },
+                "old_value": {
+                    "type": "string"
+                }
+            }
+        },
         "codersdk.TerminalFontName": {
             "type": "string",
             "enum": [
@@ -26836,6 +29092,20 @@ const docTemplate = `{
                 }
             }
         },
+        "codersdk.UpdateProvisionerJobPriorityRequest": {
+            "type": "object",
+            "properties": {
+                "priority": {
+                    "type": "integer",
+                    "maximum": 100,
+                    "minimum": -100
+                },
+                "template_id": {
+                    "type": "string",
+                    "format": "uuid"
+                }
+            }
+        },
         "codersdk.UpdateRoles": {
             "type": "object",
             "properties": {
@@ -26847,6 +29117,29 @@ const docTemplate = `{
                 }
             }
         },
+        "codersdk.UpdateScheduleExceptionCalendarRequest": {
+            "type": "object",
+            "required": [
+                "name"
+            ],
+            "properties": {
+                "dates": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/codersdk.ScheduleExceptionDateRange"
+                    }
+                },
+                "description": {
+                    "type": "string"
+                },
+                "ical": {
+                    "type": "string"
+                },
+                "name": {
+                    "type": "string"
+                }
+            }
+        },
         "codersdk.UpdateTemplateACL": {
             "type": "object",
             "properties": {
@@ -26929,6 +29222,10 @@ const docTemplate = `{
                 "display_name": {
                     "type": "string"
                 },
+                "drift_check_interval_ms": {
+                    "description": "DriftCheckIntervalMillis allows optionally specifying how often running\nworkspaces are checked for resources that changed outside of Coder.\nSet to 0 to disable drift detection. Omitting the field keeps the\nexisting value.",
+                    "type": "integer"
+                },
                 "failure_ttl_ms": {
                     "type": "integer"
                 },
@@ -26969,6 +29266,61 @@ const docTemplate = `{
                 }
             }
         },
+        "codersdk.UpdateTemplateScheduleExceptionCalendarsRequest": {
+            "type": "object",
+            "properties": {
+                "calendar_ids": {
+                    "type": "array",
+                    "items": {
+                        "type": "string",
+                        "format": "uuid"
+                    }
+                }
+            }
+        },
+        "codersdk.UpdateTemplateVersionRolloutRequest": {
+            "type": "object",
+            "properties": {
+                "failure_action": {
+                    "enum": [
+                        "pause",
+                        "rollback"
+                    ],
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/codersdk.TemplateVersionRolloutFailureAction"
+                        }
+                    ]
+                },
+                "failure_threshold": {
+                    "type": "number"
+                },
+                "group_ids": {
+                    "type": "array",
+                    "items": {
+                        "type": "string",
+                        "format": "uuid"
+                    }
+                },
+                "min_samples": {
+                    "type": "integer"
+                },
+                "percentage": {
+                    "type": "integer"
+                },
+                "status": {
+                    "enum": [
+                        "in_progress",
+                        "paused"
+                    ],
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/codersdk.TemplateVersionRolloutStatus"
+                        }
+                    ]
+                }
+            }
+        },
         "codersdk.UpdateUserAppearanceSettingsRequest": {
             "type": "object",
             "required": [
@@ -27956,9 +30308,22 @@ const docTemplate = `{
                 "latest_build": {
                     "$ref": "#/definitions/codersdk.WorkspaceBuild"
                 },
+                "latest_drift_check": {
+                    "description": "LatestDriftCheck is the most recent completed drift check of the\nworkspace's latest build. It is nil if the build was never checked.",
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/codersdk.WorkspaceDriftCheck"
+                        }
+                    ]
+                },
                 "name": {
                     "type": "string"
                 },
+                "next_skipped_autostart": {
+                    "description": "NextSkippedAutostart is the next scheduled autostart within a year that\nwill be skipped because it falls on an exception date.",
+                    "type": "string",
+                    "format": "date-time"
+                },
                 "next_start_at": {
                     "type": "string",
                     "format": "date-time"
@@ -28215,6 +30580,10 @@ const docTemplate = `{
                     "type": "string",
                     "format": "date-time"
                 },
+                "health": {
+                    "description": "Health is the status of the container healthcheck, e.g. \"starting\",\n\"healthy\" or \"unhealthy\". It is empty if the container has no\nhealthcheck.",
+                    "type": "string"
+                },
                 "id": {
                     "description": "ID is the unique identifier of the container.",
                     "type": "string"
@@ -28285,6 +30654,14 @@ const docTemplate = `{
                 "agent": {
                     "$ref": "#/definitions/codersdk.WorkspaceAgentDevcontainerAgent"
                 },
+                "compose": {
+                    "description": "Compose is set for devcontainers defined with a Docker Compose\nfile, and describes the other services of the Compose project.",
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/codersdk.WorkspaceAgentDevcontainerCompose"
+                        }
+                    ]
+                },
                 "config_path": {
                     "type": "string"
                 },
@@ -28340,6 +30717,26 @@ const docTemplate = `{
                 }
             }
         },
+        "codersdk.WorkspaceAgentDevcontainerCompose": {
+            "type": "object",
+            "properties": {
+                "project": {
+                    "description": "Project is the name of the Compose project.",
+                    "type": "string"
+                },
+                "service": {
+                    "description": "Service is the name of the primary service, the one the\ndevcontainer agent runs in.",
+                    "type": "string"
+                },
+                "sidecars": {
+                    "description": "Sidecars are the containers of the other services in the project.",
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/codersdk.WorkspaceAgentContainer"
+                    }
+                }
+            }
+        },
         "codersdk.WorkspaceAgentDevcontainerStatus": {
             "type": "string",
             "enum": [
@@ -28568,14 +30965,90 @@ const docTemplate = `{
                     "description": "Age is the number of seconds since the metadata was collected.\nIt is provided in addition to CollectedAt to protect against clock skew.",
                     "type": "integer"
                 },
-                "collected_at": {
+                "collected_at": {
+                    "type": "string",
+                    "format": "date-time"
+                },
+                "error": {
+                    "type": "string"
+                },
+                "value": {
+                    "type": "string"
+                }
+            }
+        },
+        "codersdk.WorkspaceAgentPTYShare": {
+            "type": "object",
+            "properties": {
+                "agent_id": {
+                    "type": "string",
+                    "format": "uuid"
+                },
+                "created_at": {
+                    "type": "string",
+                    "format": "date-time"
+                },
+                "created_by": {
+                    "type": "string",
+                    "format": "uuid"
+                },
+                "expires_at": {
+                    "type": "string",
+                    "format": "date-time"
+                },
+                "id": {
+                    "type": "string",
+                    "format": "uuid"
+                },
+                "reconnect_id": {
+                    "type": "string",
+                    "format": "uuid"
+                },
+                "revoked_at": {
+                    "type": "string",
+                    "format": "date-time"
+                },
+                "user_ids": {
+                    "type": "array",
+                    "items": {
+                        "type": "string",
+                        "format": "uuid"
+                    }
+                },
+                "viewers": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/codersdk.WorkspaceAgentPTYShareViewer"
+                    }
+                }
+            }
+        },
+        "codersdk.WorkspaceAgentPTYShareViewer": {
+            "type": "object",
+            "properties": {
+                "avatar_url": {
+                    "type": "string"
+                },
+                "connected_at": {
                     "type": "string",
                     "format": "date-time"
                 },
-                "error": {
+                "disconnected_at": {
+                    "type": "string",
+                    "format": "date-time"
+                },
+                "id": {
+                    "type": "string",
+                    "format": "uuid"
+                },
+                "ip": {
                     "type": "string"
                 },
-                "value": {
+                "user_id": {
+                    "type": "string",
+                    "format": "uuid"
+                },
+                "username": {
                     "type": "string"
                 }
             }
@@ -28943,6 +31416,41 @@ const docTemplate = `{
                 "WorkspaceAppStatusStateFailure"
             ]
         },
+        "codersdk.WorkspaceAutostartException": {
+            "type": "object",
+            "properties": {
+                "end_date": {
+                    "type": "string"
+                },
+                "source": {
+                    "description": "Source is the name of the exception calendar, or \"out-of-office\" for\nthe workspace owner's own ranges.",
+                    "type": "string"
+                },
+                "start_date": {
+                    "type": "string"
+                },
+                "summary": {
+                    "type": "string"
+                }
+            }
+        },
+        "codersdk.WorkspaceAutostartExceptions": {
+            "type": "object",
+            "properties": {
+                "exceptions": {
+                    "description": "Exceptions that have not ended, soonest first.",
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/codersdk.WorkspaceAutostartException"
+                    }
+                },
+                "next_skipped_autostart": {
+                    "description": "NextSkippedAutostart is the next scheduled autostart within a year that\nwill be skipped because it falls on an exception date.",
+                    "type": "string",
+                    "format": "date-time"
+                }
+            }
+        },
         "codersdk.WorkspaceBuild": {
             "type": "object",
             "properties": {
@@ -29107,6 +31615,200 @@ const docTemplate = `{
                 }
             }
         },
+        "codersdk.WorkspaceBulkOperation": {
+            "type": "object",
+            "properties": {
+                "action": {
+                    "enum": [
+                        "start",
+                        "stop",
+                        "update",
+                        "delete",
+                        "autostart",
+                        "ttl",
+                        "automatic_updates"
+                    ],
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/codersdk.WorkspaceBulkOperationAction"
+                        }
+                    ]
+                },
+                "automatic_updates": {
+                    "enum": [
+                        "always",
+                        "never"
+                    ],
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/codersdk.AutomaticUpdates"
+                        }
+                    ]
+                },
+                "autostart_schedule": {
+                    "type": "string"
+                },
+                "completed_at": {
+                    "type": "string",
+                    "format": "date-time"
+                },
+                "concurrency": {
+                    "type": "integer"
+                },
+                "created_at": {
+                    "type": "string",
+                    "format": "date-time"
+                },
+                "id": {
+                    "type": "string",
+                    "format": "uuid"
+                },
+                "initiator_id": {
+                    "type": "string",
+                    "format": "uuid"
+                },
+                "progress": {
+                    "$ref": "#/definitions/codersdk.WorkspaceBulkOperationProgress"
+                },
+                "query": {
+                    "type": "string"
+                },
+                "status": {
+                    "enum": [
+                        "running",
+                        "completed",
+                        "canceled"
+                    ],
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/codersdk.WorkspaceBulkOperationStatus"
+                        }
+                    ]
+                },
+                "ttl_ms": {
+                    "type": "integer"
+                },
+                "updated_at": {
+                    "type": "string",
+                    "format": "date-time"
+                }
+            }
+        },
+        "codersdk.WorkspaceBulkOperationAction": {
+            "type": "string",
+            "enum": [
+                "start",
+                "stop",
+                "update",
+                "delete",
+                "autostart",
+                "ttl",
+                "automatic_updates"
+            ],
+            "x-enum-varnames": [
+                "WorkspaceBulkOperationActionStart",
+                "WorkspaceBulkOperationActionStop",
+                "WorkspaceBulkOperationActionUpdate",
+                "WorkspaceBulkOperationActionDelete",
+                "WorkspaceBulkOperationActionAutostart",
+                "WorkspaceBulkOperationActionTTL",
+                "WorkspaceBulkOperationActionAutomaticUpdates"
+            ]
+        },
+        "codersdk.WorkspaceBulkOperationProgress": {
+            "type": "object",
+            "properties": {
+                "failed": {
+                    "type": "integer"
+                },
+                "pending": {
+                    "type": "integer"
+                },
+                "running": {
+                    "type": "integer"
+                },
+                "skipped": {
+                    "type": "integer"
+                },
+                "succeeded": {
+                    "type": "integer"
+                },
+                "total": {
+                    "type": "integer"
+                }
+            }
+        },
+        "codersdk.WorkspaceBulkOperationStatus": {
+            "type": "string",
+            "enum": [
+                "running",
+                "completed",
+                "canceled"
+            ],
+            "x-enum-varnames": [
+                "WorkspaceBulkOperationStatusRunning",
+                "WorkspaceBulkOperationStatusCompleted",
+                "WorkspaceBulkOperationStatusCanceled"
+            ]
+        },
+        "codersdk.WorkspaceBulkOperationWorkspace": {
+            "type": "object",
+            "properties": {
+                "build_id": {
+                    "description": "BuildID is the build created for the workspace by the start, stop,\nupdate and delete actions.",
+                    "type": "string",
+                    "format": "uuid"
+                },
+                "error": {
+                    "type": "string"
+                },
+                "status": {
+                    "enum": [
+                        "pending",
+                        "running",
+                        "succeeded",
+                        "failed",
+                        "skipped"
+                    ],
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/codersdk.WorkspaceBulkOperationWorkspaceStatus"
+                        }
+                    ]
+                },
+                "updated_at": {
+                    "type": "string",
+                    "format": "date-time"
+                },
+                "workspace_id": {
+                    "type": "string",
+                    "format": "uuid"
+                },
+                "workspace_name": {
+                    "type": "string"
+                },
+                "workspace_owner_name": {
+                    "type": "string"
+                }
+            }
+        },
+        "codersdk.WorkspaceBulkOperationWorkspaceStatus": {
+            "type": "string",
+            "enum": [
+                "pending",
+                "running",
+                "succeeded",
+                "failed",
+                "skipped"
+            ],
+            "x-enum-varnames": [
+                "WorkspaceBulkOperationWorkspaceStatusPending",
+                "WorkspaceBulkOperationWorkspaceStatusRunning",
+                "WorkspaceBulkOperationWorkspaceStatusSucceeded",
+                "WorkspaceBulkOperationWorkspaceStatusFailed",
+                "WorkspaceBulkOperationWorkspaceStatusSkipped"
+            ]
+        },
         "codersdk.WorkspaceConnectionLatencyMS": {
             "type": "object",
             "properties": {
@@ -29149,6 +31851,36 @@ const docTemplate = `{
                 }
             }
         },
+        "codersdk.WorkspaceDriftCheck": {
+            "type": "object",
+            "properties": {
+                "checked_at": {
+                    "type": "string",
+                    "format": "date-time"
+                },
+                "drifted": {
+                    "type": "boolean"
+                },
+                "id": {
+                    "type": "string",
+                    "format": "uuid"
+                },
+                "job_id": {
+                    "type": "string",
+                    "format": "uuid"
+                },
+                "resources": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/codersdk.WorkspaceResourceDrift"
+                    }
+                },
+                "workspace_build_id": {
+                    "type": "string",
+                    "format": "uuid"
+                }
+            }
+        },
         "codersdk.WorkspaceGroup": {
             "type": "object",
             "properties": {
@@ -29367,6 +32099,31 @@ const docTemplate = `{
                 }
             }
         },
+        "codersdk.WorkspaceResourceDrift": {
+            "type": "object",
+            "properties": {
+                "action": {
+                    "description": "Action is \"update\" for resources that were modified and \"delete\" for\nresources that no longer exist.",
+                    "type": "string"
+                },
+                "address": {
+                    "type": "string"
+                },
+                "attributes": {
+                    "description": "Attributes are the names of the top-level attributes that changed.",
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
+                },
+                "name": {
+                    "type": "string"
+                },
+                "type": {
+                    "type": "string"
+                }
+            }
+        },
         "codersdk.WorkspaceResourceMetadata": {
             "type": "object",
             "properties": {
@@ -30403,6 +33160,17 @@ const docTemplate = `{
                 }
             }
         },
+        "serpent.Struct-array_codersdk_OIDCProviderConfig": {
+            "type": "object",
+            "properties": {
+                "value": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/codersdk.OIDCProviderConfig"
+                    }
+                }
+            }
+        },
         "serpent.URL": {
             "type": "object",
             "properties": {
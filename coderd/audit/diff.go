@@ -44,7 +44,8 @@ type Auditable interface {
 		database.AuditableUserAIBudgetOverride |
 		database.UserSecret |
 		database.UserSkill |
-		database.ChatInstructionSettings
+		database.ChatInstructionSettings |
+		database.WorkspaceBulkOperation
 }
 
 // Map is a map of changed fields in an audited resource. It maps field names to
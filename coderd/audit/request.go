@@ -165,6 +165,8 @@ func ResourceTarget[T Auditable](tgt T) string {
 		return typed.Name
 	case database.ChatInstructionSettings:
 		return typed.Name
+	case database.WorkspaceBulkOperation:
+		return fmt.Sprintf("%s %q", typed.Action, typed.Query)
 	default:
 		panic(fmt.Sprintf("unknown resource %T for ResourceTarget", tgt))
 	}
@@ -271,6 +273,8 @@ func ResourceID[T Auditable](tgt T) uuid.UUID {
 	case database.ChatInstructionSettings:
 		// Fixed ID per setting; see ChatInstructionSettings IDs.
 		return typed.ID
+	case database.WorkspaceBulkOperation:
+		return typed.ID
 	default:
 		panic(fmt.Sprintf("unknown resource %T for ResourceID", tgt))
 	}
@@ -350,6 +354,8 @@ func ResourceType[T Auditable](tgt T) database.ResourceType {
 		return database.ResourceTypeUserSkill
 	case database.ChatInstructionSettings:
 		return database.ResourceTypeChatInstructionSettings
+	case database.WorkspaceBulkOperation:
+		return database.ResourceTypeWorkspaceBulkOperation
 	default:
 		panic(fmt.Sprintf("unknown resource %T for ResourceType", typed))
 	}
@@ -446,6 +452,9 @@ func ResourceRequiresOrgID[T Auditable]() bool {
 	case database.ChatInstructionSettings:
 		// Deployment settings, not scoped to any organization.
 		return false
+	case database.WorkspaceBulkOperation:
+		// Bulk operations can span workspaces of several organizations.
+		return false
 	default:
 		panic(fmt.Sprintf("unknown resource %T for ResourceRequiresOrgID", tgt))
 	}
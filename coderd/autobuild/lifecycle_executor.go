@@ -30,6 +30,7 @@ import (
 	"github.com/coder/coder/v2/coderd/pproflabel"
 	"github.com/coder/coder/v2/coderd/provisionerdserver"
 	"github.com/coder/coder/v2/coderd/schedule"
+	"github.com/coder/coder/v2/coderd/templaterollout"
 	"github.com/coder/coder/v2/coderd/wsbuilder"
 	"github.com/coder/coder/v2/codersdk"
 )
@@ -281,9 +282,14 @@ func (e *Executor) runOnce(t time.Time) Stats {
 						return xerrors.Errorf("get template scheduling options: %w", err)
 					}
 
+					exceptions, err := schedule.GetAutostartExceptions(e.ctx, tx, ws.ID, latestBuild.CreatedAt)
+					if err != nil {
+						return err
+					}
+
 					// If next start at is not valid we need to re-compute it
 					if !ws.NextStartAt.Valid && ws.AutostartSchedule.Valid {
-						next, err := schedule.NextAllowedAutostart(currentTick, ws.AutostartSchedule.String, templateSchedule)
+						next, err := schedule.NextAllowedAutostartWithExceptions(currentTick, ws.AutostartSchedule.String, templateSchedule, exceptions)
 						if err == nil {
 							nextStartAt := sql.NullTime{Valid: true, Time: dbtime.Time(next.UTC())}
 							if err = tx.UpdateWorkspaceNextStartAt(e.ctx, database.UpdateWorkspaceNextStartAtParams{
@@ -303,14 +309,24 @@ func (e *Executor) runOnce(t time.Time) Stats {
 						return xerrors.Errorf("get template by ID: %w", err)
 					}
 
-					activeTemplateVersion, err = tx.GetTemplateVersionByID(e.ctx, tmpl.ActiveVersionID)
+					accessControl := (*(e.accessControlStore.Load())).GetTemplateAccessControl(tmpl)
+
+					// Workspaces that follow the active version may be part
+					// of a rollout of a newer version of the template.
+					activeVersionID := tmpl.ActiveVersionID
+					if useActiveVersion(accessControl, ws) {
+						activeVersionID, err = templaterollout.VersionForWorkspace(e.ctx, tx, tmpl, ws, latestBuild.TemplateVersionID)
+						if err != nil {
+							return xerrors.Errorf("get rollout version: %w", err)
+						}
+					}
+
+					activeTemplateVersion, err = tx.GetTemplateVersionByID(e.ctx, activeVersionID)
 					if err != nil {
 						return xerrors.Errorf("get active template version by ID: %w", err)
 					}
 
-					accessControl := (*(e.accessControlStore.Load())).GetTemplateAccessControl(tmpl)
-
-					nextTransition, reason, err := getNextTransition(user, ws, latestBuild, latestJob, templateSchedule, currentTick)
+					nextTransition, reason, err := getNextTransition(user, ws, latestBuild, latestJob, templateSchedule, exceptions, currentTick)
 					if err != nil {
 						return xerrors.Errorf("get next transition: %w", err)
 					}
@@ -320,6 +336,24 @@ func (e *Executor) runOnce(t time.Time) Stats {
 					// hold to stamp the marker.
 					if reason == "" {
 						log.Debug(e.ctx, "skipping workspace, no transition due")
+						// Move next_start_at past a skipped autostart, so the
+						// workspace is not reconsidered on every tick until the
+						// exception is over.
+						if exception, ok := skippedAutostart(ws, latestBuild, templateSchedule, exceptions, currentTick); ok {
+							log.Info(e.ctx, "skipping autostart on exception date",
+								slog.F("exception_source", exception.Source),
+								slog.F("exception_summary", exception.Summary),
+							)
+							next, err := schedule.NextAllowedAutostartWithExceptions(currentTick, ws.AutostartSchedule.String, templateSchedule, exceptions)
+							if err == nil {
+								if err := tx.UpdateWorkspaceNextStartAt(e.ctx, database.UpdateWorkspaceNextStartAtParams{
+									ID:          wsID,
+									NextStartAt: sql.NullTime{Valid: true, Time: dbtime.Time(next.UTC())},
+								}); err != nil {
+									return xerrors.Errorf("update workspace next start at: %w", err)
+								}
+							}
+						}
 						// A deadline change (e.g. activity bump) re-arms the reminder; users near
 						// the boundary may receive one reminder per bump. Intentional: one-per-build
 						// would leave stale reminders after a bump.
@@ -371,7 +405,7 @@ func (e *Executor) runOnce(t time.Time) Stats {
 							log.Debug(e.ctx, "autostarting with active version")
 							builder = builder.ActiveVersion()
 
-							if latestBuild.TemplateVersionID != tmpl.ActiveVersionID {
+							if latestBuild.TemplateVersionID != activeTemplateVersion.ID {
 								// control flag to know if the workspace was auto-updated,
 								// so the lifecycle executor can notify the user
 								didAutoUpdate = true
@@ -675,6 +709,7 @@ func getNextTransition(
 	latestBuild database.WorkspaceBuild,
 	latestJob database.ProvisionerJob,
 	templateSchedule schedule.TemplateScheduleOptions,
+	exceptions schedule.AutostartExceptions,
 	currentTick time.Time,
 ) (
 	database.WorkspaceTransition,
@@ -688,7 +723,7 @@ func getNextTransition(
 			return database.WorkspaceTransitionStop, database.BuildReasonTaskAutoPause, nil
 		}
 		return database.WorkspaceTransitionStop, database.BuildReasonAutostop, nil
-	case isEligibleForAutostart(user, ws, latestBuild, latestJob, templateSchedule, currentTick):
+	case isEligibleForAutostart(user, ws, latestBuild, latestJob, templateSchedule, exceptions, currentTick):
 		return database.WorkspaceTransitionStart, database.BuildReasonAutostart, nil
 	case isEligibleForFailedCleanup(latestBuild, latestJob, templateSchedule, currentTick):
 		// Use task-specific reason for AI task workspaces.
@@ -714,7 +749,7 @@ func getNextTransition(
 }
 
 // isEligibleForAutostart returns true if the workspace should be autostarted.
-func isEligibleForAutostart(user database.User, ws database.Workspace, build database.WorkspaceBuild, job database.ProvisionerJob, templateSchedule schedule.TemplateScheduleOptions, currentTick time.Time) bool {
+func isEligibleForAutostart(user database.User, ws database.Workspace, build database.WorkspaceBuild, job database.ProvisionerJob, templateSchedule schedule.TemplateScheduleOptions, exceptions schedule.AutostartExceptions, currentTick time.Time) bool {
 	// Don't attempt to autostart workspaces for suspended users.
 	if user.Status != database.UserStatusActive {
 		return false
@@ -743,8 +778,9 @@ func isEligibleForAutostart(user database.User, ws database.Workspace, build dat
 	}
 
 	// Get the next allowed autostart time after the build's creation time,
-	// based on the workspace's schedule and the template's allowed days.
-	nextTransition, err := schedule.NextAllowedAutostart(build.CreatedAt, ws.AutostartSchedule.String, templateSchedule)
+	// based on the workspace's schedule, the template's allowed days and
+	// any exception dates.
+	nextTransition, err := schedule.NextAllowedAutostartWithExceptions(build.CreatedAt, ws.AutostartSchedule.String, templateSchedule, exceptions)
 	if err != nil {
 		return false
 	}
@@ -753,6 +789,23 @@ func isEligibleForAutostart(user database.User, ws database.Workspace, build dat
 	return !currentTick.Before(nextTransition)
 }
 
+// skippedAutostart returns the exception that stopped a stopped workspace
+// from being autostarted, if its autostart is due but falls on an exception
+// date.
+func skippedAutostart(ws database.Workspace, build database.WorkspaceBuild, templateSchedule schedule.TemplateScheduleOptions, exceptions schedule.AutostartExceptions, currentTick time.Time) (schedule.AutostartException, bool) {
+	if len(exceptions) == 0 || build.Transition != database.WorkspaceTransitionStop || !ws.AutostartSchedule.Valid {
+		return schedule.AutostartException{}, false
+	}
+	if !templateSchedule.UserAutostartEnabled {
+		return schedule.AutostartException{}, false
+	}
+	next, err := schedule.NextAllowedAutostart(build.CreatedAt, ws.AutostartSchedule.String, templateSchedule)
+	if err != nil || currentTick.Before(next) {
+		return schedule.AutostartException{}, false
+	}
+	return exceptions.Find(next)
+}
+
 // isEligibleForAutostop returns true if the workspace should be autostopped.
 func isEligibleForAutostop(user database.User, ws database.Workspace, build database.WorkspaceBuild, job database.ProvisionerJob, currentTick time.Time) bool {
 	if job.JobStatus == database.ProvisionerJobStatusFailed {
@@ -103,6 +103,7 @@ func Test_getNextTransition_TaskAutoPause(t *testing.T) {
 				tc.Build,
 				tc.Job,
 				tc.TemplateSchedule,
+				nil,
 				now,
 			)
 			require.NoError(t, err)
@@ -133,7 +134,7 @@ func Test_getNextTransition_NoAction(t *testing.T) {
 	}
 	templateSchedule := schedule.TemplateScheduleOptions{}
 
-	transition, reason, err := getNextTransition(user, ws, build, job, templateSchedule, now)
+	transition, reason, err := getNextTransition(user, ws, build, job, templateSchedule, nil, now)
 	require.NoError(t, err)
 	require.Equal(t, database.WorkspaceTransition(""), transition)
 	require.Equal(t, database.BuildReason(""), reason)
@@ -382,6 +383,7 @@ func Test_isEligibleForAutostart(t *testing.T) {
 		Build            database.WorkspaceBuild
 		Job              database.ProvisionerJob
 		TemplateSchedule schedule.TemplateScheduleOptions
+		Exceptions       schedule.AutostartExceptions
 		Tick             time.Time
 
 		ExpectedResponse bool
@@ -468,13 +470,43 @@ func Test_isEligibleForAutostart(t *testing.T) {
 			Tick:             okTick,
 			ExpectedResponse: false,
 		},
+		{
+			Name:             "ExceptionDate",
+			User:             okUser,
+			Workspace:        okWorkspace,
+			Build:            okBuild,
+			Job:              okJob,
+			TemplateSchedule: okTemplateSchedule,
+			Exceptions: schedule.AutostartExceptions{{
+				StartDate: time.Date(2021, 1, 1, 0, 0, 0, 0, time.UTC),
+				EndDate:   time.Date(2021, 1, 1, 0, 0, 0, 0, time.UTC),
+			}},
+			Tick:             okTick,
+			ExpectedResponse: false,
+		},
+		{
+			// The autostart is on the 2nd in UTC, but the exception is
+			// compared against the date in the schedule's timezone.
+			Name:             "ExceptionDateInUTC",
+			User:             okUser,
+			Workspace:        okWorkspace,
+			Build:            okBuild,
+			Job:              okJob,
+			TemplateSchedule: okTemplateSchedule,
+			Exceptions: schedule.AutostartExceptions{{
+				StartDate: time.Date(2021, 1, 2, 0, 0, 0, 0, time.UTC),
+				EndDate:   time.Date(2021, 1, 2, 0, 0, 0, 0, time.UTC),
+			}},
+			Tick:             okTick,
+			ExpectedResponse: true,
+		},
 	}
 
 	for _, c := range testCases {
 		t.Run(c.Name, func(t *testing.T) {
 			t.Parallel()
 
-			autostart := isEligibleForAutostart(c.User, c.Workspace, c.Build, c.Job, c.TemplateSchedule, c.Tick)
+			autostart := isEligibleForAutostart(c.User, c.Workspace, c.Build, c.Job, c.TemplateSchedule, c.Exceptions, c.Tick)
 			require.Equal(t, c.ExpectedResponse, autostart, "autostart not expected")
 		})
 	}
@@ -80,6 +80,7 @@ import (
 	"github.com/coder/coder/v2/coderd/pproflabel"
 	"github.com/coder/coder/v2/coderd/prebuilds"
 	"github.com/coder/coder/v2/coderd/prometheusmetrics"
+	"github.com/coder/coder/v2/coderd/promoauth"
 	"github.com/coder/coder/v2/coderd/provisionerdserver"
 	"github.com/coder/coder/v2/coderd/proxyhealth"
 	"github.com/coder/coder/v2/coderd/rbac"
@@ -186,6 +187,7 @@ type Options struct {
 	GoogleTokenValidator           *idtoken.Validator
 	GithubOAuth2Config             *GithubOAuth2Config
 	OIDCConfig                     *OIDCConfig
+	OIDCProviders                  []*OIDCConfig
 	PrometheusRegistry             *prometheus.Registry
 	StrictTransportSecurityCfg     httpmw.HSTSConfig
 	SSHKeygenAlgorithm             gitsshkey.Algorithm
@@ -442,6 +444,11 @@ func New(options *Options) *API {
 	if options.IDPSync == nil {
 		options.IDPSync = idpsync.NewAGPLSync(options.Logger, options.RuntimeConfig, idpsync.FromDeploymentValues(options.DeploymentValues))
 	}
+	for _, provider := range options.OIDCProviders {
+		if provider.IDPSync == nil {
+			provider.IDPSync = idpsync.NewAGPLSync(options.Logger.With(slog.F("oidc_provider", provider.ID)), options.RuntimeConfig, provider.SyncSettings)
+		}
+	}
 
 	if options.AppHostname != "" && options.AppHostnameRegex == nil || options.AppHostname == "" && options.AppHostnameRegex != nil {
 		panic("coderd: both AppHostname and AppHostnameRegex must be set or unset")
@@ -548,8 +555,9 @@ func New(options *Options) *API {
 	)
 
 	oauthConfigs := &httpmw.OAuth2Configs{
-		Github: options.GithubOAuth2Config,
-		OIDC:   options.OIDCConfig,
+		Github:        options.GithubOAuth2Config,
+		OIDC:          options.OIDCConfig,
+		OIDCProviders: OIDCProviderOAuth2Configs(options.OIDCProviders),
 	}
 
 	if options.DatabaseRolluper == nil {
@@ -856,6 +864,14 @@ func New(options *Options) *API {
 		oidcRedirectAllowedHosts = options.OIDCConfig.RedirectAllowedHosts
 		oidcRedirectDefaultScheme = options.OIDCConfig.RedirectDefaultScheme
 	}
+	api.oidcProviderCallbacks = make(map[string]http.Handler, len(options.OIDCProviders))
+	for _, provider := range options.OIDCProviders {
+		api.oidcProviderCallbacks[provider.ID] = httpmw.ExtractOAuth2(provider, options.HTTPClient, options.DeploymentValues.HTTPCookies, provider.AuthURLParams, provider.PKCESupported(), nil, "")(
+			http.HandlerFunc(func(rw http.ResponseWriter, r *http.Request) {
+				api.handleOIDCCallback(rw, r, provider, provider.IDPSync)
+			}),
+		)
+	}
 
 	api.Auditor.Store(&options.Auditor)
 	api.ConnectionLogger.Store(&options.ConnectionLogger)
@@ -890,10 +906,17 @@ func New(options *Options) *API {
 		}
 
 		var oidcMCPSrc mcpclient.UserOIDCTokenSource
-		if options.OIDCConfig != nil {
+		if options.OIDCConfig != nil || len(options.OIDCProviders) > 0 {
+			// Avoid storing a typed nil in the interface when only named
+			// providers are configured.
+			var oidcConfig promoauth.OAuth2Config
+			if options.OIDCConfig != nil {
+				oidcConfig = options.OIDCConfig
+			}
 			oidcMCPSrc = newOIDCMCPTokenSource(
 				options.Database,
-				options.OIDCConfig,
+				oidcConfig,
+				OIDCProviderOAuth2Configs(options.OIDCProviders),
 				options.Logger.Named("mcp-user-oidc"),
 			)
 		}
@@ -1082,6 +1105,9 @@ func New(options *Options) *API {
 	})
 	api.workspaceBuildOrchestrator.Start(api.ctx)
 
+	api.workspaceBulkRunner = newWorkspaceBulkRunner(api)
+	api.workspaceBulkRunner.Start(api.ctx)
+
 	apiKeyMiddleware := httpmw.ExtractAPIKeyMW(httpmw.ExtractAPIKeyConfig{
 		DB:                            options.Database,
 		ActivateDormantUser:           ActivateDormantUser(options.Logger, &api.Auditor, options.Database),
@@ -1291,6 +1317,22 @@ func New(options *Options) *API {
 			r.Post("/", api.postOAuth2ProviderAppToken())
 		})
 
+		// RFC 8628 Device Authorization Grant
+		r.Route("/device", func(r chi.Router) {
+			// The verification page is a browser consent form, like
+			// /authorize, so it requires a logged-in user.
+			r.Group(func(r chi.Router) {
+				r.Use(apiKeyMiddlewareRedirect)
+				r.Get("/", api.getOAuth2DeviceVerification())
+				r.Post("/", api.postOAuth2DeviceVerification())
+			})
+			// The device authorization endpoint is called by the device
+			// itself, which has no session.
+			r.With(
+				httpmw.AsAuthzSystem(httpmw.ExtractOAuth2ProviderAppWithOAuth2Errors(options.Database)),
+			).Post("/code", api.postOAuth2DeviceAuthorization())
+		})
+
 		// RFC 7009 Token Revocation Endpoint
 		r.Route("/revoke", func(r chi.Router) {
 			r.Use(
@@ -1698,9 +1740,20 @@ func New(options *Options) *API {
 					r.Get("/", api.provisionerDaemons)
 				})
 				r.Route("/provisionerjobs", func(r chi.Router) {
+					r.Get("/priorities", api.provisionerJobPriorities)
+					r.Put("/priorities", api.putProvisionerJobPriority)
 					r.Get("/{job}", api.provisionerJob)
 					r.Get("/", api.provisionerJobs)
 				})
+				r.Route("/schedule-exception-calendars", func(r chi.Router) {
+					r.Get("/", api.scheduleExceptionCalendars)
+					r.Post("/", api.postScheduleExceptionCalendar)
+					r.Route("/{calendar}", func(r chi.Router) {
+						r.Get("/", api.scheduleExceptionCalendar)
+						r.Put("/", api.putScheduleExceptionCalendar)
+						r.Delete("/", api.deleteScheduleExceptionCalendar)
+					})
+				})
 			})
 		})
 		r.Route("/templates", func(r chi.Router) {
@@ -1723,6 +1776,17 @@ func New(options *Options) *API {
 					r.Patch("/", api.patchActiveTemplateVersion)
 					r.Get("/{templateversionname}", api.templateVersionByName)
 				})
+				r.Get("/schedule-exception-calendars", api.templateScheduleExceptionCalendars)
+				r.Put("/schedule-exception-calendars", api.putTemplateScheduleExceptionCalendars)
+				r.Route("/rollouts", func(r chi.Router) {
+					r.Get("/", api.templateVersionRollouts)
+					r.Post("/", api.postTemplateVersionRollout)
+					r.Route("/{rollout}", func(r chi.Router) {
+						r.Get("/", api.templateVersionRollout)
+						r.Patch("/", api.patchTemplateVersionRollout)
+						r.Post("/rollback", api.postTemplateVersionRolloutRollback)
+					})
+				})
 			})
 		})
 
@@ -1744,6 +1808,7 @@ func New(options *Options) *API {
 			r.Get("/external-auth", api.templateVersionExternalAuth)
 			r.Get("/variables", api.templateVersionVariables)
 			r.Get("/presets", api.templateVersionPresets)
+			r.Get("/diff", api.templateVersionDiff)
 			r.Get("/resources", api.templateVersionResources)
 			r.Get("/logs", api.templateVersionLogs)
 			r.Route("/dry-run", func(r chi.Router) {
@@ -1807,6 +1872,7 @@ func New(options *Options) *API {
 					)
 					r.Get("/", api.userOIDC)
 				})
+				r.Get("/oidc/{provider}/callback", api.userOIDCProvider)
 			})
 			r.Group(func(r chi.Router) {
 				r.Use(
@@ -1899,6 +1965,11 @@ func New(options *Options) *API {
 								r.Put("/", api.putUserNotificationPreferences)
 							})
 						})
+						r.Route("/out-of-office", func(r chi.Router) {
+							r.Get("/", api.outOfOfficeRanges)
+							r.Post("/", api.postOutOfOfficeRange)
+							r.Delete("/{range}", api.deleteOutOfOfficeRange)
+						})
 						r.Route("/webpush", func(r chi.Router) {
 							r.Post("/subscription", api.postUserWebpushSubscription)
 							r.Delete("/subscription", api.deleteUserWebpushSubscription)
@@ -1971,10 +2042,21 @@ func New(options *Options) *API {
 				r.Delete("/containers/devcontainers/{devcontainer}", api.workspaceAgentDeleteDevcontainer)
 				r.Post("/containers/devcontainers/{devcontainer}/recreate", api.workspaceAgentRecreateDevcontainer)
 				r.Get("/coordinate", api.workspaceAgentClientCoordinate)
+				r.Route("/pty-shares", func(r chi.Router) {
+					// Sharing is only managed by users, never by proxies.
+					r.Use(apiKeyMiddleware)
+					r.Get("/", api.workspaceAgentPTYShares)
+					r.Post("/", api.postWorkspaceAgentPTYShare)
+					r.Delete("/{ptyshare}", api.deleteWorkspaceAgentPTYShare)
+				})
 
 				// PTY is part of workspaceAppServer.
 			})
 		})
+		r.Route("/pty-shares", func(r chi.Router) {
+			r.Use(apiKeyMiddleware)
+			r.Get("/watch", api.watchSharedWorkspaceAgentPTY)
+		})
 		r.Route("/workspaces", func(r chi.Router) {
 			r.Use(
 				apiKeyMiddleware,
@@ -1993,6 +2075,7 @@ func New(options *Options) *API {
 				r.Route("/autostart", func(r chi.Router) {
 					r.Put("/", api.putWorkspaceAutostart)
 				})
+				r.Get("/autostart-exceptions", api.workspaceAutostartExceptions)
 				r.Route("/ttl", func(r chi.Router) {
 					r.Put("/", api.putWorkspaceTTL)
 				})
@@ -2034,6 +2117,16 @@ func New(options *Options) *API {
 			r.Put("/state", api.workspaceBuildUpdateState)
 			r.Get("/timings", api.workspaceBuildTimings)
 		})
+		r.Route("/workspacebulkoperations", func(r chi.Router) {
+			r.Use(apiKeyMiddleware)
+			r.Get("/", api.workspaceBulkOperations)
+			r.Post("/", api.postWorkspaceBulkOperation)
+			r.Route("/{operation}", func(r chi.Router) {
+				r.Get("/", api.workspaceBulkOperation)
+				r.Post("/cancel", api.postWorkspaceBulkOperationCancel)
+				r.Get("/workspaces", api.workspaceBulkOperationWorkspaces)
+			})
+		})
 		r.Route("/authcheck", func(r chi.Router) {
 			r.Use(apiKeyMiddleware)
 			r.Post("/", api.checkAuthorization)
@@ -2402,6 +2495,10 @@ type API struct {
 	// routes (license-gated) which apply their own StripPrefix, and by
 	// the in-memory transport (used by chatd, license-exempt).
 	aiGatewayHandler http.Handler
+	// oidcProviderCallbacks are the callback handlers of the named OIDC
+	// providers keyed by provider ID, each wrapped in its own OAuth2
+	// middleware.
+	oidcProviderCallbacks map[string]http.Handler
 	// AIGatewayServerMetrics records AI budget cost-control metrics. May be nil.
 	AIGatewayServerMetrics *aibridgedserver.Metrics
 
@@ -2465,6 +2562,7 @@ type API struct {
 
 	workspaceAgentConnWatcher  *workspaceconnwatcher.Watcher
 	workspaceBuildOrchestrator *wsbuildorchestrator.Orchestrator
+	workspaceBulkRunner        *workspaceBulkRunner
 }
 
 // chatDaemonPublishDiffStatusChangeFunc returns chatDaemon's
@@ -2553,6 +2651,7 @@ func (api *API) Close() error {
 	_ = api.UpdatesProvider.Close()
 	api.workspaceAgentConnWatcher.Close()
 	api.workspaceBuildOrchestrator.Close()
+	api.workspaceBulkRunner.Close()
 
 	if current := api.PrebuildsReconciler.Load(); current != nil {
 		ctx, giveUp := context.WithTimeoutCause(context.Background(), time.Second*30, xerrors.New("gave up waiting for reconciler to stop before shutdown"))
@@ -2689,6 +2788,7 @@ func (api *API) CreateInMemoryTaggedProvisionerDaemon(dialCtx context.Context, n
 		api.DeploymentValues,
 		provisionerdserver.Options{
 			OIDCConfig:          api.OIDCConfig,
+			OIDCProviders:       OIDCProviderOAuth2Configs(api.OIDCProviders),
 			ExternalAuthConfigs: api.ExternalAuthConfigs,
 			AISeatTracker:       api.AISeatTracker,
 			Clock:               api.Clock,
@@ -127,6 +127,7 @@ type Options struct {
 	GithubOAuth2Config   *coderd.GithubOAuth2Config
 	RealIPConfig         *httpmw.RealIPConfig
 	OIDCConfig           *coderd.OIDCConfig
+	OIDCProviders        []*coderd.OIDCConfig
 	GoogleTokenValidator *idtoken.Validator
 	SSHKeygenAlgorithm   gitsshkey.Algorithm
 	AutobuildTicker      <-chan time.Time
@@ -653,6 +654,7 @@ func NewOptions(t testing.TB, options *Options) (func(http.Handler), context.Can
 			GithubOAuth2Config:                 options.GithubOAuth2Config,
 			RealIPConfig:                       options.RealIPConfig,
 			OIDCConfig:                         options.OIDCConfig,
+			OIDCProviders:                      options.OIDCProviders,
 			GoogleTokenValidator:               options.GoogleTokenValidator,
 			SSHKeygenAlgorithm:                 options.SSHKeygenAlgorithm,
 			DERPServer:                         derpServer,
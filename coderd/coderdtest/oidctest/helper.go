@@ -89,6 +89,7 @@ func (*LoginHelper) ExpireOauthToken(t *testing.T, db database.Store, user *code
 		UserID:                 link.UserID,
 		LoginType:              link.LoginType,
 		Claims:                 database.UserLinkClaims{},
+		OIDCProviderID:         link.OIDCProviderID,
 	})
 	require.NoError(t, err, "expire user link")
 
@@ -47,10 +47,15 @@ const (
 	CheckMcpServerConfigsTransportCheck                      CheckConstraint = "mcp_server_configs_transport_check"                        // mcp_server_configs
 	CheckMcpServerConfigsUserAclIsObject                     CheckConstraint = "mcp_server_configs_user_acl_is_object"                     // mcp_server_configs
 	CheckOauth2ProviderAppCodesScopeNotEmpty                 CheckConstraint = "oauth2_provider_app_codes_scope_not_empty"                 // oauth2_provider_app_codes
+	CheckOauth2ProviderAppDeviceCodesApprovedHasUser         CheckConstraint = "oauth2_provider_app_device_codes_approved_has_user"        // oauth2_provider_app_device_codes
+	CheckOauth2ProviderAppDeviceCodesScopeNotEmpty           CheckConstraint = "oauth2_provider_app_device_codes_scope_not_empty"          // oauth2_provider_app_device_codes
 	CheckOauth2ProviderAppTokensScopeNotEmpty                CheckConstraint = "oauth2_provider_app_tokens_scope_not_empty"                // oauth2_provider_app_tokens
 	CheckOauth2ProviderAppsClientTypeCheck                   CheckConstraint = "oauth2_provider_apps_client_type_check"                    // oauth2_provider_apps
+	CheckProvisionerJobPrioritiesPriorityCheck               CheckConstraint = "provisioner_job_priorities_priority_check"                 // provisioner_job_priorities
 	CheckMaxProvisionerLogsLength                            CheckConstraint = "max_provisioner_logs_length"                               // provisioner_jobs
+	CheckPurgeArchiveImportsRecordTypeCheck                  CheckConstraint = "purge_archive_imports_record_type_check"                   // purge_archive_imports
 	CheckNatsPortValidTcp                                    CheckConstraint = "nats_port_valid_tcp"                                       // replicas
+	CheckScheduleExceptionCalendarDatesEndAfterStart         CheckConstraint = "schedule_exception_calendar_dates_end_after_start"         // schedule_exception_calendar_dates
 	CheckMaxLogsLength                                       CheckConstraint = "max_logs_length"                                           // workspace_agents
 	CheckSubsystemsNotNone                                   CheckConstraint = "subsystems_not_none"                                       // workspace_agents
 	CheckWorkspaceBuildsDeadlineBelowMaxDeadline             CheckConstraint = "workspace_builds_deadline_below_max_deadline"              // workspace_builds
@@ -58,10 +63,14 @@ const (
 	CheckUserAclIsObject                                     CheckConstraint = "user_acl_is_object"                                        // workspaces
 	CheckTelemetryLockEventTypeConstraint                    CheckConstraint = "telemetry_lock_event_type_constraint"                      // telemetry_locks
 	CheckValidationMonotonicOrder                            CheckConstraint = "validation_monotonic_order"                                // template_version_parameters
+	CheckTemplateVersionRolloutsFailureThresholdCheck        CheckConstraint = "template_version_rollouts_failure_threshold_check"         // template_version_rollouts
+	CheckTemplateVersionRolloutsMinSamplesCheck              CheckConstraint = "template_version_rollouts_min_samples_check"               // template_version_rollouts
+	CheckTemplateVersionRolloutsPercentageCheck              CheckConstraint = "template_version_rollouts_percentage_check"                // template_version_rollouts
 	CheckUsageEventTypeCheck                                 CheckConstraint = "usage_event_type_check"                                    // usage_events
 	CheckUsageEventsAgentRuntimeHourAligned                  CheckConstraint = "usage_events_agent_runtime_hour_aligned"                   // usage_events
 	CheckUserAIBudgetOverridesSpendLimitMicrosCheck          CheckConstraint = "user_ai_budget_overrides_spend_limit_micros_check"         // user_ai_budget_overrides
 	CheckUserAIProviderKeysAPIKeyCheck                       CheckConstraint = "user_ai_provider_keys_api_key_check"                       // user_ai_provider_keys
+	CheckUserOutOfOfficeRangesEndAfterStart                  CheckConstraint = "user_out_of_office_ranges_end_after_start"                 // user_out_of_office_ranges
 	CheckUserSecretsEnabledRequiresTarget                    CheckConstraint = "user_secrets_enabled_requires_target"                      // user_secrets
 	CheckUserSkillsContentSize                               CheckConstraint = "user_skills_content_size"                                  // user_skills
 	CheckUserSkillsDescriptionSize                           CheckConstraint = "user_skills_description_size"                              // user_skills
@@ -74,4 +83,5 @@ const (
 	CheckWorkspaceBuildOrchestrationsCompletedChildCheck     CheckConstraint = "workspace_build_orchestrations_completed_child_check"      // workspace_build_orchestrations
 	CheckWorkspaceBuildOrchestrationsNextRetryAfterCheck     CheckConstraint = "workspace_build_orchestrations_next_retry_after_check"     // workspace_build_orchestrations
 	CheckWorkspaceBuildOrchestrationsStatusCheck             CheckConstraint = "workspace_build_orchestrations_status_check"               // workspace_build_orchestrations
+	CheckWorkspaceBulkOperationsConcurrencyCheck             CheckConstraint = "workspace_bulk_operations_concurrency_check"               // workspace_bulk_operations
 )
@@ -486,10 +486,11 @@ func TemplateVersionParameterOptionFromPreview(option *previewtypes.ParameterOpt
 
 func OAuth2ProviderApp(accessURL *url.URL, dbApp database.OAuth2ProviderApp) codersdk.OAuth2ProviderApp {
 	return codersdk.OAuth2ProviderApp{
-		ID:          dbApp.ID,
-		Name:        dbApp.Name,
-		CallbackURL: dbApp.CallbackURL,
-		Icon:        dbApp.Icon,
+		ID:                      dbApp.ID,
+		Name:                    dbApp.Name,
+		CallbackURL:             dbApp.CallbackURL,
+		Icon:                    dbApp.Icon,
+		ClientCredentialsUserID: nullUUIDPtr(dbApp.ClientCredentialsUserID),
 		Endpoints: codersdk.OAuth2AppEndpoints{
 			Authorization: accessURL.ResolveReference(&url.URL{
 				Path: "/oauth2/authorize",
@@ -497,8 +498,9 @@ func OAuth2ProviderApp(accessURL *url.URL, dbApp database.OAuth2ProviderApp) cod
 			Token: accessURL.ResolveReference(&url.URL{
 				Path: "/oauth2/tokens",
 			}).String(),
-			// We do not currently support DeviceAuth.
-			DeviceAuth: "",
+			DeviceAuth: accessURL.ResolveReference(&url.URL{
+				Path: "/oauth2/device/code",
+			}).String(),
 			TokenRevoke: accessURL.ResolveReference(&url.URL{
 				Path: "/oauth2/revoke",
 			}).String(),
@@ -715,6 +717,8 @@ func Apps(dbApps []database.WorkspaceApp, statuses []database.WorkspaceAppStatus
 			SharingLevel:  codersdk.WorkspaceAppSharingLevel(dbApp.SharingLevel),
 			Healthcheck: codersdk.Healthcheck{
 				URL:       dbApp.HealthcheckUrl,
+				Address:   dbApp.HealthcheckAddress,
+				Command:   dbApp.HealthcheckCommand,
 				Interval:  dbApp.HealthcheckInterval,
 				Threshold: dbApp.HealthcheckThreshold,
 			},
@@ -757,6 +761,18 @@ func ProvisionerJobLog(log database.ProvisionerJobLog) codersdk.ProvisionerJobLo
 	}
 }
 
+func ProvisionerJobPriority(p database.ProvisionerJobPriority) codersdk.ProvisionerJobPriority {
+	priority := codersdk.ProvisionerJobPriority{
+		OrganizationID: p.OrganizationID,
+		Priority:       p.Priority,
+		UpdatedAt:      p.UpdatedAt,
+	}
+	if p.TemplateID != uuid.Nil {
+		priority.TemplateID = &p.TemplateID
+	}
+	return priority
+}
+
 func WorkspaceAgentLog(log database.WorkspaceAgentLog) codersdk.WorkspaceAgentLog {
 	return codersdk.WorkspaceAgentLog{
 		ID:        log.ID,
@@ -2207,6 +2223,38 @@ func UserSecret(secret database.ListUserSecretsRow) codersdk.UserSecret {
 	}
 }
 
+func ScheduleExceptionCalendar(calendar database.ScheduleExceptionCalendar) codersdk.ScheduleExceptionCalendar {
+	return codersdk.ScheduleExceptionCalendar{
+		ID:             calendar.ID,
+		OrganizationID: calendar.OrganizationID,
+		Name:           calendar.Name,
+		Description:    calendar.Description,
+		CreatedAt:      calendar.CreatedAt,
+		UpdatedAt:      calendar.UpdatedAt,
+	}
+}
+
+func ScheduleExceptionDateRanges(dates []database.ScheduleExceptionCalendarDate) []codersdk.ScheduleExceptionDateRange {
+	return slice.List(dates, func(date database.ScheduleExceptionCalendarDate) codersdk.ScheduleExceptionDateRange {
+		return codersdk.ScheduleExceptionDateRange{
+			StartDate: date.StartDate.Format(codersdk.ScheduleExceptionDateFormat),
+			EndDate:   date.EndDate.Format(codersdk.ScheduleExceptionDateFormat),
+			Summary:   date.Summary,
+		}
+	})
+}
+
+func OutOfOfficeRange(ooo database.UserOutOfOfficeRange) codersdk.OutOfOfficeRange {
+	return codersdk.OutOfOfficeRange{
+		ID:        ooo.ID,
+		UserID:    ooo.UserID,
+		StartDate: ooo.StartDate.Format(codersdk.ScheduleExceptionDateFormat),
+		EndDate:   ooo.EndDate.Format(codersdk.ScheduleExceptionDateFormat),
+		Reason:    ooo.Reason,
+		CreatedAt: ooo.CreatedAt,
+	}
+}
+
 // UserSecretFromFull converts a full database UserSecret row to an
 // SDK UserSecret, omitting the value and encryption key ID.
 func UserSecretFromFull(secret database.UserSecret) codersdk.UserSecret {
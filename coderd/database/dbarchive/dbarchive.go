@@ -0,0 +1,270 @@
+// Package dbarchive writes records that are about to be purged from the
+// database to compressed JSON Lines archives, and reads them back.
+//
+// Each archive is stored in a Sink alongside a manifest describing it. An
+// archive contains one JSON object per line, as produced by Postgres'
+// to_jsonb for the purged row.
+package dbarchive
+
+import (
+	"bufio"
+	"bytes"
+	"compress/gzip"
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"hash"
+	"io"
+	"os"
+	"path"
+	"sort"
+	"strings"
+	"time"
+
+	"golang.org/x/xerrors"
+
+	"github.com/coder/coder/v2/cryptorand"
+)
+
+// RecordType is the kind of record stored in an archive. The values match
+// the record_type column of purge_archive_imports.
+type RecordType string
+
+const (
+	RecordTypeAuditLogs             RecordType = "audit_logs"
+	RecordTypeConnectionLogs        RecordType = "connection_logs"
+	RecordTypeWorkspaceAgentLogs    RecordType = "workspace_agent_logs"
+	RecordTypeAIBridgeInterceptions RecordType = "aibridge_interceptions"
+)
+
+func (t RecordType) Valid() bool {
+	switch t {
+	case RecordTypeAuditLogs, RecordTypeConnectionLogs, RecordTypeWorkspaceAgentLogs, RecordTypeAIBridgeInterceptions:
+		return true
+	default:
+		return false
+	}
+}
+
+const (
+	archiveSuffix  = ".jsonl.gz"
+	manifestSuffix = ".manifest.json"
+	// maxRecordSize bounds a single line when reading an archive. AI Bridge
+	// interceptions include prompts, so records can be large.
+	maxRecordSize = 64 << 20
+)
+
+// Manifest describes an archive. It is stored next to the archive as
+// "<name>.manifest.json".
+type Manifest struct {
+	// Name is the name of the archive in the sink.
+	Name       string     `json:"name"`
+	RecordType RecordType `json:"record_type"`
+	Rows       int64      `json:"rows"`
+	// SHA256 is the hex encoded checksum of the compressed archive.
+	SHA256 string `json:"sha256"`
+	// Before is the retention cutoff the records were purged with: all of
+	// them are older than it.
+	Before    time.Time `json:"before"`
+	CreatedAt time.Time `json:"created_at"`
+}
+
+// Writer buffers an archive in a temporary file until it is committed to a
+// sink. Callers must Close the Writer.
+type Writer struct {
+	recordType RecordType
+	file       *os.File
+	hash       hash.Hash
+	gzip       *gzip.Writer
+	buf        bytes.Buffer
+	rows       int64
+}
+
+// NewWriter creates a Writer for records of the given type.
+func NewWriter(recordType RecordType) (*Writer, error) {
+	if !recordType.Valid() {
+		return nil, xerrors.Errorf("invalid record type %q", recordType)
+	}
+	file, err := os.CreateTemp("", "coder-archive-*"+archiveSuffix)
+	if err != nil {
+		return nil, xerrors.Errorf("create temporary archive: %w", err)
+	}
+	h := sha256.New()
+	return &Writer{
+		recordType: recordType,
+		file:       file,
+		hash:       h,
+		gzip:       gzip.NewWriter(io.MultiWriter(file, h)),
+	}, nil
+}
+
+// Write appends a record to the archive.
+func (w *Writer) Write(record json.RawMessage) error {
+	w.buf.Reset()
+	// Records must be on a single line.
+	if err := json.Compact(&w.buf, record); err != nil {
+		return xerrors.Errorf("compact record: %w", err)
+	}
+	w.buf.WriteByte('\n')
+	if _, err := w.gzip.Write(w.buf.Bytes()); err != nil {
+		return xerrors.Errorf("write record: %w", err)
+	}
+	w.rows++
+	return nil
+}
+
+// Rows returns the number of records written.
+func (w *Writer) Rows() int64 {
+	return w.rows
+}
+
+// Commit uploads the archive and its manifest to the sink. The manifest is
+// uploaded last, so an archive without a manifest is incomplete and is
+// ignored by ReadManifests.
+func (w *Writer) Commit(ctx context.Context, sink Sink, before, now time.Time) (Manifest, error) {
+	if err := w.gzip.Close(); err != nil {
+		return Manifest{}, xerrors.Errorf("close archive: %w", err)
+	}
+	if _, err := w.file.Seek(0, io.SeekStart); err != nil {
+		return Manifest{}, xerrors.Errorf("seek archive: %w", err)
+	}
+
+	suffix, err := cryptorand.HexString(4)
+	if err != nil {
+		return Manifest{}, xerrors.Errorf("generate archive name: %w", err)
+	}
+	now = now.UTC()
+	manifest := Manifest{
+		Name: path.Join(
+			string(w.recordType),
+			now.Format("2006/01/02"),
+			fmt.Sprintf("%s-%s-%s%s", w.recordType, now.Format("20060102T150405Z"), suffix, archiveSuffix),
+		),
+		RecordType: w.recordType,
+		Rows:       w.rows,
+		SHA256:     hex.EncodeToString(w.hash.Sum(nil)),
+		Before:     before.UTC(),
+		CreatedAt:  now,
+	}
+	if err := sink.Put(ctx, manifest.Name, w.file); err != nil {
+		return Manifest{}, xerrors.Errorf("upload archive %q: %w", manifest.Name, err)
+	}
+	data, err := json.MarshalIndent(manifest, "", "  ")
+	if err != nil {
+		return Manifest{}, xerrors.Errorf("marshal manifest: %w", err)
+	}
+	if err := sink.Put(ctx, manifest.Name+manifestSuffix, bytes.NewReader(data)); err != nil {
+		return Manifest{}, xerrors.Errorf("upload manifest for %q: %w", manifest.Name, err)
+	}
+	return manifest, nil
+}
+
+// Close removes the temporary file. If the Writer was not committed, the
+// archive is discarded.
+func (w *Writer) Close() error {
+	_ = w.file.Close()
+	if err := os.Remove(w.file.Name()); err != nil && !os.IsNotExist(err) {
+		return xerrors.Errorf("remove temporary archive: %w", err)
+	}
+	return nil
+}
+
+// ReadManifests returns the manifests of all archives in the sink, sorted by
+// name.
+func ReadManifests(ctx context.Context, sink Sink) ([]Manifest, error) {
+	names, err := sink.List(ctx)
+	if err != nil {
+		return nil, xerrors.Errorf("list archives: %w", err)
+	}
+	sort.Strings(names)
+
+	manifests := make([]Manifest, 0, len(names)/2)
+	for _, name := range names {
+		if !strings.HasSuffix(name, manifestSuffix) {
+			continue
+		}
+		manifest, err := ReadManifest(ctx, sink, strings.TrimSuffix(name, manifestSuffix))
+		if err != nil {
+			return nil, err
+		}
+		manifests = append(manifests, manifest)
+	}
+	return manifests, nil
+}
+
+// ReadManifest returns the manifest of the named archive.
+func ReadManifest(ctx context.Context, sink Sink, name string) (Manifest, error) {
+	rc, err := sink.Get(ctx, name+manifestSuffix)
+	if err != nil {
+		return Manifest{}, xerrors.Errorf("get manifest for %q: %w", name, err)
+	}
+	defer rc.Close()
+
+	var manifest Manifest
+	if err := json.NewDecoder(rc).Decode(&manifest); err != nil {
+		return Manifest{}, xerrors.Errorf("decode manifest for %q: %w", name, err)
+	}
+	if manifest.Name != name {
+		return Manifest{}, xerrors.Errorf("manifest for %q names archive %q", name, manifest.Name)
+	}
+	if !manifest.RecordType.Valid() {
+		return Manifest{}, xerrors.Errorf("manifest for %q has invalid record type %q", name, manifest.RecordType)
+	}
+	return manifest, nil
+}
+
+// ReadRecords calls fn with each record in the archive described by the
+// manifest. Once every record has been read, the checksum and row count are
+// checked against the manifest, so callers should not treat the records as
+// valid until ReadRecords returns without error.
+func ReadRecords(ctx context.Context, sink Sink, manifest Manifest, fn func(json.RawMessage) error) error {
+	rc, err := sink.Get(ctx, manifest.Name)
+	if err != nil {
+		return xerrors.Errorf("get archive %q: %w", manifest.Name, err)
+	}
+	defer rc.Close()
+
+	h := sha256.New()
+	tee := io.TeeReader(rc, h)
+	gz, err := gzip.NewReader(tee)
+	if err != nil {
+		return xerrors.Errorf("read archive %q: %w", manifest.Name, err)
+	}
+	defer gz.Close()
+
+	var rows int64
+	scanner := bufio.NewScanner(gz)
+	scanner.Buffer(nil, maxRecordSize)
+	for scanner.Scan() {
+		line := scanner.Bytes()
+		if len(line) == 0 {
+			continue
+		}
+		if !json.Valid(line) {
+			return xerrors.Errorf("archive %q: record %d is not valid JSON", manifest.Name, rows+1)
+		}
+		// The scanner reuses its buffer, so the record must be copied.
+		if err := fn(bytes.Clone(line)); err != nil {
+			return err
+		}
+		rows++
+	}
+	if err := scanner.Err(); err != nil {
+		return xerrors.Errorf("read archive %q: %w", manifest.Name, err)
+	}
+	// Drain anything after the gzip stream so that the checksum covers the
+	// whole object.
+	if _, err := io.Copy(io.Discard, tee); err != nil {
+		return xerrors.Errorf("read archive %q: %w", manifest.Name, err)
+	}
+
+	if sum := hex.EncodeToString(h.Sum(nil)); sum != manifest.SHA256 {
+		return xerrors.Errorf("archive %q has checksum %s, but the manifest has %s", manifest.Name, sum, manifest.SHA256)
+	}
+	if rows != manifest.Rows {
+		return xerrors.Errorf("archive %q has %d records, but the manifest has %d", manifest.Name, rows, manifest.Rows)
+	}
+	return nil
+}
@@ -0,0 +1,126 @@
+package dbarchive_test
+
+import (
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/coder/coder/v2/coderd/database/dbarchive"
+	"github.com/coder/coder/v2/testutil"
+)
+
+func TestArchive(t *testing.T) {
+	t.Parallel()
+
+	t.Run("RoundTrip", func(t *testing.T) {
+		t.Parallel()
+
+		ctx := testutil.Context(t, testutil.WaitShort)
+		dir := t.TempDir()
+		sink, err := dbarchive.OpenSink(ctx, "file://"+filepath.ToSlash(dir))
+		require.NoError(t, err)
+
+		before := time.Date(2026, time.January, 1, 0, 0, 0, 0, time.UTC)
+		now := time.Date(2026, time.July, 1, 12, 30, 0, 0, time.UTC)
+		w, err := dbarchive.NewWriter(dbarchive.RecordTypeAuditLogs)
+		require.NoError(t, err)
+		defer w.Close()
+		require.NoError(t, w.Write(json.RawMessage(`{"id": "a",
+			"action": "create"}`)))
+		require.NoError(t, w.Write(json.RawMessage(`{"id":"b","action":"delete"}`)))
+		require.EqualValues(t, 2, w.Rows())
+		manifest, err := w.Commit(ctx, sink, before, now)
+		require.NoError(t, err)
+		require.NoError(t, w.Close())
+
+		require.Regexp(t, `^audit_logs/2026/07/01/audit_logs-20260701T123000Z-[0-9a-f]{4}\.jsonl\.gz$`, manifest.Name)
+		require.FileExists(t, filepath.Join(dir, filepath.FromSlash(manifest.Name)))
+
+		manifests, err := dbarchive.ReadManifests(ctx, sink)
+		require.NoError(t, err)
+		require.Equal(t, []dbarchive.Manifest{manifest}, manifests)
+
+		var records []string
+		err = dbarchive.ReadRecords(ctx, sink, manifest, func(record json.RawMessage) error {
+			records = append(records, string(record))
+			return nil
+		})
+		require.NoError(t, err)
+		require.Equal(t, []string{
+			`{"id":"a","action":"create"}`,
+			`{"id":"b","action":"delete"}`,
+		}, records)
+	})
+
+	t.Run("IncompleteArchivesIgnored", func(t *testing.T) {
+		t.Parallel()
+
+		ctx := testutil.Context(t, testutil.WaitShort)
+		dir := t.TempDir()
+		sink, err := dbarchive.NewDirSink(dir)
+		require.NoError(t, err)
+		// An archive whose manifest was never uploaded.
+		require.NoError(t, os.MkdirAll(filepath.Join(dir, "audit_logs"), 0o700))
+		require.NoError(t, os.WriteFile(filepath.Join(dir, "audit_logs", "partial.jsonl.gz"), []byte("partial"), 0o600))
+
+		manifests, err := dbarchive.ReadManifests(ctx, sink)
+		require.NoError(t, err)
+		require.Empty(t, manifests)
+	})
+
+	t.Run("ChecksumMismatch", func(t *testing.T) {
+		t.Parallel()
+
+		ctx := testutil.Context(t, testutil.WaitShort)
+		sink, err := dbarchive.NewDirSink(t.TempDir())
+		require.NoError(t, err)
+
+		w, err := dbarchive.NewWriter(dbarchive.RecordTypeConnectionLogs)
+		require.NoError(t, err)
+		defer w.Close()
+		require.NoError(t, w.Write(json.RawMessage(`{"id":"a"}`)))
+		manifest, err := w.Commit(ctx, sink, time.Now(), time.Now())
+		require.NoError(t, err)
+
+		manifest.SHA256 = "0000"
+		err = dbarchive.ReadRecords(ctx, sink, manifest, func(json.RawMessage) error { return nil })
+		require.ErrorContains(t, err, "checksum")
+	})
+
+	t.Run("InvalidRecord", func(t *testing.T) {
+		t.Parallel()
+
+		w, err := dbarchive.NewWriter(dbarchive.RecordTypeWorkspaceAgentLogs)
+		require.NoError(t, err)
+		defer w.Close()
+		require.Error(t, w.Write(json.RawMessage(`{"id":`)))
+	})
+}
+
+func TestOpenSink(t *testing.T) {
+	t.Parallel()
+
+	ctx := testutil.Context(t, testutil.WaitShort)
+
+	_, err := dbarchive.OpenSink(ctx, "")
+	require.Error(t, err)
+
+	_, err = dbarchive.OpenSink(ctx, "gs://bucket")
+	require.ErrorContains(t, err, "unsupported archive URL scheme")
+
+	_, err = dbarchive.OpenSink(ctx, "s3:///prefix")
+	require.ErrorContains(t, err, "bucket")
+
+	_, err = dbarchive.OpenSink(ctx, "s3://bucket?use_path_style=maybe")
+	require.ErrorContains(t, err, "use_path_style")
+
+	sink, err := dbarchive.OpenSink(ctx, filepath.Join(t.TempDir(), "archives"))
+	require.NoError(t, err)
+	names, err := sink.List(ctx)
+	require.NoError(t, err)
+	require.Empty(t, names)
+}
@@ -0,0 +1,236 @@
+package dbarchive
+
+import (
+	"context"
+	"io"
+	"io/fs"
+	"net/url"
+	"os"
+	"path"
+	"path/filepath"
+	"strconv"
+	"strings"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/config"
+	"github.com/aws/aws-sdk-go-v2/service/s3"
+	"golang.org/x/xerrors"
+)
+
+// Sink stores archives. Names are slash separated relative paths.
+type Sink interface {
+	// Put stores the contents of r under name, replacing any existing
+	// object.
+	Put(ctx context.Context, name string, r io.ReadSeeker) error
+	// Get returns the contents stored under name.
+	Get(ctx context.Context, name string) (io.ReadCloser, error)
+	// List returns the names of all objects in the sink.
+	List(ctx context.Context) ([]string, error)
+}
+
+// OpenSink returns the sink for a URL. Supported URLs are:
+//
+//   - A local directory, as a path or a file:// URL.
+//   - An S3 compatible bucket, as s3://bucket/optional/prefix. The endpoint,
+//     region and use_path_style query parameters configure the client, for
+//     example s3://archive?endpoint=https://minio.example.com&use_path_style=true.
+//     Credentials are read from the standard AWS environment variables and
+//     configuration files.
+func OpenSink(ctx context.Context, rawURL string) (Sink, error) {
+	if rawURL == "" {
+		return nil, xerrors.New("archive URL must not be empty")
+	}
+	// Windows paths such as C:\archive parse as a URL with scheme "c".
+	if filepath.IsAbs(rawURL) {
+		return NewDirSink(rawURL)
+	}
+	u, err := url.Parse(rawURL)
+	if err != nil {
+		return nil, xerrors.Errorf("parse archive URL: %w", err)
+	}
+	switch u.Scheme {
+	case "":
+		return NewDirSink(rawURL)
+	case "file":
+		return NewDirSink(u.Path)
+	case "s3":
+		return openS3Sink(ctx, u)
+	default:
+		return nil, xerrors.Errorf("unsupported archive URL scheme %q, must be file or s3", u.Scheme)
+	}
+}
+
+type dirSink struct {
+	dir string
+}
+
+// NewDirSink returns a sink that stores archives in a local directory,
+// creating it if necessary.
+func NewDirSink(dir string) (Sink, error) {
+	if err := os.MkdirAll(dir, 0o700); err != nil {
+		return nil, xerrors.Errorf("create archive directory: %w", err)
+	}
+	return &dirSink{dir: dir}, nil
+}
+
+func (s *dirSink) path(name string) (string, error) {
+	clean := path.Clean(name)
+	if !fs.ValidPath(clean) || clean == "." {
+		return "", xerrors.Errorf("invalid archive name %q", name)
+	}
+	return filepath.Join(s.dir, filepath.FromSlash(clean)), nil
+}
+
+func (s *dirSink) Put(_ context.Context, name string, r io.ReadSeeker) error {
+	p, err := s.path(name)
+	if err != nil {
+		return err
+	}
+	if err := os.MkdirAll(filepath.Dir(p), 0o700); err != nil {
+		return err
+	}
+	// Write to a temporary file first so that a partial object is never
+	// visible under its name.
+	tmp, err := os.CreateTemp(filepath.Dir(p), ".tmp-*")
+	if err != nil {
+		return err
+	}
+	defer os.Remove(tmp.Name())
+	if _, err := io.Copy(tmp, r); err != nil {
+		_ = tmp.Close()
+		return err
+	}
+	if err := tmp.Sync(); err != nil {
+		_ = tmp.Close()
+		return err
+	}
+	if err := tmp.Close(); err != nil {
+		return err
+	}
+	return os.Rename(tmp.Name(), p)
+}
+
+func (s *dirSink) Get(_ context.Context, name string) (io.ReadCloser, error) {
+	p, err := s.path(name)
+	if err != nil {
+		return nil, err
+	}
+	return os.Open(p)
+}
+
+func (s *dirSink) List(_ context.Context) ([]string, error) {
+	var names []string
+	err := filepath.WalkDir(s.dir, func(p string, d fs.DirEntry, err error) error {
+		if err != nil {
+			return err
+		}
+		if d.IsDir() || strings.HasPrefix(d.Name(), ".") {
+			return nil
+		}
+		rel, err := filepath.Rel(s.dir, p)
+		if err != nil {
+			return err
+		}
+		names = append(names, filepath.ToSlash(rel))
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return names, nil
+}
+
+type s3Sink struct {
+	client *s3.Client
+	bucket string
+	prefix string
+}
+
+func openS3Sink(ctx context.Context, u *url.URL) (Sink, error) {
+	if u.Host == "" {
+		return nil, xerrors.New("s3 archive URL must include a bucket")
+	}
+	query := u.Query()
+
+	var opts []func(*config.LoadOptions) error
+	if region := query.Get("region"); region != "" {
+		opts = append(opts, config.WithRegion(region))
+	}
+	cfg, err := config.LoadDefaultConfig(ctx, opts...)
+	if err != nil {
+		return nil, xerrors.Errorf("load aws config: %w", err)
+	}
+
+	usePathStyle := false
+	if v := query.Get("use_path_style"); v != "" {
+		usePathStyle, err = strconv.ParseBool(v)
+		if err != nil {
+			return nil, xerrors.Errorf("parse use_path_style: %w", err)
+		}
+	}
+	endpoint := query.Get("endpoint")
+	client := s3.NewFromConfig(cfg, func(o *s3.Options) {
+		if endpoint != "" {
+			o.BaseEndpoint = aws.String(endpoint)
+		}
+		o.UsePathStyle = usePathStyle
+	})
+
+	return &s3Sink{
+		client: client,
+		bucket: u.Host,
+		prefix: strings.Trim(u.Path, "/"),
+	}, nil
+}
+
+func (s *s3Sink) key(name string) string {
+	if s.prefix == "" {
+		return name
+	}
+	return s.prefix + "/" + name
+}
+
+func (s *s3Sink) Put(ctx context.Context, name string, r io.ReadSeeker) error {
+	_, err := s.client.PutObject(ctx, &s3.PutObjectInput{
+		Bucket: aws.String(s.bucket),
+		Key:    aws.String(s.key(name)),
+		Body:   r,
+	})
+	return err
+}
+
+func (s *s3Sink) Get(ctx context.Context, name string) (io.ReadCloser, error) {
+	out, err := s.client.GetObject(ctx, &s3.GetObjectInput{
+		Bucket: aws.String(s.bucket),
+		Key:    aws.String(s.key(name)),
+	})
+	if err != nil {
+		return nil, err
+	}
+	return out.Body, nil
+}
+
+func (s *s3Sink) List(ctx context.Context) ([]string, error) {
+	input := &s3.ListObjectsV2Input{
+		Bucket: aws.String(s.bucket),
+	}
+	if s.prefix != "" {
+		input.Prefix = aws.String(s.prefix + "/")
+	}
+	var names []string
+	paginator := s3.NewListObjectsV2Paginator(s.client, input)
+	for paginator.HasMorePages() {
+		page, err := paginator.NextPage(ctx)
+		if err != nil {
+			return nil, err
+		}
+		for _, obj := range page.Contents {
+			key := aws.ToString(obj.Key)
+			if s.prefix != "" {
+				key = strings.TrimPrefix(key, s.prefix+"/")
+			}
+			names = append(names, key)
+		}
+	}
+	return names, nil
+}
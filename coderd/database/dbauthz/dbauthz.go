@@ -864,6 +864,8 @@ var (
 					rbac.ResourceUser.Type:               {policy.ActionCreate, policy.ActionUpdate, policy.ActionRead, policy.ActionUpdatePersonal},
 					rbac.ResourceOrganization.Type:       {policy.ActionRead},
 					rbac.ResourceOrganizationMember.Type: {policy.ActionRead, policy.ActionCreate, policy.ActionUpdate},
+					rbac.ResourceGroup.Type:              {policy.ActionCreate, policy.ActionRead, policy.ActionUpdate, policy.ActionDelete},
+					rbac.ResourceGroupMember.Type:        {policy.ActionRead},
 				}),
 				User:    []rbac.Permission{},
 				ByOrgID: map[string]rbac.OrgPermissions{},
@@ -1746,12 +1748,45 @@ func (q *querier) authorizeProvisionerJob(ctx context.Context, job database.Prov
 		if err != nil {
 			return xerrors.Errorf("fetch related template version: %w", err)
 		}
+	case database.ProvisionerJobTypeWorkspaceDriftCheck:
+		// If we can read the workspace that was checked, we can read the job.
+		workspace, err := workspaceFromDriftCheckJob(ctx, q.db, job)
+		if err != nil {
+			return xerrors.Errorf("fetch related workspace: %w", err)
+		}
+		if err := q.authorizeContext(ctx, policy.ActionRead, workspace); err != nil {
+			return err
+		}
 	default:
 		return xerrors.Errorf("unknown job type: %q", job.Type)
 	}
 	return nil
 }
 
+// workspaceFromDriftCheckJob returns the workspace that a drift check job
+// checks. It does not perform any authorization.
+func workspaceFromDriftCheckJob(ctx context.Context, db database.Store, job database.ProvisionerJob) (database.Workspace, error) {
+	check, err := db.GetWorkspaceDriftCheckByJobID(ctx, job.ID)
+	if err != nil {
+		return database.Workspace{}, xerrors.Errorf("get workspace drift check by job id: %w", err)
+	}
+	return db.GetWorkspaceByID(ctx, check.WorkspaceID)
+}
+
+// authorizeTemplateVersionRolloutUpdate checks that the actor can update the
+// template that the rollout belongs to.
+func (q *querier) authorizeTemplateVersionRolloutUpdate(ctx context.Context, rolloutID uuid.UUID) error {
+	rollout, err := q.db.GetTemplateVersionRolloutByID(ctx, rolloutID)
+	if err != nil {
+		return xerrors.Errorf("get template version rollout: %w", err)
+	}
+	template, err := q.db.GetTemplateByID(ctx, rollout.TemplateID)
+	if err != nil {
+		return xerrors.Errorf("get template: %w", err)
+	}
+	return q.authorizeContext(ctx, policy.ActionUpdate, template)
+}
+
 // scopedOrgRoleIdentifiers wraps each role name as a RoleIdentifier scoped
 // to orgID. Used to feed rbac.ChangeRoleSet from a stored []string.
 func scopedOrgRoleIdentifiers(names []string, orgID uuid.UUID) []rbac.RoleIdentifier {
@@ -1801,6 +1836,13 @@ func (q *querier) AcquireStaleChatDiffStatuses(ctx context.Context, limitVal int
 	return q.db.AcquireStaleChatDiffStatuses(ctx, limitVal)
 }
 
+func (q *querier) AcquireWorkspaceBulkOperationWorkspaces(ctx context.Context, arg database.AcquireWorkspaceBulkOperationWorkspacesParams) ([]database.WorkspaceBulkOperationWorkspace, error) {
+	if err := q.authorizeContext(ctx, policy.ActionUpdate, rbac.ResourceSystem); err != nil {
+		return nil, err
+	}
+	return q.db.AcquireWorkspaceBulkOperationWorkspaces(ctx, arg)
+}
+
 func (q *querier) ActivityBumpWorkspace(ctx context.Context, arg database.ActivityBumpWorkspaceParams) error {
 	fetch := func(ctx context.Context, arg database.ActivityBumpWorkspaceParams) (database.Workspace, error) {
 		return q.db.GetWorkspaceByID(ctx, arg.WorkspaceID)
@@ -2090,6 +2132,13 @@ func (q *querier) CustomRoles(ctx context.Context, arg database.CustomRolesParam
 	return q.db.CustomRoles(ctx, arg)
 }
 
+func (q *querier) DeleteAIBridgeRecordsByInterceptionIDs(ctx context.Context, ids []uuid.UUID) (int64, error) {
+	if err := q.authorizeContext(ctx, policy.ActionDelete, rbac.ResourceAibridgeInterception); err != nil {
+		return -1, err
+	}
+	return q.db.DeleteAIBridgeRecordsByInterceptionIDs(ctx, ids)
+}
+
 func (q *querier) DeleteAIGatewayKey(ctx context.Context, id uuid.UUID) (database.DeleteAIGatewayKeyRow, error) {
 	if err := q.authorizeContext(ctx, policy.ActionDelete, rbac.ResourceAIGatewayKey); err != nil {
 		return database.DeleteAIGatewayKeyRow{}, err
@@ -2184,6 +2233,13 @@ func (q *querier) DeleteApplicationConnectAPIKeysByUserID(ctx context.Context, u
 	return q.db.DeleteApplicationConnectAPIKeysByUserID(ctx, userID)
 }
 
+func (q *querier) DeleteAuditLogsByIDs(ctx context.Context, ids []uuid.UUID) (int64, error) {
+	if err := q.authorizeContext(ctx, policy.ActionDelete, rbac.ResourceSystem); err != nil {
+		return 0, err
+	}
+	return q.db.DeleteAuditLogsByIDs(ctx, ids)
+}
+
 func (q *querier) DeleteChatContextResourcesByChatID(ctx context.Context, chatID uuid.UUID) error {
 	chat, err := q.db.GetChatByID(ctx, chatID)
 	if err != nil {
@@ -2254,6 +2310,13 @@ func (q *querier) DeleteChatQueuedMessageReturningCount(ctx context.Context, arg
 	return q.db.DeleteChatQueuedMessageReturningCount(ctx, arg)
 }
 
+func (q *querier) DeleteConnectionLogsByIDs(ctx context.Context, ids []uuid.UUID) (int64, error) {
+	if err := q.authorizeContext(ctx, policy.ActionDelete, rbac.ResourceSystem); err != nil {
+		return 0, err
+	}
+	return q.db.DeleteConnectionLogsByIDs(ctx, ids)
+}
+
 func (q *querier) DeleteCryptoKey(ctx context.Context, arg database.DeleteCryptoKeyParams) (database.CryptoKey, error) {
 	if err := q.authorizeContext(ctx, policy.ActionDelete, rbac.ResourceCryptoKey); err != nil {
 		return database.CryptoKey{}, err
@@ -2281,6 +2344,13 @@ func (q *querier) DeleteExpiredAPIKeys(ctx context.Context, arg database.DeleteE
 	return q.db.DeleteExpiredAPIKeys(ctx, arg)
 }
 
+func (q *querier) DeleteExpiredOAuth2ProviderAppDeviceCodes(ctx context.Context, beforeTime time.Time) error {
+	if err := q.authorizeContext(ctx, policy.ActionDelete, rbac.ResourceOauth2AppCodeToken); err != nil {
+		return err
+	}
+	return q.db.DeleteExpiredOAuth2ProviderAppDeviceCodes(ctx, beforeTime)
+}
+
 func (q *querier) DeleteExternalAuthLink(ctx context.Context, arg database.DeleteExternalAuthLinkParams) error {
 	return fetchAndExec(q.log, q.auth, policy.ActionUpdatePersonal, func(ctx context.Context, arg database.DeleteExternalAuthLinkParams) (database.ExternalAuthLink, error) {
 		return q.db.GetExternalAuthLink(ctx, database.GetExternalAuthLinkParams(arg))
@@ -2383,6 +2453,17 @@ func (q *querier) DeleteOAuth2ProviderAppCodesByAppAndUserID(ctx context.Context
 	return q.db.DeleteOAuth2ProviderAppCodesByAppAndUserID(ctx, arg)
 }
 
+func (q *querier) DeleteOAuth2ProviderAppDeviceCodeByID(ctx context.Context, id uuid.UUID) error {
+	code, err := q.db.GetOAuth2ProviderAppDeviceCodeByID(ctx, id)
+	if err != nil {
+		return err
+	}
+	if err := q.authorizeContext(ctx, policy.ActionDelete, code); err != nil {
+		return err
+	}
+	return q.db.DeleteOAuth2ProviderAppDeviceCodeByID(ctx, id)
+}
+
 func (q *querier) DeleteOAuth2ProviderAppSecretByID(ctx context.Context, id uuid.UUID) error {
 	if err := q.authorizeContext(ctx, policy.ActionDelete, rbac.ResourceOauth2AppSecret); err != nil {
 		return err
@@ -2514,6 +2595,13 @@ func (q *querier) DeleteOrganizationMember(ctx context.Context, arg database.Del
 	}, q.db.DeleteOrganizationMember)(ctx, arg)
 }
 
+func (q *querier) DeleteProvisionerJobPriority(ctx context.Context, arg database.DeleteProvisionerJobPriorityParams) error {
+	if err := q.authorizeContext(ctx, policy.ActionUpdate, rbac.ResourceProvisionerJobs.InOrg(arg.OrganizationID)); err != nil {
+		return err
+	}
+	return q.db.DeleteProvisionerJobPriority(ctx, arg)
+}
+
 func (q *querier) DeleteProvisionerKey(ctx context.Context, id uuid.UUID) error {
 	return deleteQ(q.log, q.auth, q.db.GetProvisionerKeyByID, q.db.DeleteProvisionerKey)(ctx, id)
 }
@@ -2532,6 +2620,14 @@ func (q *querier) DeleteRuntimeConfig(ctx context.Context, key string) error {
 	return q.db.DeleteRuntimeConfig(ctx, key)
 }
 
+func (q *querier) DeleteScheduleExceptionCalendarByID(ctx context.Context, id uuid.UUID) error {
+	return deleteQ(q.log, q.auth, q.db.GetScheduleExceptionCalendarByID, q.db.DeleteScheduleExceptionCalendarByID)(ctx, id)
+}
+
+func (q *querier) DeleteScheduleExceptionCalendarDates(ctx context.Context, calendarID uuid.UUID) error {
+	return fetchAndExec(q.log, q.auth, policy.ActionUpdate, q.db.GetScheduleExceptionCalendarByID, q.db.DeleteScheduleExceptionCalendarDates)(ctx, calendarID)
+}
+
 func (q *querier) DeleteStaleChatHeartbeats(ctx context.Context, staleSeconds int32) (int64, error) {
 	if err := q.authorizeContext(ctx, policy.ActionUpdate, rbac.ResourceChat); err != nil {
 		return 0, err
@@ -2576,6 +2672,10 @@ func (q *querier) DeleteTask(ctx context.Context, arg database.DeleteTaskParams)
 	return q.db.DeleteTask(ctx, arg)
 }
 
+func (q *querier) DeleteTemplateScheduleExceptionCalendars(ctx context.Context, templateID uuid.UUID) error {
+	return fetchAndExec(q.log, q.auth, policy.ActionUpdate, q.db.GetTemplateByID, q.db.DeleteTemplateScheduleExceptionCalendars)(ctx, templateID)
+}
+
 func (q *querier) DeleteUnlinkedChatFilesByIDs(ctx context.Context, arg database.DeleteUnlinkedChatFilesByIDsParams) (int64, error) {
 	if err := q.authorizeContext(ctx, policy.ActionDelete, rbac.ResourceSystem); err != nil {
 		return 0, err
@@ -2638,6 +2738,17 @@ func (q *querier) DeleteUserChatCompactionThreshold(ctx context.Context, arg dat
 	return q.db.DeleteUserChatCompactionThreshold(ctx, arg)
 }
 
+func (q *querier) DeleteUserOutOfOfficeRange(ctx context.Context, arg database.DeleteUserOutOfOfficeRangeParams) error {
+	u, err := q.db.GetUserByID(ctx, arg.UserID)
+	if err != nil {
+		return err
+	}
+	if err := q.authorizeContext(ctx, policy.ActionUpdatePersonal, u); err != nil {
+		return err
+	}
+	return q.db.DeleteUserOutOfOfficeRange(ctx, arg)
+}
+
 func (q *querier) DeleteUserSecretByUserIDAndName(ctx context.Context, arg database.DeleteUserSecretByUserIDAndNameParams) (database.UserSecret, error) {
 	obj := rbac.ResourceUserSecret.WithOwner(arg.UserID.String())
 	if err := q.authorizeContext(ctx, policy.ActionDelete, obj); err != nil {
@@ -2688,6 +2799,13 @@ func (q *querier) DeleteWorkspaceACLsByOrganization(ctx context.Context, params
 	return q.db.DeleteWorkspaceACLsByOrganization(ctx, params)
 }
 
+func (q *querier) DeleteWorkspaceAgentLogsByIDs(ctx context.Context, ids []int64) (int64, error) {
+	if err := q.authorizeContext(ctx, policy.ActionDelete, rbac.ResourceSystem); err != nil {
+		return 0, err
+	}
+	return q.db.DeleteWorkspaceAgentLogsByIDs(ctx, ids)
+}
+
 func (q *querier) DeleteWorkspaceAgentPortShare(ctx context.Context, arg database.DeleteWorkspaceAgentPortShareParams) error {
 	w, err := q.db.GetWorkspaceByID(ctx, arg.WorkspaceID)
 	if err != nil {
@@ -2792,6 +2910,20 @@ func (q *querier) FetchNewMessageMetadata(ctx context.Context, arg database.Fetc
 	return q.db.FetchNewMessageMetadata(ctx, arg)
 }
 
+func (q *querier) FetchResourceMonitorsByAgentID(ctx context.Context, agentID uuid.UUID) ([]database.WorkspaceAgentResourceMonitor, error) {
+	workspace, err := q.db.GetWorkspaceByAgentID(ctx, agentID)
+	if err != nil {
+		return nil, err
+	}
+
+	err = q.authorizeContext(ctx, policy.ActionRead, workspace)
+	if err != nil {
+		return nil, err
+	}
+
+	return q.db.FetchResourceMonitorsByAgentID(ctx, agentID)
+}
+
 func (q *querier) FetchVolumesResourceMonitorsByAgentID(ctx context.Context, agentID uuid.UUID) ([]database.WorkspaceAgentVolumeResourceMonitor, error) {
 	workspace, err := q.db.GetWorkspaceByAgentID(ctx, agentID)
 	if err != nil {
@@ -3021,6 +3153,18 @@ func (q *querier) GetActivePresetPrebuildSchedules(ctx context.Context) ([]datab
 	return q.db.GetActivePresetPrebuildSchedules(ctx)
 }
 
+func (q *querier) GetActiveTemplateVersionRolloutByTemplateID(ctx context.Context, templateID uuid.UUID) (database.TemplateVersionRollout, error) {
+	// An actor can read the rollouts of a template if they can read the template.
+	template, err := q.db.GetTemplateByID(ctx, templateID)
+	if err != nil {
+		return database.TemplateVersionRollout{}, err
+	}
+	if err := q.authorizeContext(ctx, policy.ActionRead, template); err != nil {
+		return database.TemplateVersionRollout{}, err
+	}
+	return q.db.GetActiveTemplateVersionRolloutByTemplateID(ctx, templateID)
+}
+
 func (q *querier) GetActiveUserCount(ctx context.Context, includeSystem bool) (int64, error) {
 	if err := q.authorizeContext(ctx, policy.ActionRead, rbac.ResourceSystem); err != nil {
 		return 0, err
@@ -3999,6 +4143,13 @@ func (q *querier) GetHighestGroupAIBudgetByUser(ctx context.Context, userID uuid
 	return q.db.GetHighestGroupAIBudgetByUser(ctx, userID)
 }
 
+func (q *querier) GetInProgressTemplateVersionRollouts(ctx context.Context) ([]database.TemplateVersionRollout, error) {
+	if err := q.authorizeContext(ctx, policy.ActionRead, rbac.ResourceSystem); err != nil {
+		return nil, err
+	}
+	return q.db.GetInProgressTemplateVersionRollouts(ctx)
+}
+
 func (q *querier) GetInboxNotificationByID(ctx context.Context, id uuid.UUID) (database.InboxNotification, error) {
 	return fetchWithAction(q.log, q.auth, policy.ActionRead, q.db.GetInboxNotificationByID)(ctx, id)
 }
@@ -4083,6 +4234,13 @@ func (q *querier) GetLatestWorkspaceBuildsByWorkspaceIDs(ctx context.Context, id
 	return q.db.GetLatestWorkspaceBuildsByWorkspaceIDs(ctx, ids)
 }
 
+func (q *querier) GetLatestWorkspaceDriftChecksByWorkspaceIDs(ctx context.Context, ids []uuid.UUID) ([]database.WorkspaceDriftCheck, error) {
+	if err := q.authorizeContext(ctx, policy.ActionRead, rbac.ResourceSystem); err != nil {
+		return nil, err
+	}
+	return q.db.GetLatestWorkspaceDriftChecksByWorkspaceIDs(ctx, ids)
+}
+
 func (q *querier) GetLicenseByID(ctx context.Context, id int32) (database.License, error) {
 	return fetch(q.log, q.auth, q.db.GetLicenseByID)(ctx, id)
 }
@@ -4210,6 +4368,18 @@ func (q *querier) GetOAuth2ProviderAppCodeByPrefix(ctx context.Context, secretPr
 	return fetch(q.log, q.auth, q.db.GetOAuth2ProviderAppCodeByPrefix)(ctx, secretPrefix)
 }
 
+func (q *querier) GetOAuth2ProviderAppDeviceCodeByID(ctx context.Context, id uuid.UUID) (database.OAuth2ProviderAppDeviceCode, error) {
+	return fetch(q.log, q.auth, q.db.GetOAuth2ProviderAppDeviceCodeByID)(ctx, id)
+}
+
+func (q *querier) GetOAuth2ProviderAppDeviceCodeByPrefix(ctx context.Context, deviceCodePrefix []byte) (database.OAuth2ProviderAppDeviceCode, error) {
+	return fetch(q.log, q.auth, q.db.GetOAuth2ProviderAppDeviceCodeByPrefix)(ctx, deviceCodePrefix)
+}
+
+func (q *querier) GetOAuth2ProviderAppDeviceCodeByUserCode(ctx context.Context, userCode string) (database.OAuth2ProviderAppDeviceCode, error) {
+	return fetch(q.log, q.auth, q.db.GetOAuth2ProviderAppDeviceCodeByUserCode)(ctx, userCode)
+}
+
 func (q *querier) GetOAuth2ProviderAppSecretByID(ctx context.Context, id uuid.UUID) (database.OAuth2ProviderAppSecret, error) {
 	if err := q.authorizeContext(ctx, policy.ActionRead, rbac.ResourceOauth2AppSecret); err != nil {
 		return database.OAuth2ProviderAppSecret{}, err
@@ -4270,6 +4440,40 @@ func (q *querier) GetOAuth2ProviderAppsByUserID(ctx context.Context, userID uuid
 	return q.db.GetOAuth2ProviderAppsByUserID(ctx, userID)
 }
 
+func (q *querier) GetOldAIBridgeInterceptionsForArchive(ctx context.Context, arg database.GetOldAIBridgeInterceptionsForArchiveParams) ([]database.GetOldAIBridgeInterceptionsForArchiveRow, error) {
+	// Only used to archive records before deleting them, so this requires
+	// the same permission as DeleteOldAIBridgeRecords.
+	if err := q.authorizeContext(ctx, policy.ActionDelete, rbac.ResourceAibridgeInterception); err != nil {
+		return nil, err
+	}
+	return q.db.GetOldAIBridgeInterceptionsForArchive(ctx, arg)
+}
+
+func (q *querier) GetOldAuditLogConnectionEventsForArchive(ctx context.Context, arg database.GetOldAuditLogConnectionEventsForArchiveParams) ([]json.RawMessage, error) {
+	// Only used to archive records before deleting them, so this requires
+	// the same permission as DeleteOldAuditLogConnectionEvents.
+	if err := q.authorizeContext(ctx, policy.ActionDelete, rbac.ResourceSystem); err != nil {
+		return nil, err
+	}
+	return q.db.GetOldAuditLogConnectionEventsForArchive(ctx, arg)
+}
+
+func (q *querier) GetOldAuditLogsForArchive(ctx context.Context, arg database.GetOldAuditLogsForArchiveParams) ([]json.RawMessage, error) {
+	// Only used to archive records before deleting them, so this requires
+	// the same permission as DeleteOldAuditLogs.
+	if err := q.authorizeContext(ctx, policy.ActionDelete, rbac.ResourceSystem); err != nil {
+		return nil, err
+	}
+	return q.db.GetOldAuditLogsForArchive(ctx, arg)
+}
+
+func (q *querier) GetOldConnectionLogsForArchive(ctx context.Context, arg database.GetOldConnectionLogsForArchiveParams) ([]json.RawMessage, error) {
+	if err := q.authorizeContext(ctx, policy.ActionDelete, rbac.ResourceSystem); err != nil {
+		return nil, err
+	}
+	return q.db.GetOldConnectionLogsForArchive(ctx, arg)
+}
+
 func (q *querier) GetOldUnlinkedChatFileIDs(ctx context.Context, arg database.GetOldUnlinkedChatFileIDsParams) ([]uuid.UUID, error) {
 	if err := q.authorizeContext(ctx, policy.ActionDelete, rbac.ResourceSystem); err != nil {
 		return nil, err
@@ -4277,6 +4481,13 @@ func (q *querier) GetOldUnlinkedChatFileIDs(ctx context.Context, arg database.Ge
 	return q.db.GetOldUnlinkedChatFileIDs(ctx, arg)
 }
 
+func (q *querier) GetOldWorkspaceAgentLogsForArchive(ctx context.Context, arg database.GetOldWorkspaceAgentLogsForArchiveParams) ([]database.GetOldWorkspaceAgentLogsForArchiveRow, error) {
+	if err := q.authorizeContext(ctx, policy.ActionDelete, rbac.ResourceSystem); err != nil {
+		return nil, err
+	}
+	return q.db.GetOldWorkspaceAgentLogsForArchive(ctx, arg)
+}
+
 func (q *querier) GetOrganizationByID(ctx context.Context, id uuid.UUID) (database.Organization, error) {
 	return fetch(q.log, q.auth, q.db.GetOrganizationByID)(ctx, id)
 }
@@ -4520,6 +4731,13 @@ func (q *querier) GetProvisionerJobByIDWithLock(ctx context.Context, id uuid.UUI
 	return job, nil
 }
 
+func (q *querier) GetProvisionerJobPrioritiesByOrganizationID(ctx context.Context, organizationID uuid.UUID) ([]database.ProvisionerJobPriority, error) {
+	if err := q.authorizeContext(ctx, policy.ActionRead, rbac.ResourceProvisionerJobs.InOrg(organizationID)); err != nil {
+		return nil, err
+	}
+	return q.db.GetProvisionerJobPrioritiesByOrganizationID(ctx, organizationID)
+}
+
 func (q *querier) GetProvisionerJobTimingsByJobID(ctx context.Context, jobID uuid.UUID) ([]database.ProvisionerJobTiming, error) {
 	_, err := q.GetProvisionerJobByID(ctx, jobID)
 	if err != nil {
@@ -4575,6 +4793,13 @@ func (q *querier) GetProvisionerLogsAfterID(ctx context.Context, arg database.Ge
 	return q.db.GetProvisionerLogsAfterID(ctx, arg)
 }
 
+func (q *querier) GetPurgeArchiveImports(ctx context.Context) ([]database.PurgeArchiveImport, error) {
+	if err := q.authorizeContext(ctx, policy.ActionRead, rbac.ResourceSystem); err != nil {
+		return nil, err
+	}
+	return q.db.GetPurgeArchiveImports(ctx)
+}
+
 func (q *querier) GetQuotaAllowanceForUser(ctx context.Context, params database.GetQuotaAllowanceForUserParams) (int64, error) {
 	err := q.authorizeContext(ctx, policy.ActionRead, rbac.ResourceUserObject(params.UserID))
 	if err != nil {
@@ -4627,6 +4852,34 @@ func (q *querier) GetRuntimeConfig(ctx context.Context, key string) (string, err
 	return q.db.GetRuntimeConfig(ctx, key)
 }
 
+func (q *querier) GetScheduleExceptionCalendarByID(ctx context.Context, id uuid.UUID) (database.ScheduleExceptionCalendar, error) {
+	return fetch(q.log, q.auth, q.db.GetScheduleExceptionCalendarByID)(ctx, id)
+}
+
+func (q *querier) GetScheduleExceptionCalendarDates(ctx context.Context, calendarID uuid.UUID) ([]database.ScheduleExceptionCalendarDate, error) {
+	// An actor can read the dates if they can read the calendar.
+	if _, err := q.GetScheduleExceptionCalendarByID(ctx, calendarID); err != nil {
+		return nil, err
+	}
+	return q.db.GetScheduleExceptionCalendarDates(ctx, calendarID)
+}
+
+func (q *querier) GetScheduleExceptionCalendarsByOrganizationID(ctx context.Context, organizationID uuid.UUID) ([]database.ScheduleExceptionCalendar, error) {
+	if err := q.authorizeContext(ctx, policy.ActionRead, rbac.ResourceTemplate.InOrg(organizationID)); err != nil {
+		return nil, err
+	}
+	return q.db.GetScheduleExceptionCalendarsByOrganizationID(ctx, organizationID)
+}
+
+func (q *querier) GetScheduleExceptionCalendarsByTemplateID(ctx context.Context, templateID uuid.UUID) ([]database.ScheduleExceptionCalendar, error) {
+	// An actor can see which calendars are attached to a template if they can
+	// read the template.
+	if _, err := q.GetTemplateByID(ctx, templateID); err != nil {
+		return nil, err
+	}
+	return q.db.GetScheduleExceptionCalendarsByTemplateID(ctx, templateID)
+}
+
 func (q *querier) GetStaleChats(ctx context.Context, staleThreshold time.Time) ([]database.Chat, error) {
 	// GetStaleChats is a system-level operation used by the chat processor for recovery.
 	if err := q.authorizeContext(ctx, policy.ActionRead, rbac.ResourceChat); err != nil {
@@ -4893,6 +5146,47 @@ func (q *querier) GetTemplateVersionParameters(ctx context.Context, templateVers
 	return q.db.GetTemplateVersionParameters(ctx, templateVersionID)
 }
 
+func (q *querier) GetTemplateVersionRolloutByID(ctx context.Context, id uuid.UUID) (database.TemplateVersionRollout, error) {
+	rollout, err := q.db.GetTemplateVersionRolloutByID(ctx, id)
+	if err != nil {
+		return database.TemplateVersionRollout{}, err
+	}
+	template, err := q.db.GetTemplateByID(ctx, rollout.TemplateID)
+	if err != nil {
+		return database.TemplateVersionRollout{}, err
+	}
+	if err := q.authorizeContext(ctx, policy.ActionRead, template); err != nil {
+		return database.TemplateVersionRollout{}, err
+	}
+	return rollout, nil
+}
+
+func (q *querier) GetTemplateVersionRolloutStats(ctx context.Context, arg database.GetTemplateVersionRolloutStatsParams) (database.GetTemplateVersionRolloutStatsRow, error) {
+	if err := q.authorizeContext(ctx, policy.ActionRead, rbac.ResourceSystem); err != nil {
+		return database.GetTemplateVersionRolloutStatsRow{}, err
+	}
+	return q.db.GetTemplateVersionRolloutStats(ctx, arg)
+}
+
+func (q *querier) GetTemplateVersionRolloutTargetsUser(ctx context.Context, arg database.GetTemplateVersionRolloutTargetsUserParams) (bool, error) {
+	// Anyone who can read the rollout can check whether it targets a user.
+	if _, err := q.GetTemplateVersionRolloutByID(ctx, arg.RolloutID); err != nil {
+		return false, err
+	}
+	return q.db.GetTemplateVersionRolloutTargetsUser(ctx, arg)
+}
+
+func (q *querier) GetTemplateVersionRolloutsByTemplateID(ctx context.Context, templateID uuid.UUID) ([]database.TemplateVersionRollout, error) {
+	template, err := q.db.GetTemplateByID(ctx, templateID)
+	if err != nil {
+		return nil, err
+	}
+	if err := q.authorizeContext(ctx, policy.ActionRead, template); err != nil {
+		return nil, err
+	}
+	return q.db.GetTemplateVersionRolloutsByTemplateID(ctx, templateID)
+}
+
 func (q *querier) GetTemplateVersionTerraformValues(ctx context.Context, templateVersionID uuid.UUID) (database.TemplateVersionTerraformValue, error) {
 	// The template_version_terraform_values table should follow the same access
 	// control as the template_version table. Rather than reimplement the checks,
@@ -5254,6 +5548,17 @@ func (q *querier) GetUserNotificationPreferences(ctx context.Context, userID uui
 	return q.db.GetUserNotificationPreferences(ctx, userID)
 }
 
+func (q *querier) GetUserOutOfOfficeRangesByUserID(ctx context.Context, userID uuid.UUID) ([]database.UserOutOfOfficeRange, error) {
+	u, err := q.db.GetUserByID(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+	if err := q.authorizeContext(ctx, policy.ActionReadPersonal, u); err != nil {
+		return nil, err
+	}
+	return q.db.GetUserOutOfOfficeRangesByUserID(ctx, userID)
+}
+
 func (q *querier) GetUserSecretByID(ctx context.Context, id uuid.UUID) (database.UserSecret, error) {
 	return fetch(q.log, q.auth, q.db.GetUserSecretByID)(ctx, id)
 }
@@ -5436,6 +5741,13 @@ func (q *querier) GetWorkspaceAgentLogSourcesByAgentIDs(ctx context.Context, ids
 	return q.db.GetWorkspaceAgentLogSourcesByAgentIDs(ctx, ids)
 }
 
+func (q *querier) GetWorkspaceAgentLogTailsByAgentIDs(ctx context.Context, ids []uuid.UUID) ([]database.WorkspaceAgentLogTail, error) {
+	if err := q.authorizeContext(ctx, policy.ActionRead, rbac.ResourceSystem); err != nil {
+		return nil, err
+	}
+	return q.db.GetWorkspaceAgentLogTailsByAgentIDs(ctx, ids)
+}
+
 func (q *querier) GetWorkspaceAgentLogsAfter(ctx context.Context, arg database.GetWorkspaceAgentLogsAfterParams) ([]database.WorkspaceAgentLog, error) {
 	_, err := q.GetWorkspaceAgentByID(ctx, arg.AgentID)
 	if err != nil {
@@ -5458,6 +5770,60 @@ func (q *querier) GetWorkspaceAgentMetadata(ctx context.Context, arg database.Ge
 	return q.db.GetWorkspaceAgentMetadata(ctx, arg)
 }
 
+func (q *querier) GetWorkspaceAgentPTYShareByHashedToken(ctx context.Context, hashedToken []byte) (database.WorkspaceAgentPTYShare, error) {
+	// Viewers of a share do not necessarily have access to the workspace, so
+	// shares are looked up by token as the system.
+	if err := q.authorizeContext(ctx, policy.ActionRead, rbac.ResourceSystem); err != nil {
+		return database.WorkspaceAgentPTYShare{}, err
+	}
+	return q.db.GetWorkspaceAgentPTYShareByHashedToken(ctx, hashedToken)
+}
+
+func (q *querier) GetWorkspaceAgentPTYShareByID(ctx context.Context, id uuid.UUID) (database.WorkspaceAgentPTYShare, error) {
+	share, err := q.db.GetWorkspaceAgentPTYShareByID(ctx, id)
+	if err != nil {
+		return database.WorkspaceAgentPTYShare{}, err
+	}
+
+	workspace, err := q.db.GetWorkspaceByAgentID(ctx, share.AgentID)
+	if err != nil {
+		return database.WorkspaceAgentPTYShare{}, err
+	}
+
+	// Managing terminal shares is part of sharing the workspace.
+	if err := q.authorizeContext(ctx, policy.ActionShare, workspace); err != nil {
+		return database.WorkspaceAgentPTYShare{}, err
+	}
+
+	return share, nil
+}
+
+func (q *querier) GetWorkspaceAgentPTYShareViewersByAgentID(ctx context.Context, agentID uuid.UUID) ([]database.GetWorkspaceAgentPTYShareViewersByAgentIDRow, error) {
+	workspace, err := q.db.GetWorkspaceByAgentID(ctx, agentID)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := q.authorizeContext(ctx, policy.ActionShare, workspace); err != nil {
+		return nil, err
+	}
+
+	return q.db.GetWorkspaceAgentPTYShareViewersByAgentID(ctx, agentID)
+}
+
+func (q *querier) GetWorkspaceAgentPTYSharesByAgentID(ctx context.Context, agentID uuid.UUID) ([]database.WorkspaceAgentPTYShare, error) {
+	workspace, err := q.db.GetWorkspaceByAgentID(ctx, agentID)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := q.authorizeContext(ctx, policy.ActionShare, workspace); err != nil {
+		return nil, err
+	}
+
+	return q.db.GetWorkspaceAgentPTYSharesByAgentID(ctx, agentID)
+}
+
 func (q *querier) GetWorkspaceAgentPortShare(ctx context.Context, arg database.GetWorkspaceAgentPortShareParams) (database.WorkspaceAgentPortShare, error) {
 	w, err := q.db.GetWorkspaceByID(ctx, arg.WorkspaceID)
 	if err != nil {
@@ -5632,6 +5998,21 @@ func (q *querier) GetWorkspaceAppsCreatedAfter(ctx context.Context, createdAt ti
 	return q.db.GetWorkspaceAppsCreatedAfter(ctx, createdAt)
 }
 
+func (q *querier) GetWorkspaceAutostartExceptions(ctx context.Context, arg database.GetWorkspaceAutostartExceptionsParams) ([]database.GetWorkspaceAutostartExceptionsRow, error) {
+	// The exceptions are part of the workspace's schedule.
+	if _, err := q.GetWorkspaceByID(ctx, arg.WorkspaceID); err != nil {
+		return nil, err
+	}
+	return q.db.GetWorkspaceAutostartExceptions(ctx, arg)
+}
+
+func (q *querier) GetWorkspaceAutostartExceptionsByWorkspaceIDs(ctx context.Context, arg database.GetWorkspaceAutostartExceptionsByWorkspaceIDsParams) ([]database.GetWorkspaceAutostartExceptionsByWorkspaceIDsRow, error) {
+	if err := q.authorizeContext(ctx, policy.ActionRead, rbac.ResourceSystem); err != nil {
+		return nil, err
+	}
+	return q.db.GetWorkspaceAutostartExceptionsByWorkspaceIDs(ctx, arg)
+}
+
 func (q *querier) GetWorkspaceBuildAgentsByInstanceID(ctx context.Context, authInstanceID string) ([]database.GetWorkspaceBuildAgentsByInstanceIDRow, error) {
 	if err := q.authorizeContext(ctx, policy.ActionRead, rbac.ResourceSystem); err == nil {
 		return q.db.GetWorkspaceBuildAgentsByInstanceID(ctx, authInstanceID)
@@ -5719,6 +6100,30 @@ func (q *querier) GetWorkspaceBuildsCreatedAfter(ctx context.Context, createdAt
 	return q.db.GetWorkspaceBuildsCreatedAfter(ctx, createdAt)
 }
 
+func (q *querier) GetWorkspaceBulkOperationByID(ctx context.Context, id uuid.UUID) (database.WorkspaceBulkOperation, error) {
+	return fetchWithAction(q.log, q.auth, policy.ActionReadPersonal, q.db.GetWorkspaceBulkOperationByID)(ctx, id)
+}
+
+func (q *querier) GetWorkspaceBulkOperationProgress(ctx context.Context, operationID uuid.UUID) (database.GetWorkspaceBulkOperationProgressRow, error) {
+	// Authorized by reading the operation.
+	if _, err := q.GetWorkspaceBulkOperationByID(ctx, operationID); err != nil {
+		return database.GetWorkspaceBulkOperationProgressRow{}, err
+	}
+	return q.db.GetWorkspaceBulkOperationProgress(ctx, operationID)
+}
+
+func (q *querier) GetWorkspaceBulkOperationWorkspaces(ctx context.Context, operationID uuid.UUID) ([]database.GetWorkspaceBulkOperationWorkspacesRow, error) {
+	// Authorized by reading the operation.
+	if _, err := q.GetWorkspaceBulkOperationByID(ctx, operationID); err != nil {
+		return nil, err
+	}
+	return q.db.GetWorkspaceBulkOperationWorkspaces(ctx, operationID)
+}
+
+func (q *querier) GetWorkspaceBulkOperationsByInitiatorID(ctx context.Context, arg database.GetWorkspaceBulkOperationsByInitiatorIDParams) ([]database.WorkspaceBulkOperation, error) {
+	return fetchWithPostFilter(q.auth, policy.ActionReadPersonal, q.db.GetWorkspaceBulkOperationsByInitiatorID)(ctx, arg)
+}
+
 func (q *querier) GetWorkspaceByAgentID(ctx context.Context, agentID uuid.UUID) (database.Workspace, error) {
 	return fetch(q.log, q.auth, q.db.GetWorkspaceByAgentID)(ctx, agentID)
 }
@@ -5739,6 +6144,13 @@ func (q *querier) GetWorkspaceByWorkspaceAppID(ctx context.Context, workspaceApp
 	return fetch(q.log, q.auth, q.db.GetWorkspaceByWorkspaceAppID)(ctx, workspaceAppID)
 }
 
+func (q *querier) GetWorkspaceDriftCheckByJobID(ctx context.Context, jobID uuid.UUID) (database.WorkspaceDriftCheck, error) {
+	if err := q.authorizeContext(ctx, policy.ActionRead, rbac.ResourceSystem); err != nil {
+		return database.WorkspaceDriftCheck{}, err
+	}
+	return q.db.GetWorkspaceDriftCheckByJobID(ctx, jobID)
+}
+
 func (q *querier) GetWorkspaceModulesByJobID(ctx context.Context, jobID uuid.UUID) ([]database.WorkspaceModule, error) {
 	if err := q.authorizeContext(ctx, policy.ActionRead, rbac.ResourceSystem); err != nil {
 		return nil, err
@@ -5840,6 +6252,12 @@ func (q *querier) GetWorkspaceResourcesByJobID(ctx context.Context, jobID uuid.U
 			return nil, err
 		}
 		obj = workspace
+	case database.ProvisionerJobTypeWorkspaceDriftCheck:
+		workspace, err := workspaceFromDriftCheckJob(ctx, q.db, job)
+		if err != nil {
+			return nil, err
+		}
+		obj = workspace
 	default:
 		return nil, xerrors.Errorf("unknown job type: %s", job.Type)
 	}
@@ -5897,6 +6315,13 @@ func (q *querier) GetWorkspacesByTemplateID(ctx context.Context, templateID uuid
 	return q.db.GetWorkspacesByTemplateID(ctx, templateID)
 }
 
+func (q *querier) GetWorkspacesEligibleForDriftCheck(ctx context.Context, now time.Time) ([]database.GetWorkspacesEligibleForDriftCheckRow, error) {
+	if err := q.authorizeContext(ctx, policy.ActionRead, rbac.ResourceSystem); err != nil {
+		return nil, err
+	}
+	return q.db.GetWorkspacesEligibleForDriftCheck(ctx, now)
+}
+
 func (q *querier) GetWorkspacesEligibleForLifecycleAction(ctx context.Context, now time.Time) ([]database.GetWorkspacesEligibleForLifecycleActionRow, error) {
 	return q.db.GetWorkspacesEligibleForLifecycleAction(ctx, now)
 }
@@ -6272,6 +6697,14 @@ func (q *querier) InsertOAuth2ProviderAppCode(ctx context.Context, arg database.
 	return q.db.InsertOAuth2ProviderAppCode(ctx, arg)
 }
 
+func (q *querier) InsertOAuth2ProviderAppDeviceCode(ctx context.Context, arg database.InsertOAuth2ProviderAppDeviceCodeParams) (database.OAuth2ProviderAppDeviceCode, error) {
+	// Device codes have no owner until a user approves or denies them.
+	if err := q.authorizeContext(ctx, policy.ActionCreate, rbac.ResourceOauth2AppCodeToken); err != nil {
+		return database.OAuth2ProviderAppDeviceCode{}, err
+	}
+	return q.db.InsertOAuth2ProviderAppDeviceCode(ctx, arg)
+}
+
 func (q *querier) InsertOAuth2ProviderAppSecret(ctx context.Context, arg database.InsertOAuth2ProviderAppSecretParams) (database.OAuth2ProviderAppSecret, error) {
 	if err := q.authorizeContext(ctx, policy.ActionCreate, rbac.ResourceOauth2AppSecret); err != nil {
 		return database.OAuth2ProviderAppSecret{}, err
@@ -6372,6 +6805,20 @@ func (q *querier) InsertProvisionerKey(ctx context.Context, arg database.InsertP
 	return insert(q.log, q.auth, rbac.ResourceProvisionerDaemon.InOrg(arg.OrganizationID).WithID(arg.ID), q.db.InsertProvisionerKey)(ctx, arg)
 }
 
+func (q *querier) InsertPurgeArchiveImport(ctx context.Context, arg database.InsertPurgeArchiveImportParams) (database.PurgeArchiveImport, error) {
+	if err := q.authorizeContext(ctx, policy.ActionCreate, rbac.ResourceSystem); err != nil {
+		return database.PurgeArchiveImport{}, err
+	}
+	return q.db.InsertPurgeArchiveImport(ctx, arg)
+}
+
+func (q *querier) InsertPurgeArchiveRecords(ctx context.Context, arg database.InsertPurgeArchiveRecordsParams) error {
+	if err := q.authorizeContext(ctx, policy.ActionCreate, rbac.ResourceSystem); err != nil {
+		return err
+	}
+	return q.db.InsertPurgeArchiveRecords(ctx, arg)
+}
+
 func (q *querier) InsertReplica(ctx context.Context, arg database.InsertReplicaParams) (database.Replica, error) {
 	if err := q.authorizeContext(ctx, policy.ActionCreate, rbac.ResourceSystem); err != nil {
 		return database.Replica{}, err
@@ -6379,6 +6826,25 @@ func (q *querier) InsertReplica(ctx context.Context, arg database.InsertReplicaP
 	return q.db.InsertReplica(ctx, arg)
 }
 
+func (q *querier) InsertResourceMonitor(ctx context.Context, arg database.InsertResourceMonitorParams) (database.WorkspaceAgentResourceMonitor, error) {
+	if err := q.authorizeContext(ctx, policy.ActionCreate, rbac.ResourceWorkspaceAgentResourceMonitor); err != nil {
+		return database.WorkspaceAgentResourceMonitor{}, err
+	}
+
+	return q.db.InsertResourceMonitor(ctx, arg)
+}
+
+func (q *querier) InsertScheduleExceptionCalendar(ctx context.Context, arg database.InsertScheduleExceptionCalendarParams) (database.ScheduleExceptionCalendar, error) {
+	return insert(q.log, q.auth, rbac.ResourceTemplate.InOrg(arg.OrganizationID), q.db.InsertScheduleExceptionCalendar)(ctx, arg)
+}
+
+func (q *querier) InsertScheduleExceptionCalendarDates(ctx context.Context, arg database.InsertScheduleExceptionCalendarDatesParams) error {
+	fetch := func(ctx context.Context, arg database.InsertScheduleExceptionCalendarDatesParams) (database.ScheduleExceptionCalendar, error) {
+		return q.db.GetScheduleExceptionCalendarByID(ctx, arg.CalendarID)
+	}
+	return fetchAndExec(q.log, q.auth, policy.ActionUpdate, fetch, q.db.InsertScheduleExceptionCalendarDates)(ctx, arg)
+}
+
 func (q *querier) InsertTask(ctx context.Context, arg database.InsertTaskParams) (database.TaskTable, error) {
 	// Ensure the actor can access the specified template version (and thus its template).
 	if _, err := q.GetTemplateVersionByID(ctx, arg.TemplateVersionID); err != nil {
@@ -6412,6 +6878,13 @@ func (q *querier) InsertTemplate(ctx context.Context, arg database.InsertTemplat
 	return q.db.InsertTemplate(ctx, arg)
 }
 
+func (q *querier) InsertTemplateScheduleExceptionCalendars(ctx context.Context, arg database.InsertTemplateScheduleExceptionCalendarsParams) error {
+	fetch := func(ctx context.Context, arg database.InsertTemplateScheduleExceptionCalendarsParams) (database.Template, error) {
+		return q.db.GetTemplateByID(ctx, arg.TemplateID)
+	}
+	return fetchAndExec(q.log, q.auth, policy.ActionUpdate, fetch, q.db.InsertTemplateScheduleExceptionCalendars)(ctx, arg)
+}
+
 func (q *querier) InsertTemplateVersion(ctx context.Context, arg database.InsertTemplateVersionParams) error {
 	if !arg.TemplateID.Valid {
 		// Making a new template version is the same permission as creating a new template.
@@ -6442,6 +6915,20 @@ func (q *querier) InsertTemplateVersionParameter(ctx context.Context, arg databa
 	return q.db.InsertTemplateVersionParameter(ctx, arg)
 }
 
+func (q *querier) InsertTemplateVersionRollout(ctx context.Context, arg database.InsertTemplateVersionRolloutParams) (database.TemplateVersionRollout, error) {
+	// Starting a rollout changes the version that workspaces of the template
+	// are updated to, so it requires the same permission as promoting a
+	// version.
+	template, err := q.db.GetTemplateByID(ctx, arg.TemplateID)
+	if err != nil {
+		return database.TemplateVersionRollout{}, err
+	}
+	if err := q.authorizeContext(ctx, policy.ActionUpdate, template); err != nil {
+		return database.TemplateVersionRollout{}, err
+	}
+	return q.db.InsertTemplateVersionRollout(ctx, arg)
+}
+
 func (q *querier) InsertTemplateVersionTerraformValuesByJobID(ctx context.Context, arg database.InsertTemplateVersionTerraformValuesByJobIDParams) error {
 	if err := q.authorizeContext(ctx, policy.ActionCreate, rbac.ResourceSystem); err != nil {
 		return err
@@ -6497,6 +6984,17 @@ func (q *querier) InsertUserLink(ctx context.Context, arg database.InsertUserLin
 	return q.db.InsertUserLink(ctx, arg)
 }
 
+func (q *querier) InsertUserOutOfOfficeRange(ctx context.Context, arg database.InsertUserOutOfOfficeRangeParams) (database.UserOutOfOfficeRange, error) {
+	u, err := q.db.GetUserByID(ctx, arg.UserID)
+	if err != nil {
+		return database.UserOutOfOfficeRange{}, err
+	}
+	if err := q.authorizeContext(ctx, policy.ActionUpdatePersonal, u); err != nil {
+		return database.UserOutOfOfficeRange{}, err
+	}
+	return q.db.InsertUserOutOfOfficeRange(ctx, arg)
+}
+
 func (q *querier) InsertUserSkill(ctx context.Context, arg database.InsertUserSkillParams) (database.UserSkill, error) {
 	obj := rbac.ResourceUserSkill.WithOwner(arg.UserID.String())
 	if err := q.authorizeContext(ctx, policy.ActionCreate, obj); err != nil {
@@ -6567,6 +7065,13 @@ func (q *querier) InsertWorkspaceAgentLogSources(ctx context.Context, arg databa
 	return q.db.InsertWorkspaceAgentLogSources(ctx, arg)
 }
 
+func (q *querier) InsertWorkspaceAgentLogTail(ctx context.Context, arg database.InsertWorkspaceAgentLogTailParams) (database.WorkspaceAgentLogTail, error) {
+	if err := q.authorizeContext(ctx, policy.ActionCreate, rbac.ResourceSystem); err != nil {
+		return database.WorkspaceAgentLogTail{}, err
+	}
+	return q.db.InsertWorkspaceAgentLogTail(ctx, arg)
+}
+
 func (q *querier) InsertWorkspaceAgentLogs(ctx context.Context, arg database.InsertWorkspaceAgentLogsParams) ([]database.WorkspaceAgentLog, error) {
 	// TODO: This is used by the agent, should we have an rbac check here?
 	return q.db.InsertWorkspaceAgentLogs(ctx, arg)
@@ -6582,6 +7087,26 @@ func (q *querier) InsertWorkspaceAgentMetadata(ctx context.Context, arg database
 	return q.db.InsertWorkspaceAgentMetadata(ctx, arg)
 }
 
+func (q *querier) InsertWorkspaceAgentPTYShare(ctx context.Context, arg database.InsertWorkspaceAgentPTYShareParams) (database.WorkspaceAgentPTYShare, error) {
+	workspace, err := q.db.GetWorkspaceByAgentID(ctx, arg.AgentID)
+	if err != nil {
+		return database.WorkspaceAgentPTYShare{}, err
+	}
+
+	if err := q.authorizeContext(ctx, policy.ActionShare, workspace); err != nil {
+		return database.WorkspaceAgentPTYShare{}, err
+	}
+
+	return q.db.InsertWorkspaceAgentPTYShare(ctx, arg)
+}
+
+func (q *querier) InsertWorkspaceAgentPTYShareViewer(ctx context.Context, arg database.InsertWorkspaceAgentPTYShareViewerParams) (database.WorkspaceAgentPTYShareViewer, error) {
+	if err := q.authorizeContext(ctx, policy.ActionCreate, rbac.ResourceSystem); err != nil {
+		return database.WorkspaceAgentPTYShareViewer{}, err
+	}
+	return q.db.InsertWorkspaceAgentPTYShareViewer(ctx, arg)
+}
+
 func (q *querier) InsertWorkspaceAgentScriptTimings(ctx context.Context, arg database.InsertWorkspaceAgentScriptTimingsParams) (database.WorkspaceAgentScriptTiming, error) {
 	if err := q.authorizeContext(ctx, policy.ActionCreate, rbac.ResourceSystem); err != nil {
 		return database.WorkspaceAgentScriptTiming{}, err
@@ -6743,6 +7268,31 @@ func (q *querier) InsertWorkspaceBuildParameters(ctx context.Context, arg databa
 	return q.db.InsertWorkspaceBuildParameters(ctx, arg)
 }
 
+func (q *querier) InsertWorkspaceBulkOperation(ctx context.Context, arg database.InsertWorkspaceBulkOperationParams) (database.WorkspaceBulkOperation, error) {
+	if err := q.authorizeContext(ctx, policy.ActionUpdatePersonal, rbac.ResourceUserObject(arg.InitiatorID)); err != nil {
+		return database.WorkspaceBulkOperation{}, err
+	}
+	return q.db.InsertWorkspaceBulkOperation(ctx, arg)
+}
+
+func (q *querier) InsertWorkspaceBulkOperationWorkspaces(ctx context.Context, arg database.InsertWorkspaceBulkOperationWorkspacesParams) error {
+	op, err := q.db.GetWorkspaceBulkOperationByID(ctx, arg.OperationID)
+	if err != nil {
+		return err
+	}
+	if err := q.authorizeContext(ctx, policy.ActionUpdatePersonal, op); err != nil {
+		return err
+	}
+	return q.db.InsertWorkspaceBulkOperationWorkspaces(ctx, arg)
+}
+
+func (q *querier) InsertWorkspaceDriftCheck(ctx context.Context, arg database.InsertWorkspaceDriftCheckParams) (database.WorkspaceDriftCheck, error) {
+	if err := q.authorizeContext(ctx, policy.ActionCreate, rbac.ResourceSystem); err != nil {
+		return database.WorkspaceDriftCheck{}, err
+	}
+	return q.db.InsertWorkspaceDriftCheck(ctx, arg)
+}
+
 func (q *querier) InsertWorkspaceModule(ctx context.Context, arg database.InsertWorkspaceModuleParams) (database.WorkspaceModule, error) {
 	if err := q.authorizeContext(ctx, policy.ActionCreate, rbac.ResourceSystem); err != nil {
 		return database.WorkspaceModule{}, err
@@ -7154,6 +7704,24 @@ func (q *querier) RevokeDBCryptKey(ctx context.Context, activeKeyDigest string)
 	return q.db.RevokeDBCryptKey(ctx, activeKeyDigest)
 }
 
+func (q *querier) RevokeWorkspaceAgentPTYShare(ctx context.Context, arg database.RevokeWorkspaceAgentPTYShareParams) error {
+	share, err := q.db.GetWorkspaceAgentPTYShareByID(ctx, arg.ID)
+	if err != nil {
+		return err
+	}
+
+	workspace, err := q.db.GetWorkspaceByAgentID(ctx, share.AgentID)
+	if err != nil {
+		return err
+	}
+
+	if err := q.authorizeContext(ctx, policy.ActionShare, workspace); err != nil {
+		return err
+	}
+
+	return q.db.RevokeWorkspaceAgentPTYShare(ctx, arg)
+}
+
 func (q *querier) SelectUsageEventsForPublishing(ctx context.Context, arg time.Time) ([]database.UsageEvent, error) {
 	// ActionUpdate because we're updating the publish_started_at column.
 	if err := q.authorizeContext(ctx, policy.ActionUpdate, rbac.ResourceUsageEvent); err != nil {
@@ -7807,6 +8375,20 @@ func (q *querier) UpdateOAuth2ProviderAppByID(ctx context.Context, arg database.
 	return q.db.UpdateOAuth2ProviderAppByID(ctx, arg)
 }
 
+func (q *querier) UpdateOAuth2ProviderAppDeviceCodePoll(ctx context.Context, arg database.UpdateOAuth2ProviderAppDeviceCodePollParams) error {
+	fetch := func(ctx context.Context, arg database.UpdateOAuth2ProviderAppDeviceCodePollParams) (database.OAuth2ProviderAppDeviceCode, error) {
+		return q.db.GetOAuth2ProviderAppDeviceCodeByID(ctx, arg.ID)
+	}
+	return update(q.log, q.auth, fetch, q.db.UpdateOAuth2ProviderAppDeviceCodePoll)(ctx, arg)
+}
+
+func (q *querier) UpdateOAuth2ProviderAppDeviceCodeStatus(ctx context.Context, arg database.UpdateOAuth2ProviderAppDeviceCodeStatusParams) (database.OAuth2ProviderAppDeviceCode, error) {
+	fetch := func(ctx context.Context, arg database.UpdateOAuth2ProviderAppDeviceCodeStatusParams) (database.OAuth2ProviderAppDeviceCode, error) {
+		return q.db.GetOAuth2ProviderAppDeviceCodeByID(ctx, arg.ID)
+	}
+	return updateWithReturn(q.log, q.auth, fetch, q.db.UpdateOAuth2ProviderAppDeviceCodeStatus)(ctx, arg)
+}
+
 func (q *querier) UpdateOrganization(ctx context.Context, arg database.UpdateOrganizationParams) (database.Organization, error) {
 	existing, err := q.db.GetOrganizationByID(ctx, arg.ID)
 	if err != nil {
@@ -7985,6 +8567,17 @@ func (q *querier) UpdateProvisionerJobWithCancelByID(ctx context.Context, arg da
 				return err
 			}
 		}
+	case database.ProvisionerJobTypeWorkspaceDriftCheck:
+		// Drift checks do not change the workspace, so anyone who can update
+		// the workspace may cancel them.
+		workspace, err := workspaceFromDriftCheckJob(ctx, q.db, job)
+		if err != nil {
+			return err
+		}
+		err = q.authorizeContext(ctx, policy.ActionUpdate, workspace)
+		if err != nil {
+			return err
+		}
 	default:
 		return xerrors.Errorf("unknown job type: %q", job.Type)
 	}
@@ -8012,6 +8605,21 @@ func (q *querier) UpdateReplica(ctx context.Context, arg database.UpdateReplicaP
 	return q.db.UpdateReplica(ctx, arg)
 }
 
+func (q *querier) UpdateResourceMonitor(ctx context.Context, arg database.UpdateResourceMonitorParams) error {
+	if err := q.authorizeContext(ctx, policy.ActionUpdate, rbac.ResourceWorkspaceAgentResourceMonitor); err != nil {
+		return err
+	}
+
+	return q.db.UpdateResourceMonitor(ctx, arg)
+}
+
+func (q *querier) UpdateScheduleExceptionCalendarByID(ctx context.Context, arg database.UpdateScheduleExceptionCalendarByIDParams) (database.ScheduleExceptionCalendar, error) {
+	fetch := func(ctx context.Context, arg database.UpdateScheduleExceptionCalendarByIDParams) (database.ScheduleExceptionCalendar, error) {
+		return q.db.GetScheduleExceptionCalendarByID(ctx, arg.ID)
+	}
+	return updateWithReturn(q.log, q.auth, fetch, q.db.UpdateScheduleExceptionCalendarByID)(ctx, arg)
+}
+
 func (q *querier) UpdateTailnetPeerStatusByCoordinator(ctx context.Context, arg database.UpdateTailnetPeerStatusByCoordinatorParams) ([]uuid.UUID, error) {
 	if err := q.authorizeContext(ctx, policy.ActionUpdate, rbac.ResourceTailnetCoordinator); err != nil {
 		return nil, err
@@ -8188,6 +8796,25 @@ func (q *querier) UpdateTemplateVersionFlagsByJobID(ctx context.Context, arg dat
 	return q.db.UpdateTemplateVersionFlagsByJobID(ctx, arg)
 }
 
+func (q *querier) UpdateTemplateVersionRolloutByID(ctx context.Context, arg database.UpdateTemplateVersionRolloutByIDParams) (database.TemplateVersionRollout, error) {
+	if err := q.authorizeTemplateVersionRolloutUpdate(ctx, arg.ID); err != nil {
+		return database.TemplateVersionRollout{}, err
+	}
+	return q.db.UpdateTemplateVersionRolloutByID(ctx, arg)
+}
+
+func (q *querier) UpdateTemplateVersionRolloutStatusByID(ctx context.Context, arg database.UpdateTemplateVersionRolloutStatusByIDParams) (database.TemplateVersionRollout, error) {
+	// The system pauses and rolls back rollouts that exceed their failure
+	// threshold.
+	if err := q.authorizeContext(ctx, policy.ActionUpdate, rbac.ResourceSystem); err == nil {
+		return q.db.UpdateTemplateVersionRolloutStatusByID(ctx, arg)
+	}
+	if err := q.authorizeTemplateVersionRolloutUpdate(ctx, arg.ID); err != nil {
+		return database.TemplateVersionRollout{}, err
+	}
+	return q.db.UpdateTemplateVersionRolloutStatusByID(ctx, arg)
+}
+
 func (q *querier) UpdateTemplateWorkspacesLastUsedAt(ctx context.Context, arg database.UpdateTemplateWorkspacesLastUsedAtParams) error {
 	fetch := func(ctx context.Context, arg database.UpdateTemplateWorkspacesLastUsedAtParams) (database.Template, error) {
 		return q.db.GetTemplateByID(ctx, arg.TemplateID)
@@ -8624,6 +9251,13 @@ func (q *querier) UpdateWorkspaceAgentMetadata(ctx context.Context, arg database
 	return q.db.UpdateWorkspaceAgentMetadata(ctx, arg)
 }
 
+func (q *querier) UpdateWorkspaceAgentPTYShareViewerDisconnectedAt(ctx context.Context, arg database.UpdateWorkspaceAgentPTYShareViewerDisconnectedAtParams) error {
+	if err := q.authorizeContext(ctx, policy.ActionUpdate, rbac.ResourceSystem); err != nil {
+		return err
+	}
+	return q.db.UpdateWorkspaceAgentPTYShareViewerDisconnectedAt(ctx, arg)
+}
+
 func (q *querier) UpdateWorkspaceAgentStartupByID(ctx context.Context, arg database.UpdateWorkspaceAgentStartupByIDParams) error {
 	agent, err := q.db.GetWorkspaceAgentByID(ctx, arg.ID)
 	if err != nil {
@@ -8774,6 +9408,27 @@ func (q *querier) UpdateWorkspaceBuildProvisionerStateByID(ctx context.Context,
 }
 
 // Deprecated: Use SoftDeleteWorkspaceByID
+func (q *querier) UpdateWorkspaceBulkOperationCanceled(ctx context.Context, arg database.UpdateWorkspaceBulkOperationCanceledParams) (database.WorkspaceBulkOperation, error) {
+	fetch := func(ctx context.Context, arg database.UpdateWorkspaceBulkOperationCanceledParams) (database.WorkspaceBulkOperation, error) {
+		return q.db.GetWorkspaceBulkOperationByID(ctx, arg.ID)
+	}
+	return fetchAndQuery(q.log, q.auth, policy.ActionUpdatePersonal, fetch, q.db.UpdateWorkspaceBulkOperationCanceled)(ctx, arg)
+}
+
+func (q *querier) UpdateWorkspaceBulkOperationCompleted(ctx context.Context, arg database.UpdateWorkspaceBulkOperationCompletedParams) error {
+	if err := q.authorizeContext(ctx, policy.ActionUpdate, rbac.ResourceSystem); err != nil {
+		return err
+	}
+	return q.db.UpdateWorkspaceBulkOperationCompleted(ctx, arg)
+}
+
+func (q *querier) UpdateWorkspaceBulkOperationWorkspace(ctx context.Context, arg database.UpdateWorkspaceBulkOperationWorkspaceParams) error {
+	if err := q.authorizeContext(ctx, policy.ActionUpdate, rbac.ResourceSystem); err != nil {
+		return err
+	}
+	return q.db.UpdateWorkspaceBulkOperationWorkspace(ctx, arg)
+}
+
 func (q *querier) UpdateWorkspaceDeletedByID(ctx context.Context, arg database.UpdateWorkspaceDeletedByIDParams) error {
 	// TODO deleteQ me, placeholder for database.Store
 	fetch := func(ctx context.Context, arg database.UpdateWorkspaceDeletedByIDParams) (database.Workspace, error) {
@@ -8794,6 +9449,13 @@ func (q *querier) UpdateWorkspaceDormantDeletingAt(ctx context.Context, arg data
 	return updateWithReturn(q.log, q.auth, fetch, q.db.UpdateWorkspaceDormantDeletingAt)(ctx, arg)
 }
 
+func (q *querier) UpdateWorkspaceDriftCheckByID(ctx context.Context, arg database.UpdateWorkspaceDriftCheckByIDParams) error {
+	if err := q.authorizeContext(ctx, policy.ActionUpdate, rbac.ResourceSystem); err != nil {
+		return err
+	}
+	return q.db.UpdateWorkspaceDriftCheckByID(ctx, arg)
+}
+
 func (q *querier) UpdateWorkspaceLastUsedAt(ctx context.Context, arg database.UpdateWorkspaceLastUsedAtParams) error {
 	fetch := func(ctx context.Context, arg database.UpdateWorkspaceLastUsedAtParams) (database.Workspace, error) {
 		return q.db.GetWorkspaceByID(ctx, arg.ID)
@@ -9125,6 +9787,13 @@ func (q *querier) UpsertProvisionerDaemon(ctx context.Context, arg database.Upse
 	return q.db.UpsertProvisionerDaemon(ctx, arg)
 }
 
+func (q *querier) UpsertProvisionerJobPriority(ctx context.Context, arg database.UpsertProvisionerJobPriorityParams) (database.ProvisionerJobPriority, error) {
+	if err := q.authorizeContext(ctx, policy.ActionUpdate, rbac.ResourceProvisionerJobs.InOrg(arg.OrganizationID)); err != nil {
+		return database.ProvisionerJobPriority{}, err
+	}
+	return q.db.UpsertProvisionerJobPriority(ctx, arg)
+}
+
 func (q *querier) UpsertRuntimeConfig(ctx context.Context, arg database.UpsertRuntimeConfigParams) error {
 	if err := q.authorizeContext(ctx, policy.ActionCreate, rbac.ResourceSystem); err != nil {
 		return err
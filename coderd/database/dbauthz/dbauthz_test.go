@@ -471,6 +471,19 @@ func (s *MethodTestSuite) TestAuditLogs() {
 		dbm.EXPECT().DeleteOldAuditLogs(gomock.Any(), database.DeleteOldAuditLogsParams{}).Return(int64(0), nil).AnyTimes()
 		check.Args(database.DeleteOldAuditLogsParams{}).Asserts(rbac.ResourceSystem, policy.ActionDelete)
 	}))
+	s.Run("GetOldAuditLogsForArchive", s.Mocked(func(dbm *dbmock.MockStore, _ *gofakeit.Faker, check *expects) {
+		dbm.EXPECT().GetOldAuditLogsForArchive(gomock.Any(), database.GetOldAuditLogsForArchiveParams{}).Return([]json.RawMessage{}, nil).AnyTimes()
+		check.Args(database.GetOldAuditLogsForArchiveParams{}).Asserts(rbac.ResourceSystem, policy.ActionDelete)
+	}))
+	s.Run("GetOldAuditLogConnectionEventsForArchive", s.Mocked(func(dbm *dbmock.MockStore, _ *gofakeit.Faker, check *expects) {
+		dbm.EXPECT().GetOldAuditLogConnectionEventsForArchive(gomock.Any(), database.GetOldAuditLogConnectionEventsForArchiveParams{}).Return([]json.RawMessage{}, nil).AnyTimes()
+		check.Args(database.GetOldAuditLogConnectionEventsForArchiveParams{}).Asserts(rbac.ResourceSystem, policy.ActionDelete)
+	}))
+	s.Run("DeleteAuditLogsByIDs", s.Mocked(func(dbm *dbmock.MockStore, _ *gofakeit.Faker, check *expects) {
+		ids := []uuid.UUID{uuid.New()}
+		dbm.EXPECT().DeleteAuditLogsByIDs(gomock.Any(), ids).Return(int64(1), nil).AnyTimes()
+		check.Args(ids).Asserts(rbac.ResourceSystem, policy.ActionDelete)
+	}))
 }
 
 func (s *MethodTestSuite) TestBoundaryLogs() {
@@ -557,6 +570,15 @@ func (s *MethodTestSuite) TestConnectionLogs() {
 		dbm.EXPECT().DeleteOldConnectionLogs(gomock.Any(), database.DeleteOldConnectionLogsParams{}).Return(int64(0), nil).AnyTimes()
 		check.Args(database.DeleteOldConnectionLogsParams{}).Asserts(rbac.ResourceSystem, policy.ActionDelete)
 	}))
+	s.Run("GetOldConnectionLogsForArchive", s.Mocked(func(dbm *dbmock.MockStore, _ *gofakeit.Faker, check *expects) {
+		dbm.EXPECT().GetOldConnectionLogsForArchive(gomock.Any(), database.GetOldConnectionLogsForArchiveParams{}).Return([]json.RawMessage{}, nil).AnyTimes()
+		check.Args(database.GetOldConnectionLogsForArchiveParams{}).Asserts(rbac.ResourceSystem, policy.ActionDelete)
+	}))
+	s.Run("DeleteConnectionLogsByIDs", s.Mocked(func(dbm *dbmock.MockStore, _ *gofakeit.Faker, check *expects) {
+		ids := []uuid.UUID{uuid.New()}
+		dbm.EXPECT().DeleteConnectionLogsByIDs(gomock.Any(), ids).Return(int64(1), nil).AnyTimes()
+		check.Args(ids).Asserts(rbac.ResourceSystem, policy.ActionDelete)
+	}))
 }
 
 func (s *MethodTestSuite) TestChats() {
@@ -2041,6 +2063,22 @@ func (s *MethodTestSuite) TestProvisionerJob() {
 		dbm.EXPECT().UnarchiveTemplateVersion(gomock.Any(), arg).Return(nil).AnyTimes()
 		check.Args(arg).Asserts(tpl.RBACObject(), policy.ActionUpdate)
 	}))
+	s.Run("GetProvisionerJobPrioritiesByOrganizationID", s.Mocked(func(dbm *dbmock.MockStore, faker *gofakeit.Faker, check *expects) {
+		p := testutil.Fake(s.T(), faker, database.ProvisionerJobPriority{})
+		dbm.EXPECT().GetProvisionerJobPrioritiesByOrganizationID(gomock.Any(), p.OrganizationID).Return([]database.ProvisionerJobPriority{p}, nil).AnyTimes()
+		check.Args(p.OrganizationID).Asserts(rbac.ResourceProvisionerJobs.InOrg(p.OrganizationID), policy.ActionRead).Returns([]database.ProvisionerJobPriority{p})
+	}))
+	s.Run("UpsertProvisionerJobPriority", s.Mocked(func(dbm *dbmock.MockStore, faker *gofakeit.Faker, check *expects) {
+		p := testutil.Fake(s.T(), faker, database.ProvisionerJobPriority{})
+		arg := database.UpsertProvisionerJobPriorityParams{OrganizationID: p.OrganizationID, TemplateID: p.TemplateID, Priority: p.Priority, UpdatedAt: p.UpdatedAt}
+		dbm.EXPECT().UpsertProvisionerJobPriority(gomock.Any(), arg).Return(p, nil).AnyTimes()
+		check.Args(arg).Asserts(rbac.ResourceProvisionerJobs.InOrg(p.OrganizationID), policy.ActionUpdate).Returns(p)
+	}))
+	s.Run("DeleteProvisionerJobPriority", s.Mocked(func(dbm *dbmock.MockStore, faker *gofakeit.Faker, check *expects) {
+		arg := database.DeleteProvisionerJobPriorityParams{OrganizationID: uuid.New(), TemplateID: uuid.New()}
+		dbm.EXPECT().DeleteProvisionerJobPriority(gomock.Any(), arg).Return(nil).AnyTimes()
+		check.Args(arg).Asserts(rbac.ResourceProvisionerJobs.InOrg(arg.OrganizationID), policy.ActionUpdate).Returns()
+	}))
 	s.Run("Build/GetProvisionerJobByID", s.Mocked(func(dbm *dbmock.MockStore, faker *gofakeit.Faker, check *expects) {
 		ws := testutil.Fake(s.T(), faker, database.Workspace{})
 		j := testutil.Fake(s.T(), faker, database.ProvisionerJob{Type: database.ProvisionerJobTypeWorkspaceBuild})
@@ -2071,6 +2109,26 @@ func (s *MethodTestSuite) TestProvisionerJob() {
 		dbm.EXPECT().GetTemplateByID(gomock.Any(), tpl.ID).Return(tpl, nil).AnyTimes()
 		check.Args(j.ID).Asserts(v.RBACObject(tpl), policy.ActionRead).Returns(j)
 	}))
+	s.Run("DriftCheck/GetProvisionerJobByID", s.Mocked(func(dbm *dbmock.MockStore, faker *gofakeit.Faker, check *expects) {
+		ws := testutil.Fake(s.T(), faker, database.Workspace{})
+		j := testutil.Fake(s.T(), faker, database.ProvisionerJob{Type: database.ProvisionerJobTypeWorkspaceDriftCheck})
+		dc := testutil.Fake(s.T(), faker, database.WorkspaceDriftCheck{WorkspaceID: ws.ID, JobID: j.ID})
+		dbm.EXPECT().GetProvisionerJobByID(gomock.Any(), j.ID).Return(j, nil).AnyTimes()
+		dbm.EXPECT().GetWorkspaceDriftCheckByJobID(gomock.Any(), j.ID).Return(dc, nil).AnyTimes()
+		dbm.EXPECT().GetWorkspaceByID(gomock.Any(), ws.ID).Return(ws, nil).AnyTimes()
+		check.Args(j.ID).Asserts(ws, policy.ActionRead).Returns(j)
+	}))
+	s.Run("DriftCheck/UpdateProvisionerJobWithCancelByID", s.Mocked(func(dbm *dbmock.MockStore, faker *gofakeit.Faker, check *expects) {
+		ws := testutil.Fake(s.T(), faker, database.Workspace{})
+		j := testutil.Fake(s.T(), faker, database.ProvisionerJob{Type: database.ProvisionerJobTypeWorkspaceDriftCheck})
+		dc := testutil.Fake(s.T(), faker, database.WorkspaceDriftCheck{WorkspaceID: ws.ID, JobID: j.ID})
+		arg := database.UpdateProvisionerJobWithCancelByIDParams{ID: j.ID}
+		dbm.EXPECT().GetProvisionerJobByID(gomock.Any(), j.ID).Return(j, nil).AnyTimes()
+		dbm.EXPECT().GetWorkspaceDriftCheckByJobID(gomock.Any(), j.ID).Return(dc, nil).AnyTimes()
+		dbm.EXPECT().GetWorkspaceByID(gomock.Any(), ws.ID).Return(ws, nil).AnyTimes()
+		dbm.EXPECT().UpdateProvisionerJobWithCancelByID(gomock.Any(), arg).Return(nil).AnyTimes()
+		check.Args(arg).Asserts(ws, policy.ActionUpdate).Returns()
+	}))
 	s.Run("Build/UpdateProvisionerJobWithCancelByID", s.Mocked(func(dbm *dbmock.MockStore, faker *gofakeit.Faker, check *expects) {
 		tpl := testutil.Fake(s.T(), faker, database.Template{AllowUserCancelWorkspaceJobs: true})
 		ws := testutil.Fake(s.T(), faker, database.Workspace{TemplateID: tpl.ID})
@@ -2715,6 +2773,65 @@ func (s *MethodTestSuite) TestTemplate() {
 		dbm.EXPECT().UpdateTemplateVersionFlagsByJobID(gomock.Any(), arg).Return(nil).AnyTimes()
 		check.Args(arg).Asserts(t, policy.ActionUpdate)
 	}))
+	s.Run("InsertTemplateVersionRollout", s.Mocked(func(dbm *dbmock.MockStore, faker *gofakeit.Faker, check *expects) {
+		t1 := testutil.Fake(s.T(), faker, database.Template{})
+		r := testutil.Fake(s.T(), faker, database.TemplateVersionRollout{TemplateID: t1.ID})
+		arg := database.InsertTemplateVersionRolloutParams{ID: r.ID, TemplateID: t1.ID, TemplateVersionID: r.TemplateVersionID, PreviousTemplateVersionID: r.PreviousTemplateVersionID, Percentage: 10}
+		dbm.EXPECT().GetTemplateByID(gomock.Any(), t1.ID).Return(t1, nil).AnyTimes()
+		dbm.EXPECT().InsertTemplateVersionRollout(gomock.Any(), arg).Return(r, nil).AnyTimes()
+		check.Args(arg).Asserts(t1, policy.ActionUpdate).Returns(r)
+	}))
+	s.Run("GetTemplateVersionRolloutByID", s.Mocked(func(dbm *dbmock.MockStore, faker *gofakeit.Faker, check *expects) {
+		t1 := testutil.Fake(s.T(), faker, database.Template{})
+		r := testutil.Fake(s.T(), faker, database.TemplateVersionRollout{TemplateID: t1.ID})
+		dbm.EXPECT().GetTemplateVersionRolloutByID(gomock.Any(), r.ID).Return(r, nil).AnyTimes()
+		dbm.EXPECT().GetTemplateByID(gomock.Any(), t1.ID).Return(t1, nil).AnyTimes()
+		check.Args(r.ID).Asserts(t1, policy.ActionRead).Returns(r)
+	}))
+	s.Run("GetActiveTemplateVersionRolloutByTemplateID", s.Mocked(func(dbm *dbmock.MockStore, faker *gofakeit.Faker, check *expects) {
+		t1 := testutil.Fake(s.T(), faker, database.Template{})
+		r := testutil.Fake(s.T(), faker, database.TemplateVersionRollout{TemplateID: t1.ID})
+		dbm.EXPECT().GetTemplateByID(gomock.Any(), t1.ID).Return(t1, nil).AnyTimes()
+		dbm.EXPECT().GetActiveTemplateVersionRolloutByTemplateID(gomock.Any(), t1.ID).Return(r, nil).AnyTimes()
+		check.Args(t1.ID).Asserts(t1, policy.ActionRead).Returns(r)
+	}))
+	s.Run("GetTemplateVersionRolloutsByTemplateID", s.Mocked(func(dbm *dbmock.MockStore, faker *gofakeit.Faker, check *expects) {
+		t1 := testutil.Fake(s.T(), faker, database.Template{})
+		r := testutil.Fake(s.T(), faker, database.TemplateVersionRollout{TemplateID: t1.ID})
+		dbm.EXPECT().GetTemplateByID(gomock.Any(), t1.ID).Return(t1, nil).AnyTimes()
+		dbm.EXPECT().GetTemplateVersionRolloutsByTemplateID(gomock.Any(), t1.ID).Return([]database.TemplateVersionRollout{r}, nil).AnyTimes()
+		check.Args(t1.ID).Asserts(t1, policy.ActionRead).Returns([]database.TemplateVersionRollout{r})
+	}))
+	s.Run("GetTemplateVersionRolloutTargetsUser", s.Mocked(func(dbm *dbmock.MockStore, faker *gofakeit.Faker, check *expects) {
+		t1 := testutil.Fake(s.T(), faker, database.Template{})
+		r := testutil.Fake(s.T(), faker, database.TemplateVersionRollout{TemplateID: t1.ID})
+		arg := database.GetTemplateVersionRolloutTargetsUserParams{RolloutID: r.ID, UserID: uuid.New()}
+		dbm.EXPECT().GetTemplateVersionRolloutByID(gomock.Any(), r.ID).Return(r, nil).AnyTimes()
+		dbm.EXPECT().GetTemplateByID(gomock.Any(), t1.ID).Return(t1, nil).AnyTimes()
+		dbm.EXPECT().GetTemplateVersionRolloutTargetsUser(gomock.Any(), arg).Return(true, nil).AnyTimes()
+		check.Args(arg).Asserts(t1, policy.ActionRead).Returns(true)
+	}))
+	s.Run("UpdateTemplateVersionRolloutByID", s.Mocked(func(dbm *dbmock.MockStore, faker *gofakeit.Faker, check *expects) {
+		t1 := testutil.Fake(s.T(), faker, database.Template{})
+		r := testutil.Fake(s.T(), faker, database.TemplateVersionRollout{TemplateID: t1.ID})
+		arg := database.UpdateTemplateVersionRolloutByIDParams{ID: r.ID, Percentage: 50}
+		dbm.EXPECT().GetTemplateVersionRolloutByID(gomock.Any(), r.ID).Return(r, nil).AnyTimes()
+		dbm.EXPECT().GetTemplateByID(gomock.Any(), t1.ID).Return(t1, nil).AnyTimes()
+		dbm.EXPECT().UpdateTemplateVersionRolloutByID(gomock.Any(), arg).Return(r, nil).AnyTimes()
+		check.Args(arg).Asserts(t1, policy.ActionUpdate).Returns(r)
+	}))
+	s.Run("UpdateTemplateVersionRolloutStatusByID", s.Mocked(func(dbm *dbmock.MockStore, faker *gofakeit.Faker, check *expects) {
+		t1 := testutil.Fake(s.T(), faker, database.Template{})
+		r := testutil.Fake(s.T(), faker, database.TemplateVersionRollout{TemplateID: t1.ID})
+		arg := database.UpdateTemplateVersionRolloutStatusByIDParams{ID: r.ID, Status: database.TemplateVersionRolloutStatusPaused}
+		dbm.EXPECT().GetTemplateVersionRolloutByID(gomock.Any(), r.ID).Return(r, nil).AnyTimes()
+		dbm.EXPECT().GetTemplateByID(gomock.Any(), t1.ID).Return(t1, nil).AnyTimes()
+		dbm.EXPECT().UpdateTemplateVersionRolloutStatusByID(gomock.Any(), arg).Return(r, nil).AnyTimes()
+		check.Args(arg).
+			Asserts(rbac.ResourceSystem, policy.ActionUpdate, t1, policy.ActionUpdate).
+			Returns(r).
+			FailSystemObjectChecks()
+	}))
 	s.Run("UpdateTemplateWorkspacesLastUsedAt", s.Mocked(func(dbm *dbmock.MockStore, faker *gofakeit.Faker, check *expects) {
 		t1 := testutil.Fake(s.T(), faker, database.Template{})
 		arg := database.UpdateTemplateWorkspacesLastUsedAtParams{TemplateID: t1.ID}
@@ -2849,6 +2966,118 @@ func (s *MethodTestSuite) TestTemplate() {
 	}))
 }
 
+func (s *MethodTestSuite) TestScheduleExceptions() {
+	s.Run("InsertScheduleExceptionCalendar", s.Mocked(func(dbm *dbmock.MockStore, faker *gofakeit.Faker, check *expects) {
+		arg := database.InsertScheduleExceptionCalendarParams{ID: uuid.New(), OrganizationID: uuid.New(), Name: "holidays"}
+		cal := database.ScheduleExceptionCalendar{ID: arg.ID, OrganizationID: arg.OrganizationID, Name: arg.Name}
+		dbm.EXPECT().InsertScheduleExceptionCalendar(gomock.Any(), arg).Return(cal, nil).AnyTimes()
+		check.Args(arg).Asserts(rbac.ResourceTemplate.InOrg(arg.OrganizationID), policy.ActionCreate).Returns(cal)
+	}))
+	s.Run("GetScheduleExceptionCalendarByID", s.Mocked(func(dbm *dbmock.MockStore, faker *gofakeit.Faker, check *expects) {
+		cal := testutil.Fake(s.T(), faker, database.ScheduleExceptionCalendar{})
+		dbm.EXPECT().GetScheduleExceptionCalendarByID(gomock.Any(), cal.ID).Return(cal, nil).AnyTimes()
+		check.Args(cal.ID).Asserts(cal, policy.ActionRead).Returns(cal)
+	}))
+	s.Run("GetScheduleExceptionCalendarsByOrganizationID", s.Mocked(func(dbm *dbmock.MockStore, faker *gofakeit.Faker, check *expects) {
+		cal := testutil.Fake(s.T(), faker, database.ScheduleExceptionCalendar{})
+		dbm.EXPECT().GetScheduleExceptionCalendarsByOrganizationID(gomock.Any(), cal.OrganizationID).Return([]database.ScheduleExceptionCalendar{cal}, nil).AnyTimes()
+		check.Args(cal.OrganizationID).Asserts(rbac.ResourceTemplate.InOrg(cal.OrganizationID), policy.ActionRead).Returns([]database.ScheduleExceptionCalendar{cal})
+	}))
+	s.Run("UpdateScheduleExceptionCalendarByID", s.Mocked(func(dbm *dbmock.MockStore, faker *gofakeit.Faker, check *expects) {
+		cal := testutil.Fake(s.T(), faker, database.ScheduleExceptionCalendar{})
+		arg := database.UpdateScheduleExceptionCalendarByIDParams{ID: cal.ID, Name: "renamed"}
+		dbm.EXPECT().GetScheduleExceptionCalendarByID(gomock.Any(), cal.ID).Return(cal, nil).AnyTimes()
+		dbm.EXPECT().UpdateScheduleExceptionCalendarByID(gomock.Any(), arg).Return(cal, nil).AnyTimes()
+		check.Args(arg).Asserts(cal, policy.ActionUpdate).Returns(cal)
+	}))
+	s.Run("DeleteScheduleExceptionCalendarByID", s.Mocked(func(dbm *dbmock.MockStore, faker *gofakeit.Faker, check *expects) {
+		cal := testutil.Fake(s.T(), faker, database.ScheduleExceptionCalendar{})
+		dbm.EXPECT().GetScheduleExceptionCalendarByID(gomock.Any(), cal.ID).Return(cal, nil).AnyTimes()
+		dbm.EXPECT().DeleteScheduleExceptionCalendarByID(gomock.Any(), cal.ID).Return(nil).AnyTimes()
+		check.Args(cal.ID).Asserts(cal, policy.ActionDelete).Returns()
+	}))
+	s.Run("GetScheduleExceptionCalendarDates", s.Mocked(func(dbm *dbmock.MockStore, faker *gofakeit.Faker, check *expects) {
+		cal := testutil.Fake(s.T(), faker, database.ScheduleExceptionCalendar{})
+		dates := []database.ScheduleExceptionCalendarDate{{CalendarID: cal.ID}}
+		dbm.EXPECT().GetScheduleExceptionCalendarByID(gomock.Any(), cal.ID).Return(cal, nil).AnyTimes()
+		dbm.EXPECT().GetScheduleExceptionCalendarDates(gomock.Any(), cal.ID).Return(dates, nil).AnyTimes()
+		check.Args(cal.ID).Asserts(cal, policy.ActionRead).Returns(dates)
+	}))
+	s.Run("DeleteScheduleExceptionCalendarDates", s.Mocked(func(dbm *dbmock.MockStore, faker *gofakeit.Faker, check *expects) {
+		cal := testutil.Fake(s.T(), faker, database.ScheduleExceptionCalendar{})
+		dbm.EXPECT().GetScheduleExceptionCalendarByID(gomock.Any(), cal.ID).Return(cal, nil).AnyTimes()
+		dbm.EXPECT().DeleteScheduleExceptionCalendarDates(gomock.Any(), cal.ID).Return(nil).AnyTimes()
+		check.Args(cal.ID).Asserts(cal, policy.ActionUpdate).Returns()
+	}))
+	s.Run("InsertScheduleExceptionCalendarDates", s.Mocked(func(dbm *dbmock.MockStore, faker *gofakeit.Faker, check *expects) {
+		cal := testutil.Fake(s.T(), faker, database.ScheduleExceptionCalendar{})
+		arg := database.InsertScheduleExceptionCalendarDatesParams{
+			CalendarID: cal.ID,
+			StartDates: []time.Time{dbtime.Now()},
+			EndDates:   []time.Time{dbtime.Now()},
+			Summaries:  []string{"holiday"},
+		}
+		dbm.EXPECT().GetScheduleExceptionCalendarByID(gomock.Any(), cal.ID).Return(cal, nil).AnyTimes()
+		dbm.EXPECT().InsertScheduleExceptionCalendarDates(gomock.Any(), arg).Return(nil).AnyTimes()
+		check.Args(arg).Asserts(cal, policy.ActionUpdate).Returns()
+	}))
+	s.Run("GetScheduleExceptionCalendarsByTemplateID", s.Mocked(func(dbm *dbmock.MockStore, faker *gofakeit.Faker, check *expects) {
+		tpl := testutil.Fake(s.T(), faker, database.Template{})
+		cal := testutil.Fake(s.T(), faker, database.ScheduleExceptionCalendar{OrganizationID: tpl.OrganizationID})
+		dbm.EXPECT().GetTemplateByID(gomock.Any(), tpl.ID).Return(tpl, nil).AnyTimes()
+		dbm.EXPECT().GetScheduleExceptionCalendarsByTemplateID(gomock.Any(), tpl.ID).Return([]database.ScheduleExceptionCalendar{cal}, nil).AnyTimes()
+		check.Args(tpl.ID).Asserts(tpl, policy.ActionRead).Returns([]database.ScheduleExceptionCalendar{cal})
+	}))
+	s.Run("DeleteTemplateScheduleExceptionCalendars", s.Mocked(func(dbm *dbmock.MockStore, faker *gofakeit.Faker, check *expects) {
+		tpl := testutil.Fake(s.T(), faker, database.Template{})
+		dbm.EXPECT().GetTemplateByID(gomock.Any(), tpl.ID).Return(tpl, nil).AnyTimes()
+		dbm.EXPECT().DeleteTemplateScheduleExceptionCalendars(gomock.Any(), tpl.ID).Return(nil).AnyTimes()
+		check.Args(tpl.ID).Asserts(tpl, policy.ActionUpdate).Returns()
+	}))
+	s.Run("InsertTemplateScheduleExceptionCalendars", s.Mocked(func(dbm *dbmock.MockStore, faker *gofakeit.Faker, check *expects) {
+		tpl := testutil.Fake(s.T(), faker, database.Template{})
+		arg := database.InsertTemplateScheduleExceptionCalendarsParams{TemplateID: tpl.ID, CalendarIDs: []uuid.UUID{uuid.New()}}
+		dbm.EXPECT().GetTemplateByID(gomock.Any(), tpl.ID).Return(tpl, nil).AnyTimes()
+		dbm.EXPECT().InsertTemplateScheduleExceptionCalendars(gomock.Any(), arg).Return(nil).AnyTimes()
+		check.Args(arg).Asserts(tpl, policy.ActionUpdate).Returns()
+	}))
+	s.Run("InsertUserOutOfOfficeRange", s.Mocked(func(dbm *dbmock.MockStore, faker *gofakeit.Faker, check *expects) {
+		u := testutil.Fake(s.T(), faker, database.User{})
+		arg := database.InsertUserOutOfOfficeRangeParams{ID: uuid.New(), UserID: u.ID, StartDate: dbtime.Now(), EndDate: dbtime.Now()}
+		ooo := database.UserOutOfOfficeRange{ID: arg.ID, UserID: u.ID, StartDate: arg.StartDate, EndDate: arg.EndDate}
+		dbm.EXPECT().GetUserByID(gomock.Any(), u.ID).Return(u, nil).AnyTimes()
+		dbm.EXPECT().InsertUserOutOfOfficeRange(gomock.Any(), arg).Return(ooo, nil).AnyTimes()
+		check.Args(arg).Asserts(u, policy.ActionUpdatePersonal).Returns(ooo)
+	}))
+	s.Run("GetUserOutOfOfficeRangesByUserID", s.Mocked(func(dbm *dbmock.MockStore, faker *gofakeit.Faker, check *expects) {
+		u := testutil.Fake(s.T(), faker, database.User{})
+		ooo := testutil.Fake(s.T(), faker, database.UserOutOfOfficeRange{UserID: u.ID})
+		dbm.EXPECT().GetUserByID(gomock.Any(), u.ID).Return(u, nil).AnyTimes()
+		dbm.EXPECT().GetUserOutOfOfficeRangesByUserID(gomock.Any(), u.ID).Return([]database.UserOutOfOfficeRange{ooo}, nil).AnyTimes()
+		check.Args(u.ID).Asserts(u, policy.ActionReadPersonal).Returns([]database.UserOutOfOfficeRange{ooo})
+	}))
+	s.Run("DeleteUserOutOfOfficeRange", s.Mocked(func(dbm *dbmock.MockStore, faker *gofakeit.Faker, check *expects) {
+		u := testutil.Fake(s.T(), faker, database.User{})
+		arg := database.DeleteUserOutOfOfficeRangeParams{ID: uuid.New(), UserID: u.ID}
+		dbm.EXPECT().GetUserByID(gomock.Any(), u.ID).Return(u, nil).AnyTimes()
+		dbm.EXPECT().DeleteUserOutOfOfficeRange(gomock.Any(), arg).Return(nil).AnyTimes()
+		check.Args(arg).Asserts(u, policy.ActionUpdatePersonal).Returns()
+	}))
+	s.Run("GetWorkspaceAutostartExceptions", s.Mocked(func(dbm *dbmock.MockStore, faker *gofakeit.Faker, check *expects) {
+		ws := testutil.Fake(s.T(), faker, database.Workspace{})
+		arg := database.GetWorkspaceAutostartExceptionsParams{WorkspaceID: ws.ID, After: dbtime.Now()}
+		rows := []database.GetWorkspaceAutostartExceptionsRow{{StartDate: arg.After, EndDate: arg.After, Source: "holidays"}}
+		dbm.EXPECT().GetWorkspaceByID(gomock.Any(), ws.ID).Return(ws, nil).AnyTimes()
+		dbm.EXPECT().GetWorkspaceAutostartExceptions(gomock.Any(), arg).Return(rows, nil).AnyTimes()
+		check.Args(arg).Asserts(ws, policy.ActionRead).Returns(rows)
+	}))
+	s.Run("GetWorkspaceAutostartExceptionsByWorkspaceIDs", s.Mocked(func(dbm *dbmock.MockStore, _ *gofakeit.Faker, check *expects) {
+		arg := database.GetWorkspaceAutostartExceptionsByWorkspaceIDsParams{WorkspaceIDs: []uuid.UUID{uuid.New()}, After: dbtime.Now()}
+		dbm.EXPECT().GetWorkspaceAutostartExceptionsByWorkspaceIDs(gomock.Any(), arg).Return([]database.GetWorkspaceAutostartExceptionsByWorkspaceIDsRow{}, nil).AnyTimes()
+		check.Args(arg).Asserts(rbac.ResourceSystem, policy.ActionRead)
+	}))
+}
+
 func (s *MethodTestSuite) TestUser() {
 	s.Run("GetAuthorizedUsers", s.Mocked(func(dbm *dbmock.MockStore, _ *gofakeit.Faker, check *expects) {
 		arg := database.GetUsersParams{}
@@ -4418,6 +4647,78 @@ func (s *MethodTestSuite) TestWorkspacePortSharing() {
 	}))
 }
 
+func (s *MethodTestSuite) TestWorkspacePTYSharing() {
+	s.Run("InsertWorkspaceAgentPTYShare", s.Mocked(func(dbm *dbmock.MockStore, faker *gofakeit.Faker, check *expects) {
+		ws := testutil.Fake(s.T(), faker, database.Workspace{})
+		agt := testutil.Fake(s.T(), faker, database.WorkspaceAgent{})
+		arg := database.InsertWorkspaceAgentPTYShareParams{
+			ID:      uuid.New(),
+			AgentID: agt.ID,
+		}
+		dbm.EXPECT().GetWorkspaceByAgentID(gomock.Any(), agt.ID).Return(ws, nil).AnyTimes()
+		dbm.EXPECT().InsertWorkspaceAgentPTYShare(gomock.Any(), arg).Return(database.WorkspaceAgentPTYShare{}, nil).AnyTimes()
+		check.Args(arg).Asserts(ws, policy.ActionShare)
+	}))
+	s.Run("GetWorkspaceAgentPTYSharesByAgentID", s.Mocked(func(dbm *dbmock.MockStore, faker *gofakeit.Faker, check *expects) {
+		ws := testutil.Fake(s.T(), faker, database.Workspace{})
+		share := testutil.Fake(s.T(), faker, database.WorkspaceAgentPTYShare{})
+		dbm.EXPECT().GetWorkspaceByAgentID(gomock.Any(), share.AgentID).Return(ws, nil).AnyTimes()
+		dbm.EXPECT().GetWorkspaceAgentPTYSharesByAgentID(gomock.Any(), share.AgentID).Return([]database.WorkspaceAgentPTYShare{share}, nil).AnyTimes()
+		check.Args(share.AgentID).Asserts(ws, policy.ActionShare).Returns([]database.WorkspaceAgentPTYShare{share})
+	}))
+	s.Run("GetWorkspaceAgentPTYShareByID", s.Mocked(func(dbm *dbmock.MockStore, faker *gofakeit.Faker, check *expects) {
+		ws := testutil.Fake(s.T(), faker, database.Workspace{})
+		share := testutil.Fake(s.T(), faker, database.WorkspaceAgentPTYShare{})
+		dbm.EXPECT().GetWorkspaceAgentPTYShareByID(gomock.Any(), share.ID).Return(share, nil).AnyTimes()
+		dbm.EXPECT().GetWorkspaceByAgentID(gomock.Any(), share.AgentID).Return(ws, nil).AnyTimes()
+		check.Args(share.ID).Asserts(ws, policy.ActionShare).Returns(share)
+	}))
+	s.Run("GetWorkspaceAgentPTYShareByHashedToken", s.Mocked(func(dbm *dbmock.MockStore, faker *gofakeit.Faker, check *expects) {
+		share := testutil.Fake(s.T(), faker, database.WorkspaceAgentPTYShare{})
+		dbm.EXPECT().GetWorkspaceAgentPTYShareByHashedToken(gomock.Any(), share.HashedToken).Return(share, nil).AnyTimes()
+		check.Args(share.HashedToken).Asserts(rbac.ResourceSystem, policy.ActionRead).Returns(share)
+	}))
+	s.Run("RevokeWorkspaceAgentPTYShare", s.Mocked(func(dbm *dbmock.MockStore, faker *gofakeit.Faker, check *expects) {
+		ws := testutil.Fake(s.T(), faker, database.Workspace{})
+		share := testutil.Fake(s.T(), faker, database.WorkspaceAgentPTYShare{})
+		arg := database.RevokeWorkspaceAgentPTYShareParams{
+			ID:        share.ID,
+			RevokedAt: dbtime.Now(),
+		}
+		dbm.EXPECT().GetWorkspaceAgentPTYShareByID(gomock.Any(), share.ID).Return(share, nil).AnyTimes()
+		dbm.EXPECT().GetWorkspaceByAgentID(gomock.Any(), share.AgentID).Return(ws, nil).AnyTimes()
+		dbm.EXPECT().RevokeWorkspaceAgentPTYShare(gomock.Any(), arg).Return(nil).AnyTimes()
+		check.Args(arg).Asserts(ws, policy.ActionShare)
+	}))
+	s.Run("GetWorkspaceAgentPTYShareViewersByAgentID", s.Mocked(func(dbm *dbmock.MockStore, faker *gofakeit.Faker, check *expects) {
+		ws := testutil.Fake(s.T(), faker, database.Workspace{})
+		agt := testutil.Fake(s.T(), faker, database.WorkspaceAgent{})
+		viewers := []database.GetWorkspaceAgentPTYShareViewersByAgentIDRow{
+			testutil.Fake(s.T(), faker, database.GetWorkspaceAgentPTYShareViewersByAgentIDRow{}),
+		}
+		dbm.EXPECT().GetWorkspaceByAgentID(gomock.Any(), agt.ID).Return(ws, nil).AnyTimes()
+		dbm.EXPECT().GetWorkspaceAgentPTYShareViewersByAgentID(gomock.Any(), agt.ID).Return(viewers, nil).AnyTimes()
+		check.Args(agt.ID).Asserts(ws, policy.ActionShare).Returns(viewers)
+	}))
+	s.Run("InsertWorkspaceAgentPTYShareViewer", s.Mocked(func(dbm *dbmock.MockStore, faker *gofakeit.Faker, check *expects) {
+		arg := database.InsertWorkspaceAgentPTYShareViewerParams{
+			ID:      uuid.New(),
+			ShareID: uuid.New(),
+			UserID:  uuid.New(),
+		}
+		dbm.EXPECT().InsertWorkspaceAgentPTYShareViewer(gomock.Any(), arg).Return(database.WorkspaceAgentPTYShareViewer{}, nil).AnyTimes()
+		check.Args(arg).Asserts(rbac.ResourceSystem, policy.ActionCreate)
+	}))
+	s.Run("UpdateWorkspaceAgentPTYShareViewerDisconnectedAt", s.Mocked(func(dbm *dbmock.MockStore, faker *gofakeit.Faker, check *expects) {
+		arg := database.UpdateWorkspaceAgentPTYShareViewerDisconnectedAtParams{
+			ID:             uuid.New(),
+			DisconnectedAt: dbtime.Now(),
+		}
+		dbm.EXPECT().UpdateWorkspaceAgentPTYShareViewerDisconnectedAt(gomock.Any(), arg).Return(nil).AnyTimes()
+		check.Args(arg).Asserts(rbac.ResourceSystem, policy.ActionUpdate)
+	}))
+}
+
 func (s *MethodTestSuite) TestTasks() {
 	s.Run("GetTaskByID", s.Mocked(func(dbm *dbmock.MockStore, faker *gofakeit.Faker, check *expects) {
 		task := testutil.Fake(s.T(), faker, database.Task{})
@@ -5244,6 +5545,30 @@ func (s *MethodTestSuite) TestSystemFunctions() {
 		dbm.EXPECT().DeleteOldWorkspaceAgentLogs(gomock.Any(), t).Return(int64(0), nil).AnyTimes()
 		check.Args(t).Asserts(rbac.ResourceSystem, policy.ActionDelete)
 	}))
+	s.Run("GetOldWorkspaceAgentLogsForArchive", s.Mocked(func(dbm *dbmock.MockStore, _ *gofakeit.Faker, check *expects) {
+		arg := database.GetOldWorkspaceAgentLogsForArchiveParams{}
+		dbm.EXPECT().GetOldWorkspaceAgentLogsForArchive(gomock.Any(), arg).Return([]database.GetOldWorkspaceAgentLogsForArchiveRow{}, nil).AnyTimes()
+		check.Args(arg).Asserts(rbac.ResourceSystem, policy.ActionDelete)
+	}))
+	s.Run("DeleteWorkspaceAgentLogsByIDs", s.Mocked(func(dbm *dbmock.MockStore, _ *gofakeit.Faker, check *expects) {
+		ids := []int64{1}
+		dbm.EXPECT().DeleteWorkspaceAgentLogsByIDs(gomock.Any(), ids).Return(int64(1), nil).AnyTimes()
+		check.Args(ids).Asserts(rbac.ResourceSystem, policy.ActionDelete)
+	}))
+	s.Run("GetPurgeArchiveImports", s.Mocked(func(dbm *dbmock.MockStore, _ *gofakeit.Faker, check *expects) {
+		dbm.EXPECT().GetPurgeArchiveImports(gomock.Any()).Return([]database.PurgeArchiveImport{}, nil).AnyTimes()
+		check.Args().Asserts(rbac.ResourceSystem, policy.ActionRead)
+	}))
+	s.Run("InsertPurgeArchiveImport", s.Mocked(func(dbm *dbmock.MockStore, _ *gofakeit.Faker, check *expects) {
+		arg := database.InsertPurgeArchiveImportParams{}
+		dbm.EXPECT().InsertPurgeArchiveImport(gomock.Any(), arg).Return(database.PurgeArchiveImport{}, nil).AnyTimes()
+		check.Args(arg).Asserts(rbac.ResourceSystem, policy.ActionCreate)
+	}))
+	s.Run("InsertPurgeArchiveRecords", s.Mocked(func(dbm *dbmock.MockStore, _ *gofakeit.Faker, check *expects) {
+		arg := database.InsertPurgeArchiveRecordsParams{}
+		dbm.EXPECT().InsertPurgeArchiveRecords(gomock.Any(), arg).Return(nil).AnyTimes()
+		check.Args(arg).Asserts(rbac.ResourceSystem, policy.ActionCreate)
+	}))
 	s.Run("InsertWorkspaceAgentStats", s.Mocked(func(dbm *dbmock.MockStore, _ *gofakeit.Faker, check *expects) {
 		arg := database.InsertWorkspaceAgentStatsParams{}
 		dbm.EXPECT().InsertWorkspaceAgentStats(gomock.Any(), arg).Return(xerrors.New("any error")).AnyTimes()
@@ -5287,6 +5612,11 @@ func (s *MethodTestSuite) TestSystemFunctions() {
 		dbm.EXPECT().InsertWorkspaceAgentLogSources(gomock.Any(), arg).Return([]database.WorkspaceAgentLogSource{}, nil).AnyTimes()
 		check.Args(arg).Asserts()
 	}))
+	s.Run("InsertWorkspaceAgentLogTail", s.Mocked(func(dbm *dbmock.MockStore, _ *gofakeit.Faker, check *expects) {
+		arg := database.InsertWorkspaceAgentLogTailParams{}
+		dbm.EXPECT().InsertWorkspaceAgentLogTail(gomock.Any(), arg).Return(database.WorkspaceAgentLogTail{}, nil).AnyTimes()
+		check.Args(arg).Asserts(rbac.ResourceSystem, policy.ActionCreate)
+	}))
 	s.Run("GetActiveWorkspaceBuildsByTemplateID", s.Mocked(func(dbm *dbmock.MockStore, _ *gofakeit.Faker, check *expects) {
 		id := uuid.New()
 		dbm.EXPECT().GetActiveWorkspaceBuildsByTemplateID(gomock.Any(), id).Return([]database.WorkspaceBuild{}, nil).AnyTimes()
@@ -5427,6 +5757,11 @@ func (s *MethodTestSuite) TestSystemFunctions() {
 		dbm.EXPECT().GetWorkspaceAgentLogSourcesByAgentIDs(gomock.Any(), ids).Return([]database.WorkspaceAgentLogSource{}, nil).AnyTimes()
 		check.Args(ids).Asserts(rbac.ResourceSystem, policy.ActionRead)
 	}))
+	s.Run("GetWorkspaceAgentLogTailsByAgentIDs", s.Mocked(func(dbm *dbmock.MockStore, _ *gofakeit.Faker, check *expects) {
+		ids := []uuid.UUID{uuid.New()}
+		dbm.EXPECT().GetWorkspaceAgentLogTailsByAgentIDs(gomock.Any(), ids).Return([]database.WorkspaceAgentLogTail{}, nil).AnyTimes()
+		check.Args(ids).Asserts(rbac.ResourceSystem, policy.ActionRead)
+	}))
 	s.Run("GetProvisionerJobsByIDsWithQueuePosition", s.Mocked(func(dbm *dbmock.MockStore, _ *gofakeit.Faker, check *expects) {
 		arg := database.GetProvisionerJobsByIDsWithQueuePositionParams{}
 		dbm.EXPECT().GetProvisionerJobsByIDsWithQueuePosition(gomock.Any(), arg).Return([]database.GetProvisionerJobsByIDsWithQueuePositionRow{}, nil).AnyTimes()
@@ -5514,6 +5849,41 @@ func (s *MethodTestSuite) TestSystemFunctions() {
 		dbm.EXPECT().GetWorkspaceModulesCreatedAfter(gomock.Any(), at).Return([]database.WorkspaceModule{}, nil).AnyTimes()
 		check.Args(at).Asserts(rbac.ResourceSystem, policy.ActionRead)
 	}))
+	s.Run("InsertWorkspaceDriftCheck", s.Mocked(func(dbm *dbmock.MockStore, faker *gofakeit.Faker, check *expects) {
+		dc := testutil.Fake(s.T(), faker, database.WorkspaceDriftCheck{})
+		arg := database.InsertWorkspaceDriftCheckParams{ID: dc.ID, WorkspaceID: dc.WorkspaceID, WorkspaceBuildID: dc.WorkspaceBuildID, JobID: dc.JobID, CreatedAt: dc.CreatedAt}
+		dbm.EXPECT().InsertWorkspaceDriftCheck(gomock.Any(), arg).Return(dc, nil).AnyTimes()
+		check.Args(arg).Asserts(rbac.ResourceSystem, policy.ActionCreate).Returns(dc)
+	}))
+	s.Run("GetWorkspaceDriftCheckByJobID", s.Mocked(func(dbm *dbmock.MockStore, faker *gofakeit.Faker, check *expects) {
+		dc := testutil.Fake(s.T(), faker, database.WorkspaceDriftCheck{})
+		dbm.EXPECT().GetWorkspaceDriftCheckByJobID(gomock.Any(), dc.JobID).Return(dc, nil).AnyTimes()
+		check.Args(dc.JobID).Asserts(rbac.ResourceSystem, policy.ActionRead).Returns(dc)
+	}))
+	s.Run("UpdateWorkspaceDriftCheckByID", s.Mocked(func(dbm *dbmock.MockStore, _ *gofakeit.Faker, check *expects) {
+		arg := database.UpdateWorkspaceDriftCheckByIDParams{ID: uuid.New(), CheckedAt: sql.NullTime{Time: dbtime.Now(), Valid: true}, ResourceDrift: json.RawMessage("[]")}
+		dbm.EXPECT().UpdateWorkspaceDriftCheckByID(gomock.Any(), arg).Return(nil).AnyTimes()
+		check.Args(arg).Asserts(rbac.ResourceSystem, policy.ActionUpdate).Returns()
+	}))
+	s.Run("GetLatestWorkspaceDriftChecksByWorkspaceIDs", s.Mocked(func(dbm *dbmock.MockStore, _ *gofakeit.Faker, check *expects) {
+		ids := []uuid.UUID{uuid.New()}
+		dbm.EXPECT().GetLatestWorkspaceDriftChecksByWorkspaceIDs(gomock.Any(), ids).Return([]database.WorkspaceDriftCheck{}, nil).AnyTimes()
+		check.Args(ids).Asserts(rbac.ResourceSystem, policy.ActionRead)
+	}))
+	s.Run("GetWorkspacesEligibleForDriftCheck", s.Mocked(func(dbm *dbmock.MockStore, _ *gofakeit.Faker, check *expects) {
+		now := dbtime.Now()
+		dbm.EXPECT().GetWorkspacesEligibleForDriftCheck(gomock.Any(), now).Return([]database.GetWorkspacesEligibleForDriftCheckRow{}, nil).AnyTimes()
+		check.Args(now).Asserts(rbac.ResourceSystem, policy.ActionRead)
+	}))
+	s.Run("GetInProgressTemplateVersionRollouts", s.Mocked(func(dbm *dbmock.MockStore, _ *gofakeit.Faker, check *expects) {
+		dbm.EXPECT().GetInProgressTemplateVersionRollouts(gomock.Any()).Return([]database.TemplateVersionRollout{}, nil).AnyTimes()
+		check.Args().Asserts(rbac.ResourceSystem, policy.ActionRead)
+	}))
+	s.Run("GetTemplateVersionRolloutStats", s.Mocked(func(dbm *dbmock.MockStore, _ *gofakeit.Faker, check *expects) {
+		arg := database.GetTemplateVersionRolloutStatsParams{TemplateVersionID: uuid.New(), StartedAt: dbtime.Now()}
+		dbm.EXPECT().GetTemplateVersionRolloutStats(gomock.Any(), arg).Return(database.GetTemplateVersionRolloutStatsRow{}, nil).AnyTimes()
+		check.Args(arg).Asserts(rbac.ResourceSystem, policy.ActionRead)
+	}))
 	s.Run("GetTelemetryItem", s.Mocked(func(dbm *dbmock.MockStore, _ *gofakeit.Faker, check *expects) {
 		dbm.EXPECT().GetTelemetryItem(gomock.Any(), "test").Return(database.TelemetryItem{}, sql.ErrNoRows).AnyTimes()
 		check.Args("test").Asserts(rbac.ResourceSystem, policy.ActionRead).Errors(sql.ErrNoRows)
@@ -6119,6 +6489,67 @@ func (s *MethodTestSuite) TestOAuth2ProviderAppCodes() {
 	}))
 }
 
+func (s *MethodTestSuite) TestOAuth2ProviderAppDeviceCodes() {
+	s.Run("GetOAuth2ProviderAppDeviceCodeByID", s.Subtest(func(db database.Store, check *expects) {
+		app := dbgen.OAuth2ProviderApp(s.T(), db, database.OAuth2ProviderApp{})
+		code := dbgen.OAuth2ProviderAppDeviceCode(s.T(), db, database.OAuth2ProviderAppDeviceCode{AppID: app.ID})
+		check.Args(code.ID).Asserts(code, policy.ActionRead).Returns(code)
+	}))
+	s.Run("GetOAuth2ProviderAppDeviceCodeByPrefix", s.Subtest(func(db database.Store, check *expects) {
+		app := dbgen.OAuth2ProviderApp(s.T(), db, database.OAuth2ProviderApp{})
+		code := dbgen.OAuth2ProviderAppDeviceCode(s.T(), db, database.OAuth2ProviderAppDeviceCode{AppID: app.ID})
+		check.Args(code.DeviceCodePrefix).Asserts(code, policy.ActionRead).Returns(code)
+	}))
+	s.Run("GetOAuth2ProviderAppDeviceCodeByUserCode", s.Subtest(func(db database.Store, check *expects) {
+		user := dbgen.User(s.T(), db, database.User{})
+		app := dbgen.OAuth2ProviderApp(s.T(), db, database.OAuth2ProviderApp{})
+		code := dbgen.OAuth2ProviderAppDeviceCode(s.T(), db, database.OAuth2ProviderAppDeviceCode{
+			AppID:  app.ID,
+			UserID: uuid.NullUUID{UUID: user.ID, Valid: true},
+			Status: database.OAuth2DeviceCodeStatusApproved,
+		})
+		check.Args(code.UserCode).Asserts(code, policy.ActionRead).Returns(code)
+	}))
+	s.Run("InsertOAuth2ProviderAppDeviceCode", s.Subtest(func(db database.Store, check *expects) {
+		app := dbgen.OAuth2ProviderApp(s.T(), db, database.OAuth2ProviderApp{})
+		check.Args(database.InsertOAuth2ProviderAppDeviceCodeParams{
+			ID:               uuid.New(),
+			AppID:            app.ID,
+			DeviceCodePrefix: []byte("prefix"),
+			UserCode:         "BCDFGHJK",
+			Scope:            string(database.ApiKeyScopeCoderAll),
+			PollingInterval:  5,
+		}).Asserts(rbac.ResourceOauth2AppCodeToken, policy.ActionCreate)
+	}))
+	s.Run("UpdateOAuth2ProviderAppDeviceCodeStatus", s.Subtest(func(db database.Store, check *expects) {
+		user := dbgen.User(s.T(), db, database.User{})
+		app := dbgen.OAuth2ProviderApp(s.T(), db, database.OAuth2ProviderApp{})
+		code := dbgen.OAuth2ProviderAppDeviceCode(s.T(), db, database.OAuth2ProviderAppDeviceCode{AppID: app.ID})
+		check.Args(database.UpdateOAuth2ProviderAppDeviceCodeStatusParams{
+			ID:     code.ID,
+			Status: database.OAuth2DeviceCodeStatusApproved,
+			UserID: uuid.NullUUID{UUID: user.ID, Valid: true},
+		}).Asserts(code, policy.ActionUpdate)
+	}))
+	s.Run("UpdateOAuth2ProviderAppDeviceCodePoll", s.Subtest(func(db database.Store, check *expects) {
+		app := dbgen.OAuth2ProviderApp(s.T(), db, database.OAuth2ProviderApp{})
+		code := dbgen.OAuth2ProviderAppDeviceCode(s.T(), db, database.OAuth2ProviderAppDeviceCode{AppID: app.ID})
+		check.Args(database.UpdateOAuth2ProviderAppDeviceCodePollParams{
+			ID:              code.ID,
+			LastPolledAt:    sql.NullTime{Time: dbtime.Now(), Valid: true},
+			PollingInterval: 10,
+		}).Asserts(code, policy.ActionUpdate)
+	}))
+	s.Run("DeleteOAuth2ProviderAppDeviceCodeByID", s.Subtest(func(db database.Store, check *expects) {
+		app := dbgen.OAuth2ProviderApp(s.T(), db, database.OAuth2ProviderApp{})
+		code := dbgen.OAuth2ProviderAppDeviceCode(s.T(), db, database.OAuth2ProviderAppDeviceCode{AppID: app.ID})
+		check.Args(code.ID).Asserts(code, policy.ActionDelete)
+	}))
+	s.Run("DeleteExpiredOAuth2ProviderAppDeviceCodes", s.Subtest(func(db database.Store, check *expects) {
+		check.Args(dbtime.Now()).Asserts(rbac.ResourceOauth2AppCodeToken, policy.ActionDelete)
+	}))
+}
+
 func (s *MethodTestSuite) TestOAuth2ProviderAppTokens() {
 	s.Run("InsertOAuth2ProviderAppToken", s.Subtest(func(db database.Store, check *expects) {
 		user := dbgen.User(s.T(), db, database.User{})
@@ -6263,6 +6694,38 @@ func (s *MethodTestSuite) TestResourcesMonitor() {
 		dbm.EXPECT().FetchVolumesResourceMonitorsByAgentID(gomock.Any(), agt.ID).Return(monitors, nil).AnyTimes()
 		check.Args(agt.ID).Asserts(w, policy.ActionRead).Returns(monitors)
 	}))
+
+	s.Run("FetchResourceMonitorsByAgentID", s.Mocked(func(dbm *dbmock.MockStore, faker *gofakeit.Faker, check *expects) {
+		w := testutil.Fake(s.T(), faker, database.Workspace{})
+		agt := testutil.Fake(s.T(), faker, database.WorkspaceAgent{})
+		monitors := []database.WorkspaceAgentResourceMonitor{
+			testutil.Fake(s.T(), faker, database.WorkspaceAgentResourceMonitor{}),
+		}
+		dbm.EXPECT().GetWorkspaceByAgentID(gomock.Any(), agt.ID).Return(w, nil).AnyTimes()
+		dbm.EXPECT().FetchResourceMonitorsByAgentID(gomock.Any(), agt.ID).Return(monitors, nil).AnyTimes()
+		check.Args(agt.ID).Asserts(w, policy.ActionRead).Returns(monitors)
+	}))
+
+	s.Run("InsertResourceMonitor", s.Mocked(func(dbm *dbmock.MockStore, faker *gofakeit.Faker, check *expects) {
+		arg := database.InsertResourceMonitorParams{
+			AgentID: uuid.New(),
+			Type:    database.WorkspaceAgentResourceMonitorTypeCpu,
+			State:   database.WorkspaceAgentMonitorStateOK,
+		}
+		dbm.EXPECT().InsertResourceMonitor(gomock.Any(), arg).Return(database.WorkspaceAgentResourceMonitor{}, nil).AnyTimes()
+		check.Args(arg).Asserts(rbac.ResourceWorkspaceAgentResourceMonitor, policy.ActionCreate)
+	}))
+
+	s.Run("UpdateResourceMonitor", s.Mocked(func(dbm *dbmock.MockStore, faker *gofakeit.Faker, check *expects) {
+		arg := database.UpdateResourceMonitorParams{
+			AgentID: uuid.New(),
+			Type:    database.WorkspaceAgentResourceMonitorTypeInodes,
+			Path:    "/",
+			State:   database.WorkspaceAgentMonitorStateOK,
+		}
+		dbm.EXPECT().UpdateResourceMonitor(gomock.Any(), arg).Return(nil).AnyTimes()
+		check.Args(arg).Asserts(rbac.ResourceWorkspaceAgentResourceMonitor, policy.ActionUpdate)
+	}))
 }
 
 func (s *MethodTestSuite) TestWorkspaceAgentContext() {
@@ -6564,6 +7027,71 @@ func (s *MethodTestSuite) TestUserSkills() {
 	}))
 }
 
+func (s *MethodTestSuite) TestWorkspaceBulkOperations() {
+	s.Run("InsertWorkspaceBulkOperation", s.Mocked(func(dbm *dbmock.MockStore, faker *gofakeit.Faker, check *expects) {
+		user := testutil.Fake(s.T(), faker, database.User{})
+		op := testutil.Fake(s.T(), faker, database.WorkspaceBulkOperation{InitiatorID: user.ID})
+		arg := database.InsertWorkspaceBulkOperationParams{ID: op.ID, InitiatorID: user.ID, Action: database.WorkspaceBulkOperationActionStop, Concurrency: 5}
+		dbm.EXPECT().InsertWorkspaceBulkOperation(gomock.Any(), arg).Return(op, nil).AnyTimes()
+		check.Args(arg).Asserts(rbac.ResourceUserObject(user.ID), policy.ActionUpdatePersonal).Returns(op)
+	}))
+	s.Run("InsertWorkspaceBulkOperationWorkspaces", s.Mocked(func(dbm *dbmock.MockStore, faker *gofakeit.Faker, check *expects) {
+		op := testutil.Fake(s.T(), faker, database.WorkspaceBulkOperation{})
+		arg := database.InsertWorkspaceBulkOperationWorkspacesParams{OperationID: op.ID, WorkspaceIds: []uuid.UUID{uuid.New()}}
+		dbm.EXPECT().GetWorkspaceBulkOperationByID(gomock.Any(), op.ID).Return(op, nil).AnyTimes()
+		dbm.EXPECT().InsertWorkspaceBulkOperationWorkspaces(gomock.Any(), arg).Return(nil).AnyTimes()
+		check.Args(arg).Asserts(op, policy.ActionUpdatePersonal).Returns()
+	}))
+	s.Run("GetWorkspaceBulkOperationByID", s.Mocked(func(dbm *dbmock.MockStore, faker *gofakeit.Faker, check *expects) {
+		op := testutil.Fake(s.T(), faker, database.WorkspaceBulkOperation{})
+		dbm.EXPECT().GetWorkspaceBulkOperationByID(gomock.Any(), op.ID).Return(op, nil).AnyTimes()
+		check.Args(op.ID).Asserts(op, policy.ActionReadPersonal).Returns(op)
+	}))
+	s.Run("GetWorkspaceBulkOperationsByInitiatorID", s.Mocked(func(dbm *dbmock.MockStore, faker *gofakeit.Faker, check *expects) {
+		user := testutil.Fake(s.T(), faker, database.User{})
+		op := testutil.Fake(s.T(), faker, database.WorkspaceBulkOperation{InitiatorID: user.ID})
+		arg := database.GetWorkspaceBulkOperationsByInitiatorIDParams{InitiatorID: user.ID, LimitOpt: 25}
+		dbm.EXPECT().GetWorkspaceBulkOperationsByInitiatorID(gomock.Any(), arg).Return([]database.WorkspaceBulkOperation{op}, nil).AnyTimes()
+		check.Args(arg).Asserts(op, policy.ActionReadPersonal).Returns([]database.WorkspaceBulkOperation{op})
+	}))
+	s.Run("GetWorkspaceBulkOperationProgress", s.Mocked(func(dbm *dbmock.MockStore, faker *gofakeit.Faker, check *expects) {
+		op := testutil.Fake(s.T(), faker, database.WorkspaceBulkOperation{})
+		row := database.GetWorkspaceBulkOperationProgressRow{Pending: 1, Succeeded: 2}
+		dbm.EXPECT().GetWorkspaceBulkOperationByID(gomock.Any(), op.ID).Return(op, nil).AnyTimes()
+		dbm.EXPECT().GetWorkspaceBulkOperationProgress(gomock.Any(), op.ID).Return(row, nil).AnyTimes()
+		check.Args(op.ID).Asserts(op, policy.ActionReadPersonal).Returns(row)
+	}))
+	s.Run("GetWorkspaceBulkOperationWorkspaces", s.Mocked(func(dbm *dbmock.MockStore, faker *gofakeit.Faker, check *expects) {
+		op := testutil.Fake(s.T(), faker, database.WorkspaceBulkOperation{})
+		row := testutil.Fake(s.T(), faker, database.GetWorkspaceBulkOperationWorkspacesRow{OperationID: op.ID})
+		dbm.EXPECT().GetWorkspaceBulkOperationByID(gomock.Any(), op.ID).Return(op, nil).AnyTimes()
+		dbm.EXPECT().GetWorkspaceBulkOperationWorkspaces(gomock.Any(), op.ID).Return([]database.GetWorkspaceBulkOperationWorkspacesRow{row}, nil).AnyTimes()
+		check.Args(op.ID).Asserts(op, policy.ActionReadPersonal).Returns([]database.GetWorkspaceBulkOperationWorkspacesRow{row})
+	}))
+	s.Run("UpdateWorkspaceBulkOperationCanceled", s.Mocked(func(dbm *dbmock.MockStore, faker *gofakeit.Faker, check *expects) {
+		op := testutil.Fake(s.T(), faker, database.WorkspaceBulkOperation{})
+		arg := database.UpdateWorkspaceBulkOperationCanceledParams{ID: op.ID, Error: "canceled"}
+		dbm.EXPECT().GetWorkspaceBulkOperationByID(gomock.Any(), op.ID).Return(op, nil).AnyTimes()
+		dbm.EXPECT().UpdateWorkspaceBulkOperationCanceled(gomock.Any(), arg).Return(op, nil).AnyTimes()
+		check.Args(arg).Asserts(op, policy.ActionUpdatePersonal).Returns(op)
+	}))
+	s.Run("AcquireWorkspaceBulkOperationWorkspaces", s.Mocked(func(dbm *dbmock.MockStore, faker *gofakeit.Faker, check *expects) {
+		arg := database.AcquireWorkspaceBulkOperationWorkspacesParams{StaleBefore: dbtime.Now(), Now: dbtime.Now()}
+		dbm.EXPECT().AcquireWorkspaceBulkOperationWorkspaces(gomock.Any(), arg).Return([]database.WorkspaceBulkOperationWorkspace{}, nil).AnyTimes()
+		check.Args(arg).Asserts(rbac.ResourceSystem, policy.ActionUpdate)
+	}))
+	s.Run("UpdateWorkspaceBulkOperationWorkspace", s.Mocked(func(dbm *dbmock.MockStore, faker *gofakeit.Faker, check *expects) {
+		arg := database.UpdateWorkspaceBulkOperationWorkspaceParams{OperationID: uuid.New(), WorkspaceID: uuid.New(), Status: database.WorkspaceBulkOperationWorkspaceStatusSucceeded}
+		dbm.EXPECT().UpdateWorkspaceBulkOperationWorkspace(gomock.Any(), arg).Return(nil).AnyTimes()
+		check.Args(arg).Asserts(rbac.ResourceSystem, policy.ActionUpdate)
+	}))
+	s.Run("UpdateWorkspaceBulkOperationCompleted", s.Mocked(func(dbm *dbmock.MockStore, faker *gofakeit.Faker, check *expects) {
+		arg := database.UpdateWorkspaceBulkOperationCompletedParams{ID: uuid.New(), Now: dbtime.Now()}
+		dbm.EXPECT().UpdateWorkspaceBulkOperationCompleted(gomock.Any(), arg).Return(nil).AnyTimes()
+		check.Args(arg).Asserts(rbac.ResourceSystem, policy.ActionUpdate)
+	}))
+}
+
 func (s *MethodTestSuite) TestUsageEvents() {
 	s.Run("InsertUsageEvent", s.Mocked(func(db *dbmock.MockStore, faker *gofakeit.Faker, check *expects) {
 		params := database.InsertUsageEventParams{
@@ -6925,6 +7453,17 @@ func (s *MethodTestSuite) TestAIBridge() {
 		check.Args(t).Asserts(rbac.ResourceAibridgeInterception, policy.ActionDelete)
 	}))
 
+	s.Run("GetOldAIBridgeInterceptionsForArchive", s.Mocked(func(db *dbmock.MockStore, _ *gofakeit.Faker, check *expects) {
+		arg := database.GetOldAIBridgeInterceptionsForArchiveParams{}
+		db.EXPECT().GetOldAIBridgeInterceptionsForArchive(gomock.Any(), arg).Return([]database.GetOldAIBridgeInterceptionsForArchiveRow{}, nil).AnyTimes()
+		check.Args(arg).Asserts(rbac.ResourceAibridgeInterception, policy.ActionDelete)
+	}))
+	s.Run("DeleteAIBridgeRecordsByInterceptionIDs", s.Mocked(func(db *dbmock.MockStore, _ *gofakeit.Faker, check *expects) {
+		ids := []uuid.UUID{uuid.New()}
+		db.EXPECT().DeleteAIBridgeRecordsByInterceptionIDs(gomock.Any(), ids).Return(int64(1), nil).AnyTimes()
+		check.Args(ids).Asserts(rbac.ResourceAibridgeInterception, policy.ActionDelete)
+	}))
+
 	s.Run("UpsertAIModelPrices", s.Mocked(func(db *dbmock.MockStore, _ *gofakeit.Faker, check *expects) {
 		db.EXPECT().UpsertAIModelPrices(gomock.Any(), gomock.Any()).Return(nil).AnyTimes()
 		check.Args(database.UpsertAIModelPricesParams{
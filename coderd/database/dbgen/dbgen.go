@@ -1295,6 +1295,7 @@ func ProvisionerJob(t testing.TB, db database.Store, ps pubsub.Pubsub, orig data
 		Tags:           tags,
 		TraceMetadata:  pqtype.NullRawMessage{},
 		LogsOverflowed: false,
+		Priority:       orig.Priority,
 	})
 	require.NoError(t, err, "insert job")
 	if ps != nil {
@@ -1394,6 +1395,8 @@ func WorkspaceApp(t testing.TB, db database.Store, orig database.WorkspaceApp) d
 		Hidden:               orig.Hidden,
 		OpenIn:               takeFirst(orig.OpenIn, database.WorkspaceAppOpenInSlimWindow),
 		Tooltip:              takeFirst(orig.Tooltip, testutil.GetRandomName(t)),
+		HealthcheckAddress:   orig.HealthcheckAddress,
+		HealthcheckCommand:   orig.HealthcheckCommand,
 	})
 	require.NoError(t, err, "insert app")
 	return resource
@@ -1484,6 +1487,61 @@ func WorkspaceModule(t testing.TB, db database.Store, orig database.WorkspaceMod
 	return module
 }
 
+// WorkspaceDriftCheck inserts a drift check. The check is completed with the
+// seed's result when seed.CheckedAt is set.
+func WorkspaceDriftCheck(t testing.TB, db database.Store, seed database.WorkspaceDriftCheck) database.WorkspaceDriftCheck {
+	check, err := db.InsertWorkspaceDriftCheck(genCtx, database.InsertWorkspaceDriftCheckParams{
+		ID:               takeFirst(seed.ID, uuid.New()),
+		WorkspaceID:      takeFirst(seed.WorkspaceID, uuid.New()),
+		WorkspaceBuildID: takeFirst(seed.WorkspaceBuildID, uuid.New()),
+		JobID:            takeFirst(seed.JobID, uuid.New()),
+		CreatedAt:        takeFirst(seed.CreatedAt, dbtime.Now()),
+	})
+	require.NoError(t, err, "insert workspace drift check")
+	if !seed.CheckedAt.Valid {
+		return check
+	}
+	err = db.UpdateWorkspaceDriftCheckByID(genCtx, database.UpdateWorkspaceDriftCheckByIDParams{
+		ID:            check.ID,
+		CheckedAt:     seed.CheckedAt,
+		Drifted:       seed.Drifted,
+		ResourceDrift: takeFirstSlice(seed.ResourceDrift, []byte("[]")),
+	})
+	require.NoError(t, err, "update workspace drift check")
+	check, err = db.GetWorkspaceDriftCheckByJobID(genCtx, check.JobID)
+	require.NoError(t, err, "get workspace drift check")
+	return check
+}
+
+func WorkspaceBulkOperation(t testing.TB, db database.Store, seed database.WorkspaceBulkOperation, workspaceIDs ...uuid.UUID) database.WorkspaceBulkOperation {
+	now := dbtime.Now()
+	op, err := db.InsertWorkspaceBulkOperation(genCtx, database.InsertWorkspaceBulkOperationParams{
+		ID:                takeFirst(seed.ID, uuid.New()),
+		InitiatorID:       takeFirst(seed.InitiatorID, uuid.New()),
+		Action:            takeFirst(seed.Action, database.WorkspaceBulkOperationActionStop),
+		Query:             seed.Query,
+		AutostartSchedule: seed.AutostartSchedule,
+		TTLMillis:         seed.TTLMillis,
+		AutomaticUpdates:  takeFirst(seed.AutomaticUpdates, database.AutomaticUpdatesNever),
+		Concurrency:       takeFirst(seed.Concurrency, 5),
+		WorkspaceCount:    int32(len(workspaceIDs)), // #nosec G115 - Workspace counts fit in an int32.
+		CreatedAt:         takeFirst(seed.CreatedAt, now),
+		UpdatedAt:         takeFirst(seed.UpdatedAt, now),
+		APIKeyScopes:      takeFirstSlice([]database.APIKeyScope(seed.APIKeyScopes), []database.APIKeyScope{database.ApiKeyScopeCoderAll}),
+		APIKeyAllowList:   takeFirstSlice(seed.APIKeyAllowList, database.AllowList{{Type: policy.WildcardSymbol, ID: policy.WildcardSymbol}}),
+	})
+	require.NoError(t, err, "insert workspace bulk operation")
+	if len(workspaceIDs) > 0 {
+		err = db.InsertWorkspaceBulkOperationWorkspaces(genCtx, database.InsertWorkspaceBulkOperationWorkspacesParams{
+			OperationID:  op.ID,
+			WorkspaceIds: workspaceIDs,
+			UpdatedAt:    now,
+		})
+		require.NoError(t, err, "insert workspace bulk operation workspaces")
+	}
+	return op
+}
+
 func WorkspaceResourceMetadatums(t testing.TB, db database.Store, seed database.WorkspaceResourceMetadatum) []database.WorkspaceResourceMetadatum {
 	meta, err := db.InsertWorkspaceResourceMetadata(genCtx, database.InsertWorkspaceResourceMetadataParams{
 		WorkspaceResourceID: takeFirst(seed.WorkspaceResourceID, uuid.New()),
@@ -1548,6 +1606,7 @@ func UserLink(t testing.TB, db database.Store, orig database.UserLink) database.
 		OAuthRefreshTokenKeyID: takeFirst(orig.OAuthRefreshTokenKeyID, sql.NullString{}),
 		OAuthExpiry:            takeFirst(orig.OAuthExpiry, dbtime.Now().Add(time.Hour*24)),
 		Claims:                 orig.Claims,
+		OIDCProviderID:         orig.OIDCProviderID,
 	})
 
 	require.NoError(t, err, "insert link")
@@ -1617,6 +1676,41 @@ func TemplateVersion(t testing.TB, db database.Store, orig database.TemplateVers
 	return version
 }
 
+// TemplateVersionRollout inserts a rollout. The rollout status is updated to
+// the seed's status when it is set and is not in_progress.
+func TemplateVersionRollout(t testing.TB, db database.Store, seed database.TemplateVersionRollout) database.TemplateVersionRollout {
+	now := dbtime.Now()
+	rollout, err := db.InsertTemplateVersionRollout(genCtx, database.InsertTemplateVersionRolloutParams{
+		ID:                        takeFirst(seed.ID, uuid.New()),
+		TemplateID:                takeFirst(seed.TemplateID, uuid.New()),
+		TemplateVersionID:         takeFirst(seed.TemplateVersionID, uuid.New()),
+		PreviousTemplateVersionID: takeFirst(seed.PreviousTemplateVersionID, uuid.New()),
+		Percentage:                seed.Percentage,
+		GroupIDs:                  takeFirstSlice(seed.GroupIDs, []uuid.UUID{}),
+		FailureThreshold:          takeFirst(seed.FailureThreshold, 0.2),
+		MinSamples:                takeFirst(seed.MinSamples, 5),
+		FailureAction:             takeFirst(seed.FailureAction, database.TemplateVersionRolloutFailureActionPause),
+		CreatedBy:                 takeFirst(seed.CreatedBy, uuid.New()),
+		CreatedAt:                 takeFirst(seed.CreatedAt, now),
+		UpdatedAt:                 takeFirst(seed.UpdatedAt, now),
+		StartedAt:                 takeFirst(seed.StartedAt, now),
+	})
+	require.NoError(t, err, "insert template version rollout")
+	if seed.Status == "" || seed.Status == database.TemplateVersionRolloutStatusInProgress {
+		return rollout
+	}
+	rollout, err = db.UpdateTemplateVersionRolloutStatusByID(genCtx, database.UpdateTemplateVersionRolloutStatusByIDParams{
+		ID:            rollout.ID,
+		Status:        seed.Status,
+		StatusMessage: seed.StatusMessage,
+		UpdatedAt:     rollout.UpdatedAt,
+		StartedAt:     rollout.StartedAt,
+		FinishedAt:    seed.FinishedAt,
+	})
+	require.NoError(t, err, "update template version rollout status")
+	return rollout
+}
+
 func TemplateVersionVariable(t testing.TB, db database.Store, orig database.TemplateVersionVariable) database.TemplateVersionVariable {
 	version, err := db.InsertTemplateVersionVariable(genCtx, database.InsertTemplateVersionVariableParams{
 		TemplateVersionID: takeFirst(orig.TemplateVersionID, uuid.New()),
@@ -1776,6 +1870,7 @@ func OAuth2ProviderApp(t testing.TB, db database.Store, seed database.OAuth2Prov
 		SoftwareVersion:         takeFirst(seed.SoftwareVersion, sql.NullString{}),
 		RegistrationAccessToken: seed.RegistrationAccessToken,
 		RegistrationClientUri:   takeFirst(seed.RegistrationClientUri, sql.NullString{}),
+		ClientCredentialsUserID: seed.ClientCredentialsUserID,
 	})
 	require.NoError(t, err, "insert oauth2 app")
 	return app
@@ -1814,6 +1909,31 @@ func OAuth2ProviderAppCode(t testing.TB, db database.Store, seed database.OAuth2
 	return code
 }
 
+func OAuth2ProviderAppDeviceCode(t testing.TB, db database.Store, seed database.OAuth2ProviderAppDeviceCode) database.OAuth2ProviderAppDeviceCode {
+	code, err := db.InsertOAuth2ProviderAppDeviceCode(genCtx, database.InsertOAuth2ProviderAppDeviceCodeParams{
+		ID:               takeFirst(seed.ID, uuid.New()),
+		CreatedAt:        takeFirst(seed.CreatedAt, dbtime.Now()),
+		ExpiresAt:        takeFirst(seed.ExpiresAt, dbtime.Now().Add(15*time.Minute)),
+		DeviceCodePrefix: takeFirstSlice(seed.DeviceCodePrefix, []byte(testutil.GetRandomName(t))),
+		DeviceCodeHash:   takeFirstSlice(seed.DeviceCodeHash, []byte("hashed-secret")),
+		UserCode:         takeFirst(seed.UserCode, strings.ToUpper(testutil.GetRandomName(t))),
+		AppID:            takeFirst(seed.AppID, uuid.New()),
+		ResourceUri:      seed.ResourceUri,
+		Scope:            takeFirst(seed.Scope, string(database.ApiKeyScopeCoderAll)),
+		PollingInterval:  takeFirst(seed.PollingInterval, 5),
+	})
+	require.NoError(t, err, "insert oauth2 app device code")
+	if seed.Status != "" && seed.Status != database.OAuth2DeviceCodeStatusPending {
+		code, err = db.UpdateOAuth2ProviderAppDeviceCodeStatus(genCtx, database.UpdateOAuth2ProviderAppDeviceCodeStatusParams{
+			ID:     code.ID,
+			Status: seed.Status,
+			UserID: seed.UserID,
+		})
+		require.NoError(t, err, "update oauth2 app device code status")
+	}
+	return code
+}
+
 func OAuth2ProviderAppToken(t testing.TB, db database.Store, seed database.OAuth2ProviderAppToken) database.OAuth2ProviderAppToken {
 	require.NotEqual(t, uuid.Nil, seed.AppID, "An app id is required to use 'dbgen.OAuth2ProviderAppToken', use 'dbgen.OAuth2ProviderApp'.")
 	token, err := db.InsertOAuth2ProviderAppToken(genCtx, database.InsertOAuth2ProviderAppTokenParams{
@@ -1865,6 +1985,22 @@ func WorkspaceAgentVolumeResourceMonitor(t testing.TB, db database.Store, seed d
 	return monitor
 }
 
+func WorkspaceAgentResourceMonitor(t testing.TB, db database.Store, seed database.WorkspaceAgentResourceMonitor) database.WorkspaceAgentResourceMonitor {
+	monitor, err := db.InsertResourceMonitor(genCtx, database.InsertResourceMonitorParams{
+		AgentID:        takeFirst(seed.AgentID, uuid.New()),
+		Type:           takeFirst(seed.Type, database.WorkspaceAgentResourceMonitorTypeCpu),
+		Path:           seed.Path,
+		Enabled:        takeFirst(seed.Enabled, true),
+		State:          takeFirst(seed.State, database.WorkspaceAgentMonitorStateOK),
+		Threshold:      takeFirst(seed.Threshold, 100),
+		CreatedAt:      takeFirst(seed.CreatedAt, dbtime.Now()),
+		UpdatedAt:      takeFirst(seed.UpdatedAt, dbtime.Now()),
+		DebouncedUntil: takeFirst(seed.DebouncedUntil, time.Time{}),
+	})
+	require.NoError(t, err, "insert workspace agent resource monitor")
+	return monitor
+}
+
 func CustomRole(t testing.TB, db database.Store, seed database.CustomRole) database.CustomRole {
 	role, err := db.InsertCustomRole(genCtx, database.InsertCustomRoleParams{
 		Name:              takeFirst(seed.Name, strings.ToLower(testutil.GetRandomName(t))),
@@ -5,6 +5,7 @@ package dbmetrics
 
 import (
 	"context"
+	"encoding/json"
 	"slices"
 	"time"
 
@@ -144,6 +145,14 @@ func (m queryMetricsStore) AcquireStaleChatDiffStatuses(ctx context.Context, lim
 	return r0, r1
 }
 
+func (m queryMetricsStore) AcquireWorkspaceBulkOperationWorkspaces(ctx context.Context, arg database.AcquireWorkspaceBulkOperationWorkspacesParams) ([]database.WorkspaceBulkOperationWorkspace, error) {
+	start := time.Now()
+	r0, r1 := m.s.AcquireWorkspaceBulkOperationWorkspaces(ctx, arg)
+	m.queryLatencies.WithLabelValues("AcquireWorkspaceBulkOperationWorkspaces").Observe(time.Since(start).Seconds())
+	m.queryCounts.WithLabelValues(httpmw.ExtractHTTPRoute(ctx), httpmw.ExtractHTTPMethod(ctx), "AcquireWorkspaceBulkOperationWorkspaces").Inc()
+	return r0, r1
+}
+
 func (m queryMetricsStore) ActivityBumpWorkspace(ctx context.Context, arg database.ActivityBumpWorkspaceParams) error {
 	start := time.Now()
 	r0 := m.s.ActivityBumpWorkspace(ctx, arg)
@@ -408,6 +417,14 @@ func (m queryMetricsStore) CustomRoles(ctx context.Context, arg database.CustomR
 	return r0, r1
 }
 
+func (m queryMetricsStore) DeleteAIBridgeRecordsByInterceptionIDs(ctx context.Context, ids []uuid.UUID) (int64, error) {
+	start := time.Now()
+	r0, r1 := m.s.DeleteAIBridgeRecordsByInterceptionIDs(ctx, ids)
+	m.queryLatencies.WithLabelValues("DeleteAIBridgeRecordsByInterceptionIDs").Observe(time.Since(start).Seconds())
+	m.queryCounts.WithLabelValues(httpmw.ExtractHTTPRoute(ctx), httpmw.ExtractHTTPMethod(ctx), "DeleteAIBridgeRecordsByInterceptionIDs").Inc()
+	return r0, r1
+}
+
 func (m queryMetricsStore) DeleteAIGatewayKey(ctx context.Context, id uuid.UUID) (database.DeleteAIGatewayKeyRow, error) {
 	start := time.Now()
 	r0, r1 := m.s.DeleteAIGatewayKey(ctx, id)
@@ -496,6 +513,14 @@ func (m queryMetricsStore) DeleteApplicationConnectAPIKeysByUserID(ctx context.C
 	return r0
 }
 
+func (m queryMetricsStore) DeleteAuditLogsByIDs(ctx context.Context, ids []uuid.UUID) (int64, error) {
+	start := time.Now()
+	r0, r1 := m.s.DeleteAuditLogsByIDs(ctx, ids)
+	m.queryLatencies.WithLabelValues("DeleteAuditLogsByIDs").Observe(time.Since(start).Seconds())
+	m.queryCounts.WithLabelValues(httpmw.ExtractHTTPRoute(ctx), httpmw.ExtractHTTPMethod(ctx), "DeleteAuditLogsByIDs").Inc()
+	return r0, r1
+}
+
 func (m queryMetricsStore) DeleteChatContextResourcesByChatID(ctx context.Context, chatID uuid.UUID) error {
 	start := time.Now()
 	r0 := m.s.DeleteChatContextResourcesByChatID(ctx, chatID)
@@ -552,6 +577,14 @@ func (m queryMetricsStore) DeleteChatQueuedMessageReturningCount(ctx context.Con
 	return r0, r1
 }
 
+func (m queryMetricsStore) DeleteConnectionLogsByIDs(ctx context.Context, ids []uuid.UUID) (int64, error) {
+	start := time.Now()
+	r0, r1 := m.s.DeleteConnectionLogsByIDs(ctx, ids)
+	m.queryLatencies.WithLabelValues("DeleteConnectionLogsByIDs").Observe(time.Since(start).Seconds())
+	m.queryCounts.WithLabelValues(httpmw.ExtractHTTPRoute(ctx), httpmw.ExtractHTTPMethod(ctx), "DeleteConnectionLogsByIDs").Inc()
+	return r0, r1
+}
+
 func (m queryMetricsStore) DeleteCryptoKey(ctx context.Context, arg database.DeleteCryptoKeyParams) (database.CryptoKey, error) {
 	start := time.Now()
 	r0, r1 := m.s.DeleteCryptoKey(ctx, arg)
@@ -576,6 +609,14 @@ func (m queryMetricsStore) DeleteExpiredAPIKeys(ctx context.Context, arg databas
 	return r0, r1
 }
 
+func (m queryMetricsStore) DeleteExpiredOAuth2ProviderAppDeviceCodes(ctx context.Context, beforeTime time.Time) error {
+	start := time.Now()
+	r0 := m.s.DeleteExpiredOAuth2ProviderAppDeviceCodes(ctx, beforeTime)
+	m.queryLatencies.WithLabelValues("DeleteExpiredOAuth2ProviderAppDeviceCodes").Observe(time.Since(start).Seconds())
+	m.queryCounts.WithLabelValues(httpmw.ExtractHTTPRoute(ctx), httpmw.ExtractHTTPMethod(ctx), "DeleteExpiredOAuth2ProviderAppDeviceCodes").Inc()
+	return r0
+}
+
 func (m queryMetricsStore) DeleteExternalAuthLink(ctx context.Context, arg database.DeleteExternalAuthLinkParams) error {
 	start := time.Now()
 	r0 := m.s.DeleteExternalAuthLink(ctx, arg)
@@ -672,6 +713,14 @@ func (m queryMetricsStore) DeleteOAuth2ProviderAppCodesByAppAndUserID(ctx contex
 	return r0
 }
 
+func (m queryMetricsStore) DeleteOAuth2ProviderAppDeviceCodeByID(ctx context.Context, id uuid.UUID) error {
+	start := time.Now()
+	r0 := m.s.DeleteOAuth2ProviderAppDeviceCodeByID(ctx, id)
+	m.queryLatencies.WithLabelValues("DeleteOAuth2ProviderAppDeviceCodeByID").Observe(time.Since(start).Seconds())
+	m.queryCounts.WithLabelValues(httpmw.ExtractHTTPRoute(ctx), httpmw.ExtractHTTPMethod(ctx), "DeleteOAuth2ProviderAppDeviceCodeByID").Inc()
+	return r0
+}
+
 func (m queryMetricsStore) DeleteOAuth2ProviderAppSecretByID(ctx context.Context, id uuid.UUID) error {
 	start := time.Now()
 	r0 := m.s.DeleteOAuth2ProviderAppSecretByID(ctx, id)
@@ -808,6 +857,14 @@ func (m queryMetricsStore) DeleteOrganizationMember(ctx context.Context, arg dat
 	return r0
 }
 
+func (m queryMetricsStore) DeleteProvisionerJobPriority(ctx context.Context, arg database.DeleteProvisionerJobPriorityParams) error {
+	start := time.Now()
+	r0 := m.s.DeleteProvisionerJobPriority(ctx, arg)
+	m.queryLatencies.WithLabelValues("DeleteProvisionerJobPriority").Observe(time.Since(start).Seconds())
+	m.queryCounts.WithLabelValues(httpmw.ExtractHTTPRoute(ctx), httpmw.ExtractHTTPMethod(ctx), "DeleteProvisionerJobPriority").Inc()
+	return r0
+}
+
 func (m queryMetricsStore) DeleteProvisionerKey(ctx context.Context, id uuid.UUID) error {
 	start := time.Now()
 	r0 := m.s.DeleteProvisionerKey(ctx, id)
@@ -832,6 +889,22 @@ func (m queryMetricsStore) DeleteRuntimeConfig(ctx context.Context, key string)
 	return r0
 }
 
+func (m queryMetricsStore) DeleteScheduleExceptionCalendarByID(ctx context.Context, id uuid.UUID) error {
+	start := time.Now()
+	r0 := m.s.DeleteScheduleExceptionCalendarByID(ctx, id)
+	m.queryLatencies.WithLabelValues("DeleteScheduleExceptionCalendarByID").Observe(time.Since(start).Seconds())
+	m.queryCounts.WithLabelValues(httpmw.ExtractHTTPRoute(ctx), httpmw.ExtractHTTPMethod(ctx), "DeleteScheduleExceptionCalendarByID").Inc()
+	return r0
+}
+
+func (m queryMetricsStore) DeleteScheduleExceptionCalendarDates(ctx context.Context, calendarID uuid.UUID) error {
+	start := time.Now()
+	r0 := m.s.DeleteScheduleExceptionCalendarDates(ctx, calendarID)
+	m.queryLatencies.WithLabelValues("DeleteScheduleExceptionCalendarDates").Observe(time.Since(start).Seconds())
+	m.queryCounts.WithLabelValues(httpmw.ExtractHTTPRoute(ctx), httpmw.ExtractHTTPMethod(ctx), "DeleteScheduleExceptionCalendarDates").Inc()
+	return r0
+}
+
 func (m queryMetricsStore) DeleteStaleChatHeartbeats(ctx context.Context, staleSeconds int32) (int64, error) {
 	start := time.Now()
 	r0, r1 := m.s.DeleteStaleChatHeartbeats(ctx, staleSeconds)
@@ -872,6 +945,14 @@ func (m queryMetricsStore) DeleteTask(ctx context.Context, arg database.DeleteTa
 	return r0, r1
 }
 
+func (m queryMetricsStore) DeleteTemplateScheduleExceptionCalendars(ctx context.Context, templateID uuid.UUID) error {
+	start := time.Now()
+	r0 := m.s.DeleteTemplateScheduleExceptionCalendars(ctx, templateID)
+	m.queryLatencies.WithLabelValues("DeleteTemplateScheduleExceptionCalendars").Observe(time.Since(start).Seconds())
+	m.queryCounts.WithLabelValues(httpmw.ExtractHTTPRoute(ctx), httpmw.ExtractHTTPMethod(ctx), "DeleteTemplateScheduleExceptionCalendars").Inc()
+	return r0
+}
+
 func (m queryMetricsStore) DeleteUnlinkedChatFilesByIDs(ctx context.Context, arg database.DeleteUnlinkedChatFilesByIDsParams) (int64, error) {
 	start := time.Now()
 	r0, r1 := m.s.DeleteUnlinkedChatFilesByIDs(ctx, arg)
@@ -912,6 +993,14 @@ func (m queryMetricsStore) DeleteUserChatCompactionThreshold(ctx context.Context
 	return r0
 }
 
+func (m queryMetricsStore) DeleteUserOutOfOfficeRange(ctx context.Context, arg database.DeleteUserOutOfOfficeRangeParams) error {
+	start := time.Now()
+	r0 := m.s.DeleteUserOutOfOfficeRange(ctx, arg)
+	m.queryLatencies.WithLabelValues("DeleteUserOutOfOfficeRange").Observe(time.Since(start).Seconds())
+	m.queryCounts.WithLabelValues(httpmw.ExtractHTTPRoute(ctx), httpmw.ExtractHTTPMethod(ctx), "DeleteUserOutOfOfficeRange").Inc()
+	return r0
+}
+
 func (m queryMetricsStore) DeleteUserSecretByUserIDAndName(ctx context.Context, arg database.DeleteUserSecretByUserIDAndNameParams) (database.UserSecret, error) {
 	start := time.Now()
 	r0, r1 := m.s.DeleteUserSecretByUserIDAndName(ctx, arg)
@@ -960,6 +1049,14 @@ func (m queryMetricsStore) DeleteWorkspaceACLsByOrganization(ctx context.Context
 	return r0
 }
 
+func (m queryMetricsStore) DeleteWorkspaceAgentLogsByIDs(ctx context.Context, ids []int64) (int64, error) {
+	start := time.Now()
+	r0, r1 := m.s.DeleteWorkspaceAgentLogsByIDs(ctx, ids)
+	m.queryLatencies.WithLabelValues("DeleteWorkspaceAgentLogsByIDs").Observe(time.Since(start).Seconds())
+	m.queryCounts.WithLabelValues(httpmw.ExtractHTTPRoute(ctx), httpmw.ExtractHTTPMethod(ctx), "DeleteWorkspaceAgentLogsByIDs").Inc()
+	return r0, r1
+}
+
 func (m queryMetricsStore) DeleteWorkspaceAgentPortShare(ctx context.Context, arg database.DeleteWorkspaceAgentPortShareParams) error {
 	start := time.Now()
 	r0 := m.s.DeleteWorkspaceAgentPortShare(ctx, arg)
@@ -1048,6 +1145,14 @@ func (m queryMetricsStore) FetchNewMessageMetadata(ctx context.Context, arg data
 	return r0, r1
 }
 
+func (m queryMetricsStore) FetchResourceMonitorsByAgentID(ctx context.Context, agentID uuid.UUID) ([]database.WorkspaceAgentResourceMonitor, error) {
+	start := time.Now()
+	r0, r1 := m.s.FetchResourceMonitorsByAgentID(ctx, agentID)
+	m.queryLatencies.WithLabelValues("FetchResourceMonitorsByAgentID").Observe(time.Since(start).Seconds())
+	m.queryCounts.WithLabelValues(httpmw.ExtractHTTPRoute(ctx), httpmw.ExtractHTTPMethod(ctx), "FetchResourceMonitorsByAgentID").Inc()
+	return r0, r1
+}
+
 func (m queryMetricsStore) FetchVolumesResourceMonitorsByAgentID(ctx context.Context, agentID uuid.UUID) ([]database.WorkspaceAgentVolumeResourceMonitor, error) {
 	start := time.Now()
 	r0, r1 := m.s.FetchVolumesResourceMonitorsByAgentID(ctx, agentID)
@@ -1304,6 +1409,14 @@ func (m queryMetricsStore) GetActivePresetPrebuildSchedules(ctx context.Context)
 	return r0, r1
 }
 
+func (m queryMetricsStore) GetActiveTemplateVersionRolloutByTemplateID(ctx context.Context, templateID uuid.UUID) (database.TemplateVersionRollout, error) {
+	start := time.Now()
+	r0, r1 := m.s.GetActiveTemplateVersionRolloutByTemplateID(ctx, templateID)
+	m.queryLatencies.WithLabelValues("GetActiveTemplateVersionRolloutByTemplateID").Observe(time.Since(start).Seconds())
+	m.queryCounts.WithLabelValues(httpmw.ExtractHTTPRoute(ctx), httpmw.ExtractHTTPMethod(ctx), "GetActiveTemplateVersionRolloutByTemplateID").Inc()
+	return r0, r1
+}
+
 func (m queryMetricsStore) GetActiveUserCount(ctx context.Context, includeSystem bool) (int64, error) {
 	start := time.Now()
 	r0, r1 := m.s.GetActiveUserCount(ctx, includeSystem)
@@ -2224,6 +2337,14 @@ func (m queryMetricsStore) GetHighestGroupAIBudgetByUser(ctx context.Context, us
 	return r0, r1
 }
 
+func (m queryMetricsStore) GetInProgressTemplateVersionRollouts(ctx context.Context) ([]database.TemplateVersionRollout, error) {
+	start := time.Now()
+	r0, r1 := m.s.GetInProgressTemplateVersionRollouts(ctx)
+	m.queryLatencies.WithLabelValues("GetInProgressTemplateVersionRollouts").Observe(time.Since(start).Seconds())
+	m.queryCounts.WithLabelValues(httpmw.ExtractHTTPRoute(ctx), httpmw.ExtractHTTPMethod(ctx), "GetInProgressTemplateVersionRollouts").Inc()
+	return r0, r1
+}
+
 func (m queryMetricsStore) GetInboxNotificationByID(ctx context.Context, id uuid.UUID) (database.InboxNotification, error) {
 	start := time.Now()
 	r0, r1 := m.s.GetInboxNotificationByID(ctx, id)
@@ -2312,6 +2433,14 @@ func (m queryMetricsStore) GetLatestWorkspaceBuildsByWorkspaceIDs(ctx context.Co
 	return r0, r1
 }
 
+func (m queryMetricsStore) GetLatestWorkspaceDriftChecksByWorkspaceIDs(ctx context.Context, ids []uuid.UUID) ([]database.WorkspaceDriftCheck, error) {
+	start := time.Now()
+	r0, r1 := m.s.GetLatestWorkspaceDriftChecksByWorkspaceIDs(ctx, ids)
+	m.queryLatencies.WithLabelValues("GetLatestWorkspaceDriftChecksByWorkspaceIDs").Observe(time.Since(start).Seconds())
+	m.queryCounts.WithLabelValues(httpmw.ExtractHTTPRoute(ctx), httpmw.ExtractHTTPMethod(ctx), "GetLatestWorkspaceDriftChecksByWorkspaceIDs").Inc()
+	return r0, r1
+}
+
 func (m queryMetricsStore) GetLicenseByID(ctx context.Context, id int32) (database.License, error) {
 	start := time.Now()
 	r0, r1 := m.s.GetLicenseByID(ctx, id)
@@ -2488,6 +2617,30 @@ func (m queryMetricsStore) GetOAuth2ProviderAppCodeByPrefix(ctx context.Context,
 	return r0, r1
 }
 
+func (m queryMetricsStore) GetOAuth2ProviderAppDeviceCodeByID(ctx context.Context, id uuid.UUID) (database.OAuth2ProviderAppDeviceCode, error) {
+	start := time.Now()
+	r0, r1 := m.s.GetOAuth2ProviderAppDeviceCodeByID(ctx, id)
+	m.queryLatencies.WithLabelValues("GetOAuth2ProviderAppDeviceCodeByID").Observe(time.Since(start).Seconds())
+	m.queryCounts.WithLabelValues(httpmw.ExtractHTTPRoute(ctx), httpmw.ExtractHTTPMethod(ctx), "GetOAuth2ProviderAppDeviceCodeByID").Inc()
+	return r0, r1
+}
+
+func (m queryMetricsStore) GetOAuth2ProviderAppDeviceCodeByPrefix(ctx context.Context, deviceCodePrefix []byte) (database.OAuth2ProviderAppDeviceCode, error) {
+	start := time.Now()
+	r0, r1 := m.s.GetOAuth2ProviderAppDeviceCodeByPrefix(ctx, deviceCodePrefix)
+	m.queryLatencies.WithLabelValues("GetOAuth2ProviderAppDeviceCodeByPrefix").Observe(time.Since(start).Seconds())
+	m.queryCounts.WithLabelValues(httpmw.ExtractHTTPRoute(ctx), httpmw.ExtractHTTPMethod(ctx), "GetOAuth2ProviderAppDeviceCodeByPrefix").Inc()
+	return r0, r1
+}
+
+func (m queryMetricsStore) GetOAuth2ProviderAppDeviceCodeByUserCode(ctx context.Context, userCode string) (database.OAuth2ProviderAppDeviceCode, error) {
+	start := time.Now()
+	r0, r1 := m.s.GetOAuth2ProviderAppDeviceCodeByUserCode(ctx, userCode)
+	m.queryLatencies.WithLabelValues("GetOAuth2ProviderAppDeviceCodeByUserCode").Observe(time.Since(start).Seconds())
+	m.queryCounts.WithLabelValues(httpmw.ExtractHTTPRoute(ctx), httpmw.ExtractHTTPMethod(ctx), "GetOAuth2ProviderAppDeviceCodeByUserCode").Inc()
+	return r0, r1
+}
+
 func (m queryMetricsStore) GetOAuth2ProviderAppSecretByID(ctx context.Context, id uuid.UUID) (database.OAuth2ProviderAppSecret, error) {
 	start := time.Now()
 	r0, r1 := m.s.GetOAuth2ProviderAppSecretByID(ctx, id)
@@ -2544,6 +2697,38 @@ func (m queryMetricsStore) GetOAuth2ProviderAppsByUserID(ctx context.Context, us
 	return r0, r1
 }
 
+func (m queryMetricsStore) GetOldAIBridgeInterceptionsForArchive(ctx context.Context, arg database.GetOldAIBridgeInterceptionsForArchiveParams) ([]database.GetOldAIBridgeInterceptionsForArchiveRow, error) {
+	start := time.Now()
+	r0, r1 := m.s.GetOldAIBridgeInterceptionsForArchive(ctx, arg)
+	m.queryLatencies.WithLabelValues("GetOldAIBridgeInterceptionsForArchive").Observe(time.Since(start).Seconds())
+	m.queryCounts.WithLabelValues(httpmw.ExtractHTTPRoute(ctx), httpmw.ExtractHTTPMethod(ctx), "GetOldAIBridgeInterceptionsForArchive").Inc()
+	return r0, r1
+}
+
+func (m queryMetricsStore) GetOldAuditLogConnectionEventsForArchive(ctx context.Context, arg database.GetOldAuditLogConnectionEventsForArchiveParams) ([]json.RawMessage, error) {
+	start := time.Now()
+	r0, r1 := m.s.GetOldAuditLogConnectionEventsForArchive(ctx, arg)
+	m.queryLatencies.WithLabelValues("GetOldAuditLogConnectionEventsForArchive").Observe(time.Since(start).Seconds())
+	m.queryCounts.WithLabelValues(httpmw.ExtractHTTPRoute(ctx), httpmw.ExtractHTTPMethod(ctx), "GetOldAuditLogConnectionEventsForArchive").Inc()
+	return r0, r1
+}
+
+func (m queryMetricsStore) GetOldAuditLogsForArchive(ctx context.Context, arg database.GetOldAuditLogsForArchiveParams) ([]json.RawMessage, error) {
+	start := time.Now()
+	r0, r1 := m.s.GetOldAuditLogsForArchive(ctx, arg)
+	m.queryLatencies.WithLabelValues("GetOldAuditLogsForArchive").Observe(time.Since(start).Seconds())
+	m.queryCounts.WithLabelValues(httpmw.ExtractHTTPRoute(ctx), httpmw.ExtractHTTPMethod(ctx), "GetOldAuditLogsForArchive").Inc()
+	return r0, r1
+}
+
+func (m queryMetricsStore) GetOldConnectionLogsForArchive(ctx context.Context, arg database.GetOldConnectionLogsForArchiveParams) ([]json.RawMessage, error) {
+	start := time.Now()
+	r0, r1 := m.s.GetOldConnectionLogsForArchive(ctx, arg)
+	m.queryLatencies.WithLabelValues("GetOldConnectionLogsForArchive").Observe(time.Since(start).Seconds())
+	m.queryCounts.WithLabelValues(httpmw.ExtractHTTPRoute(ctx), httpmw.ExtractHTTPMethod(ctx), "GetOldConnectionLogsForArchive").Inc()
+	return r0, r1
+}
+
 func (m queryMetricsStore) GetOldUnlinkedChatFileIDs(ctx context.Context, arg database.GetOldUnlinkedChatFileIDsParams) ([]uuid.UUID, error) {
 	start := time.Now()
 	r0, r1 := m.s.GetOldUnlinkedChatFileIDs(ctx, arg)
@@ -2552,6 +2737,14 @@ func (m queryMetricsStore) GetOldUnlinkedChatFileIDs(ctx context.Context, arg da
 	return r0, r1
 }
 
+func (m queryMetricsStore) GetOldWorkspaceAgentLogsForArchive(ctx context.Context, arg database.GetOldWorkspaceAgentLogsForArchiveParams) ([]database.GetOldWorkspaceAgentLogsForArchiveRow, error) {
+	start := time.Now()
+	r0, r1 := m.s.GetOldWorkspaceAgentLogsForArchive(ctx, arg)
+	m.queryLatencies.WithLabelValues("GetOldWorkspaceAgentLogsForArchive").Observe(time.Since(start).Seconds())
+	m.queryCounts.WithLabelValues(httpmw.ExtractHTTPRoute(ctx), httpmw.ExtractHTTPMethod(ctx), "GetOldWorkspaceAgentLogsForArchive").Inc()
+	return r0, r1
+}
+
 func (m queryMetricsStore) GetOrganizationByID(ctx context.Context, id uuid.UUID) (database.Organization, error) {
 	start := time.Now()
 	r0, r1 := m.s.GetOrganizationByID(ctx, id)
@@ -2760,6 +2953,14 @@ func (m queryMetricsStore) GetProvisionerJobByIDWithLock(ctx context.Context, id
 	return r0, r1
 }
 
+func (m queryMetricsStore) GetProvisionerJobPrioritiesByOrganizationID(ctx context.Context, organizationID uuid.UUID) ([]database.ProvisionerJobPriority, error) {
+	start := time.Now()
+	r0, r1 := m.s.GetProvisionerJobPrioritiesByOrganizationID(ctx, organizationID)
+	m.queryLatencies.WithLabelValues("GetProvisionerJobPrioritiesByOrganizationID").Observe(time.Since(start).Seconds())
+	m.queryCounts.WithLabelValues(httpmw.ExtractHTTPRoute(ctx), httpmw.ExtractHTTPMethod(ctx), "GetProvisionerJobPrioritiesByOrganizationID").Inc()
+	return r0, r1
+}
+
 func (m queryMetricsStore) GetProvisionerJobTimingsByJobID(ctx context.Context, jobID uuid.UUID) ([]database.ProvisionerJobTiming, error) {
 	start := time.Now()
 	r0, r1 := m.s.GetProvisionerJobTimingsByJobID(ctx, jobID)
@@ -2832,6 +3033,14 @@ func (m queryMetricsStore) GetProvisionerLogsAfterID(ctx context.Context, arg da
 	return r0, r1
 }
 
+func (m queryMetricsStore) GetPurgeArchiveImports(ctx context.Context) ([]database.PurgeArchiveImport, error) {
+	start := time.Now()
+	r0, r1 := m.s.GetPurgeArchiveImports(ctx)
+	m.queryLatencies.WithLabelValues("GetPurgeArchiveImports").Observe(time.Since(start).Seconds())
+	m.queryCounts.WithLabelValues(httpmw.ExtractHTTPRoute(ctx), httpmw.ExtractHTTPMethod(ctx), "GetPurgeArchiveImports").Inc()
+	return r0, r1
+}
+
 func (m queryMetricsStore) GetQuotaAllowanceForUser(ctx context.Context, arg database.GetQuotaAllowanceForUserParams) (int64, error) {
 	start := time.Now()
 	r0, r1 := m.s.GetQuotaAllowanceForUser(ctx, arg)
@@ -2888,6 +3097,38 @@ func (m queryMetricsStore) GetRuntimeConfig(ctx context.Context, key string) (st
 	return r0, r1
 }
 
+func (m queryMetricsStore) GetScheduleExceptionCalendarByID(ctx context.Context, id uuid.UUID) (database.ScheduleExceptionCalendar, error) {
+	start := time.Now()
+	r0, r1 := m.s.GetScheduleExceptionCalendarByID(ctx, id)
+	m.queryLatencies.WithLabelValues("GetScheduleExceptionCalendarByID").Observe(time.Since(start).Seconds())
+	m.queryCounts.WithLabelValues(httpmw.ExtractHTTPRoute(ctx), httpmw.ExtractHTTPMethod(ctx), "GetScheduleExceptionCalendarByID").Inc()
+	return r0, r1
+}
+
+func (m queryMetricsStore) GetScheduleExceptionCalendarDates(ctx context.Context, calendarID uuid.UUID) ([]database.ScheduleExceptionCalendarDate, error) {
+	start := time.Now()
+	r0, r1 := m.s.GetScheduleExceptionCalendarDates(ctx, calendarID)
+	m.queryLatencies.WithLabelValues("GetScheduleExceptionCalendarDates").Observe(time.Since(start).Seconds())
+	m.queryCounts.WithLabelValues(httpmw.ExtractHTTPRoute(ctx), httpmw.ExtractHTTPMethod(ctx), "GetScheduleExceptionCalendarDates").Inc()
+	return r0, r1
+}
+
+func (m queryMetricsStore) GetScheduleExceptionCalendarsByOrganizationID(ctx context.Context, organizationID uuid.UUID) ([]database.ScheduleExceptionCalendar, error) {
+	start := time.Now()
+	r0, r1 := m.s.GetScheduleExceptionCalendarsByOrganizationID(ctx, organizationID)
+	m.queryLatencies.WithLabelValues("GetScheduleExceptionCalendarsByOrganizationID").Observe(time.Since(start).Seconds())
+	m.queryCounts.WithLabelValues(httpmw.ExtractHTTPRoute(ctx), httpmw.ExtractHTTPMethod(ctx), "GetScheduleExceptionCalendarsByOrganizationID").Inc()
+	return r0, r1
+}
+
+func (m queryMetricsStore) GetScheduleExceptionCalendarsByTemplateID(ctx context.Context, templateID uuid.UUID) ([]database.ScheduleExceptionCalendar, error) {
+	start := time.Now()
+	r0, r1 := m.s.GetScheduleExceptionCalendarsByTemplateID(ctx, templateID)
+	m.queryLatencies.WithLabelValues("GetScheduleExceptionCalendarsByTemplateID").Observe(time.Since(start).Seconds())
+	m.queryCounts.WithLabelValues(httpmw.ExtractHTTPRoute(ctx), httpmw.ExtractHTTPMethod(ctx), "GetScheduleExceptionCalendarsByTemplateID").Inc()
+	return r0, r1
+}
+
 func (m queryMetricsStore) GetStaleChats(ctx context.Context, staleThreshold time.Time) ([]database.Chat, error) {
 	start := time.Now()
 	r0, r1 := m.s.GetStaleChats(ctx, staleThreshold)
@@ -3104,6 +3345,38 @@ func (m queryMetricsStore) GetTemplateVersionParameters(ctx context.Context, tem
 	return r0, r1
 }
 
+func (m queryMetricsStore) GetTemplateVersionRolloutByID(ctx context.Context, id uuid.UUID) (database.TemplateVersionRollout, error) {
+	start := time.Now()
+	r0, r1 := m.s.GetTemplateVersionRolloutByID(ctx, id)
+	m.queryLatencies.WithLabelValues("GetTemplateVersionRolloutByID").Observe(time.Since(start).Seconds())
+	m.queryCounts.WithLabelValues(httpmw.ExtractHTTPRoute(ctx), httpmw.ExtractHTTPMethod(ctx), "GetTemplateVersionRolloutByID").Inc()
+	return r0, r1
+}
+
+func (m queryMetricsStore) GetTemplateVersionRolloutStats(ctx context.Context, arg database.GetTemplateVersionRolloutStatsParams) (database.GetTemplateVersionRolloutStatsRow, error) {
+	start := time.Now()
+	r0, r1 := m.s.GetTemplateVersionRolloutStats(ctx, arg)
+	m.queryLatencies.WithLabelValues("GetTemplateVersionRolloutStats").Observe(time.Since(start).Seconds())
+	m.queryCounts.WithLabelValues(httpmw.ExtractHTTPRoute(ctx), httpmw.ExtractHTTPMethod(ctx), "GetTemplateVersionRolloutStats").Inc()
+	return r0, r1
+}
+
+func (m queryMetricsStore) GetTemplateVersionRolloutTargetsUser(ctx context.Context, arg database.GetTemplateVersionRolloutTargetsUserParams) (bool, error) {
+	start := time.Now()
+	r0, r1 := m.s.GetTemplateVersionRolloutTargetsUser(ctx, arg)
+	m.queryLatencies.WithLabelValues("GetTemplateVersionRolloutTargetsUser").Observe(time.Since(start).Seconds())
+	m.queryCounts.WithLabelValues(httpmw.ExtractHTTPRoute(ctx), httpmw.ExtractHTTPMethod(ctx), "GetTemplateVersionRolloutTargetsUser").Inc()
+	return r0, r1
+}
+
+func (m queryMetricsStore) GetTemplateVersionRolloutsByTemplateID(ctx context.Context, templateID uuid.UUID) ([]database.TemplateVersionRollout, error) {
+	start := time.Now()
+	r0, r1 := m.s.GetTemplateVersionRolloutsByTemplateID(ctx, templateID)
+	m.queryLatencies.WithLabelValues("GetTemplateVersionRolloutsByTemplateID").Observe(time.Since(start).Seconds())
+	m.queryCounts.WithLabelValues(httpmw.ExtractHTTPRoute(ctx), httpmw.ExtractHTTPMethod(ctx), "GetTemplateVersionRolloutsByTemplateID").Inc()
+	return r0, r1
+}
+
 func (m queryMetricsStore) GetTemplateVersionTerraformValues(ctx context.Context, templateVersionID uuid.UUID) (database.TemplateVersionTerraformValue, error) {
 	start := time.Now()
 	r0, r1 := m.s.GetTemplateVersionTerraformValues(ctx, templateVersionID)
@@ -3392,6 +3665,14 @@ func (m queryMetricsStore) GetUserNotificationPreferences(ctx context.Context, u
 	return r0, r1
 }
 
+func (m queryMetricsStore) GetUserOutOfOfficeRangesByUserID(ctx context.Context, userID uuid.UUID) ([]database.UserOutOfOfficeRange, error) {
+	start := time.Now()
+	r0, r1 := m.s.GetUserOutOfOfficeRangesByUserID(ctx, userID)
+	m.queryLatencies.WithLabelValues("GetUserOutOfOfficeRangesByUserID").Observe(time.Since(start).Seconds())
+	m.queryCounts.WithLabelValues(httpmw.ExtractHTTPRoute(ctx), httpmw.ExtractHTTPMethod(ctx), "GetUserOutOfOfficeRangesByUserID").Inc()
+	return r0, r1
+}
+
 func (m queryMetricsStore) GetUserSecretByID(ctx context.Context, id uuid.UUID) (database.UserSecret, error) {
 	start := time.Now()
 	r0, r1 := m.s.GetUserSecretByID(ctx, id)
@@ -3544,6 +3825,14 @@ func (m queryMetricsStore) GetWorkspaceAgentLogSourcesByAgentIDs(ctx context.Con
 	return r0, r1
 }
 
+func (m queryMetricsStore) GetWorkspaceAgentLogTailsByAgentIDs(ctx context.Context, ids []uuid.UUID) ([]database.WorkspaceAgentLogTail, error) {
+	start := time.Now()
+	r0, r1 := m.s.GetWorkspaceAgentLogTailsByAgentIDs(ctx, ids)
+	m.queryLatencies.WithLabelValues("GetWorkspaceAgentLogTailsByAgentIDs").Observe(time.Since(start).Seconds())
+	m.queryCounts.WithLabelValues(httpmw.ExtractHTTPRoute(ctx), httpmw.ExtractHTTPMethod(ctx), "GetWorkspaceAgentLogTailsByAgentIDs").Inc()
+	return r0, r1
+}
+
 func (m queryMetricsStore) GetWorkspaceAgentLogsAfter(ctx context.Context, arg database.GetWorkspaceAgentLogsAfterParams) ([]database.WorkspaceAgentLog, error) {
 	start := time.Now()
 	r0, r1 := m.s.GetWorkspaceAgentLogsAfter(ctx, arg)
@@ -3560,6 +3849,38 @@ func (m queryMetricsStore) GetWorkspaceAgentMetadata(ctx context.Context, arg da
 	return r0, r1
 }
 
+func (m queryMetricsStore) GetWorkspaceAgentPTYShareByHashedToken(ctx context.Context, hashedToken []byte) (database.WorkspaceAgentPTYShare, error) {
+	start := time.Now()
+	r0, r1 := m.s.GetWorkspaceAgentPTYShareByHashedToken(ctx, hashedToken)
+	m.queryLatencies.WithLabelValues("GetWorkspaceAgentPTYShareByHashedToken").Observe(time.Since(start).Seconds())
+	m.queryCounts.WithLabelValues(httpmw.ExtractHTTPRoute(ctx), httpmw.ExtractHTTPMethod(ctx), "GetWorkspaceAgentPTYShareByHashedToken").Inc()
+	return r0, r1
+}
+
+func (m queryMetricsStore) GetWorkspaceAgentPTYShareByID(ctx context.Context, id uuid.UUID) (database.WorkspaceAgentPTYShare, error) {
+	start := time.Now()
+	r0, r1 := m.s.GetWorkspaceAgentPTYShareByID(ctx, id)
+	m.queryLatencies.WithLabelValues("GetWorkspaceAgentPTYShareByID").Observe(time.Since(start).Seconds())
+	m.queryCounts.WithLabelValues(httpmw.ExtractHTTPRoute(ctx), httpmw.ExtractHTTPMethod(ctx), "GetWorkspaceAgentPTYShareByID").Inc()
+	return r0, r1
+}
+
+func (m queryMetricsStore) GetWorkspaceAgentPTYShareViewersByAgentID(ctx context.Context, agentID uuid.UUID) ([]database.GetWorkspaceAgentPTYShareViewersByAgentIDRow, error) {
+	start := time.Now()
+	r0, r1 := m.s.GetWorkspaceAgentPTYShareViewersByAgentID(ctx, agentID)
+	m.queryLatencies.WithLabelValues("GetWorkspaceAgentPTYShareViewersByAgentID").Observe(time.Since(start).Seconds())
+	m.queryCounts.WithLabelValues(httpmw.ExtractHTTPRoute(ctx), httpmw.ExtractHTTPMethod(ctx), "GetWorkspaceAgentPTYShareViewersByAgentID").Inc()
+	return r0, r1
+}
+
+func (m queryMetricsStore) GetWorkspaceAgentPTYSharesByAgentID(ctx context.Context, agentID uuid.UUID) ([]database.WorkspaceAgentPTYShare, error) {
+	start := time.Now()
+	r0, r1 := m.s.GetWorkspaceAgentPTYSharesByAgentID(ctx, agentID)
+	m.queryLatencies.WithLabelValues("GetWorkspaceAgentPTYSharesByAgentID").Observe(time.Since(start).Seconds())
+	m.queryCounts.WithLabelValues(httpmw.ExtractHTTPRoute(ctx), httpmw.ExtractHTTPMethod(ctx), "GetWorkspaceAgentPTYSharesByAgentID").Inc()
+	return r0, r1
+}
+
 func (m queryMetricsStore) GetWorkspaceAgentPortShare(ctx context.Context, arg database.GetWorkspaceAgentPortShareParams) (database.WorkspaceAgentPortShare, error) {
 	start := time.Now()
 	r0, r1 := m.s.GetWorkspaceAgentPortShare(ctx, arg)
@@ -3720,6 +4041,22 @@ func (m queryMetricsStore) GetWorkspaceAppsCreatedAfter(ctx context.Context, cre
 	return r0, r1
 }
 
+func (m queryMetricsStore) GetWorkspaceAutostartExceptions(ctx context.Context, arg database.GetWorkspaceAutostartExceptionsParams) ([]database.GetWorkspaceAutostartExceptionsRow, error) {
+	start := time.Now()
+	r0, r1 := m.s.GetWorkspaceAutostartExceptions(ctx, arg)
+	m.queryLatencies.WithLabelValues("GetWorkspaceAutostartExceptions").Observe(time.Since(start).Seconds())
+	m.queryCounts.WithLabelValues(httpmw.ExtractHTTPRoute(ctx), httpmw.ExtractHTTPMethod(ctx), "GetWorkspaceAutostartExceptions").Inc()
+	return r0, r1
+}
+
+func (m queryMetricsStore) GetWorkspaceAutostartExceptionsByWorkspaceIDs(ctx context.Context, arg database.GetWorkspaceAutostartExceptionsByWorkspaceIDsParams) ([]database.GetWorkspaceAutostartExceptionsByWorkspaceIDsRow, error) {
+	start := time.Now()
+	r0, r1 := m.s.GetWorkspaceAutostartExceptionsByWorkspaceIDs(ctx, arg)
+	m.queryLatencies.WithLabelValues("GetWorkspaceAutostartExceptionsByWorkspaceIDs").Observe(time.Since(start).Seconds())
+	m.queryCounts.WithLabelValues(httpmw.ExtractHTTPRoute(ctx), httpmw.ExtractHTTPMethod(ctx), "GetWorkspaceAutostartExceptionsByWorkspaceIDs").Inc()
+	return r0, r1
+}
+
 func (m queryMetricsStore) GetWorkspaceBuildAgentsByInstanceID(ctx context.Context, authInstanceID string) ([]database.GetWorkspaceBuildAgentsByInstanceIDRow, error) {
 	start := time.Now()
 	r0, r1 := m.s.GetWorkspaceBuildAgentsByInstanceID(ctx, authInstanceID)
@@ -3800,6 +4137,38 @@ func (m queryMetricsStore) GetWorkspaceBuildsCreatedAfter(ctx context.Context, c
 	return r0, r1
 }
 
+func (m queryMetricsStore) GetWorkspaceBulkOperationByID(ctx context.Context, id uuid.UUID) (database.WorkspaceBulkOperation, error) {
+	start := time.Now()
+	r0, r1 := m.s.GetWorkspaceBulkOperationByID(ctx, id)
+	m.queryLatencies.WithLabelValues("GetWorkspaceBulkOperationByID").Observe(time.Since(start).Seconds())
+	m.queryCounts.WithLabelValues(httpmw.ExtractHTTPRoute(ctx), httpmw.ExtractHTTPMethod(ctx), "GetWorkspaceBulkOperationByID").Inc()
+	return r0, r1
+}
+
+func (m queryMetricsStore) GetWorkspaceBulkOperationProgress(ctx context.Context, operationID uuid.UUID) (database.GetWorkspaceBulkOperationProgressRow, error) {
+	start := time.Now()
+	r0, r1 := m.s.GetWorkspaceBulkOperationProgress(ctx, operationID)
+	m.queryLatencies.WithLabelValues("GetWorkspaceBulkOperationProgress").Observe(time.Since(start).Seconds())
+	m.queryCounts.WithLabelValues(httpmw.ExtractHTTPRoute(ctx), httpmw.ExtractHTTPMethod(ctx), "GetWorkspaceBulkOperationProgress").Inc()
+	return r0, r1
+}
+
+func (m queryMetricsStore) GetWorkspaceBulkOperationWorkspaces(ctx context.Context, operationID uuid.UUID) ([]database.GetWorkspaceBulkOperationWorkspacesRow, error) {
+	start := time.Now()
+	r0, r1 := m.s.GetWorkspaceBulkOperationWorkspaces(ctx, operationID)
+	m.queryLatencies.WithLabelValues("GetWorkspaceBulkOperationWorkspaces").Observe(time.Since(start).Seconds())
+	m.queryCounts.WithLabelValues(httpmw.ExtractHTTPRoute(ctx), httpmw.ExtractHTTPMethod(ctx), "GetWorkspaceBulkOperationWorkspaces").Inc()
+	return r0, r1
+}
+
+func (m queryMetricsStore) GetWorkspaceBulkOperationsByInitiatorID(ctx context.Context, arg database.GetWorkspaceBulkOperationsByInitiatorIDParams) ([]database.WorkspaceBulkOperation, error) {
+	start := time.Now()
+	r0, r1 := m.s.GetWorkspaceBulkOperationsByInitiatorID(ctx, arg)
+	m.queryLatencies.WithLabelValues("GetWorkspaceBulkOperationsByInitiatorID").Observe(time.Since(start).Seconds())
+	m.queryCounts.WithLabelValues(httpmw.ExtractHTTPRoute(ctx), httpmw.ExtractHTTPMethod(ctx), "GetWorkspaceBulkOperationsByInitiatorID").Inc()
+	return r0, r1
+}
+
 func (m queryMetricsStore) GetWorkspaceByAgentID(ctx context.Context, agentID uuid.UUID) (database.Workspace, error) {
 	start := time.Now()
 	r0, r1 := m.s.GetWorkspaceByAgentID(ctx, agentID)
@@ -3840,6 +4209,14 @@ func (m queryMetricsStore) GetWorkspaceByWorkspaceAppID(ctx context.Context, wor
 	return r0, r1
 }
 
+func (m queryMetricsStore) GetWorkspaceDriftCheckByJobID(ctx context.Context, jobID uuid.UUID) (database.WorkspaceDriftCheck, error) {
+	start := time.Now()
+	r0, r1 := m.s.GetWorkspaceDriftCheckByJobID(ctx, jobID)
+	m.queryLatencies.WithLabelValues("GetWorkspaceDriftCheckByJobID").Observe(time.Since(start).Seconds())
+	m.queryCounts.WithLabelValues(httpmw.ExtractHTTPRoute(ctx), httpmw.ExtractHTTPMethod(ctx), "GetWorkspaceDriftCheckByJobID").Inc()
+	return r0, r1
+}
+
 func (m queryMetricsStore) GetWorkspaceModulesByJobID(ctx context.Context, jobID uuid.UUID) ([]database.WorkspaceModule, error) {
 	start := time.Now()
 	r0, r1 := m.s.GetWorkspaceModulesByJobID(ctx, jobID)
@@ -3968,6 +4345,14 @@ func (m queryMetricsStore) GetWorkspacesByTemplateID(ctx context.Context, templa
 	return r0, r1
 }
 
+func (m queryMetricsStore) GetWorkspacesEligibleForDriftCheck(ctx context.Context, now time.Time) ([]database.GetWorkspacesEligibleForDriftCheckRow, error) {
+	start := time.Now()
+	r0, r1 := m.s.GetWorkspacesEligibleForDriftCheck(ctx, now)
+	m.queryLatencies.WithLabelValues("GetWorkspacesEligibleForDriftCheck").Observe(time.Since(start).Seconds())
+	m.queryCounts.WithLabelValues(httpmw.ExtractHTTPRoute(ctx), httpmw.ExtractHTTPMethod(ctx), "GetWorkspacesEligibleForDriftCheck").Inc()
+	return r0, r1
+}
+
 func (m queryMetricsStore) GetWorkspacesEligibleForLifecycleAction(ctx context.Context, now time.Time) ([]database.GetWorkspacesEligibleForLifecycleActionRow, error) {
 	start := time.Now()
 	r0, r1 := m.s.GetWorkspacesEligibleForLifecycleAction(ctx, now)
@@ -4328,6 +4713,14 @@ func (m queryMetricsStore) InsertOAuth2ProviderAppCode(ctx context.Context, arg
 	return r0, r1
 }
 
+func (m queryMetricsStore) InsertOAuth2ProviderAppDeviceCode(ctx context.Context, arg database.InsertOAuth2ProviderAppDeviceCodeParams) (database.OAuth2ProviderAppDeviceCode, error) {
+	start := time.Now()
+	r0, r1 := m.s.InsertOAuth2ProviderAppDeviceCode(ctx, arg)
+	m.queryLatencies.WithLabelValues("InsertOAuth2ProviderAppDeviceCode").Observe(time.Since(start).Seconds())
+	m.queryCounts.WithLabelValues(httpmw.ExtractHTTPRoute(ctx), httpmw.ExtractHTTPMethod(ctx), "InsertOAuth2ProviderAppDeviceCode").Inc()
+	return r0, r1
+}
+
 func (m queryMetricsStore) InsertOAuth2ProviderAppSecret(ctx context.Context, arg database.InsertOAuth2ProviderAppSecretParams) (database.OAuth2ProviderAppSecret, error) {
 	start := time.Now()
 	r0, r1 := m.s.InsertOAuth2ProviderAppSecret(ctx, arg)
@@ -4416,6 +4809,22 @@ func (m queryMetricsStore) InsertProvisionerKey(ctx context.Context, arg databas
 	return r0, r1
 }
 
+func (m queryMetricsStore) InsertPurgeArchiveImport(ctx context.Context, arg database.InsertPurgeArchiveImportParams) (database.PurgeArchiveImport, error) {
+	start := time.Now()
+	r0, r1 := m.s.InsertPurgeArchiveImport(ctx, arg)
+	m.queryLatencies.WithLabelValues("InsertPurgeArchiveImport").Observe(time.Since(start).Seconds())
+	m.queryCounts.WithLabelValues(httpmw.ExtractHTTPRoute(ctx), httpmw.ExtractHTTPMethod(ctx), "InsertPurgeArchiveImport").Inc()
+	return r0, r1
+}
+
+func (m queryMetricsStore) InsertPurgeArchiveRecords(ctx context.Context, arg database.InsertPurgeArchiveRecordsParams) error {
+	start := time.Now()
+	r0 := m.s.InsertPurgeArchiveRecords(ctx, arg)
+	m.queryLatencies.WithLabelValues("InsertPurgeArchiveRecords").Observe(time.Since(start).Seconds())
+	m.queryCounts.WithLabelValues(httpmw.ExtractHTTPRoute(ctx), httpmw.ExtractHTTPMethod(ctx), "InsertPurgeArchiveRecords").Inc()
+	return r0
+}
+
 func (m queryMetricsStore) InsertReplica(ctx context.Context, arg database.InsertReplicaParams) (database.Replica, error) {
 	start := time.Now()
 	r0, r1 := m.s.InsertReplica(ctx, arg)
@@ -4424,6 +4833,30 @@ func (m queryMetricsStore) InsertReplica(ctx context.Context, arg database.Inser
 	return r0, r1
 }
 
+func (m queryMetricsStore) InsertResourceMonitor(ctx context.Context, arg database.InsertResourceMonitorParams) (database.WorkspaceAgentResourceMonitor, error) {
+	start := time.Now()
+	r0, r1 := m.s.InsertResourceMonitor(ctx, arg)
+	m.queryLatencies.WithLabelValues("InsertResourceMonitor").Observe(time.Since(start).Seconds())
+	m.queryCounts.WithLabelValues(httpmw.ExtractHTTPRoute(ctx), httpmw.ExtractHTTPMethod(ctx), "InsertResourceMonitor").Inc()
+	return r0, r1
+}
+
+func (m queryMetricsStore) InsertScheduleExceptionCalendar(ctx context.Context, arg database.InsertScheduleExceptionCalendarParams) (database.ScheduleExceptionCalendar, error) {
+	start := time.Now()
+	r0, r1 := m.s.InsertScheduleExceptionCalendar(ctx, arg)
+	m.queryLatencies.WithLabelValues("InsertScheduleExceptionCalendar").Observe(time.Since(start).Seconds())
+	m.queryCounts.WithLabelValues(httpmw.ExtractHTTPRoute(ctx), httpmw.ExtractHTTPMethod(ctx), "InsertScheduleExceptionCalendar").Inc()
+	return r0, r1
+}
+
+func (m queryMetricsStore) InsertScheduleExceptionCalendarDates(ctx context.Context, arg database.InsertScheduleExceptionCalendarDatesParams) error {
+	start := time.Now()
+	r0 := m.s.InsertScheduleExceptionCalendarDates(ctx, arg)
+	m.queryLatencies.WithLabelValues("InsertScheduleExceptionCalendarDates").Observe(time.Since(start).Seconds())
+	m.queryCounts.WithLabelValues(httpmw.ExtractHTTPRoute(ctx), httpmw.ExtractHTTPMethod(ctx), "InsertScheduleExceptionCalendarDates").Inc()
+	return r0
+}
+
 func (m queryMetricsStore) InsertTask(ctx context.Context, arg database.InsertTaskParams) (database.TaskTable, error) {
 	start := time.Now()
 	r0, r1 := m.s.InsertTask(ctx, arg)
@@ -4456,6 +4889,14 @@ func (m queryMetricsStore) InsertTemplate(ctx context.Context, arg database.Inse
 	return r0
 }
 
+func (m queryMetricsStore) InsertTemplateScheduleExceptionCalendars(ctx context.Context, arg database.InsertTemplateScheduleExceptionCalendarsParams) error {
+	start := time.Now()
+	r0 := m.s.InsertTemplateScheduleExceptionCalendars(ctx, arg)
+	m.queryLatencies.WithLabelValues("InsertTemplateScheduleExceptionCalendars").Observe(time.Since(start).Seconds())
+	m.queryCounts.WithLabelValues(httpmw.ExtractHTTPRoute(ctx), httpmw.ExtractHTTPMethod(ctx), "InsertTemplateScheduleExceptionCalendars").Inc()
+	return r0
+}
+
 func (m queryMetricsStore) InsertTemplateVersion(ctx context.Context, arg database.InsertTemplateVersionParams) error {
 	start := time.Now()
 	r0 := m.s.InsertTemplateVersion(ctx, arg)
@@ -4472,6 +4913,14 @@ func (m queryMetricsStore) InsertTemplateVersionParameter(ctx context.Context, a
 	return r0, r1
 }
 
+func (m queryMetricsStore) InsertTemplateVersionRollout(ctx context.Context, arg database.InsertTemplateVersionRolloutParams) (database.TemplateVersionRollout, error) {
+	start := time.Now()
+	r0, r1 := m.s.InsertTemplateVersionRollout(ctx, arg)
+	m.queryLatencies.WithLabelValues("InsertTemplateVersionRollout").Observe(time.Since(start).Seconds())
+	m.queryCounts.WithLabelValues(httpmw.ExtractHTTPRoute(ctx), httpmw.ExtractHTTPMethod(ctx), "InsertTemplateVersionRollout").Inc()
+	return r0, r1
+}
+
 func (m queryMetricsStore) InsertTemplateVersionTerraformValuesByJobID(ctx context.Context, arg database.InsertTemplateVersionTerraformValuesByJobIDParams) error {
 	start := time.Now()
 	r0 := m.s.InsertTemplateVersionTerraformValuesByJobID(ctx, arg)
@@ -4528,6 +4977,14 @@ func (m queryMetricsStore) InsertUserLink(ctx context.Context, arg database.Inse
 	return r0, r1
 }
 
+func (m queryMetricsStore) InsertUserOutOfOfficeRange(ctx context.Context, arg database.InsertUserOutOfOfficeRangeParams) (database.UserOutOfOfficeRange, error) {
+	start := time.Now()
+	r0, r1 := m.s.InsertUserOutOfOfficeRange(ctx, arg)
+	m.queryLatencies.WithLabelValues("InsertUserOutOfOfficeRange").Observe(time.Since(start).Seconds())
+	m.queryCounts.WithLabelValues(httpmw.ExtractHTTPRoute(ctx), httpmw.ExtractHTTPMethod(ctx), "InsertUserOutOfOfficeRange").Inc()
+	return r0, r1
+}
+
 func (m queryMetricsStore) InsertUserSkill(ctx context.Context, arg database.InsertUserSkillParams) (database.UserSkill, error) {
 	start := time.Now()
 	r0, r1 := m.s.InsertUserSkill(ctx, arg)
@@ -4584,6 +5041,14 @@ func (m queryMetricsStore) InsertWorkspaceAgentLogSources(ctx context.Context, a
 	return r0, r1
 }
 
+func (m queryMetricsStore) InsertWorkspaceAgentLogTail(ctx context.Context, arg database.InsertWorkspaceAgentLogTailParams) (database.WorkspaceAgentLogTail, error) {
+	start := time.Now()
+	r0, r1 := m.s.InsertWorkspaceAgentLogTail(ctx, arg)
+	m.queryLatencies.WithLabelValues("InsertWorkspaceAgentLogTail").Observe(time.Since(start).Seconds())
+	m.queryCounts.WithLabelValues(httpmw.ExtractHTTPRoute(ctx), httpmw.ExtractHTTPMethod(ctx), "InsertWorkspaceAgentLogTail").Inc()
+	return r0, r1
+}
+
 func (m queryMetricsStore) InsertWorkspaceAgentLogs(ctx context.Context, arg database.InsertWorkspaceAgentLogsParams) ([]database.WorkspaceAgentLog, error) {
 	start := time.Now()
 	r0, r1 := m.s.InsertWorkspaceAgentLogs(ctx, arg)
@@ -4600,6 +5065,22 @@ func (m queryMetricsStore) InsertWorkspaceAgentMetadata(ctx context.Context, arg
 	return r0
 }
 
+func (m queryMetricsStore) InsertWorkspaceAgentPTYShare(ctx context.Context, arg database.InsertWorkspaceAgentPTYShareParams) (database.WorkspaceAgentPTYShare, error) {
+	start := time.Now()
+	r0, r1 := m.s.InsertWorkspaceAgentPTYShare(ctx, arg)
+	m.queryLatencies.WithLabelValues("InsertWorkspaceAgentPTYShare").Observe(time.Since(start).Seconds())
+	m.queryCounts.WithLabelValues(httpmw.ExtractHTTPRoute(ctx), httpmw.ExtractHTTPMethod(ctx), "InsertWorkspaceAgentPTYShare").Inc()
+	return r0, r1
+}
+
+func (m queryMetricsStore) InsertWorkspaceAgentPTYShareViewer(ctx context.Context, arg database.InsertWorkspaceAgentPTYShareViewerParams) (database.WorkspaceAgentPTYShareViewer, error) {
+	start := time.Now()
+	r0, r1 := m.s.InsertWorkspaceAgentPTYShareViewer(ctx, arg)
+	m.queryLatencies.WithLabelValues("InsertWorkspaceAgentPTYShareViewer").Observe(time.Since(start).Seconds())
+	m.queryCounts.WithLabelValues(httpmw.ExtractHTTPRoute(ctx), httpmw.ExtractHTTPMethod(ctx), "InsertWorkspaceAgentPTYShareViewer").Inc()
+	return r0, r1
+}
+
 func (m queryMetricsStore) InsertWorkspaceAgentScriptTimings(ctx context.Context, arg database.InsertWorkspaceAgentScriptTimingsParams) (database.WorkspaceAgentScriptTiming, error) {
 	start := time.Now()
 	r0, r1 := m.s.InsertWorkspaceAgentScriptTimings(ctx, arg)
@@ -4664,6 +5145,30 @@ func (m queryMetricsStore) InsertWorkspaceBuildParameters(ctx context.Context, a
 	return r0
 }
 
+func (m queryMetricsStore) InsertWorkspaceBulkOperation(ctx context.Context, arg database.InsertWorkspaceBulkOperationParams) (database.WorkspaceBulkOperation, error) {
+	start := time.Now()
+	r0, r1 := m.s.InsertWorkspaceBulkOperation(ctx, arg)
+	m.queryLatencies.WithLabelValues("InsertWorkspaceBulkOperation").Observe(time.Since(start).Seconds())
+	m.queryCounts.WithLabelValues(httpmw.ExtractHTTPRoute(ctx), httpmw.ExtractHTTPMethod(ctx), "InsertWorkspaceBulkOperation").Inc()
+	return r0, r1
+}
+
+func (m queryMetricsStore) InsertWorkspaceBulkOperationWorkspaces(ctx context.Context, arg database.InsertWorkspaceBulkOperationWorkspacesParams) error {
+	start := time.Now()
+	r0 := m.s.InsertWorkspaceBulkOperationWorkspaces(ctx, arg)
+	m.queryLatencies.WithLabelValues("InsertWorkspaceBulkOperationWorkspaces").Observe(time.Since(start).Seconds())
+	m.queryCounts.WithLabelValues(httpmw.ExtractHTTPRoute(ctx), httpmw.ExtractHTTPMethod(ctx), "InsertWorkspaceBulkOperationWorkspaces").Inc()
+	return r0
+}
+
+func (m queryMetricsStore) InsertWorkspaceDriftCheck(ctx context.Context, arg database.InsertWorkspaceDriftCheckParams) (database.WorkspaceDriftCheck, error) {
+	start := time.Now()
+	r0, r1 := m.s.InsertWorkspaceDriftCheck(ctx, arg)
+	m.queryLatencies.WithLabelValues("InsertWorkspaceDriftCheck").Observe(time.Since(start).Seconds())
+	m.queryCounts.WithLabelValues(httpmw.ExtractHTTPRoute(ctx), httpmw.ExtractHTTPMethod(ctx), "InsertWorkspaceDriftCheck").Inc()
+	return r0, r1
+}
+
 func (m queryMetricsStore) InsertWorkspaceModule(ctx context.Context, arg database.InsertWorkspaceModuleParams) (database.WorkspaceModule, error) {
 	start := time.Now()
 	r0, r1 := m.s.InsertWorkspaceModule(ctx, arg)
@@ -5056,6 +5561,14 @@ func (m queryMetricsStore) RevokeDBCryptKey(ctx context.Context, activeKeyDigest
 	return r0
 }
 
+func (m queryMetricsStore) RevokeWorkspaceAgentPTYShare(ctx context.Context, arg database.RevokeWorkspaceAgentPTYShareParams) error {
+	start := time.Now()
+	r0 := m.s.RevokeWorkspaceAgentPTYShare(ctx, arg)
+	m.queryLatencies.WithLabelValues("RevokeWorkspaceAgentPTYShare").Observe(time.Since(start).Seconds())
+	m.queryCounts.WithLabelValues(httpmw.ExtractHTTPRoute(ctx), httpmw.ExtractHTTPMethod(ctx), "RevokeWorkspaceAgentPTYShare").Inc()
+	return r0
+}
+
 func (m queryMetricsStore) SelectUsageEventsForPublishing(ctx context.Context, now time.Time) ([]database.UsageEvent, error) {
 	start := time.Now()
 	r0, r1 := m.s.SelectUsageEventsForPublishing(ctx, now)
@@ -5520,6 +6033,22 @@ func (m queryMetricsStore) UpdateOAuth2ProviderAppByID(ctx context.Context, arg
 	return r0, r1
 }
 
+func (m queryMetricsStore) UpdateOAuth2ProviderAppDeviceCodePoll(ctx context.Context, arg database.UpdateOAuth2ProviderAppDeviceCodePollParams) error {
+	start := time.Now()
+	r0 := m.s.UpdateOAuth2ProviderAppDeviceCodePoll(ctx, arg)
+	m.queryLatencies.WithLabelValues("UpdateOAuth2ProviderAppDeviceCodePoll").Observe(time.Since(start).Seconds())
+	m.queryCounts.WithLabelValues(httpmw.ExtractHTTPRoute(ctx), httpmw.ExtractHTTPMethod(ctx), "UpdateOAuth2ProviderAppDeviceCodePoll").Inc()
+	return r0
+}
+
+func (m queryMetricsStore) UpdateOAuth2ProviderAppDeviceCodeStatus(ctx context.Context, arg database.UpdateOAuth2ProviderAppDeviceCodeStatusParams) (database.OAuth2ProviderAppDeviceCode, error) {
+	start := time.Now()
+	r0, r1 := m.s.UpdateOAuth2ProviderAppDeviceCodeStatus(ctx, arg)
+	m.queryLatencies.WithLabelValues("UpdateOAuth2ProviderAppDeviceCodeStatus").Observe(time.Since(start).Seconds())
+	m.queryCounts.WithLabelValues(httpmw.ExtractHTTPRoute(ctx), httpmw.ExtractHTTPMethod(ctx), "UpdateOAuth2ProviderAppDeviceCodeStatus").Inc()
+	return r0, r1
+}
+
 func (m queryMetricsStore) UpdateOrganization(ctx context.Context, arg database.UpdateOrganizationParams) (database.Organization, error) {
 	start := time.Now()
 	r0, r1 := m.s.UpdateOrganization(ctx, arg)
@@ -5632,6 +6161,22 @@ func (m queryMetricsStore) UpdateReplica(ctx context.Context, arg database.Updat
 	return r0, r1
 }
 
+func (m queryMetricsStore) UpdateResourceMonitor(ctx context.Context, arg database.UpdateResourceMonitorParams) error {
+	start := time.Now()
+	r0 := m.s.UpdateResourceMonitor(ctx, arg)
+	m.queryLatencies.WithLabelValues("UpdateResourceMonitor").Observe(time.Since(start).Seconds())
+	m.queryCounts.WithLabelValues(httpmw.ExtractHTTPRoute(ctx), httpmw.ExtractHTTPMethod(ctx), "UpdateResourceMonitor").Inc()
+	return r0
+}
+
+func (m queryMetricsStore) UpdateScheduleExceptionCalendarByID(ctx context.Context, arg database.UpdateScheduleExceptionCalendarByIDParams) (database.ScheduleExceptionCalendar, error) {
+	start := time.Now()
+	r0, r1 := m.s.UpdateScheduleExceptionCalendarByID(ctx, arg)
+	m.queryLatencies.WithLabelValues("UpdateScheduleExceptionCalendarByID").Observe(time.Since(start).Seconds())
+	m.queryCounts.WithLabelValues(httpmw.ExtractHTTPRoute(ctx), httpmw.ExtractHTTPMethod(ctx), "UpdateScheduleExceptionCalendarByID").Inc()
+	return r0, r1
+}
+
 func (m queryMetricsStore) UpdateTailnetPeerStatusByCoordinator(ctx context.Context, arg database.UpdateTailnetPeerStatusByCoordinatorParams) ([]uuid.UUID, error) {
 	start := time.Now()
 	r0, r1 := m.s.UpdateTailnetPeerStatusByCoordinator(ctx, arg)
@@ -5736,6 +6281,22 @@ func (m queryMetricsStore) UpdateTemplateVersionFlagsByJobID(ctx context.Context
 	return r0
 }
 
+func (m queryMetricsStore) UpdateTemplateVersionRolloutByID(ctx context.Context, arg database.UpdateTemplateVersionRolloutByIDParams) (database.TemplateVersionRollout, error) {
+	start := time.Now()
+	r0, r1 := m.s.UpdateTemplateVersionRolloutByID(ctx, arg)
+	m.queryLatencies.WithLabelValues("UpdateTemplateVersionRolloutByID").Observe(time.Since(start).Seconds())
+	m.queryCounts.WithLabelValues(httpmw.ExtractHTTPRoute(ctx), httpmw.ExtractHTTPMethod(ctx), "UpdateTemplateVersionRolloutByID").Inc()
+	return r0, r1
+}
+
+func (m queryMetricsStore) UpdateTemplateVersionRolloutStatusByID(ctx context.Context, arg database.UpdateTemplateVersionRolloutStatusByIDParams) (database.TemplateVersionRollout, error) {
+	start := time.Now()
+	r0, r1 := m.s.UpdateTemplateVersionRolloutStatusByID(ctx, arg)
+	m.queryLatencies.WithLabelValues("UpdateTemplateVersionRolloutStatusByID").Observe(time.Since(start).Seconds())
+	m.queryCounts.WithLabelValues(httpmw.ExtractHTTPRoute(ctx), httpmw.ExtractHTTPMethod(ctx), "UpdateTemplateVersionRolloutStatusByID").Inc()
+	return r0, r1
+}
+
 func (m queryMetricsStore) UpdateTemplateWorkspacesLastUsedAt(ctx context.Context, arg database.UpdateTemplateWorkspacesLastUsedAtParams) error {
 	start := time.Now()
 	r0 := m.s.UpdateTemplateWorkspacesLastUsedAt(ctx, arg)
@@ -6048,6 +6609,14 @@ func (m queryMetricsStore) UpdateWorkspaceAgentMetadata(ctx context.Context, arg
 	return r0
 }
 
+func (m queryMetricsStore) UpdateWorkspaceAgentPTYShareViewerDisconnectedAt(ctx context.Context, arg database.UpdateWorkspaceAgentPTYShareViewerDisconnectedAtParams) error {
+	start := time.Now()
+	r0 := m.s.UpdateWorkspaceAgentPTYShareViewerDisconnectedAt(ctx, arg)
+	m.queryLatencies.WithLabelValues("UpdateWorkspaceAgentPTYShareViewerDisconnectedAt").Observe(time.Since(start).Seconds())
+	m.queryCounts.WithLabelValues(httpmw.ExtractHTTPRoute(ctx), httpmw.ExtractHTTPMethod(ctx), "UpdateWorkspaceAgentPTYShareViewerDisconnectedAt").Inc()
+	return r0
+}
+
 func (m queryMetricsStore) UpdateWorkspaceAgentStartupByID(ctx context.Context, arg database.UpdateWorkspaceAgentStartupByIDParams) error {
 	start := time.Now()
 	r0 := m.s.UpdateWorkspaceAgentStartupByID(ctx, arg)
@@ -6152,6 +6721,30 @@ func (m queryMetricsStore) UpdateWorkspaceBuildProvisionerStateByID(ctx context.
 	return r0
 }
 
+func (m queryMetricsStore) UpdateWorkspaceBulkOperationCanceled(ctx context.Context, arg database.UpdateWorkspaceBulkOperationCanceledParams) (database.WorkspaceBulkOperation, error) {
+	start := time.Now()
+	r0, r1 := m.s.UpdateWorkspaceBulkOperationCanceled(ctx, arg)
+	m.queryLatencies.WithLabelValues("UpdateWorkspaceBulkOperationCanceled").Observe(time.Since(start).Seconds())
+	m.queryCounts.WithLabelValues(httpmw.ExtractHTTPRoute(ctx), httpmw.ExtractHTTPMethod(ctx), "UpdateWorkspaceBulkOperationCanceled").Inc()
+	return r0, r1
+}
+
+func (m queryMetricsStore) UpdateWorkspaceBulkOperationCompleted(ctx context.Context, arg database.UpdateWorkspaceBulkOperationCompletedParams) error {
+	start := time.Now()
+	r0 := m.s.UpdateWorkspaceBulkOperationCompleted(ctx, arg)
+	m.queryLatencies.WithLabelValues("UpdateWorkspaceBulkOperationCompleted").Observe(time.Since(start).Seconds())
+	m.queryCounts.WithLabelValues(httpmw.ExtractHTTPRoute(ctx), httpmw.ExtractHTTPMethod(ctx), "UpdateWorkspaceBulkOperationCompleted").Inc()
+	return r0
+}
+
+func (m queryMetricsStore) UpdateWorkspaceBulkOperationWorkspace(ctx context.Context, arg database.UpdateWorkspaceBulkOperationWorkspaceParams) error {
+	start := time.Now()
+	r0 := m.s.UpdateWorkspaceBulkOperationWorkspace(ctx, arg)
+	m.queryLatencies.WithLabelValues("UpdateWorkspaceBulkOperationWorkspace").Observe(time.Since(start).Seconds())
+	m.queryCounts.WithLabelValues(httpmw.ExtractHTTPRoute(ctx), httpmw.ExtractHTTPMethod(ctx), "UpdateWorkspaceBulkOperationWorkspace").Inc()
+	return r0
+}
+
 func (m queryMetricsStore) UpdateWorkspaceDeletedByID(ctx context.Context, arg database.UpdateWorkspaceDeletedByIDParams) error {
 	start := time.Now()
 	r0 := m.s.UpdateWorkspaceDeletedByID(ctx, arg)
@@ -6168,6 +6761,14 @@ func (m queryMetricsStore) UpdateWorkspaceDormantDeletingAt(ctx context.Context,
 	return r0, r1
 }
 
+func (m queryMetricsStore) UpdateWorkspaceDriftCheckByID(ctx context.Context, arg database.UpdateWorkspaceDriftCheckByIDParams) error {
+	start := time.Now()
+	r0 := m.s.UpdateWorkspaceDriftCheckByID(ctx, arg)
+	m.queryLatencies.WithLabelValues("UpdateWorkspaceDriftCheckByID").Observe(time.Since(start).Seconds())
+	m.queryCounts.WithLabelValues(httpmw.ExtractHTTPRoute(ctx), httpmw.ExtractHTTPMethod(ctx), "UpdateWorkspaceDriftCheckByID").Inc()
+	return r0
+}
+
 func (m queryMetricsStore) UpdateWorkspaceLastUsedAt(ctx context.Context, arg database.UpdateWorkspaceLastUsedAtParams) error {
 	start := time.Now()
 	r0 := m.s.UpdateWorkspaceLastUsedAt(ctx, arg)
@@ -6512,6 +7113,14 @@ func (m queryMetricsStore) UpsertProvisionerDaemon(ctx context.Context, arg data
 	return r0, r1
 }
 
+func (m queryMetricsStore) UpsertProvisionerJobPriority(ctx context.Context, arg database.UpsertProvisionerJobPriorityParams) (database.ProvisionerJobPriority, error) {
+	start := time.Now()
+	r0, r1 := m.s.UpsertProvisionerJobPriority(ctx, arg)
+	m.queryLatencies.WithLabelValues("UpsertProvisionerJobPriority").Observe(time.Since(start).Seconds())
+	m.queryCounts.WithLabelValues(httpmw.ExtractHTTPRoute(ctx), httpmw.ExtractHTTPMethod(ctx), "UpsertProvisionerJobPriority").Inc()
+	return r0, r1
+}
+
 func (m queryMetricsStore) UpsertRuntimeConfig(ctx context.Context, arg database.UpsertRuntimeConfigParams) error {
 	start := time.Now()
 	r0 := m.s.UpsertRuntimeConfig(ctx, arg)
@@ -11,6 +11,7 @@ package dbmock
 
 import (
 	context "context"
+	json "encoding/json"
 	reflect "reflect"
 	time "time"
 
@@ -118,6 +119,21 @@ func (mr *MockStoreMockRecorder) AcquireStaleChatDiffStatuses(ctx, limitVal any)
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AcquireStaleChatDiffStatuses", reflect.TypeOf((*MockStore)(nil).AcquireStaleChatDiffStatuses), ctx, limitVal)
 }
 
+// AcquireWorkspaceBulkOperationWorkspaces mocks base method.
+func (m *MockStore) AcquireWorkspaceBulkOperationWorkspaces(ctx context.Context, arg database.AcquireWorkspaceBulkOperationWorkspacesParams) ([]database.WorkspaceBulkOperationWorkspace, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AcquireWorkspaceBulkOperationWorkspaces", ctx, arg)
+	ret0, _ := ret[0].([]database.WorkspaceBulkOperationWorkspace)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// AcquireWorkspaceBulkOperationWorkspaces indicates an expected call of AcquireWorkspaceBulkOperationWorkspaces.
+func (mr *MockStoreMockRecorder) AcquireWorkspaceBulkOperationWorkspaces(ctx, arg any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AcquireWorkspaceBulkOperationWorkspaces", reflect.TypeOf((*MockStore)(nil).AcquireWorkspaceBulkOperationWorkspaces), ctx, arg)
+}
+
 // ActivityBumpWorkspace mocks base method.
 func (m *MockStore) ActivityBumpWorkspace(ctx context.Context, arg database.ActivityBumpWorkspaceParams) error {
 	m.ctrl.T.Helper()
@@ -647,6 +663,21 @@ func (mr *MockStoreMockRecorder) CustomRoles(ctx, arg any) *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CustomRoles", reflect.TypeOf((*MockStore)(nil).CustomRoles), ctx, arg)
 }
 
+// DeleteAIBridgeRecordsByInterceptionIDs mocks base method.
+func (m *MockStore) DeleteAIBridgeRecordsByInterceptionIDs(ctx context.Context, ids []uuid.UUID) (int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteAIBridgeRecordsByInterceptionIDs", ctx, ids)
+	ret0, _ := ret[0].(int64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DeleteAIBridgeRecordsByInterceptionIDs indicates an expected call of DeleteAIBridgeRecordsByInterceptionIDs.
+func (mr *MockStoreMockRecorder) DeleteAIBridgeRecordsByInterceptionIDs(ctx, ids any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteAIBridgeRecordsByInterceptionIDs", reflect.TypeOf((*MockStore)(nil).DeleteAIBridgeRecordsByInterceptionIDs), ctx, ids)
+}
+
 // DeleteAIGatewayKey mocks base method.
 func (m *MockStore) DeleteAIGatewayKey(ctx context.Context, id uuid.UUID) (database.DeleteAIGatewayKeyRow, error) {
 	m.ctrl.T.Helper()
@@ -804,6 +835,21 @@ func (mr *MockStoreMockRecorder) DeleteApplicationConnectAPIKeysByUserID(ctx, us
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteApplicationConnectAPIKeysByUserID", reflect.TypeOf((*MockStore)(nil).DeleteApplicationConnectAPIKeysByUserID), ctx, userID)
 }
 
+// DeleteAuditLogsByIDs mocks base method.
+func (m *MockStore) DeleteAuditLogsByIDs(ctx context.Context, ids []uuid.UUID) (int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteAuditLogsByIDs", ctx, ids)
+	ret0, _ := ret[0].(int64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DeleteAuditLogsByIDs indicates an expected call of DeleteAuditLogsByIDs.
+func (mr *MockStoreMockRecorder) DeleteAuditLogsByIDs(ctx, ids any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteAuditLogsByIDs", reflect.TypeOf((*MockStore)(nil).DeleteAuditLogsByIDs), ctx, ids)
+}
+
 // DeleteChatContextResourcesByChatID mocks base method.
 func (m *MockStore) DeleteChatContextResourcesByChatID(ctx context.Context, chatID uuid.UUID) error {
 	m.ctrl.T.Helper()
@@ -905,6 +951,21 @@ func (mr *MockStoreMockRecorder) DeleteChatQueuedMessageReturningCount(ctx, arg
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteChatQueuedMessageReturningCount", reflect.TypeOf((*MockStore)(nil).DeleteChatQueuedMessageReturningCount), ctx, arg)
 }
 
+// DeleteConnectionLogsByIDs mocks base method.
+func (m *MockStore) DeleteConnectionLogsByIDs(ctx context.Context, ids []uuid.UUID) (int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteConnectionLogsByIDs", ctx, ids)
+	ret0, _ := ret[0].(int64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DeleteConnectionLogsByIDs indicates an expected call of DeleteConnectionLogsByIDs.
+func (mr *MockStoreMockRecorder) DeleteConnectionLogsByIDs(ctx, ids any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteConnectionLogsByIDs", reflect.TypeOf((*MockStore)(nil).DeleteConnectionLogsByIDs), ctx, ids)
+}
+
 // DeleteCryptoKey mocks base method.
 func (m *MockStore) DeleteCryptoKey(ctx context.Context, arg database.DeleteCryptoKeyParams) (database.CryptoKey, error) {
 	m.ctrl.T.Helper()
@@ -949,6 +1010,20 @@ func (mr *MockStoreMockRecorder) DeleteExpiredAPIKeys(ctx, arg any) *gomock.Call
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteExpiredAPIKeys", reflect.TypeOf((*MockStore)(nil).DeleteExpiredAPIKeys), ctx, arg)
 }
 
+// DeleteExpiredOAuth2ProviderAppDeviceCodes mocks base method.
+func (m *MockStore) DeleteExpiredOAuth2ProviderAppDeviceCodes(ctx context.Context, beforeTime time.Time) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteExpiredOAuth2ProviderAppDeviceCodes", ctx, beforeTime)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteExpiredOAuth2ProviderAppDeviceCodes indicates an expected call of DeleteExpiredOAuth2ProviderAppDeviceCodes.
+func (mr *MockStoreMockRecorder) DeleteExpiredOAuth2ProviderAppDeviceCodes(ctx, beforeTime any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteExpiredOAuth2ProviderAppDeviceCodes", reflect.TypeOf((*MockStore)(nil).DeleteExpiredOAuth2ProviderAppDeviceCodes), ctx, beforeTime)
+}
+
 // DeleteExternalAuthLink mocks base method.
 func (m *MockStore) DeleteExternalAuthLink(ctx context.Context, arg database.DeleteExternalAuthLinkParams) error {
 	m.ctrl.T.Helper()
@@ -1119,6 +1194,20 @@ func (mr *MockStoreMockRecorder) DeleteOAuth2ProviderAppCodesByAppAndUserID(ctx,
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteOAuth2ProviderAppCodesByAppAndUserID", reflect.TypeOf((*MockStore)(nil).DeleteOAuth2ProviderAppCodesByAppAndUserID), ctx, arg)
 }
 
+// DeleteOAuth2ProviderAppDeviceCodeByID mocks base method.
+func (m *MockStore) DeleteOAuth2ProviderAppDeviceCodeByID(ctx context.Context, id uuid.UUID) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteOAuth2ProviderAppDeviceCodeByID", ctx, id)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteOAuth2ProviderAppDeviceCodeByID indicates an expected call of DeleteOAuth2ProviderAppDeviceCodeByID.
+func (mr *MockStoreMockRecorder) DeleteOAuth2ProviderAppDeviceCodeByID(ctx, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteOAuth2ProviderAppDeviceCodeByID", reflect.TypeOf((*MockStore)(nil).DeleteOAuth2ProviderAppDeviceCodeByID), ctx, id)
+}
+
 // DeleteOAuth2ProviderAppSecretByID mocks base method.
 func (m *MockStore) DeleteOAuth2ProviderAppSecretByID(ctx context.Context, id uuid.UUID) error {
 	m.ctrl.T.Helper()
@@ -1381,6 +1470,20 @@ func (mr *MockStoreMockRecorder) DeleteOrganizationMember(ctx, arg any) *gomock.
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteOrganizationMember", reflect.TypeOf((*MockStore)(nil).DeleteOrganizationMember), ctx, arg)
 }
 
+// DeleteProvisionerJobPriority mocks base method.
+func (m *MockStore) DeleteProvisionerJobPriority(ctx context.Context, arg database.DeleteProvisionerJobPriorityParams) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteProvisionerJobPriority", ctx, arg)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteProvisionerJobPriority indicates an expected call of DeleteProvisionerJobPriority.
+func (mr *MockStoreMockRecorder) DeleteProvisionerJobPriority(ctx, arg any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteProvisionerJobPriority", reflect.TypeOf((*MockStore)(nil).DeleteProvisionerJobPriority), ctx, arg)
+}
+
 // DeleteProvisionerKey mocks base method.
 func (m *MockStore) DeleteProvisionerKey(ctx context.Context, id uuid.UUID) error {
 	m.ctrl.T.Helper()
@@ -1423,6 +1526,34 @@ func (mr *MockStoreMockRecorder) DeleteRuntimeConfig(ctx, key any) *gomock.Call
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteRuntimeConfig", reflect.TypeOf((*MockStore)(nil).DeleteRuntimeConfig), ctx, key)
 }
 
+// DeleteScheduleExceptionCalendarByID mocks base method.
+func (m *MockStore) DeleteScheduleExceptionCalendarByID(ctx context.Context, id uuid.UUID) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteScheduleExceptionCalendarByID", ctx, id)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteScheduleExceptionCalendarByID indicates an expected call of DeleteScheduleExceptionCalendarByID.
+func (mr *MockStoreMockRecorder) DeleteScheduleExceptionCalendarByID(ctx, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteScheduleExceptionCalendarByID", reflect.TypeOf((*MockStore)(nil).DeleteScheduleExceptionCalendarByID), ctx, id)
+}
+
+// DeleteScheduleExceptionCalendarDates mocks base method.
+func (m *MockStore) DeleteScheduleExceptionCalendarDates(ctx context.Context, calendarID uuid.UUID) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteScheduleExceptionCalendarDates", ctx, calendarID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteScheduleExceptionCalendarDates indicates an expected call of DeleteScheduleExceptionCalendarDates.
+func (mr *MockStoreMockRecorder) DeleteScheduleExceptionCalendarDates(ctx, calendarID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteScheduleExceptionCalendarDates", reflect.TypeOf((*MockStore)(nil).DeleteScheduleExceptionCalendarDates), ctx, calendarID)
+}
+
 // DeleteStaleChatHeartbeats mocks base method.
 func (m *MockStore) DeleteStaleChatHeartbeats(ctx context.Context, staleSeconds int32) (int64, error) {
 	m.ctrl.T.Helper()
@@ -1497,6 +1628,20 @@ func (mr *MockStoreMockRecorder) DeleteTask(ctx, arg any) *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteTask", reflect.TypeOf((*MockStore)(nil).DeleteTask), ctx, arg)
 }
 
+// DeleteTemplateScheduleExceptionCalendars mocks base method.
+func (m *MockStore) DeleteTemplateScheduleExceptionCalendars(ctx context.Context, templateID uuid.UUID) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteTemplateScheduleExceptionCalendars", ctx, templateID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteTemplateScheduleExceptionCalendars indicates an expected call of DeleteTemplateScheduleExceptionCalendars.
+func (mr *MockStoreMockRecorder) DeleteTemplateScheduleExceptionCalendars(ctx, templateID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteTemplateScheduleExceptionCalendars", reflect.TypeOf((*MockStore)(nil).DeleteTemplateScheduleExceptionCalendars), ctx, templateID)
+}
+
 // DeleteUnlinkedChatFilesByIDs mocks base method.
 func (m *MockStore) DeleteUnlinkedChatFilesByIDs(ctx context.Context, arg database.DeleteUnlinkedChatFilesByIDsParams) (int64, error) {
 	m.ctrl.T.Helper()
@@ -1569,6 +1714,20 @@ func (mr *MockStoreMockRecorder) DeleteUserChatCompactionThreshold(ctx, arg any)
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteUserChatCompactionThreshold", reflect.TypeOf((*MockStore)(nil).DeleteUserChatCompactionThreshold), ctx, arg)
 }
 
+// DeleteUserOutOfOfficeRange mocks base method.
+func (m *MockStore) DeleteUserOutOfOfficeRange(ctx context.Context, arg database.DeleteUserOutOfOfficeRangeParams) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteUserOutOfOfficeRange", ctx, arg)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteUserOutOfOfficeRange indicates an expected call of DeleteUserOutOfOfficeRange.
+func (mr *MockStoreMockRecorder) DeleteUserOutOfOfficeRange(ctx, arg any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteUserOutOfOfficeRange", reflect.TypeOf((*MockStore)(nil).DeleteUserOutOfOfficeRange), ctx, arg)
+}
+
 // DeleteUserSecretByUserIDAndName mocks base method.
 func (m *MockStore) DeleteUserSecretByUserIDAndName(ctx context.Context, arg database.DeleteUserSecretByUserIDAndNameParams) (database.UserSecret, error) {
 	m.ctrl.T.Helper()
@@ -1655,6 +1814,21 @@ func (mr *MockStoreMockRecorder) DeleteWorkspaceACLsByOrganization(ctx, arg any)
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteWorkspaceACLsByOrganization", reflect.TypeOf((*MockStore)(nil).DeleteWorkspaceACLsByOrganization), ctx, arg)
 }
 
+// DeleteWorkspaceAgentLogsByIDs mocks base method.
+func (m *MockStore) DeleteWorkspaceAgentLogsByIDs(ctx context.Context, ids []int64) (int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteWorkspaceAgentLogsByIDs", ctx, ids)
+	ret0, _ := ret[0].(int64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DeleteWorkspaceAgentLogsByIDs indicates an expected call of DeleteWorkspaceAgentLogsByIDs.
+func (mr *MockStoreMockRecorder) DeleteWorkspaceAgentLogsByIDs(ctx, ids any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteWorkspaceAgentLogsByIDs", reflect.TypeOf((*MockStore)(nil).DeleteWorkspaceAgentLogsByIDs), ctx, ids)
+}
+
 // DeleteWorkspaceAgentPortShare mocks base method.
 func (m *MockStore) DeleteWorkspaceAgentPortShare(ctx context.Context, arg database.DeleteWorkspaceAgentPortShareParams) error {
 	m.ctrl.T.Helper()
@@ -1813,6 +1987,21 @@ func (mr *MockStoreMockRecorder) FetchNewMessageMetadata(ctx, arg any) *gomock.C
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FetchNewMessageMetadata", reflect.TypeOf((*MockStore)(nil).FetchNewMessageMetadata), ctx, arg)
 }
 
+// FetchResourceMonitorsByAgentID mocks base method.
+func (m *MockStore) FetchResourceMonitorsByAgentID(ctx context.Context, agentID uuid.UUID) ([]database.WorkspaceAgentResourceMonitor, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FetchResourceMonitorsByAgentID", ctx, agentID)
+	ret0, _ := ret[0].([]database.WorkspaceAgentResourceMonitor)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FetchResourceMonitorsByAgentID indicates an expected call of FetchResourceMonitorsByAgentID.
+func (mr *MockStoreMockRecorder) FetchResourceMonitorsByAgentID(ctx, agentID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FetchResourceMonitorsByAgentID", reflect.TypeOf((*MockStore)(nil).FetchResourceMonitorsByAgentID), ctx, agentID)
+}
+
 // FetchVolumesResourceMonitorsByAgentID mocks base method.
 func (m *MockStore) FetchVolumesResourceMonitorsByAgentID(ctx context.Context, agentID uuid.UUID) ([]database.WorkspaceAgentVolumeResourceMonitor, error) {
 	m.ctrl.T.Helper()
@@ -2293,6 +2482,21 @@ func (mr *MockStoreMockRecorder) GetActivePresetPrebuildSchedules(ctx any) *gomo
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetActivePresetPrebuildSchedules", reflect.TypeOf((*MockStore)(nil).GetActivePresetPrebuildSchedules), ctx)
 }
 
+// GetActiveTemplateVersionRolloutByTemplateID mocks base method.
+func (m *MockStore) GetActiveTemplateVersionRolloutByTemplateID(ctx context.Context, templateID uuid.UUID) (database.TemplateVersionRollout, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetActiveTemplateVersionRolloutByTemplateID", ctx, templateID)
+	ret0, _ := ret[0].(database.TemplateVersionRollout)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetActiveTemplateVersionRolloutByTemplateID indicates an expected call of GetActiveTemplateVersionRolloutByTemplateID.
+func (mr *MockStoreMockRecorder) GetActiveTemplateVersionRolloutByTemplateID(ctx, templateID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetActiveTemplateVersionRolloutByTemplateID", reflect.TypeOf((*MockStore)(nil).GetActiveTemplateVersionRolloutByTemplateID), ctx, templateID)
+}
+
 // GetActiveUserCount mocks base method.
 func (m *MockStore) GetActiveUserCount(ctx context.Context, includeSystem bool) (int64, error) {
 	m.ctrl.T.Helper()
@@ -4153,6 +4357,21 @@ func (mr *MockStoreMockRecorder) GetHighestGroupAIBudgetByUser(ctx, userID any)
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetHighestGroupAIBudgetByUser", reflect.TypeOf((*MockStore)(nil).GetHighestGroupAIBudgetByUser), ctx, userID)
 }
 
+// GetInProgressTemplateVersionRollouts mocks base method.
+func (m *MockStore) GetInProgressTemplateVersionRollouts(ctx context.Context) ([]database.TemplateVersionRollout, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetInProgressTemplateVersionRollouts", ctx)
+	ret0, _ := ret[0].([]database.TemplateVersionRollout)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetInProgressTemplateVersionRollouts indicates an expected call of GetInProgressTemplateVersionRollouts.
+func (mr *MockStoreMockRecorder) GetInProgressTemplateVersionRollouts(ctx any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetInProgressTemplateVersionRollouts", reflect.TypeOf((*MockStore)(nil).GetInProgressTemplateVersionRollouts), ctx)
+}
+
 // GetInboxNotificationByID mocks base method.
 func (m *MockStore) GetInboxNotificationByID(ctx context.Context, id uuid.UUID) (database.InboxNotification, error) {
 	m.ctrl.T.Helper()
@@ -4318,6 +4537,21 @@ func (mr *MockStoreMockRecorder) GetLatestWorkspaceBuildsByWorkspaceIDs(ctx, ids
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetLatestWorkspaceBuildsByWorkspaceIDs", reflect.TypeOf((*MockStore)(nil).GetLatestWorkspaceBuildsByWorkspaceIDs), ctx, ids)
 }
 
+// GetLatestWorkspaceDriftChecksByWorkspaceIDs mocks base method.
+func (m *MockStore) GetLatestWorkspaceDriftChecksByWorkspaceIDs(ctx context.Context, ids []uuid.UUID) ([]database.WorkspaceDriftCheck, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetLatestWorkspaceDriftChecksByWorkspaceIDs", ctx, ids)
+	ret0, _ := ret[0].([]database.WorkspaceDriftCheck)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetLatestWorkspaceDriftChecksByWorkspaceIDs indicates an expected call of GetLatestWorkspaceDriftChecksByWorkspaceIDs.
+func (mr *MockStoreMockRecorder) GetLatestWorkspaceDriftChecksByWorkspaceIDs(ctx, ids any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetLatestWorkspaceDriftChecksByWorkspaceIDs", reflect.TypeOf((*MockStore)(nil).GetLatestWorkspaceDriftChecksByWorkspaceIDs), ctx, ids)
+}
+
 // GetLicenseByID mocks base method.
 func (m *MockStore) GetLicenseByID(ctx context.Context, id int32) (database.License, error) {
 	m.ctrl.T.Helper()
@@ -4648,6 +4882,51 @@ func (mr *MockStoreMockRecorder) GetOAuth2ProviderAppCodeByPrefix(ctx, secretPre
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetOAuth2ProviderAppCodeByPrefix", reflect.TypeOf((*MockStore)(nil).GetOAuth2ProviderAppCodeByPrefix), ctx, secretPrefix)
 }
 
+// GetOAuth2ProviderAppDeviceCodeByID mocks base method.
+func (m *MockStore) GetOAuth2ProviderAppDeviceCodeByID(ctx context.Context, id uuid.UUID) (database.OAuth2ProviderAppDeviceCode, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetOAuth2ProviderAppDeviceCodeByID", ctx, id)
+	ret0, _ := ret[0].(database.OAuth2ProviderAppDeviceCode)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetOAuth2ProviderAppDeviceCodeByID indicates an expected call of GetOAuth2ProviderAppDeviceCodeByID.
+func (mr *MockStoreMockRecorder) GetOAuth2ProviderAppDeviceCodeByID(ctx, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetOAuth2ProviderAppDeviceCodeByID", reflect.TypeOf((*MockStore)(nil).GetOAuth2ProviderAppDeviceCodeByID), ctx, id)
+}
+
+// GetOAuth2ProviderAppDeviceCodeByPrefix mocks base method.
+func (m *MockStore) GetOAuth2ProviderAppDeviceCodeByPrefix(ctx context.Context, deviceCodePrefix []byte) (database.OAuth2ProviderAppDeviceCode, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetOAuth2ProviderAppDeviceCodeByPrefix", ctx, deviceCodePrefix)
+	ret0, _ := ret[0].(database.OAuth2ProviderAppDeviceCode)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetOAuth2ProviderAppDeviceCodeByPrefix indicates an expected call of GetOAuth2ProviderAppDeviceCodeByPrefix.
+func (mr *MockStoreMockRecorder) GetOAuth2ProviderAppDeviceCodeByPrefix(ctx, deviceCodePrefix any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetOAuth2ProviderAppDeviceCodeByPrefix", reflect.TypeOf((*MockStore)(nil).GetOAuth2ProviderAppDeviceCodeByPrefix), ctx, deviceCodePrefix)
+}
+
+// GetOAuth2ProviderAppDeviceCodeByUserCode mocks base method.
+func (m *MockStore) GetOAuth2ProviderAppDeviceCodeByUserCode(ctx context.Context, userCode string) (database.OAuth2ProviderAppDeviceCode, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetOAuth2ProviderAppDeviceCodeByUserCode", ctx, userCode)
+	ret0, _ := ret[0].(database.OAuth2ProviderAppDeviceCode)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetOAuth2ProviderAppDeviceCodeByUserCode indicates an expected call of GetOAuth2ProviderAppDeviceCodeByUserCode.
+func (mr *MockStoreMockRecorder) GetOAuth2ProviderAppDeviceCodeByUserCode(ctx, userCode any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetOAuth2ProviderAppDeviceCodeByUserCode", reflect.TypeOf((*MockStore)(nil).GetOAuth2ProviderAppDeviceCodeByUserCode), ctx, userCode)
+}
+
 // GetOAuth2ProviderAppSecretByID mocks base method.
 func (m *MockStore) GetOAuth2ProviderAppSecretByID(ctx context.Context, id uuid.UUID) (database.OAuth2ProviderAppSecret, error) {
 	m.ctrl.T.Helper()
@@ -4753,6 +5032,66 @@ func (mr *MockStoreMockRecorder) GetOAuth2ProviderAppsByUserID(ctx, userID any)
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetOAuth2ProviderAppsByUserID", reflect.TypeOf((*MockStore)(nil).GetOAuth2ProviderAppsByUserID), ctx, userID)
 }
 
+// GetOldAIBridgeInterceptionsForArchive mocks base method.
+func (m *MockStore) GetOldAIBridgeInterceptionsForArchive(ctx context.Context, arg database.GetOldAIBridgeInterceptionsForArchiveParams) ([]database.GetOldAIBridgeInterceptionsForArchiveRow, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetOldAIBridgeInterceptionsForArchive", ctx, arg)
+	ret0, _ := ret[0].([]database.GetOldAIBridgeInterceptionsForArchiveRow)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetOldAIBridgeInterceptionsForArchive indicates an expected call of GetOldAIBridgeInterceptionsForArchive.
+func (mr *MockStoreMockRecorder) GetOldAIBridgeInterceptionsForArchive(ctx, arg any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetOldAIBridgeInterceptionsForArchive", reflect.TypeOf((*MockStore)(nil).GetOldAIBridgeInterceptionsForArchive), ctx, arg)
+}
+
+// GetOldAuditLogConnectionEventsForArchive mocks base method.
+func (m *MockStore) GetOldAuditLogConnectionEventsForArchive(ctx context.Context, arg database.GetOldAuditLogConnectionEventsForArchiveParams) ([]json.RawMessage, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetOldAuditLogConnectionEventsForArchive", ctx, arg)
+	ret0, _ := ret[0].([]json.RawMessage)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetOldAuditLogConnectionEventsForArchive indicates an expected call of GetOldAuditLogConnectionEventsForArchive.
+func (mr *MockStoreMockRecorder) GetOldAuditLogConnectionEventsForArchive(ctx, arg any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetOldAuditLogConnectionEventsForArchive", reflect.TypeOf((*MockStore)(nil).GetOldAuditLogConnectionEventsForArchive), ctx, arg)
+}
+
+// GetOldAuditLogsForArchive mocks base method.
+func (m *MockStore) GetOldAuditLogsForArchive(ctx context.Context, arg database.GetOldAuditLogsForArchiveParams) ([]json.RawMessage, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetOldAuditLogsForArchive", ctx, arg)
+	ret0, _ := ret[0].([]json.RawMessage)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetOldAuditLogsForArchive indicates an expected call of GetOldAuditLogsForArchive.
+func (mr *MockStoreMockRecorder) GetOldAuditLogsForArchive(ctx, arg any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetOldAuditLogsForArchive", reflect.TypeOf((*MockStore)(nil).GetOldAuditLogsForArchive), ctx, arg)
+}
+
+// GetOldConnectionLogsForArchive mocks base method.
+func (m *MockStore) GetOldConnectionLogsForArchive(ctx context.Context, arg database.GetOldConnectionLogsForArchiveParams) ([]json.RawMessage, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetOldConnectionLogsForArchive", ctx, arg)
+	ret0, _ := ret[0].([]json.RawMessage)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetOldConnectionLogsForArchive indicates an expected call of GetOldConnectionLogsForArchive.
+func (mr *MockStoreMockRecorder) GetOldConnectionLogsForArchive(ctx, arg any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetOldConnectionLogsForArchive", reflect.TypeOf((*MockStore)(nil).GetOldConnectionLogsForArchive), ctx, arg)
+}
+
 // GetOldUnlinkedChatFileIDs mocks base method.
 func (m *MockStore) GetOldUnlinkedChatFileIDs(ctx context.Context, arg database.GetOldUnlinkedChatFileIDsParams) ([]uuid.UUID, error) {
 	m.ctrl.T.Helper()
@@ -4768,6 +5107,21 @@ func (mr *MockStoreMockRecorder) GetOldUnlinkedChatFileIDs(ctx, arg any) *gomock
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetOldUnlinkedChatFileIDs", reflect.TypeOf((*MockStore)(nil).GetOldUnlinkedChatFileIDs), ctx, arg)
 }
 
+// GetOldWorkspaceAgentLogsForArchive mocks base method.
+func (m *MockStore) GetOldWorkspaceAgentLogsForArchive(ctx context.Context, arg database.GetOldWorkspaceAgentLogsForArchiveParams) ([]database.GetOldWorkspaceAgentLogsForArchiveRow, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetOldWorkspaceAgentLogsForArchive", ctx, arg)
+	ret0, _ := ret[0].([]database.GetOldWorkspaceAgentLogsForArchiveRow)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetOldWorkspaceAgentLogsForArchive indicates an expected call of GetOldWorkspaceAgentLogsForArchive.
+func (mr *MockStoreMockRecorder) GetOldWorkspaceAgentLogsForArchive(ctx, arg any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetOldWorkspaceAgentLogsForArchive", reflect.TypeOf((*MockStore)(nil).GetOldWorkspaceAgentLogsForArchive), ctx, arg)
+}
+
 // GetOrganizationByID mocks base method.
 func (m *MockStore) GetOrganizationByID(ctx context.Context, id uuid.UUID) (database.Organization, error) {
 	m.ctrl.T.Helper()
@@ -5158,6 +5512,21 @@ func (mr *MockStoreMockRecorder) GetProvisionerJobByIDWithLock(ctx, id any) *gom
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetProvisionerJobByIDWithLock", reflect.TypeOf((*MockStore)(nil).GetProvisionerJobByIDWithLock), ctx, id)
 }
 
+// GetProvisionerJobPrioritiesByOrganizationID mocks base method.
+func (m *MockStore) GetProvisionerJobPrioritiesByOrganizationID(ctx context.Context, organizationID uuid.UUID) ([]database.ProvisionerJobPriority, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetProvisionerJobPrioritiesByOrganizationID", ctx, organizationID)
+	ret0, _ := ret[0].([]database.ProvisionerJobPriority)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetProvisionerJobPrioritiesByOrganizationID indicates an expected call of GetProvisionerJobPrioritiesByOrganizationID.
+func (mr *MockStoreMockRecorder) GetProvisionerJobPrioritiesByOrganizationID(ctx, organizationID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetProvisionerJobPrioritiesByOrganizationID", reflect.TypeOf((*MockStore)(nil).GetProvisionerJobPrioritiesByOrganizationID), ctx, organizationID)
+}
+
 // GetProvisionerJobTimingsByJobID mocks base method.
 func (m *MockStore) GetProvisionerJobTimingsByJobID(ctx context.Context, jobID uuid.UUID) ([]database.ProvisionerJobTiming, error) {
 	m.ctrl.T.Helper()
@@ -5293,6 +5662,21 @@ func (mr *MockStoreMockRecorder) GetProvisionerLogsAfterID(ctx, arg any) *gomock
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetProvisionerLogsAfterID", reflect.TypeOf((*MockStore)(nil).GetProvisionerLogsAfterID), ctx, arg)
 }
 
+// GetPurgeArchiveImports mocks base method.
+func (m *MockStore) GetPurgeArchiveImports(ctx context.Context) ([]database.PurgeArchiveImport, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetPurgeArchiveImports", ctx)
+	ret0, _ := ret[0].([]database.PurgeArchiveImport)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetPurgeArchiveImports indicates an expected call of GetPurgeArchiveImports.
+func (mr *MockStoreMockRecorder) GetPurgeArchiveImports(ctx any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetPurgeArchiveImports", reflect.TypeOf((*MockStore)(nil).GetPurgeArchiveImports), ctx)
+}
+
 // GetQuotaAllowanceForUser mocks base method.
 func (m *MockStore) GetQuotaAllowanceForUser(ctx context.Context, arg database.GetQuotaAllowanceForUserParams) (int64, error) {
 	m.ctrl.T.Helper()
@@ -5398,6 +5782,66 @@ func (mr *MockStoreMockRecorder) GetRuntimeConfig(ctx, key any) *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetRuntimeConfig", reflect.TypeOf((*MockStore)(nil).GetRuntimeConfig), ctx, key)
 }
 
+// GetScheduleExceptionCalendarByID mocks base method.
+func (m *MockStore) GetScheduleExceptionCalendarByID(ctx context.Context, id uuid.UUID) (database.ScheduleExceptionCalendar, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetScheduleExceptionCalendarByID", ctx, id)
+	ret0, _ := ret[0].(database.ScheduleExceptionCalendar)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetScheduleExceptionCalendarByID indicates an expected call of GetScheduleExceptionCalendarByID.
+func (mr *MockStoreMockRecorder) GetScheduleExceptionCalendarByID(ctx, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetScheduleExceptionCalendarByID", reflect.TypeOf((*MockStore)(nil).GetScheduleExceptionCalendarByID), ctx, id)
+}
+
+// GetScheduleExceptionCalendarDates mocks base method.
+func (m *MockStore) GetScheduleExceptionCalendarDates(ctx context.Context, calendarID uuid.UUID) ([]database.ScheduleExceptionCalendarDate, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetScheduleExceptionCalendarDates", ctx, calendarID)
+	ret0, _ := ret[0].([]database.ScheduleExceptionCalendarDate)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetScheduleExceptionCalendarDates indicates an expected call of GetScheduleExceptionCalendarDates.
+func (mr *MockStoreMockRecorder) GetScheduleExceptionCalendarDates(ctx, calendarID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetScheduleExceptionCalendarDates", reflect.TypeOf((*MockStore)(nil).GetScheduleExceptionCalendarDates), ctx, calendarID)
+}
+
+// GetScheduleExceptionCalendarsByOrganizationID mocks base method.
+func (m *MockStore) GetScheduleExceptionCalendarsByOrganizationID(ctx context.Context, organizationID uuid.UUID) ([]database.ScheduleExceptionCalendar, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetScheduleExceptionCalendarsByOrganizationID", ctx, organizationID)
+	ret0, _ := ret[0].([]database.ScheduleExceptionCalendar)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetScheduleExceptionCalendarsByOrganizationID indicates an expected call of GetScheduleExceptionCalendarsByOrganizationID.
+func (mr *MockStoreMockRecorder) GetScheduleExceptionCalendarsByOrganizationID(ctx, organizationID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetScheduleExceptionCalendarsByOrganizationID", reflect.TypeOf((*MockStore)(nil).GetScheduleExceptionCalendarsByOrganizationID), ctx, organizationID)
+}
+
+// GetScheduleExceptionCalendarsByTemplateID mocks base method.
+func (m *MockStore) GetScheduleExceptionCalendarsByTemplateID(ctx context.Context, templateID uuid.UUID) ([]database.ScheduleExceptionCalendar, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetScheduleExceptionCalendarsByTemplateID", ctx, templateID)
+	ret0, _ := ret[0].([]database.ScheduleExceptionCalendar)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetScheduleExceptionCalendarsByTemplateID indicates an expected call of GetScheduleExceptionCalendarsByTemplateID.
+func (mr *MockStoreMockRecorder) GetScheduleExceptionCalendarsByTemplateID(ctx, templateID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetScheduleExceptionCalendarsByTemplateID", reflect.TypeOf((*MockStore)(nil).GetScheduleExceptionCalendarsByTemplateID), ctx, templateID)
+}
+
 // GetStaleChats mocks base method.
 func (m *MockStore) GetStaleChats(ctx context.Context, staleThreshold time.Time) ([]database.Chat, error) {
 	m.ctrl.T.Helper()
@@ -5833,31 +6277,91 @@ func (mr *MockStoreMockRecorder) GetTemplateVersionParameters(ctx, templateVersi
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetTemplateVersionParameters", reflect.TypeOf((*MockStore)(nil).GetTemplateVersionParameters), ctx, templateVersionID)
 }
 
-// GetTemplateVersionTerraformValues mocks base method.
-func (m *MockStore) GetTemplateVersionTerraformValues(ctx context.Context, templateVersionID uuid.UUID) (database.TemplateVersionTerraformValue, error) {
+// GetTemplateVersionRolloutByID mocks base method.
+func (m *MockStore) GetTemplateVersionRolloutByID(ctx context.Context, id uuid.UUID) (database.TemplateVersionRollout, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "GetTemplateVersionTerraformValues", ctx, templateVersionID)
-	ret0, _ := ret[0].(database.TemplateVersionTerraformValue)
+	ret := m.ctrl.Call(m, "GetTemplateVersionRolloutByID", ctx, id)
+	ret0, _ := ret[0].(database.TemplateVersionRollout)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
-// GetTemplateVersionTerraformValues indicates an expected call of GetTemplateVersionTerraformValues.
-func (mr *MockStoreMockRecorder) GetTemplateVersionTerraformValues(ctx, templateVersionID any) *gomock.Call {
+// GetTemplateVersionRolloutByID indicates an expected call of GetTemplateVersionRolloutByID.
+func (mr *MockStoreMockRecorder) GetTemplateVersionRolloutByID(ctx, id any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetTemplateVersionTerraformValues", reflect.TypeOf((*MockStore)(nil).GetTemplateVersionTerraformValues), ctx, templateVersionID)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetTemplateVersionRolloutByID", reflect.TypeOf((*MockStore)(nil).GetTemplateVersionRolloutByID), ctx, id)
 }
 
-// GetTemplateVersionVariables mocks base method.
-func (m *MockStore) GetTemplateVersionVariables(ctx context.Context, templateVersionID uuid.UUID) ([]database.TemplateVersionVariable, error) {
+// GetTemplateVersionRolloutStats mocks base method.
+func (m *MockStore) GetTemplateVersionRolloutStats(ctx context.Context, arg database.GetTemplateVersionRolloutStatsParams) (database.GetTemplateVersionRolloutStatsRow, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "GetTemplateVersionVariables", ctx, templateVersionID)
-	ret0, _ := ret[0].([]database.TemplateVersionVariable)
+	ret := m.ctrl.Call(m, "GetTemplateVersionRolloutStats", ctx, arg)
+	ret0, _ := ret[0].(database.GetTemplateVersionRolloutStatsRow)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
-// GetTemplateVersionVariables indicates an expected call of GetTemplateVersionVariables.
+// GetTemplateVersionRolloutStats indicates an expected call of GetTemplateVersionRolloutStats.
+func (mr *MockStoreMockRecorder) GetTemplateVersionRolloutStats(ctx, arg any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetTemplateVersionRolloutStats", reflect.TypeOf((*MockStore)(nil).GetTemplateVersionRolloutStats), ctx, arg)
+}
+
+// GetTemplateVersionRolloutTargetsUser mocks base method.
+func (m *MockStore) GetTemplateVersionRolloutTargetsUser(ctx context.Context, arg database.GetTemplateVersionRolloutTargetsUserParams) (bool, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetTemplateVersionRolloutTargetsUser", ctx, arg)
+	ret0, _ := ret[0].(bool)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetTemplateVersionRolloutTargetsUser indicates an expected call of GetTemplateVersionRolloutTargetsUser.
+func (mr *MockStoreMockRecorder) GetTemplateVersionRolloutTargetsUser(ctx, arg any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetTemplateVersionRolloutTargetsUser", reflect.TypeOf((*MockStore)(nil).GetTemplateVersionRolloutTargetsUser), ctx, arg)
+}
+
+// GetTemplateVersionRolloutsByTemplateID mocks base method.
+func (m *MockStore) GetTemplateVersionRolloutsByTemplateID(ctx context.Context, templateID uuid.UUID) ([]database.TemplateVersionRollout, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetTemplateVersionRolloutsByTemplateID", ctx, templateID)
+	ret0, _ := ret[0].([]database.TemplateVersionRollout)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetTemplateVersionRolloutsByTemplateID indicates an expected call of GetTemplateVersionRolloutsByTemplateID.
+func (mr *MockStoreMockRecorder) GetTemplateVersionRolloutsByTemplateID(ctx, templateID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetTemplateVersionRolloutsByTemplateID", reflect.TypeOf((*MockStore)(nil).GetTemplateVersionRolloutsByTemplateID), ctx, templateID)
+}
+
+// GetTemplateVersionTerraformValues mocks base method.
+func (m *MockStore) GetTemplateVersionTerraformValues(ctx context.Context, templateVersionID uuid.UUID) (database.TemplateVersionTerraformValue, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetTemplateVersionTerraformValues", ctx, templateVersionID)
+	ret0, _ := ret[0].(database.TemplateVersionTerraformValue)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetTemplateVersionTerraformValues indicates an expected call of GetTemplateVersionTerraformValues.
+func (mr *MockStoreMockRecorder) GetTemplateVersionTerraformValues(ctx, templateVersionID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetTemplateVersionTerraformValues", reflect.TypeOf((*MockStore)(nil).GetTemplateVersionTerraformValues), ctx, templateVersionID)
+}
+
+// GetTemplateVersionVariables mocks base method.
+func (m *MockStore) GetTemplateVersionVariables(ctx context.Context, templateVersionID uuid.UUID) ([]database.TemplateVersionVariable, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetTemplateVersionVariables", ctx, templateVersionID)
+	ret0, _ := ret[0].([]database.TemplateVersionVariable)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetTemplateVersionVariables indicates an expected call of GetTemplateVersionVariables.
 func (mr *MockStoreMockRecorder) GetTemplateVersionVariables(ctx, templateVersionID any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetTemplateVersionVariables", reflect.TypeOf((*MockStore)(nil).GetTemplateVersionVariables), ctx, templateVersionID)
@@ -6373,6 +6877,21 @@ func (mr *MockStoreMockRecorder) GetUserNotificationPreferences(ctx, userID any)
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetUserNotificationPreferences", reflect.TypeOf((*MockStore)(nil).GetUserNotificationPreferences), ctx, userID)
 }
 
+// GetUserOutOfOfficeRangesByUserID mocks base method.
+func (m *MockStore) GetUserOutOfOfficeRangesByUserID(ctx context.Context, userID uuid.UUID) ([]database.UserOutOfOfficeRange, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetUserOutOfOfficeRangesByUserID", ctx, userID)
+	ret0, _ := ret[0].([]database.UserOutOfOfficeRange)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetUserOutOfOfficeRangesByUserID indicates an expected call of GetUserOutOfOfficeRangesByUserID.
+func (mr *MockStoreMockRecorder) GetUserOutOfOfficeRangesByUserID(ctx, userID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetUserOutOfOfficeRangesByUserID", reflect.TypeOf((*MockStore)(nil).GetUserOutOfOfficeRangesByUserID), ctx, userID)
+}
+
 // GetUserSecretByID mocks base method.
 func (m *MockStore) GetUserSecretByID(ctx context.Context, id uuid.UUID) (database.UserSecret, error) {
 	m.ctrl.T.Helper()
@@ -6658,6 +7177,21 @@ func (mr *MockStoreMockRecorder) GetWorkspaceAgentLogSourcesByAgentIDs(ctx, ids
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetWorkspaceAgentLogSourcesByAgentIDs", reflect.TypeOf((*MockStore)(nil).GetWorkspaceAgentLogSourcesByAgentIDs), ctx, ids)
 }
 
+// GetWorkspaceAgentLogTailsByAgentIDs mocks base method.
+func (m *MockStore) GetWorkspaceAgentLogTailsByAgentIDs(ctx context.Context, ids []uuid.UUID) ([]database.WorkspaceAgentLogTail, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetWorkspaceAgentLogTailsByAgentIDs", ctx, ids)
+	ret0, _ := ret[0].([]database.WorkspaceAgentLogTail)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetWorkspaceAgentLogTailsByAgentIDs indicates an expected call of GetWorkspaceAgentLogTailsByAgentIDs.
+func (mr *MockStoreMockRecorder) GetWorkspaceAgentLogTailsByAgentIDs(ctx, ids any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetWorkspaceAgentLogTailsByAgentIDs", reflect.TypeOf((*MockStore)(nil).GetWorkspaceAgentLogTailsByAgentIDs), ctx, ids)
+}
+
 // GetWorkspaceAgentLogsAfter mocks base method.
 func (m *MockStore) GetWorkspaceAgentLogsAfter(ctx context.Context, arg database.GetWorkspaceAgentLogsAfterParams) ([]database.WorkspaceAgentLog, error) {
 	m.ctrl.T.Helper()
@@ -6688,6 +7222,66 @@ func (mr *MockStoreMockRecorder) GetWorkspaceAgentMetadata(ctx, arg any) *gomock
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetWorkspaceAgentMetadata", reflect.TypeOf((*MockStore)(nil).GetWorkspaceAgentMetadata), ctx, arg)
 }
 
+// GetWorkspaceAgentPTYShareByHashedToken mocks base method.
+func (m *MockStore) GetWorkspaceAgentPTYShareByHashedToken(ctx context.Context, hashedToken []byte) (database.WorkspaceAgentPTYShare, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetWorkspaceAgentPTYShareByHashedToken", ctx, hashedToken)
+	ret0, _ := ret[0].(database.WorkspaceAgentPTYShare)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetWorkspaceAgentPTYShareByHashedToken indicates an expected call of GetWorkspaceAgentPTYShareByHashedToken.
+func (mr *MockStoreMockRecorder) GetWorkspaceAgentPTYShareByHashedToken(ctx, hashedToken any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetWorkspaceAgentPTYShareByHashedToken", reflect.TypeOf((*MockStore)(nil).GetWorkspaceAgentPTYShareByHashedToken), ctx, hashedToken)
+}
+
+// GetWorkspaceAgentPTYShareByID mocks base method.
+func (m *MockStore) GetWorkspaceAgentPTYShareByID(ctx context.Context, id uuid.UUID) (database.WorkspaceAgentPTYShare, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetWorkspaceAgentPTYShareByID", ctx, id)
+	ret0, _ := ret[0].(database.WorkspaceAgentPTYShare)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetWorkspaceAgentPTYShareByID indicates an expected call of GetWorkspaceAgentPTYShareByID.
+func (mr *MockStoreMockRecorder) GetWorkspaceAgentPTYShareByID(ctx, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetWorkspaceAgentPTYShareByID", reflect.TypeOf((*MockStore)(nil).GetWorkspaceAgentPTYShareByID), ctx, id)
+}
+
+// GetWorkspaceAgentPTYShareViewersByAgentID mocks base method.
+func (m *MockStore) GetWorkspaceAgentPTYShareViewersByAgentID(ctx context.Context, agentID uuid.UUID) ([]database.GetWorkspaceAgentPTYShareViewersByAgentIDRow, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetWorkspaceAgentPTYShareViewersByAgentID", ctx, agentID)
+	ret0, _ := ret[0].([]database.GetWorkspaceAgentPTYShareViewersByAgentIDRow)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetWorkspaceAgentPTYShareViewersByAgentID indicates an expected call of GetWorkspaceAgentPTYShareViewersByAgentID.
+func (mr *MockStoreMockRecorder) GetWorkspaceAgentPTYShareViewersByAgentID(ctx, agentID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetWorkspaceAgentPTYShareViewersByAgentID", reflect.TypeOf((*MockStore)(nil).GetWorkspaceAgentPTYShareViewersByAgentID), ctx, agentID)
+}
+
+// GetWorkspaceAgentPTYSharesByAgentID mocks base method.
+func (m *MockStore) GetWorkspaceAgentPTYSharesByAgentID(ctx context.Context, agentID uuid.UUID) ([]database.WorkspaceAgentPTYShare, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetWorkspaceAgentPTYSharesByAgentID", ctx, agentID)
+	ret0, _ := ret[0].([]database.WorkspaceAgentPTYShare)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetWorkspaceAgentPTYSharesByAgentID indicates an expected call of GetWorkspaceAgentPTYSharesByAgentID.
+func (mr *MockStoreMockRecorder) GetWorkspaceAgentPTYSharesByAgentID(ctx, agentID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetWorkspaceAgentPTYSharesByAgentID", reflect.TypeOf((*MockStore)(nil).GetWorkspaceAgentPTYSharesByAgentID), ctx, agentID)
+}
+
 // GetWorkspaceAgentPortShare mocks base method.
 func (m *MockStore) GetWorkspaceAgentPortShare(ctx context.Context, arg database.GetWorkspaceAgentPortShareParams) (database.WorkspaceAgentPortShare, error) {
 	m.ctrl.T.Helper()
@@ -6988,6 +7582,36 @@ func (mr *MockStoreMockRecorder) GetWorkspaceAppsCreatedAfter(ctx, createdAt any
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetWorkspaceAppsCreatedAfter", reflect.TypeOf((*MockStore)(nil).GetWorkspaceAppsCreatedAfter), ctx, createdAt)
 }
 
+// GetWorkspaceAutostartExceptions mocks base method.
+func (m *MockStore) GetWorkspaceAutostartExceptions(ctx context.Context, arg database.GetWorkspaceAutostartExceptionsParams) ([]database.GetWorkspaceAutostartExceptionsRow, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetWorkspaceAutostartExceptions", ctx, arg)
+	ret0, _ := ret[0].([]database.GetWorkspaceAutostartExceptionsRow)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetWorkspaceAutostartExceptions indicates an expected call of GetWorkspaceAutostartExceptions.
+func (mr *MockStoreMockRecorder) GetWorkspaceAutostartExceptions(ctx, arg any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetWorkspaceAutostartExceptions", reflect.TypeOf((*MockStore)(nil).GetWorkspaceAutostartExceptions), ctx, arg)
+}
+
+// GetWorkspaceAutostartExceptionsByWorkspaceIDs mocks base method.
+func (m *MockStore) GetWorkspaceAutostartExceptionsByWorkspaceIDs(ctx context.Context, arg database.GetWorkspaceAutostartExceptionsByWorkspaceIDsParams) ([]database.GetWorkspaceAutostartExceptionsByWorkspaceIDsRow, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetWorkspaceAutostartExceptionsByWorkspaceIDs", ctx, arg)
+	ret0, _ := ret[0].([]database.GetWorkspaceAutostartExceptionsByWorkspaceIDsRow)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetWorkspaceAutostartExceptionsByWorkspaceIDs indicates an expected call of GetWorkspaceAutostartExceptionsByWorkspaceIDs.
+func (mr *MockStoreMockRecorder) GetWorkspaceAutostartExceptionsByWorkspaceIDs(ctx, arg any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetWorkspaceAutostartExceptionsByWorkspaceIDs", reflect.TypeOf((*MockStore)(nil).GetWorkspaceAutostartExceptionsByWorkspaceIDs), ctx, arg)
+}
+
 // GetWorkspaceBuildAgentsByInstanceID mocks base method.
 func (m *MockStore) GetWorkspaceBuildAgentsByInstanceID(ctx context.Context, authInstanceID string) ([]database.GetWorkspaceBuildAgentsByInstanceIDRow, error) {
 	m.ctrl.T.Helper()
@@ -7138,6 +7762,66 @@ func (mr *MockStoreMockRecorder) GetWorkspaceBuildsCreatedAfter(ctx, createdAt a
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetWorkspaceBuildsCreatedAfter", reflect.TypeOf((*MockStore)(nil).GetWorkspaceBuildsCreatedAfter), ctx, createdAt)
 }
 
+// GetWorkspaceBulkOperationByID mocks base method.
+func (m *MockStore) GetWorkspaceBulkOperationByID(ctx context.Context, id uuid.UUID) (database.WorkspaceBulkOperation, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetWorkspaceBulkOperationByID", ctx, id)
+	ret0, _ := ret[0].(database.WorkspaceBulkOperation)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetWorkspaceBulkOperationByID indicates an expected call of GetWorkspaceBulkOperationByID.
+func (mr *MockStoreMockRecorder) GetWorkspaceBulkOperationByID(ctx, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetWorkspaceBulkOperationByID", reflect.TypeOf((*MockStore)(nil).GetWorkspaceBulkOperationByID), ctx, id)
+}
+
+// GetWorkspaceBulkOperationProgress mocks base method.
+func (m *MockStore) GetWorkspaceBulkOperationProgress(ctx context.Context, operationID uuid.UUID) (database.GetWorkspaceBulkOperationProgressRow, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetWorkspaceBulkOperationProgress", ctx, operationID)
+	ret0, _ := ret[0].(database.GetWorkspaceBulkOperationProgressRow)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetWorkspaceBulkOperationProgress indicates an expected call of GetWorkspaceBulkOperationProgress.
+func (mr *MockStoreMockRecorder) GetWorkspaceBulkOperationProgress(ctx, operationID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetWorkspaceBulkOperationProgress", reflect.TypeOf((*MockStore)(nil).GetWorkspaceBulkOperationProgress), ctx, operationID)
+}
+
+// GetWorkspaceBulkOperationWorkspaces mocks base method.
+func (m *MockStore) GetWorkspaceBulkOperationWorkspaces(ctx context.Context, operationID uuid.UUID) ([]database.GetWorkspaceBulkOperationWorkspacesRow, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetWorkspaceBulkOperationWorkspaces", ctx, operationID)
+	ret0, _ := ret[0].([]database.GetWorkspaceBulkOperationWorkspacesRow)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetWorkspaceBulkOperationWorkspaces indicates an expected call of GetWorkspaceBulkOperationWorkspaces.
+func (mr *MockStoreMockRecorder) GetWorkspaceBulkOperationWorkspaces(ctx, operationID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetWorkspaceBulkOperationWorkspaces", reflect.TypeOf((*MockStore)(nil).GetWorkspaceBulkOperationWorkspaces), ctx, operationID)
+}
+
+// GetWorkspaceBulkOperationsByInitiatorID mocks base method.
+func (m *MockStore) GetWorkspaceBulkOperationsByInitiatorID(ctx context.Context, arg database.GetWorkspaceBulkOperationsByInitiatorIDParams) ([]database.WorkspaceBulkOperation, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetWorkspaceBulkOperationsByInitiatorID", ctx, arg)
+	ret0, _ := ret[0].([]database.WorkspaceBulkOperation)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetWorkspaceBulkOperationsByInitiatorID indicates an expected call of GetWorkspaceBulkOperationsByInitiatorID.
+func (mr *MockStoreMockRecorder) GetWorkspaceBulkOperationsByInitiatorID(ctx, arg any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetWorkspaceBulkOperationsByInitiatorID", reflect.TypeOf((*MockStore)(nil).GetWorkspaceBulkOperationsByInitiatorID), ctx, arg)
+}
+
 // GetWorkspaceByAgentID mocks base method.
 func (m *MockStore) GetWorkspaceByAgentID(ctx context.Context, agentID uuid.UUID) (database.Workspace, error) {
 	m.ctrl.T.Helper()
@@ -7213,6 +7897,21 @@ func (mr *MockStoreMockRecorder) GetWorkspaceByWorkspaceAppID(ctx, workspaceAppI
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetWorkspaceByWorkspaceAppID", reflect.TypeOf((*MockStore)(nil).GetWorkspaceByWorkspaceAppID), ctx, workspaceAppID)
 }
 
+// GetWorkspaceDriftCheckByJobID mocks base method.
+func (m *MockStore) GetWorkspaceDriftCheckByJobID(ctx context.Context, jobID uuid.UUID) (database.WorkspaceDriftCheck, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetWorkspaceDriftCheckByJobID", ctx, jobID)
+	ret0, _ := ret[0].(database.WorkspaceDriftCheck)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetWorkspaceDriftCheckByJobID indicates an expected call of GetWorkspaceDriftCheckByJobID.
+func (mr *MockStoreMockRecorder) GetWorkspaceDriftCheckByJobID(ctx, jobID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetWorkspaceDriftCheckByJobID", reflect.TypeOf((*MockStore)(nil).GetWorkspaceDriftCheckByJobID), ctx, jobID)
+}
+
 // GetWorkspaceModulesByJobID mocks base method.
 func (m *MockStore) GetWorkspaceModulesByJobID(ctx context.Context, jobID uuid.UUID) ([]database.WorkspaceModule, error) {
 	m.ctrl.T.Helper()
@@ -7453,6 +8152,21 @@ func (mr *MockStoreMockRecorder) GetWorkspacesByTemplateID(ctx, templateID any)
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetWorkspacesByTemplateID", reflect.TypeOf((*MockStore)(nil).GetWorkspacesByTemplateID), ctx, templateID)
 }
 
+// GetWorkspacesEligibleForDriftCheck mocks base method.
+func (m *MockStore) GetWorkspacesEligibleForDriftCheck(ctx context.Context, now time.Time) ([]database.GetWorkspacesEligibleForDriftCheckRow, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetWorkspacesEligibleForDriftCheck", ctx, now)
+	ret0, _ := ret[0].([]database.GetWorkspacesEligibleForDriftCheckRow)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetWorkspacesEligibleForDriftCheck indicates an expected call of GetWorkspacesEligibleForDriftCheck.
+func (mr *MockStoreMockRecorder) GetWorkspacesEligibleForDriftCheck(ctx, now any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetWorkspacesEligibleForDriftCheck", reflect.TypeOf((*MockStore)(nil).GetWorkspacesEligibleForDriftCheck), ctx, now)
+}
+
 // GetWorkspacesEligibleForLifecycleAction mocks base method.
 func (m *MockStore) GetWorkspacesEligibleForLifecycleAction(ctx context.Context, now time.Time) ([]database.GetWorkspacesEligibleForLifecycleActionRow, error) {
 	m.ctrl.T.Helper()
@@ -8137,6 +8851,21 @@ func (mr *MockStoreMockRecorder) InsertOAuth2ProviderAppCode(ctx, arg any) *gomo
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "InsertOAuth2ProviderAppCode", reflect.TypeOf((*MockStore)(nil).InsertOAuth2ProviderAppCode), ctx, arg)
 }
 
+// InsertOAuth2ProviderAppDeviceCode mocks base method.
+func (m *MockStore) InsertOAuth2ProviderAppDeviceCode(ctx context.Context, arg database.InsertOAuth2ProviderAppDeviceCodeParams) (database.OAuth2ProviderAppDeviceCode, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "InsertOAuth2ProviderAppDeviceCode", ctx, arg)
+	ret0, _ := ret[0].(database.OAuth2ProviderAppDeviceCode)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// InsertOAuth2ProviderAppDeviceCode indicates an expected call of InsertOAuth2ProviderAppDeviceCode.
+func (mr *MockStoreMockRecorder) InsertOAuth2ProviderAppDeviceCode(ctx, arg any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "InsertOAuth2ProviderAppDeviceCode", reflect.TypeOf((*MockStore)(nil).InsertOAuth2ProviderAppDeviceCode), ctx, arg)
+}
+
 // InsertOAuth2ProviderAppSecret mocks base method.
 func (m *MockStore) InsertOAuth2ProviderAppSecret(ctx context.Context, arg database.InsertOAuth2ProviderAppSecretParams) (database.OAuth2ProviderAppSecret, error) {
 	m.ctrl.T.Helper()
@@ -8302,6 +9031,35 @@ func (mr *MockStoreMockRecorder) InsertProvisionerKey(ctx, arg any) *gomock.Call
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "InsertProvisionerKey", reflect.TypeOf((*MockStore)(nil).InsertProvisionerKey), ctx, arg)
 }
 
+// InsertPurgeArchiveImport mocks base method.
+func (m *MockStore) InsertPurgeArchiveImport(ctx context.Context, arg database.InsertPurgeArchiveImportParams) (database.PurgeArchiveImport, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "InsertPurgeArchiveImport", ctx, arg)
+	ret0, _ := ret[0].(database.PurgeArchiveImport)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// InsertPurgeArchiveImport indicates an expected call of InsertPurgeArchiveImport.
+func (mr *MockStoreMockRecorder) InsertPurgeArchiveImport(ctx, arg any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "InsertPurgeArchiveImport", reflect.TypeOf((*MockStore)(nil).InsertPurgeArchiveImport), ctx, arg)
+}
+
+// InsertPurgeArchiveRecords mocks base method.
+func (m *MockStore) InsertPurgeArchiveRecords(ctx context.Context, arg database.InsertPurgeArchiveRecordsParams) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "InsertPurgeArchiveRecords", ctx, arg)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// InsertPurgeArchiveRecords indicates an expected call of InsertPurgeArchiveRecords.
+func (mr *MockStoreMockRecorder) InsertPurgeArchiveRecords(ctx, arg any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "InsertPurgeArchiveRecords", reflect.TypeOf((*MockStore)(nil).InsertPurgeArchiveRecords), ctx, arg)
+}
+
 // InsertReplica mocks base method.
 func (m *MockStore) InsertReplica(ctx context.Context, arg database.InsertReplicaParams) (database.Replica, error) {
 	m.ctrl.T.Helper()
@@ -8317,6 +9075,50 @@ func (mr *MockStoreMockRecorder) InsertReplica(ctx, arg any) *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "InsertReplica", reflect.TypeOf((*MockStore)(nil).InsertReplica), ctx, arg)
 }
 
+// InsertResourceMonitor mocks base method.
+func (m *MockStore) InsertResourceMonitor(ctx context.Context, arg database.InsertResourceMonitorParams) (database.WorkspaceAgentResourceMonitor, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "InsertResourceMonitor", ctx, arg)
+	ret0, _ := ret[0].(database.WorkspaceAgentResourceMonitor)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// InsertResourceMonitor indicates an expected call of InsertResourceMonitor.
+func (mr *MockStoreMockRecorder) InsertResourceMonitor(ctx, arg any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "InsertResourceMonitor", reflect.TypeOf((*MockStore)(nil).InsertResourceMonitor), ctx, arg)
+}
+
+// InsertScheduleExceptionCalendar mocks base method.
+func (m *MockStore) InsertScheduleExceptionCalendar(ctx context.Context, arg database.InsertScheduleExceptionCalendarParams) (database.ScheduleExceptionCalendar, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "InsertScheduleExceptionCalendar", ctx, arg)
+	ret0, _ := ret[0].(database.ScheduleExceptionCalendar)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// InsertScheduleExceptionCalendar indicates an expected call of InsertScheduleExceptionCalendar.
+func (mr *MockStoreMockRecorder) InsertScheduleExceptionCalendar(ctx, arg any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "InsertScheduleExceptionCalendar", reflect.TypeOf((*MockStore)(nil).InsertScheduleExceptionCalendar), ctx, arg)
+}
+
+// InsertScheduleExceptionCalendarDates mocks base method.
+func (m *MockStore) InsertScheduleExceptionCalendarDates(ctx context.Context, arg database.InsertScheduleExceptionCalendarDatesParams) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "InsertScheduleExceptionCalendarDates", ctx, arg)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// InsertScheduleExceptionCalendarDates indicates an expected call of InsertScheduleExceptionCalendarDates.
+func (mr *MockStoreMockRecorder) InsertScheduleExceptionCalendarDates(ctx, arg any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "InsertScheduleExceptionCalendarDates", reflect.TypeOf((*MockStore)(nil).InsertScheduleExceptionCalendarDates), ctx, arg)
+}
+
 // InsertTask mocks base method.
 func (m *MockStore) InsertTask(ctx context.Context, arg database.InsertTaskParams) (database.TaskTable, error) {
 	m.ctrl.T.Helper()
@@ -8374,6 +9176,20 @@ func (mr *MockStoreMockRecorder) InsertTemplate(ctx, arg any) *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "InsertTemplate", reflect.TypeOf((*MockStore)(nil).InsertTemplate), ctx, arg)
 }
 
+// InsertTemplateScheduleExceptionCalendars mocks base method.
+func (m *MockStore) InsertTemplateScheduleExceptionCalendars(ctx context.Context, arg database.InsertTemplateScheduleExceptionCalendarsParams) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "InsertTemplateScheduleExceptionCalendars", ctx, arg)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// InsertTemplateScheduleExceptionCalendars indicates an expected call of InsertTemplateScheduleExceptionCalendars.
+func (mr *MockStoreMockRecorder) InsertTemplateScheduleExceptionCalendars(ctx, arg any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "InsertTemplateScheduleExceptionCalendars", reflect.TypeOf((*MockStore)(nil).InsertTemplateScheduleExceptionCalendars), ctx, arg)
+}
+
 // InsertTemplateVersion mocks base method.
 func (m *MockStore) InsertTemplateVersion(ctx context.Context, arg database.InsertTemplateVersionParams) error {
 	m.ctrl.T.Helper()
@@ -8403,6 +9219,21 @@ func (mr *MockStoreMockRecorder) InsertTemplateVersionParameter(ctx, arg any) *g
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "InsertTemplateVersionParameter", reflect.TypeOf((*MockStore)(nil).InsertTemplateVersionParameter), ctx, arg)
 }
 
+// InsertTemplateVersionRollout mocks base method.
+func (m *MockStore) InsertTemplateVersionRollout(ctx context.Context, arg database.InsertTemplateVersionRolloutParams) (database.TemplateVersionRollout, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "InsertTemplateVersionRollout", ctx, arg)
+	ret0, _ := ret[0].(database.TemplateVersionRollout)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// InsertTemplateVersionRollout indicates an expected call of InsertTemplateVersionRollout.
+func (mr *MockStoreMockRecorder) InsertTemplateVersionRollout(ctx, arg any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "InsertTemplateVersionRollout", reflect.TypeOf((*MockStore)(nil).InsertTemplateVersionRollout), ctx, arg)
+}
+
 // InsertTemplateVersionTerraformValuesByJobID mocks base method.
 func (m *MockStore) InsertTemplateVersionTerraformValuesByJobID(ctx context.Context, arg database.InsertTemplateVersionTerraformValuesByJobIDParams) error {
 	m.ctrl.T.Helper()
@@ -8506,6 +9337,21 @@ func (mr *MockStoreMockRecorder) InsertUserLink(ctx, arg any) *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "InsertUserLink", reflect.TypeOf((*MockStore)(nil).InsertUserLink), ctx, arg)
 }
 
+// InsertUserOutOfOfficeRange mocks base method.
+func (m *MockStore) InsertUserOutOfOfficeRange(ctx context.Context, arg database.InsertUserOutOfOfficeRangeParams) (database.UserOutOfOfficeRange, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "InsertUserOutOfOfficeRange", ctx, arg)
+	ret0, _ := ret[0].(database.UserOutOfOfficeRange)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// InsertUserOutOfOfficeRange indicates an expected call of InsertUserOutOfOfficeRange.
+func (mr *MockStoreMockRecorder) InsertUserOutOfOfficeRange(ctx, arg any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "InsertUserOutOfOfficeRange", reflect.TypeOf((*MockStore)(nil).InsertUserOutOfOfficeRange), ctx, arg)
+}
+
 // InsertUserSkill mocks base method.
 func (m *MockStore) InsertUserSkill(ctx context.Context, arg database.InsertUserSkillParams) (database.UserSkill, error) {
 	m.ctrl.T.Helper()
@@ -8611,6 +9457,21 @@ func (mr *MockStoreMockRecorder) InsertWorkspaceAgentLogSources(ctx, arg any) *g
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "InsertWorkspaceAgentLogSources", reflect.TypeOf((*MockStore)(nil).InsertWorkspaceAgentLogSources), ctx, arg)
 }
 
+// InsertWorkspaceAgentLogTail mocks base method.
+func (m *MockStore) InsertWorkspaceAgentLogTail(ctx context.Context, arg database.InsertWorkspaceAgentLogTailParams) (database.WorkspaceAgentLogTail, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "InsertWorkspaceAgentLogTail", ctx, arg)
+	ret0, _ := ret[0].(database.WorkspaceAgentLogTail)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// InsertWorkspaceAgentLogTail indicates an expected call of InsertWorkspaceAgentLogTail.
+func (mr *MockStoreMockRecorder) InsertWorkspaceAgentLogTail(ctx, arg any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "InsertWorkspaceAgentLogTail", reflect.TypeOf((*MockStore)(nil).InsertWorkspaceAgentLogTail), ctx, arg)
+}
+
 // InsertWorkspaceAgentLogs mocks base method.
 func (m *MockStore) InsertWorkspaceAgentLogs(ctx context.Context, arg database.InsertWorkspaceAgentLogsParams) ([]database.WorkspaceAgentLog, error) {
 	m.ctrl.T.Helper()
@@ -8640,6 +9501,36 @@ func (mr *MockStoreMockRecorder) InsertWorkspaceAgentMetadata(ctx, arg any) *gom
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "InsertWorkspaceAgentMetadata", reflect.TypeOf((*MockStore)(nil).InsertWorkspaceAgentMetadata), ctx, arg)
 }
 
+// InsertWorkspaceAgentPTYShare mocks base method.
+func (m *MockStore) InsertWorkspaceAgentPTYShare(ctx context.Context, arg database.InsertWorkspaceAgentPTYShareParams) (database.WorkspaceAgentPTYShare, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "InsertWorkspaceAgentPTYShare", ctx, arg)
+	ret0, _ := ret[0].(database.WorkspaceAgentPTYShare)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// InsertWorkspaceAgentPTYShare indicates an expected call of InsertWorkspaceAgentPTYShare.
+func (mr *MockStoreMockRecorder) InsertWorkspaceAgentPTYShare(ctx, arg any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "InsertWorkspaceAgentPTYShare", reflect.TypeOf((*MockStore)(nil).InsertWorkspaceAgentPTYShare), ctx, arg)
+}
+
+// InsertWorkspaceAgentPTYShareViewer mocks base method.
+func (m *MockStore) InsertWorkspaceAgentPTYShareViewer(ctx context.Context, arg database.InsertWorkspaceAgentPTYShareViewerParams) (database.WorkspaceAgentPTYShareViewer, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "InsertWorkspaceAgentPTYShareViewer", ctx, arg)
+	ret0, _ := ret[0].(database.WorkspaceAgentPTYShareViewer)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// InsertWorkspaceAgentPTYShareViewer indicates an expected call of InsertWorkspaceAgentPTYShareViewer.
+func (mr *MockStoreMockRecorder) InsertWorkspaceAgentPTYShareViewer(ctx, arg any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "InsertWorkspaceAgentPTYShareViewer", reflect.TypeOf((*MockStore)(nil).InsertWorkspaceAgentPTYShareViewer), ctx, arg)
+}
+
 // InsertWorkspaceAgentScriptTimings mocks base method.
 func (m *MockStore) InsertWorkspaceAgentScriptTimings(ctx context.Context, arg database.InsertWorkspaceAgentScriptTimingsParams) (database.WorkspaceAgentScriptTiming, error) {
 	m.ctrl.T.Helper()
@@ -8756,6 +9647,50 @@ func (mr *MockStoreMockRecorder) InsertWorkspaceBuildParameters(ctx, arg any) *g
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "InsertWorkspaceBuildParameters", reflect.TypeOf((*MockStore)(nil).InsertWorkspaceBuildParameters), ctx, arg)
 }
 
+// InsertWorkspaceBulkOperation mocks base method.
+func (m *MockStore) InsertWorkspaceBulkOperation(ctx context.Context, arg database.InsertWorkspaceBulkOperationParams) (database.WorkspaceBulkOperation, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "InsertWorkspaceBulkOperation", ctx, arg)
+	ret0, _ := ret[0].(database.WorkspaceBulkOperation)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// InsertWorkspaceBulkOperation indicates an expected call of InsertWorkspaceBulkOperation.
+func (mr *MockStoreMockRecorder) InsertWorkspaceBulkOperation(ctx, arg any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "InsertWorkspaceBulkOperation", reflect.TypeOf((*MockStore)(nil).InsertWorkspaceBulkOperation), ctx, arg)
+}
+
+// InsertWorkspaceBulkOperationWorkspaces mocks base method.
+func (m *MockStore) InsertWorkspaceBulkOperationWorkspaces(ctx context.Context, arg database.InsertWorkspaceBulkOperationWorkspacesParams) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "InsertWorkspaceBulkOperationWorkspaces", ctx, arg)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// InsertWorkspaceBulkOperationWorkspaces indicates an expected call of InsertWorkspaceBulkOperationWorkspaces.
+func (mr *MockStoreMockRecorder) InsertWorkspaceBulkOperationWorkspaces(ctx, arg any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "InsertWorkspaceBulkOperationWorkspaces", reflect.TypeOf((*MockStore)(nil).InsertWorkspaceBulkOperationWorkspaces), ctx, arg)
+}
+
+// InsertWorkspaceDriftCheck mocks base method.
+func (m *MockStore) InsertWorkspaceDriftCheck(ctx context.Context, arg database.InsertWorkspaceDriftCheckParams) (database.WorkspaceDriftCheck, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "InsertWorkspaceDriftCheck", ctx, arg)
+	ret0, _ := ret[0].(database.WorkspaceDriftCheck)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// InsertWorkspaceDriftCheck indicates an expected call of InsertWorkspaceDriftCheck.
+func (mr *MockStoreMockRecorder) InsertWorkspaceDriftCheck(ctx, arg any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "InsertWorkspaceDriftCheck", reflect.TypeOf((*MockStore)(nil).InsertWorkspaceDriftCheck), ctx, arg)
+}
+
 // InsertWorkspaceModule mocks base method.
 func (m *MockStore) InsertWorkspaceModule(ctx context.Context, arg database.InsertWorkspaceModuleParams) (database.WorkspaceModule, error) {
 	m.ctrl.T.Helper()
@@ -9591,6 +10526,20 @@ func (mr *MockStoreMockRecorder) RevokeDBCryptKey(ctx, activeKeyDigest any) *gom
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RevokeDBCryptKey", reflect.TypeOf((*MockStore)(nil).RevokeDBCryptKey), ctx, activeKeyDigest)
 }
 
+// RevokeWorkspaceAgentPTYShare mocks base method.
+func (m *MockStore) RevokeWorkspaceAgentPTYShare(ctx context.Context, arg database.RevokeWorkspaceAgentPTYShareParams) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RevokeWorkspaceAgentPTYShare", ctx, arg)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// RevokeWorkspaceAgentPTYShare indicates an expected call of RevokeWorkspaceAgentPTYShare.
+func (mr *MockStoreMockRecorder) RevokeWorkspaceAgentPTYShare(ctx, arg any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RevokeWorkspaceAgentPTYShare", reflect.TypeOf((*MockStore)(nil).RevokeWorkspaceAgentPTYShare), ctx, arg)
+}
+
 // SelectUsageEventsForPublishing mocks base method.
 func (m *MockStore) SelectUsageEventsForPublishing(ctx context.Context, now time.Time) ([]database.UsageEvent, error) {
 	m.ctrl.T.Helper()
@@ -10442,6 +11391,35 @@ func (mr *MockStoreMockRecorder) UpdateOAuth2ProviderAppByID(ctx, arg any) *gomo
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateOAuth2ProviderAppByID", reflect.TypeOf((*MockStore)(nil).UpdateOAuth2ProviderAppByID), ctx, arg)
 }
 
+// UpdateOAuth2ProviderAppDeviceCodePoll mocks base method.
+func (m *MockStore) UpdateOAuth2ProviderAppDeviceCodePoll(ctx context.Context, arg database.UpdateOAuth2ProviderAppDeviceCodePollParams) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateOAuth2ProviderAppDeviceCodePoll", ctx, arg)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UpdateOAuth2ProviderAppDeviceCodePoll indicates an expected call of UpdateOAuth2ProviderAppDeviceCodePoll.
+func (mr *MockStoreMockRecorder) UpdateOAuth2ProviderAppDeviceCodePoll(ctx, arg any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateOAuth2ProviderAppDeviceCodePoll", reflect.TypeOf((*MockStore)(nil).UpdateOAuth2ProviderAppDeviceCodePoll), ctx, arg)
+}
+
+// UpdateOAuth2ProviderAppDeviceCodeStatus mocks base method.
+func (m *MockStore) UpdateOAuth2ProviderAppDeviceCodeStatus(ctx context.Context, arg database.UpdateOAuth2ProviderAppDeviceCodeStatusParams) (database.OAuth2ProviderAppDeviceCode, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateOAuth2ProviderAppDeviceCodeStatus", ctx, arg)
+	ret0, _ := ret[0].(database.OAuth2ProviderAppDeviceCode)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpdateOAuth2ProviderAppDeviceCodeStatus indicates an expected call of UpdateOAuth2ProviderAppDeviceCodeStatus.
+func (mr *MockStoreMockRecorder) UpdateOAuth2ProviderAppDeviceCodeStatus(ctx, arg any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateOAuth2ProviderAppDeviceCodeStatus", reflect.TypeOf((*MockStore)(nil).UpdateOAuth2ProviderAppDeviceCodeStatus), ctx, arg)
+}
+
 // UpdateOrganization mocks base method.
 func (m *MockStore) UpdateOrganization(ctx context.Context, arg database.UpdateOrganizationParams) (database.Organization, error) {
 	m.ctrl.T.Helper()
@@ -10643,6 +11621,35 @@ func (mr *MockStoreMockRecorder) UpdateReplica(ctx, arg any) *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateReplica", reflect.TypeOf((*MockStore)(nil).UpdateReplica), ctx, arg)
 }
 
+// UpdateResourceMonitor mocks base method.
+func (m *MockStore) UpdateResourceMonitor(ctx context.Context, arg database.UpdateResourceMonitorParams) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateResourceMonitor", ctx, arg)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UpdateResourceMonitor indicates an expected call of UpdateResourceMonitor.
+func (mr *MockStoreMockRecorder) UpdateResourceMonitor(ctx, arg any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateResourceMonitor", reflect.TypeOf((*MockStore)(nil).UpdateResourceMonitor), ctx, arg)
+}
+
+// UpdateScheduleExceptionCalendarByID mocks base method.
+func (m *MockStore) UpdateScheduleExceptionCalendarByID(ctx context.Context, arg database.UpdateScheduleExceptionCalendarByIDParams) (database.ScheduleExceptionCalendar, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateScheduleExceptionCalendarByID", ctx, arg)
+	ret0, _ := ret[0].(database.ScheduleExceptionCalendar)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpdateScheduleExceptionCalendarByID indicates an expected call of UpdateScheduleExceptionCalendarByID.
+func (mr *MockStoreMockRecorder) UpdateScheduleExceptionCalendarByID(ctx, arg any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateScheduleExceptionCalendarByID", reflect.TypeOf((*MockStore)(nil).UpdateScheduleExceptionCalendarByID), ctx, arg)
+}
+
 // UpdateTailnetPeerStatusByCoordinator mocks base method.
 func (m *MockStore) UpdateTailnetPeerStatusByCoordinator(ctx context.Context, arg database.UpdateTailnetPeerStatusByCoordinatorParams) ([]uuid.UUID, error) {
 	m.ctrl.T.Helper()
@@ -10828,6 +11835,36 @@ func (mr *MockStoreMockRecorder) UpdateTemplateVersionFlagsByJobID(ctx, arg any)
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateTemplateVersionFlagsByJobID", reflect.TypeOf((*MockStore)(nil).UpdateTemplateVersionFlagsByJobID), ctx, arg)
 }
 
+// UpdateTemplateVersionRolloutByID mocks base method.
+func (m *MockStore) UpdateTemplateVersionRolloutByID(ctx context.Context, arg database.UpdateTemplateVersionRolloutByIDParams) (database.TemplateVersionRollout, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateTemplateVersionRolloutByID", ctx, arg)
+	ret0, _ := ret[0].(database.TemplateVersionRollout)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpdateTemplateVersionRolloutByID indicates an expected call of UpdateTemplateVersionRolloutByID.
+func (mr *MockStoreMockRecorder) UpdateTemplateVersionRolloutByID(ctx, arg any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateTemplateVersionRolloutByID", reflect.TypeOf((*MockStore)(nil).UpdateTemplateVersionRolloutByID), ctx, arg)
+}
+
+// UpdateTemplateVersionRolloutStatusByID mocks base method.
+func (m *MockStore) UpdateTemplateVersionRolloutStatusByID(ctx context.Context, arg database.UpdateTemplateVersionRolloutStatusByIDParams) (database.TemplateVersionRollout, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateTemplateVersionRolloutStatusByID", ctx, arg)
+	ret0, _ := ret[0].(database.TemplateVersionRollout)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpdateTemplateVersionRolloutStatusByID indicates an expected call of UpdateTemplateVersionRolloutStatusByID.
+func (mr *MockStoreMockRecorder) UpdateTemplateVersionRolloutStatusByID(ctx, arg any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateTemplateVersionRolloutStatusByID", reflect.TypeOf((*MockStore)(nil).UpdateTemplateVersionRolloutStatusByID), ctx, arg)
+}
+
 // UpdateTemplateWorkspacesLastUsedAt mocks base method.
 func (m *MockStore) UpdateTemplateWorkspacesLastUsedAt(ctx context.Context, arg database.UpdateTemplateWorkspacesLastUsedAtParams) error {
 	m.ctrl.T.Helper()
@@ -11399,6 +12436,20 @@ func (mr *MockStoreMockRecorder) UpdateWorkspaceAgentMetadata(ctx, arg any) *gom
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateWorkspaceAgentMetadata", reflect.TypeOf((*MockStore)(nil).UpdateWorkspaceAgentMetadata), ctx, arg)
 }
 
+// UpdateWorkspaceAgentPTYShareViewerDisconnectedAt mocks base method.
+func (m *MockStore) UpdateWorkspaceAgentPTYShareViewerDisconnectedAt(ctx context.Context, arg database.UpdateWorkspaceAgentPTYShareViewerDisconnectedAtParams) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateWorkspaceAgentPTYShareViewerDisconnectedAt", ctx, arg)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UpdateWorkspaceAgentPTYShareViewerDisconnectedAt indicates an expected call of UpdateWorkspaceAgentPTYShareViewerDisconnectedAt.
+func (mr *MockStoreMockRecorder) UpdateWorkspaceAgentPTYShareViewerDisconnectedAt(ctx, arg any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateWorkspaceAgentPTYShareViewerDisconnectedAt", reflect.TypeOf((*MockStore)(nil).UpdateWorkspaceAgentPTYShareViewerDisconnectedAt), ctx, arg)
+}
+
 // UpdateWorkspaceAgentStartupByID mocks base method.
 func (m *MockStore) UpdateWorkspaceAgentStartupByID(ctx context.Context, arg database.UpdateWorkspaceAgentStartupByIDParams) error {
 	m.ctrl.T.Helper()
@@ -11585,6 +12636,49 @@ func (mr *MockStoreMockRecorder) UpdateWorkspaceBuildProvisionerStateByID(ctx, a
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateWorkspaceBuildProvisionerStateByID", reflect.TypeOf((*MockStore)(nil).UpdateWorkspaceBuildProvisionerStateByID), ctx, arg)
 }
 
+// UpdateWorkspaceBulkOperationCanceled mocks base method.
+func (m *MockStore) UpdateWorkspaceBulkOperationCanceled(ctx context.Context, arg database.UpdateWorkspaceBulkOperationCanceledParams) (database.WorkspaceBulkOperation, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateWorkspaceBulkOperationCanceled", ctx, arg)
+	ret0, _ := ret[0].(database.WorkspaceBulkOperation)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpdateWorkspaceBulkOperationCanceled indicates an expected call of UpdateWorkspaceBulkOperationCanceled.
+func (mr *MockStoreMockRecorder) UpdateWorkspaceBulkOperationCanceled(ctx, arg any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateWorkspaceBulkOperationCanceled", reflect.TypeOf((*MockStore)(nil).UpdateWorkspaceBulkOperationCanceled), ctx, arg)
+}
+
+// UpdateWorkspaceBulkOperationCompleted mocks base method.
+func (m *MockStore) UpdateWorkspaceBulkOperationCompleted(ctx context.Context, arg database.UpdateWorkspaceBulkOperationCompletedParams) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateWorkspaceBulkOperationCompleted", ctx, arg)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UpdateWorkspaceBulkOperationCompleted indicates an expected call of UpdateWorkspaceBulkOperationCompleted.
+func (mr *MockStoreMockRecorder) UpdateWorkspaceBulkOperationCompleted(ctx, arg any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateWorkspaceBulkOperationCompleted", reflect.TypeOf((*MockStore)(nil).UpdateWorkspaceBulkOperationCompleted), ctx, arg)
+}
+
+// UpdateWorkspaceBulkOperationWorkspace mocks base method.
+func (m *MockStore) UpdateWorkspaceBulkOperationWorkspace(ctx context.Context, arg database.UpdateWorkspaceBulkOperationWorkspaceParams) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateWorkspaceBulkOperationWorkspace", ctx, arg)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UpdateWorkspaceBulkOperationWorkspace indicates an expected call of UpdateWorkspaceBulkOperationWorkspace.
+func (mr *MockStoreMockRecorder) UpdateWorkspaceBulkOperationWorkspace(ctx, arg any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateWorkspaceBulkOperationWorkspace", reflect.TypeOf((*MockStore)(nil).UpdateWorkspaceBulkOperationWorkspace), ctx, arg)
+}
+
 // UpdateWorkspaceDeletedByID mocks base method.
 func (m *MockStore) UpdateWorkspaceDeletedByID(ctx context.Context, arg database.UpdateWorkspaceDeletedByIDParams) error {
 	m.ctrl.T.Helper()
@@ -11614,6 +12708,20 @@ func (mr *MockStoreMockRecorder) UpdateWorkspaceDormantDeletingAt(ctx, arg any)
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateWorkspaceDormantDeletingAt", reflect.TypeOf((*MockStore)(nil).UpdateWorkspaceDormantDeletingAt), ctx, arg)
 }
 
+// UpdateWorkspaceDriftCheckByID mocks base method.
+func (m *MockStore) UpdateWorkspaceDriftCheckByID(ctx context.Context, arg database.UpdateWorkspaceDriftCheckByIDParams) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateWorkspaceDriftCheckByID", ctx, arg)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UpdateWorkspaceDriftCheckByID indicates an expected call of UpdateWorkspaceDriftCheckByID.
+func (mr *MockStoreMockRecorder) UpdateWorkspaceDriftCheckByID(ctx, arg any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateWorkspaceDriftCheckByID", reflect.TypeOf((*MockStore)(nil).UpdateWorkspaceDriftCheckByID), ctx, arg)
+}
+
 // UpdateWorkspaceLastUsedAt mocks base method.
 func (m *MockStore) UpdateWorkspaceLastUsedAt(ctx context.Context, arg database.UpdateWorkspaceLastUsedAtParams) error {
 	m.ctrl.T.Helper()
@@ -12225,6 +13333,21 @@ func (mr *MockStoreMockRecorder) UpsertProvisionerDaemon(ctx, arg any) *gomock.C
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpsertProvisionerDaemon", reflect.TypeOf((*MockStore)(nil).UpsertProvisionerDaemon), ctx, arg)
 }
 
+// UpsertProvisionerJobPriority mocks base method.
+func (m *MockStore) UpsertProvisionerJobPriority(ctx context.Context, arg database.UpsertProvisionerJobPriorityParams) (database.ProvisionerJobPriority, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpsertProvisionerJobPriority", ctx, arg)
+	ret0, _ := ret[0].(database.ProvisionerJobPriority)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpsertProvisionerJobPriority indicates an expected call of UpsertProvisionerJobPriority.
+func (mr *MockStoreMockRecorder) UpsertProvisionerJobPriority(ctx, arg any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpsertProvisionerJobPriority", reflect.TypeOf((*MockStore)(nil).UpsertProvisionerJobPriority), ctx, arg)
+}
+
 // UpsertRuntimeConfig mocks base method.
 func (m *MockStore) UpsertRuntimeConfig(ctx context.Context, arg database.UpsertRuntimeConfigParams) error {
 	m.ctrl.T.Helper()
@@ -2,15 +2,19 @@ package dbpurge
 
 import (
 	"context"
+	"encoding/json"
 	"errors"
 	"io"
+	"slices"
 	"time"
 
+	"github.com/google/uuid"
 	"github.com/prometheus/client_golang/prometheus"
 	"golang.org/x/xerrors"
 
 	"cdr.dev/slog/v3"
 	"github.com/coder/coder/v2/coderd/database"
+	"github.com/coder/coder/v2/coderd/database/dbarchive"
 	"github.com/coder/coder/v2/coderd/database/dbauthz"
 	"github.com/coder/coder/v2/coderd/database/dbtime"
 	"github.com/coder/coder/v2/coderd/pproflabel"
@@ -57,6 +61,11 @@ const (
 	// This is considered acceptable but may need dialing in later.
 	chatSearchBackfillBatchSize  = 10000
 	chatSearchBackfillMaxBatches = 5
+	// Page size when reading workspace agent logs and AI Bridge
+	// interceptions to archive. Their deletes are not batched, so every
+	// page is read into the same archive. Archived records are also
+	// deleted by ID in batches of this size.
+	archivePageSize = 10000
 )
 
 type Option func(*instance)
@@ -75,6 +84,12 @@ func WithChatSearchBackfillLimits(batchSize int32, maxBatches int) Option {
 	}
 }
 
+// WithArchiveSink archives audit logs, connection logs, workspace agent logs
+// and AI Bridge records to the sink before they are purged.
+func WithArchiveSink(sink dbarchive.Sink) Option {
+	return func(i *instance) { i.archiveSink = sink }
+}
+
 // New creates a new periodically purging database instance.
 // Callers must Close the returned instance.
 func New(ctx context.Context, logger slog.Logger, db database.Store, vals *codersdk.DeploymentValues, reg prometheus.Registerer, opts ...Option) io.Closer {
@@ -101,6 +116,14 @@ func New(ctx context.Context, logger slog.Logger, db database.Store, vals *coder
 	}, []string{"record_type"})
 	reg.MustRegister(recordsPurged)
 
+	recordsArchived := prometheus.NewCounterVec(prometheus.CounterOpts{
+		Namespace: "coderd",
+		Subsystem: "dbpurge",
+		Name:      "records_archived_total",
+		Help:      "Total number of records archived before being purged, by type.",
+	}, []string{"record_type"})
+	reg.MustRegister(recordsArchived)
+
 	chatSearchRowsBackfilled := prometheus.NewCounter(prometheus.CounterOpts{
 		Namespace: "coderd",
 		Subsystem: "dbpurge",
@@ -117,6 +140,7 @@ func New(ctx context.Context, logger slog.Logger, db database.Store, vals *coder
 		clk:                          quartz.NewReal(),
 		iterationDuration:            iterationDuration,
 		recordsPurged:                recordsPurged,
+		recordsArchived:              recordsArchived,
 		chatSearchRowsBackfilled:     chatSearchRowsBackfilled,
 		chatSearchBackfillBatchSize:  chatSearchBackfillBatchSize,
 		chatSearchBackfillMaxBatches: chatSearchBackfillMaxBatches,
@@ -181,6 +205,19 @@ func (i *instance) purgeTick(ctx context.Context, db database.Store, start time.
 
 	chatConfigErr := errors.Join(chatRetentionErr, chatDebugRetentionErr)
 
+	// Records that fail to archive are not purged, but the other purges
+	// still run. Like chatConfigErr, the errors are returned after the tx.
+	var archiveErrs []error
+	var archived map[string]*pendingArchive
+	if i.archiveSink != nil {
+		archived, archiveErrs = i.archiveTick(ctx, db, start)
+		defer func() {
+			for _, a := range archived {
+				_ = a.writer.Close()
+			}
+		}()
+	}
+
 	// Start a transaction to grab advisory lock, we don't want to run
 	// multiple purges at the same time (multiple replicas).
 	err := db.InTx(func(tx database.Store) error {
@@ -198,8 +235,12 @@ func (i *instance) purgeTick(ctx context.Context, db database.Store, start time.
 		var purgedWorkspaceAgentLogs int64
 		workspaceAgentLogsRetention := i.vals.Retention.WorkspaceAgentLogs.Value()
 		if workspaceAgentLogsRetention > 0 {
-			deleteOldWorkspaceAgentLogsBefore := start.Add(-workspaceAgentLogsRetention)
-			purgedWorkspaceAgentLogs, err = tx.DeleteOldWorkspaceAgentLogs(ctx, deleteOldWorkspaceAgentLogsBefore)
+			if i.archiveSink != nil {
+				purgedWorkspaceAgentLogs, err = archived[purgeWorkspaceAgentLogs].purge(ctx, tx)
+			} else {
+				deleteOldWorkspaceAgentLogsBefore := start.Add(-workspaceAgentLogsRetention)
+				purgedWorkspaceAgentLogs, err = tx.DeleteOldWorkspaceAgentLogs(ctx, deleteOldWorkspaceAgentLogsBefore)
+			}
 			if err != nil {
 				return xerrors.Errorf("failed to delete old workspace agent logs: %w", err)
 			}
@@ -239,21 +280,35 @@ func (i *instance) purgeTick(ctx context.Context, db database.Store, start time.
 		if err := tx.DeleteOldTelemetryLocks(ctx, deleteOldTelemetryLocksBefore); err != nil {
 			return xerrors.Errorf("failed to delete old telemetry locks: %w", err)
 		}
+		// Device codes are deleted when exchanged, denied, or polled after
+		// expiry, so this only catches codes a client abandoned.
+		if err := tx.DeleteExpiredOAuth2ProviderAppDeviceCodes(dbauthz.AsSystemOAuth2(ctx), dbtime.Time(start)); err != nil {
+			return xerrors.Errorf("failed to delete expired oauth2 device codes: %w", err)
+		}
 
-		deleteOldAuditLogConnectionEventsBefore := start.Add(-maxAuditLogConnectionEventAge)
-		if err := tx.DeleteOldAuditLogConnectionEvents(ctx, database.DeleteOldAuditLogConnectionEventsParams{
-			BeforeTime: deleteOldAuditLogConnectionEventsBefore,
-			LimitCount: auditLogConnectionEventBatchSize,
-		}); err != nil {
+		if i.archiveSink != nil {
+			_, err = archived[purgeAuditLogConnectionEvents].purge(ctx, tx)
+		} else {
+			deleteOldAuditLogConnectionEventsBefore := start.Add(-maxAuditLogConnectionEventAge)
+			err = tx.DeleteOldAuditLogConnectionEvents(ctx, database.DeleteOldAuditLogConnectionEventsParams{
+				BeforeTime: deleteOldAuditLogConnectionEventsBefore,
+				LimitCount: auditLogConnectionEventBatchSize,
+			})
+		}
+		if err != nil {
 			return xerrors.Errorf("failed to delete old audit log connection events: %w", err)
 		}
 
 		var purgedAIBridgeRecords int64
 		aibridgeRetention := i.vals.AI.BridgeConfig.Retention.Value()
 		if aibridgeRetention > 0 {
-			deleteAIBridgeRecordsBefore := start.Add(-aibridgeRetention)
-			// nolint:gocritic // Needs to run as aibridge context.
-			purgedAIBridgeRecords, err = tx.DeleteOldAIBridgeRecords(dbauthz.AsAIBridged(ctx), deleteAIBridgeRecordsBefore)
+			if i.archiveSink != nil {
+				purgedAIBridgeRecords, err = archived[purgeAIBridgeRecords].purge(ctx, tx)
+			} else {
+				deleteAIBridgeRecordsBefore := start.Add(-aibridgeRetention)
+				// nolint:gocritic // Needs to run as aibridge context.
+				purgedAIBridgeRecords, err = tx.DeleteOldAIBridgeRecords(dbauthz.AsAIBridged(ctx), deleteAIBridgeRecordsBefore)
+			}
 			if err != nil {
 				return xerrors.Errorf("failed to delete old aibridge records: %w", err)
 			}
@@ -262,11 +317,15 @@ func (i *instance) purgeTick(ctx context.Context, db database.Store, start time.
 		var purgedConnectionLogs int64
 		connectionLogsRetention := i.vals.Retention.ConnectionLogs.Value()
 		if connectionLogsRetention > 0 {
-			deleteConnectionLogsBefore := start.Add(-connectionLogsRetention)
-			purgedConnectionLogs, err = tx.DeleteOldConnectionLogs(ctx, database.DeleteOldConnectionLogsParams{
-				BeforeTime: deleteConnectionLogsBefore,
-				LimitCount: connectionLogsBatchSize,
-			})
+			if i.archiveSink != nil {
+				purgedConnectionLogs, err = archived[purgeConnectionLogs].purge(ctx, tx)
+			} else {
+				deleteConnectionLogsBefore := start.Add(-connectionLogsRetention)
+				purgedConnectionLogs, err = tx.DeleteOldConnectionLogs(ctx, database.DeleteOldConnectionLogsParams{
+					BeforeTime: deleteConnectionLogsBefore,
+					LimitCount: connectionLogsBatchSize,
+				})
+			}
 			if err != nil {
 				return xerrors.Errorf("failed to delete old connection logs: %w", err)
 			}
@@ -275,11 +334,15 @@ func (i *instance) purgeTick(ctx context.Context, db database.Store, start time.
 		var purgedAuditLogs int64
 		auditLogsRetention := i.vals.Retention.AuditLogs.Value()
 		if auditLogsRetention > 0 {
-			deleteAuditLogsBefore := start.Add(-auditLogsRetention)
-			purgedAuditLogs, err = tx.DeleteOldAuditLogs(ctx, database.DeleteOldAuditLogsParams{
-				BeforeTime: deleteAuditLogsBefore,
-				LimitCount: auditLogsBatchSize,
-			})
+			if i.archiveSink != nil {
+				purgedAuditLogs, err = archived[purgeAuditLogs].purge(ctx, tx)
+			} else {
+				deleteAuditLogsBefore := start.Add(-auditLogsRetention)
+				purgedAuditLogs, err = tx.DeleteOldAuditLogs(ctx, database.DeleteOldAuditLogsParams{
+					BeforeTime: deleteAuditLogsBefore,
+					LimitCount: auditLogsBatchSize,
+				})
+			}
 			if err != nil {
 				return xerrors.Errorf("failed to delete old audit logs: %w", err)
 			}
@@ -387,9 +450,9 @@ func (i *instance) purgeTick(ctx context.Context, db database.Store, start time.
 			i.chatSearchRowsBackfilled.Add(float64(backfilledChatSearchRows))
 		}
 
-		// chatConfigErr is returned after the tx, so do not record this
-		// iteration as successful when only the deferred config read failed.
-		if i.iterationDuration != nil && chatConfigErr == nil {
+		// chatConfigErr and archiveErrs are returned after the tx, so do
+		// not record this iteration as successful when they are set.
+		if i.iterationDuration != nil && chatConfigErr == nil && len(archiveErrs) == 0 {
 			duration := i.clk.Since(start)
 			i.iterationDuration.WithLabelValues("true").Observe(duration.Seconds())
 		}
@@ -405,18 +468,329 @@ func (i *instance) purgeTick(ctx context.Context, db database.Store, start time.
 	if chatConfigErr != nil {
 		return xerrors.Errorf("chat config read failed this tick: %w", chatConfigErr)
 	}
+	if len(archiveErrs) > 0 {
+		return xerrors.Errorf("archive failed this tick, the records were not purged: %w", errors.Join(archiveErrs...))
+	}
+
+	return nil
+}
+
+// Keys of the archives returned by archiveTick, named after the purge that
+// deletes their records.
+const (
+	purgeWorkspaceAgentLogs       = "workspace_agent_logs"
+	purgeAuditLogConnectionEvents = "audit_log_connection_events"
+	purgeAIBridgeRecords          = "aibridge_records"
+	purgeConnectionLogs           = "connection_logs"
+	purgeAuditLogs                = "audit_logs"
+)
+
+// pendingArchive is an archive of records that are about to be purged,
+// along with how to delete exactly those records.
+type pendingArchive struct {
+	recordType dbarchive.RecordType
+	before     time.Time
+	writer     *dbarchive.Writer
+	delete     func(ctx context.Context, tx database.Store) (int64, error)
+}
+
+// purge deletes the archived records. Nothing is deleted for a nil archive,
+// which is what archiveTick returns for records that were not archived.
+func (a *pendingArchive) purge(ctx context.Context, tx database.Store) (int64, error) {
+	if a == nil {
+		return 0, nil
+	}
+	return a.delete(ctx, tx)
+}
+
+// archiveTick archives the records that this purge will delete, and returns
+// the archives that were uploaded. Records are read while holding the purge
+// lock, but uploaded after releasing it, so a slow sink can't keep other
+// replicas waiting on the lock. The purge then deletes the archived records
+// by ID, so records that became eligible after they were read are left for
+// the next purge to archive.
+//
+// If the purge tx is rolled back, the records are archived again by the
+// next purge, so the archives may contain duplicates but never miss a
+// purged record.
+func (i *instance) archiveTick(ctx context.Context, db database.Store, start time.Time) (map[string]*pendingArchive, []error) {
+	archived := make(map[string]*pendingArchive)
+	err := db.InTx(func(tx database.Store) error {
+		ok, err := tx.TryAcquireLock(ctx, database.LockIDDBPurge)
+		if err != nil {
+			return err
+		}
+		if !ok {
+			i.logger.Debug(ctx, "unable to acquire lock for archiving old database entries, skipping")
+			return nil
+		}
+		return i.readArchives(ctx, tx, start, archived)
+	}, database.DefaultTXOptions().WithID("db_purge_archive"))
+	if err != nil {
+		for _, a := range archived {
+			_ = a.writer.Close()
+		}
+		return nil, []error{xerrors.Errorf("read records to archive: %w", err)}
+	}
+
+	var errs []error
+	for name, a := range archived {
+		if err := i.upload(ctx, a, start); err != nil {
+			_ = a.writer.Close()
+			delete(archived, name)
+			errs = append(errs, xerrors.Errorf("archive %s: %w", name, err))
+		}
+	}
+	return archived, errs
+}
+
+// readArchives reads the records that this purge will delete into archived.
+// It must be called with the purge lock held.
+func (i *instance) readArchives(ctx context.Context, tx database.Store, start time.Time, archived map[string]*pendingArchive) error {
+	workspaceAgentLogsRetention := i.vals.Retention.WorkspaceAgentLogs.Value()
+	if workspaceAgentLogsRetention > 0 {
+		deleteOldWorkspaceAgentLogsBefore := start.Add(-workspaceAgentLogsRetention)
+		var afterID int64
+		var ids []int64
+		a, err := readArchive(dbarchive.RecordTypeWorkspaceAgentLogs, deleteOldWorkspaceAgentLogsBefore, func() ([]json.RawMessage, error) {
+			rows, err := tx.GetOldWorkspaceAgentLogsForArchive(ctx, database.GetOldWorkspaceAgentLogsForArchiveParams{
+				Threshold:  deleteOldWorkspaceAgentLogsBefore,
+				AfterID:    afterID,
+				LimitCount: archivePageSize,
+			})
+			if err != nil || len(rows) == 0 {
+				return nil, err
+			}
+			afterID = rows[len(rows)-1].ID
+			records := make([]json.RawMessage, 0, len(rows))
+			for _, row := range rows {
+				ids = append(ids, row.ID)
+				records = append(records, row.Record)
+			}
+			return records, nil
+		})
+		if err != nil {
+			return xerrors.Errorf("workspace agent logs: %w", err)
+		}
+		if a != nil {
+			a.delete = deleteByIDs(ids, database.Store.DeleteWorkspaceAgentLogsByIDs)
+			archived[purgeWorkspaceAgentLogs] = a
+		}
+	}
+
+	deleteOldAuditLogConnectionEventsBefore := start.Add(-maxAuditLogConnectionEventAge)
+	var connectionEventIDs []uuid.UUID
+	a, err := readArchive(dbarchive.RecordTypeAuditLogs, deleteOldAuditLogConnectionEventsBefore, once(func() ([]json.RawMessage, error) {
+		records, err := tx.GetOldAuditLogConnectionEventsForArchive(ctx, database.GetOldAuditLogConnectionEventsForArchiveParams{
+			BeforeTime: deleteOldAuditLogConnectionEventsBefore,
+			LimitCount: auditLogConnectionEventBatchSize,
+		})
+		if err != nil {
+			return nil, err
+		}
+		connectionEventIDs, err = recordIDs(records)
+		return records, err
+	}))
+	if err != nil {
+		return xerrors.Errorf("audit log connection events: %w", err)
+	}
+	if a != nil {
+		a.delete = deleteByIDs(connectionEventIDs, database.Store.DeleteAuditLogsByIDs)
+		archived[purgeAuditLogConnectionEvents] = a
+	}
+
+	aibridgeRetention := i.vals.AI.BridgeConfig.Retention.Value()
+	if aibridgeRetention > 0 {
+		deleteAIBridgeRecordsBefore := start.Add(-aibridgeRetention)
+		var afterID uuid.UUID
+		var ids []uuid.UUID
+		a, err := readArchive(dbarchive.RecordTypeAIBridgeInterceptions, deleteAIBridgeRecordsBefore, func() ([]json.RawMessage, error) {
+			// nolint:gocritic // Needs to run as aibridge context.
+			rows, err := tx.GetOldAIBridgeInterceptionsForArchive(dbauthz.AsAIBridged(ctx), database.GetOldAIBridgeInterceptionsForArchiveParams{
+				BeforeTime: deleteAIBridgeRecordsBefore,
+				AfterID:    afterID,
+				LimitCount: archivePageSize,
+			})
+			if err != nil || len(rows) == 0 {
+				return nil, err
+			}
+			afterID = rows[len(rows)-1].ID
+			records := make([]json.RawMessage, 0, len(rows))
+			for _, row := range rows {
+				ids = append(ids, row.ID)
+				records = append(records, row.Record)
+			}
+			return records, nil
+		})
+		if err != nil {
+			return xerrors.Errorf("aibridge records: %w", err)
+		}
+		if a != nil {
+			a.delete = deleteByIDs(ids, func(tx database.Store, ctx context.Context, ids []uuid.UUID) (int64, error) {
+				// nolint:gocritic // Needs to run as aibridge context.
+				return tx.DeleteAIBridgeRecordsByInterceptionIDs(dbauthz.AsAIBridged(ctx), ids)
+			})
+			archived[purgeAIBridgeRecords] = a
+		}
+	}
+
+	connectionLogsRetention := i.vals.Retention.ConnectionLogs.Value()
+	if connectionLogsRetention > 0 {
+		deleteConnectionLogsBefore := start.Add(-connectionLogsRetention)
+		var ids []uuid.UUID
+		a, err := readArchive(dbarchive.RecordTypeConnectionLogs, deleteConnectionLogsBefore, once(func() ([]json.RawMessage, error) {
+			records, err := tx.GetOldConnectionLogsForArchive(ctx, database.GetOldConnectionLogsForArchiveParams{
+				BeforeTime: deleteConnectionLogsBefore,
+				LimitCount: connectionLogsBatchSize,
+			})
+			if err != nil {
+				return nil, err
+			}
+			ids, err = recordIDs(records)
+			return records, err
+		}))
+		if err != nil {
+			return xerrors.Errorf("connection logs: %w", err)
+		}
+		if a != nil {
+			a.delete = deleteByIDs(ids, database.Store.DeleteConnectionLogsByIDs)
+			archived[purgeConnectionLogs] = a
+		}
+	}
+
+	auditLogsRetention := i.vals.Retention.AuditLogs.Value()
+	if auditLogsRetention > 0 {
+		deleteAuditLogsBefore := start.Add(-auditLogsRetention)
+		var ids []uuid.UUID
+		a, err := readArchive(dbarchive.RecordTypeAuditLogs, deleteAuditLogsBefore, once(func() ([]json.RawMessage, error) {
+			records, err := tx.GetOldAuditLogsForArchive(ctx, database.GetOldAuditLogsForArchiveParams{
+				BeforeTime: deleteAuditLogsBefore,
+				LimitCount: auditLogsBatchSize,
+			})
+			if err != nil {
+				return nil, err
+			}
+			ids, err = recordIDs(records)
+			return records, err
+		}))
+		if err != nil {
+			return xerrors.Errorf("audit logs: %w", err)
+		}
+		if a != nil {
+			a.delete = deleteByIDs(ids, database.Store.DeleteAuditLogsByIDs)
+			archived[purgeAuditLogs] = a
+		}
+	}
 
 	return nil
 }
 
+// readArchive writes the records returned by next to a new archive, calling
+// next until it returns no records. It returns nil if there are no records.
+func readArchive(recordType dbarchive.RecordType, before time.Time, next func() ([]json.RawMessage, error)) (*pendingArchive, error) {
+	w, err := dbarchive.NewWriter(recordType)
+	if err != nil {
+		return nil, err
+	}
+	for {
+		records, err := next()
+		if err != nil {
+			_ = w.Close()
+			return nil, xerrors.Errorf("get records: %w", err)
+		}
+		if len(records) == 0 {
+			break
+		}
+		for _, record := range records {
+			if err := w.Write(record); err != nil {
+				_ = w.Close()
+				return nil, err
+			}
+		}
+	}
+	if w.Rows() == 0 {
+		_ = w.Close()
+		return nil, nil
+	}
+	return &pendingArchive{
+		recordType: recordType,
+		before:     before,
+		writer:     w,
+	}, nil
+}
+
+// upload commits the archive to the sink.
+func (i *instance) upload(ctx context.Context, a *pendingArchive, start time.Time) error {
+	manifest, err := a.writer.Commit(ctx, i.archiveSink, a.before, start)
+	if err != nil {
+		return err
+	}
+	i.logger.Debug(ctx, "archived records before purging",
+		slog.F("record_type", a.recordType),
+		slog.F("archive", manifest.Name),
+		slog.F("rows", manifest.Rows),
+	)
+	if i.recordsArchived != nil {
+		i.recordsArchived.WithLabelValues(string(a.recordType)).Add(float64(manifest.Rows))
+	}
+	return nil
+}
+
+// once adapts a query that returns a single batch to the paging function
+// expected by readArchive.
+func once(fn func() ([]json.RawMessage, error)) func() ([]json.RawMessage, error) {
+	done := false
+	return func() ([]json.RawMessage, error) {
+		if done {
+			return nil, nil
+		}
+		done = true
+		return fn()
+	}
+}
+
+// recordIDs returns the IDs of archived records from tables with a UUID
+// primary key.
+func recordIDs(records []json.RawMessage) ([]uuid.UUID, error) {
+	ids := make([]uuid.UUID, 0, len(records))
+	for _, record := range records {
+		var row struct {
+			ID uuid.UUID `json:"id"`
+		}
+		if err := json.Unmarshal(record, &row); err != nil {
+			return nil, xerrors.Errorf("decode record id: %w", err)
+		}
+		ids = append(ids, row.ID)
+	}
+	return ids, nil
+}
+
+// deleteByIDs returns a function that deletes the records with the given IDs
+// in batches.
+func deleteByIDs[T any](ids []T, del func(database.Store, context.Context, []T) (int64, error)) func(context.Context, database.Store) (int64, error) {
+	return func(ctx context.Context, tx database.Store) (int64, error) {
+		var deleted int64
+		for batch := range slices.Chunk(ids, archivePageSize) {
+			n, err := del(tx, ctx, batch)
+			if err != nil {
+				return deleted, err
+			}
+			deleted += n
+		}
+		return deleted, nil
+	}
+}
+
 type instance struct {
 	cancel                       context.CancelFunc
 	closed                       chan struct{}
 	logger                       slog.Logger
 	vals                         *codersdk.DeploymentValues
 	clk                          quartz.Clock
+	archiveSink                  dbarchive.Sink
 	iterationDuration            *prometheus.HistogramVec
 	recordsPurged                *prometheus.CounterVec
+	recordsArchived              *prometheus.CounterVec
 	chatSearchRowsBackfilled     prometheus.Counter
 	chatSearchBackfillBatchSize  int32
 	chatSearchBackfillMaxBatches int
@@ -7,6 +7,7 @@ import (
 	"database/sql"
 	"encoding/json"
 	"fmt"
+	"io"
 	"slices"
 	"testing"
 	"time"
@@ -25,6 +26,7 @@ import (
 	"cdr.dev/slog/v3/sloggers/slogtest"
 	"github.com/coder/coder/v2/coderd/coderdtest/promhelp"
 	"github.com/coder/coder/v2/coderd/database"
+	"github.com/coder/coder/v2/coderd/database/dbarchive"
 	"github.com/coder/coder/v2/coderd/database/dbgen"
 	"github.com/coder/coder/v2/coderd/database/dbmock"
 	"github.com/coder/coder/v2/coderd/database/dbpurge"
@@ -1821,6 +1823,187 @@ func TestDeleteOldAuditLogs(t *testing.T) {
 	})
 }
 
+func TestArchiveBeforePurge(t *testing.T) {
+	t.Parallel()
+
+	now := time.Date(2025, 1, 15, 7, 30, 0, 0, time.UTC)
+	retentionPeriod := 30 * 24 * time.Hour
+
+	setup := func(t *testing.T) (database.Store, database.AuditLog, database.AuditLog) {
+		db, _ := dbtestutil.NewDB(t, dbtestutil.WithDumpOnFailure())
+		user := dbgen.User(t, db, database.User{})
+		org := dbgen.Organization(t, db, database.Organization{})
+		oldLog := dbgen.AuditLog(t, db, database.AuditLog{
+			UserID:         user.ID,
+			OrganizationID: org.ID,
+			Time:           now.Add(-retentionPeriod).Add(-24 * time.Hour),
+			Action:         database.AuditActionCreate,
+			ResourceType:   database.ResourceTypeWorkspace,
+		})
+		recentLog := dbgen.AuditLog(t, db, database.AuditLog{
+			UserID:         user.ID,
+			OrganizationID: org.ID,
+			Time:           now.Add(-24 * time.Hour),
+			Action:         database.AuditActionCreate,
+			ResourceType:   database.ResourceTypeWorkspace,
+		})
+		return db, oldLog, recentLog
+	}
+
+	remainingAuditLogIDs := func(ctx context.Context, t *testing.T, db database.Store) []uuid.UUID {
+		logs, err := db.GetAuditLogsOffset(ctx, database.GetAuditLogsOffsetParams{
+			LimitOpt: 100,
+		})
+		require.NoError(t, err)
+		ids := make([]uuid.UUID, 0, len(logs))
+		for _, log := range logs {
+			ids = append(ids, log.AuditLog.ID)
+		}
+		return ids
+	}
+
+	t.Run("Archived", func(t *testing.T) {
+		t.Parallel()
+
+		ctx := testutil.Context(t, testutil.WaitShort)
+		clk := quartz.NewMock(t)
+		clk.Set(now).MustWait(ctx)
+		logger := slogtest.Make(t, &slogtest.Options{IgnoreErrors: true})
+		db, oldLog, recentLog := setup(t)
+		sink, err := dbarchive.NewDirSink(t.TempDir())
+		require.NoError(t, err)
+
+		reg := prometheus.NewRegistry()
+		done := awaitDoTick(ctx, t, clk)
+		closer := dbpurge.New(ctx, logger, db, &codersdk.DeploymentValues{
+			Retention: codersdk.RetentionConfig{
+				AuditLogs: serpent.Duration(retentionPeriod),
+			},
+		}, reg, dbpurge.WithClock(clk), dbpurge.WithArchiveSink(sink))
+		defer closer.Close()
+		testutil.TryReceive(ctx, t, done)
+
+		require.Equal(t, []uuid.UUID{recentLog.ID}, remainingAuditLogIDs(ctx, t, db))
+
+		// Only the purged log is archived, and no empty archives are
+		// written for record types with nothing to purge.
+		manifests, err := dbarchive.ReadManifests(ctx, sink)
+		require.NoError(t, err)
+		require.Len(t, manifests, 1)
+		require.Equal(t, dbarchive.RecordTypeAuditLogs, manifests[0].RecordType)
+		require.EqualValues(t, 1, manifests[0].Rows)
+		require.Equal(t, now.Add(-retentionPeriod), manifests[0].Before)
+
+		type archivedAuditLog struct {
+			ID     uuid.UUID            `json:"id"`
+			Time   time.Time            `json:"time"`
+			Action database.AuditAction `json:"action"`
+		}
+		var archived []archivedAuditLog
+		err = dbarchive.ReadRecords(ctx, sink, manifests[0], func(record json.RawMessage) error {
+			var log archivedAuditLog
+			if err := json.Unmarshal(record, &log); err != nil {
+				return err
+			}
+			archived = append(archived, log)
+			return nil
+		})
+		require.NoError(t, err)
+		require.Len(t, archived, 1)
+		require.Equal(t, oldLog.ID, archived[0].ID)
+		require.Equal(t, oldLog.Action, archived[0].Action)
+		require.True(t, oldLog.Time.Equal(archived[0].Time))
+
+		require.EqualValues(t, 1, promhelp.CounterValue(t, reg, "coderd_dbpurge_records_archived_total", prometheus.Labels{
+			"record_type": string(dbarchive.RecordTypeAuditLogs),
+		}))
+	})
+
+	t.Run("ArchiveFailureSkipsPurge", func(t *testing.T) {
+		t.Parallel()
+
+		ctx := testutil.Context(t, testutil.WaitShort)
+		clk := quartz.NewMock(t)
+		clk.Set(now).MustWait(ctx)
+		logger := slogtest.Make(t, &slogtest.Options{IgnoreErrors: true})
+		db, oldLog, recentLog := setup(t)
+
+		done := awaitDoTick(ctx, t, clk)
+		closer := dbpurge.New(ctx, logger, db, &codersdk.DeploymentValues{
+			Retention: codersdk.RetentionConfig{
+				AuditLogs: serpent.Duration(retentionPeriod),
+			},
+		}, prometheus.NewRegistry(), dbpurge.WithClock(clk), dbpurge.WithArchiveSink(failingSink{}))
+		defer closer.Close()
+		testutil.TryReceive(ctx, t, done)
+
+		require.ElementsMatch(t, []uuid.UUID{oldLog.ID, recentLog.ID}, remainingAuditLogIDs(ctx, t, db))
+	})
+
+	t.Run("ConnectionEventsArchived", func(t *testing.T) {
+		t.Parallel()
+
+		ctx := testutil.Context(t, testutil.WaitShort)
+		clk := quartz.NewMock(t)
+		clk.Set(now).MustWait(ctx)
+		logger := slogtest.Make(t, &slogtest.Options{IgnoreErrors: true})
+		db, oldLog, recentLog := setup(t)
+		oldConnectLog := dbgen.AuditLog(t, db, database.AuditLog{
+			UserID:         oldLog.UserID,
+			OrganizationID: oldLog.OrganizationID,
+			Time:           now.Add(-91 * 24 * time.Hour),
+			Action:         database.AuditActionConnect,
+			ResourceType:   database.ResourceTypeWorkspace,
+		})
+		sink, err := dbarchive.NewDirSink(t.TempDir())
+		require.NoError(t, err)
+
+		// Connection events are purged regardless of the audit log
+		// retention, so they must be archived too.
+		done := awaitDoTick(ctx, t, clk)
+		closer := dbpurge.New(ctx, logger, db, &codersdk.DeploymentValues{}, prometheus.NewRegistry(), dbpurge.WithClock(clk), dbpurge.WithArchiveSink(sink))
+		defer closer.Close()
+		testutil.TryReceive(ctx, t, done)
+
+		require.ElementsMatch(t, []uuid.UUID{oldLog.ID, recentLog.ID}, remainingAuditLogIDs(ctx, t, db))
+
+		manifests, err := dbarchive.ReadManifests(ctx, sink)
+		require.NoError(t, err)
+		require.Len(t, manifests, 1)
+		require.Equal(t, dbarchive.RecordTypeAuditLogs, manifests[0].RecordType)
+		require.EqualValues(t, 1, manifests[0].Rows)
+		require.Equal(t, now.Add(-90*24*time.Hour), manifests[0].Before)
+
+		var archived []uuid.UUID
+		err = dbarchive.ReadRecords(ctx, sink, manifests[0], func(record json.RawMessage) error {
+			var log struct {
+				ID uuid.UUID `json:"id"`
+			}
+			if err := json.Unmarshal(record, &log); err != nil {
+				return err
+			}
+			archived = append(archived, log.ID)
+			return nil
+		})
+		require.NoError(t, err)
+		require.Equal(t, []uuid.UUID{oldConnectLog.ID}, archived)
+	})
+}
+
+type failingSink struct{}
+
+func (failingSink) Put(context.Context, string, io.ReadSeeker) error {
+	return xerrors.New("sink unavailable")
+}
+
+func (failingSink) Get(context.Context, string) (io.ReadCloser, error) {
+	return nil, xerrors.New("sink unavailable")
+}
+
+func (failingSink) List(context.Context) ([]string, error) {
+	return nil, xerrors.New("sink unavailable")
+}
+
 func TestDeleteOldBoundaryLogs(t *testing.T) {
 	t.Parallel()
 
@@ -474,7 +474,9 @@ CREATE TYPE notification_message_status AS ENUM (
 CREATE TYPE notification_method AS ENUM (
     'smtp',
     'webhook',
-    'inbox'
+    'inbox',
+    'slack',
+    'msteams'
 );
 
 CREATE TYPE notification_template_kind AS ENUM (
@@ -482,6 +484,12 @@ CREATE TYPE notification_template_kind AS ENUM (
     'custom'
 );
 
+CREATE TYPE oauth2_device_code_status AS ENUM (
+    'pending',
+    'approved',
+    'denied'
+);
+
 CREATE TYPE parameter_destination_scheme AS ENUM (
     'none',
     'environment_variable',
@@ -561,7 +569,8 @@ CREATE TYPE provisioner_job_timing_stage AS ENUM (
 CREATE TYPE provisioner_job_type AS ENUM (
     'template_version_import',
     'workspace_build',
-    'template_version_dry_run'
+    'template_version_dry_run',
+    'workspace_drift_check'
 );
 
 CREATE TYPE provisioner_storage_method AS ENUM (
@@ -611,7 +620,8 @@ CREATE TYPE resource_type AS ENUM (
     'user_ai_budget_override',
     'oauth2_provider_settings',
     'chat_instruction_settings',
-    'mcp_server_config'
+    'mcp_server_config',
+    'workspace_bulk_operation'
 );
 
 CREATE TYPE shareable_workspace_owners AS ENUM (
@@ -643,6 +653,19 @@ CREATE TYPE task_status AS ENUM (
     'error'
 );
 
+CREATE TYPE template_version_rollout_failure_action AS ENUM (
+    'pause',
+    'rollback'
+);
+
+CREATE TYPE template_version_rollout_status AS ENUM (
+    'in_progress',
+    'paused',
+    'completed',
+    'rolled_back',
+    'canceled'
+);
+
 CREATE TYPE user_status AS ENUM (
     'active',
     'suspended',
@@ -687,6 +710,12 @@ CREATE TYPE workspace_agent_monitor_state AS ENUM (
     'NOK'
 );
 
+CREATE TYPE workspace_agent_resource_monitor_type AS ENUM (
+    'cpu',
+    'processes',
+    'inodes'
+);
+
 CREATE TYPE workspace_agent_script_timing_stage AS ENUM (
     'start',
     'stop',
@@ -731,6 +760,30 @@ CREATE TYPE workspace_app_status_state AS ENUM (
     'idle'
 );
 
+CREATE TYPE workspace_bulk_operation_action AS ENUM (
+    'start',
+    'stop',
+    'update',
+    'delete',
+    'autostart',
+    'ttl',
+    'automatic_updates'
+);
+
+CREATE TYPE workspace_bulk_operation_status AS ENUM (
+    'running',
+    'completed',
+    'canceled'
+);
+
+CREATE TYPE workspace_bulk_operation_workspace_status AS ENUM (
+    'pending',
+    'running',
+    'succeeded',
+    'failed',
+    'skipped'
+);
+
 CREATE TYPE workspace_transition AS ENUM (
     'start',
     'stop',
@@ -2671,6 +2724,36 @@ COMMENT ON COLUMN oauth2_provider_app_codes.redirect_uri IS 'The redirect_uri pr
 
 COMMENT ON COLUMN oauth2_provider_app_codes.scope IS 'Space-separated scope negotiated at authorization time, drawn from the api_key_scope vocabulary. Always set; coder:all records an unrestricted grant.';
 
+CREATE TABLE oauth2_provider_app_device_codes (
+    id uuid NOT NULL,
+    created_at timestamp with time zone NOT NULL,
+    expires_at timestamp with time zone NOT NULL,
+    device_code_prefix bytea NOT NULL,
+    device_code_hash bytea NOT NULL,
+    user_code text NOT NULL,
+    app_id uuid NOT NULL,
+    user_id uuid,
+    status oauth2_device_code_status DEFAULT 'pending'::oauth2_device_code_status NOT NULL,
+    resource_uri text,
+    scope text NOT NULL,
+    polling_interval integer NOT NULL,
+    last_polled_at timestamp with time zone,
+    CONSTRAINT oauth2_provider_app_device_codes_approved_has_user CHECK (((status <> 'approved'::oauth2_device_code_status) OR (user_id IS NOT NULL))),
+    CONSTRAINT oauth2_provider_app_device_codes_scope_not_empty CHECK ((scope <> ''::text))
+);
+
+COMMENT ON TABLE oauth2_provider_app_device_codes IS 'RFC 8628 device codes, exchanged for access tokens once a user approves them.';
+
+COMMENT ON COLUMN oauth2_provider_app_device_codes.user_code IS 'Short code the user enters on the verification page. Stored normalized: upper case without separators.';
+
+COMMENT ON COLUMN oauth2_provider_app_device_codes.user_id IS 'The user that approved or denied the code. NULL while the code is pending.';
+
+COMMENT ON COLUMN oauth2_provider_app_device_codes.resource_uri IS 'RFC 8707 resource parameter for audience restriction';
+
+COMMENT ON COLUMN oauth2_provider_app_device_codes.polling_interval IS 'Minimum seconds between token requests. Raised each time the client polls too fast (RFC 8628 §3.5 slow_down).';
+
+COMMENT ON COLUMN oauth2_provider_app_device_codes.last_polled_at IS 'When the client last polled the token endpoint with this code.';
+
 CREATE TABLE oauth2_provider_app_secrets (
     id uuid NOT NULL,
     created_at timestamp with time zone NOT NULL,
@@ -2735,6 +2818,7 @@ CREATE TABLE oauth2_provider_apps (
     software_version text,
     registration_access_token bytea,
     registration_client_uri text,
+    client_credentials_user_id uuid,
     CONSTRAINT oauth2_provider_apps_client_type_check CHECK ((client_type = ANY (ARRAY['confidential'::text, 'public'::text])))
 );
 
@@ -2780,6 +2864,8 @@ COMMENT ON COLUMN oauth2_provider_apps.registration_access_token IS 'RFC 7592: H
 
 COMMENT ON COLUMN oauth2_provider_apps.registration_client_uri IS 'RFC 7592: URI for client configuration endpoint';
 
+COMMENT ON COLUMN oauth2_provider_apps.client_credentials_user_id IS 'Service account that tokens from the client_credentials grant are issued for. NULL disables the grant for this app.';
+
 CREATE TABLE organizations (
     id uuid NOT NULL,
     name text NOT NULL,
@@ -2865,6 +2951,18 @@ CREATE SEQUENCE provisioner_job_logs_id_seq
 
 ALTER SEQUENCE provisioner_job_logs_id_seq OWNED BY provisioner_job_logs.id;
 
+CREATE TABLE provisioner_job_priorities (
+    organization_id uuid NOT NULL,
+    template_id uuid DEFAULT '00000000-0000-0000-0000-000000000000'::uuid NOT NULL,
+    priority integer NOT NULL,
+    updated_at timestamp with time zone NOT NULL,
+    CONSTRAINT provisioner_job_priorities_priority_check CHECK (((priority >= '-100'::integer) AND (priority <= 100)))
+);
+
+COMMENT ON TABLE provisioner_job_priorities IS 'Priority boosts applied to provisioner jobs of an organization or template.';
+
+COMMENT ON COLUMN provisioner_job_priorities.template_id IS 'The template the boost applies to. uuid.Nil means the boost applies to every job in the organization.';
+
 CREATE VIEW provisioner_job_stats AS
 SELECT
     NULL::uuid AS job_id,
@@ -2929,6 +3027,7 @@ CASE
 END) STORED NOT NULL,
     logs_length integer DEFAULT 0 NOT NULL,
     logs_overflowed boolean DEFAULT false NOT NULL,
+    priority integer DEFAULT 0 NOT NULL,
     CONSTRAINT max_provisioner_logs_length CHECK ((logs_length <= 1048576))
 );
 
@@ -2938,6 +3037,8 @@ COMMENT ON COLUMN provisioner_jobs.logs_length IS 'Total length of provisioner l
 
 COMMENT ON COLUMN provisioner_jobs.logs_overflowed IS 'Whether the provisioner logs overflowed in length';
 
+COMMENT ON COLUMN provisioner_jobs.priority IS 'Jobs with a higher priority are acquired first. Computed when the job is inserted from the job''s priority class and any organization or template priority boosts.';
+
 CREATE TABLE provisioner_keys (
     id uuid NOT NULL,
     created_at timestamp with time zone NOT NULL,
@@ -2947,6 +3048,106 @@ CREATE TABLE provisioner_keys (
     tags jsonb NOT NULL
 );
 
+CREATE TABLE purge_archive_imports (
+    id uuid NOT NULL,
+    name text NOT NULL,
+    record_type text NOT NULL,
+    row_count bigint NOT NULL,
+    imported_at timestamp with time zone NOT NULL,
+    CONSTRAINT purge_archive_imports_record_type_check CHECK ((record_type = ANY (ARRAY['audit_logs'::text, 'connection_logs'::text, 'workspace_agent_logs'::text, 'aibridge_interceptions'::text])))
+);
+
+COMMENT ON TABLE purge_archive_imports IS 'Archives of purged records that have been re-imported into the database.';
+
+COMMENT ON COLUMN purge_archive_imports.name IS 'Name of the archive in the archive sink.';
+
+CREATE TABLE purge_archive_records (
+    import_id uuid NOT NULL,
+    record jsonb NOT NULL
+);
+
+COMMENT ON TABLE purge_archive_records IS 'Rows of imported archives, as exported by to_jsonb when they were purged.';
+
+CREATE VIEW archived_aibridge_interceptions AS
+ SELECT ((r.record ->> 'id'::text))::uuid AS id,
+    ((r.record ->> 'initiator_id'::text))::uuid AS initiator_id,
+    (r.record ->> 'provider'::text) AS provider,
+    (r.record ->> 'model'::text) AS model,
+    ((r.record ->> 'started_at'::text))::timestamp with time zone AS started_at,
+    ((r.record ->> 'ended_at'::text))::timestamp with time zone AS ended_at,
+    (r.record -> 'metadata'::text) AS metadata,
+    (r.record -> 'token_usages'::text) AS token_usages,
+    (r.record -> 'user_prompts'::text) AS user_prompts,
+    (r.record -> 'tool_usages'::text) AS tool_usages,
+    (r.record -> 'model_thoughts'::text) AS model_thoughts,
+    i.name AS archive_name
+   FROM (purge_archive_records r
+     JOIN purge_archive_imports i ON ((i.id = r.import_id)))
+  WHERE (i.record_type = 'aibridge_interceptions'::text);
+
+COMMENT ON VIEW archived_aibridge_interceptions IS 'AI Bridge interceptions from imported archives, with their token usages, user prompts, tool usages and model thoughts.';
+
+CREATE VIEW archived_audit_logs AS
+ SELECT ((r.record ->> 'id'::text))::uuid AS id,
+    ((r.record ->> 'time'::text))::timestamp with time zone AS "time",
+    ((r.record ->> 'user_id'::text))::uuid AS user_id,
+    ((r.record ->> 'organization_id'::text))::uuid AS organization_id,
+    ((r.record ->> 'ip'::text))::inet AS ip,
+    (r.record ->> 'user_agent'::text) AS user_agent,
+    (r.record ->> 'resource_type'::text) AS resource_type,
+    ((r.record ->> 'resource_id'::text))::uuid AS resource_id,
+    (r.record ->> 'resource_target'::text) AS resource_target,
+    (r.record ->> 'action'::text) AS action,
+    (r.record -> 'diff'::text) AS diff,
+    ((r.record ->> 'status_code'::text))::integer AS status_code,
+    (r.record -> 'additional_fields'::text) AS additional_fields,
+    ((r.record ->> 'request_id'::text))::uuid AS request_id,
+    (r.record ->> 'resource_icon'::text) AS resource_icon,
+    i.name AS archive_name
+   FROM (purge_archive_records r
+     JOIN purge_archive_imports i ON ((i.id = r.import_id)))
+  WHERE (i.record_type = 'audit_logs'::text);
+
+COMMENT ON VIEW archived_audit_logs IS 'Audit logs from imported archives.';
+
+CREATE VIEW archived_connection_logs AS
+ SELECT ((r.record ->> 'id'::text))::uuid AS id,
+    ((r.record ->> 'connect_time'::text))::timestamp with time zone AS connect_time,
+    ((r.record ->> 'organization_id'::text))::uuid AS organization_id,
+    ((r.record ->> 'workspace_owner_id'::text))::uuid AS workspace_owner_id,
+    ((r.record ->> 'workspace_id'::text))::uuid AS workspace_id,
+    (r.record ->> 'workspace_name'::text) AS workspace_name,
+    (r.record ->> 'agent_name'::text) AS agent_name,
+    (r.record ->> 'type'::text) AS type,
+    ((r.record ->> 'ip'::text))::inet AS ip,
+    ((r.record ->> 'code'::text))::integer AS code,
+    (r.record ->> 'user_agent'::text) AS user_agent,
+    ((r.record ->> 'user_id'::text))::uuid AS user_id,
+    (r.record ->> 'slug_or_port'::text) AS slug_or_port,
+    ((r.record ->> 'connection_id'::text))::uuid AS connection_id,
+    ((r.record ->> 'disconnect_time'::text))::timestamp with time zone AS disconnect_time,
+    (r.record ->> 'disconnect_reason'::text) AS disconnect_reason,
+    i.name AS archive_name
+   FROM (purge_archive_records r
+     JOIN purge_archive_imports i ON ((i.id = r.import_id)))
+  WHERE (i.record_type = 'connection_logs'::text);
+
+COMMENT ON VIEW archived_connection_logs IS 'Connection logs from imported archives.';
+
+CREATE VIEW archived_workspace_agent_logs AS
+ SELECT ((r.record ->> 'id'::text))::bigint AS id,
+    ((r.record ->> 'agent_id'::text))::uuid AS agent_id,
+    ((r.record ->> 'created_at'::text))::timestamp with time zone AS created_at,
+    (r.record ->> 'output'::text) AS output,
+    (r.record ->> 'level'::text) AS level,
+    ((r.record ->> 'log_source_id'::text))::uuid AS log_source_id,
+    i.name AS archive_name
+   FROM (purge_archive_records r
+     JOIN purge_archive_imports i ON ((i.id = r.import_id)))
+  WHERE (i.record_type = 'workspace_agent_logs'::text);
+
+COMMENT ON VIEW archived_workspace_agent_logs IS 'Workspace agent logs from imported archives.';
+
 CREATE TABLE replicas (
     id uuid NOT NULL,
     created_at timestamp with time zone NOT NULL,
@@ -2971,6 +3172,29 @@ COMMENT ON COLUMN replicas.cluster_host IS 'Hostname or IP address the replica i
 
 COMMENT ON COLUMN replicas.nats_port IS 'Port number for NATS clustering. 0 means NATS is disabled.';
 
+CREATE TABLE schedule_exception_calendar_dates (
+    calendar_id uuid NOT NULL,
+    start_date date NOT NULL,
+    end_date date NOT NULL,
+    summary text DEFAULT ''::text NOT NULL,
+    CONSTRAINT schedule_exception_calendar_dates_end_after_start CHECK ((end_date >= start_date))
+);
+
+COMMENT ON COLUMN schedule_exception_calendar_dates.start_date IS 'First date of the exception, inclusive. Dates are interpreted in the timezone of each workspace''s autostart schedule.';
+
+COMMENT ON COLUMN schedule_exception_calendar_dates.end_date IS 'Last date of the exception, inclusive.';
+
+CREATE TABLE schedule_exception_calendars (
+    id uuid NOT NULL,
+    organization_id uuid NOT NULL,
+    name text NOT NULL,
+    description text DEFAULT ''::text NOT NULL,
+    created_at timestamp with time zone NOT NULL,
+    updated_at timestamp with time zone NOT NULL
+);
+
+COMMENT ON TABLE schedule_exception_calendars IS 'Calendars of dates on which workspaces of the templates they are attached to do not autostart.';
+
 CREATE TABLE site_configs (
     key character varying(256) NOT NULL,
     value text NOT NULL
@@ -3120,7 +3344,9 @@ CREATE TABLE workspace_apps (
     hidden boolean DEFAULT false NOT NULL,
     open_in workspace_app_open_in DEFAULT 'slim-window'::workspace_app_open_in NOT NULL,
     display_group text,
-    tooltip character varying(2048) DEFAULT ''::character varying NOT NULL
+    tooltip character varying(2048) DEFAULT ''::character varying NOT NULL,
+    healthcheck_address text DEFAULT ''::text NOT NULL,
+    healthcheck_command text DEFAULT ''::text NOT NULL
 );
 
 COMMENT ON COLUMN workspace_apps.display_order IS 'Specifies the order in which to display agent app in user interfaces.';
@@ -3129,6 +3355,10 @@ COMMENT ON COLUMN workspace_apps.hidden IS 'Determines if the app is not shown i
 
 COMMENT ON COLUMN workspace_apps.tooltip IS 'Markdown text that is displayed when hovering over workspace apps.';
 
+COMMENT ON COLUMN workspace_apps.healthcheck_address IS 'The host:port that must accept TCP connections for the app to be healthy. Set instead of healthcheck_url for TCP healthchecks.';
+
+COMMENT ON COLUMN workspace_apps.healthcheck_command IS 'The command run in the workspace that must exit with status 0 for the app to be healthy. Set instead of healthcheck_url for exec healthchecks.';
+
 CREATE TABLE workspace_builds (
     id uuid NOT NULL,
     created_at timestamp with time zone NOT NULL,
@@ -3277,6 +3507,11 @@ COMMENT ON COLUMN telemetry_locks.event_type IS 'The type of event that was sent
 
 COMMENT ON COLUMN telemetry_locks.period_ending_at IS 'The heartbeat period end timestamp.';
 
+CREATE TABLE template_schedule_exception_calendars (
+    template_id uuid NOT NULL,
+    calendar_id uuid NOT NULL
+);
+
 CREATE TABLE template_usage_stats (
     start_time timestamp with time zone NOT NULL,
     end_time timestamp with time zone NOT NULL,
@@ -3407,6 +3642,42 @@ COMMENT ON COLUMN template_version_presets.description IS 'Short text describing
 
 COMMENT ON COLUMN template_version_presets.icon IS 'URL or path to an icon representing the preset (max 256 characters).';
 
+CREATE TABLE template_version_rollouts (
+    id uuid NOT NULL,
+    template_id uuid NOT NULL,
+    template_version_id uuid NOT NULL,
+    previous_template_version_id uuid NOT NULL,
+    percentage integer DEFAULT 0 NOT NULL,
+    group_ids uuid[] DEFAULT '{}'::uuid[] NOT NULL,
+    failure_threshold double precision DEFAULT 0.2 NOT NULL,
+    min_samples integer DEFAULT 5 NOT NULL,
+    failure_action template_version_rollout_failure_action DEFAULT 'pause'::template_version_rollout_failure_action NOT NULL,
+    status template_version_rollout_status DEFAULT 'in_progress'::template_version_rollout_status NOT NULL,
+    status_message text DEFAULT ''::text NOT NULL,
+    created_by uuid NOT NULL,
+    created_at timestamp with time zone NOT NULL,
+    updated_at timestamp with time zone NOT NULL,
+    started_at timestamp with time zone NOT NULL,
+    finished_at timestamp with time zone,
+    CONSTRAINT template_version_rollouts_failure_threshold_check CHECK (((failure_threshold > (0)::double precision) AND (failure_threshold <= (1)::double precision))),
+    CONSTRAINT template_version_rollouts_min_samples_check CHECK ((min_samples > 0)),
+    CONSTRAINT template_version_rollouts_percentage_check CHECK (((percentage >= 0) AND (percentage <= 100)))
+);
+
+COMMENT ON TABLE template_version_rollouts IS 'Staged rollouts of a template version to a subset of the workspaces that follow the active version.';
+
+COMMENT ON COLUMN template_version_rollouts.previous_template_version_id IS 'The active version of the template when the rollout was created. Workspaces outside of the rollout keep using the active version.';
+
+COMMENT ON COLUMN template_version_rollouts.percentage IS 'The percentage of workspaces, chosen by a stable hash of the workspace ID, that are updated to the rollout version.';
+
+COMMENT ON COLUMN template_version_rollouts.group_ids IS 'Workspaces owned by members of these groups are updated to the rollout version, regardless of the percentage.';
+
+COMMENT ON COLUMN template_version_rollouts.failure_threshold IS 'The ratio of failed builds or agents, between 0 and 1, above which the failure action is taken.';
+
+COMMENT ON COLUMN template_version_rollouts.min_samples IS 'The minimum number of finished builds or agents before the failure ratio is evaluated.';
+
+COMMENT ON COLUMN template_version_rollouts.started_at IS 'Builds and agents since this time are used to evaluate the rollout. Reset when a paused rollout is resumed.';
+
 CREATE TABLE template_version_terraform_values (
     template_version_id uuid NOT NULL,
     updated_at timestamp with time zone DEFAULT now() NOT NULL,
@@ -3527,7 +3798,8 @@ CREATE TABLE templates (
     cors_behavior cors_behavior DEFAULT 'simple'::cors_behavior NOT NULL,
     disable_module_cache boolean DEFAULT false NOT NULL,
     time_til_autostop_notify bigint DEFAULT 0 NOT NULL,
-    agents_allowed boolean DEFAULT true NOT NULL
+    agents_allowed boolean DEFAULT true NOT NULL,
+    drift_check_interval bigint DEFAULT '86400000000000'::bigint NOT NULL
 );
 
 COMMENT ON COLUMN templates.default_ttl IS 'The default duration for autostop for workspaces created from this template.';
@@ -3554,6 +3826,8 @@ COMMENT ON COLUMN templates.time_til_autostop_notify IS 'How long before the wor
 
 COMMENT ON COLUMN templates.agents_allowed IS 'Whether Coder Agents can create workspaces using this template.';
 
+COMMENT ON COLUMN templates.drift_check_interval IS 'How often running workspaces of this template are checked for infrastructure drift, in nanoseconds. 0 disables drift detection.';
+
 CREATE VIEW template_with_names AS
  SELECT templates.id,
     templates.created_at,
@@ -3588,6 +3862,7 @@ CREATE VIEW template_with_names AS
     templates.disable_module_cache,
     templates.time_til_autostop_notify,
     templates.agents_allowed,
+    templates.drift_check_interval,
     COALESCE(visible_users.avatar_url, ''::text) AS created_by_avatar_url,
     COALESCE(visible_users.username, ''::text) AS created_by_username,
     COALESCE(visible_users.name, ''::text) AS created_by_name,
@@ -3689,7 +3964,8 @@ CREATE TABLE user_links (
     oauth_expiry timestamp with time zone DEFAULT '0001-01-01 00:00:00+00'::timestamp with time zone NOT NULL,
     oauth_access_token_key_id text,
     oauth_refresh_token_key_id text,
-    claims jsonb DEFAULT '{}'::jsonb NOT NULL
+    claims jsonb DEFAULT '{}'::jsonb NOT NULL,
+    oidc_provider_id text DEFAULT ''::text NOT NULL
 );
 
 COMMENT ON COLUMN user_links.oauth_access_token_key_id IS 'The ID of the key used to encrypt the OAuth access token. If this is NULL, the access token is not encrypted';
@@ -3698,6 +3974,20 @@ COMMENT ON COLUMN user_links.oauth_refresh_token_key_id IS 'The ID of the key us
 
 COMMENT ON COLUMN user_links.claims IS 'Claims from the IDP for the linked user. Includes both id_token and userinfo claims. ';
 
+COMMENT ON COLUMN user_links.oidc_provider_id IS 'The ID of the named OIDC provider the user is linked to. Empty for the deployment''s primary OIDC provider and for non-OIDC login types.';
+
+CREATE TABLE user_out_of_office_ranges (
+    id uuid NOT NULL,
+    user_id uuid NOT NULL,
+    start_date date NOT NULL,
+    end_date date NOT NULL,
+    reason text DEFAULT ''::text NOT NULL,
+    created_at timestamp with time zone NOT NULL,
+    CONSTRAINT user_out_of_office_ranges_end_after_start CHECK ((end_date >= start_date))
+);
+
+COMMENT ON TABLE user_out_of_office_ranges IS 'Dates on which a user''s workspaces do not autostart.';
+
 CREATE TABLE user_secrets (
     id uuid DEFAULT gen_random_uuid() NOT NULL,
     user_id uuid NOT NULL,
@@ -3827,6 +4117,20 @@ CREATE TABLE workspace_agent_log_sources (
     icon text NOT NULL
 );
 
+CREATE TABLE workspace_agent_log_tails (
+    workspace_agent_id uuid NOT NULL,
+    log_source_id uuid NOT NULL,
+    created_at timestamp with time zone NOT NULL,
+    paths text[] DEFAULT '{}'::text[] NOT NULL,
+    journald_units text[] DEFAULT '{}'::text[] NOT NULL
+);
+
+COMMENT ON TABLE workspace_agent_log_tails IS 'Log files and journald units that the agent tails into a log source.';
+
+COMMENT ON COLUMN workspace_agent_log_tails.paths IS 'Paths of log files to tail. Paths may contain glob patterns.';
+
+COMMENT ON COLUMN workspace_agent_log_tails.journald_units IS 'Names of systemd units whose journal is tailed.';
+
 CREATE UNLOGGED TABLE workspace_agent_logs (
     agent_id uuid NOT NULL,
     created_at timestamp with time zone NOT NULL,
@@ -3869,6 +4173,57 @@ CREATE TABLE workspace_agent_port_share (
     protocol port_share_protocol DEFAULT 'http'::port_share_protocol NOT NULL
 );
 
+CREATE TABLE workspace_agent_pty_share_viewers (
+    id uuid NOT NULL,
+    share_id uuid NOT NULL,
+    user_id uuid NOT NULL,
+    ip inet,
+    connected_at timestamp with time zone NOT NULL,
+    disconnected_at timestamp with time zone
+);
+
+COMMENT ON TABLE workspace_agent_pty_share_viewers IS 'Connections of viewers to shared reconnecting PTY sessions.';
+
+COMMENT ON COLUMN workspace_agent_pty_share_viewers.id IS 'Also used as the connection ID in the connection log.';
+
+CREATE TABLE workspace_agent_pty_shares (
+    id uuid NOT NULL,
+    agent_id uuid NOT NULL,
+    reconnect_id uuid NOT NULL,
+    created_by uuid NOT NULL,
+    hashed_token bytea NOT NULL,
+    user_ids uuid[] DEFAULT '{}'::uuid[] NOT NULL,
+    created_at timestamp with time zone NOT NULL,
+    expires_at timestamp with time zone NOT NULL,
+    revoked_at timestamp with time zone
+);
+
+COMMENT ON TABLE workspace_agent_pty_shares IS 'Read-only shares of reconnecting PTY sessions.';
+
+COMMENT ON COLUMN workspace_agent_pty_shares.reconnect_id IS 'ID of the shared reconnecting PTY session on the agent.';
+
+COMMENT ON COLUMN workspace_agent_pty_shares.hashed_token IS 'SHA-256 hash of the share token. The token itself is only returned when the share is created.';
+
+COMMENT ON COLUMN workspace_agent_pty_shares.user_ids IS 'Users invited to watch the session.';
+
+CREATE TABLE workspace_agent_resource_monitors (
+    agent_id uuid NOT NULL,
+    type workspace_agent_resource_monitor_type NOT NULL,
+    path text DEFAULT ''::text NOT NULL,
+    enabled boolean NOT NULL,
+    threshold integer NOT NULL,
+    created_at timestamp with time zone NOT NULL,
+    updated_at timestamp with time zone DEFAULT CURRENT_TIMESTAMP NOT NULL,
+    state workspace_agent_monitor_state DEFAULT 'OK'::workspace_agent_monitor_state NOT NULL,
+    debounced_until timestamp with time zone DEFAULT '0001-01-01 00:00:00+00'::timestamp with time zone NOT NULL
+);
+
+COMMENT ON TABLE workspace_agent_resource_monitors IS 'Resource monitors of a workspace agent other than memory and volume usage.';
+
+COMMENT ON COLUMN workspace_agent_resource_monitors.path IS 'Path of the volume for inodes monitors, empty for other types.';
+
+COMMENT ON COLUMN workspace_agent_resource_monitors.threshold IS 'Percentage of the resource in use above which the monitor is not OK.';
+
 CREATE TABLE workspace_agent_script_timings (
     script_id uuid NOT NULL,
     started_at timestamp with time zone NOT NULL,
@@ -4122,6 +4477,71 @@ CREATE VIEW workspace_latest_builds AS
   WHERE (workspaces.deleted = false)
   ORDER BY workspaces.id;
 
+CREATE TABLE workspace_bulk_operation_workspaces (
+    operation_id uuid NOT NULL,
+    workspace_id uuid NOT NULL,
+    status workspace_bulk_operation_workspace_status DEFAULT 'pending'::workspace_bulk_operation_workspace_status NOT NULL,
+    build_id uuid,
+    error text DEFAULT ''::text NOT NULL,
+    updated_at timestamp with time zone NOT NULL
+);
+
+COMMENT ON TABLE workspace_bulk_operation_workspaces IS 'The outcome of a workspace bulk operation for each of its workspaces.';
+
+COMMENT ON COLUMN workspace_bulk_operation_workspaces.build_id IS 'The build created for the workspace by the start, stop, update and delete actions.';
+
+CREATE TABLE workspace_bulk_operations (
+    id uuid NOT NULL,
+    initiator_id uuid NOT NULL,
+    action workspace_bulk_operation_action NOT NULL,
+    query text NOT NULL,
+    autostart_schedule text DEFAULT ''::text NOT NULL,
+    ttl_ms bigint DEFAULT 0 NOT NULL,
+    automatic_updates automatic_updates DEFAULT 'never'::automatic_updates NOT NULL,
+    concurrency integer NOT NULL,
+    workspace_count integer DEFAULT 0 NOT NULL,
+    status workspace_bulk_operation_status DEFAULT 'running'::workspace_bulk_operation_status NOT NULL,
+    created_at timestamp with time zone NOT NULL,
+    updated_at timestamp with time zone NOT NULL,
+    completed_at timestamp with time zone,
+    api_key_scopes api_key_scope[] NOT NULL,
+    api_key_allow_list text[] NOT NULL,
+    CONSTRAINT workspace_bulk_operations_concurrency_check CHECK ((concurrency > 0))
+);
+
+COMMENT ON TABLE workspace_bulk_operations IS 'Actions applied to every workspace that matched a search query when the operation was created.';
+
+COMMENT ON COLUMN workspace_bulk_operations.query IS 'The workspace search query, evaluated with the permissions of the initiator.';
+
+COMMENT ON COLUMN workspace_bulk_operations.autostart_schedule IS 'The autostart schedule set by the autostart action. Empty disables autostart.';
+
+COMMENT ON COLUMN workspace_bulk_operations.ttl_ms IS 'The autostop TTL set by the ttl action. Zero disables autostop.';
+
+COMMENT ON COLUMN workspace_bulk_operations.concurrency IS 'The maximum number of workspaces of the operation that are processed at the same time.';
+
+COMMENT ON COLUMN workspace_bulk_operations.api_key_scopes IS 'The scopes of the API key that created the operation, which limit what the operation can do on behalf of the initiator.';
+
+COMMENT ON COLUMN workspace_bulk_operations.api_key_allow_list IS 'The allow list of the API key that created the operation.';
+
+CREATE TABLE workspace_drift_checks (
+    id uuid NOT NULL,
+    workspace_id uuid NOT NULL,
+    workspace_build_id uuid NOT NULL,
+    job_id uuid NOT NULL,
+    created_at timestamp with time zone NOT NULL,
+    checked_at timestamp with time zone,
+    drifted boolean DEFAULT false NOT NULL,
+    resource_drift jsonb DEFAULT '[]'::jsonb NOT NULL
+);
+
+COMMENT ON TABLE workspace_drift_checks IS 'Refresh-only Terraform plans run against running workspaces to detect infrastructure that changed outside of Coder.';
+
+COMMENT ON COLUMN workspace_drift_checks.workspace_build_id IS 'The build whose Terraform state was checked.';
+
+COMMENT ON COLUMN workspace_drift_checks.checked_at IS 'When the drift check job completed successfully. NULL while the job is pending or if it failed.';
+
+COMMENT ON COLUMN workspace_drift_checks.resource_drift IS 'The resources that changed outside of Coder, as reported by the provisioner.';
+
 CREATE TABLE workspace_modules (
     id uuid NOT NULL,
     job_id uuid NOT NULL,
@@ -4490,6 +4910,15 @@ ALTER TABLE ONLY oauth2_provider_app_codes
 ALTER TABLE ONLY oauth2_provider_app_codes
     ADD CONSTRAINT oauth2_provider_app_codes_secret_prefix_key UNIQUE (secret_prefix);
 
+ALTER TABLE ONLY oauth2_provider_app_device_codes
+    ADD CONSTRAINT oauth2_provider_app_device_codes_device_code_prefix_key UNIQUE (device_code_prefix);
+
+ALTER TABLE ONLY oauth2_provider_app_device_codes
+    ADD CONSTRAINT oauth2_provider_app_device_codes_pkey PRIMARY KEY (id);
+
+ALTER TABLE ONLY oauth2_provider_app_device_codes
+    ADD CONSTRAINT oauth2_provider_app_device_codes_user_code_key UNIQUE (user_code);
+
 ALTER TABLE ONLY oauth2_provider_app_secrets
     ADD CONSTRAINT oauth2_provider_app_secrets_pkey PRIMARY KEY (id);
 
@@ -4529,12 +4958,27 @@ ALTER TABLE ONLY provisioner_daemons
 ALTER TABLE ONLY provisioner_job_logs
     ADD CONSTRAINT provisioner_job_logs_pkey PRIMARY KEY (id);
 
+ALTER TABLE ONLY provisioner_job_priorities
+    ADD CONSTRAINT provisioner_job_priorities_pkey PRIMARY KEY (organization_id, template_id);
+
 ALTER TABLE ONLY provisioner_jobs
     ADD CONSTRAINT provisioner_jobs_pkey PRIMARY KEY (id);
 
 ALTER TABLE ONLY provisioner_keys
     ADD CONSTRAINT provisioner_keys_pkey PRIMARY KEY (id);
 
+ALTER TABLE ONLY purge_archive_imports
+    ADD CONSTRAINT purge_archive_imports_name_key UNIQUE (name);
+
+ALTER TABLE ONLY purge_archive_imports
+    ADD CONSTRAINT purge_archive_imports_pkey PRIMARY KEY (id);
+
+ALTER TABLE ONLY schedule_exception_calendars
+    ADD CONSTRAINT schedule_exception_calendars_organization_id_name_key UNIQUE (organization_id, name);
+
+ALTER TABLE ONLY schedule_exception_calendars
+    ADD CONSTRAINT schedule_exception_calendars_pkey PRIMARY KEY (id);
+
 ALTER TABLE ONLY site_configs
     ADD CONSTRAINT site_configs_key_key UNIQUE (key);
 
@@ -4562,6 +5006,9 @@ ALTER TABLE ONLY telemetry_items
 ALTER TABLE ONLY telemetry_locks
     ADD CONSTRAINT telemetry_locks_pkey PRIMARY KEY (event_type, period_ending_at);
 
+ALTER TABLE ONLY template_schedule_exception_calendars
+    ADD CONSTRAINT template_schedule_exception_calendars_pkey PRIMARY KEY (template_id, calendar_id);
+
 ALTER TABLE ONLY template_usage_stats
     ADD CONSTRAINT template_usage_stats_pkey PRIMARY KEY (start_time, template_id, user_id);
 
@@ -4580,6 +5027,9 @@ ALTER TABLE ONLY template_version_presets
 ALTER TABLE ONLY template_version_presets
     ADD CONSTRAINT template_version_presets_pkey PRIMARY KEY (id);
 
+ALTER TABLE ONLY template_version_rollouts
+    ADD CONSTRAINT template_version_rollouts_pkey PRIMARY KEY (id);
+
 ALTER TABLE ONLY template_version_terraform_values
     ADD CONSTRAINT template_version_terraform_values_template_version_id_key UNIQUE (template_version_id);
 
@@ -4622,6 +5072,9 @@ ALTER TABLE ONLY user_deleted
 ALTER TABLE ONLY user_links
     ADD CONSTRAINT user_links_pkey PRIMARY KEY (user_id, login_type);
 
+ALTER TABLE ONLY user_out_of_office_ranges
+    ADD CONSTRAINT user_out_of_office_ranges_pkey PRIMARY KEY (id);
+
 ALTER TABLE ONLY user_secrets
     ADD CONSTRAINT user_secrets_pkey PRIMARY KEY (id);
 
@@ -4649,6 +5102,9 @@ ALTER TABLE ONLY workspace_agent_devcontainers
 ALTER TABLE ONLY workspace_agent_log_sources
     ADD CONSTRAINT workspace_agent_log_sources_pkey PRIMARY KEY (workspace_agent_id, id);
 
+ALTER TABLE ONLY workspace_agent_log_tails
+    ADD CONSTRAINT workspace_agent_log_tails_pkey PRIMARY KEY (workspace_agent_id, log_source_id);
+
 ALTER TABLE ONLY workspace_agent_memory_resource_monitors
     ADD CONSTRAINT workspace_agent_memory_resource_monitors_pkey PRIMARY KEY (agent_id);
 
@@ -4658,6 +5114,15 @@ ALTER TABLE ONLY workspace_agent_metadata
 ALTER TABLE ONLY workspace_agent_port_share
     ADD CONSTRAINT workspace_agent_port_share_pkey PRIMARY KEY (workspace_id, agent_name, port);
 
+ALTER TABLE ONLY workspace_agent_pty_share_viewers
+    ADD CONSTRAINT workspace_agent_pty_share_viewers_pkey PRIMARY KEY (id);
+
+ALTER TABLE ONLY workspace_agent_pty_shares
+    ADD CONSTRAINT workspace_agent_pty_shares_pkey PRIMARY KEY (id);
+
+ALTER TABLE ONLY workspace_agent_resource_monitors
+    ADD CONSTRAINT workspace_agent_resource_monitors_pkey PRIMARY KEY (agent_id, type, path);
+
 ALTER TABLE ONLY workspace_agent_script_timings
     ADD CONSTRAINT workspace_agent_script_timings_script_id_started_at_key UNIQUE (script_id, started_at);
 
@@ -4718,6 +5183,18 @@ ALTER TABLE ONLY workspace_builds
 ALTER TABLE ONLY workspace_builds
     ADD CONSTRAINT workspace_builds_workspace_id_build_number_key UNIQUE (workspace_id, build_number);
 
+ALTER TABLE ONLY workspace_bulk_operation_workspaces
+    ADD CONSTRAINT workspace_bulk_operation_workspaces_pkey PRIMARY KEY (operation_id, workspace_id);
+
+ALTER TABLE ONLY workspace_bulk_operations
+    ADD CONSTRAINT workspace_bulk_operations_pkey PRIMARY KEY (id);
+
+ALTER TABLE ONLY workspace_drift_checks
+    ADD CONSTRAINT workspace_drift_checks_job_id_key UNIQUE (job_id);
+
+ALTER TABLE ONLY workspace_drift_checks
+    ADD CONSTRAINT workspace_drift_checks_pkey PRIMARY KEY (id);
+
 ALTER TABLE ONLY workspace_proxies
     ADD CONSTRAINT workspace_proxies_pkey PRIMARY KEY (id);
 
@@ -4982,6 +5459,8 @@ CREATE INDEX idx_workspace_builds_initiator_id ON workspace_builds USING btree (
 
 CREATE UNIQUE INDEX notification_messages_dedupe_hash_idx ON notification_messages USING btree (dedupe_hash);
 
+CREATE INDEX oauth2_provider_app_device_codes_expires_at_idx ON oauth2_provider_app_device_codes USING btree (expires_at);
+
 CREATE UNIQUE INDEX organizations_single_default_org ON organizations USING btree (is_default) WHERE (is_default = true);
 
 CREATE INDEX provisioner_job_logs_id_job_id_idx ON provisioner_job_logs USING btree (job_id, id);
@@ -4994,6 +5473,10 @@ COMMENT ON INDEX provisioner_jobs_worker_id_organization_id_completed_at_idx IS
 
 CREATE UNIQUE INDEX provisioner_keys_organization_id_name_idx ON provisioner_keys USING btree (organization_id, lower((name)::text));
 
+CREATE INDEX purge_archive_records_import_id_idx ON purge_archive_records USING btree (import_id);
+
+CREATE INDEX schedule_exception_calendar_dates_calendar_id_end_date_idx ON schedule_exception_calendar_dates USING btree (calendar_id, end_date);
+
 CREATE INDEX tasks_organization_id_idx ON tasks USING btree (organization_id);
 
 CREATE INDEX tasks_owner_id_idx ON tasks USING btree (owner_id);
@@ -5012,10 +5495,16 @@ CREATE UNIQUE INDEX template_usage_stats_start_time_template_id_user_id_idx ON t
 
 COMMENT ON INDEX template_usage_stats_start_time_template_id_user_id_idx IS 'Index for primary key.';
 
+CREATE INDEX template_version_rollouts_template_id_created_at_idx ON template_version_rollouts USING btree (template_id, created_at DESC);
+
+CREATE UNIQUE INDEX template_version_rollouts_template_id_active_idx ON template_version_rollouts USING btree (template_id) WHERE (status = ANY (ARRAY['in_progress'::template_version_rollout_status, 'paused'::template_version_rollout_status]));
+
 CREATE UNIQUE INDEX templates_organization_id_name_idx ON templates USING btree (organization_id, lower((name)::text)) WHERE (deleted = false);
 
 CREATE UNIQUE INDEX user_links_linked_id_login_type_idx ON user_links USING btree (linked_id, login_type) WHERE (linked_id <> ''::text);
 
+CREATE INDEX user_out_of_office_ranges_user_id_end_date_idx ON user_out_of_office_ranges USING btree (user_id, end_date);
+
 CREATE UNIQUE INDEX user_secrets_user_env_name_idx ON user_secrets USING btree (user_id, env_name) WHERE (env_name <> ''::text);
 
 CREATE UNIQUE INDEX user_secrets_user_file_path_idx ON user_secrets USING btree (user_id, file_path) WHERE (file_path <> ''::text);
@@ -5034,6 +5523,12 @@ CREATE INDEX workspace_agent_devcontainers_workspace_agent_id ON workspace_agent
 
 COMMENT ON INDEX workspace_agent_devcontainers_workspace_agent_id IS 'Workspace agent foreign key and query index';
 
+CREATE INDEX workspace_agent_pty_share_viewers_share_id_idx ON workspace_agent_pty_share_viewers USING btree (share_id);
+
+CREATE INDEX workspace_agent_pty_shares_agent_id_idx ON workspace_agent_pty_shares USING btree (agent_id);
+
+CREATE UNIQUE INDEX workspace_agent_pty_shares_hashed_token_idx ON workspace_agent_pty_shares USING btree (hashed_token);
+
 CREATE INDEX workspace_agent_scripts_workspace_agent_id_idx ON workspace_agent_scripts USING btree (workspace_agent_id);
 
 COMMENT ON INDEX workspace_agent_scripts_workspace_agent_id_idx IS 'Foreign key support index for faster lookups';
@@ -5058,6 +5553,12 @@ CREATE INDEX workspace_app_stats_workspace_id_idx ON workspace_app_stats USING b
 
 CREATE INDEX workspace_app_statuses_app_id_idx ON workspace_app_statuses USING btree (app_id, created_at DESC);
 
+CREATE INDEX workspace_bulk_operation_workspaces_status_idx ON workspace_bulk_operation_workspaces USING btree (status) WHERE (status = ANY (ARRAY['pending'::workspace_bulk_operation_workspace_status, 'running'::workspace_bulk_operation_workspace_status]));
+
+CREATE INDEX workspace_bulk_operations_created_at_idx ON workspace_bulk_operations USING btree (created_at DESC);
+
+CREATE INDEX workspace_drift_checks_workspace_id_created_at_idx ON workspace_drift_checks USING btree (workspace_id, created_at DESC);
+
 CREATE INDEX workspace_modules_created_at_idx ON workspace_modules USING btree (created_at);
 
 CREATE INDEX workspace_next_start_at_idx ON workspaces USING btree (next_start_at) WHERE (deleted = false);
@@ -5388,6 +5889,12 @@ ALTER TABLE ONLY oauth2_provider_app_codes
 ALTER TABLE ONLY oauth2_provider_app_codes
     ADD CONSTRAINT oauth2_provider_app_codes_user_id_fkey FOREIGN KEY (user_id) REFERENCES users(id) ON DELETE CASCADE;
 
+ALTER TABLE ONLY oauth2_provider_app_device_codes
+    ADD CONSTRAINT oauth2_provider_app_device_codes_app_id_fkey FOREIGN KEY (app_id) REFERENCES oauth2_provider_apps(id) ON DELETE CASCADE;
+
+ALTER TABLE ONLY oauth2_provider_app_device_codes
+    ADD CONSTRAINT oauth2_provider_app_device_codes_user_id_fkey FOREIGN KEY (user_id) REFERENCES users(id) ON DELETE CASCADE;
+
 ALTER TABLE ONLY oauth2_provider_app_secrets
     ADD CONSTRAINT oauth2_provider_app_secrets_app_id_fkey FOREIGN KEY (app_id) REFERENCES oauth2_provider_apps(id) ON DELETE CASCADE;
 
@@ -5400,6 +5907,9 @@ ALTER TABLE ONLY oauth2_provider_app_tokens
 ALTER TABLE ONLY oauth2_provider_app_tokens
     ADD CONSTRAINT oauth2_provider_app_tokens_app_secret_id_fkey FOREIGN KEY (app_secret_id) REFERENCES oauth2_provider_app_secrets(id) ON DELETE CASCADE;
 
+ALTER TABLE ONLY oauth2_provider_apps
+    ADD CONSTRAINT oauth2_provider_apps_client_credentials_user_id_fkey FOREIGN KEY (client_credentials_user_id) REFERENCES users(id) ON DELETE SET NULL;
+
 ALTER TABLE ONLY organization_members
     ADD CONSTRAINT organization_members_organization_id_uuid_fkey FOREIGN KEY (organization_id) REFERENCES organizations(id) ON DELETE CASCADE;
 
@@ -5418,6 +5928,9 @@ ALTER TABLE ONLY provisioner_daemons
 ALTER TABLE ONLY provisioner_job_logs
     ADD CONSTRAINT provisioner_job_logs_job_id_fkey FOREIGN KEY (job_id) REFERENCES provisioner_jobs(id) ON DELETE CASCADE;
 
+ALTER TABLE ONLY provisioner_job_priorities
+    ADD CONSTRAINT provisioner_job_priorities_organization_id_fkey FOREIGN KEY (organization_id) REFERENCES organizations(id) ON DELETE CASCADE;
+
 ALTER TABLE ONLY provisioner_job_timings
     ADD CONSTRAINT provisioner_job_timings_job_id_fkey FOREIGN KEY (job_id) REFERENCES provisioner_jobs(id) ON DELETE CASCADE;
 
@@ -5427,6 +5940,15 @@ ALTER TABLE ONLY provisioner_jobs
 ALTER TABLE ONLY provisioner_keys
     ADD CONSTRAINT provisioner_keys_organization_id_fkey FOREIGN KEY (organization_id) REFERENCES organizations(id) ON DELETE CASCADE;
 
+ALTER TABLE ONLY purge_archive_records
+    ADD CONSTRAINT purge_archive_records_import_id_fkey FOREIGN KEY (import_id) REFERENCES purge_archive_imports(id) ON DELETE CASCADE;
+
+ALTER TABLE ONLY schedule_exception_calendar_dates
+    ADD CONSTRAINT schedule_exception_calendar_dates_calendar_id_fkey FOREIGN KEY (calendar_id) REFERENCES schedule_exception_calendars(id) ON DELETE CASCADE;
+
+ALTER TABLE ONLY schedule_exception_calendars
+    ADD CONSTRAINT schedule_exception_calendars_organization_id_fkey FOREIGN KEY (organization_id) REFERENCES organizations(id) ON DELETE CASCADE;
+
 ALTER TABLE ONLY tailnet_peers
     ADD CONSTRAINT tailnet_peers_coordinator_id_fkey FOREIGN KEY (coordinator_id) REFERENCES tailnet_coordinators(id) ON DELETE CASCADE;
 
@@ -5457,6 +5979,12 @@ ALTER TABLE ONLY tasks
 ALTER TABLE ONLY tasks
     ADD CONSTRAINT tasks_workspace_id_fkey FOREIGN KEY (workspace_id) REFERENCES workspaces(id) ON DELETE CASCADE;
 
+ALTER TABLE ONLY template_schedule_exception_calendars
+    ADD CONSTRAINT template_schedule_exception_calendars_calendar_id_fkey FOREIGN KEY (calendar_id) REFERENCES schedule_exception_calendars(id) ON DELETE CASCADE;
+
+ALTER TABLE ONLY template_schedule_exception_calendars
+    ADD CONSTRAINT template_schedule_exception_calendars_template_id_fkey FOREIGN KEY (template_id) REFERENCES templates(id) ON DELETE CASCADE;
+
 ALTER TABLE ONLY template_version_parameters
     ADD CONSTRAINT template_version_parameters_template_version_id_fkey FOREIGN KEY (template_version_id) REFERENCES template_versions(id) ON DELETE CASCADE;
 
@@ -5469,6 +5997,18 @@ ALTER TABLE ONLY template_version_preset_prebuild_schedules
 ALTER TABLE ONLY template_version_presets
     ADD CONSTRAINT template_version_presets_template_version_id_fkey FOREIGN KEY (template_version_id) REFERENCES template_versions(id) ON DELETE CASCADE;
 
+ALTER TABLE ONLY template_version_rollouts
+    ADD CONSTRAINT template_version_rollouts_created_by_fkey FOREIGN KEY (created_by) REFERENCES users(id) ON DELETE RESTRICT;
+
+ALTER TABLE ONLY template_version_rollouts
+    ADD CONSTRAINT template_version_rollouts_previous_template_version_id_fkey FOREIGN KEY (previous_template_version_id) REFERENCES template_versions(id) ON DELETE CASCADE;
+
+ALTER TABLE ONLY template_version_rollouts
+    ADD CONSTRAINT template_version_rollouts_template_id_fkey FOREIGN KEY (template_id) REFERENCES templates(id) ON DELETE CASCADE;
+
+ALTER TABLE ONLY template_version_rollouts
+    ADD CONSTRAINT template_version_rollouts_template_version_id_fkey FOREIGN KEY (template_version_id) REFERENCES template_versions(id) ON DELETE CASCADE;
+
 ALTER TABLE ONLY template_version_terraform_values
     ADD CONSTRAINT template_version_terraform_values_cached_module_files_fkey FOREIGN KEY (cached_module_files) REFERENCES files(id);
 
@@ -5526,6 +6066,9 @@ ALTER TABLE ONLY user_links
 ALTER TABLE ONLY user_links
     ADD CONSTRAINT user_links_user_id_fkey FOREIGN KEY (user_id) REFERENCES users(id) ON DELETE CASCADE;
 
+ALTER TABLE ONLY user_out_of_office_ranges
+    ADD CONSTRAINT user_out_of_office_ranges_user_id_fkey FOREIGN KEY (user_id) REFERENCES users(id) ON DELETE CASCADE;
+
 ALTER TABLE ONLY user_secrets
     ADD CONSTRAINT user_secrets_user_id_fkey FOREIGN KEY (user_id) REFERENCES users(id) ON DELETE CASCADE;
 
@@ -5556,6 +6099,9 @@ ALTER TABLE ONLY workspace_agent_devcontainers
 ALTER TABLE ONLY workspace_agent_log_sources
     ADD CONSTRAINT workspace_agent_log_sources_workspace_agent_id_fkey FOREIGN KEY (workspace_agent_id) REFERENCES workspace_agents(id) ON DELETE CASCADE;
 
+ALTER TABLE ONLY workspace_agent_log_tails
+    ADD CONSTRAINT workspace_agent_log_tails_workspace_agent_id_log_source_id_fkey FOREIGN KEY (workspace_agent_id, log_source_id) REFERENCES workspace_agent_log_sources(workspace_agent_id, id) ON DELETE CASCADE;
+
 ALTER TABLE ONLY workspace_agent_memory_resource_monitors
     ADD CONSTRAINT workspace_agent_memory_resource_monitors_agent_id_fkey FOREIGN KEY (agent_id) REFERENCES workspace_agents(id) ON DELETE CASCADE;
 
@@ -5565,6 +6111,21 @@ ALTER TABLE ONLY workspace_agent_metadata
 ALTER TABLE ONLY workspace_agent_port_share
     ADD CONSTRAINT workspace_agent_port_share_workspace_id_fkey FOREIGN KEY (workspace_id) REFERENCES workspaces(id) ON DELETE CASCADE;
 
+ALTER TABLE ONLY workspace_agent_pty_share_viewers
+    ADD CONSTRAINT workspace_agent_pty_share_viewers_share_id_fkey FOREIGN KEY (share_id) REFERENCES workspace_agent_pty_shares(id) ON DELETE CASCADE;
+
+ALTER TABLE ONLY workspace_agent_pty_share_viewers
+    ADD CONSTRAINT workspace_agent_pty_share_viewers_user_id_fkey FOREIGN KEY (user_id) REFERENCES users(id) ON DELETE CASCADE;
+
+ALTER TABLE ONLY workspace_agent_pty_shares
+    ADD CONSTRAINT workspace_agent_pty_shares_agent_id_fkey FOREIGN KEY (agent_id) REFERENCES workspace_agents(id) ON DELETE CASCADE;
+
+ALTER TABLE ONLY workspace_agent_pty_shares
+    ADD CONSTRAINT workspace_agent_pty_shares_created_by_fkey FOREIGN KEY (created_by) REFERENCES users(id) ON DELETE CASCADE;
+
+ALTER TABLE ONLY workspace_agent_resource_monitors
+    ADD CONSTRAINT workspace_agent_resource_monitors_agent_id_fkey FOREIGN KEY (agent_id) REFERENCES workspace_agents(id) ON DELETE CASCADE;
+
 ALTER TABLE ONLY workspace_agent_script_timings
     ADD CONSTRAINT workspace_agent_script_timings_script_id_fkey FOREIGN KEY (script_id) REFERENCES workspace_agent_scripts(id) ON DELETE CASCADE;
 
@@ -5637,6 +6198,27 @@ ALTER TABLE ONLY workspace_builds
 ALTER TABLE ONLY workspace_builds
     ADD CONSTRAINT workspace_builds_workspace_id_fkey FOREIGN KEY (workspace_id) REFERENCES workspaces(id) ON DELETE CASCADE;
 
+ALTER TABLE ONLY workspace_bulk_operation_workspaces
+    ADD CONSTRAINT workspace_bulk_operation_workspaces_build_id_fkey FOREIGN KEY (build_id) REFERENCES workspace_builds(id) ON DELETE SET NULL;
+
+ALTER TABLE ONLY workspace_bulk_operation_workspaces
+    ADD CONSTRAINT workspace_bulk_operation_workspaces_operation_id_fkey FOREIGN KEY (operation_id) REFERENCES workspace_bulk_operations(id) ON DELETE CASCADE;
+
+ALTER TABLE ONLY workspace_bulk_operation_workspaces
+    ADD CONSTRAINT workspace_bulk_operation_workspaces_workspace_id_fkey FOREIGN KEY (workspace_id) REFERENCES workspaces(id) ON DELETE CASCADE;
+
+ALTER TABLE ONLY workspace_bulk_operations
+    ADD CONSTRAINT workspace_bulk_operations_initiator_id_fkey FOREIGN KEY (initiator_id) REFERENCES users(id) ON DELETE CASCADE;
+
+ALTER TABLE ONLY workspace_drift_checks
+    ADD CONSTRAINT workspace_drift_checks_job_id_fkey FOREIGN KEY (job_id) REFERENCES provisioner_jobs(id) ON DELETE CASCADE;
+
+ALTER TABLE ONLY workspace_drift_checks
+    ADD CONSTRAINT workspace_drift_checks_workspace_build_id_fkey FOREIGN KEY (workspace_build_id) REFERENCES workspace_builds(id) ON DELETE CASCADE;
+
+ALTER TABLE ONLY workspace_drift_checks
+    ADD CONSTRAINT workspace_drift_checks_workspace_id_fkey FOREIGN KEY (workspace_id) REFERENCES workspaces(id) ON DELETE CASCADE;
+
 ALTER TABLE ONLY workspace_modules
     ADD CONSTRAINT workspace_modules_job_id_fkey FOREIGN KEY (job_id) REFERENCES provisioner_jobs(id) ON DELETE CASCADE;
 
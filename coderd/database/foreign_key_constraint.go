@@ -72,19 +72,26 @@ const (
 	ForeignKeyNotificationPreferencesUserID                       ForeignKeyConstraint = "notification_preferences_user_id_fkey"                           // ALTER TABLE ONLY notification_preferences ADD CONSTRAINT notification_preferences_user_id_fkey FOREIGN KEY (user_id) REFERENCES users(id) ON DELETE CASCADE;
 	ForeignKeyOauth2ProviderAppCodesAppID                         ForeignKeyConstraint = "oauth2_provider_app_codes_app_id_fkey"                           // ALTER TABLE ONLY oauth2_provider_app_codes ADD CONSTRAINT oauth2_provider_app_codes_app_id_fkey FOREIGN KEY (app_id) REFERENCES oauth2_provider_apps(id) ON DELETE CASCADE;
 	ForeignKeyOauth2ProviderAppCodesUserID                        ForeignKeyConstraint = "oauth2_provider_app_codes_user_id_fkey"                          // ALTER TABLE ONLY oauth2_provider_app_codes ADD CONSTRAINT oauth2_provider_app_codes_user_id_fkey FOREIGN KEY (user_id) REFERENCES users(id) ON DELETE CASCADE;
+	ForeignKeyOauth2ProviderAppDeviceCodesAppID                   ForeignKeyConstraint = "oauth2_provider_app_device_codes_app_id_fkey"                    // ALTER TABLE ONLY oauth2_provider_app_device_codes ADD CONSTRAINT oauth2_provider_app_device_codes_app_id_fkey FOREIGN KEY (app_id) REFERENCES oauth2_provider_apps(id) ON DELETE CASCADE;
+	ForeignKeyOauth2ProviderAppDeviceCodesUserID                  ForeignKeyConstraint = "oauth2_provider_app_device_codes_user_id_fkey"                   // ALTER TABLE ONLY oauth2_provider_app_device_codes ADD CONSTRAINT oauth2_provider_app_device_codes_user_id_fkey FOREIGN KEY (user_id) REFERENCES users(id) ON DELETE CASCADE;
 	ForeignKeyOauth2ProviderAppSecretsAppID                       ForeignKeyConstraint = "oauth2_provider_app_secrets_app_id_fkey"                         // ALTER TABLE ONLY oauth2_provider_app_secrets ADD CONSTRAINT oauth2_provider_app_secrets_app_id_fkey FOREIGN KEY (app_id) REFERENCES oauth2_provider_apps(id) ON DELETE CASCADE;
 	ForeignKeyOauth2ProviderAppTokensAPIKeyID                     ForeignKeyConstraint = "oauth2_provider_app_tokens_api_key_id_fkey"                      // ALTER TABLE ONLY oauth2_provider_app_tokens ADD CONSTRAINT oauth2_provider_app_tokens_api_key_id_fkey FOREIGN KEY (api_key_id) REFERENCES api_keys(id) ON DELETE CASCADE;
 	ForeignKeyOauth2ProviderAppTokensAppID                        ForeignKeyConstraint = "oauth2_provider_app_tokens_app_id_fkey"                          // ALTER TABLE ONLY oauth2_provider_app_tokens ADD CONSTRAINT oauth2_provider_app_tokens_app_id_fkey FOREIGN KEY (app_id) REFERENCES oauth2_provider_apps(id) ON DELETE CASCADE;
 	ForeignKeyOauth2ProviderAppTokensAppSecretID                  ForeignKeyConstraint = "oauth2_provider_app_tokens_app_secret_id_fkey"                   // ALTER TABLE ONLY oauth2_provider_app_tokens ADD CONSTRAINT oauth2_provider_app_tokens_app_secret_id_fkey FOREIGN KEY (app_secret_id) REFERENCES oauth2_provider_app_secrets(id) ON DELETE CASCADE;
+	ForeignKeyOauth2ProviderAppsClientCredentialsUserID           ForeignKeyConstraint = "oauth2_provider_apps_client_credentials_user_id_fkey"            // ALTER TABLE ONLY oauth2_provider_apps ADD CONSTRAINT oauth2_provider_apps_client_credentials_user_id_fkey FOREIGN KEY (client_credentials_user_id) REFERENCES users(id) ON DELETE SET NULL;
 	ForeignKeyOrganizationMembersOrganizationIDUUID               ForeignKeyConstraint = "organization_members_organization_id_uuid_fkey"                  // ALTER TABLE ONLY organization_members ADD CONSTRAINT organization_members_organization_id_uuid_fkey FOREIGN KEY (organization_id) REFERENCES organizations(id) ON DELETE CASCADE;
 	ForeignKeyOrganizationMembersUserIDUUID                       ForeignKeyConstraint = "organization_members_user_id_uuid_fkey"                          // ALTER TABLE ONLY organization_members ADD CONSTRAINT organization_members_user_id_uuid_fkey FOREIGN KEY (user_id) REFERENCES users(id) ON DELETE CASCADE;
 	ForeignKeyParameterSchemasJobID                               ForeignKeyConstraint = "parameter_schemas_job_id_fkey"                                   // ALTER TABLE ONLY parameter_schemas ADD CONSTRAINT parameter_schemas_job_id_fkey FOREIGN KEY (job_id) REFERENCES provisioner_jobs(id) ON DELETE CASCADE;
 	ForeignKeyProvisionerDaemonsKeyID                             ForeignKeyConstraint = "provisioner_daemons_key_id_fkey"                                 // ALTER TABLE ONLY provisioner_daemons ADD CONSTRAINT provisioner_daemons_key_id_fkey FOREIGN KEY (key_id) REFERENCES provisioner_keys(id) ON DELETE CASCADE;
 	ForeignKeyProvisionerDaemonsOrganizationID                    ForeignKeyConstraint = "provisioner_daemons_organization_id_fkey"                        // ALTER TABLE ONLY provisioner_daemons ADD CONSTRAINT provisioner_daemons_organization_id_fkey FOREIGN KEY (organization_id) REFERENCES organizations(id) ON DELETE CASCADE;
 	ForeignKeyProvisionerJobLogsJobID                             ForeignKeyConstraint = "provisioner_job_logs_job_id_fkey"                                // ALTER TABLE ONLY provisioner_job_logs ADD CONSTRAINT provisioner_job_logs_job_id_fkey FOREIGN KEY (job_id) REFERENCES provisioner_jobs(id) ON DELETE CASCADE;
+	ForeignKeyProvisionerJobPrioritiesOrganizationID              ForeignKeyConstraint = "provisioner_job_priorities_organization_id_fkey"                 // ALTER TABLE ONLY provisioner_job_priorities ADD CONSTRAINT provisioner_job_priorities_organization_id_fkey FOREIGN KEY (organization_id) REFERENCES organizations(id) ON DELETE CASCADE;
 	ForeignKeyProvisionerJobTimingsJobID                          ForeignKeyConstraint = "provisioner_job_timings_job_id_fkey"                             // ALTER TABLE ONLY provisioner_job_timings ADD CONSTRAINT provisioner_job_timings_job_id_fkey FOREIGN KEY (job_id) REFERENCES provisioner_jobs(id) ON DELETE CASCADE;
 	ForeignKeyProvisionerJobsOrganizationID                       ForeignKeyConstraint = "provisioner_jobs_organization_id_fkey"                           // ALTER TABLE ONLY provisioner_jobs ADD CONSTRAINT provisioner_jobs_organization_id_fkey FOREIGN KEY (organization_id) REFERENCES organizations(id) ON DELETE CASCADE;
 	ForeignKeyProvisionerKeysOrganizationID                       ForeignKeyConstraint = "provisioner_keys_organization_id_fkey"                           // ALTER TABLE ONLY provisioner_keys ADD CONSTRAINT provisioner_keys_organization_id_fkey FOREIGN KEY (organization_id) REFERENCES organizations(id) ON DELETE CASCADE;
+	ForeignKeyPurgeArchiveRecordsImportID                         ForeignKeyConstraint = "purge_archive_records_import_id_fkey"                            // ALTER TABLE ONLY purge_archive_records ADD CONSTRAINT purge_archive_records_import_id_fkey FOREIGN KEY (import_id) REFERENCES purge_archive_imports(id) ON DELETE CASCADE;
+	ForeignKeyScheduleExceptionCalendarDatesCalendarID            ForeignKeyConstraint = "schedule_exception_calendar_dates_calendar_id_fkey"              // ALTER TABLE ONLY schedule_exception_calendar_dates ADD CONSTRAINT schedule_exception_calendar_dates_calendar_id_fkey FOREIGN KEY (calendar_id) REFERENCES schedule_exception_calendars(id) ON DELETE CASCADE;
+	ForeignKeyScheduleExceptionCalendarsOrganizationID            ForeignKeyConstraint = "schedule_exception_calendars_organization_id_fkey"               // ALTER TABLE ONLY schedule_exception_calendars ADD CONSTRAINT schedule_exception_calendars_organization_id_fkey FOREIGN KEY (organization_id) REFERENCES organizations(id) ON DELETE CASCADE;
 	ForeignKeyTailnetPeersCoordinatorID                           ForeignKeyConstraint = "tailnet_peers_coordinator_id_fkey"                               // ALTER TABLE ONLY tailnet_peers ADD CONSTRAINT tailnet_peers_coordinator_id_fkey FOREIGN KEY (coordinator_id) REFERENCES tailnet_coordinators(id) ON DELETE CASCADE;
 	ForeignKeyTailnetTunnelsCoordinatorID                         ForeignKeyConstraint = "tailnet_tunnels_coordinator_id_fkey"                             // ALTER TABLE ONLY tailnet_tunnels ADD CONSTRAINT tailnet_tunnels_coordinator_id_fkey FOREIGN KEY (coordinator_id) REFERENCES tailnet_coordinators(id) ON DELETE CASCADE;
 	ForeignKeyTaskSnapshotsTaskID                                 ForeignKeyConstraint = "task_snapshots_task_id_fkey"                                     // ALTER TABLE ONLY task_snapshots ADD CONSTRAINT task_snapshots_task_id_fkey FOREIGN KEY (task_id) REFERENCES tasks(id) ON DELETE CASCADE;
@@ -95,10 +102,16 @@ const (
 	ForeignKeyTasksOwnerID                                        ForeignKeyConstraint = "tasks_owner_id_fkey"                                             // ALTER TABLE ONLY tasks ADD CONSTRAINT tasks_owner_id_fkey FOREIGN KEY (owner_id) REFERENCES users(id) ON DELETE CASCADE;
 	ForeignKeyTasksTemplateVersionID                              ForeignKeyConstraint = "tasks_template_version_id_fkey"                                  // ALTER TABLE ONLY tasks ADD CONSTRAINT tasks_template_version_id_fkey FOREIGN KEY (template_version_id) REFERENCES template_versions(id) ON DELETE CASCADE;
 	ForeignKeyTasksWorkspaceID                                    ForeignKeyConstraint = "tasks_workspace_id_fkey"                                         // ALTER TABLE ONLY tasks ADD CONSTRAINT tasks_workspace_id_fkey FOREIGN KEY (workspace_id) REFERENCES workspaces(id) ON DELETE CASCADE;
+	ForeignKeyTemplateScheduleExceptionCalendarsCalendarID        ForeignKeyConstraint = "template_schedule_exception_calendars_calendar_id_fkey"          // ALTER TABLE ONLY template_schedule_exception_calendars ADD CONSTRAINT template_schedule_exception_calendars_calendar_id_fkey FOREIGN KEY (calendar_id) REFERENCES schedule_exception_calendars(id) ON DELETE CASCADE;
+	ForeignKeyTemplateScheduleExceptionCalendarsTemplateID        ForeignKeyConstraint = "template_schedule_exception_calendars_template_id_fkey"          // ALTER TABLE ONLY template_schedule_exception_calendars ADD CONSTRAINT template_schedule_exception_calendars_template_id_fkey FOREIGN KEY (template_id) REFERENCES templates(id) ON DELETE CASCADE;
 	ForeignKeyTemplateVersionParametersTemplateVersionID          ForeignKeyConstraint = "template_version_parameters_template_version_id_fkey"            // ALTER TABLE ONLY template_version_parameters ADD CONSTRAINT template_version_parameters_template_version_id_fkey FOREIGN KEY (template_version_id) REFERENCES template_versions(id) ON DELETE CASCADE;
 	ForeignKeyTemplateVersionPresetParametTemplateVersionPresetID ForeignKeyConstraint = "template_version_preset_paramet_template_version_preset_id_fkey" // ALTER TABLE ONLY template_version_preset_parameters ADD CONSTRAINT template_version_preset_paramet_template_version_preset_id_fkey FOREIGN KEY (template_version_preset_id) REFERENCES template_version_presets(id) ON DELETE CASCADE;
 	ForeignKeyTemplateVersionPresetPrebuildSchedulesPresetID      ForeignKeyConstraint = "template_version_preset_prebuild_schedules_preset_id_fkey"       // ALTER TABLE ONLY template_version_preset_prebuild_schedules ADD CONSTRAINT template_version_preset_prebuild_schedules_preset_id_fkey FOREIGN KEY (preset_id) REFERENCES template_version_presets(id) ON DELETE CASCADE;
 	ForeignKeyTemplateVersionPresetsTemplateVersionID             ForeignKeyConstraint = "template_version_presets_template_version_id_fkey"               // ALTER TABLE ONLY template_version_presets ADD CONSTRAINT template_version_presets_template_version_id_fkey FOREIGN KEY (template_version_id) REFERENCES template_versions(id) ON DELETE CASCADE;
+	ForeignKeyTemplateVersionRolloutsCreatedBy                    ForeignKeyConstraint = "template_version_rollouts_created_by_fkey"                       // ALTER TABLE ONLY template_version_rollouts ADD CONSTRAINT template_version_rollouts_created_by_fkey FOREIGN KEY (created_by) REFERENCES users(id) ON DELETE RESTRICT;
+	ForeignKeyTemplateVersionRolloutsPreviousTemplateVersionID    ForeignKeyConstraint = "template_version_rollouts_previous_template_version_id_fkey"     // ALTER TABLE ONLY template_version_rollouts ADD CONSTRAINT template_version_rollouts_previous_template_version_id_fkey FOREIGN KEY (previous_template_version_id) REFERENCES template_versions(id) ON DELETE CASCADE;
+	ForeignKeyTemplateVersionRolloutsTemplateID                   ForeignKeyConstraint = "template_version_rollouts_template_id_fkey"                      // ALTER TABLE ONLY template_version_rollouts ADD CONSTRAINT template_version_rollouts_template_id_fkey FOREIGN KEY (template_id) REFERENCES templates(id) ON DELETE CASCADE;
+	ForeignKeyTemplateVersionRolloutsTemplateVersionID            ForeignKeyConstraint = "template_version_rollouts_template_version_id_fkey"              // ALTER TABLE ONLY template_version_rollouts ADD CONSTRAINT template_version_rollouts_template_version_id_fkey FOREIGN KEY (template_version_id) REFERENCES template_versions(id) ON DELETE CASCADE;
 	ForeignKeyTemplateVersionTerraformValuesCachedModuleFiles     ForeignKeyConstraint = "template_version_terraform_values_cached_module_files_fkey"      // ALTER TABLE ONLY template_version_terraform_values ADD CONSTRAINT template_version_terraform_values_cached_module_files_fkey FOREIGN KEY (cached_module_files) REFERENCES files(id);
 	ForeignKeyTemplateVersionTerraformValuesTemplateVersionID     ForeignKeyConstraint = "template_version_terraform_values_template_version_id_fkey"      // ALTER TABLE ONLY template_version_terraform_values ADD CONSTRAINT template_version_terraform_values_template_version_id_fkey FOREIGN KEY (template_version_id) REFERENCES template_versions(id) ON DELETE CASCADE;
 	ForeignKeyTemplateVersionVariablesTemplateVersionID           ForeignKeyConstraint = "template_version_variables_template_version_id_fkey"             // ALTER TABLE ONLY template_version_variables ADD CONSTRAINT template_version_variables_template_version_id_fkey FOREIGN KEY (template_version_id) REFERENCES template_versions(id) ON DELETE CASCADE;
@@ -118,6 +131,7 @@ const (
 	ForeignKeyUserLinksOauthAccessTokenKeyID                      ForeignKeyConstraint = "user_links_oauth_access_token_key_id_fkey"                       // ALTER TABLE ONLY user_links ADD CONSTRAINT user_links_oauth_access_token_key_id_fkey FOREIGN KEY (oauth_access_token_key_id) REFERENCES dbcrypt_keys(active_key_digest);
 	ForeignKeyUserLinksOauthRefreshTokenKeyID                     ForeignKeyConstraint = "user_links_oauth_refresh_token_key_id_fkey"                      // ALTER TABLE ONLY user_links ADD CONSTRAINT user_links_oauth_refresh_token_key_id_fkey FOREIGN KEY (oauth_refresh_token_key_id) REFERENCES dbcrypt_keys(active_key_digest);
 	ForeignKeyUserLinksUserID                                     ForeignKeyConstraint = "user_links_user_id_fkey"                                         // ALTER TABLE ONLY user_links ADD CONSTRAINT user_links_user_id_fkey FOREIGN KEY (user_id) REFERENCES users(id) ON DELETE CASCADE;
+	ForeignKeyUserOutOfOfficeRangesUserID                         ForeignKeyConstraint = "user_out_of_office_ranges_user_id_fkey"                          // ALTER TABLE ONLY user_out_of_office_ranges ADD CONSTRAINT user_out_of_office_ranges_user_id_fkey FOREIGN KEY (user_id) REFERENCES users(id) ON DELETE CASCADE;
 	ForeignKeyUserSecretsUserID                                   ForeignKeyConstraint = "user_secrets_user_id_fkey"                                       // ALTER TABLE ONLY user_secrets ADD CONSTRAINT user_secrets_user_id_fkey FOREIGN KEY (user_id) REFERENCES users(id) ON DELETE CASCADE;
 	ForeignKeyUserSecretsValueKeyID                               ForeignKeyConstraint = "user_secrets_value_key_id_fkey"                                  // ALTER TABLE ONLY user_secrets ADD CONSTRAINT user_secrets_value_key_id_fkey FOREIGN KEY (value_key_id) REFERENCES dbcrypt_keys(active_key_digest);
 	ForeignKeyUserSkillsUserID                                    ForeignKeyConstraint = "user_skills_user_id_fkey"                                        // ALTER TABLE ONLY user_skills ADD CONSTRAINT user_skills_user_id_fkey FOREIGN KEY (user_id) REFERENCES users(id) ON DELETE CASCADE;
@@ -128,9 +142,15 @@ const (
 	ForeignKeyWorkspaceAgentDevcontainersSubagentID               ForeignKeyConstraint = "workspace_agent_devcontainers_subagent_id_fkey"                  // ALTER TABLE ONLY workspace_agent_devcontainers ADD CONSTRAINT workspace_agent_devcontainers_subagent_id_fkey FOREIGN KEY (subagent_id) REFERENCES workspace_agents(id) ON DELETE CASCADE;
 	ForeignKeyWorkspaceAgentDevcontainersWorkspaceAgentID         ForeignKeyConstraint = "workspace_agent_devcontainers_workspace_agent_id_fkey"           // ALTER TABLE ONLY workspace_agent_devcontainers ADD CONSTRAINT workspace_agent_devcontainers_workspace_agent_id_fkey FOREIGN KEY (workspace_agent_id) REFERENCES workspace_agents(id) ON DELETE CASCADE;
 	ForeignKeyWorkspaceAgentLogSourcesWorkspaceAgentID            ForeignKeyConstraint = "workspace_agent_log_sources_workspace_agent_id_fkey"             // ALTER TABLE ONLY workspace_agent_log_sources ADD CONSTRAINT workspace_agent_log_sources_workspace_agent_id_fkey FOREIGN KEY (workspace_agent_id) REFERENCES workspace_agents(id) ON DELETE CASCADE;
+	ForeignKeyWorkspaceAgentLogTailsWorkspaceAgentIDLogSourceID   ForeignKeyConstraint = "workspace_agent_log_tails_workspace_agent_id_log_source_id_fkey" // ALTER TABLE ONLY workspace_agent_log_tails ADD CONSTRAINT workspace_agent_log_tails_workspace_agent_id_log_source_id_fkey FOREIGN KEY (workspace_agent_id, log_source_id) REFERENCES workspace_agent_log_sources(workspace_agent_id, id) ON DELETE CASCADE;
 	ForeignKeyWorkspaceAgentMemoryResourceMonitorsAgentID         ForeignKeyConstraint = "workspace_agent_memory_resource_monitors_agent_id_fkey"          // ALTER TABLE ONLY workspace_agent_memory_resource_monitors ADD CONSTRAINT workspace_agent_memory_resource_monitors_agent_id_fkey FOREIGN KEY (agent_id) REFERENCES workspace_agents(id) ON DELETE CASCADE;
 	ForeignKeyWorkspaceAgentMetadataWorkspaceAgentID              ForeignKeyConstraint = "workspace_agent_metadata_workspace_agent_id_fkey"                // ALTER TABLE ONLY workspace_agent_metadata ADD CONSTRAINT workspace_agent_metadata_workspace_agent_id_fkey FOREIGN KEY (workspace_agent_id) REFERENCES workspace_agents(id) ON DELETE CASCADE;
 	ForeignKeyWorkspaceAgentPortShareWorkspaceID                  ForeignKeyConstraint = "workspace_agent_port_share_workspace_id_fkey"                    // ALTER TABLE ONLY workspace_agent_port_share ADD CONSTRAINT workspace_agent_port_share_workspace_id_fkey FOREIGN KEY (workspace_id) REFERENCES workspaces(id) ON DELETE CASCADE;
+	ForeignKeyWorkspaceAgentPtyShareViewersShareID                ForeignKeyConstraint = "workspace_agent_pty_share_viewers_share_id_fkey"                 // ALTER TABLE ONLY workspace_agent_pty_share_viewers ADD CONSTRAINT workspace_agent_pty_share_viewers_share_id_fkey FOREIGN KEY (share_id) REFERENCES workspace_agent_pty_shares(id) ON DELETE CASCADE;
+	ForeignKeyWorkspaceAgentPtyShareViewersUserID                 ForeignKeyConstraint = "workspace_agent_pty_share_viewers_user_id_fkey"                  // ALTER TABLE ONLY workspace_agent_pty_share_viewers ADD CONSTRAINT workspace_agent_pty_share_viewers_user_id_fkey FOREIGN KEY (user_id) REFERENCES users(id) ON DELETE CASCADE;
+	ForeignKeyWorkspaceAgentPtySharesAgentID                      ForeignKeyConstraint = "workspace_agent_pty_shares_agent_id_fkey"                        // ALTER TABLE ONLY workspace_agent_pty_shares ADD CONSTRAINT workspace_agent_pty_shares_agent_id_fkey FOREIGN KEY (agent_id) REFERENCES workspace_agents(id) ON DELETE CASCADE;
+	ForeignKeyWorkspaceAgentPtySharesCreatedBy                    ForeignKeyConstraint = "workspace_agent_pty_shares_created_by_fkey"                      // ALTER TABLE ONLY workspace_agent_pty_shares ADD CONSTRAINT workspace_agent_pty_shares_created_by_fkey FOREIGN KEY (created_by) REFERENCES users(id) ON DELETE CASCADE;
+	ForeignKeyWorkspaceAgentResourceMonitorsAgentID               ForeignKeyConstraint = "workspace_agent_resource_monitors_agent_id_fkey"                 // ALTER TABLE ONLY workspace_agent_resource_monitors ADD CONSTRAINT workspace_agent_resource_monitors_agent_id_fkey FOREIGN KEY (agent_id) REFERENCES workspace_agents(id) ON DELETE CASCADE;
 	ForeignKeyWorkspaceAgentScriptTimingsScriptID                 ForeignKeyConstraint = "workspace_agent_script_timings_script_id_fkey"                   // ALTER TABLE ONLY workspace_agent_script_timings ADD CONSTRAINT workspace_agent_script_timings_script_id_fkey FOREIGN KEY (script_id) REFERENCES workspace_agent_scripts(id) ON DELETE CASCADE;
 	ForeignKeyWorkspaceAgentScriptsWorkspaceAgentID               ForeignKeyConstraint = "workspace_agent_scripts_workspace_agent_id_fkey"                 // ALTER TABLE ONLY workspace_agent_scripts ADD CONSTRAINT workspace_agent_scripts_workspace_agent_id_fkey FOREIGN KEY (workspace_agent_id) REFERENCES workspace_agents(id) ON DELETE CASCADE;
 	ForeignKeyWorkspaceAgentStartupLogsAgentID                    ForeignKeyConstraint = "workspace_agent_startup_logs_agent_id_fkey"                      // ALTER TABLE ONLY workspace_agent_logs ADD CONSTRAINT workspace_agent_startup_logs_agent_id_fkey FOREIGN KEY (agent_id) REFERENCES workspace_agents(id) ON DELETE CASCADE;
@@ -155,6 +175,13 @@ const (
 	ForeignKeyWorkspaceBuildsTemplateVersionID                    ForeignKeyConstraint = "workspace_builds_template_version_id_fkey"                       // ALTER TABLE ONLY workspace_builds ADD CONSTRAINT workspace_builds_template_version_id_fkey FOREIGN KEY (template_version_id) REFERENCES template_versions(id) ON DELETE CASCADE;
 	ForeignKeyWorkspaceBuildsTemplateVersionPresetID              ForeignKeyConstraint = "workspace_builds_template_version_preset_id_fkey"                // ALTER TABLE ONLY workspace_builds ADD CONSTRAINT workspace_builds_template_version_preset_id_fkey FOREIGN KEY (template_version_preset_id) REFERENCES template_version_presets(id) ON DELETE SET NULL;
 	ForeignKeyWorkspaceBuildsWorkspaceID                          ForeignKeyConstraint = "workspace_builds_workspace_id_fkey"                              // ALTER TABLE ONLY workspace_builds ADD CONSTRAINT workspace_builds_workspace_id_fkey FOREIGN KEY (workspace_id) REFERENCES workspaces(id) ON DELETE CASCADE;
+	ForeignKeyWorkspaceBulkOperationWorkspacesBuildID             ForeignKeyConstraint = "workspace_bulk_operation_workspaces_build_id_fkey"               // ALTER TABLE ONLY workspace_bulk_operation_workspaces ADD CONSTRAINT workspace_bulk_operation_workspaces_build_id_fkey FOREIGN KEY (build_id) REFERENCES workspace_builds(id) ON DELETE SET NULL;
+	ForeignKeyWorkspaceBulkOperationWorkspacesOperationID         ForeignKeyConstraint = "workspace_bulk_operation_workspaces_operation_id_fkey"           // ALTER TABLE ONLY workspace_bulk_operation_workspaces ADD CONSTRAINT workspace_bulk_operation_workspaces_operation_id_fkey FOREIGN KEY (operation_id) REFERENCES workspace_bulk_operations(id) ON DELETE CASCADE;
+	ForeignKeyWorkspaceBulkOperationWorkspacesWorkspaceID         ForeignKeyConstraint = "workspace_bulk_operation_workspaces_workspace_id_fkey"           // ALTER TABLE ONLY workspace_bulk_operation_workspaces ADD CONSTRAINT workspace_bulk_operation_workspaces_workspace_id_fkey FOREIGN KEY (workspace_id) REFERENCES workspaces(id) ON DELETE CASCADE;
+	ForeignKeyWorkspaceBulkOperationsInitiatorID                  ForeignKeyConstraint = "workspace_bulk_operations_initiator_id_fkey"                     // ALTER TABLE ONLY workspace_bulk_operations ADD CONSTRAINT workspace_bulk_operations_initiator_id_fkey FOREIGN KEY (initiator_id) REFERENCES users(id) ON DELETE CASCADE;
+	ForeignKeyWorkspaceDriftChecksJobID                           ForeignKeyConstraint = "workspace_drift_checks_job_id_fkey"                              // ALTER TABLE ONLY workspace_drift_checks ADD CONSTRAINT workspace_drift_checks_job_id_fkey FOREIGN KEY (job_id) REFERENCES provisioner_jobs(id) ON DELETE CASCADE;
+	ForeignKeyWorkspaceDriftChecksWorkspaceBuildID                ForeignKeyConstraint = "workspace_drift_checks_workspace_build_id_fkey"                  // ALTER TABLE ONLY workspace_drift_checks ADD CONSTRAINT workspace_drift_checks_workspace_build_id_fkey FOREIGN KEY (workspace_build_id) REFERENCES workspace_builds(id) ON DELETE CASCADE;
+	ForeignKeyWorkspaceDriftChecksWorkspaceID                     ForeignKeyConstraint = "workspace_drift_checks_workspace_id_fkey"                        // ALTER TABLE ONLY workspace_drift_checks ADD CONSTRAINT workspace_drift_checks_workspace_id_fkey FOREIGN KEY (workspace_id) REFERENCES workspaces(id) ON DELETE CASCADE;
 	ForeignKeyWorkspaceModulesJobID                               ForeignKeyConstraint = "workspace_modules_job_id_fkey"                                   // ALTER TABLE ONLY workspace_modules ADD CONSTRAINT workspace_modules_job_id_fkey FOREIGN KEY (job_id) REFERENCES provisioner_jobs(id) ON DELETE CASCADE;
 	ForeignKeyWorkspaceResourceMetadataWorkspaceResourceID        ForeignKeyConstraint = "workspace_resource_metadata_workspace_resource_id_fkey"          // ALTER TABLE ONLY workspace_resource_metadata ADD CONSTRAINT workspace_resource_metadata_workspace_resource_id_fkey FOREIGN KEY (workspace_resource_id) REFERENCES workspace_resources(id) ON DELETE CASCADE;
 	ForeignKeyWorkspaceResourcesJobID                             ForeignKeyConstraint = "workspace_resources_job_id_fkey"                                 // ALTER TABLE ONLY workspace_resources ADD CONSTRAINT workspace_resources_job_id_fkey FOREIGN KEY (job_id) REFERENCES provisioner_jobs(id) ON DELETE CASCADE;
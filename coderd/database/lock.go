@@ -18,6 +18,9 @@ const (
 	LockIDAIProvidersEnvSeed
 	LockIDChatModelConfigWrites
 	LockIDChatCapacityAdmission
+	LockIDWorkspaceDriftChecks
+	LockIDTemplateVersionRollouts
+	LockIDWorkspaceBulkOperations
 )
 
 // Per-setting advisory lock IDs for the chat instruction settings. These
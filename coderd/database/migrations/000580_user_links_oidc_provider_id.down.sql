@@ -0,0 +1,2 @@
+ALTER TABLE user_links
+	DROP COLUMN IF EXISTS oidc_provider_id;
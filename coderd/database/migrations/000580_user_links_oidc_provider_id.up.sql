@@ -0,0 +1,4 @@
+ALTER TABLE user_links
+	ADD COLUMN oidc_provider_id text DEFAULT ''::text NOT NULL;
+
+COMMENT ON COLUMN user_links.oidc_provider_id IS 'The ID of the named OIDC provider the user is linked to. Empty for the deployment''s primary OIDC provider and for non-OIDC login types.';
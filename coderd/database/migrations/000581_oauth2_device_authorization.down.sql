@@ -0,0 +1,5 @@
+ALTER TABLE oauth2_provider_apps DROP COLUMN IF EXISTS client_credentials_user_id;
+
+DROP TABLE IF EXISTS oauth2_provider_app_device_codes;
+
+DROP TYPE IF EXISTS oauth2_device_code_status;
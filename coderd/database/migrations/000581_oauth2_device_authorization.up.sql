@@ -0,0 +1,44 @@
+-- RFC 8628 device authorization grant. A device code is created pending
+-- when a client starts the flow, and a user approves or denies it from the
+-- verification page using the short user code. The client then exchanges
+-- the device code for tokens at the token endpoint.
+CREATE TYPE oauth2_device_code_status AS ENUM ('pending', 'approved', 'denied');
+
+CREATE TABLE oauth2_provider_app_device_codes (
+    id uuid NOT NULL PRIMARY KEY,
+    created_at timestamp with time zone NOT NULL,
+    expires_at timestamp with time zone NOT NULL,
+    device_code_prefix bytea NOT NULL UNIQUE,
+    device_code_hash bytea NOT NULL,
+    user_code text NOT NULL UNIQUE,
+    app_id uuid NOT NULL REFERENCES oauth2_provider_apps(id) ON DELETE CASCADE,
+    user_id uuid REFERENCES users(id) ON DELETE CASCADE,
+    status oauth2_device_code_status NOT NULL DEFAULT 'pending',
+    resource_uri text,
+    scope text NOT NULL,
+    polling_interval integer NOT NULL,
+    last_polled_at timestamp with time zone,
+    CONSTRAINT oauth2_provider_app_device_codes_scope_not_empty CHECK ((scope <> ''::text)),
+    CONSTRAINT oauth2_provider_app_device_codes_approved_has_user CHECK (((status <> 'approved'::oauth2_device_code_status) OR (user_id IS NOT NULL)))
+);
+
+COMMENT ON TABLE oauth2_provider_app_device_codes IS 'RFC 8628 device codes, exchanged for access tokens once a user approves them.';
+
+COMMENT ON COLUMN oauth2_provider_app_device_codes.user_code IS 'Short code the user enters on the verification page. Stored normalized: upper case without separators.';
+
+COMMENT ON COLUMN oauth2_provider_app_device_codes.user_id IS 'The user that approved or denied the code. NULL while the code is pending.';
+
+COMMENT ON COLUMN oauth2_provider_app_device_codes.resource_uri IS 'RFC 8707 resource parameter for audience restriction';
+
+COMMENT ON COLUMN oauth2_provider_app_device_codes.polling_interval IS 'Minimum seconds between token requests. Raised each time the client polls too fast (RFC 8628 §3.5 slow_down).';
+
+COMMENT ON COLUMN oauth2_provider_app_device_codes.last_polled_at IS 'When the client last polled the token endpoint with this code.';
+
+CREATE INDEX oauth2_provider_app_device_codes_expires_at_idx ON oauth2_provider_app_device_codes USING btree (expires_at);
+
+-- The client_credentials grant has no end user, so tokens are issued for a
+-- service account chosen by an administrator.
+ALTER TABLE oauth2_provider_apps
+    ADD COLUMN client_credentials_user_id uuid REFERENCES users(id) ON DELETE SET NULL;
+
+COMMENT ON COLUMN oauth2_provider_apps.client_credentials_user_id IS 'Service account that tokens from the client_credentials grant are issued for. NULL disables the grant for this app.';
@@ -0,0 +1,2 @@
+-- The migration is about an enum value change
+-- As we can not remove a value from an enum, we can let the down migration empty
@@ -0,0 +1,4 @@
+-- As we can not remove a value from an enum, the down migration is left empty.
+-- In order to avoid any failure, we use ADD VALUE IF NOT EXISTS to add the values.
+ALTER TYPE notification_method ADD VALUE IF NOT EXISTS 'slack';
+ALTER TYPE notification_method ADD VALUE IF NOT EXISTS 'msteams';
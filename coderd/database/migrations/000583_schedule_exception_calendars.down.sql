@@ -0,0 +1,7 @@
+DROP TABLE IF EXISTS user_out_of_office_ranges;
+
+DROP TABLE IF EXISTS template_schedule_exception_calendars;
+
+DROP TABLE IF EXISTS schedule_exception_calendar_dates;
+
+DROP TABLE IF EXISTS schedule_exception_calendars;
@@ -0,0 +1,50 @@
+-- Exception calendars list dates on which workspaces should not autostart,
+-- such as public holidays or company shutdowns. They are managed per
+-- organization and attached to templates.
+CREATE TABLE schedule_exception_calendars (
+    id uuid NOT NULL PRIMARY KEY,
+    organization_id uuid NOT NULL REFERENCES organizations(id) ON DELETE CASCADE,
+    name text NOT NULL,
+    description text NOT NULL DEFAULT '',
+    created_at timestamp with time zone NOT NULL,
+    updated_at timestamp with time zone NOT NULL,
+    CONSTRAINT schedule_exception_calendars_organization_id_name_key UNIQUE (organization_id, name)
+);
+
+COMMENT ON TABLE schedule_exception_calendars IS 'Calendars of dates on which workspaces of the templates they are attached to do not autostart.';
+
+CREATE TABLE schedule_exception_calendar_dates (
+    calendar_id uuid NOT NULL REFERENCES schedule_exception_calendars(id) ON DELETE CASCADE,
+    start_date date NOT NULL,
+    end_date date NOT NULL,
+    summary text NOT NULL DEFAULT '',
+    CONSTRAINT schedule_exception_calendar_dates_end_after_start CHECK ((end_date >= start_date))
+);
+
+COMMENT ON COLUMN schedule_exception_calendar_dates.start_date IS 'First date of the exception, inclusive. Dates are interpreted in the timezone of each workspace''s autostart schedule.';
+
+COMMENT ON COLUMN schedule_exception_calendar_dates.end_date IS 'Last date of the exception, inclusive.';
+
+CREATE INDEX schedule_exception_calendar_dates_calendar_id_end_date_idx ON schedule_exception_calendar_dates USING btree (calendar_id, end_date);
+
+CREATE TABLE template_schedule_exception_calendars (
+    template_id uuid NOT NULL REFERENCES templates(id) ON DELETE CASCADE,
+    calendar_id uuid NOT NULL REFERENCES schedule_exception_calendars(id) ON DELETE CASCADE,
+    PRIMARY KEY (template_id, calendar_id)
+);
+
+-- Out-of-office ranges are personal exceptions that apply to all of a user's
+-- workspaces.
+CREATE TABLE user_out_of_office_ranges (
+    id uuid NOT NULL PRIMARY KEY,
+    user_id uuid NOT NULL REFERENCES users(id) ON DELETE CASCADE,
+    start_date date NOT NULL,
+    end_date date NOT NULL,
+    reason text NOT NULL DEFAULT '',
+    created_at timestamp with time zone NOT NULL,
+    CONSTRAINT user_out_of_office_ranges_end_after_start CHECK ((end_date >= start_date))
+);
+
+COMMENT ON TABLE user_out_of_office_ranges IS 'Dates on which a user''s workspaces do not autostart.';
+
+CREATE INDEX user_out_of_office_ranges_user_id_end_date_idx ON user_out_of_office_ranges USING btree (user_id, end_date);
@@ -0,0 +1,6 @@
+DROP VIEW IF EXISTS archived_aibridge_interceptions;
+DROP VIEW IF EXISTS archived_workspace_agent_logs;
+DROP VIEW IF EXISTS archived_connection_logs;
+DROP VIEW IF EXISTS archived_audit_logs;
+DROP TABLE IF EXISTS purge_archive_records;
+DROP TABLE IF EXISTS purge_archive_imports;
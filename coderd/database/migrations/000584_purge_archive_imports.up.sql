@@ -0,0 +1,117 @@
+-- Records purged by their retention settings can be archived by dbpurge, then
+-- re-imported with "coder server import-archive" to be queried through the
+-- archived_* views.
+CREATE TABLE purge_archive_imports (
+    id uuid NOT NULL PRIMARY KEY,
+    name text NOT NULL,
+    record_type text NOT NULL,
+    row_count bigint NOT NULL,
+    imported_at timestamp with time zone NOT NULL,
+    CONSTRAINT purge_archive_imports_name_key UNIQUE (name),
+    CONSTRAINT purge_archive_imports_record_type_check CHECK ((record_type = ANY (ARRAY['audit_logs'::text, 'connection_logs'::text, 'workspace_agent_logs'::text, 'aibridge_interceptions'::text])))
+);
+
+COMMENT ON TABLE purge_archive_imports IS 'Archives of purged records that have been re-imported into the database.';
+
+COMMENT ON COLUMN purge_archive_imports.name IS 'Name of the archive in the archive sink.';
+
+CREATE TABLE purge_archive_records (
+    import_id uuid NOT NULL REFERENCES purge_archive_imports(id) ON DELETE CASCADE,
+    record jsonb NOT NULL
+);
+
+COMMENT ON TABLE purge_archive_records IS 'Rows of imported archives, as exported by to_jsonb when they were purged.';
+
+CREATE INDEX purge_archive_records_import_id_idx ON purge_archive_records USING btree (import_id);
+
+CREATE VIEW archived_audit_logs AS
+SELECT
+    ((r.record ->> 'id'::text))::uuid AS id,
+    ((r.record ->> 'time'::text))::timestamp with time zone AS "time",
+    ((r.record ->> 'user_id'::text))::uuid AS user_id,
+    ((r.record ->> 'organization_id'::text))::uuid AS organization_id,
+    ((r.record ->> 'ip'::text))::inet AS ip,
+    (r.record ->> 'user_agent'::text) AS user_agent,
+    (r.record ->> 'resource_type'::text) AS resource_type,
+    ((r.record ->> 'resource_id'::text))::uuid AS resource_id,
+    (r.record ->> 'resource_target'::text) AS resource_target,
+    (r.record ->> 'action'::text) AS action,
+    (r.record -> 'diff'::text) AS diff,
+    ((r.record ->> 'status_code'::text))::integer AS status_code,
+    (r.record -> 'additional_fields'::text) AS additional_fields,
+    ((r.record ->> 'request_id'::text))::uuid AS request_id,
+    (r.record ->> 'resource_icon'::text) AS resource_icon,
+    i.name AS archive_name
+FROM
+    (purge_archive_records r
+    JOIN purge_archive_imports i ON ((i.id = r.import_id)))
+WHERE
+    (i.record_type = 'audit_logs'::text);
+
+COMMENT ON VIEW archived_audit_logs IS 'Audit logs from imported archives.';
+
+CREATE VIEW archived_connection_logs AS
+SELECT
+    ((r.record ->> 'id'::text))::uuid AS id,
+    ((r.record ->> 'connect_time'::text))::timestamp with time zone AS connect_time,
+    ((r.record ->> 'organization_id'::text))::uuid AS organization_id,
+    ((r.record ->> 'workspace_owner_id'::text))::uuid AS workspace_owner_id,
+    ((r.record ->> 'workspace_id'::text))::uuid AS workspace_id,
+    (r.record ->> 'workspace_name'::text) AS workspace_name,
+    (r.record ->> 'agent_name'::text) AS agent_name,
+    (r.record ->> 'type'::text) AS type,
+    ((r.record ->> 'ip'::text))::inet AS ip,
+    ((r.record ->> 'code'::text))::integer AS code,
+    (r.record ->> 'user_agent'::text) AS user_agent,
+    ((r.record ->> 'user_id'::text))::uuid AS user_id,
+    (r.record ->> 'slug_or_port'::text) AS slug_or_port,
+    ((r.record ->> 'connection_id'::text))::uuid AS connection_id,
+    ((r.record ->> 'disconnect_time'::text))::timestamp with time zone AS disconnect_time,
+    (r.record ->> 'disconnect_reason'::text) AS disconnect_reason,
+    i.name AS archive_name
+FROM
+    (purge_archive_records r
+    JOIN purge_archive_imports i ON ((i.id = r.import_id)))
+WHERE
+    (i.record_type = 'connection_logs'::text);
+
+COMMENT ON VIEW archived_connection_logs IS 'Connection logs from imported archives.';
+
+CREATE VIEW archived_workspace_agent_logs AS
+SELECT
+    ((r.record ->> 'id'::text))::bigint AS id,
+    ((r.record ->> 'agent_id'::text))::uuid AS agent_id,
+    ((r.record ->> 'created_at'::text))::timestamp with time zone AS created_at,
+    (r.record ->> 'output'::text) AS output,
+    (r.record ->> 'level'::text) AS level,
+    ((r.record ->> 'log_source_id'::text))::uuid AS log_source_id,
+    i.name AS archive_name
+FROM
+    (purge_archive_records r
+    JOIN purge_archive_imports i ON ((i.id = r.import_id)))
+WHERE
+    (i.record_type = 'workspace_agent_logs'::text);
+
+COMMENT ON VIEW archived_workspace_agent_logs IS 'Workspace agent logs from imported archives.';
+
+CREATE VIEW archived_aibridge_interceptions AS
+SELECT
+    ((r.record ->> 'id'::text))::uuid AS id,
+    ((r.record ->> 'initiator_id'::text))::uuid AS initiator_id,
+    (r.record ->> 'provider'::text) AS provider,
+    (r.record ->> 'model'::text) AS model,
+    ((r.record ->> 'started_at'::text))::timestamp with time zone AS started_at,
+    ((r.record ->> 'ended_at'::text))::timestamp with time zone AS ended_at,
+    (r.record -> 'metadata'::text) AS metadata,
+    (r.record -> 'token_usages'::text) AS token_usages,
+    (r.record -> 'user_prompts'::text) AS user_prompts,
+    (r.record -> 'tool_usages'::text) AS tool_usages,
+    (r.record -> 'model_thoughts'::text) AS model_thoughts,
+    i.name AS archive_name
+FROM
+    (purge_archive_records r
+    JOIN purge_archive_imports i ON ((i.id = r.import_id)))
+WHERE
+    (i.record_type = 'aibridge_interceptions'::text);
+
+COMMENT ON VIEW archived_aibridge_interceptions IS 'AI Bridge interceptions from imported archives, with their token usages, user prompts, tool usages and model thoughts.';
@@ -0,0 +1,3 @@
+ALTER TABLE workspace_apps
+	DROP COLUMN healthcheck_address,
+	DROP COLUMN healthcheck_command;
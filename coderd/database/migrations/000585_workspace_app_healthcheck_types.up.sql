@@ -0,0 +1,7 @@
+ALTER TABLE workspace_apps
+	ADD COLUMN healthcheck_address text DEFAULT ''::text NOT NULL,
+	ADD COLUMN healthcheck_command text DEFAULT ''::text NOT NULL;
+
+COMMENT ON COLUMN workspace_apps.healthcheck_address IS 'The host:port that must accept TCP connections for the app to be healthy. Set instead of healthcheck_url for TCP healthchecks.';
+
+COMMENT ON COLUMN workspace_apps.healthcheck_command IS 'The command run in the workspace that must exit with status 0 for the app to be healthy. Set instead of healthcheck_url for exec healthchecks.';
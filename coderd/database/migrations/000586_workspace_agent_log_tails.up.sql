@@ -0,0 +1,15 @@
+CREATE TABLE workspace_agent_log_tails (
+	workspace_agent_id uuid NOT NULL,
+	log_source_id uuid NOT NULL,
+	created_at timestamp with time zone NOT NULL,
+	paths text[] DEFAULT '{}'::text[] NOT NULL,
+	journald_units text[] DEFAULT '{}'::text[] NOT NULL,
+	PRIMARY KEY (workspace_agent_id, log_source_id),
+	FOREIGN KEY (workspace_agent_id, log_source_id) REFERENCES workspace_agent_log_sources(workspace_agent_id, id) ON DELETE CASCADE
+);
+
+COMMENT ON TABLE workspace_agent_log_tails IS 'Log files and journald units that the agent tails into a log source.';
+
+COMMENT ON COLUMN workspace_agent_log_tails.paths IS 'Paths of log files to tail. Paths may contain glob patterns.';
+
+COMMENT ON COLUMN workspace_agent_log_tails.journald_units IS 'Names of systemd units whose journal is tailed.';
@@ -0,0 +1,2 @@
+DROP TABLE IF EXISTS workspace_agent_resource_monitors;
+DROP TYPE IF EXISTS workspace_agent_resource_monitor_type;
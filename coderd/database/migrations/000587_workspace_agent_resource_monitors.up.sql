@@ -0,0 +1,24 @@
+CREATE TYPE workspace_agent_resource_monitor_type AS ENUM (
+	'cpu',
+	'processes',
+	'inodes'
+);
+
+CREATE TABLE workspace_agent_resource_monitors (
+	agent_id        uuid                                  NOT NULL REFERENCES workspace_agents(id) ON DELETE CASCADE,
+	type            workspace_agent_resource_monitor_type NOT NULL,
+	path            text                                  NOT NULL DEFAULT '',
+	enabled         boolean                               NOT NULL,
+	threshold       integer                               NOT NULL,
+	created_at      timestamp with time zone              NOT NULL,
+	updated_at      timestamp with time zone              NOT NULL DEFAULT CURRENT_TIMESTAMP,
+	state           workspace_agent_monitor_state         NOT NULL DEFAULT 'OK',
+	debounced_until timestamp with time zone              NOT NULL DEFAULT '0001-01-01 00:00:00'::timestamptz,
+	PRIMARY KEY (agent_id, type, path)
+);
+
+COMMENT ON TABLE workspace_agent_resource_monitors IS 'Resource monitors of a workspace agent other than memory and volume usage.';
+
+COMMENT ON COLUMN workspace_agent_resource_monitors.path IS 'Path of the volume for inodes monitors, empty for other types.';
+
+COMMENT ON COLUMN workspace_agent_resource_monitors.threshold IS 'Percentage of the resource in use above which the monitor is not OK.';
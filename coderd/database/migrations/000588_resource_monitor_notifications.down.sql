@@ -0,0 +1,6 @@
+DELETE FROM notification_templates
+WHERE id IN (
+	'66713419-c6e2-48e1-bac5-6a1c5dbc1652',
+	'cce0693f-17f0-4646-96c0-891b862dc77e',
+	'85b3ff61-d24a-407f-b829-0926e1ad6bd4'
+);
@@ -0,0 +1,54 @@
+INSERT INTO notification_templates
+	(id, name, title_template, body_template, "group", actions)
+VALUES (
+	'66713419-c6e2-48e1-bac5-6a1c5dbc1652',
+	'Workspace High CPU Usage',
+	E'Your workspace "{{.Labels.workspace}}" is using a lot of CPU',
+	E'Your workspace **{{.Labels.workspace}}** has been using more than **{{.Labels.threshold}}** of its CPU.',
+	'Workspace Events',
+	'[
+		{
+			"label": "View workspace",
+			"url": "{{base_url}}/@{{.UserUsername}}/{{.Labels.workspace}}"
+		}
+	]'::jsonb
+);
+
+INSERT INTO notification_templates
+	(id, name, title_template, body_template, "group", actions)
+VALUES (
+	'cce0693f-17f0-4646-96c0-891b862dc77e',
+	'Workspace Process Limit',
+	E'Your workspace "{{.Labels.workspace}}" is running too many processes',
+	E'Your workspace **{{.Labels.workspace}}** has reached the process usage threshold set at **{{.Labels.threshold}}** of its process limit.',
+	'Workspace Events',
+	'[
+		{
+			"label": "View workspace",
+			"url": "{{base_url}}/@{{.UserUsername}}/{{.Labels.workspace}}"
+		}
+	]'::jsonb
+);
+
+INSERT INTO notification_templates
+	(id, name, title_template, body_template, "group", actions)
+VALUES (
+	'85b3ff61-d24a-407f-b829-0926e1ad6bd4',
+	'Workspace Out Of Inodes',
+	E'Your workspace "{{.Labels.workspace}}" is low on inodes',
+	E'{{ if eq (len .Data.volumes) 1 }}{{ $volume := index .Data.volumes 0 }}'||
+			E'Volume **`{{$volume.path}}`** has used over {{$volume.threshold}} of its inodes in workspace **{{.Labels.workspace}}**.'||
+		E'{{ else }}'||
+			E'The following volumes are running out of inodes in workspace **{{.Labels.workspace}}**\n\n'||
+			E'{{ range $volume := .Data.volumes }}'||
+				E'- **`{{$volume.path}}`** has used over {{$volume.threshold}} of its inodes\n'||
+			E'{{ end }}'||
+		E'{{ end }}',
+	'Workspace Events',
+	'[
+		{
+			"label": "View workspace",
+			"url": "{{base_url}}/@{{.UserUsername}}/{{.Labels.workspace}}"
+		}
+	]'::jsonb
+);
@@ -0,0 +1,3 @@
+DROP TABLE IF EXISTS workspace_agent_pty_share_viewers;
+
+DROP TABLE IF EXISTS workspace_agent_pty_shares;
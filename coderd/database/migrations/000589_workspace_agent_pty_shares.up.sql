@@ -0,0 +1,40 @@
+CREATE TABLE workspace_agent_pty_shares (
+	id uuid NOT NULL,
+	agent_id uuid NOT NULL REFERENCES workspace_agents(id) ON DELETE CASCADE,
+	reconnect_id uuid NOT NULL,
+	created_by uuid NOT NULL REFERENCES users(id) ON DELETE CASCADE,
+	hashed_token bytea NOT NULL,
+	user_ids uuid[] DEFAULT '{}'::uuid[] NOT NULL,
+	created_at timestamp with time zone NOT NULL,
+	expires_at timestamp with time zone NOT NULL,
+	revoked_at timestamp with time zone,
+	PRIMARY KEY (id)
+);
+
+COMMENT ON TABLE workspace_agent_pty_shares IS 'Read-only shares of reconnecting PTY sessions.';
+
+COMMENT ON COLUMN workspace_agent_pty_shares.reconnect_id IS 'ID of the shared reconnecting PTY session on the agent.';
+
+COMMENT ON COLUMN workspace_agent_pty_shares.hashed_token IS 'SHA-256 hash of the share token. The token itself is only returned when the share is created.';
+
+COMMENT ON COLUMN workspace_agent_pty_shares.user_ids IS 'Users invited to watch the session.';
+
+CREATE UNIQUE INDEX workspace_agent_pty_shares_hashed_token_idx ON workspace_agent_pty_shares USING btree (hashed_token);
+
+CREATE INDEX workspace_agent_pty_shares_agent_id_idx ON workspace_agent_pty_shares USING btree (agent_id);
+
+CREATE TABLE workspace_agent_pty_share_viewers (
+	id uuid NOT NULL,
+	share_id uuid NOT NULL REFERENCES workspace_agent_pty_shares(id) ON DELETE CASCADE,
+	user_id uuid NOT NULL REFERENCES users(id) ON DELETE CASCADE,
+	ip inet,
+	connected_at timestamp with time zone NOT NULL,
+	disconnected_at timestamp with time zone,
+	PRIMARY KEY (id)
+);
+
+COMMENT ON TABLE workspace_agent_pty_share_viewers IS 'Connections of viewers to shared reconnecting PTY sessions.';
+
+COMMENT ON COLUMN workspace_agent_pty_share_viewers.id IS 'Also used as the connection ID in the connection log.';
+
+CREATE INDEX workspace_agent_pty_share_viewers_share_id_idx ON workspace_agent_pty_share_viewers USING btree (share_id);
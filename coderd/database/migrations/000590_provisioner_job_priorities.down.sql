@@ -0,0 +1,3 @@
+DROP TABLE IF EXISTS provisioner_job_priorities;
+
+ALTER TABLE provisioner_jobs DROP COLUMN IF EXISTS priority;
@@ -0,0 +1,23 @@
+ALTER TABLE provisioner_jobs ADD COLUMN priority integer NOT NULL DEFAULT 0;
+
+COMMENT ON COLUMN provisioner_jobs.priority IS 'Jobs with a higher priority are acquired first. Computed when the job is inserted from the job''s priority class and any organization or template priority boosts.';
+
+-- Jobs that are still pending were all inserted before priority classes
+-- existed. Keep human-initiated jobs ahead of prebuilds like before.
+UPDATE provisioner_jobs
+SET priority = 2000
+WHERE job_status = 'pending'
+	AND initiator_id != 'c42fdf75-3097-471c-8c33-fb52454d81c0'::uuid;
+
+CREATE TABLE provisioner_job_priorities (
+	organization_id uuid NOT NULL REFERENCES organizations(id) ON DELETE CASCADE,
+	template_id uuid NOT NULL DEFAULT '00000000-0000-0000-0000-000000000000'::uuid,
+	priority integer NOT NULL,
+	updated_at timestamp with time zone NOT NULL,
+	PRIMARY KEY (organization_id, template_id),
+	CONSTRAINT provisioner_job_priorities_priority_check CHECK (priority >= -100 AND priority <= 100)
+);
+
+COMMENT ON TABLE provisioner_job_priorities IS 'Priority boosts applied to provisioner jobs of an organization or template.';
+
+COMMENT ON COLUMN provisioner_job_priorities.template_id IS 'The template the boost applies to. uuid.Nil means the boost applies to every job in the organization.';
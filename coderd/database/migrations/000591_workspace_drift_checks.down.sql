@@ -0,0 +1,24 @@
+DELETE FROM notification_templates WHERE id = 'd404a2a1-20be-40bd-97a0-89b4a5e21a08';
+
+DROP TABLE IF EXISTS workspace_drift_checks;
+
+DROP VIEW template_with_names;
+
+ALTER TABLE templates DROP COLUMN drift_check_interval;
+
+CREATE VIEW template_with_names AS
+SELECT templates.*,
+	   COALESCE(visible_users.avatar_url, ''::text) AS created_by_avatar_url,
+	   COALESCE(visible_users.username, ''::text) AS created_by_username,
+	   COALESCE(visible_users.name, ''::text) AS created_by_name,
+	   COALESCE(organizations.name, ''::text) AS organization_name,
+	   COALESCE(organizations.display_name, ''::text) AS organization_display_name,
+	   COALESCE(organizations.icon, ''::text) AS organization_icon
+FROM ((templates
+	LEFT JOIN visible_users ON ((templates.created_by = visible_users.id)))
+	LEFT JOIN organizations ON ((templates.organization_id = organizations.id)));
+
+COMMENT ON VIEW template_with_names IS 'Joins in the display name information such as username, avatar, and organization name.';
+
+-- The workspace_drift_check provisioner_job_type value is left in place, as
+-- enum values can not be removed.
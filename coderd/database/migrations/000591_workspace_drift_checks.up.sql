@@ -0,0 +1,64 @@
+-- As we can not remove a value from an enum, the down migration leaves it in place.
+ALTER TYPE provisioner_job_type ADD VALUE IF NOT EXISTS 'workspace_drift_check';
+
+ALTER TABLE templates ADD COLUMN drift_check_interval bigint DEFAULT '86400000000000'::bigint NOT NULL;
+
+COMMENT ON COLUMN templates.drift_check_interval IS 'How often running workspaces of this template are checked for infrastructure drift, in nanoseconds. 0 disables drift detection.';
+
+-- As usual, recreate the view so templates.* is expanded to include the new column.
+DROP VIEW template_with_names;
+
+CREATE VIEW template_with_names AS
+SELECT templates.*,
+	   COALESCE(visible_users.avatar_url, ''::text) AS created_by_avatar_url,
+	   COALESCE(visible_users.username, ''::text) AS created_by_username,
+	   COALESCE(visible_users.name, ''::text) AS created_by_name,
+	   COALESCE(organizations.name, ''::text) AS organization_name,
+	   COALESCE(organizations.display_name, ''::text) AS organization_display_name,
+	   COALESCE(organizations.icon, ''::text) AS organization_icon
+FROM ((templates
+	LEFT JOIN visible_users ON ((templates.created_by = visible_users.id)))
+	LEFT JOIN organizations ON ((templates.organization_id = organizations.id)));
+
+COMMENT ON VIEW template_with_names IS 'Joins in the display name information such as username, avatar, and organization name.';
+
+CREATE TABLE workspace_drift_checks (
+	id uuid PRIMARY KEY,
+	workspace_id uuid NOT NULL REFERENCES workspaces(id) ON DELETE CASCADE,
+	workspace_build_id uuid NOT NULL REFERENCES workspace_builds(id) ON DELETE CASCADE,
+	job_id uuid NOT NULL UNIQUE REFERENCES provisioner_jobs(id) ON DELETE CASCADE,
+	created_at timestamp with time zone NOT NULL,
+	checked_at timestamp with time zone,
+	drifted boolean NOT NULL DEFAULT false,
+	resource_drift jsonb NOT NULL DEFAULT '[]'::jsonb
+);
+
+COMMENT ON TABLE workspace_drift_checks IS 'Refresh-only Terraform plans run against running workspaces to detect infrastructure that changed outside of Coder.';
+
+COMMENT ON COLUMN workspace_drift_checks.workspace_build_id IS 'The build whose Terraform state was checked.';
+
+COMMENT ON COLUMN workspace_drift_checks.checked_at IS 'When the drift check job completed successfully. NULL while the job is pending or if it failed.';
+
+COMMENT ON COLUMN workspace_drift_checks.resource_drift IS 'The resources that changed outside of Coder, as reported by the provisioner.';
+
+CREATE INDEX workspace_drift_checks_workspace_id_created_at_idx ON workspace_drift_checks (workspace_id, created_at DESC);
+
+INSERT INTO notification_templates
+	(id, name, title_template, body_template, "group", actions)
+VALUES (
+	'd404a2a1-20be-40bd-97a0-89b4a5e21a08',
+	'Workspace Drift Detected',
+	E'Workspace "{{.Labels.workspace}}" has drifted',
+	E'Resources of workspace **{{.Labels.workspace}}** owned by **{{.Labels.workspace_owner_username}}** were changed outside of Coder:\n\n'||
+		E'{{ range $resource := .Data.resources }}'||
+			E'- **`{{$resource.address}}`** ({{$resource.action}})\n'||
+		E'{{ end }}\n'||
+		E'Rebuild the workspace to restore the resources defined by template **{{.Labels.template_name}}**.',
+	'Workspace Events',
+	'[
+		{
+			"label": "View workspace",
+			"url": "{{base_url}}/@{{.Labels.workspace_owner_username}}/{{.Labels.workspace}}"
+		}
+	]'::jsonb
+);
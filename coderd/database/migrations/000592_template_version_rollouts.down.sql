@@ -0,0 +1,5 @@
+DROP TABLE IF EXISTS template_version_rollouts;
+
+DROP TYPE IF EXISTS template_version_rollout_failure_action;
+
+DROP TYPE IF EXISTS template_version_rollout_status;
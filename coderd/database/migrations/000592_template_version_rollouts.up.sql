@@ -0,0 +1,49 @@
+CREATE TYPE template_version_rollout_status AS ENUM (
+	'in_progress',
+	'paused',
+	'completed',
+	'rolled_back',
+	'canceled'
+);
+
+CREATE TYPE template_version_rollout_failure_action AS ENUM (
+	'pause',
+	'rollback'
+);
+
+CREATE TABLE template_version_rollouts (
+	id uuid PRIMARY KEY,
+	template_id uuid NOT NULL REFERENCES templates(id) ON DELETE CASCADE,
+	template_version_id uuid NOT NULL REFERENCES template_versions(id) ON DELETE CASCADE,
+	previous_template_version_id uuid NOT NULL REFERENCES template_versions(id) ON DELETE CASCADE,
+	percentage integer NOT NULL DEFAULT 0 CHECK (percentage >= 0 AND percentage <= 100),
+	group_ids uuid[] NOT NULL DEFAULT '{}'::uuid[],
+	failure_threshold double precision NOT NULL DEFAULT 0.2 CHECK (failure_threshold > 0 AND failure_threshold <= 1),
+	min_samples integer NOT NULL DEFAULT 5 CHECK (min_samples > 0),
+	failure_action template_version_rollout_failure_action NOT NULL DEFAULT 'pause',
+	status template_version_rollout_status NOT NULL DEFAULT 'in_progress',
+	status_message text NOT NULL DEFAULT '',
+	created_by uuid NOT NULL REFERENCES users(id) ON DELETE RESTRICT,
+	created_at timestamp with time zone NOT NULL,
+	updated_at timestamp with time zone NOT NULL,
+	started_at timestamp with time zone NOT NULL,
+	finished_at timestamp with time zone
+);
+
+COMMENT ON TABLE template_version_rollouts IS 'Staged rollouts of a template version to a subset of the workspaces that follow the active version.';
+
+COMMENT ON COLUMN template_version_rollouts.previous_template_version_id IS 'The active version of the template when the rollout was created. Workspaces outside of the rollout keep using the active version.';
+
+COMMENT ON COLUMN template_version_rollouts.percentage IS 'The percentage of workspaces, chosen by a stable hash of the workspace ID, that are updated to the rollout version.';
+
+COMMENT ON COLUMN template_version_rollouts.group_ids IS 'Workspaces owned by members of these groups are updated to the rollout version, regardless of the percentage.';
+
+COMMENT ON COLUMN template_version_rollouts.failure_threshold IS 'The ratio of failed builds or agents, between 0 and 1, above which the failure action is taken.';
+
+COMMENT ON COLUMN template_version_rollouts.min_samples IS 'The minimum number of finished builds or agents before the failure ratio is evaluated.';
+
+COMMENT ON COLUMN template_version_rollouts.started_at IS 'Builds and agents since this time are used to evaluate the rollout. Reset when a paused rollout is resumed.';
+
+CREATE UNIQUE INDEX template_version_rollouts_template_id_active_idx ON template_version_rollouts (template_id) WHERE (status = ANY (ARRAY['in_progress'::template_version_rollout_status, 'paused'::template_version_rollout_status]));
+
+CREATE INDEX template_version_rollouts_template_id_created_at_idx ON template_version_rollouts (template_id, created_at DESC);
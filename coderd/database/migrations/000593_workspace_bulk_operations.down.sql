@@ -0,0 +1,11 @@
+-- The resource_type enum addition is intentionally not reverted because
+-- Postgres cannot drop enum values safely.
+DROP TABLE IF EXISTS workspace_bulk_operation_workspaces;
+
+DROP TABLE IF EXISTS workspace_bulk_operations;
+
+DROP TYPE IF EXISTS workspace_bulk_operation_workspace_status;
+
+DROP TYPE IF EXISTS workspace_bulk_operation_status;
+
+DROP TYPE IF EXISTS workspace_bulk_operation_action;
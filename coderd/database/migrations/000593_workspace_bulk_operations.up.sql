@@ -0,0 +1,75 @@
+CREATE TYPE workspace_bulk_operation_action AS ENUM (
+	'start',
+	'stop',
+	'update',
+	'delete',
+	'autostart',
+	'ttl',
+	'automatic_updates'
+);
+
+CREATE TYPE workspace_bulk_operation_status AS ENUM (
+	'running',
+	'completed',
+	'canceled'
+);
+
+CREATE TYPE workspace_bulk_operation_workspace_status AS ENUM (
+	'pending',
+	'running',
+	'succeeded',
+	'failed',
+	'skipped'
+);
+
+CREATE TABLE workspace_bulk_operations (
+	id uuid PRIMARY KEY,
+	initiator_id uuid NOT NULL REFERENCES users(id) ON DELETE CASCADE,
+	action workspace_bulk_operation_action NOT NULL,
+	query text NOT NULL,
+	autostart_schedule text NOT NULL DEFAULT '',
+	ttl_ms bigint NOT NULL DEFAULT 0,
+	automatic_updates automatic_updates NOT NULL DEFAULT 'never',
+	concurrency integer NOT NULL CHECK (concurrency > 0),
+	workspace_count integer NOT NULL DEFAULT 0,
+	status workspace_bulk_operation_status NOT NULL DEFAULT 'running',
+	created_at timestamp with time zone NOT NULL,
+	updated_at timestamp with time zone NOT NULL,
+	completed_at timestamp with time zone,
+	api_key_scopes api_key_scope[] NOT NULL,
+	api_key_allow_list text[] NOT NULL
+);
+
+COMMENT ON TABLE workspace_bulk_operations IS 'Actions applied to every workspace that matched a search query when the operation was created.';
+
+COMMENT ON COLUMN workspace_bulk_operations.query IS 'The workspace search query, evaluated with the permissions of the initiator.';
+
+COMMENT ON COLUMN workspace_bulk_operations.autostart_schedule IS 'The autostart schedule set by the autostart action. Empty disables autostart.';
+
+COMMENT ON COLUMN workspace_bulk_operations.ttl_ms IS 'The autostop TTL set by the ttl action. Zero disables autostop.';
+
+COMMENT ON COLUMN workspace_bulk_operations.concurrency IS 'The maximum number of workspaces of the operation that are processed at the same time.';
+
+COMMENT ON COLUMN workspace_bulk_operations.api_key_scopes IS 'The scopes of the API key that created the operation, which limit what the operation can do on behalf of the initiator.';
+
+COMMENT ON COLUMN workspace_bulk_operations.api_key_allow_list IS 'The allow list of the API key that created the operation.';
+
+CREATE INDEX workspace_bulk_operations_created_at_idx ON workspace_bulk_operations (created_at DESC);
+
+CREATE TABLE workspace_bulk_operation_workspaces (
+	operation_id uuid NOT NULL REFERENCES workspace_bulk_operations(id) ON DELETE CASCADE,
+	workspace_id uuid NOT NULL REFERENCES workspaces(id) ON DELETE CASCADE,
+	status workspace_bulk_operation_workspace_status NOT NULL DEFAULT 'pending',
+	build_id uuid REFERENCES workspace_builds(id) ON DELETE SET NULL,
+	error text NOT NULL DEFAULT '',
+	updated_at timestamp with time zone NOT NULL,
+	PRIMARY KEY (operation_id, workspace_id)
+);
+
+COMMENT ON TABLE workspace_bulk_operation_workspaces IS 'The outcome of a workspace bulk operation for each of its workspaces.';
+
+COMMENT ON COLUMN workspace_bulk_operation_workspaces.build_id IS 'The build created for the workspace by the start, stop, update and delete actions.';
+
+CREATE INDEX workspace_bulk_operation_workspaces_status_idx ON workspace_bulk_operation_workspaces (status) WHERE (status = ANY (ARRAY['pending'::workspace_bulk_operation_workspace_status, 'running'::workspace_bulk_operation_workspace_status]));
+
+ALTER TYPE resource_type ADD VALUE IF NOT EXISTS 'workspace_bulk_operation';
@@ -0,0 +1,37 @@
+INSERT INTO schedule_exception_calendars
+	(id, organization_id, name, description, created_at, updated_at)
+VALUES (
+	'f4a1c9c2-6a0e-4a8c-9f3d-2b7e5d8c1a01',
+	'bb640d07-ca8a-4869-b6bc-ae61ebb2fda1',
+	'us-holidays',
+	'US public holidays',
+	'2023-06-15 10:23:54+00',
+	'2023-06-15 10:23:54+00'
+);
+
+INSERT INTO schedule_exception_calendar_dates
+	(calendar_id, start_date, end_date, summary)
+VALUES (
+	'f4a1c9c2-6a0e-4a8c-9f3d-2b7e5d8c1a01',
+	'2023-12-25',
+	'2023-12-25',
+	'Christmas Day'
+);
+
+INSERT INTO template_schedule_exception_calendars
+	(template_id, calendar_id)
+VALUES (
+	'4cc1f466-f326-477e-8762-9d0c6781fc56',
+	'f4a1c9c2-6a0e-4a8c-9f3d-2b7e5d8c1a01'
+);
+
+INSERT INTO user_out_of_office_ranges
+	(id, user_id, start_date, end_date, reason, created_at)
+VALUES (
+	'f4a1c9c2-6a0e-4a8c-9f3d-2b7e5d8c1a02',
+	'0ed9befc-4911-4ccf-a8e2-559bf72daa94',
+	'2023-07-01',
+	'2023-07-14',
+	'Vacation',
+	'2023-06-15 10:23:54+00'
+);
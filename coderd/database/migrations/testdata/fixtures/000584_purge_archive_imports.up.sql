@@ -0,0 +1,16 @@
+INSERT INTO purge_archive_imports
+	(id, name, record_type, row_count, imported_at)
+VALUES (
+	'c2f1d6a4-3b8e-4f5a-9d7c-1e2b3a4c5d01',
+	'audit_logs/2023/06/15/audit_logs-20230615T102354Z-5f3c.jsonl.gz',
+	'audit_logs',
+	1,
+	'2023-06-15 10:23:54+00'
+);
+
+INSERT INTO purge_archive_records
+	(import_id, record)
+VALUES (
+	'c2f1d6a4-3b8e-4f5a-9d7c-1e2b3a4c5d01',
+	'{"id": "c2f1d6a4-3b8e-4f5a-9d7c-1e2b3a4c5d02", "time": "2016-06-15T10:23:54+00:00", "action": "create", "resource_type": "template"}'
+);
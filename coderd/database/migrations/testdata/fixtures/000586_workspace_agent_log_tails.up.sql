@@ -0,0 +1,13 @@
+INSERT INTO workspace_agent_log_tails (
+	workspace_agent_id,
+	log_source_id,
+	created_at,
+	paths,
+	journald_units
+) VALUES (
+	'45e89705-e09d-4850-bcec-f9a937f5d78d',
+	'0ff953c0-92a6-4fe6-a415-eb0139a36ad1',
+	'2022-11-02 13:03:45.046432+02',
+	'{"/var/log/nginx/*.log"}',
+	'{"docker.service"}'
+) ON CONFLICT DO NOTHING;
@@ -0,0 +1,37 @@
+INSERT INTO
+	workspace_agent_pty_shares (
+		id,
+		agent_id,
+		reconnect_id,
+		created_by,
+		hashed_token,
+		user_ids,
+		created_at,
+		expires_at
+	)
+	VALUES (
+		'2d9b6a3e-58b4-4a5f-9a54-5b0c6f1b7a11',
+		'45e89705-e09d-4850-bcec-f9a937f5d78d',
+		'8c4b4a1e-6b61-4d2f-8a2a-0c6e0e7d9f42',
+		'30095c71-380b-457a-8995-97b8ee6e5307',
+		'\xdeadbeef',
+		'{30095c71-380b-457a-8995-97b8ee6e5307}',
+		'2024-01-01 00:00:00',
+		'2024-01-01 01:00:00'
+	);
+
+INSERT INTO
+	workspace_agent_pty_share_viewers (
+		id,
+		share_id,
+		user_id,
+		ip,
+		connected_at
+	)
+	VALUES (
+		'b3f0c1d2-9e8a-4b7c-a6d5-e4f3a2b1c0d9',
+		'2d9b6a3e-58b4-4a5f-9a54-5b0c6f1b7a11',
+		'30095c71-380b-457a-8995-97b8ee6e5307',
+		'127.0.0.1',
+		'2024-01-01 00:10:00'
+	);
@@ -0,0 +1,20 @@
+INSERT INTO
+	provisioner_job_priorities (
+		organization_id,
+		template_id,
+		priority,
+		updated_at
+	)
+	VALUES
+	(
+		'bb640d07-ca8a-4869-b6bc-ae61ebb2fda1',
+		'00000000-0000-0000-0000-000000000000',
+		10,
+		'2024-01-01 00:00:00'
+	),
+	(
+		'20362772-802a-4a72-8e4f-3648b4bfd168',
+		'6b298946-7a4f-47ac-9158-b03b08740a41',
+		-20,
+		'2024-01-01 00:00:00'
+	);
@@ -0,0 +1,63 @@
+INSERT INTO provisioner_jobs (
+	id,
+	created_at,
+	updated_at,
+	started_at,
+	completed_at,
+	organization_id,
+	initiator_id,
+	provisioner,
+	storage_method,
+	type,
+	input,
+	file_id,
+	tags
+)
+SELECT
+	'0e9b623d-223c-4906-87e9-793ff70a1403'::uuid,
+	NOW(),
+	NOW(),
+	NOW(),
+	NOW(),
+	provisioner_jobs.organization_id,
+	provisioner_jobs.initiator_id,
+	provisioner_jobs.provisioner,
+	provisioner_jobs.storage_method,
+	'workspace_drift_check'::provisioner_job_type,
+	jsonb_build_object('workspace_build_id', workspace_builds.id),
+	provisioner_jobs.file_id,
+	provisioner_jobs.tags
+FROM
+	workspace_builds
+	JOIN provisioner_jobs ON provisioner_jobs.id = workspace_builds.job_id
+ORDER BY
+	workspace_builds.created_at, workspace_builds.id
+LIMIT 1
+ON CONFLICT DO NOTHING;
+
+INSERT INTO workspace_drift_checks (
+	id,
+	workspace_id,
+	workspace_build_id,
+	job_id,
+	created_at,
+	checked_at,
+	drifted,
+	resource_drift
+)
+SELECT
+	'98efa36b-9fcd-40e1-93e5-7d5146d94dfe'::uuid,
+	workspace_builds.workspace_id,
+	workspace_builds.id,
+	'0e9b623d-223c-4906-87e9-793ff70a1403'::uuid,
+	NOW(),
+	NOW(),
+	true,
+	'[{"address": "docker_container.workspace[0]", "type": "docker_container", "name": "workspace", "action": "update", "attributes": ["image"]}]'::jsonb
+FROM
+	workspace_builds
+	JOIN provisioner_jobs ON provisioner_jobs.id = '0e9b623d-223c-4906-87e9-793ff70a1403'::uuid
+ORDER BY
+	workspace_builds.created_at, workspace_builds.id
+LIMIT 1
+ON CONFLICT DO NOTHING;
@@ -0,0 +1,40 @@
+INSERT INTO template_version_rollouts (
+	id,
+	template_id,
+	template_version_id,
+	previous_template_version_id,
+	percentage,
+	group_ids,
+	failure_threshold,
+	min_samples,
+	failure_action,
+	status,
+	status_message,
+	created_by,
+	created_at,
+	updated_at,
+	started_at
+)
+SELECT
+	'5a1f3c0e-6a3b-4f0e-9a57-0c1d6f2e8b41'::uuid,
+	templates.id,
+	template_versions.id,
+	templates.active_version_id,
+	10,
+	ARRAY[templates.organization_id],
+	0.2,
+	5,
+	'pause'::template_version_rollout_failure_action,
+	'in_progress'::template_version_rollout_status,
+	'',
+	templates.created_by,
+	NOW(),
+	NOW(),
+	NOW()
+FROM
+	templates
+	JOIN template_versions ON template_versions.template_id = templates.id
+ORDER BY
+	templates.created_at, templates.id, template_versions.created_at DESC
+LIMIT 1
+ON CONFLICT DO NOTHING;
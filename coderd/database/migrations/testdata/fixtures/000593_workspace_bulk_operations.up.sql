@@ -0,0 +1,52 @@
+INSERT INTO workspace_bulk_operations (
+	id,
+	initiator_id,
+	action,
+	query,
+	concurrency,
+	workspace_count,
+	status,
+	created_at,
+	updated_at,
+	api_key_scopes,
+	api_key_allow_list
+)
+SELECT
+	'7d0c1f4a-2b8e-4c55-9f3a-6e1b2d4c8a90'::uuid,
+	workspaces.owner_id,
+	'stop'::workspace_bulk_operation_action,
+	'owner:me',
+	5,
+	1,
+	'running'::workspace_bulk_operation_status,
+	NOW(),
+	NOW(),
+	ARRAY['coder:all']::api_key_scope[],
+	ARRAY['*:*']
+FROM
+	workspaces
+ORDER BY
+	workspaces.created_at, workspaces.id
+LIMIT 1
+ON CONFLICT DO NOTHING;
+
+INSERT INTO workspace_bulk_operation_workspaces (
+	operation_id,
+	workspace_id,
+	status,
+	updated_at
+)
+SELECT
+	workspace_bulk_operations.id,
+	workspaces.id,
+	'pending'::workspace_bulk_operation_workspace_status,
+	NOW()
+FROM
+	workspace_bulk_operations
+	JOIN workspaces ON workspaces.owner_id = workspace_bulk_operations.initiator_id
+WHERE
+	workspace_bulk_operations.id = '7d0c1f4a-2b8e-4c55-9f3a-6e1b2d4c8a90'::uuid
+ORDER BY
+	workspaces.created_at, workspaces.id
+LIMIT 1
+ON CONFLICT DO NOTHING;
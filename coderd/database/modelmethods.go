@@ -637,6 +637,13 @@ func (p ProvisionerKey) RBACObject() rbac.Object {
 		InOrg(p.OrganizationID)
 }
 
+// RBACObject for an exception calendar is the organization's templates, since
+// calendars only take effect once attached to them.
+func (c ScheduleExceptionCalendar) RBACObject() rbac.Object {
+	return rbac.ResourceTemplate.
+		InOrg(c.OrganizationID)
+}
+
 func (w WorkspaceProxy) RBACObject() rbac.Object {
 	return rbac.ResourceWorkspaceProxy.
 		WithID(w.ID)
@@ -666,6 +673,12 @@ func (u ExternalAuthLink) RBACObject() rbac.Object   { return rbac.ResourceUserO
 func (u UserLink) RBACObject() rbac.Object           { return rbac.ResourceUserObject(u.UserID) }
 func (u MCPServerUserToken) RBACObject() rbac.Object { return rbac.ResourceUserObject(u.UserID) }
 
+// RBACObject returns the initiator of the operation. The workspaces of the
+// operation are authorized separately with the permissions of the initiator.
+func (o WorkspaceBulkOperation) RBACObject() rbac.Object {
+	return rbac.ResourceUserObject(o.InitiatorID)
+}
+
 func (u ExternalAuthLink) OAuthToken() *oauth2.Token {
 	return &oauth2.Token{
 		AccessToken:  u.OAuthAccessToken,
@@ -682,6 +695,16 @@ func (c OAuth2ProviderAppCode) RBACObject() rbac.Object {
 	return rbac.ResourceOauth2AppCodeToken.WithOwner(c.UserID.String())
 }
 
+// RBACObject for a device code is owned by the user that acted on it. A
+// pending code has no owner, so only the system can read it.
+func (c OAuth2ProviderAppDeviceCode) RBACObject() rbac.Object {
+	obj := rbac.ResourceOauth2AppCodeToken.WithID(c.ID)
+	if c.UserID.Valid {
+		obj = obj.WithOwner(c.UserID.UUID.String())
+	}
+	return obj
+}
+
 func (t OAuth2ProviderAppToken) RBACObject() rbac.Object {
 	return rbac.ResourceOauth2AppCodeToken.WithOwner(t.UserID.String()).WithID(t.ID)
 }
@@ -854,7 +877,7 @@ func (p ProvisionerJob) RBACObject() rbac.Object {
 	switch p.Type {
 	// Only acceptable for known job types at this time because template
 	// admins may not be allowed to view new types.
-	case ProvisionerJobTypeTemplateVersionImport, ProvisionerJobTypeTemplateVersionDryRun, ProvisionerJobTypeWorkspaceBuild:
+	case ProvisionerJobTypeTemplateVersionImport, ProvisionerJobTypeTemplateVersionDryRun, ProvisionerJobTypeWorkspaceBuild, ProvisionerJobTypeWorkspaceDriftCheck:
 		return rbac.ResourceProvisionerJobs.InOrg(p.OrganizationID)
 
 	default:
@@ -956,6 +979,20 @@ func (m WorkspaceAgentVolumeResourceMonitor) Debounce(
 	return m.DebouncedUntil, false
 }
 
+func (m WorkspaceAgentResourceMonitor) Debounce(
+	by time.Duration,
+	now time.Time,
+	oldState, newState WorkspaceAgentMonitorState,
+) (debouncedUntil time.Time, shouldNotify bool) {
+	if now.After(m.DebouncedUntil) &&
+		oldState == WorkspaceAgentMonitorStateOK &&
+		newState == WorkspaceAgentMonitorStateNOK {
+		return now.Add(by), true
+	}
+
+	return m.DebouncedUntil, false
+}
+
 func (s UserSkill) RBACObject() rbac.Object {
 	return rbac.ResourceUserSkill.WithID(s.ID).WithOwner(s.UserID.String())
 }
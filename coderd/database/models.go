@@ -2642,6 +2642,8 @@ const (
 	NotificationMethodSmtp    NotificationMethod = "smtp"
 	NotificationMethodWebhook NotificationMethod = "webhook"
 	NotificationMethodInbox   NotificationMethod = "inbox"
+	NotificationMethodSlack   NotificationMethod = "slack"
+	NotificationMethodMsteams NotificationMethod = "msteams"
 )
 
 func (e *NotificationMethod) Scan(src interface{}) error {
@@ -2683,7 +2685,9 @@ func (e NotificationMethod) Valid() bool {
 	switch e {
 	case NotificationMethodSmtp,
 		NotificationMethodWebhook,
-		NotificationMethodInbox:
+		NotificationMethodInbox,
+		NotificationMethodSlack,
+		NotificationMethodMsteams:
 		return true
 	}
 	return false
@@ -2694,6 +2698,8 @@ func AllNotificationMethodValues() []NotificationMethod {
 		NotificationMethodSmtp,
 		NotificationMethodWebhook,
 		NotificationMethodInbox,
+		NotificationMethodSlack,
+		NotificationMethodMsteams,
 	}
 }
 
@@ -2755,6 +2761,67 @@ func AllNotificationTemplateKindValues() []NotificationTemplateKind {
 	}
 }
 
+type OAuth2DeviceCodeStatus string
+
+const (
+	OAuth2DeviceCodeStatusPending  OAuth2DeviceCodeStatus = "pending"
+	OAuth2DeviceCodeStatusApproved OAuth2DeviceCodeStatus = "approved"
+	OAuth2DeviceCodeStatusDenied   OAuth2DeviceCodeStatus = "denied"
+)
+
+func (e *OAuth2DeviceCodeStatus) Scan(src interface{}) error {
+	switch s := src.(type) {
+	case []byte:
+		*e = OAuth2DeviceCodeStatus(s)
+	case string:
+		*e = OAuth2DeviceCodeStatus(s)
+	default:
+		return fmt.Errorf("unsupported scan type for OAuth2DeviceCodeStatus: %T", src)
+	}
+	return nil
+}
+
+type NullOAuth2DeviceCodeStatus struct {
+	OAuth2DeviceCodeStatus OAuth2DeviceCodeStatus `json:"oauth2_device_code_status"`
+	Valid                  bool                   `json:"valid"` // Valid is true if OAuth2DeviceCodeStatus is not NULL
+}
+
+// Scan implements the Scanner interface.
+func (ns *NullOAuth2DeviceCodeStatus) Scan(value interface{}) error {
+	if value == nil {
+		ns.OAuth2DeviceCodeStatus, ns.Valid = "", false
+		return nil
+	}
+	ns.Valid = true
+	return ns.OAuth2DeviceCodeStatus.Scan(value)
+}
+
+// Value implements the driver Valuer interface.
+func (ns NullOAuth2DeviceCodeStatus) Value() (driver.Value, error) {
+	if !ns.Valid {
+		return nil, nil
+	}
+	return string(ns.OAuth2DeviceCodeStatus), nil
+}
+
+func (e OAuth2DeviceCodeStatus) Valid() bool {
+	switch e {
+	case OAuth2DeviceCodeStatusPending,
+		OAuth2DeviceCodeStatusApproved,
+		OAuth2DeviceCodeStatusDenied:
+		return true
+	}
+	return false
+}
+
+func AllOAuth2DeviceCodeStatusValues() []OAuth2DeviceCodeStatus {
+	return []OAuth2DeviceCodeStatus{
+		OAuth2DeviceCodeStatusPending,
+		OAuth2DeviceCodeStatusApproved,
+		OAuth2DeviceCodeStatusDenied,
+	}
+}
+
 type ParameterDestinationScheme string
 
 const (
@@ -3404,6 +3471,7 @@ const (
 	ProvisionerJobTypeTemplateVersionImport ProvisionerJobType = "template_version_import"
 	ProvisionerJobTypeWorkspaceBuild        ProvisionerJobType = "workspace_build"
 	ProvisionerJobTypeTemplateVersionDryRun ProvisionerJobType = "template_version_dry_run"
+	ProvisionerJobTypeWorkspaceDriftCheck   ProvisionerJobType = "workspace_drift_check"
 )
 
 func (e *ProvisionerJobType) Scan(src interface{}) error {
@@ -3445,7 +3513,8 @@ func (e ProvisionerJobType) Valid() bool {
 	switch e {
 	case ProvisionerJobTypeTemplateVersionImport,
 		ProvisionerJobTypeWorkspaceBuild,
-		ProvisionerJobTypeTemplateVersionDryRun:
+		ProvisionerJobTypeTemplateVersionDryRun,
+		ProvisionerJobTypeWorkspaceDriftCheck:
 		return true
 	}
 	return false
@@ -3456,6 +3525,7 @@ func AllProvisionerJobTypeValues() []ProvisionerJobType {
 		ProvisionerJobTypeTemplateVersionImport,
 		ProvisionerJobTypeWorkspaceBuild,
 		ProvisionerJobTypeTemplateVersionDryRun,
+		ProvisionerJobTypeWorkspaceDriftCheck,
 	}
 }
 
@@ -3613,6 +3683,7 @@ const (
 	ResourceTypeOauth2ProviderSettings      ResourceType = "oauth2_provider_settings"
 	ResourceTypeChatInstructionSettings     ResourceType = "chat_instruction_settings"
 	ResourceTypeMCPServerConfig             ResourceType = "mcp_server_config"
+	ResourceTypeWorkspaceBulkOperation      ResourceType = "workspace_bulk_operation"
 )
 
 func (e *ResourceType) Scan(src interface{}) error {
@@ -3689,7 +3760,8 @@ func (e ResourceType) Valid() bool {
 		ResourceTypeUserAIBudgetOverride,
 		ResourceTypeOauth2ProviderSettings,
 		ResourceTypeChatInstructionSettings,
-		ResourceTypeMCPServerConfig:
+		ResourceTypeMCPServerConfig,
+		ResourceTypeWorkspaceBulkOperation:
 		return true
 	}
 	return false
@@ -3735,6 +3807,7 @@ func AllResourceTypeValues() []ResourceType {
 		ResourceTypeOauth2ProviderSettings,
 		ResourceTypeChatInstructionSettings,
 		ResourceTypeMCPServerConfig,
+		ResourceTypeWorkspaceBulkOperation,
 	}
 }
 
@@ -3986,6 +4059,131 @@ func AllTaskStatusValues() []TaskStatus {
 }
 
 // Defines the users status: active, dormant, or suspended.
+type TemplateVersionRolloutFailureAction string
+
+const (
+	TemplateVersionRolloutFailureActionPause    TemplateVersionRolloutFailureAction = "pause"
+	TemplateVersionRolloutFailureActionRollback TemplateVersionRolloutFailureAction = "rollback"
+)
+
+func (e *TemplateVersionRolloutFailureAction) Scan(src interface{}) error {
+	switch s := src.(type) {
+	case []byte:
+		*e = TemplateVersionRolloutFailureAction(s)
+	case string:
+		*e = TemplateVersionRolloutFailureAction(s)
+	default:
+		return fmt.Errorf("unsupported scan type for TemplateVersionRolloutFailureAction: %T", src)
+	}
+	return nil
+}
+
+type NullTemplateVersionRolloutFailureAction struct {
+	TemplateVersionRolloutFailureAction TemplateVersionRolloutFailureAction `json:"template_version_rollout_failure_action"`
+	Valid                               bool                                `json:"valid"` // Valid is true if TemplateVersionRolloutFailureAction is not NULL
+}
+
+// Scan implements the Scanner interface.
+func (ns *NullTemplateVersionRolloutFailureAction) Scan(value interface{}) error {
+	if value == nil {
+		ns.TemplateVersionRolloutFailureAction, ns.Valid = "", false
+		return nil
+	}
+	ns.Valid = true
+	return ns.TemplateVersionRolloutFailureAction.Scan(value)
+}
+
+// Value implements the driver Valuer interface.
+func (ns NullTemplateVersionRolloutFailureAction) Value() (driver.Value, error) {
+	if !ns.Valid {
+		return nil, nil
+	}
+	return string(ns.TemplateVersionRolloutFailureAction), nil
+}
+
+func (e TemplateVersionRolloutFailureAction) Valid() bool {
+	switch e {
+	case TemplateVersionRolloutFailureActionPause,
+		TemplateVersionRolloutFailureActionRollback:
+		return true
+	}
+	return false
+}
+
+func AllTemplateVersionRolloutFailureActionValues() []TemplateVersionRolloutFailureAction {
+	return []TemplateVersionRolloutFailureAction{
+		TemplateVersionRolloutFailureActionPause,
+		TemplateVersionRolloutFailureActionRollback,
+	}
+}
+
+type TemplateVersionRolloutStatus string
+
+const (
+	TemplateVersionRolloutStatusInProgress TemplateVersionRolloutStatus = "in_progress"
+	TemplateVersionRolloutStatusPaused     TemplateVersionRolloutStatus = "paused"
+	TemplateVersionRolloutStatusCompleted  TemplateVersionRolloutStatus = "completed"
+	TemplateVersionRolloutStatusRolledBack TemplateVersionRolloutStatus = "rolled_back"
+	TemplateVersionRolloutStatusCanceled   TemplateVersionRolloutStatus = "canceled"
+)
+
+func (e *TemplateVersionRolloutStatus) Scan(src interface{}) error {
+	switch s := src.(type) {
+	case []byte:
+		*e = TemplateVersionRolloutStatus(s)
+	case string:
+		*e = TemplateVersionRolloutStatus(s)
+	default:
+		return fmt.Errorf("unsupported scan type for TemplateVersionRolloutStatus: %T", src)
+	}
+	return nil
+}
+
+type NullTemplateVersionRolloutStatus struct {
+	TemplateVersionRolloutStatus TemplateVersionRolloutStatus `json:"template_version_rollout_status"`
+	Valid                        bool                         `json:"valid"` // Valid is true if TemplateVersionRolloutStatus is not NULL
+}
+
+// Scan implements the Scanner interface.
+func (ns *NullTemplateVersionRolloutStatus) Scan(value interface{}) error {
+	if value == nil {
+		ns.TemplateVersionRolloutStatus, ns.Valid = "", false
+		return nil
+	}
+	ns.Valid = true
+	return ns.TemplateVersionRolloutStatus.Scan(value)
+}
+
+// Value implements the driver Valuer interface.
+func (ns NullTemplateVersionRolloutStatus) Value() (driver.Value, error) {
+	if !ns.Valid {
+		return nil, nil
+	}
+	return string(ns.TemplateVersionRolloutStatus), nil
+}
+
+func (e TemplateVersionRolloutStatus) Valid() bool {
+	switch e {
+	case TemplateVersionRolloutStatusInProgress,
+		TemplateVersionRolloutStatusPaused,
+		TemplateVersionRolloutStatusCompleted,
+		TemplateVersionRolloutStatusRolledBack,
+		TemplateVersionRolloutStatusCanceled:
+		return true
+	}
+	return false
+}
+
+func AllTemplateVersionRolloutStatusValues() []TemplateVersionRolloutStatus {
+	return []TemplateVersionRolloutStatus{
+		TemplateVersionRolloutStatusInProgress,
+		TemplateVersionRolloutStatusPaused,
+		TemplateVersionRolloutStatusCompleted,
+		TemplateVersionRolloutStatusRolledBack,
+		TemplateVersionRolloutStatusCanceled,
+	}
+}
+
 type UserStatus string
 
 const (
@@ -4327,6 +4525,67 @@ func AllWorkspaceAgentMonitorStateValues() []WorkspaceAgentMonitorState {
 	}
 }
 
+type WorkspaceAgentResourceMonitorType string
+
+const (
+	WorkspaceAgentResourceMonitorTypeCpu       WorkspaceAgentResourceMonitorType = "cpu"
+	WorkspaceAgentResourceMonitorTypeProcesses WorkspaceAgentResourceMonitorType = "processes"
+	WorkspaceAgentResourceMonitorTypeInodes    WorkspaceAgentResourceMonitorType = "inodes"
+)
+
+func (e *WorkspaceAgentResourceMonitorType) Scan(src interface{}) error {
+	switch s := src.(type) {
+	case []byte:
+		*e = WorkspaceAgentResourceMonitorType(s)
+	case string:
+		*e = WorkspaceAgentResourceMonitorType(s)
+	default:
+		return fmt.Errorf("unsupported scan type for WorkspaceAgentResourceMonitorType: %T", src)
+	}
+	return nil
+}
+
+type NullWorkspaceAgentResourceMonitorType struct {
+	WorkspaceAgentResourceMonitorType WorkspaceAgentResourceMonitorType `json:"workspace_agent_resource_monitor_type"`
+	Valid                             bool                              `json:"valid"` // Valid is true if WorkspaceAgentResourceMonitorType is not NULL
+}
+
+// Scan implements the Scanner interface.
+func (ns *NullWorkspaceAgentResourceMonitorType) Scan(value interface{}) error {
+	if value == nil {
+		ns.WorkspaceAgentResourceMonitorType, ns.Valid = "", false
+		return nil
+	}
+	ns.Valid = true
+	return ns.WorkspaceAgentResourceMonitorType.Scan(value)
+}
+
+// Value implements the driver Valuer interface.
+func (ns NullWorkspaceAgentResourceMonitorType) Value() (driver.Value, error) {
+	if !ns.Valid {
+		return nil, nil
+	}
+	return string(ns.WorkspaceAgentResourceMonitorType), nil
+}
+
+func (e WorkspaceAgentResourceMonitorType) Valid() bool {
+	switch e {
+	case WorkspaceAgentResourceMonitorTypeCpu,
+		WorkspaceAgentResourceMonitorTypeProcesses,
+		WorkspaceAgentResourceMonitorTypeInodes:
+		return true
+	}
+	return false
+}
+
+func AllWorkspaceAgentResourceMonitorTypeValues() []WorkspaceAgentResourceMonitorType {
+	return []WorkspaceAgentResourceMonitorType{
+		WorkspaceAgentResourceMonitorTypeCpu,
+		WorkspaceAgentResourceMonitorTypeProcesses,
+		WorkspaceAgentResourceMonitorTypeInodes,
+	}
+}
+
 // What stage the script was ran in.
 type WorkspaceAgentScriptTimingStage string
 
@@ -4707,6 +4966,207 @@ func AllWorkspaceAppStatusStateValues() []WorkspaceAppStatusState {
 	}
 }
 
+type WorkspaceBulkOperationAction string
+
+const (
+	WorkspaceBulkOperationActionStart            WorkspaceBulkOperationAction = "start"
+	WorkspaceBulkOperationActionStop             WorkspaceBulkOperationAction = "stop"
+	WorkspaceBulkOperationActionUpdate           WorkspaceBulkOperationAction = "update"
+	WorkspaceBulkOperationActionDelete           WorkspaceBulkOperationAction = "delete"
+	WorkspaceBulkOperationActionAutostart        WorkspaceBulkOperationAction = "autostart"
+	WorkspaceBulkOperationActionTtl              WorkspaceBulkOperationAction = "ttl"
+	WorkspaceBulkOperationActionAutomaticUpdates WorkspaceBulkOperationAction = "automatic_updates"
+)
+
+func (e *WorkspaceBulkOperationAction) Scan(src interface{}) error {
+	switch s := src.(type) {
+	case []byte:
+		*e = WorkspaceBulkOperationAction(s)
+	case string:
+		*e = WorkspaceBulkOperationAction(s)
+	default:
+		return fmt.Errorf("unsupported scan type for WorkspaceBulkOperationAction: %T", src)
+	}
+	return nil
+}
+
+type NullWorkspaceBulkOperationAction struct {
+	WorkspaceBulkOperationAction WorkspaceBulkOperationAction `json:"workspace_bulk_operation_action"`
+	Valid                        bool                         `json:"valid"` // Valid is true if WorkspaceBulkOperationAction is not NULL
+}
+
+// Scan implements the Scanner interface.
+func (ns *NullWorkspaceBulkOperationAction) Scan(value interface{}) error {
+	if value == nil {
+		ns.WorkspaceBulkOperationAction, ns.Valid = "", false
+		return nil
+	}
+	ns.Valid = true
+	return ns.WorkspaceBulkOperationAction.Scan(value)
+}
+
+// Value implements the driver Valuer interface.
+func (ns NullWorkspaceBulkOperationAction) Value() (driver.Value, error) {
+	if !ns.Valid {
+		return nil, nil
+	}
+	return string(ns.WorkspaceBulkOperationAction), nil
+}
+
+func (e WorkspaceBulkOperationAction) Valid() bool {
+	switch e {
+	case WorkspaceBulkOperationActionStart,
+		WorkspaceBulkOperationActionStop,
+		WorkspaceBulkOperationActionUpdate,
+		WorkspaceBulkOperationActionDelete,
+		WorkspaceBulkOperationActionAutostart,
+		WorkspaceBulkOperationActionTtl,
+		WorkspaceBulkOperationActionAutomaticUpdates:
+		return true
+	}
+	return false
+}
+
+func AllWorkspaceBulkOperationActionValues() []WorkspaceBulkOperationAction {
+	return []WorkspaceBulkOperationAction{
+		WorkspaceBulkOperationActionStart,
+		WorkspaceBulkOperationActionStop,
+		WorkspaceBulkOperationActionUpdate,
+		WorkspaceBulkOperationActionDelete,
+		WorkspaceBulkOperationActionAutostart,
+		WorkspaceBulkOperationActionTtl,
+		WorkspaceBulkOperationActionAutomaticUpdates,
+	}
+}
+
+type WorkspaceBulkOperationStatus string
+
+const (
+	WorkspaceBulkOperationStatusRunning   WorkspaceBulkOperationStatus = "running"
+	WorkspaceBulkOperationStatusCompleted WorkspaceBulkOperationStatus = "completed"
+	WorkspaceBulkOperationStatusCanceled  WorkspaceBulkOperationStatus = "canceled"
+)
+
+func (e *WorkspaceBulkOperationStatus) Scan(src interface{}) error {
+	switch s := src.(type) {
+	case []byte:
+		*e = WorkspaceBulkOperationStatus(s)
+	case string:
+		*e = WorkspaceBulkOperationStatus(s)
+	default:
+		return fmt.Errorf("unsupported scan type for WorkspaceBulkOperationStatus: %T", src)
+	}
+	return nil
+}
+
+type NullWorkspaceBulkOperationStatus struct {
+	WorkspaceBulkOperationStatus WorkspaceBulkOperationStatus `json:"workspace_bulk_operation_status"`
+	Valid                        bool                         `json:"valid"` // Valid is true if WorkspaceBulkOperationStatus is not NULL
+}
+
+// Scan implements the Scanner interface.
+func (ns *NullWorkspaceBulkOperationStatus) Scan(value interface{}) error {
+	if value == nil {
+		ns.WorkspaceBulkOperationStatus, ns.Valid = "", false
+		return nil
+	}
+	ns.Valid = true
+	return ns.WorkspaceBulkOperationStatus.Scan(value)
+}
+
+// Value implements the driver Valuer interface.
+func (ns NullWorkspaceBulkOperationStatus) Value() (driver.Value, error) {
+	if !ns.Valid {
+		return nil, nil
+	}
+	return string(ns.WorkspaceBulkOperationStatus), nil
+}
+
+func (e WorkspaceBulkOperationStatus) Valid() bool {
+	switch e {
+	case WorkspaceBulkOperationStatusRunning,
+		WorkspaceBulkOperationStatusCompleted,
+		WorkspaceBulkOperationStatusCanceled:
+		return true
+	}
+	return false
+}
+
+func AllWorkspaceBulkOperationStatusValues() []WorkspaceBulkOperationStatus {
+	return []WorkspaceBulkOperationStatus{
+		WorkspaceBulkOperationStatusRunning,
+		WorkspaceBulkOperationStatusCompleted,
+		WorkspaceBulkOperationStatusCanceled,
+	}
+}
+
+type WorkspaceBulkOperationWorkspaceStatus string
+
+const (
+	WorkspaceBulkOperationWorkspaceStatusPending   WorkspaceBulkOperationWorkspaceStatus = "pending"
+	WorkspaceBulkOperationWorkspaceStatusRunning   WorkspaceBulkOperationWorkspaceStatus = "running"
+	WorkspaceBulkOperationWorkspaceStatusSucceeded WorkspaceBulkOperationWorkspaceStatus = "succeeded"
+	WorkspaceBulkOperationWorkspaceStatusFailed    WorkspaceBulkOperationWorkspaceStatus = "failed"
+	WorkspaceBulkOperationWorkspaceStatusSkipped   WorkspaceBulkOperationWorkspaceStatus = "skipped"
+)
+
+func (e *WorkspaceBulkOperationWorkspaceStatus) Scan(src interface{}) error {
+	switch s := src.(type) {
+	case []byte:
+		*e = WorkspaceBulkOperationWorkspaceStatus(s)
+	case string:
+		*e = WorkspaceBulkOperationWorkspaceStatus(s)
+	default:
+		return fmt.Errorf("unsupported scan type for WorkspaceBulkOperationWorkspaceStatus: %T", src)
+	}
+	return nil
+}
+
+type NullWorkspaceBulkOperationWorkspaceStatus struct {
+	WorkspaceBulkOperationWorkspaceStatus WorkspaceBulkOperationWorkspaceStatus `json:"workspace_bulk_operation_workspace_status"`
+	Valid                                 bool                                  `json:"valid"` // Valid is true if WorkspaceBulkOperationWorkspaceStatus is not NULL
+}
+
+// Scan implements the Scanner interface.
+func (ns *NullWorkspaceBulkOperationWorkspaceStatus) Scan(value interface{}) error {
+	if value == nil {
+		ns.WorkspaceBulkOperationWorkspaceStatus, ns.Valid = "", false
+		return nil
+	}
+	ns.Valid = true
+	return ns.WorkspaceBulkOperationWorkspaceStatus.Scan(value)
+}
+
+// Value implements the driver Valuer interface.
+func (ns NullWorkspaceBulkOperationWorkspaceStatus) Value() (driver.Value, error) {
+	if !ns.Valid {
+		return nil, nil
+	}
+	return string(ns.WorkspaceBulkOperationWorkspaceStatus), nil
+}
+
+func (e WorkspaceBulkOperationWorkspaceStatus) Valid() bool {
+	switch e {
+	case WorkspaceBulkOperationWorkspaceStatusPending,
+		WorkspaceBulkOperationWorkspaceStatusRunning,
+		WorkspaceBulkOperationWorkspaceStatusSucceeded,
+		WorkspaceBulkOperationWorkspaceStatusFailed,
+		WorkspaceBulkOperationWorkspaceStatusSkipped:
+		return true
+	}
+	return false
+}
+
+func AllWorkspaceBulkOperationWorkspaceStatusValues() []WorkspaceBulkOperationWorkspaceStatus {
+	return []WorkspaceBulkOperationWorkspaceStatus{
+		WorkspaceBulkOperationWorkspaceStatusPending,
+		WorkspaceBulkOperationWorkspaceStatusRunning,
+		WorkspaceBulkOperationWorkspaceStatusSucceeded,
+		WorkspaceBulkOperationWorkspaceStatusFailed,
+		WorkspaceBulkOperationWorkspaceStatusSkipped,
+	}
+}
+
 type WorkspaceTransition string
 
 const (
@@ -4959,6 +5419,70 @@ type APIKey struct {
 	AllowList       AllowList    `db:"allow_list" json:"allow_list"`
 }
 
+type ArchivedAIBridgeInterception struct {
+	ID            uuid.UUID             `db:"id" json:"id"`
+	InitiatorID   uuid.UUID             `db:"initiator_id" json:"initiator_id"`
+	Provider      string                `db:"provider" json:"provider"`
+	Model         string                `db:"model" json:"model"`
+	StartedAt     time.Time             `db:"started_at" json:"started_at"`
+	EndedAt       sql.NullTime          `db:"ended_at" json:"ended_at"`
+	Metadata      pqtype.NullRawMessage `db:"metadata" json:"metadata"`
+	TokenUsages   json.RawMessage       `db:"token_usages" json:"token_usages"`
+	UserPrompts   json.RawMessage       `db:"user_prompts" json:"user_prompts"`
+	ToolUsages    json.RawMessage       `db:"tool_usages" json:"tool_usages"`
+	ModelThoughts json.RawMessage       `db:"model_thoughts" json:"model_thoughts"`
+	ArchiveName   string                `db:"archive_name" json:"archive_name"`
+}
+
+type ArchivedAuditLog struct {
+	ID               uuid.UUID       `db:"id" json:"id"`
+	Time             time.Time       `db:"time" json:"time"`
+	UserID           uuid.UUID       `db:"user_id" json:"user_id"`
+	OrganizationID   uuid.UUID       `db:"organization_id" json:"organization_id"`
+	Ip               pqtype.Inet     `db:"ip" json:"ip"`
+	UserAgent        sql.NullString  `db:"user_agent" json:"user_agent"`
+	ResourceType     string          `db:"resource_type" json:"resource_type"`
+	ResourceID       uuid.UUID       `db:"resource_id" json:"resource_id"`
+	ResourceTarget   string          `db:"resource_target" json:"resource_target"`
+	Action           string          `db:"action" json:"action"`
+	Diff             json.RawMessage `db:"diff" json:"diff"`
+	StatusCode       int32           `db:"status_code" json:"status_code"`
+	AdditionalFields json.RawMessage `db:"additional_fields" json:"additional_fields"`
+	RequestID        uuid.UUID       `db:"request_id" json:"request_id"`
+	ResourceIcon     string          `db:"resource_icon" json:"resource_icon"`
+	ArchiveName      string          `db:"archive_name" json:"archive_name"`
+}
+
+type ArchivedConnectionLog struct {
+	ID               uuid.UUID      `db:"id" json:"id"`
+	ConnectTime      time.Time      `db:"connect_time" json:"connect_time"`
+	OrganizationID   uuid.UUID      `db:"organization_id" json:"organization_id"`
+	WorkspaceOwnerID uuid.UUID      `db:"workspace_owner_id" json:"workspace_owner_id"`
+	WorkspaceID      uuid.UUID      `db:"workspace_id" json:"workspace_id"`
+	WorkspaceName    string         `db:"workspace_name" json:"workspace_name"`
+	AgentName        string         `db:"agent_name" json:"agent_name"`
+	Type             string         `db:"type" json:"type"`
+	Ip               pqtype.Inet    `db:"ip" json:"ip"`
+	Code             sql.NullInt32  `db:"code" json:"code"`
+	UserAgent        sql.NullString `db:"user_agent" json:"user_agent"`
+	UserID           uuid.NullUUID  `db:"user_id" json:"user_id"`
+	SlugOrPort       sql.NullString `db:"slug_or_port" json:"slug_or_port"`
+	ConnectionID     uuid.NullUUID  `db:"connection_id" json:"connection_id"`
+	DisconnectTime   sql.NullTime   `db:"disconnect_time" json:"disconnect_time"`
+	DisconnectReason sql.NullString `db:"disconnect_reason" json:"disconnect_reason"`
+	ArchiveName      string         `db:"archive_name" json:"archive_name"`
+}
+
+type ArchivedWorkspaceAgentLog struct {
+	ID          int64     `db:"id" json:"id"`
+	AgentID     uuid.UUID `db:"agent_id" json:"agent_id"`
+	CreatedAt   time.Time `db:"created_at" json:"created_at"`
+	Output      string    `db:"output" json:"output"`
+	Level       string    `db:"level" json:"level"`
+	LogSourceID uuid.UUID `db:"log_source_id" json:"log_source_id"`
+	ArchiveName string    `db:"archive_name" json:"archive_name"`
+}
+
 type AuditLog struct {
 	ID               uuid.UUID       `db:"id" json:"id"`
 	Time             time.Time       `db:"time" json:"time"`
@@ -5654,6 +6178,8 @@ type OAuth2ProviderApp struct {
 	RegistrationAccessToken []byte `db:"registration_access_token" json:"registration_access_token"`
 	// RFC 7592: URI for client configuration endpoint
 	RegistrationClientUri sql.NullString `db:"registration_client_uri" json:"registration_client_uri"`
+	// Service account that tokens from the client_credentials grant are issued for. NULL disables the grant for this app.
+	ClientCredentialsUserID uuid.NullUUID `db:"client_credentials_user_id" json:"client_credentials_user_id"`
 }
 
 // Codes are meant to be exchanged for access tokens.
@@ -5679,6 +6205,28 @@ type OAuth2ProviderAppCode struct {
 	Scope string `db:"scope" json:"scope"`
 }
 
+// RFC 8628 device codes, exchanged for access tokens once a user approves them.
+type OAuth2ProviderAppDeviceCode struct {
+	ID               uuid.UUID `db:"id" json:"id"`
+	CreatedAt        time.Time `db:"created_at" json:"created_at"`
+	ExpiresAt        time.Time `db:"expires_at" json:"expires_at"`
+	DeviceCodePrefix []byte    `db:"device_code_prefix" json:"device_code_prefix"`
+	DeviceCodeHash   []byte    `db:"device_code_hash" json:"device_code_hash"`
+	// Short code the user enters on the verification page. Stored normalized: upper case without separators.
+	UserCode string    `db:"user_code" json:"user_code"`
+	AppID    uuid.UUID `db:"app_id" json:"app_id"`
+	// The user that approved or denied the code. NULL while the code is pending.
+	UserID uuid.NullUUID          `db:"user_id" json:"user_id"`
+	Status OAuth2DeviceCodeStatus `db:"status" json:"status"`
+	// RFC 8707 resource parameter for audience restriction
+	ResourceUri sql.NullString `db:"resource_uri" json:"resource_uri"`
+	Scope       string         `db:"scope" json:"scope"`
+	// Minimum seconds between token requests. Raised each time the client polls too fast (RFC 8628 §3.5 slow_down).
+	PollingInterval int32 `db:"polling_interval" json:"polling_interval"`
+	// When the client last polled the token endpoint with this code.
+	LastPolledAt sql.NullTime `db:"last_polled_at" json:"last_polled_at"`
+}
+
 type OAuth2ProviderAppSecret struct {
 	ID           uuid.UUID    `db:"id" json:"id"`
 	CreatedAt    time.Time    `db:"created_at" json:"created_at"`
@@ -5805,6 +6353,8 @@ type ProvisionerJob struct {
 	LogsLength int32 `db:"logs_length" json:"logs_length"`
 	// Whether the provisioner logs overflowed in length
 	LogsOverflowed bool `db:"logs_overflowed" json:"logs_overflowed"`
+	// Jobs with a higher priority are acquired first. Computed when the job is inserted from the job's priority class and any organization or template priority boosts.
+	Priority int32 `db:"priority" json:"priority"`
 }
 
 type ProvisionerJobLog struct {
@@ -5817,6 +6367,15 @@ type ProvisionerJobLog struct {
 	ID        int64     `db:"id" json:"id"`
 }
 
+// Priority boosts applied to provisioner jobs of an organization or template.
+type ProvisionerJobPriority struct {
+	OrganizationID uuid.UUID `db:"organization_id" json:"organization_id"`
+	// The template the boost applies to. uuid.Nil means the boost applies to every job in the organization.
+	TemplateID uuid.UUID `db:"template_id" json:"template_id"`
+	Priority   int32     `db:"priority" json:"priority"`
+	UpdatedAt  time.Time `db:"updated_at" json:"updated_at"`
+}
+
 type ProvisionerJobStat struct {
 	JobID          uuid.UUID            `db:"job_id" json:"job_id"`
 	JobStatus      ProvisionerJobStatus `db:"job_status" json:"job_status"`
@@ -5853,6 +6412,22 @@ type ProvisionerKey struct {
 	Tags           StringMap `db:"tags" json:"tags"`
 }
 
+// Archives of purged records that have been re-imported into the database.
+type PurgeArchiveImport struct {
+	ID uuid.UUID `db:"id" json:"id"`
+	// Name of the archive in the archive sink.
+	Name       string    `db:"name" json:"name"`
+	RecordType string    `db:"record_type" json:"record_type"`
+	RowCount   int64     `db:"row_count" json:"row_count"`
+	ImportedAt time.Time `db:"imported_at" json:"imported_at"`
+}
+
+// Rows of imported archives, as exported by to_jsonb when they were purged.
+type PurgeArchiveRecord struct {
+	ImportID uuid.UUID       `db:"import_id" json:"import_id"`
+	Record   json.RawMessage `db:"record" json:"record"`
+}
+
 type Replica struct {
 	ID        uuid.UUID    `db:"id" json:"id"`
 	CreatedAt time.Time    `db:"created_at" json:"created_at"`
@@ -5873,6 +6448,25 @@ type Replica struct {
 	NATSPort int32 `db:"nats_port" json:"nats_port"`
 }
 
+// Calendars of dates on which workspaces of the templates they are attached to do not autostart.
+type ScheduleExceptionCalendar struct {
+	ID             uuid.UUID `db:"id" json:"id"`
+	OrganizationID uuid.UUID `db:"organization_id" json:"organization_id"`
+	Name           string    `db:"name" json:"name"`
+	Description    string    `db:"description" json:"description"`
+	CreatedAt      time.Time `db:"created_at" json:"created_at"`
+	UpdatedAt      time.Time `db:"updated_at" json:"updated_at"`
+}
+
+type ScheduleExceptionCalendarDate struct {
+	CalendarID uuid.UUID `db:"calendar_id" json:"calendar_id"`
+	// First date of the exception, inclusive. Dates are interpreted in the timezone of each workspace's autostart schedule.
+	StartDate time.Time `db:"start_date" json:"start_date"`
+	// Last date of the exception, inclusive.
+	EndDate time.Time `db:"end_date" json:"end_date"`
+	Summary string    `db:"summary" json:"summary"`
+}
+
 type SiteConfig struct {
 	Key   string `db:"key" json:"key"`
 	Value string `db:"value" json:"value"`
@@ -6007,6 +6601,7 @@ type Template struct {
 	DisableModuleCache            bool            `db:"disable_module_cache" json:"disable_module_cache"`
 	TimeTilAutostopNotify         int64           `db:"time_til_autostop_notify" json:"time_til_autostop_notify"`
 	AgentsAllowed                 bool            `db:"agents_allowed" json:"agents_allowed"`
+	DriftCheckInterval            int64           `db:"drift_check_interval" json:"drift_check_interval"`
 	CreatedByAvatarURL            string          `db:"created_by_avatar_url" json:"created_by_avatar_url"`
 	CreatedByUsername             string          `db:"created_by_username" json:"created_by_username"`
 	CreatedByName                 string          `db:"created_by_name" json:"created_by_name"`
@@ -6015,6 +6610,11 @@ type Template struct {
 	OrganizationIcon              string          `db:"organization_icon" json:"organization_icon"`
 }
 
+type TemplateScheduleExceptionCalendar struct {
+	TemplateID uuid.UUID `db:"template_id" json:"template_id"`
+	CalendarID uuid.UUID `db:"calendar_id" json:"calendar_id"`
+}
+
 type TemplateTable struct {
 	ID              uuid.UUID       `db:"id" json:"id"`
 	CreatedAt       time.Time       `db:"created_at" json:"created_at"`
@@ -6061,6 +6661,8 @@ type TemplateTable struct {
 	TimeTilAutostopNotify int64 `db:"time_til_autostop_notify" json:"time_til_autostop_notify"`
 	// Whether Coder Agents can create workspaces using this template.
 	AgentsAllowed bool `db:"agents_allowed" json:"agents_allowed"`
+	// How often running workspaces of this template are checked for infrastructure drift, in nanoseconds. 0 disables drift detection.
+	DriftCheckInterval int64 `db:"drift_check_interval" json:"drift_check_interval"`
 }
 
 // Records aggregated usage statistics for templates/users. All usage is rounded up to the nearest minute.
@@ -6182,6 +6784,32 @@ type TemplateVersionPresetPrebuildSchedule struct {
 	DesiredInstances int32     `db:"desired_instances" json:"desired_instances"`
 }
 
+// Staged rollouts of a template version to a subset of the workspaces that follow the active version.
+type TemplateVersionRollout struct {
+	ID                uuid.UUID `db:"id" json:"id"`
+	TemplateID        uuid.UUID `db:"template_id" json:"template_id"`
+	TemplateVersionID uuid.UUID `db:"template_version_id" json:"template_version_id"`
+	// The active version of the template when the rollout was created. Workspaces outside of the rollout keep using the active version.
+	PreviousTemplateVersionID uuid.UUID `db:"previous_template_version_id" json:"previous_template_version_id"`
+	// The percentage of workspaces, chosen by a stable hash of the workspace ID, that are updated to the rollout version.
+	Percentage int32 `db:"percentage" json:"percentage"`
+	// Workspaces owned by members of these groups are updated to the rollout version, regardless of the percentage.
+	GroupIDs []uuid.UUID `db:"group_ids" json:"group_ids"`
+	// The ratio of failed builds or agents, between 0 and 1, above which the failure action is taken.
+	FailureThreshold float64 `db:"failure_threshold" json:"failure_threshold"`
+	// The minimum number of finished builds or agents before the failure ratio is evaluated.
+	MinSamples    int32                               `db:"min_samples" json:"min_samples"`
+	FailureAction TemplateVersionRolloutFailureAction `db:"failure_action" json:"failure_action"`
+	Status        TemplateVersionRolloutStatus        `db:"status" json:"status"`
+	StatusMessage string                              `db:"status_message" json:"status_message"`
+	CreatedBy     uuid.UUID                           `db:"created_by" json:"created_by"`
+	CreatedAt     time.Time                           `db:"created_at" json:"created_at"`
+	UpdatedAt     time.Time                           `db:"updated_at" json:"updated_at"`
+	// Builds and agents since this time are used to evaluate the rollout. Reset when a paused rollout is resumed.
+	StartedAt  time.Time    `db:"started_at" json:"started_at"`
+	FinishedAt sql.NullTime `db:"finished_at" json:"finished_at"`
+}
+
 type TemplateVersionTable struct {
 	ID             uuid.UUID     `db:"id" json:"id"`
 	TemplateID     uuid.NullUUID `db:"template_id" json:"template_id"`
@@ -6339,6 +6967,18 @@ type UserLink struct {
 	OAuthRefreshTokenKeyID sql.NullString `db:"oauth_refresh_token_key_id" json:"oauth_refresh_token_key_id"`
 	// Claims from the IDP for the linked user. Includes both id_token and userinfo claims.
 	Claims UserLinkClaims `db:"claims" json:"claims"`
+	// The ID of the named OIDC provider the user is linked to. Empty for the deployment's primary OIDC provider and for non-OIDC login types.
+	OIDCProviderID string `db:"oidc_provider_id" json:"oidc_provider_id"`
+}
+
+// Dates on which a user's workspaces do not autostart.
+type UserOutOfOfficeRange struct {
+	ID        uuid.UUID `db:"id" json:"id"`
+	UserID    uuid.UUID `db:"user_id" json:"user_id"`
+	StartDate time.Time `db:"start_date" json:"start_date"`
+	EndDate   time.Time `db:"end_date" json:"end_date"`
+	Reason    string    `db:"reason" json:"reason"`
+	CreatedAt time.Time `db:"created_at" json:"created_at"`
 }
 
 type UserSecret struct {
@@ -6546,6 +7186,17 @@ type WorkspaceAgentLogSource struct {
 	Icon             string    `db:"icon" json:"icon"`
 }
 
+// Log files and journald units that the agent tails into a log source.
+type WorkspaceAgentLogTail struct {
+	WorkspaceAgentID uuid.UUID `db:"workspace_agent_id" json:"workspace_agent_id"`
+	LogSourceID      uuid.UUID `db:"log_source_id" json:"log_source_id"`
+	CreatedAt        time.Time `db:"created_at" json:"created_at"`
+	// Paths of log files to tail. Paths may contain glob patterns.
+	Paths []string `db:"paths" json:"paths"`
+	// Names of systemd units whose journal is tailed.
+	JournaldUnits []string `db:"journald_units" json:"journald_units"`
+}
+
 type WorkspaceAgentMemoryResourceMonitor struct {
 	AgentID        uuid.UUID                  `db:"agent_id" json:"agent_id"`
 	Enabled        bool                       `db:"enabled" json:"enabled"`
@@ -6578,6 +7229,48 @@ type WorkspaceAgentPortShare struct {
 	Protocol    PortShareProtocol `db:"protocol" json:"protocol"`
 }
 
+// Connections of viewers to shared reconnecting PTY sessions.
+type WorkspaceAgentPTYShareViewer struct {
+	// Also used as the connection ID in the connection log.
+	ID             uuid.UUID    `db:"id" json:"id"`
+	ShareID        uuid.UUID    `db:"share_id" json:"share_id"`
+	UserID         uuid.UUID    `db:"user_id" json:"user_id"`
+	Ip             pqtype.Inet  `db:"ip" json:"ip"`
+	ConnectedAt    time.Time    `db:"connected_at" json:"connected_at"`
+	DisconnectedAt sql.NullTime `db:"disconnected_at" json:"disconnected_at"`
+}
+
+// Read-only shares of reconnecting PTY sessions.
+type WorkspaceAgentPTYShare struct {
+	ID      uuid.UUID `db:"id" json:"id"`
+	AgentID uuid.UUID `db:"agent_id" json:"agent_id"`
+	// ID of the shared reconnecting PTY session on the agent.
+	ReconnectID uuid.UUID `db:"reconnect_id" json:"reconnect_id"`
+	CreatedBy   uuid.UUID `db:"created_by" json:"created_by"`
+	// SHA-256 hash of the share token. The token itself is only returned when the share is created.
+	HashedToken []byte `db:"hashed_token" json:"hashed_token"`
+	// Users invited to watch the session.
+	UserIds   []uuid.UUID  `db:"user_ids" json:"user_ids"`
+	CreatedAt time.Time    `db:"created_at" json:"created_at"`
+	ExpiresAt time.Time    `db:"expires_at" json:"expires_at"`
+	RevokedAt sql.NullTime `db:"revoked_at" json:"revoked_at"`
+}
+
+// Resource monitors of a workspace agent other than memory and volume usage.
+type WorkspaceAgentResourceMonitor struct {
+	AgentID uuid.UUID                         `db:"agent_id" json:"agent_id"`
+	Type    WorkspaceAgentResourceMonitorType `db:"type" json:"type"`
+	// Path of the volume for inodes monitors, empty for other types.
+	Path    string `db:"path" json:"path"`
+	Enabled bool   `db:"enabled" json:"enabled"`
+	// Percentage of the resource in use above which the monitor is not OK.
+	Threshold      int32                      `db:"threshold" json:"threshold"`
+	CreatedAt      time.Time                  `db:"created_at" json:"created_at"`
+	UpdatedAt      time.Time                  `db:"updated_at" json:"updated_at"`
+	State          WorkspaceAgentMonitorState `db:"state" json:"state"`
+	DebouncedUntil time.Time                  `db:"debounced_until" json:"debounced_until"`
+}
+
 type WorkspaceAgentScript struct {
 	WorkspaceAgentID uuid.UUID `db:"workspace_agent_id" json:"workspace_agent_id"`
 	LogSourceID      uuid.UUID `db:"log_source_id" json:"log_source_id"`
@@ -6658,6 +7351,10 @@ type WorkspaceApp struct {
 	DisplayGroup sql.NullString     `db:"display_group" json:"display_group"`
 	// Markdown text that is displayed when hovering over workspace apps.
 	Tooltip string `db:"tooltip" json:"tooltip"`
+	// The host:port that must accept TCP connections for the app to be healthy. Set instead of healthcheck_url for TCP healthchecks.
+	HealthcheckAddress string `db:"healthcheck_address" json:"healthcheck_address"`
+	// The command run in the workspace that must exit with status 0 for the app to be healthy. Set instead of healthcheck_url for exec healthchecks.
+	HealthcheckCommand string `db:"healthcheck_command" json:"healthcheck_command"`
 }
 
 // Audit sessions for workspace apps, the data in this table is ephemeral and is used to deduplicate audit log entries for workspace apps. While a session is active, the same data will not be logged again. This table does not store historical data.
@@ -6797,6 +7494,57 @@ type WorkspaceBuildTable struct {
 	NotifiedAutostopDeadline time.Time `db:"notified_autostop_deadline" json:"notified_autostop_deadline"`
 }
 
+// Actions applied to every workspace that matched a search query when the operation was created.
+type WorkspaceBulkOperation struct {
+	ID          uuid.UUID                    `db:"id" json:"id"`
+	InitiatorID uuid.UUID                    `db:"initiator_id" json:"initiator_id"`
+	Action      WorkspaceBulkOperationAction `db:"action" json:"action"`
+	// The workspace search query, evaluated with the permissions of the initiator.
+	Query string `db:"query" json:"query"`
+	// The autostart schedule set by the autostart action. Empty disables autostart.
+	AutostartSchedule string `db:"autostart_schedule" json:"autostart_schedule"`
+	// The autostop TTL set by the ttl action. Zero disables autostop.
+	TTLMillis        int64            `db:"ttl_ms" json:"ttl_ms"`
+	AutomaticUpdates AutomaticUpdates `db:"automatic_updates" json:"automatic_updates"`
+	// The maximum number of workspaces of the operation that are processed at the same time.
+	Concurrency    int32                        `db:"concurrency" json:"concurrency"`
+	WorkspaceCount int32                        `db:"workspace_count" json:"workspace_count"`
+	Status         WorkspaceBulkOperationStatus `db:"status" json:"status"`
+	CreatedAt      time.Time                    `db:"created_at" json:"created_at"`
+	UpdatedAt      time.Time                    `db:"updated_at" json:"updated_at"`
+	CompletedAt    sql.NullTime                 `db:"completed_at" json:"completed_at"`
+	// The scopes of the API key that created the operation, which limit what the operation can do on behalf of the initiator.
+	APIKeyScopes APIKeyScopes `db:"api_key_scopes" json:"api_key_scopes"`
+	// The allow list of the API key that created the operation.
+	APIKeyAllowList AllowList `db:"api_key_allow_list" json:"api_key_allow_list"`
+}
+
+// The outcome of a workspace bulk operation for each of its workspaces.
+type WorkspaceBulkOperationWorkspace struct {
+	OperationID uuid.UUID                             `db:"operation_id" json:"operation_id"`
+	WorkspaceID uuid.UUID                             `db:"workspace_id" json:"workspace_id"`
+	Status      WorkspaceBulkOperationWorkspaceStatus `db:"status" json:"status"`
+	// The build created for the workspace by the start, stop, update and delete actions.
+	BuildID   uuid.NullUUID `db:"build_id" json:"build_id"`
+	Error     string        `db:"error" json:"error"`
+	UpdatedAt time.Time     `db:"updated_at" json:"updated_at"`
+}
+
+// Refresh-only Terraform plans run against running workspaces to detect infrastructure that changed outside of Coder.
+type WorkspaceDriftCheck struct {
+	ID          uuid.UUID `db:"id" json:"id"`
+	WorkspaceID uuid.UUID `db:"workspace_id" json:"workspace_id"`
+	// The build whose Terraform state was checked.
+	WorkspaceBuildID uuid.UUID `db:"workspace_build_id" json:"workspace_build_id"`
+	JobID            uuid.UUID `db:"job_id" json:"job_id"`
+	CreatedAt        time.Time `db:"created_at" json:"created_at"`
+	// When the drift check job completed successfully. NULL while the job is pending or if it failed.
+	CheckedAt sql.NullTime `db:"checked_at" json:"checked_at"`
+	Drifted   bool         `db:"drifted" json:"drifted"`
+	// The resources that changed outside of Coder, as reported by the provisioner.
+	ResourceDrift json.RawMessage `db:"resource_drift" json:"resource_drift"`
+}
+
 type WorkspaceLatestBuild struct {
 	ID                      uuid.UUID            `db:"id" json:"id"`
 	WorkspaceID             uuid.UUID            `db:"workspace_id" json:"workspace_id"`
@@ -6,6 +6,7 @@ package database
 
 import (
 	"context"
+	"encoding/json"
 	"time"
 
 	"github.com/google/uuid"
@@ -35,11 +36,24 @@ type sqlcQuerier interface {
 	// Acquires the lock for a single job that isn't started, completed,
 	// canceled, and that matches an array of provisioner types.
 	//
+	// Jobs are acquired by priority first. Within the same priority, jobs of
+	// initiators with the fewest running jobs in the organization are acquired
+	// first, so a single user queueing many jobs cannot starve everyone else.
+	// Organizations do not compete with each other since provisioner daemons only
+	// acquire jobs of their own organization.
+	//
 	// SKIP LOCKED is used to jump over locked rows. This prevents
 	// multiple provisioners from acquiring the same jobs. See:
 	// https://www.postgresql.org/docs/9.5/sql-select.html#SQL-FOR-UPDATE-SHARE
 	AcquireProvisionerJob(ctx context.Context, arg AcquireProvisionerJobParams) (ProvisionerJob, error)
 	AcquireStaleChatDiffStatuses(ctx context.Context, limitVal int32) ([]AcquireStaleChatDiffStatusesRow, error)
+	// Marks the next pending workspaces of every running operation as running, so
+	// that no more than the concurrency of the operation are running at a time.
+	// Workspaces that have been running without an update since stale_before are
+	// acquired again, since the replica that processed them may have stopped.
+	// Callers must hold the LockIDWorkspaceBulkOperations lock so that concurrent
+	// callers do not exceed the concurrency of an operation.
+	AcquireWorkspaceBulkOperationWorkspaces(ctx context.Context, arg AcquireWorkspaceBulkOperationWorkspacesParams) ([]WorkspaceBulkOperationWorkspace, error)
 	// Bumps the workspace deadline by the template's configured "activity_bump"
 	// duration (default 1h). If the workspace bump will cross an autostart
 	// threshold, then the bump is autostart + TTL. This is the deadline behavior if
@@ -115,6 +129,10 @@ type sqlcQuerier interface {
 	CountUnreadInboxNotificationsByUserID(ctx context.Context, userID uuid.UUID) (int64, error)
 	CreateUserSecret(ctx context.Context, arg CreateUserSecretParams) (UserSecret, error)
 	CustomRoles(ctx context.Context, arg CustomRolesParams) ([]CustomRole, error)
+	// Deletes interceptions that were archived before being purged, along with
+	// their dependent records, like DeleteOldAIBridgeRecords.
+	// Cumulative count.
+	DeleteAIBridgeRecordsByInterceptionIDs(ctx context.Context, ids []uuid.UUID) (int64, error)
 	DeleteAIGatewayKey(ctx context.Context, id uuid.UUID) (DeleteAIGatewayKeyRow, error)
 	DeleteAIProviderByID(ctx context.Context, id uuid.UUID) error
 	DeleteAIProviderKey(ctx context.Context, id uuid.UUID) error
@@ -132,6 +150,8 @@ type sqlcQuerier interface {
 	// be recreated.
 	DeleteAllWebpushSubscriptions(ctx context.Context) error
 	DeleteApplicationConnectAPIKeysByUserID(ctx context.Context, userID uuid.UUID) error
+	// Deletes audit logs that were archived before being purged.
+	DeleteAuditLogsByIDs(ctx context.Context, ids []uuid.UUID) (int64, error)
 	// Clears a chat's pinned context resources. Used as the first half of a
 	// clear-then-copy re-pin, and on its own when the chat's current agent
 	// has no snapshot.
@@ -153,9 +173,12 @@ type sqlcQuerier interface {
 	// number of affected rows so callers can detect missing rows without
 	// a follow-up read.
 	DeleteChatQueuedMessageReturningCount(ctx context.Context, arg DeleteChatQueuedMessageReturningCountParams) (int64, error)
+	// Deletes connection logs that were archived before being purged.
+	DeleteConnectionLogsByIDs(ctx context.Context, ids []uuid.UUID) (int64, error)
 	DeleteCryptoKey(ctx context.Context, arg DeleteCryptoKeyParams) (CryptoKey, error)
 	DeleteCustomRole(ctx context.Context, arg DeleteCustomRoleParams) error
 	DeleteExpiredAPIKeys(ctx context.Context, arg DeleteExpiredAPIKeysParams) (int64, error)
+	DeleteExpiredOAuth2ProviderAppDeviceCodes(ctx context.Context, beforeTime time.Time) error
 	DeleteExternalAuthLink(ctx context.Context, arg DeleteExternalAuthLinkParams) error
 	DeleteGroupAIBudget(ctx context.Context, groupID uuid.UUID) (GroupAIBudget, error)
 	DeleteGroupByID(ctx context.Context, id uuid.UUID) error
@@ -168,6 +191,7 @@ type sqlcQuerier interface {
 	DeleteOAuth2ProviderAppByID(ctx context.Context, id uuid.UUID) error
 	DeleteOAuth2ProviderAppCodeByID(ctx context.Context, id uuid.UUID) error
 	DeleteOAuth2ProviderAppCodesByAppAndUserID(ctx context.Context, arg DeleteOAuth2ProviderAppCodesByAppAndUserIDParams) error
+	DeleteOAuth2ProviderAppDeviceCodeByID(ctx context.Context, id uuid.UUID) error
 	DeleteOAuth2ProviderAppSecretByID(ctx context.Context, id uuid.UUID) error
 	// Filters directly on app_id rather than joining through app_secret_id,
 	// since app_secret_id is NULL for public (secretless) clients and would
@@ -213,9 +237,12 @@ type sqlcQuerier interface {
 	DeleteOldWorkspaceAgentStats(ctx context.Context) error
 	DeleteOldWorkspaceBuildOrchestrations(ctx context.Context, arg DeleteOldWorkspaceBuildOrchestrationsParams) (int64, error)
 	DeleteOrganizationMember(ctx context.Context, arg DeleteOrganizationMemberParams) error
+	DeleteProvisionerJobPriority(ctx context.Context, arg DeleteProvisionerJobPriorityParams) error
 	DeleteProvisionerKey(ctx context.Context, id uuid.UUID) error
 	DeleteReplicasUpdatedBefore(ctx context.Context, updatedAt time.Time) error
 	DeleteRuntimeConfig(ctx context.Context, key string) error
+	DeleteScheduleExceptionCalendarByID(ctx context.Context, id uuid.UUID) error
+	DeleteScheduleExceptionCalendarDates(ctx context.Context, calendarID uuid.UUID) error
 	DeleteStaleChatHeartbeats(ctx context.Context, staleSeconds int32) (int64, error)
 	// Deletes any resources for the agent whose source is not in the
 	// supplied active set. Atomic alongside the snapshot upsert so the
@@ -224,17 +251,21 @@ type sqlcQuerier interface {
 	DeleteTailnetPeer(ctx context.Context, arg DeleteTailnetPeerParams) (DeleteTailnetPeerRow, error)
 	DeleteTailnetTunnel(ctx context.Context, arg DeleteTailnetTunnelParams) (DeleteTailnetTunnelRow, error)
 	DeleteTask(ctx context.Context, arg DeleteTaskParams) (uuid.UUID, error)
+	DeleteTemplateScheduleExceptionCalendars(ctx context.Context, templateID uuid.UUID) error
 	DeleteUnlinkedChatFilesByIDs(ctx context.Context, arg DeleteUnlinkedChatFilesByIDsParams) (int64, error)
 	DeleteUserAIBudgetOverride(ctx context.Context, userID uuid.UUID) (UserAIBudgetOverride, error)
 	DeleteUserAIProviderKey(ctx context.Context, arg DeleteUserAIProviderKeyParams) error
 	DeleteUserAIProviderKeysByProviderID(ctx context.Context, aiProviderID uuid.UUID) error
 	DeleteUserChatCompactionThreshold(ctx context.Context, arg DeleteUserChatCompactionThresholdParams) error
+	DeleteUserOutOfOfficeRange(ctx context.Context, arg DeleteUserOutOfOfficeRangeParams) error
 	DeleteUserSecretByUserIDAndName(ctx context.Context, arg DeleteUserSecretByUserIDAndNameParams) (UserSecret, error)
 	DeleteUserSkillByUserIDAndName(ctx context.Context, arg DeleteUserSkillByUserIDAndNameParams) (UserSkill, error)
 	DeleteWebpushSubscriptionByUserIDAndEndpoint(ctx context.Context, arg DeleteWebpushSubscriptionByUserIDAndEndpointParams) error
 	DeleteWebpushSubscriptions(ctx context.Context, ids []uuid.UUID) error
 	DeleteWorkspaceACLByID(ctx context.Context, id uuid.UUID) error
 	DeleteWorkspaceACLsByOrganization(ctx context.Context, arg DeleteWorkspaceACLsByOrganizationParams) error
+	// Deletes workspace agent logs that were archived before being purged.
+	DeleteWorkspaceAgentLogsByIDs(ctx context.Context, ids []int64) (int64, error)
 	DeleteWorkspaceAgentPortShare(ctx context.Context, arg DeleteWorkspaceAgentPortShareParams) error
 	DeleteWorkspaceAgentPortSharesByTemplate(ctx context.Context, templateID uuid.UUID) error
 	// Soft-deletes a single sub-agent (a child agent such as a devcontainer
@@ -267,6 +298,7 @@ type sqlcQuerier interface {
 	FetchMemoryResourceMonitorsUpdatedAfter(ctx context.Context, updatedAt time.Time) ([]WorkspaceAgentMemoryResourceMonitor, error)
 	// This is used to build up the notification_message's JSON payload.
 	FetchNewMessageMetadata(ctx context.Context, arg FetchNewMessageMetadataParams) (FetchNewMessageMetadataRow, error)
+	FetchResourceMonitorsByAgentID(ctx context.Context, agentID uuid.UUID) ([]WorkspaceAgentResourceMonitor, error)
 	FetchVolumesResourceMonitorsByAgentID(ctx context.Context, agentID uuid.UUID) ([]WorkspaceAgentVolumeResourceMonitor, error)
 	FetchVolumesResourceMonitorsUpdatedAfter(ctx context.Context, updatedAt time.Time) ([]WorkspaceAgentVolumeResourceMonitor, error)
 	// Marks orphaned in-progress rows as interrupted so they do not stay
@@ -368,6 +400,9 @@ type sqlcQuerier interface {
 	GetActiveAISeatCount(ctx context.Context) (int64, error)
 	GetActiveChatsByAgentID(ctx context.Context, agentID uuid.UUID) ([]Chat, error)
 	GetActivePresetPrebuildSchedules(ctx context.Context) ([]TemplateVersionPresetPrebuildSchedule, error)
+	// Returns the rollout of the template that is in progress or paused. There is
+	// at most one such rollout per template.
+	GetActiveTemplateVersionRolloutByTemplateID(ctx context.Context, templateID uuid.UUID) (TemplateVersionRollout, error)
 	GetActiveUserCount(ctx context.Context, includeSystem bool) (int64, error)
 	// Returns the authorization roles (site and org-scoped, including implied
 	// member roles and organization default roles) and the group memberships
@@ -631,6 +666,7 @@ type sqlcQuerier interface {
 	// (group_id == organization_id) is included. Returns no rows when the user has
 	// no budgeted groups. Callers should treat sql.ErrNoRows as "no group budget".
 	GetHighestGroupAIBudgetByUser(ctx context.Context, userID uuid.UUID) (GetHighestGroupAIBudgetByUserRow, error)
+	GetInProgressTemplateVersionRollouts(ctx context.Context) ([]TemplateVersionRollout, error)
 	GetInboxNotificationByID(ctx context.Context, id uuid.UUID) (InboxNotification, error)
 	// Fetches inbox notifications for a user filtered by templates and targets
 	// param user_id: The user ID
@@ -653,6 +689,10 @@ type sqlcQuerier interface {
 	GetLatestWorkspaceBuildByWorkspaceID(ctx context.Context, workspaceID uuid.UUID) (WorkspaceBuild, error)
 	GetLatestWorkspaceBuildWithStatusByWorkspaceID(ctx context.Context, workspaceID uuid.UUID) (GetLatestWorkspaceBuildWithStatusByWorkspaceIDRow, error)
 	GetLatestWorkspaceBuildsByWorkspaceIDs(ctx context.Context, ids []uuid.UUID) ([]WorkspaceBuild, error)
+	// Returns the most recent successful drift check of each workspace. Checks
+	// that were run against a build other than the workspace's latest build are
+	// ignored, as a new build replaces the infrastructure that was checked.
+	GetLatestWorkspaceDriftChecksByWorkspaceIDs(ctx context.Context, ids []uuid.UUID) ([]WorkspaceDriftCheck, error)
 	GetLicenseByID(ctx context.Context, id int32) (License, error)
 	GetLicenses(ctx context.Context) ([]License, error)
 	GetLogoURL(ctx context.Context) (string, error)
@@ -679,6 +719,9 @@ type sqlcQuerier interface {
 	GetOAuth2ProviderAppByID(ctx context.Context, id uuid.UUID) (OAuth2ProviderApp, error)
 	GetOAuth2ProviderAppCodeByID(ctx context.Context, id uuid.UUID) (OAuth2ProviderAppCode, error)
 	GetOAuth2ProviderAppCodeByPrefix(ctx context.Context, secretPrefix []byte) (OAuth2ProviderAppCode, error)
+	GetOAuth2ProviderAppDeviceCodeByID(ctx context.Context, id uuid.UUID) (OAuth2ProviderAppDeviceCode, error)
+	GetOAuth2ProviderAppDeviceCodeByPrefix(ctx context.Context, deviceCodePrefix []byte) (OAuth2ProviderAppDeviceCode, error)
+	GetOAuth2ProviderAppDeviceCodeByUserCode(ctx context.Context, userCode string) (OAuth2ProviderAppDeviceCode, error)
 	GetOAuth2ProviderAppSecretByID(ctx context.Context, id uuid.UUID) (OAuth2ProviderAppSecret, error)
 	GetOAuth2ProviderAppSecretByPrefix(ctx context.Context, secretPrefix []byte) (OAuth2ProviderAppSecret, error)
 	GetOAuth2ProviderAppSecretsByAppID(ctx context.Context, appID uuid.UUID) ([]OAuth2ProviderAppSecret, error)
@@ -689,8 +732,24 @@ type sqlcQuerier interface {
 	// app_secret_id, since app_secret_id is NULL for public (secretless) clients
 	// and would silently exclude their tokens from this listing.
 	GetOAuth2ProviderAppsByUserID(ctx context.Context, userID uuid.UUID) ([]GetOAuth2ProviderAppsByUserIDRow, error)
+	// Returns a page of the interceptions that DeleteOldAIBridgeRecords will
+	// delete with the same time, as JSON with their dependent records nested, so
+	// they can be archived first.
+	GetOldAIBridgeInterceptionsForArchive(ctx context.Context, arg GetOldAIBridgeInterceptionsForArchiveParams) ([]GetOldAIBridgeInterceptionsForArchiveRow, error)
+	// Returns the connection events that DeleteOldAuditLogConnectionEvents will
+	// delete with the same arguments, as JSON, so they can be archived first.
+	GetOldAuditLogConnectionEventsForArchive(ctx context.Context, arg GetOldAuditLogConnectionEventsForArchiveParams) ([]json.RawMessage, error)
+	// Returns the audit logs that DeleteOldAuditLogs will delete with the same
+	// arguments, as JSON, so they can be archived first.
+	GetOldAuditLogsForArchive(ctx context.Context, arg GetOldAuditLogsForArchiveParams) ([]json.RawMessage, error)
+	// Returns the connection logs that DeleteOldConnectionLogs will delete with
+	// the same arguments, as JSON, so they can be archived first.
+	GetOldConnectionLogsForArchive(ctx context.Context, arg GetOldConnectionLogsForArchiveParams) ([]json.RawMessage, error)
 	// Locks candidate rows against foreign-key inserts for the transaction.
 	GetOldUnlinkedChatFileIDs(ctx context.Context, arg GetOldUnlinkedChatFileIDsParams) ([]uuid.UUID, error)
+	// Returns a page of the logs that DeleteOldWorkspaceAgentLogs will delete with
+	// the same threshold, as JSON, so they can be archived first.
+	GetOldWorkspaceAgentLogsForArchive(ctx context.Context, arg GetOldWorkspaceAgentLogsForArchiveParams) ([]GetOldWorkspaceAgentLogsForArchiveRow, error)
 	GetOrganizationByID(ctx context.Context, id uuid.UUID) (Organization, error)
 	GetOrganizationByName(ctx context.Context, arg GetOrganizationByNameParams) (Organization, error)
 	// Returns AI spend limits and aggregate spend for groups in @group_ids that
@@ -759,6 +818,7 @@ type sqlcQuerier interface {
 	// Gets a provisioner job by ID with exclusive lock.
 	// Blocks until the row is available for update.
 	GetProvisionerJobByIDWithLock(ctx context.Context, id uuid.UUID) (ProvisionerJob, error)
+	GetProvisionerJobPrioritiesByOrganizationID(ctx context.Context, organizationID uuid.UUID) ([]ProvisionerJobPriority, error)
 	GetProvisionerJobTimingsByJobID(ctx context.Context, jobID uuid.UUID) ([]ProvisionerJobTiming, error)
 	GetProvisionerJobsByIDsWithQueuePosition(ctx context.Context, arg GetProvisionerJobsByIDsWithQueuePositionParams) ([]GetProvisionerJobsByIDsWithQueuePositionRow, error)
 	GetProvisionerJobsByOrganizationAndStatusWithQueuePositionAndProvisioner(ctx context.Context, arg GetProvisionerJobsByOrganizationAndStatusWithQueuePositionAndProvisionerParams) ([]GetProvisionerJobsByOrganizationAndStatusWithQueuePositionAndProvisionerRow, error)
@@ -769,6 +829,7 @@ type sqlcQuerier interface {
 	GetProvisionerKeyByID(ctx context.Context, id uuid.UUID) (ProvisionerKey, error)
 	GetProvisionerKeyByName(ctx context.Context, arg GetProvisionerKeyByNameParams) (ProvisionerKey, error)
 	GetProvisionerLogsAfterID(ctx context.Context, arg GetProvisionerLogsAfterIDParams) ([]ProvisionerJobLog, error)
+	GetPurgeArchiveImports(ctx context.Context) ([]PurgeArchiveImport, error)
 	GetQuotaAllowanceForUser(ctx context.Context, arg GetQuotaAllowanceForUserParams) (int64, error)
 	GetQuotaConsumedForUser(ctx context.Context, arg GetQuotaConsumedForUserParams) (int64, error)
 	// Count regular workspaces: only those whose first successful 'start' build
@@ -778,6 +839,10 @@ type sqlcQuerier interface {
 	GetReplicasUpdatedAfter(ctx context.Context, updatedAt time.Time) ([]Replica, error)
 	GetRunningPrebuiltWorkspaces(ctx context.Context) ([]GetRunningPrebuiltWorkspacesRow, error)
 	GetRuntimeConfig(ctx context.Context, key string) (string, error)
+	GetScheduleExceptionCalendarByID(ctx context.Context, id uuid.UUID) (ScheduleExceptionCalendar, error)
+	GetScheduleExceptionCalendarDates(ctx context.Context, calendarID uuid.UUID) ([]ScheduleExceptionCalendarDate, error)
+	GetScheduleExceptionCalendarsByOrganizationID(ctx context.Context, organizationID uuid.UUID) ([]ScheduleExceptionCalendar, error)
+	GetScheduleExceptionCalendarsByTemplateID(ctx context.Context, templateID uuid.UUID) ([]ScheduleExceptionCalendar, error)
 	// Find chats that appear stuck and need recovery:
 	//   1. Running chats whose heartbeat has expired (worker crash).
 	//   2. requires_action chats past the timeout threshold (client
@@ -860,6 +925,16 @@ type sqlcQuerier interface {
 	GetTemplateVersionByJobID(ctx context.Context, jobID uuid.UUID) (TemplateVersion, error)
 	GetTemplateVersionByTemplateIDAndName(ctx context.Context, arg GetTemplateVersionByTemplateIDAndNameParams) (TemplateVersion, error)
 	GetTemplateVersionParameters(ctx context.Context, templateVersionID uuid.UUID) ([]TemplateVersionParameter, error)
+	GetTemplateVersionRolloutByID(ctx context.Context, id uuid.UUID) (TemplateVersionRollout, error)
+	// Returns the outcome of the start builds and agents of the rollout version
+	// since the rollout was started. Agents count as ready once they have been
+	// ready, and as failed if they errored or timed out without ever becoming
+	// ready. Agents that are still starting are not counted.
+	GetTemplateVersionRolloutStats(ctx context.Context, arg GetTemplateVersionRolloutStatsParams) (GetTemplateVersionRolloutStatsRow, error)
+	// Returns whether the user is a member of one of the groups targeted by the
+	// rollout. The "Everyone" group of an organization is included.
+	GetTemplateVersionRolloutTargetsUser(ctx context.Context, arg GetTemplateVersionRolloutTargetsUserParams) (bool, error)
+	GetTemplateVersionRolloutsByTemplateID(ctx context.Context, templateID uuid.UUID) ([]TemplateVersionRollout, error)
 	GetTemplateVersionTerraformValues(ctx context.Context, templateVersionID uuid.UUID) (TemplateVersionTerraformValue, error)
 	GetTemplateVersionVariables(ctx context.Context, templateVersionID uuid.UUID) ([]TemplateVersionVariable, error)
 	GetTemplateVersionWorkspaceTags(ctx context.Context, templateVersionID uuid.UUID) ([]TemplateVersionWorkspaceTag, error)
@@ -941,6 +1016,7 @@ type sqlcQuerier interface {
 	GetUserLinkByUserIDLoginType(ctx context.Context, arg GetUserLinkByUserIDLoginTypeParams) (UserLink, error)
 	GetUserLinksByUserID(ctx context.Context, userID uuid.UUID) ([]UserLink, error)
 	GetUserNotificationPreferences(ctx context.Context, userID uuid.UUID) ([]NotificationPreference, error)
+	GetUserOutOfOfficeRangesByUserID(ctx context.Context, userID uuid.UUID) ([]UserOutOfOfficeRange, error)
 	GetUserSecretByID(ctx context.Context, id uuid.UUID) (UserSecret, error)
 	GetUserSecretByUserIDAndName(ctx context.Context, arg GetUserSecretByUserIDAndNameParams) (UserSecret, error)
 	// Returns deployment-wide aggregates for the telemetry snapshot.
@@ -992,8 +1068,13 @@ type sqlcQuerier interface {
 	GetWorkspaceAgentDevcontainersByAgentID(ctx context.Context, workspaceAgentID uuid.UUID) ([]WorkspaceAgentDevcontainer, error)
 	GetWorkspaceAgentLifecycleStateByID(ctx context.Context, id uuid.UUID) (GetWorkspaceAgentLifecycleStateByIDRow, error)
 	GetWorkspaceAgentLogSourcesByAgentIDs(ctx context.Context, ids []uuid.UUID) ([]WorkspaceAgentLogSource, error)
+	GetWorkspaceAgentLogTailsByAgentIDs(ctx context.Context, ids []uuid.UUID) ([]WorkspaceAgentLogTail, error)
 	GetWorkspaceAgentLogsAfter(ctx context.Context, arg GetWorkspaceAgentLogsAfterParams) ([]WorkspaceAgentLog, error)
 	GetWorkspaceAgentMetadata(ctx context.Context, arg GetWorkspaceAgentMetadataParams) ([]WorkspaceAgentMetadatum, error)
+	GetWorkspaceAgentPTYShareByHashedToken(ctx context.Context, hashedToken []byte) (WorkspaceAgentPTYShare, error)
+	GetWorkspaceAgentPTYShareByID(ctx context.Context, id uuid.UUID) (WorkspaceAgentPTYShare, error)
+	GetWorkspaceAgentPTYShareViewersByAgentID(ctx context.Context, agentID uuid.UUID) ([]GetWorkspaceAgentPTYShareViewersByAgentIDRow, error)
+	GetWorkspaceAgentPTYSharesByAgentID(ctx context.Context, agentID uuid.UUID) ([]WorkspaceAgentPTYShare, error)
 	GetWorkspaceAgentPortShare(ctx context.Context, arg GetWorkspaceAgentPortShareParams) (WorkspaceAgentPortShare, error)
 	GetWorkspaceAgentScriptTimingsByBuildID(ctx context.Context, id uuid.UUID) ([]GetWorkspaceAgentScriptTimingsByBuildIDRow, error)
 	GetWorkspaceAgentScriptsByAgentIDs(ctx context.Context, ids []uuid.UUID) ([]GetWorkspaceAgentScriptsByAgentIDsRow, error)
@@ -1015,6 +1096,13 @@ type sqlcQuerier interface {
 	GetWorkspaceAppsByAgentID(ctx context.Context, agentID uuid.UUID) ([]WorkspaceApp, error)
 	GetWorkspaceAppsByAgentIDs(ctx context.Context, ids []uuid.UUID) ([]WorkspaceApp, error)
 	GetWorkspaceAppsCreatedAfter(ctx context.Context, createdAt time.Time) ([]WorkspaceApp, error)
+	// Returns the dates on which a workspace must not be started automatically:
+	// those in the exception calendars attached to its template, and the
+	// workspace owner's out of office ranges. Ranges that ended before @after are
+	// omitted.
+	GetWorkspaceAutostartExceptions(ctx context.Context, arg GetWorkspaceAutostartExceptionsParams) ([]GetWorkspaceAutostartExceptionsRow, error)
+	// Like GetWorkspaceAutostartExceptions, but for several workspaces at once.
+	GetWorkspaceAutostartExceptionsByWorkspaceIDs(ctx context.Context, arg GetWorkspaceAutostartExceptionsByWorkspaceIDsParams) ([]GetWorkspaceAutostartExceptionsByWorkspaceIDsRow, error)
 	GetWorkspaceBuildAgentsByInstanceID(ctx context.Context, authInstanceID string) ([]GetWorkspaceBuildAgentsByInstanceIDRow, error)
 	GetWorkspaceBuildByID(ctx context.Context, id uuid.UUID) (WorkspaceBuild, error)
 	GetWorkspaceBuildByJobID(ctx context.Context, jobID uuid.UUID) (WorkspaceBuild, error)
@@ -1031,11 +1119,16 @@ type sqlcQuerier interface {
 	GetWorkspaceBuildStatsByTemplates(ctx context.Context, since time.Time) ([]GetWorkspaceBuildStatsByTemplatesRow, error)
 	GetWorkspaceBuildsByWorkspaceID(ctx context.Context, arg GetWorkspaceBuildsByWorkspaceIDParams) ([]WorkspaceBuild, error)
 	GetWorkspaceBuildsCreatedAfter(ctx context.Context, createdAt time.Time) ([]WorkspaceBuild, error)
+	GetWorkspaceBulkOperationByID(ctx context.Context, id uuid.UUID) (WorkspaceBulkOperation, error)
+	GetWorkspaceBulkOperationProgress(ctx context.Context, operationID uuid.UUID) (GetWorkspaceBulkOperationProgressRow, error)
+	GetWorkspaceBulkOperationWorkspaces(ctx context.Context, operationID uuid.UUID) ([]GetWorkspaceBulkOperationWorkspacesRow, error)
+	GetWorkspaceBulkOperationsByInitiatorID(ctx context.Context, arg GetWorkspaceBulkOperationsByInitiatorIDParams) ([]WorkspaceBulkOperation, error)
 	GetWorkspaceByAgentID(ctx context.Context, agentID uuid.UUID) (Workspace, error)
 	GetWorkspaceByID(ctx context.Context, id uuid.UUID) (Workspace, error)
 	GetWorkspaceByOwnerIDAndName(ctx context.Context, arg GetWorkspaceByOwnerIDAndNameParams) (Workspace, error)
 	GetWorkspaceByResourceID(ctx context.Context, resourceID uuid.UUID) (Workspace, error)
 	GetWorkspaceByWorkspaceAppID(ctx context.Context, workspaceAppID uuid.UUID) (Workspace, error)
+	GetWorkspaceDriftCheckByJobID(ctx context.Context, jobID uuid.UUID) (WorkspaceDriftCheck, error)
 	GetWorkspaceModulesByJobID(ctx context.Context, jobID uuid.UUID) ([]WorkspaceModule, error)
 	GetWorkspaceModulesCreatedAfter(ctx context.Context, createdAt time.Time) ([]WorkspaceModule, error)
 	GetWorkspaceProxies(ctx context.Context) ([]WorkspaceProxy, error)
@@ -1062,6 +1155,12 @@ type sqlcQuerier interface {
 	GetWorkspaces(ctx context.Context, arg GetWorkspacesParams) ([]GetWorkspacesRow, error)
 	GetWorkspacesAndAgentsByOwnerID(ctx context.Context, ownerID uuid.UUID) ([]GetWorkspacesAndAgentsByOwnerIDRow, error)
 	GetWorkspacesByTemplateID(ctx context.Context, templateID uuid.UUID) ([]WorkspaceTable, error)
+	// Returns the running workspaces that are due for a drift check. A workspace
+	// is due when its template has drift detection enabled, its latest build
+	// successfully started it, and it has neither been checked within the
+	// template's drift check interval nor has a drift check that is still in
+	// progress. Dormant workspaces and prebuilds are never checked.
+	GetWorkspacesEligibleForDriftCheck(ctx context.Context, now time.Time) ([]GetWorkspacesEligibleForDriftCheckRow, error)
 	// Returns workspaces the lifecycle executor must act on this tick. An
 	// "action" is a state transition (autostart/autostop/dormancy/delete), a
 	// dormancy mark (which has no build transition), or a one-time autostop
@@ -1158,6 +1257,7 @@ type sqlcQuerier interface {
 	InsertMissingGroups(ctx context.Context, arg InsertMissingGroupsParams) ([]Group, error)
 	InsertOAuth2ProviderApp(ctx context.Context, arg InsertOAuth2ProviderAppParams) (OAuth2ProviderApp, error)
 	InsertOAuth2ProviderAppCode(ctx context.Context, arg InsertOAuth2ProviderAppCodeParams) (OAuth2ProviderAppCode, error)
+	InsertOAuth2ProviderAppDeviceCode(ctx context.Context, arg InsertOAuth2ProviderAppDeviceCodeParams) (OAuth2ProviderAppDeviceCode, error)
 	InsertOAuth2ProviderAppSecret(ctx context.Context, arg InsertOAuth2ProviderAppSecretParams) (OAuth2ProviderAppSecret, error)
 	InsertOAuth2ProviderAppToken(ctx context.Context, arg InsertOAuth2ProviderAppTokenParams) (OAuth2ProviderAppToken, error)
 	InsertOrganization(ctx context.Context, arg InsertOrganizationParams) (Organization, error)
@@ -1169,7 +1269,12 @@ type sqlcQuerier interface {
 	InsertProvisionerJobLogs(ctx context.Context, arg InsertProvisionerJobLogsParams) ([]ProvisionerJobLog, error)
 	InsertProvisionerJobTimings(ctx context.Context, arg InsertProvisionerJobTimingsParams) ([]ProvisionerJobTiming, error)
 	InsertProvisionerKey(ctx context.Context, arg InsertProvisionerKeyParams) (ProvisionerKey, error)
+	InsertPurgeArchiveImport(ctx context.Context, arg InsertPurgeArchiveImportParams) (PurgeArchiveImport, error)
+	InsertPurgeArchiveRecords(ctx context.Context, arg InsertPurgeArchiveRecordsParams) error
 	InsertReplica(ctx context.Context, arg InsertReplicaParams) (Replica, error)
+	InsertResourceMonitor(ctx context.Context, arg InsertResourceMonitorParams) (WorkspaceAgentResourceMonitor, error)
+	InsertScheduleExceptionCalendar(ctx context.Context, arg InsertScheduleExceptionCalendarParams) (ScheduleExceptionCalendar, error)
+	InsertScheduleExceptionCalendarDates(ctx context.Context, arg InsertScheduleExceptionCalendarDatesParams) error
 	InsertTask(ctx context.Context, arg InsertTaskParams) (TaskTable, error)
 	InsertTelemetryItemIfNotExists(ctx context.Context, arg InsertTelemetryItemIfNotExistsParams) error
 	// Inserts a new lock row into the telemetry_locks table. Replicas should call
@@ -1179,8 +1284,10 @@ type sqlcQuerier interface {
 	// attempt to generate or publish the event to the telemetry service.
 	InsertTelemetryLock(ctx context.Context, arg InsertTelemetryLockParams) error
 	InsertTemplate(ctx context.Context, arg InsertTemplateParams) error
+	InsertTemplateScheduleExceptionCalendars(ctx context.Context, arg InsertTemplateScheduleExceptionCalendarsParams) error
 	InsertTemplateVersion(ctx context.Context, arg InsertTemplateVersionParams) error
 	InsertTemplateVersionParameter(ctx context.Context, arg InsertTemplateVersionParameterParams) (TemplateVersionParameter, error)
+	InsertTemplateVersionRollout(ctx context.Context, arg InsertTemplateVersionRolloutParams) (TemplateVersionRollout, error)
 	InsertTemplateVersionTerraformValuesByJobID(ctx context.Context, arg InsertTemplateVersionTerraformValuesByJobIDParams) error
 	InsertTemplateVersionVariable(ctx context.Context, arg InsertTemplateVersionVariableParams) (TemplateVersionVariable, error)
 	InsertTemplateVersionWorkspaceTag(ctx context.Context, arg InsertTemplateVersionWorkspaceTagParams) (TemplateVersionWorkspaceTag, error)
@@ -1192,6 +1299,7 @@ type sqlcQuerier interface {
 	// If there is a conflict, the user is already a member
 	InsertUserGroupsByID(ctx context.Context, arg InsertUserGroupsByIDParams) ([]uuid.UUID, error)
 	InsertUserLink(ctx context.Context, arg InsertUserLinkParams) (UserLink, error)
+	InsertUserOutOfOfficeRange(ctx context.Context, arg InsertUserOutOfOfficeRangeParams) (UserOutOfOfficeRange, error)
 	InsertUserSkill(ctx context.Context, arg InsertUserSkillParams) (UserSkill, error)
 	InsertVolumeResourceMonitor(ctx context.Context, arg InsertVolumeResourceMonitorParams) (WorkspaceAgentVolumeResourceMonitor, error)
 	// Inserts or updates a webpush subscription. The (user_id, endpoint) pair
@@ -1203,8 +1311,11 @@ type sqlcQuerier interface {
 	InsertWorkspaceAgent(ctx context.Context, arg InsertWorkspaceAgentParams) (WorkspaceAgent, error)
 	InsertWorkspaceAgentDevcontainers(ctx context.Context, arg InsertWorkspaceAgentDevcontainersParams) ([]WorkspaceAgentDevcontainer, error)
 	InsertWorkspaceAgentLogSources(ctx context.Context, arg InsertWorkspaceAgentLogSourcesParams) ([]WorkspaceAgentLogSource, error)
+	InsertWorkspaceAgentLogTail(ctx context.Context, arg InsertWorkspaceAgentLogTailParams) (WorkspaceAgentLogTail, error)
 	InsertWorkspaceAgentLogs(ctx context.Context, arg InsertWorkspaceAgentLogsParams) ([]WorkspaceAgentLog, error)
 	InsertWorkspaceAgentMetadata(ctx context.Context, arg InsertWorkspaceAgentMetadataParams) error
+	InsertWorkspaceAgentPTYShare(ctx context.Context, arg InsertWorkspaceAgentPTYShareParams) (WorkspaceAgentPTYShare, error)
+	InsertWorkspaceAgentPTYShareViewer(ctx context.Context, arg InsertWorkspaceAgentPTYShareViewerParams) (WorkspaceAgentPTYShareViewer, error)
 	InsertWorkspaceAgentScriptTimings(ctx context.Context, arg InsertWorkspaceAgentScriptTimingsParams) (WorkspaceAgentScriptTiming, error)
 	InsertWorkspaceAgentScripts(ctx context.Context, arg InsertWorkspaceAgentScriptsParams) ([]WorkspaceAgentScript, error)
 	InsertWorkspaceAgentStats(ctx context.Context, arg InsertWorkspaceAgentStatsParams) error
@@ -1213,6 +1324,9 @@ type sqlcQuerier interface {
 	InsertWorkspaceBuild(ctx context.Context, arg InsertWorkspaceBuildParams) error
 	InsertWorkspaceBuildOrchestration(ctx context.Context, arg InsertWorkspaceBuildOrchestrationParams) (WorkspaceBuildOrchestration, error)
 	InsertWorkspaceBuildParameters(ctx context.Context, arg InsertWorkspaceBuildParametersParams) error
+	InsertWorkspaceBulkOperation(ctx context.Context, arg InsertWorkspaceBulkOperationParams) (WorkspaceBulkOperation, error)
+	InsertWorkspaceBulkOperationWorkspaces(ctx context.Context, arg InsertWorkspaceBulkOperationWorkspacesParams) error
+	InsertWorkspaceDriftCheck(ctx context.Context, arg InsertWorkspaceDriftCheckParams) (WorkspaceDriftCheck, error)
 	InsertWorkspaceModule(ctx context.Context, arg InsertWorkspaceModuleParams) (WorkspaceModule, error)
 	InsertWorkspaceProxy(ctx context.Context, arg InsertWorkspaceProxyParams) (WorkspaceProxy, error)
 	InsertWorkspaceResource(ctx context.Context, arg InsertWorkspaceResourceParams) (WorkspaceResource, error)
@@ -1349,6 +1463,7 @@ type sqlcQuerier interface {
 	// current minimum position for that chat, moving it to the head.
 	ReorderChatQueuedMessageToHead(ctx context.Context, arg ReorderChatQueuedMessageToHeadParams) (int64, error)
 	RevokeDBCryptKey(ctx context.Context, activeKeyDigest string) error
+	RevokeWorkspaceAgentPTYShare(ctx context.Context, arg RevokeWorkspaceAgentPTYShareParams) error
 	// Note that this selects from the CTE, not the original table. The CTE is named
 	// the same as the original table to trick sqlc into reusing the existing struct
 	// for the table.
@@ -1523,6 +1638,10 @@ type sqlcQuerier interface {
 	UpdateNotificationTemplateMethodByID(ctx context.Context, arg UpdateNotificationTemplateMethodByIDParams) (NotificationTemplate, error)
 	UpdateOAuth2ProviderAppByClientID(ctx context.Context, arg UpdateOAuth2ProviderAppByClientIDParams) (OAuth2ProviderApp, error)
 	UpdateOAuth2ProviderAppByID(ctx context.Context, arg UpdateOAuth2ProviderAppByIDParams) (OAuth2ProviderApp, error)
+	UpdateOAuth2ProviderAppDeviceCodePoll(ctx context.Context, arg UpdateOAuth2ProviderAppDeviceCodePollParams) error
+	// Only a pending code can be approved or denied, so a code cannot change
+	// hands once a user has acted on it.
+	UpdateOAuth2ProviderAppDeviceCodeStatus(ctx context.Context, arg UpdateOAuth2ProviderAppDeviceCodeStatusParams) (OAuth2ProviderAppDeviceCode, error)
 	UpdateOrganization(ctx context.Context, arg UpdateOrganizationParams) (Organization, error)
 	UpdateOrganizationDeletedByID(ctx context.Context, arg UpdateOrganizationDeletedByIDParams) error
 	UpdateOrganizationWorkspaceSharingSettings(ctx context.Context, arg UpdateOrganizationWorkspaceSharingSettingsParams) (Organization, error)
@@ -1540,6 +1659,8 @@ type sqlcQuerier interface {
 	UpdateProvisionerJobWithCompleteByID(ctx context.Context, arg UpdateProvisionerJobWithCompleteByIDParams) error
 	UpdateProvisionerJobWithCompleteWithStartedAtByID(ctx context.Context, arg UpdateProvisionerJobWithCompleteWithStartedAtByIDParams) error
 	UpdateReplica(ctx context.Context, arg UpdateReplicaParams) (Replica, error)
+	UpdateResourceMonitor(ctx context.Context, arg UpdateResourceMonitorParams) error
+	UpdateScheduleExceptionCalendarByID(ctx context.Context, arg UpdateScheduleExceptionCalendarByIDParams) (ScheduleExceptionCalendar, error)
 	UpdateTailnetPeerStatusByCoordinator(ctx context.Context, arg UpdateTailnetPeerStatusByCoordinatorParams) ([]uuid.UUID, error)
 	UpdateTaskPrompt(ctx context.Context, arg UpdateTaskPromptParams) (TaskTable, error)
 	UpdateTaskWorkspaceID(ctx context.Context, arg UpdateTaskWorkspaceIDParams) (TaskTable, error)
@@ -1553,6 +1674,8 @@ type sqlcQuerier interface {
 	UpdateTemplateVersionDescriptionByJobID(ctx context.Context, arg UpdateTemplateVersionDescriptionByJobIDParams) error
 	UpdateTemplateVersionExternalAuthProvidersByJobID(ctx context.Context, arg UpdateTemplateVersionExternalAuthProvidersByJobIDParams) error
 	UpdateTemplateVersionFlagsByJobID(ctx context.Context, arg UpdateTemplateVersionFlagsByJobIDParams) error
+	UpdateTemplateVersionRolloutByID(ctx context.Context, arg UpdateTemplateVersionRolloutByIDParams) (TemplateVersionRollout, error)
+	UpdateTemplateVersionRolloutStatusByID(ctx context.Context, arg UpdateTemplateVersionRolloutStatusByIDParams) (TemplateVersionRollout, error)
 	UpdateTemplateWorkspacesLastUsedAt(ctx context.Context, arg UpdateTemplateWorkspacesLastUsedAtParams) error
 	UpdateUsageEventsPostPublish(ctx context.Context, arg UpdateUsageEventsPostPublishParams) error
 	UpdateUserAIProviderKey(ctx context.Context, arg UpdateUserAIProviderKeyParams) (UserAIProviderKey, error)
@@ -1595,6 +1718,7 @@ type sqlcQuerier interface {
 	UpdateWorkspaceAgentLifecycleStateByID(ctx context.Context, arg UpdateWorkspaceAgentLifecycleStateByIDParams) error
 	UpdateWorkspaceAgentLogOverflowByID(ctx context.Context, arg UpdateWorkspaceAgentLogOverflowByIDParams) error
 	UpdateWorkspaceAgentMetadata(ctx context.Context, arg UpdateWorkspaceAgentMetadataParams) error
+	UpdateWorkspaceAgentPTYShareViewerDisconnectedAt(ctx context.Context, arg UpdateWorkspaceAgentPTYShareViewerDisconnectedAtParams) error
 	UpdateWorkspaceAgentStartupByID(ctx context.Context, arg UpdateWorkspaceAgentStartupByIDParams) error
 	UpdateWorkspaceAppHealthByID(ctx context.Context, arg UpdateWorkspaceAppHealthByIDParams) error
 	UpdateWorkspaceAutomaticUpdates(ctx context.Context, arg UpdateWorkspaceAutomaticUpdatesParams) error
@@ -1613,8 +1737,16 @@ type sqlcQuerier interface {
 	UpdateWorkspaceBuildOrchestrationFailedByID(ctx context.Context, arg UpdateWorkspaceBuildOrchestrationFailedByIDParams) (WorkspaceBuildOrchestration, error)
 	UpdateWorkspaceBuildOrchestrationRetryByID(ctx context.Context, arg UpdateWorkspaceBuildOrchestrationRetryByIDParams) (WorkspaceBuildOrchestration, error)
 	UpdateWorkspaceBuildProvisionerStateByID(ctx context.Context, arg UpdateWorkspaceBuildProvisionerStateByIDParams) error
+	// Cancels a running operation. Its pending workspaces are skipped, and
+	// workspaces that are already running are left to finish.
+	UpdateWorkspaceBulkOperationCanceled(ctx context.Context, arg UpdateWorkspaceBulkOperationCanceledParams) (WorkspaceBulkOperation, error)
+	// Marks the operation as completed once none of its workspaces are pending or
+	// running.
+	UpdateWorkspaceBulkOperationCompleted(ctx context.Context, arg UpdateWorkspaceBulkOperationCompletedParams) error
+	UpdateWorkspaceBulkOperationWorkspace(ctx context.Context, arg UpdateWorkspaceBulkOperationWorkspaceParams) error
 	UpdateWorkspaceDeletedByID(ctx context.Context, arg UpdateWorkspaceDeletedByIDParams) error
 	UpdateWorkspaceDormantDeletingAt(ctx context.Context, arg UpdateWorkspaceDormantDeletingAtParams) (WorkspaceTable, error)
+	UpdateWorkspaceDriftCheckByID(ctx context.Context, arg UpdateWorkspaceDriftCheckByIDParams) error
 	UpdateWorkspaceLastUsedAt(ctx context.Context, arg UpdateWorkspaceLastUsedAtParams) error
 	UpdateWorkspaceNextStartAt(ctx context.Context, arg UpdateWorkspaceNextStartAtParams) error
 	// This allows editing the properties of a workspace proxy.
@@ -1684,6 +1816,7 @@ type sqlcQuerier interface {
 	UpsertOAuth2GithubDefaultEligible(ctx context.Context, eligible bool) error
 	UpsertPrebuildsSettings(ctx context.Context, value string) error
 	UpsertProvisionerDaemon(ctx context.Context, arg UpsertProvisionerDaemonParams) (ProvisionerDaemon, error)
+	UpsertProvisionerJobPriority(ctx context.Context, arg UpsertProvisionerJobPriorityParams) (ProvisionerJobPriority, error)
 	UpsertRuntimeConfig(ctx context.Context, arg UpsertRuntimeConfigParams) error
 	UpsertTailnetCoordinator(ctx context.Context, id uuid.UUID) (TailnetCoordinator, error)
 	UpsertTailnetPeer(ctx context.Context, arg UpsertTailnetPeerParams) (TailnetPeer, error)
@@ -2615,6 +2615,147 @@ func TestAcquireProvisionerJob(t *testing.T) {
 		require.ErrorIs(t, err, sql.ErrNoRows)
 	})
 
+	t.Run("PriorityClassesAndBoosts", func(t *testing.T) {
+		t.Parallel()
+		var (
+			db, _      = dbtestutil.NewDB(t)
+			ctx        = testutil.Context(t, testutil.WaitMedium)
+			org        = dbgen.Organization(t, db, database.Organization{})
+			templateID = uuid.New()
+			now        = dbtime.Now()
+		)
+
+		// Given: a priority boost for the organization and one of its templates.
+		_, err := db.UpsertProvisionerJobPriority(ctx, database.UpsertProvisionerJobPriorityParams{
+			OrganizationID: org.ID,
+			TemplateID:     uuid.Nil,
+			Priority:       5,
+			UpdatedAt:      now,
+		})
+		require.NoError(t, err)
+		_, err = db.UpsertProvisionerJobPriority(ctx, database.UpsertProvisionerJobPriorityParams{
+			OrganizationID: org.ID,
+			TemplateID:     templateID,
+			Priority:       -50,
+			UpdatedAt:      now,
+		})
+		require.NoError(t, err)
+
+		insert := func(priority int32, templateID uuid.UUID, createdAt time.Time) database.ProvisionerJob {
+			job, err := db.InsertProvisionerJob(ctx, database.InsertProvisionerJobParams{
+				ID:             uuid.New(),
+				CreatedAt:      createdAt,
+				UpdatedAt:      createdAt,
+				InitiatorID:    uuid.New(),
+				OrganizationID: org.ID,
+				Provisioner:    database.ProvisionerTypeEcho,
+				Type:           database.ProvisionerJobTypeWorkspaceBuild,
+				StorageMethod:  database.ProvisionerStorageMethodFile,
+				FileID:         uuid.New(),
+				Input:          json.RawMessage(`{}`),
+				Tags:           database.StringMap{},
+				TraceMetadata:  pqtype.NullRawMessage{},
+				Priority:       priority,
+				TemplateID:     templateID,
+			})
+			require.NoError(t, err)
+			return job
+		}
+
+		// When: jobs of every priority class are queued, oldest first.
+		automated := insert(codersdk.ProvisionerJobPriorityAutomated, uuid.Nil, now.Add(-3*time.Minute))
+		templateImport := insert(codersdk.ProvisionerJobPriorityTemplateImport, templateID, now.Add(-2*time.Minute))
+		interactive := insert(codersdk.ProvisionerJobPriorityInteractive, templateID, now.Add(-time.Minute))
+
+		// Then: the boosts are added to the priority class.
+		require.EqualValues(t, 5, automated.Priority)
+		require.EqualValues(t, 955, templateImport.Priority)
+		require.EqualValues(t, 1955, interactive.Priority)
+
+		// Then: jobs are acquired by priority instead of age.
+		for _, want := range []database.ProvisionerJob{interactive, templateImport, automated} {
+			acquired, err := db.AcquireProvisionerJob(ctx, database.AcquireProvisionerJobParams{
+				OrganizationID:  org.ID,
+				StartedAt:       sql.NullTime{Time: now, Valid: true},
+				WorkerID:        uuid.NullUUID{UUID: uuid.New(), Valid: true},
+				Types:           []database.ProvisionerType{database.ProvisionerTypeEcho},
+				ProvisionerTags: json.RawMessage(`{}`),
+			})
+			require.NoError(t, err)
+			require.Equal(t, want.ID, acquired.ID)
+		}
+	})
+
+	t.Run("FairShareBetweenInitiators", func(t *testing.T) {
+		t.Parallel()
+		var (
+			db, _    = dbtestutil.NewDB(t)
+			ctx      = testutil.Context(t, testutil.WaitMedium)
+			org      = dbgen.Organization(t, db, database.Organization{})
+			_        = dbgen.ProvisionerDaemon(t, db, database.ProvisionerDaemon{}) // Required for queue position
+			busyUser = uuid.New()
+			idleUser = uuid.New()
+			now      = dbtime.Now()
+		)
+
+		// Given: a user that already has a running job.
+		_ = dbgen.ProvisionerJob(t, db, nil, database.ProvisionerJob{
+			OrganizationID: org.ID,
+			InitiatorID:    busyUser,
+			StartedAt:      sql.NullTime{Time: now, Valid: true},
+		})
+
+		insert := func(initiatorID uuid.UUID, createdAt time.Time) database.ProvisionerJob {
+			job, err := db.InsertProvisionerJob(ctx, database.InsertProvisionerJobParams{
+				ID:             uuid.New(),
+				CreatedAt:      createdAt,
+				UpdatedAt:      createdAt,
+				InitiatorID:    initiatorID,
+				OrganizationID: org.ID,
+				Provisioner:    database.ProvisionerTypeEcho,
+				Type:           database.ProvisionerJobTypeWorkspaceBuild,
+				StorageMethod:  database.ProvisionerStorageMethodFile,
+				FileID:         uuid.New(),
+				Input:          json.RawMessage(`{}`),
+				Tags:           database.StringMap{},
+				TraceMetadata:  pqtype.NullRawMessage{},
+				Priority:       codersdk.ProvisionerJobPriorityInteractive,
+			})
+			require.NoError(t, err)
+			return job
+		}
+
+		// When: that user queues a job before a user without running jobs.
+		busyJob := insert(busyUser, now.Add(-time.Minute))
+		idleJob := insert(idleUser, now)
+
+		// Then: the job of the idle user is ahead in the queue.
+		qjs, err := db.GetProvisionerJobsByIDsWithQueuePosition(ctx, database.GetProvisionerJobsByIDsWithQueuePositionParams{
+			IDs:             []uuid.UUID{busyJob.ID, idleJob.ID},
+			StaleIntervalMS: provisionerdserver.StaleInterval.Milliseconds(),
+		})
+		require.NoError(t, err)
+		positions := make(map[uuid.UUID]int64)
+		for _, qj := range qjs {
+			positions[qj.ProvisionerJob.ID] = qj.QueuePosition
+		}
+		require.Equal(t, int64(1), positions[idleJob.ID])
+		require.Equal(t, int64(2), positions[busyJob.ID])
+
+		// Then: the job of the idle user is acquired first.
+		for _, want := range []database.ProvisionerJob{idleJob, busyJob} {
+			acquired, err := db.AcquireProvisionerJob(ctx, database.AcquireProvisionerJobParams{
+				OrganizationID:  org.ID,
+				StartedAt:       sql.NullTime{Time: now, Valid: true},
+				WorkerID:        uuid.NullUUID{UUID: uuid.New(), Valid: true},
+				Types:           []database.ProvisionerType{database.ProvisionerTypeEcho},
+				ProvisionerTags: json.RawMessage(`{}`),
+			})
+			require.NoError(t, err)
+			require.Equal(t, want.ID, acquired.ID)
+		}
+	})
+
 	t.Run("ProvisionerKeyLock", func(t *testing.T) {
 		t.Parallel()
 		var (
@@ -9974,6 +10115,100 @@ func TestGetLatestWorkspaceBuildsByWorkspaceIDs(t *testing.T) {
 	}
 }
 
+func TestGetWorkspacesEligibleForDriftCheck(t *testing.T) {
+	t.Parallel()
+
+	db, _ := dbtestutil.NewDB(t)
+	ctx := testutil.Context(t, testutil.WaitLong)
+	now := dbtime.Now()
+
+	org := dbgen.Organization(t, db, database.Organization{})
+	user := dbgen.User(t, db, database.User{})
+	dbgen.OrganizationMember(t, db, database.OrganizationMember{
+		UserID:         user.ID,
+		OrganizationID: org.ID,
+	})
+
+	// Templates check their workspaces daily by default.
+	enabled := dbfake.TemplateVersion(t, db).
+		Seed(database.TemplateVersion{OrganizationID: org.ID, CreatedBy: user.ID}).
+		Do()
+	require.Equal(t, int64(24*time.Hour), enabled.Template.DriftCheckInterval)
+
+	disabled := dbfake.TemplateVersion(t, db).
+		Seed(database.TemplateVersion{OrganizationID: org.ID, CreatedBy: user.ID}).
+		Do()
+	err := db.UpdateTemplateMetaByID(ctx, database.UpdateTemplateMetaByIDParams{
+		ID:                           disabled.Template.ID,
+		UpdatedAt:                    now,
+		Name:                         disabled.Template.Name,
+		AllowUserCancelWorkspaceJobs: disabled.Template.AllowUserCancelWorkspaceJobs,
+		GroupACL:                     disabled.Template.GroupACL,
+		MaxPortSharingLevel:          disabled.Template.MaxPortSharingLevel,
+		CorsBehavior:                 disabled.Template.CorsBehavior,
+		AgentsAllowed:                disabled.Template.AgentsAllowed,
+		DriftCheckInterval:           0,
+	})
+	require.NoError(t, err)
+
+	newWorkspace := func(tpl database.Template, transition database.WorkspaceTransition) dbfake.WorkspaceResponse {
+		return dbfake.WorkspaceBuild(t, db, database.WorkspaceTable{
+			OrganizationID: org.ID,
+			OwnerID:        user.ID,
+			TemplateID:     tpl.ID,
+		}).Seed(database.WorkspaceBuild{Transition: transition}).Do()
+	}
+	newDriftCheck := func(ws dbfake.WorkspaceResponse, createdAt time.Time, completed bool) {
+		job := database.ProvisionerJob{
+			CreatedAt:      createdAt,
+			OrganizationID: org.ID,
+			InitiatorID:    user.ID,
+			Type:           database.ProvisionerJobTypeWorkspaceDriftCheck,
+		}
+		if completed {
+			job.CompletedAt = sql.NullTime{Time: createdAt, Valid: true}
+		}
+		job = dbgen.ProvisionerJob(t, db, nil, job)
+		dbgen.WorkspaceDriftCheck(t, db, database.WorkspaceDriftCheck{
+			WorkspaceID:      ws.Workspace.ID,
+			WorkspaceBuildID: ws.Build.ID,
+			JobID:            job.ID,
+			CreatedAt:        createdAt,
+		})
+	}
+
+	neverChecked := newWorkspace(enabled.Template, database.WorkspaceTransitionStart)
+	_ = newWorkspace(enabled.Template, database.WorkspaceTransitionStop)
+	_ = newWorkspace(disabled.Template, database.WorkspaceTransitionStart)
+
+	recentlyChecked := newWorkspace(enabled.Template, database.WorkspaceTransitionStart)
+	newDriftCheck(recentlyChecked, now.Add(-time.Hour), true)
+
+	staleCheck := newWorkspace(enabled.Template, database.WorkspaceTransitionStart)
+	newDriftCheck(staleCheck, now.Add(-25*time.Hour), true)
+
+	// A check that never completed keeps new checks from piling up behind it.
+	inProgress := newWorkspace(enabled.Template, database.WorkspaceTransitionStart)
+	newDriftCheck(inProgress, now.Add(-25*time.Hour), false)
+
+	rows, err := db.GetWorkspacesEligibleForDriftCheck(ctx, now)
+	require.NoError(t, err)
+
+	got := make(map[uuid.UUID]database.GetWorkspacesEligibleForDriftCheckRow)
+	for _, row := range rows {
+		got[row.WorkspaceID] = row
+	}
+	require.Len(t, got, 2)
+	require.Contains(t, got, neverChecked.Workspace.ID)
+	require.Contains(t, got, staleCheck.Workspace.ID)
+
+	row := got[neverChecked.Workspace.ID]
+	require.Equal(t, neverChecked.Build.ID, row.WorkspaceBuildID)
+	require.Equal(t, neverChecked.Build.TemplateVersionID, row.TemplateVersionID)
+	require.Equal(t, enabled.Template.ID, row.TemplateID)
+	require.Equal(t, user.ID, row.OwnerID)
+}
+
 func TestTasksWithStatusView(t *testing.T) {
 	t.Parallel()
 
@@ -19272,3 +19507,82 @@ func TestGetAIModelPrices(t *testing.T) {
 		})
 	}
 }
+
+func TestAcquireWorkspaceBulkOperationWorkspaces(t *testing.T) {
+	t.Parallel()
+
+	db, _ := dbtestutil.NewDB(t)
+	ctx := testutil.Context(t, testutil.WaitShort)
+
+	org := dbgen.Organization(t, db, database.Organization{})
+	user := dbgen.User(t, db, database.User{})
+	tpl := dbgen.Template(t, db, database.Template{OrganizationID: org.ID, CreatedBy: user.ID})
+	workspaceIDs := make([]uuid.UUID, 0, 3)
+	for range 3 {
+		ws := dbgen.Workspace(t, db, database.WorkspaceTable{OwnerID: user.ID, OrganizationID: org.ID, TemplateID: tpl.ID})
+		workspaceIDs = append(workspaceIDs, ws.ID)
+	}
+	op := dbgen.WorkspaceBulkOperation(t, db, database.WorkspaceBulkOperation{InitiatorID: user.ID, Concurrency: 2}, workspaceIDs...)
+
+	now := dbtime.Now()
+	staleBefore := now.Add(-time.Hour)
+
+	// Only as many workspaces as the concurrency of the operation are acquired.
+	acquired, err := db.AcquireWorkspaceBulkOperationWorkspaces(ctx, database.AcquireWorkspaceBulkOperationWorkspacesParams{StaleBefore: staleBefore, Now: now})
+	require.NoError(t, err)
+	require.Len(t, acquired, 2)
+	for _, row := range acquired {
+		require.Equal(t, database.WorkspaceBulkOperationWorkspaceStatusRunning, row.Status)
+	}
+
+	// Nothing more is acquired while both are running.
+	more, err := db.AcquireWorkspaceBulkOperationWorkspaces(ctx, database.AcquireWorkspaceBulkOperationWorkspacesParams{StaleBefore: staleBefore, Now: now})
+	require.NoError(t, err)
+	require.Empty(t, more)
+
+	// Finishing one frees up a slot for the last workspace.
+	err = db.UpdateWorkspaceBulkOperationWorkspace(ctx, database.UpdateWorkspaceBulkOperationWorkspaceParams{
+		Status:      database.WorkspaceBulkOperationWorkspaceStatusSucceeded,
+		UpdatedAt:   now,
+		OperationID: op.ID,
+		WorkspaceID: acquired[0].WorkspaceID,
+	})
+	require.NoError(t, err)
+	more, err = db.AcquireWorkspaceBulkOperationWorkspaces(ctx, database.AcquireWorkspaceBulkOperationWorkspacesParams{StaleBefore: staleBefore, Now: now})
+	require.NoError(t, err)
+	require.Len(t, more, 1)
+
+	// Workspaces without an update since stale_before are acquired again.
+	later := now.Add(time.Minute)
+	stale, err := db.AcquireWorkspaceBulkOperationWorkspaces(ctx, database.AcquireWorkspaceBulkOperationWorkspacesParams{StaleBefore: later, Now: later})
+	require.NoError(t, err)
+	require.Len(t, stale, 2)
+
+	// The operation is not completed while workspaces are running.
+	err = db.UpdateWorkspaceBulkOperationCompleted(ctx, database.UpdateWorkspaceBulkOperationCompletedParams{Now: later, ID: op.ID})
+	require.NoError(t, err)
+	got, err := db.GetWorkspaceBulkOperationByID(ctx, op.ID)
+	require.NoError(t, err)
+	require.Equal(t, database.WorkspaceBulkOperationStatusRunning, got.Status)
+
+	for _, row := range stale {
+		err = db.UpdateWorkspaceBulkOperationWorkspace(ctx, database.UpdateWorkspaceBulkOperationWorkspaceParams{
+			Status:      database.WorkspaceBulkOperationWorkspaceStatusFailed,
+			Error:       "failed",
+			UpdatedAt:   later,
+			OperationID: op.ID,
+			WorkspaceID: row.WorkspaceID,
+		})
+		require.NoError(t, err)
+	}
+	err = db.UpdateWorkspaceBulkOperationCompleted(ctx, database.UpdateWorkspaceBulkOperationCompletedParams{Now: later, ID: op.ID})
+	require.NoError(t, err)
+	got, err = db.GetWorkspaceBulkOperationByID(ctx, op.ID)
+	require.NoError(t, err)
+	require.Equal(t, database.WorkspaceBulkOperationStatusCompleted, got.Status)
+	require.True(t, got.CompletedAt.Valid)
+
+	progress, err := db.GetWorkspaceBulkOperationProgress(ctx, op.ID)
+	require.NoError(t, err)
+	require.Equal(t, database.GetWorkspaceBulkOperationProgressRow{Succeeded: 1, Failed: 2}, progress)
+}
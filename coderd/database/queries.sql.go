@@ -1106,6 +1106,52 @@ func (q *sqlQuerier) CountAIBridgeSessions(ctx context.Context, arg CountAIBridg
 	return count, err
 }
 
+const deleteAIBridgeRecordsByInterceptionIDs = `-- name: DeleteAIBridgeRecordsByInterceptionIDs :one
+WITH
+  model_thoughts AS (
+    DELETE FROM aibridge_model_thoughts
+    WHERE interception_id = ANY($1::uuid[])
+    RETURNING 1
+  ),
+  tool_usages AS (
+    DELETE FROM aibridge_tool_usages
+    WHERE interception_id = ANY($1::uuid[])
+    RETURNING 1
+  ),
+  token_usages AS (
+    DELETE FROM aibridge_token_usages
+    WHERE interception_id = ANY($1::uuid[])
+    RETURNING 1
+  ),
+  user_prompts AS (
+    DELETE FROM aibridge_user_prompts
+    WHERE interception_id = ANY($1::uuid[])
+    RETURNING 1
+  ),
+  interceptions AS (
+    DELETE FROM aibridge_interceptions
+    WHERE id = ANY($1::uuid[])
+    RETURNING 1
+  )
+SELECT (
+  (SELECT COUNT(*) FROM model_thoughts) +
+  (SELECT COUNT(*) FROM tool_usages) +
+  (SELECT COUNT(*) FROM token_usages) +
+  (SELECT COUNT(*) FROM user_prompts) +
+  (SELECT COUNT(*) FROM interceptions)
+)::bigint as total_deleted
+`
+
+// Deletes interceptions that were archived before being purged, along with
+// their dependent records, like DeleteOldAIBridgeRecords.
+// Cumulative count.
+func (q *sqlQuerier) DeleteAIBridgeRecordsByInterceptionIDs(ctx context.Context, ids []uuid.UUID) (int64, error) {
+	row := q.db.QueryRowContext(ctx, deleteAIBridgeRecordsByInterceptionIDs, pq.Array(ids))
+	var total_deleted int64
+	err := row.Scan(&total_deleted)
+	return total_deleted, err
+}
+
 const deleteOldAIBridgeRecords = `-- name: DeleteOldAIBridgeRecords :one
 WITH
   -- We don't have FK relationships between the dependent tables and aibridge_interceptions, so we can't rely on DELETE CASCADE.
@@ -1559,6 +1605,63 @@ func (q *sqlQuerier) GetAIBridgeUserPromptsByInterceptionID(ctx context.Context,
 	return items, nil
 }
 
+const getOldAIBridgeInterceptionsForArchive = `-- name: GetOldAIBridgeInterceptionsForArchive :many
+SELECT
+	aibridge_interceptions.id,
+	to_jsonb(aibridge_interceptions) || jsonb_build_object(
+		'token_usages', COALESCE((SELECT jsonb_agg(to_jsonb(t)) FROM aibridge_token_usages t WHERE t.interception_id = aibridge_interceptions.id), '[]'::jsonb),
+		'user_prompts', COALESCE((SELECT jsonb_agg(to_jsonb(p)) FROM aibridge_user_prompts p WHERE p.interception_id = aibridge_interceptions.id), '[]'::jsonb),
+		'tool_usages', COALESCE((SELECT jsonb_agg(to_jsonb(u)) FROM aibridge_tool_usages u WHERE u.interception_id = aibridge_interceptions.id), '[]'::jsonb),
+		'model_thoughts', COALESCE((SELECT jsonb_agg(to_jsonb(m)) FROM aibridge_model_thoughts m WHERE m.interception_id = aibridge_interceptions.id), '[]'::jsonb)
+	) AS record
+FROM
+	aibridge_interceptions
+WHERE
+	started_at < $1::timestamp with time zone
+	AND id > $2::uuid
+ORDER BY
+	id ASC
+LIMIT
+	$3
+`
+
+type GetOldAIBridgeInterceptionsForArchiveParams struct {
+	BeforeTime time.Time `db:"before_time" json:"before_time"`
+	AfterID    uuid.UUID `db:"after_id" json:"after_id"`
+	LimitCount int32     `db:"limit_count" json:"limit_count"`
+}
+
+type GetOldAIBridgeInterceptionsForArchiveRow struct {
+	ID     uuid.UUID       `db:"id" json:"id"`
+	Record json.RawMessage `db:"record" json:"record"`
+}
+
+// Returns a page of the interceptions that DeleteOldAIBridgeRecords will
+// delete with the same time, as JSON with their dependent records nested, so
+// they can be archived first.
+func (q *sqlQuerier) GetOldAIBridgeInterceptionsForArchive(ctx context.Context, arg GetOldAIBridgeInterceptionsForArchiveParams) ([]GetOldAIBridgeInterceptionsForArchiveRow, error) {
+	rows, err := q.db.QueryContext(ctx, getOldAIBridgeInterceptionsForArchive, arg.BeforeTime, arg.AfterID, arg.LimitCount)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []GetOldAIBridgeInterceptionsForArchiveRow
+	for rows.Next() {
+		var i GetOldAIBridgeInterceptionsForArchiveRow
+		if err := rows.Scan(&i.ID, &i.Record); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
 const insertAIBridgeInterception = `-- name: InsertAIBridgeInterception :one
 INSERT INTO aibridge_interceptions (
 	id, api_key_id, initiator_id, provider, provider_name, model, metadata, started_at, client, client_session_id, thread_parent_id, thread_root_id, credential_kind, credential_hint, agent_firewall_session_id, agent_firewall_sequence_number
@@ -4337,6 +4440,20 @@ func (q *sqlQuerier) CountAuditLogs(ctx context.Context, arg CountAuditLogsParam
 	return count, err
 }
 
+const deleteAuditLogsByIDs = `-- name: DeleteAuditLogsByIDs :execrows
+DELETE FROM audit_logs
+WHERE id = ANY($1::uuid[])
+`
+
+// Deletes audit logs that were archived before being purged.
+func (q *sqlQuerier) DeleteAuditLogsByIDs(ctx context.Context, ids []uuid.UUID) (int64, error) {
+	result, err := q.db.ExecContext(ctx, deleteAuditLogsByIDs, pq.Array(ids))
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected()
+}
+
 const deleteOldAuditLogConnectionEvents = `-- name: DeleteOldAuditLogConnectionEvents :exec
 DELETE FROM audit_logs
 WHERE id IN (
@@ -4371,7 +4488,9 @@ WITH old_logs AS (
     WHERE
         "time" < $1::timestamp with time zone
         AND action NOT IN ('connect', 'disconnect', 'open', 'close')
-    ORDER BY "time" ASC
+    -- Ordering by id breaks ties, so that GetOldAuditLogsForArchive
+    -- returns exactly the rows that are deleted.
+    ORDER BY "time" ASC, id ASC
     LIMIT $2
 )
 DELETE FROM audit_logs
@@ -4614,6 +4733,88 @@ func (q *sqlQuerier) GetAuditLogsOffset(ctx context.Context, arg GetAuditLogsOff
 	return items, nil
 }
 
+const getOldAuditLogConnectionEventsForArchive = `-- name: GetOldAuditLogConnectionEventsForArchive :many
+SELECT
+    to_jsonb(audit_logs) AS record
+FROM audit_logs
+WHERE
+    "time" < $1::timestamp with time zone
+    AND action IN ('connect', 'disconnect', 'open', 'close')
+ORDER BY "time" ASC, id ASC
+LIMIT $2
+`
+
+type GetOldAuditLogConnectionEventsForArchiveParams struct {
+	BeforeTime time.Time `db:"before_time" json:"before_time"`
+	LimitCount int32     `db:"limit_count" json:"limit_count"`
+}
+
+// Returns the connection events that DeleteOldAuditLogConnectionEvents will
+// delete with the same arguments, as JSON, so they can be archived first.
+func (q *sqlQuerier) GetOldAuditLogConnectionEventsForArchive(ctx context.Context, arg GetOldAuditLogConnectionEventsForArchiveParams) ([]json.RawMessage, error) {
+	rows, err := q.db.QueryContext(ctx, getOldAuditLogConnectionEventsForArchive, arg.BeforeTime, arg.LimitCount)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []json.RawMessage
+	for rows.Next() {
+		var record json.RawMessage
+		if err := rows.Scan(&record); err != nil {
+			return nil, err
+		}
+		items = append(items, record)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const getOldAuditLogsForArchive = `-- name: GetOldAuditLogsForArchive :many
+SELECT
+    to_jsonb(audit_logs) AS record
+FROM audit_logs
+WHERE
+    "time" < $1::timestamp with time zone
+    AND action NOT IN ('connect', 'disconnect', 'open', 'close')
+ORDER BY "time" ASC, id ASC
+LIMIT $2
+`
+
+type GetOldAuditLogsForArchiveParams struct {
+	BeforeTime time.Time `db:"before_time" json:"before_time"`
+	LimitCount int32     `db:"limit_count" json:"limit_count"`
+}
+
+// Returns the audit logs that DeleteOldAuditLogs will delete with the same
+// arguments, as JSON, so they can be archived first.
+func (q *sqlQuerier) GetOldAuditLogsForArchive(ctx context.Context, arg GetOldAuditLogsForArchiveParams) ([]json.RawMessage, error) {
+	rows, err := q.db.QueryContext(ctx, getOldAuditLogsForArchive, arg.BeforeTime, arg.LimitCount)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []json.RawMessage
+	for rows.Next() {
+		var record json.RawMessage
+		if err := rows.Scan(&record); err != nil {
+			return nil, err
+		}
+		items = append(items, record)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
 const insertAuditLog = `-- name: InsertAuditLog :one
 INSERT INTO audit_logs (
 		id,
@@ -13476,12 +13677,28 @@ func (q *sqlQuerier) CountConnectionLogs(ctx context.Context, arg CountConnectio
 	return count, err
 }
 
+const deleteConnectionLogsByIDs = `-- name: DeleteConnectionLogsByIDs :execrows
+DELETE FROM connection_logs
+WHERE id = ANY($1::uuid[])
+`
+
+// Deletes connection logs that were archived before being purged.
+func (q *sqlQuerier) DeleteConnectionLogsByIDs(ctx context.Context, ids []uuid.UUID) (int64, error) {
+	result, err := q.db.ExecContext(ctx, deleteConnectionLogsByIDs, pq.Array(ids))
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected()
+}
+
 const deleteOldConnectionLogs = `-- name: DeleteOldConnectionLogs :execrows
 WITH old_logs AS (
 	SELECT id
 	FROM connection_logs
 	WHERE connect_time < $1::timestamp with time zone
-	ORDER BY connect_time ASC
+	-- Ordering by id breaks ties, so that GetOldConnectionLogsForArchive
+	-- returns exactly the rows that are deleted.
+	ORDER BY connect_time ASC, id ASC
 	LIMIT $2
 )
 DELETE FROM connection_logs
@@ -13748,6 +13965,45 @@ func (q *sqlQuerier) GetConnectionLogsOffset(ctx context.Context, arg GetConnect
 	return items, nil
 }
 
+const getOldConnectionLogsForArchive = `-- name: GetOldConnectionLogsForArchive :many
+SELECT
+	to_jsonb(connection_logs) AS record
+FROM connection_logs
+WHERE connect_time < $1::timestamp with time zone
+ORDER BY connect_time ASC, id ASC
+LIMIT $2
+`
+
+type GetOldConnectionLogsForArchiveParams struct {
+	BeforeTime time.Time `db:"before_time" json:"before_time"`
+	LimitCount int32     `db:"limit_count" json:"limit_count"`
+}
+
+// Returns the connection logs that DeleteOldConnectionLogs will delete with
+// the same arguments, as JSON, so they can be archived first.
+func (q *sqlQuerier) GetOldConnectionLogsForArchive(ctx context.Context, arg GetOldConnectionLogsForArchiveParams) ([]json.RawMessage, error) {
+	rows, err := q.db.QueryContext(ctx, getOldConnectionLogsForArchive, arg.BeforeTime, arg.LimitCount)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []json.RawMessage
+	for rows.Next() {
+		var record json.RawMessage
+		if err := rows.Scan(&record); err != nil {
+			return nil, err
+		}
+		items = append(items, record)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
 const deleteCryptoKey = `-- name: DeleteCryptoKey :one
 UPDATE crypto_keys
 SET secret = NULL, secret_key_id = NULL
@@ -15240,14 +15496,18 @@ WHERE
 				groups.id = ANY($4)
 			ELSE true
 		END
+		AND CASE WHEN array_length($5 :: text[], 1) > 0  THEN
+				groups.display_name = ANY($5)
+			ELSE true
+		END
 		-- Filter by group name or display name (substring, case-insensitive).
-		AND CASE WHEN $5 :: text != '' THEN (
-				groups.name ILIKE concat('%', $5, '%')
-				OR groups.display_name ILIKE concat('%', $5, '%')
+		AND CASE WHEN $6 :: text != '' THEN (
+				groups.name ILIKE concat('%', $6, '%')
+				OR groups.display_name ILIKE concat('%', $6, '%')
 			)
 			ELSE true
 		END
-LIMIT NULLIF($6 :: int, 0)
+LIMIT NULLIF($7 :: int, 0)
 `
 
 type GetGroupsParams struct {
@@ -15255,6 +15515,7 @@ type GetGroupsParams struct {
 	HasMemberID    uuid.UUID   `db:"has_member_id" json:"has_member_id"`
 	GroupNames     []string    `db:"group_names" json:"group_names"`
 	GroupIds       []uuid.UUID `db:"group_ids" json:"group_ids"`
+	DisplayNames   []string    `db:"display_names" json:"display_names"`
 	Search         string      `db:"search" json:"search"`
 	LimitOpt       int32       `db:"limit_opt" json:"limit_opt"`
 }
@@ -15272,6 +15533,7 @@ func (q *sqlQuerier) GetGroups(ctx context.Context, arg GetGroupsParams) ([]GetG
 		arg.HasMemberID,
 		pq.Array(arg.GroupNames),
 		pq.Array(arg.GroupIds),
+		pq.Array(arg.DisplayNames),
 		arg.Search,
 		arg.LimitOpt,
 	)
@@ -19197,6 +19459,15 @@ func (q *sqlQuerier) UpdateInboxNotificationReadStatus(ctx context.Context, arg
 	return err
 }
 
+const deleteExpiredOAuth2ProviderAppDeviceCodes = `-- name: DeleteExpiredOAuth2ProviderAppDeviceCodes :exec
+DELETE FROM oauth2_provider_app_device_codes WHERE expires_at < $1
+`
+
+func (q *sqlQuerier) DeleteExpiredOAuth2ProviderAppDeviceCodes(ctx context.Context, beforeTime time.Time) error {
+	_, err := q.db.ExecContext(ctx, deleteExpiredOAuth2ProviderAppDeviceCodes, beforeTime)
+	return err
+}
+
 const deleteOAuth2ProviderAppByClientID = `-- name: DeleteOAuth2ProviderAppByClientID :exec
 DELETE FROM oauth2_provider_apps WHERE id = $1
 `
@@ -19238,6 +19509,15 @@ func (q *sqlQuerier) DeleteOAuth2ProviderAppCodesByAppAndUserID(ctx context.Cont
 	return err
 }
 
+const deleteOAuth2ProviderAppDeviceCodeByID = `-- name: DeleteOAuth2ProviderAppDeviceCodeByID :exec
+DELETE FROM oauth2_provider_app_device_codes WHERE id = $1
+`
+
+func (q *sqlQuerier) DeleteOAuth2ProviderAppDeviceCodeByID(ctx context.Context, id uuid.UUID) error {
+	_, err := q.db.ExecContext(ctx, deleteOAuth2ProviderAppDeviceCodeByID, id)
+	return err
+}
+
 const deleteOAuth2ProviderAppSecretByID = `-- name: DeleteOAuth2ProviderAppSecretByID :exec
 DELETE FROM oauth2_provider_app_secrets WHERE id = $1
 `
@@ -19270,7 +19550,7 @@ func (q *sqlQuerier) DeleteOAuth2ProviderAppTokensByAppAndUserID(ctx context.Con
 
 const getOAuth2ProviderAppByClientID = `-- name: GetOAuth2ProviderAppByClientID :one
 
-SELECT id, created_at, updated_at, name, icon, callback_url, redirect_uris, client_type, dynamically_registered, client_id_issued_at, client_secret_expires_at, grant_types, response_types, token_endpoint_auth_method, scope, contacts, client_uri, logo_uri, tos_uri, policy_uri, jwks_uri, jwks, software_id, software_version, registration_access_token, registration_client_uri FROM oauth2_provider_apps WHERE id = $1
+SELECT id, created_at, updated_at, name, icon, callback_url, redirect_uris, client_type, dynamically_registered, client_id_issued_at, client_secret_expires_at, grant_types, response_types, token_endpoint_auth_method, scope, contacts, client_uri, logo_uri, tos_uri, policy_uri, jwks_uri, jwks, software_id, software_version, registration_access_token, registration_client_uri, client_credentials_user_id FROM oauth2_provider_apps WHERE id = $1
 `
 
 // RFC 7591/7592 Dynamic Client Registration queries
@@ -19304,12 +19584,13 @@ func (q *sqlQuerier) GetOAuth2ProviderAppByClientID(ctx context.Context, id uuid
 		&i.SoftwareVersion,
 		&i.RegistrationAccessToken,
 		&i.RegistrationClientUri,
+		&i.ClientCredentialsUserID,
 	)
 	return i, err
 }
 
 const getOAuth2ProviderAppByID = `-- name: GetOAuth2ProviderAppByID :one
-SELECT id, created_at, updated_at, name, icon, callback_url, redirect_uris, client_type, dynamically_registered, client_id_issued_at, client_secret_expires_at, grant_types, response_types, token_endpoint_auth_method, scope, contacts, client_uri, logo_uri, tos_uri, policy_uri, jwks_uri, jwks, software_id, software_version, registration_access_token, registration_client_uri FROM oauth2_provider_apps WHERE id = $1
+SELECT id, created_at, updated_at, name, icon, callback_url, redirect_uris, client_type, dynamically_registered, client_id_issued_at, client_secret_expires_at, grant_types, response_types, token_endpoint_auth_method, scope, contacts, client_uri, logo_uri, tos_uri, policy_uri, jwks_uri, jwks, software_id, software_version, registration_access_token, registration_client_uri, client_credentials_user_id FROM oauth2_provider_apps WHERE id = $1
 `
 
 func (q *sqlQuerier) GetOAuth2ProviderAppByID(ctx context.Context, id uuid.UUID) (OAuth2ProviderApp, error) {
@@ -19342,6 +19623,7 @@ func (q *sqlQuerier) GetOAuth2ProviderAppByID(ctx context.Context, id uuid.UUID)
 		&i.SoftwareVersion,
 		&i.RegistrationAccessToken,
 		&i.RegistrationClientUri,
+		&i.ClientCredentialsUserID,
 	)
 	return i, err
 }
@@ -19396,6 +19678,81 @@ func (q *sqlQuerier) GetOAuth2ProviderAppCodeByPrefix(ctx context.Context, secre
 	return i, err
 }
 
+const getOAuth2ProviderAppDeviceCodeByID = `-- name: GetOAuth2ProviderAppDeviceCodeByID :one
+SELECT id, created_at, expires_at, device_code_prefix, device_code_hash, user_code, app_id, user_id, status, resource_uri, scope, polling_interval, last_polled_at FROM oauth2_provider_app_device_codes WHERE id = $1
+`
+
+func (q *sqlQuerier) GetOAuth2ProviderAppDeviceCodeByID(ctx context.Context, id uuid.UUID) (OAuth2ProviderAppDeviceCode, error) {
+	row := q.db.QueryRowContext(ctx, getOAuth2ProviderAppDeviceCodeByID, id)
+	var i OAuth2ProviderAppDeviceCode
+	err := row.Scan(
+		&i.ID,
+		&i.CreatedAt,
+		&i.ExpiresAt,
+		&i.DeviceCodePrefix,
+		&i.DeviceCodeHash,
+		&i.UserCode,
+		&i.AppID,
+		&i.UserID,
+		&i.Status,
+		&i.ResourceUri,
+		&i.Scope,
+		&i.PollingInterval,
+		&i.LastPolledAt,
+	)
+	return i, err
+}
+
+const getOAuth2ProviderAppDeviceCodeByPrefix = `-- name: GetOAuth2ProviderAppDeviceCodeByPrefix :one
+SELECT id, created_at, expires_at, device_code_prefix, device_code_hash, user_code, app_id, user_id, status, resource_uri, scope, polling_interval, last_polled_at FROM oauth2_provider_app_device_codes WHERE device_code_prefix = $1
+`
+
+func (q *sqlQuerier) GetOAuth2ProviderAppDeviceCodeByPrefix(ctx context.Context, deviceCodePrefix []byte) (OAuth2ProviderAppDeviceCode, error) {
+	row := q.db.QueryRowContext(ctx, getOAuth2ProviderAppDeviceCodeByPrefix, deviceCodePrefix)
+	var i OAuth2ProviderAppDeviceCode
+	err := row.Scan(
+		&i.ID,
+		&i.CreatedAt,
+		&i.ExpiresAt,
+		&i.DeviceCodePrefix,
+		&i.DeviceCodeHash,
+		&i.UserCode,
+		&i.AppID,
+		&i.UserID,
+		&i.Status,
+		&i.ResourceUri,
+		&i.Scope,
+		&i.PollingInterval,
+		&i.LastPolledAt,
+	)
+	return i, err
+}
+
+const getOAuth2ProviderAppDeviceCodeByUserCode = `-- name: GetOAuth2ProviderAppDeviceCodeByUserCode :one
+SELECT id, created_at, expires_at, device_code_prefix, device_code_hash, user_code, app_id, user_id, status, resource_uri, scope, polling_interval, last_polled_at FROM oauth2_provider_app_device_codes WHERE user_code = $1
+`
+
+func (q *sqlQuerier) GetOAuth2ProviderAppDeviceCodeByUserCode(ctx context.Context, userCode string) (OAuth2ProviderAppDeviceCode, error) {
+	row := q.db.QueryRowContext(ctx, getOAuth2ProviderAppDeviceCodeByUserCode, userCode)
+	var i OAuth2ProviderAppDeviceCode
+	err := row.Scan(
+		&i.ID,
+		&i.CreatedAt,
+		&i.ExpiresAt,
+		&i.DeviceCodePrefix,
+		&i.DeviceCodeHash,
+		&i.UserCode,
+		&i.AppID,
+		&i.UserID,
+		&i.Status,
+		&i.ResourceUri,
+		&i.Scope,
+		&i.PollingInterval,
+		&i.LastPolledAt,
+	)
+	return i, err
+}
+
 const getOAuth2ProviderAppSecretByID = `-- name: GetOAuth2ProviderAppSecretByID :one
 SELECT id, created_at, last_used_at, hashed_secret, display_secret, app_id, secret_prefix FROM oauth2_provider_app_secrets WHERE id = $1
 `
@@ -19516,7 +19873,7 @@ func (q *sqlQuerier) GetOAuth2ProviderAppTokenByPrefix(ctx context.Context, hash
 }
 
 const getOAuth2ProviderApps = `-- name: GetOAuth2ProviderApps :many
-SELECT id, created_at, updated_at, name, icon, callback_url, redirect_uris, client_type, dynamically_registered, client_id_issued_at, client_secret_expires_at, grant_types, response_types, token_endpoint_auth_method, scope, contacts, client_uri, logo_uri, tos_uri, policy_uri, jwks_uri, jwks, software_id, software_version, registration_access_token, registration_client_uri FROM oauth2_provider_apps ORDER BY (name, id) ASC
+SELECT id, created_at, updated_at, name, icon, callback_url, redirect_uris, client_type, dynamically_registered, client_id_issued_at, client_secret_expires_at, grant_types, response_types, token_endpoint_auth_method, scope, contacts, client_uri, logo_uri, tos_uri, policy_uri, jwks_uri, jwks, software_id, software_version, registration_access_token, registration_client_uri, client_credentials_user_id FROM oauth2_provider_apps ORDER BY (name, id) ASC
 `
 
 func (q *sqlQuerier) GetOAuth2ProviderApps(ctx context.Context) ([]OAuth2ProviderApp, error) {
@@ -19555,6 +19912,7 @@ func (q *sqlQuerier) GetOAuth2ProviderApps(ctx context.Context) ([]OAuth2Provide
 			&i.SoftwareVersion,
 			&i.RegistrationAccessToken,
 			&i.RegistrationClientUri,
+			&i.ClientCredentialsUserID,
 		); err != nil {
 			return nil, err
 		}
@@ -19572,7 +19930,7 @@ func (q *sqlQuerier) GetOAuth2ProviderApps(ctx context.Context) ([]OAuth2Provide
 const getOAuth2ProviderAppsByUserID = `-- name: GetOAuth2ProviderAppsByUserID :many
 SELECT
   COUNT(DISTINCT oauth2_provider_app_tokens.id) as token_count,
-  oauth2_provider_apps.id, oauth2_provider_apps.created_at, oauth2_provider_apps.updated_at, oauth2_provider_apps.name, oauth2_provider_apps.icon, oauth2_provider_apps.callback_url, oauth2_provider_apps.redirect_uris, oauth2_provider_apps.client_type, oauth2_provider_apps.dynamically_registered, oauth2_provider_apps.client_id_issued_at, oauth2_provider_apps.client_secret_expires_at, oauth2_provider_apps.grant_types, oauth2_provider_apps.response_types, oauth2_provider_apps.token_endpoint_auth_method, oauth2_provider_apps.scope, oauth2_provider_apps.contacts, oauth2_provider_apps.client_uri, oauth2_provider_apps.logo_uri, oauth2_provider_apps.tos_uri, oauth2_provider_apps.policy_uri, oauth2_provider_apps.jwks_uri, oauth2_provider_apps.jwks, oauth2_provider_apps.software_id, oauth2_provider_apps.software_version, oauth2_provider_apps.registration_access_token, oauth2_provider_apps.registration_client_uri
+  oauth2_provider_apps.id, oauth2_provider_apps.created_at, oauth2_provider_apps.updated_at, oauth2_provider_apps.name, oauth2_provider_apps.icon, oauth2_provider_apps.callback_url, oauth2_provider_apps.redirect_uris, oauth2_provider_apps.client_type, oauth2_provider_apps.dynamically_registered, oauth2_provider_apps.client_id_issued_at, oauth2_provider_apps.client_secret_expires_at, oauth2_provider_apps.grant_types, oauth2_provider_apps.response_types, oauth2_provider_apps.token_endpoint_auth_method, oauth2_provider_apps.scope, oauth2_provider_apps.contacts, oauth2_provider_apps.client_uri, oauth2_provider_apps.logo_uri, oauth2_provider_apps.tos_uri, oauth2_provider_apps.policy_uri, oauth2_provider_apps.jwks_uri, oauth2_provider_apps.jwks, oauth2_provider_apps.software_id, oauth2_provider_apps.software_version, oauth2_provider_apps.registration_access_token, oauth2_provider_apps.registration_client_uri, oauth2_provider_apps.client_credentials_user_id
 FROM oauth2_provider_app_tokens
   INNER JOIN oauth2_provider_apps
     ON oauth2_provider_apps.id = oauth2_provider_app_tokens.app_id
@@ -19627,6 +19985,7 @@ func (q *sqlQuerier) GetOAuth2ProviderAppsByUserID(ctx context.Context, userID u
 			&i.OAuth2ProviderApp.SoftwareVersion,
 			&i.OAuth2ProviderApp.RegistrationAccessToken,
 			&i.OAuth2ProviderApp.RegistrationClientUri,
+			&i.OAuth2ProviderApp.ClientCredentialsUserID,
 		); err != nil {
 			return nil, err
 		}
@@ -19668,7 +20027,8 @@ INSERT INTO oauth2_provider_apps (
     software_id,
     software_version,
     registration_access_token,
-    registration_client_uri
+    registration_client_uri,
+    client_credentials_user_id
 ) VALUES(
     $1,
     $2,
@@ -19695,8 +20055,9 @@ INSERT INTO oauth2_provider_apps (
     $23,
     $24,
     $25,
-    $26
-) RETURNING id, created_at, updated_at, name, icon, callback_url, redirect_uris, client_type, dynamically_registered, client_id_issued_at, client_secret_expires_at, grant_types, response_types, token_endpoint_auth_method, scope, contacts, client_uri, logo_uri, tos_uri, policy_uri, jwks_uri, jwks, software_id, software_version, registration_access_token, registration_client_uri
+    $26,
+    $27
+) RETURNING id, created_at, updated_at, name, icon, callback_url, redirect_uris, client_type, dynamically_registered, client_id_issued_at, client_secret_expires_at, grant_types, response_types, token_endpoint_auth_method, scope, contacts, client_uri, logo_uri, tos_uri, policy_uri, jwks_uri, jwks, software_id, software_version, registration_access_token, registration_client_uri, client_credentials_user_id
 `
 
 type InsertOAuth2ProviderAppParams struct {
@@ -19726,6 +20087,7 @@ type InsertOAuth2ProviderAppParams struct {
 	SoftwareVersion         sql.NullString        `db:"software_version" json:"software_version"`
 	RegistrationAccessToken []byte                `db:"registration_access_token" json:"registration_access_token"`
 	RegistrationClientUri   sql.NullString        `db:"registration_client_uri" json:"registration_client_uri"`
+	ClientCredentialsUserID uuid.NullUUID         `db:"client_credentials_user_id" json:"client_credentials_user_id"`
 }
 
 func (q *sqlQuerier) InsertOAuth2ProviderApp(ctx context.Context, arg InsertOAuth2ProviderAppParams) (OAuth2ProviderApp, error) {
@@ -19756,6 +20118,7 @@ func (q *sqlQuerier) InsertOAuth2ProviderApp(ctx context.Context, arg InsertOAut
 		arg.SoftwareVersion,
 		arg.RegistrationAccessToken,
 		arg.RegistrationClientUri,
+		arg.ClientCredentialsUserID,
 	)
 	var i OAuth2ProviderApp
 	err := row.Scan(
@@ -19785,6 +20148,7 @@ func (q *sqlQuerier) InsertOAuth2ProviderApp(ctx context.Context, arg InsertOAut
 		&i.SoftwareVersion,
 		&i.RegistrationAccessToken,
 		&i.RegistrationClientUri,
+		&i.ClientCredentialsUserID,
 	)
 	return i, err
 }
@@ -19872,6 +20236,77 @@ func (q *sqlQuerier) InsertOAuth2ProviderAppCode(ctx context.Context, arg Insert
 	return i, err
 }
 
+const insertOAuth2ProviderAppDeviceCode = `-- name: InsertOAuth2ProviderAppDeviceCode :one
+INSERT INTO oauth2_provider_app_device_codes (
+    id,
+    created_at,
+    expires_at,
+    device_code_prefix,
+    device_code_hash,
+    user_code,
+    app_id,
+    resource_uri,
+    scope,
+    polling_interval
+) VALUES(
+    $1,
+    $2,
+    $3,
+    $4,
+    $5,
+    $6,
+    $7,
+    $8,
+    $9,
+    $10
+) RETURNING id, created_at, expires_at, device_code_prefix, device_code_hash, user_code, app_id, user_id, status, resource_uri, scope, polling_interval, last_polled_at
+`
+
+type InsertOAuth2ProviderAppDeviceCodeParams struct {
+	ID               uuid.UUID      `db:"id" json:"id"`
+	CreatedAt        time.Time      `db:"created_at" json:"created_at"`
+	ExpiresAt        time.Time      `db:"expires_at" json:"expires_at"`
+	DeviceCodePrefix []byte         `db:"device_code_prefix" json:"device_code_prefix"`
+	DeviceCodeHash   []byte         `db:"device_code_hash" json:"device_code_hash"`
+	UserCode         string         `db:"user_code" json:"user_code"`
+	AppID            uuid.UUID      `db:"app_id" json:"app_id"`
+	ResourceUri      sql.NullString `db:"resource_uri" json:"resource_uri"`
+	Scope            string         `db:"scope" json:"scope"`
+	PollingInterval  int32          `db:"polling_interval" json:"polling_interval"`
+}
+
+func (q *sqlQuerier) InsertOAuth2ProviderAppDeviceCode(ctx context.Context, arg InsertOAuth2ProviderAppDeviceCodeParams) (OAuth2ProviderAppDeviceCode, error) {
+	row := q.db.QueryRowContext(ctx, insertOAuth2ProviderAppDeviceCode,
+		arg.ID,
+		arg.CreatedAt,
+		arg.ExpiresAt,
+		arg.DeviceCodePrefix,
+		arg.DeviceCodeHash,
+		arg.UserCode,
+		arg.AppID,
+		arg.ResourceUri,
+		arg.Scope,
+		arg.PollingInterval,
+	)
+	var i OAuth2ProviderAppDeviceCode
+	err := row.Scan(
+		&i.ID,
+		&i.CreatedAt,
+		&i.ExpiresAt,
+		&i.DeviceCodePrefix,
+		&i.DeviceCodeHash,
+		&i.UserCode,
+		&i.AppID,
+		&i.UserID,
+		&i.Status,
+		&i.ResourceUri,
+		&i.Scope,
+		&i.PollingInterval,
+		&i.LastPolledAt,
+	)
+	return i, err
+}
+
 const insertOAuth2ProviderAppSecret = `-- name: InsertOAuth2ProviderAppSecret :one
 INSERT INTO oauth2_provider_app_secrets (
     id,
@@ -20016,7 +20451,7 @@ UPDATE oauth2_provider_apps SET
     jwks = $19,
     software_id = $20,
     software_version = $21
-WHERE id = $1 RETURNING id, created_at, updated_at, name, icon, callback_url, redirect_uris, client_type, dynamically_registered, client_id_issued_at, client_secret_expires_at, grant_types, response_types, token_endpoint_auth_method, scope, contacts, client_uri, logo_uri, tos_uri, policy_uri, jwks_uri, jwks, software_id, software_version, registration_access_token, registration_client_uri
+WHERE id = $1 RETURNING id, created_at, updated_at, name, icon, callback_url, redirect_uris, client_type, dynamically_registered, client_id_issued_at, client_secret_expires_at, grant_types, response_types, token_endpoint_auth_method, scope, contacts, client_uri, logo_uri, tos_uri, policy_uri, jwks_uri, jwks, software_id, software_version, registration_access_token, registration_client_uri, client_credentials_user_id
 `
 
 type UpdateOAuth2ProviderAppByClientIDParams struct {
@@ -20095,6 +20530,7 @@ func (q *sqlQuerier) UpdateOAuth2ProviderAppByClientID(ctx context.Context, arg
 		&i.SoftwareVersion,
 		&i.RegistrationAccessToken,
 		&i.RegistrationClientUri,
+		&i.ClientCredentialsUserID,
 	)
 	return i, err
 }
@@ -20121,8 +20557,9 @@ UPDATE oauth2_provider_apps SET
     jwks_uri = $19,
     jwks = $20,
     software_id = $21,
-    software_version = $22
-WHERE id = $1 RETURNING id, created_at, updated_at, name, icon, callback_url, redirect_uris, client_type, dynamically_registered, client_id_issued_at, client_secret_expires_at, grant_types, response_types, token_endpoint_auth_method, scope, contacts, client_uri, logo_uri, tos_uri, policy_uri, jwks_uri, jwks, software_id, software_version, registration_access_token, registration_client_uri
+    software_version = $22,
+    client_credentials_user_id = $23
+WHERE id = $1 RETURNING id, created_at, updated_at, name, icon, callback_url, redirect_uris, client_type, dynamically_registered, client_id_issued_at, client_secret_expires_at, grant_types, response_types, token_endpoint_auth_method, scope, contacts, client_uri, logo_uri, tos_uri, policy_uri, jwks_uri, jwks, software_id, software_version, registration_access_token, registration_client_uri, client_credentials_user_id
 `
 
 type UpdateOAuth2ProviderAppByIDParams struct {
@@ -20148,6 +20585,7 @@ type UpdateOAuth2ProviderAppByIDParams struct {
 	Jwks                    pqtype.NullRawMessage `db:"jwks" json:"jwks"`
 	SoftwareID              sql.NullString        `db:"software_id" json:"software_id"`
 	SoftwareVersion         sql.NullString        `db:"software_version" json:"software_version"`
+	ClientCredentialsUserID uuid.NullUUID         `db:"client_credentials_user_id" json:"client_credentials_user_id"`
 }
 
 func (q *sqlQuerier) UpdateOAuth2ProviderAppByID(ctx context.Context, arg UpdateOAuth2ProviderAppByIDParams) (OAuth2ProviderApp, error) {
@@ -20174,6 +20612,7 @@ func (q *sqlQuerier) UpdateOAuth2ProviderAppByID(ctx context.Context, arg Update
 		arg.Jwks,
 		arg.SoftwareID,
 		arg.SoftwareVersion,
+		arg.ClientCredentialsUserID,
 	)
 	var i OAuth2ProviderApp
 	err := row.Scan(
@@ -20203,6 +20642,64 @@ func (q *sqlQuerier) UpdateOAuth2ProviderAppByID(ctx context.Context, arg Update
 		&i.SoftwareVersion,
 		&i.RegistrationAccessToken,
 		&i.RegistrationClientUri,
+		&i.ClientCredentialsUserID,
+	)
+	return i, err
+}
+
+const updateOAuth2ProviderAppDeviceCodePoll = `-- name: UpdateOAuth2ProviderAppDeviceCodePoll :exec
+UPDATE oauth2_provider_app_device_codes SET
+    last_polled_at = $1,
+    polling_interval = $2
+WHERE id = $3
+`
+
+type UpdateOAuth2ProviderAppDeviceCodePollParams struct {
+	LastPolledAt    sql.NullTime `db:"last_polled_at" json:"last_polled_at"`
+	PollingInterval int32        `db:"polling_interval" json:"polling_interval"`
+	ID              uuid.UUID    `db:"id" json:"id"`
+}
+
+func (q *sqlQuerier) UpdateOAuth2ProviderAppDeviceCodePoll(ctx context.Context, arg UpdateOAuth2ProviderAppDeviceCodePollParams) error {
+	_, err := q.db.ExecContext(ctx, updateOAuth2ProviderAppDeviceCodePoll, arg.LastPolledAt, arg.PollingInterval, arg.ID)
+	return err
+}
+
+const updateOAuth2ProviderAppDeviceCodeStatus = `-- name: UpdateOAuth2ProviderAppDeviceCodeStatus :one
+UPDATE oauth2_provider_app_device_codes SET
+    status = $1,
+    user_id = $2
+WHERE
+    id = $3
+    AND status = 'pending'::oauth2_device_code_status
+RETURNING id, created_at, expires_at, device_code_prefix, device_code_hash, user_code, app_id, user_id, status, resource_uri, scope, polling_interval, last_polled_at
+`
+
+type UpdateOAuth2ProviderAppDeviceCodeStatusParams struct {
+	Status OAuth2DeviceCodeStatus `db:"status" json:"status"`
+	UserID uuid.NullUUID          `db:"user_id" json:"user_id"`
+	ID     uuid.UUID              `db:"id" json:"id"`
+}
+
+// Only a pending code can be approved or denied, so a code cannot change
+// hands once a user has acted on it.
+func (q *sqlQuerier) UpdateOAuth2ProviderAppDeviceCodeStatus(ctx context.Context, arg UpdateOAuth2ProviderAppDeviceCodeStatusParams) (OAuth2ProviderAppDeviceCode, error) {
+	row := q.db.QueryRowContext(ctx, updateOAuth2ProviderAppDeviceCodeStatus, arg.Status, arg.UserID, arg.ID)
+	var i OAuth2ProviderAppDeviceCode
+	err := row.Scan(
+		&i.ID,
+		&i.CreatedAt,
+		&i.ExpiresAt,
+		&i.DeviceCodePrefix,
+		&i.DeviceCodeHash,
+		&i.UserCode,
+		&i.AppID,
+		&i.UserID,
+		&i.Status,
+		&i.ResourceUri,
+		&i.Scope,
+		&i.PollingInterval,
+		&i.LastPolledAt,
 	)
 	return i, err
 }
@@ -23013,6 +23510,104 @@ func (q *sqlQuerier) UpdateProvisionerJobLogsOverflowed(ctx context.Context, arg
 	return err
 }
 
+const deleteProvisionerJobPriority = `-- name: DeleteProvisionerJobPriority :exec
+DELETE FROM
+	provisioner_job_priorities
+WHERE
+	organization_id = $1
+	AND template_id = $2
+`
+
+type DeleteProvisionerJobPriorityParams struct {
+	OrganizationID uuid.UUID `db:"organization_id" json:"organization_id"`
+	TemplateID     uuid.UUID `db:"template_id" json:"template_id"`
+}
+
+func (q *sqlQuerier) DeleteProvisionerJobPriority(ctx context.Context, arg DeleteProvisionerJobPriorityParams) error {
+	_, err := q.db.ExecContext(ctx, deleteProvisionerJobPriority, arg.OrganizationID, arg.TemplateID)
+	return err
+}
+
+const getProvisionerJobPrioritiesByOrganizationID = `-- name: GetProvisionerJobPrioritiesByOrganizationID :many
+SELECT
+	organization_id, template_id, priority, updated_at
+FROM
+	provisioner_job_priorities
+WHERE
+	organization_id = $1 :: uuid
+ORDER BY
+	template_id ASC
+`
+
+func (q *sqlQuerier) GetProvisionerJobPrioritiesByOrganizationID(ctx context.Context, organizationID uuid.UUID) ([]ProvisionerJobPriority, error) {
+	rows, err := q.db.QueryContext(ctx, getProvisionerJobPrioritiesByOrganizationID, organizationID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []ProvisionerJobPriority
+	for rows.Next() {
+		var i ProvisionerJobPriority
+		if err := rows.Scan(
+			&i.OrganizationID,
+			&i.TemplateID,
+			&i.Priority,
+			&i.UpdatedAt,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const upsertProvisionerJobPriority = `-- name: UpsertProvisionerJobPriority :one
+INSERT INTO
+	provisioner_job_priorities (
+		organization_id,
+		template_id,
+		priority,
+		updated_at
+	)
+VALUES
+	($1, $2, $3, $4)
+ON CONFLICT (organization_id, template_id) DO UPDATE
+SET
+	priority = EXCLUDED.priority,
+	updated_at = EXCLUDED.updated_at
+RETURNING organization_id, template_id, priority, updated_at
+`
+
+type UpsertProvisionerJobPriorityParams struct {
+	OrganizationID uuid.UUID `db:"organization_id" json:"organization_id"`
+	TemplateID     uuid.UUID `db:"template_id" json:"template_id"`
+	Priority       int32     `db:"priority" json:"priority"`
+	UpdatedAt      time.Time `db:"updated_at" json:"updated_at"`
+}
+
+func (q *sqlQuerier) UpsertProvisionerJobPriority(ctx context.Context, arg UpsertProvisionerJobPriorityParams) (ProvisionerJobPriority, error) {
+	row := q.db.QueryRowContext(ctx, upsertProvisionerJobPriority,
+		arg.OrganizationID,
+		arg.TemplateID,
+		arg.Priority,
+		arg.UpdatedAt,
+	)
+	var i ProvisionerJobPriority
+	err := row.Scan(
+		&i.OrganizationID,
+		&i.TemplateID,
+		&i.Priority,
+		&i.UpdatedAt,
+	)
+	return i, err
+}
+
 const acquireProvisionerJob = `-- name: AcquireProvisionerJob :one
 UPDATE
 	provisioner_jobs
@@ -23036,14 +23631,26 @@ WHERE
 			-- they are aliases and the code that calls this query already relies on a different type
 			AND provisioner_tagset_contains($5 :: jsonb, potential_job.tags :: jsonb)
 		ORDER BY
+			potential_job.priority DESC,
 			-- Ensure that human-initiated jobs are prioritized over prebuilds.
 			potential_job.initiator_id = 'c42fdf75-3097-471c-8c33-fb52454d81c0'::uuid ASC,
+			-- Fair share between initiators.
+			(
+				SELECT
+					COUNT(*)
+				FROM
+					provisioner_jobs AS running_job
+				WHERE
+					running_job.job_status = 'running'
+					AND running_job.organization_id = potential_job.organization_id
+					AND running_job.initiator_id = potential_job.initiator_id
+			) ASC,
 			potential_job.created_at ASC
 		FOR UPDATE
 		SKIP LOCKED
 		LIMIT
 			1
-	) RETURNING id, created_at, updated_at, started_at, canceled_at, completed_at, error, organization_id, initiator_id, provisioner, storage_method, type, input, worker_id, file_id, tags, error_code, trace_metadata, job_status, logs_length, logs_overflowed
+	) RETURNING id, created_at, updated_at, started_at, canceled_at, completed_at, error, organization_id, initiator_id, provisioner, storage_method, type, input, worker_id, file_id, tags, error_code, trace_metadata, job_status, logs_length, logs_overflowed, priority
 `
 
 type AcquireProvisionerJobParams struct {
@@ -23057,6 +23664,12 @@ type AcquireProvisionerJobParams struct {
 // Acquires the lock for a single job that isn't started, completed,
 // canceled, and that matches an array of provisioner types.
 //
+// Jobs are acquired by priority first. Within the same priority, jobs of
+// initiators with the fewest running jobs in the organization are acquired
+// first, so a single user queueing many jobs cannot starve everyone else.
+// Organizations do not compete with each other since provisioner daemons only
+// acquire jobs of their own organization.
+//
 // SKIP LOCKED is used to jump over locked rows. This prevents
 // multiple provisioners from acquiring the same jobs. See:
 // https://www.postgresql.org/docs/9.5/sql-select.html#SQL-FOR-UPDATE-SHARE
@@ -23091,13 +23704,14 @@ func (q *sqlQuerier) AcquireProvisionerJob(ctx context.Context, arg AcquireProvi
 		&i.JobStatus,
 		&i.LogsLength,
 		&i.LogsOverflowed,
+		&i.Priority,
 	)
 	return i, err
 }
 
 const getProvisionerJobByID = `-- name: GetProvisionerJobByID :one
 SELECT
-	id, created_at, updated_at, started_at, canceled_at, completed_at, error, organization_id, initiator_id, provisioner, storage_method, type, input, worker_id, file_id, tags, error_code, trace_metadata, job_status, logs_length, logs_overflowed
+	id, created_at, updated_at, started_at, canceled_at, completed_at, error, organization_id, initiator_id, provisioner, storage_method, type, input, worker_id, file_id, tags, error_code, trace_metadata, job_status, logs_length, logs_overflowed, priority
 FROM
 	provisioner_jobs
 WHERE
@@ -23129,13 +23743,14 @@ func (q *sqlQuerier) GetProvisionerJobByID(ctx context.Context, id uuid.UUID) (P
 		&i.JobStatus,
 		&i.LogsLength,
 		&i.LogsOverflowed,
+		&i.Priority,
 	)
 	return i, err
 }
 
 const getProvisionerJobByIDForUpdate = `-- name: GetProvisionerJobByIDForUpdate :one
 SELECT
-	id, created_at, updated_at, started_at, canceled_at, completed_at, error, organization_id, initiator_id, provisioner, storage_method, type, input, worker_id, file_id, tags, error_code, trace_metadata, job_status, logs_length, logs_overflowed
+	id, created_at, updated_at, started_at, canceled_at, completed_at, error, organization_id, initiator_id, provisioner, storage_method, type, input, worker_id, file_id, tags, error_code, trace_metadata, job_status, logs_length, logs_overflowed, priority
 FROM
 	provisioner_jobs
 WHERE
@@ -23171,13 +23786,14 @@ func (q *sqlQuerier) GetProvisionerJobByIDForUpdate(ctx context.Context, id uuid
 		&i.JobStatus,
 		&i.LogsLength,
 		&i.LogsOverflowed,
+		&i.Priority,
 	)
 	return i, err
 }
 
 const getProvisionerJobByIDWithLock = `-- name: GetProvisionerJobByIDWithLock :one
 SELECT
-	id, created_at, updated_at, started_at, canceled_at, completed_at, error, organization_id, initiator_id, provisioner, storage_method, type, input, worker_id, file_id, tags, error_code, trace_metadata, job_status, logs_length, logs_overflowed
+	id, created_at, updated_at, started_at, canceled_at, completed_at, error, organization_id, initiator_id, provisioner, storage_method, type, input, worker_id, file_id, tags, error_code, trace_metadata, job_status, logs_length, logs_overflowed, priority
 FROM
 	provisioner_jobs
 WHERE
@@ -23212,6 +23828,7 @@ func (q *sqlQuerier) GetProvisionerJobByIDWithLock(ctx context.Context, id uuid.
 		&i.JobStatus,
 		&i.LogsLength,
 		&i.LogsOverflowed,
+		&i.Priority,
 	)
 	return i, err
 }
@@ -23266,12 +23883,23 @@ WITH filtered_provisioner_jobs AS (
 pending_jobs AS (
 	-- Step 2: Extract only pending jobs
 	SELECT
-		id, initiator_id, created_at, tags
+		id, organization_id, initiator_id, priority, created_at, tags
 	FROM
 		provisioner_jobs
 	WHERE
 		job_status = 'pending'
 ),
+running_jobs AS (
+	-- Running jobs per initiator, used for fair share. See AcquireProvisionerJob.
+	SELECT
+		organization_id, initiator_id, COUNT(*) AS count
+	FROM
+		provisioner_jobs
+	WHERE
+		job_status = 'running'
+	GROUP BY
+		organization_id, initiator_id
+),
 unique_daemon_tags AS (
 	SELECT DISTINCT tags FROM provisioner_daemons pd
 	WHERE pd.last_seen_at IS NOT NULL
@@ -23290,7 +23918,8 @@ ranked_jobs AS (
 	SELECT
 		pj.id,
 		pj.created_at,
-		ROW_NUMBER() OVER (PARTITION BY rdt.tags ORDER BY pj.initiator_id = 'c42fdf75-3097-471c-8c33-fb52454d81c0'::uuid ASC, pj.created_at ASC) AS queue_position,
+		-- Keep the ordering in sync with AcquireProvisionerJob.
+		ROW_NUMBER() OVER (PARTITION BY rdt.tags ORDER BY pj.priority DESC, pj.initiator_id = 'c42fdf75-3097-471c-8c33-fb52454d81c0'::uuid ASC, COALESCE(rj.count, 0) ASC, pj.created_at ASC) AS queue_position,
 		COUNT(*) OVER (PARTITION BY rdt.tags) AS queue_size
 	FROM
 		pending_jobs pj
@@ -23298,6 +23927,11 @@ ranked_jobs AS (
 		relevant_daemon_tags rdt
 	ON
 		provisioner_tagset_contains(rdt.tags, pj.tags)
+	LEFT JOIN
+		running_jobs rj
+	ON
+		rj.organization_id = pj.organization_id
+		AND rj.initiator_id = pj.initiator_id
 ),
 final_jobs AS (
 	-- Step 4: Compute best queue position and max queue size per job
@@ -23317,7 +23951,7 @@ SELECT
 	-- Step 5: Final SELECT with INNER JOIN provisioner_jobs
 	fj.id,
 	fj.created_at,
-	pj.id, pj.created_at, pj.updated_at, pj.started_at, pj.canceled_at, pj.completed_at, pj.error, pj.organization_id, pj.initiator_id, pj.provisioner, pj.storage_method, pj.type, pj.input, pj.worker_id, pj.file_id, pj.tags, pj.error_code, pj.trace_metadata, pj.job_status, pj.logs_length, pj.logs_overflowed,
+	pj.id, pj.created_at, pj.updated_at, pj.started_at, pj.canceled_at, pj.completed_at, pj.error, pj.organization_id, pj.initiator_id, pj.provisioner, pj.storage_method, pj.type, pj.input, pj.worker_id, pj.file_id, pj.tags, pj.error_code, pj.trace_metadata, pj.job_status, pj.logs_length, pj.logs_overflowed, pj.priority,
 	fj.queue_position,
 	fj.queue_size
 FROM
@@ -23375,6 +24009,7 @@ func (q *sqlQuerier) GetProvisionerJobsByIDsWithQueuePosition(ctx context.Contex
 			&i.ProvisionerJob.JobStatus,
 			&i.ProvisionerJob.LogsLength,
 			&i.ProvisionerJob.LogsOverflowed,
+			&i.ProvisionerJob.Priority,
 			&i.QueuePosition,
 			&i.QueueSize,
 		); err != nil {
@@ -23394,10 +24029,14 @@ func (q *sqlQuerier) GetProvisionerJobsByIDsWithQueuePosition(ctx context.Contex
 const getProvisionerJobsByOrganizationAndStatusWithQueuePositionAndProvisioner = `-- name: GetProvisionerJobsByOrganizationAndStatusWithQueuePositionAndProvisioner :many
 WITH pending_jobs AS (
     SELECT
-        id, initiator_id, created_at
+        id, initiator_id, priority, created_at
     FROM
         provisioner_jobs
     WHERE
+        -- Daemons only acquire jobs of their own organization, so jobs of
+        -- other organizations are not ahead in the queue.
+        organization_id = $1::uuid
+    AND
         started_at IS NULL
     AND
         canceled_at IS NULL
@@ -23406,18 +24045,34 @@ WITH pending_jobs AS (
     AND
         error IS NULL
 ),
+running_jobs AS (
+    -- Running jobs per initiator, used for fair share. See AcquireProvisionerJob.
+    SELECT
+        initiator_id, COUNT(*) AS count
+    FROM
+        provisioner_jobs
+    WHERE
+        organization_id = $1::uuid
+    AND
+        job_status = 'running'
+    GROUP BY
+        initiator_id
+),
 queue_position AS (
     SELECT
-        id,
-        ROW_NUMBER() OVER (ORDER BY initiator_id = 'c42fdf75-3097-471c-8c33-fb52454d81c0'::uuid ASC, created_at ASC) AS queue_position
+        pj.id,
+        -- Keep the ordering in sync with AcquireProvisionerJob.
+        ROW_NUMBER() OVER (ORDER BY pj.priority DESC, pj.initiator_id = 'c42fdf75-3097-471c-8c33-fb52454d81c0'::uuid ASC, COALESCE(rj.count, 0) ASC, pj.created_at ASC) AS queue_position
     FROM
-        pending_jobs
+        pending_jobs pj
+    LEFT JOIN
+        running_jobs rj ON rj.initiator_id = pj.initiator_id
 ),
 queue_size AS (
 	SELECT COUNT(*) AS count FROM pending_jobs
 )
 SELECT
-	pj.id, pj.created_at, pj.updated_at, pj.started_at, pj.canceled_at, pj.completed_at, pj.error, pj.organization_id, pj.initiator_id, pj.provisioner, pj.storage_method, pj.type, pj.input, pj.worker_id, pj.file_id, pj.tags, pj.error_code, pj.trace_metadata, pj.job_status, pj.logs_length, pj.logs_overflowed,
+	pj.id, pj.created_at, pj.updated_at, pj.started_at, pj.canceled_at, pj.completed_at, pj.error, pj.organization_id, pj.initiator_id, pj.provisioner, pj.storage_method, pj.type, pj.input, pj.worker_id, pj.file_id, pj.tags, pj.error_code, pj.trace_metadata, pj.job_status, pj.logs_length, pj.logs_overflowed, pj.priority,
     COALESCE(qp.queue_position, 0) AS queue_position,
     COALESCE(qs.count, 0) AS queue_size,
 	-- Use subquery to utilize ORDER BY in array_agg since it cannot be
@@ -23561,6 +24216,7 @@ func (q *sqlQuerier) GetProvisionerJobsByOrganizationAndStatusWithQueuePositionA
 			&i.ProvisionerJob.JobStatus,
 			&i.ProvisionerJob.LogsLength,
 			&i.ProvisionerJob.LogsOverflowed,
+			&i.ProvisionerJob.Priority,
 			&i.QueuePosition,
 			&i.QueueSize,
 			pq.Array(&i.AvailableWorkers),
@@ -23588,7 +24244,7 @@ func (q *sqlQuerier) GetProvisionerJobsByOrganizationAndStatusWithQueuePositionA
 }
 
 const getProvisionerJobsCreatedAfter = `-- name: GetProvisionerJobsCreatedAfter :many
-SELECT id, created_at, updated_at, started_at, canceled_at, completed_at, error, organization_id, initiator_id, provisioner, storage_method, type, input, worker_id, file_id, tags, error_code, trace_metadata, job_status, logs_length, logs_overflowed FROM provisioner_jobs WHERE created_at > $1
+SELECT id, created_at, updated_at, started_at, canceled_at, completed_at, error, organization_id, initiator_id, provisioner, storage_method, type, input, worker_id, file_id, tags, error_code, trace_metadata, job_status, logs_length, logs_overflowed, priority FROM provisioner_jobs WHERE created_at > $1
 `
 
 func (q *sqlQuerier) GetProvisionerJobsCreatedAfter(ctx context.Context, createdAt time.Time) ([]ProvisionerJob, error) {
@@ -23622,6 +24278,7 @@ func (q *sqlQuerier) GetProvisionerJobsCreatedAfter(ctx context.Context, created
 			&i.JobStatus,
 			&i.LogsLength,
 			&i.LogsOverflowed,
+			&i.Priority,
 		); err != nil {
 			return nil, err
 		}
@@ -23638,7 +24295,7 @@ func (q *sqlQuerier) GetProvisionerJobsCreatedAfter(ctx context.Context, created
 
 const getProvisionerJobsToBeReaped = `-- name: GetProvisionerJobsToBeReaped :many
 SELECT
-	id, created_at, updated_at, started_at, canceled_at, completed_at, error, organization_id, initiator_id, provisioner, storage_method, type, input, worker_id, file_id, tags, error_code, trace_metadata, job_status, logs_length, logs_overflowed
+	id, created_at, updated_at, started_at, canceled_at, completed_at, error, organization_id, initiator_id, provisioner, storage_method, type, input, worker_id, file_id, tags, error_code, trace_metadata, job_status, logs_length, logs_overflowed, priority
 FROM
 	provisioner_jobs
 WHERE
@@ -23697,6 +24354,7 @@ func (q *sqlQuerier) GetProvisionerJobsToBeReaped(ctx context.Context, arg GetPr
 			&i.JobStatus,
 			&i.LogsLength,
 			&i.LogsOverflowed,
+			&i.Priority,
 		); err != nil {
 			return nil, err
 		}
@@ -23726,10 +24384,36 @@ INSERT INTO
 		"input",
 		tags,
 		trace_metadata,
-		logs_overflowed
+		logs_overflowed,
+		priority
 	)
 VALUES
-	($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12, $13) RETURNING id, created_at, updated_at, started_at, canceled_at, completed_at, error, organization_id, initiator_id, provisioner, storage_method, type, input, worker_id, file_id, tags, error_code, trace_metadata, job_status, logs_length, logs_overflowed
+	(
+		$1,
+		$2,
+		$3,
+		$4,
+		$5,
+		$6,
+		$7,
+		$8,
+		$9,
+		$10,
+		$11,
+		$12,
+		$13,
+		-- The priority class of the job plus the organization and template
+		-- boosts. See provisioner_job_priorities.
+		$14 :: integer + COALESCE((
+			SELECT
+				SUM(pjp.priority)
+			FROM
+				provisioner_job_priorities pjp
+			WHERE
+				pjp.organization_id = $4
+				AND pjp.template_id = ANY(ARRAY['00000000-0000-0000-0000-000000000000' :: uuid, $15 :: uuid])
+		), 0) :: integer
+	) RETURNING id, created_at, updated_at, started_at, canceled_at, completed_at, error, organization_id, initiator_id, provisioner, storage_method, type, input, worker_id, file_id, tags, error_code, trace_metadata, job_status, logs_length, logs_overflowed, priority
 `
 
 type InsertProvisionerJobParams struct {
@@ -23746,6 +24430,8 @@ type InsertProvisionerJobParams struct {
 	Tags           StringMap                `db:"tags" json:"tags"`
 	TraceMetadata  pqtype.NullRawMessage    `db:"trace_metadata" json:"trace_metadata"`
 	LogsOverflowed bool                     `db:"logs_overflowed" json:"logs_overflowed"`
+	Priority       int32                    `db:"priority" json:"priority"`
+	TemplateID     uuid.UUID                `db:"template_id" json:"template_id"`
 }
 
 func (q *sqlQuerier) InsertProvisionerJob(ctx context.Context, arg InsertProvisionerJobParams) (ProvisionerJob, error) {
@@ -23763,6 +24449,8 @@ func (q *sqlQuerier) InsertProvisionerJob(ctx context.Context, arg InsertProvisi
 		arg.Tags,
 		arg.TraceMetadata,
 		arg.LogsOverflowed,
+		arg.Priority,
+		arg.TemplateID,
 	)
 	var i ProvisionerJob
 	err := row.Scan(
@@ -23787,6 +24475,7 @@ func (q *sqlQuerier) InsertProvisionerJob(ctx context.Context, arg InsertProvisi
 		&i.JobStatus,
 		&i.LogsLength,
 		&i.LogsOverflowed,
+		&i.Priority,
 	)
 	return i, err
 }
@@ -24573,6 +25262,99 @@ func (q *sqlQuerier) UpdateWorkspaceProxyDeleted(ctx context.Context, arg Update
 	return err
 }
 
+const getPurgeArchiveImports = `-- name: GetPurgeArchiveImports :many
+SELECT id, name, record_type, row_count, imported_at FROM purge_archive_imports ORDER BY name
+`
+
+func (q *sqlQuerier) GetPurgeArchiveImports(ctx context.Context) ([]PurgeArchiveImport, error) {
+	rows, err := q.db.QueryContext(ctx, getPurgeArchiveImports)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []PurgeArchiveImport
+	for rows.Next() {
+		var i PurgeArchiveImport
+		if err := rows.Scan(
+			&i.ID,
+			&i.Name,
+			&i.RecordType,
+			&i.RowCount,
+			&i.ImportedAt,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const insertPurgeArchiveImport = `-- name: InsertPurgeArchiveImport :one
+INSERT INTO purge_archive_imports (
+	id,
+	name,
+	record_type,
+	row_count,
+	imported_at
+) VALUES (
+	$1,
+	$2,
+	$3,
+	$4,
+	$5
+) RETURNING id, name, record_type, row_count, imported_at
+`
+
+type InsertPurgeArchiveImportParams struct {
+	ID         uuid.UUID `db:"id" json:"id"`
+	Name       string    `db:"name" json:"name"`
+	RecordType string    `db:"record_type" json:"record_type"`
+	RowCount   int64     `db:"row_count" json:"row_count"`
+	ImportedAt time.Time `db:"imported_at" json:"imported_at"`
+}
+
+func (q *sqlQuerier) InsertPurgeArchiveImport(ctx context.Context, arg InsertPurgeArchiveImportParams) (PurgeArchiveImport, error) {
+	row := q.db.QueryRowContext(ctx, insertPurgeArchiveImport,
+		arg.ID,
+		arg.Name,
+		arg.RecordType,
+		arg.RowCount,
+		arg.ImportedAt,
+	)
+	var i PurgeArchiveImport
+	err := row.Scan(
+		&i.ID,
+		&i.Name,
+		&i.RecordType,
+		&i.RowCount,
+		&i.ImportedAt,
+	)
+	return i, err
+}
+
+const insertPurgeArchiveRecords = `-- name: InsertPurgeArchiveRecords :exec
+INSERT INTO purge_archive_records (import_id, record)
+SELECT
+	$1 :: uuid,
+	unnest($2 :: text[]) :: jsonb
+`
+
+type InsertPurgeArchiveRecordsParams struct {
+	ImportID uuid.UUID `db:"import_id" json:"import_id"`
+	Records  []string  `db:"records" json:"records"`
+}
+
+func (q *sqlQuerier) InsertPurgeArchiveRecords(ctx context.Context, arg InsertPurgeArchiveRecordsParams) error {
+	_, err := q.db.ExecContext(ctx, insertPurgeArchiveRecords, arg.ImportID, pq.Array(arg.Records))
+	return err
+}
+
 const getQuotaAllowanceForUser = `-- name: GetQuotaAllowanceForUser :one
 SELECT
 	coalesce(SUM(groups.quota_allowance), 0)::BIGINT
@@ -25080,239 +25862,781 @@ func (q *sqlQuerier) UpdateCustomRole(ctx context.Context, arg UpdateCustomRoleP
 	return i, err
 }
 
-const deleteRuntimeConfig = `-- name: DeleteRuntimeConfig :exec
-DELETE FROM site_configs
-WHERE site_configs.key = $1
+const deleteScheduleExceptionCalendarByID = `-- name: DeleteScheduleExceptionCalendarByID :exec
+DELETE FROM schedule_exception_calendars
+WHERE id = $1
 `
 
-func (q *sqlQuerier) DeleteRuntimeConfig(ctx context.Context, key string) error {
-	_, err := q.db.ExecContext(ctx, deleteRuntimeConfig, key)
+func (q *sqlQuerier) DeleteScheduleExceptionCalendarByID(ctx context.Context, id uuid.UUID) error {
+	_, err := q.db.ExecContext(ctx, deleteScheduleExceptionCalendarByID, id)
 	return err
 }
 
-const getAnnouncementBanners = `-- name: GetAnnouncementBanners :one
-SELECT value FROM site_configs WHERE key = 'announcement_banners'
+const deleteScheduleExceptionCalendarDates = `-- name: DeleteScheduleExceptionCalendarDates :exec
+DELETE FROM schedule_exception_calendar_dates
+WHERE calendar_id = $1
 `
 
-func (q *sqlQuerier) GetAnnouncementBanners(ctx context.Context) (string, error) {
-	row := q.db.QueryRowContext(ctx, getAnnouncementBanners)
-	var value string
-	err := row.Scan(&value)
-	return value, err
+func (q *sqlQuerier) DeleteScheduleExceptionCalendarDates(ctx context.Context, calendarID uuid.UUID) error {
+	_, err := q.db.ExecContext(ctx, deleteScheduleExceptionCalendarDates, calendarID)
+	return err
 }
 
-const getApplicationName = `-- name: GetApplicationName :one
-SELECT value FROM site_configs WHERE key = 'application_name'
+const deleteTemplateScheduleExceptionCalendars = `-- name: DeleteTemplateScheduleExceptionCalendars :exec
+DELETE FROM template_schedule_exception_calendars
+WHERE template_id = $1
 `
 
-func (q *sqlQuerier) GetApplicationName(ctx context.Context) (string, error) {
-	row := q.db.QueryRowContext(ctx, getApplicationName)
-	var value string
-	err := row.Scan(&value)
-	return value, err
+func (q *sqlQuerier) DeleteTemplateScheduleExceptionCalendars(ctx context.Context, templateID uuid.UUID) error {
+	_, err := q.db.ExecContext(ctx, deleteTemplateScheduleExceptionCalendars, templateID)
+	return err
 }
 
-const getChatAdvisorConfig = `-- name: GetChatAdvisorConfig :one
-SELECT
-    COALESCE((SELECT value FROM site_configs WHERE key = 'agents_advisor_config'), '{}') :: text AS advisor_config
+const deleteUserOutOfOfficeRange = `-- name: DeleteUserOutOfOfficeRange :exec
+DELETE FROM user_out_of_office_ranges
+WHERE id = $1 AND user_id = $2
 `
 
-// GetChatAdvisorConfig returns the deployment-wide runtime configuration
-// for the experimental chat advisor as a JSON blob. Callers unmarshal the
-// result into codersdk.AdvisorConfig. Returns '{}' when unset so zero
-// values apply by default.
-func (q *sqlQuerier) GetChatAdvisorConfig(ctx context.Context) (string, error) {
-	row := q.db.QueryRowContext(ctx, getChatAdvisorConfig)
-	var advisor_config string
-	err := row.Scan(&advisor_config)
-	return advisor_config, err
+type DeleteUserOutOfOfficeRangeParams struct {
+	ID     uuid.UUID `db:"id" json:"id"`
+	UserID uuid.UUID `db:"user_id" json:"user_id"`
 }
 
-const getChatAutoArchiveDays = `-- name: GetChatAutoArchiveDays :one
-SELECT COALESCE(
-    (SELECT value::integer FROM site_configs
-     WHERE key = 'agents_chat_auto_archive_days'),
-    $1::integer
-) :: integer AS auto_archive_days
-`
-
-// Auto-archive window in days. 0 disables.
-func (q *sqlQuerier) GetChatAutoArchiveDays(ctx context.Context, defaultAutoArchiveDays int32) (int32, error) {
-	row := q.db.QueryRowContext(ctx, getChatAutoArchiveDays, defaultAutoArchiveDays)
-	var auto_archive_days int32
-	err := row.Scan(&auto_archive_days)
-	return auto_archive_days, err
+func (q *sqlQuerier) DeleteUserOutOfOfficeRange(ctx context.Context, arg DeleteUserOutOfOfficeRangeParams) error {
+	_, err := q.db.ExecContext(ctx, deleteUserOutOfOfficeRange, arg.ID, arg.UserID)
+	return err
 }
 
-const getChatCompactionModelOverride = `-- name: GetChatCompactionModelOverride :one
-SELECT
-	COALESCE((SELECT value FROM site_configs WHERE key = 'agents_chat_compaction_model_override'), '') :: text AS model_config_id
+const getScheduleExceptionCalendarByID = `-- name: GetScheduleExceptionCalendarByID :one
+SELECT id, organization_id, name, description, created_at, updated_at
+FROM schedule_exception_calendars
+WHERE id = $1
 `
 
-func (q *sqlQuerier) GetChatCompactionModelOverride(ctx context.Context) (string, error) {
-	row := q.db.QueryRowContext(ctx, getChatCompactionModelOverride)
-	var model_config_id string
-	err := row.Scan(&model_config_id)
-	return model_config_id, err
+func (q *sqlQuerier) GetScheduleExceptionCalendarByID(ctx context.Context, id uuid.UUID) (ScheduleExceptionCalendar, error) {
+	row := q.db.QueryRowContext(ctx, getScheduleExceptionCalendarByID, id)
+	var i ScheduleExceptionCalendar
+	err := row.Scan(
+		&i.ID,
+		&i.OrganizationID,
+		&i.Name,
+		&i.Description,
+		&i.CreatedAt,
+		&i.UpdatedAt,
+	)
+	return i, err
 }
 
-const getChatComputerUseProvider = `-- name: GetChatComputerUseProvider :one
-SELECT
-	COALESCE((SELECT value FROM site_configs WHERE key = 'agents_computer_use_provider'), '') :: text AS provider
+const getScheduleExceptionCalendarDates = `-- name: GetScheduleExceptionCalendarDates :many
+SELECT calendar_id, start_date, end_date, summary
+FROM schedule_exception_calendar_dates
+WHERE calendar_id = $1
+ORDER BY start_date ASC, end_date ASC
 `
 
-func (q *sqlQuerier) GetChatComputerUseProvider(ctx context.Context) (string, error) {
-	row := q.db.QueryRowContext(ctx, getChatComputerUseProvider)
-	var provider string
-	err := row.Scan(&provider)
-	return provider, err
+func (q *sqlQuerier) GetScheduleExceptionCalendarDates(ctx context.Context, calendarID uuid.UUID) ([]ScheduleExceptionCalendarDate, error) {
+	rows, err := q.db.QueryContext(ctx, getScheduleExceptionCalendarDates, calendarID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []ScheduleExceptionCalendarDate
+	for rows.Next() {
+		var i ScheduleExceptionCalendarDate
+		if err := rows.Scan(
+			&i.CalendarID,
+			&i.StartDate,
+			&i.EndDate,
+			&i.Summary,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
 }
 
-const getChatDebugLoggingAllowUsers = `-- name: GetChatDebugLoggingAllowUsers :one
-SELECT
-	COALESCE((SELECT value = 'true' FROM site_configs WHERE key = 'agents_chat_debug_logging_allow_users'), false) :: boolean AS allow_users
+const getScheduleExceptionCalendarsByOrganizationID = `-- name: GetScheduleExceptionCalendarsByOrganizationID :many
+SELECT id, organization_id, name, description, created_at, updated_at
+FROM schedule_exception_calendars
+WHERE organization_id = $1
+ORDER BY name ASC
 `
 
-// GetChatDebugLoggingAllowUsers returns the runtime admin setting that
-// allows users to opt into chat debug logging when the deployment does
-// not already force debug logging on globally.
-func (q *sqlQuerier) GetChatDebugLoggingAllowUsers(ctx context.Context) (bool, error) {
-	row := q.db.QueryRowContext(ctx, getChatDebugLoggingAllowUsers)
-	var allow_users bool
-	err := row.Scan(&allow_users)
-	return allow_users, err
+func (q *sqlQuerier) GetScheduleExceptionCalendarsByOrganizationID(ctx context.Context, organizationID uuid.UUID) ([]ScheduleExceptionCalendar, error) {
+	rows, err := q.db.QueryContext(ctx, getScheduleExceptionCalendarsByOrganizationID, organizationID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []ScheduleExceptionCalendar
+	for rows.Next() {
+		var i ScheduleExceptionCalendar
+		if err := rows.Scan(
+			&i.ID,
+			&i.OrganizationID,
+			&i.Name,
+			&i.Description,
+			&i.CreatedAt,
+			&i.UpdatedAt,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
 }
 
-const getChatDebugRetentionDays = `-- name: GetChatDebugRetentionDays :one
-SELECT COALESCE(
-    (SELECT value::integer FROM site_configs
-     WHERE key = 'agents_chat_debug_retention_days'),
-    $1::integer
-) :: integer AS debug_retention_days
+const getScheduleExceptionCalendarsByTemplateID = `-- name: GetScheduleExceptionCalendarsByTemplateID :many
+SELECT schedule_exception_calendars.id, schedule_exception_calendars.organization_id, schedule_exception_calendars.name, schedule_exception_calendars.description, schedule_exception_calendars.created_at, schedule_exception_calendars.updated_at
+FROM schedule_exception_calendars
+JOIN template_schedule_exception_calendars
+    ON template_schedule_exception_calendars.calendar_id = schedule_exception_calendars.id
+WHERE template_schedule_exception_calendars.template_id = $1
+ORDER BY schedule_exception_calendars.name ASC
 `
 
-// Chat debug run retention window in days. 0 disables.
-func (q *sqlQuerier) GetChatDebugRetentionDays(ctx context.Context, defaultDebugRetentionDays int32) (int32, error) {
-	row := q.db.QueryRowContext(ctx, getChatDebugRetentionDays, defaultDebugRetentionDays)
-	var debug_retention_days int32
-	err := row.Scan(&debug_retention_days)
-	return debug_retention_days, err
+func (q *sqlQuerier) GetScheduleExceptionCalendarsByTemplateID(ctx context.Context, templateID uuid.UUID) ([]ScheduleExceptionCalendar, error) {
+	rows, err := q.db.QueryContext(ctx, getScheduleExceptionCalendarsByTemplateID, templateID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []ScheduleExceptionCalendar
+	for rows.Next() {
+		var i ScheduleExceptionCalendar
+		if err := rows.Scan(
+			&i.ID,
+			&i.OrganizationID,
+			&i.Name,
+			&i.Description,
+			&i.CreatedAt,
+			&i.UpdatedAt,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
 }
 
-const getChatDesktopEnabled = `-- name: GetChatDesktopEnabled :one
-SELECT
-	COALESCE((SELECT value = 'true' FROM site_configs WHERE key = 'agents_desktop_enabled'), false) :: boolean AS enable_desktop
+const getUserOutOfOfficeRangesByUserID = `-- name: GetUserOutOfOfficeRangesByUserID :many
+SELECT id, user_id, start_date, end_date, reason, created_at
+FROM user_out_of_office_ranges
+WHERE user_id = $1
+ORDER BY start_date ASC, end_date ASC
 `
 
-func (q *sqlQuerier) GetChatDesktopEnabled(ctx context.Context) (bool, error) {
-	row := q.db.QueryRowContext(ctx, getChatDesktopEnabled)
-	var enable_desktop bool
-	err := row.Scan(&enable_desktop)
-	return enable_desktop, err
+func (q *sqlQuerier) GetUserOutOfOfficeRangesByUserID(ctx context.Context, userID uuid.UUID) ([]UserOutOfOfficeRange, error) {
+	rows, err := q.db.QueryContext(ctx, getUserOutOfOfficeRangesByUserID, userID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []UserOutOfOfficeRange
+	for rows.Next() {
+		var i UserOutOfOfficeRange
+		if err := rows.Scan(
+			&i.ID,
+			&i.UserID,
+			&i.StartDate,
+			&i.EndDate,
+			&i.Reason,
+			&i.CreatedAt,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
 }
 
-const getChatExploreModelOverride = `-- name: GetChatExploreModelOverride :one
+const getWorkspaceAutostartExceptions = `-- name: GetWorkspaceAutostartExceptions :many
 SELECT
-	COALESCE((SELECT value FROM site_configs WHERE key = 'agents_chat_explore_model_override'), '') :: text AS model_config_id
-`
-
-func (q *sqlQuerier) GetChatExploreModelOverride(ctx context.Context) (string, error) {
-	row := q.db.QueryRowContext(ctx, getChatExploreModelOverride)
-	var model_config_id string
-	err := row.Scan(&model_config_id)
-	return model_config_id, err
-}
-
-const getChatGeneralModelOverride = `-- name: GetChatGeneralModelOverride :one
+    schedule_exception_calendar_dates.start_date,
+    schedule_exception_calendar_dates.end_date,
+    schedule_exception_calendars.name AS source,
+    schedule_exception_calendar_dates.summary
+FROM workspaces
+JOIN template_schedule_exception_calendars
+    ON template_schedule_exception_calendars.template_id = workspaces.template_id
+JOIN schedule_exception_calendars
+    ON schedule_exception_calendars.id = template_schedule_exception_calendars.calendar_id
+JOIN schedule_exception_calendar_dates
+    ON schedule_exception_calendar_dates.calendar_id = schedule_exception_calendars.id
+WHERE
+    workspaces.id = $1
+    AND schedule_exception_calendar_dates.end_date >= $2 :: date
+UNION ALL
 SELECT
-	COALESCE((SELECT value FROM site_configs WHERE key = 'agents_chat_general_model_override'), '') :: text AS model_config_id
+    user_out_of_office_ranges.start_date,
+    user_out_of_office_ranges.end_date,
+    'out-of-office' :: text AS source,
+    user_out_of_office_ranges.reason AS summary
+FROM workspaces
+JOIN user_out_of_office_ranges
+    ON user_out_of_office_ranges.user_id = workspaces.owner_id
+WHERE
+    workspaces.id = $1
+    AND user_out_of_office_ranges.end_date >= $2 :: date
+ORDER BY start_date ASC, end_date ASC
 `
 
-func (q *sqlQuerier) GetChatGeneralModelOverride(ctx context.Context) (string, error) {
-	row := q.db.QueryRowContext(ctx, getChatGeneralModelOverride)
-	var model_config_id string
-	err := row.Scan(&model_config_id)
-	return model_config_id, err
+type GetWorkspaceAutostartExceptionsParams struct {
+	WorkspaceID uuid.UUID `db:"workspace_id" json:"workspace_id"`
+	After       time.Time `db:"after" json:"after"`
 }
 
-const getChatIncludeDefaultSystemPrompt = `-- name: GetChatIncludeDefaultSystemPrompt :one
-SELECT
-    COALESCE(
-        (SELECT value = 'true' FROM site_configs WHERE key = 'agents_chat_include_default_system_prompt'),
-        NOT EXISTS (
-            SELECT 1
-            FROM site_configs
-            WHERE key = 'agents_chat_system_prompt'
-                AND value != ''
-        )
-    ) :: boolean AS include_default_system_prompt
-`
-
-// GetChatIncludeDefaultSystemPrompt preserves the legacy default
-// for deployments created before the explicit include-default toggle.
-// When the toggle is unset, a non-empty custom prompt implies false;
-// otherwise the setting defaults to true.
-func (q *sqlQuerier) GetChatIncludeDefaultSystemPrompt(ctx context.Context) (bool, error) {
-	row := q.db.QueryRowContext(ctx, getChatIncludeDefaultSystemPrompt)
-	var include_default_system_prompt bool
-	err := row.Scan(&include_default_system_prompt)
-	return include_default_system_prompt, err
+type GetWorkspaceAutostartExceptionsRow struct {
+	StartDate time.Time `db:"start_date" json:"start_date"`
+	EndDate   time.Time `db:"end_date" json:"end_date"`
+	Source    string    `db:"source" json:"source"`
+	Summary   string    `db:"summary" json:"summary"`
 }
 
-const getChatPersonalModelOverridesEnabled = `-- name: GetChatPersonalModelOverridesEnabled :one
-SELECT
-	COALESCE((SELECT value = 'true' FROM site_configs WHERE key = 'agents_chat_personal_model_overrides_enabled'), false) :: boolean AS enabled
-`
-
-// GetChatPersonalModelOverridesEnabled returns whether users may configure
-// personal chat model overrides. It defaults to false when unset.
-func (q *sqlQuerier) GetChatPersonalModelOverridesEnabled(ctx context.Context) (bool, error) {
-	row := q.db.QueryRowContext(ctx, getChatPersonalModelOverridesEnabled)
-	var enabled bool
-	err := row.Scan(&enabled)
-	return enabled, err
+// Returns the dates on which a workspace must not be started automatically:
+// those in the exception calendars attached to its template, and the
+// workspace owner's out of office ranges. Ranges that ended before @after are
+// omitted.
+func (q *sqlQuerier) GetWorkspaceAutostartExceptions(ctx context.Context, arg GetWorkspaceAutostartExceptionsParams) ([]GetWorkspaceAutostartExceptionsRow, error) {
+	rows, err := q.db.QueryContext(ctx, getWorkspaceAutostartExceptions, arg.WorkspaceID, arg.After)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []GetWorkspaceAutostartExceptionsRow
+	for rows.Next() {
+		var i GetWorkspaceAutostartExceptionsRow
+		if err := rows.Scan(
+			&i.StartDate,
+			&i.EndDate,
+			&i.Source,
+			&i.Summary,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
 }
 
-const getChatPlanModeInstructions = `-- name: GetChatPlanModeInstructions :one
+const getWorkspaceAutostartExceptionsByWorkspaceIDs = `-- name: GetWorkspaceAutostartExceptionsByWorkspaceIDs :many
 SELECT
-	COALESCE((SELECT value FROM site_configs WHERE key = 'agents_chat_plan_mode_instructions'), '') :: text AS plan_mode_instructions
+    workspaces.id AS workspace_id,
+    schedule_exception_calendar_dates.start_date,
+    schedule_exception_calendar_dates.end_date,
+    schedule_exception_calendars.name AS source,
+    schedule_exception_calendar_dates.summary
+FROM workspaces
+JOIN template_schedule_exception_calendars
+    ON template_schedule_exception_calendars.template_id = workspaces.template_id
+JOIN schedule_exception_calendars
+    ON schedule_exception_calendars.id = template_schedule_exception_calendars.calendar_id
+JOIN schedule_exception_calendar_dates
+    ON schedule_exception_calendar_dates.calendar_id = schedule_exception_calendars.id
+WHERE
+    workspaces.id = ANY($1 :: uuid[])
+    AND schedule_exception_calendar_dates.end_date >= $2 :: date
+UNION ALL
+SELECT
+    workspaces.id AS workspace_id,
+    user_out_of_office_ranges.start_date,
+    user_out_of_office_ranges.end_date,
+    'out-of-office' :: text AS source,
+    user_out_of_office_ranges.reason AS summary
+FROM workspaces
+JOIN user_out_of_office_ranges
+    ON user_out_of_office_ranges.user_id = workspaces.owner_id
+WHERE
+    workspaces.id = ANY($1 :: uuid[])
+    AND user_out_of_office_ranges.end_date >= $2 :: date
+ORDER BY workspace_id ASC, start_date ASC, end_date ASC
 `
 
-func (q *sqlQuerier) GetChatPlanModeInstructions(ctx context.Context) (string, error) {
-	row := q.db.QueryRowContext(ctx, getChatPlanModeInstructions)
-	var plan_mode_instructions string
-	err := row.Scan(&plan_mode_instructions)
-	return plan_mode_instructions, err
+type GetWorkspaceAutostartExceptionsByWorkspaceIDsParams struct {
+	WorkspaceIDs []uuid.UUID `db:"workspace_ids" json:"workspace_ids"`
+	After        time.Time   `db:"after" json:"after"`
 }
 
-const getChatRetentionDays = `-- name: GetChatRetentionDays :one
-SELECT COALESCE(
-    (SELECT value::integer FROM site_configs
-     WHERE key = 'agents_chat_retention_days'),
-    30
-) :: integer AS retention_days
-`
-
-// Returns the chat retention period in days. Chats archived longer
-// than this and orphaned chat files older than this are purged by
-// dbpurge. Returns 30 (days) when no value has been configured.
-// A value of 0 disables chat purging entirely.
-func (q *sqlQuerier) GetChatRetentionDays(ctx context.Context) (int32, error) {
-	row := q.db.QueryRowContext(ctx, getChatRetentionDays)
-	var retention_days int32
-	err := row.Scan(&retention_days)
-	return retention_days, err
+type GetWorkspaceAutostartExceptionsByWorkspaceIDsRow struct {
+	WorkspaceID uuid.UUID `db:"workspace_id" json:"workspace_id"`
+	StartDate   time.Time `db:"start_date" json:"start_date"`
+	EndDate     time.Time `db:"end_date" json:"end_date"`
+	Source      string    `db:"source" json:"source"`
+	Summary     string    `db:"summary" json:"summary"`
 }
 
-const getChatSystemPrompt = `-- name: GetChatSystemPrompt :one
-SELECT
-	COALESCE((SELECT value FROM site_configs WHERE key = 'agents_chat_system_prompt'), '') :: text AS chat_system_prompt
-`
-
-func (q *sqlQuerier) GetChatSystemPrompt(ctx context.Context) (string, error) {
-	row := q.db.QueryRowContext(ctx, getChatSystemPrompt)
-	var chat_system_prompt string
-	err := row.Scan(&chat_system_prompt)
+// Like GetWorkspaceAutostartExceptions, but for several workspaces at once.
+func (q *sqlQuerier) GetWorkspaceAutostartExceptionsByWorkspaceIDs(ctx context.Context, arg GetWorkspaceAutostartExceptionsByWorkspaceIDsParams) ([]GetWorkspaceAutostartExceptionsByWorkspaceIDsRow, error) {
+	rows, err := q.db.QueryContext(ctx, getWorkspaceAutostartExceptionsByWorkspaceIDs, pq.Array(arg.WorkspaceIDs), arg.After)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []GetWorkspaceAutostartExceptionsByWorkspaceIDsRow
+	for rows.Next() {
+		var i GetWorkspaceAutostartExceptionsByWorkspaceIDsRow
+		if err := rows.Scan(
+			&i.WorkspaceID,
+			&i.StartDate,
+			&i.EndDate,
+			&i.Source,
+			&i.Summary,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const insertScheduleExceptionCalendar = `-- name: InsertScheduleExceptionCalendar :one
+INSERT INTO schedule_exception_calendars (
+    id,
+    organization_id,
+    name,
+    description,
+    created_at,
+    updated_at
+) VALUES (
+    $1,
+    $2,
+    $3,
+    $4,
+    $5,
+    $6
+) RETURNING id, organization_id, name, description, created_at, updated_at
+`
+
+type InsertScheduleExceptionCalendarParams struct {
+	ID             uuid.UUID `db:"id" json:"id"`
+	OrganizationID uuid.UUID `db:"organization_id" json:"organization_id"`
+	Name           string    `db:"name" json:"name"`
+	Description    string    `db:"description" json:"description"`
+	CreatedAt      time.Time `db:"created_at" json:"created_at"`
+	UpdatedAt      time.Time `db:"updated_at" json:"updated_at"`
+}
+
+func (q *sqlQuerier) InsertScheduleExceptionCalendar(ctx context.Context, arg InsertScheduleExceptionCalendarParams) (ScheduleExceptionCalendar, error) {
+	row := q.db.QueryRowContext(ctx, insertScheduleExceptionCalendar,
+		arg.ID,
+		arg.OrganizationID,
+		arg.Name,
+		arg.Description,
+		arg.CreatedAt,
+		arg.UpdatedAt,
+	)
+	var i ScheduleExceptionCalendar
+	err := row.Scan(
+		&i.ID,
+		&i.OrganizationID,
+		&i.Name,
+		&i.Description,
+		&i.CreatedAt,
+		&i.UpdatedAt,
+	)
+	return i, err
+}
+
+const insertScheduleExceptionCalendarDates = `-- name: InsertScheduleExceptionCalendarDates :exec
+INSERT INTO schedule_exception_calendar_dates (
+    calendar_id,
+    start_date,
+    end_date,
+    summary
+)
+SELECT
+    $1 :: uuid AS calendar_id,
+    unnest($2 :: date[]) AS start_date,
+    unnest($3 :: date[]) AS end_date,
+    unnest($4 :: text[]) AS summary
+`
+
+type InsertScheduleExceptionCalendarDatesParams struct {
+	CalendarID uuid.UUID   `db:"calendar_id" json:"calendar_id"`
+	StartDates []time.Time `db:"start_dates" json:"start_dates"`
+	EndDates   []time.Time `db:"end_dates" json:"end_dates"`
+	Summaries  []string    `db:"summaries" json:"summaries"`
+}
+
+func (q *sqlQuerier) InsertScheduleExceptionCalendarDates(ctx context.Context, arg InsertScheduleExceptionCalendarDatesParams) error {
+	_, err := q.db.ExecContext(ctx, insertScheduleExceptionCalendarDates,
+		arg.CalendarID,
+		pq.Array(arg.StartDates),
+		pq.Array(arg.EndDates),
+		pq.Array(arg.Summaries),
+	)
+	return err
+}
+
+const insertTemplateScheduleExceptionCalendars = `-- name: InsertTemplateScheduleExceptionCalendars :exec
+INSERT INTO template_schedule_exception_calendars (template_id, calendar_id)
+SELECT $1 :: uuid, unnest($2 :: uuid[])
+`
+
+type InsertTemplateScheduleExceptionCalendarsParams struct {
+	TemplateID  uuid.UUID   `db:"template_id" json:"template_id"`
+	CalendarIDs []uuid.UUID `db:"calendar_ids" json:"calendar_ids"`
+}
+
+func (q *sqlQuerier) InsertTemplateScheduleExceptionCalendars(ctx context.Context, arg InsertTemplateScheduleExceptionCalendarsParams) error {
+	_, err := q.db.ExecContext(ctx, insertTemplateScheduleExceptionCalendars, arg.TemplateID, pq.Array(arg.CalendarIDs))
+	return err
+}
+
+const insertUserOutOfOfficeRange = `-- name: InsertUserOutOfOfficeRange :one
+INSERT INTO user_out_of_office_ranges (
+    id,
+    user_id,
+    start_date,
+    end_date,
+    reason,
+    created_at
+) VALUES (
+    $1,
+    $2,
+    $3,
+    $4,
+    $5,
+    $6
+) RETURNING id, user_id, start_date, end_date, reason, created_at
+`
+
+type InsertUserOutOfOfficeRangeParams struct {
+	ID        uuid.UUID `db:"id" json:"id"`
+	UserID    uuid.UUID `db:"user_id" json:"user_id"`
+	StartDate time.Time `db:"start_date" json:"start_date"`
+	EndDate   time.Time `db:"end_date" json:"end_date"`
+	Reason    string    `db:"reason" json:"reason"`
+	CreatedAt time.Time `db:"created_at" json:"created_at"`
+}
+
+func (q *sqlQuerier) InsertUserOutOfOfficeRange(ctx context.Context, arg InsertUserOutOfOfficeRangeParams) (UserOutOfOfficeRange, error) {
+	row := q.db.QueryRowContext(ctx, insertUserOutOfOfficeRange,
+		arg.ID,
+		arg.UserID,
+		arg.StartDate,
+		arg.EndDate,
+		arg.Reason,
+		arg.CreatedAt,
+	)
+	var i UserOutOfOfficeRange
+	err := row.Scan(
+		&i.ID,
+		&i.UserID,
+		&i.StartDate,
+		&i.EndDate,
+		&i.Reason,
+		&i.CreatedAt,
+	)
+	return i, err
+}
+
+const updateScheduleExceptionCalendarByID = `-- name: UpdateScheduleExceptionCalendarByID :one
+UPDATE schedule_exception_calendars
+SET
+    name = $1,
+    description = $2,
+    updated_at = $3
+WHERE id = $4
+RETURNING id, organization_id, name, description, created_at, updated_at
+`
+
+type UpdateScheduleExceptionCalendarByIDParams struct {
+	Name        string    `db:"name" json:"name"`
+	Description string    `db:"description" json:"description"`
+	UpdatedAt   time.Time `db:"updated_at" json:"updated_at"`
+	ID          uuid.UUID `db:"id" json:"id"`
+}
+
+func (q *sqlQuerier) UpdateScheduleExceptionCalendarByID(ctx context.Context, arg UpdateScheduleExceptionCalendarByIDParams) (ScheduleExceptionCalendar, error) {
+	row := q.db.QueryRowContext(ctx, updateScheduleExceptionCalendarByID,
+		arg.Name,
+		arg.Description,
+		arg.UpdatedAt,
+		arg.ID,
+	)
+	var i ScheduleExceptionCalendar
+	err := row.Scan(
+		&i.ID,
+		&i.OrganizationID,
+		&i.Name,
+		&i.Description,
+		&i.CreatedAt,
+		&i.UpdatedAt,
+	)
+	return i, err
+}
+
+const deleteRuntimeConfig = `-- name: DeleteRuntimeConfig :exec
+DELETE FROM site_configs
+WHERE site_configs.key = $1
+`
+
+func (q *sqlQuerier) DeleteRuntimeConfig(ctx context.Context, key string) error {
+	_, err := q.db.ExecContext(ctx, deleteRuntimeConfig, key)
+	return err
+}
+
+const getAnnouncementBanners = `-- name: GetAnnouncementBanners :one
+SELECT value FROM site_configs WHERE key = 'announcement_banners'
+`
+
+func (q *sqlQuerier) GetAnnouncementBanners(ctx context.Context) (string, error) {
+	row := q.db.QueryRowContext(ctx, getAnnouncementBanners)
+	var value string
+	err := row.Scan(&value)
+	return value, err
+}
+
+const getApplicationName = `-- name: GetApplicationName :one
+SELECT value FROM site_configs WHERE key = 'application_name'
+`
+
+func (q *sqlQuerier) GetApplicationName(ctx context.Context) (string, error) {
+	row := q.db.QueryRowContext(ctx, getApplicationName)
+	var value string
+	err := row.Scan(&value)
+	return value, err
+}
+
+const getChatAdvisorConfig = `-- name: GetChatAdvisorConfig :one
+SELECT
+    COALESCE((SELECT value FROM site_configs WHERE key = 'agents_advisor_config'), '{}') :: text AS advisor_config
+`
+
+// GetChatAdvisorConfig returns the deployment-wide runtime configuration
+// for the experimental chat advisor as a JSON blob. Callers unmarshal the
+// result into codersdk.AdvisorConfig. Returns '{}' when unset so zero
+// values apply by default.
+func (q *sqlQuerier) GetChatAdvisorConfig(ctx context.Context) (string, error) {
+	row := q.db.QueryRowContext(ctx, getChatAdvisorConfig)
+	var advisor_config string
+	err := row.Scan(&advisor_config)
+	return advisor_config, err
+}
+
+const getChatAutoArchiveDays = `-- name: GetChatAutoArchiveDays :one
+SELECT COALESCE(
+    (SELECT value::integer FROM site_configs
+     WHERE key = 'agents_chat_auto_archive_days'),
+    $1::integer
+) :: integer AS auto_archive_days
+`
+
+// Auto-archive window in days. 0 disables.
+func (q *sqlQuerier) GetChatAutoArchiveDays(ctx context.Context, defaultAutoArchiveDays int32) (int32, error) {
+	row := q.db.QueryRowContext(ctx, getChatAutoArchiveDays, defaultAutoArchiveDays)
+	var auto_archive_days int32
+	err := row.Scan(&auto_archive_days)
+	return auto_archive_days, err
+}
+
+const getChatCompactionModelOverride = `-- name: GetChatCompactionModelOverride :one
+SELECT
+	COALESCE((SELECT value FROM site_configs WHERE key = 'agents_chat_compaction_model_override'), '') :: text AS model_config_id
+`
+
+func (q *sqlQuerier) GetChatCompactionModelOverride(ctx context.Context) (string, error) {
+	row := q.db.QueryRowContext(ctx, getChatCompactionModelOverride)
+	var model_config_id string
+	err := row.Scan(&model_config_id)
+	return model_config_id, err
+}
+
+const getChatComputerUseProvider = `-- name: GetChatComputerUseProvider :one
+SELECT
+	COALESCE((SELECT value FROM site_configs WHERE key = 'agents_computer_use_provider'), '') :: text AS provider
+`
+
+func (q *sqlQuerier) GetChatComputerUseProvider(ctx context.Context) (string, error) {
+	row := q.db.QueryRowContext(ctx, getChatComputerUseProvider)
+	var provider string
+	err := row.Scan(&provider)
+	return provider, err
+}
+
+const getChatDebugLoggingAllowUsers = `-- name: GetChatDebugLoggingAllowUsers :one
+SELECT
+	COALESCE((SELECT value = 'true' FROM site_configs WHERE key = 'agents_chat_debug_logging_allow_users'), false) :: boolean AS allow_users
+`
+
+// GetChatDebugLoggingAllowUsers returns the runtime admin setting that
+// allows users to opt into chat debug logging when the deployment does
+// not already force debug logging on globally.
+func (q *sqlQuerier) GetChatDebugLoggingAllowUsers(ctx context.Context) (bool, error) {
+	row := q.db.QueryRowContext(ctx, getChatDebugLoggingAllowUsers)
+	var allow_users bool
+	err := row.Scan(&allow_users)
+	return allow_users, err
+}
+
+const getChatDebugRetentionDays = `-- name: GetChatDebugRetentionDays :one
+SELECT COALESCE(
+    (SELECT value::integer FROM site_configs
+     WHERE key = 'agents_chat_debug_retention_days'),
+    $1::integer
+) :: integer AS debug_retention_days
+`
+
+// Chat debug run retention window in days. 0 disables.
+func (q *sqlQuerier) GetChatDebugRetentionDays(ctx context.Context, defaultDebugRetentionDays int32) (int32, error) {
+	row := q.db.QueryRowContext(ctx, getChatDebugRetentionDays, defaultDebugRetentionDays)
+	var debug_retention_days int32
+	err := row.Scan(&debug_retention_days)
+	return debug_retention_days, err
+}
+
+const getChatDesktopEnabled = `-- name: GetChatDesktopEnabled :one
+SELECT
+	COALESCE((SELECT value = 'true' FROM site_configs WHERE key = 'agents_desktop_enabled'), false) :: boolean AS enable_desktop
+`
+
+func (q *sqlQuerier) GetChatDesktopEnabled(ctx context.Context) (bool, error) {
+	row := q.db.QueryRowContext(ctx, getChatDesktopEnabled)
+	var enable_desktop bool
+	err := row.Scan(&enable_desktop)
+	return enable_desktop, err
+}
+
+const getChatExploreModelOverride = `-- name: GetChatExploreModelOverride :one
+SELECT
+	COALESCE((SELECT value FROM site_configs WHERE key = 'agents_chat_explore_model_override'), '') :: text AS model_config_id
+`
+
+func (q *sqlQuerier) GetChatExploreModelOverride(ctx context.Context) (string, error) {
+	row := q.db.QueryRowContext(ctx, getChatExploreModelOverride)
+	var model_config_id string
+	err := row.Scan(&model_config_id)
+	return model_config_id, err
+}
+
+const getChatGeneralModelOverride = `-- name: GetChatGeneralModelOverride :one
+SELECT
+	COALESCE((SELECT value FROM site_configs WHERE key = 'agents_chat_general_model_override'), '') :: text AS model_config_id
+`
+
+func (q *sqlQuerier) GetChatGeneralModelOverride(ctx context.Context) (string, error) {
+	row := q.db.QueryRowContext(ctx, getChatGeneralModelOverride)
+	var model_config_id string
+	err := row.Scan(&model_config_id)
+	return model_config_id, err
+}
+
+const getChatIncludeDefaultSystemPrompt = `-- name: GetChatIncludeDefaultSystemPrompt :one
+SELECT
+    COALESCE(
+        (SELECT value = 'true' FROM site_configs WHERE key = 'agents_chat_include_default_system_prompt'),
+        NOT EXISTS (
+            SELECT 1
+            FROM site_configs
+            WHERE key = 'agents_chat_system_prompt'
+                AND value != ''
+        )
+    ) :: boolean AS include_default_system_prompt
+`
+
+// GetChatIncludeDefaultSystemPrompt preserves the legacy default
+// for deployments created before the explicit include-default toggle.
+// When the toggle is unset, a non-empty custom prompt implies false;
+// otherwise the setting defaults to true.
+func (q *sqlQuerier) GetChatIncludeDefaultSystemPrompt(ctx context.Context) (bool, error) {
+	row := q.db.QueryRowContext(ctx, getChatIncludeDefaultSystemPrompt)
+	var include_default_system_prompt bool
+	err := row.Scan(&include_default_system_prompt)
+	return include_default_system_prompt, err
+}
+
+const getChatPersonalModelOverridesEnabled = `-- name: GetChatPersonalModelOverridesEnabled :one
+SELECT
+	COALESCE((SELECT value = 'true' FROM site_configs WHERE key = 'agents_chat_personal_model_overrides_enabled'), false) :: boolean AS enabled
+`
+
+// GetChatPersonalModelOverridesEnabled returns whether users may configure
+// personal chat model overrides. It defaults to false when unset.
+func (q *sqlQuerier) GetChatPersonalModelOverridesEnabled(ctx context.Context) (bool, error) {
+	row := q.db.QueryRowContext(ctx, getChatPersonalModelOverridesEnabled)
+	var enabled bool
+	err := row.Scan(&enabled)
+	return enabled, err
+}
+
+const getChatPlanModeInstructions = `-- name: GetChatPlanModeInstructions :one
+SELECT
+	COALESCE((SELECT value FROM site_configs WHERE key = 'agents_chat_plan_mode_instructions'), '') :: text AS plan_mode_instructions
+`
+
+func (q *sqlQuerier) GetChatPlanModeInstructions(ctx context.Context) (string, error) {
+	row := q.db.QueryRowContext(ctx, getChatPlanModeInstructions)
+	var plan_mode_instructions string
+	err := row.Scan(&plan_mode_instructions)
+	return plan_mode_instructions, err
+}
+
+const getChatRetentionDays = `-- name: GetChatRetentionDays :one
+SELECT COALESCE(
+    (SELECT value::integer FROM site_configs
+     WHERE key = 'agents_chat_retention_days'),
+    30
+) :: integer AS retention_days
+`
+
+// Returns the chat retention period in days. Chats archived longer
+// than this and orphaned chat files older than this are purged by
+// dbpurge. Returns 30 (days) when no value has been configured.
+// A value of 0 disables chat purging entirely.
+func (q *sqlQuerier) GetChatRetentionDays(ctx context.Context) (int32, error) {
+	row := q.db.QueryRowContext(ctx, getChatRetentionDays)
+	var retention_days int32
+	err := row.Scan(&retention_days)
+	return retention_days, err
+}
+
+const getChatSystemPrompt = `-- name: GetChatSystemPrompt :one
+SELECT
+	COALESCE((SELECT value FROM site_configs WHERE key = 'agents_chat_system_prompt'), '') :: text AS chat_system_prompt
+`
+
+func (q *sqlQuerier) GetChatSystemPrompt(ctx context.Context) (string, error) {
+	row := q.db.QueryRowContext(ctx, getChatSystemPrompt)
+	var chat_system_prompt string
+	err := row.Scan(&chat_system_prompt)
 	return chat_system_prompt, err
 }
 
@@ -27222,7 +28546,7 @@ func (q *sqlQuerier) GetTemplateAverageBuildTime(ctx context.Context, templateID
 
 const getTemplateByID = `-- name: GetTemplateByID :one
 SELECT
-	id, created_at, updated_at, organization_id, deleted, name, provisioner, active_version_id, description, default_ttl, created_by, icon, user_acl, group_acl, display_name, allow_user_cancel_workspace_jobs, allow_user_autostart, allow_user_autostop, failure_ttl, time_til_dormant, time_til_dormant_autodelete, autostop_requirement_days_of_week, autostop_requirement_weeks, autostart_block_days_of_week, require_active_version, deprecated, activity_bump, max_port_sharing_level, use_classic_parameter_flow, cors_behavior, disable_module_cache, time_til_autostop_notify, agents_allowed, created_by_avatar_url, created_by_username, created_by_name, organization_name, organization_display_name, organization_icon
+	id, created_at, updated_at, organization_id, deleted, name, provisioner, active_version_id, description, default_ttl, created_by, icon, user_acl, group_acl, display_name, allow_user_cancel_workspace_jobs, allow_user_autostart, allow_user_autostop, failure_ttl, time_til_dormant, time_til_dormant_autodelete, autostop_requirement_days_of_week, autostop_requirement_weeks, autostart_block_days_of_week, require_active_version, deprecated, activity_bump, max_port_sharing_level, use_classic_parameter_flow, cors_behavior, disable_module_cache, time_til_autostop_notify, agents_allowed, drift_check_interval, created_by_avatar_url, created_by_username, created_by_name, organization_name, organization_display_name, organization_icon
 FROM
 	template_with_names
 WHERE
@@ -27268,6 +28592,7 @@ func (q *sqlQuerier) GetTemplateByID(ctx context.Context, id uuid.UUID) (Templat
 		&i.DisableModuleCache,
 		&i.TimeTilAutostopNotify,
 		&i.AgentsAllowed,
+		&i.DriftCheckInterval,
 		&i.CreatedByAvatarURL,
 		&i.CreatedByUsername,
 		&i.CreatedByName,
@@ -27280,7 +28605,7 @@ func (q *sqlQuerier) GetTemplateByID(ctx context.Context, id uuid.UUID) (Templat
 
 const getTemplateByOrganizationAndName = `-- name: GetTemplateByOrganizationAndName :one
 SELECT
-	id, created_at, updated_at, organization_id, deleted, name, provisioner, active_version_id, description, default_ttl, created_by, icon, user_acl, group_acl, display_name, allow_user_cancel_workspace_jobs, allow_user_autostart, allow_user_autostop, failure_ttl, time_til_dormant, time_til_dormant_autodelete, autostop_requirement_days_of_week, autostop_requirement_weeks, autostart_block_days_of_week, require_active_version, deprecated, activity_bump, max_port_sharing_level, use_classic_parameter_flow, cors_behavior, disable_module_cache, time_til_autostop_notify, agents_allowed, created_by_avatar_url, created_by_username, created_by_name, organization_name, organization_display_name, organization_icon
+	id, created_at, updated_at, organization_id, deleted, name, provisioner, active_version_id, description, default_ttl, created_by, icon, user_acl, group_acl, display_name, allow_user_cancel_workspace_jobs, allow_user_autostart, allow_user_autostop, failure_ttl, time_til_dormant, time_til_dormant_autodelete, autostop_requirement_days_of_week, autostop_requirement_weeks, autostart_block_days_of_week, require_active_version, deprecated, activity_bump, max_port_sharing_level, use_classic_parameter_flow, cors_behavior, disable_module_cache, time_til_autostop_notify, agents_allowed, drift_check_interval, created_by_avatar_url, created_by_username, created_by_name, organization_name, organization_display_name, organization_icon
 FROM
 	template_with_names AS templates
 WHERE
@@ -27334,6 +28659,7 @@ func (q *sqlQuerier) GetTemplateByOrganizationAndName(ctx context.Context, arg G
 		&i.DisableModuleCache,
 		&i.TimeTilAutostopNotify,
 		&i.AgentsAllowed,
+		&i.DriftCheckInterval,
 		&i.CreatedByAvatarURL,
 		&i.CreatedByUsername,
 		&i.CreatedByName,
@@ -27345,7 +28671,7 @@ func (q *sqlQuerier) GetTemplateByOrganizationAndName(ctx context.Context, arg G
 }
 
 const getTemplates = `-- name: GetTemplates :many
-SELECT id, created_at, updated_at, organization_id, deleted, name, provisioner, active_version_id, description, default_ttl, created_by, icon, user_acl, group_acl, display_name, allow_user_cancel_workspace_jobs, allow_user_autostart, allow_user_autostop, failure_ttl, time_til_dormant, time_til_dormant_autodelete, autostop_requirement_days_of_week, autostop_requirement_weeks, autostart_block_days_of_week, require_active_version, deprecated, activity_bump, max_port_sharing_level, use_classic_parameter_flow, cors_behavior, disable_module_cache, time_til_autostop_notify, agents_allowed, created_by_avatar_url, created_by_username, created_by_name, organization_name, organization_display_name, organization_icon FROM template_with_names AS templates
+SELECT id, created_at, updated_at, organization_id, deleted, name, provisioner, active_version_id, description, default_ttl, created_by, icon, user_acl, group_acl, display_name, allow_user_cancel_workspace_jobs, allow_user_autostart, allow_user_autostop, failure_ttl, time_til_dormant, time_til_dormant_autodelete, autostop_requirement_days_of_week, autostop_requirement_weeks, autostart_block_days_of_week, require_active_version, deprecated, activity_bump, max_port_sharing_level, use_classic_parameter_flow, cors_behavior, disable_module_cache, time_til_autostop_notify, agents_allowed, drift_check_interval, created_by_avatar_url, created_by_username, created_by_name, organization_name, organization_display_name, organization_icon FROM template_with_names AS templates
 ORDER BY (name, id) ASC
 `
 
@@ -27392,6 +28718,7 @@ func (q *sqlQuerier) GetTemplates(ctx context.Context) ([]Template, error) {
 			&i.DisableModuleCache,
 			&i.TimeTilAutostopNotify,
 			&i.AgentsAllowed,
+			&i.DriftCheckInterval,
 			&i.CreatedByAvatarURL,
 			&i.CreatedByUsername,
 			&i.CreatedByName,
@@ -27414,7 +28741,7 @@ func (q *sqlQuerier) GetTemplates(ctx context.Context) ([]Template, error) {
 
 const getTemplatesWithFilter = `-- name: GetTemplatesWithFilter :many
 SELECT
-	t.id, t.created_at, t.updated_at, t.organization_id, t.deleted, t.name, t.provisioner, t.active_version_id, t.description, t.default_ttl, t.created_by, t.icon, t.user_acl, t.group_acl, t.display_name, t.allow_user_cancel_workspace_jobs, t.allow_user_autostart, t.allow_user_autostop, t.failure_ttl, t.time_til_dormant, t.time_til_dormant_autodelete, t.autostop_requirement_days_of_week, t.autostop_requirement_weeks, t.autostart_block_days_of_week, t.require_active_version, t.deprecated, t.activity_bump, t.max_port_sharing_level, t.use_classic_parameter_flow, t.cors_behavior, t.disable_module_cache, t.time_til_autostop_notify, t.agents_allowed, t.created_by_avatar_url, t.created_by_username, t.created_by_name, t.organization_name, t.organization_display_name, t.organization_icon
+	t.id, t.created_at, t.updated_at, t.organization_id, t.deleted, t.name, t.provisioner, t.active_version_id, t.description, t.default_ttl, t.created_by, t.icon, t.user_acl, t.group_acl, t.display_name, t.allow_user_cancel_workspace_jobs, t.allow_user_autostart, t.allow_user_autostop, t.failure_ttl, t.time_til_dormant, t.time_til_dormant_autodelete, t.autostop_requirement_days_of_week, t.autostop_requirement_weeks, t.autostart_block_days_of_week, t.require_active_version, t.deprecated, t.activity_bump, t.max_port_sharing_level, t.use_classic_parameter_flow, t.cors_behavior, t.disable_module_cache, t.time_til_autostop_notify, t.agents_allowed, t.drift_check_interval, t.created_by_avatar_url, t.created_by_username, t.created_by_name, t.organization_name, t.organization_display_name, t.organization_icon
 FROM
 	template_with_names AS t
 LEFT JOIN
@@ -27584,6 +28911,7 @@ func (q *sqlQuerier) GetTemplatesWithFilter(ctx context.Context, arg GetTemplate
 			&i.DisableModuleCache,
 			&i.TimeTilAutostopNotify,
 			&i.AgentsAllowed,
+			&i.DriftCheckInterval,
 			&i.CreatedByAvatarURL,
 			&i.CreatedByUsername,
 			&i.CreatedByName,
@@ -27774,7 +29102,8 @@ SET
 	use_classic_parameter_flow = $10,
 	cors_behavior = $11,
 	disable_module_cache = $12,
-	agents_allowed = $13
+	agents_allowed = $13,
+	drift_check_interval = $14
 WHERE
 	id = $1
 `
@@ -27793,6 +29122,7 @@ type UpdateTemplateMetaByIDParams struct {
 	CorsBehavior                 CorsBehavior    `db:"cors_behavior" json:"cors_behavior"`
 	DisableModuleCache           bool            `db:"disable_module_cache" json:"disable_module_cache"`
 	AgentsAllowed                bool            `db:"agents_allowed" json:"agents_allowed"`
+	DriftCheckInterval           int64           `db:"drift_check_interval" json:"drift_check_interval"`
 }
 
 func (q *sqlQuerier) UpdateTemplateMetaByID(ctx context.Context, arg UpdateTemplateMetaByIDParams) error {
@@ -27810,6 +29140,7 @@ func (q *sqlQuerier) UpdateTemplateMetaByID(ctx context.Context, arg UpdateTempl
 		arg.CorsBehavior,
 		arg.DisableModuleCache,
 		arg.AgentsAllowed,
+		arg.DriftCheckInterval,
 	)
 	return err
 }
@@ -28026,6 +29357,482 @@ func (q *sqlQuerier) InsertTemplateVersionParameter(ctx context.Context, arg Ins
 	return i, err
 }
 
+const getActiveTemplateVersionRolloutByTemplateID = `-- name: GetActiveTemplateVersionRolloutByTemplateID :one
+SELECT
+	id, template_id, template_version_id, previous_template_version_id, percentage, group_ids, failure_threshold, min_samples, failure_action, status, status_message, created_by, created_at, updated_at, started_at, finished_at
+FROM
+	template_version_rollouts
+WHERE
+	template_id = $1
+	AND status IN ('in_progress'::template_version_rollout_status, 'paused'::template_version_rollout_status)
+`
+
+// Returns the rollout of the template that is in progress or paused. There is
+// at most one such rollout per template.
+func (q *sqlQuerier) GetActiveTemplateVersionRolloutByTemplateID(ctx context.Context, templateID uuid.UUID) (TemplateVersionRollout, error) {
+	row := q.db.QueryRowContext(ctx, getActiveTemplateVersionRolloutByTemplateID, templateID)
+	var i TemplateVersionRollout
+	err := row.Scan(
+		&i.ID,
+		&i.TemplateID,
+		&i.TemplateVersionID,
+		&i.PreviousTemplateVersionID,
+		&i.Percentage,
+		pq.Array(&i.GroupIDs),
+		&i.FailureThreshold,
+		&i.MinSamples,
+		&i.FailureAction,
+		&i.Status,
+		&i.StatusMessage,
+		&i.CreatedBy,
+		&i.CreatedAt,
+		&i.UpdatedAt,
+		&i.StartedAt,
+		&i.FinishedAt,
+	)
+	return i, err
+}
+
+const getInProgressTemplateVersionRollouts = `-- name: GetInProgressTemplateVersionRollouts :many
+SELECT
+	id, template_id, template_version_id, previous_template_version_id, percentage, group_ids, failure_threshold, min_samples, failure_action, status, status_message, created_by, created_at, updated_at, started_at, finished_at
+FROM
+	template_version_rollouts
+WHERE
+	status = 'in_progress'::template_version_rollout_status
+ORDER BY
+	created_at ASC
+`
+
+func (q *sqlQuerier) GetInProgressTemplateVersionRollouts(ctx context.Context) ([]TemplateVersionRollout, error) {
+	rows, err := q.db.QueryContext(ctx, getInProgressTemplateVersionRollouts)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []TemplateVersionRollout
+	for rows.Next() {
+		var i TemplateVersionRollout
+		if err := rows.Scan(
+			&i.ID,
+			&i.TemplateID,
+			&i.TemplateVersionID,
+			&i.PreviousTemplateVersionID,
+			&i.Percentage,
+			pq.Array(&i.GroupIDs),
+			&i.FailureThreshold,
+			&i.MinSamples,
+			&i.FailureAction,
+			&i.Status,
+			&i.StatusMessage,
+			&i.CreatedBy,
+			&i.CreatedAt,
+			&i.UpdatedAt,
+			&i.StartedAt,
+			&i.FinishedAt,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const getTemplateVersionRolloutByID = `-- name: GetTemplateVersionRolloutByID :one
+SELECT
+	id, template_id, template_version_id, previous_template_version_id, percentage, group_ids, failure_threshold, min_samples, failure_action, status, status_message, created_by, created_at, updated_at, started_at, finished_at
+FROM
+	template_version_rollouts
+WHERE
+	id = $1
+`
+
+func (q *sqlQuerier) GetTemplateVersionRolloutByID(ctx context.Context, id uuid.UUID) (TemplateVersionRollout, error) {
+	row := q.db.QueryRowContext(ctx, getTemplateVersionRolloutByID, id)
+	var i TemplateVersionRollout
+	err := row.Scan(
+		&i.ID,
+		&i.TemplateID,
+		&i.TemplateVersionID,
+		&i.PreviousTemplateVersionID,
+		&i.Percentage,
+		pq.Array(&i.GroupIDs),
+		&i.FailureThreshold,
+		&i.MinSamples,
+		&i.FailureAction,
+		&i.Status,
+		&i.StatusMessage,
+		&i.CreatedBy,
+		&i.CreatedAt,
+		&i.UpdatedAt,
+		&i.StartedAt,
+		&i.FinishedAt,
+	)
+	return i, err
+}
+
+const getTemplateVersionRolloutStats = `-- name: GetTemplateVersionRolloutStats :one
+SELECT
+	(
+		SELECT
+			COUNT(*)
+		FROM
+			workspace_builds
+		JOIN
+			provisioner_jobs ON provisioner_jobs.id = workspace_builds.job_id
+		WHERE
+			workspace_builds.template_version_id = $1
+			AND workspace_builds.transition = 'start'::workspace_transition
+			AND workspace_builds.created_at >= $2
+			AND provisioner_jobs.job_status = 'succeeded'::provisioner_job_status
+	) :: bigint AS builds_succeeded,
+	(
+		SELECT
+			COUNT(*)
+		FROM
+			workspace_builds
+		JOIN
+			provisioner_jobs ON provisioner_jobs.id = workspace_builds.job_id
+		WHERE
+			workspace_builds.template_version_id = $1
+			AND workspace_builds.transition = 'start'::workspace_transition
+			AND workspace_builds.created_at >= $2
+			AND provisioner_jobs.job_status = 'failed'::provisioner_job_status
+	) :: bigint AS builds_failed,
+	(
+		SELECT
+			COUNT(*)
+		FROM
+			workspace_agents
+		JOIN
+			workspace_resources ON workspace_resources.id = workspace_agents.resource_id
+		JOIN
+			workspace_builds ON workspace_builds.job_id = workspace_resources.job_id
+		WHERE
+			workspace_builds.template_version_id = $1
+			AND workspace_builds.transition = 'start'::workspace_transition
+			AND workspace_builds.created_at >= $2
+			AND workspace_agents.deleted = false
+			AND workspace_agents.ready_at IS NOT NULL
+	) :: bigint AS agents_ready,
+	(
+		SELECT
+			COUNT(*)
+		FROM
+			workspace_agents
+		JOIN
+			workspace_resources ON workspace_resources.id = workspace_agents.resource_id
+		JOIN
+			workspace_builds ON workspace_builds.job_id = workspace_resources.job_id
+		WHERE
+			workspace_builds.template_version_id = $1
+			AND workspace_builds.transition = 'start'::workspace_transition
+			AND workspace_builds.created_at >= $2
+			AND workspace_agents.deleted = false
+			AND workspace_agents.ready_at IS NULL
+			AND workspace_agents.lifecycle_state IN ('start_error'::workspace_agent_lifecycle_state, 'start_timeout'::workspace_agent_lifecycle_state)
+	) :: bigint AS agents_failed
+`
+
+type GetTemplateVersionRolloutStatsParams struct {
+	TemplateVersionID uuid.UUID `db:"template_version_id" json:"template_version_id"`
+	StartedAt         time.Time `db:"started_at" json:"started_at"`
+}
+
+type GetTemplateVersionRolloutStatsRow struct {
+	BuildsSucceeded int64 `db:"builds_succeeded" json:"builds_succeeded"`
+	BuildsFailed    int64 `db:"builds_failed" json:"builds_failed"`
+	AgentsReady     int64 `db:"agents_ready" json:"agents_ready"`
+	AgentsFailed    int64 `db:"agents_failed" json:"agents_failed"`
+}
+
+// Returns the outcome of the start builds and agents of the rollout version
+// since the rollout was started. Agents count as ready once they have been
+// ready, and as failed if they errored or timed out without ever becoming
+// ready. Agents that are still starting are not counted.
+func (q *sqlQuerier) GetTemplateVersionRolloutStats(ctx context.Context, arg GetTemplateVersionRolloutStatsParams) (GetTemplateVersionRolloutStatsRow, error) {
+	row := q.db.QueryRowContext(ctx, getTemplateVersionRolloutStats, arg.TemplateVersionID, arg.StartedAt)
+	var i GetTemplateVersionRolloutStatsRow
+	err := row.Scan(
+		&i.BuildsSucceeded,
+		&i.BuildsFailed,
+		&i.AgentsReady,
+		&i.AgentsFailed,
+	)
+	return i, err
+}
+
+const getTemplateVersionRolloutTargetsUser = `-- name: GetTemplateVersionRolloutTargetsUser :one
+SELECT EXISTS (
+	SELECT
+		1
+	FROM
+		group_members_expanded
+	JOIN
+		template_version_rollouts ON group_members_expanded.group_id = ANY(template_version_rollouts.group_ids)
+	WHERE
+		template_version_rollouts.id = $1
+		AND group_members_expanded.user_id = $2
+) :: boolean
+`
+
+type GetTemplateVersionRolloutTargetsUserParams struct {
+	RolloutID uuid.UUID `db:"rollout_id" json:"rollout_id"`
+	UserID    uuid.UUID `db:"user_id" json:"user_id"`
+}
+
+// Returns whether the user is a member of one of the groups targeted by the
+// rollout. The "Everyone" group of an organization is included.
+func (q *sqlQuerier) GetTemplateVersionRolloutTargetsUser(ctx context.Context, arg GetTemplateVersionRolloutTargetsUserParams) (bool, error) {
+	row := q.db.QueryRowContext(ctx, getTemplateVersionRolloutTargetsUser, arg.RolloutID, arg.UserID)
+	var column_1 bool
+	err := row.Scan(&column_1)
+	return column_1, err
+}
+
+const getTemplateVersionRolloutsByTemplateID = `-- name: GetTemplateVersionRolloutsByTemplateID :many
+SELECT
+	id, template_id, template_version_id, previous_template_version_id, percentage, group_ids, failure_threshold, min_samples, failure_action, status, status_message, created_by, created_at, updated_at, started_at, finished_at
+FROM
+	template_version_rollouts
+WHERE
+	template_id = $1
+ORDER BY
+	created_at DESC
+`
+
+func (q *sqlQuerier) GetTemplateVersionRolloutsByTemplateID(ctx context.Context, templateID uuid.UUID) ([]TemplateVersionRollout, error) {
+	rows, err := q.db.QueryContext(ctx, getTemplateVersionRolloutsByTemplateID, templateID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []TemplateVersionRollout
+	for rows.Next() {
+		var i TemplateVersionRollout
+		if err := rows.Scan(
+			&i.ID,
+			&i.TemplateID,
+			&i.TemplateVersionID,
+			&i.PreviousTemplateVersionID,
+			&i.Percentage,
+			pq.Array(&i.GroupIDs),
+			&i.FailureThreshold,
+			&i.MinSamples,
+			&i.FailureAction,
+			&i.Status,
+			&i.StatusMessage,
+			&i.CreatedBy,
+			&i.CreatedAt,
+			&i.UpdatedAt,
+			&i.StartedAt,
+			&i.FinishedAt,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const insertTemplateVersionRollout = `-- name: InsertTemplateVersionRollout :one
+INSERT INTO template_version_rollouts (
+	id,
+	template_id,
+	template_version_id,
+	previous_template_version_id,
+	percentage,
+	group_ids,
+	failure_threshold,
+	min_samples,
+	failure_action,
+	created_by,
+	created_at,
+	updated_at,
+	started_at
+)
+VALUES
+	($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12, $13)
+RETURNING id, template_id, template_version_id, previous_template_version_id, percentage, group_ids, failure_threshold, min_samples, failure_action, status, status_message, created_by, created_at, updated_at, started_at, finished_at
+`
+
+type InsertTemplateVersionRolloutParams struct {
+	ID                        uuid.UUID                           `db:"id" json:"id"`
+	TemplateID                uuid.UUID                           `db:"template_id" json:"template_id"`
+	TemplateVersionID         uuid.UUID                           `db:"template_version_id" json:"template_version_id"`
+	PreviousTemplateVersionID uuid.UUID                           `db:"previous_template_version_id" json:"previous_template_version_id"`
+	Percentage                int32                               `db:"percentage" json:"percentage"`
+	GroupIDs                  []uuid.UUID                         `db:"group_ids" json:"group_ids"`
+	FailureThreshold          float64                             `db:"failure_threshold" json:"failure_threshold"`
+	MinSamples                int32                               `db:"min_samples" json:"min_samples"`
+	FailureAction             TemplateVersionRolloutFailureAction `db:"failure_action" json:"failure_action"`
+	CreatedBy                 uuid.UUID                           `db:"created_by" json:"created_by"`
+	CreatedAt                 time.Time                           `db:"created_at" json:"created_at"`
+	UpdatedAt                 time.Time                           `db:"updated_at" json:"updated_at"`
+	StartedAt                 time.Time                           `db:"started_at" json:"started_at"`
+}
+
+func (q *sqlQuerier) InsertTemplateVersionRollout(ctx context.Context, arg InsertTemplateVersionRolloutParams) (TemplateVersionRollout, error) {
+	row := q.db.QueryRowContext(ctx, insertTemplateVersionRollout,
+		arg.ID,
+		arg.TemplateID,
+		arg.TemplateVersionID,
+		arg.PreviousTemplateVersionID,
+		arg.Percentage,
+		pq.Array(arg.GroupIDs),
+		arg.FailureThreshold,
+		arg.MinSamples,
+		arg.FailureAction,
+		arg.CreatedBy,
+		arg.CreatedAt,
+		arg.UpdatedAt,
+		arg.StartedAt,
+	)
+	var i TemplateVersionRollout
+	err := row.Scan(
+		&i.ID,
+		&i.TemplateID,
+		&i.TemplateVersionID,
+		&i.PreviousTemplateVersionID,
+		&i.Percentage,
+		pq.Array(&i.GroupIDs),
+		&i.FailureThreshold,
+		&i.MinSamples,
+		&i.FailureAction,
+		&i.Status,
+		&i.StatusMessage,
+		&i.CreatedBy,
+		&i.CreatedAt,
+		&i.UpdatedAt,
+		&i.StartedAt,
+		&i.FinishedAt,
+	)
+	return i, err
+}
+
+const updateTemplateVersionRolloutByID = `-- name: UpdateTemplateVersionRolloutByID :one
+UPDATE
+	template_version_rollouts
+SET
+	percentage = $2,
+	group_ids = $3,
+	failure_threshold = $4,
+	min_samples = $5,
+	failure_action = $6,
+	updated_at = $7
+WHERE
+	id = $1
+RETURNING id, template_id, template_version_id, previous_template_version_id, percentage, group_ids, failure_threshold, min_samples, failure_action, status, status_message, created_by, created_at, updated_at, started_at, finished_at
+`
+
+type UpdateTemplateVersionRolloutByIDParams struct {
+	ID               uuid.UUID                           `db:"id" json:"id"`
+	Percentage       int32                               `db:"percentage" json:"percentage"`
+	GroupIDs         []uuid.UUID                         `db:"group_ids" json:"group_ids"`
+	FailureThreshold float64                             `db:"failure_threshold" json:"failure_threshold"`
+	MinSamples       int32                               `db:"min_samples" json:"min_samples"`
+	FailureAction    TemplateVersionRolloutFailureAction `db:"failure_action" json:"failure_action"`
+	UpdatedAt        time.Time                           `db:"updated_at" json:"updated_at"`
+}
+
+func (q *sqlQuerier) UpdateTemplateVersionRolloutByID(ctx context.Context, arg UpdateTemplateVersionRolloutByIDParams) (TemplateVersionRollout, error) {
+	row := q.db.QueryRowContext(ctx, updateTemplateVersionRolloutByID,
+		arg.ID,
+		arg.Percentage,
+		pq.Array(arg.GroupIDs),
+		arg.FailureThreshold,
+		arg.MinSamples,
+		arg.FailureAction,
+		arg.UpdatedAt,
+	)
+	var i TemplateVersionRollout
+	err := row.Scan(
+		&i.ID,
+		&i.TemplateID,
+		&i.TemplateVersionID,
+		&i.PreviousTemplateVersionID,
+		&i.Percentage,
+		pq.Array(&i.GroupIDs),
+		&i.FailureThreshold,
+		&i.MinSamples,
+		&i.FailureAction,
+		&i.Status,
+		&i.StatusMessage,
+		&i.CreatedBy,
+		&i.CreatedAt,
+		&i.UpdatedAt,
+		&i.StartedAt,
+		&i.FinishedAt,
+	)
+	return i, err
+}
+
+const updateTemplateVersionRolloutStatusByID = `-- name: UpdateTemplateVersionRolloutStatusByID :one
+UPDATE
+	template_version_rollouts
+SET
+	status = $2,
+	status_message = $3,
+	updated_at = $4,
+	started_at = $5,
+	finished_at = $6
+WHERE
+	id = $1
+RETURNING id, template_id, template_version_id, previous_template_version_id, percentage, group_ids, failure_threshold, min_samples, failure_action, status, status_message, created_by, created_at, updated_at, started_at, finished_at
+`
+
+type UpdateTemplateVersionRolloutStatusByIDParams struct {
+	ID            uuid.UUID                    `db:"id" json:"id"`
+	Status        TemplateVersionRolloutStatus `db:"status" json:"status"`
+	StatusMessage string                       `db:"status_message" json:"status_message"`
+	UpdatedAt     time.Time                    `db:"updated_at" json:"updated_at"`
+	StartedAt     time.Time                    `db:"started_at" json:"started_at"`
+	FinishedAt    sql.NullTime                 `db:"finished_at" json:"finished_at"`
+}
+
+func (q *sqlQuerier) UpdateTemplateVersionRolloutStatusByID(ctx context.Context, arg UpdateTemplateVersionRolloutStatusByIDParams) (TemplateVersionRollout, error) {
+	row := q.db.QueryRowContext(ctx, updateTemplateVersionRolloutStatusByID,
+		arg.ID,
+		arg.Status,
+		arg.StatusMessage,
+		arg.UpdatedAt,
+		arg.StartedAt,
+		arg.FinishedAt,
+	)
+	var i TemplateVersionRollout
+	err := row.Scan(
+		&i.ID,
+		&i.TemplateID,
+		&i.TemplateVersionID,
+		&i.PreviousTemplateVersionID,
+		&i.Percentage,
+		pq.Array(&i.GroupIDs),
+		&i.FailureThreshold,
+		&i.MinSamples,
+		&i.FailureAction,
+		&i.Status,
+		&i.StatusMessage,
+		&i.CreatedBy,
+		&i.CreatedAt,
+		&i.UpdatedAt,
+		&i.StartedAt,
+		&i.FinishedAt,
+	)
+	return i, err
+}
+
 const archiveUnusedTemplateVersions = `-- name: ArchiveUnusedTemplateVersions :many
 UPDATE
 	template_versions
@@ -29509,6 +31316,7 @@ INNER JOIN
 	users ON user_links.user_id = users.id
 WHERE
 	user_links.login_type = 'oidc'
+	AND user_links.oidc_provider_id = ''
 	AND users.deleted = false
 GROUP BY issuer_prefix
 `
@@ -29521,7 +31329,8 @@ type CountOIDCLinkedIDsByIssuerRow struct {
 // Groups OIDC user links by their issuer prefix (the part before "||" in
 // linked_id) and returns a count for each. Empty linked_ids are reported
 // with an empty issuer_prefix. Used for analysis before resetting
-// mismatched links.
+// mismatched links. Links to named OIDC providers are excluded, since
+// they are expected to carry a different issuer.
 func (q *sqlQuerier) CountOIDCLinkedIDsByIssuer(ctx context.Context) ([]CountOIDCLinkedIDsByIssuerRow, error) {
 	rows, err := q.db.QueryContext(ctx, countOIDCLinkedIDsByIssuer)
 	if err != nil {
@@ -29547,7 +31356,7 @@ func (q *sqlQuerier) CountOIDCLinkedIDsByIssuer(ctx context.Context) ([]CountOID
 
 const getUserLinkByLinkedID = `-- name: GetUserLinkByLinkedID :one
 SELECT
-	user_links.user_id, user_links.login_type, user_links.linked_id, user_links.oauth_access_token, user_links.oauth_refresh_token, user_links.oauth_expiry, user_links.oauth_access_token_key_id, user_links.oauth_refresh_token_key_id, user_links.claims
+	user_links.user_id, user_links.login_type, user_links.linked_id, user_links.oauth_access_token, user_links.oauth_refresh_token, user_links.oauth_expiry, user_links.oauth_access_token_key_id, user_links.oauth_refresh_token_key_id, user_links.claims, user_links.oidc_provider_id
 FROM
 	user_links
 INNER JOIN
@@ -29571,13 +31380,14 @@ func (q *sqlQuerier) GetUserLinkByLinkedID(ctx context.Context, linkedID string)
 		&i.OAuthAccessTokenKeyID,
 		&i.OAuthRefreshTokenKeyID,
 		&i.Claims,
+		&i.OIDCProviderID,
 	)
 	return i, err
 }
 
 const getUserLinkByUserIDLoginType = `-- name: GetUserLinkByUserIDLoginType :one
 SELECT
-	user_id, login_type, linked_id, oauth_access_token, oauth_refresh_token, oauth_expiry, oauth_access_token_key_id, oauth_refresh_token_key_id, claims
+	user_id, login_type, linked_id, oauth_access_token, oauth_refresh_token, oauth_expiry, oauth_access_token_key_id, oauth_refresh_token_key_id, claims, oidc_provider_id
 FROM
 	user_links
 WHERE
@@ -29602,12 +31412,13 @@ func (q *sqlQuerier) GetUserLinkByUserIDLoginType(ctx context.Context, arg GetUs
 		&i.OAuthAccessTokenKeyID,
 		&i.OAuthRefreshTokenKeyID,
 		&i.Claims,
+		&i.OIDCProviderID,
 	)
 	return i, err
 }
 
 const getUserLinksByUserID = `-- name: GetUserLinksByUserID :many
-SELECT user_id, login_type, linked_id, oauth_access_token, oauth_refresh_token, oauth_expiry, oauth_access_token_key_id, oauth_refresh_token_key_id, claims FROM user_links WHERE user_id = $1
+SELECT user_id, login_type, linked_id, oauth_access_token, oauth_refresh_token, oauth_expiry, oauth_access_token_key_id, oauth_refresh_token_key_id, claims, oidc_provider_id FROM user_links WHERE user_id = $1
 `
 
 func (q *sqlQuerier) GetUserLinksByUserID(ctx context.Context, userID uuid.UUID) ([]UserLink, error) {
@@ -29629,6 +31440,7 @@ func (q *sqlQuerier) GetUserLinksByUserID(ctx context.Context, userID uuid.UUID)
 			&i.OAuthAccessTokenKeyID,
 			&i.OAuthRefreshTokenKeyID,
 			&i.Claims,
+			&i.OIDCProviderID,
 		); err != nil {
 			return nil, err
 		}
@@ -29654,10 +31466,11 @@ INSERT INTO
 		oauth_refresh_token,
 		oauth_refresh_token_key_id,
 		oauth_expiry,
-		claims
+		claims,
+		oidc_provider_id
 	)
 VALUES
-	( $1, $2, $3, $4, $5, $6, $7, $8, $9 ) RETURNING user_id, login_type, linked_id, oauth_access_token, oauth_refresh_token, oauth_expiry, oauth_access_token_key_id, oauth_refresh_token_key_id, claims
+	( $1, $2, $3, $4, $5, $6, $7, $8, $9, $10 ) RETURNING user_id, login_type, linked_id, oauth_access_token, oauth_refresh_token, oauth_expiry, oauth_access_token_key_id, oauth_refresh_token_key_id, claims, oidc_provider_id
 `
 
 type InsertUserLinkParams struct {
@@ -29670,6 +31483,7 @@ type InsertUserLinkParams struct {
 	OAuthRefreshTokenKeyID sql.NullString `db:"oauth_refresh_token_key_id" json:"oauth_refresh_token_key_id"`
 	OAuthExpiry            time.Time      `db:"oauth_expiry" json:"oauth_expiry"`
 	Claims                 UserLinkClaims `db:"claims" json:"claims"`
+	OIDCProviderID         string         `db:"oidc_provider_id" json:"oidc_provider_id"`
 }
 
 func (q *sqlQuerier) InsertUserLink(ctx context.Context, arg InsertUserLinkParams) (UserLink, error) {
@@ -29683,6 +31497,7 @@ func (q *sqlQuerier) InsertUserLink(ctx context.Context, arg InsertUserLinkParam
 		arg.OAuthRefreshTokenKeyID,
 		arg.OAuthExpiry,
 		arg.Claims,
+		arg.OIDCProviderID,
 	)
 	var i UserLink
 	err := row.Scan(
@@ -29695,6 +31510,7 @@ func (q *sqlQuerier) InsertUserLink(ctx context.Context, arg InsertUserLinkParam
 		&i.OAuthAccessTokenKeyID,
 		&i.OAuthRefreshTokenKeyID,
 		&i.Claims,
+		&i.OIDCProviderID,
 	)
 	return i, err
 }
@@ -29809,6 +31625,7 @@ SET linked_id = ''
 FROM users
 WHERE user_links.user_id = users.id
 	AND user_links.login_type = 'oidc'
+	AND user_links.oidc_provider_id = ''
 	AND user_links.linked_id != ''
 	AND NOT starts_with(user_links.linked_id, $1)
 	AND users.deleted = false
@@ -29816,7 +31633,8 @@ WHERE user_links.user_id = users.id
 
 // Resets linked_id to ” for OIDC links where the linked_id is non-empty
 // and does not begin with the expected issuer prefix. This allows users to
-// re-authenticate under a new OIDC provider.
+// re-authenticate under a new OIDC provider. Links to named OIDC
+// providers are left untouched.
 func (q *sqlQuerier) UnlinkOIDCUsersByIssuerMismatch(ctx context.Context, expectedPrefix string) (int64, error) {
 	result, err := q.db.ExecContext(ctx, unlinkOIDCUsersByIssuerMismatch, expectedPrefix)
 	if err != nil {
@@ -29834,9 +31652,10 @@ SET
 	oauth_refresh_token = $3,
 	oauth_refresh_token_key_id = $4,
 	oauth_expiry = $5,
-	claims = $6
+	claims = $6,
+	oidc_provider_id = $7
 WHERE
-	user_id = $7 AND login_type = $8 RETURNING user_id, login_type, linked_id, oauth_access_token, oauth_refresh_token, oauth_expiry, oauth_access_token_key_id, oauth_refresh_token_key_id, claims
+	user_id = $8 AND login_type = $9 RETURNING user_id, login_type, linked_id, oauth_access_token, oauth_refresh_token, oauth_expiry, oauth_access_token_key_id, oauth_refresh_token_key_id, claims, oidc_provider_id
 `
 
 type UpdateUserLinkParams struct {
@@ -29846,6 +31665,7 @@ type UpdateUserLinkParams struct {
 	OAuthRefreshTokenKeyID sql.NullString `db:"oauth_refresh_token_key_id" json:"oauth_refresh_token_key_id"`
 	OAuthExpiry            time.Time      `db:"oauth_expiry" json:"oauth_expiry"`
 	Claims                 UserLinkClaims `db:"claims" json:"claims"`
+	OIDCProviderID         string         `db:"oidc_provider_id" json:"oidc_provider_id"`
 	UserID                 uuid.UUID      `db:"user_id" json:"user_id"`
 	LoginType              LoginType      `db:"login_type" json:"login_type"`
 }
@@ -29858,6 +31678,7 @@ func (q *sqlQuerier) UpdateUserLink(ctx context.Context, arg UpdateUserLinkParam
 		arg.OAuthRefreshTokenKeyID,
 		arg.OAuthExpiry,
 		arg.Claims,
+		arg.OIDCProviderID,
 		arg.UserID,
 		arg.LoginType,
 	)
@@ -29872,6 +31693,7 @@ func (q *sqlQuerier) UpdateUserLink(ctx context.Context, arg UpdateUserLinkParam
 		&i.OAuthAccessTokenKeyID,
 		&i.OAuthRefreshTokenKeyID,
 		&i.Claims,
+		&i.OIDCProviderID,
 	)
 	return i, err
 }
@@ -29880,22 +31702,29 @@ const updateUserLinkedID = `-- name: UpdateUserLinkedID :one
 UPDATE
 	user_links
 SET
-	linked_id = $1
+	linked_id = $1,
+	oidc_provider_id = $2
 WHERE
-	user_id = $2 AND login_type = $3 AND linked_id = '' RETURNING user_id, login_type, linked_id, oauth_access_token, oauth_refresh_token, oauth_expiry, oauth_access_token_key_id, oauth_refresh_token_key_id, claims
+	user_id = $3 AND login_type = $4 AND linked_id = '' RETURNING user_id, login_type, linked_id, oauth_access_token, oauth_refresh_token, oauth_expiry, oauth_access_token_key_id, oauth_refresh_token_key_id, claims, oidc_provider_id
 `
 
 type UpdateUserLinkedIDParams struct {
-	LinkedID  string    `db:"linked_id" json:"linked_id"`
-	UserID    uuid.UUID `db:"user_id" json:"user_id"`
-	LoginType LoginType `db:"login_type" json:"login_type"`
+	LinkedID       string    `db:"linked_id" json:"linked_id"`
+	OIDCProviderID string    `db:"oidc_provider_id" json:"oidc_provider_id"`
+	UserID         uuid.UUID `db:"user_id" json:"user_id"`
+	LoginType      LoginType `db:"login_type" json:"login_type"`
 }
 
 // Backfills linked_id for legacy user_links that were created before
 // linked_id tracking was added. Only updates when linked_id is empty
 // to avoid overwriting a valid binding.
 func (q *sqlQuerier) UpdateUserLinkedID(ctx context.Context, arg UpdateUserLinkedIDParams) (UserLink, error) {
-	row := q.db.QueryRowContext(ctx, updateUserLinkedID, arg.LinkedID, arg.UserID, arg.LoginType)
+	row := q.db.QueryRowContext(ctx, updateUserLinkedID,
+		arg.LinkedID,
+		arg.OIDCProviderID,
+		arg.UserID,
+		arg.LoginType,
+	)
 	var i UserLink
 	err := row.Scan(
 		&i.UserID,
@@ -29907,6 +31736,7 @@ func (q *sqlQuerier) UpdateUserLinkedID(ctx context.Context, arg UpdateUserLinke
 		&i.OAuthAccessTokenKeyID,
 		&i.OAuthRefreshTokenKeyID,
 		&i.Claims,
+		&i.OIDCProviderID,
 	)
 	return i, err
 }
@@ -32804,6 +34634,271 @@ func (q *sqlQuerier) UpsertWorkspaceAgentPortShare(ctx context.Context, arg Upse
 	return i, err
 }
 
+const getWorkspaceAgentPTYShareByHashedToken = `-- name: GetWorkspaceAgentPTYShareByHashedToken :one
+SELECT id, agent_id, reconnect_id, created_by, hashed_token, user_ids, created_at, expires_at, revoked_at FROM workspace_agent_pty_shares WHERE hashed_token = $1
+`
+
+func (q *sqlQuerier) GetWorkspaceAgentPTYShareByHashedToken(ctx context.Context, hashedToken []byte) (WorkspaceAgentPTYShare, error) {
+	row := q.db.QueryRowContext(ctx, getWorkspaceAgentPTYShareByHashedToken, hashedToken)
+	var i WorkspaceAgentPTYShare
+	err := row.Scan(
+		&i.ID,
+		&i.AgentID,
+		&i.ReconnectID,
+		&i.CreatedBy,
+		&i.HashedToken,
+		pq.Array(&i.UserIds),
+		&i.CreatedAt,
+		&i.ExpiresAt,
+		&i.RevokedAt,
+	)
+	return i, err
+}
+
+const getWorkspaceAgentPTYShareByID = `-- name: GetWorkspaceAgentPTYShareByID :one
+SELECT id, agent_id, reconnect_id, created_by, hashed_token, user_ids, created_at, expires_at, revoked_at FROM workspace_agent_pty_shares WHERE id = $1
+`
+
+func (q *sqlQuerier) GetWorkspaceAgentPTYShareByID(ctx context.Context, id uuid.UUID) (WorkspaceAgentPTYShare, error) {
+	row := q.db.QueryRowContext(ctx, getWorkspaceAgentPTYShareByID, id)
+	var i WorkspaceAgentPTYShare
+	err := row.Scan(
+		&i.ID,
+		&i.AgentID,
+		&i.ReconnectID,
+		&i.CreatedBy,
+		&i.HashedToken,
+		pq.Array(&i.UserIds),
+		&i.CreatedAt,
+		&i.ExpiresAt,
+		&i.RevokedAt,
+	)
+	return i, err
+}
+
+const getWorkspaceAgentPTYShareViewersByAgentID = `-- name: GetWorkspaceAgentPTYShareViewersByAgentID :many
+SELECT
+	workspace_agent_pty_share_viewers.id, workspace_agent_pty_share_viewers.share_id, workspace_agent_pty_share_viewers.user_id, workspace_agent_pty_share_viewers.ip, workspace_agent_pty_share_viewers.connected_at, workspace_agent_pty_share_viewers.disconnected_at,
+	users.username,
+	users.avatar_url
+FROM
+	workspace_agent_pty_share_viewers
+JOIN
+	workspace_agent_pty_shares ON workspace_agent_pty_shares.id = workspace_agent_pty_share_viewers.share_id
+JOIN
+	users ON users.id = workspace_agent_pty_share_viewers.user_id
+WHERE
+	workspace_agent_pty_shares.agent_id = $1
+ORDER BY
+	workspace_agent_pty_share_viewers.connected_at DESC
+`
+
+type GetWorkspaceAgentPTYShareViewersByAgentIDRow struct {
+	ID             uuid.UUID    `db:"id" json:"id"`
+	ShareID        uuid.UUID    `db:"share_id" json:"share_id"`
+	UserID         uuid.UUID    `db:"user_id" json:"user_id"`
+	Ip             pqtype.Inet  `db:"ip" json:"ip"`
+	ConnectedAt    time.Time    `db:"connected_at" json:"connected_at"`
+	DisconnectedAt sql.NullTime `db:"disconnected_at" json:"disconnected_at"`
+	Username       string       `db:"username" json:"username"`
+	AvatarURL      string       `db:"avatar_url" json:"avatar_url"`
+}
+
+func (q *sqlQuerier) GetWorkspaceAgentPTYShareViewersByAgentID(ctx context.Context, agentID uuid.UUID) ([]GetWorkspaceAgentPTYShareViewersByAgentIDRow, error) {
+	rows, err := q.db.QueryContext(ctx, getWorkspaceAgentPTYShareViewersByAgentID, agentID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []GetWorkspaceAgentPTYShareViewersByAgentIDRow
+	for rows.Next() {
+		var i GetWorkspaceAgentPTYShareViewersByAgentIDRow
+		if err := rows.Scan(
+			&i.ID,
+			&i.ShareID,
+			&i.UserID,
+			&i.Ip,
+			&i.ConnectedAt,
+			&i.DisconnectedAt,
+			&i.Username,
+			&i.AvatarURL,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const getWorkspaceAgentPTYSharesByAgentID = `-- name: GetWorkspaceAgentPTYSharesByAgentID :many
+SELECT id, agent_id, reconnect_id, created_by, hashed_token, user_ids, created_at, expires_at, revoked_at FROM workspace_agent_pty_shares WHERE agent_id = $1 ORDER BY created_at DESC
+`
+
+func (q *sqlQuerier) GetWorkspaceAgentPTYSharesByAgentID(ctx context.Context, agentID uuid.UUID) ([]WorkspaceAgentPTYShare, error) {
+	rows, err := q.db.QueryContext(ctx, getWorkspaceAgentPTYSharesByAgentID, agentID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []WorkspaceAgentPTYShare
+	for rows.Next() {
+		var i WorkspaceAgentPTYShare
+		if err := rows.Scan(
+			&i.ID,
+			&i.AgentID,
+			&i.ReconnectID,
+			&i.CreatedBy,
+			&i.HashedToken,
+			pq.Array(&i.UserIds),
+			&i.CreatedAt,
+			&i.ExpiresAt,
+			&i.RevokedAt,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const insertWorkspaceAgentPTYShare = `-- name: InsertWorkspaceAgentPTYShare :one
+INSERT INTO
+	workspace_agent_pty_shares (
+		id,
+		agent_id,
+		reconnect_id,
+		created_by,
+		hashed_token,
+		user_ids,
+		created_at,
+		expires_at
+	)
+VALUES
+	($1, $2, $3, $4, $5, $6, $7, $8) RETURNING id, agent_id, reconnect_id, created_by, hashed_token, user_ids, created_at, expires_at, revoked_at
+`
+
+type InsertWorkspaceAgentPTYShareParams struct {
+	ID          uuid.UUID   `db:"id" json:"id"`
+	AgentID     uuid.UUID   `db:"agent_id" json:"agent_id"`
+	ReconnectID uuid.UUID   `db:"reconnect_id" json:"reconnect_id"`
+	CreatedBy   uuid.UUID   `db:"created_by" json:"created_by"`
+	HashedToken []byte      `db:"hashed_token" json:"hashed_token"`
+	UserIds     []uuid.UUID `db:"user_ids" json:"user_ids"`
+	CreatedAt   time.Time   `db:"created_at" json:"created_at"`
+	ExpiresAt   time.Time   `db:"expires_at" json:"expires_at"`
+}
+
+func (q *sqlQuerier) InsertWorkspaceAgentPTYShare(ctx context.Context, arg InsertWorkspaceAgentPTYShareParams) (WorkspaceAgentPTYShare, error) {
+	row := q.db.QueryRowContext(ctx, insertWorkspaceAgentPTYShare,
+		arg.ID,
+		arg.AgentID,
+		arg.ReconnectID,
+		arg.CreatedBy,
+		arg.HashedToken,
+		pq.Array(arg.UserIds),
+		arg.CreatedAt,
+		arg.ExpiresAt,
+	)
+	var i WorkspaceAgentPTYShare
+	err := row.Scan(
+		&i.ID,
+		&i.AgentID,
+		&i.ReconnectID,
+		&i.CreatedBy,
+		&i.HashedToken,
+		pq.Array(&i.UserIds),
+		&i.CreatedAt,
+		&i.ExpiresAt,
+		&i.RevokedAt,
+	)
+	return i, err
+}
+
+const insertWorkspaceAgentPTYShareViewer = `-- name: InsertWorkspaceAgentPTYShareViewer :one
+INSERT INTO
+	workspace_agent_pty_share_viewers (
+		id,
+		share_id,
+		user_id,
+		ip,
+		connected_at
+	)
+VALUES
+	($1, $2, $3, $4, $5) RETURNING id, share_id, user_id, ip, connected_at, disconnected_at
+`
+
+type InsertWorkspaceAgentPTYShareViewerParams struct {
+	ID          uuid.UUID   `db:"id" json:"id"`
+	ShareID     uuid.UUID   `db:"share_id" json:"share_id"`
+	UserID      uuid.UUID   `db:"user_id" json:"user_id"`
+	Ip          pqtype.Inet `db:"ip" json:"ip"`
+	ConnectedAt time.Time   `db:"connected_at" json:"connected_at"`
+}
+
+func (q *sqlQuerier) InsertWorkspaceAgentPTYShareViewer(ctx context.Context, arg InsertWorkspaceAgentPTYShareViewerParams) (WorkspaceAgentPTYShareViewer, error) {
+	row := q.db.QueryRowContext(ctx, insertWorkspaceAgentPTYShareViewer,
+		arg.ID,
+		arg.ShareID,
+		arg.UserID,
+		arg.Ip,
+		arg.ConnectedAt,
+	)
+	var i WorkspaceAgentPTYShareViewer
+	err := row.Scan(
+		&i.ID,
+		&i.ShareID,
+		&i.UserID,
+		&i.Ip,
+		&i.ConnectedAt,
+		&i.DisconnectedAt,
+	)
+	return i, err
+}
+
+const revokeWorkspaceAgentPTYShare = `-- name: RevokeWorkspaceAgentPTYShare :exec
+UPDATE workspace_agent_pty_shares
+SET revoked_at = $1::timestamptz
+WHERE id = $2 AND revoked_at IS NULL
+`
+
+type RevokeWorkspaceAgentPTYShareParams struct {
+	RevokedAt time.Time `db:"revoked_at" json:"revoked_at"`
+	ID        uuid.UUID `db:"id" json:"id"`
+}
+
+func (q *sqlQuerier) RevokeWorkspaceAgentPTYShare(ctx context.Context, arg RevokeWorkspaceAgentPTYShareParams) error {
+	_, err := q.db.ExecContext(ctx, revokeWorkspaceAgentPTYShare, arg.RevokedAt, arg.ID)
+	return err
+}
+
+const updateWorkspaceAgentPTYShareViewerDisconnectedAt = `-- name: UpdateWorkspaceAgentPTYShareViewerDisconnectedAt :exec
+UPDATE workspace_agent_pty_share_viewers
+SET disconnected_at = $1::timestamptz
+WHERE id = $2
+`
+
+type UpdateWorkspaceAgentPTYShareViewerDisconnectedAtParams struct {
+	DisconnectedAt time.Time `db:"disconnected_at" json:"disconnected_at"`
+	ID             uuid.UUID `db:"id" json:"id"`
+}
+
+func (q *sqlQuerier) UpdateWorkspaceAgentPTYShareViewerDisconnectedAt(ctx context.Context, arg UpdateWorkspaceAgentPTYShareViewerDisconnectedAtParams) error {
+	_, err := q.db.ExecContext(ctx, updateWorkspaceAgentPTYShareViewerDisconnectedAt, arg.DisconnectedAt, arg.ID)
+	return err
+}
+
 const fetchMemoryResourceMonitorsByAgentID = `-- name: FetchMemoryResourceMonitorsByAgentID :one
 SELECT
 	agent_id, enabled, threshold, created_at, updated_at, state, debounced_until
@@ -32868,6 +34963,48 @@ func (q *sqlQuerier) FetchMemoryResourceMonitorsUpdatedAfter(ctx context.Context
 	return items, nil
 }
 
+const fetchResourceMonitorsByAgentID = `-- name: FetchResourceMonitorsByAgentID :many
+SELECT
+	agent_id, type, path, enabled, threshold, created_at, updated_at, state, debounced_until
+FROM
+	workspace_agent_resource_monitors
+WHERE
+	agent_id = $1
+`
+
+func (q *sqlQuerier) FetchResourceMonitorsByAgentID(ctx context.Context, agentID uuid.UUID) ([]WorkspaceAgentResourceMonitor, error) {
+	rows, err := q.db.QueryContext(ctx, fetchResourceMonitorsByAgentID, agentID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []WorkspaceAgentResourceMonitor
+	for rows.Next() {
+		var i WorkspaceAgentResourceMonitor
+		if err := rows.Scan(
+			&i.AgentID,
+			&i.Type,
+			&i.Path,
+			&i.Enabled,
+			&i.Threshold,
+			&i.CreatedAt,
+			&i.UpdatedAt,
+			&i.State,
+			&i.DebouncedUntil,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
 const fetchVolumesResourceMonitorsByAgentID = `-- name: FetchVolumesResourceMonitorsByAgentID :many
 SELECT
 	agent_id, enabled, threshold, path, created_at, updated_at, state, debounced_until
@@ -32998,6 +35135,62 @@ func (q *sqlQuerier) InsertMemoryResourceMonitor(ctx context.Context, arg Insert
 	return i, err
 }
 
+const insertResourceMonitor = `-- name: InsertResourceMonitor :one
+INSERT INTO
+	workspace_agent_resource_monitors (
+		agent_id,
+		type,
+		path,
+		enabled,
+		state,
+		threshold,
+		created_at,
+		updated_at,
+		debounced_until
+	)
+VALUES
+	($1, $2, $3, $4, $5, $6, $7, $8, $9) RETURNING agent_id, type, path, enabled, threshold, created_at, updated_at, state, debounced_until
+`
+
+type InsertResourceMonitorParams struct {
+	AgentID        uuid.UUID                         `db:"agent_id" json:"agent_id"`
+	Type           WorkspaceAgentResourceMonitorType `db:"type" json:"type"`
+	Path           string                            `db:"path" json:"path"`
+	Enabled        bool                              `db:"enabled" json:"enabled"`
+	State          WorkspaceAgentMonitorState        `db:"state" json:"state"`
+	Threshold      int32                             `db:"threshold" json:"threshold"`
+	CreatedAt      time.Time                         `db:"created_at" json:"created_at"`
+	UpdatedAt      time.Time                         `db:"updated_at" json:"updated_at"`
+	DebouncedUntil time.Time                         `db:"debounced_until" json:"debounced_until"`
+}
+
+func (q *sqlQuerier) InsertResourceMonitor(ctx context.Context, arg InsertResourceMonitorParams) (WorkspaceAgentResourceMonitor, error) {
+	row := q.db.QueryRowContext(ctx, insertResourceMonitor,
+		arg.AgentID,
+		arg.Type,
+		arg.Path,
+		arg.Enabled,
+		arg.State,
+		arg.Threshold,
+		arg.CreatedAt,
+		arg.UpdatedAt,
+		arg.DebouncedUntil,
+	)
+	var i WorkspaceAgentResourceMonitor
+	err := row.Scan(
+		&i.AgentID,
+		&i.Type,
+		&i.Path,
+		&i.Enabled,
+		&i.Threshold,
+		&i.CreatedAt,
+		&i.UpdatedAt,
+		&i.State,
+		&i.DebouncedUntil,
+	)
+	return i, err
+}
+
 const insertVolumeResourceMonitor = `-- name: InsertVolumeResourceMonitor :one
 INSERT INTO
 	workspace_agent_volume_resource_monitors (
@@ -33077,6 +35270,37 @@ func (q *sqlQuerier) UpdateMemoryResourceMonitor(ctx context.Context, arg Update
 	return err
 }
 
+const updateResourceMonitor = `-- name: UpdateResourceMonitor :exec
+UPDATE workspace_agent_resource_monitors
+SET
+	updated_at = $4,
+	state = $5,
+	debounced_until = $6
+WHERE
+	agent_id = $1 AND type = $2 AND path = $3
+`
+
+type UpdateResourceMonitorParams struct {
+	AgentID        uuid.UUID                         `db:"agent_id" json:"agent_id"`
+	Type           WorkspaceAgentResourceMonitorType `db:"type" json:"type"`
+	Path           string                            `db:"path" json:"path"`
+	UpdatedAt      time.Time                         `db:"updated_at" json:"updated_at"`
+	State          WorkspaceAgentMonitorState        `db:"state" json:"state"`
+	DebouncedUntil time.Time                         `db:"debounced_until" json:"debounced_until"`
+}
+
+func (q *sqlQuerier) UpdateResourceMonitor(ctx context.Context, arg UpdateResourceMonitorParams) error {
+	_, err := q.db.ExecContext(ctx, updateResourceMonitor,
+		arg.AgentID,
+		arg.Type,
+		arg.Path,
+		arg.UpdatedAt,
+		arg.State,
+		arg.DebouncedUntil,
+	)
+	return err
+}
+
 const updateVolumeResourceMonitor = `-- name: UpdateVolumeResourceMonitor :exec
 UPDATE workspace_agent_volume_resource_monitors
 SET
@@ -33177,302 +35401,402 @@ WITH
 		AND
 			latest_builds.max_build_number = wb.build_number
 		WHERE
-			-- Filter out the latest builds for each workspace.
+			-- Filter out the latest builds for each workspace.
+			latest_builds.workspace_id IS NULL
+		AND CASE
+			-- If the last time the agent connected was before @threshold
+			WHEN wa.last_connected_at IS NOT NULL THEN
+				 wa.last_connected_at < $1 :: timestamptz
+			-- The agent never connected, and was created before @threshold
+			ELSE wa.created_at < $1 :: timestamptz
+		END
+	)
+DELETE FROM workspace_agent_logs WHERE agent_id IN (SELECT id FROM old_agents)
+`
+
+// If an agent hasn't connected within the retention period, we purge its logs.
+// Exception: if the logs are related to the latest build, we keep those around.
+// Logs can take up a lot of space, so it's important we clean up frequently.
+func (q *sqlQuerier) DeleteOldWorkspaceAgentLogs(ctx context.Context, threshold time.Time) (int64, error) {
+	result, err := q.db.ExecContext(ctx, deleteOldWorkspaceAgentLogs, threshold)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected()
+}
+
+const deleteWorkspaceAgentLogsByIDs = `-- name: DeleteWorkspaceAgentLogsByIDs :execrows
+DELETE FROM workspace_agent_logs WHERE id = ANY($1 :: bigint[])
+`
+
+// Deletes workspace agent logs that were archived before being purged.
+func (q *sqlQuerier) DeleteWorkspaceAgentLogsByIDs(ctx context.Context, ids []int64) (int64, error) {
+	result, err := q.db.ExecContext(ctx, deleteWorkspaceAgentLogsByIDs, pq.Array(ids))
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected()
+}
+
+const deleteWorkspaceSubAgentByID = `-- name: DeleteWorkspaceSubAgentByID :exec
+WITH soft_deleted_agents AS (
+    UPDATE workspace_agents
+    SET deleted = TRUE
+    WHERE id = $1
+        AND parent_id IS NOT NULL
+        AND deleted = FALSE
+    RETURNING id
+), purged_context_resources AS (
+    DELETE FROM workspace_agent_context_resources
+    WHERE workspace_agent_id IN (SELECT id FROM soft_deleted_agents)
+)
+DELETE FROM workspace_agent_context_snapshots
+WHERE workspace_agent_id IN (SELECT id FROM soft_deleted_agents)
+`
+
+// Soft-deletes a single sub-agent (a child agent such as a devcontainer
+// agent). Called from the DeleteSubAgent RPC when a sub-agent is torn
+// down, which can happen mid-build without a full workspace rebuild.
+//
+// Agent context rows are hard-deleted for the same reason as in
+// SoftDeletePriorWorkspaceAgents: they only describe live agents, the
+// rebuild-time soft-delete queries skip already-deleted agents, and
+// agents are never hard-deleted, so the rows would otherwise orphan
+// forever.
+func (q *sqlQuerier) DeleteWorkspaceSubAgentByID(ctx context.Context, id uuid.UUID) error {
+	_, err := q.db.ExecContext(ctx, deleteWorkspaceSubAgentByID, id)
+	return err
+}
+
+const getAuthenticatedWorkspaceAgentAndBuildByAuthToken = `-- name: GetAuthenticatedWorkspaceAgentAndBuildByAuthToken :one
+SELECT
+	workspaces.id, workspaces.created_at, workspaces.updated_at, workspaces.owner_id, workspaces.organization_id, workspaces.template_id, workspaces.deleted, workspaces.name, workspaces.autostart_schedule, workspaces.ttl, workspaces.last_used_at, workspaces.dormant_at, workspaces.deleting_at, workspaces.automatic_updates, workspaces.favorite, workspaces.next_start_at, workspaces.group_acl, workspaces.user_acl,
+	workspace_agents.id, workspace_agents.created_at, workspace_agents.updated_at, workspace_agents.name, workspace_agents.first_connected_at, workspace_agents.last_connected_at, workspace_agents.disconnected_at, workspace_agents.resource_id, workspace_agents.auth_token, workspace_agents.auth_instance_id, workspace_agents.architecture, workspace_agents.environment_variables, workspace_agents.operating_system, workspace_agents.instance_metadata, workspace_agents.resource_metadata, workspace_agents.directory, workspace_agents.version, workspace_agents.last_connected_replica_id, workspace_agents.connection_timeout_seconds, workspace_agents.troubleshooting_url, workspace_agents.motd_file, workspace_agents.lifecycle_state, workspace_agents.expanded_directory, workspace_agents.logs_length, workspace_agents.logs_overflowed, workspace_agents.started_at, workspace_agents.ready_at, workspace_agents.subsystems, workspace_agents.display_apps, workspace_agents.api_version, workspace_agents.display_order, workspace_agents.parent_id, workspace_agents.api_key_scope, workspace_agents.deleted,
+	workspace_build_with_user.id, workspace_build_with_user.created_at, workspace_build_with_user.updated_at, workspace_build_with_user.workspace_id, workspace_build_with_user.template_version_id, workspace_build_with_user.build_number, workspace_build_with_user.transition, workspace_build_with_user.initiator_id, workspace_build_with_user.job_id, workspace_build_with_user.deadline, workspace_build_with_user.reason, workspace_build_with_user.daily_cost, workspace_build_with_user.max_deadline, workspace_build_with_user.template_version_preset_id, workspace_build_with_user.has_ai_task, workspace_build_with_user.has_external_agent, workspace_build_with_user.notified_autostop_deadline, workspace_build_with_user.initiator_by_avatar_url, workspace_build_with_user.initiator_by_username, workspace_build_with_user.initiator_by_name,
+	tasks.id AS task_id
+FROM
+	workspace_agents
+JOIN
+	workspace_resources
+ON
+	workspace_agents.resource_id = workspace_resources.id
+JOIN
+	workspace_build_with_user
+ON
+	workspace_resources.job_id = workspace_build_with_user.job_id
+JOIN
+	workspaces
+ON
+	workspace_build_with_user.workspace_id = workspaces.id
+LEFT JOIN
+	tasks
+ON
+	tasks.workspace_id = workspaces.id
+WHERE
+	-- This should only match 1 agent, so 1 returned row or 0.
+	workspace_agents.auth_token = $1::uuid
+	AND workspaces.deleted = FALSE
+	-- Filter out deleted sub agents.
+	AND workspace_agents.deleted = FALSE
+	-- Filter out builds that are not the latest, with exception for shutdown case.
+	-- Use CASE for short-circuiting: check normal case first (most common), then shutdown case.
+	AND CASE
+		-- Normal case: Agent's build is the latest build.
+		WHEN workspace_build_with_user.build_number = (
+			SELECT
+				MAX(build_number)
+			FROM
+				workspace_builds
+			WHERE
+				workspace_id = workspace_build_with_user.workspace_id
+		) THEN TRUE
+		-- Shutdown case: Agent from previous START build during STOP build execution.
+		WHEN workspace_build_with_user.transition = 'start'
+			-- Agent's START build job succeeded.
+			AND (SELECT job_status FROM provisioner_jobs WHERE id = workspace_build_with_user.job_id) = 'succeeded'
+			-- Latest build is a STOP build whose job is still active,
+			-- and agent's build is immediately previous.
+			AND EXISTS (
+				SELECT 1
+				FROM workspace_builds latest
+				JOIN provisioner_jobs pj ON pj.id = latest.job_id
+				WHERE latest.workspace_id = workspace_build_with_user.workspace_id
+				AND latest.build_number = workspace_build_with_user.build_number + 1
+				AND latest.build_number = (
+					SELECT MAX(build_number)
+					FROM workspace_builds l2
+					WHERE l2.workspace_id = latest.workspace_id
+				)
+				AND latest.transition = 'stop'
+				AND pj.job_status IN ('pending', 'running')
+			) THEN TRUE
+		ELSE FALSE
+	END
+`
+
+type GetAuthenticatedWorkspaceAgentAndBuildByAuthTokenRow struct {
+	WorkspaceTable WorkspaceTable `db:"workspace_table" json:"workspace_table"`
+	WorkspaceAgent WorkspaceAgent `db:"workspace_agent" json:"workspace_agent"`
+	WorkspaceBuild WorkspaceBuild `db:"workspace_build" json:"workspace_build"`
+	TaskID         uuid.NullUUID  `db:"task_id" json:"task_id"`
+}
+
+// GetAuthenticatedWorkspaceAgentAndBuildByAuthToken returns an authenticated
+// workspace agent and its associated build. During normal operation, this is
+// the latest build. During shutdown, this may be the previous START build while
+// the STOP build is executing, allowing shutdown scripts to authenticate (see
+// issue #19467).
+func (q *sqlQuerier) GetAuthenticatedWorkspaceAgentAndBuildByAuthToken(ctx context.Context, authToken uuid.UUID) (GetAuthenticatedWorkspaceAgentAndBuildByAuthTokenRow, error) {
+	row := q.db.QueryRowContext(ctx, getAuthenticatedWorkspaceAgentAndBuildByAuthToken, authToken)
+	var i GetAuthenticatedWorkspaceAgentAndBuildByAuthTokenRow
+	err := row.Scan(
+		&i.WorkspaceTable.ID,
+		&i.WorkspaceTable.CreatedAt,
+		&i.WorkspaceTable.UpdatedAt,
+		&i.WorkspaceTable.OwnerID,
+		&i.WorkspaceTable.OrganizationID,
+		&i.WorkspaceTable.TemplateID,
+		&i.WorkspaceTable.Deleted,
+		&i.WorkspaceTable.Name,
+		&i.WorkspaceTable.AutostartSchedule,
+		&i.WorkspaceTable.Ttl,
+		&i.WorkspaceTable.LastUsedAt,
+		&i.WorkspaceTable.DormantAt,
+		&i.WorkspaceTable.DeletingAt,
+		&i.WorkspaceTable.AutomaticUpdates,
+		&i.WorkspaceTable.Favorite,
+		&i.WorkspaceTable.NextStartAt,
+		&i.WorkspaceTable.GroupACL,
+		&i.WorkspaceTable.UserACL,
+		&i.WorkspaceAgent.ID,
+		&i.WorkspaceAgent.CreatedAt,
+		&i.WorkspaceAgent.UpdatedAt,
+		&i.WorkspaceAgent.Name,
+		&i.WorkspaceAgent.FirstConnectedAt,
+		&i.WorkspaceAgent.LastConnectedAt,
+		&i.WorkspaceAgent.DisconnectedAt,
+		&i.WorkspaceAgent.ResourceID,
+		&i.WorkspaceAgent.AuthToken,
+		&i.WorkspaceAgent.AuthInstanceID,
+		&i.WorkspaceAgent.Architecture,
+		&i.WorkspaceAgent.EnvironmentVariables,
+		&i.WorkspaceAgent.OperatingSystem,
+		&i.WorkspaceAgent.InstanceMetadata,
+		&i.WorkspaceAgent.ResourceMetadata,
+		&i.WorkspaceAgent.Directory,
+		&i.WorkspaceAgent.Version,
+		&i.WorkspaceAgent.LastConnectedReplicaID,
+		&i.WorkspaceAgent.ConnectionTimeoutSeconds,
+		&i.WorkspaceAgent.TroubleshootingURL,
+		&i.WorkspaceAgent.MOTDFile,
+		&i.WorkspaceAgent.LifecycleState,
+		&i.WorkspaceAgent.ExpandedDirectory,
+		&i.WorkspaceAgent.LogsLength,
+		&i.WorkspaceAgent.LogsOverflowed,
+		&i.WorkspaceAgent.StartedAt,
+		&i.WorkspaceAgent.ReadyAt,
+		pq.Array(&i.WorkspaceAgent.Subsystems),
+		pq.Array(&i.WorkspaceAgent.DisplayApps),
+		&i.WorkspaceAgent.APIVersion,
+		&i.WorkspaceAgent.DisplayOrder,
+		&i.WorkspaceAgent.ParentID,
+		&i.WorkspaceAgent.APIKeyScope,
+		&i.WorkspaceAgent.Deleted,
+		&i.WorkspaceBuild.ID,
+		&i.WorkspaceBuild.CreatedAt,
+		&i.WorkspaceBuild.UpdatedAt,
+		&i.WorkspaceBuild.WorkspaceID,
+		&i.WorkspaceBuild.TemplateVersionID,
+		&i.WorkspaceBuild.BuildNumber,
+		&i.WorkspaceBuild.Transition,
+		&i.WorkspaceBuild.InitiatorID,
+		&i.WorkspaceBuild.JobID,
+		&i.WorkspaceBuild.Deadline,
+		&i.WorkspaceBuild.Reason,
+		&i.WorkspaceBuild.DailyCost,
+		&i.WorkspaceBuild.MaxDeadline,
+		&i.WorkspaceBuild.TemplateVersionPresetID,
+		&i.WorkspaceBuild.HasAITask,
+		&i.WorkspaceBuild.HasExternalAgent,
+		&i.WorkspaceBuild.NotifiedAutostopDeadline,
+		&i.WorkspaceBuild.InitiatorByAvatarUrl,
+		&i.WorkspaceBuild.InitiatorByUsername,
+		&i.WorkspaceBuild.InitiatorByName,
+		&i.TaskID,
+	)
+	return i, err
+}
+
+const getExternalAgentTokensByTemplateID = `-- name: GetExternalAgentTokensByTemplateID :many
+SELECT
+	workspaces.id               AS workspace_id,
+	workspaces.name             AS workspace_name,
+	workspace_agents.id         AS agent_id,
+	workspace_agents.name       AS agent_name,
+	workspace_agents.auth_token AS agent_token
+FROM
+	workspaces
+JOIN (
+	-- latest build per workspace
+	SELECT DISTINCT ON (workspace_id)
+		id, workspace_id, job_id, transition, has_external_agent
+	FROM
+		workspace_builds
+	ORDER BY
+		workspace_id, build_number DESC
+) AS latest_builds
+ON
+	latest_builds.workspace_id = workspaces.id
+JOIN
+	provisioner_jobs
+ON
+	provisioner_jobs.id = latest_builds.job_id
+JOIN
+	workspace_resources
+ON
+	workspace_resources.job_id = latest_builds.job_id
+JOIN
+	workspace_agents
+ON
+	workspace_agents.resource_id = workspace_resources.id
+WHERE
+	workspaces.template_id = $1
+	AND (
+		$2 :: uuid = '00000000-0000-0000-0000-000000000000' :: uuid
+		OR workspaces.owner_id = $2
+	)
+	AND workspaces.deleted = FALSE
+	AND latest_builds.has_external_agent = TRUE
+	AND latest_builds.transition = 'start' :: workspace_transition
+	AND provisioner_jobs.job_status = 'succeeded' :: provisioner_job_status
+	AND workspace_agents.deleted = FALSE
+	AND workspace_agents.auth_instance_id IS NULL
+`
+
+type GetExternalAgentTokensByTemplateIDParams struct {
+	TemplateID uuid.UUID `db:"template_id" json:"template_id"`
+	OwnerID    uuid.UUID `db:"owner_id" json:"owner_id"`
+}
+
+type GetExternalAgentTokensByTemplateIDRow struct {
+	WorkspaceID   uuid.UUID `db:"workspace_id" json:"workspace_id"`
+	WorkspaceName string    `db:"workspace_name" json:"workspace_name"`
+	AgentID       uuid.UUID `db:"agent_id" json:"agent_id"`
+	AgentName     string    `db:"agent_name" json:"agent_name"`
+	AgentToken    uuid.UUID `db:"agent_token" json:"agent_token"`
+}
+
+// GetExternalAgentTokensByTemplateID returns the auth tokens for all
+// non-deleted external agents on the latest build of every running workspace
+// of the given template. "Running" means the latest build has
+// transition=start and job_status=succeeded (matches the workspace-status
+// definition used by coderd/database/queries/workspaces.sql).
+// An owner_id of '00000000-0000-0000-0000-000000000000' (uuid.Nil) means
+// "all owners"; any other value restricts results to workspaces owned by
+// that user.
+func (q *sqlQuerier) GetExternalAgentTokensByTemplateID(ctx context.Context, arg GetExternalAgentTokensByTemplateIDParams) ([]GetExternalAgentTokensByTemplateIDRow, error) {
+	rows, err := q.db.QueryContext(ctx, getExternalAgentTokensByTemplateID, arg.TemplateID, arg.OwnerID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []GetExternalAgentTokensByTemplateIDRow
+	for rows.Next() {
+		var i GetExternalAgentTokensByTemplateIDRow
+		if err := rows.Scan(
+			&i.WorkspaceID,
+			&i.WorkspaceName,
+			&i.AgentID,
+			&i.AgentName,
+			&i.AgentToken,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const getOldWorkspaceAgentLogsForArchive = `-- name: GetOldWorkspaceAgentLogsForArchive :many
+WITH
+	latest_builds AS (
+		SELECT
+			workspace_id, max(build_number) AS max_build_number
+		FROM
+			workspace_builds
+		GROUP BY
+			workspace_id
+	),
+	old_agents AS (
+		SELECT
+			wa.id
+		FROM
+			workspace_agents AS wa
+		JOIN
+			workspace_resources AS wr
+		ON
+			wa.resource_id = wr.id
+		JOIN
+			workspace_builds AS wb
+		ON
+			wb.job_id = wr.job_id
+		LEFT JOIN
+			latest_builds
+		ON
+			latest_builds.workspace_id = wb.workspace_id
+		AND
+			latest_builds.max_build_number = wb.build_number
+		WHERE
 			latest_builds.workspace_id IS NULL
 		AND CASE
-			-- If the last time the agent connected was before @threshold
 			WHEN wa.last_connected_at IS NOT NULL THEN
 				 wa.last_connected_at < $1 :: timestamptz
-			-- The agent never connected, and was created before @threshold
 			ELSE wa.created_at < $1 :: timestamptz
 		END
 	)
-DELETE FROM workspace_agent_logs WHERE agent_id IN (SELECT id FROM old_agents)
-`
-
-// If an agent hasn't connected within the retention period, we purge its logs.
-// Exception: if the logs are related to the latest build, we keep those around.
-// Logs can take up a lot of space, so it's important we clean up frequently.
-func (q *sqlQuerier) DeleteOldWorkspaceAgentLogs(ctx context.Context, threshold time.Time) (int64, error) {
-	result, err := q.db.ExecContext(ctx, deleteOldWorkspaceAgentLogs, threshold)
-	if err != nil {
-		return 0, err
-	}
-	return result.RowsAffected()
-}
-
-const deleteWorkspaceSubAgentByID = `-- name: DeleteWorkspaceSubAgentByID :exec
-WITH soft_deleted_agents AS (
-    UPDATE workspace_agents
-    SET deleted = TRUE
-    WHERE id = $1
-        AND parent_id IS NOT NULL
-        AND deleted = FALSE
-    RETURNING id
-), purged_context_resources AS (
-    DELETE FROM workspace_agent_context_resources
-    WHERE workspace_agent_id IN (SELECT id FROM soft_deleted_agents)
-)
-DELETE FROM workspace_agent_context_snapshots
-WHERE workspace_agent_id IN (SELECT id FROM soft_deleted_agents)
-`
-
-// Soft-deletes a single sub-agent (a child agent such as a devcontainer
-// agent). Called from the DeleteSubAgent RPC when a sub-agent is torn
-// down, which can happen mid-build without a full workspace rebuild.
-//
-// Agent context rows are hard-deleted for the same reason as in
-// SoftDeletePriorWorkspaceAgents: they only describe live agents, the
-// rebuild-time soft-delete queries skip already-deleted agents, and
-// agents are never hard-deleted, so the rows would otherwise orphan
-// forever.
-func (q *sqlQuerier) DeleteWorkspaceSubAgentByID(ctx context.Context, id uuid.UUID) error {
-	_, err := q.db.ExecContext(ctx, deleteWorkspaceSubAgentByID, id)
-	return err
-}
-
-const getAuthenticatedWorkspaceAgentAndBuildByAuthToken = `-- name: GetAuthenticatedWorkspaceAgentAndBuildByAuthToken :one
 SELECT
-	workspaces.id, workspaces.created_at, workspaces.updated_at, workspaces.owner_id, workspaces.organization_id, workspaces.template_id, workspaces.deleted, workspaces.name, workspaces.autostart_schedule, workspaces.ttl, workspaces.last_used_at, workspaces.dormant_at, workspaces.deleting_at, workspaces.automatic_updates, workspaces.favorite, workspaces.next_start_at, workspaces.group_acl, workspaces.user_acl,
-	workspace_agents.id, workspace_agents.created_at, workspace_agents.updated_at, workspace_agents.name, workspace_agents.first_connected_at, workspace_agents.last_connected_at, workspace_agents.disconnected_at, workspace_agents.resource_id, workspace_agents.auth_token, workspace_agents.auth_instance_id, workspace_agents.architecture, workspace_agents.environment_variables, workspace_agents.operating_system, workspace_agents.instance_metadata, workspace_agents.resource_metadata, workspace_agents.directory, workspace_agents.version, workspace_agents.last_connected_replica_id, workspace_agents.connection_timeout_seconds, workspace_agents.troubleshooting_url, workspace_agents.motd_file, workspace_agents.lifecycle_state, workspace_agents.expanded_directory, workspace_agents.logs_length, workspace_agents.logs_overflowed, workspace_agents.started_at, workspace_agents.ready_at, workspace_agents.subsystems, workspace_agents.display_apps, workspace_agents.api_version, workspace_agents.display_order, workspace_agents.parent_id, workspace_agents.api_key_scope, workspace_agents.deleted,
-	workspace_build_with_user.id, workspace_build_with_user.created_at, workspace_build_with_user.updated_at, workspace_build_with_user.workspace_id, workspace_build_with_user.template_version_id, workspace_build_with_user.build_number, workspace_build_with_user.transition, workspace_build_with_user.initiator_id, workspace_build_with_user.job_id, workspace_build_with_user.deadline, workspace_build_with_user.reason, workspace_build_with_user.daily_cost, workspace_build_with_user.max_deadline, workspace_build_with_user.template_version_preset_id, workspace_build_with_user.has_ai_task, workspace_build_with_user.has_external_agent, workspace_build_with_user.notified_autostop_deadline, workspace_build_with_user.initiator_by_avatar_url, workspace_build_with_user.initiator_by_username, workspace_build_with_user.initiator_by_name,
-	tasks.id AS task_id
+	workspace_agent_logs.id,
+	to_jsonb(workspace_agent_logs) AS record
 FROM
-	workspace_agents
-JOIN
-	workspace_resources
-ON
-	workspace_agents.resource_id = workspace_resources.id
-JOIN
-	workspace_build_with_user
-ON
-	workspace_resources.job_id = workspace_build_with_user.job_id
-JOIN
-	workspaces
-ON
-	workspace_build_with_user.workspace_id = workspaces.id
-LEFT JOIN
-	tasks
-ON
-	tasks.workspace_id = workspaces.id
-WHERE
-	-- This should only match 1 agent, so 1 returned row or 0.
-	workspace_agents.auth_token = $1::uuid
-	AND workspaces.deleted = FALSE
-	-- Filter out deleted sub agents.
-	AND workspace_agents.deleted = FALSE
-	-- Filter out builds that are not the latest, with exception for shutdown case.
-	-- Use CASE for short-circuiting: check normal case first (most common), then shutdown case.
-	AND CASE
-		-- Normal case: Agent's build is the latest build.
-		WHEN workspace_build_with_user.build_number = (
-			SELECT
-				MAX(build_number)
-			FROM
-				workspace_builds
-			WHERE
-				workspace_id = workspace_build_with_user.workspace_id
-		) THEN TRUE
-		-- Shutdown case: Agent from previous START build during STOP build execution.
-		WHEN workspace_build_with_user.transition = 'start'
-			-- Agent's START build job succeeded.
-			AND (SELECT job_status FROM provisioner_jobs WHERE id = workspace_build_with_user.job_id) = 'succeeded'
-			-- Latest build is a STOP build whose job is still active,
-			-- and agent's build is immediately previous.
-			AND EXISTS (
-				SELECT 1
-				FROM workspace_builds latest
-				JOIN provisioner_jobs pj ON pj.id = latest.job_id
-				WHERE latest.workspace_id = workspace_build_with_user.workspace_id
-				AND latest.build_number = workspace_build_with_user.build_number + 1
-				AND latest.build_number = (
-					SELECT MAX(build_number)
-					FROM workspace_builds l2
-					WHERE l2.workspace_id = latest.workspace_id
-				)
-				AND latest.transition = 'stop'
-				AND pj.job_status IN ('pending', 'running')
-			) THEN TRUE
-		ELSE FALSE
-	END
-`
-
-type GetAuthenticatedWorkspaceAgentAndBuildByAuthTokenRow struct {
-	WorkspaceTable WorkspaceTable `db:"workspace_table" json:"workspace_table"`
-	WorkspaceAgent WorkspaceAgent `db:"workspace_agent" json:"workspace_agent"`
-	WorkspaceBuild WorkspaceBuild `db:"workspace_build" json:"workspace_build"`
-	TaskID         uuid.NullUUID  `db:"task_id" json:"task_id"`
-}
-
-// GetAuthenticatedWorkspaceAgentAndBuildByAuthToken returns an authenticated
-// workspace agent and its associated build. During normal operation, this is
-// the latest build. During shutdown, this may be the previous START build while
-// the STOP build is executing, allowing shutdown scripts to authenticate (see
-// issue #19467).
-func (q *sqlQuerier) GetAuthenticatedWorkspaceAgentAndBuildByAuthToken(ctx context.Context, authToken uuid.UUID) (GetAuthenticatedWorkspaceAgentAndBuildByAuthTokenRow, error) {
-	row := q.db.QueryRowContext(ctx, getAuthenticatedWorkspaceAgentAndBuildByAuthToken, authToken)
-	var i GetAuthenticatedWorkspaceAgentAndBuildByAuthTokenRow
-	err := row.Scan(
-		&i.WorkspaceTable.ID,
-		&i.WorkspaceTable.CreatedAt,
-		&i.WorkspaceTable.UpdatedAt,
-		&i.WorkspaceTable.OwnerID,
-		&i.WorkspaceTable.OrganizationID,
-		&i.WorkspaceTable.TemplateID,
-		&i.WorkspaceTable.Deleted,
-		&i.WorkspaceTable.Name,
-		&i.WorkspaceTable.AutostartSchedule,
-		&i.WorkspaceTable.Ttl,
-		&i.WorkspaceTable.LastUsedAt,
-		&i.WorkspaceTable.DormantAt,
-		&i.WorkspaceTable.DeletingAt,
-		&i.WorkspaceTable.AutomaticUpdates,
-		&i.WorkspaceTable.Favorite,
-		&i.WorkspaceTable.NextStartAt,
-		&i.WorkspaceTable.GroupACL,
-		&i.WorkspaceTable.UserACL,
-		&i.WorkspaceAgent.ID,
-		&i.WorkspaceAgent.CreatedAt,
-		&i.WorkspaceAgent.UpdatedAt,
-		&i.WorkspaceAgent.Name,
-		&i.WorkspaceAgent.FirstConnectedAt,
-		&i.WorkspaceAgent.LastConnectedAt,
-		&i.WorkspaceAgent.DisconnectedAt,
-		&i.WorkspaceAgent.ResourceID,
-		&i.WorkspaceAgent.AuthToken,
-		&i.WorkspaceAgent.AuthInstanceID,
-		&i.WorkspaceAgent.Architecture,
-		&i.WorkspaceAgent.EnvironmentVariables,
-		&i.WorkspaceAgent.OperatingSystem,
-		&i.WorkspaceAgent.InstanceMetadata,
-		&i.WorkspaceAgent.ResourceMetadata,
-		&i.WorkspaceAgent.Directory,
-		&i.WorkspaceAgent.Version,
-		&i.WorkspaceAgent.LastConnectedReplicaID,
-		&i.WorkspaceAgent.ConnectionTimeoutSeconds,
-		&i.WorkspaceAgent.TroubleshootingURL,
-		&i.WorkspaceAgent.MOTDFile,
-		&i.WorkspaceAgent.LifecycleState,
-		&i.WorkspaceAgent.ExpandedDirectory,
-		&i.WorkspaceAgent.LogsLength,
-		&i.WorkspaceAgent.LogsOverflowed,
-		&i.WorkspaceAgent.StartedAt,
-		&i.WorkspaceAgent.ReadyAt,
-		pq.Array(&i.WorkspaceAgent.Subsystems),
-		pq.Array(&i.WorkspaceAgent.DisplayApps),
-		&i.WorkspaceAgent.APIVersion,
-		&i.WorkspaceAgent.DisplayOrder,
-		&i.WorkspaceAgent.ParentID,
-		&i.WorkspaceAgent.APIKeyScope,
-		&i.WorkspaceAgent.Deleted,
-		&i.WorkspaceBuild.ID,
-		&i.WorkspaceBuild.CreatedAt,
-		&i.WorkspaceBuild.UpdatedAt,
-		&i.WorkspaceBuild.WorkspaceID,
-		&i.WorkspaceBuild.TemplateVersionID,
-		&i.WorkspaceBuild.BuildNumber,
-		&i.WorkspaceBuild.Transition,
-		&i.WorkspaceBuild.InitiatorID,
-		&i.WorkspaceBuild.JobID,
-		&i.WorkspaceBuild.Deadline,
-		&i.WorkspaceBuild.Reason,
-		&i.WorkspaceBuild.DailyCost,
-		&i.WorkspaceBuild.MaxDeadline,
-		&i.WorkspaceBuild.TemplateVersionPresetID,
-		&i.WorkspaceBuild.HasAITask,
-		&i.WorkspaceBuild.HasExternalAgent,
-		&i.WorkspaceBuild.NotifiedAutostopDeadline,
-		&i.WorkspaceBuild.InitiatorByAvatarUrl,
-		&i.WorkspaceBuild.InitiatorByUsername,
-		&i.WorkspaceBuild.InitiatorByName,
-		&i.TaskID,
-	)
-	return i, err
-}
-
-const getExternalAgentTokensByTemplateID = `-- name: GetExternalAgentTokensByTemplateID :many
-SELECT
-	workspaces.id               AS workspace_id,
-	workspaces.name             AS workspace_name,
-	workspace_agents.id         AS agent_id,
-	workspace_agents.name       AS agent_name,
-	workspace_agents.auth_token AS agent_token
-FROM
-	workspaces
-JOIN (
-	-- latest build per workspace
-	SELECT DISTINCT ON (workspace_id)
-		id, workspace_id, job_id, transition, has_external_agent
-	FROM
-		workspace_builds
-	ORDER BY
-		workspace_id, build_number DESC
-) AS latest_builds
-ON
-	latest_builds.workspace_id = workspaces.id
-JOIN
-	provisioner_jobs
-ON
-	provisioner_jobs.id = latest_builds.job_id
-JOIN
-	workspace_resources
-ON
-	workspace_resources.job_id = latest_builds.job_id
-JOIN
-	workspace_agents
-ON
-	workspace_agents.resource_id = workspace_resources.id
+	workspace_agent_logs
 WHERE
-	workspaces.template_id = $1
-	AND (
-		$2 :: uuid = '00000000-0000-0000-0000-000000000000' :: uuid
-		OR workspaces.owner_id = $2
-	)
-	AND workspaces.deleted = FALSE
-	AND latest_builds.has_external_agent = TRUE
-	AND latest_builds.transition = 'start' :: workspace_transition
-	AND provisioner_jobs.job_status = 'succeeded' :: provisioner_job_status
-	AND workspace_agents.deleted = FALSE
-	AND workspace_agents.auth_instance_id IS NULL
+	agent_id IN (SELECT id FROM old_agents)
+	AND workspace_agent_logs.id > $2 :: bigint
+ORDER BY
+	workspace_agent_logs.id ASC
+LIMIT
+	$3
 `
 
-type GetExternalAgentTokensByTemplateIDParams struct {
-	TemplateID uuid.UUID `db:"template_id" json:"template_id"`
-	OwnerID    uuid.UUID `db:"owner_id" json:"owner_id"`
+type GetOldWorkspaceAgentLogsForArchiveParams struct {
+	Threshold  time.Time `db:"threshold" json:"threshold"`
+	AfterID    int64     `db:"after_id" json:"after_id"`
+	LimitCount int32     `db:"limit_count" json:"limit_count"`
 }
 
-type GetExternalAgentTokensByTemplateIDRow struct {
-	WorkspaceID   uuid.UUID `db:"workspace_id" json:"workspace_id"`
-	WorkspaceName string    `db:"workspace_name" json:"workspace_name"`
-	AgentID       uuid.UUID `db:"agent_id" json:"agent_id"`
-	AgentName     string    `db:"agent_name" json:"agent_name"`
-	AgentToken    uuid.UUID `db:"agent_token" json:"agent_token"`
+type GetOldWorkspaceAgentLogsForArchiveRow struct {
+	ID     int64           `db:"id" json:"id"`
+	Record json.RawMessage `db:"record" json:"record"`
 }
 
-// GetExternalAgentTokensByTemplateID returns the auth tokens for all
-// non-deleted external agents on the latest build of every running workspace
-// of the given template. "Running" means the latest build has
-// transition=start and job_status=succeeded (matches the workspace-status
-// definition used by coderd/database/queries/workspaces.sql).
-// An owner_id of '00000000-0000-0000-0000-000000000000' (uuid.Nil) means
-// "all owners"; any other value restricts results to workspaces owned by
-// that user.
-func (q *sqlQuerier) GetExternalAgentTokensByTemplateID(ctx context.Context, arg GetExternalAgentTokensByTemplateIDParams) ([]GetExternalAgentTokensByTemplateIDRow, error) {
-	rows, err := q.db.QueryContext(ctx, getExternalAgentTokensByTemplateID, arg.TemplateID, arg.OwnerID)
+// Returns a page of the logs that DeleteOldWorkspaceAgentLogs will delete with
+// the same threshold, as JSON, so they can be archived first.
+func (q *sqlQuerier) GetOldWorkspaceAgentLogsForArchive(ctx context.Context, arg GetOldWorkspaceAgentLogsForArchiveParams) ([]GetOldWorkspaceAgentLogsForArchiveRow, error) {
+	rows, err := q.db.QueryContext(ctx, getOldWorkspaceAgentLogsForArchive, arg.Threshold, arg.AfterID, arg.LimitCount)
 	if err != nil {
 		return nil, err
 	}
 	defer rows.Close()
-	var items []GetExternalAgentTokensByTemplateIDRow
+	var items []GetOldWorkspaceAgentLogsForArchiveRow
 	for rows.Next() {
-		var i GetExternalAgentTokensByTemplateIDRow
-		if err := rows.Scan(
-			&i.WorkspaceID,
-			&i.WorkspaceName,
-			&i.AgentID,
-			&i.AgentName,
-			&i.AgentToken,
-		); err != nil {
+		var i GetOldWorkspaceAgentLogsForArchiveRow
+		if err := rows.Scan(&i.ID, &i.Record); err != nil {
 			return nil, err
 		}
 		items = append(items, i)
@@ -33689,6 +36013,39 @@ func (q *sqlQuerier) GetWorkspaceAgentLogSourcesByAgentIDs(ctx context.Context,
 	return items, nil
 }
 
+const getWorkspaceAgentLogTailsByAgentIDs = `-- name: GetWorkspaceAgentLogTailsByAgentIDs :many
+SELECT workspace_agent_id, log_source_id, created_at, paths, journald_units FROM workspace_agent_log_tails WHERE workspace_agent_id = ANY($1 :: uuid [ ])
+`
+
+func (q *sqlQuerier) GetWorkspaceAgentLogTailsByAgentIDs(ctx context.Context, ids []uuid.UUID) ([]WorkspaceAgentLogTail, error) {
+	rows, err := q.db.QueryContext(ctx, getWorkspaceAgentLogTailsByAgentIDs, pq.Array(ids))
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []WorkspaceAgentLogTail
+	for rows.Next() {
+		var i WorkspaceAgentLogTail
+		if err := rows.Scan(
+			&i.WorkspaceAgentID,
+			&i.LogSourceID,
+			&i.CreatedAt,
+			pq.Array(&i.Paths),
+			pq.Array(&i.JournaldUnits),
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
 const getWorkspaceAgentLogsAfter = `-- name: GetWorkspaceAgentLogsAfter :many
 SELECT
 	agent_id, created_at, output, id, level, log_source_id
@@ -34756,6 +37113,41 @@ func (q *sqlQuerier) InsertWorkspaceAgentLogSources(ctx context.Context, arg Ins
 	return items, nil
 }
 
+const insertWorkspaceAgentLogTail = `-- name: InsertWorkspaceAgentLogTail :one
+INSERT INTO
+	workspace_agent_log_tails (workspace_agent_id, log_source_id, created_at, paths, journald_units)
+VALUES
+	($1, $2, $3, $4, $5)
+RETURNING workspace_agent_id, log_source_id, created_at, paths, journald_units
+`
+
+type InsertWorkspaceAgentLogTailParams struct {
+	WorkspaceAgentID uuid.UUID `db:"workspace_agent_id" json:"workspace_agent_id"`
+	LogSourceID      uuid.UUID `db:"log_source_id" json:"log_source_id"`
+	CreatedAt        time.Time `db:"created_at" json:"created_at"`
+	Paths            []string  `db:"paths" json:"paths"`
+	JournaldUnits    []string  `db:"journald_units" json:"journald_units"`
+}
+
+func (q *sqlQuerier) InsertWorkspaceAgentLogTail(ctx context.Context, arg InsertWorkspaceAgentLogTailParams) (WorkspaceAgentLogTail, error) {
+	row := q.db.QueryRowContext(ctx, insertWorkspaceAgentLogTail,
+		arg.WorkspaceAgentID,
+		arg.LogSourceID,
+		arg.CreatedAt,
+		pq.Array(arg.Paths),
+		pq.Array(arg.JournaldUnits),
+	)
+	var i WorkspaceAgentLogTail
+	err := row.Scan(
+		&i.WorkspaceAgentID,
+		&i.LogSourceID,
+		&i.CreatedAt,
+		pq.Array(&i.Paths),
+		pq.Array(&i.JournaldUnits),
+	)
+	return i, err
+}
+
 const insertWorkspaceAgentLogs = `-- name: InsertWorkspaceAgentLogs :many
 WITH new_length AS (
 	UPDATE workspace_agents SET
@@ -36002,7 +38394,7 @@ func (q *sqlQuerier) GetLatestWorkspaceAppStatusesByWorkspaceIDs(ctx context.Con
 }
 
 const getWorkspaceAppByAgentIDAndSlug = `-- name: GetWorkspaceAppByAgentIDAndSlug :one
-SELECT id, created_at, agent_id, display_name, icon, command, url, healthcheck_url, healthcheck_interval, healthcheck_threshold, health, subdomain, sharing_level, slug, external, display_order, hidden, open_in, display_group, tooltip FROM workspace_apps WHERE agent_id = $1 AND slug = $2
+SELECT id, created_at, agent_id, display_name, icon, command, url, healthcheck_url, healthcheck_interval, healthcheck_threshold, health, subdomain, sharing_level, slug, external, display_order, hidden, open_in, display_group, tooltip, healthcheck_address, healthcheck_command FROM workspace_apps WHERE agent_id = $1 AND slug = $2
 `
 
 type GetWorkspaceAppByAgentIDAndSlugParams struct {
@@ -36034,6 +38426,8 @@ func (q *sqlQuerier) GetWorkspaceAppByAgentIDAndSlug(ctx context.Context, arg Ge
 		&i.OpenIn,
 		&i.DisplayGroup,
 		&i.Tooltip,
+		&i.HealthcheckAddress,
+		&i.HealthcheckCommand,
 	)
 	return i, err
 }
@@ -36076,7 +38470,7 @@ func (q *sqlQuerier) GetWorkspaceAppStatusesByAppIDs(ctx context.Context, ids []
 }
 
 const getWorkspaceAppsByAgentID = `-- name: GetWorkspaceAppsByAgentID :many
-SELECT id, created_at, agent_id, display_name, icon, command, url, healthcheck_url, healthcheck_interval, healthcheck_threshold, health, subdomain, sharing_level, slug, external, display_order, hidden, open_in, display_group, tooltip FROM workspace_apps WHERE agent_id = $1 ORDER BY slug ASC
+SELECT id, created_at, agent_id, display_name, icon, command, url, healthcheck_url, healthcheck_interval, healthcheck_threshold, health, subdomain, sharing_level, slug, external, display_order, hidden, open_in, display_group, tooltip, healthcheck_address, healthcheck_command FROM workspace_apps WHERE agent_id = $1 ORDER BY slug ASC
 `
 
 func (q *sqlQuerier) GetWorkspaceAppsByAgentID(ctx context.Context, agentID uuid.UUID) ([]WorkspaceApp, error) {
@@ -36109,6 +38503,8 @@ func (q *sqlQuerier) GetWorkspaceAppsByAgentID(ctx context.Context, agentID uuid
 			&i.OpenIn,
 			&i.DisplayGroup,
 			&i.Tooltip,
+			&i.HealthcheckAddress,
+			&i.HealthcheckCommand,
 		); err != nil {
 			return nil, err
 		}
@@ -36124,7 +38520,7 @@ func (q *sqlQuerier) GetWorkspaceAppsByAgentID(ctx context.Context, agentID uuid
 }
 
 const getWorkspaceAppsByAgentIDs = `-- name: GetWorkspaceAppsByAgentIDs :many
-SELECT id, created_at, agent_id, display_name, icon, command, url, healthcheck_url, healthcheck_interval, healthcheck_threshold, health, subdomain, sharing_level, slug, external, display_order, hidden, open_in, display_group, tooltip FROM workspace_apps WHERE agent_id = ANY($1 :: uuid [ ]) ORDER BY slug ASC
+SELECT id, created_at, agent_id, display_name, icon, command, url, healthcheck_url, healthcheck_interval, healthcheck_threshold, health, subdomain, sharing_level, slug, external, display_order, hidden, open_in, display_group, tooltip, healthcheck_address, healthcheck_command FROM workspace_apps WHERE agent_id = ANY($1 :: uuid [ ]) ORDER BY slug ASC
 `
 
 func (q *sqlQuerier) GetWorkspaceAppsByAgentIDs(ctx context.Context, ids []uuid.UUID) ([]WorkspaceApp, error) {
@@ -36157,6 +38553,8 @@ func (q *sqlQuerier) GetWorkspaceAppsByAgentIDs(ctx context.Context, ids []uuid.
 			&i.OpenIn,
 			&i.DisplayGroup,
 			&i.Tooltip,
+			&i.HealthcheckAddress,
+			&i.HealthcheckCommand,
 		); err != nil {
 			return nil, err
 		}
@@ -36172,7 +38570,7 @@ func (q *sqlQuerier) GetWorkspaceAppsByAgentIDs(ctx context.Context, ids []uuid.
 }
 
 const getWorkspaceAppsCreatedAfter = `-- name: GetWorkspaceAppsCreatedAfter :many
-SELECT id, created_at, agent_id, display_name, icon, command, url, healthcheck_url, healthcheck_interval, healthcheck_threshold, health, subdomain, sharing_level, slug, external, display_order, hidden, open_in, display_group, tooltip FROM workspace_apps WHERE created_at > $1 ORDER BY slug ASC
+SELECT id, created_at, agent_id, display_name, icon, command, url, healthcheck_url, healthcheck_interval, healthcheck_threshold, health, subdomain, sharing_level, slug, external, display_order, hidden, open_in, display_group, tooltip, healthcheck_address, healthcheck_command FROM workspace_apps WHERE created_at > $1 ORDER BY slug ASC
 `
 
 func (q *sqlQuerier) GetWorkspaceAppsCreatedAfter(ctx context.Context, createdAt time.Time) ([]WorkspaceApp, error) {
@@ -36205,6 +38603,8 @@ func (q *sqlQuerier) GetWorkspaceAppsCreatedAfter(ctx context.Context, createdAt
 			&i.OpenIn,
 			&i.DisplayGroup,
 			&i.Tooltip,
+			&i.HealthcheckAddress,
+			&i.HealthcheckCommand,
 		); err != nil {
 			return nil, err
 		}
@@ -36302,10 +38702,12 @@ INSERT INTO
         hidden,
         open_in,
         display_group,
-        tooltip
+        tooltip,
+        healthcheck_address,
+        healthcheck_command
     )
 VALUES
-    ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12, $13, $14, $15, $16, $17, $18, $19, $20)
+    ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12, $13, $14, $15, $16, $17, $18, $19, $20, $21, $22)
 ON CONFLICT (id) DO UPDATE SET
     display_name = EXCLUDED.display_name,
     icon = EXCLUDED.icon,
@@ -36324,7 +38726,9 @@ ON CONFLICT (id) DO UPDATE SET
     display_group = EXCLUDED.display_group,
     agent_id = EXCLUDED.agent_id,
     slug = EXCLUDED.slug,
-    tooltip = EXCLUDED.tooltip
+    tooltip = EXCLUDED.tooltip,
+    healthcheck_address = EXCLUDED.healthcheck_address,
+    healthcheck_command = EXCLUDED.healthcheck_command
 WHERE
     -- Prevent cross-tenant/cross-workspace agent rebinding (SEC-91).
     -- App IDs persist across builds of the same workspace, but agent IDs are
@@ -36361,7 +38765,7 @@ WHERE
             existing_agent.id = workspace_apps.agent_id
             AND existing_build.workspace_id = incoming_build.workspace_id
     )
-RETURNING id, created_at, agent_id, display_name, icon, command, url, healthcheck_url, healthcheck_interval, healthcheck_threshold, health, subdomain, sharing_level, slug, external, display_order, hidden, open_in, display_group, tooltip
+RETURNING id, created_at, agent_id, display_name, icon, command, url, healthcheck_url, healthcheck_interval, healthcheck_threshold, health, subdomain, sharing_level, slug, external, display_order, hidden, open_in, display_group, tooltip, healthcheck_address, healthcheck_command
 `
 
 type UpsertWorkspaceAppParams struct {
@@ -36385,6 +38789,8 @@ type UpsertWorkspaceAppParams struct {
 	OpenIn               WorkspaceAppOpenIn `db:"open_in" json:"open_in"`
 	DisplayGroup         sql.NullString     `db:"display_group" json:"display_group"`
 	Tooltip              string             `db:"tooltip" json:"tooltip"`
+	HealthcheckAddress   string             `db:"healthcheck_address" json:"healthcheck_address"`
+	HealthcheckCommand   string             `db:"healthcheck_command" json:"healthcheck_command"`
 }
 
 func (q *sqlQuerier) UpsertWorkspaceApp(ctx context.Context, arg UpsertWorkspaceAppParams) (WorkspaceApp, error) {
@@ -36409,6 +38815,8 @@ func (q *sqlQuerier) UpsertWorkspaceApp(ctx context.Context, arg UpsertWorkspace
 		arg.OpenIn,
 		arg.DisplayGroup,
 		arg.Tooltip,
+		arg.HealthcheckAddress,
+		arg.HealthcheckCommand,
 	)
 	var i WorkspaceApp
 	err := row.Scan(
@@ -36432,6 +38840,8 @@ func (q *sqlQuerier) UpsertWorkspaceApp(ctx context.Context, arg UpsertWorkspace
 		&i.OpenIn,
 		&i.DisplayGroup,
 		&i.Tooltip,
+		&i.HealthcheckAddress,
+		&i.HealthcheckCommand,
 	)
 	return i, err
 }
@@ -37887,6 +40297,774 @@ func (q *sqlQuerier) UpdateWorkspaceBuildProvisionerStateByID(ctx context.Contex
 	return err
 }
 
+const acquireWorkspaceBulkOperationWorkspaces = `-- name: AcquireWorkspaceBulkOperationWorkspaces :many
+WITH running AS (
+	SELECT
+		operation_id,
+		COUNT(*) AS count
+	FROM
+		workspace_bulk_operation_workspaces
+	WHERE
+		status = 'running'::workspace_bulk_operation_workspace_status
+		AND updated_at >= $1 :: timestamptz
+	GROUP BY
+		operation_id
+), candidates AS (
+	SELECT
+		workspace_bulk_operation_workspaces.operation_id,
+		workspace_bulk_operation_workspaces.workspace_id,
+		ROW_NUMBER() OVER (
+			PARTITION BY workspace_bulk_operation_workspaces.operation_id
+			ORDER BY workspace_bulk_operation_workspaces.workspace_id
+		) AS position,
+		workspace_bulk_operations.concurrency - COALESCE(running.count, 0) AS available
+	FROM
+		workspace_bulk_operation_workspaces
+	JOIN
+		workspace_bulk_operations ON workspace_bulk_operations.id = workspace_bulk_operation_workspaces.operation_id
+	LEFT JOIN
+		running ON running.operation_id = workspace_bulk_operation_workspaces.operation_id
+	WHERE
+		workspace_bulk_operations.status = 'running'::workspace_bulk_operation_status
+		AND (
+			workspace_bulk_operation_workspaces.status = 'pending'::workspace_bulk_operation_workspace_status
+			OR (
+				workspace_bulk_operation_workspaces.status = 'running'::workspace_bulk_operation_workspace_status
+				AND workspace_bulk_operation_workspaces.updated_at < $1 :: timestamptz
+			)
+		)
+)
+UPDATE
+	workspace_bulk_operation_workspaces
+SET
+	status = 'running'::workspace_bulk_operation_workspace_status,
+	updated_at = $2 :: timestamptz
+FROM
+	candidates
+WHERE
+	workspace_bulk_operation_workspaces.operation_id = candidates.operation_id
+	AND workspace_bulk_operation_workspaces.workspace_id = candidates.workspace_id
+	AND candidates.position <= candidates.available
+RETURNING
+	workspace_bulk_operation_workspaces.operation_id, workspace_bulk_operation_workspaces.workspace_id, workspace_bulk_operation_workspaces.status, workspace_bulk_operation_workspaces.build_id, workspace_bulk_operation_workspaces.error, workspace_bulk_operation_workspaces.updated_at
+`
+
+type AcquireWorkspaceBulkOperationWorkspacesParams struct {
+	StaleBefore time.Time `db:"stale_before" json:"stale_before"`
+	Now         time.Time `db:"now" json:"now"`
+}
+
+// Marks the next pending workspaces of every running operation as running, so
+// that no more than the concurrency of the operation are running at a time.
+// Workspaces that have been running without an update since stale_before are
+// acquired again, since the replica that processed them may have stopped.
+// Callers must hold the LockIDWorkspaceBulkOperations lock so that concurrent
+// callers do not exceed the concurrency of an operation.
+func (q *sqlQuerier) AcquireWorkspaceBulkOperationWorkspaces(ctx context.Context, arg AcquireWorkspaceBulkOperationWorkspacesParams) ([]WorkspaceBulkOperationWorkspace, error) {
+	rows, err := q.db.QueryContext(ctx, acquireWorkspaceBulkOperationWorkspaces, arg.StaleBefore, arg.Now)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []WorkspaceBulkOperationWorkspace
+	for rows.Next() {
+		var i WorkspaceBulkOperationWorkspace
+		if err := rows.Scan(
+			&i.OperationID,
+			&i.WorkspaceID,
+			&i.Status,
+			&i.BuildID,
+			&i.Error,
+			&i.UpdatedAt,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const getWorkspaceBulkOperationByID = `-- name: GetWorkspaceBulkOperationByID :one
+SELECT
+	id, initiator_id, action, query, autostart_schedule, ttl_ms, automatic_updates, concurrency, workspace_count, status, created_at, updated_at, completed_at, api_key_scopes, api_key_allow_list
+FROM
+	workspace_bulk_operations
+WHERE
+	id = $1
+`
+
+func (q *sqlQuerier) GetWorkspaceBulkOperationByID(ctx context.Context, id uuid.UUID) (WorkspaceBulkOperation, error) {
+	row := q.db.QueryRowContext(ctx, getWorkspaceBulkOperationByID, id)
+	var i WorkspaceBulkOperation
+	err := row.Scan(
+		&i.ID,
+		&i.InitiatorID,
+		&i.Action,
+		&i.Query,
+		&i.AutostartSchedule,
+		&i.TTLMillis,
+		&i.AutomaticUpdates,
+		&i.Concurrency,
+		&i.WorkspaceCount,
+		&i.Status,
+		&i.CreatedAt,
+		&i.UpdatedAt,
+		&i.CompletedAt,
+		&i.APIKeyScopes,
+		&i.APIKeyAllowList,
+	)
+	return i, err
+}
+
+const getWorkspaceBulkOperationProgress = `-- name: GetWorkspaceBulkOperationProgress :one
+SELECT
+	COUNT(*) FILTER (WHERE status = 'pending'::workspace_bulk_operation_workspace_status) AS pending,
+	COUNT(*) FILTER (WHERE status = 'running'::workspace_bulk_operation_workspace_status) AS running,
+	COUNT(*) FILTER (WHERE status = 'succeeded'::workspace_bulk_operation_workspace_status) AS succeeded,
+	COUNT(*) FILTER (WHERE status = 'failed'::workspace_bulk_operation_workspace_status) AS failed,
+	COUNT(*) FILTER (WHERE status = 'skipped'::workspace_bulk_operation_workspace_status) AS skipped
+FROM
+	workspace_bulk_operation_workspaces
+WHERE
+	operation_id = $1
+`
+
+type GetWorkspaceBulkOperationProgressRow struct {
+	Pending   int64 `db:"pending" json:"pending"`
+	Running   int64 `db:"running" json:"running"`
+	Succeeded int64 `db:"succeeded" json:"succeeded"`
+	Failed    int64 `db:"failed" json:"failed"`
+	Skipped   int64 `db:"skipped" json:"skipped"`
+}
+
+func (q *sqlQuerier) GetWorkspaceBulkOperationProgress(ctx context.Context, operationID uuid.UUID) (GetWorkspaceBulkOperationProgressRow, error) {
+	row := q.db.QueryRowContext(ctx, getWorkspaceBulkOperationProgress, operationID)
+	var i GetWorkspaceBulkOperationProgressRow
+	err := row.Scan(
+		&i.Pending,
+		&i.Running,
+		&i.Succeeded,
+		&i.Failed,
+		&i.Skipped,
+	)
+	return i, err
+}
+
+const getWorkspaceBulkOperationWorkspaces = `-- name: GetWorkspaceBulkOperationWorkspaces :many
+SELECT
+	workspace_bulk_operation_workspaces.operation_id,
+	workspace_bulk_operation_workspaces.workspace_id,
+	workspace_bulk_operation_workspaces.status,
+	workspace_bulk_operation_workspaces.build_id,
+	workspace_bulk_operation_workspaces.error,
+	workspace_bulk_operation_workspaces.updated_at,
+	workspaces.name AS workspace_name,
+	users.username AS workspace_owner_username
+FROM
+	workspace_bulk_operation_workspaces
+JOIN
+	workspaces ON workspaces.id = workspace_bulk_operation_workspaces.workspace_id
+JOIN
+	users ON users.id = workspaces.owner_id
+WHERE
+	workspace_bulk_operation_workspaces.operation_id = $1
+ORDER BY
+	LOWER(users.username) ASC,
+	LOWER(workspaces.name) ASC
+`
+
+type GetWorkspaceBulkOperationWorkspacesRow struct {
+	OperationID            uuid.UUID                             `db:"operation_id" json:"operation_id"`
+	WorkspaceID            uuid.UUID                             `db:"workspace_id" json:"workspace_id"`
+	Status                 WorkspaceBulkOperationWorkspaceStatus `db:"status" json:"status"`
+	BuildID                uuid.NullUUID                         `db:"build_id" json:"build_id"`
+	Error                  string                                `db:"error" json:"error"`
+	UpdatedAt              time.Time                             `db:"updated_at" json:"updated_at"`
+	WorkspaceName          string                                `db:"workspace_name" json:"workspace_name"`
+	WorkspaceOwnerUsername string                                `db:"workspace_owner_username" json:"workspace_owner_username"`
+}
+
+func (q *sqlQuerier) GetWorkspaceBulkOperationWorkspaces(ctx context.Context, operationID uuid.UUID) ([]GetWorkspaceBulkOperationWorkspacesRow, error) {
+	rows, err := q.db.QueryContext(ctx, getWorkspaceBulkOperationWorkspaces, operationID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []GetWorkspaceBulkOperationWorkspacesRow
+	for rows.Next() {
+		var i GetWorkspaceBulkOperationWorkspacesRow
+		if err := rows.Scan(
+			&i.OperationID,
+			&i.WorkspaceID,
+			&i.Status,
+			&i.BuildID,
+			&i.Error,
+			&i.UpdatedAt,
+			&i.WorkspaceName,
+			&i.WorkspaceOwnerUsername,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const getWorkspaceBulkOperationsByInitiatorID = `-- name: GetWorkspaceBulkOperationsByInitiatorID :many
+SELECT
+	id, initiator_id, action, query, autostart_schedule, ttl_ms, automatic_updates, concurrency, workspace_count, status, created_at, updated_at, completed_at, api_key_scopes, api_key_allow_list
+FROM
+	workspace_bulk_operations
+WHERE
+	initiator_id = $1
+ORDER BY
+	created_at DESC
+LIMIT
+	$2 :: integer
+`
+
+type GetWorkspaceBulkOperationsByInitiatorIDParams struct {
+	InitiatorID uuid.UUID `db:"initiator_id" json:"initiator_id"`
+	LimitOpt    int32     `db:"limit_opt" json:"limit_opt"`
+}
+
+func (q *sqlQuerier) GetWorkspaceBulkOperationsByInitiatorID(ctx context.Context, arg GetWorkspaceBulkOperationsByInitiatorIDParams) ([]WorkspaceBulkOperation, error) {
+	rows, err := q.db.QueryContext(ctx, getWorkspaceBulkOperationsByInitiatorID, arg.InitiatorID, arg.LimitOpt)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []WorkspaceBulkOperation
+	for rows.Next() {
+		var i WorkspaceBulkOperation
+		if err := rows.Scan(
+			&i.ID,
+			&i.InitiatorID,
+			&i.Action,
+			&i.Query,
+			&i.AutostartSchedule,
+			&i.TTLMillis,
+			&i.AutomaticUpdates,
+			&i.Concurrency,
+			&i.WorkspaceCount,
+			&i.Status,
+			&i.CreatedAt,
+			&i.UpdatedAt,
+			&i.CompletedAt,
+			&i.APIKeyScopes,
+			&i.APIKeyAllowList,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const insertWorkspaceBulkOperation = `-- name: InsertWorkspaceBulkOperation :one
+INSERT INTO workspace_bulk_operations (
+	id,
+	initiator_id,
+	action,
+	query,
+	autostart_schedule,
+	ttl_ms,
+	automatic_updates,
+	concurrency,
+	workspace_count,
+	created_at,
+	updated_at,
+	api_key_scopes,
+	api_key_allow_list
+)
+VALUES
+	($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12, $13)
+RETURNING id, initiator_id, action, query, autostart_schedule, ttl_ms, automatic_updates, concurrency, workspace_count, status, created_at, updated_at, completed_at, api_key_scopes, api_key_allow_list
+`
+
+type InsertWorkspaceBulkOperationParams struct {
+	ID                uuid.UUID                    `db:"id" json:"id"`
+	InitiatorID       uuid.UUID                    `db:"initiator_id" json:"initiator_id"`
+	Action            WorkspaceBulkOperationAction `db:"action" json:"action"`
+	Query             string                       `db:"query" json:"query"`
+	AutostartSchedule string                       `db:"autostart_schedule" json:"autostart_schedule"`
+	TTLMillis         int64                        `db:"ttl_ms" json:"ttl_ms"`
+	AutomaticUpdates  AutomaticUpdates             `db:"automatic_updates" json:"automatic_updates"`
+	Concurrency       int32                        `db:"concurrency" json:"concurrency"`
+	WorkspaceCount    int32                        `db:"workspace_count" json:"workspace_count"`
+	CreatedAt         time.Time                    `db:"created_at" json:"created_at"`
+	UpdatedAt         time.Time                    `db:"updated_at" json:"updated_at"`
+	APIKeyScopes      APIKeyScopes                 `db:"api_key_scopes" json:"api_key_scopes"`
+	APIKeyAllowList   AllowList                    `db:"api_key_allow_list" json:"api_key_allow_list"`
+}
+
+func (q *sqlQuerier) InsertWorkspaceBulkOperation(ctx context.Context, arg InsertWorkspaceBulkOperationParams) (WorkspaceBulkOperation, error) {
+	row := q.db.QueryRowContext(ctx, insertWorkspaceBulkOperation,
+		arg.ID,
+		arg.InitiatorID,
+		arg.Action,
+		arg.Query,
+		arg.AutostartSchedule,
+		arg.TTLMillis,
+		arg.AutomaticUpdates,
+		arg.Concurrency,
+		arg.WorkspaceCount,
+		arg.CreatedAt,
+		arg.UpdatedAt,
+		arg.APIKeyScopes,
+		arg.APIKeyAllowList,
+	)
+	var i WorkspaceBulkOperation
+	err := row.Scan(
+		&i.ID,
+		&i.InitiatorID,
+		&i.Action,
+		&i.Query,
+		&i.AutostartSchedule,
+		&i.TTLMillis,
+		&i.AutomaticUpdates,
+		&i.Concurrency,
+		&i.WorkspaceCount,
+		&i.Status,
+		&i.CreatedAt,
+		&i.UpdatedAt,
+		&i.CompletedAt,
+		&i.APIKeyScopes,
+		&i.APIKeyAllowList,
+	)
+	return i, err
+}
+
+const insertWorkspaceBulkOperationWorkspaces = `-- name: InsertWorkspaceBulkOperationWorkspaces :exec
+INSERT INTO workspace_bulk_operation_workspaces (
+	operation_id,
+	workspace_id,
+	updated_at
+)
+SELECT
+	$1 :: uuid,
+	unnest($2 :: uuid[]),
+	$3 :: timestamptz
+`
+
+type InsertWorkspaceBulkOperationWorkspacesParams struct {
+	OperationID  uuid.UUID   `db:"operation_id" json:"operation_id"`
+	WorkspaceIds []uuid.UUID `db:"workspace_ids" json:"workspace_ids"`
+	UpdatedAt    time.Time   `db:"updated_at" json:"updated_at"`
+}
+
+func (q *sqlQuerier) InsertWorkspaceBulkOperationWorkspaces(ctx context.Context, arg InsertWorkspaceBulkOperationWorkspacesParams) error {
+	_, err := q.db.ExecContext(ctx, insertWorkspaceBulkOperationWorkspaces, arg.OperationID, pq.Array(arg.WorkspaceIds), arg.UpdatedAt)
+	return err
+}
+
+const updateWorkspaceBulkOperationCanceled = `-- name: UpdateWorkspaceBulkOperationCanceled :one
+WITH skipped AS (
+	UPDATE
+		workspace_bulk_operation_workspaces
+	SET
+		status = 'skipped'::workspace_bulk_operation_workspace_status,
+		error = $1 :: text,
+		updated_at = $2 :: timestamptz
+	WHERE
+		operation_id = $3
+		AND status = 'pending'::workspace_bulk_operation_workspace_status
+)
+UPDATE
+	workspace_bulk_operations
+SET
+	status = 'canceled'::workspace_bulk_operation_status,
+	updated_at = $2 :: timestamptz,
+	completed_at = $2 :: timestamptz
+WHERE
+	id = $3
+	AND status = 'running'::workspace_bulk_operation_status
+RETURNING id, initiator_id, action, query, autostart_schedule, ttl_ms, automatic_updates, concurrency, workspace_count, status, created_at, updated_at, completed_at, api_key_scopes, api_key_allow_list
+`
+
+type UpdateWorkspaceBulkOperationCanceledParams struct {
+	Error string    `db:"error" json:"error"`
+	Now   time.Time `db:"now" json:"now"`
+	ID    uuid.UUID `db:"id" json:"id"`
+}
+
+// Cancels a running operation. Its pending workspaces are skipped, and
+// workspaces that are already running are left to finish.
+func (q *sqlQuerier) UpdateWorkspaceBulkOperationCanceled(ctx context.Context, arg UpdateWorkspaceBulkOperationCanceledParams) (WorkspaceBulkOperation, error) {
+	row := q.db.QueryRowContext(ctx, updateWorkspaceBulkOperationCanceled, arg.Error, arg.Now, arg.ID)
+	var i WorkspaceBulkOperation
+	err := row.Scan(
+		&i.ID,
+		&i.InitiatorID,
+		&i.Action,
+		&i.Query,
+		&i.AutostartSchedule,
+		&i.TTLMillis,
+		&i.AutomaticUpdates,
+		&i.Concurrency,
+		&i.WorkspaceCount,
+		&i.Status,
+		&i.CreatedAt,
+		&i.UpdatedAt,
+		&i.CompletedAt,
+		&i.APIKeyScopes,
+		&i.APIKeyAllowList,
+	)
+	return i, err
+}
+
+const updateWorkspaceBulkOperationCompleted = `-- name: UpdateWorkspaceBulkOperationCompleted :exec
+UPDATE
+	workspace_bulk_operations
+SET
+	status = 'completed'::workspace_bulk_operation_status,
+	updated_at = $1 :: timestamptz,
+	completed_at = $1 :: timestamptz
+WHERE
+	id = $2
+	AND status = 'running'::workspace_bulk_operation_status
+	AND NOT EXISTS (
+		SELECT
+			1
+		FROM
+			workspace_bulk_operation_workspaces
+		WHERE
+			operation_id = $2
+			AND status IN ('pending'::workspace_bulk_operation_workspace_status, 'running'::workspace_bulk_operation_workspace_status)
+	)
+`
+
+type UpdateWorkspaceBulkOperationCompletedParams struct {
+	Now time.Time `db:"now" json:"now"`
+	ID  uuid.UUID `db:"id" json:"id"`
+}
+
+// Marks the operation as completed once none of its workspaces are pending or
+// running.
+func (q *sqlQuerier) UpdateWorkspaceBulkOperationCompleted(ctx context.Context, arg UpdateWorkspaceBulkOperationCompletedParams) error {
+	_, err := q.db.ExecContext(ctx, updateWorkspaceBulkOperationCompleted, arg.Now, arg.ID)
+	return err
+}
+
+const updateWorkspaceBulkOperationWorkspace = `-- name: UpdateWorkspaceBulkOperationWorkspace :exec
+UPDATE
+	workspace_bulk_operation_workspaces
+SET
+	status = $1,
+	build_id = $2,
+	error = $3,
+	updated_at = $4
+WHERE
+	operation_id = $5
+	AND workspace_id = $6
+`
+
+type UpdateWorkspaceBulkOperationWorkspaceParams struct {
+	Status      WorkspaceBulkOperationWorkspaceStatus `db:"status" json:"status"`
+	BuildID     uuid.NullUUID                         `db:"build_id" json:"build_id"`
+	Error       string                                `db:"error" json:"error"`
+	UpdatedAt   time.Time                             `db:"updated_at" json:"updated_at"`
+	OperationID uuid.UUID                             `db:"operation_id" json:"operation_id"`
+	WorkspaceID uuid.UUID                             `db:"workspace_id" json:"workspace_id"`
+}
+
+func (q *sqlQuerier) UpdateWorkspaceBulkOperationWorkspace(ctx context.Context, arg UpdateWorkspaceBulkOperationWorkspaceParams) error {
+	_, err := q.db.ExecContext(ctx, updateWorkspaceBulkOperationWorkspace,
+		arg.Status,
+		arg.BuildID,
+		arg.Error,
+		arg.UpdatedAt,
+		arg.OperationID,
+		arg.WorkspaceID,
+	)
+	return err
+}
+
+const getLatestWorkspaceDriftChecksByWorkspaceIDs = `-- name: GetLatestWorkspaceDriftChecksByWorkspaceIDs :many
+SELECT DISTINCT ON (workspace_drift_checks.workspace_id)
+	workspace_drift_checks.id, workspace_drift_checks.workspace_id, workspace_drift_checks.workspace_build_id, workspace_drift_checks.job_id, workspace_drift_checks.created_at, workspace_drift_checks.checked_at, workspace_drift_checks.drifted, workspace_drift_checks.resource_drift
+FROM
+	workspace_drift_checks
+WHERE
+	workspace_drift_checks.workspace_id = ANY($1 :: uuid[])
+	AND workspace_drift_checks.checked_at IS NOT NULL
+	AND workspace_drift_checks.workspace_build_id = (
+		SELECT
+			workspace_builds.id
+		FROM
+			workspace_builds
+		WHERE
+			workspace_builds.workspace_id = workspace_drift_checks.workspace_id
+		ORDER BY
+			workspace_builds.build_number DESC
+		LIMIT
+			1
+	)
+ORDER BY
+	workspace_drift_checks.workspace_id,
+	workspace_drift_checks.checked_at DESC
+`
+
+// Returns the most recent successful drift check of each workspace. Checks
+// that were run against a build other than the workspace's latest build are
+// ignored, as a new build replaces the infrastructure that was checked.
+func (q *sqlQuerier) GetLatestWorkspaceDriftChecksByWorkspaceIDs(ctx context.Context, ids []uuid.UUID) ([]WorkspaceDriftCheck, error) {
+	rows, err := q.db.QueryContext(ctx, getLatestWorkspaceDriftChecksByWorkspaceIDs, pq.Array(ids))
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []WorkspaceDriftCheck
+	for rows.Next() {
+		var i WorkspaceDriftCheck
+		if err := rows.Scan(
+			&i.ID,
+			&i.WorkspaceID,
+			&i.WorkspaceBuildID,
+			&i.JobID,
+			&i.CreatedAt,
+			&i.CheckedAt,
+			&i.Drifted,
+			&i.ResourceDrift,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const getWorkspaceDriftCheckByJobID = `-- name: GetWorkspaceDriftCheckByJobID :one
+SELECT
+	id, workspace_id, workspace_build_id, job_id, created_at, checked_at, drifted, resource_drift
+FROM
+	workspace_drift_checks
+WHERE
+	job_id = $1
+`
+
+func (q *sqlQuerier) GetWorkspaceDriftCheckByJobID(ctx context.Context, jobID uuid.UUID) (WorkspaceDriftCheck, error) {
+	row := q.db.QueryRowContext(ctx, getWorkspaceDriftCheckByJobID, jobID)
+	var i WorkspaceDriftCheck
+	err := row.Scan(
+		&i.ID,
+		&i.WorkspaceID,
+		&i.WorkspaceBuildID,
+		&i.JobID,
+		&i.CreatedAt,
+		&i.CheckedAt,
+		&i.Drifted,
+		&i.ResourceDrift,
+	)
+	return i, err
+}
+
+const getWorkspacesEligibleForDriftCheck = `-- name: GetWorkspacesEligibleForDriftCheck :many
+SELECT
+	workspaces.id AS workspace_id,
+	workspaces.owner_id,
+	workspaces.organization_id,
+	workspaces.template_id,
+	latest_build.id AS workspace_build_id,
+	latest_build.template_version_id,
+	latest_build.provisioner,
+	latest_build.file_id,
+	latest_build.tags
+FROM
+	workspaces
+JOIN
+	templates ON templates.id = workspaces.template_id
+JOIN LATERAL (
+	SELECT
+		workspace_builds.id,
+		workspace_builds.template_version_id,
+		workspace_builds.transition,
+		provisioner_jobs.job_status,
+		provisioner_jobs.provisioner,
+		provisioner_jobs.file_id,
+		provisioner_jobs.tags
+	FROM
+		workspace_builds
+	JOIN
+		provisioner_jobs ON provisioner_jobs.id = workspace_builds.job_id
+	WHERE
+		workspace_builds.workspace_id = workspaces.id
+	ORDER BY
+		workspace_builds.build_number DESC
+	LIMIT
+		1
+) latest_build ON TRUE
+WHERE
+	workspaces.deleted = false
+	AND workspaces.dormant_at IS NULL
+	AND workspaces.owner_id != 'c42fdf75-3097-471c-8c33-fb52454d81c0'::uuid -- The prebuilds system user.
+	AND templates.deleted = false
+	AND templates.drift_check_interval > 0
+	AND latest_build.transition = 'start'::workspace_transition
+	AND latest_build.job_status = 'succeeded'::provisioner_job_status
+	AND NOT EXISTS (
+		SELECT
+			1
+		FROM
+			workspace_drift_checks
+		JOIN
+			provisioner_jobs ON provisioner_jobs.id = workspace_drift_checks.job_id
+		WHERE
+			workspace_drift_checks.workspace_id = workspaces.id
+			AND (
+				provisioner_jobs.completed_at IS NULL
+				OR workspace_drift_checks.created_at > $1 :: timestamptz - (INTERVAL '1 millisecond' * (templates.drift_check_interval / 1000000))
+			)
+	)
+ORDER BY
+	workspaces.id
+`
+
+type GetWorkspacesEligibleForDriftCheckRow struct {
+	WorkspaceID       uuid.UUID       `db:"workspace_id" json:"workspace_id"`
+	OwnerID           uuid.UUID       `db:"owner_id" json:"owner_id"`
+	OrganizationID    uuid.UUID       `db:"organization_id" json:"organization_id"`
+	TemplateID        uuid.UUID       `db:"template_id" json:"template_id"`
+	WorkspaceBuildID  uuid.UUID       `db:"workspace_build_id" json:"workspace_build_id"`
+	TemplateVersionID uuid.UUID       `db:"template_version_id" json:"template_version_id"`
+	Provisioner       ProvisionerType `db:"provisioner" json:"provisioner"`
+	FileID            uuid.UUID       `db:"file_id" json:"file_id"`
+	Tags              StringMap       `db:"tags" json:"tags"`
+}
+
+// Returns the running workspaces that are due for a drift check. A workspace
+// is due when its template has drift detection enabled, its latest build
+// successfully started it, and it has neither been checked within the
+// template's drift check interval nor has a drift check that is still in
+// progress. Dormant workspaces and prebuilds are never checked.
+func (q *sqlQuerier) GetWorkspacesEligibleForDriftCheck(ctx context.Context, now time.Time) ([]GetWorkspacesEligibleForDriftCheckRow, error) {
+	rows, err := q.db.QueryContext(ctx, getWorkspacesEligibleForDriftCheck, now)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []GetWorkspacesEligibleForDriftCheckRow
+	for rows.Next() {
+		var i GetWorkspacesEligibleForDriftCheckRow
+		if err := rows.Scan(
+			&i.WorkspaceID,
+			&i.OwnerID,
+			&i.OrganizationID,
+			&i.TemplateID,
+			&i.WorkspaceBuildID,
+			&i.TemplateVersionID,
+			&i.Provisioner,
+			&i.FileID,
+			&i.Tags,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const insertWorkspaceDriftCheck = `-- name: InsertWorkspaceDriftCheck :one
+INSERT INTO workspace_drift_checks (
+	id,
+	workspace_id,
+	workspace_build_id,
+	job_id,
+	created_at
+)
+VALUES
+	($1, $2, $3, $4, $5)
+RETURNING id, workspace_id, workspace_build_id, job_id, created_at, checked_at, drifted, resource_drift
+`
+
+type InsertWorkspaceDriftCheckParams struct {
+	ID               uuid.UUID `db:"id" json:"id"`
+	WorkspaceID      uuid.UUID `db:"workspace_id" json:"workspace_id"`
+	WorkspaceBuildID uuid.UUID `db:"workspace_build_id" json:"workspace_build_id"`
+	JobID            uuid.UUID `db:"job_id" json:"job_id"`
+	CreatedAt        time.Time `db:"created_at" json:"created_at"`
+}
+
+func (q *sqlQuerier) InsertWorkspaceDriftCheck(ctx context.Context, arg InsertWorkspaceDriftCheckParams) (WorkspaceDriftCheck, error) {
+	row := q.db.QueryRowContext(ctx, insertWorkspaceDriftCheck,
+		arg.ID,
+		arg.WorkspaceID,
+		arg.WorkspaceBuildID,
+		arg.JobID,
+		arg.CreatedAt,
+	)
+	var i WorkspaceDriftCheck
+	err := row.Scan(
+		&i.ID,
+		&i.WorkspaceID,
+		&i.WorkspaceBuildID,
+		&i.JobID,
+		&i.CreatedAt,
+		&i.CheckedAt,
+		&i.Drifted,
+		&i.ResourceDrift,
+	)
+	return i, err
+}
+
+const updateWorkspaceDriftCheckByID = `-- name: UpdateWorkspaceDriftCheckByID :exec
+UPDATE
+	workspace_drift_checks
+SET
+	checked_at = $2,
+	drifted = $3,
+	resource_drift = $4
+WHERE
+	id = $1
+`
+
+type UpdateWorkspaceDriftCheckByIDParams struct {
+	ID            uuid.UUID       `db:"id" json:"id"`
+	CheckedAt     sql.NullTime    `db:"checked_at" json:"checked_at"`
+	Drifted       bool            `db:"drifted" json:"drifted"`
+	ResourceDrift json.RawMessage `db:"resource_drift" json:"resource_drift"`
+}
+
+func (q *sqlQuerier) UpdateWorkspaceDriftCheckByID(ctx context.Context, arg UpdateWorkspaceDriftCheckByIDParams) error {
+	_, err := q.db.ExecContext(ctx, updateWorkspaceDriftCheckByID,
+		arg.ID,
+		arg.CheckedAt,
+		arg.Drifted,
+		arg.ResourceDrift,
+	)
+	return err
+}
+
 const getWorkspaceModulesByJobID = `-- name: GetWorkspaceModulesByJobID :many
 SELECT
 	id, job_id, transition, source, version, key, created_at
@@ -39134,7 +42312,7 @@ LEFT JOIN LATERAL (
 ) latest_build ON TRUE
 LEFT JOIN LATERAL (
 	SELECT
-		id, created_at, updated_at, organization_id, deleted, name, provisioner, active_version_id, description, default_ttl, created_by, icon, user_acl, group_acl, display_name, allow_user_cancel_workspace_jobs, allow_user_autostart, allow_user_autostop, failure_ttl, time_til_dormant, time_til_dormant_autodelete, autostop_requirement_days_of_week, autostop_requirement_weeks, autostart_block_days_of_week, require_active_version, deprecated, activity_bump, max_port_sharing_level, use_classic_parameter_flow, cors_behavior, disable_module_cache, time_til_autostop_notify, agents_allowed
+		id, created_at, updated_at, organization_id, deleted, name, provisioner, active_version_id, description, default_ttl, created_by, icon, user_acl, group_acl, display_name, allow_user_cancel_workspace_jobs, allow_user_autostart, allow_user_autostop, failure_ttl, time_til_dormant, time_til_dormant_autodelete, autostop_requirement_days_of_week, autostop_requirement_weeks, autostart_block_days_of_week, require_active_version, deprecated, activity_bump, max_port_sharing_level, use_classic_parameter_flow, cors_behavior, disable_module_cache, time_til_autostop_notify, agents_allowed, drift_check_interval
 	FROM
 		templates
 	WHERE
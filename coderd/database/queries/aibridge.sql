@@ -298,6 +298,66 @@ SELECT (
   (SELECT COUNT(*) FROM interceptions)
 )::bigint as total_deleted;
 
+-- name: GetOldAIBridgeInterceptionsForArchive :many
+-- Returns a page of the interceptions that DeleteOldAIBridgeRecords will
+-- delete with the same time, as JSON with their dependent records nested, so
+-- they can be archived first.
+SELECT
+	aibridge_interceptions.id,
+	to_jsonb(aibridge_interceptions) || jsonb_build_object(
+		'token_usages', COALESCE((SELECT jsonb_agg(to_jsonb(t)) FROM aibridge_token_usages t WHERE t.interception_id = aibridge_interceptions.id), '[]'::jsonb),
+		'user_prompts', COALESCE((SELECT jsonb_agg(to_jsonb(p)) FROM aibridge_user_prompts p WHERE p.interception_id = aibridge_interceptions.id), '[]'::jsonb),
+		'tool_usages', COALESCE((SELECT jsonb_agg(to_jsonb(u)) FROM aibridge_tool_usages u WHERE u.interception_id = aibridge_interceptions.id), '[]'::jsonb),
+		'model_thoughts', COALESCE((SELECT jsonb_agg(to_jsonb(m)) FROM aibridge_model_thoughts m WHERE m.interception_id = aibridge_interceptions.id), '[]'::jsonb)
+	) AS record
+FROM
+	aibridge_interceptions
+WHERE
+	started_at < @before_time::timestamp with time zone
+	AND id > @after_id::uuid
+ORDER BY
+	id ASC
+LIMIT
+	@limit_count;
+
+-- name: DeleteAIBridgeRecordsByInterceptionIDs :one
+-- Deletes interceptions that were archived before being purged, along with
+-- their dependent records, like DeleteOldAIBridgeRecords.
+WITH
+  model_thoughts AS (
+    DELETE FROM aibridge_model_thoughts
+    WHERE interception_id = ANY(@ids::uuid[])
+    RETURNING 1
+  ),
+  tool_usages AS (
+    DELETE FROM aibridge_tool_usages
+    WHERE interception_id = ANY(@ids::uuid[])
+    RETURNING 1
+  ),
+  token_usages AS (
+    DELETE FROM aibridge_token_usages
+    WHERE interception_id = ANY(@ids::uuid[])
+    RETURNING 1
+  ),
+  user_prompts AS (
+    DELETE FROM aibridge_user_prompts
+    WHERE interception_id = ANY(@ids::uuid[])
+    RETURNING 1
+  ),
+  interceptions AS (
+    DELETE FROM aibridge_interceptions
+    WHERE id = ANY(@ids::uuid[])
+    RETURNING 1
+  )
+-- Cumulative count.
+SELECT (
+  (SELECT COUNT(*) FROM model_thoughts) +
+  (SELECT COUNT(*) FROM tool_usages) +
+  (SELECT COUNT(*) FROM token_usages) +
+  (SELECT COUNT(*) FROM user_prompts) +
+  (SELECT COUNT(*) FROM interceptions)
+)::bigint as total_deleted;
+
 -- name: CountAIBridgeSessions :one
 SELECT
 	COUNT(DISTINCT (aibridge_interceptions.session_id, aibridge_interceptions.initiator_id))
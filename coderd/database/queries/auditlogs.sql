@@ -249,6 +249,18 @@ SELECT COUNT(*) FROM (
 	LIMIT NULLIF(@count_cap::int, 0) + 1
 ) AS limited_count;
 
+-- name: GetOldAuditLogConnectionEventsForArchive :many
+-- Returns the connection events that DeleteOldAuditLogConnectionEvents will
+-- delete with the same arguments, as JSON, so they can be archived first.
+SELECT
+    to_jsonb(audit_logs) AS record
+FROM audit_logs
+WHERE
+    "time" < @before_time::timestamp with time zone
+    AND action IN ('connect', 'disconnect', 'open', 'close')
+ORDER BY "time" ASC, id ASC
+LIMIT @limit_count;
+
 -- name: DeleteOldAuditLogConnectionEvents :exec
 DELETE FROM audit_logs
 WHERE id IN (
@@ -265,6 +277,18 @@ WHERE id IN (
     LIMIT @limit_count
 );
 
+-- name: GetOldAuditLogsForArchive :many
+-- Returns the audit logs that DeleteOldAuditLogs will delete with the same
+-- arguments, as JSON, so they can be archived first.
+SELECT
+    to_jsonb(audit_logs) AS record
+FROM audit_logs
+WHERE
+    "time" < @before_time::timestamp with time zone
+    AND action NOT IN ('connect', 'disconnect', 'open', 'close')
+ORDER BY "time" ASC, id ASC
+LIMIT @limit_count;
+
 -- name: DeleteOldAuditLogs :execrows
 -- Deletes old audit logs based on retention policy, excluding deprecated
 -- connection events (connect, disconnect, open, close) which are handled
@@ -275,9 +299,16 @@ WITH old_logs AS (
     WHERE
         "time" < @before_time::timestamp with time zone
         AND action NOT IN ('connect', 'disconnect', 'open', 'close')
-    ORDER BY "time" ASC
+    -- Ordering by id breaks ties, so that GetOldAuditLogsForArchive
+    -- returns exactly the rows that are deleted.
+    ORDER BY "time" ASC, id ASC
     LIMIT @limit_count
 )
 DELETE FROM audit_logs
 USING old_logs
 WHERE audit_logs.id = old_logs.id;
+
+-- name: DeleteAuditLogsByIDs :execrows
+-- Deletes audit logs that were archived before being purged.
+DELETE FROM audit_logs
+WHERE id = ANY(@ids::uuid[]);
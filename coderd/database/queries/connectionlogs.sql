@@ -243,18 +243,35 @@ SELECT COUNT(*) AS count FROM (
 	LIMIT NULLIF(@count_cap::int, 0) + 1
 ) AS limited_count;
 
+-- name: GetOldConnectionLogsForArchive :many
+-- Returns the connection logs that DeleteOldConnectionLogs will delete with
+-- the same arguments, as JSON, so they can be archived first.
+SELECT
+	to_jsonb(connection_logs) AS record
+FROM connection_logs
+WHERE connect_time < @before_time::timestamp with time zone
+ORDER BY connect_time ASC, id ASC
+LIMIT @limit_count;
+
 -- name: DeleteOldConnectionLogs :execrows
 WITH old_logs AS (
 	SELECT id
 	FROM connection_logs
 	WHERE connect_time < @before_time::timestamp with time zone
-	ORDER BY connect_time ASC
+	-- Ordering by id breaks ties, so that GetOldConnectionLogsForArchive
+	-- returns exactly the rows that are deleted.
+	ORDER BY connect_time ASC, id ASC
 	LIMIT @limit_count
 )
 DELETE FROM connection_logs
 USING old_logs
 WHERE connection_logs.id = old_logs.id;
 
+-- name: DeleteConnectionLogsByIDs :execrows
+-- Deletes connection logs that were archived before being purged.
+DELETE FROM connection_logs
+WHERE id = ANY(@ids::uuid[]);
+
 -- name: BatchUpsertConnectionLogs :exec
 INSERT INTO connection_logs (
     id, connect_time, organization_id, workspace_owner_id, workspace_id,
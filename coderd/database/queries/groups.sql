@@ -80,6 +80,10 @@ WHERE
 				groups.id = ANY(@group_ids)
 			ELSE true
 		END
+		AND CASE WHEN array_length(@display_names :: text[], 1) > 0  THEN
+				groups.display_name = ANY(@display_names)
+			ELSE true
+		END
 		-- Filter by group name or display name (substring, case-insensitive).
 		AND CASE WHEN @search :: text != '' THEN (
 				groups.name ILIKE concat('%', @search, '%')
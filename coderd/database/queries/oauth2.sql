@@ -31,7 +31,8 @@ INSERT INTO oauth2_provider_apps (
     software_id,
     software_version,
     registration_access_token,
-    registration_client_uri
+    registration_client_uri,
+    client_credentials_user_id
 ) VALUES(
     $1,
     $2,
@@ -58,7 +59,8 @@ INSERT INTO oauth2_provider_apps (
     $23,
     $24,
     $25,
-    $26
+    $26,
+    $27
 ) RETURNING *;
 
 -- name: UpdateOAuth2ProviderAppByID :one
@@ -83,7 +85,8 @@ UPDATE oauth2_provider_apps SET
     jwks_uri = $19,
     jwks = $20,
     software_id = $21,
-    software_version = $22
+    software_version = $22,
+    client_credentials_user_id = $23
 WHERE id = $1 RETURNING *;
 
 -- name: DeleteOAuth2ProviderAppByID :exec
@@ -161,6 +164,63 @@ DELETE FROM oauth2_provider_app_codes WHERE id = $1;
 -- name: DeleteOAuth2ProviderAppCodesByAppAndUserID :exec
 DELETE FROM oauth2_provider_app_codes WHERE app_id = $1 AND user_id = $2;
 
+-- name: GetOAuth2ProviderAppDeviceCodeByID :one
+SELECT * FROM oauth2_provider_app_device_codes WHERE id = $1;
+
+-- name: GetOAuth2ProviderAppDeviceCodeByPrefix :one
+SELECT * FROM oauth2_provider_app_device_codes WHERE device_code_prefix = $1;
+
+-- name: GetOAuth2ProviderAppDeviceCodeByUserCode :one
+SELECT * FROM oauth2_provider_app_device_codes WHERE user_code = $1;
+
+-- name: InsertOAuth2ProviderAppDeviceCode :one
+INSERT INTO oauth2_provider_app_device_codes (
+    id,
+    created_at,
+    expires_at,
+    device_code_prefix,
+    device_code_hash,
+    user_code,
+    app_id,
+    resource_uri,
+    scope,
+    polling_interval
+) VALUES(
+    $1,
+    $2,
+    $3,
+    $4,
+    $5,
+    $6,
+    $7,
+    $8,
+    $9,
+    $10
+) RETURNING *;
+
+-- name: UpdateOAuth2ProviderAppDeviceCodeStatus :one
+-- Only a pending code can be approved or denied, so a code cannot change
+-- hands once a user has acted on it.
+UPDATE oauth2_provider_app_device_codes SET
+    status = @status,
+    user_id = @user_id
+WHERE
+    id = @id
+    AND status = 'pending'::oauth2_device_code_status
+RETURNING *;
+
+-- name: UpdateOAuth2ProviderAppDeviceCodePoll :exec
+UPDATE oauth2_provider_app_device_codes SET
+    last_polled_at = @last_polled_at,
+    polling_interval = @polling_interval
+WHERE id = @id;
+
+-- name: DeleteOAuth2ProviderAppDeviceCodeByID :exec
+DELETE FROM oauth2_provider_app_device_codes WHERE id = $1;
+
+-- name: DeleteExpiredOAuth2ProviderAppDeviceCodes :exec
+DELETE FROM oauth2_provider_app_device_codes WHERE expires_at < @before_time;
+
 -- name: InsertOAuth2ProviderAppToken :one
 INSERT INTO oauth2_provider_app_tokens (
     id,
@@ -0,0 +1,32 @@
+-- name: GetProvisionerJobPrioritiesByOrganizationID :many
+SELECT
+	*
+FROM
+	provisioner_job_priorities
+WHERE
+	organization_id = @organization_id :: uuid
+ORDER BY
+	template_id ASC;
+
+-- name: UpsertProvisionerJobPriority :one
+INSERT INTO
+	provisioner_job_priorities (
+		organization_id,
+		template_id,
+		priority,
+		updated_at
+	)
+VALUES
+	(@organization_id, @template_id, @priority, @updated_at)
+ON CONFLICT (organization_id, template_id) DO UPDATE
+SET
+	priority = EXCLUDED.priority,
+	updated_at = EXCLUDED.updated_at
+RETURNING *;
+
+-- name: DeleteProvisionerJobPriority :exec
+DELETE FROM
+	provisioner_job_priorities
+WHERE
+	organization_id = @organization_id
+	AND template_id = @template_id;
@@ -1,6 +1,12 @@
 -- Acquires the lock for a single job that isn't started, completed,
 -- canceled, and that matches an array of provisioner types.
 --
+-- Jobs are acquired by priority first. Within the same priority, jobs of
+-- initiators with the fewest running jobs in the organization are acquired
+-- first, so a single user queueing many jobs cannot starve everyone else.
+-- Organizations do not compete with each other since provisioner daemons only
+-- acquire jobs of their own organization.
+--
 -- SKIP LOCKED is used to jump over locked rows. This prevents
 -- multiple provisioners from acquiring the same jobs. See:
 -- https://www.postgresql.org/docs/9.5/sql-select.html#SQL-FOR-UPDATE-SHARE
@@ -27,8 +33,20 @@ WHERE
 			-- they are aliases and the code that calls this query already relies on a different type
 			AND provisioner_tagset_contains(@provisioner_tags :: jsonb, potential_job.tags :: jsonb)
 		ORDER BY
+			potential_job.priority DESC,
 			-- Ensure that human-initiated jobs are prioritized over prebuilds.
 			potential_job.initiator_id = 'c42fdf75-3097-471c-8c33-fb52454d81c0'::uuid ASC,
+			-- Fair share between initiators.
+			(
+				SELECT
+					COUNT(*)
+				FROM
+					provisioner_jobs AS running_job
+				WHERE
+					running_job.job_status = 'running'
+					AND running_job.organization_id = potential_job.organization_id
+					AND running_job.initiator_id = potential_job.initiator_id
+			) ASC,
 			potential_job.created_at ASC
 		FOR UPDATE
 		SKIP LOCKED
@@ -80,12 +98,23 @@ WITH filtered_provisioner_jobs AS (
 pending_jobs AS (
 	-- Step 2: Extract only pending jobs
 	SELECT
-		id, initiator_id, created_at, tags
+		id, organization_id, initiator_id, priority, created_at, tags
 	FROM
 		provisioner_jobs
 	WHERE
 		job_status = 'pending'
 ),
+running_jobs AS (
+	-- Running jobs per initiator, used for fair share. See AcquireProvisionerJob.
+	SELECT
+		organization_id, initiator_id, COUNT(*) AS count
+	FROM
+		provisioner_jobs
+	WHERE
+		job_status = 'running'
+	GROUP BY
+		organization_id, initiator_id
+),
 unique_daemon_tags AS (
 	SELECT DISTINCT tags FROM provisioner_daemons pd
 	WHERE pd.last_seen_at IS NOT NULL
@@ -104,7 +133,8 @@ ranked_jobs AS (
 	SELECT
 		pj.id,
 		pj.created_at,
-		ROW_NUMBER() OVER (PARTITION BY rdt.tags ORDER BY pj.initiator_id = 'c42fdf75-3097-471c-8c33-fb52454d81c0'::uuid ASC, pj.created_at ASC) AS queue_position,
+		-- Keep the ordering in sync with AcquireProvisionerJob.
+		ROW_NUMBER() OVER (PARTITION BY rdt.tags ORDER BY pj.priority DESC, pj.initiator_id = 'c42fdf75-3097-471c-8c33-fb52454d81c0'::uuid ASC, COALESCE(rj.count, 0) ASC, pj.created_at ASC) AS queue_position,
 		COUNT(*) OVER (PARTITION BY rdt.tags) AS queue_size
 	FROM
 		pending_jobs pj
@@ -112,6 +142,11 @@ ranked_jobs AS (
 		relevant_daemon_tags rdt
 	ON
 		provisioner_tagset_contains(rdt.tags, pj.tags)
+	LEFT JOIN
+		running_jobs rj
+	ON
+		rj.organization_id = pj.organization_id
+		AND rj.initiator_id = pj.initiator_id
 ),
 final_jobs AS (
 	-- Step 4: Compute best queue position and max queue size per job
@@ -145,10 +180,14 @@ ORDER BY
 -- name: GetProvisionerJobsByOrganizationAndStatusWithQueuePositionAndProvisioner :many
 WITH pending_jobs AS (
     SELECT
-        id, initiator_id, created_at
+        id, initiator_id, priority, created_at
     FROM
         provisioner_jobs
     WHERE
+        -- Daemons only acquire jobs of their own organization, so jobs of
+        -- other organizations are not ahead in the queue.
+        organization_id = @organization_id::uuid
+    AND
         started_at IS NULL
     AND
         canceled_at IS NULL
@@ -157,12 +196,28 @@ WITH pending_jobs AS (
     AND
         error IS NULL
 ),
+running_jobs AS (
+    -- Running jobs per initiator, used for fair share. See AcquireProvisionerJob.
+    SELECT
+        initiator_id, COUNT(*) AS count
+    FROM
+        provisioner_jobs
+    WHERE
+        organization_id = @organization_id::uuid
+    AND
+        job_status = 'running'
+    GROUP BY
+        initiator_id
+),
 queue_position AS (
     SELECT
-        id,
-        ROW_NUMBER() OVER (ORDER BY initiator_id = 'c42fdf75-3097-471c-8c33-fb52454d81c0'::uuid ASC, created_at ASC) AS queue_position
+        pj.id,
+        -- Keep the ordering in sync with AcquireProvisionerJob.
+        ROW_NUMBER() OVER (ORDER BY pj.priority DESC, pj.initiator_id = 'c42fdf75-3097-471c-8c33-fb52454d81c0'::uuid ASC, COALESCE(rj.count, 0) ASC, pj.created_at ASC) AS queue_position
     FROM
-        pending_jobs
+        pending_jobs pj
+    LEFT JOIN
+        running_jobs rj ON rj.initiator_id = pj.initiator_id
 ),
 queue_size AS (
 	SELECT COUNT(*) AS count FROM pending_jobs
@@ -266,10 +321,36 @@ INSERT INTO
 		"input",
 		tags,
 		trace_metadata,
-		logs_overflowed
+		logs_overflowed,
+		priority
 	)
 VALUES
-	($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12, $13) RETURNING *;
+	(
+		@id,
+		@created_at,
+		@updated_at,
+		@organization_id,
+		@initiator_id,
+		@provisioner,
+		@storage_method,
+		@file_id,
+		@type,
+		@input,
+		@tags,
+		@trace_metadata,
+		@logs_overflowed,
+		-- The priority class of the job plus the organization and template
+		-- boosts. See provisioner_job_priorities.
+		@priority :: integer + COALESCE((
+			SELECT
+				SUM(pjp.priority)
+			FROM
+				provisioner_job_priorities pjp
+			WHERE
+				pjp.organization_id = @organization_id
+				AND pjp.template_id = ANY(ARRAY['00000000-0000-0000-0000-000000000000' :: uuid, @template_id :: uuid])
+		), 0) :: integer
+	) RETURNING *;
 
 -- name: UpdateProvisionerJobByID :exec
 UPDATE
@@ -0,0 +1,23 @@
+-- name: InsertPurgeArchiveImport :one
+INSERT INTO purge_archive_imports (
+	id,
+	name,
+	record_type,
+	row_count,
+	imported_at
+) VALUES (
+	@id,
+	@name,
+	@record_type,
+	@row_count,
+	@imported_at
+) RETURNING *;
+
+-- name: InsertPurgeArchiveRecords :exec
+INSERT INTO purge_archive_records (import_id, record)
+SELECT
+	@import_id :: uuid,
+	unnest(@records :: text[]) :: jsonb;
+
+-- name: GetPurgeArchiveImports :many
+SELECT * FROM purge_archive_imports ORDER BY name;
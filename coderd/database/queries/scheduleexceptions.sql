@@ -0,0 +1,173 @@
+-- name: InsertScheduleExceptionCalendar :one
+INSERT INTO schedule_exception_calendars (
+    id,
+    organization_id,
+    name,
+    description,
+    created_at,
+    updated_at
+) VALUES (
+    @id,
+    @organization_id,
+    @name,
+    @description,
+    @created_at,
+    @updated_at
+) RETURNING *;
+
+-- name: GetScheduleExceptionCalendarByID :one
+SELECT *
+FROM schedule_exception_calendars
+WHERE id = @id;
+
+-- name: GetScheduleExceptionCalendarsByOrganizationID :many
+SELECT *
+FROM schedule_exception_calendars
+WHERE organization_id = @organization_id
+ORDER BY name ASC;
+
+-- name: UpdateScheduleExceptionCalendarByID :one
+UPDATE schedule_exception_calendars
+SET
+    name = @name,
+    description = @description,
+    updated_at = @updated_at
+WHERE id = @id
+RETURNING *;
+
+-- name: DeleteScheduleExceptionCalendarByID :exec
+DELETE FROM schedule_exception_calendars
+WHERE id = @id;
+
+-- name: GetScheduleExceptionCalendarDates :many
+SELECT *
+FROM schedule_exception_calendar_dates
+WHERE calendar_id = @calendar_id
+ORDER BY start_date ASC, end_date ASC;
+
+-- name: DeleteScheduleExceptionCalendarDates :exec
+DELETE FROM schedule_exception_calendar_dates
+WHERE calendar_id = @calendar_id;
+
+-- name: InsertScheduleExceptionCalendarDates :exec
+INSERT INTO schedule_exception_calendar_dates (
+    calendar_id,
+    start_date,
+    end_date,
+    summary
+)
+SELECT
+    @calendar_id :: uuid AS calendar_id,
+    unnest(@start_dates :: date[]) AS start_date,
+    unnest(@end_dates :: date[]) AS end_date,
+    unnest(@summaries :: text[]) AS summary;
+
+-- name: GetScheduleExceptionCalendarsByTemplateID :many
+SELECT schedule_exception_calendars.*
+FROM schedule_exception_calendars
+JOIN template_schedule_exception_calendars
+    ON template_schedule_exception_calendars.calendar_id = schedule_exception_calendars.id
+WHERE template_schedule_exception_calendars.template_id = @template_id
+ORDER BY schedule_exception_calendars.name ASC;
+
+-- name: DeleteTemplateScheduleExceptionCalendars :exec
+DELETE FROM template_schedule_exception_calendars
+WHERE template_id = @template_id;
+
+-- name: InsertTemplateScheduleExceptionCalendars :exec
+INSERT INTO template_schedule_exception_calendars (template_id, calendar_id)
+SELECT @template_id :: uuid, unnest(@calendar_ids :: uuid[]);
+
+-- name: InsertUserOutOfOfficeRange :one
+INSERT INTO user_out_of_office_ranges (
+    id,
+    user_id,
+    start_date,
+    end_date,
+    reason,
+    created_at
+) VALUES (
+    @id,
+    @user_id,
+    @start_date,
+    @end_date,
+    @reason,
+    @created_at
+) RETURNING *;
+
+-- name: GetUserOutOfOfficeRangesByUserID :many
+SELECT *
+FROM user_out_of_office_ranges
+WHERE user_id = @user_id
+ORDER BY start_date ASC, end_date ASC;
+
+-- name: DeleteUserOutOfOfficeRange :exec
+DELETE FROM user_out_of_office_ranges
+WHERE id = @id AND user_id = @user_id;
+
+-- name: GetWorkspaceAutostartExceptions :many
+-- Returns the dates on which a workspace must not be started automatically:
+-- those in the exception calendars attached to its template, and the
+-- workspace owner's out of office ranges. Ranges that ended before @after are
+-- omitted.
+SELECT
+    schedule_exception_calendar_dates.start_date,
+    schedule_exception_calendar_dates.end_date,
+    schedule_exception_calendars.name AS source,
+    schedule_exception_calendar_dates.summary
+FROM workspaces
+JOIN template_schedule_exception_calendars
+    ON template_schedule_exception_calendars.template_id = workspaces.template_id
+JOIN schedule_exception_calendars
+    ON schedule_exception_calendars.id = template_schedule_exception_calendars.calendar_id
+JOIN schedule_exception_calendar_dates
+    ON schedule_exception_calendar_dates.calendar_id = schedule_exception_calendars.id
+WHERE
+    workspaces.id = @workspace_id
+    AND schedule_exception_calendar_dates.end_date >= @after :: date
+UNION ALL
+SELECT
+    user_out_of_office_ranges.start_date,
+    user_out_of_office_ranges.end_date,
+    'out-of-office' :: text AS source,
+    user_out_of_office_ranges.reason AS summary
+FROM workspaces
+JOIN user_out_of_office_ranges
+    ON user_out_of_office_ranges.user_id = workspaces.owner_id
+WHERE
+    workspaces.id = @workspace_id
+    AND user_out_of_office_ranges.end_date >= @after :: date
+ORDER BY start_date ASC, end_date ASC;
+
+-- name: GetWorkspaceAutostartExceptionsByWorkspaceIDs :many
+-- Like GetWorkspaceAutostartExceptions, but for several workspaces at once.
+SELECT
+    workspaces.id AS workspace_id,
+    schedule_exception_calendar_dates.start_date,
+    schedule_exception_calendar_dates.end_date,
+    schedule_exception_calendars.name AS source,
+    schedule_exception_calendar_dates.summary
+FROM workspaces
+JOIN template_schedule_exception_calendars
+    ON template_schedule_exception_calendars.template_id = workspaces.template_id
+JOIN schedule_exception_calendars
+    ON schedule_exception_calendars.id = template_schedule_exception_calendars.calendar_id
+JOIN schedule_exception_calendar_dates
+    ON schedule_exception_calendar_dates.calendar_id = schedule_exception_calendars.id
+WHERE
+    workspaces.id = ANY(@workspace_ids :: uuid[])
+    AND schedule_exception_calendar_dates.end_date >= @after :: date
+UNION ALL
+SELECT
+    workspaces.id AS workspace_id,
+    user_out_of_office_ranges.start_date,
+    user_out_of_office_ranges.end_date,
+    'out-of-office' :: text AS source,
+    user_out_of_office_ranges.reason AS summary
+FROM workspaces
+JOIN user_out_of_office_ranges
+    ON user_out_of_office_ranges.user_id = workspaces.owner_id
+WHERE
+    workspaces.id = ANY(@workspace_ids :: uuid[])
+    AND user_out_of_office_ranges.end_date >= @after :: date
+ORDER BY workspace_id ASC, start_date ASC, end_date ASC;
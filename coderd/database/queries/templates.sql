@@ -182,7 +182,8 @@ SET
 	use_classic_parameter_flow = $10,
 	cors_behavior = $11,
 	disable_module_cache = $12,
-	agents_allowed = $13
+	agents_allowed = $13,
+	drift_check_interval = $14
 WHERE
 	id = $1
 ;
@@ -0,0 +1,166 @@
+-- name: InsertTemplateVersionRollout :one
+INSERT INTO template_version_rollouts (
+	id,
+	template_id,
+	template_version_id,
+	previous_template_version_id,
+	percentage,
+	group_ids,
+	failure_threshold,
+	min_samples,
+	failure_action,
+	created_by,
+	created_at,
+	updated_at,
+	started_at
+)
+VALUES
+	($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12, $13)
+RETURNING *;
+
+-- name: GetTemplateVersionRolloutByID :one
+SELECT
+	*
+FROM
+	template_version_rollouts
+WHERE
+	id = $1;
+
+-- name: GetActiveTemplateVersionRolloutByTemplateID :one
+-- Returns the rollout of the template that is in progress or paused. There is
+-- at most one such rollout per template.
+SELECT
+	*
+FROM
+	template_version_rollouts
+WHERE
+	template_id = $1
+	AND status IN ('in_progress'::template_version_rollout_status, 'paused'::template_version_rollout_status);
+
+-- name: GetTemplateVersionRolloutsByTemplateID :many
+SELECT
+	*
+FROM
+	template_version_rollouts
+WHERE
+	template_id = $1
+ORDER BY
+	created_at DESC;
+
+-- name: GetInProgressTemplateVersionRollouts :many
+SELECT
+	*
+FROM
+	template_version_rollouts
+WHERE
+	status = 'in_progress'::template_version_rollout_status
+ORDER BY
+	created_at ASC;
+
+-- name: UpdateTemplateVersionRolloutByID :one
+UPDATE
+	template_version_rollouts
+SET
+	percentage = $2,
+	group_ids = $3,
+	failure_threshold = $4,
+	min_samples = $5,
+	failure_action = $6,
+	updated_at = $7
+WHERE
+	id = $1
+RETURNING *;
+
+-- name: UpdateTemplateVersionRolloutStatusByID :one
+UPDATE
+	template_version_rollouts
+SET
+	status = $2,
+	status_message = $3,
+	updated_at = $4,
+	started_at = $5,
+	finished_at = $6
+WHERE
+	id = $1
+RETURNING *;
+
+-- name: GetTemplateVersionRolloutTargetsUser :one
+-- Returns whether the user is a member of one of the groups targeted by the
+-- rollout. The "Everyone" group of an organization is included.
+SELECT EXISTS (
+	SELECT
+		1
+	FROM
+		group_members_expanded
+	JOIN
+		template_version_rollouts ON group_members_expanded.group_id = ANY(template_version_rollouts.group_ids)
+	WHERE
+		template_version_rollouts.id = @rollout_id
+		AND group_members_expanded.user_id = @user_id
+) :: boolean;
+
+-- name: GetTemplateVersionRolloutStats :one
+-- Returns the outcome of the start builds and agents of the rollout version
+-- since the rollout was started. Agents count as ready once they have been
+-- ready, and as failed if they errored or timed out without ever becoming
+-- ready. Agents that are still starting are not counted.
+SELECT
+	(
+		SELECT
+			COUNT(*)
+		FROM
+			workspace_builds
+		JOIN
+			provisioner_jobs ON provisioner_jobs.id = workspace_builds.job_id
+		WHERE
+			workspace_builds.template_version_id = @template_version_id
+			AND workspace_builds.transition = 'start'::workspace_transition
+			AND workspace_builds.created_at >= @started_at
+			AND provisioner_jobs.job_status = 'succeeded'::provisioner_job_status
+	) :: bigint AS builds_succeeded,
+	(
+		SELECT
+			COUNT(*)
+		FROM
+			workspace_builds
+		JOIN
+			provisioner_jobs ON provisioner_jobs.id = workspace_builds.job_id
+		WHERE
+			workspace_builds.template_version_id = @template_version_id
+			AND workspace_builds.transition = 'start'::workspace_transition
+			AND workspace_builds.created_at >= @started_at
+			AND provisioner_jobs.job_status = 'failed'::provisioner_job_status
+	) :: bigint AS builds_failed,
+	(
+		SELECT
+			COUNT(*)
+		FROM
+			workspace_agents
+		JOIN
+			workspace_resources ON workspace_resources.id = workspace_agents.resource_id
+		JOIN
+			workspace_builds ON workspace_builds.job_id = workspace_resources.job_id
+		WHERE
+			workspace_builds.template_version_id = @template_version_id
+			AND workspace_builds.transition = 'start'::workspace_transition
+			AND workspace_builds.created_at >= @started_at
+			AND workspace_agents.deleted = false
+			AND workspace_agents.ready_at IS NOT NULL
+	) :: bigint AS agents_ready,
+	(
+		SELECT
+			COUNT(*)
+		FROM
+			workspace_agents
+		JOIN
+			workspace_resources ON workspace_resources.id = workspace_agents.resource_id
+		JOIN
+			workspace_builds ON workspace_builds.job_id = workspace_resources.job_id
+		WHERE
+			workspace_builds.template_version_id = @template_version_id
+			AND workspace_builds.transition = 'start'::workspace_transition
+			AND workspace_builds.created_at >= @started_at
+			AND workspace_agents.deleted = false
+			AND workspace_agents.ready_at IS NULL
+			AND workspace_agents.lifecycle_state IN ('start_error'::workspace_agent_lifecycle_state, 'start_timeout'::workspace_agent_lifecycle_state)
+	) :: bigint AS agents_failed;
@@ -32,10 +32,11 @@ INSERT INTO
 		oauth_refresh_token,
 		oauth_refresh_token_key_id,
 		oauth_expiry,
-		claims
+		claims,
+		oidc_provider_id
 	)
 VALUES
-	( $1, $2, $3, $4, $5, $6, $7, $8, $9 ) RETURNING *;
+	( $1, $2, $3, $4, $5, $6, $7, $8, $9, $10 ) RETURNING *;
 
 -- name: UpdateUserLink :one
 UPDATE
@@ -46,9 +47,10 @@ SET
 	oauth_refresh_token = $3,
 	oauth_refresh_token_key_id = $4,
 	oauth_expiry = $5,
-	claims = $6
+	claims = $6,
+	oidc_provider_id = $7
 WHERE
-	user_id = $7 AND login_type = $8 RETURNING *;
+	user_id = $8 AND login_type = $9 RETURNING *;
 
 -- name: UpdateUserLinkedID :one
 -- Backfills linked_id for legacy user_links that were created before
@@ -57,7 +59,8 @@ WHERE
 UPDATE
 	user_links
 SET
-	linked_id = @linked_id
+	linked_id = @linked_id,
+	oidc_provider_id = @oidc_provider_id
 WHERE
 	user_id = @user_id AND login_type = @login_type AND linked_id = '' RETURNING *;
 
@@ -118,7 +121,8 @@ WHERE
 -- Groups OIDC user links by their issuer prefix (the part before "||" in
 -- linked_id) and returns a count for each. Empty linked_ids are reported
 -- with an empty issuer_prefix. Used for analysis before resetting
--- mismatched links.
+-- mismatched links. Links to named OIDC providers are excluded, since
+-- they are expected to carry a different issuer.
 SELECT
 	(CASE
 		WHEN user_links.linked_id = '' THEN ''
@@ -131,18 +135,21 @@ INNER JOIN
 	users ON user_links.user_id = users.id
 WHERE
 	user_links.login_type = 'oidc'
+	AND user_links.oidc_provider_id = ''
 	AND users.deleted = false
 GROUP BY issuer_prefix;
 
 -- name: UnlinkOIDCUsersByIssuerMismatch :execrows
 -- Resets linked_id to '' for OIDC links where the linked_id is non-empty
 -- and does not begin with the expected issuer prefix. This allows users to
--- re-authenticate under a new OIDC provider.
+-- re-authenticate under a new OIDC provider. Links to named OIDC
+-- providers are left untouched.
 UPDATE user_links
 SET linked_id = ''
 FROM users
 WHERE user_links.user_id = users.id
 	AND user_links.login_type = 'oidc'
+	AND user_links.oidc_provider_id = ''
 	AND user_links.linked_id != ''
 	AND NOT starts_with(user_links.linked_id, @expected_prefix)
 	AND users.deleted = false;
@@ -0,0 +1,61 @@
+-- name: InsertWorkspaceAgentPTYShare :one
+INSERT INTO
+	workspace_agent_pty_shares (
+		id,
+		agent_id,
+		reconnect_id,
+		created_by,
+		hashed_token,
+		user_ids,
+		created_at,
+		expires_at
+	)
+VALUES
+	($1, $2, $3, $4, $5, $6, $7, $8) RETURNING *;
+
+-- name: GetWorkspaceAgentPTYShareByID :one
+SELECT * FROM workspace_agent_pty_shares WHERE id = $1;
+
+-- name: GetWorkspaceAgentPTYShareByHashedToken :one
+SELECT * FROM workspace_agent_pty_shares WHERE hashed_token = $1;
+
+-- name: GetWorkspaceAgentPTYSharesByAgentID :many
+SELECT * FROM workspace_agent_pty_shares WHERE agent_id = $1 ORDER BY created_at DESC;
+
+-- name: RevokeWorkspaceAgentPTYShare :exec
+UPDATE workspace_agent_pty_shares
+SET revoked_at = @revoked_at::timestamptz
+WHERE id = @id AND revoked_at IS NULL;
+
+-- name: InsertWorkspaceAgentPTYShareViewer :one
+INSERT INTO
+	workspace_agent_pty_share_viewers (
+		id,
+		share_id,
+		user_id,
+		ip,
+		connected_at
+	)
+VALUES
+	($1, $2, $3, $4, $5) RETURNING *;
+
+-- name: UpdateWorkspaceAgentPTYShareViewerDisconnectedAt :exec
+UPDATE workspace_agent_pty_share_viewers
+SET disconnected_at = @disconnected_at::timestamptz
+WHERE id = @id;
+
+-- name: GetWorkspaceAgentPTYShareViewersByAgentID :many
+SELECT
+	workspace_agent_pty_share_viewers.*,
+	users.username,
+	users.avatar_url
+FROM
+	workspace_agent_pty_share_viewers
+JOIN
+	workspace_agent_pty_shares ON workspace_agent_pty_shares.id = workspace_agent_pty_share_viewers.share_id
+JOIN
+	users ON users.id = workspace_agent_pty_share_viewers.user_id
+WHERE
+	workspace_agent_pty_shares.agent_id = $1
+ORDER BY
+	workspace_agent_pty_share_viewers.connected_at DESC;
@@ -270,6 +270,16 @@ INSERT INTO
 -- name: GetWorkspaceAgentLogSourcesByAgentIDs :many
 SELECT * FROM workspace_agent_log_sources WHERE workspace_agent_id = ANY(@ids :: uuid [ ]);
 
+-- name: InsertWorkspaceAgentLogTail :one
+INSERT INTO
+	workspace_agent_log_tails (workspace_agent_id, log_source_id, created_at, paths, journald_units)
+VALUES
+	($1, $2, $3, $4, $5)
+RETURNING *;
+
+-- name: GetWorkspaceAgentLogTailsByAgentIDs :many
+SELECT * FROM workspace_agent_log_tails WHERE workspace_agent_id = ANY(@ids :: uuid [ ]);
+
 -- If an agent hasn't connected within the retention period, we purge its logs.
 -- Exception: if the logs are related to the latest build, we keep those around.
 -- Logs can take up a lot of space, so it's important we clean up frequently.
@@ -315,6 +325,62 @@ WITH
 	)
 DELETE FROM workspace_agent_logs WHERE agent_id IN (SELECT id FROM old_agents);
 
+-- name: GetOldWorkspaceAgentLogsForArchive :many
+-- Returns a page of the logs that DeleteOldWorkspaceAgentLogs will delete with
+-- the same threshold, as JSON, so they can be archived first.
+WITH
+	latest_builds AS (
+		SELECT
+			workspace_id, max(build_number) AS max_build_number
+		FROM
+			workspace_builds
+		GROUP BY
+			workspace_id
+	),
+	old_agents AS (
+		SELECT
+			wa.id
+		FROM
+			workspace_agents AS wa
+		JOIN
+			workspace_resources AS wr
+		ON
+			wa.resource_id = wr.id
+		JOIN
+			workspace_builds AS wb
+		ON
+			wb.job_id = wr.job_id
+		LEFT JOIN
+			latest_builds
+		ON
+			latest_builds.workspace_id = wb.workspace_id
+		AND
+			latest_builds.max_build_number = wb.build_number
+		WHERE
+			latest_builds.workspace_id IS NULL
+		AND CASE
+			WHEN wa.last_connected_at IS NOT NULL THEN
+				 wa.last_connected_at < @threshold :: timestamptz
+			ELSE wa.created_at < @threshold :: timestamptz
+		END
+	)
+SELECT
+	workspace_agent_logs.id,
+	to_jsonb(workspace_agent_logs) AS record
+FROM
+	workspace_agent_logs
+WHERE
+	agent_id IN (SELECT id FROM old_agents)
+	AND workspace_agent_logs.id > @after_id :: bigint
+ORDER BY
+	workspace_agent_logs.id ASC
+LIMIT
+	@limit_count;
+
+-- name: DeleteWorkspaceAgentLogsByIDs :execrows
+-- Deletes workspace agent logs that were archived before being purged.
+DELETE FROM workspace_agent_logs WHERE id = ANY(@ids :: bigint[]);
+
 -- name: GetWorkspaceAgentsInLatestBuildByWorkspaceID :many
 SELECT
 	workspace_agents.*
@@ -32,10 +32,12 @@ INSERT INTO
         hidden,
         open_in,
         display_group,
-        tooltip
+        tooltip,
+        healthcheck_address,
+        healthcheck_command
     )
 VALUES
-    ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12, $13, $14, $15, $16, $17, $18, $19, $20)
+    ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12, $13, $14, $15, $16, $17, $18, $19, $20, $21, $22)
 ON CONFLICT (id) DO UPDATE SET
     display_name = EXCLUDED.display_name,
     icon = EXCLUDED.icon,
@@ -54,7 +56,9 @@ ON CONFLICT (id) DO UPDATE SET
     display_group = EXCLUDED.display_group,
     agent_id = EXCLUDED.agent_id,
     slug = EXCLUDED.slug,
-    tooltip = EXCLUDED.tooltip
+    tooltip = EXCLUDED.tooltip,
+    healthcheck_address = EXCLUDED.healthcheck_address,
+    healthcheck_command = EXCLUDED.healthcheck_command
 WHERE
     -- Prevent cross-tenant/cross-workspace agent rebinding (SEC-91).
     -- App IDs persist across builds of the same workspace, but agent IDs are
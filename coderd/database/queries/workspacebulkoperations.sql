@@ -0,0 +1,200 @@
+-- name: InsertWorkspaceBulkOperation :one
+INSERT INTO workspace_bulk_operations (
+	id,
+	initiator_id,
+	action,
+	query,
+	autostart_schedule,
+	ttl_ms,
+	automatic_updates,
+	concurrency,
+	workspace_count,
+	created_at,
+	updated_at,
+	api_key_scopes,
+	api_key_allow_list
+)
+VALUES
+	($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12, $13)
+RETURNING *;
+
+-- name: InsertWorkspaceBulkOperationWorkspaces :exec
+INSERT INTO workspace_bulk_operation_workspaces (
+	operation_id,
+	workspace_id,
+	updated_at
+)
+SELECT
+	@operation_id :: uuid,
+	unnest(@workspace_ids :: uuid[]),
+	@updated_at :: timestamptz;
+
+-- name: GetWorkspaceBulkOperationByID :one
+SELECT
+	*
+FROM
+	workspace_bulk_operations
+WHERE
+	id = $1;
+
+-- name: GetWorkspaceBulkOperationsByInitiatorID :many
+SELECT
+	*
+FROM
+	workspace_bulk_operations
+WHERE
+	initiator_id = @initiator_id
+ORDER BY
+	created_at DESC
+LIMIT
+	@limit_opt :: integer;
+
+-- name: GetWorkspaceBulkOperationProgress :one
+SELECT
+	COUNT(*) FILTER (WHERE status = 'pending'::workspace_bulk_operation_workspace_status) AS pending,
+	COUNT(*) FILTER (WHERE status = 'running'::workspace_bulk_operation_workspace_status) AS running,
+	COUNT(*) FILTER (WHERE status = 'succeeded'::workspace_bulk_operation_workspace_status) AS succeeded,
+	COUNT(*) FILTER (WHERE status = 'failed'::workspace_bulk_operation_workspace_status) AS failed,
+	COUNT(*) FILTER (WHERE status = 'skipped'::workspace_bulk_operation_workspace_status) AS skipped
+FROM
+	workspace_bulk_operation_workspaces
+WHERE
+	operation_id = @operation_id;
+
+-- name: GetWorkspaceBulkOperationWorkspaces :many
+SELECT
+	workspace_bulk_operation_workspaces.operation_id,
+	workspace_bulk_operation_workspaces.workspace_id,
+	workspace_bulk_operation_workspaces.status,
+	workspace_bulk_operation_workspaces.build_id,
+	workspace_bulk_operation_workspaces.error,
+	workspace_bulk_operation_workspaces.updated_at,
+	workspaces.name AS workspace_name,
+	users.username AS workspace_owner_username
+FROM
+	workspace_bulk_operation_workspaces
+JOIN
+	workspaces ON workspaces.id = workspace_bulk_operation_workspaces.workspace_id
+JOIN
+	users ON users.id = workspaces.owner_id
+WHERE
+	workspace_bulk_operation_workspaces.operation_id = @operation_id
+ORDER BY
+	LOWER(users.username) ASC,
+	LOWER(workspaces.name) ASC;
+
+-- name: AcquireWorkspaceBulkOperationWorkspaces :many
+-- Marks the next pending workspaces of every running operation as running, so
+-- that no more than the concurrency of the operation are running at a time.
+-- Workspaces that have been running without an update since stale_before are
+-- acquired again, since the replica that processed them may have stopped.
+-- Callers must hold the LockIDWorkspaceBulkOperations lock so that concurrent
+-- callers do not exceed the concurrency of an operation.
+WITH running AS (
+	SELECT
+		operation_id,
+		COUNT(*) AS count
+	FROM
+		workspace_bulk_operation_workspaces
+	WHERE
+		status = 'running'::workspace_bulk_operation_workspace_status
+		AND updated_at >= @stale_before :: timestamptz
+	GROUP BY
+		operation_id
+), candidates AS (
+	SELECT
+		workspace_bulk_operation_workspaces.operation_id,
+		workspace_bulk_operation_workspaces.workspace_id,
+		ROW_NUMBER() OVER (
+			PARTITION BY workspace_bulk_operation_workspaces.operation_id
+			ORDER BY workspace_bulk_operation_workspaces.workspace_id
+		) AS position,
+		workspace_bulk_operations.concurrency - COALESCE(running.count, 0) AS available
+	FROM
+		workspace_bulk_operation_workspaces
+	JOIN
+		workspace_bulk_operations ON workspace_bulk_operations.id = workspace_bulk_operation_workspaces.operation_id
+	LEFT JOIN
+		running ON running.operation_id = workspace_bulk_operation_workspaces.operation_id
+	WHERE
+		workspace_bulk_operations.status = 'running'::workspace_bulk_operation_status
+		AND (
+			workspace_bulk_operation_workspaces.status = 'pending'::workspace_bulk_operation_workspace_status
+			OR (
+				workspace_bulk_operation_workspaces.status = 'running'::workspace_bulk_operation_workspace_status
+				AND workspace_bulk_operation_workspaces.updated_at < @stale_before :: timestamptz
+			)
+		)
+)
+UPDATE
+	workspace_bulk_operation_workspaces
+SET
+	status = 'running'::workspace_bulk_operation_workspace_status,
+	updated_at = @now :: timestamptz
+FROM
+	candidates
+WHERE
+	workspace_bulk_operation_workspaces.operation_id = candidates.operation_id
+	AND workspace_bulk_operation_workspaces.workspace_id = candidates.workspace_id
+	AND candidates.position <= candidates.available
+RETURNING
+	workspace_bulk_operation_workspaces.*;
+
+-- name: UpdateWorkspaceBulkOperationWorkspace :exec
+UPDATE
+	workspace_bulk_operation_workspaces
+SET
+	status = @status,
+	build_id = @build_id,
+	error = @error,
+	updated_at = @updated_at
+WHERE
+	operation_id = @operation_id
+	AND workspace_id = @workspace_id;
+
+-- name: UpdateWorkspaceBulkOperationCompleted :exec
+-- Marks the operation as completed once none of its workspaces are pending or
+-- running.
+UPDATE
+	workspace_bulk_operations
+SET
+	status = 'completed'::workspace_bulk_operation_status,
+	updated_at = @now :: timestamptz,
+	completed_at = @now :: timestamptz
+WHERE
+	id = @id
+	AND status = 'running'::workspace_bulk_operation_status
+	AND NOT EXISTS (
+		SELECT
+			1
+		FROM
+			workspace_bulk_operation_workspaces
+		WHERE
+			operation_id = @id
+			AND status IN ('pending'::workspace_bulk_operation_workspace_status, 'running'::workspace_bulk_operation_workspace_status)
+	);
+
+-- name: UpdateWorkspaceBulkOperationCanceled :one
+-- Cancels a running operation. Its pending workspaces are skipped, and
+-- workspaces that are already running are left to finish.
+WITH skipped AS (
+	UPDATE
+		workspace_bulk_operation_workspaces
+	SET
+		status = 'skipped'::workspace_bulk_operation_workspace_status,
+		error = @error :: text,
+		updated_at = @now :: timestamptz
+	WHERE
+		operation_id = @id
+		AND status = 'pending'::workspace_bulk_operation_workspace_status
+)
+UPDATE
+	workspace_bulk_operations
+SET
+	status = 'canceled'::workspace_bulk_operation_status,
+	updated_at = @now :: timestamptz,
+	completed_at = @now :: timestamptz
+WHERE
+	id = @id
+	AND status = 'running'::workspace_bulk_operation_status
+RETURNING *;
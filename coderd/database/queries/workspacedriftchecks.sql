@@ -0,0 +1,121 @@
+-- name: InsertWorkspaceDriftCheck :one
+INSERT INTO workspace_drift_checks (
+	id,
+	workspace_id,
+	workspace_build_id,
+	job_id,
+	created_at
+)
+VALUES
+	($1, $2, $3, $4, $5)
+RETURNING *;
+
+-- name: GetWorkspaceDriftCheckByJobID :one
+SELECT
+	*
+FROM
+	workspace_drift_checks
+WHERE
+	job_id = $1;
+
+-- name: UpdateWorkspaceDriftCheckByID :exec
+UPDATE
+	workspace_drift_checks
+SET
+	checked_at = $2,
+	drifted = $3,
+	resource_drift = $4
+WHERE
+	id = $1;
+
+-- name: GetLatestWorkspaceDriftChecksByWorkspaceIDs :many
+-- Returns the most recent successful drift check of each workspace. Checks
+-- that were run against a build other than the workspace's latest build are
+-- ignored, as a new build replaces the infrastructure that was checked.
+SELECT DISTINCT ON (workspace_drift_checks.workspace_id)
+	workspace_drift_checks.*
+FROM
+	workspace_drift_checks
+WHERE
+	workspace_drift_checks.workspace_id = ANY(@ids :: uuid[])
+	AND workspace_drift_checks.checked_at IS NOT NULL
+	AND workspace_drift_checks.workspace_build_id = (
+		SELECT
+			workspace_builds.id
+		FROM
+			workspace_builds
+		WHERE
+			workspace_builds.workspace_id = workspace_drift_checks.workspace_id
+		ORDER BY
+			workspace_builds.build_number DESC
+		LIMIT
+			1
+	)
+ORDER BY
+	workspace_drift_checks.workspace_id,
+	workspace_drift_checks.checked_at DESC;
+
+-- name: GetWorkspacesEligibleForDriftCheck :many
+-- Returns the running workspaces that are due for a drift check. A workspace
+-- is due when its template has drift detection enabled, its latest build
+-- successfully started it, and it has neither been checked within the
+-- template's drift check interval nor has a drift check that is still in
+-- progress. Dormant workspaces and prebuilds are never checked.
+SELECT
+	workspaces.id AS workspace_id,
+	workspaces.owner_id,
+	workspaces.organization_id,
+	workspaces.template_id,
+	latest_build.id AS workspace_build_id,
+	latest_build.template_version_id,
+	latest_build.provisioner,
+	latest_build.file_id,
+	latest_build.tags
+FROM
+	workspaces
+JOIN
+	templates ON templates.id = workspaces.template_id
+JOIN LATERAL (
+	SELECT
+		workspace_builds.id,
+		workspace_builds.template_version_id,
+		workspace_builds.transition,
+		provisioner_jobs.job_status,
+		provisioner_jobs.provisioner,
+		provisioner_jobs.file_id,
+		provisioner_jobs.tags
+	FROM
+		workspace_builds
+	JOIN
+		provisioner_jobs ON provisioner_jobs.id = workspace_builds.job_id
+	WHERE
+		workspace_builds.workspace_id = workspaces.id
+	ORDER BY
+		workspace_builds.build_number DESC
+	LIMIT
+		1
+) latest_build ON TRUE
+WHERE
+	workspaces.deleted = false
+	AND workspaces.dormant_at IS NULL
+	AND workspaces.owner_id != 'c42fdf75-3097-471c-8c33-fb52454d81c0'::uuid -- The prebuilds system user.
+	AND templates.deleted = false
+	AND templates.drift_check_interval > 0
+	AND latest_build.transition = 'start'::workspace_transition
+	AND latest_build.job_status = 'succeeded'::provisioner_job_status
+	AND NOT EXISTS (
+		SELECT
+			1
+		FROM
+			workspace_drift_checks
+		JOIN
+			provisioner_jobs ON provisioner_jobs.id = workspace_drift_checks.job_id
+		WHERE
+			workspace_drift_checks.workspace_id = workspaces.id
+			AND (
+				provisioner_jobs.completed_at IS NULL
+				OR workspace_drift_checks.created_at > @now :: timestamptz - (INTERVAL '1 millisecond' * (templates.drift_check_interval / 1000000))
+			)
+	)
+ORDER BY
+	workspaces.id;
@@ -64,6 +64,9 @@ const (
 	UniqueNotificationTemplatesPkey                           UniqueConstraint = "notification_templates_pkey"                                     // ALTER TABLE ONLY notification_templates ADD CONSTRAINT notification_templates_pkey PRIMARY KEY (id);
 	UniqueOauth2ProviderAppCodesPkey                          UniqueConstraint = "oauth2_provider_app_codes_pkey"                                  // ALTER TABLE ONLY oauth2_provider_app_codes ADD CONSTRAINT oauth2_provider_app_codes_pkey PRIMARY KEY (id);
 	UniqueOauth2ProviderAppCodesSecretPrefixKey               UniqueConstraint = "oauth2_provider_app_codes_secret_prefix_key"                     // ALTER TABLE ONLY oauth2_provider_app_codes ADD CONSTRAINT oauth2_provider_app_codes_secret_prefix_key UNIQUE (secret_prefix);
+	UniqueOauth2ProviderAppDeviceCodesDeviceCodePrefixKey     UniqueConstraint = "oauth2_provider_app_device_codes_device_code_prefix_key"         // ALTER TABLE ONLY oauth2_provider_app_device_codes ADD CONSTRAINT oauth2_provider_app_device_codes_device_code_prefix_key UNIQUE (device_code_prefix);
+	UniqueOauth2ProviderAppDeviceCodesPkey                    UniqueConstraint = "oauth2_provider_app_device_codes_pkey"                           // ALTER TABLE ONLY oauth2_provider_app_device_codes ADD CONSTRAINT oauth2_provider_app_device_codes_pkey PRIMARY KEY (id);
+	UniqueOauth2ProviderAppDeviceCodesUserCodeKey             UniqueConstraint = "oauth2_provider_app_device_codes_user_code_key"                  // ALTER TABLE ONLY oauth2_provider_app_device_codes ADD CONSTRAINT oauth2_provider_app_device_codes_user_code_key UNIQUE (user_code);
 	UniqueOauth2ProviderAppSecretsPkey                        UniqueConstraint = "oauth2_provider_app_secrets_pkey"                                // ALTER TABLE ONLY oauth2_provider_app_secrets ADD CONSTRAINT oauth2_provider_app_secrets_pkey PRIMARY KEY (id);
 	UniqueOauth2ProviderAppSecretsSecretPrefixKey             UniqueConstraint = "oauth2_provider_app_secrets_secret_prefix_key"                   // ALTER TABLE ONLY oauth2_provider_app_secrets ADD CONSTRAINT oauth2_provider_app_secrets_secret_prefix_key UNIQUE (secret_prefix);
 	UniqueOauth2ProviderAppTokensHashPrefixKey                UniqueConstraint = "oauth2_provider_app_tokens_hash_prefix_key"                      // ALTER TABLE ONLY oauth2_provider_app_tokens ADD CONSTRAINT oauth2_provider_app_tokens_hash_prefix_key UNIQUE (hash_prefix);
@@ -77,8 +80,13 @@ const (
 	UniqueParameterValuesScopeIDNameKey                       UniqueConstraint = "parameter_values_scope_id_name_key"                              // ALTER TABLE ONLY parameter_values ADD CONSTRAINT parameter_values_scope_id_name_key UNIQUE (scope_id, name);
 	UniqueProvisionerDaemonsPkey                              UniqueConstraint = "provisioner_daemons_pkey"                                        // ALTER TABLE ONLY provisioner_daemons ADD CONSTRAINT provisioner_daemons_pkey PRIMARY KEY (id);
 	UniqueProvisionerJobLogsPkey                              UniqueConstraint = "provisioner_job_logs_pkey"                                       // ALTER TABLE ONLY provisioner_job_logs ADD CONSTRAINT provisioner_job_logs_pkey PRIMARY KEY (id);
+	UniqueProvisionerJobPrioritiesPkey                        UniqueConstraint = "provisioner_job_priorities_pkey"                                 // ALTER TABLE ONLY provisioner_job_priorities ADD CONSTRAINT provisioner_job_priorities_pkey PRIMARY KEY (organization_id, template_id);
 	UniqueProvisionerJobsPkey                                 UniqueConstraint = "provisioner_jobs_pkey"                                           // ALTER TABLE ONLY provisioner_jobs ADD CONSTRAINT provisioner_jobs_pkey PRIMARY KEY (id);
 	UniqueProvisionerKeysPkey                                 UniqueConstraint = "provisioner_keys_pkey"                                           // ALTER TABLE ONLY provisioner_keys ADD CONSTRAINT provisioner_keys_pkey PRIMARY KEY (id);
+	UniquePurgeArchiveImportsNameKey                          UniqueConstraint = "purge_archive_imports_name_key"                                  // ALTER TABLE ONLY purge_archive_imports ADD CONSTRAINT purge_archive_imports_name_key UNIQUE (name);
+	UniquePurgeArchiveImportsPkey                             UniqueConstraint = "purge_archive_imports_pkey"                                      // ALTER TABLE ONLY purge_archive_imports ADD CONSTRAINT purge_archive_imports_pkey PRIMARY KEY (id);
+	UniqueScheduleExceptionCalendarsOrganizationIDNameKey     UniqueConstraint = "schedule_exception_calendars_organization_id_name_key"           // ALTER TABLE ONLY schedule_exception_calendars ADD CONSTRAINT schedule_exception_calendars_organization_id_name_key UNIQUE (organization_id, name);
+	UniqueScheduleExceptionCalendarsPkey                      UniqueConstraint = "schedule_exception_calendars_pkey"                               // ALTER TABLE ONLY schedule_exception_calendars ADD CONSTRAINT schedule_exception_calendars_pkey PRIMARY KEY (id);
 	UniqueSiteConfigsKeyKey                                   UniqueConstraint = "site_configs_key_key"                                            // ALTER TABLE ONLY site_configs ADD CONSTRAINT site_configs_key_key UNIQUE (key);
 	UniqueTailnetCoordinatorsPkey                             UniqueConstraint = "tailnet_coordinators_pkey"                                       // ALTER TABLE ONLY tailnet_coordinators ADD CONSTRAINT tailnet_coordinators_pkey PRIMARY KEY (id);
 	UniqueTailnetPeersPkey                                    UniqueConstraint = "tailnet_peers_pkey"                                              // ALTER TABLE ONLY tailnet_peers ADD CONSTRAINT tailnet_peers_pkey PRIMARY KEY (id, coordinator_id);
@@ -88,12 +96,14 @@ const (
 	UniqueTasksPkey                                           UniqueConstraint = "tasks_pkey"                                                      // ALTER TABLE ONLY tasks ADD CONSTRAINT tasks_pkey PRIMARY KEY (id);
 	UniqueTelemetryItemsPkey                                  UniqueConstraint = "telemetry_items_pkey"                                            // ALTER TABLE ONLY telemetry_items ADD CONSTRAINT telemetry_items_pkey PRIMARY KEY (key);
 	UniqueTelemetryLocksPkey                                  UniqueConstraint = "telemetry_locks_pkey"                                            // ALTER TABLE ONLY telemetry_locks ADD CONSTRAINT telemetry_locks_pkey PRIMARY KEY (event_type, period_ending_at);
+	UniqueTemplateScheduleExceptionCalendarsPkey              UniqueConstraint = "template_schedule_exception_calendars_pkey"                      // ALTER TABLE ONLY template_schedule_exception_calendars ADD CONSTRAINT template_schedule_exception_calendars_pkey PRIMARY KEY (template_id, calendar_id);
 	UniqueTemplateUsageStatsPkey                              UniqueConstraint = "template_usage_stats_pkey"                                       // ALTER TABLE ONLY template_usage_stats ADD CONSTRAINT template_usage_stats_pkey PRIMARY KEY (start_time, template_id, user_id);
 	UniqueTemplateVersionParametersTemplateVersionIDNameKey   UniqueConstraint = "template_version_parameters_template_version_id_name_key"        // ALTER TABLE ONLY template_version_parameters ADD CONSTRAINT template_version_parameters_template_version_id_name_key UNIQUE (template_version_id, name);
 	UniqueTemplateVersionPresetParametersPkey                 UniqueConstraint = "template_version_preset_parameters_pkey"                         // ALTER TABLE ONLY template_version_preset_parameters ADD CONSTRAINT template_version_preset_parameters_pkey PRIMARY KEY (id);
 	UniqueTemplateVersionPresetPrebuildSchedulesPkey          UniqueConstraint = "template_version_preset_prebuild_schedules_pkey"                 // ALTER TABLE ONLY template_version_preset_prebuild_schedules ADD CONSTRAINT template_version_preset_prebuild_schedules_pkey PRIMARY KEY (id);
 	UniqueTemplateVersionPresetsIDTemplateVersionIDKey        UniqueConstraint = "template_version_presets_id_template_version_id_key"             // ALTER TABLE ONLY template_version_presets ADD CONSTRAINT template_version_presets_id_template_version_id_key UNIQUE (id, template_version_id);
 	UniqueTemplateVersionPresetsPkey                          UniqueConstraint = "template_version_presets_pkey"                                   // ALTER TABLE ONLY template_version_presets ADD CONSTRAINT template_version_presets_pkey PRIMARY KEY (id);
+	UniqueTemplateVersionRolloutsPkey                         UniqueConstraint = "template_version_rollouts_pkey"                                  // ALTER TABLE ONLY template_version_rollouts ADD CONSTRAINT template_version_rollouts_pkey PRIMARY KEY (id);
 	UniqueTemplateVersionTerraformValuesTemplateVersionIDKey  UniqueConstraint = "template_version_terraform_values_template_version_id_key"       // ALTER TABLE ONLY template_version_terraform_values ADD CONSTRAINT template_version_terraform_values_template_version_id_key UNIQUE (template_version_id);
 	UniqueTemplateVersionVariablesTemplateVersionIDNameKey    UniqueConstraint = "template_version_variables_template_version_id_name_key"         // ALTER TABLE ONLY template_version_variables ADD CONSTRAINT template_version_variables_template_version_id_name_key UNIQUE (template_version_id, name);
 	UniqueTemplateVersionWorkspaceTagsTemplateVersionIDKeyKey UniqueConstraint = "template_version_workspace_tags_template_version_id_key_key"     // ALTER TABLE ONLY template_version_workspace_tags ADD CONSTRAINT template_version_workspace_tags_template_version_id_key_key UNIQUE (template_version_id, key);
@@ -108,6 +118,7 @@ const (
 	UniqueUserConfigsPkey                                     UniqueConstraint = "user_configs_pkey"                                               // ALTER TABLE ONLY user_configs ADD CONSTRAINT user_configs_pkey PRIMARY KEY (user_id, key);
 	UniqueUserDeletedPkey                                     UniqueConstraint = "user_deleted_pkey"                                               // ALTER TABLE ONLY user_deleted ADD CONSTRAINT user_deleted_pkey PRIMARY KEY (id);
 	UniqueUserLinksPkey                                       UniqueConstraint = "user_links_pkey"                                                 // ALTER TABLE ONLY user_links ADD CONSTRAINT user_links_pkey PRIMARY KEY (user_id, login_type);
+	UniqueUserOutOfOfficeRangesPkey                           UniqueConstraint = "user_out_of_office_ranges_pkey"                                  // ALTER TABLE ONLY user_out_of_office_ranges ADD CONSTRAINT user_out_of_office_ranges_pkey PRIMARY KEY (id);
 	UniqueUserSecretsPkey                                     UniqueConstraint = "user_secrets_pkey"                                               // ALTER TABLE ONLY user_secrets ADD CONSTRAINT user_secrets_pkey PRIMARY KEY (id);
 	UniqueUserSkillsPkey                                      UniqueConstraint = "user_skills_pkey"                                                // ALTER TABLE ONLY user_skills ADD CONSTRAINT user_skills_pkey PRIMARY KEY (id);
 	UniqueUserStatusChangesPkey                               UniqueConstraint = "user_status_changes_pkey"                                        // ALTER TABLE ONLY user_status_changes ADD CONSTRAINT user_status_changes_pkey PRIMARY KEY (id);
@@ -117,9 +128,13 @@ const (
 	UniqueWorkspaceAgentContextSnapshotsPkey                  UniqueConstraint = "workspace_agent_context_snapshots_pkey"                          // ALTER TABLE ONLY workspace_agent_context_snapshots ADD CONSTRAINT workspace_agent_context_snapshots_pkey PRIMARY KEY (workspace_agent_id);
 	UniqueWorkspaceAgentDevcontainersPkey                     UniqueConstraint = "workspace_agent_devcontainers_pkey"                              // ALTER TABLE ONLY workspace_agent_devcontainers ADD CONSTRAINT workspace_agent_devcontainers_pkey PRIMARY KEY (id);
 	UniqueWorkspaceAgentLogSourcesPkey                        UniqueConstraint = "workspace_agent_log_sources_pkey"                                // ALTER TABLE ONLY workspace_agent_log_sources ADD CONSTRAINT workspace_agent_log_sources_pkey PRIMARY KEY (workspace_agent_id, id);
+	UniqueWorkspaceAgentLogTailsPkey                          UniqueConstraint = "workspace_agent_log_tails_pkey"                                  // ALTER TABLE ONLY workspace_agent_log_tails ADD CONSTRAINT workspace_agent_log_tails_pkey PRIMARY KEY (workspace_agent_id, log_source_id);
 	UniqueWorkspaceAgentMemoryResourceMonitorsPkey            UniqueConstraint = "workspace_agent_memory_resource_monitors_pkey"                   // ALTER TABLE ONLY workspace_agent_memory_resource_monitors ADD CONSTRAINT workspace_agent_memory_resource_monitors_pkey PRIMARY KEY (agent_id);
 	UniqueWorkspaceAgentMetadataPkey                          UniqueConstraint = "workspace_agent_metadata_pkey"                                   // ALTER TABLE ONLY workspace_agent_metadata ADD CONSTRAINT workspace_agent_metadata_pkey PRIMARY KEY (workspace_agent_id, key);
 	UniqueWorkspaceAgentPortSharePkey                         UniqueConstraint = "workspace_agent_port_share_pkey"                                 // ALTER TABLE ONLY workspace_agent_port_share ADD CONSTRAINT workspace_agent_port_share_pkey PRIMARY KEY (workspace_id, agent_name, port);
+	UniqueWorkspaceAgentPtyShareViewersPkey                   UniqueConstraint = "workspace_agent_pty_share_viewers_pkey"                          // ALTER TABLE ONLY workspace_agent_pty_share_viewers ADD CONSTRAINT workspace_agent_pty_share_viewers_pkey PRIMARY KEY (id);
+	UniqueWorkspaceAgentPtySharesPkey                         UniqueConstraint = "workspace_agent_pty_shares_pkey"                                 // ALTER TABLE ONLY workspace_agent_pty_shares ADD CONSTRAINT workspace_agent_pty_shares_pkey PRIMARY KEY (id);
+	UniqueWorkspaceAgentResourceMonitorsPkey                  UniqueConstraint = "workspace_agent_resource_monitors_pkey"                          // ALTER TABLE ONLY workspace_agent_resource_monitors ADD CONSTRAINT workspace_agent_resource_monitors_pkey PRIMARY KEY (agent_id, type, path);
 	UniqueWorkspaceAgentScriptTimingsScriptIDStartedAtKey     UniqueConstraint = "workspace_agent_script_timings_script_id_started_at_key"         // ALTER TABLE ONLY workspace_agent_script_timings ADD CONSTRAINT workspace_agent_script_timings_script_id_started_at_key UNIQUE (script_id, started_at);
 	UniqueWorkspaceAgentScriptsIDKey                          UniqueConstraint = "workspace_agent_scripts_id_key"                                  // ALTER TABLE ONLY workspace_agent_scripts ADD CONSTRAINT workspace_agent_scripts_id_key UNIQUE (id);
 	UniqueWorkspaceAgentStartupLogsPkey                       UniqueConstraint = "workspace_agent_startup_logs_pkey"                               // ALTER TABLE ONLY workspace_agent_logs ADD CONSTRAINT workspace_agent_startup_logs_pkey PRIMARY KEY (id);
@@ -140,6 +155,10 @@ const (
 	UniqueWorkspaceBuildsJobIDKey                             UniqueConstraint = "workspace_builds_job_id_key"                                     // ALTER TABLE ONLY workspace_builds ADD CONSTRAINT workspace_builds_job_id_key UNIQUE (job_id);
 	UniqueWorkspaceBuildsPkey                                 UniqueConstraint = "workspace_builds_pkey"                                           // ALTER TABLE ONLY workspace_builds ADD CONSTRAINT workspace_builds_pkey PRIMARY KEY (id);
 	UniqueWorkspaceBuildsWorkspaceIDBuildNumberKey            UniqueConstraint = "workspace_builds_workspace_id_build_number_key"                  // ALTER TABLE ONLY workspace_builds ADD CONSTRAINT workspace_builds_workspace_id_build_number_key UNIQUE (workspace_id, build_number);
+	UniqueWorkspaceBulkOperationWorkspacesPkey                UniqueConstraint = "workspace_bulk_operation_workspaces_pkey"                        // ALTER TABLE ONLY workspace_bulk_operation_workspaces ADD CONSTRAINT workspace_bulk_operation_workspaces_pkey PRIMARY KEY (operation_id, workspace_id);
+	UniqueWorkspaceBulkOperationsPkey                         UniqueConstraint = "workspace_bulk_operations_pkey"                                  // ALTER TABLE ONLY workspace_bulk_operations ADD CONSTRAINT workspace_bulk_operations_pkey PRIMARY KEY (id);
+	UniqueWorkspaceDriftChecksJobIDKey                        UniqueConstraint = "workspace_drift_checks_job_id_key"                               // ALTER TABLE ONLY workspace_drift_checks ADD CONSTRAINT workspace_drift_checks_job_id_key UNIQUE (job_id);
+	UniqueWorkspaceDriftChecksPkey                            UniqueConstraint = "workspace_drift_checks_pkey"                                     // ALTER TABLE ONLY workspace_drift_checks ADD CONSTRAINT workspace_drift_checks_pkey PRIMARY KEY (id);
 	UniqueWorkspaceProxiesPkey                                UniqueConstraint = "workspace_proxies_pkey"                                          // ALTER TABLE ONLY workspace_proxies ADD CONSTRAINT workspace_proxies_pkey PRIMARY KEY (id);
 	UniqueWorkspaceProxiesRegionIDUnique                      UniqueConstraint = "workspace_proxies_region_id_unique"                              // ALTER TABLE ONLY workspace_proxies ADD CONSTRAINT workspace_proxies_region_id_unique UNIQUE (region_id);
 	UniqueWorkspaceResourceMetadataName                       UniqueConstraint = "workspace_resource_metadata_name"                                // ALTER TABLE ONLY workspace_resource_metadata ADD CONSTRAINT workspace_resource_metadata_name UNIQUE (workspace_resource_id, key);
@@ -168,6 +187,7 @@ const (
 	UniqueProvisionerKeysOrganizationIDNameIndex              UniqueConstraint = "provisioner_keys_organization_id_name_idx"                       // CREATE UNIQUE INDEX provisioner_keys_organization_id_name_idx ON provisioner_keys USING btree (organization_id, lower((name)::text));
 	UniqueTasksOwnerIDNameUniqueIndex                         UniqueConstraint = "tasks_owner_id_name_unique_idx"                                  // CREATE UNIQUE INDEX tasks_owner_id_name_unique_idx ON tasks USING btree (owner_id, lower(name)) WHERE (deleted_at IS NULL);
 	UniqueTemplateUsageStatsStartTimeTemplateIDUserIDIndex    UniqueConstraint = "template_usage_stats_start_time_template_id_user_id_idx"         // CREATE UNIQUE INDEX template_usage_stats_start_time_template_id_user_id_idx ON template_usage_stats USING btree (start_time, template_id, user_id);
+	UniqueTemplateVersionRolloutsTemplateIDActiveIndex        UniqueConstraint = "template_version_rollouts_template_id_active_idx"                // CREATE UNIQUE INDEX template_version_rollouts_template_id_active_idx ON template_version_rollouts USING btree (template_id) WHERE (status = ANY (ARRAY['in_progress'::template_version_rollout_status, 'paused'::template_version_rollout_status]));
 	UniqueTemplatesOrganizationIDNameIndex                    UniqueConstraint = "templates_organization_id_name_idx"                              // CREATE UNIQUE INDEX templates_organization_id_name_idx ON templates USING btree (organization_id, lower((name)::text)) WHERE (deleted = false);
 	UniqueUserLinksLinkedIDLoginTypeIndex                     UniqueConstraint = "user_links_linked_id_login_type_idx"                             // CREATE UNIQUE INDEX user_links_linked_id_login_type_idx ON user_links USING btree (linked_id, login_type) WHERE (linked_id <> ''::text);
 	UniqueUserSecretsUserEnvNameIndex                         UniqueConstraint = "user_secrets_user_env_name_idx"                                  // CREATE UNIQUE INDEX user_secrets_user_env_name_idx ON user_secrets USING btree (user_id, env_name) WHERE (env_name <> ''::text);
@@ -177,6 +197,7 @@ const (
 	UniqueUsersEmailLowerIndex                                UniqueConstraint = "users_email_lower_idx"                                           // CREATE UNIQUE INDEX users_email_lower_idx ON users USING btree (lower(email)) WHERE ((deleted = false) AND (email <> ''::text));
 	UniqueUsersUsernameLowerIndex                             UniqueConstraint = "users_username_lower_idx"                                        // CREATE UNIQUE INDEX users_username_lower_idx ON users USING btree (lower(username)) WHERE (deleted = false);
 	UniqueWebpushSubscriptionsUserIDEndpointIndex             UniqueConstraint = "webpush_subscriptions_user_id_endpoint_idx"                      // CREATE UNIQUE INDEX webpush_subscriptions_user_id_endpoint_idx ON webpush_subscriptions USING btree (user_id, endpoint);
+	UniqueWorkspaceAgentPtySharesHashedTokenIndex             UniqueConstraint = "workspace_agent_pty_shares_hashed_token_idx"                     // CREATE UNIQUE INDEX workspace_agent_pty_shares_hashed_token_idx ON workspace_agent_pty_shares USING btree (hashed_token);
 	UniqueWorkspaceAppAuditSessionsUniqueIndex                UniqueConstraint = "workspace_app_audit_sessions_unique_index"                       // CREATE UNIQUE INDEX workspace_app_audit_sessions_unique_index ON workspace_app_audit_sessions USING btree (agent_id, app_id, user_id, ip, user_agent, slug_or_port, status_code);
 	UniqueWorkspaceProxiesLowerNameIndex                      UniqueConstraint = "workspace_proxies_lower_name_idx"                                // CREATE UNIQUE INDEX workspace_proxies_lower_name_idx ON workspace_proxies USING btree (lower(name)) WHERE (deleted = false);
 	UniqueWorkspacesOwnerIDLowerIndex                         UniqueConstraint = "workspaces_owner_id_lower_idx"                                   // CREATE UNIQUE INDEX workspaces_owner_id_lower_idx ON workspaces USING btree (owner_id, lower((name)::text)) WHERE (deleted = false);
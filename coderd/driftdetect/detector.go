@@ -0,0 +1,226 @@
+package driftdetect
+
+import (
+	"context"
+	"encoding/json"
+	"time"
+
+	"github.com/google/uuid"
+	"golang.org/x/xerrors"
+
+	"cdr.dev/slog/v3"
+	"github.com/coder/coder/v2/coderd/database"
+	"github.com/coder/coder/v2/coderd/database/dbauthz"
+	"github.com/coder/coder/v2/coderd/database/dbtime"
+	"github.com/coder/coder/v2/coderd/database/provisionerjobs"
+	"github.com/coder/coder/v2/coderd/database/pubsub"
+	"github.com/coder/coder/v2/coderd/provisionerdserver"
+	"github.com/coder/coder/v2/codersdk"
+)
+
+const (
+	// Interval is how often the detector looks for workspaces that are due
+	// for a drift check. How often each workspace is checked is configured
+	// per template.
+	Interval = 10 * time.Minute
+
+	// MaxChecksPerRun is the maximum number of drift checks that the detector
+	// schedules in a single run, so that a large deployment does not flood
+	// the provisioner queue. The remaining workspaces are picked up by the
+	// following runs.
+	MaxChecksPerRun = 50
+)
+
+// Detector periodically schedules drift check jobs for running workspaces.
+// A drift check is a refresh-only plan that runs through the regular
+// provisioner pipeline and reports the resources that were changed outside of
+// Coder, without modifying the workspace.
+type Detector struct {
+	ctx    context.Context
+	cancel context.CancelFunc
+	done   chan struct{}
+
+	db     database.Store
+	pubsub pubsub.Pubsub
+	log    slog.Logger
+	tick   <-chan time.Time
+	stats  chan<- Stats
+}
+
+// Stats contains statistics about the last run of the detector.
+type Stats struct {
+	// ScheduledWorkspaceIDs contains the IDs of all workspaces a drift check
+	// was scheduled for.
+	ScheduledWorkspaceIDs []uuid.UUID
+	// Error is the fatal error that occurred during the last run of the
+	// detector, if any.
+	Error error
+}
+
+// New returns a new drift detector.
+func New(ctx context.Context, db database.Store, pub pubsub.Pubsub, log slog.Logger, tick <-chan time.Time) *Detector {
+	//nolint:gocritic // The detector schedules jobs for all workspaces.
+	ctx, cancel := context.WithCancel(dbauthz.AsSystemRestricted(ctx))
+	return &Detector{
+		ctx:    ctx,
+		cancel: cancel,
+		done:   make(chan struct{}),
+		db:     db,
+		pubsub: pub,
+		log:    log,
+		tick:   tick,
+		stats:  nil,
+	}
+}
+
+// WithStatsChannel will cause the detector to push Stats to ch after every
+// tick. This push is blocking, so if ch is not read, the detector will hang.
+// This should only be used in tests.
+func (d *Detector) WithStatsChannel(ch chan<- Stats) *Detector {
+	d.stats = ch
+	return d
+}
+
+// Start will cause the detector to schedule drift checks on every tick from
+// its channel. It will stop when its context is Done, or when its channel is
+// closed.
+//
+// Start should only be called once.
+func (d *Detector) Start() {
+	go func() {
+		defer close(d.done)
+		defer d.cancel()
+
+		for {
+			select {
+			case <-d.ctx.Done():
+				return
+			case t, ok := <-d.tick:
+				if !ok {
+					return
+				}
+				stats := d.run(t)
+				if stats.Error != nil {
+					d.log.Warn(d.ctx, "error running workspace drift detector once", slog.Error(stats.Error))
+				}
+				if d.stats != nil {
+					select {
+					case <-d.ctx.Done():
+						return
+					case d.stats <- stats:
+					}
+				}
+			}
+		}
+	}()
+}
+
+// Close will stop the detector.
+func (d *Detector) Close() {
+	d.cancel()
+	<-d.done
+}
+
+func (d *Detector) run(t time.Time) Stats {
+	ctx, cancel := context.WithTimeout(d.ctx, 5*time.Minute)
+	defer cancel()
+
+	stats := Stats{
+		ScheduledWorkspaceIDs: []uuid.UUID{},
+		Error:                 nil,
+	}
+
+	var jobs []database.ProvisionerJob
+	err := d.db.InTx(func(db database.Store) error {
+		// Only one replica schedules checks at a time, otherwise the same
+		// workspace could be checked by several jobs at once.
+		ok, err := db.TryAcquireLock(ctx, database.LockIDWorkspaceDriftChecks)
+		if err != nil {
+			return xerrors.Errorf("acquire lock: %w", err)
+		}
+		if !ok {
+			return nil
+		}
+
+		workspaces, err := db.GetWorkspacesEligibleForDriftCheck(ctx, t)
+		if err != nil {
+			return xerrors.Errorf("get workspaces eligible for drift check: %w", err)
+		}
+		if len(workspaces) > MaxChecksPerRun {
+			workspaces = workspaces[:MaxChecksPerRun]
+		}
+
+		for _, workspace := range workspaces {
+			job, err := scheduleDriftCheck(ctx, db, workspace)
+			if err != nil {
+				return xerrors.Errorf("schedule drift check for workspace %s: %w", workspace.WorkspaceID, err)
+			}
+			jobs = append(jobs, job)
+			stats.ScheduledWorkspaceIDs = append(stats.ScheduledWorkspaceIDs, workspace.WorkspaceID)
+		}
+		return nil
+	}, nil)
+	if err != nil {
+		stats.ScheduledWorkspaceIDs = []uuid.UUID{}
+		stats.Error = err
+		return stats
+	}
+
+	for _, job := range jobs {
+		err = provisionerjobs.PostJob(d.pubsub, job)
+		if err != nil {
+			// Client probably doesn't care about this error, so just log it.
+			d.log.Error(ctx, "failed to post provisioner job to pubsub", slog.F("job_id", job.ID), slog.Error(err))
+		}
+	}
+	if len(jobs) > 0 {
+		d.log.Debug(ctx, "scheduled workspace drift checks", slog.F("count", len(jobs)))
+	}
+
+	return stats
+}
+
+// scheduleDriftCheck inserts a drift check job for the latest build of the
+// workspace. The job runs on the same provisioners, with the same files, as
+// the build it checks.
+func scheduleDriftCheck(ctx context.Context, db database.Store, workspace database.GetWorkspacesEligibleForDriftCheckRow) (database.ProvisionerJob, error) {
+	input, err := json.Marshal(provisionerdserver.WorkspaceDriftCheckJob{
+		WorkspaceBuildID: workspace.WorkspaceBuildID,
+	})
+	if err != nil {
+		return database.ProvisionerJob{}, xerrors.Errorf("marshal job input: %w", err)
+	}
+
+	now := dbtime.Now()
+	job, err := db.InsertProvisionerJob(ctx, database.InsertProvisionerJobParams{
+		ID:             uuid.New(),
+		CreatedAt:      now,
+		UpdatedAt:      now,
+		OrganizationID: workspace.OrganizationID,
+		InitiatorID:    workspace.OwnerID,
+		Provisioner:    workspace.Provisioner,
+		StorageMethod:  database.ProvisionerStorageMethodFile,
+		FileID:         workspace.FileID,
+		Type:           database.ProvisionerJobTypeWorkspaceDriftCheck,
+		Input:          input,
+		Tags:           workspace.Tags,
+		LogsOverflowed: false,
+		Priority:       codersdk.ProvisionerJobPriorityAutomated,
+		TemplateID:     workspace.TemplateID,
+	})
+	if err != nil {
+		return database.ProvisionerJob{}, xerrors.Errorf("insert provisioner job: %w", err)
+	}
+
+	_, err = db.InsertWorkspaceDriftCheck(ctx, database.InsertWorkspaceDriftCheckParams{
+		ID:               uuid.New(),
+		WorkspaceID:      workspace.WorkspaceID,
+		WorkspaceBuildID: workspace.WorkspaceBuildID,
+		JobID:            job.ID,
+		CreatedAt:        now,
+	})
+	if err != nil {
+		return database.ProvisionerJob{}, xerrors.Errorf("insert workspace drift check: %w", err)
+	}
+	return job, nil
+}
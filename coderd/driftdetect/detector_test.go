@@ -0,0 +1,123 @@
+package driftdetect_test
+
+import (
+	"context"
+	"encoding/json"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/goleak"
+
+	"github.com/coder/coder/v2/coderd/coderdtest"
+	"github.com/coder/coder/v2/coderd/database"
+	"github.com/coder/coder/v2/coderd/database/dbauthz"
+	"github.com/coder/coder/v2/coderd/database/dbfake"
+	"github.com/coder/coder/v2/coderd/database/dbgen"
+	"github.com/coder/coder/v2/coderd/database/dbtestutil"
+	"github.com/coder/coder/v2/coderd/database/pubsub"
+	"github.com/coder/coder/v2/coderd/driftdetect"
+	"github.com/coder/coder/v2/coderd/provisionerdserver"
+	"github.com/coder/coder/v2/coderd/rbac"
+	"github.com/coder/coder/v2/codersdk"
+	"github.com/coder/coder/v2/testutil"
+)
+
+func TestMain(m *testing.M) {
+	goleak.VerifyTestMain(m, testutil.GoleakOptions...)
+}
+
+// startDetector starts a detector that runs on every tick sent to the
+// returned channel and reports its stats on the other.
+func startDetector(ctx context.Context, t *testing.T, db database.Store, ps pubsub.Pubsub) (chan<- time.Time, <-chan driftdetect.Stats) {
+	t.Helper()
+
+	log := testutil.Logger(t)
+	tickCh := make(chan time.Time)
+	statsCh := make(chan driftdetect.Stats)
+	authzDB := dbauthz.New(db, rbac.NewStrictCachingAuthorizer(prometheus.NewRegistry()), log, coderdtest.AccessControlStorePointer())
+	detector := driftdetect.New(ctx, authzDB, ps, log, tickCh).WithStatsChannel(statsCh)
+	detector.Start()
+	t.Cleanup(detector.Close)
+	return tickCh, statsCh
+}
+
+func TestDetector(t *testing.T) {
+	t.Parallel()
+
+	t.Run("NoWorkspaces", func(t *testing.T) {
+		t.Parallel()
+
+		ctx := testutil.Context(t, testutil.WaitLong)
+		db, ps := dbtestutil.NewDB(t)
+		tickCh, statsCh := startDetector(ctx, t, db, ps)
+
+		testutil.RequireSend(ctx, t, tickCh, time.Now())
+		stats := testutil.RequireReceive(ctx, t, statsCh)
+		require.NoError(t, stats.Error)
+		require.Empty(t, stats.ScheduledWorkspaceIDs)
+	})
+
+	t.Run("SchedulesOnce", func(t *testing.T) {
+		t.Parallel()
+
+		ctx := testutil.Context(t, testutil.WaitLong)
+		db, ps := dbtestutil.NewDB(t)
+		tickCh, statsCh := startDetector(ctx, t, db, ps)
+
+		org := dbgen.Organization(t, db, database.Organization{})
+		user := dbgen.User(t, db, database.User{})
+		running := dbfake.WorkspaceBuild(t, db, database.WorkspaceTable{
+			OrganizationID: org.ID,
+			OwnerID:        user.ID,
+		}).Seed(database.WorkspaceBuild{Transition: database.WorkspaceTransitionStart}).Do()
+		// Stopped workspaces have nothing to check.
+		_ = dbfake.WorkspaceBuild(t, db, database.WorkspaceTable{
+			OrganizationID: org.ID,
+			OwnerID:        user.ID,
+		}).Seed(database.WorkspaceBuild{Transition: database.WorkspaceTransitionStop}).Do()
+
+		testutil.RequireSend(ctx, t, tickCh, time.Now())
+		stats := testutil.RequireReceive(ctx, t, statsCh)
+		require.NoError(t, stats.Error)
+		require.Equal(t, []uuid.UUID{running.Workspace.ID}, stats.ScheduledWorkspaceIDs)
+
+		checks, err := db.GetWorkspacesEligibleForDriftCheck(ctx, time.Now())
+		require.NoError(t, err)
+		require.Empty(t, checks, "the pending check should make the workspace ineligible")
+
+		buildJob, err := db.GetProvisionerJobByID(ctx, running.Build.JobID)
+		require.NoError(t, err)
+		jobs, err := db.GetProvisionerJobsByOrganizationAndStatusWithQueuePositionAndProvisioner(ctx, database.GetProvisionerJobsByOrganizationAndStatusWithQueuePositionAndProvisionerParams{
+			OrganizationID: org.ID,
+			Status:         []database.ProvisionerJobStatus{database.ProvisionerJobStatusPending},
+		})
+		require.NoError(t, err)
+		require.Len(t, jobs, 1)
+		job := jobs[0].ProvisionerJob
+		require.Equal(t, database.ProvisionerJobTypeWorkspaceDriftCheck, job.Type)
+		require.Equal(t, buildJob.FileID, job.FileID)
+		require.Equal(t, buildJob.Tags, job.Tags)
+		require.Equal(t, user.ID, job.InitiatorID)
+		require.EqualValues(t, codersdk.ProvisionerJobPriorityAutomated, job.Priority)
+
+		var input provisionerdserver.WorkspaceDriftCheckJob
+		require.NoError(t, json.Unmarshal(job.Input, &input))
+		require.Equal(t, running.Build.ID, input.WorkspaceBuildID)
+
+		check, err := db.GetWorkspaceDriftCheckByJobID(ctx, job.ID)
+		require.NoError(t, err)
+		require.Equal(t, running.Workspace.ID, check.WorkspaceID)
+		require.Equal(t, running.Build.ID, check.WorkspaceBuildID)
+		require.False(t, check.CheckedAt.Valid)
+
+		// The next run must not schedule a second check while the first is
+		// still pending.
+		testutil.RequireSend(ctx, t, tickCh, time.Now())
+		stats = testutil.RequireReceive(ctx, t, statsCh)
+		require.NoError(t, stats.Error)
+		require.Empty(t, stats.ScheduledWorkspaceIDs)
+	})
+}
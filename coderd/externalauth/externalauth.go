@@ -194,7 +194,7 @@ type Config struct {
 // Git returns a Provider for this config. It returns (nil, nil) when
 // this config's type has no provider implementation, which covers both
 // non-git types (e.g. Slack, JFrog) and git types that are not
-// implemented yet (bitbucket-*, azure-devops*, gitea). Callers cannot
+// implemented yet (azure-devops*). Callers cannot
 // distinguish the two cases from the return values. Returns a non-nil
 // error if provider construction fails.
 //
@@ -1293,12 +1293,40 @@ func copyDefaultSettings(config *codersdk.ExternalAuthConfig, defaults codersdk.
 					config.APIBaseURL = au.Scheme + "://" + au.Host + "/api/v4"
 				}
 			}
+		case codersdk.EnhancedExternalAuthProviderBitBucketCloud:
+			config.APIBaseURL = "https://api.bitbucket.org/2.0"
+		case codersdk.EnhancedExternalAuthProviderBitBucketServer:
+			// Bitbucket Server has no public default; derive the
+			// instance URL (including any context path) from the
+			// auth URL, e.g. https://bb.corp.com/rest/oauth2/latest/authorize.
+			if base, ok := instanceBaseURL(config.AuthURL, "/rest/oauth2/"); ok {
+				config.APIBaseURL = base + "/rest/api/1.0"
+			}
 		case codersdk.EnhancedExternalAuthProviderGitea:
 			config.APIBaseURL = "https://gitea.com/api/v1"
+			if base, ok := instanceBaseURL(config.AuthURL, "/login/oauth/"); ok {
+				config.APIBaseURL = base + "/api/v1"
+			}
 		}
 	}
 }
 
+// instanceBaseURL returns the base URL of a self-hosted instance by
+// stripping everything from marker onwards in the path of authURL.
+// Instances served under a sub-path keep that prefix, e.g.
+// https://corp.com/gitea/login/oauth/authorize → https://corp.com/gitea.
+func instanceBaseURL(authURL string, marker string) (string, bool) {
+	if authURL == "" {
+		return "", false
+	}
+	au, err := url.Parse(authURL)
+	if err != nil || au.Scheme == "" || au.Host == "" {
+		return "", false
+	}
+	prefix, _, _ := strings.Cut(au.Path, marker)
+	return au.Scheme + "://" + au.Host + strings.TrimSuffix(prefix, "/"), true
+}
+
 // gitHubDefaults returns default config values for GitHub.
 // The only dynamic value is the revocation URL which depends on client ID.
 func gitHubDefaults(config *codersdk.ExternalAuthConfig) codersdk.ExternalAuthConfig {
@@ -2218,6 +2218,33 @@ func TestConvertYAML(t *testing.T) {
 		require.Len(t, configs, 1)
 		require.Equal(t, "https://gitlab.corp.com/api/v4", configs[0].APIBaseURL)
 	})
+
+	t.Run("SelfHostedAPIBaseURL", func(t *testing.T) {
+		t.Parallel()
+		configs, err := externalauth.ConvertConfig(context.Background(), testutil.Logger(t), instrument, []codersdk.ExternalAuthConfig{{
+			ID:           "bitbucket-server",
+			Type:         string(codersdk.EnhancedExternalAuthProviderBitBucketServer),
+			ClientID:     "id",
+			ClientSecret: "secret",
+			AuthURL:      "https://bitbucket.corp.com/rest/oauth2/latest/authorize",
+		}, {
+			ID:           "gitea",
+			Type:         string(codersdk.EnhancedExternalAuthProviderGitea),
+			ClientID:     "id",
+			ClientSecret: "secret",
+			AuthURL:      "https://corp.com/gitea/login/oauth/authorize",
+		}, {
+			ID:           "bitbucket-cloud",
+			Type:         string(codersdk.EnhancedExternalAuthProviderBitBucketCloud),
+			ClientID:     "id",
+			ClientSecret: "secret",
+		}}, &url.URL{}, nil)
+		require.NoError(t, err)
+		require.Len(t, configs, 3)
+		require.Equal(t, "https://bitbucket.corp.com/rest/api/1.0", configs[0].APIBaseURL)
+		require.Equal(t, "https://corp.com/gitea/api/v1", configs[1].APIBaseURL)
+		require.Equal(t, "https://api.bitbucket.org/2.0", configs[2].APIBaseURL)
+	})
 }
 
 // TestConstantQueryParams verifies a constant query parameter can be set in the
@@ -0,0 +1,482 @@
+package gitprovider
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+
+	"golang.org/x/xerrors"
+
+	"github.com/coder/quartz"
+)
+
+const (
+	defaultBitbucketCloudAPIBaseURL = "https://api.bitbucket.org/2.0"
+	defaultBitbucketCloudWebBaseURL = "https://bitbucket.org"
+
+	// bitbucketCloudMaxPages bounds the number of pages followed
+	// when summing paginated resources (diffstat, commits) so a huge
+	// pull request cannot turn one status refresh into hundreds of
+	// API calls.
+	bitbucketCloudMaxPages = 10
+)
+
+type bitbucketCloudProvider struct {
+	apiBaseURL string
+	webBaseURL string
+	client     *restClient
+	clock      quartz.Clock
+}
+
+func newBitbucketCloud(apiBaseURL string, httpClient *http.Client, clock quartz.Clock) (Provider, error) {
+	if apiBaseURL == "" {
+		apiBaseURL = defaultBitbucketCloudAPIBaseURL
+	}
+	apiBaseURL = strings.TrimRight(apiBaseURL, "/")
+	if httpClient == nil {
+		httpClient = http.DefaultClient
+	}
+
+	webBaseURL, err := deriveBitbucketCloudWebBaseURL(apiBaseURL)
+	if err != nil {
+		return nil, err
+	}
+
+	return &bitbucketCloudProvider{
+		apiBaseURL: apiBaseURL,
+		webBaseURL: webBaseURL,
+		client: &restClient{
+			name:        "bitbucket cloud",
+			httpClient:  httpClient,
+			clock:       clock,
+			resetHeader: "X-RateLimit-Reset",
+		},
+		clock: clock,
+	}, nil
+}
+
+var _ Provider = (*bitbucketCloudProvider)(nil)
+
+// deriveBitbucketCloudWebBaseURL converts a Bitbucket Cloud API base
+// URL to the corresponding web base URL.
+//
+// bitbucket.org: https://api.bitbucket.org/2.0 → https://bitbucket.org
+// Other hosts (tests, proxies): strip the /2.0 path suffix.
+func deriveBitbucketCloudWebBaseURL(apiBaseURL string) (string, error) {
+	u, err := url.Parse(apiBaseURL)
+	if err != nil {
+		return "", xerrors.Errorf("parse bitbucket cloud api base url: %w", err)
+	}
+	if u.Host == "" {
+		return "", xerrors.Errorf("bitbucket cloud api base url %q has no host", apiBaseURL)
+	}
+	if strings.EqualFold(u.Host, "api.bitbucket.org") {
+		return defaultBitbucketCloudWebBaseURL, nil
+	}
+	u.Path = strings.TrimSuffix(u.Path, "/2.0")
+	u.Path = strings.TrimSuffix(u.Path, "/")
+	return u.String(), nil
+}
+
+// webHost returns the hostname (with port if present) of the
+// Bitbucket Cloud web URL.
+func (b *bitbucketCloudProvider) webHost() string {
+	return extractHost(b.webBaseURL)
+}
+
+// repoEndpoint returns the API URL of a repository, optionally
+// followed by additional path segments.
+func (b *bitbucketCloudProvider) repoEndpoint(owner, repo string, segments ...string) string {
+	endpoint := fmt.Sprintf(
+		"%s/repositories/%s/%s",
+		b.apiBaseURL,
+		url.PathEscape(owner),
+		url.PathEscape(repo),
+	)
+	for _, seg := range segments {
+		endpoint += "/" + seg
+	}
+	return endpoint
+}
+
+// bitbucketCloudUser is the subset of a Bitbucket Cloud account
+// object that we use.
+type bitbucketCloudUser struct {
+	DisplayName string `json:"display_name"`
+	Nickname    string `json:"nickname"`
+	Links       struct {
+		Avatar struct {
+			Href string `json:"href"`
+		} `json:"avatar"`
+	} `json:"links"`
+}
+
+// login returns the best available handle for the user. Bitbucket
+// Cloud no longer exposes usernames through the API, so the nickname
+// is the closest equivalent.
+func (u bitbucketCloudUser) login() string {
+	if u.Nickname != "" {
+		return u.Nickname
+	}
+	return u.DisplayName
+}
+
+func (b *bitbucketCloudProvider) FetchPullRequestStatus(
+	ctx context.Context,
+	token string,
+	ref PRRef,
+) (*PRStatus, error) {
+	pullEndpoint := b.repoEndpoint(ref.Owner, ref.Repo, "pullrequests", strconv.Itoa(ref.Number))
+
+	var pull struct {
+		ID     int    `json:"id"`
+		Title  string `json:"title"`
+		State  string `json:"state"`
+		Draft  bool   `json:"draft"`
+		Source struct {
+			Branch struct {
+				Name string `json:"name"`
+			} `json:"branch"`
+			Commit struct {
+				Hash string `json:"hash"`
+			} `json:"commit"`
+		} `json:"source"`
+		Destination struct {
+			Branch struct {
+				Name string `json:"name"`
+			} `json:"branch"`
+		} `json:"destination"`
+		Author       bitbucketCloudUser `json:"author"`
+		Participants []struct {
+			User     bitbucketCloudUser `json:"user"`
+			Approved bool               `json:"approved"`
+			// State is "approved", "changes_requested" or null.
+			State *string `json:"state"`
+		} `json:"participants"`
+	}
+	if _, err := b.client.getJSON(ctx, pullEndpoint, token, "get pull request", &pull); err != nil {
+		return nil, err
+	}
+
+	var diffStats DiffStats
+	err := forEachBitbucketCloudPage(ctx, b.client, token, pullEndpoint+"/diffstat?pagelen=500", "get pull request diffstat", func(values []bitbucketCloudDiffStat) {
+		for _, v := range values {
+			diffStats.Additions += v.LinesAdded
+			diffStats.Deletions += v.LinesRemoved
+			diffStats.ChangedFiles++
+		}
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	var commits int32
+	err = forEachBitbucketCloudPage(ctx, b.client, token, pullEndpoint+"/commits?pagelen=100", "get pull request commits", func(values []struct{}) {
+		commits += int32(len(values))
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	var (
+		changesRequested bool
+		hasApproval      bool
+		reviewerCount    int32
+	)
+	for _, p := range pull.Participants {
+		state := ""
+		if p.State != nil {
+			state = strings.ToLower(*p.State)
+		}
+		switch {
+		case state == "changes_requested":
+			changesRequested = true
+			reviewerCount++
+		case p.Approved || state == "approved":
+			hasApproval = true
+			reviewerCount++
+		}
+	}
+
+	return &PRStatus{
+		Title:            pull.Title,
+		State:            mapBitbucketState(pull.State),
+		Draft:            pull.Draft,
+		HeadSHA:          pull.Source.Commit.Hash,
+		HeadBranch:       pull.Source.Branch.Name,
+		DiffStats:        diffStats,
+		ChangesRequested: changesRequested,
+		Approved:         hasApproval && !changesRequested,
+		ReviewerCount:    reviewerCount,
+		AuthorLogin:      pull.Author.login(),
+		AuthorAvatarURL:  pull.Author.Links.Avatar.Href,
+		BaseBranch:       pull.Destination.Branch.Name,
+		PRNumber:         pull.ID,
+		Commits:          commits,
+		FetchedAt:        b.clock.Now().UTC(),
+	}, nil
+}
+
+// bitbucketCloudDiffStat is a single file entry of the diffstat
+// endpoint.
+type bitbucketCloudDiffStat struct {
+	LinesAdded   int32 `json:"lines_added"`
+	LinesRemoved int32 `json:"lines_removed"`
+}
+
+// forEachBitbucketCloudPage follows Bitbucket Cloud's "next"
+// pagination links starting at requestURL, invoking fn with the values
+// of each page. At most bitbucketCloudMaxPages pages are read.
+func forEachBitbucketCloudPage[T any](
+	ctx context.Context,
+	client *restClient,
+	token string,
+	requestURL string,
+	action string,
+	fn func([]T),
+) error {
+	for range bitbucketCloudMaxPages {
+		var page struct {
+			Values []T    `json:"values"`
+			Next   string `json:"next"`
+		}
+		if _, err := client.getJSON(ctx, requestURL, token, action, &page); err != nil {
+			return err
+		}
+		fn(page.Values)
+		if page.Next == "" {
+			return nil
+		}
+		requestURL = page.Next
+	}
+	return nil
+}
+
+func (b *bitbucketCloudProvider) ResolveBranchPullRequest(
+	ctx context.Context,
+	token string,
+	ref BranchRef,
+) (*PRRef, error) {
+	if ref.Owner == "" || ref.Repo == "" || ref.Branch == "" {
+		return nil, nil
+	}
+
+	query := url.Values{}
+	query.Set("q", fmt.Sprintf(`source.branch.name = %s AND state = "OPEN"`, strconv.Quote(ref.Branch)))
+	query.Set("sort", "-updated_on")
+	query.Set("pagelen", "1")
+
+	var pulls struct {
+		Values []struct {
+			ID    int `json:"id"`
+			Links struct {
+				HTML struct {
+					Href string `json:"href"`
+				} `json:"html"`
+			} `json:"links"`
+		} `json:"values"`
+	}
+	requestURL := b.repoEndpoint(ref.Owner, ref.Repo, "pullrequests") + "?" + query.Encode()
+	if _, err := b.client.getJSON(ctx, requestURL, token, "list pull requests by branch", &pulls); err != nil {
+		return nil, err
+	}
+	if len(pulls.Values) == 0 {
+		return nil, nil
+	}
+
+	prRef, ok := b.ParsePullRequestURL(pulls.Values[0].Links.HTML.Href)
+	if !ok {
+		// Fallback: construct from known owner/repo and returned ID.
+		return &PRRef{
+			Owner:  ref.Owner,
+			Repo:   ref.Repo,
+			Number: pulls.Values[0].ID,
+		}, nil
+	}
+	return &prRef, nil
+}
+
+func (b *bitbucketCloudProvider) FetchPullRequestDiff(
+	ctx context.Context,
+	token string,
+	ref PRRef,
+) (string, error) {
+	// The diff endpoint redirects to the repository diff endpoint
+	// for the PR's source and destination commits, which the HTTP
+	// client follows.
+	requestURL := b.repoEndpoint(ref.Owner, ref.Repo, "pullrequests", strconv.Itoa(ref.Number), "diff")
+	return b.client.getDiff(ctx, requestURL, token, "get pull request diff")
+}
+
+func (b *bitbucketCloudProvider) FetchBranchDiff(
+	ctx context.Context,
+	token string,
+	ref BranchRef,
+) (string, error) {
+	if ref.Owner == "" || ref.Repo == "" || ref.Branch == "" {
+		return "", nil
+	}
+
+	var repository struct {
+		MainBranch struct {
+			Name string `json:"name"`
+		} `json:"mainbranch"`
+	}
+	if _, err := b.client.getJSON(ctx, b.repoEndpoint(ref.Owner, ref.Repo), token, "get repository", &repository); err != nil {
+		return "", err
+	}
+	defaultBranch := strings.TrimSpace(repository.MainBranch.Name)
+	if defaultBranch == "" {
+		return "", xerrors.New("bitbucket cloud repository main branch is empty")
+	}
+
+	// The spec is "source..destination". Bitbucket diffs against the
+	// merge base by default (topic=true), matching GitHub's
+	// three-dot compare.
+	spec := url.PathEscape(ref.Branch + ".." + defaultBranch)
+	requestURL := b.repoEndpoint(ref.Owner, ref.Repo, "diff", spec)
+	return b.client.getDiff(ctx, requestURL, token, "compare branches")
+}
+
+func (b *bitbucketCloudProvider) ParseRepositoryOrigin(raw string) (owner, repo, normalizedOrigin string, ok bool) {
+	raw = strings.TrimSpace(raw)
+	if raw == "" {
+		return "", "", "", false
+	}
+
+	host := b.webHost()
+	path, matched := parseSCPLikeOrigin(raw, host)
+	if !matched {
+		u, err := url.Parse(raw)
+		if err != nil {
+			return "", "", "", false
+		}
+		switch u.Scheme {
+		case "https", "http":
+			if !strings.EqualFold(u.Host, host) {
+				return "", "", "", false
+			}
+		case "ssh":
+			if !strings.EqualFold(u.Hostname(), hostWithoutPort(host)) {
+				return "", "", "", false
+			}
+		default:
+			return "", "", "", false
+		}
+		path = trimOriginPath(u.Path)
+	}
+
+	// Bitbucket Cloud repositories are always workspace/repo_slug.
+	parts := strings.Split(path, "/")
+	if len(parts) != 2 || parts[0] == "" || parts[1] == "" {
+		return "", "", "", false
+	}
+	owner, repo = parts[0], parts[1]
+	return owner, repo, b.BuildRepositoryURL(owner, repo), true
+}
+
+func (b *bitbucketCloudProvider) ParsePullRequestURL(raw string) (PRRef, bool) {
+	raw = strings.TrimSpace(raw)
+	if raw == "" {
+		return PRRef{}, false
+	}
+
+	u, err := url.Parse(raw)
+	if err != nil {
+		return PRRef{}, false
+	}
+	if u.Scheme != "https" && u.Scheme != "http" {
+		return PRRef{}, false
+	}
+	if !strings.EqualFold(u.Host, b.webHost()) {
+		return PRRef{}, false
+	}
+
+	// Bitbucket Cloud PR URLs: /workspace/repo/pull-requests/123
+	// optionally followed by a tab such as /diff or /commits.
+	parts := strings.Split(strings.Trim(u.Path, "/"), "/")
+	if len(parts) < 4 || parts[2] != "pull-requests" {
+		return PRRef{}, false
+	}
+	number, err := strconv.Atoi(parts[3])
+	if err != nil || number <= 0 {
+		return PRRef{}, false
+	}
+	if parts[0] == "" || parts[1] == "" {
+		return PRRef{}, false
+	}
+
+	return PRRef{
+		Owner:  parts[0],
+		Repo:   parts[1],
+		Number: number,
+	}, true
+}
+
+// NormalizePullRequestURL normalizes a Bitbucket Cloud pull request URL.
+func (b *bitbucketCloudProvider) NormalizePullRequestURL(raw string) string {
+	ref, ok := b.ParsePullRequestURL(strings.TrimRight(
+		strings.TrimSpace(raw),
+		trailingPunctuation,
+	))
+	if !ok {
+		return ""
+	}
+	return b.BuildPullRequestURL(ref)
+}
+
+func (b *bitbucketCloudProvider) BuildBranchURL(owner, repo, branch string) string {
+	owner = strings.TrimSpace(owner)
+	repo = strings.TrimSpace(repo)
+	branch = strings.TrimSpace(branch)
+	if owner == "" || repo == "" || branch == "" {
+		return ""
+	}
+
+	return fmt.Sprintf(
+		"%s/%s/%s/branch/%s",
+		b.webBaseURL,
+		url.PathEscape(owner),
+		url.PathEscape(repo),
+		escapePathPreserveSlashes(branch),
+	)
+}
+
+func (b *bitbucketCloudProvider) BuildRepositoryURL(owner, repo string) string {
+	owner = strings.TrimSpace(owner)
+	repo = strings.TrimSpace(repo)
+	if owner == "" || repo == "" {
+		return ""
+	}
+	return fmt.Sprintf("%s/%s/%s", b.webBaseURL, url.PathEscape(owner), url.PathEscape(repo))
+}
+
+func (b *bitbucketCloudProvider) BuildPullRequestURL(ref PRRef) string {
+	if ref.Owner == "" || ref.Repo == "" || ref.Number <= 0 {
+		return ""
+	}
+	return fmt.Sprintf(
+		"%s/%s/%s/pull-requests/%d",
+		b.webBaseURL,
+		url.PathEscape(ref.Owner),
+		url.PathEscape(ref.Repo),
+		ref.Number,
+	)
+}
+
+// mapBitbucketState maps a Bitbucket Cloud or Server pull request
+// state to a normalized PRState. DECLINED and SUPERSEDED are both
+// treated as closed.
+func mapBitbucketState(state string) PRState {
+	switch strings.ToUpper(strings.TrimSpace(state)) {
+	case "OPEN":
+		return PRStateOpen
+	case "MERGED":
+		return PRStateMerged
+	default:
+		return PRStateClosed
+	}
+}
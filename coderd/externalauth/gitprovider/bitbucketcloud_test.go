@@ -0,0 +1,310 @@
+package gitprovider_test
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/coder/coder/v2/coderd/externalauth/gitprovider"
+)
+
+func TestBitbucketCloudParseRepositoryOrigin(t *testing.T) {
+	t.Parallel()
+	gp, err := gitprovider.New("bitbucket-cloud", "", nil)
+	require.NoError(t, err)
+	require.NotNil(t, gp)
+
+	tests := []struct {
+		name             string
+		raw              string
+		expectOK         bool
+		expectOwner      string
+		expectRepo       string
+		expectNormalized string
+	}{
+		{
+			name:             "HTTPS URL",
+			raw:              "https://bitbucket.org/coder/coder",
+			expectOK:         true,
+			expectOwner:      "coder",
+			expectRepo:       "coder",
+			expectNormalized: "https://bitbucket.org/coder/coder",
+		},
+		{
+			name:             "HTTPS URL with user and .git",
+			raw:              "https://alice@bitbucket.org/coder/coder.git",
+			expectOK:         true,
+			expectOwner:      "coder",
+			expectRepo:       "coder",
+			expectNormalized: "https://bitbucket.org/coder/coder",
+		},
+		{
+			name:             "SSH URL",
+			raw:              "git@bitbucket.org:coder/coder.git",
+			expectOK:         true,
+			expectOwner:      "coder",
+			expectRepo:       "coder",
+			expectNormalized: "https://bitbucket.org/coder/coder",
+		},
+		{
+			name:             "SSH URL with ssh:// prefix",
+			raw:              "ssh://git@bitbucket.org/coder/coder.git",
+			expectOK:         true,
+			expectOwner:      "coder",
+			expectRepo:       "coder",
+			expectNormalized: "https://bitbucket.org/coder/coder",
+		},
+		{
+			name:     "GitHub URL does not match",
+			raw:      "https://github.com/coder/coder",
+			expectOK: false,
+		},
+		{
+			name:     "Too many path segments",
+			raw:      "https://bitbucket.org/coder/coder/src/main",
+			expectOK: false,
+		},
+		{
+			name:     "Empty string",
+			raw:      "",
+			expectOK: false,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			owner, repo, normalized, ok := gp.ParseRepositoryOrigin(tt.raw)
+			assert.Equal(t, tt.expectOK, ok)
+			if tt.expectOK {
+				assert.Equal(t, tt.expectOwner, owner)
+				assert.Equal(t, tt.expectRepo, repo)
+				assert.Equal(t, tt.expectNormalized, normalized)
+			}
+		})
+	}
+}
+
+func TestBitbucketCloudPullRequestURLs(t *testing.T) {
+	t.Parallel()
+	gp, err := gitprovider.New("bitbucket-cloud", "", nil)
+	require.NoError(t, err)
+	require.NotNil(t, gp)
+
+	t.Run("Parse", func(t *testing.T) {
+		t.Parallel()
+		ref, ok := gp.ParsePullRequestURL("https://bitbucket.org/coder/coder/pull-requests/42/diff")
+		require.True(t, ok)
+		assert.Equal(t, gitprovider.PRRef{Owner: "coder", Repo: "coder", Number: 42}, ref)
+
+		_, ok = gp.ParsePullRequestURL("https://bitbucket.org/coder/coder/issues/42")
+		assert.False(t, ok)
+		_, ok = gp.ParsePullRequestURL("https://github.com/coder/coder/pull/42")
+		assert.False(t, ok)
+	})
+
+	t.Run("Normalize", func(t *testing.T) {
+		t.Parallel()
+		assert.Equal(t,
+			"https://bitbucket.org/coder/coder/pull-requests/42",
+			gp.NormalizePullRequestURL("https://bitbucket.org/coder/coder/pull-requests/42/overview?w=1)."),
+		)
+		assert.Empty(t, gp.NormalizePullRequestURL("https://bitbucket.org/coder/coder"))
+	})
+
+	t.Run("Build", func(t *testing.T) {
+		t.Parallel()
+		assert.Equal(t, "https://bitbucket.org/coder/coder", gp.BuildRepositoryURL("coder", "coder"))
+		assert.Equal(t, "https://bitbucket.org/coder/coder/branch/feat/new-thing", gp.BuildBranchURL("coder", "coder", "feat/new-thing"))
+		assert.Equal(t, "https://bitbucket.org/coder/coder/pull-requests/7", gp.BuildPullRequestURL(gitprovider.PRRef{Owner: "coder", Repo: "coder", Number: 7}))
+		assert.Empty(t, gp.BuildPullRequestURL(gitprovider.PRRef{Owner: "coder", Repo: "coder"}))
+		assert.Empty(t, gp.BuildBranchURL("coder", "coder", ""))
+	})
+}
+
+func TestBitbucketCloudFetchPullRequestStatus(t *testing.T) {
+	t.Parallel()
+
+	var srvURL string
+	mux := http.NewServeMux()
+	mux.HandleFunc("/2.0/repositories/owner/repo/pullrequests/1", func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "Bearer token", r.Header.Get("Authorization"))
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write([]byte(`{
+			"id": 1,
+			"title": "Add feature",
+			"state": "OPEN",
+			"draft": true,
+			"source": {"branch": {"name": "feat"}, "commit": {"hash": "abc123"}},
+			"destination": {"branch": {"name": "main"}},
+			"author": {"display_name": "Alice", "nickname": "alice", "links": {"avatar": {"href": "https://avatar/alice"}}},
+			"participants": [
+				{"user": {"nickname": "bob"}, "approved": true, "state": "approved"},
+				{"user": {"nickname": "carol"}, "approved": false, "state": "changes_requested"},
+				{"user": {"nickname": "dave"}, "approved": false, "state": null}
+			]
+		}`))
+	})
+	mux.HandleFunc("/2.0/repositories/owner/repo/pullrequests/1/diffstat", func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		if r.URL.Query().Get("page") == "2" {
+			_, _ = w.Write([]byte(`{"values":[{"lines_added":1,"lines_removed":0}]}`))
+			return
+		}
+		_, _ = fmt.Fprintf(w, `{"values":[{"lines_added":5,"lines_removed":2},{"lines_added":3,"lines_removed":1}],"next":"%s/2.0/repositories/owner/repo/pullrequests/1/diffstat?page=2"}`, srvURL)
+	})
+	mux.HandleFunc("/2.0/repositories/owner/repo/pullrequests/1/commits", func(w http.ResponseWriter, _ *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write([]byte(`{"values":[{},{},{}]}`))
+	})
+	srv := httptest.NewServer(mux)
+	defer srv.Close()
+	srvURL = srv.URL
+
+	gp, err := gitprovider.New("bitbucket-cloud", srv.URL+"/2.0", srv.Client())
+	require.NoError(t, err)
+
+	status, err := gp.FetchPullRequestStatus(t.Context(), "token", gitprovider.PRRef{Owner: "owner", Repo: "repo", Number: 1})
+	require.NoError(t, err)
+	assert.Equal(t, "Add feature", status.Title)
+	assert.Equal(t, gitprovider.PRStateOpen, status.State)
+	assert.True(t, status.Draft)
+	assert.Equal(t, "abc123", status.HeadSHA)
+	assert.Equal(t, "feat", status.HeadBranch)
+	assert.Equal(t, "main", status.BaseBranch)
+	assert.Equal(t, "alice", status.AuthorLogin)
+	assert.Equal(t, "https://avatar/alice", status.AuthorAvatarURL)
+	assert.Equal(t, gitprovider.DiffStats{Additions: 9, Deletions: 3, ChangedFiles: 3}, status.DiffStats)
+	assert.Equal(t, int32(3), status.Commits)
+	assert.True(t, status.ChangesRequested)
+	assert.False(t, status.Approved)
+	assert.Equal(t, int32(2), status.ReviewerCount)
+	assert.Equal(t, 1, status.PRNumber)
+	assert.False(t, status.FetchedAt.IsZero())
+}
+
+func TestBitbucketCloudResolveBranchPullRequest(t *testing.T) {
+	t.Parallel()
+
+	t.Run("Found", func(t *testing.T) {
+		t.Parallel()
+		srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			assert.Equal(t, "/2.0/repositories/owner/repo/pullrequests", r.URL.Path)
+			assert.Equal(t, `source.branch.name = "feat/x" AND state = "OPEN"`, r.URL.Query().Get("q"))
+			w.Header().Set("Content-Type", "application/json")
+			_, _ = w.Write([]byte(`{"values":[{"id":12,"links":{"html":{"href":"https://elsewhere/owner/repo/pull-requests/12"}}}]}`))
+		}))
+		defer srv.Close()
+
+		gp, err := gitprovider.New("bitbucket-cloud", srv.URL+"/2.0", srv.Client())
+		require.NoError(t, err)
+
+		ref, err := gp.ResolveBranchPullRequest(t.Context(), "token", gitprovider.BranchRef{Owner: "owner", Repo: "repo", Branch: "feat/x"})
+		require.NoError(t, err)
+		require.NotNil(t, ref)
+		assert.Equal(t, gitprovider.PRRef{Owner: "owner", Repo: "repo", Number: 12}, *ref)
+	})
+
+	t.Run("NotFound", func(t *testing.T) {
+		t.Parallel()
+		srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+			w.Header().Set("Content-Type", "application/json")
+			_, _ = w.Write([]byte(`{"values":[]}`))
+		}))
+		defer srv.Close()
+
+		gp, err := gitprovider.New("bitbucket-cloud", srv.URL+"/2.0", srv.Client())
+		require.NoError(t, err)
+
+		ref, err := gp.ResolveBranchPullRequest(t.Context(), "token", gitprovider.BranchRef{Owner: "owner", Repo: "repo", Branch: "feat"})
+		require.NoError(t, err)
+		assert.Nil(t, ref)
+	})
+}
+
+func TestBitbucketCloudDiffs(t *testing.T) {
+	t.Parallel()
+
+	const smallDiff = "diff --git a/file.go b/file.go\n--- a/file.go\n+++ b/file.go\n@@ -1 +1 @@\n-old\n+new\n"
+
+	t.Run("PullRequestDiffFollowsRedirect", func(t *testing.T) {
+		t.Parallel()
+		mux := http.NewServeMux()
+		mux.HandleFunc("/2.0/repositories/owner/repo/pullrequests/1/diff", func(w http.ResponseWriter, r *http.Request) {
+			http.Redirect(w, r, "/2.0/repositories/owner/repo/diff/abc..def", http.StatusFound)
+		})
+		mux.HandleFunc("/2.0/repositories/owner/repo/diff/", func(w http.ResponseWriter, _ *http.Request) {
+			_, _ = w.Write([]byte(smallDiff))
+		})
+		srv := httptest.NewServer(mux)
+		defer srv.Close()
+
+		gp, err := gitprovider.New("bitbucket-cloud", srv.URL+"/2.0", srv.Client())
+		require.NoError(t, err)
+
+		diff, err := gp.FetchPullRequestDiff(t.Context(), "token", gitprovider.PRRef{Owner: "owner", Repo: "repo", Number: 1})
+		require.NoError(t, err)
+		assert.Equal(t, smallDiff, diff)
+	})
+
+	t.Run("BranchDiff", func(t *testing.T) {
+		t.Parallel()
+		mux := http.NewServeMux()
+		mux.HandleFunc("/2.0/repositories/owner/repo", func(w http.ResponseWriter, _ *http.Request) {
+			w.Header().Set("Content-Type", "application/json")
+			_, _ = w.Write([]byte(`{"mainbranch":{"name":"main"}}`))
+		})
+		mux.HandleFunc("/2.0/repositories/owner/repo/diff/", func(w http.ResponseWriter, r *http.Request) {
+			assert.Equal(t, "/2.0/repositories/owner/repo/diff/feat%2Fx..main", r.URL.EscapedPath())
+			_, _ = w.Write([]byte(smallDiff))
+		})
+		srv := httptest.NewServer(mux)
+		defer srv.Close()
+
+		gp, err := gitprovider.New("bitbucket-cloud", srv.URL+"/2.0", srv.Client())
+		require.NoError(t, err)
+
+		diff, err := gp.FetchBranchDiff(t.Context(), "token", gitprovider.BranchRef{Owner: "owner", Repo: "repo", Branch: "feat/x"})
+		require.NoError(t, err)
+		assert.Equal(t, smallDiff, diff)
+	})
+
+	t.Run("TooLarge", func(t *testing.T) {
+		t.Parallel()
+		srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+			_, _ = w.Write([]byte(strings.Repeat("+", gitprovider.MaxDiffSize+1)))
+		}))
+		defer srv.Close()
+
+		gp, err := gitprovider.New("bitbucket-cloud", srv.URL+"/2.0", srv.Client())
+		require.NoError(t, err)
+
+		_, err = gp.FetchPullRequestDiff(t.Context(), "token", gitprovider.PRRef{Owner: "owner", Repo: "repo", Number: 1})
+		assert.ErrorIs(t, err, gitprovider.ErrDiffTooLarge)
+	})
+
+	t.Run("RateLimit", func(t *testing.T) {
+		t.Parallel()
+		srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+			w.Header().Set("Retry-After", "60")
+			w.WriteHeader(http.StatusTooManyRequests)
+		}))
+		defer srv.Close()
+
+		gp, err := gitprovider.New("bitbucket-cloud", srv.URL+"/2.0", srv.Client())
+		require.NoError(t, err)
+
+		_, err = gp.FetchPullRequestDiff(t.Context(), "token", gitprovider.PRRef{Owner: "owner", Repo: "repo", Number: 1})
+		var rlErr *gitprovider.RateLimitError
+		require.True(t, errors.As(err, &rlErr), "error should be *RateLimitError, got: %T", err)
+		expected := time.Now().Add(60 * time.Second)
+		assert.WithinDuration(t, expected.Add(gitprovider.RateLimitPadding), rlErr.RetryAfter, 5*time.Second)
+	})
+}
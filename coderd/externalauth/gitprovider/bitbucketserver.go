@@ -0,0 +1,539 @@
+package gitprovider
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+
+	"golang.org/x/xerrors"
+
+	"github.com/coder/quartz"
+)
+
+const (
+	// bitbucketServerAPIPath is appended to the web base URL to form
+	// the REST API base URL.
+	bitbucketServerAPIPath = "/rest/api/1.0"
+
+	// bitbucketServerPageLimit is the page size requested from
+	// paginated endpoints. Bitbucket Server caps this server-side
+	// (1000 by default).
+	bitbucketServerPageLimit = 1000
+
+	// bitbucketServerMaxPages bounds the number of pages followed
+	// when counting paginated resources.
+	bitbucketServerMaxPages = 5
+)
+
+// bitbucketServerProvider implements Provider for Bitbucket Server
+// and Bitbucket Data Center. Repositories are addressed by project
+// key and repository slug; personal repositories use the "~username"
+// pseudo project key, which the REST API accepts in place of a
+// project key.
+type bitbucketServerProvider struct {
+	apiBaseURL string
+	webBaseURL string
+	// webPath is the path component of webBaseURL (the instance's
+	// context path, e.g. "/bitbucket"), without a trailing slash.
+	webPath string
+	client  *restClient
+	clock   quartz.Clock
+}
+
+func newBitbucketServer(apiBaseURL string, httpClient *http.Client, clock quartz.Clock) (Provider, error) {
+	apiBaseURL = strings.TrimRight(apiBaseURL, "/")
+	if apiBaseURL == "" {
+		return nil, xerrors.New("bitbucket server requires an api base url")
+	}
+	if httpClient == nil {
+		httpClient = http.DefaultClient
+	}
+
+	// Accept either the instance URL or its REST API URL.
+	// https://bitbucket.corp.com/rest/api/1.0 → https://bitbucket.corp.com
+	webBaseURL := apiBaseURL
+	for _, suffix := range []string{bitbucketServerAPIPath, "/rest/api/latest"} {
+		webBaseURL = strings.TrimSuffix(webBaseURL, suffix)
+	}
+	u, err := url.Parse(webBaseURL)
+	if err != nil {
+		return nil, xerrors.Errorf("parse bitbucket server base url: %w", err)
+	}
+	if u.Host == "" {
+		return nil, xerrors.Errorf("bitbucket server base url %q has no host", apiBaseURL)
+	}
+
+	return &bitbucketServerProvider{
+		apiBaseURL: webBaseURL + bitbucketServerAPIPath,
+		webBaseURL: webBaseURL,
+		webPath:    strings.TrimSuffix(u.Path, "/"),
+		client: &restClient{
+			name:        "bitbucket server",
+			httpClient:  httpClient,
+			clock:       clock,
+			resetHeader: "X-RateLimit-Reset",
+		},
+		clock: clock,
+	}, nil
+}
+
+var _ Provider = (*bitbucketServerProvider)(nil)
+
+// webHost returns the hostname (with port if present) of the
+// Bitbucket Server web URL.
+func (b *bitbucketServerProvider) webHost() string {
+	return extractHost(b.webBaseURL)
+}
+
+// repoEndpoint returns the API URL of a repository, optionally
+// followed by additional path segments.
+func (b *bitbucketServerProvider) repoEndpoint(owner, repo string, segments ...string) string {
+	endpoint := fmt.Sprintf(
+		"%s/projects/%s/repos/%s",
+		b.apiBaseURL,
+		url.PathEscape(owner),
+		url.PathEscape(repo),
+	)
+	for _, seg := range segments {
+		endpoint += "/" + seg
+	}
+	return endpoint
+}
+
+// bitbucketServerUser is the subset of a Bitbucket Server user
+// object that we use.
+type bitbucketServerUser struct {
+	Name      string `json:"name"`
+	AvatarURL string `json:"avatarUrl"`
+}
+
+func (b *bitbucketServerProvider) FetchPullRequestStatus(
+	ctx context.Context,
+	token string,
+	ref PRRef,
+) (*PRStatus, error) {
+	pullEndpoint := b.repoEndpoint(ref.Owner, ref.Repo, "pull-requests", strconv.Itoa(ref.Number))
+
+	var pull struct {
+		ID      int    `json:"id"`
+		Title   string `json:"title"`
+		State   string `json:"state"`
+		Draft   bool   `json:"draft"`
+		FromRef struct {
+			DisplayID    string `json:"displayId"`
+			LatestCommit string `json:"latestCommit"`
+		} `json:"fromRef"`
+		ToRef struct {
+			DisplayID string `json:"displayId"`
+		} `json:"toRef"`
+		Author struct {
+			User bitbucketServerUser `json:"user"`
+		} `json:"author"`
+		Reviewers []struct {
+			User bitbucketServerUser `json:"user"`
+			// Status is APPROVED, NEEDS_WORK or UNAPPROVED.
+			Status string `json:"status"`
+		} `json:"reviewers"`
+	}
+	// avatarSize asks the server to include avatarUrl on user
+	// objects.
+	if _, err := b.client.getJSON(ctx, pullEndpoint+"?avatarSize=64", token, "get pull request", &pull); err != nil {
+		return nil, err
+	}
+
+	var changedFiles int32
+	err := forEachBitbucketServerPage(ctx, b.client, token, pullEndpoint+"/changes", "get pull request changes", func(values []struct{}) {
+		changedFiles += int32(len(values))
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	var commits int32
+	err = forEachBitbucketServerPage(ctx, b.client, token, pullEndpoint+"/commits", "get pull request commits", func(values []struct{}) {
+		commits += int32(len(values))
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	// Bitbucket Server does not report line counts on the pull
+	// request, so count them from the raw diff. Diffs exceeding
+	// MaxDiffSize leave Additions/Deletions at zero rather than
+	// failing the whole status refresh.
+	var additions, deletions int32
+	diff, err := b.FetchPullRequestDiff(ctx, token, ref)
+	switch {
+	case errors.Is(err, ErrDiffTooLarge):
+	case err != nil:
+		return nil, err
+	default:
+		additions, deletions = countDiffLines(diff)
+	}
+
+	var (
+		changesRequested bool
+		hasApproval      bool
+		reviewerCount    int32
+	)
+	for _, r := range pull.Reviewers {
+		switch strings.ToUpper(r.Status) {
+		case "NEEDS_WORK":
+			changesRequested = true
+			reviewerCount++
+		case "APPROVED":
+			hasApproval = true
+			reviewerCount++
+		}
+	}
+
+	return &PRStatus{
+		Title:      pull.Title,
+		State:      mapBitbucketState(pull.State),
+		Draft:      pull.Draft,
+		HeadSHA:    pull.FromRef.LatestCommit,
+		HeadBranch: pull.FromRef.DisplayID,
+		DiffStats: DiffStats{
+			Additions:    additions,
+			Deletions:    deletions,
+			ChangedFiles: changedFiles,
+		},
+		ChangesRequested: changesRequested,
+		Approved:         hasApproval && !changesRequested,
+		ReviewerCount:    reviewerCount,
+		AuthorLogin:      pull.Author.User.Name,
+		AuthorAvatarURL:  b.resolveWebURL(pull.Author.User.AvatarURL),
+		BaseBranch:       pull.ToRef.DisplayID,
+		PRNumber:         pull.ID,
+		Commits:          commits,
+		FetchedAt:        b.clock.Now().UTC(),
+	}, nil
+}
+
+// forEachBitbucketServerPage follows Bitbucket Server's
+// start/nextPageStart pagination starting at requestURL, invoking fn
+// with the values of each page. At most bitbucketServerMaxPages pages
+// are read.
+func forEachBitbucketServerPage[T any](
+	ctx context.Context,
+	client *restClient,
+	token string,
+	requestURL string,
+	action string,
+	fn func([]T),
+) error {
+	start := 0
+	for range bitbucketServerMaxPages {
+		query := url.Values{}
+		query.Set("start", strconv.Itoa(start))
+		query.Set("limit", strconv.Itoa(bitbucketServerPageLimit))
+
+		var page struct {
+			Values        []T  `json:"values"`
+			IsLastPage    bool `json:"isLastPage"`
+			NextPageStart int  `json:"nextPageStart"`
+		}
+		if _, err := client.getJSON(ctx, requestURL+"?"+query.Encode(), token, action, &page); err != nil {
+			return err
+		}
+		fn(page.Values)
+		if page.IsLastPage || page.NextPageStart <= start {
+			return nil
+		}
+		start = page.NextPageStart
+	}
+	return nil
+}
+
+// resolveWebURL resolves a possibly relative URL returned by the API
+// (such as a locally stored avatar) against the instance URL.
+func (b *bitbucketServerProvider) resolveWebURL(raw string) string {
+	if raw == "" {
+		return ""
+	}
+	ref, err := url.Parse(raw)
+	if err != nil {
+		return ""
+	}
+	base, err := url.Parse(b.webBaseURL)
+	if err != nil {
+		return raw
+	}
+	return base.ResolveReference(ref).String()
+}
+
+func (b *bitbucketServerProvider) ResolveBranchPullRequest(
+	ctx context.Context,
+	token string,
+	ref BranchRef,
+) (*PRRef, error) {
+	if ref.Owner == "" || ref.Repo == "" || ref.Branch == "" {
+		return nil, nil
+	}
+
+	query := url.Values{}
+	query.Set("at", "refs/heads/"+ref.Branch)
+	query.Set("direction", "OUTGOING")
+	query.Set("state", "OPEN")
+	query.Set("order", "NEWEST")
+	query.Set("limit", "1")
+
+	var pulls struct {
+		Values []struct {
+			ID    int `json:"id"`
+			Links struct {
+				Self []struct {
+					Href string `json:"href"`
+				} `json:"self"`
+			} `json:"links"`
+		} `json:"values"`
+	}
+	requestURL := b.repoEndpoint(ref.Owner, ref.Repo, "pull-requests") + "?" + query.Encode()
+	if _, err := b.client.getJSON(ctx, requestURL, token, "list pull requests by branch", &pulls); err != nil {
+		return nil, err
+	}
+	if len(pulls.Values) == 0 {
+		return nil, nil
+	}
+
+	pull := pulls.Values[0]
+	for _, link := range pull.Links.Self {
+		if prRef, ok := b.ParsePullRequestURL(link.Href); ok {
+			return &prRef, nil
+		}
+	}
+	// Fallback: construct from known owner/repo and returned ID.
+	return &PRRef{
+		Owner:  ref.Owner,
+		Repo:   ref.Repo,
+		Number: pull.ID,
+	}, nil
+}
+
+func (b *bitbucketServerProvider) FetchPullRequestDiff(
+	ctx context.Context,
+	token string,
+	ref PRRef,
+) (string, error) {
+	requestURL := b.repoEndpoint(ref.Owner, ref.Repo, "pull-requests", strconv.Itoa(ref.Number)+".diff")
+	return b.client.getDiff(ctx, requestURL, token, "get pull request diff")
+}
+
+func (b *bitbucketServerProvider) FetchBranchDiff(
+	ctx context.Context,
+	token string,
+	ref BranchRef,
+) (string, error) {
+	if ref.Owner == "" || ref.Repo == "" || ref.Branch == "" {
+		return "", nil
+	}
+
+	var defaultBranch struct {
+		DisplayID string `json:"displayId"`
+	}
+	if _, err := b.client.getJSON(ctx, b.repoEndpoint(ref.Owner, ref.Repo, "default-branch"), token, "get default branch", &defaultBranch); err != nil {
+		return "", err
+	}
+	if strings.TrimSpace(defaultBranch.DisplayID) == "" {
+		return "", xerrors.New("bitbucket server repository default branch is empty")
+	}
+
+	// The raw diff endpoint streams a unified diff when text/plain
+	// is requested.
+	query := url.Values{}
+	query.Set("since", "refs/heads/"+defaultBranch.DisplayID)
+	query.Set("until", "refs/heads/"+ref.Branch)
+	requestURL := b.repoEndpoint(ref.Owner, ref.Repo, "diff") + "?" + query.Encode()
+	return b.client.getDiff(ctx, requestURL, token, "compare branches")
+}
+
+// repoPathSegments extracts (owner, repo) from the path segments of a
+// web or clone URL, relative to the instance's context path.
+//
+// Supported layouts:
+//
+//	scm/PROJ/repo            (HTTP clone)
+//	scm/~user/repo           (HTTP clone, personal repository)
+//	projects/PROJ/repos/repo (web UI)
+//	users/user/repos/repo    (web UI, personal repository)
+//
+// The returned segments slice holds any path segments after the
+// repository (e.g. "pull-requests", "42").
+func repoPathSegments(segments []string) (owner, repo string, rest []string, ok bool) {
+	switch {
+	case len(segments) >= 3 && segments[0] == "scm":
+		owner, repo, rest = segments[1], segments[2], segments[3:]
+	case len(segments) >= 4 && segments[0] == "projects" && segments[2] == "repos":
+		owner, repo, rest = segments[1], segments[3], segments[4:]
+	case len(segments) >= 4 && segments[0] == "users" && segments[2] == "repos":
+		owner, repo, rest = "~"+segments[1], segments[3], segments[4:]
+	default:
+		return "", "", nil, false
+	}
+	repo = strings.TrimSuffix(repo, ".git")
+	if owner == "" || owner == "~" || repo == "" {
+		return "", "", nil, false
+	}
+	return normalizeBitbucketServerOwner(owner), repo, rest, true
+}
+
+// normalizeBitbucketServerOwner upper-cases project keys, which
+// clone URLs report in lower case. Personal "~user" keys are left
+// untouched.
+func normalizeBitbucketServerOwner(owner string) string {
+	if strings.HasPrefix(owner, "~") {
+		return owner
+	}
+	return strings.ToUpper(owner)
+}
+
+func (b *bitbucketServerProvider) ParseRepositoryOrigin(raw string) (owner, repo, normalizedOrigin string, ok bool) {
+	raw = strings.TrimSpace(raw)
+	if raw == "" {
+		return "", "", "", false
+	}
+
+	host := b.webHost()
+
+	// SSH clone URLs are served from the instance root on a
+	// dedicated port: ssh://git@HOST:7999/PROJ/repo.git.
+	sshPath, matched := parseSCPLikeOrigin(raw, host)
+	if !matched {
+		u, err := url.Parse(raw)
+		if err != nil {
+			return "", "", "", false
+		}
+		if u.Scheme == "ssh" {
+			if !strings.EqualFold(u.Hostname(), hostWithoutPort(host)) {
+				return "", "", "", false
+			}
+			sshPath, matched = trimOriginPath(u.Path), true
+		}
+	}
+	if matched {
+		parts := strings.Split(sshPath, "/")
+		if len(parts) != 2 || parts[0] == "" || parts[1] == "" {
+			return "", "", "", false
+		}
+		owner, repo = normalizeBitbucketServerOwner(parts[0]), parts[1]
+		return owner, repo, b.BuildRepositoryURL(owner, repo), true
+	}
+
+	u, err := url.Parse(raw)
+	if err != nil {
+		return "", "", "", false
+	}
+	if u.Scheme != "https" && u.Scheme != "http" {
+		return "", "", "", false
+	}
+	if !strings.EqualFold(u.Host, host) {
+		return "", "", "", false
+	}
+	segments, ok := relativePathSegments(b.webPath, u.Path)
+	if !ok {
+		return "", "", "", false
+	}
+	owner, repo, rest, ok := repoPathSegments(segments)
+	if !ok {
+		return "", "", "", false
+	}
+	// Clone URLs end at the repository; web URLs may continue with
+	// a view such as /browse.
+	if segments[0] == "scm" && len(rest) > 0 {
+		return "", "", "", false
+	}
+	return owner, repo, b.BuildRepositoryURL(owner, repo), true
+}
+
+func (b *bitbucketServerProvider) ParsePullRequestURL(raw string) (PRRef, bool) {
+	raw = strings.TrimSpace(raw)
+	if raw == "" {
+		return PRRef{}, false
+	}
+
+	u, err := url.Parse(raw)
+	if err != nil {
+		return PRRef{}, false
+	}
+	if u.Scheme != "https" && u.Scheme != "http" {
+		return PRRef{}, false
+	}
+	if !strings.EqualFold(u.Host, b.webHost()) {
+		return PRRef{}, false
+	}
+
+	// Bitbucket Server PR URLs:
+	// /projects/PROJ/repos/repo/pull-requests/123[/overview|/diff|...]
+	// /users/user/repos/repo/pull-requests/123
+	segments, ok := relativePathSegments(b.webPath, u.Path)
+	if !ok || segments[0] == "scm" {
+		return PRRef{}, false
+	}
+	owner, repo, rest, ok := repoPathSegments(segments)
+	if !ok || len(rest) < 2 || rest[0] != "pull-requests" {
+		return PRRef{}, false
+	}
+	number, err := strconv.Atoi(rest[1])
+	if err != nil || number <= 0 {
+		return PRRef{}, false
+	}
+
+	return PRRef{
+		Owner:  owner,
+		Repo:   repo,
+		Number: number,
+	}, true
+}
+
+// NormalizePullRequestURL normalizes a Bitbucket Server pull request URL.
+func (b *bitbucketServerProvider) NormalizePullRequestURL(raw string) string {
+	ref, ok := b.ParsePullRequestURL(strings.TrimRight(
+		strings.TrimSpace(raw),
+		trailingPunctuation,
+	))
+	if !ok {
+		return ""
+	}
+	return b.BuildPullRequestURL(ref)
+}
+
+// BuildBranchURL links to the repository browser at the branch;
+// Bitbucket Server has no dedicated branch page.
+func (b *bitbucketServerProvider) BuildBranchURL(owner, repo, branch string) string {
+	branch = strings.TrimSpace(branch)
+	repoURL := b.BuildRepositoryURL(owner, repo)
+	if repoURL == "" || branch == "" {
+		return ""
+	}
+	return repoURL + "/browse?at=" + url.QueryEscape("refs/heads/"+branch)
+}
+
+func (b *bitbucketServerProvider) BuildRepositoryURL(owner, repo string) string {
+	owner = strings.TrimSpace(owner)
+	repo = strings.TrimSpace(repo)
+	if owner == "" || repo == "" {
+		return ""
+	}
+	if user, ok := strings.CutPrefix(owner, "~"); ok {
+		if user == "" {
+			return ""
+		}
+		return fmt.Sprintf("%s/users/%s/repos/%s", b.webBaseURL, url.PathEscape(user), url.PathEscape(repo))
+	}
+	return fmt.Sprintf("%s/projects/%s/repos/%s", b.webBaseURL, url.PathEscape(owner), url.PathEscape(repo))
+}
+
+func (b *bitbucketServerProvider) BuildPullRequestURL(ref PRRef) string {
+	if ref.Number <= 0 {
+		return ""
+	}
+	repoURL := b.BuildRepositoryURL(ref.Owner, ref.Repo)
+	if repoURL == "" {
+		return ""
+	}
+	return fmt.Sprintf("%s/pull-requests/%d", repoURL, ref.Number)
+}
@@ -0,0 +1,276 @@
+package gitprovider_test
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/coder/coder/v2/coderd/externalauth/gitprovider"
+)
+
+func TestNewBitbucketServerRequiresBaseURL(t *testing.T) {
+	t.Parallel()
+	gp, err := gitprovider.New("bitbucket-server", "", nil)
+	require.Error(t, err)
+	assert.Nil(t, gp)
+}
+
+func TestBitbucketServerParseRepositoryOrigin(t *testing.T) {
+	t.Parallel()
+	gp, err := gitprovider.New("bitbucket-server", "https://bitbucket.corp.com/rest/api/1.0", nil)
+	require.NoError(t, err)
+	require.NotNil(t, gp)
+
+	tests := []struct {
+		name             string
+		raw              string
+		expectOK         bool
+		expectOwner      string
+		expectRepo       string
+		expectNormalized string
+	}{
+		{
+			name:             "HTTPS clone URL",
+			raw:              "https://bitbucket.corp.com/scm/proj/repo.git",
+			expectOK:         true,
+			expectOwner:      "PROJ",
+			expectRepo:       "repo",
+			expectNormalized: "https://bitbucket.corp.com/projects/PROJ/repos/repo",
+		},
+		{
+			name:             "HTTPS clone URL with user",
+			raw:              "https://alice@bitbucket.corp.com/scm/proj/repo.git",
+			expectOK:         true,
+			expectOwner:      "PROJ",
+			expectRepo:       "repo",
+			expectNormalized: "https://bitbucket.corp.com/projects/PROJ/repos/repo",
+		},
+		{
+			name:             "Personal repository clone URL",
+			raw:              "https://bitbucket.corp.com/scm/~alice/dotfiles.git",
+			expectOK:         true,
+			expectOwner:      "~alice",
+			expectRepo:       "dotfiles",
+			expectNormalized: "https://bitbucket.corp.com/users/alice/repos/dotfiles",
+		},
+		{
+			name:             "SSH clone URL on custom port",
+			raw:              "ssh://git@bitbucket.corp.com:7999/proj/repo.git",
+			expectOK:         true,
+			expectOwner:      "PROJ",
+			expectRepo:       "repo",
+			expectNormalized: "https://bitbucket.corp.com/projects/PROJ/repos/repo",
+		},
+		{
+			name:             "Web URL",
+			raw:              "https://bitbucket.corp.com/projects/PROJ/repos/repo/browse",
+			expectOK:         true,
+			expectOwner:      "PROJ",
+			expectRepo:       "repo",
+			expectNormalized: "https://bitbucket.corp.com/projects/PROJ/repos/repo",
+		},
+		{
+			name:     "Other host",
+			raw:      "https://bitbucket.org/proj/repo.git",
+			expectOK: false,
+		},
+		{
+			name:     "Unknown layout",
+			raw:      "https://bitbucket.corp.com/proj/repo.git",
+			expectOK: false,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			owner, repo, normalized, ok := gp.ParseRepositoryOrigin(tt.raw)
+			assert.Equal(t, tt.expectOK, ok)
+			if tt.expectOK {
+				assert.Equal(t, tt.expectOwner, owner)
+				assert.Equal(t, tt.expectRepo, repo)
+				assert.Equal(t, tt.expectNormalized, normalized)
+			}
+		})
+	}
+}
+
+func TestBitbucketServerPullRequestURLs(t *testing.T) {
+	t.Parallel()
+
+	t.Run("Root", func(t *testing.T) {
+		t.Parallel()
+		gp, err := gitprovider.New("bitbucket-server", "https://bitbucket.corp.com", nil)
+		require.NoError(t, err)
+
+		ref, ok := gp.ParsePullRequestURL("https://bitbucket.corp.com/projects/PROJ/repos/repo/pull-requests/42/overview")
+		require.True(t, ok)
+		assert.Equal(t, gitprovider.PRRef{Owner: "PROJ", Repo: "repo", Number: 42}, ref)
+
+		ref, ok = gp.ParsePullRequestURL("https://bitbucket.corp.com/users/alice/repos/dotfiles/pull-requests/3")
+		require.True(t, ok)
+		assert.Equal(t, gitprovider.PRRef{Owner: "~alice", Repo: "dotfiles", Number: 3}, ref)
+
+		_, ok = gp.ParsePullRequestURL("https://bitbucket.corp.com/projects/PROJ/repos/repo/browse")
+		assert.False(t, ok)
+
+		assert.Equal(t,
+			"https://bitbucket.corp.com/projects/PROJ/repos/repo/pull-requests/42",
+			gp.NormalizePullRequestURL("https://bitbucket.corp.com/projects/PROJ/repos/repo/pull-requests/42/diff#f1)"),
+		)
+		assert.Equal(t,
+			"https://bitbucket.corp.com/users/alice/repos/dotfiles/pull-requests/3",
+			gp.BuildPullRequestURL(gitprovider.PRRef{Owner: "~alice", Repo: "dotfiles", Number: 3}),
+		)
+		assert.Equal(t,
+			"https://bitbucket.corp.com/projects/PROJ/repos/repo/browse?at=refs%2Fheads%2Ffeat%2Fx",
+			gp.BuildBranchURL("PROJ", "repo", "feat/x"),
+		)
+	})
+
+	t.Run("ContextPath", func(t *testing.T) {
+		t.Parallel()
+		gp, err := gitprovider.New("bitbucket-server", "https://corp.com/bitbucket/rest/api/1.0", nil)
+		require.NoError(t, err)
+
+		ref, ok := gp.ParsePullRequestURL("https://corp.com/bitbucket/projects/PROJ/repos/repo/pull-requests/42")
+		require.True(t, ok)
+		assert.Equal(t, gitprovider.PRRef{Owner: "PROJ", Repo: "repo", Number: 42}, ref)
+
+		_, ok = gp.ParsePullRequestURL("https://corp.com/projects/PROJ/repos/repo/pull-requests/42")
+		assert.False(t, ok)
+
+		assert.Equal(t, "https://corp.com/bitbucket/projects/PROJ/repos/repo", gp.BuildRepositoryURL("PROJ", "repo"))
+	})
+}
+
+func TestBitbucketServerFetchPullRequestStatus(t *testing.T) {
+	t.Parallel()
+
+	const diff = "diff --git a/a.go b/a.go\n--- a/a.go\n+++ b/a.go\n@@ -1,2 +1,3 @@\n-old\n+new\n+another\n"
+
+	mux := http.NewServeMux()
+	mux.HandleFunc("/rest/api/1.0/projects/PROJ/repos/repo/pull-requests/5", func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "Bearer token", r.Header.Get("Authorization"))
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write([]byte(`{
+			"id": 5,
+			"title": "Fix bug",
+			"state": "MERGED",
+			"fromRef": {"displayId": "fix", "latestCommit": "deadbeef"},
+			"toRef": {"displayId": "develop"},
+			"author": {"user": {"name": "alice", "avatarUrl": "/users/alice/avatar.png?s=64"}},
+			"reviewers": [
+				{"user": {"name": "bob"}, "status": "APPROVED"},
+				{"user": {"name": "carol"}, "status": "UNAPPROVED"}
+			]
+		}`))
+	})
+	mux.HandleFunc("/rest/api/1.0/projects/PROJ/repos/repo/pull-requests/5/changes", func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		if r.URL.Query().Get("start") == "2" {
+			_, _ = w.Write([]byte(`{"values":[{}],"isLastPage":true}`))
+			return
+		}
+		_, _ = w.Write([]byte(`{"values":[{},{}],"isLastPage":false,"nextPageStart":2}`))
+	})
+	mux.HandleFunc("/rest/api/1.0/projects/PROJ/repos/repo/pull-requests/5/commits", func(w http.ResponseWriter, _ *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write([]byte(`{"values":[{},{}],"isLastPage":true}`))
+	})
+	mux.HandleFunc("/rest/api/1.0/projects/PROJ/repos/repo/pull-requests/5.diff", func(w http.ResponseWriter, _ *http.Request) {
+		_, _ = w.Write([]byte(diff))
+	})
+	srv := httptest.NewServer(mux)
+	defer srv.Close()
+
+	gp, err := gitprovider.New("bitbucket-server", srv.URL, srv.Client())
+	require.NoError(t, err)
+
+	status, err := gp.FetchPullRequestStatus(t.Context(), "token", gitprovider.PRRef{Owner: "PROJ", Repo: "repo", Number: 5})
+	require.NoError(t, err)
+	assert.Equal(t, "Fix bug", status.Title)
+	assert.Equal(t, gitprovider.PRStateMerged, status.State)
+	assert.Equal(t, "deadbeef", status.HeadSHA)
+	assert.Equal(t, "fix", status.HeadBranch)
+	assert.Equal(t, "develop", status.BaseBranch)
+	assert.Equal(t, "alice", status.AuthorLogin)
+	assert.Equal(t, srv.URL+"/users/alice/avatar.png?s=64", status.AuthorAvatarURL)
+	assert.Equal(t, gitprovider.DiffStats{Additions: 2, Deletions: 1, ChangedFiles: 3}, status.DiffStats)
+	assert.Equal(t, int32(2), status.Commits)
+	assert.True(t, status.Approved)
+	assert.False(t, status.ChangesRequested)
+	assert.Equal(t, int32(1), status.ReviewerCount)
+	assert.Equal(t, 5, status.PRNumber)
+}
+
+func TestBitbucketServerResolveBranchPullRequest(t *testing.T) {
+	t.Parallel()
+
+	var srvURL string
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "/rest/api/1.0/projects/PROJ/repos/repo/pull-requests", r.URL.Path)
+		assert.Equal(t, "refs/heads/feat", r.URL.Query().Get("at"))
+		assert.Equal(t, "OUTGOING", r.URL.Query().Get("direction"))
+		assert.Equal(t, "OPEN", r.URL.Query().Get("state"))
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write([]byte(`{"values":[{"id":9,"links":{"self":[{"href":"` + srvURL + `/projects/PROJ/repos/repo/pull-requests/9"}]}}]}`))
+	}))
+	defer srv.Close()
+	srvURL = srv.URL
+
+	gp, err := gitprovider.New("bitbucket-server", srv.URL, srv.Client())
+	require.NoError(t, err)
+
+	ref, err := gp.ResolveBranchPullRequest(t.Context(), "token", gitprovider.BranchRef{Owner: "PROJ", Repo: "repo", Branch: "feat"})
+	require.NoError(t, err)
+	require.NotNil(t, ref)
+	assert.Equal(t, gitprovider.PRRef{Owner: "PROJ", Repo: "repo", Number: 9}, *ref)
+}
+
+func TestBitbucketServerFetchBranchDiff(t *testing.T) {
+	t.Parallel()
+
+	const diff = "diff --git a/a.go b/a.go\n--- a/a.go\n+++ b/a.go\n@@ -1 +1 @@\n-old\n+new\n"
+
+	mux := http.NewServeMux()
+	mux.HandleFunc("/rest/api/1.0/projects/PROJ/repos/repo/default-branch", func(w http.ResponseWriter, _ *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write([]byte(`{"id":"refs/heads/main","displayId":"main"}`))
+	})
+	mux.HandleFunc("/rest/api/1.0/projects/PROJ/repos/repo/diff", func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "text/plain", r.Header.Get("Accept"))
+		assert.Equal(t, "refs/heads/main", r.URL.Query().Get("since"))
+		assert.Equal(t, "refs/heads/feat", r.URL.Query().Get("until"))
+		_, _ = w.Write([]byte(diff))
+	})
+	srv := httptest.NewServer(mux)
+	defer srv.Close()
+
+	gp, err := gitprovider.New("bitbucket-server", srv.URL+"/rest/api/1.0", srv.Client())
+	require.NoError(t, err)
+
+	got, err := gp.FetchBranchDiff(t.Context(), "token", gitprovider.BranchRef{Owner: "PROJ", Repo: "repo", Branch: "feat"})
+	require.NoError(t, err)
+	assert.Equal(t, diff, got)
+
+	t.Run("Error", func(t *testing.T) {
+		t.Parallel()
+		srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+			w.WriteHeader(http.StatusNotFound)
+			_, _ = w.Write([]byte(`{"errors":[{"message":"Repository does not exist."}]}`))
+		}))
+		defer srv.Close()
+
+		gp, err := gitprovider.New("bitbucket-server", srv.URL, srv.Client())
+		require.NoError(t, err)
+
+		_, err = gp.FetchBranchDiff(t.Context(), "token", gitprovider.BranchRef{Owner: "PROJ", Repo: "repo", Branch: "feat"})
+		require.Error(t, err)
+		assert.True(t, strings.Contains(err.Error(), "404"), "error should mention status: %s", err)
+	})
+}
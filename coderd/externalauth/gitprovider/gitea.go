@@ -0,0 +1,479 @@
+package gitprovider
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+
+	"golang.org/x/xerrors"
+
+	"github.com/coder/quartz"
+)
+
+const (
+	defaultGiteaAPIBaseURL = "https://gitea.com/api/v1"
+
+	// giteaPageLimit is the page size requested from paginated
+	// endpoints. Gitea's default MAX_RESPONSE_ITEMS is 50.
+	giteaPageLimit = 50
+
+	// giteaMaxBranchPages bounds how many pages of open pull requests
+	// are scanned when resolving a branch, since Gitea cannot filter
+	// the pull request list by head branch.
+	giteaMaxBranchPages = 5
+)
+
+// giteaWIPPrefixes are Gitea's default WORK_IN_PROGRESS_PREFIXES. Gitea
+// versions that predate the "draft" field only mark drafts through
+// these title prefixes.
+var giteaWIPPrefixes = []string{"WIP:", "[WIP]"}
+
+// giteaProvider implements Provider for Gitea and its API-compatible
+// fork Forgejo.
+type giteaProvider struct {
+	apiBaseURL string
+	webBaseURL string
+	// webPath is the path component of webBaseURL (the instance's
+	// sub-path, e.g. "/gitea"), without a trailing slash.
+	webPath string
+	client  *restClient
+	clock   quartz.Clock
+}
+
+func newGitea(apiBaseURL string, httpClient *http.Client, clock quartz.Clock) (Provider, error) {
+	if apiBaseURL == "" {
+		apiBaseURL = defaultGiteaAPIBaseURL
+	}
+	apiBaseURL = strings.TrimRight(apiBaseURL, "/")
+	if httpClient == nil {
+		httpClient = http.DefaultClient
+	}
+
+	// https://gitea.corp.com/api/v1 → https://gitea.corp.com
+	webBaseURL := strings.TrimSuffix(apiBaseURL, "/api/v1")
+	u, err := url.Parse(webBaseURL)
+	if err != nil {
+		return nil, xerrors.Errorf("parse gitea base url: %w", err)
+	}
+	if u.Host == "" {
+		return nil, xerrors.Errorf("gitea base url %q has no host", apiBaseURL)
+	}
+
+	return &giteaProvider{
+		apiBaseURL: webBaseURL + "/api/v1",
+		webBaseURL: webBaseURL,
+		webPath:    strings.TrimSuffix(u.Path, "/"),
+		client: &restClient{
+			name:        "gitea",
+			httpClient:  httpClient,
+			clock:       clock,
+			resetHeader: "X-RateLimit-Reset",
+		},
+		clock: clock,
+	}, nil
+}
+
+var _ Provider = (*giteaProvider)(nil)
+
+// webHost returns the hostname (with port if present) of the Gitea
+// web URL.
+func (g *giteaProvider) webHost() string {
+	return extractHost(g.webBaseURL)
+}
+
+// repoEndpoint returns the API URL of a repository, optionally
+// followed by additional path segments.
+func (g *giteaProvider) repoEndpoint(owner, repo string, segments ...string) string {
+	endpoint := fmt.Sprintf(
+		"%s/repos/%s/%s",
+		g.apiBaseURL,
+		url.PathEscape(owner),
+		url.PathEscape(repo),
+	)
+	for _, seg := range segments {
+		endpoint += "/" + seg
+	}
+	return endpoint
+}
+
+func (g *giteaProvider) FetchPullRequestStatus(
+	ctx context.Context,
+	token string,
+	ref PRRef,
+) (*PRStatus, error) {
+	pullEndpoint := g.repoEndpoint(ref.Owner, ref.Repo, "pulls", strconv.Itoa(ref.Number))
+
+	var pull struct {
+		Number       int    `json:"number"`
+		Title        string `json:"title"`
+		State        string `json:"state"`
+		Merged       bool   `json:"merged"`
+		Draft        bool   `json:"draft"`
+		Additions    *int32 `json:"additions"`
+		Deletions    *int32 `json:"deletions"`
+		ChangedFiles *int32 `json:"changed_files"`
+		Head         struct {
+			Ref string `json:"ref"`
+			SHA string `json:"sha"`
+		} `json:"head"`
+		Base struct {
+			Ref string `json:"ref"`
+		} `json:"base"`
+		User struct {
+			Login     string `json:"login"`
+			AvatarURL string `json:"avatar_url"`
+		} `json:"user"`
+	}
+	if _, err := g.client.getJSON(ctx, pullEndpoint, token, "get pull request", &pull); err != nil {
+		return nil, err
+	}
+
+	// The commit list reports its total through X-Total-Count, so a
+	// single-item page is enough to count commits.
+	var commits []struct{}
+	header, err := g.client.getJSON(
+		ctx,
+		pullEndpoint+"/commits?limit=1&stat=false&verification=false&files=false",
+		token,
+		"get pull request commits",
+		&commits,
+	)
+	if err != nil {
+		return nil, err
+	}
+	commitCount := int32(len(commits))
+	if total, err := strconv.Atoi(header.Get("X-Total-Count")); err == nil {
+		commitCount = int32(total)
+	}
+
+	var giteaReviews []struct {
+		ID        int64  `json:"id"`
+		State     string `json:"state"`
+		Dismissed bool   `json:"dismissed"`
+		User      struct {
+			Login string `json:"login"`
+		} `json:"user"`
+	}
+	// Like the GitHub provider, we read a single page of reviews.
+	if _, err := g.client.getJSON(
+		ctx,
+		pullEndpoint+"/reviews?limit="+strconv.Itoa(giteaPageLimit),
+		token,
+		"list pull request reviews",
+		&giteaReviews,
+	); err != nil {
+		return nil, err
+	}
+
+	// Translate Gitea review states to GitHub's so the same
+	// latest-decisive-review logic applies.
+	reviews := make([]struct {
+		ID    int64  `json:"id"`
+		State string `json:"state"`
+		User  struct {
+			Login string `json:"login"`
+		} `json:"user"`
+	}, len(giteaReviews))
+	for i, r := range giteaReviews {
+		reviews[i].ID = r.ID
+		reviews[i].User.Login = r.User.Login
+		switch state := strings.ToUpper(r.State); {
+		case r.Dismissed:
+			reviews[i].State = "DISMISSED"
+		case state == "REQUEST_CHANGES":
+			reviews[i].State = "CHANGES_REQUESTED"
+		default:
+			reviews[i].State = state
+		}
+	}
+	reviewInfo := summarizeReviews(reviews)
+
+	// Gitea only reports line counts since 1.22. On older servers,
+	// derive them from the raw diff; diffs exceeding MaxDiffSize
+	// leave the counts at zero.
+	var diffStats DiffStats
+	if pull.Additions != nil && pull.Deletions != nil && pull.ChangedFiles != nil {
+		diffStats = DiffStats{
+			Additions:    *pull.Additions,
+			Deletions:    *pull.Deletions,
+			ChangedFiles: *pull.ChangedFiles,
+		}
+	} else {
+		diff, err := g.FetchPullRequestDiff(ctx, token, ref)
+		switch {
+		case errors.Is(err, ErrDiffTooLarge):
+		case err != nil:
+			return nil, err
+		default:
+			diffStats.Additions, diffStats.Deletions = countDiffLines(diff)
+			diffStats.ChangedFiles = int32(strings.Count(diff, "diff --git "))
+		}
+	}
+
+	state := PRState(strings.ToLower(strings.TrimSpace(pull.State)))
+	if pull.Merged {
+		state = PRStateMerged
+	}
+
+	draft := pull.Draft
+	for _, prefix := range giteaWIPPrefixes {
+		if strings.HasPrefix(strings.ToUpper(pull.Title), prefix) {
+			draft = true
+		}
+	}
+
+	return &PRStatus{
+		Title:            pull.Title,
+		State:            state,
+		Draft:            draft,
+		HeadSHA:          pull.Head.SHA,
+		HeadBranch:       pull.Head.Ref,
+		DiffStats:        diffStats,
+		ChangesRequested: reviewInfo.changesRequested,
+		Approved:         reviewInfo.approved,
+		ReviewerCount:    reviewInfo.reviewerCount,
+		AuthorLogin:      pull.User.Login,
+		AuthorAvatarURL:  pull.User.AvatarURL,
+		BaseBranch:       pull.Base.Ref,
+		PRNumber:         pull.Number,
+		Commits:          commitCount,
+		FetchedAt:        g.clock.Now().UTC(),
+	}, nil
+}
+
+func (g *giteaProvider) ResolveBranchPullRequest(
+	ctx context.Context,
+	token string,
+	ref BranchRef,
+) (*PRRef, error) {
+	if ref.Owner == "" || ref.Repo == "" || ref.Branch == "" {
+		return nil, nil
+	}
+
+	// Gitea's pull request list cannot be filtered by head branch,
+	// so scan the most recently updated open pull requests.
+	for page := 1; page <= giteaMaxBranchPages; page++ {
+		query := url.Values{}
+		query.Set("state", "open")
+		query.Set("sort", "recentupdate")
+		query.Set("page", strconv.Itoa(page))
+		query.Set("limit", strconv.Itoa(giteaPageLimit))
+
+		var pulls []struct {
+			Number  int    `json:"number"`
+			HTMLURL string `json:"html_url"`
+			Head    struct {
+				Ref  string `json:"ref"`
+				Repo *struct {
+					FullName string `json:"full_name"`
+				} `json:"repo"`
+			} `json:"head"`
+		}
+		requestURL := g.repoEndpoint(ref.Owner, ref.Repo, "pulls") + "?" + query.Encode()
+		if _, err := g.client.getJSON(ctx, requestURL, token, "list pull requests", &pulls); err != nil {
+			return nil, err
+		}
+
+		for _, pull := range pulls {
+			if pull.Head.Ref != ref.Branch {
+				continue
+			}
+			// Match GitHub's owner:branch semantics and ignore
+			// pull requests from forks that reuse the branch name.
+			if pull.Head.Repo != nil && !strings.EqualFold(pull.Head.Repo.FullName, ref.Owner+"/"+ref.Repo) {
+				continue
+			}
+			if prRef, ok := g.ParsePullRequestURL(pull.HTMLURL); ok {
+				return &prRef, nil
+			}
+			return &PRRef{
+				Owner:  ref.Owner,
+				Repo:   ref.Repo,
+				Number: pull.Number,
+			}, nil
+		}
+		if len(pulls) < giteaPageLimit {
+			break
+		}
+	}
+	return nil, nil
+}
+
+func (g *giteaProvider) FetchPullRequestDiff(
+	ctx context.Context,
+	token string,
+	ref PRRef,
+) (string, error) {
+	requestURL := g.repoEndpoint(ref.Owner, ref.Repo, "pulls", strconv.Itoa(ref.Number)+".diff")
+	return g.client.getDiff(ctx, requestURL, token, "get pull request diff")
+}
+
+func (g *giteaProvider) FetchBranchDiff(
+	ctx context.Context,
+	token string,
+	ref BranchRef,
+) (string, error) {
+	if ref.Owner == "" || ref.Repo == "" || ref.Branch == "" {
+		return "", nil
+	}
+
+	var repository struct {
+		DefaultBranch string `json:"default_branch"`
+	}
+	if _, err := g.client.getJSON(ctx, g.repoEndpoint(ref.Owner, ref.Repo), token, "get repository", &repository); err != nil {
+		return "", err
+	}
+	defaultBranch := strings.TrimSpace(repository.DefaultBranch)
+	if defaultBranch == "" {
+		return "", xerrors.New("gitea repository default branch is empty")
+	}
+
+	// The REST API has no raw compare diff, so use the web compare
+	// route, which accepts the same OAuth2 bearer tokens and diffs
+	// against the merge base like GitHub's three-dot compare.
+	requestURL := fmt.Sprintf(
+		"%s/%s/%s/compare/%s...%s.diff",
+		g.webBaseURL,
+		url.PathEscape(ref.Owner),
+		url.PathEscape(ref.Repo),
+		escapePathPreserveSlashes(defaultBranch),
+		escapePathPreserveSlashes(ref.Branch),
+	)
+	return g.client.getDiff(ctx, requestURL, token, "compare branches")
+}
+
+func (g *giteaProvider) ParseRepositoryOrigin(raw string) (owner, repo, normalizedOrigin string, ok bool) {
+	raw = strings.TrimSpace(raw)
+	if raw == "" {
+		return "", "", "", false
+	}
+
+	host := g.webHost()
+
+	// SSH clone URLs are served from the instance root regardless of
+	// the web sub-path.
+	path, matched := parseSCPLikeOrigin(raw, host)
+	if !matched {
+		u, err := url.Parse(raw)
+		if err != nil {
+			return "", "", "", false
+		}
+		switch u.Scheme {
+		case "ssh":
+			if !strings.EqualFold(u.Hostname(), hostWithoutPort(host)) {
+				return "", "", "", false
+			}
+			path = trimOriginPath(u.Path)
+		case "https", "http":
+			if !strings.EqualFold(u.Host, host) {
+				return "", "", "", false
+			}
+			segments, ok := relativePathSegments(g.webPath, u.Path)
+			if !ok {
+				return "", "", "", false
+			}
+			path = trimOriginPath(strings.Join(segments, "/"))
+		default:
+			return "", "", "", false
+		}
+	}
+
+	parts := strings.Split(path, "/")
+	if len(parts) != 2 || parts[0] == "" || parts[1] == "" {
+		return "", "", "", false
+	}
+	owner, repo = parts[0], parts[1]
+	return owner, repo, g.BuildRepositoryURL(owner, repo), true
+}
+
+func (g *giteaProvider) ParsePullRequestURL(raw string) (PRRef, bool) {
+	raw = strings.TrimSpace(raw)
+	if raw == "" {
+		return PRRef{}, false
+	}
+
+	u, err := url.Parse(raw)
+	if err != nil {
+		return PRRef{}, false
+	}
+	if u.Scheme != "https" && u.Scheme != "http" {
+		return PRRef{}, false
+	}
+	if !strings.EqualFold(u.Host, g.webHost()) {
+		return PRRef{}, false
+	}
+
+	// Gitea PR URLs: /owner/repo/pulls/123[/files|/commits]
+	segments, ok := relativePathSegments(g.webPath, u.Path)
+	if !ok || len(segments) < 4 || segments[2] != "pulls" {
+		return PRRef{}, false
+	}
+	number, err := strconv.Atoi(segments[3])
+	if err != nil || number <= 0 {
+		return PRRef{}, false
+	}
+	if segments[0] == "" || segments[1] == "" {
+		return PRRef{}, false
+	}
+
+	return PRRef{
+		Owner:  segments[0],
+		Repo:   segments[1],
+		Number: number,
+	}, true
+}
+
+// NormalizePullRequestURL normalizes a Gitea pull request URL.
+func (g *giteaProvider) NormalizePullRequestURL(raw string) string {
+	ref, ok := g.ParsePullRequestURL(strings.TrimRight(
+		strings.TrimSpace(raw),
+		trailingPunctuation,
+	))
+	if !ok {
+		return ""
+	}
+	return g.BuildPullRequestURL(ref)
+}
+
+func (g *giteaProvider) BuildBranchURL(owner, repo, branch string) string {
+	owner = strings.TrimSpace(owner)
+	repo = strings.TrimSpace(repo)
+	branch = strings.TrimSpace(branch)
+	if owner == "" || repo == "" || branch == "" {
+		return ""
+	}
+
+	return fmt.Sprintf(
+		"%s/%s/%s/src/branch/%s",
+		g.webBaseURL,
+		url.PathEscape(owner),
+		url.PathEscape(repo),
+		escapePathPreserveSlashes(branch),
+	)
+}
+
+func (g *giteaProvider) BuildRepositoryURL(owner, repo string) string {
+	owner = strings.TrimSpace(owner)
+	repo = strings.TrimSpace(repo)
+	if owner == "" || repo == "" {
+		return ""
+	}
+	return fmt.Sprintf("%s/%s/%s", g.webBaseURL, url.PathEscape(owner), url.PathEscape(repo))
+}
+
+func (g *giteaProvider) BuildPullRequestURL(ref PRRef) string {
+	if ref.Owner == "" || ref.Repo == "" || ref.Number <= 0 {
+		return ""
+	}
+	return fmt.Sprintf(
+		"%s/%s/%s/pulls/%d",
+		g.webBaseURL,
+		url.PathEscape(ref.Owner),
+		url.PathEscape(ref.Repo),
+		ref.Number,
+	)
+}
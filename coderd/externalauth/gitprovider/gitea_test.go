@@ -0,0 +1,261 @@
+package gitprovider_test
+
+import (
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/coder/coder/v2/coderd/externalauth/gitprovider"
+)
+
+func TestGiteaParseRepositoryOrigin(t *testing.T) {
+	t.Parallel()
+	gp, err := gitprovider.New("gitea", "https://git.corp.com/api/v1", nil)
+	require.NoError(t, err)
+	require.NotNil(t, gp)
+
+	tests := []struct {
+		name             string
+		raw              string
+		expectOK         bool
+		expectOwner      string
+		expectRepo       string
+		expectNormalized string
+	}{
+		{
+			name:             "HTTPS URL",
+			raw:              "https://git.corp.com/org/repo.git",
+			expectOK:         true,
+			expectOwner:      "org",
+			expectRepo:       "repo",
+			expectNormalized: "https://git.corp.com/org/repo",
+		},
+		{
+			name:             "SCP-like SSH URL",
+			raw:              "git@git.corp.com:org/repo.git",
+			expectOK:         true,
+			expectOwner:      "org",
+			expectRepo:       "repo",
+			expectNormalized: "https://git.corp.com/org/repo",
+		},
+		{
+			name:             "SSH URL with custom port",
+			raw:              "ssh://git@git.corp.com:2222/org/repo.git",
+			expectOK:         true,
+			expectOwner:      "org",
+			expectRepo:       "repo",
+			expectNormalized: "https://git.corp.com/org/repo",
+		},
+		{
+			name:     "Other host",
+			raw:      "https://gitea.com/org/repo.git",
+			expectOK: false,
+		},
+		{
+			name:     "Not a repository",
+			raw:      "https://git.corp.com/org",
+			expectOK: false,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			owner, repo, normalized, ok := gp.ParseRepositoryOrigin(tt.raw)
+			assert.Equal(t, tt.expectOK, ok)
+			if tt.expectOK {
+				assert.Equal(t, tt.expectOwner, owner)
+				assert.Equal(t, tt.expectRepo, repo)
+				assert.Equal(t, tt.expectNormalized, normalized)
+			}
+		})
+	}
+}
+
+func TestGiteaPullRequestURLs(t *testing.T) {
+	t.Parallel()
+
+	t.Run("Default", func(t *testing.T) {
+		t.Parallel()
+		gp, err := gitprovider.New("gitea", "", nil)
+		require.NoError(t, err)
+
+		ref, ok := gp.ParsePullRequestURL("https://gitea.com/org/repo/pulls/12/files")
+		require.True(t, ok)
+		assert.Equal(t, gitprovider.PRRef{Owner: "org", Repo: "repo", Number: 12}, ref)
+
+		_, ok = gp.ParsePullRequestURL("https://gitea.com/org/repo/issues/12")
+		assert.False(t, ok)
+
+		assert.Equal(t, "https://gitea.com/org/repo/pulls/12", gp.NormalizePullRequestURL("https://gitea.com/org/repo/pulls/12?x=1."))
+		assert.Equal(t, "https://gitea.com/org/repo/src/branch/feat/x", gp.BuildBranchURL("org", "repo", "feat/x"))
+		assert.Equal(t, "https://gitea.com/org/repo", gp.BuildRepositoryURL("org", "repo"))
+	})
+
+	t.Run("SubPath", func(t *testing.T) {
+		t.Parallel()
+		gp, err := gitprovider.New("gitea", "https://corp.com/gitea/api/v1", nil)
+		require.NoError(t, err)
+
+		ref, ok := gp.ParsePullRequestURL("https://corp.com/gitea/org/repo/pulls/3")
+		require.True(t, ok)
+		assert.Equal(t, gitprovider.PRRef{Owner: "org", Repo: "repo", Number: 3}, ref)
+
+		owner, repo, normalized, ok := gp.ParseRepositoryOrigin("https://corp.com/gitea/org/repo.git")
+		require.True(t, ok)
+		assert.Equal(t, "org", owner)
+		assert.Equal(t, "repo", repo)
+		assert.Equal(t, "https://corp.com/gitea/org/repo", normalized)
+		assert.Equal(t, "https://corp.com/gitea/org/repo/pulls/3", gp.BuildPullRequestURL(ref))
+	})
+}
+
+func TestGiteaFetchPullRequestStatus(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name             string
+		pullJSON         string
+		reviewsJSON      string
+		expectedState    gitprovider.PRState
+		expectedDraft    bool
+		expectedStats    gitprovider.DiffStats
+		changesRequested bool
+		approved         bool
+	}{
+		{
+			name:          "OpenWithStats",
+			pullJSON:      `{"number":1,"title":"Feature","state":"open","merged":false,"additions":10,"deletions":4,"changed_files":2,"head":{"ref":"feat","sha":"abc"},"base":{"ref":"main"},"user":{"login":"alice","avatar_url":"https://a/alice"}}`,
+			reviewsJSON:   `[{"id":1,"state":"APPROVED","user":{"login":"bob"}}]`,
+			expectedState: gitprovider.PRStateOpen,
+			expectedStats: gitprovider.DiffStats{Additions: 10, Deletions: 4, ChangedFiles: 2},
+			approved:      true,
+		},
+		{
+			name:             "ChangesRequested",
+			pullJSON:         `{"number":1,"title":"Feature","state":"open","additions":1,"deletions":0,"changed_files":1,"head":{"ref":"feat","sha":"abc"},"base":{"ref":"main"},"user":{"login":"alice"}}`,
+			reviewsJSON:      `[{"id":1,"state":"APPROVED","user":{"login":"bob"}},{"id":2,"state":"REQUEST_CHANGES","user":{"login":"carol"}}]`,
+			expectedState:    gitprovider.PRStateOpen,
+			expectedStats:    gitprovider.DiffStats{Additions: 1, ChangedFiles: 1},
+			changesRequested: true,
+		},
+		{
+			name:          "DismissedChangesRequest",
+			pullJSON:      `{"number":1,"title":"Feature","state":"open","additions":1,"deletions":0,"changed_files":1,"head":{"ref":"feat","sha":"abc"},"base":{"ref":"main"},"user":{"login":"alice"}}`,
+			reviewsJSON:   `[{"id":2,"state":"REQUEST_CHANGES","dismissed":true,"user":{"login":"carol"}}]`,
+			expectedState: gitprovider.PRStateOpen,
+			expectedStats: gitprovider.DiffStats{Additions: 1, ChangedFiles: 1},
+		},
+		{
+			name:          "MergedWIPWithoutStats",
+			pullJSON:      `{"number":1,"title":"WIP: Feature","state":"closed","merged":true,"head":{"ref":"feat","sha":"abc"},"base":{"ref":"main"},"user":{"login":"alice"}}`,
+			reviewsJSON:   `[]`,
+			expectedState: gitprovider.PRStateMerged,
+			expectedDraft: true,
+			// Counted from the raw diff served below.
+			expectedStats: gitprovider.DiffStats{Additions: 2, Deletions: 1, ChangedFiles: 1},
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			mux := http.NewServeMux()
+			mux.HandleFunc("/api/v1/repos/owner/repo/pulls/1", func(w http.ResponseWriter, r *http.Request) {
+				assert.Equal(t, "Bearer token", r.Header.Get("Authorization"))
+				w.Header().Set("Content-Type", "application/json")
+				_, _ = w.Write([]byte(tc.pullJSON))
+			})
+			mux.HandleFunc("/api/v1/repos/owner/repo/pulls/1/commits", func(w http.ResponseWriter, _ *http.Request) {
+				w.Header().Set("Content-Type", "application/json")
+				w.Header().Set("X-Total-Count", "4")
+				_, _ = w.Write([]byte(`[{}]`))
+			})
+			mux.HandleFunc("/api/v1/repos/owner/repo/pulls/1/reviews", func(w http.ResponseWriter, _ *http.Request) {
+				w.Header().Set("Content-Type", "application/json")
+				_, _ = w.Write([]byte(tc.reviewsJSON))
+			})
+			mux.HandleFunc("/api/v1/repos/owner/repo/pulls/1.diff", func(w http.ResponseWriter, _ *http.Request) {
+				_, _ = w.Write([]byte("diff --git a/a b/a\n--- a/a\n+++ b/a\n@@ -1 +1,2 @@\n-x\n+y\n+z\n"))
+			})
+			srv := httptest.NewServer(mux)
+			defer srv.Close()
+
+			gp, err := gitprovider.New("gitea", srv.URL+"/api/v1", srv.Client())
+			require.NoError(t, err)
+
+			status, err := gp.FetchPullRequestStatus(t.Context(), "token", gitprovider.PRRef{Owner: "owner", Repo: "repo", Number: 1})
+			require.NoError(t, err)
+			assert.Equal(t, tc.expectedState, status.State)
+			assert.Equal(t, tc.expectedDraft, status.Draft)
+			assert.Equal(t, tc.expectedStats, status.DiffStats)
+			assert.Equal(t, tc.changesRequested, status.ChangesRequested)
+			assert.Equal(t, tc.approved, status.Approved)
+			assert.Equal(t, int32(4), status.Commits)
+			assert.Equal(t, "abc", status.HeadSHA)
+			assert.Equal(t, "feat", status.HeadBranch)
+			assert.Equal(t, "main", status.BaseBranch)
+			assert.Equal(t, "alice", status.AuthorLogin)
+		})
+	}
+}
+
+func TestGiteaResolveBranchPullRequest(t *testing.T) {
+	t.Parallel()
+
+	var srvURL string
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "/api/v1/repos/owner/repo/pulls", r.URL.Path)
+		assert.Equal(t, "open", r.URL.Query().Get("state"))
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = fmt.Fprintf(w, `[
+			{"number":3,"html_url":"%[1]s/owner/repo/pulls/3","head":{"ref":"other","repo":{"full_name":"owner/repo"}}},
+			{"number":4,"html_url":"%[1]s/owner/repo/pulls/4","head":{"ref":"feat","repo":{"full_name":"fork/repo"}}},
+			{"number":5,"html_url":"%[1]s/owner/repo/pulls/5","head":{"ref":"feat","repo":{"full_name":"owner/repo"}}}
+		]`, srvURL)
+	}))
+	defer srv.Close()
+	srvURL = srv.URL
+
+	gp, err := gitprovider.New("gitea", srv.URL+"/api/v1", srv.Client())
+	require.NoError(t, err)
+
+	ref, err := gp.ResolveBranchPullRequest(t.Context(), "token", gitprovider.BranchRef{Owner: "owner", Repo: "repo", Branch: "feat"})
+	require.NoError(t, err)
+	require.NotNil(t, ref)
+	assert.Equal(t, gitprovider.PRRef{Owner: "owner", Repo: "repo", Number: 5}, *ref)
+
+	ref, err = gp.ResolveBranchPullRequest(t.Context(), "token", gitprovider.BranchRef{Owner: "owner", Repo: "repo", Branch: "missing"})
+	require.NoError(t, err)
+	assert.Nil(t, ref)
+}
+
+func TestGiteaFetchBranchDiff(t *testing.T) {
+	t.Parallel()
+
+	const diff = "diff --git a/a b/a\n--- a/a\n+++ b/a\n@@ -1 +1 @@\n-x\n+y\n"
+
+	mux := http.NewServeMux()
+	mux.HandleFunc("/api/v1/repos/owner/repo", func(w http.ResponseWriter, _ *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write([]byte(`{"default_branch":"main"}`))
+	})
+	mux.HandleFunc("/owner/repo/compare/", func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "/owner/repo/compare/main...feat/x.diff", r.URL.Path)
+		_, _ = w.Write([]byte(diff))
+	})
+	srv := httptest.NewServer(mux)
+	defer srv.Close()
+
+	gp, err := gitprovider.New("gitea", srv.URL+"/api/v1", srv.Client())
+	require.NoError(t, err)
+
+	got, err := gp.FetchBranchDiff(t.Context(), "token", gitprovider.BranchRef{Owner: "owner", Repo: "repo", Branch: "feat/x"})
+	require.NoError(t, err)
+	assert.Equal(t, diff, got)
+}
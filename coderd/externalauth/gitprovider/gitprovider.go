@@ -195,9 +195,17 @@ func New(providerType string, apiBaseURL string, httpClient *http.Client, opts .
 		return newGitHub(apiBaseURL, httpClient, o.clock)
 	case "gitlab":
 		return newGitLab(apiBaseURL, httpClient, o.clock)
+	case "bitbucket-cloud":
+		return newBitbucketCloud(apiBaseURL, httpClient, o.clock)
+	case "bitbucket-server":
+		return newBitbucketServer(apiBaseURL, httpClient, o.clock)
+	case "gitea":
+		// Forgejo is API-compatible with Gitea and is configured
+		// with the "gitea" type.
+		return newGitea(apiBaseURL, httpClient, o.clock)
 	default:
-		// Other providers (bitbucket-cloud, etc.) will be
-		// added here as they are implemented.
+		// Other providers (azure-devops, etc.) will be added
+		// here as they are implemented.
 		return nil, nil //nolint:nilnil // nil provider means unsupported type, not an error
 	}
 }
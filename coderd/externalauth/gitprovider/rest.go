@@ -0,0 +1,166 @@
+package gitprovider
+
+import (
+	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+	"strings"
+
+	"golang.org/x/xerrors"
+
+	"github.com/coder/quartz"
+)
+
+// restClient issues authenticated REST requests for providers that
+// are implemented without a dedicated client library (Bitbucket and
+// Gitea). It centralizes status handling, rate-limit detection and
+// diff size limits so each provider only deals with its own URL
+// layout and response shapes.
+type restClient struct {
+	// name is the provider name used as an error prefix, e.g.
+	// "bitbucket cloud".
+	name       string
+	httpClient *http.Client
+	clock      quartz.Clock
+	// resetHeader is the provider-specific header carrying a unix
+	// timestamp at which the rate limit resets.
+	resetHeader string
+}
+
+func (c *restClient) newRequest(ctx context.Context, requestURL, token, accept string) (*http.Request, error) {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, requestURL, nil)
+	if err != nil {
+		return nil, xerrors.Errorf("create %s request: %w", c.name, err)
+	}
+	req.Header.Set("Accept", accept)
+	req.Header.Set("User-Agent", "coder-chat-diff-status")
+	if token != "" {
+		req.Header.Set("Authorization", "Bearer "+token)
+	}
+	return req, nil
+}
+
+// do executes req and converts non-200 responses into errors. The
+// caller must close the returned response body.
+func (c *restClient) do(req *http.Request, action string) (*http.Response, error) {
+	resp, err := c.httpClient.Do(req)
+	if err != nil {
+		return nil, xerrors.Errorf("%s %s: %w", c.name, action, err)
+	}
+	if resp.StatusCode == http.StatusOK {
+		return resp, nil
+	}
+	defer resp.Body.Close()
+
+	if rlErr := checkRateLimitError(resp, c.clock, c.resetHeader); rlErr != nil {
+		return nil, rlErr
+	}
+	body, readErr := io.ReadAll(io.LimitReader(resp.Body, 8192))
+	if readErr != nil {
+		return nil, xerrors.Errorf("%s %s: unexpected status %d", c.name, action, resp.StatusCode)
+	}
+	return nil, xerrors.Errorf(
+		"%s %s: unexpected status %d: %s",
+		c.name,
+		action,
+		resp.StatusCode,
+		strings.TrimSpace(string(body)),
+	)
+}
+
+// getJSON decodes a JSON response from requestURL into dest and
+// returns the response headers, which some providers use to report
+// pagination totals.
+func (c *restClient) getJSON(ctx context.Context, requestURL, token, action string, dest any) (http.Header, error) {
+	req, err := c.newRequest(ctx, requestURL, token, "application/json")
+	if err != nil {
+		return nil, err
+	}
+	resp, err := c.do(req, action)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	if err := json.NewDecoder(resp.Body).Decode(dest); err != nil {
+		return nil, xerrors.Errorf("decode %s %s response: %w", c.name, action, err)
+	}
+	return resp.Header, nil
+}
+
+// getDiff reads a raw unified diff from requestURL. Returns
+// ErrDiffTooLarge if the diff exceeds MaxDiffSize.
+func (c *restClient) getDiff(ctx context.Context, requestURL, token, action string) (string, error) {
+	req, err := c.newRequest(ctx, requestURL, token, "text/plain")
+	if err != nil {
+		return "", err
+	}
+	resp, err := c.do(req, action)
+	if err != nil {
+		return "", err
+	}
+	defer resp.Body.Close()
+
+	// Read one extra byte beyond MaxDiffSize so we can detect
+	// whether the diff exceeds the limit.
+	buf, err := io.ReadAll(io.LimitReader(resp.Body, MaxDiffSize+1))
+	if err != nil {
+		return "", xerrors.Errorf("read %s %s response: %w", c.name, action, err)
+	}
+	if len(buf) > MaxDiffSize {
+		return "", ErrDiffTooLarge
+	}
+	return string(buf), nil
+}
+
+// trimOriginPath normalizes the path component of a clone URL into
+// a repository path without leading/trailing slashes or a .git
+// suffix.
+func trimOriginPath(path string) string {
+	path = strings.TrimPrefix(path, "/")
+	path = strings.TrimSuffix(path, "/")
+	path = strings.TrimSuffix(path, ".git")
+	return path
+}
+
+// parseSCPLikeOrigin parses an SCP-like SSH remote such as
+// "git@host:owner/repo.git" for the given host and returns the
+// repository path. The user portion is not required to be "git".
+func parseSCPLikeOrigin(raw, host string) (string, bool) {
+	if strings.Contains(raw, "://") {
+		return "", false
+	}
+	at := strings.Index(raw, "@")
+	colon := strings.Index(raw, ":")
+	if at < 0 || colon < at {
+		return "", false
+	}
+	if !strings.EqualFold(raw[at+1:colon], hostWithoutPort(host)) {
+		return "", false
+	}
+	path := trimOriginPath(raw[colon+1:])
+	if path == "" {
+		return "", false
+	}
+	return path, true
+}
+
+// relativePathSegments strips the instance context path (e.g.
+// "/bitbucket" for an instance served at https://corp.com/bitbucket)
+// from p and returns the remaining non-empty path segments. Returns
+// false if p is not below the context path.
+func relativePathSegments(contextPath, p string) ([]string, bool) {
+	if contextPath != "" {
+		var ok bool
+		p, ok = strings.CutPrefix(p, contextPath+"/")
+		if !ok {
+			return nil, false
+		}
+	}
+	p = strings.Trim(p, "/")
+	if p == "" {
+		return nil, false
+	}
+	return strings.Split(p, "/"), true
+}
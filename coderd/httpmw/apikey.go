@@ -118,13 +118,17 @@ func UserAuthorization(ctx context.Context) rbac.Subject {
 type OAuth2Configs struct {
 	Github promoauth.OAuth2Config
 	OIDC   promoauth.OAuth2Config
+	// OIDCProviders are the configs of named OIDC providers, keyed by
+	// provider ID. They are used to refresh tokens for user links that
+	// belong to a named provider.
+	OIDCProviders map[string]promoauth.OAuth2Config
 }
 
 func (c *OAuth2Configs) IsZero() bool {
 	if c == nil {
 		return true
 	}
-	return c.Github == nil && c.OIDC == nil
+	return c.Github == nil && c.OIDC == nil && len(c.OIDCProviders) == 0
 }
 
 const (
@@ -319,6 +323,9 @@ func ValidateAPIKey(ctx context.Context, cfg ValidateAPIKeyConfig, r *http.Reque
 				friendlyName = "GitHub"
 			case database.LoginTypeOIDC:
 				oauthConfig = cfg.OAuth2Configs.OIDC
+				if link.OIDCProviderID != "" {
+					oauthConfig = cfg.OAuth2Configs.OIDCProviders[link.OIDCProviderID]
+				}
 				friendlyName = "OpenID Connect"
 			default:
 				return nil, &ValidateAPIKeyError{
@@ -391,7 +398,8 @@ func ValidateAPIKey(ctx context.Context, cfg ValidateAPIKeyConfig, r *http.Reque
 				OAuthExpiry:            link.OAuthExpiry,
 				// Refresh should keep the same debug context because we use
 				// the original claims for the group/role sync.
-				Claims: link.Claims,
+				Claims:         link.Claims,
+				OIDCProviderID: link.OIDCProviderID,
 			})
 			if err != nil {
 				return nil, &ValidateAPIKeyError{
@@ -66,8 +66,12 @@ func CSRF(cookieCfg codersdk.HTTPCookieConfig) func(next http.Handler) http.Hand
 			// endpoint. The authorize endpoint serves a browser consent
 			// form whose POST must be CSRF-protected to prevent
 			// cross-site authorization code theft (coder/security#121).
+			// The device verification page is a consent form too, but
+			// its sibling device authorization endpoint is called by
+			// clients without a session, so only the page is matched.
 			if !strings.HasPrefix(r.URL.Path, "/api") &&
-				!strings.HasPrefix(r.URL.Path, "/oauth2/authorize") {
+				!strings.HasPrefix(r.URL.Path, "/oauth2/authorize") &&
+				strings.TrimSuffix(r.URL.Path, "/") != "/oauth2/device" {
 				return true
 			}
 
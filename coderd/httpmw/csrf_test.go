@@ -61,6 +61,16 @@ func TestCSRFExemptList(t *testing.T) {
 			URL:    "https://coder.com/oauth2/authorize?client_id=test",
 			Exempt: false,
 		},
+		{
+			Name:   "OAuth2DeviceVerification",
+			URL:    "https://coder.com/oauth2/device",
+			Exempt: false,
+		},
+		{
+			Name:   "OAuth2DeviceAuthorization",
+			URL:    "https://coder.com/oauth2/device/code",
+			Exempt: true,
+		},
 		{
 			Name:   "OAuth2Tokens",
 			URL:    "https://coder.com/oauth2/tokens",
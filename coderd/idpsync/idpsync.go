@@ -128,25 +128,32 @@ func FromDeploymentValues(dv *codersdk.DeploymentValues) DeploymentSyncSettings
 	if dv == nil {
 		panic("Developer error: DeploymentValues should not be nil")
 	}
+	return FromOIDCConfig(dv.OIDC)
+}
+
+// FromOIDCConfig builds the static sync settings for a single OIDC provider.
+// The deployment's primary provider and every named provider each get their
+// own settings.
+func FromOIDCConfig(cfg codersdk.OIDCConfig) DeploymentSyncSettings {
 	return DeploymentSyncSettings{
-		OrganizationField:         dv.OIDC.OrganizationField.Value(),
-		OrganizationMapping:       dv.OIDC.OrganizationMapping.Value,
-		OrganizationAssignDefault: dv.OIDC.OrganizationAssignDefault.Value(),
+		OrganizationField:         cfg.OrganizationField.Value(),
+		OrganizationMapping:       cfg.OrganizationMapping.Value,
+		OrganizationAssignDefault: cfg.OrganizationAssignDefault.Value(),
 
-		SiteRoleField:    dv.OIDC.UserRoleField.Value(),
-		SiteRoleMapping:  dv.OIDC.UserRoleMapping.Value,
-		SiteDefaultRoles: dv.OIDC.UserRolesDefault.Value(),
+		SiteRoleField:    cfg.UserRoleField.Value(),
+		SiteRoleMapping:  cfg.UserRoleMapping.Value,
+		SiteDefaultRoles: cfg.UserRolesDefault.Value(),
 
 		// TODO: Separate group field for allow list from default org.
 		// Right now you cannot disable group sync from the default org and
 		// configure an allow list.
-		GroupField:     dv.OIDC.GroupField.Value(),
-		GroupAllowList: ConvertAllowList(dv.OIDC.GroupAllowList.Value()),
+		GroupField:     cfg.GroupField.Value(),
+		GroupAllowList: ConvertAllowList(cfg.GroupAllowList.Value()),
 		Legacy: DefaultOrgLegacySettings{
-			GroupField:          dv.OIDC.GroupField.Value(),
-			GroupMapping:        dv.OIDC.GroupMapping.Value,
-			GroupFilter:         dv.OIDC.GroupRegexFilter.Value(),
-			CreateMissingGroups: dv.OIDC.GroupAutoCreate.Value(),
+			GroupField:          cfg.GroupField.Value(),
+			GroupMapping:        cfg.GroupMapping.Value,
+			GroupFilter:         cfg.GroupRegexFilter.Value(),
+			CreateMissingGroups: cfg.GroupAutoCreate.Value(),
 		},
 	}
 }
@@ -41,6 +41,10 @@ var fallbackIcons = map[uuid.UUID]string{
 	notifications.TemplateWorkspaceManualBuildFailed: codersdk.InboxNotificationFallbackIconWorkspace,
 	notifications.TemplateWorkspaceOutOfMemory:       codersdk.InboxNotificationFallbackIconWorkspace,
 	notifications.TemplateWorkspaceOutOfDisk:         codersdk.InboxNotificationFallbackIconWorkspace,
+	notifications.TemplateWorkspaceHighCPU:           codersdk.InboxNotificationFallbackIconWorkspace,
+	notifications.TemplateWorkspaceProcessLimit:      codersdk.InboxNotificationFallbackIconWorkspace,
+	notifications.TemplateWorkspaceOutOfInodes:       codersdk.InboxNotificationFallbackIconWorkspace,
+	notifications.TemplateWorkspaceDriftDetected:     codersdk.InboxNotificationFallbackIconWorkspace,
 
 	// account related notifications
 	notifications.TemplateUserAccountCreated:           codersdk.InboxNotificationFallbackIconAccount,
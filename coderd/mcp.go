@@ -44,20 +44,23 @@ const mcpProtocolVersion = "2026-07-28"
 type oidcMCPTokenSource struct {
 	db     database.Store
 	config promoauth.OAuth2Config
-	logger slog.Logger
+	// providers are the configs of named OIDC providers, keyed by ID.
+	providers map[string]promoauth.OAuth2Config
+	logger    slog.Logger
 }
 
 // newOIDCMCPTokenSource returns nil when no OIDC provider is
 // configured. mcpclient treats a nil source the same as "no token
 // available" and omits the Authorization header.
-func newOIDCMCPTokenSource(db database.Store, config promoauth.OAuth2Config, logger slog.Logger) mcpclient.UserOIDCTokenSource {
-	if config == nil {
+func newOIDCMCPTokenSource(db database.Store, config promoauth.OAuth2Config, providers map[string]promoauth.OAuth2Config, logger slog.Logger) mcpclient.UserOIDCTokenSource {
+	if config == nil && len(providers) == 0 {
 		return nil
 	}
 	return &oidcMCPTokenSource{
-		db:     db,
-		config: config,
-		logger: logger,
+		db:        db,
+		config:    config,
+		providers: providers,
+		logger:    logger,
 	}
 }
 
@@ -77,8 +80,21 @@ func (s *oidcMCPTokenSource) OIDCAccessToken(ctx context.Context, userID uuid.UU
 		return "", xerrors.Errorf("get oidc user link: %w", err)
 	}
 
-	if shouldRefresh, expiresAt := shouldRefreshOIDCToken(link); shouldRefresh {
-		token, err := s.config.TokenSource(ctx, &oauth2.Token{
+	config := s.config
+	if link.OIDCProviderID != "" {
+		// The link belongs to a named OIDC provider, so it must be
+		// refreshed with that provider's config.
+		config = s.providers[link.OIDCProviderID]
+	}
+
+	shouldRefresh, expiresAt := shouldRefreshOIDCToken(link)
+	if shouldRefresh {
+		if config == nil {
+			// The provider is no longer configured, so the token cannot
+			// be refreshed.
+			return "", nil
+		}
+		token, err := config.TokenSource(ctx, &oauth2.Token{
 			AccessToken:  link.OAuthAccessToken,
 			RefreshToken: link.OAuthRefreshToken,
 			// Use the expiresAt returned by shouldRefreshOIDCToken.
@@ -112,6 +128,7 @@ func (s *oidcMCPTokenSource) OIDCAccessToken(ctx context.Context, userID uuid.UU
 			OAuthRefreshTokenKeyID: sql.NullString{}, // set by dbcrypt if required
 			OAuthExpiry:            link.OAuthExpiry,
 			Claims:                 link.Claims,
+			OIDCProviderID:         link.OIDCProviderID,
 		})
 		persistCancel()
 		if err != nil {
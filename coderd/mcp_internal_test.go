@@ -16,6 +16,7 @@ import (
 	"github.com/coder/coder/v2/coderd/database/dbgen"
 	"github.com/coder/coder/v2/coderd/database/dbtestutil"
 	"github.com/coder/coder/v2/coderd/database/dbtime"
+	"github.com/coder/coder/v2/coderd/promoauth"
 	"github.com/coder/coder/v2/coderd/rbac"
 	"github.com/coder/coder/v2/testutil"
 )
@@ -99,7 +100,7 @@ func TestOIDCMCPTokenSource(t *testing.T) {
 	t.Run("NilConfig", func(t *testing.T) {
 		t.Parallel()
 		db, _ := dbtestutil.NewDB(t)
-		require.Nil(t, newOIDCMCPTokenSource(db, nil, logger))
+		require.Nil(t, newOIDCMCPTokenSource(db, nil, nil, logger))
 	})
 
 	t.Run("NoLink", func(t *testing.T) {
@@ -111,7 +112,7 @@ func TestOIDCMCPTokenSource(t *testing.T) {
 		store := dbauthzTestStore(t, db)
 		user := dbgen.User(t, db, database.User{LoginType: database.LoginTypeOIDC})
 
-		src := newOIDCMCPTokenSource(store, &testutil.OAuth2Config{}, logger)
+		src := newOIDCMCPTokenSource(store, &testutil.OAuth2Config{}, nil, logger)
 		ctx := dbauthz.AsChatd(context.Background())
 
 		tok, err := src.OIDCAccessToken(ctx, user.ID)
@@ -135,7 +136,7 @@ func TestOIDCMCPTokenSource(t *testing.T) {
 
 		src := newOIDCMCPTokenSource(store, &testutil.OAuth2Config{
 			Token: &oauth2.Token{AccessToken: "should-not-be-used"},
-		}, logger)
+		}, nil, logger)
 		ctx := dbauthz.AsChatd(context.Background())
 
 		tok, err := src.OIDCAccessToken(ctx, user.ID)
@@ -167,7 +168,7 @@ func TestOIDCMCPTokenSource(t *testing.T) {
 				RefreshToken: "new-refresh",
 				Expiry:       dbtime.Now().Add(time.Hour),
 			},
-		}, logger)
+		}, nil, logger)
 		ctx := dbauthz.AsChatd(context.Background())
 
 		tok, err := src.OIDCAccessToken(ctx, user.ID)
@@ -187,6 +188,51 @@ func TestOIDCMCPTokenSource(t *testing.T) {
 		require.Equal(t, "new-refresh", got.OAuthRefreshToken)
 	})
 
+	t.Run("RefreshNamedProvider", func(t *testing.T) {
+		// Links created by a named provider are refreshed with that
+		// provider's config rather than the primary one.
+		t.Parallel()
+		db, _ := dbtestutil.NewDB(t)
+		store := dbauthzTestStore(t, db)
+		user := dbgen.User(t, db, database.User{})
+		dbgen.UserLink(t, db, database.UserLink{
+			UserID:            user.ID,
+			LoginType:         database.LoginTypeOIDC,
+			OAuthAccessToken:  "stale",
+			OAuthRefreshToken: "refresh",
+			OAuthExpiry:       dbtime.Now().Add(-time.Hour),
+			OIDCProviderID:    "contractors",
+		})
+
+		src := newOIDCMCPTokenSource(store, &testutil.OAuth2Config{
+			Token: &oauth2.Token{AccessToken: "should-not-be-used"},
+		}, map[string]promoauth.OAuth2Config{
+			"contractors": &testutil.OAuth2Config{
+				Token: &oauth2.Token{
+					AccessToken:  "fresh",
+					RefreshToken: "new-refresh",
+					Expiry:       dbtime.Now().Add(time.Hour),
+				},
+			},
+		}, logger)
+		ctx := dbauthz.AsChatd(context.Background())
+
+		tok, err := src.OIDCAccessToken(ctx, user.ID)
+		require.NoError(t, err)
+		require.Equal(t, "fresh", tok)
+
+		got, err := db.GetUserLinkByUserIDLoginType(
+			dbauthz.AsSystemRestricted(context.Background()),
+			database.GetUserLinkByUserIDLoginTypeParams{
+				UserID:    user.ID,
+				LoginType: database.LoginTypeOIDC,
+			},
+		)
+		require.NoError(t, err)
+		require.Equal(t, "fresh", got.OAuthAccessToken)
+		require.Equal(t, "contractors", got.OIDCProviderID)
+	})
+
 	t.Run("RefreshFailureReturnsEmpty", func(t *testing.T) {
 		// A refresh attempt that fails (e.g. invalid client config)
 		// must not surface an error to the caller; per the
@@ -206,7 +252,7 @@ func TestOIDCMCPTokenSource(t *testing.T) {
 
 		// An empty oauth2.Config triggers a refresh failure
 		// because it has no token endpoint to call.
-		src := newOIDCMCPTokenSource(store, &oauth2.Config{}, logger)
+		src := newOIDCMCPTokenSource(store, &oauth2.Config{}, nil, logger)
 		ctx := dbauthz.AsChatd(context.Background())
 
 		tok, err := src.OIDCAccessToken(ctx, user.ID)
@@ -0,0 +1,119 @@
+package dispatch
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"strings"
+
+	"github.com/google/uuid"
+	"golang.org/x/xerrors"
+
+	"cdr.dev/slog/v3"
+	"github.com/coder/coder/v2/coderd/database"
+	"github.com/coder/coder/v2/coderd/database/dbauthz"
+)
+
+// UserLinkStore is used by handlers which resolve a per-user destination from
+// the claims stored against a user's external login.
+type UserLinkStore interface {
+	GetUserLinksByUserID(ctx context.Context, userID uuid.UUID) ([]database.UserLink, error)
+}
+
+// newHTTPClient creates a client with its own transport in favor of reusing the default, since other http clients may
+// interfere. http.Transport maintains its own connection pool, and we want to avoid cross-contamination.
+func newHTTPClient(log slog.Logger) *http.Client {
+	var rt http.RoundTripper
+
+	def := http.DefaultTransport
+	t, ok := def.(*http.Transport)
+	if !ok {
+		// The API has changed (very unlikely), so let's use the default transport (previous behavior) and log.
+		log.Warn(context.Background(), "failed to clone default HTTP transport, unexpected type", slog.F("type", fmt.Sprintf("%T", def)))
+		rt = def
+	} else {
+		// Clone the transport's exported fields, but not its connection pool.
+		rt = t.Clone()
+	}
+
+	return &http.Client{Transport: rt}
+}
+
+// postJSON marshals body and POSTs it to endpoint. Non-2xx responses and
+// network failures are considered retryable. On success the response body is
+// returned so callers can inspect APIs which report errors in a 200 response.
+func postJSON(ctx context.Context, cl *http.Client, log slog.Logger, endpoint string, msgID uuid.UUID, headers http.Header, body any) (respBody []byte, retryable bool, err error) {
+	m, err := json.Marshal(body)
+	if err != nil {
+		return nil, false, xerrors.Errorf("marshal payload: %v", err)
+	}
+
+	// Outer context has a deadline (see CODER_NOTIFICATIONS_DISPATCH_TIMEOUT).
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, endpoint, bytes.NewBuffer(m))
+	if err != nil {
+		return nil, false, xerrors.Errorf("create HTTP request: %v", err)
+	}
+	for k, v := range headers {
+		req.Header[k] = v
+	}
+	req.Header.Set("Content-Type", "application/json")
+
+	resp, err := cl.Do(req)
+	if err != nil {
+		if errors.Is(err, context.DeadlineExceeded) {
+			return nil, true, xerrors.Errorf("request timeout: %w", err)
+		}
+		return nil, true, xerrors.Errorf("request failed: %w", err)
+	}
+	defer resp.Body.Close()
+
+	// Responses from chat APIs are small; cap what we read regardless.
+	respBody, err = io.ReadAll(io.LimitReader(resp.Body, 64<<10))
+	if err != nil {
+		return nil, true, xerrors.Errorf("read response body: %w", err)
+	}
+
+	if resp.StatusCode/100 > 2 {
+		// Client errors other than rate limiting won't succeed on retry.
+		retryable := resp.StatusCode == http.StatusTooManyRequests || resp.StatusCode >= 500
+		log.Warn(ctx, "unsuccessful delivery", slog.F("status_code", resp.StatusCode),
+			slog.F("response", truncate(string(respBody), 512)), slog.F("msg_id", msgID))
+		return nil, retryable, xerrors.Errorf("non-2xx response (%d)", resp.StatusCode)
+	}
+
+	return respBody, false, nil
+}
+
+// lookupClaim returns the first non-empty string value of claim across the
+// user's links.
+func lookupClaim(ctx context.Context, store UserLinkStore, userID uuid.UUID, claim string) (string, error) {
+	if store == nil || claim == "" {
+		return "", nil
+	}
+	// The notifier is only permitted to manage notifications, but needs to
+	// read the recipient's links to route the message to them.
+	//nolint:gocritic // Reading user links to resolve a notification destination.
+	links, err := store.GetUserLinksByUserID(dbauthz.AsSystemRestricted(ctx), userID)
+	if err != nil {
+		return "", xerrors.Errorf("get user links: %w", err)
+	}
+	for _, link := range links {
+		if v, ok := link.Claims.MergedClaims[claim].(string); ok && strings.TrimSpace(v) != "" {
+			return strings.TrimSpace(v), nil
+		}
+	}
+	return "", nil
+}
+
+// truncate shortens s to at most n runes.
+func truncate(s string, n int) string {
+	r := []rune(s)
+	if len(r) <= n {
+		return s
+	}
+	return string(r[:n])
+}
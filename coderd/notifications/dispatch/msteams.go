@@ -0,0 +1,188 @@
+package dispatch
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"slices"
+	"strings"
+	"text/template"
+
+	"github.com/google/uuid"
+	"golang.org/x/xerrors"
+
+	"cdr.dev/slog/v3"
+	"github.com/coder/coder/v2/coderd/notifications/types"
+	markdown "github.com/coder/coder/v2/coderd/render"
+	"github.com/coder/coder/v2/codersdk"
+)
+
+// TeamsHandler dispatches notification messages to a Microsoft Teams channel
+// as Adaptive Cards, via an incoming webhook or a Workflows "When a Teams
+// webhook request is received" trigger.
+//
+// The recipient is @mentioned in the card so the notification reaches them
+// directly. They are identified by the value of a claim from one of their user
+// links (their Entra ID object ID by default), falling back to their email
+// address as their user principal name. Private messages are never posted.
+type TeamsHandler struct {
+	cfg   codersdk.NotificationsTeamsConfig
+	log   slog.Logger
+	store UserLinkStore
+
+	cl *http.Client
+}
+
+// TeamsMessage is the body sent to the webhook.
+type TeamsMessage struct {
+	Type        string            `json:"type"`
+	Attachments []TeamsAttachment `json:"attachments"`
+}
+
+type TeamsAttachment struct {
+	ContentType string            `json:"contentType"`
+	Content     TeamsAdaptiveCard `json:"content"`
+}
+
+// TeamsAdaptiveCard is a subset of the Adaptive Card schema.
+type TeamsAdaptiveCard struct {
+	Schema  string              `json:"$schema"`
+	Type    string              `json:"type"`
+	Version string              `json:"version"`
+	Body    []TeamsCardElement  `json:"body"`
+	Actions []TeamsCardAction   `json:"actions,omitempty"`
+	MSTeams *TeamsCardExtension `json:"msteams,omitempty"`
+}
+
+type TeamsCardElement struct {
+	Type   string `json:"type"`
+	Text   string `json:"text"`
+	Wrap   bool   `json:"wrap"`
+	Size   string `json:"size,omitempty"`
+	Weight string `json:"weight,omitempty"`
+}
+
+type TeamsCardAction struct {
+	Type  string `json:"type"`
+	Title string `json:"title"`
+	URL   string `json:"url"`
+}
+
+// TeamsCardExtension holds Teams-specific card properties.
+type TeamsCardExtension struct {
+	Width    string         `json:"width,omitempty"`
+	Entities []TeamsMention `json:"entities,omitempty"`
+}
+
+type TeamsMention struct {
+	Type      string             `json:"type"`
+	Text      string             `json:"text"`
+	Mentioned TeamsMentionTarget `json:"mentioned"`
+}
+
+type TeamsMentionTarget struct {
+	ID   string `json:"id"`
+	Name string `json:"name"`
+}
+
+func NewTeamsHandler(cfg codersdk.NotificationsTeamsConfig, store UserLinkStore, log slog.Logger) *TeamsHandler {
+	return &TeamsHandler{cfg: cfg, log: log, store: store, cl: newHTTPClient(log)}
+}
+
+func (t *TeamsHandler) Dispatcher(payload types.MessagePayload, titleMarkdown, bodyMarkdown string, _ template.FuncMap) (DeliveryFunc, error) {
+	if t.cfg.WebhookURL.String() == "" {
+		return nil, xerrors.New("teams webhook URL not defined")
+	}
+
+	title, err := markdown.PlaintextFromMarkdown(titleMarkdown)
+	if err != nil {
+		return nil, xerrors.Errorf("render title: %w", err)
+	}
+
+	card := TeamsAdaptiveCard{
+		Schema:  "http://adaptivecards.io/schemas/adaptive-card.json",
+		Type:    "AdaptiveCard",
+		Version: "1.4",
+		Body: []TeamsCardElement{
+			{Type: "TextBlock", Text: title, Wrap: true, Size: "Medium", Weight: "Bolder"},
+		},
+	}
+	// TextBlocks render a subset of Markdown, which covers what notification
+	// templates use.
+	if body := strings.TrimSpace(bodyMarkdown); body != "" {
+		card.Body = append(card.Body, TeamsCardElement{Type: "TextBlock", Text: body, Wrap: true})
+	}
+	for _, action := range payload.Actions {
+		if action.URL == "" {
+			continue
+		}
+		card.Actions = append(card.Actions, TeamsCardAction{Type: "Action.OpenUrl", Title: action.Label, URL: action.URL})
+	}
+
+	return t.dispatch(payload, card), nil
+}
+
+func (t *TeamsHandler) dispatch(payload types.MessagePayload, card TeamsAdaptiveCard) DeliveryFunc {
+	return func(ctx context.Context, msgID uuid.UUID) (retryable bool, err error) {
+		// The webhook always posts to a channel other users can read.
+		if payload.Private {
+			return false, xerrors.Errorf("%q cannot be posted to a shared teams channel", payload.NotificationName)
+		}
+
+		mention, err := t.mention(ctx, payload)
+		if err != nil {
+			return true, xerrors.Errorf("resolve teams user: %w", err)
+		}
+		// Copy the card so the DeliveryFunc can safely be called again.
+		card := card
+		card.Body = slices.Clone(card.Body)
+		card.MSTeams = &TeamsCardExtension{Width: "Full"}
+		if mention != nil {
+			card.Body = append(card.Body, TeamsCardElement{Type: "TextBlock", Text: "For " + mention.Text, Wrap: true, Size: "Small"})
+			card.MSTeams.Entities = []TeamsMention{*mention}
+		}
+
+		msg := TeamsMessage{
+			Type: "message",
+			Attachments: []TeamsAttachment{{
+				ContentType: "application/vnd.microsoft.card.adaptive",
+				Content:     card,
+			}},
+		}
+		headers := http.Header{}
+		headers.Set("X-Message-Id", msgID.String())
+		_, retryable, err = postJSON(ctx, t.cl, t.log, t.cfg.WebhookURL.String(), msgID, headers, msg)
+		return retryable, err
+	}
+}
+
+// mention builds an @mention of the recipient, or nil if they cannot be
+// identified.
+func (t *TeamsHandler) mention(ctx context.Context, payload types.MessagePayload) (*TeamsMention, error) {
+	var id string
+	if userID, err := uuid.Parse(payload.UserID); err == nil {
+		id, err = lookupClaim(ctx, t.store, userID, t.cfg.UserIDClaim.String())
+		if err != nil {
+			return nil, err
+		}
+	}
+	if id == "" {
+		id = payload.UserEmail
+	}
+	if id == "" {
+		return nil, nil
+	}
+
+	name := payload.UserName
+	if name == "" {
+		name = payload.UserUsername
+	}
+	if name == "" {
+		name = id
+	}
+	return &TeamsMention{
+		Type:      "mention",
+		Text:      fmt.Sprintf("<at>%s</at>", name),
+		Mentioned: TeamsMentionTarget{ID: id, Name: name},
+	}, nil
+}
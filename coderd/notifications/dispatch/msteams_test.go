@@ -0,0 +1,154 @@
+package dispatch_test
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"cdr.dev/slog/v3"
+	"cdr.dev/slog/v3/sloggers/slogtest"
+	"github.com/coder/coder/v2/coderd/notifications/dispatch"
+	"github.com/coder/coder/v2/coderd/notifications/types"
+	"github.com/coder/coder/v2/codersdk"
+	"github.com/coder/coder/v2/testutil"
+	"github.com/coder/serpent"
+)
+
+func TestTeams(t *testing.T) {
+	t.Parallel()
+
+	const (
+		titleMarkdown = "Workspace **stopped**"
+		bodyMarkdown  = "Your workspace **dev** was stopped."
+	)
+
+	msgPayload := types.MessagePayload{
+		Version:          "1.2",
+		NotificationName: "test",
+		UserID:           uuid.NewString(),
+		UserName:         "Bob Bobson",
+		UserUsername:     "bob",
+		UserEmail:        "bob@coder.com",
+		Actions: []types.TemplateAction{
+			{Label: "View workspace", URL: "https://coder.com/@bob/dev"},
+		},
+	}
+
+	tests := []struct {
+		name     string
+		private  bool
+		claims   map[string]interface{}
+		serverFn func(t *testing.T, w http.ResponseWriter, r *http.Request)
+
+		expectMentionID string
+		expectRetryable bool
+		expectErr       string
+	}{
+		{
+			name:            "MentionByClaim",
+			claims:          map[string]interface{}{"oid": "00000000-aaaa-bbbb-cccc-000000000000"},
+			expectMentionID: "00000000-aaaa-bbbb-cccc-000000000000",
+		},
+		{
+			name:            "MentionByEmail",
+			expectMentionID: "bob@coder.com",
+		},
+		{
+			name:    "PrivateNotPosted",
+			private: true,
+			serverFn: func(t *testing.T, _ http.ResponseWriter, _ *http.Request) {
+				t.Error("unexpected webhook call")
+			},
+			expectRetryable: false,
+			expectErr:       "cannot be posted to a shared teams channel",
+		},
+		{
+			name: "ServerError",
+			serverFn: func(_ *testing.T, w http.ResponseWriter, _ *http.Request) {
+				w.WriteHeader(http.StatusInternalServerError)
+			},
+			expectRetryable: true,
+			expectErr:       "non-2xx response (500)",
+		},
+		{
+			name: "BadRequest",
+			serverFn: func(_ *testing.T, w http.ResponseWriter, _ *http.Request) {
+				w.WriteHeader(http.StatusBadRequest)
+			},
+			expectRetryable: false,
+			expectErr:       "non-2xx response (400)",
+		},
+	}
+
+	logger := slogtest.Make(t, &slogtest.Options{IgnoreErrors: true}).Leveled(slog.LevelDebug)
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			var (
+				ctx   = testutil.Context(t, testutil.WaitLong)
+				msgID = uuid.New()
+			)
+
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				if tc.serverFn != nil {
+					tc.serverFn(t, w, r)
+					return
+				}
+				assert.Equal(t, msgID.String(), r.Header.Get("X-Message-Id"))
+				var msg dispatch.TeamsMessage
+				assert.NoError(t, json.NewDecoder(r.Body).Decode(&msg))
+				if !assert.Len(t, msg.Attachments, 1) {
+					return
+				}
+				card := msg.Attachments[0].Content
+				assert.Equal(t, "application/vnd.microsoft.card.adaptive", msg.Attachments[0].ContentType)
+				assert.Equal(t, "AdaptiveCard", card.Type)
+				if assert.Len(t, card.Body, 3) {
+					assert.Equal(t, "Workspace stopped", card.Body[0].Text)
+					assert.Equal(t, bodyMarkdown, card.Body[1].Text)
+					assert.Equal(t, "For <at>Bob Bobson</at>", card.Body[2].Text)
+				}
+				if assert.Len(t, card.Actions, 1) {
+					assert.Equal(t, "Action.OpenUrl", card.Actions[0].Type)
+					assert.Equal(t, "https://coder.com/@bob/dev", card.Actions[0].URL)
+				}
+				if assert.NotNil(t, card.MSTeams) && assert.Len(t, card.MSTeams.Entities, 1) {
+					assert.Equal(t, tc.expectMentionID, card.MSTeams.Entities[0].Mentioned.ID)
+				}
+				w.WriteHeader(http.StatusAccepted)
+			}))
+			t.Cleanup(server.Close)
+
+			u, err := url.Parse(server.URL)
+			require.NoError(t, err)
+			cfg := codersdk.NotificationsTeamsConfig{
+				WebhookURL:  *serpent.URLOf(u),
+				UserIDClaim: "oid",
+			}
+
+			handler := dispatch.NewTeamsHandler(cfg, fakeUserLinkStore{claims: tc.claims}, logger.With(slog.F("test", tc.name)))
+			payload := msgPayload
+			payload.Private = tc.private
+			deliveryFn, err := handler.Dispatcher(payload, titleMarkdown, bodyMarkdown, helpers())
+			require.NoError(t, err)
+
+			retryable, err := deliveryFn(ctx, msgID)
+			if tc.expectErr == "" {
+				require.NoError(t, err)
+				require.False(t, retryable)
+				return
+			}
+
+			require.ErrorContains(t, err, tc.expectErr)
+			require.Equal(t, tc.expectRetryable, retryable)
+		})
+	}
+}
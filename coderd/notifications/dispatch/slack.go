@@ -0,0 +1,225 @@
+package dispatch
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"regexp"
+	"slices"
+	"strings"
+	"text/template"
+
+	"github.com/google/uuid"
+	"golang.org/x/xerrors"
+
+	"cdr.dev/slog/v3"
+	"github.com/coder/coder/v2/coderd/notifications/types"
+	markdown "github.com/coder/coder/v2/coderd/render"
+	"github.com/coder/coder/v2/codersdk"
+)
+
+const (
+	slackDefaultAPIURL = "https://slack.com/api"
+
+	// Slack rejects header text longer than this.
+	slackMaxHeaderLength = 150
+	// Slack rejects section text longer than this.
+	slackMaxSectionLength = 3000
+	// Slack rejects actions blocks with more elements than this.
+	slackMaxActions = 25
+)
+
+// SlackHandler dispatches notification messages to Slack as Block Kit
+// messages.
+//
+// When a bot token is configured and the recipient's Slack user ID can be
+// resolved from the claims of one of their user links, the message is sent to
+// them directly via chat.postMessage. Otherwise, it is posted to the configured
+// incoming webhook, unless the message is private.
+type SlackHandler struct {
+	cfg   codersdk.NotificationsSlackConfig
+	log   slog.Logger
+	store UserLinkStore
+
+	apiURL string
+	cl     *http.Client
+}
+
+// SlackMessage is the body sent to chat.postMessage or an incoming webhook.
+type SlackMessage struct {
+	Channel string       `json:"channel,omitempty"`
+	Text    string       `json:"text"`
+	Blocks  []SlackBlock `json:"blocks"`
+}
+
+// SlackBlock is a subset of the Block Kit layout blocks. Elements holds
+// SlackButton values in actions blocks and SlackText values in context blocks.
+type SlackBlock struct {
+	Type     string     `json:"type"`
+	Text     *SlackText `json:"text,omitempty"`
+	Elements []any      `json:"elements,omitempty"`
+}
+
+type SlackText struct {
+	Type string `json:"type"`
+	Text string `json:"text"`
+}
+
+// SlackButton is a link button within an actions block.
+type SlackButton struct {
+	Type string    `json:"type"`
+	Text SlackText `json:"text"`
+	URL  string    `json:"url"`
+}
+
+func NewSlackHandler(cfg codersdk.NotificationsSlackConfig, store UserLinkStore, log slog.Logger) *SlackHandler {
+	return &SlackHandler{cfg: cfg, log: log, store: store, apiURL: slackDefaultAPIURL, cl: newHTTPClient(log)}
+}
+
+// WithAPIURL overrides the Slack Web API base URL. It is intended for tests.
+func (s *SlackHandler) WithAPIURL(u string) *SlackHandler {
+	s.apiURL = strings.TrimSuffix(u, "/")
+	return s
+}
+
+func (s *SlackHandler) Dispatcher(payload types.MessagePayload, titleMarkdown, bodyMarkdown string, _ template.FuncMap) (DeliveryFunc, error) {
+	if s.cfg.BotToken.String() == "" && s.cfg.WebhookURL.String() == "" {
+		return nil, xerrors.New("neither slack bot token nor webhook URL defined")
+	}
+
+	title, err := markdown.PlaintextFromMarkdown(titleMarkdown)
+	if err != nil {
+		return nil, xerrors.Errorf("render title: %w", err)
+	}
+	bodyPlaintext, err := markdown.PlaintextFromMarkdown(bodyMarkdown)
+	if err != nil {
+		return nil, xerrors.Errorf("render body: %w", err)
+	}
+
+	msg := SlackMessage{
+		// Text is the fallback shown in notifications and by clients that
+		// cannot render blocks.
+		Text:   title + "\n" + bodyPlaintext,
+		Blocks: slackBlocks(title, SlackMrkdwnFromMarkdown(bodyMarkdown), payload.Actions),
+	}
+	return s.dispatch(payload, msg), nil
+}
+
+func (s *SlackHandler) dispatch(payload types.MessagePayload, msg SlackMessage) DeliveryFunc {
+	return func(ctx context.Context, msgID uuid.UUID) (retryable bool, err error) {
+		var slackUserID string
+		if s.cfg.BotToken.String() != "" {
+			userID, err := uuid.Parse(payload.UserID)
+			if err != nil {
+				return false, xerrors.Errorf("parse user ID: %w", err)
+			}
+			slackUserID, err = lookupClaim(ctx, s.store, userID, s.cfg.UserIDClaim.String())
+			if err != nil {
+				return true, xerrors.Errorf("resolve slack user: %w", err)
+			}
+		}
+
+		if slackUserID != "" {
+			return s.postMessage(ctx, msgID, slackUserID, msg)
+		}
+		// The webhook posts to a channel other users can read.
+		if payload.Private {
+			return false, xerrors.Errorf("no slack user ID found in claim %q for user %q and %q cannot be posted to a shared channel", s.cfg.UserIDClaim.String(), payload.UserUsername, payload.NotificationName)
+		}
+		if s.cfg.WebhookURL.String() == "" {
+			return false, xerrors.Errorf("no slack user ID found in claim %q for user %q and no webhook URL defined", s.cfg.UserIDClaim.String(), payload.UserUsername)
+		}
+
+		// Without a direct message, make it clear who the notification is for.
+		if payload.UserUsername != "" {
+			msg.Blocks = append(slices.Clone(msg.Blocks), SlackBlock{
+				Type:     "context",
+				Elements: []any{SlackText{Type: "mrkdwn", Text: "For " + slackEscape(payload.UserUsername)}},
+			})
+		}
+		_, retryable, err = postJSON(ctx, s.cl, s.log, s.cfg.WebhookURL.String(), msgID, nil, msg)
+		return retryable, err
+	}
+}
+
+// postMessage sends msg to a user via the Web API. Slack reports most errors
+// with a 200 status, so the response body must be checked.
+func (s *SlackHandler) postMessage(ctx context.Context, msgID uuid.UUID, channel string, msg SlackMessage) (bool, error) {
+	msg.Channel = channel
+	headers := http.Header{}
+	headers.Set("Authorization", "Bearer "+s.cfg.BotToken.String())
+
+	body, retryable, err := postJSON(ctx, s.cl, s.log, s.apiURL+"/chat.postMessage", msgID, headers, msg)
+	if err != nil {
+		return retryable, err
+	}
+
+	var resp struct {
+		OK    bool   `json:"ok"`
+		Error string `json:"error"`
+	}
+	if err := json.Unmarshal(body, &resp); err != nil {
+		return true, xerrors.Errorf("decode slack response: %w", err)
+	}
+	if !resp.OK {
+		switch resp.Error {
+		case "ratelimited", "internal_error", "fatal_error", "service_unavailable", "request_timeout":
+			return true, xerrors.Errorf("slack API error: %s", resp.Error)
+		default:
+			return false, xerrors.Errorf("slack API error: %s", resp.Error)
+		}
+	}
+	return false, nil
+}
+
+func slackBlocks(title, body string, actions []types.TemplateAction) []SlackBlock {
+	blocks := []SlackBlock{{
+		Type: "header",
+		Text: &SlackText{Type: "plain_text", Text: truncate(title, slackMaxHeaderLength)},
+	}}
+	if body != "" {
+		blocks = append(blocks, SlackBlock{
+			Type: "section",
+			Text: &SlackText{Type: "mrkdwn", Text: truncate(body, slackMaxSectionLength)},
+		})
+	}
+
+	var buttons []any
+	for _, action := range actions {
+		if action.URL == "" || len(buttons) == slackMaxActions {
+			continue
+		}
+		buttons = append(buttons, SlackButton{
+			Type: "button",
+			Text: SlackText{Type: "plain_text", Text: action.Label},
+			URL:  action.URL,
+		})
+	}
+	if len(buttons) > 0 {
+		blocks = append(blocks, SlackBlock{Type: "actions", Elements: buttons})
+	}
+	return blocks
+}
+
+var (
+	slackMarkdownLink   = regexp.MustCompile(`\[([^\]]+)\]\(([^)\s]+)\)`)
+	slackMarkdownBold   = regexp.MustCompile(`\*\*([^*]+)\*\*|__([^_]+)__`)
+	slackMarkdownHeader = regexp.MustCompile(`(?m)^#{1,6}\s+(.+)$`)
+)
+
+// SlackMrkdwnFromMarkdown converts the subset of Markdown used by notification
+// templates into Slack's mrkdwn format.
+func SlackMrkdwnFromMarkdown(md string) string {
+	out := slackEscape(strings.TrimSpace(md))
+	out = slackMarkdownHeader.ReplaceAllString(out, "*$1*")
+	out = slackMarkdownBold.ReplaceAllStringFunc(out, func(s string) string {
+		return "*" + strings.Trim(s, "*_") + "*"
+	})
+	out = slackMarkdownLink.ReplaceAllString(out, "<$2|$1>")
+	return out
+}
+
+// slackEscape escapes the control characters Slack recognizes in mrkdwn text.
+func slackEscape(s string) string {
+	return strings.NewReplacer("&", "&amp;", "<", "&lt;", ">", "&gt;").Replace(s)
+}
@@ -0,0 +1,245 @@
+package dispatch_test
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"cdr.dev/slog/v3"
+	"cdr.dev/slog/v3/sloggers/slogtest"
+	"github.com/coder/coder/v2/coderd/database"
+	"github.com/coder/coder/v2/coderd/notifications/dispatch"
+	"github.com/coder/coder/v2/coderd/notifications/types"
+	"github.com/coder/coder/v2/codersdk"
+	"github.com/coder/coder/v2/testutil"
+	"github.com/coder/serpent"
+)
+
+// fakeUserLinkStore returns links with the given merged claims for any user.
+type fakeUserLinkStore struct {
+	claims map[string]interface{}
+}
+
+func (f fakeUserLinkStore) GetUserLinksByUserID(_ context.Context, userID uuid.UUID) ([]database.UserLink, error) {
+	if f.claims == nil {
+		return nil, nil
+	}
+	return []database.UserLink{{
+		UserID:    userID,
+		LoginType: database.LoginTypeOIDC,
+		Claims:    database.UserLinkClaims{MergedClaims: f.claims},
+	}}, nil
+}
+
+func TestSlack(t *testing.T) {
+	t.Parallel()
+
+	const (
+		titleMarkdown = "Workspace **stopped**"
+		bodyMarkdown  = "Your workspace [dev](https://coder.com/@bob/dev) was **stopped** by an admin."
+		slackClaim    = "https://slack.com/user_id"
+	)
+
+	msgPayload := types.MessagePayload{
+		Version:          "1.2",
+		NotificationName: "test",
+		UserID:           uuid.NewString(),
+		UserUsername:     "bob",
+		Actions: []types.TemplateAction{
+			{Label: "View workspace", URL: "https://coder.com/@bob/dev"},
+		},
+	}
+
+	tests := []struct {
+		name     string
+		botToken string
+		webhook  bool
+		private  bool
+		claims   map[string]interface{}
+		// apiFn handles chat.postMessage, webhookFn the incoming webhook.
+		apiFn     func(t *testing.T, w http.ResponseWriter, r *http.Request)
+		webhookFn func(t *testing.T, w http.ResponseWriter, r *http.Request)
+
+		expectSuccess   bool
+		expectRetryable bool
+		expectErr       string
+	}{
+		{
+			name:     "DirectMessage",
+			botToken: "xoxb-token",
+			webhook:  true,
+			claims:   map[string]interface{}{slackClaim: "U123"},
+			apiFn: func(t *testing.T, w http.ResponseWriter, r *http.Request) {
+				assert.Equal(t, "Bearer xoxb-token", r.Header.Get("Authorization"))
+				var msg dispatch.SlackMessage
+				assert.NoError(t, json.NewDecoder(r.Body).Decode(&msg))
+				assert.Equal(t, "U123", msg.Channel)
+				if assert.Len(t, msg.Blocks, 3) {
+					assert.Equal(t, "header", msg.Blocks[0].Type)
+					assert.Equal(t, "Workspace stopped", msg.Blocks[0].Text.Text)
+					assert.Equal(t, "section", msg.Blocks[1].Type)
+					assert.Equal(t, "Your workspace <https://coder.com/@bob/dev|dev> was *stopped* by an admin.", msg.Blocks[1].Text.Text)
+					assert.Equal(t, "actions", msg.Blocks[2].Type)
+					assert.Len(t, msg.Blocks[2].Elements, 1)
+				}
+				_, _ = w.Write([]byte(`{"ok":true}`))
+			},
+			expectSuccess: true,
+		},
+		{
+			name:     "FallbackToWebhook",
+			botToken: "xoxb-token",
+			webhook:  true,
+			apiFn: func(t *testing.T, _ http.ResponseWriter, _ *http.Request) {
+				t.Error("unexpected chat.postMessage call")
+			},
+			webhookFn: func(t *testing.T, w http.ResponseWriter, r *http.Request) {
+				assert.Empty(t, r.Header.Get("Authorization"))
+				var msg dispatch.SlackMessage
+				assert.NoError(t, json.NewDecoder(r.Body).Decode(&msg))
+				assert.Empty(t, msg.Channel)
+				if assert.Len(t, msg.Blocks, 4) {
+					assert.Equal(t, "context", msg.Blocks[3].Type)
+				}
+				w.WriteHeader(http.StatusOK)
+			},
+			expectSuccess: true,
+		},
+		{
+			name:     "PrivateDirectMessage",
+			botToken: "xoxb-token",
+			webhook:  true,
+			private:  true,
+			claims:   map[string]interface{}{slackClaim: "U123"},
+			apiFn: func(t *testing.T, w http.ResponseWriter, r *http.Request) {
+				var msg dispatch.SlackMessage
+				assert.NoError(t, json.NewDecoder(r.Body).Decode(&msg))
+				assert.Equal(t, "U123", msg.Channel)
+				_, _ = w.Write([]byte(`{"ok":true}`))
+			},
+			webhookFn: func(t *testing.T, _ http.ResponseWriter, _ *http.Request) {
+				t.Error("unexpected webhook call")
+			},
+			expectSuccess: true,
+		},
+		{
+			name:     "PrivateNotPostedToWebhook",
+			botToken: "xoxb-token",
+			webhook:  true,
+			private:  true,
+			apiFn: func(t *testing.T, _ http.ResponseWriter, _ *http.Request) {
+				t.Error("unexpected chat.postMessage call")
+			},
+			webhookFn: func(t *testing.T, _ http.ResponseWriter, _ *http.Request) {
+				t.Error("unexpected webhook call")
+			},
+			expectRetryable: false,
+			expectErr:       "cannot be posted to a shared channel",
+		},
+		{
+			name:            "NoDestination",
+			botToken:        "xoxb-token",
+			expectRetryable: false,
+			expectErr:       "no slack user ID found",
+		},
+		{
+			name:     "RateLimited",
+			botToken: "xoxb-token",
+			claims:   map[string]interface{}{slackClaim: "U123"},
+			apiFn: func(_ *testing.T, w http.ResponseWriter, _ *http.Request) {
+				_, _ = w.Write([]byte(`{"ok":false,"error":"ratelimited"}`))
+			},
+			expectRetryable: true,
+			expectErr:       "slack API error: ratelimited",
+		},
+		{
+			name:     "ChannelNotFound",
+			botToken: "xoxb-token",
+			claims:   map[string]interface{}{slackClaim: "U123"},
+			apiFn: func(_ *testing.T, w http.ResponseWriter, _ *http.Request) {
+				_, _ = w.Write([]byte(`{"ok":false,"error":"channel_not_found"}`))
+			},
+			expectRetryable: false,
+			expectErr:       "slack API error: channel_not_found",
+		},
+		{
+			name:    "WebhookServerError",
+			webhook: true,
+			webhookFn: func(_ *testing.T, w http.ResponseWriter, _ *http.Request) {
+				w.WriteHeader(http.StatusServiceUnavailable)
+			},
+			expectRetryable: true,
+			expectErr:       "non-2xx response (503)",
+		},
+	}
+
+	logger := slogtest.Make(t, &slogtest.Options{IgnoreErrors: true}).Leveled(slog.LevelDebug)
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			ctx := testutil.Context(t, testutil.WaitLong)
+
+			mux := http.NewServeMux()
+			mux.HandleFunc("/api/chat.postMessage", func(w http.ResponseWriter, r *http.Request) {
+				tc.apiFn(t, w, r)
+			})
+			mux.HandleFunc("/webhook", func(w http.ResponseWriter, r *http.Request) {
+				tc.webhookFn(t, w, r)
+			})
+			server := httptest.NewServer(mux)
+			t.Cleanup(server.Close)
+
+			cfg := codersdk.NotificationsSlackConfig{
+				BotToken:    serpent.String(tc.botToken),
+				UserIDClaim: slackClaim,
+			}
+			if tc.webhook {
+				u, err := url.Parse(server.URL + "/webhook")
+				require.NoError(t, err)
+				cfg.WebhookURL = *serpent.URLOf(u)
+			}
+
+			handler := dispatch.NewSlackHandler(cfg, fakeUserLinkStore{claims: tc.claims}, logger.With(slog.F("test", tc.name))).
+				WithAPIURL(server.URL + "/api")
+			payload := msgPayload
+			payload.Private = tc.private
+			deliveryFn, err := handler.Dispatcher(payload, titleMarkdown, bodyMarkdown, helpers())
+			require.NoError(t, err)
+
+			retryable, err := deliveryFn(ctx, uuid.New())
+			if tc.expectSuccess {
+				require.NoError(t, err)
+				require.False(t, retryable)
+				return
+			}
+
+			require.ErrorContains(t, err, tc.expectErr)
+			require.Equal(t, tc.expectRetryable, retryable)
+		})
+	}
+}
+
+func TestSlackMrkdwnFromMarkdown(t *testing.T) {
+	t.Parallel()
+
+	for _, tc := range []struct {
+		in, out string
+	}{
+		{in: "plain", out: "plain"},
+		{in: "**bold** and __bold__", out: "*bold* and *bold*"},
+		{in: "[link](https://example.com?a=1)", out: "<https://example.com?a=1|link>"},
+		{in: "## Heading\nbody", out: "*Heading*\nbody"},
+		{in: "a < b & c > d", out: "a &lt; b &amp; c &gt; d"},
+	} {
+		assert.Equal(t, tc.out, dispatch.SlackMrkdwnFromMarkdown(tc.in), "input %q", tc.in)
+	}
+}
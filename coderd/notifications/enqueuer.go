@@ -40,6 +40,7 @@ type StoreEnqueuer struct {
 	defaultMethod  database.NotificationMethod
 	defaultEnabled bool
 	inboxEnabled   bool
+	smtpEnabled    bool
 
 	// helpers holds a map of template funcs which are used when rendering templates. These need to be passed in because
 	// the template funcs will return values which are inappropriately encapsulated in this struct.
@@ -66,6 +67,7 @@ func NewStoreEnqueuer(cfg codersdk.NotificationsConfig, store Store, helpers tem
 		defaultMethod:  method,
 		defaultEnabled: cfg.Enabled(),
 		inboxEnabled:   cfg.Inbox.Enabled.Value(),
+		smtpEnabled:    cfg.SMTP.Smarthost != "",
 		helpers:        helpers,
 		clock:          clock,
 	}, nil
@@ -115,6 +117,18 @@ func (s *StoreEnqueuer) EnqueueWithData(ctx context.Context, userID, templateID
 		methods = append(methods, database.NotificationMethodInbox)
 	}
 
+	// Slack and Microsoft Teams may post to a channel shared with other users,
+	// so private messages are sent by email instead when it is available. If
+	// it is not, the dispatchers will only deliver them directly to the user.
+	if payload.Private && s.smtpEnabled {
+		for i, method := range methods {
+			if method == database.NotificationMethodSlack || method == database.NotificationMethodMsteams {
+				methods[i] = database.NotificationMethodSmtp
+			}
+		}
+		methods = slices.Compact(methods)
+	}
+
 	uuids := make([]uuid.UUID, 0, 2)
 	for _, method := range methods {
 		// TODO(DanielleMaywood):
@@ -194,6 +208,8 @@ func (s *StoreEnqueuer) buildPayload(metadata database.FetchNewMessageMetadataRo
 		Data:    data,
 		Targets: targets,
 
+		Private: privateTemplates[metadata.NotificationTemplateID],
+
 		// No actions yet
 	}
 
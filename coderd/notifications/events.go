@@ -19,6 +19,10 @@ var (
 	TemplateWorkspaceManualBuildFailed = uuid.MustParse("2faeee0f-26cb-4e96-821c-85ccb9f71513")
 	TemplateWorkspaceOutOfMemory       = uuid.MustParse("a9d027b4-ac49-4fb1-9f6d-45af15f64e7a")
 	TemplateWorkspaceOutOfDisk         = uuid.MustParse("f047f6a3-5713-40f7-85aa-0394cce9fa3a")
+	TemplateWorkspaceHighCPU           = uuid.MustParse("66713419-c6e2-48e1-bac5-6a1c5dbc1652")
+	TemplateWorkspaceProcessLimit      = uuid.MustParse("cce0693f-17f0-4646-96c0-891b862dc77e")
+	TemplateWorkspaceOutOfInodes       = uuid.MustParse("85b3ff61-d24a-407f-b829-0926e1ad6bd4")
+	TemplateWorkspaceDriftDetected     = uuid.MustParse("d404a2a1-20be-40bd-97a0-89b4a5e21a08")
 )
 
 // Account-related events.
@@ -77,3 +81,23 @@ var (
 	TemplateAIBudgetWarningAdmin      = uuid.MustParse("2a7b0ac1-00e1-4625-9cd5-1e5933972c77")
 	TemplateAIBudgetLimitReachedAdmin = uuid.MustParse("0bafe0ea-a78b-4217-ad05-1ef12e92e025")
 )
+
+// privateTemplates are the templates whose messages must only ever reach the
+// recipient, and never a destination shared with other users. They cover
+// credentials, changes to an account, and workspace changes which the owner
+// did not make themselves. Keep the list in the notifications docs in sync.
+var privateTemplates = map[uuid.UUID]bool{
+	TemplateUserRequestedOneTimePasscode: true,
+
+	TemplateUserAccountCreated:   true,
+	TemplateUserAccountDeleted:   true,
+	TemplateUserAccountSuspended: true,
+	TemplateUserAccountActivated: true,
+	TemplateYourAccountSuspended: true,
+	TemplateYourAccountActivated: true,
+
+	TemplateWorkspaceDeleted:           true,
+	TemplateWorkspaceDormant:           true,
+	TemplateWorkspaceMarkedForDeletion: true,
+	TemplateWorkspaceDriftDetected:     true,
+}
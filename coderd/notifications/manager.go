@@ -127,6 +127,8 @@ func defaultHandlers(cfg codersdk.NotificationsConfig, log slog.Logger, store St
 		database.NotificationMethodSmtp:    dispatch.NewSMTPHandler(cfg.SMTP, log.Named("dispatcher.smtp")),
 		database.NotificationMethodWebhook: dispatch.NewWebhookHandler(cfg.Webhook, log.Named("dispatcher.webhook")),
 		database.NotificationMethodInbox:   dispatch.NewInboxHandler(log.Named("dispatcher.inbox"), store, ps),
+		database.NotificationMethodSlack:   dispatch.NewSlackHandler(cfg.Slack, store, log.Named("dispatcher.slack")),
+		database.NotificationMethodMsteams: dispatch.NewTeamsHandler(cfg.Teams, store, log.Named("dispatcher.msteams")),
 	}
 }
 
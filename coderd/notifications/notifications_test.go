@@ -1293,6 +1293,106 @@ func TestNotificationTemplates_Golden(t *testing.T) {
 				},
 			},
 		},
+		{
+			name: "TemplateWorkspaceHighCPU",
+			id:   notifications.TemplateWorkspaceHighCPU,
+			payload: types.MessagePayload{
+				UserName:     "Bobby",
+				UserEmail:    "bobby@coder.com",
+				UserUsername: "bobby",
+				Labels: map[string]string{
+					"workspace": "bobby-workspace",
+					"threshold": "90%",
+				},
+			},
+		},
+		{
+			name: "TemplateWorkspaceProcessLimit",
+			id:   notifications.TemplateWorkspaceProcessLimit,
+			payload: types.MessagePayload{
+				UserName:     "Bobby",
+				UserEmail:    "bobby@coder.com",
+				UserUsername: "bobby",
+				Labels: map[string]string{
+					"workspace": "bobby-workspace",
+					"threshold": "90%",
+				},
+			},
+		},
+		{
+			name: "TemplateWorkspaceOutOfInodes",
+			id:   notifications.TemplateWorkspaceOutOfInodes,
+			payload: types.MessagePayload{
+				UserName:     "Bobby",
+				UserEmail:    "bobby@coder.com",
+				UserUsername: "bobby",
+				Labels: map[string]string{
+					"workspace": "bobby-workspace",
+				},
+				Data: map[string]any{
+					"volumes": []map[string]any{
+						{
+							"path":      "/home/coder",
+							"threshold": "90%",
+						},
+					},
+				},
+			},
+		},
+		{
+			name: "TemplateWorkspaceOutOfInodes_MultipleVolumes",
+			id:   notifications.TemplateWorkspaceOutOfInodes,
+			payload: types.MessagePayload{
+				UserName:     "Bobby",
+				UserEmail:    "bobby@coder.com",
+				UserUsername: "bobby",
+				Labels: map[string]string{
+					"workspace": "bobby-workspace",
+				},
+				Data: map[string]any{
+					"volumes": []map[string]any{
+						{
+							"path":      "/home/coder",
+							"threshold": "90%",
+						},
+						{
+							"path":      "/dev/coder",
+							"threshold": "80%",
+						},
+						{
+							"path":      "/etc/coder",
+							"threshold": "95%",
+						},
+					},
+				},
+			},
+		},
+		{
+			name: "TemplateWorkspaceDriftDetected",
+			id:   notifications.TemplateWorkspaceDriftDetected,
+			payload: types.MessagePayload{
+				UserName:     "Bobby",
+				UserEmail:    "bobby@coder.com",
+				UserUsername: "bobby",
+				Labels: map[string]string{
+					"workspace":                "bobby-workspace",
+					"workspace_owner_username": "bobby",
+					"template_name":            "bobby-template",
+				},
+				Data: map[string]any{
+					"resources": []map[string]any{
+						{
+							"address": "docker_container.workspace[0]",
+							"action":  "update",
+						},
+						{
+							"address": "docker_volume.home",
+							"action":  "delete",
+						},
+					},
+				},
+			},
+		},
 		{
 			name: "TemplateTestNotification",
 			id:   notifications.TemplateTestNotification,
@@ -2436,6 +2536,84 @@ func TestNotificationOneTimePasswordDeliveryTargets(t *testing.T) {
 		require.NoError(t, err)
 		require.Len(t, enqueued, 1)
 	})
+
+	for _, method := range []database.NotificationMethod{database.NotificationMethodSlack, database.NotificationMethodMsteams} {
+		t.Run(string(method), func(t *testing.T) {
+			t.Parallel()
+
+			t.Run("FallbackToSMTP", func(t *testing.T) {
+				t.Parallel()
+
+				ctx := dbauthz.AsNotifier(testutil.Context(t, testutil.WaitSuperLong))
+				store, _ := dbtestutil.NewDB(t)
+				logger := testutil.Logger(t)
+
+				// Given: the default method posts to a shared channel, and SMTP is configured.
+				cfg := defaultNotificationsConfig(method)
+				cfg.Inbox.Enabled = false
+				cfg.SMTP.Smarthost = "localhost:1337"
+
+				enq, err := notifications.NewStoreEnqueuer(cfg, store, defaultHelpers(), logger.Named("enqueuer"), quartz.NewMock(t))
+				require.NoError(t, err)
+				user := createSampleUser(t, store)
+
+				// When: A one-time-passcode notification is sent.
+				enqueued, err := enq.Enqueue(ctx, user.ID, notifications.TemplateUserRequestedOneTimePasscode,
+					map[string]string{"one_time_passcode": "1234"}, "test", user.ID)
+				require.NoError(t, err)
+				require.Len(t, enqueued, 1)
+
+				// Then: it is sent by email instead.
+				msgs, err := store.GetNotificationMessagesByStatus(ctx, database.GetNotificationMessagesByStatusParams{
+					Status: database.NotificationMessageStatusPending,
+					Limit:  10,
+				})
+				require.NoError(t, err)
+				require.Len(t, msgs, 1)
+				require.Equal(t, database.NotificationMethodSmtp, msgs[0].Method)
+			})
+
+			t.Run("Private", func(t *testing.T) {
+				t.Parallel()
+
+				ctx := dbauthz.AsNotifier(testutil.Context(t, testutil.WaitSuperLong))
+				store, _ := dbtestutil.NewDB(t)
+				logger := testutil.Logger(t)
+
+				// Given: the default method posts to a shared channel, and SMTP is not configured.
+				cfg := defaultNotificationsConfig(method)
+				cfg.Inbox.Enabled = false
+
+				enq, err := notifications.NewStoreEnqueuer(cfg, store, defaultHelpers(), logger.Named("enqueuer"), quartz.NewMock(t))
+				require.NoError(t, err)
+				user := createSampleUser(t, store)
+
+				// When: A one-time-passcode and a dormant workspace notification are sent.
+				enqueued, err := enq.Enqueue(ctx, user.ID, notifications.TemplateUserRequestedOneTimePasscode,
+					map[string]string{"one_time_passcode": "1234"}, "test", user.ID)
+				require.NoError(t, err)
+				require.Len(t, enqueued, 1)
+				enqueued, err = enq.Enqueue(ctx, user.ID, notifications.TemplateWorkspaceDormant,
+					map[string]string{"name": "bobby-workspace", "reason": "inactivity", "initiator": "autobuild", "dormancyHours": "24", "timeTilDelete": "24 hours"}, "test", user.ID)
+				require.NoError(t, err)
+				require.Len(t, enqueued, 1)
+
+				// Then: they are marked private so the dispatcher will not post them to a channel.
+				msgs, err := store.GetNotificationMessagesByStatus(ctx, database.GetNotificationMessagesByStatusParams{
+					Status: database.NotificationMessageStatusPending,
+					Limit:  10,
+				})
+				require.NoError(t, err)
+				require.Len(t, msgs, 2)
+				for _, msg := range msgs {
+					require.Equal(t, method, msg.Method)
+					var payload types.MessagePayload
+					require.NoError(t, json.Unmarshal(msg.Payload, &payload))
+					require.True(t, payload.Private, payload.NotificationName)
+				}
+			})
+		})
+	}
 }
 
 type fakeHandler struct {
@@ -21,4 +21,9 @@ type MessagePayload struct {
 	Labels  map[string]string `json:"labels"`
 	Data    map[string]any    `json:"data"`
 	Targets []uuid.UUID       `json:"targets"`
+
+	// Private is set for messages which must only be delivered to the
+	// recipient, such as those containing credentials. Methods which post to a
+	// shared destination must not deliver these.
+	Private bool `json:"private,omitempty"`
 }
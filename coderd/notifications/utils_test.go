@@ -24,6 +24,8 @@ func defaultNotificationsConfig(method database.NotificationMethod) codersdk.Not
 	var (
 		smtp    codersdk.NotificationsEmailConfig
 		webhook codersdk.NotificationsWebhookConfig
+		slack   codersdk.NotificationsSlackConfig
+		teams   codersdk.NotificationsTeamsConfig
 	)
 
 	switch method {
@@ -31,6 +33,10 @@ func defaultNotificationsConfig(method database.NotificationMethod) codersdk.Not
 		smtp.Smarthost = serpent.String("localhost:1337")
 	case database.NotificationMethodWebhook:
 		webhook.Endpoint = serpent.URL(url.URL{Host: "localhost"})
+	case database.NotificationMethodSlack:
+		slack.WebhookURL = serpent.URL(url.URL{Host: "localhost"})
+	case database.NotificationMethodMsteams:
+		teams.WebhookURL = serpent.URL(url.URL{Host: "localhost"})
 	}
 
 	return codersdk.NotificationsConfig{
@@ -45,6 +51,8 @@ func defaultNotificationsConfig(method database.NotificationMethod) codersdk.Not
 		StoreSyncBufferSize: 50,
 		SMTP:                smtp,
 		Webhook:             webhook,
+		Slack:               slack,
+		Teams:               teams,
 		Inbox: codersdk.NotificationsInboxConfig{
 			Enabled: serpent.Bool(true),
 		},
@@ -150,6 +150,7 @@ func (api *API) postOAuth2ProviderAppAuthorize() http.HandlerFunc {
 // @Param client_secret formData string false "Client secret, required if grant_type=authorization_code"
 // @Param code formData string false "Authorization code, required if grant_type=authorization_code"
 // @Param refresh_token formData string false "Refresh token, required if grant_type=refresh_token"
+// @Param device_code formData string false "Device code, required if grant_type=urn:ietf:params:oauth:grant-type:device_code"
 // @Param grant_type formData codersdk.OAuth2ProviderGrantType true "Grant type"
 // @Success 200 {object} oauth2.Token
 // @Router /oauth2/tokens [post]
@@ -157,6 +158,45 @@ func (api *API) postOAuth2ProviderAppToken() http.HandlerFunc {
 	return oauth2provider.Tokens(api.Database, api.DeploymentValues.Sessions)
 }
 
+// @Summary OAuth2 device authorization request (RFC 8628).
+// @ID oauth2-device-authorization-request
+// @Accept x-www-form-urlencoded
+// @Produce json
+// @Tags Enterprise
+// @Param client_id formData string true "Client ID"
+// @Param client_secret formData string false "Client secret, for confidential clients"
+// @Param scope formData string false "Token scopes (currently ignored)"
+// @Param resource formData string false "Resource indicator (RFC 8707)"
+// @Success 200 {object} codersdk.OAuth2DeviceAuthorizationResponse
+// @Router /oauth2/device/code [post]
+func (api *API) postOAuth2DeviceAuthorization() http.HandlerFunc {
+	return oauth2provider.DeviceAuthorization(api.Database, api.AccessURL)
+}
+
+// @Summary OAuth2 device verification (GET - show verification page).
+// @ID oauth2-device-verification-get
+// @Security CoderSessionToken
+// @Tags Enterprise
+// @Param user_code query string false "User code displayed on the device"
+// @Success 200 "Returns HTML device verification page"
+// @Router /oauth2/device [get]
+func (api *API) getOAuth2DeviceVerification() http.HandlerFunc {
+	return oauth2provider.ShowDevicePage(api.Database, api.AccessURL)
+}
+
+// @Summary OAuth2 device verification (POST - process verification).
+// @ID oauth2-device-verification-post
+// @Security CoderSessionToken
+// @Accept x-www-form-urlencoded
+// @Tags Enterprise
+// @Param user_code formData string true "User code displayed on the device"
+// @Param action formData string true "Either allow or deny"
+// @Success 200 "Returns HTML device verification result"
+// @Router /oauth2/device [post]
+func (api *API) postOAuth2DeviceVerification() http.HandlerFunc {
+	return oauth2provider.ProcessDevice(api.Database, api.AccessURL)
+}
+
 // @Summary Delete OAuth2 application tokens.
 // @ID delete-oauth2-application-tokens
 // @Security CoderSessionToken
@@ -23,6 +23,7 @@ import (
 	"github.com/coder/coder/v2/coderd/coderdtest/oidctest"
 	"github.com/coder/coder/v2/coderd/database"
 	"github.com/coder/coder/v2/coderd/database/dbauthz"
+	"github.com/coder/coder/v2/coderd/database/dbgen"
 	"github.com/coder/coder/v2/coderd/database/dbtestutil"
 	"github.com/coder/coder/v2/coderd/database/dbtime"
 	"github.com/coder/coder/v2/coderd/oauth2provider"
@@ -2015,3 +2016,247 @@ func TestOAuth2CoderClient(t *testing.T) {
 
 // NOTE: OAuth2 client registration validation tests have been migrated to
 // oauth2provider/validation_test.go for better separation of concerns
+
+// postOAuth2Form posts a form to an OAuth2 endpoint without any Coder session,
+// the way a device or machine client would, and decodes the JSON response.
+func postOAuth2Form(ctx context.Context, t *testing.T, rawURL string, form url.Values, basicUser, basicPass string, out any) int {
+	t.Helper()
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, rawURL, strings.NewReader(form.Encode()))
+	require.NoError(t, err)
+	req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+	if basicUser != "" {
+		req.SetBasicAuth(basicUser, basicPass)
+	}
+	res, err := http.DefaultClient.Do(req)
+	require.NoError(t, err)
+	defer res.Body.Close()
+	require.NoError(t, json.NewDecoder(res.Body).Decode(out))
+	return res.StatusCode
+}
+
+func TestOAuth2ProviderDeviceFlow(t *testing.T) {
+	t.Parallel()
+
+	ownerClient := coderdtest.New(t, nil)
+	owner := coderdtest.CreateFirstUser(t, ownerClient)
+	userClient, user := coderdtest.CreateAnotherUser(t, ownerClient, owner.OrganizationID)
+
+	ctx := testutil.Context(t, testutil.WaitLong)
+	//nolint:gocritic // OAauth2 app management requires owner permission.
+	app, err := ownerClient.PostOAuth2ProviderApp(ctx, codersdk.PostOAuth2ProviderAppRequest{
+		Name:        fmt.Sprintf("device-%d", time.Now().UnixNano()%1000000),
+		CallbackURL: "http://localhost:3000",
+	})
+	require.NoError(t, err)
+
+	startDeviceFlow := func(t *testing.T) codersdk.OAuth2DeviceAuthorizationResponse {
+		t.Helper()
+		var resp codersdk.OAuth2DeviceAuthorizationResponse
+		status := postOAuth2Form(ctx, t, app.Endpoints.DeviceAuth, url.Values{
+			"client_id": {app.ID.String()},
+		}, "", "", &resp)
+		require.Equal(t, http.StatusOK, status)
+		require.NotEmpty(t, resp.DeviceCode)
+		require.Len(t, resp.UserCode, 9) // XXXX-XXXX
+		require.Equal(t, ownerClient.URL.JoinPath("/oauth2/device").String(), resp.VerificationURI)
+		require.Contains(t, resp.VerificationURIComplete, "user_code=")
+		require.EqualValues(t, 5, resp.Interval)
+		return resp
+	}
+
+	exchange := func(t *testing.T, deviceCode string) (codersdk.OAuth2TokenResponse, codersdk.OAuth2Error) {
+		t.Helper()
+		var raw json.RawMessage
+		status := postOAuth2Form(ctx, t, app.Endpoints.Token, url.Values{
+			"grant_type":  {string(codersdk.OAuth2ProviderGrantTypeDeviceCode)},
+			"client_id":   {app.ID.String()},
+			"device_code": {deviceCode},
+		}, "", "", &raw)
+		var token codersdk.OAuth2TokenResponse
+		var oauthErr codersdk.OAuth2Error
+		if status == http.StatusOK {
+			require.NoError(t, json.Unmarshal(raw, &token))
+		} else {
+			require.NoError(t, json.Unmarshal(raw, &oauthErr))
+		}
+		return token, oauthErr
+	}
+
+	decide := func(t *testing.T, userCode, action string) {
+		t.Helper()
+		form := url.Values{"user_code": {userCode}, "action": {action}}
+		res, err := userClient.Request(ctx, http.MethodPost, "/oauth2/device", strings.NewReader(form.Encode()), func(r *http.Request) {
+			r.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+		})
+		require.NoError(t, err)
+		defer res.Body.Close()
+		require.Equal(t, http.StatusOK, res.StatusCode)
+	}
+
+	t.Run("Approve", func(t *testing.T) {
+		t.Parallel()
+
+		resp := startDeviceFlow(t)
+		_, oauthErr := exchange(t, resp.DeviceCode)
+		require.Equal(t, codersdk.OAuth2ErrorCodeAuthorizationPending, oauthErr.Error)
+
+		// The user code is accepted in any case and without the separator.
+		decide(t, strings.ToLower(strings.ReplaceAll(resp.UserCode, "-", "")), "allow")
+
+		token, oauthErr := exchange(t, resp.DeviceCode)
+		require.Empty(t, oauthErr.Error)
+		require.NotEmpty(t, token.AccessToken)
+		require.NotEmpty(t, token.RefreshToken)
+
+		usingOauth := codersdk.New(ownerClient.URL)
+		usingOauth.SetSessionToken(token.AccessToken)
+		me, err := usingOauth.User(ctx, codersdk.Me)
+		require.NoError(t, err)
+		require.Equal(t, user.ID, me.ID)
+
+		// Device codes are single-use.
+		_, oauthErr = exchange(t, resp.DeviceCode)
+		require.Equal(t, codersdk.OAuth2ErrorCodeInvalidGrant, oauthErr.Error)
+	})
+
+	t.Run("Deny", func(t *testing.T) {
+		t.Parallel()
+
+		resp := startDeviceFlow(t)
+		decide(t, resp.UserCode, "deny")
+
+		_, oauthErr := exchange(t, resp.DeviceCode)
+		require.Equal(t, codersdk.OAuth2ErrorCodeAccessDenied, oauthErr.Error)
+	})
+
+	t.Run("SlowDown", func(t *testing.T) {
+		t.Parallel()
+
+		resp := startDeviceFlow(t)
+		_, oauthErr := exchange(t, resp.DeviceCode)
+		require.Equal(t, codersdk.OAuth2ErrorCodeAuthorizationPending, oauthErr.Error)
+		_, oauthErr = exchange(t, resp.DeviceCode)
+		require.Equal(t, codersdk.OAuth2ErrorCodeSlowDown, oauthErr.Error)
+	})
+
+	t.Run("InvalidDeviceCode", func(t *testing.T) {
+		t.Parallel()
+
+		_, oauthErr := exchange(t, "coder_invalid_code")
+		require.Equal(t, codersdk.OAuth2ErrorCodeInvalidGrant, oauthErr.Error)
+	})
+}
+
+func TestOAuth2ProviderClientCredentials(t *testing.T) {
+	t.Parallel()
+
+	db, pubsub := dbtestutil.NewDB(t)
+	ownerClient := coderdtest.New(t, &coderdtest.Options{
+		Database: db,
+		Pubsub:   pubsub,
+	})
+	owner := coderdtest.CreateFirstUser(t, ownerClient)
+	serviceAccount := dbgen.User(t, db, database.User{
+		IsServiceAccount: true,
+		Status:           database.UserStatusActive,
+	})
+	ctx := testutil.Context(t, testutil.WaitLong)
+
+	//nolint:gocritic // OAauth2 app management requires owner permission.
+	_, err := ownerClient.PostOAuth2ProviderApp(ctx, codersdk.PostOAuth2ProviderAppRequest{
+		Name:                    "client-creds-person",
+		CallbackURL:             "http://localhost:3000",
+		ClientCredentialsUserID: &owner.UserID,
+	})
+	require.Error(t, err, "a person cannot be used for client credentials")
+
+	//nolint:gocritic // OAauth2 app management requires owner permission.
+	app, err := ownerClient.PostOAuth2ProviderApp(ctx, codersdk.PostOAuth2ProviderAppRequest{
+		Name:                    "client-creds",
+		CallbackURL:             "http://localhost:3000",
+		ClientCredentialsUserID: &serviceAccount.ID,
+	})
+	require.NoError(t, err)
+	require.Equal(t, &serviceAccount.ID, app.ClientCredentialsUserID)
+	//nolint:gocritic // OAauth2 app management requires owner permission.
+	secret, err := ownerClient.PostOAuth2ProviderAppSecret(ctx, app.ID)
+	require.NoError(t, err)
+
+	form := url.Values{"grant_type": {string(codersdk.OAuth2ProviderGrantTypeClientCredentials)}}
+
+	t.Run("OK", func(t *testing.T) {
+		t.Parallel()
+
+		var token codersdk.OAuth2TokenResponse
+		status := postOAuth2Form(ctx, t, app.Endpoints.Token, form, app.ID.String(), secret.ClientSecretFull, &token)
+		require.Equal(t, http.StatusOK, status)
+		require.NotEmpty(t, token.AccessToken)
+		require.Empty(t, token.RefreshToken)
+
+		usingOauth := codersdk.New(ownerClient.URL)
+		usingOauth.SetSessionToken(token.AccessToken)
+		me, err := usingOauth.User(ctx, codersdk.Me)
+		require.NoError(t, err)
+		require.Equal(t, serviceAccount.ID, me.ID)
+	})
+
+	t.Run("BadSecret", func(t *testing.T) {
+		t.Parallel()
+
+		var oauthErr codersdk.OAuth2Error
+		status := postOAuth2Form(ctx, t, app.Endpoints.Token, form, app.ID.String(), "coder_bad_secret", &oauthErr)
+		require.Equal(t, http.StatusUnauthorized, status)
+		require.Equal(t, codersdk.OAuth2ErrorCodeInvalidClient, oauthErr.Error)
+	})
+
+	t.Run("Update", func(t *testing.T) {
+		t.Parallel()
+
+		//nolint:gocritic // OAauth2 app management requires owner permission.
+		other, err := ownerClient.PostOAuth2ProviderApp(ctx, codersdk.PostOAuth2ProviderAppRequest{
+			Name:                    "client-creds-update",
+			CallbackURL:             "http://localhost:3000",
+			ClientCredentialsUserID: &serviceAccount.ID,
+		})
+		require.NoError(t, err)
+
+		// Leaving the field out keeps the service account.
+		//nolint:gocritic // OAauth2 app management requires owner permission.
+		other, err = ownerClient.PutOAuth2ProviderApp(ctx, other.ID, codersdk.PutOAuth2ProviderAppRequest{
+			Name:        "client-creds-update",
+			CallbackURL: "http://localhost:3001",
+		})
+		require.NoError(t, err)
+		require.Equal(t, &serviceAccount.ID, other.ClientCredentialsUserID)
+
+		// The nil UUID disables the grant.
+		//nolint:gocritic // OAauth2 app management requires owner permission.
+		other, err = ownerClient.PutOAuth2ProviderApp(ctx, other.ID, codersdk.PutOAuth2ProviderAppRequest{
+			Name:                    "client-creds-update",
+			CallbackURL:             "http://localhost:3001",
+			ClientCredentialsUserID: &uuid.Nil,
+		})
+		require.NoError(t, err)
+		require.Nil(t, other.ClientCredentialsUserID)
+	})
+
+	t.Run("NotEnabled", func(t *testing.T) {
+		t.Parallel()
+
+		//nolint:gocritic // OAauth2 app management requires owner permission.
+		other, err := ownerClient.PostOAuth2ProviderApp(ctx, codersdk.PostOAuth2ProviderAppRequest{
+			Name:        "client-creds-off",
+			CallbackURL: "http://localhost:3000",
+		})
+		require.NoError(t, err)
+		//nolint:gocritic // OAauth2 app management requires owner permission.
+		otherSecret, err := ownerClient.PostOAuth2ProviderAppSecret(ctx, other.ID)
+		require.NoError(t, err)
+
+		var oauthErr codersdk.OAuth2Error
+		status := postOAuth2Form(ctx, t, other.Endpoints.Token, form, other.ID.String(), otherSecret.ClientSecretFull, &oauthErr)
+		require.Equal(t, http.StatusBadRequest, status)
+		require.Equal(t, codersdk.OAuth2ErrorCodeUnauthorizedClient, oauthErr.Error)
+	})
+}
@@ -1,6 +1,7 @@
 package oauth2provider
 
 import (
+	"context"
 	"database/sql"
 	"fmt"
 	"net/http"
@@ -84,6 +85,10 @@ func CreateApp(db database.Store, accessURL *url.URL, auditor *audit.Auditor, lo
 		if !httpapi.Read(ctx, rw, r, &req) {
 			return
 		}
+		clientCredentialsUserID, ok := readClientCredentialsUser(ctx, db, rw, req.ClientCredentialsUserID, uuid.NullUUID{})
+		if !ok {
+			return
+		}
 		app, err := db.InsertOAuth2ProviderApp(ctx, database.InsertOAuth2ProviderAppParams{
 			ID:                      uuid.New(),
 			CreatedAt:               dbtime.Now(),
@@ -111,6 +116,7 @@ func CreateApp(db database.Store, accessURL *url.URL, auditor *audit.Auditor, lo
 			SoftwareVersion:         sql.NullString{},
 			RegistrationAccessToken: nil,
 			RegistrationClientUri:   sql.NullString{},
+			ClientCredentialsUserID: clientCredentialsUserID,
 		})
 		if err != nil {
 			httpapi.Write(ctx, rw, http.StatusInternalServerError, codersdk.Response{
@@ -143,6 +149,10 @@ func UpdateApp(db database.Store, accessURL *url.URL, auditor *audit.Auditor, lo
 		if !httpapi.Read(ctx, rw, r, &req) {
 			return
 		}
+		clientCredentialsUserID, ok := readClientCredentialsUser(ctx, db, rw, req.ClientCredentialsUserID, app.ClientCredentialsUserID)
+		if !ok {
+			return
+		}
 		app, err := db.UpdateOAuth2ProviderAppByID(ctx, database.UpdateOAuth2ProviderAppByIDParams{
 			ID:                      app.ID,
 			UpdatedAt:               dbtime.Now(),
@@ -166,6 +176,7 @@ func UpdateApp(db database.Store, accessURL *url.URL, auditor *audit.Auditor, lo
 			Jwks:                    app.Jwks,                    // Keep existing value
 			SoftwareID:              app.SoftwareID,              // Keep existing value
 			SoftwareVersion:         app.SoftwareVersion,         // Keep existing value
+			ClientCredentialsUserID: clientCredentialsUserID,
 		})
 		if err != nil {
 			httpapi.Write(ctx, rw, http.StatusInternalServerError, codersdk.Response{
@@ -205,3 +216,40 @@ func DeleteApp(db database.Store, auditor *audit.Auditor, logger slog.Logger) ht
 		rw.WriteHeader(http.StatusNoContent)
 	}
 }
+
+// readClientCredentialsUser validates the service account an app's
+// client_credentials tokens act as. A nil ID keeps the current service
+// account, and the nil UUID disables the grant. Only service accounts may be
+// used so that a token cannot act as a person who never consented to it.
+func readClientCredentialsUser(ctx context.Context, db database.Store, rw http.ResponseWriter, id *uuid.UUID, current uuid.NullUUID) (uuid.NullUUID, bool) {
+	if id == nil {
+		return current, true
+	}
+	if *id == uuid.Nil {
+		return uuid.NullUUID{}, true
+	}
+	user, err := db.GetUserByID(ctx, *id)
+	if httpapi.Is404Error(err) {
+		httpapi.Write(ctx, rw, http.StatusBadRequest, codersdk.Response{
+			Message: "Client credentials user not found.",
+			Validations: []codersdk.ValidationError{
+				{Field: "client_credentials_user_id", Detail: "user does not exist"},
+			},
+		})
+		return uuid.NullUUID{}, false
+	}
+	if err != nil {
+		httpapi.InternalServerError(rw, err)
+		return uuid.NullUUID{}, false
+	}
+	if !user.IsServiceAccount {
+		httpapi.Write(ctx, rw, http.StatusBadRequest, codersdk.Response{
+			Message: "Client credentials user must be a service account.",
+			Validations: []codersdk.ValidationError{
+				{Field: "client_credentials_user_id", Detail: "user is not a service account"},
+			},
+		})
+		return uuid.NullUUID{}, false
+	}
+	return uuid.NullUUID{UUID: user.ID, Valid: true}, true
+}
@@ -0,0 +1,317 @@
+package oauth2provider
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"net/http"
+	"net/url"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/justinas/nosurf"
+	"golang.org/x/xerrors"
+
+	"github.com/coder/coder/v2/coderd/database"
+	"github.com/coder/coder/v2/coderd/database/dbauthz"
+	"github.com/coder/coder/v2/coderd/database/dbtime"
+	"github.com/coder/coder/v2/coderd/httpapi"
+	"github.com/coder/coder/v2/coderd/httpmw"
+	"github.com/coder/coder/v2/codersdk"
+	"github.com/coder/coder/v2/cryptorand"
+	"github.com/coder/coder/v2/site"
+)
+
+const (
+	// deviceCodeLifetime is how long a user has to enter and approve a user
+	// code. RFC 8628 leaves this to the server; fifteen minutes matches
+	// GitHub.
+	deviceCodeLifetime = 15 * time.Minute
+	// deviceCodePollingInterval is the minimum number of seconds a client
+	// must wait between token requests (RFC 8628 §3.2).
+	deviceCodePollingInterval = 5
+	// deviceCodeSlowDownIncrement is added to the polling interval every time
+	// a client polls too quickly (RFC 8628 §3.5).
+	deviceCodeSlowDownIncrement = 5
+	// userCodeCharset excludes vowels so that generated codes cannot spell
+	// words, and is case-insensitive for easy entry (RFC 8628 §6.1).
+	userCodeCharset = "BCDFGHJKLMNPQRSTVWXZ"
+	userCodeLength  = 8
+)
+
+var (
+	// errDeviceCodePending means the user has not yet acted on the code.
+	errDeviceCodePending = xerrors.New("authorization pending")
+	// errDeviceCodeSlowDown means the client polled before its interval.
+	errDeviceCodeSlowDown = xerrors.New("slow down")
+	// errDeviceCodeExpired means the code expired before it was approved.
+	errDeviceCodeExpired = xerrors.New("device code expired")
+	// errDeviceCodeDenied means the user denied the request.
+	errDeviceCodeDenied = xerrors.New("access denied")
+	// errUserCodeNotFound means the user code does not match a pending code.
+	errUserCodeNotFound = xerrors.New("user code not found")
+)
+
+// generateUserCode returns a random user code without formatting.
+func generateUserCode() (string, error) {
+	return cryptorand.StringCharset(userCodeCharset, userCodeLength)
+}
+
+// normalizeUserCode strips separators and whitespace from a user-entered code
+// and uppercases it, so "bcdf-ghjk" and "BCDFGHJK" match the same code.
+func normalizeUserCode(code string) string {
+	return strings.Map(func(r rune) rune {
+		if r == '-' || r == ' ' || r == '\t' {
+			return -1
+		}
+		if r >= 'a' && r <= 'z' {
+			return r - 'a' + 'A'
+		}
+		return r
+	}, code)
+}
+
+// formatUserCode splits a user code in half for display, e.g. "BCDF-GHJK".
+func formatUserCode(code string) string {
+	if len(code) != userCodeLength {
+		return code
+	}
+	return code[:userCodeLength/2] + "-" + code[userCodeLength/2:]
+}
+
+// DeviceAuthorization returns an http.HandlerFunc that handles POST
+// /oauth2/device/code, the device authorization endpoint (RFC 8628 §3.1).
+func DeviceAuthorization(db database.Store, accessURL *url.URL) http.HandlerFunc {
+	return func(rw http.ResponseWriter, r *http.Request) {
+		ctx := r.Context()
+		app := httpmw.OAuth2ProviderApp(r)
+
+		if err := r.ParseForm(); err != nil {
+			httpapi.WriteOAuth2Error(ctx, rw, http.StatusBadRequest, codersdk.OAuth2ErrorCodeInvalidRequest, "Failed to parse form")
+			return
+		}
+		p := httpapi.NewQueryParamParser()
+		vals := r.Form
+		_ = p.String(vals, "", "client_id")
+		clientSecret := p.String(vals, "", "client_secret")
+		resource := p.String(vals, "", "resource")
+		_ = p.String(vals, "", "scope")
+		if err := validateResourceParameter(resource); err != nil {
+			p.Errors = append(p.Errors, codersdk.ValidationError{
+				Field:  "resource",
+				Detail: "must be an absolute URI without fragment",
+			})
+		}
+		p.ErrorExcessParams(vals)
+		if len(p.Errors) > 0 {
+			httpapi.WriteOAuth2Error(ctx, rw, http.StatusBadRequest, codersdk.OAuth2ErrorCodeInvalidRequest, "The request is missing required parameters or is otherwise malformed")
+			return
+		}
+
+		// Confidential clients may authenticate here (RFC 8628 §3.1), in which
+		// case a bad secret must be rejected rather than ignored.
+		if _, pass, ok := r.BasicAuth(); ok && clientSecret == "" {
+			clientSecret = pass
+		}
+		if clientSecret != "" {
+			if _, err := validateAppSecret(ctx, db, app, clientSecret); err != nil {
+				if errors.Is(err, errBadSecret) {
+					httpapi.WriteOAuth2Error(ctx, rw, http.StatusUnauthorized, codersdk.OAuth2ErrorCodeInvalidClient, "The client credentials are invalid")
+					return
+				}
+				httpapi.WriteOAuth2Error(ctx, rw, http.StatusInternalServerError, codersdk.OAuth2ErrorCodeServerError, "Failed to validate client credentials")
+				return
+			}
+		}
+
+		deviceCode, err := GenerateSecret()
+		if err != nil {
+			httpapi.WriteOAuth2Error(ctx, rw, http.StatusInternalServerError, codersdk.OAuth2ErrorCodeServerError, "Failed to generate device code")
+			return
+		}
+
+		// User codes are short enough that a collision with an outstanding
+		// code is possible, so retry a few times before giving up.
+		var dbCode database.OAuth2ProviderAppDeviceCode
+		for attempt := 0; attempt < 5; attempt++ {
+			var userCode string
+			userCode, err = generateUserCode()
+			if err != nil {
+				break
+			}
+			//nolint:gocritic // Device codes have no owner until a user approves them.
+			dbCode, err = db.InsertOAuth2ProviderAppDeviceCode(dbauthz.AsSystemOAuth2(ctx), database.InsertOAuth2ProviderAppDeviceCodeParams{
+				ID:               uuid.New(),
+				CreatedAt:        dbtime.Now(),
+				ExpiresAt:        dbtime.Now().Add(deviceCodeLifetime),
+				DeviceCodePrefix: []byte(deviceCode.Prefix),
+				DeviceCodeHash:   deviceCode.Hashed,
+				UserCode:         userCode,
+				AppID:            app.ID,
+				ResourceUri:      sql.NullString{String: resource, Valid: resource != ""},
+				// Scope negotiation lands in a later phase, see
+				// ProcessAuthorize.
+				Scope:           string(database.ApiKeyScopeCoderAll),
+				PollingInterval: deviceCodePollingInterval,
+			})
+			if !database.IsUniqueViolation(err, database.UniqueOauth2ProviderAppDeviceCodesUserCodeKey) {
+				break
+			}
+		}
+		if err != nil {
+			httpapi.WriteOAuth2Error(ctx, rw, http.StatusInternalServerError, codersdk.OAuth2ErrorCodeServerError, "Failed to generate device code")
+			return
+		}
+
+		verificationURI := accessURL.JoinPath("/oauth2/device")
+		complete := *verificationURI
+		complete.RawQuery = url.Values{"user_code": {formatUserCode(dbCode.UserCode)}}.Encode()
+
+		httpapi.Write(ctx, rw, http.StatusOK, codersdk.OAuth2DeviceAuthorizationResponse{
+			DeviceCode:              deviceCode.Formatted,
+			UserCode:                formatUserCode(dbCode.UserCode),
+			VerificationURI:         verificationURI.String(),
+			VerificationURIComplete: complete.String(),
+			ExpiresIn:               int64(deviceCodeLifetime.Seconds()),
+			Interval:                int64(dbCode.PollingInterval),
+		})
+	}
+}
+
+// lookupPendingDeviceCode finds a pending, unexpired device code by the code
+// the user typed in, along with the app that requested it.
+func lookupPendingDeviceCode(ctx context.Context, db database.Store, userCode string) (database.OAuth2ProviderAppDeviceCode, database.OAuth2ProviderApp, error) {
+	userCode = normalizeUserCode(userCode)
+	if userCode == "" {
+		return database.OAuth2ProviderAppDeviceCode{}, database.OAuth2ProviderApp{}, errUserCodeNotFound
+	}
+	//nolint:gocritic // Pending device codes have no owner yet.
+	code, err := db.GetOAuth2ProviderAppDeviceCodeByUserCode(dbauthz.AsSystemOAuth2(ctx), userCode)
+	if errors.Is(err, sql.ErrNoRows) {
+		return database.OAuth2ProviderAppDeviceCode{}, database.OAuth2ProviderApp{}, errUserCodeNotFound
+	}
+	if err != nil {
+		return database.OAuth2ProviderAppDeviceCode{}, database.OAuth2ProviderApp{}, err
+	}
+	if code.Status != database.OAuth2DeviceCodeStatusPending || code.ExpiresAt.Before(dbtime.Now()) {
+		return database.OAuth2ProviderAppDeviceCode{}, database.OAuth2ProviderApp{}, errUserCodeNotFound
+	}
+	//nolint:gocritic // The user has not been granted anything by the app yet.
+	app, err := db.GetOAuth2ProviderAppByID(dbauthz.AsSystemOAuth2(ctx), code.AppID)
+	if err != nil {
+		return database.OAuth2ProviderAppDeviceCode{}, database.OAuth2ProviderApp{}, xerrors.Errorf("get oauth2 app: %w", err)
+	}
+	return code, app, nil
+}
+
+// ShowDevicePage returns an http.HandlerFunc that handles GET /oauth2/device,
+// the verification page where a user enters the code shown on their device
+// (RFC 8628 §3.3).
+func ShowDevicePage(db database.Store, accessURL *url.URL) http.HandlerFunc {
+	return func(rw http.ResponseWriter, r *http.Request) {
+		ctx := r.Context()
+		ua := httpmw.UserAuthorization(ctx)
+		data := site.RenderOAuthDeviceData{
+			DashboardURL: accessURL.String(),
+			CSRFToken:    nosurf.Token(r),
+			Username:     ua.FriendlyName,
+		}
+
+		rawCode := r.URL.Query().Get("user_code")
+		if rawCode == "" {
+			site.RenderOAuthDevicePage(rw, r, data)
+			return
+		}
+
+		code, app, err := lookupPendingDeviceCode(ctx, db, rawCode)
+		if errors.Is(err, errUserCodeNotFound) {
+			data.UserCode = rawCode
+			data.Error = "The code is invalid or has expired."
+			site.RenderOAuthDevicePage(rw, r, data)
+			return
+		}
+		if err != nil {
+			site.RenderStaticErrorPage(rw, r, site.ErrorPageData{
+				Status:      http.StatusInternalServerError,
+				Title:       "Internal Server Error",
+				Description: err.Error(),
+				Actions: []site.Action{
+					{
+						URL:  accessURL.String(),
+						Text: "Back to site",
+					},
+				},
+			})
+			return
+		}
+
+		data.AppName = app.Name
+		data.AppIcon = app.Icon
+		data.UserCode = formatUserCode(code.UserCode)
+		site.RenderOAuthDevicePage(rw, r, data)
+	}
+}
+
+// ProcessDevice returns an http.HandlerFunc that handles POST /oauth2/device,
+// recording the user's decision for the device code.
+func ProcessDevice(db database.Store, accessURL *url.URL) http.HandlerFunc {
+	return func(rw http.ResponseWriter, r *http.Request) {
+		ctx := r.Context()
+		apiKey := httpmw.APIKey(r)
+		ua := httpmw.UserAuthorization(ctx)
+		data := site.RenderOAuthDeviceData{
+			DashboardURL: accessURL.String(),
+			CSRFToken:    nosurf.Token(r),
+			Username:     ua.FriendlyName,
+		}
+
+		if err := r.ParseForm(); err != nil {
+			httpapi.Write(ctx, rw, http.StatusBadRequest, codersdk.Response{
+				Message: "Failed to parse form.",
+				Detail:  err.Error(),
+			})
+			return
+		}
+		rawCode := r.PostForm.Get("user_code")
+
+		code, app, err := lookupPendingDeviceCode(ctx, db, rawCode)
+		if err == nil {
+			status := database.OAuth2DeviceCodeStatusDenied
+			if r.PostForm.Get("action") == "allow" {
+				status = database.OAuth2DeviceCodeStatusApproved
+			}
+			//nolint:gocritic // Pending device codes have no owner until this update assigns one.
+			_, err = db.UpdateOAuth2ProviderAppDeviceCodeStatus(dbauthz.AsSystemOAuth2(ctx), database.UpdateOAuth2ProviderAppDeviceCodeStatusParams{
+				ID:     code.ID,
+				Status: status,
+				UserID: uuid.NullUUID{UUID: apiKey.UserID, Valid: true},
+			})
+			if errors.Is(err, sql.ErrNoRows) {
+				// Someone else acted on the code between the lookup and the
+				// update.
+				err = errUserCodeNotFound
+			}
+			if err == nil {
+				data.AppName = app.Name
+				data.AppIcon = app.Icon
+				data.Message = app.Name + " was denied access to your account. This window can now be closed."
+				if status == database.OAuth2DeviceCodeStatusApproved {
+					data.Message = app.Name + " is now authorized to access your account. Return to your device to continue."
+				}
+				site.RenderOAuthDevicePage(rw, r, data)
+				return
+			}
+		}
+		if errors.Is(err, errUserCodeNotFound) {
+			data.UserCode = rawCode
+			data.Error = "The code is invalid or has expired."
+			site.RenderOAuthDevicePage(rw, r, data)
+			return
+		}
+		httpapi.Write(ctx, rw, http.StatusInternalServerError, codersdk.Response{
+			Message: "Internal error processing device authorization.",
+			Detail:  err.Error(),
+		})
+	}
+}
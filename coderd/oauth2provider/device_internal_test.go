@@ -0,0 +1,38 @@
+package oauth2provider
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestUserCode(t *testing.T) {
+	t.Parallel()
+
+	t.Run("Generate", func(t *testing.T) {
+		t.Parallel()
+		code, err := generateUserCode()
+		require.NoError(t, err)
+		require.Len(t, code, userCodeLength)
+		for _, r := range code {
+			assert.True(t, strings.ContainsRune(userCodeCharset, r), "unexpected character %q", r)
+		}
+	})
+
+	t.Run("Format", func(t *testing.T) {
+		t.Parallel()
+		assert.Equal(t, "BCDF-GHJK", formatUserCode("BCDFGHJK"))
+		// Codes of an unexpected length are left alone.
+		assert.Equal(t, "BCD", formatUserCode("BCD"))
+	})
+
+	t.Run("Normalize", func(t *testing.T) {
+		t.Parallel()
+		for _, input := range []string{"BCDFGHJK", "BCDF-GHJK", "bcdf-ghjk", " bcdf ghjk "} {
+			assert.Equal(t, "BCDFGHJK", normalizeUserCode(input), "input %q", input)
+		}
+		assert.Empty(t, normalizeUserCode(" - "))
+	})
+}
@@ -28,12 +28,18 @@ func GetAuthorizationServerMetadata(db database.Store, accessURL *url.URL) http.
 		}
 
 		metadata := codersdk.OAuth2AuthorizationServerMetadata{
-			Issuer:                        accessURL.String(),
-			AuthorizationEndpoint:         accessURL.JoinPath("/oauth2/authorize").String(),
-			TokenEndpoint:                 accessURL.JoinPath("/oauth2/tokens").String(),
-			RevocationEndpoint:            accessURL.JoinPath("/oauth2/revoke").String(), // RFC 7009
-			ResponseTypesSupported:        []codersdk.OAuth2ProviderResponseType{codersdk.OAuth2ProviderResponseTypeCode},
-			GrantTypesSupported:           []codersdk.OAuth2ProviderGrantType{codersdk.OAuth2ProviderGrantTypeAuthorizationCode, codersdk.OAuth2ProviderGrantTypeRefreshToken},
+			Issuer:                      accessURL.String(),
+			AuthorizationEndpoint:       accessURL.JoinPath("/oauth2/authorize").String(),
+			TokenEndpoint:               accessURL.JoinPath("/oauth2/tokens").String(),
+			RevocationEndpoint:          accessURL.JoinPath("/oauth2/revoke").String(),      // RFC 7009
+			DeviceAuthorizationEndpoint: accessURL.JoinPath("/oauth2/device/code").String(), // RFC 8628
+			ResponseTypesSupported:      []codersdk.OAuth2ProviderResponseType{codersdk.OAuth2ProviderResponseTypeCode},
+			GrantTypesSupported: []codersdk.OAuth2ProviderGrantType{
+				codersdk.OAuth2ProviderGrantTypeAuthorizationCode,
+				codersdk.OAuth2ProviderGrantTypeRefreshToken,
+				codersdk.OAuth2ProviderGrantTypeClientCredentials,
+				codersdk.OAuth2ProviderGrantTypeDeviceCode,
+			},
 			CodeChallengeMethodsSupported: []codersdk.OAuth2PKCECodeChallengeMethod{codersdk.OAuth2PKCECodeChallengeMethodS256},
 			ScopesSupported:               rbac.ExternalScopeNames(),
 			// Not gated on dcrEnabled: existing clients still need to
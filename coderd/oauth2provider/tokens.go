@@ -39,6 +39,10 @@ var (
 	// errConflictingClientAuth means the client provided credentials in both the
 	// request body and HTTP Basic, but they did not match.
 	errConflictingClientAuth = xerrors.New("conflicting client authentication")
+	// errBadDeviceCode means the user provided a bad device code.
+	errBadDeviceCode = xerrors.New("Invalid device code")
+	// errUnauthorizedClient means the app is not allowed to use the grant.
+	errUnauthorizedClient = xerrors.New("unauthorized client")
 )
 
 func extractTokenRequest(r *http.Request, callbackURL *url.URL) (codersdk.OAuth2TokenRequest, []codersdk.ValidationError, error) {
@@ -58,6 +62,8 @@ func extractTokenRequest(r *http.Request, callbackURL *url.URL) (codersdk.OAuth2
 		p.RequiredNotEmpty("refresh_token")
 	case codersdk.OAuth2ProviderGrantTypeAuthorizationCode:
 		p.RequiredNotEmpty("code")
+	case codersdk.OAuth2ProviderGrantTypeDeviceCode:
+		p.RequiredNotEmpty("device_code")
 	}
 
 	req := codersdk.OAuth2TokenRequest{
@@ -67,6 +73,7 @@ func extractTokenRequest(r *http.Request, callbackURL *url.URL) (codersdk.OAuth2
 		Code:         p.String(vals, "", "code"),
 		RedirectURI:  p.String(vals, "", "redirect_uri"),
 		RefreshToken: p.String(vals, "", "refresh_token"),
+		DeviceCode:   p.String(vals, "", "device_code"),
 		CodeVerifier: p.String(vals, "", "code_verifier"),
 		Resource:     p.String(vals, "", "resource"),
 		Scope:        p.String(vals, "", "scope"),
@@ -86,6 +93,12 @@ func extractTokenRequest(r *http.Request, callbackURL *url.URL) (codersdk.OAuth2
 	}
 
 	// Grant-specific required checks that can be satisfied via HTTP Basic.
+	if req.GrantType == codersdk.OAuth2ProviderGrantTypeClientCredentials && req.ClientSecret == "" {
+		p.Errors = append(p.Errors, codersdk.ValidationError{
+			Field:  "client_secret",
+			Detail: "Parameter \"client_secret\" is required and cannot be empty",
+		})
+	}
 	if req.GrantType == codersdk.OAuth2ProviderGrantTypeAuthorizationCode {
 		if req.ClientID == "" {
 			p.Errors = append(p.Errors, codersdk.ValidationError{
@@ -162,8 +175,8 @@ func Tokens(db database.Store, lifetimes codersdk.SessionLifetime) http.HandlerF
 				return
 			}
 
-			// Check for missing required parameters for authorization_code grant
-			for _, field := range []string{"code", "client_id", "client_secret"} {
+			// Check for missing required parameters for each grant
+			for _, field := range []string{"code", "device_code", "client_id", "client_secret"} {
 				if slices.ContainsFunc(validationErrs, func(validationError codersdk.ValidationError) bool {
 					return validationError.Field == field
 				}) {
@@ -189,13 +202,15 @@ func Tokens(db database.Store, lifetimes codersdk.SessionLifetime) http.HandlerF
 		}
 
 		var token codersdk.OAuth2TokenResponse
-		//nolint:gocritic,revive // More cases will be added later.
 		switch req.GrantType {
-		// TODO: Client creds, device code.
 		case codersdk.OAuth2ProviderGrantTypeRefreshToken:
 			token, err = refreshTokenGrant(ctx, db, app, lifetimes, req)
 		case codersdk.OAuth2ProviderGrantTypeAuthorizationCode:
 			token, err = authorizationCodeGrant(ctx, db, app, lifetimes, req)
+		case codersdk.OAuth2ProviderGrantTypeDeviceCode:
+			token, err = deviceCodeGrant(ctx, db, app, lifetimes, req)
+		case codersdk.OAuth2ProviderGrantTypeClientCredentials:
+			token, err = clientCredentialsGrant(ctx, db, app, lifetimes, req)
 		default:
 			// This should handle truly invalid grant types
 			httpapi.WriteOAuth2Error(ctx, rw, http.StatusBadRequest, codersdk.OAuth2ErrorCodeUnsupportedGrantType, fmt.Sprintf("The grant type %q is not supported", req.GrantType))
@@ -222,6 +237,31 @@ func Tokens(db database.Store, lifetimes codersdk.SessionLifetime) http.HandlerF
 			httpapi.WriteOAuth2Error(ctx, rw, http.StatusBadRequest, codersdk.OAuth2ErrorCodeInvalidGrant, "The refresh token is invalid or expired")
 			return
 		}
+		if errors.Is(err, errBadDeviceCode) {
+			httpapi.WriteOAuth2Error(ctx, rw, http.StatusBadRequest, codersdk.OAuth2ErrorCodeInvalidGrant, "The device code is invalid")
+			return
+		}
+		// RFC 8628 §3.5 polling responses.
+		if errors.Is(err, errDeviceCodePending) {
+			httpapi.WriteOAuth2Error(ctx, rw, http.StatusBadRequest, codersdk.OAuth2ErrorCodeAuthorizationPending, "The user has not yet approved the request")
+			return
+		}
+		if errors.Is(err, errDeviceCodeSlowDown) {
+			httpapi.WriteOAuth2Error(ctx, rw, http.StatusBadRequest, codersdk.OAuth2ErrorCodeSlowDown, "The polling interval has been increased")
+			return
+		}
+		if errors.Is(err, errDeviceCodeDenied) {
+			httpapi.WriteOAuth2Error(ctx, rw, http.StatusBadRequest, codersdk.OAuth2ErrorCodeAccessDenied, "The user denied the request")
+			return
+		}
+		if errors.Is(err, errDeviceCodeExpired) {
+			httpapi.WriteOAuth2Error(ctx, rw, http.StatusBadRequest, codersdk.OAuth2ErrorCodeExpiredToken, "The device code has expired")
+			return
+		}
+		if errors.Is(err, errUnauthorizedClient) {
+			httpapi.WriteOAuth2Error(ctx, rw, http.StatusBadRequest, codersdk.OAuth2ErrorCodeUnauthorizedClient, "The client is not authorized to use this grant type")
+			return
+		}
 		if err != nil {
 			httpapi.Write(ctx, rw, http.StatusInternalServerError, codersdk.Response{
 				Message: "Failed to exchange token",
@@ -265,24 +305,25 @@ func revokeOAuth2CodeOnPKCEFailure(ctx context.Context, db database.Store, codeI
 	}
 }
 
-func authorizationCodeGrant(ctx context.Context, db database.Store, app database.OAuth2ProviderApp, lifetimes codersdk.SessionLifetime, req codersdk.OAuth2TokenRequest) (codersdk.OAuth2TokenResponse, error) {
-	// Validate the client secret.
-	secret, err := ParseFormattedSecret(req.ClientSecret)
+// validateAppSecret checks that clientSecret is a valid secret for app and
+// returns it, or errBadSecret.
+func validateAppSecret(ctx context.Context, db database.Store, app database.OAuth2ProviderApp, clientSecret string) (database.OAuth2ProviderAppSecret, error) {
+	secret, err := ParseFormattedSecret(clientSecret)
 	if err != nil {
-		return codersdk.OAuth2TokenResponse{}, errBadSecret
+		return database.OAuth2ProviderAppSecret{}, errBadSecret
 	}
 	//nolint:gocritic // OAuth2 system context, users cannot read secrets
 	dbSecret, err := db.GetOAuth2ProviderAppSecretByPrefix(dbauthz.AsSystemOAuth2(ctx), []byte(secret.Prefix))
 	if errors.Is(err, sql.ErrNoRows) {
-		return codersdk.OAuth2TokenResponse{}, errBadSecret
+		return database.OAuth2ProviderAppSecret{}, errBadSecret
 	}
 	if err != nil {
-		return codersdk.OAuth2TokenResponse{}, err
+		return database.OAuth2ProviderAppSecret{}, err
 	}
 
 	equalSecret := apikey.ValidateHash(dbSecret.HashedSecret, secret.Secret)
 	if !equalSecret {
-		return codersdk.OAuth2TokenResponse{}, errBadSecret
+		return database.OAuth2ProviderAppSecret{}, errBadSecret
 	}
 
 	// The secret must belong to the app identified by the request's
@@ -291,7 +332,16 @@ func authorizationCodeGrant(ctx context.Context, db database.Store, app database
 	// check, a valid secret for one app could issue a token attributed to a
 	// different app.
 	if dbSecret.AppID != app.ID {
-		return codersdk.OAuth2TokenResponse{}, errBadSecret
+		return database.OAuth2ProviderAppSecret{}, errBadSecret
+	}
+	return dbSecret, nil
+}
+
+func authorizationCodeGrant(ctx context.Context, db database.Store, app database.OAuth2ProviderApp, lifetimes codersdk.SessionLifetime, req codersdk.OAuth2TokenRequest) (codersdk.OAuth2TokenResponse, error) {
+	// Validate the client secret.
+	dbSecret, err := validateAppSecret(ctx, db, app, req.ClientSecret)
+	if err != nil {
+		return codersdk.OAuth2TokenResponse{}, err
 	}
 
 	// Validate the authorization code.
@@ -367,93 +417,18 @@ func authorizationCodeGrant(ctx context.Context, db database.Store, app database
 		return codersdk.OAuth2TokenResponse{}, errInvalidResource
 	}
 
-	// Generate a refresh token.
-	refreshToken, err := GenerateSecret()
-	if err != nil {
-		return codersdk.OAuth2TokenResponse{}, err
-	}
-
-	// Generate the API key we will swap for the code.
-	// TODO: We are ignoring scopes for now.
-	tokenName := fmt.Sprintf("%s_%s_oauth_session_token", dbCode.UserID, app.ID)
-	key, sessionToken, err := apikey.Generate(apikey.CreateParams{
-		UserID:          dbCode.UserID,
-		LoginType:       database.LoginTypeOAuth2ProviderApp,
-		DefaultLifetime: lifetimes.DefaultDuration.Value(),
-		// For now, we allow only one token per app and user at a time.
-		TokenName: tokenName,
+	return issueToken(ctx, db, app, lifetimes, issueTokenParams{
+		userID:      dbCode.UserID,
+		appSecretID: uuid.NullUUID{UUID: dbSecret.ID, Valid: true},
+		audience:    dbCode.ResourceUri,
+		scope:       dbCode.Scope,
+		consume: func(ctx context.Context, tx database.Store) error {
+			if err := tx.DeleteOAuth2ProviderAppCodeByID(ctx, dbCode.ID); err != nil {
+				return xerrors.Errorf("delete oauth2 app code: %w", err)
+			}
+			return nil
+		},
 	})
-	if err != nil {
-		return codersdk.OAuth2TokenResponse{}, err
-	}
-
-	// Grab the user roles so we can perform the exchange as the user.
-	actor, _, err := httpmw.UserRBACSubject(ctx, db, dbCode.UserID, rbac.ScopeAll)
-	if err != nil {
-		return codersdk.OAuth2TokenResponse{}, xerrors.Errorf("fetch user actor: %w", err)
-	}
-
-	// Do the actual token exchange in the database.
-	// Determine refresh token expiry independently from the access token.
-	refreshLifetime := lifetimes.RefreshDefaultDuration.Value()
-	if refreshLifetime == 0 {
-		refreshLifetime = lifetimes.DefaultDuration.Value()
-	}
-	refreshExpiresAt := dbtime.Now().Add(refreshLifetime)
-
-	err = db.InTx(func(tx database.Store) error {
-		ctx := dbauthz.As(ctx, actor)
-		err = tx.DeleteOAuth2ProviderAppCodeByID(ctx, dbCode.ID)
-		if err != nil {
-			return xerrors.Errorf("delete oauth2 app code: %w", err)
-		}
-
-		// Delete the previous key, if any.
-		prevKey, err := tx.GetAPIKeyByName(ctx, database.GetAPIKeyByNameParams{
-			UserID:    dbCode.UserID,
-			TokenName: tokenName,
-		})
-		if err == nil {
-			err = tx.DeleteAPIKeyByID(ctx, prevKey.ID)
-		}
-		if err != nil && !errors.Is(err, sql.ErrNoRows) {
-			return xerrors.Errorf("delete api key by name: %w", err)
-		}
-
-		newKey, err := tx.InsertAPIKey(ctx, key)
-		if err != nil {
-			return xerrors.Errorf("insert oauth2 access token: %w", err)
-		}
-
-		_, err = tx.InsertOAuth2ProviderAppToken(ctx, database.InsertOAuth2ProviderAppTokenParams{
-			ID:          uuid.New(),
-			CreatedAt:   dbtime.Now(),
-			ExpiresAt:   refreshExpiresAt,
-			HashPrefix:  []byte(refreshToken.Prefix),
-			RefreshHash: refreshToken.Hashed,
-			AppID:       dbCode.AppID,
-			AppSecretID: uuid.NullUUID{UUID: dbSecret.ID, Valid: true},
-			APIKeyID:    newKey.ID,
-			UserID:      dbCode.UserID,
-			Audience:    dbCode.ResourceUri,
-			Scope:       dbCode.Scope,
-		})
-		if err != nil {
-			return xerrors.Errorf("insert oauth2 refresh token: %w", err)
-		}
-		return nil
-	}, nil)
-	if err != nil {
-		return codersdk.OAuth2TokenResponse{}, err
-	}
-
-	return codersdk.OAuth2TokenResponse{
-		AccessToken:  sessionToken,
-		TokenType:    codersdk.OAuth2TokenTypeBearer,
-		RefreshToken: refreshToken.Formatted,
-		ExpiresIn:    int64(time.Until(key.ExpiresAt).Seconds()),
-		Expiry:       &key.ExpiresAt,
-	}, nil
 }
 
 func refreshTokenGrant(ctx context.Context, db database.Store, app database.OAuth2ProviderApp, lifetimes codersdk.SessionLifetime, req codersdk.OAuth2TokenRequest) (codersdk.OAuth2TokenResponse, error) {
@@ -584,6 +559,263 @@ func refreshTokenGrant(ctx context.Context, db database.Store, app database.OAut
 	}, nil
 }
 
+func deviceCodeGrant(ctx context.Context, db database.Store, app database.OAuth2ProviderApp, lifetimes codersdk.SessionLifetime, req codersdk.OAuth2TokenRequest) (codersdk.OAuth2TokenResponse, error) {
+	// Device clients are usually public, but a confidential client that
+	// sends a secret must send a valid one.
+	appSecretID := uuid.NullUUID{}
+	if req.ClientSecret != "" {
+		dbSecret, err := validateAppSecret(ctx, db, app, req.ClientSecret)
+		if err != nil {
+			return codersdk.OAuth2TokenResponse{}, err
+		}
+		appSecretID = uuid.NullUUID{UUID: dbSecret.ID, Valid: true}
+	}
+
+	// Validate the device code.
+	code, err := ParseFormattedSecret(req.DeviceCode)
+	if err != nil {
+		return codersdk.OAuth2TokenResponse{}, errBadDeviceCode
+	}
+	//nolint:gocritic // OAuth2 system context, no authenticated user during token exchange
+	dbCode, err := db.GetOAuth2ProviderAppDeviceCodeByPrefix(dbauthz.AsSystemOAuth2(ctx), []byte(code.Prefix))
+	if errors.Is(err, sql.ErrNoRows) {
+		return codersdk.OAuth2TokenResponse{}, errBadDeviceCode
+	}
+	if err != nil {
+		return codersdk.OAuth2TokenResponse{}, err
+	}
+	if !apikey.ValidateHash(dbCode.DeviceCodeHash, code.Secret) {
+		return codersdk.OAuth2TokenResponse{}, errBadDeviceCode
+	}
+	// The code must belong to the app identified by the request's client_id.
+	if dbCode.AppID != app.ID {
+		return codersdk.OAuth2TokenResponse{}, errBadDeviceCode
+	}
+
+	// Expired and denied codes are terminal, so they are deleted once the
+	// client has been told.
+	if dbCode.ExpiresAt.Before(dbtime.Now()) {
+		//nolint:gocritic // OAuth2 system context, no authenticated user during token exchange
+		if err := db.DeleteOAuth2ProviderAppDeviceCodeByID(dbauthz.AsSystemOAuth2(ctx), dbCode.ID); err != nil && !errors.Is(err, sql.ErrNoRows) {
+			return codersdk.OAuth2TokenResponse{}, xerrors.Errorf("delete expired device code: %w", err)
+		}
+		return codersdk.OAuth2TokenResponse{}, errDeviceCodeExpired
+	}
+
+	switch dbCode.Status {
+	case database.OAuth2DeviceCodeStatusPending:
+		// RFC 8628 §3.5: a client that polls faster than the interval is
+		// told to slow down, and must add five seconds to its interval.
+		now := dbtime.Now()
+		interval := dbCode.PollingInterval
+		tooFast := dbCode.LastPolledAt.Valid &&
+			now.Before(dbCode.LastPolledAt.Time.Add(time.Duration(interval)*time.Second))
+		if tooFast {
+			interval += deviceCodeSlowDownIncrement
+		}
+		//nolint:gocritic // OAuth2 system context, no authenticated user during token exchange
+		err = db.UpdateOAuth2ProviderAppDeviceCodePoll(dbauthz.AsSystemOAuth2(ctx), database.UpdateOAuth2ProviderAppDeviceCodePollParams{
+			ID:              dbCode.ID,
+			LastPolledAt:    sql.NullTime{Time: now, Valid: true},
+			PollingInterval: interval,
+		})
+		if err != nil {
+			return codersdk.OAuth2TokenResponse{}, xerrors.Errorf("update device code poll: %w", err)
+		}
+		if tooFast {
+			return codersdk.OAuth2TokenResponse{}, errDeviceCodeSlowDown
+		}
+		return codersdk.OAuth2TokenResponse{}, errDeviceCodePending
+	case database.OAuth2DeviceCodeStatusDenied:
+		//nolint:gocritic // OAuth2 system context, no authenticated user during token exchange
+		if err := db.DeleteOAuth2ProviderAppDeviceCodeByID(dbauthz.AsSystemOAuth2(ctx), dbCode.ID); err != nil && !errors.Is(err, sql.ErrNoRows) {
+			return codersdk.OAuth2TokenResponse{}, xerrors.Errorf("delete denied device code: %w", err)
+		}
+		return codersdk.OAuth2TokenResponse{}, errDeviceCodeDenied
+	case database.OAuth2DeviceCodeStatusApproved:
+	default:
+		return codersdk.OAuth2TokenResponse{}, xerrors.Errorf("unknown device code status %q", dbCode.Status)
+	}
+	userID := dbCode.UserID.UUID
+
+	// Verify resource parameter consistency (RFC 8707), as for codes.
+	if req.Resource != "" && (!dbCode.ResourceUri.Valid || req.Resource != dbCode.ResourceUri.String) {
+		return codersdk.OAuth2TokenResponse{}, errInvalidResource
+	}
+
+	return issueToken(ctx, db, app, lifetimes, issueTokenParams{
+		userID:      userID,
+		appSecretID: appSecretID,
+		audience:    dbCode.ResourceUri,
+		scope:       dbCode.Scope,
+		consume: func(ctx context.Context, tx database.Store) error {
+			// Deleting the code first makes it single-use: a concurrent
+			// exchange of the same code finds no row and fails.
+			err := tx.DeleteOAuth2ProviderAppDeviceCodeByID(ctx, dbCode.ID)
+			if errors.Is(err, sql.ErrNoRows) {
+				return errBadDeviceCode
+			}
+			if err != nil {
+				return xerrors.Errorf("delete oauth2 device code: %w", err)
+			}
+			return nil
+		},
+	})
+}
+
+func clientCredentialsGrant(ctx context.Context, db database.Store, app database.OAuth2ProviderApp, lifetimes codersdk.SessionLifetime, req codersdk.OAuth2TokenRequest) (codersdk.OAuth2TokenResponse, error) {
+	// The client_credentials grant is for confidential clients only
+	// (RFC 6749 §4.4).
+	dbSecret, err := validateAppSecret(ctx, db, app, req.ClientSecret)
+	if err != nil {
+		return codersdk.OAuth2TokenResponse{}, err
+	}
+
+	// Tokens act as the service account an administrator configured for
+	// the app. Without one, the app cannot use this grant.
+	if !app.ClientCredentialsUserID.Valid {
+		return codersdk.OAuth2TokenResponse{}, errUnauthorizedClient
+	}
+	userID := app.ClientCredentialsUserID.UUID
+
+	//nolint:gocritic // OAuth2 system context, no authenticated user during token exchange
+	user, err := db.GetUserByID(dbauthz.AsSystemOAuth2(ctx), userID)
+	if err != nil {
+		return codersdk.OAuth2TokenResponse{}, xerrors.Errorf("get client credentials user: %w", err)
+	}
+	if user.Deleted || user.Status == database.UserStatusSuspended || !user.IsServiceAccount {
+		return codersdk.OAuth2TokenResponse{}, errUnauthorizedClient
+	}
+
+	if err := validateResourceParameter(req.Resource); err != nil {
+		return codersdk.OAuth2TokenResponse{}, errInvalidResource
+	}
+
+	return issueToken(ctx, db, app, lifetimes, issueTokenParams{
+		userID:      userID,
+		appSecretID: uuid.NullUUID{UUID: dbSecret.ID, Valid: true},
+		audience:    sql.NullString{String: req.Resource, Valid: req.Resource != ""},
+		scope:       string(database.ApiKeyScopeCoderAll),
+		// RFC 6749 §4.4.3 says a refresh token should not be issued, since
+		// the client can always request a new token.
+		withoutRefreshToken: true,
+	})
+}
+
+// issueTokenParams describes the tokens a grant issues.
+type issueTokenParams struct {
+	userID      uuid.UUID
+	appSecretID uuid.NullUUID
+	audience    sql.NullString
+	scope       string
+	// withoutRefreshToken leaves the refresh token out of the response. The
+	// token row is still stored, with a secret that is never handed out,
+	// because that row is what ties the API key to the app for revocation.
+	withoutRefreshToken bool
+	// consume runs as the user in the transaction that issues the tokens.
+	// Grants use it to make their code single-use.
+	consume func(ctx context.Context, tx database.Store) error
+}
+
+// issueToken generates an API key and a refresh token for the user, and
+// replaces any previous API key the user has for the app.
+func issueToken(ctx context.Context, db database.Store, app database.OAuth2ProviderApp, lifetimes codersdk.SessionLifetime, p issueTokenParams) (codersdk.OAuth2TokenResponse, error) {
+	// Generate a refresh token.
+	refreshToken, err := GenerateSecret()
+	if err != nil {
+		return codersdk.OAuth2TokenResponse{}, err
+	}
+
+	// Generate the API key we will swap for the grant.
+	// TODO: We are ignoring scopes for now.
+	tokenName := fmt.Sprintf("%s_%s_oauth_session_token", p.userID, app.ID)
+	key, sessionToken, err := apikey.Generate(apikey.CreateParams{
+		UserID:          p.userID,
+		LoginType:       database.LoginTypeOAuth2ProviderApp,
+		DefaultLifetime: lifetimes.DefaultDuration.Value(),
+		// For now, we allow only one token per app and user at a time.
+		TokenName: tokenName,
+	})
+	if err != nil {
+		return codersdk.OAuth2TokenResponse{}, err
+	}
+
+	// Grab the user roles so we can perform the exchange as the user.
+	actor, _, err := httpmw.UserRBACSubject(ctx, db, p.userID, rbac.ScopeAll)
+	if err != nil {
+		return codersdk.OAuth2TokenResponse{}, xerrors.Errorf("fetch user actor: %w", err)
+	}
+
+	// Determine refresh token expiry independently from the access token.
+	refreshExpiresAt := key.ExpiresAt
+	if !p.withoutRefreshToken {
+		refreshLifetime := lifetimes.RefreshDefaultDuration.Value()
+		if refreshLifetime == 0 {
+			refreshLifetime = lifetimes.DefaultDuration.Value()
+		}
+		refreshExpiresAt = dbtime.Now().Add(refreshLifetime)
+	}
+
+	// Do the actual token exchange in the database.
+	err = db.InTx(func(tx database.Store) error {
+		ctx := dbauthz.As(ctx, actor)
+		if p.consume != nil {
+			if err := p.consume(ctx, tx); err != nil {
+				return err
+			}
+		}
+
+		// Delete the previous key, if any.
+		prevKey, err := tx.GetAPIKeyByName(ctx, database.GetAPIKeyByNameParams{
+			UserID:    p.userID,
+			TokenName: tokenName,
+		})
+		if err == nil {
+			err = tx.DeleteAPIKeyByID(ctx, prevKey.ID)
+		}
+		if err != nil && !errors.Is(err, sql.ErrNoRows) {
+			return xerrors.Errorf("delete api key by name: %w", err)
+		}
+
+		newKey, err := tx.InsertAPIKey(ctx, key)
+		if err != nil {
+			return xerrors.Errorf("insert oauth2 access token: %w", err)
+		}
+
+		_, err = tx.InsertOAuth2ProviderAppToken(ctx, database.InsertOAuth2ProviderAppTokenParams{
+			ID:          uuid.New(),
+			CreatedAt:   dbtime.Now(),
+			ExpiresAt:   refreshExpiresAt,
+			HashPrefix:  []byte(refreshToken.Prefix),
+			RefreshHash: refreshToken.Hashed,
+			AppID:       app.ID,
+			AppSecretID: p.appSecretID,
+			APIKeyID:    newKey.ID,
+			UserID:      p.userID,
+			Audience:    p.audience,
+			Scope:       p.scope,
+		})
+		if err != nil {
+			return xerrors.Errorf("insert oauth2 refresh token: %w", err)
+		}
+		return nil
+	}, nil)
+	if err != nil {
+		return codersdk.OAuth2TokenResponse{}, err
+	}
+
+	resp := codersdk.OAuth2TokenResponse{
+		AccessToken: sessionToken,
+		TokenType:   codersdk.OAuth2TokenTypeBearer,
+		ExpiresIn:   int64(time.Until(key.ExpiresAt).Seconds()),
+		Expiry:      &key.ExpiresAt,
+	}
+	if !p.withoutRefreshToken {
+		resp.RefreshToken = refreshToken.Formatted
+	}
+	return resp, nil
+}
+
 // validateResourceParameter validates that a resource parameter conforms to RFC 8707:
 // must be an absolute URI without fragment component.
 func validateResourceParameter(resource string) error {
@@ -39,6 +39,9 @@ const (
 	// orchestrations once their parent build reaches a terminal state.
 	ServiceWorkspaceBuildOrchestrator = "workspace-build-orchestrator"
 	ServiceUsageEventGenerator        = "usage-event-generator"
+	// ServiceWorkspaceBulkOperations applies workspace bulk operations to
+	// their workspaces.
+	ServiceWorkspaceBulkOperations = "workspace-bulk-operations"
 
 	RequestTypeTag = "coder_request_type"
 )
@@ -4,6 +4,7 @@ import (
 	"database/sql"
 	"net/http"
 
+	"github.com/coder/coder/v2/coderd/database"
 	"github.com/coder/coder/v2/coderd/httpapi"
 	"github.com/coder/coder/v2/coderd/httpmw"
 	"github.com/coder/coder/v2/codersdk"
@@ -39,6 +40,11 @@ func (api *API) templateVersionPresets(rw http.ResponseWriter, r *http.Request)
 		return
 	}
 
+	res := convertPresets(presets, presetParams)
+	httpapi.Write(ctx, rw, http.StatusOK, res)
+}
+
+func convertPresets(presets []database.TemplateVersionPreset, presetParams []database.TemplateVersionPresetParameter) []codersdk.Preset {
 	convertPrebuildInstances := func(desiredInstances sql.NullInt32) *int {
 		if desiredInstances.Valid {
 			value := int(desiredInstances.Int32)
@@ -69,6 +75,5 @@ func (api *API) templateVersionPresets(rw http.ResponseWriter, r *http.Request)
 		}
 		res = append(res, sdkPreset)
 	}
-
-	httpapi.Write(ctx, rw, http.StatusOK, res)
+	return res
 }
@@ -77,6 +77,7 @@ const (
 
 type Options struct {
 	OIDCConfig          promoauth.OAuth2Config
+	OIDCProviders       map[string]promoauth.OAuth2Config
 	ExternalAuthConfigs []*externalauth.Config
 	AISeatTracker       aiseats.SeatTracker
 
@@ -136,7 +137,8 @@ type server struct {
 	AISeatTracker               aiseats.SeatTracker
 	Experiments                 codersdk.Experiments
 
-	OIDCConfig promoauth.OAuth2Config
+	OIDCConfig    promoauth.OAuth2Config
+	OIDCProviders map[string]promoauth.OAuth2Config
 
 	Clock quartz.Clock
 
@@ -294,6 +296,7 @@ func NewServer(
 		UserQuietHoursScheduleStore: userQuietHoursScheduleStore,
 		DeploymentValues:            deploymentValues,
 		OIDCConfig:                  options.OIDCConfig,
+		OIDCProviders:               options.OIDCProviders,
 		Clock:                       options.Clock,
 		acquireJobLongPollDur:       options.AcquireJobLongPollDur,
 		heartbeatInterval:           options.HeartbeatInterval,
@@ -734,8 +737,8 @@ func (s *server) acquireProtoJob(ctx context.Context, job database.ProvisionerJo
 		var workspaceOwnerOIDCAccessToken string
 		// The check `s.OIDCConfig != nil` is not as strict, since it can be an interface
 		// pointing to a typed nil.
-		if !reflect.ValueOf(s.OIDCConfig).IsNil() {
-			workspaceOwnerOIDCAccessToken, err = ObtainOIDCAccessToken(ctx, s.Logger, s.Database, s.OIDCConfig, owner.ID)
+		if len(s.OIDCProviders) > 0 || !reflect.ValueOf(s.OIDCConfig).IsNil() {
+			workspaceOwnerOIDCAccessToken, err = ObtainOIDCAccessToken(ctx, s.Logger, s.Database, s.OIDCConfig, s.OIDCProviders, owner.ID)
 			if err != nil {
 				return nil, failJob(fmt.Sprintf("obtain OIDC access token: %s", err))
 			}
@@ -809,47 +812,9 @@ func (s *server) acquireProtoJob(ctx context.Context, job database.ProvisionerJo
 			return nil, xerrors.Errorf("failed to deserialize external_auth_providers value: %w", err)
 		}
 
-		externalAuthProviders := make([]*sdkproto.ExternalAuthProvider, 0, len(dbExternalAuthProviders))
-		for _, p := range dbExternalAuthProviders {
-			link, err := s.Database.GetExternalAuthLink(ctx, database.GetExternalAuthLinkParams{
-				ProviderID: p.ID,
-				UserID:     owner.ID,
-			})
-			if errors.Is(err, sql.ErrNoRows) {
-				continue
-			}
-			if err != nil {
-				return nil, failJob(fmt.Sprintf("acquire external auth link: %s", err))
-			}
-			var config *externalauth.Config
-			for _, c := range s.ExternalAuthConfigs {
-				if c.ID != p.ID {
-					continue
-				}
-				config = c
-				break
-			}
-			// We weren't able to find a matching config for the ID!
-			if config == nil {
-				s.Logger.Warn(ctx, "workspace build job is missing external auth provider",
-					slog.F("provider_id", p.ID),
-					slog.F("template_version_id", templateVersion.ID),
-					slog.F("workspace_id", workspaceBuild.WorkspaceID))
-				continue
-			}
-
-			refreshed, err := config.RefreshToken(ctx, s.Database, link)
-			if err != nil && !externalauth.IsInvalidTokenError(err) {
-				return nil, failJob(fmt.Sprintf("refresh external auth link %q: %s", p.ID, err))
-			}
-			if err != nil {
-				// Invalid tokens are skipped
-				continue
-			}
-			externalAuthProviders = append(externalAuthProviders, &sdkproto.ExternalAuthProvider{
-				Id:          p.ID,
-				AccessToken: refreshed.OAuthAccessToken,
-			})
+		externalAuthProviders, err := s.ownerExternalAuthProviders(ctx, dbExternalAuthProviders, owner.ID, templateVersion.ID, workspace.ID)
+		if err != nil {
+			return nil, failJob(err.Error())
 		}
 
 		allUserRoles, err := s.Database.GetAuthorizationUserRoles(ctx, owner.ID)
@@ -940,6 +905,131 @@ func (s *server) acquireProtoJob(ctx context.Context, job database.ProvisionerJo
 				LogLevel: input.LogLevel,
 			},
 		}
+	case database.ProvisionerJobTypeWorkspaceDriftCheck:
+		var input WorkspaceDriftCheckJob
+		err = json.Unmarshal(job.Input, &input)
+		if err != nil {
+			return nil, failJob(fmt.Sprintf("unmarshal job input %q: %s", job.Input, err))
+		}
+		workspaceBuild, err := s.Database.GetWorkspaceBuildByID(ctx, input.WorkspaceBuildID)
+		if err != nil {
+			return nil, failJob(fmt.Sprintf("get workspace build: %s", err))
+		}
+		// Checks are queued at the lowest priority, so the workspace may have
+		// been stopped or rebuilt while this one waited. Refreshing the state
+		// of a replaced build would report drift that does not exist.
+		latestBuild, err := s.Database.GetLatestWorkspaceBuildByWorkspaceID(ctx, workspaceBuild.WorkspaceID)
+		if err != nil {
+			return nil, failJob(fmt.Sprintf("get latest workspace build: %s", err))
+		}
+		if latestBuild.ID != workspaceBuild.ID {
+			return nil, failJob(fmt.Sprintf("workspace build %s was replaced by build %s, skipping drift check", workspaceBuild.ID, latestBuild.ID))
+		}
+		workspace, err := s.Database.GetWorkspaceByID(ctx, workspaceBuild.WorkspaceID)
+		if err != nil {
+			return nil, failJob(fmt.Sprintf("get workspace: %s", err))
+		}
+		templateVersion, err := s.Database.GetTemplateVersionByID(ctx, workspaceBuild.TemplateVersionID)
+		if err != nil {
+			return nil, failJob(fmt.Sprintf("get template version: %s", err))
+		}
+		templateVariables, err := s.Database.GetTemplateVersionVariables(ctx, templateVersion.ID)
+		if err != nil && !xerrors.Is(err, sql.ErrNoRows) {
+			return nil, failJob(fmt.Sprintf("get template version variables: %s", err))
+		}
+		template, err := s.Database.GetTemplateByID(ctx, templateVersion.TemplateID.UUID)
+		if err != nil {
+			return nil, failJob(fmt.Sprintf("get template: %s", err))
+		}
+		owner, err := s.Database.GetUserByID(ctx, workspace.OwnerID)
+		if err != nil {
+			return nil, failJob(fmt.Sprintf("get owner: %s", err))
+		}
+
+		versionModulesFile := ""
+		if !template.DisableModuleCache {
+			tfvals, err := s.Database.GetTemplateVersionTerraformValues(ctx, templateVersion.ID)
+			if err != nil && !xerrors.Is(err, sql.ErrNoRows) {
+				return nil, failJob(fmt.Sprintf("get template version terraform values: %s", err))
+			}
+			if err == nil && tfvals.CachedModuleFiles.Valid {
+				versionModulesFile = tfvals.CachedModuleFiles.UUID.String()
+			}
+		}
+
+		ownerGroups, err := s.Database.GetGroups(ctx, database.GetGroupsParams{
+			HasMemberID:    owner.ID,
+			OrganizationID: s.OrganizationID,
+		})
+		if err != nil {
+			return nil, failJob(fmt.Sprintf("get owner group names: %s", err))
+		}
+		ownerGroupNames := []string{}
+		for _, group := range ownerGroups {
+			ownerGroupNames = append(ownerGroupNames, group.Group.Name)
+		}
+
+		var workspaceOwnerOIDCAccessToken string
+		if len(s.OIDCProviders) > 0 || !reflect.ValueOf(s.OIDCConfig).IsNil() {
+			workspaceOwnerOIDCAccessToken, err = ObtainOIDCAccessToken(ctx, s.Logger, s.Database, s.OIDCConfig, s.OIDCProviders, owner.ID)
+			if err != nil {
+				return nil, failJob(fmt.Sprintf("obtain OIDC access token: %s", err))
+			}
+		}
+
+		workspaceBuildParameters, err := s.Database.GetWorkspaceBuildParameters(ctx, workspaceBuild.ID)
+		if err != nil {
+			return nil, failJob(fmt.Sprintf("get workspace build parameters: %s", err))
+		}
+
+		dbExternalAuthProviders := []database.ExternalAuthProvider{}
+		err = json.Unmarshal(templateVersion.ExternalAuthProviders, &dbExternalAuthProviders)
+		if err != nil {
+			return nil, failJob(fmt.Sprintf("deserialize external_auth_providers value: %s", err))
+		}
+		externalAuthProviders, err := s.ownerExternalAuthProviders(ctx, dbExternalAuthProviders, owner.ID, templateVersion.ID, workspace.ID)
+		if err != nil {
+			return nil, failJob(err.Error())
+		}
+
+		provisionerStateRow, err := s.Database.GetWorkspaceBuildProvisionerStateByID(ctx, workspaceBuild.ID)
+		if err != nil {
+			return nil, failJob(fmt.Sprintf("get workspace build provisioner state: %s", err))
+		}
+
+		// The check only refreshes the state of the running workspace, so it
+		// is planned as a start. The owner's session token is deliberately
+		// left out: regenerating it would invalidate the token the running
+		// workspace is using.
+		protoJob.Type = &proto.AcquiredJob_WorkspaceDriftCheck_{
+			WorkspaceDriftCheck: &proto.AcquiredJob_WorkspaceDriftCheck{
+				WorkspaceBuildId:      workspaceBuild.ID.String(),
+				State:                 provisionerStateRow.ProvisionerState,
+				RichParameterValues:   convertRichParameterValues(workspaceBuildParameters),
+				VariableValues:        asVariableValues(templateVariables),
+				ExternalAuthProviders: externalAuthProviders,
+				Metadata: &sdkproto.Metadata{
+					CoderUrl:                      s.AccessURL.String(),
+					WorkspaceTransition:           sdkproto.WorkspaceTransition_START,
+					WorkspaceName:                 workspace.Name,
+					WorkspaceOwner:                owner.Username,
+					WorkspaceOwnerEmail:           owner.Email,
+					WorkspaceOwnerName:            owner.Name,
+					WorkspaceOwnerGroups:          ownerGroupNames,
+					WorkspaceOwnerOidcAccessToken: workspaceOwnerOIDCAccessToken,
+					WorkspaceId:                   workspace.ID.String(),
+					WorkspaceOwnerId:              owner.ID.String(),
+					TemplateId:                    template.ID.String(),
+					TemplateName:                  template.Name,
+					TemplateVersionId:             templateVersion.ID.String(),
+					TemplateVersion:               templateVersion.Name,
+					WorkspaceBuildId:              workspaceBuild.ID.String(),
+					WorkspaceOwnerLoginType:       string(owner.LoginType),
+					TemplateVersionModulesFile:    versionModulesFile,
+				},
+				LogLevel: input.LogLevel,
+			},
+		}
 	case database.ProvisionerJobTypeTemplateVersionDryRun:
 		var input TemplateVersionDryRunJob
 		err = json.Unmarshal(job.Input, &input)
@@ -1027,6 +1117,55 @@ func (s *server) acquireProtoJob(ctx context.Context, job database.ProvisionerJo
 	return protoJob, err
 }
 
+// ownerExternalAuthProviders returns fresh access tokens for the external auth
+// providers a template version requires. Providers the owner has not linked,
+// or whose tokens can no longer be refreshed, are skipped.
+func (s *server) ownerExternalAuthProviders(ctx context.Context, dbExternalAuthProviders []database.ExternalAuthProvider, ownerID, templateVersionID, workspaceID uuid.UUID) ([]*sdkproto.ExternalAuthProvider, error) {
+	externalAuthProviders := make([]*sdkproto.ExternalAuthProvider, 0, len(dbExternalAuthProviders))
+	for _, p := range dbExternalAuthProviders {
+		link, err := s.Database.GetExternalAuthLink(ctx, database.GetExternalAuthLinkParams{
+			ProviderID: p.ID,
+			UserID:     ownerID,
+		})
+		if errors.Is(err, sql.ErrNoRows) {
+			continue
+		}
+		if err != nil {
+			return nil, xerrors.Errorf("acquire external auth link: %w", err)
+		}
+		var config *externalauth.Config
+		for _, c := range s.ExternalAuthConfigs {
+			if c.ID != p.ID {
+				continue
+			}
+			config = c
+			break
+		}
+		// We weren't able to find a matching config for the ID!
+		if config == nil {
+			s.Logger.Warn(ctx, "workspace build job is missing external auth provider",
+				slog.F("provider_id", p.ID),
+				slog.F("template_version_id", templateVersionID),
+				slog.F("workspace_id", workspaceID))
+			continue
+		}
+
+		refreshed, err := config.RefreshToken(ctx, s.Database, link)
+		if err != nil && !externalauth.IsInvalidTokenError(err) {
+			return nil, xerrors.Errorf("refresh external auth link %q: %w", p.ID, err)
+		}
+		if err != nil {
+			// Invalid tokens are skipped
+			continue
+		}
+		externalAuthProviders = append(externalAuthProviders, &sdkproto.ExternalAuthProvider{
+			Id:          p.ID,
+			AccessToken: refreshed.OAuthAccessToken,
+		})
+	}
+	return externalAuthProviders, nil
+}
+
 func (s *server) includeLastVariableValues(ctx context.Context, templateVersionID uuid.UUID, userVariableValues []codersdk.VariableValue) ([]codersdk.VariableValue, error) {
 	var values []codersdk.VariableValue
 	values = append(values, userVariableValues...)
@@ -1483,6 +1622,9 @@ func (s *server) FailJob(ctx context.Context, failJob *proto.FailedJob) (*proto.
 			}
 		}
 	case *proto.FailedJob_TemplateImport_:
+	case *proto.FailedJob_WorkspaceDriftCheck_:
+		// A failed check leaves the drift check unchecked, so the
+		// workspace is picked up again on the next run.
 	}
 
 	// if failed job is a workspace build, audit the outcome
@@ -1848,6 +1990,11 @@ func (s *server) CompleteJob(ctx context.Context, completed *proto.CompletedJob)
 		if err != nil {
 			return nil, err
 		}
+	case *proto.CompletedJob_WorkspaceDriftCheck_:
+		err = s.completeWorkspaceDriftCheckJob(ctx, jobID, jobType)
+		if err != nil {
+			return nil, err
+		}
 	default:
 		if completed.Type == nil {
 			return nil, xerrors.Errorf("type payload must be provided")
@@ -2216,7 +2363,12 @@ func (s *server) completeWorkspaceBuildJob(ctx context.Context, job database.Pro
 					return xerrors.Errorf("get template schedule options: %w", err)
 				}
 
-				nextStartAt, err := schedule.NextAllowedAutostart(now, workspace.AutostartSchedule.String, templateScheduleOptions)
+				exceptions, err := schedule.GetAutostartExceptions(ctx, db, workspace.ID, now)
+				if err != nil {
+					return xerrors.Errorf("get autostart exceptions: %w", err)
+				}
+
+				nextStartAt, err := schedule.NextAllowedAutostartWithExceptions(now, workspace.AutostartSchedule.String, templateScheduleOptions, exceptions)
 				if err == nil {
 					err = db.UpdateWorkspaceNextStartAt(ctx, database.UpdateWorkspaceNextStartAtParams{
 						ID:          workspace.ID,
@@ -2832,6 +2984,173 @@ func (s *server) completeTemplateDryRunJob(ctx context.Context, job database.Pro
 	}, nil) // End of transaction
 }
 
+// completeWorkspaceDriftCheckJob records the result of a drift check and
+// notifies the workspace owner and template admins if the workspace drifted.
+// The result is discarded if the checked build was replaced while the check
+// ran.
+func (s *server) completeWorkspaceDriftCheckJob(ctx context.Context, jobID uuid.UUID, jobType *proto.CompletedJob_WorkspaceDriftCheck_) error {
+	resources := make([]codersdk.WorkspaceResourceDrift, 0, len(jobType.WorkspaceDriftCheck.ResourceDrift))
+	for _, drift := range jobType.WorkspaceDriftCheck.ResourceDrift {
+		resources = append(resources, codersdk.WorkspaceResourceDrift{
+			Address:    drift.Address,
+			Type:       drift.Type,
+			Name:       drift.Name,
+			Action:     drift.Action,
+			Attributes: drift.Attributes,
+		})
+	}
+	resourceDrift, err := json.Marshal(resources)
+	if err != nil {
+		return xerrors.Errorf("marshal resource drift: %w", err)
+	}
+
+	var (
+		check database.WorkspaceDriftCheck
+		stale bool
+	)
+	err = s.Database.InTx(func(db database.Store) error {
+		now := s.timeNow()
+
+		check, err = db.GetWorkspaceDriftCheckByJobID(ctx, jobID)
+		if err != nil {
+			return xerrors.Errorf("get workspace drift check: %w", err)
+		}
+		latestBuild, err := db.GetLatestWorkspaceBuildByWorkspaceID(ctx, check.WorkspaceID)
+		if err != nil {
+			return xerrors.Errorf("get latest workspace build: %w", err)
+		}
+		// The check is left unchecked, so it is never reported.
+		stale = latestBuild.ID != check.WorkspaceBuildID
+		if !stale {
+			err = db.UpdateWorkspaceDriftCheckByID(ctx, database.UpdateWorkspaceDriftCheckByIDParams{
+				ID: check.ID,
+				CheckedAt: sql.NullTime{
+					Time:  now,
+					Valid: true,
+				},
+				Drifted:       len(resources) > 0,
+				ResourceDrift: resourceDrift,
+			})
+			if err != nil {
+				return xerrors.Errorf("update workspace drift check: %w", err)
+			}
+		}
+
+		err = db.UpdateProvisionerJobWithCompleteByID(ctx, database.UpdateProvisionerJobWithCompleteByIDParams{
+			ID:        jobID,
+			UpdatedAt: now,
+			CompletedAt: sql.NullTime{
+				Time:  now,
+				Valid: true,
+			},
+			Error:     sql.NullString{},
+			ErrorCode: sql.NullString{},
+		})
+		if err != nil {
+			return xerrors.Errorf("update provisioner job: %w", err)
+		}
+		s.Logger.Debug(ctx, "marked workspace drift check job as completed", slog.F("job_id", jobID))
+
+		return nil
+	}, nil)
+	if err != nil {
+		return err
+	}
+
+	if stale {
+		s.Logger.Debug(ctx, "discarded workspace drift check of a replaced build",
+			slog.F("job_id", jobID), slog.F("workspace_build_id", check.WorkspaceBuildID))
+		return nil
+	}
+	if len(resources) == 0 {
+		return nil
+	}
+
+	workspace, err := s.Database.GetWorkspaceByID(ctx, check.WorkspaceID)
+	if err != nil {
+		return xerrors.Errorf("get workspace: %w", err)
+	}
+	s.notifyWorkspaceDriftDetected(ctx, workspace, resources)
+
+	msg, err := json.Marshal(wspubsub.WorkspaceEvent{
+		Kind:        wspubsub.WorkspaceEventKindStateChange,
+		WorkspaceID: workspace.ID,
+	})
+	if err != nil {
+		return xerrors.Errorf("marshal workspace update event: %s", err)
+	}
+	err = s.Pubsub.Publish(wspubsub.WorkspaceEventChannel(workspace.OwnerID), msg)
+	if err != nil {
+		return xerrors.Errorf("publish workspace update: %w", err)
+	}
+	return nil
+}
+
+// notifyWorkspaceDriftDetected notifies the workspace owner and the template
+// admins of the workspace's organization about drifted resources.
+func (s *server) notifyWorkspaceDriftDetected(ctx context.Context, workspace database.Workspace, resources []codersdk.WorkspaceResourceDrift) {
+	template, err := s.Database.GetTemplateByID(ctx, workspace.TemplateID)
+	if err != nil {
+		s.Logger.Error(ctx, "unable to fetch template for drift notification", slog.Error(err))
+		return
+	}
+	templateNameLabel := template.DisplayName
+	if templateNameLabel == "" {
+		templateNameLabel = template.Name
+	}
+
+	templateAdmins, err := s.Database.GetUsers(ctx, database.GetUsersParams{
+		RbacRole: []string{codersdk.RoleTemplateAdmin},
+	})
+	if err != nil {
+		s.Logger.Error(ctx, "unable to fetch template admins for drift notification", slog.Error(err))
+		return
+	}
+
+	recipients := []uuid.UUID{workspace.OwnerID}
+	if len(templateAdmins) > 0 {
+		userIDs := make([]uuid.UUID, 0, len(templateAdmins))
+		for _, user := range templateAdmins {
+			userIDs = append(userIDs, user.ID)
+		}
+		orgIDsByMemberIDs, err := s.Database.GetOrganizationIDsByMemberIDs(ctx, userIDs)
+		if err != nil {
+			s.Logger.Error(ctx, "unable to fetch organization IDs by member IDs for drift notification", slog.Error(err))
+			return
+		}
+		for _, entry := range orgIDsByMemberIDs {
+			if slices.Contains(entry.OrganizationIDs, workspace.OrganizationID) && !slices.Contains(recipients, entry.UserID) {
+				recipients = append(recipients, entry.UserID)
+			}
+		}
+	}
+
+	data := make([]map[string]any, 0, len(resources))
+	for _, resource := range resources {
+		data = append(data, map[string]any{
+			"address": resource.Address,
+			"action":  resource.Action,
+		})
+	}
+
+	for _, recipient := range recipients {
+		if _, err := s.NotificationsEnqueuer.EnqueueWithData(ctx, recipient, notifications.TemplateWorkspaceDriftDetected,
+			map[string]string{
+				"workspace":                workspace.Name,
+				"workspace_owner_username": workspace.OwnerUsername,
+				"template_name":            templateNameLabel,
+			},
+			map[string]any{
+				"resources": data,
+			}, "provisionerdserver",
+			// Associate this notification with all the related entities.
+			workspace.ID, workspace.OwnerID, workspace.TemplateID, workspace.OrganizationID,
+		); err != nil {
+			s.Logger.Warn(ctx, "failed to notify of workspace drift", slog.Error(err))
+		}
+	}
+}
+
 func (s *server) notifyWorkspaceDeleted(ctx context.Context, workspace database.Workspace, build database.WorkspaceBuild) {
 	var reason string
 	initiator := build.InitiatorByUsername
@@ -3191,6 +3510,52 @@ func InsertWorkspaceResource(ctx context.Context, db database.Store, jobID uuid.
 					return xerrors.Errorf("failed to insert agent volume resource monitor into db: %w", err)
 				}
 			}
+			if cpu := prAgent.ResourcesMonitoring.Cpu; cpu != nil {
+				_, err = db.InsertResourceMonitor(ctx, database.InsertResourceMonitorParams{
+					AgentID:        agentID,
+					Type:           database.WorkspaceAgentResourceMonitorTypeCpu,
+					Enabled:        cpu.Enabled,
+					Threshold:      cpu.Threshold,
+					State:          database.WorkspaceAgentMonitorStateOK,
+					CreatedAt:      dbtime.Now(),
+					UpdatedAt:      dbtime.Now(),
+					DebouncedUntil: time.Time{},
+				})
+				if err != nil {
+					return xerrors.Errorf("failed to insert agent cpu resource monitor into db: %w", err)
+				}
+			}
+			if processes := prAgent.ResourcesMonitoring.Processes; processes != nil {
+				_, err = db.InsertResourceMonitor(ctx, database.InsertResourceMonitorParams{
+					AgentID:        agentID,
+					Type:           database.WorkspaceAgentResourceMonitorTypeProcesses,
+					Enabled:        processes.Enabled,
+					Threshold:      processes.Threshold,
+					State:          database.WorkspaceAgentMonitorStateOK,
+					CreatedAt:      dbtime.Now(),
+					UpdatedAt:      dbtime.Now(),
+					DebouncedUntil: time.Time{},
+				})
+				if err != nil {
+					return xerrors.Errorf("failed to insert agent processes resource monitor into db: %w", err)
+				}
+			}
+			for _, inode := range prAgent.ResourcesMonitoring.Inodes {
+				_, err = db.InsertResourceMonitor(ctx, database.InsertResourceMonitorParams{
+					AgentID:        agentID,
+					Type:           database.WorkspaceAgentResourceMonitorTypeInodes,
+					Path:           inode.Path,
+					Enabled:        inode.Enabled,
+					Threshold:      inode.Threshold,
+					State:          database.WorkspaceAgentMonitorStateOK,
+					CreatedAt:      dbtime.Now(),
+					UpdatedAt:      dbtime.Now(),
+					DebouncedUntil: time.Time{},
+				})
+				if err != nil {
+					return xerrors.Errorf("failed to insert agent inodes resource monitor into db: %w", err)
+				}
+			}
 		}
 
 		scriptsParams := agentScriptsFromProto(prAgent.Scripts)
@@ -3260,6 +3625,10 @@ func InsertWorkspaceResource(ctx context.Context, db database.Store, jobID uuid.
 			return xerrors.Errorf("insert agent scripts and log sources: %w", err)
 		}
 
+		if err := insertAgentLogTails(ctx, db, agentID, prAgent.GetLogTails()); err != nil {
+			return xerrors.Errorf("insert agent log tails: %w", err)
+		}
+
 		for _, app := range prAgent.Apps {
 			if err := insertAgentApp(ctx, db, dbAgent.ID, app, appSlugs, snapshot); err != nil {
 				return xerrors.Errorf("insert agent app: %w", err)
@@ -3389,7 +3758,7 @@ func shouldRefreshOIDCToken(link database.UserLink) (bool, time.Time) {
 
 // ObtainOIDCAccessToken returns a valid OpenID Connect access token
 // for the user if it's able to obtain one, otherwise it returns an empty string.
-func ObtainOIDCAccessToken(ctx context.Context, logger slog.Logger, db database.Store, oidcConfig promoauth.OAuth2Config, userID uuid.UUID) (string, error) {
+func ObtainOIDCAccessToken(ctx context.Context, logger slog.Logger, db database.Store, oidcConfig promoauth.OAuth2Config, oidcProviders map[string]promoauth.OAuth2Config, userID uuid.UUID) (string, error) {
 	link, err := db.GetUserLinkByUserIDLoginType(ctx, database.GetUserLinkByUserIDLoginTypeParams{
 		UserID:    userID,
 		LoginType: database.LoginTypeOIDC,
@@ -3401,7 +3770,19 @@ func ObtainOIDCAccessToken(ctx context.Context, logger slog.Logger, db database.
 		return "", xerrors.Errorf("get owner oidc link: %w", err)
 	}
 
-	if shouldRefresh, expiresAt := shouldRefreshOIDCToken(link); shouldRefresh {
+	if link.OIDCProviderID != "" {
+		// The link belongs to a named OIDC provider, so it must be
+		// refreshed with that provider's config.
+		oidcConfig = oidcProviders[link.OIDCProviderID]
+	}
+
+	shouldRefresh, expiresAt := shouldRefreshOIDCToken(link)
+	if shouldRefresh {
+		if oidcConfig == nil || reflect.ValueOf(oidcConfig).IsNil() {
+			// The provider is not configured, so the token cannot be
+			// refreshed.
+			return "", nil
+		}
 		token, err := oidcConfig.TokenSource(ctx, &oauth2.Token{
 			AccessToken:  link.OAuthAccessToken,
 			RefreshToken: link.OAuthRefreshToken,
@@ -3428,6 +3809,7 @@ func ObtainOIDCAccessToken(ctx context.Context, logger slog.Logger, db database.
 			OAuthRefreshTokenKeyID: sql.NullString{}, // set by dbcrypt if required
 			OAuthExpiry:            link.OAuthExpiry,
 			Claims:                 link.Claims,
+			OIDCProviderID:         link.OIDCProviderID,
 		})
 		if err != nil {
 			return "", xerrors.Errorf("update user link: %w", err)
@@ -3532,6 +3914,12 @@ type WorkspaceProvisionJob struct {
 	PrebuiltWorkspaceBuildStage sdkproto.PrebuiltWorkspaceBuildStage `json:"prebuilt_workspace_stage,omitempty"`
 }
 
+// WorkspaceDriftCheckJob is the payload for the "workspace_drift_check" job type.
+type WorkspaceDriftCheckJob struct {
+	WorkspaceBuildID uuid.UUID `json:"workspace_build_id"`
+	LogLevel         string    `json:"log_level,omitempty"`
+}
+
 // TemplateVersionDryRunJob is the payload for the "template_version_dry_run" job type.
 type TemplateVersionDryRunJob struct {
 	TemplateVersionID   uuid.UUID                          `json:"template_version_id"`
@@ -3828,6 +4216,58 @@ func insertAgentScriptsAndLogSources(ctx context.Context, db database.Store, age
 	return nil
 }
 
+// insertAgentLogTails inserts a log source for each log tail of an agent,
+// along with the files and journald units the agent tails into it.
+func insertAgentLogTails(ctx context.Context, db database.Store, agentID uuid.UUID, logTails []*sdkproto.LogTail) error {
+	if len(logTails) == 0 {
+		return nil
+	}
+
+	var (
+		ids          = make([]uuid.UUID, 0, len(logTails))
+		displayNames = make([]string, 0, len(logTails))
+		icons        = make([]string, 0, len(logTails))
+	)
+	for _, logTail := range logTails {
+		ids = append(ids, uuid.New())
+		displayNames = append(displayNames, logTail.GetDisplayName())
+		icons = append(icons, logTail.GetIcon())
+	}
+	_, err := db.InsertWorkspaceAgentLogSources(ctx, database.InsertWorkspaceAgentLogSourcesParams{
+		WorkspaceAgentID: agentID,
+		ID:               ids,
+		CreatedAt:        dbtime.Now(),
+		DisplayName:      displayNames,
+		Icon:             icons,
+	})
+	if err != nil {
+		return xerrors.Errorf("insert log sources: %w", err)
+	}
+
+	for i, logTail := range logTails {
+		paths := logTail.GetPaths()
+		if paths == nil {
+			paths = []string{}
+		}
+		journaldUnits := logTail.GetJournaldUnits()
+		if journaldUnits == nil {
+			journaldUnits = []string{}
+		}
+		_, err = db.InsertWorkspaceAgentLogTail(ctx, database.InsertWorkspaceAgentLogTailParams{
+			WorkspaceAgentID: agentID,
+			LogSourceID:      ids[i],
+			CreatedAt:        dbtime.Now(),
+			Paths:            paths,
+			JournaldUnits:    journaldUnits,
+		})
+		if err != nil {
+			return xerrors.Errorf("insert log tail %q: %w", logTail.GetDisplayName(), err)
+		}
+	}
+
+	return nil
+}
+
 type workspaceAppRebindError struct {
 	slug    string
 	appID   uuid.UUID
@@ -3875,7 +4315,7 @@ func insertAgentApp(ctx context.Context, db database.Store, agentID uuid.UUID, a
 	if healthcheck == nil {
 		healthcheck = &sdkproto.Healthcheck{}
 	}
-	if healthcheck.Url != "" {
+	if healthcheck.Url != "" || healthcheck.Address != "" || healthcheck.Command != "" {
 		health = database.WorkspaceAppHealthInitializing
 	}
 
@@ -3933,6 +4373,8 @@ func insertAgentApp(ctx context.Context, db database.Store, agentID uuid.UUID, a
 		HealthcheckUrl:       healthcheck.Url,
 		HealthcheckInterval:  healthcheck.Interval,
 		HealthcheckThreshold: healthcheck.Threshold,
+		HealthcheckAddress:   healthcheck.Address,
+		HealthcheckCommand:   healthcheck.Command,
 		Health:               health,
 		// #nosec G115 - Order represents a display order value that's always small and fits in int32
 		DisplayOrder: int32(app.Order),
@@ -14,6 +14,7 @@ import (
 	"github.com/coder/coder/v2/coderd/database/dbgen"
 	"github.com/coder/coder/v2/coderd/database/dbtestutil"
 	"github.com/coder/coder/v2/coderd/database/dbtime"
+	"github.com/coder/coder/v2/coderd/promoauth"
 	"github.com/coder/coder/v2/codersdk"
 	"github.com/coder/coder/v2/testutil"
 )
@@ -120,7 +121,7 @@ func TestObtainOIDCAccessToken(t *testing.T) {
 	t.Run("NoToken", func(t *testing.T) {
 		t.Parallel()
 		db, _ := dbtestutil.NewDB(t)
-		_, err := ObtainOIDCAccessToken(ctx, testutil.Logger(t), db, nil, uuid.Nil)
+		_, err := ObtainOIDCAccessToken(ctx, testutil.Logger(t), db, nil, nil, uuid.Nil)
 		require.NoError(t, err)
 	})
 	t.Run("InvalidConfig", func(t *testing.T) {
@@ -133,7 +134,7 @@ func TestObtainOIDCAccessToken(t *testing.T) {
 			LoginType:   database.LoginTypeOIDC,
 			OAuthExpiry: dbtime.Now().Add(-time.Hour),
 		})
-		_, err := ObtainOIDCAccessToken(ctx, testutil.Logger(t), db, &oauth2.Config{}, user.ID)
+		_, err := ObtainOIDCAccessToken(ctx, testutil.Logger(t), db, &oauth2.Config{}, nil, user.ID)
 		require.NoError(t, err)
 	})
 	t.Run("MissingLink", func(t *testing.T) {
@@ -142,7 +143,7 @@ func TestObtainOIDCAccessToken(t *testing.T) {
 		user := dbgen.User(t, db, database.User{
 			LoginType: database.LoginTypeOIDC,
 		})
-		tok, err := ObtainOIDCAccessToken(ctx, testutil.Logger(t), db, &oauth2.Config{}, user.ID)
+		tok, err := ObtainOIDCAccessToken(ctx, testutil.Logger(t), db, &oauth2.Config{}, nil, user.ID)
 		require.Empty(t, tok)
 		require.NoError(t, err)
 	})
@@ -159,7 +160,7 @@ func TestObtainOIDCAccessToken(t *testing.T) {
 			Token: &oauth2.Token{
 				AccessToken: "token",
 			},
-		}, user.ID)
+		}, nil, user.ID)
 		require.NoError(t, err)
 		link, err := db.GetUserLinkByUserIDLoginType(ctx, database.GetUserLinkByUserIDLoginTypeParams{
 			UserID:    user.ID,
@@ -168,6 +169,39 @@ func TestObtainOIDCAccessToken(t *testing.T) {
 		require.NoError(t, err)
 		require.Equal(t, "token", link.OAuthAccessToken)
 	})
+	t.Run("NamedProvider", func(t *testing.T) {
+		t.Parallel()
+		db, _ := dbtestutil.NewDB(t)
+		user := dbgen.User(t, db, database.User{})
+		dbgen.UserLink(t, db, database.UserLink{
+			UserID:         user.ID,
+			LoginType:      database.LoginTypeOIDC,
+			OAuthExpiry:    dbtime.Now().Add(-time.Hour),
+			OIDCProviderID: "secondary",
+		})
+		// The link must be refreshed with the config of the provider that
+		// created it.
+		tok, err := ObtainOIDCAccessToken(ctx, testutil.Logger(t), db, &testutil.OAuth2Config{
+			Token: &oauth2.Token{
+				AccessToken: "primary",
+			},
+		}, map[string]promoauth.OAuth2Config{
+			"secondary": &testutil.OAuth2Config{
+				Token: &oauth2.Token{
+					AccessToken: "secondary",
+				},
+			},
+		}, user.ID)
+		require.NoError(t, err)
+		require.Equal(t, "secondary", tok)
+		link, err := db.GetUserLinkByUserIDLoginType(ctx, database.GetUserLinkByUserIDLoginTypeParams{
+			UserID:    user.ID,
+			LoginType: database.LoginTypeOIDC,
+		})
+		require.NoError(t, err)
+		require.Equal(t, "secondary", link.OAuthAccessToken)
+		require.Equal(t, "secondary", link.OIDCProviderID)
+	})
 }
 
 // TestNewServer_SessionCancelRequired verifies that constructing a server for
@@ -213,7 +213,7 @@ func TestTokenIsRefreshedEarlyWithoutCoderd(t *testing.T) {
 			ctx := testutil.Context(t, testutil.WaitShort)
 			oldLink := setLinkExpiration(t, c.expires())
 			tokenRefreshCount = 0
-			_, err := provisionerdserver.ObtainOIDCAccessToken(ctx, testutil.Logger(t), db, cfg, user.ID)
+			_, err := provisionerdserver.ObtainOIDCAccessToken(ctx, testutil.Logger(t), db, cfg, nil, user.ID)
 			require.NoError(t, err)
 			links, err := db.GetUserLinksByUserID(ctx, user.ID)
 			require.NoError(t, err)
@@ -881,6 +881,171 @@ func TestAcquireJob(t *testing.T) {
 			require.NoError(t, err)
 			require.JSONEq(t, string(want), string(got))
 		})
+		t.Run(tc.name+"_WorkspaceDriftCheck", func(t *testing.T) {
+			t.Parallel()
+			srv, db, ps, pd := setup(t, false, nil)
+			ctx := context.Background()
+
+			user := dbgen.User(t, db, database.User{})
+			template := dbgen.Template(t, db, database.Template{
+				Name:           "template",
+				Provisioner:    database.ProvisionerTypeEcho,
+				OrganizationID: pd.OrganizationID,
+				CreatedBy:      user.ID,
+			})
+			version := dbgen.TemplateVersion(t, db, database.TemplateVersion{
+				CreatedBy:      user.ID,
+				OrganizationID: pd.OrganizationID,
+				TemplateID:     uuid.NullUUID{UUID: template.ID, Valid: true},
+			})
+			workspace := dbgen.Workspace(t, db, database.WorkspaceTable{
+				TemplateID:     template.ID,
+				OwnerID:        user.ID,
+				OrganizationID: pd.OrganizationID,
+			})
+			file := dbgen.File(t, db, database.File{CreatedBy: user.ID})
+			buildJob := dbgen.ProvisionerJob(t, db, ps, database.ProvisionerJob{
+				OrganizationID: pd.OrganizationID,
+				InitiatorID:    user.ID,
+				Provisioner:    database.ProvisionerTypeEcho,
+				StorageMethod:  database.ProvisionerStorageMethodFile,
+				FileID:         file.ID,
+				Type:           database.ProvisionerJobTypeWorkspaceBuild,
+				CompletedAt:    sql.NullTime{Time: dbtime.Now(), Valid: true},
+				Tags:           map[string]string{"unrelated": "tag"},
+			})
+			build := dbgen.WorkspaceBuild(t, db, database.WorkspaceBuild{
+				WorkspaceID:       workspace.ID,
+				TemplateVersionID: version.ID,
+				JobID:             buildJob.ID,
+				InitiatorID:       user.ID,
+				Transition:        database.WorkspaceTransitionStart,
+			})
+			err := db.UpdateWorkspaceBuildProvisionerStateByID(ctx, database.UpdateWorkspaceBuildProvisionerStateByIDParams{
+				ID:               build.ID,
+				UpdatedAt:        dbtime.Now(),
+				ProvisionerState: []byte("state"),
+			})
+			require.NoError(t, err)
+			driftJob := dbgen.ProvisionerJob(t, db, ps, database.ProvisionerJob{
+				OrganizationID: pd.OrganizationID,
+				InitiatorID:    user.ID,
+				Provisioner:    database.ProvisionerTypeEcho,
+				StorageMethod:  database.ProvisionerStorageMethodFile,
+				FileID:         file.ID,
+				Type:           database.ProvisionerJobTypeWorkspaceDriftCheck,
+				Input: must(json.Marshal(provisionerdserver.WorkspaceDriftCheckJob{
+					WorkspaceBuildID: build.ID,
+				})),
+				Tags: pd.Tags,
+			})
+			_ = dbgen.WorkspaceDriftCheck(t, db, database.WorkspaceDriftCheck{
+				WorkspaceID:      workspace.ID,
+				WorkspaceBuildID: build.ID,
+				JobID:            driftJob.ID,
+			})
+
+			job, err := tc.acquire(ctx, srv)
+			require.NoError(t, err)
+			require.Equal(t, driftJob.ID.String(), job.JobId)
+
+			got, err := json.Marshal(job.Type)
+			require.NoError(t, err)
+
+			// The owner's session token must not be regenerated, as that
+			// would invalidate the token of the running workspace.
+			want, err := json.Marshal(&proto.AcquiredJob_WorkspaceDriftCheck_{
+				WorkspaceDriftCheck: &proto.AcquiredJob_WorkspaceDriftCheck{
+					WorkspaceBuildId:      build.ID.String(),
+					State:                 []byte("state"),
+					ExternalAuthProviders: []*sdkproto.ExternalAuthProvider{},
+					Metadata: &sdkproto.Metadata{
+						CoderUrl:                (&url.URL{}).String(),
+						WorkspaceTransition:     sdkproto.WorkspaceTransition_START,
+						WorkspaceName:           workspace.Name,
+						WorkspaceOwner:          user.Username,
+						WorkspaceOwnerEmail:     user.Email,
+						WorkspaceOwnerName:      user.Name,
+						WorkspaceOwnerGroups:    []string{},
+						WorkspaceId:             workspace.ID.String(),
+						WorkspaceOwnerId:        user.ID.String(),
+						TemplateId:              template.ID.String(),
+						TemplateName:            template.Name,
+						TemplateVersionId:       version.ID.String(),
+						TemplateVersion:         version.Name,
+						WorkspaceBuildId:        build.ID.String(),
+						WorkspaceOwnerLoginType: string(user.LoginType),
+					},
+				},
+			})
+			require.NoError(t, err)
+			require.JSONEq(t, string(want), string(got))
+		})
+		t.Run(tc.name+"_WorkspaceDriftCheckReplacedBuild", func(t *testing.T) {
+			t.Parallel()
+			srv, db, ps, pd := setup(t, true /* ignoreLogErrors */, nil)
+			ctx := testutil.Context(t, testutil.WaitShort)
+
+			user := dbgen.User(t, db, database.User{})
+			template := dbgen.Template(t, db, database.Template{
+				Provisioner:    database.ProvisionerTypeEcho,
+				OrganizationID: pd.OrganizationID,
+				CreatedBy:      user.ID,
+			})
+			version := dbgen.TemplateVersion(t, db, database.TemplateVersion{
+				CreatedBy:      user.ID,
+				OrganizationID: pd.OrganizationID,
+				TemplateID:     uuid.NullUUID{UUID: template.ID, Valid: true},
+			})
+			workspace := dbgen.Workspace(t, db, database.WorkspaceTable{
+				TemplateID:     template.ID,
+				OwnerID:        user.ID,
+				OrganizationID: pd.OrganizationID,
+			})
+			file := dbgen.File(t, db, database.File{CreatedBy: user.ID})
+			build := dbgen.WorkspaceBuild(t, db, database.WorkspaceBuild{
+				WorkspaceID:       workspace.ID,
+				TemplateVersionID: version.ID,
+				InitiatorID:       user.ID,
+				Transition:        database.WorkspaceTransitionStart,
+			})
+			driftJob := dbgen.ProvisionerJob(t, db, ps, database.ProvisionerJob{
+				OrganizationID: pd.OrganizationID,
+				InitiatorID:    user.ID,
+				Provisioner:    database.ProvisionerTypeEcho,
+				StorageMethod:  database.ProvisionerStorageMethodFile,
+				FileID:         file.ID,
+				Type:           database.ProvisionerJobTypeWorkspaceDriftCheck,
+				Input: must(json.Marshal(provisionerdserver.WorkspaceDriftCheckJob{
+					WorkspaceBuildID: build.ID,
+				})),
+				Tags: pd.Tags,
+			})
+			check := dbgen.WorkspaceDriftCheck(t, db, database.WorkspaceDriftCheck{
+				WorkspaceID:      workspace.ID,
+				WorkspaceBuildID: build.ID,
+				JobID:            driftJob.ID,
+			})
+			// The workspace is stopped while the check is queued.
+			_ = dbgen.WorkspaceBuild(t, db, database.WorkspaceBuild{
+				WorkspaceID:       workspace.ID,
+				TemplateVersionID: version.ID,
+				InitiatorID:       user.ID,
+				BuildNumber:       build.BuildNumber + 1,
+				Transition:        database.WorkspaceTransitionStop,
+			})
+
+			_, err := tc.acquire(ctx, srv)
+			require.ErrorContains(t, err, "skipping drift check")
+
+			// The check is never reported.
+			check, err = db.GetWorkspaceDriftCheckByJobID(ctx, check.JobID)
+			require.NoError(t, err)
+			require.False(t, check.CheckedAt.Valid)
+			driftJob, err = db.GetProvisionerJobByID(ctx, driftJob.ID)
+			require.NoError(t, err)
+			require.True(t, driftJob.CompletedAt.Valid)
+		})
 		t.Run(tc.name+"_TemplateVersionImport", func(t *testing.T) {
 			t.Parallel()
 			srv, db, ps, pd := setup(t, false, nil)
@@ -4248,6 +4413,61 @@ func TestInsertWorkspaceResource(t *testing.T) {
 		}, agent.DisplayApps)
 	})
 
+	t.Run("LogTails", func(t *testing.T) {
+		t.Parallel()
+		db, _ := dbtestutil.NewDB(t)
+		job := dbgen.ProvisionerJob(t, db, nil, database.ProvisionerJob{})
+		err := insert(db, job.ID, &sdkproto.Resource{
+			Name: "something",
+			Type: "aws_instance",
+			Agents: []*sdkproto.Agent{{
+				Name: "dev",
+				Auth: &sdkproto.Agent_Token{
+					Token: uuid.NewString(),
+				},
+				Scripts: []*sdkproto.Script{{
+					DisplayName: "Startup",
+				}},
+				LogTails: []*sdkproto.LogTail{{
+					DisplayName: "Nginx",
+					Icon:        "/icon/nginx.svg",
+					Paths:       []string{"/var/log/nginx/*.log"},
+				}, {
+					DisplayName:   "Docker",
+					JournaldUnits: []string{"docker.service"},
+				}},
+			}},
+		})
+		require.NoError(t, err)
+		resources, err := db.GetWorkspaceResourcesByJobID(ctx, job.ID)
+		require.NoError(t, err)
+		require.Len(t, resources, 1)
+		agents, err := db.GetWorkspaceAgentsByResourceIDs(ctx, []uuid.UUID{resources[0].ID})
+		require.NoError(t, err)
+		require.Len(t, agents, 1)
+
+		sources, err := db.GetWorkspaceAgentLogSourcesByAgentIDs(ctx, []uuid.UUID{agents[0].ID})
+		require.NoError(t, err)
+		require.Len(t, sources, 3)
+		sourceNames := make(map[uuid.UUID]string, len(sources))
+		for _, source := range sources {
+			sourceNames[source.ID] = source.DisplayName
+		}
+
+		logTails, err := db.GetWorkspaceAgentLogTailsByAgentIDs(ctx, []uuid.UUID{agents[0].ID})
+		require.NoError(t, err)
+		require.Len(t, logTails, 2)
+		slices.SortFunc(logTails, func(a, b database.WorkspaceAgentLogTail) int {
+			return strings.Compare(sourceNames[a.LogSourceID], sourceNames[b.LogSourceID])
+		})
+		require.Equal(t, "Docker", sourceNames[logTails[0].LogSourceID])
+		require.Empty(t, logTails[0].Paths)
+		require.Equal(t, []string{"docker.service"}, logTails[0].JournaldUnits)
+		require.Equal(t, "Nginx", sourceNames[logTails[1].LogSourceID])
+		require.Equal(t, []string{"/var/log/nginx/*.log"}, logTails[1].Paths)
+		require.Empty(t, logTails[1].JournaldUnits)
+	})
+
 	t.Run("AllDisplayApps", func(t *testing.T) {
 		t.Parallel()
 		db, _ := dbtestutil.NewDB(t)
@@ -4329,6 +4549,21 @@ func TestInsertWorkspaceResource(t *testing.T) {
 							Threshold: 50,
 						},
 					},
+					Cpu: &sdkproto.CPUResourceMonitor{
+						Enabled:   true,
+						Threshold: 95,
+					},
+					Processes: &sdkproto.ProcessResourceMonitor{
+						Enabled:   true,
+						Threshold: 70,
+					},
+					Inodes: []*sdkproto.VolumeResourceMonitor{
+						{
+							Path:      "/volume1",
+							Enabled:   true,
+							Threshold: 85,
+						},
+					},
 				},
 			}},
 		})
@@ -4352,6 +4587,24 @@ func TestInsertWorkspaceResource(t *testing.T) {
 		require.Equal(t, "/volume1", volMonitors[0].Path)
 		require.Equal(t, int32(50), volMonitors[1].Threshold)
 		require.Equal(t, "/volume2", volMonitors[1].Path)
+
+		monitors, err := db.FetchResourceMonitorsByAgentID(ctx, agent.ID)
+		require.NoError(t, err)
+		require.Len(t, monitors, 3)
+		thresholds := map[database.WorkspaceAgentResourceMonitorType]int32{}
+		for _, monitor := range monitors {
+			thresholds[monitor.Type] = monitor.Threshold
+			if monitor.Type == database.WorkspaceAgentResourceMonitorTypeInodes {
+				require.Equal(t, "/volume1", monitor.Path)
+			} else {
+				require.Empty(t, monitor.Path)
+			}
+		}
+		require.Equal(t, map[database.WorkspaceAgentResourceMonitorType]int32{
+			database.WorkspaceAgentResourceMonitorTypeCpu:       95,
+			database.WorkspaceAgentResourceMonitorTypeProcesses: 70,
+			database.WorkspaceAgentResourceMonitorTypeInodes:    85,
+		}, thresholds)
 	})
 
 	t.Run("Devcontainers", func(t *testing.T) {
@@ -5134,6 +5387,256 @@ func TestNotifications(t *testing.T) {
 		assert.Equal(t, user.Username, sent[0].Labels["workspace_owner_username"])
 		assert.Equal(t, strconv.Itoa(int(build.BuildNumber)), sent[0].Labels["workspace_build_number"])
 	})
+
+	t.Run("Workspace drift detected, owner and template admins notified", func(t *testing.T) {
+		t.Parallel()
+
+		ctx := context.Background()
+
+		// given
+		notifEnq := &notificationstest.FakeEnqueuer{}
+		srv, db, ps, pd := setup(t, true /* ignoreLogErrors */, &overrides{notificationEnqueuer: notifEnq})
+
+		templateAdmin := dbgen.User(t, db, database.User{RBACRoles: []string{codersdk.RoleTemplateAdmin}})
+		_ /* template admin of another organization, should not receive notification */ = dbgen.User(t, db, database.User{RBACRoles: []string{codersdk.RoleTemplateAdmin}})
+		_ = dbgen.OrganizationMember(t, db, database.OrganizationMember{UserID: templateAdmin.ID, OrganizationID: pd.OrganizationID})
+		user := dbgen.User(t, db, database.User{})
+		_ = dbgen.OrganizationMember(t, db, database.OrganizationMember{UserID: user.ID, OrganizationID: pd.OrganizationID})
+
+		template := dbgen.Template(t, db, database.Template{
+			CreatedBy: user.ID,
+			Name:      "template", DisplayName: "William's Template", Provisioner: database.ProvisionerTypeEcho, OrganizationID: pd.OrganizationID,
+		})
+		workspace := dbgen.Workspace(t, db, database.WorkspaceTable{
+			TemplateID: template.ID, OwnerID: user.ID, OrganizationID: pd.OrganizationID,
+		})
+		version := dbgen.TemplateVersion(t, db, database.TemplateVersion{
+			CreatedBy:      user.ID,
+			OrganizationID: pd.OrganizationID, TemplateID: uuid.NullUUID{UUID: template.ID, Valid: true}, JobID: uuid.New(),
+		})
+		file := dbgen.File(t, db, database.File{CreatedBy: user.ID})
+		buildJob := dbgen.ProvisionerJob(t, db, ps, database.ProvisionerJob{
+			FileID:         file.ID,
+			InitiatorID:    user.ID,
+			Type:           database.ProvisionerJobTypeWorkspaceBuild,
+			OrganizationID: pd.OrganizationID,
+			CompletedAt:    sql.NullTime{Time: dbtime.Now(), Valid: true},
+			Tags:           map[string]string{"unrelated": "tag"},
+		})
+		build := dbgen.WorkspaceBuild(t, db, database.WorkspaceBuild{
+			JobID:       buildJob.ID,
+			WorkspaceID: workspace.ID, TemplateVersionID: version.ID, InitiatorID: user.ID, Transition: database.WorkspaceTransitionStart, Reason: database.BuildReasonInitiator,
+		})
+		job := dbgen.ProvisionerJob(t, db, ps, database.ProvisionerJob{
+			FileID:         file.ID,
+			InitiatorID:    user.ID,
+			Type:           database.ProvisionerJobTypeWorkspaceDriftCheck,
+			Input:          must(json.Marshal(provisionerdserver.WorkspaceDriftCheckJob{WorkspaceBuildID: build.ID})),
+			OrganizationID: pd.OrganizationID,
+		})
+		check := dbgen.WorkspaceDriftCheck(t, db, database.WorkspaceDriftCheck{
+			WorkspaceID:      workspace.ID,
+			WorkspaceBuildID: build.ID,
+			JobID:            job.ID,
+		})
+		_, err := db.AcquireProvisionerJob(ctx, database.AcquireProvisionerJobParams{
+			OrganizationID:  pd.OrganizationID,
+			WorkerID:        uuid.NullUUID{UUID: pd.ID, Valid: true},
+			Types:           []database.ProvisionerType{database.ProvisionerTypeEcho},
+			ProvisionerTags: must(json.Marshal(job.Tags)),
+			StartedAt:       sql.NullTime{Time: job.CreatedAt, Valid: true},
+		})
+		require.NoError(t, err)
+
+		// when
+		_, err = srv.CompleteJob(ctx, &proto.CompletedJob{
+			JobId: job.ID.String(),
+			Type: &proto.CompletedJob_WorkspaceDriftCheck_{
+				WorkspaceDriftCheck: &proto.CompletedJob_WorkspaceDriftCheck{
+					ResourceDrift: []*sdkproto.ResourceDrift{{
+						Address:    "docker_container.workspace[0]",
+						Type:       "docker_container",
+						Name:       "workspace",
+						Action:     "update",
+						Attributes: []string{"memory"},
+					}},
+				},
+			},
+		})
+		require.NoError(t, err)
+
+		// then
+		check, err = db.GetWorkspaceDriftCheckByJobID(ctx, job.ID)
+		require.NoError(t, err)
+		require.True(t, check.CheckedAt.Valid)
+		require.True(t, check.Drifted)
+
+		sent := notifEnq.Sent()
+		require.Len(t, sent, 2)
+		recipients := []uuid.UUID{sent[0].UserID, sent[1].UserID}
+		assert.ElementsMatch(t, []uuid.UUID{user.ID, templateAdmin.ID}, recipients)
+		for _, notif := range sent {
+			assert.Equal(t, notifications.TemplateWorkspaceDriftDetected, notif.TemplateID)
+			assert.Contains(t, notif.Targets, template.ID)
+			assert.Contains(t, notif.Targets, workspace.ID)
+			assert.Contains(t, notif.Targets, workspace.OrganizationID)
+			assert.Contains(t, notif.Targets, user.ID)
+			assert.Equal(t, workspace.Name, notif.Labels["workspace"])
+			assert.Equal(t, template.DisplayName, notif.Labels["template_name"])
+			assert.Equal(t, user.Username, notif.Labels["workspace_owner_username"])
+			assert.Equal(t, []map[string]any{{
+				"address": "docker_container.workspace[0]",
+				"action":  "update",
+			}}, notif.Data["resources"])
+		}
+	})
+
+	t.Run("Workspace drift check without drift, nobody notified", func(t *testing.T) {
+		t.Parallel()
+
+		ctx := context.Background()
+
+		// given
+		notifEnq := &notificationstest.FakeEnqueuer{}
+		srv, db, ps, pd := setup(t, true /* ignoreLogErrors */, &overrides{notificationEnqueuer: notifEnq})
+
+		user := dbgen.User(t, db, database.User{})
+		template := dbgen.Template(t, db, database.Template{
+			CreatedBy: user.ID, Provisioner: database.ProvisionerTypeEcho, OrganizationID: pd.OrganizationID,
+		})
+		workspace := dbgen.Workspace(t, db, database.WorkspaceTable{
+			TemplateID: template.ID, OwnerID: user.ID, OrganizationID: pd.OrganizationID,
+		})
+		version := dbgen.TemplateVersion(t, db, database.TemplateVersion{
+			CreatedBy:      user.ID,
+			OrganizationID: pd.OrganizationID, TemplateID: uuid.NullUUID{UUID: template.ID, Valid: true}, JobID: uuid.New(),
+		})
+		file := dbgen.File(t, db, database.File{CreatedBy: user.ID})
+		buildJob := dbgen.ProvisionerJob(t, db, ps, database.ProvisionerJob{
+			FileID:         file.ID,
+			InitiatorID:    user.ID,
+			Type:           database.ProvisionerJobTypeWorkspaceBuild,
+			OrganizationID: pd.OrganizationID,
+			CompletedAt:    sql.NullTime{Time: dbtime.Now(), Valid: true},
+			Tags:           map[string]string{"unrelated": "tag"},
+		})
+		build := dbgen.WorkspaceBuild(t, db, database.WorkspaceBuild{
+			JobID:       buildJob.ID,
+			WorkspaceID: workspace.ID, TemplateVersionID: version.ID, InitiatorID: user.ID, Transition: database.WorkspaceTransitionStart,
+		})
+		job := dbgen.ProvisionerJob(t, db, ps, database.ProvisionerJob{
+			FileID:         file.ID,
+			InitiatorID:    user.ID,
+			Type:           database.ProvisionerJobTypeWorkspaceDriftCheck,
+			Input:          must(json.Marshal(provisionerdserver.WorkspaceDriftCheckJob{WorkspaceBuildID: build.ID})),
+			OrganizationID: pd.OrganizationID,
+		})
+		_ = dbgen.WorkspaceDriftCheck(t, db, database.WorkspaceDriftCheck{
+			WorkspaceID:      workspace.ID,
+			WorkspaceBuildID: build.ID,
+			JobID:            job.ID,
+		})
+		_, err := db.AcquireProvisionerJob(ctx, database.AcquireProvisionerJobParams{
+			OrganizationID:  pd.OrganizationID,
+			WorkerID:        uuid.NullUUID{UUID: pd.ID, Valid: true},
+			Types:           []database.ProvisionerType{database.ProvisionerTypeEcho},
+			ProvisionerTags: must(json.Marshal(job.Tags)),
+			StartedAt:       sql.NullTime{Time: job.CreatedAt, Valid: true},
+		})
+		require.NoError(t, err)
+
+		// when
+		_, err = srv.CompleteJob(ctx, &proto.CompletedJob{
+			JobId: job.ID.String(),
+			Type: &proto.CompletedJob_WorkspaceDriftCheck_{
+				WorkspaceDriftCheck: &proto.CompletedJob_WorkspaceDriftCheck{},
+			},
+		})
+		require.NoError(t, err)
+
+		// then
+		check, err := db.GetWorkspaceDriftCheckByJobID(ctx, job.ID)
+		require.NoError(t, err)
+		require.True(t, check.CheckedAt.Valid)
+		require.False(t, check.Drifted)
+		require.Empty(t, notifEnq.Sent())
+	})
+
+	t.Run("Workspace drift check of a replaced build, nobody notified", func(t *testing.T) {
+		t.Parallel()
+
+		ctx := context.Background()
+
+		// given
+		notifEnq := &notificationstest.FakeEnqueuer{}
+		srv, db, ps, pd := setup(t, true /* ignoreLogErrors */, &overrides{notificationEnqueuer: notifEnq})
+
+		user := dbgen.User(t, db, database.User{})
+		template := dbgen.Template(t, db, database.Template{
+			CreatedBy: user.ID, Provisioner: database.ProvisionerTypeEcho, OrganizationID: pd.OrganizationID,
+		})
+		workspace := dbgen.Workspace(t, db, database.WorkspaceTable{
+			TemplateID: template.ID, OwnerID: user.ID, OrganizationID: pd.OrganizationID,
+		})
+		version := dbgen.TemplateVersion(t, db, database.TemplateVersion{
+			CreatedBy:      user.ID,
+			OrganizationID: pd.OrganizationID, TemplateID: uuid.NullUUID{UUID: template.ID, Valid: true}, JobID: uuid.New(),
+		})
+		file := dbgen.File(t, db, database.File{CreatedBy: user.ID})
+		build := dbgen.WorkspaceBuild(t, db, database.WorkspaceBuild{
+			WorkspaceID: workspace.ID, TemplateVersionID: version.ID, InitiatorID: user.ID, Transition: database.WorkspaceTransitionStart,
+		})
+		job := dbgen.ProvisionerJob(t, db, ps, database.ProvisionerJob{
+			FileID:         file.ID,
+			InitiatorID:    user.ID,
+			Type:           database.ProvisionerJobTypeWorkspaceDriftCheck,
+			Input:          must(json.Marshal(provisionerdserver.WorkspaceDriftCheckJob{WorkspaceBuildID: build.ID})),
+			OrganizationID: pd.OrganizationID,
+		})
+		_ = dbgen.WorkspaceDriftCheck(t, db, database.WorkspaceDriftCheck{
+			WorkspaceID:      workspace.ID,
+			WorkspaceBuildID: build.ID,
+			JobID:            job.ID,
+		})
+		_, err := db.AcquireProvisionerJob(ctx, database.AcquireProvisionerJobParams{
+			OrganizationID:  pd.OrganizationID,
+			WorkerID:        uuid.NullUUID{UUID: pd.ID, Valid: true},
+			Types:           []database.ProvisionerType{database.ProvisionerTypeEcho},
+			ProvisionerTags: must(json.Marshal(job.Tags)),
+			StartedAt:       sql.NullTime{Time: job.CreatedAt, Valid: true},
+		})
+		require.NoError(t, err)
+		// The workspace is rebuilt while the check runs.
+		_ = dbgen.WorkspaceBuild(t, db, database.WorkspaceBuild{
+			WorkspaceID: workspace.ID, TemplateVersionID: version.ID, InitiatorID: user.ID, BuildNumber: build.BuildNumber + 1, Transition: database.WorkspaceTransitionStart,
+		})
+
+		// when
+		_, err = srv.CompleteJob(ctx, &proto.CompletedJob{
+			JobId: job.ID.String(),
+			Type: &proto.CompletedJob_WorkspaceDriftCheck_{
+				WorkspaceDriftCheck: &proto.CompletedJob_WorkspaceDriftCheck{
+					ResourceDrift: []*sdkproto.ResourceDrift{{
+						Address: "docker_container.workspace[0]",
+						Type:    "docker_container",
+						Name:    "workspace",
+						Action:  "update",
+					}},
+				},
+			},
+		})
+		require.NoError(t, err)
+
+		// then
+		check, err := db.GetWorkspaceDriftCheckByJobID(ctx, job.ID)
+		require.NoError(t, err)
+		require.False(t, check.CheckedAt.Valid)
+		require.False(t, check.Drifted)
+		job, err = db.GetProvisionerJobByID(ctx, job.ID)
+		require.NoError(t, err)
+		require.True(t, job.CompletedAt.Valid)
+		require.Empty(t, notifEnq.Sent())
+	})
 }
 
 func TestServer_ExpirePrebuildsSessionToken(t *testing.T) {
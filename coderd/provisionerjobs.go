@@ -17,6 +17,7 @@ import (
 	"github.com/coder/coder/v2/coderd/database"
 	"github.com/coder/coder/v2/coderd/database/db2sdk"
 	"github.com/coder/coder/v2/coderd/database/dbauthz"
+	"github.com/coder/coder/v2/coderd/database/dbtime"
 	"github.com/coder/coder/v2/coderd/database/pubsub"
 	"github.com/coder/coder/v2/coderd/httpapi"
 	"github.com/coder/coder/v2/coderd/httpmw"
@@ -90,6 +91,117 @@ func (api *API) provisionerJobs(rw http.ResponseWriter, r *http.Request) {
 	httpapi.Write(ctx, rw, http.StatusOK, slice.List(jobs, convertProvisionerJobWithQueuePosition))
 }
 
+// @Summary Get provisioner job priorities
+// @ID get-provisioner-job-priorities
+// @Security CoderSessionToken
+// @Produce json
+// @Tags Organizations
+// @Param organization path string true "Organization ID" format(uuid)
+// @Success 200 {array} codersdk.ProvisionerJobPriority
+// @Router /api/v2/organizations/{organization}/provisionerjobs/priorities [get]
+func (api *API) provisionerJobPriorities(rw http.ResponseWriter, r *http.Request) {
+	var (
+		ctx = r.Context()
+		org = httpmw.OrganizationParam(r)
+	)
+
+	priorities, err := api.Database.GetProvisionerJobPrioritiesByOrganizationID(ctx, org.ID)
+	if httpapi.IsUnauthorizedError(err) {
+		httpapi.ResourceNotFound(rw)
+		return
+	}
+	if err != nil {
+		httpapi.Write(ctx, rw, http.StatusInternalServerError, codersdk.Response{
+			Message: "Internal error fetching provisioner job priorities.",
+			Detail:  err.Error(),
+		})
+		return
+	}
+
+	httpapi.Write(ctx, rw, http.StatusOK, slice.List(priorities, db2sdk.ProvisionerJobPriority))
+}
+
+// @Summary Update provisioner job priority
+// @ID update-provisioner-job-priority
+// @Security CoderSessionToken
+// @Accept json
+// @Produce json
+// @Tags Organizations
+// @Param organization path string true "Organization ID" format(uuid)
+// @Param request body codersdk.UpdateProvisionerJobPriorityRequest true "Update priority request"
+// @Success 200 {object} codersdk.ProvisionerJobPriority
+// @Router /api/v2/organizations/{organization}/provisionerjobs/priorities [put]
+func (api *API) putProvisionerJobPriority(rw http.ResponseWriter, r *http.Request) {
+	var (
+		ctx = r.Context()
+		org = httpmw.OrganizationParam(r)
+	)
+
+	var req codersdk.UpdateProvisionerJobPriorityRequest
+	if !httpapi.Read(ctx, rw, r, &req) {
+		return
+	}
+
+	// uuid.Nil stores the boost of the whole organization.
+	templateID := uuid.Nil
+	if req.TemplateID != nil {
+		template, err := api.Database.GetTemplateByID(ctx, *req.TemplateID)
+		if httpapi.Is404Error(err) || (err == nil && template.OrganizationID != org.ID) {
+			httpapi.Write(ctx, rw, http.StatusBadRequest, codersdk.Response{
+				Message: "Template does not exist in this organization.",
+				Validations: []codersdk.ValidationError{
+					{Field: "template_id", Detail: "template not found"},
+				},
+			})
+			return
+		}
+		if err != nil {
+			httpapi.Write(ctx, rw, http.StatusInternalServerError, codersdk.Response{
+				Message: "Internal error fetching template.",
+				Detail:  err.Error(),
+			})
+			return
+		}
+		templateID = template.ID
+	}
+
+	now := dbtime.Time(api.Clock.Now())
+	priority := database.ProvisionerJobPriority{
+		OrganizationID: org.ID,
+		TemplateID:     templateID,
+		Priority:       req.Priority,
+		UpdatedAt:      now,
+	}
+	var err error
+	if req.Priority == 0 {
+		// A boost of zero is the same as no boost.
+		err = api.Database.DeleteProvisionerJobPriority(ctx, database.DeleteProvisionerJobPriorityParams{
+			OrganizationID: org.ID,
+			TemplateID:     templateID,
+		})
+	} else {
+		priority, err = api.Database.UpsertProvisionerJobPriority(ctx, database.UpsertProvisionerJobPriorityParams{
+			OrganizationID: org.ID,
+			TemplateID:     templateID,
+			Priority:       req.Priority,
+			UpdatedAt:      now,
+		})
+	}
+	if httpapi.IsUnauthorizedError(err) {
+		httpapi.Forbidden(rw)
+		return
+	}
+	if err != nil {
+		httpapi.Write(ctx, rw, http.StatusInternalServerError, codersdk.Response{
+			Message: "Internal error updating provisioner job priority.",
+			Detail:  err.Error(),
+		})
+		return
+	}
+
+	httpapi.Write(ctx, rw, http.StatusOK, db2sdk.ProvisionerJobPriority(priority))
+}
+
 // handleAuthAndFetchProvisionerJobs is an internal method shared by
 // provisionerJob and provisionerJobs. If ok is false the caller should
 // return immediately because the response has already been written.
@@ -389,6 +501,7 @@ func convertProvisionerJob(pj database.GetProvisionerJobsByIDsWithQueuePositionR
 		Tags:           provisionerJob.Tags,
 		QueuePosition:  int(pj.QueuePosition),
 		QueueSize:      int(pj.QueueSize),
+		Priority:       provisionerJob.Priority,
 		LogsOverflowed: provisionerJob.LogsOverflowed,
 	}
 	// Applying values optional to the struct.
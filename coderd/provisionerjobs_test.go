@@ -4,6 +4,7 @@ import (
 	"context"
 	"database/sql"
 	"encoding/json"
+	"net/http"
 	"strconv"
 	"testing"
 
@@ -396,6 +397,105 @@ func TestProvisionerJobs(t *testing.T) {
 	})
 }
 
+func TestProvisionerJobPriorities(t *testing.T) {
+	t.Parallel()
+
+	client := coderdtest.New(t, &coderdtest.Options{IncludeProvisionerDaemon: true})
+	owner := coderdtest.CreateFirstUser(t, client)
+	templateAdminClient, _ := coderdtest.CreateAnotherUser(t, client, owner.OrganizationID, rbac.ScopedRoleOrgTemplateAdmin(owner.OrganizationID))
+	memberClient, _ := coderdtest.CreateAnotherUser(t, client, owner.OrganizationID)
+
+	version := coderdtest.CreateTemplateVersion(t, client, owner.OrganizationID, nil)
+	coderdtest.AwaitTemplateVersionJobCompleted(t, client, version.ID)
+	template := coderdtest.CreateTemplate(t, client, owner.OrganizationID, version.ID)
+
+	ctx := testutil.Context(t, testutil.WaitLong)
+
+	orgPriority, err := client.UpdateOrganizationProvisionerJobPriority(ctx, owner.OrganizationID, codersdk.UpdateProvisionerJobPriorityRequest{
+		Priority: 10,
+	})
+	require.NoError(t, err)
+	require.Nil(t, orgPriority.TemplateID)
+	require.EqualValues(t, 10, orgPriority.Priority)
+
+	_, err = client.UpdateOrganizationProvisionerJobPriority(ctx, owner.OrganizationID, codersdk.UpdateProvisionerJobPriorityRequest{
+		TemplateID: &template.ID,
+		Priority:   -20,
+	})
+	require.NoError(t, err)
+
+	priorities, err := templateAdminClient.OrganizationProvisionerJobPriorities(ctx, owner.OrganizationID)
+	require.NoError(t, err)
+	require.Len(t, priorities, 2)
+
+	// New jobs get the sum of the organization and template boosts added
+	// to their priority class.
+	workspace := coderdtest.CreateWorkspace(t, client, template.ID)
+	build := coderdtest.AwaitWorkspaceBuildJobCompleted(t, client, workspace.LatestBuild.ID)
+	require.EqualValues(t, codersdk.ProvisionerJobPriorityInteractive-10, build.Job.Priority)
+
+	t.Run("RemoveWithZero", func(t *testing.T) {
+		t.Parallel()
+		ctx := testutil.Context(t, testutil.WaitMedium)
+
+		other := coderdtest.CreateTemplate(t, client, owner.OrganizationID, version.ID)
+		_, err := client.UpdateOrganizationProvisionerJobPriority(ctx, owner.OrganizationID, codersdk.UpdateProvisionerJobPriorityRequest{
+			TemplateID: &other.ID,
+			Priority:   5,
+		})
+		require.NoError(t, err)
+		_, err = client.UpdateOrganizationProvisionerJobPriority(ctx, owner.OrganizationID, codersdk.UpdateProvisionerJobPriorityRequest{
+			TemplateID: &other.ID,
+			Priority:   0,
+		})
+		require.NoError(t, err)
+
+		priorities, err := client.OrganizationProvisionerJobPriorities(ctx, owner.OrganizationID)
+		require.NoError(t, err)
+		for _, priority := range priorities {
+			require.NotEqual(t, &other.ID, priority.TemplateID)
+		}
+	})
+
+	t.Run("OutOfRange", func(t *testing.T) {
+		t.Parallel()
+		ctx := testutil.Context(t, testutil.WaitMedium)
+
+		_, err := client.UpdateOrganizationProvisionerJobPriority(ctx, owner.OrganizationID, codersdk.UpdateProvisionerJobPriorityRequest{
+			Priority: codersdk.MaxProvisionerJobPriorityBoost + 1,
+		})
+		var apiErr *codersdk.Error
+		require.ErrorAs(t, err, &apiErr)
+		require.Equal(t, http.StatusBadRequest, apiErr.StatusCode())
+	})
+
+	t.Run("UnknownTemplate", func(t *testing.T) {
+		t.Parallel()
+		ctx := testutil.Context(t, testutil.WaitMedium)
+
+		templateID := uuid.New()
+		_, err := client.UpdateOrganizationProvisionerJobPriority(ctx, owner.OrganizationID, codersdk.UpdateProvisionerJobPriorityRequest{
+			TemplateID: &templateID,
+			Priority:   5,
+		})
+		var apiErr *codersdk.Error
+		require.ErrorAs(t, err, &apiErr)
+		require.Equal(t, http.StatusBadRequest, apiErr.StatusCode())
+	})
+
+	t.Run("MemberDenied", func(t *testing.T) {
+		t.Parallel()
+		ctx := testutil.Context(t, testutil.WaitMedium)
+
+		_, err := memberClient.OrganizationProvisionerJobPriorities(ctx, owner.OrganizationID)
+		require.Error(t, err)
+		_, err = memberClient.UpdateOrganizationProvisionerJobPriority(ctx, owner.OrganizationID, codersdk.UpdateProvisionerJobPriorityRequest{
+			Priority: 50,
+		})
+		require.Error(t, err)
+	})
+}
+
 func TestProvisionerJobLogs(t *testing.T) {
 	t.Parallel()
 	t.Run("StreamAfterComplete", func(t *testing.T) {
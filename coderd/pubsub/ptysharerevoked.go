@@ -0,0 +1,10 @@
+package pubsub
+
+import "github.com/google/uuid"
+
+// WorkspaceAgentPTYShareRevokedChannel returns the pubsub channel that
+// carries a notification when the reconnecting PTY share with the given ID
+// is revoked.
+func WorkspaceAgentPTYShareRevokedChannel(shareID uuid.UUID) string {
+	return "workspace_agent_pty_share_revoked:" + shareID.String()
+}
@@ -0,0 +1,142 @@
+package schedule
+
+import (
+	"context"
+	"time"
+
+	"github.com/google/uuid"
+	"golang.org/x/xerrors"
+
+	"github.com/coder/coder/v2/coderd/database"
+)
+
+// maxExceptionLookahead bounds how far NextAllowedAutostartWithExceptions
+// searches past 'at' for an autostart that is not covered by an exception.
+const maxExceptionLookahead = 366 * 24 * time.Hour
+
+// AutostartException is a range of dates on which a workspace must not be
+// started automatically, such as a public holiday or the owner being out of
+// office. Dates have no location: they are compared against the calendar date
+// of an autostart in the location of the workspace's schedule.
+type AutostartException struct {
+	// StartDate and EndDate are both inclusive. Only the year, month and
+	// day are used.
+	StartDate time.Time
+	EndDate   time.Time
+	// Source is the name of the calendar the exception came from, or
+	// "out-of-office" for the workspace owner's own ranges.
+	Source  string
+	Summary string
+}
+
+// Contains reports whether t falls on one of the exception's dates, using
+// t's location to determine the date.
+func (e AutostartException) Contains(t time.Time) bool {
+	date := civilDate(t)
+	return !date.Before(civilDate(e.StartDate)) && !date.After(civilDate(e.EndDate))
+}
+
+// AutostartExceptions is a set of exceptions that apply to a workspace.
+type AutostartExceptions []AutostartException
+
+// Find returns the first exception containing t.
+func (es AutostartExceptions) Find(t time.Time) (AutostartException, bool) {
+	for _, e := range es {
+		if e.Contains(t) {
+			return e, true
+		}
+	}
+	return AutostartException{}, false
+}
+
+// AutostartExceptionsFromRows converts the result of
+// GetWorkspaceAutostartExceptions.
+func AutostartExceptionsFromRows(rows []database.GetWorkspaceAutostartExceptionsRow) AutostartExceptions {
+	es := make(AutostartExceptions, 0, len(rows))
+	for _, row := range rows {
+		es = append(es, AutostartException{
+			StartDate: row.StartDate,
+			EndDate:   row.EndDate,
+			Source:    row.Source,
+			Summary:   row.Summary,
+		})
+	}
+	return es
+}
+
+// AutostartExceptionsByWorkspace groups the result of
+// GetWorkspaceAutostartExceptionsByWorkspaceIDs by workspace.
+func AutostartExceptionsByWorkspace(rows []database.GetWorkspaceAutostartExceptionsByWorkspaceIDsRow) map[uuid.UUID]AutostartExceptions {
+	es := make(map[uuid.UUID]AutostartExceptions)
+	for _, row := range rows {
+		es[row.WorkspaceID] = append(es[row.WorkspaceID], AutostartException{
+			StartDate: row.StartDate,
+			EndDate:   row.EndDate,
+			Source:    row.Source,
+			Summary:   row.Summary,
+		})
+	}
+	return es
+}
+
+// GetAutostartExceptions returns the exceptions that can apply to autostarts
+// of a workspace after 'at'.
+func GetAutostartExceptions(ctx context.Context, db database.Store, workspaceID uuid.UUID, at time.Time) (AutostartExceptions, error) {
+	// Exceptions are loaded from the day before, since dates are compared in
+	// the timezone of the workspace's schedule.
+	rows, err := db.GetWorkspaceAutostartExceptions(ctx, database.GetWorkspaceAutostartExceptionsParams{
+		WorkspaceID: workspaceID,
+		After:       at.AddDate(0, 0, -1),
+	})
+	if err != nil {
+		return nil, xerrors.Errorf("get workspace autostart exceptions: %w", err)
+	}
+	return AutostartExceptionsFromRows(rows), nil
+}
+
+// NextAllowedAutostartWithExceptions is like NextAllowedAutostart, but also
+// skips autostarts that fall on an exception date. It searches up to a year
+// ahead, so that long exceptions such as extended leave are skipped over
+// rather than disabling autostart altogether.
+func NextAllowedAutostartWithExceptions(at time.Time, wsSchedule string, templateSchedule TemplateScheduleOptions, exceptions AutostartExceptions) (time.Time, error) {
+	next := at
+	for next.Sub(at) < maxExceptionLookahead {
+		var err error
+		next, err = NextAllowedAutostart(next, wsSchedule, templateSchedule)
+		if err != nil {
+			return time.Time{}, err
+		}
+		if _, skip := exceptions.Find(next); !skip {
+			return next, nil
+		}
+	}
+	return time.Time{}, xerrors.Errorf("every autostart in the next year is on an exception date: %w", ErrNoAllowedAutostart)
+}
+
+// NextSkippedAutostart returns the first autostart within a year after 'at'
+// that will be skipped because it falls on an exception date, along with the
+// exception.
+func NextSkippedAutostart(at time.Time, wsSchedule string, templateSchedule TemplateScheduleOptions, exceptions AutostartExceptions) (time.Time, AutostartException, bool) {
+	if len(exceptions) == 0 {
+		return time.Time{}, AutostartException{}, false
+	}
+	next := at
+	for next.Sub(at) < maxExceptionLookahead {
+		var err error
+		next, err = NextAllowedAutostart(next, wsSchedule, templateSchedule)
+		if err != nil {
+			break
+		}
+		if exception, ok := exceptions.Find(next); ok {
+			return next, exception, true
+		}
+	}
+	return time.Time{}, AutostartException{}, false
+}
+
+// civilDate returns midnight UTC on t's date in t's location, so that dates
+// from different locations can be compared.
+func civilDate(t time.Time) time.Time {
+	y, m, d := t.Date()
+	return time.Date(y, m, d, 0, 0, 0, 0, time.UTC)
+}
@@ -0,0 +1,153 @@
+package schedule_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/coder/coder/v2/coderd/schedule"
+)
+
+func TestNextAllowedAutostartWithExceptions(t *testing.T) {
+	t.Parallel()
+
+	// Monday-Friday 9:00AM in New York.
+	sched := "CRON_TZ=America/New_York 00 09 * * 1-5"
+	opts := schedule.TemplateScheduleOptions{
+		AutostartRequirement: schedule.TemplateAutostartRequirement{
+			DaysOfWeek: 0b01111111,
+		},
+	}
+	ny, err := time.LoadLocation("America/New_York")
+	require.NoError(t, err)
+
+	t.Run("NoExceptions", func(t *testing.T) {
+		t.Parallel()
+
+		// Thursday 24th December 2026, after the autostart.
+		at := time.Date(2026, time.December, 24, 10, 0, 0, 0, ny)
+		next, err := schedule.NextAllowedAutostartWithExceptions(at, sched, opts, nil)
+		require.NoError(t, err)
+		require.Equal(t, time.Date(2026, time.December, 25, 9, 0, 0, 0, ny), next)
+	})
+
+	t.Run("SkipsExceptionDates", func(t *testing.T) {
+		t.Parallel()
+
+		at := time.Date(2026, time.December, 24, 10, 0, 0, 0, ny)
+		exceptions := schedule.AutostartExceptions{
+			{
+				StartDate: time.Date(2026, time.December, 25, 0, 0, 0, 0, time.UTC),
+				EndDate:   time.Date(2026, time.December, 25, 0, 0, 0, 0, time.UTC),
+				Summary:   "Christmas Day",
+			},
+			{
+				StartDate: time.Date(2026, time.December, 28, 0, 0, 0, 0, time.UTC),
+				EndDate:   time.Date(2026, time.December, 31, 0, 0, 0, 0, time.UTC),
+				Source:    "out-of-office",
+			},
+		}
+		next, err := schedule.NextAllowedAutostartWithExceptions(at, sched, opts, exceptions)
+		require.NoError(t, err)
+		// Friday 1st January 2027.
+		require.Equal(t, time.Date(2027, time.January, 1, 9, 0, 0, 0, ny), next)
+	})
+
+	t.Run("DatesUseScheduleLocation", func(t *testing.T) {
+		t.Parallel()
+
+		// 9:00AM on the 25th in New York is already the 25th in UTC, but
+		// 11:00PM on the 24th in New York is the 25th in UTC. Only the
+		// former is on the exception date.
+		exception := schedule.AutostartException{
+			StartDate: time.Date(2026, time.December, 25, 0, 0, 0, 0, time.UTC),
+			EndDate:   time.Date(2026, time.December, 25, 0, 0, 0, 0, time.UTC),
+		}
+		require.True(t, exception.Contains(time.Date(2026, time.December, 25, 9, 0, 0, 0, ny)))
+		require.False(t, exception.Contains(time.Date(2026, time.December, 24, 23, 0, 0, 0, ny)))
+	})
+
+	t.Run("AllExcepted", func(t *testing.T) {
+		t.Parallel()
+
+		at := time.Date(2026, time.December, 24, 10, 0, 0, 0, ny)
+		exceptions := schedule.AutostartExceptions{{
+			StartDate: time.Date(2026, time.January, 1, 0, 0, 0, 0, time.UTC),
+			EndDate:   time.Date(2030, time.January, 1, 0, 0, 0, 0, time.UTC),
+		}}
+		_, err := schedule.NextAllowedAutostartWithExceptions(at, sched, opts, exceptions)
+		require.ErrorIs(t, err, schedule.ErrNoAllowedAutostart)
+	})
+}
+
+func TestParseICalExceptions(t *testing.T) {
+	t.Parallel()
+
+	now := time.Date(2026, time.October, 1, 12, 0, 0, 0, time.UTC)
+	date := func(y int, m time.Month, d int) time.Time {
+		return time.Date(y, m, d, 0, 0, 0, 0, time.UTC)
+	}
+
+	t.Run("Events", func(t *testing.T) {
+		t.Parallel()
+
+		data := "BEGIN:VCALENDAR\r\n" +
+			"VERSION:2.0\r\n" +
+			"BEGIN:VEVENT\r\n" +
+			"DTSTART;VALUE=DATE:20261225\r\n" +
+			"DTEND;VALUE=DATE:20261227\r\n" +
+			"SUMMARY:Christmas\\, and\r\n" +
+			"  Boxing Day\r\n" +
+			"END:VEVENT\r\n" +
+			"BEGIN:VEVENT\r\n" +
+			"DTSTART:20261111T090000Z\r\n" +
+			"DURATION:P1D\r\n" +
+			"SUMMARY:Company offsite\r\n" +
+			"END:VEVENT\r\n" +
+			"BEGIN:VEVENT\r\n" +
+			"DTSTART;VALUE=DATE:20260101\r\n" +
+			"SUMMARY:Already over\r\n" +
+			"END:VEVENT\r\n" +
+			"END:VCALENDAR\r\n"
+		exceptions, err := schedule.ParseICalExceptions([]byte(data), now)
+		require.NoError(t, err)
+		require.Equal(t, []schedule.AutostartException{
+			{StartDate: date(2026, time.December, 25), EndDate: date(2026, time.December, 26), Summary: "Christmas, and Boxing Day"},
+			{StartDate: date(2026, time.November, 11), EndDate: date(2026, time.November, 11), Summary: "Company offsite"},
+		}, exceptions)
+	})
+
+	t.Run("YearlyRecurrence", func(t *testing.T) {
+		t.Parallel()
+
+		data := "BEGIN:VEVENT\n" +
+			"DTSTART;VALUE=DATE:20200704\n" +
+			"RRULE:FREQ=YEARLY;BYMONTH=7;UNTIL=20280101\n" +
+			"SUMMARY:Independence Day\n" +
+			"END:VEVENT\n"
+		exceptions, err := schedule.ParseICalExceptions([]byte(data), now)
+		require.NoError(t, err)
+		require.Equal(t, []schedule.AutostartException{
+			{StartDate: date(2027, time.July, 4), EndDate: date(2027, time.July, 4), Summary: "Independence Day"},
+		}, exceptions)
+	})
+
+	t.Run("UnsupportedRecurrence", func(t *testing.T) {
+		t.Parallel()
+
+		data := "BEGIN:VEVENT\n" +
+			"DTSTART;VALUE=DATE:20261126\n" +
+			"RRULE:FREQ=YEARLY;BYMONTH=11;BYDAY=4TH\n" +
+			"END:VEVENT\n"
+		_, err := schedule.ParseICalExceptions([]byte(data), now)
+		require.ErrorContains(t, err, "BYDAY")
+	})
+
+	t.Run("Unterminated", func(t *testing.T) {
+		t.Parallel()
+
+		_, err := schedule.ParseICalExceptions([]byte("BEGIN:VEVENT\nDTSTART:20261225\n"), now)
+		require.Error(t, err)
+	})
+}
@@ -0,0 +1,273 @@
+package schedule
+
+import (
+	"bufio"
+	"bytes"
+	"strconv"
+	"strings"
+	"time"
+
+	"golang.org/x/xerrors"
+)
+
+// icalRecurrenceYears is how many years after 'now' open-ended yearly
+// recurrences are expanded to.
+const icalRecurrenceYears = 5
+
+// ParseICalExceptions reads the events of an iCalendar (RFC 5545) file as
+// autostart exceptions. Only the parts of the format used by holiday
+// calendars are supported: each VEVENT's DTSTART, DTEND or DURATION in days,
+// SUMMARY, and yearly RRULEs. Times of day are ignored, so an event covers
+// every date it touches. Occurrences that end before now are omitted.
+func ParseICalExceptions(data []byte, now time.Time) ([]AutostartException, error) {
+	lines, err := unfoldICalLines(data)
+	if err != nil {
+		return nil, err
+	}
+
+	today := civilDate(now)
+	var (
+		exceptions []AutostartException
+		event      map[string]icalProperty
+	)
+	for i, line := range lines {
+		prop, err := parseICalProperty(line)
+		if err != nil {
+			return nil, xerrors.Errorf("line %d: %w", i+1, err)
+		}
+		switch {
+		case prop.name == "BEGIN" && prop.value == "VEVENT":
+			event = map[string]icalProperty{}
+		case prop.name == "END" && prop.value == "VEVENT":
+			if event == nil {
+				return nil, xerrors.Errorf("line %d: END:VEVENT without BEGIN:VEVENT", i+1)
+			}
+			occurrences, err := icalEventOccurrences(event, today)
+			if err != nil {
+				return nil, xerrors.Errorf("event ending on line %d: %w", i+1, err)
+			}
+			exceptions = append(exceptions, occurrences...)
+			event = nil
+		case event != nil:
+			event[prop.name] = prop
+		}
+	}
+	if event != nil {
+		return nil, xerrors.New("unterminated VEVENT")
+	}
+	return exceptions, nil
+}
+
+type icalProperty struct {
+	name  string
+	value string
+}
+
+// unfoldICalLines splits data into content lines, joining lines that were
+// folded by starting continuations with whitespace.
+func unfoldICalLines(data []byte) ([]string, error) {
+	var lines []string
+	scanner := bufio.NewScanner(bytes.NewReader(data))
+	scanner.Buffer(make([]byte, 0, 4096), 1<<20)
+	for scanner.Scan() {
+		line := strings.TrimRight(scanner.Text(), "\r")
+		if line == "" {
+			continue
+		}
+		if (line[0] == ' ' || line[0] == '\t') && len(lines) > 0 {
+			lines[len(lines)-1] += line[1:]
+			continue
+		}
+		lines = append(lines, line)
+	}
+	if err := scanner.Err(); err != nil {
+		return nil, xerrors.Errorf("read calendar: %w", err)
+	}
+	return lines, nil
+}
+
+func parseICalProperty(line string) (icalProperty, error) {
+	head, value, ok := strings.Cut(line, ":")
+	if !ok {
+		return icalProperty{}, xerrors.Errorf("invalid content line %q", line)
+	}
+	// Parameters such as VALUE=DATE or TZID are not needed, since dates are
+	// read from the value and times of day are ignored.
+	name, _, _ := strings.Cut(head, ";")
+	return icalProperty{
+		name:  strings.ToUpper(name),
+		value: value,
+	}, nil
+}
+
+func icalEventOccurrences(event map[string]icalProperty, today time.Time) ([]AutostartException, error) {
+	dtstart, ok := event["DTSTART"]
+	if !ok {
+		return nil, xerrors.New("missing DTSTART")
+	}
+	start, _, err := parseICalDate(dtstart.value)
+	if err != nil {
+		return nil, xerrors.Errorf("DTSTART: %w", err)
+	}
+
+	// The end is inclusive. All-day events end the day before DTEND, as do
+	// events that end at midnight.
+	end := start
+	if dtend, ok := event["DTEND"]; ok {
+		var midnight bool
+		end, midnight, err = parseICalDate(dtend.value)
+		if err != nil {
+			return nil, xerrors.Errorf("DTEND: %w", err)
+		}
+		if midnight && end.After(start) {
+			end = end.AddDate(0, 0, -1)
+		}
+	} else if duration, ok := event["DURATION"]; ok {
+		days, err := parseICalDays(duration.value)
+		if err != nil {
+			return nil, xerrors.Errorf("DURATION: %w", err)
+		}
+		if days > 0 {
+			end = start.AddDate(0, 0, days-1)
+		}
+	}
+	if end.Before(start) {
+		return nil, xerrors.New("DTEND is before DTSTART")
+	}
+
+	summary := unescapeICalText(event["SUMMARY"].value)
+	rrule, ok := event["RRULE"]
+	if !ok {
+		if end.Before(today) {
+			return nil, nil
+		}
+		return []AutostartException{{StartDate: start, EndDate: end, Summary: summary}}, nil
+	}
+
+	rule, err := parseICalYearlyRule(rrule.value, start)
+	if err != nil {
+		return nil, xerrors.Errorf("RRULE: %w", err)
+	}
+	length := end.Sub(start)
+	limit := today.AddDate(icalRecurrenceYears, 0, 0)
+	var occurrences []AutostartException
+	for n := 0; rule.count == 0 || n < rule.count; n++ {
+		next := time.Date(start.Year()+n*rule.interval, start.Month(), start.Day(), 0, 0, 0, 0, time.UTC)
+		if next.After(limit) || (!rule.until.IsZero() && next.After(rule.until)) {
+			break
+		}
+		// Events on the 29th of February only occur in leap years.
+		if next.Month() != start.Month() {
+			continue
+		}
+		if next.Add(length).Before(today) {
+			continue
+		}
+		occurrences = append(occurrences, AutostartException{
+			StartDate: next,
+			EndDate:   next.Add(length),
+			Summary:   summary,
+		})
+	}
+	return occurrences, nil
+}
+
+// parseICalDate parses a DATE or DATE-TIME value, discarding the time of
+// day. The boolean reports whether the value is at the start of the day.
+func parseICalDate(value string) (time.Time, bool, error) {
+	if len(value) < 8 {
+		return time.Time{}, false, xerrors.Errorf("invalid date %q", value)
+	}
+	date, err := time.Parse("20060102", value[:8])
+	if err != nil {
+		return time.Time{}, false, xerrors.Errorf("invalid date %q", value)
+	}
+	rest := value[8:]
+	if rest == "" {
+		return date, true, nil
+	}
+	if rest[0] != 'T' || len(rest) < 7 {
+		return time.Time{}, false, xerrors.Errorf("invalid date-time %q", value)
+	}
+	return date, rest[1:7] == "000000", nil
+}
+
+// parseICalDays parses a DURATION made up of whole days or weeks, such as
+// "P1D" or "P2W".
+func parseICalDays(value string) (int, error) {
+	value = strings.TrimPrefix(value, "+")
+	if !strings.HasPrefix(value, "P") || len(value) < 3 {
+		return 0, xerrors.Errorf("invalid duration %q", value)
+	}
+	n, err := strconv.Atoi(value[1 : len(value)-1])
+	if err != nil || n < 0 {
+		return 0, xerrors.Errorf("invalid duration %q", value)
+	}
+	switch value[len(value)-1] {
+	case 'D':
+		return n, nil
+	case 'W':
+		return n * 7, nil
+	default:
+		return 0, xerrors.Errorf("unsupported duration %q, only days and weeks are supported", value)
+	}
+}
+
+type icalYearlyRule struct {
+	interval int
+	count    int
+	until    time.Time
+}
+
+// parseICalYearlyRule parses an RRULE that repeats an event on the date of
+// its DTSTART every year or every few years.
+func parseICalYearlyRule(value string, start time.Time) (icalYearlyRule, error) {
+	rule := icalYearlyRule{interval: 1}
+	var yearly bool
+	for _, part := range strings.Split(value, ";") {
+		k, v, _ := strings.Cut(part, "=")
+		var err error
+		switch strings.ToUpper(k) {
+		case "FREQ":
+			yearly = strings.EqualFold(v, "YEARLY")
+		case "INTERVAL":
+			rule.interval, err = strconv.Atoi(v)
+			if err == nil && rule.interval < 1 {
+				err = xerrors.New("must be positive")
+			}
+		case "COUNT":
+			rule.count, err = strconv.Atoi(v)
+			if err == nil && rule.count < 1 {
+				err = xerrors.New("must be positive")
+			}
+		case "UNTIL":
+			rule.until, _, err = parseICalDate(v)
+		case "BYMONTH":
+			// Calendar applications often restate the month of DTSTART.
+			if v != strconv.Itoa(int(start.Month())) {
+				err = xerrors.New("must match the month of DTSTART")
+			}
+		case "BYMONTHDAY":
+			if v != strconv.Itoa(start.Day()) {
+				err = xerrors.New("must match the day of DTSTART")
+			}
+		case "WKST":
+			// The start of the week does not affect yearly rules.
+		default:
+			return icalYearlyRule{}, xerrors.Errorf("unsupported rule part %q", k)
+		}
+		if err != nil {
+			return icalYearlyRule{}, xerrors.Errorf("%s: %w", k, err)
+		}
+	}
+	if !yearly {
+		return icalYearlyRule{}, xerrors.Errorf("unsupported rule %q, only yearly recurrences are supported", value)
+	}
+	return rule, nil
+}
+
+var icalTextUnescaper = strings.NewReplacer(`\\`, `\`, `\,`, ",", `\;`, ";", `\n`, "\n", `\N`, "\n")
+
+func unescapeICalText(value string) string {
+	return icalTextUnescaper.Replace(value)
+}
@@ -0,0 +1,640 @@
+package coderd
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+	"time"
+
+	"github.com/google/uuid"
+	"golang.org/x/xerrors"
+
+	"github.com/coder/coder/v2/coderd/database"
+	"github.com/coder/coder/v2/coderd/database/db2sdk"
+	"github.com/coder/coder/v2/coderd/database/dbtime"
+	"github.com/coder/coder/v2/coderd/httpapi"
+	"github.com/coder/coder/v2/coderd/httpmw"
+	"github.com/coder/coder/v2/coderd/schedule"
+	"github.com/coder/coder/v2/coderd/util/slice"
+	"github.com/coder/coder/v2/codersdk"
+)
+
+// maxScheduleExceptionDates limits the number of date ranges in a calendar,
+// which matters mostly for calendars imported from iCal with yearly
+// recurrences.
+const maxScheduleExceptionDates = 1000
+
+// maxICalRequestBytes allows for calendar files with many years of holidays.
+const maxICalRequestBytes = 4 << 20
+
+// @Summary Get schedule exception calendars by organization
+// @ID get-schedule-exception-calendars-by-organization
+// @Security CoderSessionToken
+// @Produce json
+// @Tags Templates
+// @Param organization path string true "Organization ID" format(uuid)
+// @Success 200 {array} codersdk.ScheduleExceptionCalendar
+// @Router /api/v2/organizations/{organization}/schedule-exception-calendars [get]
+func (api *API) scheduleExceptionCalendars(rw http.ResponseWriter, r *http.Request) {
+	var (
+		ctx = r.Context()
+		org = httpmw.OrganizationParam(r)
+	)
+
+	calendars, err := api.Database.GetScheduleExceptionCalendarsByOrganizationID(ctx, org.ID)
+	if httpapi.IsUnauthorizedError(err) {
+		httpapi.Forbidden(rw)
+		return
+	}
+	if err != nil {
+		httpapi.Write(ctx, rw, http.StatusInternalServerError, codersdk.Response{
+			Message: "Internal error fetching schedule exception calendars.",
+			Detail:  err.Error(),
+		})
+		return
+	}
+
+	httpapi.Write(ctx, rw, http.StatusOK, slice.List(calendars, db2sdk.ScheduleExceptionCalendar))
+}
+
+// @Summary Create schedule exception calendar
+// @ID create-schedule-exception-calendar
+// @Security CoderSessionToken
+// @Accept json
+// @Produce json
+// @Tags Templates
+// @Param organization path string true "Organization ID" format(uuid)
+// @Param request body codersdk.CreateScheduleExceptionCalendarRequest true "Create calendar request"
+// @Success 201 {object} codersdk.ScheduleExceptionCalendar
+// @Router /api/v2/organizations/{organization}/schedule-exception-calendars [post]
+func (api *API) postScheduleExceptionCalendar(rw http.ResponseWriter, r *http.Request) {
+	var (
+		ctx = r.Context()
+		org = httpmw.OrganizationParam(r)
+	)
+
+	var req codersdk.CreateScheduleExceptionCalendarRequest
+	if !httpapi.ReadLimit(ctx, rw, r, maxICalRequestBytes, &req) {
+		return
+	}
+	dates, ok := api.scheduleExceptionDates(rw, r, req.Dates, req.ICal)
+	if !ok {
+		return
+	}
+
+	var calendar database.ScheduleExceptionCalendar
+	err := api.Database.InTx(func(tx database.Store) error {
+		now := dbtime.Time(api.Clock.Now())
+		var err error
+		calendar, err = tx.InsertScheduleExceptionCalendar(ctx, database.InsertScheduleExceptionCalendarParams{
+			ID:             uuid.New(),
+			OrganizationID: org.ID,
+			Name:           req.Name,
+			Description:    req.Description,
+			CreatedAt:      now,
+			UpdatedAt:      now,
+		})
+		if err != nil {
+			return err
+		}
+		dates.CalendarID = calendar.ID
+		return tx.InsertScheduleExceptionCalendarDates(ctx, dates)
+	}, nil)
+	if !api.writeScheduleExceptionCalendarError(rw, r, err) {
+		return
+	}
+
+	sdkCalendar := db2sdk.ScheduleExceptionCalendar(calendar)
+	sdkCalendar.Dates = scheduleExceptionDateRanges(calendar.ID, dates)
+	httpapi.Write(ctx, rw, http.StatusCreated, sdkCalendar)
+}
+
+// @Summary Get schedule exception calendar
+// @ID get-schedule-exception-calendar
+// @Security CoderSessionToken
+// @Produce json
+// @Tags Templates
+// @Param organization path string true "Organization ID" format(uuid)
+// @Param calendar path string true "Calendar ID" format(uuid)
+// @Success 200 {object} codersdk.ScheduleExceptionCalendar
+// @Router /api/v2/organizations/{organization}/schedule-exception-calendars/{calendar} [get]
+func (api *API) scheduleExceptionCalendar(rw http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	calendar, ok := api.scheduleExceptionCalendarParam(rw, r)
+	if !ok {
+		return
+	}
+
+	dates, err := api.Database.GetScheduleExceptionCalendarDates(ctx, calendar.ID)
+	if err != nil {
+		httpapi.Write(ctx, rw, http.StatusInternalServerError, codersdk.Response{
+			Message: "Internal error fetching schedule exception calendar dates.",
+			Detail:  err.Error(),
+		})
+		return
+	}
+
+	sdkCalendar := db2sdk.ScheduleExceptionCalendar(calendar)
+	sdkCalendar.Dates = db2sdk.ScheduleExceptionDateRanges(dates)
+	httpapi.Write(ctx, rw, http.StatusOK, sdkCalendar)
+}
+
+// @Summary Update schedule exception calendar
+// @ID update-schedule-exception-calendar
+// @Security CoderSessionToken
+// @Accept json
+// @Produce json
+// @Tags Templates
+// @Param organization path string true "Organization ID" format(uuid)
+// @Param calendar path string true "Calendar ID" format(uuid)
+// @Param request body codersdk.UpdateScheduleExceptionCalendarRequest true "Update calendar request"
+// @Success 200 {object} codersdk.ScheduleExceptionCalendar
+// @Router /api/v2/organizations/{organization}/schedule-exception-calendars/{calendar} [put]
+func (api *API) putScheduleExceptionCalendar(rw http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	calendar, ok := api.scheduleExceptionCalendarParam(rw, r)
+	if !ok {
+		return
+	}
+
+	var req codersdk.UpdateScheduleExceptionCalendarRequest
+	if !httpapi.ReadLimit(ctx, rw, r, maxICalRequestBytes, &req) {
+		return
+	}
+	dates, ok := api.scheduleExceptionDates(rw, r, req.Dates, req.ICal)
+	if !ok {
+		return
+	}
+	dates.CalendarID = calendar.ID
+
+	err := api.Database.InTx(func(tx database.Store) error {
+		var err error
+		calendar, err = tx.UpdateScheduleExceptionCalendarByID(ctx, database.UpdateScheduleExceptionCalendarByIDParams{
+			ID:          calendar.ID,
+			Name:        req.Name,
+			Description: req.Description,
+			UpdatedAt:   dbtime.Time(api.Clock.Now()),
+		})
+		if err != nil {
+			return err
+		}
+		if err := tx.DeleteScheduleExceptionCalendarDates(ctx, calendar.ID); err != nil {
+			return err
+		}
+		return tx.InsertScheduleExceptionCalendarDates(ctx, dates)
+	}, nil)
+	if !api.writeScheduleExceptionCalendarError(rw, r, err) {
+		return
+	}
+
+	sdkCalendar := db2sdk.ScheduleExceptionCalendar(calendar)
+	sdkCalendar.Dates = scheduleExceptionDateRanges(calendar.ID, dates)
+	httpapi.Write(ctx, rw, http.StatusOK, sdkCalendar)
+}
+
+// @Summary Delete schedule exception calendar
+// @ID delete-schedule-exception-calendar
+// @Security CoderSessionToken
+// @Tags Templates
+// @Param organization path string true "Organization ID" format(uuid)
+// @Param calendar path string true "Calendar ID" format(uuid)
+// @Success 204
+// @Router /api/v2/organizations/{organization}/schedule-exception-calendars/{calendar} [delete]
+func (api *API) deleteScheduleExceptionCalendar(rw http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	calendar, ok := api.scheduleExceptionCalendarParam(rw, r)
+	if !ok {
+		return
+	}
+
+	err := api.Database.DeleteScheduleExceptionCalendarByID(ctx, calendar.ID)
+	if httpapi.IsUnauthorizedError(err) {
+		httpapi.Forbidden(rw)
+		return
+	}
+	if err != nil {
+		httpapi.Write(ctx, rw, http.StatusInternalServerError, codersdk.Response{
+			Message: "Internal error deleting schedule exception calendar.",
+			Detail:  err.Error(),
+		})
+		return
+	}
+
+	rw.WriteHeader(http.StatusNoContent)
+}
+
+// @Summary Get template schedule exception calendars
+// @ID get-template-schedule-exception-calendars
+// @Security CoderSessionToken
+// @Produce json
+// @Tags Templates
+// @Param template path string true "Template ID" format(uuid)
+// @Success 200 {array} codersdk.ScheduleExceptionCalendar
+// @Router /api/v2/templates/{template}/schedule-exception-calendars [get]
+func (api *API) templateScheduleExceptionCalendars(rw http.ResponseWriter, r *http.Request) {
+	var (
+		ctx      = r.Context()
+		template = httpmw.TemplateParam(r)
+	)
+
+	calendars, err := api.Database.GetScheduleExceptionCalendarsByTemplateID(ctx, template.ID)
+	if err != nil {
+		httpapi.Write(ctx, rw, http.StatusInternalServerError, codersdk.Response{
+			Message: "Internal error fetching template schedule exception calendars.",
+			Detail:  err.Error(),
+		})
+		return
+	}
+
+	httpapi.Write(ctx, rw, http.StatusOK, slice.List(calendars, db2sdk.ScheduleExceptionCalendar))
+}
+
+// @Summary Update template schedule exception calendars
+// @ID update-template-schedule-exception-calendars
+// @Security CoderSessionToken
+// @Accept json
+// @Produce json
+// @Tags Templates
+// @Param template path string true "Template ID" format(uuid)
+// @Param request body codersdk.UpdateTemplateScheduleExceptionCalendarsRequest true "Calendars to attach"
+// @Success 200 {array} codersdk.ScheduleExceptionCalendar
+// @Router /api/v2/templates/{template}/schedule-exception-calendars [put]
+func (api *API) putTemplateScheduleExceptionCalendars(rw http.ResponseWriter, r *http.Request) {
+	var (
+		ctx      = r.Context()
+		template = httpmw.TemplateParam(r)
+	)
+
+	var req codersdk.UpdateTemplateScheduleExceptionCalendarsRequest
+	if !httpapi.Read(ctx, rw, r, &req) {
+		return
+	}
+
+	var calendars []database.ScheduleExceptionCalendar
+	err := api.Database.InTx(func(tx database.Store) error {
+		if err := tx.DeleteTemplateScheduleExceptionCalendars(ctx, template.ID); err != nil {
+			return err
+		}
+		seen := make(map[uuid.UUID]bool, len(req.CalendarIDs))
+		ids := make([]uuid.UUID, 0, len(req.CalendarIDs))
+		for _, id := range req.CalendarIDs {
+			if seen[id] {
+				continue
+			}
+			seen[id] = true
+			calendar, err := tx.GetScheduleExceptionCalendarByID(ctx, id)
+			if err != nil {
+				if httpapi.Is404Error(err) {
+					return errScheduleExceptionCalendarNotFound{id: id}
+				}
+				return err
+			}
+			if calendar.OrganizationID != template.OrganizationID {
+				return errScheduleExceptionCalendarNotFound{id: id}
+			}
+			ids = append(ids, id)
+		}
+		if err := tx.InsertTemplateScheduleExceptionCalendars(ctx, database.InsertTemplateScheduleExceptionCalendarsParams{
+			TemplateID:  template.ID,
+			CalendarIDs: ids,
+		}); err != nil {
+			return err
+		}
+		var err error
+		calendars, err = tx.GetScheduleExceptionCalendarsByTemplateID(ctx, template.ID)
+		return err
+	}, nil)
+	var notFound errScheduleExceptionCalendarNotFound
+	if errors.As(err, &notFound) {
+		httpapi.Write(ctx, rw, http.StatusBadRequest, codersdk.Response{
+			Message: "Invalid schedule exception calendar.",
+			Validations: []codersdk.ValidationError{{
+				Field:  "calendar_ids",
+				Detail: fmt.Sprintf("Calendar %s does not exist in the template's organization.", notFound.id),
+			}},
+		})
+		return
+	}
+	if httpapi.IsUnauthorizedError(err) {
+		httpapi.Forbidden(rw)
+		return
+	}
+	if err != nil {
+		httpapi.Write(ctx, rw, http.StatusInternalServerError, codersdk.Response{
+			Message: "Internal error updating template schedule exception calendars.",
+			Detail:  err.Error(),
+		})
+		return
+	}
+
+	httpapi.Write(ctx, rw, http.StatusOK, slice.List(calendars, db2sdk.ScheduleExceptionCalendar))
+}
+
+// @Summary Get user out of office ranges
+// @ID get-user-out-of-office-ranges
+// @Security CoderSessionToken
+// @Produce json
+// @Tags Users
+// @Param user path string true "User ID, name, or me"
+// @Success 200 {array} codersdk.OutOfOfficeRange
+// @Router /api/v2/users/{user}/out-of-office [get]
+func (api *API) outOfOfficeRanges(rw http.ResponseWriter, r *http.Request) {
+	var (
+		ctx  = r.Context()
+		user = httpmw.UserParam(r)
+	)
+
+	ranges, err := api.Database.GetUserOutOfOfficeRangesByUserID(ctx, user.ID)
+	if httpapi.IsUnauthorizedError(err) {
+		httpapi.ResourceNotFound(rw)
+		return
+	}
+	if err != nil {
+		httpapi.Write(ctx, rw, http.StatusInternalServerError, codersdk.Response{
+			Message: "Internal error fetching out of office ranges.",
+			Detail:  err.Error(),
+		})
+		return
+	}
+
+	httpapi.Write(ctx, rw, http.StatusOK, slice.List(ranges, db2sdk.OutOfOfficeRange))
+}
+
+// @Summary Create user out of office range
+// @ID create-user-out-of-office-range
+// @Security CoderSessionToken
+// @Accept json
+// @Produce json
+// @Tags Users
+// @Param user path string true "User ID, name, or me"
+// @Param request body codersdk.CreateOutOfOfficeRangeRequest true "Out of office range"
+// @Success 201 {object} codersdk.OutOfOfficeRange
+// @Router /api/v2/users/{user}/out-of-office [post]
+func (api *API) postOutOfOfficeRange(rw http.ResponseWriter, r *http.Request) {
+	var (
+		ctx  = r.Context()
+		user = httpmw.UserParam(r)
+	)
+
+	var req codersdk.CreateOutOfOfficeRangeRequest
+	if !httpapi.Read(ctx, rw, r, &req) {
+		return
+	}
+	start, end, err := parseScheduleExceptionDateRange(req.StartDate, req.EndDate)
+	if err != nil {
+		httpapi.Write(ctx, rw, http.StatusBadRequest, codersdk.Response{
+			Message:     "Invalid out of office range.",
+			Validations: []codersdk.ValidationError{{Field: "start_date", Detail: err.Error()}},
+		})
+		return
+	}
+
+	ooo, err := api.Database.InsertUserOutOfOfficeRange(ctx, database.InsertUserOutOfOfficeRangeParams{
+		ID:        uuid.New(),
+		UserID:    user.ID,
+		StartDate: start,
+		EndDate:   end,
+		Reason:    req.Reason,
+		CreatedAt: dbtime.Time(api.Clock.Now()),
+	})
+	if httpapi.IsUnauthorizedError(err) {
+		httpapi.Forbidden(rw)
+		return
+	}
+	if err != nil {
+		httpapi.Write(ctx, rw, http.StatusInternalServerError, codersdk.Response{
+			Message: "Internal error creating out of office range.",
+			Detail:  err.Error(),
+		})
+		return
+	}
+
+	httpapi.Write(ctx, rw, http.StatusCreated, db2sdk.OutOfOfficeRange(ooo))
+}
+
+// @Summary Delete user out of office range
+// @ID delete-user-out-of-office-range
+// @Security CoderSessionToken
+// @Tags Users
+// @Param user path string true "User ID, name, or me"
+// @Param range path string true "Out of office range ID" format(uuid)
+// @Success 204
+// @Router /api/v2/users/{user}/out-of-office/{range} [delete]
+func (api *API) deleteOutOfOfficeRange(rw http.ResponseWriter, r *http.Request) {
+	var (
+		ctx  = r.Context()
+		user = httpmw.UserParam(r)
+	)
+	id, ok := httpmw.ParseUUIDParam(rw, r, "range")
+	if !ok {
+		return
+	}
+
+	err := api.Database.DeleteUserOutOfOfficeRange(ctx, database.DeleteUserOutOfOfficeRangeParams{
+		ID:     id,
+		UserID: user.ID,
+	})
+	if httpapi.IsUnauthorizedError(err) {
+		httpapi.Forbidden(rw)
+		return
+	}
+	if err != nil {
+		httpapi.Write(ctx, rw, http.StatusInternalServerError, codersdk.Response{
+			Message: "Internal error deleting out of office range.",
+			Detail:  err.Error(),
+		})
+		return
+	}
+
+	rw.WriteHeader(http.StatusNoContent)
+}
+
+// @Summary Get workspace autostart exceptions
+// @ID get-workspace-autostart-exceptions
+// @Security CoderSessionToken
+// @Produce json
+// @Tags Workspaces
+// @Param workspace path string true "Workspace ID" format(uuid)
+// @Success 200 {object} codersdk.WorkspaceAutostartExceptions
+// @Router /api/v2/workspaces/{workspace}/autostart-exceptions [get]
+func (api *API) workspaceAutostartExceptions(rw http.ResponseWriter, r *http.Request) {
+	var (
+		ctx       = r.Context()
+		workspace = httpmw.WorkspaceParam(r)
+		now       = api.Clock.Now()
+	)
+
+	exceptions, err := schedule.GetAutostartExceptions(ctx, api.Database, workspace.ID, now)
+	if err != nil {
+		httpapi.Write(ctx, rw, http.StatusInternalServerError, codersdk.Response{
+			Message: "Internal error fetching workspace autostart exceptions.",
+			Detail:  err.Error(),
+		})
+		return
+	}
+
+	resp := codersdk.WorkspaceAutostartExceptions{
+		Exceptions: make([]codersdk.WorkspaceAutostartException, 0, len(exceptions)),
+	}
+	for _, e := range exceptions {
+		resp.Exceptions = append(resp.Exceptions, codersdk.WorkspaceAutostartException{
+			StartDate: e.StartDate.Format(codersdk.ScheduleExceptionDateFormat),
+			EndDate:   e.EndDate.Format(codersdk.ScheduleExceptionDateFormat),
+			Source:    e.Source,
+			Summary:   e.Summary,
+		})
+	}
+
+	if workspace.AutostartSchedule.Valid && len(exceptions) > 0 {
+		templateSchedule, err := (*api.TemplateScheduleStore.Load()).Get(ctx, api.Database, workspace.TemplateID)
+		if err != nil {
+			httpapi.Write(ctx, rw, http.StatusInternalServerError, codersdk.Response{
+				Message: "Internal error getting template schedule options.",
+				Detail:  err.Error(),
+			})
+			return
+		}
+		if templateSchedule.UserAutostartEnabled {
+			if next, _, ok := schedule.NextSkippedAutostart(now, workspace.AutostartSchedule.String, templateSchedule, exceptions); ok {
+				resp.NextSkippedAutostart = &next
+			}
+		}
+	}
+
+	httpapi.Write(ctx, rw, http.StatusOK, resp)
+}
+
+type errScheduleExceptionCalendarNotFound struct {
+	id uuid.UUID
+}
+
+func (e errScheduleExceptionCalendarNotFound) Error() string {
+	return fmt.Sprintf("schedule exception calendar %s not found", e.id)
+}
+
+// scheduleExceptionCalendarParam fetches the calendar in the URL, which must
+// belong to the organization in the URL.
+func (api *API) scheduleExceptionCalendarParam(rw http.ResponseWriter, r *http.Request) (database.ScheduleExceptionCalendar, bool) {
+	var (
+		ctx = r.Context()
+		org = httpmw.OrganizationParam(r)
+	)
+	id, ok := httpmw.ParseUUIDParam(rw, r, "calendar")
+	if !ok {
+		return database.ScheduleExceptionCalendar{}, false
+	}
+
+	calendar, err := api.Database.GetScheduleExceptionCalendarByID(ctx, id)
+	if httpapi.Is404Error(err) || (err == nil && calendar.OrganizationID != org.ID) {
+		httpapi.ResourceNotFound(rw)
+		return database.ScheduleExceptionCalendar{}, false
+	}
+	if err != nil {
+		httpapi.Write(ctx, rw, http.StatusInternalServerError, codersdk.Response{
+			Message: "Internal error fetching schedule exception calendar.",
+			Detail:  err.Error(),
+		})
+		return database.ScheduleExceptionCalendar{}, false
+	}
+	return calendar, true
+}
+
+// scheduleExceptionDates validates the date ranges and iCal data of a create
+// or update request, and returns them ready to insert.
+func (api *API) scheduleExceptionDates(rw http.ResponseWriter, r *http.Request, ranges []codersdk.ScheduleExceptionDateRange, ical string) (database.InsertScheduleExceptionCalendarDatesParams, bool) {
+	ctx := r.Context()
+	var params database.InsertScheduleExceptionCalendarDatesParams
+	for i, dr := range ranges {
+		start, end, err := parseScheduleExceptionDateRange(dr.StartDate, dr.EndDate)
+		if err != nil {
+			httpapi.Write(ctx, rw, http.StatusBadRequest, codersdk.Response{
+				Message:     "Invalid schedule exception dates.",
+				Validations: []codersdk.ValidationError{{Field: fmt.Sprintf("dates[%d]", i), Detail: err.Error()}},
+			})
+			return params, false
+		}
+		params.StartDates = append(params.StartDates, start)
+		params.EndDates = append(params.EndDates, end)
+		params.Summaries = append(params.Summaries, dr.Summary)
+	}
+
+	if ical != "" {
+		events, err := schedule.ParseICalExceptions([]byte(ical), api.Clock.Now())
+		if err != nil {
+			httpapi.Write(ctx, rw, http.StatusBadRequest, codersdk.Response{
+				Message:     "Invalid iCal data.",
+				Validations: []codersdk.ValidationError{{Field: "ical", Detail: err.Error()}},
+			})
+			return params, false
+		}
+		for _, event := range events {
+			params.StartDates = append(params.StartDates, event.StartDate)
+			params.EndDates = append(params.EndDates, event.EndDate)
+			params.Summaries = append(params.Summaries, event.Summary)
+		}
+	}
+
+	if len(params.StartDates) > maxScheduleExceptionDates {
+		httpapi.Write(ctx, rw, http.StatusBadRequest, codersdk.Response{
+			Message: fmt.Sprintf("A calendar may have at most %d date ranges, got %d.", maxScheduleExceptionDates, len(params.StartDates)),
+		})
+		return params, false
+	}
+	return params, true
+}
+
+// writeScheduleExceptionCalendarError writes the response for an error from
+// creating or updating a calendar, returning true if there was no error.
+func (*API) writeScheduleExceptionCalendarError(rw http.ResponseWriter, r *http.Request, err error) bool {
+	ctx := r.Context()
+	switch {
+	case err == nil:
+		return true
+	case database.IsUniqueViolation(err, database.UniqueScheduleExceptionCalendarsOrganizationIDNameKey):
+		httpapi.Write(ctx, rw, http.StatusConflict, codersdk.Response{
+			Message:     "A schedule exception calendar with this name already exists.",
+			Validations: []codersdk.ValidationError{{Field: "name", Detail: "This value is already in use and should be unique."}},
+		})
+	case httpapi.IsUnauthorizedError(err):
+		httpapi.Forbidden(rw)
+	default:
+		httpapi.Write(ctx, rw, http.StatusInternalServerError, codersdk.Response{
+			Message: "Internal error saving schedule exception calendar.",
+			Detail:  err.Error(),
+		})
+	}
+	return false
+}
+
+// parseScheduleExceptionDateRange parses an inclusive range of dates. The end
+// defaults to the start.
+func parseScheduleExceptionDateRange(startDate, endDate string) (time.Time, time.Time, error) {
+	start, err := time.Parse(codersdk.ScheduleExceptionDateFormat, startDate)
+	if err != nil {
+		return time.Time{}, time.Time{}, xerrors.Errorf("start date %q must be formatted as YYYY-MM-DD", startDate)
+	}
+	if endDate == "" {
+		return start, start, nil
+	}
+	end, err := time.Parse(codersdk.ScheduleExceptionDateFormat, endDate)
+	if err != nil {
+		return time.Time{}, time.Time{}, xerrors.Errorf("end date %q must be formatted as YYYY-MM-DD", endDate)
+	}
+	if end.Before(start) {
+		return time.Time{}, time.Time{}, xerrors.Errorf("end date %s is before start date %s", endDate, startDate)
+	}
+	return start, end, nil
+}
+
+func scheduleExceptionDateRanges(calendarID uuid.UUID, params database.InsertScheduleExceptionCalendarDatesParams) []codersdk.ScheduleExceptionDateRange {
+	dates := make([]database.ScheduleExceptionCalendarDate, 0, len(params.StartDates))
+	for i := range params.StartDates {
+		dates = append(dates, database.ScheduleExceptionCalendarDate{
+			CalendarID: calendarID,
+			StartDate:  params.StartDates[i],
+			EndDate:    params.EndDates[i],
+			Summary:    params.Summaries[i],
+		})
+	}
+	return db2sdk.ScheduleExceptionDateRanges(dates)
+}
@@ -0,0 +1,217 @@
+package coderd_test
+
+import (
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/require"
+
+	"github.com/coder/coder/v2/coderd/coderdtest"
+	"github.com/coder/coder/v2/coderd/database"
+	"github.com/coder/coder/v2/coderd/database/dbfake"
+	"github.com/coder/coder/v2/coderd/schedule/cron"
+	"github.com/coder/coder/v2/codersdk"
+	"github.com/coder/coder/v2/testutil"
+)
+
+func TestScheduleExceptionCalendars(t *testing.T) {
+	t.Parallel()
+
+	ownerClient := coderdtest.New(t, nil)
+	owner := coderdtest.CreateFirstUser(t, ownerClient)
+	memberClient, _ := coderdtest.CreateAnotherUser(t, ownerClient, owner.OrganizationID)
+	ctx := testutil.Context(t, testutil.WaitLong)
+
+	ical := "BEGIN:VCALENDAR\r\n" +
+		"BEGIN:VEVENT\r\n" +
+		"DTSTART;VALUE=DATE:20991225\r\n" +
+		"SUMMARY:Christmas Day\r\n" +
+		"END:VEVENT\r\n" +
+		"END:VCALENDAR\r\n"
+	calendar, err := ownerClient.CreateScheduleExceptionCalendar(ctx, owner.OrganizationID, codersdk.CreateScheduleExceptionCalendarRequest{
+		Name: "holidays",
+		Dates: []codersdk.ScheduleExceptionDateRange{
+			{StartDate: "2099-01-01", Summary: "New Year's Day"},
+		},
+		ICal: ical,
+	})
+	require.NoError(t, err)
+	require.Equal(t, []codersdk.ScheduleExceptionDateRange{
+		{StartDate: "2099-01-01", EndDate: "2099-01-01", Summary: "New Year's Day"},
+		{StartDate: "2099-12-25", EndDate: "2099-12-25", Summary: "Christmas Day"},
+	}, calendar.Dates)
+
+	t.Run("DuplicateName", func(t *testing.T) {
+		t.Parallel()
+
+		_, err := ownerClient.CreateScheduleExceptionCalendar(ctx, owner.OrganizationID, codersdk.CreateScheduleExceptionCalendarRequest{
+			Name: "holidays",
+		})
+		var apiErr *codersdk.Error
+		require.ErrorAs(t, err, &apiErr)
+		require.Equal(t, http.StatusConflict, apiErr.StatusCode())
+	})
+
+	t.Run("InvalidDates", func(t *testing.T) {
+		t.Parallel()
+
+		_, err := ownerClient.CreateScheduleExceptionCalendar(ctx, owner.OrganizationID, codersdk.CreateScheduleExceptionCalendarRequest{
+			Name: "invalid",
+			Dates: []codersdk.ScheduleExceptionDateRange{
+				{StartDate: "2099-01-02", EndDate: "2099-01-01"},
+			},
+		})
+		var apiErr *codersdk.Error
+		require.ErrorAs(t, err, &apiErr)
+		require.Equal(t, http.StatusBadRequest, apiErr.StatusCode())
+	})
+
+	t.Run("MemberCannotCreate", func(t *testing.T) {
+		t.Parallel()
+
+		_, err := memberClient.CreateScheduleExceptionCalendar(ctx, owner.OrganizationID, codersdk.CreateScheduleExceptionCalendarRequest{
+			Name: "member",
+		})
+		var apiErr *codersdk.Error
+		require.ErrorAs(t, err, &apiErr)
+		require.Equal(t, http.StatusForbidden, apiErr.StatusCode())
+	})
+
+	t.Run("Update", func(t *testing.T) {
+		t.Parallel()
+
+		created, err := ownerClient.CreateScheduleExceptionCalendar(ctx, owner.OrganizationID, codersdk.CreateScheduleExceptionCalendarRequest{
+			Name: "shutdown",
+			Dates: []codersdk.ScheduleExceptionDateRange{
+				{StartDate: "2099-12-24", EndDate: "2099-12-31"},
+			},
+		})
+		require.NoError(t, err)
+
+		updated, err := ownerClient.UpdateScheduleExceptionCalendar(ctx, owner.OrganizationID, created.ID, codersdk.UpdateScheduleExceptionCalendarRequest{
+			Name:        "winter-shutdown",
+			Description: "Office closed",
+			Dates: []codersdk.ScheduleExceptionDateRange{
+				{StartDate: "2099-12-27", EndDate: "2099-12-31"},
+			},
+		})
+		require.NoError(t, err)
+		require.Equal(t, "winter-shutdown", updated.Name)
+
+		fetched, err := ownerClient.ScheduleExceptionCalendar(ctx, owner.OrganizationID, created.ID)
+		require.NoError(t, err)
+		require.Equal(t, "Office closed", fetched.Description)
+		require.Equal(t, []codersdk.ScheduleExceptionDateRange{
+			{StartDate: "2099-12-27", EndDate: "2099-12-31"},
+		}, fetched.Dates)
+
+		err = ownerClient.DeleteScheduleExceptionCalendar(ctx, owner.OrganizationID, created.ID)
+		require.NoError(t, err)
+		_, err = ownerClient.ScheduleExceptionCalendar(ctx, owner.OrganizationID, created.ID)
+		var apiErr *codersdk.Error
+		require.ErrorAs(t, err, &apiErr)
+		require.Equal(t, http.StatusNotFound, apiErr.StatusCode())
+	})
+}
+
+func TestWorkspaceAutostartExceptions(t *testing.T) {
+	t.Parallel()
+
+	ownerClient, db := coderdtest.NewWithDatabase(t, nil)
+	owner := coderdtest.CreateFirstUser(t, ownerClient)
+	memberClient, member := coderdtest.CreateAnotherUser(t, ownerClient, owner.OrganizationID)
+	ctx := testutil.Context(t, testutil.WaitLong)
+
+	resp := dbfake.WorkspaceBuild(t, db, database.WorkspaceTable{
+		OwnerID:        member.ID,
+		OrganizationID: owner.OrganizationID,
+	}).Do()
+	workspace := resp.Workspace
+
+	calendar, err := ownerClient.CreateScheduleExceptionCalendar(ctx, owner.OrganizationID, codersdk.CreateScheduleExceptionCalendarRequest{
+		Name: "holidays",
+		Dates: []codersdk.ScheduleExceptionDateRange{
+			{StartDate: "2099-12-25", Summary: "Christmas Day"},
+		},
+	})
+	require.NoError(t, err)
+
+	attached, err := ownerClient.UpdateTemplateScheduleExceptionCalendars(ctx, workspace.TemplateID, codersdk.UpdateTemplateScheduleExceptionCalendarsRequest{
+		CalendarIDs: []uuid.UUID{calendar.ID},
+	})
+	require.NoError(t, err)
+	require.Len(t, attached, 1)
+	require.Equal(t, calendar.ID, attached[0].ID)
+
+	ooo, err := memberClient.CreateOutOfOfficeRange(ctx, codersdk.Me, codersdk.CreateOutOfOfficeRangeRequest{
+		StartDate: "2099-08-01",
+		EndDate:   "2099-08-14",
+		Reason:    "Vacation",
+	})
+	require.NoError(t, err)
+
+	// Users cannot add out of office ranges for others.
+	_, err = memberClient.CreateOutOfOfficeRange(ctx, owner.UserID.String(), codersdk.CreateOutOfOfficeRangeRequest{
+		StartDate: "2099-08-01",
+	})
+	require.Error(t, err)
+
+	exceptions, err := memberClient.WorkspaceAutostartExceptions(ctx, workspace.ID)
+	require.NoError(t, err)
+	require.Equal(t, []codersdk.WorkspaceAutostartException{
+		{StartDate: "2099-08-01", EndDate: "2099-08-14", Source: "out-of-office", Summary: "Vacation"},
+		{StartDate: "2099-12-25", EndDate: "2099-12-25", Source: "holidays", Summary: "Christmas Day"},
+	}, exceptions.Exceptions)
+	// The workspace has no autostart schedule.
+	require.Nil(t, exceptions.NextSkippedAutostart)
+
+	err = memberClient.DeleteOutOfOfficeRange(ctx, codersdk.Me, ooo.ID)
+	require.NoError(t, err)
+	ranges, err := memberClient.OutOfOfficeRanges(ctx, codersdk.Me)
+	require.NoError(t, err)
+	require.Empty(t, ranges)
+}
+
+func TestWorkspaceAutostartSkipsExceptions(t *testing.T) {
+	t.Parallel()
+
+	ownerClient, db := coderdtest.NewWithDatabase(t, nil)
+	owner := coderdtest.CreateFirstUser(t, ownerClient)
+	memberClient, member := coderdtest.CreateAnotherUser(t, ownerClient, owner.OrganizationID)
+	ctx := testutil.Context(t, testutil.WaitLong)
+
+	workspace := dbfake.WorkspaceBuild(t, db, database.WorkspaceTable{
+		OwnerID:        member.ID,
+		OrganizationID: owner.OrganizationID,
+	}).Do().Workspace
+
+	// The next two daily autostarts fall on out of office dates.
+	schedule := "CRON_TZ=UTC 0 9 * * *"
+	sched, err := cron.Weekly(schedule)
+	require.NoError(t, err)
+	skipped := sched.Next(time.Now())
+	_, err = memberClient.CreateOutOfOfficeRange(ctx, codersdk.Me, codersdk.CreateOutOfOfficeRangeRequest{
+		StartDate: skipped.Format(codersdk.ScheduleExceptionDateFormat),
+		EndDate:   skipped.AddDate(0, 0, 1).Format(codersdk.ScheduleExceptionDateFormat),
+		Reason:    "Vacation",
+	})
+	require.NoError(t, err)
+
+	err = memberClient.UpdateWorkspaceAutostart(ctx, workspace.ID, codersdk.UpdateWorkspaceAutostartRequest{
+		Schedule: &schedule,
+	})
+	require.NoError(t, err)
+
+	// The workspace list reports both the skipped autostart and the next
+	// one that is not skipped.
+	res, err := memberClient.Workspaces(ctx, codersdk.WorkspaceFilter{Owner: codersdk.Me})
+	require.NoError(t, err)
+	require.Len(t, res.Workspaces, 1)
+	got := res.Workspaces[0]
+	require.NotNil(t, got.NextSkippedAutostart)
+	require.True(t, skipped.Equal(*got.NextSkippedAutostart), "next skipped autostart %s, want %s", got.NextSkippedAutostart, skipped)
+	require.NotNil(t, got.NextStartAt)
+	require.True(t, skipped.AddDate(0, 0, 2).Equal(*got.NextStartAt), "next start at %s, want %s", got.NextStartAt, skipped.AddDate(0, 0, 2))
+}
@@ -410,6 +410,7 @@ func (api *API) templateBuilderCreateTemplate(rw http.ResponseWriter, r *http.Re
 				RawMessage: traceMetadataRaw,
 			},
 			LogsOverflowed: false,
+			Priority:       codersdk.ProvisionerJobPriorityTemplateImport,
 		})
 		if err != nil {
 			return xerrors.Errorf("insert provisioner job: %w", err)
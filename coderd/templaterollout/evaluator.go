@@ -0,0 +1,207 @@
+package templaterollout
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+	"golang.org/x/xerrors"
+
+	"cdr.dev/slog/v3"
+	"github.com/coder/coder/v2/coderd/database"
+	"github.com/coder/coder/v2/coderd/database/dbauthz"
+	"github.com/coder/coder/v2/coderd/database/dbtime"
+)
+
+// EvaluationInterval is how often the evaluator checks the failure ratio of
+// the rollouts that are in progress.
+const EvaluationInterval = time.Minute
+
+// Evaluator periodically checks the builds and agents of the rollouts that are
+// in progress, and pauses or rolls back the rollouts whose failure ratio
+// exceeds their threshold.
+type Evaluator struct {
+	ctx    context.Context
+	cancel context.CancelFunc
+	done   chan struct{}
+
+	db    database.Store
+	log   slog.Logger
+	tick  <-chan time.Time
+	stats chan<- Stats
+}
+
+// Stats contains statistics about the last run of the evaluator.
+type Stats struct {
+	// Transitions maps the IDs of the rollouts that were paused or rolled
+	// back to their new status.
+	Transitions map[uuid.UUID]database.TemplateVersionRolloutStatus
+	// Error is the fatal error that occurred during the last run of the
+	// evaluator, if any.
+	Error error
+}
+
+// NewEvaluator returns a new rollout evaluator.
+func NewEvaluator(ctx context.Context, db database.Store, log slog.Logger, tick <-chan time.Time) *Evaluator {
+	//nolint:gocritic // The evaluator reads the builds of all workspaces.
+	ctx, cancel := context.WithCancel(dbauthz.AsSystemRestricted(ctx))
+	return &Evaluator{
+		ctx:    ctx,
+		cancel: cancel,
+		done:   make(chan struct{}),
+		db:     db,
+		log:    log,
+		tick:   tick,
+		stats:  nil,
+	}
+}
+
+// WithStatsChannel will cause the evaluator to push Stats to ch after every
+// tick. This push is blocking, so if ch is not read, the evaluator will hang.
+// This should only be used in tests.
+func (e *Evaluator) WithStatsChannel(ch chan<- Stats) *Evaluator {
+	e.stats = ch
+	return e
+}
+
+// Start will cause the evaluator to evaluate rollouts on every tick from its
+// channel. It will stop when its context is Done, or when its channel is
+// closed.
+//
+// Start should only be called once.
+func (e *Evaluator) Start() {
+	go func() {
+		defer close(e.done)
+		defer e.cancel()
+
+		for {
+			select {
+			case <-e.ctx.Done():
+				return
+			case t, ok := <-e.tick:
+				if !ok {
+					return
+				}
+				stats := e.run(t)
+				if stats.Error != nil {
+					e.log.Warn(e.ctx, "error running template version rollout evaluator once", slog.Error(stats.Error))
+				}
+				if e.stats != nil {
+					select {
+					case <-e.ctx.Done():
+						return
+					case e.stats <- stats:
+					}
+				}
+			}
+		}
+	}()
+}
+
+// Close will stop the evaluator.
+func (e *Evaluator) Close() {
+	e.cancel()
+	<-e.done
+}
+
+func (e *Evaluator) run(t time.Time) Stats {
+	ctx, cancel := context.WithTimeout(e.ctx, 5*time.Minute)
+	defer cancel()
+
+	stats := Stats{
+		Transitions: map[uuid.UUID]database.TemplateVersionRolloutStatus{},
+		Error:       nil,
+	}
+
+	err := e.db.InTx(func(db database.Store) error {
+		// Only one replica evaluates rollouts at a time.
+		ok, err := db.TryAcquireLock(ctx, database.LockIDTemplateVersionRollouts)
+		if err != nil {
+			return xerrors.Errorf("acquire lock: %w", err)
+		}
+		if !ok {
+			return nil
+		}
+
+		rollouts, err := db.GetInProgressTemplateVersionRollouts(ctx)
+		if err != nil {
+			return xerrors.Errorf("get in progress template version rollouts: %w", err)
+		}
+
+		for _, rollout := range rollouts {
+			rolloutStats, err := db.GetTemplateVersionRolloutStats(ctx, database.GetTemplateVersionRolloutStatsParams{
+				TemplateVersionID: rollout.TemplateVersionID,
+				StartedAt:         rollout.StartedAt,
+			})
+			if err != nil {
+				return xerrors.Errorf("get stats of rollout %s: %w", rollout.ID, err)
+			}
+			status, message, ok := Evaluate(rollout, rolloutStats)
+			if !ok {
+				continue
+			}
+
+			now := dbtime.Time(t)
+			finishedAt := sql.NullTime{}
+			if status == database.TemplateVersionRolloutStatusRolledBack {
+				finishedAt = sql.NullTime{Time: now, Valid: true}
+			}
+			_, err = db.UpdateTemplateVersionRolloutStatusByID(ctx, database.UpdateTemplateVersionRolloutStatusByIDParams{
+				ID:            rollout.ID,
+				Status:        status,
+				StatusMessage: message,
+				UpdatedAt:     now,
+				StartedAt:     rollout.StartedAt,
+				FinishedAt:    finishedAt,
+			})
+			if err != nil {
+				return xerrors.Errorf("update status of rollout %s: %w", rollout.ID, err)
+			}
+			stats.Transitions[rollout.ID] = status
+			e.log.Info(ctx, "template version rollout exceeded its failure threshold",
+				slog.F("rollout_id", rollout.ID),
+				slog.F("template_id", rollout.TemplateID),
+				slog.F("template_version_id", rollout.TemplateVersionID),
+				slog.F("status", status),
+				slog.F("message", message),
+			)
+		}
+		return nil
+	}, nil)
+	if err != nil {
+		stats.Transitions = map[uuid.UUID]database.TemplateVersionRolloutStatus{}
+		stats.Error = err
+	}
+	return stats
+}
+
+// Evaluate returns the status that the rollout should transition to, and why,
+// given the outcome of its builds and agents. It returns false if the failure
+// ratio of both the builds and the agents is within the threshold of the
+// rollout, or if there are not enough samples yet.
+func Evaluate(rollout database.TemplateVersionRollout, stats database.GetTemplateVersionRolloutStatsRow) (database.TemplateVersionRolloutStatus, string, bool) {
+	var message string
+	switch {
+	case exceedsThreshold(rollout, stats.BuildsFailed, stats.BuildsSucceeded):
+		message = fmt.Sprintf("%d of %d workspace builds failed", stats.BuildsFailed, stats.BuildsFailed+stats.BuildsSucceeded)
+	case exceedsThreshold(rollout, stats.AgentsFailed, stats.AgentsReady):
+		message = fmt.Sprintf("%d of %d workspace agents failed to become ready", stats.AgentsFailed, stats.AgentsFailed+stats.AgentsReady)
+	default:
+		return "", "", false
+	}
+
+	if rollout.FailureAction == database.TemplateVersionRolloutFailureActionRollback {
+		return database.TemplateVersionRolloutStatusRolledBack, message, true
+	}
+	return database.TemplateVersionRolloutStatusPaused, message, true
+}
+
+func exceedsThreshold(rollout database.TemplateVersionRollout, failed, succeeded int64) bool {
+	samples := failed + succeeded
+	if samples == 0 || samples < int64(rollout.MinSamples) {
+		return false
+	}
+	return float64(failed)/float64(samples) > rollout.FailureThreshold
+}
@@ -0,0 +1,121 @@
+package templaterollout_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/stretchr/testify/require"
+
+	"github.com/coder/coder/v2/coderd/coderdtest"
+	"github.com/coder/coder/v2/coderd/database"
+	"github.com/coder/coder/v2/coderd/database/dbauthz"
+	"github.com/coder/coder/v2/coderd/database/dbfake"
+	"github.com/coder/coder/v2/coderd/rbac"
+	"github.com/coder/coder/v2/coderd/templaterollout"
+	"github.com/coder/coder/v2/testutil"
+)
+
+// startEvaluator starts an evaluator that runs on every tick sent to the
+// returned channel and reports its stats on the other.
+func startEvaluator(ctx context.Context, t *testing.T, db database.Store) (chan<- time.Time, <-chan templaterollout.Stats) {
+	t.Helper()
+
+	log := testutil.Logger(t)
+	tickCh := make(chan time.Time)
+	statsCh := make(chan templaterollout.Stats)
+	authzDB := dbauthz.New(db, rbac.NewStrictCachingAuthorizer(prometheus.NewRegistry()), log, coderdtest.AccessControlStorePointer())
+	evaluator := templaterollout.NewEvaluator(ctx, authzDB, log, tickCh).WithStatsChannel(statsCh)
+	evaluator.Start()
+	t.Cleanup(evaluator.Close)
+	return tickCh, statsCh
+}
+
+func TestEvaluator(t *testing.T) {
+	t.Parallel()
+
+	// build inserts a start build of the canary version for a new workspace.
+	build := func(t *testing.T, f fixture, failed bool) {
+		t.Helper()
+
+		b := dbfake.WorkspaceBuild(t, f.db, database.WorkspaceTable{
+			OrganizationID: f.template.OrganizationID,
+			OwnerID:        f.owner.ID,
+			TemplateID:     f.template.ID,
+		}).Seed(database.WorkspaceBuild{
+			TemplateVersionID: f.canary.ID,
+			Transition:        database.WorkspaceTransitionStart,
+		})
+		if failed {
+			b = b.Failed()
+		}
+		b.Do()
+	}
+
+	t.Run("WithinThreshold", func(t *testing.T) {
+		t.Parallel()
+
+		ctx := testutil.Context(t, testutil.WaitLong)
+		f := setup(t)
+		tickCh, statsCh := startEvaluator(ctx, t, f.db)
+		rollout := f.rollout(t, database.TemplateVersionRollout{Percentage: 50})
+		for range 4 {
+			build(t, f, false)
+		}
+		build(t, f, true)
+
+		testutil.RequireSend(ctx, t, tickCh, time.Now())
+		stats := testutil.RequireReceive(ctx, t, statsCh)
+		require.NoError(t, stats.Error)
+		require.Empty(t, stats.Transitions)
+
+		rollout, err := f.db.GetTemplateVersionRolloutByID(ctx, rollout.ID)
+		require.NoError(t, err)
+		require.Equal(t, database.TemplateVersionRolloutStatusInProgress, rollout.Status)
+	})
+
+	for _, tc := range []struct {
+		action database.TemplateVersionRolloutFailureAction
+		status database.TemplateVersionRolloutStatus
+	}{
+		{action: database.TemplateVersionRolloutFailureActionPause, status: database.TemplateVersionRolloutStatusPaused},
+		{action: database.TemplateVersionRolloutFailureActionRollback, status: database.TemplateVersionRolloutStatusRolledBack},
+	} {
+		t.Run(string(tc.status), func(t *testing.T) {
+			t.Parallel()
+
+			ctx := testutil.Context(t, testutil.WaitLong)
+			f := setup(t)
+			tickCh, statsCh := startEvaluator(ctx, t, f.db)
+			rollout := f.rollout(t, database.TemplateVersionRollout{
+				Percentage:    50,
+				FailureAction: tc.action,
+			})
+			for range 3 {
+				build(t, f, false)
+			}
+			for range 2 {
+				build(t, f, true)
+			}
+
+			testutil.RequireSend(ctx, t, tickCh, time.Now())
+			stats := testutil.RequireReceive(ctx, t, statsCh)
+			require.NoError(t, stats.Error)
+			require.Equal(t, map[uuid.UUID]database.TemplateVersionRolloutStatus{rollout.ID: tc.status}, stats.Transitions)
+
+			rollout, err := f.db.GetTemplateVersionRolloutByID(ctx, rollout.ID)
+			require.NoError(t, err)
+			require.Equal(t, tc.status, rollout.Status)
+			require.Equal(t, "2 of 5 workspace builds failed", rollout.StatusMessage)
+			require.Equal(t, tc.status == database.TemplateVersionRolloutStatusRolledBack, rollout.FinishedAt.Valid)
+
+			// Rollouts that are no longer in progress are not evaluated again.
+			testutil.RequireSend(ctx, t, tickCh, time.Now())
+			stats = testutil.RequireReceive(ctx, t, statsCh)
+			require.NoError(t, stats.Error)
+			require.Empty(t, stats.Transitions)
+		})
+	}
+}
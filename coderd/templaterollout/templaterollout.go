@@ -0,0 +1,132 @@
+// Package templaterollout implements staged rollouts of template versions.
+//
+// While a rollout is in progress, the active version of the template stays the
+// same, and new workspaces are created from it. Existing workspaces that follow
+// the active version, either because they have automatic updates enabled or
+// because the template requires the active version, are updated to the
+// rollout version instead if they are part of the rollout. A workspace is part
+// of the rollout if it falls within the rollout percentage, or if its owner is
+// a member of one of the targeted groups.
+package templaterollout
+
+import (
+	"context"
+	"database/sql"
+	"hash/fnv"
+	"time"
+
+	"github.com/google/uuid"
+	"golang.org/x/xerrors"
+
+	"github.com/coder/coder/v2/coderd/database"
+)
+
+// VersionForWorkspace returns the template version that a workspace which
+// follows the active version of the template should be built with.
+// currentVersionID is the version of the latest build of the workspace.
+//
+// Workspaces that are already on the rollout version stay on it while the
+// rollout is in progress or paused, so pausing a rollout only stops it from
+// reaching more workspaces. Once a rollout is rolled back or canceled, the
+// workspaces are moved back to the active version on their next start.
+func VersionForWorkspace(ctx context.Context, db database.Store, template database.Template, workspace database.Workspace, currentVersionID uuid.UUID) (uuid.UUID, error) {
+	rollout, err := db.GetActiveTemplateVersionRolloutByTemplateID(ctx, template.ID)
+	if xerrors.Is(err, sql.ErrNoRows) {
+		return template.ActiveVersionID, nil
+	}
+	if err != nil {
+		return uuid.Nil, xerrors.Errorf("get active template version rollout: %w", err)
+	}
+	// The active version was changed without finishing the rollout, so the
+	// rollout no longer applies.
+	if rollout.PreviousTemplateVersionID != template.ActiveVersionID {
+		return template.ActiveVersionID, nil
+	}
+	if currentVersionID == rollout.TemplateVersionID {
+		return rollout.TemplateVersionID, nil
+	}
+	if rollout.Status != database.TemplateVersionRolloutStatusInProgress {
+		return template.ActiveVersionID, nil
+	}
+
+	included, err := Includes(ctx, db, rollout, workspace)
+	if err != nil {
+		return uuid.Nil, err
+	}
+	if included {
+		return rollout.TemplateVersionID, nil
+	}
+	return template.ActiveVersionID, nil
+}
+
+// Finish ends the rollout of the template that is in progress or paused, if
+// any, because the active version of the template was changed. The rollout is
+// completed if its version was promoted, and canceled otherwise.
+func Finish(ctx context.Context, db database.Store, templateID, activeVersionID uuid.UUID, now time.Time) error {
+	rollout, err := db.GetActiveTemplateVersionRolloutByTemplateID(ctx, templateID)
+	if xerrors.Is(err, sql.ErrNoRows) {
+		return nil
+	}
+	if err != nil {
+		return xerrors.Errorf("get active template version rollout: %w", err)
+	}
+
+	status := database.TemplateVersionRolloutStatusCanceled
+	if rollout.TemplateVersionID == activeVersionID {
+		status = database.TemplateVersionRolloutStatusCompleted
+	}
+	_, err = db.UpdateTemplateVersionRolloutStatusByID(ctx, database.UpdateTemplateVersionRolloutStatusByIDParams{
+		ID:            rollout.ID,
+		Status:        status,
+		StatusMessage: rollout.StatusMessage,
+		UpdatedAt:     now,
+		StartedAt:     rollout.StartedAt,
+		FinishedAt:    sql.NullTime{Time: now, Valid: true},
+	})
+	if err != nil {
+		return xerrors.Errorf("update template version rollout status: %w", err)
+	}
+	return nil
+}
+
+// Includes returns whether the workspace is part of the rollout.
+func Includes(ctx context.Context, db database.Store, rollout database.TemplateVersionRollout, workspace database.Workspace) (bool, error) {
+	if InPercentage(rollout.ID, workspace.ID, rollout.Percentage) {
+		return true, nil
+	}
+	if len(rollout.GroupIDs) == 0 {
+		return false, nil
+	}
+	targeted, err := db.GetTemplateVersionRolloutTargetsUser(ctx, database.GetTemplateVersionRolloutTargetsUserParams{
+		RolloutID: rollout.ID,
+		UserID:    workspace.OwnerID,
+	})
+	if err != nil {
+		return false, xerrors.Errorf("get template version rollout targets user: %w", err)
+	}
+	return targeted, nil
+}
+
+// InPercentage returns whether the workspace falls within the given percentage
+// of the rollout. The workspaces are ordered by a hash of the rollout and
+// workspace IDs, so that increasing the percentage of a rollout only adds
+// workspaces to it, and different rollouts pick different workspaces first.
+func InPercentage(rolloutID, workspaceID uuid.UUID, percentage int32) bool {
+	if percentage <= 0 {
+		return false
+	}
+	if percentage >= 100 {
+		return true
+	}
+	//nolint:gosec // The bucket is always below 100.
+	return int32(bucket(rolloutID, workspaceID)) < percentage
+}
+
+// bucket maps the workspace to a number between 0 and 99 that is stable for
+// the lifetime of the rollout.
+func bucket(rolloutID, workspaceID uuid.UUID) uint32 {
+	h := fnv.New32a()
+	_, _ = h.Write(rolloutID[:])
+	_, _ = h.Write(workspaceID[:])
+	return h.Sum32() % 100
+}
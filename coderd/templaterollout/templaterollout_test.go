@@ -0,0 +1,263 @@
+package templaterollout_test
+
+import (
+	"database/sql"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/goleak"
+
+	"github.com/coder/coder/v2/coderd/database"
+	"github.com/coder/coder/v2/coderd/database/dbfake"
+	"github.com/coder/coder/v2/coderd/database/dbgen"
+	"github.com/coder/coder/v2/coderd/database/dbtestutil"
+	"github.com/coder/coder/v2/coderd/database/dbtime"
+	"github.com/coder/coder/v2/coderd/templaterollout"
+	"github.com/coder/coder/v2/testutil"
+)
+
+func TestMain(m *testing.M) {
+	goleak.VerifyTestMain(m, testutil.GoleakOptions...)
+}
+
+func TestInPercentage(t *testing.T) {
+	t.Parallel()
+
+	rolloutID := uuid.New()
+	workspaceIDs := make([]uuid.UUID, 1000)
+	for i := range workspaceIDs {
+		workspaceIDs[i] = uuid.New()
+	}
+
+	included := func(percentage int32) map[uuid.UUID]bool {
+		res := map[uuid.UUID]bool{}
+		for _, id := range workspaceIDs {
+			if templaterollout.InPercentage(rolloutID, id, percentage) {
+				res[id] = true
+			}
+		}
+		return res
+	}
+
+	require.Empty(t, included(0))
+	require.Len(t, included(100), len(workspaceIDs))
+
+	// Increasing the percentage only adds workspaces to the rollout.
+	previous := included(0)
+	for _, percentage := range []int32{10, 25, 50, 75} {
+		current := included(percentage)
+		for id := range previous {
+			require.True(t, current[id], "workspace %s left the rollout at %d%%", id, percentage)
+		}
+		// The hash spreads workspaces evenly enough for a loose bound.
+		require.InDelta(t, int(percentage)*len(workspaceIDs)/100, len(current), 60)
+		previous = current
+	}
+}
+
+func TestEvaluate(t *testing.T) {
+	t.Parallel()
+
+	for _, tc := range []struct {
+		name    string
+		action  database.TemplateVersionRolloutFailureAction
+		stats   database.GetTemplateVersionRolloutStatsRow
+		status  database.TemplateVersionRolloutStatus
+		message string
+	}{
+		{
+			name:  "NotEnoughSamples",
+			stats: database.GetTemplateVersionRolloutStatsRow{BuildsFailed: 4},
+		},
+		{
+			name:  "WithinThreshold",
+			stats: database.GetTemplateVersionRolloutStatsRow{BuildsSucceeded: 8, BuildsFailed: 2, AgentsReady: 8, AgentsFailed: 2},
+		},
+		{
+			name:    "BuildsExceedThreshold",
+			action:  database.TemplateVersionRolloutFailureActionPause,
+			stats:   database.GetTemplateVersionRolloutStatsRow{BuildsSucceeded: 3, BuildsFailed: 2},
+			status:  database.TemplateVersionRolloutStatusPaused,
+			message: "2 of 5 workspace builds failed",
+		},
+		{
+			name:    "AgentsExceedThreshold",
+			action:  database.TemplateVersionRolloutFailureActionRollback,
+			stats:   database.GetTemplateVersionRolloutStatsRow{BuildsSucceeded: 5, AgentsReady: 1, AgentsFailed: 4},
+			status:  database.TemplateVersionRolloutStatusRolledBack,
+			message: "4 of 5 workspace agents failed to become ready",
+		},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			rollout := database.TemplateVersionRollout{
+				FailureThreshold: 0.2,
+				MinSamples:       5,
+				FailureAction:    tc.action,
+			}
+			status, message, ok := templaterollout.Evaluate(rollout, tc.stats)
+			require.Equal(t, tc.status != "", ok)
+			require.Equal(t, tc.status, status)
+			require.Equal(t, tc.message, message)
+		})
+	}
+}
+
+// fixture is a template with an active version, a version to roll out and a
+// workspace that follows the active version.
+type fixture struct {
+	db        database.Store
+	template  database.Template
+	canary    database.TemplateVersion
+	workspace database.WorkspaceTable
+	owner     database.User
+}
+
+func setup(t *testing.T) fixture {
+	t.Helper()
+
+	db, _ := dbtestutil.NewDB(t)
+	org := dbgen.Organization(t, db, database.Organization{})
+	owner := dbgen.User(t, db, database.User{})
+	active := dbfake.TemplateVersion(t, db).Seed(database.TemplateVersion{
+		OrganizationID: org.ID,
+		CreatedBy:      owner.ID,
+	}).Do()
+	canary := dbfake.TemplateVersion(t, db).Seed(database.TemplateVersion{
+		OrganizationID: org.ID,
+		CreatedBy:      owner.ID,
+		TemplateID:     uuid.NullUUID{UUID: active.Template.ID, Valid: true},
+	}).SkipCreateTemplate().Do()
+	workspace := dbgen.Workspace(t, db, database.WorkspaceTable{
+		OrganizationID: org.ID,
+		OwnerID:        owner.ID,
+		TemplateID:     active.Template.ID,
+	})
+	return fixture{
+		db:        db,
+		template:  active.Template,
+		canary:    canary.TemplateVersion,
+		workspace: workspace,
+		owner:     owner,
+	}
+}
+
+// rollout inserts a rollout of the canary version of the fixture.
+func (f fixture) rollout(t *testing.T, seed database.TemplateVersionRollout) database.TemplateVersionRollout {
+	t.Helper()
+
+	seed.TemplateID = f.template.ID
+	seed.TemplateVersionID = f.canary.ID
+	seed.PreviousTemplateVersionID = f.template.ActiveVersionID
+	seed.CreatedBy = f.owner.ID
+	return dbgen.TemplateVersionRollout(t, f.db, seed)
+}
+
+func TestVersionForWorkspace(t *testing.T) {
+	t.Parallel()
+
+	versionFor := func(t *testing.T, f fixture, currentVersionID uuid.UUID) uuid.UUID {
+		t.Helper()
+
+		ctx := testutil.Context(t, testutil.WaitShort)
+		workspace, err := f.db.GetWorkspaceByID(ctx, f.workspace.ID)
+		require.NoError(t, err)
+		versionID, err := templaterollout.VersionForWorkspace(ctx, f.db, f.template, workspace, currentVersionID)
+		require.NoError(t, err)
+		return versionID
+	}
+
+	t.Run("NoRollout", func(t *testing.T) {
+		t.Parallel()
+
+		f := setup(t)
+		require.Equal(t, f.template.ActiveVersionID, versionFor(t, f, f.template.ActiveVersionID))
+	})
+
+	t.Run("Percentage", func(t *testing.T) {
+		t.Parallel()
+
+		f := setup(t)
+		f.rollout(t, database.TemplateVersionRollout{
+			Percentage: 100,
+		})
+		require.Equal(t, f.canary.ID, versionFor(t, f, f.template.ActiveVersionID))
+	})
+
+	t.Run("Group", func(t *testing.T) {
+		t.Parallel()
+
+		f := setup(t)
+		group := dbgen.Group(t, f.db, database.Group{OrganizationID: f.template.OrganizationID})
+		f.rollout(t, database.TemplateVersionRollout{
+			GroupIDs: []uuid.UUID{group.ID},
+		})
+		require.Equal(t, f.template.ActiveVersionID, versionFor(t, f, f.template.ActiveVersionID))
+
+		dbgen.GroupMember(t, f.db, database.GroupMemberTable{GroupID: group.ID, UserID: f.owner.ID})
+		require.Equal(t, f.canary.ID, versionFor(t, f, f.template.ActiveVersionID))
+	})
+
+	t.Run("Paused", func(t *testing.T) {
+		t.Parallel()
+
+		f := setup(t)
+		f.rollout(t, database.TemplateVersionRollout{
+			Percentage: 100,
+			Status:     database.TemplateVersionRolloutStatusPaused,
+		})
+		// A paused rollout reaches no more workspaces, but keeps the ones
+		// that were already updated.
+		require.Equal(t, f.template.ActiveVersionID, versionFor(t, f, f.template.ActiveVersionID))
+		require.Equal(t, f.canary.ID, versionFor(t, f, f.canary.ID))
+	})
+
+	t.Run("RolledBack", func(t *testing.T) {
+		t.Parallel()
+
+		f := setup(t)
+		f.rollout(t, database.TemplateVersionRollout{
+			Percentage: 100,
+			Status:     database.TemplateVersionRolloutStatusRolledBack,
+			FinishedAt: sql.NullTime{Time: dbtime.Now(), Valid: true},
+		})
+		require.Equal(t, f.template.ActiveVersionID, versionFor(t, f, f.canary.ID))
+	})
+}
+
+func TestFinish(t *testing.T) {
+	t.Parallel()
+
+	for _, tc := range []struct {
+		name     string
+		promoted bool
+		status   database.TemplateVersionRolloutStatus
+	}{
+		{name: "Completed", promoted: true, status: database.TemplateVersionRolloutStatusCompleted},
+		{name: "Canceled", promoted: false, status: database.TemplateVersionRolloutStatusCanceled},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			ctx := testutil.Context(t, testutil.WaitShort)
+			f := setup(t)
+			rollout := f.rollout(t, database.TemplateVersionRollout{Percentage: 50})
+
+			promotedID := uuid.New()
+			if tc.promoted {
+				promotedID = rollout.TemplateVersionID
+			}
+			require.NoError(t, templaterollout.Finish(ctx, f.db, f.template.ID, promotedID, dbtime.Now()))
+
+			rollout, err := f.db.GetTemplateVersionRolloutByID(ctx, rollout.ID)
+			require.NoError(t, err)
+			require.Equal(t, tc.status, rollout.Status)
+			require.True(t, rollout.FinishedAt.Valid)
+
+			// There is nothing left to finish.
+			require.NoError(t, templaterollout.Finish(ctx, f.db, f.template.ID, promotedID, dbtime.Now()))
+		})
+	}
+}
@@ -0,0 +1,528 @@
+package coderd
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"net/http"
+
+	"github.com/google/uuid"
+	"golang.org/x/xerrors"
+
+	"github.com/coder/coder/v2/coderd/database"
+	"github.com/coder/coder/v2/coderd/database/dbauthz"
+	"github.com/coder/coder/v2/coderd/database/dbtime"
+	"github.com/coder/coder/v2/coderd/httpapi"
+	"github.com/coder/coder/v2/coderd/httpmw"
+	"github.com/coder/coder/v2/codersdk"
+)
+
+const (
+	defaultRolloutFailureThreshold = 0.2
+	defaultRolloutMinSamples       = 5
+)
+
+// @Summary Create template version rollout
+// @ID create-template-version-rollout
+// @Security CoderSessionToken
+// @Accept json
+// @Produce json
+// @Tags Templates
+// @Param template path string true "Template ID" format(uuid)
+// @Param request body codersdk.CreateTemplateVersionRolloutRequest true "Rollout request"
+// @Success 201 {object} codersdk.TemplateVersionRollout
+// @Router /api/v2/templates/{template}/rollouts [post]
+func (api *API) postTemplateVersionRollout(rw http.ResponseWriter, r *http.Request) {
+	var (
+		ctx      = r.Context()
+		template = httpmw.TemplateParam(r)
+		apiKey   = httpmw.APIKey(r)
+	)
+
+	var req codersdk.CreateTemplateVersionRolloutRequest
+	if !httpapi.Read(ctx, rw, r, &req) {
+		return
+	}
+	if req.FailureThreshold == 0 {
+		req.FailureThreshold = defaultRolloutFailureThreshold
+	}
+	if req.MinSamples == 0 {
+		req.MinSamples = defaultRolloutMinSamples
+	}
+	if req.FailureAction == "" {
+		req.FailureAction = codersdk.TemplateVersionRolloutFailureActionPause
+	}
+	validations := validateTemplateVersionRollout(req.Percentage, req.GroupIDs, req.FailureThreshold, req.MinSamples, req.FailureAction)
+	if len(validations) > 0 {
+		httpapi.Write(ctx, rw, http.StatusBadRequest, codersdk.Response{
+			Message:     "Invalid template version rollout.",
+			Validations: validations,
+		})
+		return
+	}
+	if !api.validateTemplateVersionRolloutGroups(ctx, rw, template, req.GroupIDs) {
+		return
+	}
+
+	version, err := api.Database.GetTemplateVersionByID(ctx, req.TemplateVersionID)
+	if httpapi.Is404Error(err) {
+		httpapi.Write(ctx, rw, http.StatusNotFound, codersdk.Response{
+			Message: "Template version not found.",
+		})
+		return
+	}
+	if err != nil {
+		httpapi.Write(ctx, rw, http.StatusInternalServerError, codersdk.Response{
+			Message: "Internal error fetching template version.",
+			Detail:  err.Error(),
+		})
+		return
+	}
+	if version.TemplateID.UUID != template.ID {
+		httpapi.Write(ctx, rw, http.StatusBadRequest, codersdk.Response{
+			Message: "The provided template version doesn't belong to the specified template.",
+		})
+		return
+	}
+	if version.ID == template.ActiveVersionID {
+		httpapi.Write(ctx, rw, http.StatusBadRequest, codersdk.Response{
+			Message: "The provided template version is already the active version.",
+		})
+		return
+	}
+	if version.Archived {
+		httpapi.Write(ctx, rw, http.StatusBadRequest, codersdk.Response{
+			Message: "The provided template version is archived.",
+		})
+		return
+	}
+	job, err := api.Database.GetProvisionerJobByID(ctx, version.JobID)
+	if err != nil {
+		httpapi.Write(ctx, rw, http.StatusInternalServerError, codersdk.Response{
+			Message: "Internal error fetching template version job status.",
+			Detail:  err.Error(),
+		})
+		return
+	}
+	if job.JobStatus != database.ProvisionerJobStatusSucceeded {
+		httpapi.Write(ctx, rw, http.StatusForbidden, codersdk.Response{
+			Message: "Only versions that have been built successfully can be rolled out.",
+			Detail:  fmt.Sprintf("Attempted to roll out a version with a %s build", job.JobStatus),
+		})
+		return
+	}
+
+	groupIDs := req.GroupIDs
+	if groupIDs == nil {
+		groupIDs = []uuid.UUID{}
+	}
+	now := dbtime.Now()
+	rollout, err := api.Database.InsertTemplateVersionRollout(ctx, database.InsertTemplateVersionRolloutParams{
+		ID:                        uuid.New(),
+		TemplateID:                template.ID,
+		TemplateVersionID:         version.ID,
+		PreviousTemplateVersionID: template.ActiveVersionID,
+		Percentage:                req.Percentage,
+		GroupIDs:                  groupIDs,
+		FailureThreshold:          req.FailureThreshold,
+		MinSamples:                req.MinSamples,
+		FailureAction:             database.TemplateVersionRolloutFailureAction(req.FailureAction),
+		CreatedBy:                 apiKey.UserID,
+		CreatedAt:                 now,
+		UpdatedAt:                 now,
+		StartedAt:                 now,
+	})
+	if database.IsUniqueViolation(err, database.UniqueTemplateVersionRolloutsTemplateIDActiveIndex) {
+		httpapi.Write(ctx, rw, http.StatusConflict, codersdk.Response{
+			Message: "The template already has a rollout in progress.",
+			Detail:  "Promote, roll back or cancel the current rollout before starting a new one.",
+		})
+		return
+	}
+	if httpapi.IsUnauthorizedError(err) {
+		httpapi.Forbidden(rw)
+		return
+	}
+	if err != nil {
+		httpapi.Write(ctx, rw, http.StatusInternalServerError, codersdk.Response{
+			Message: "Internal error creating template version rollout.",
+			Detail:  err.Error(),
+		})
+		return
+	}
+
+	api.writeTemplateVersionRollout(ctx, rw, http.StatusCreated, rollout)
+}
+
+// @Summary Get template version rollouts
+// @ID get-template-version-rollouts
+// @Security CoderSessionToken
+// @Produce json
+// @Tags Templates
+// @Param template path string true "Template ID" format(uuid)
+// @Success 200 {array} codersdk.TemplateVersionRollout
+// @Router /api/v2/templates/{template}/rollouts [get]
+func (api *API) templateVersionRollouts(rw http.ResponseWriter, r *http.Request) {
+	var (
+		ctx      = r.Context()
+		template = httpmw.TemplateParam(r)
+	)
+
+	rollouts, err := api.Database.GetTemplateVersionRolloutsByTemplateID(ctx, template.ID)
+	if err != nil {
+		httpapi.Write(ctx, rw, http.StatusInternalServerError, codersdk.Response{
+			Message: "Internal error fetching template version rollouts.",
+			Detail:  err.Error(),
+		})
+		return
+	}
+
+	res := make([]codersdk.TemplateVersionRollout, 0, len(rollouts))
+	for _, rollout := range rollouts {
+		converted, err := api.convertTemplateVersionRollout(ctx, rollout)
+		if err != nil {
+			httpapi.Write(ctx, rw, http.StatusInternalServerError, codersdk.Response{
+				Message: "Internal error converting template version rollout.",
+				Detail:  err.Error(),
+			})
+			return
+		}
+		res = append(res, converted)
+	}
+
+	httpapi.Write(ctx, rw, http.StatusOK, res)
+}
+
+// @Summary Get template version rollout
+// @ID get-template-version-rollout
+// @Security CoderSessionToken
+// @Produce json
+// @Tags Templates
+// @Param template path string true "Template ID" format(uuid)
+// @Param rollout path string true "Rollout ID" format(uuid)
+// @Success 200 {object} codersdk.TemplateVersionRollout
+// @Router /api/v2/templates/{template}/rollouts/{rollout} [get]
+func (api *API) templateVersionRollout(rw http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	rollout, ok := api.templateVersionRolloutParam(rw, r)
+	if !ok {
+		return
+	}
+
+	api.writeTemplateVersionRollout(ctx, rw, http.StatusOK, rollout)
+}
+
+// @Summary Update template version rollout
+// @ID update-template-version-rollout
+// @Security CoderSessionToken
+// @Accept json
+// @Produce json
+// @Tags Templates
+// @Param template path string true "Template ID" format(uuid)
+// @Param rollout path string true "Rollout ID" format(uuid)
+// @Param request body codersdk.UpdateTemplateVersionRolloutRequest true "Update request"
+// @Success 200 {object} codersdk.TemplateVersionRollout
+// @Router /api/v2/templates/{template}/rollouts/{rollout} [patch]
+func (api *API) patchTemplateVersionRollout(rw http.ResponseWriter, r *http.Request) {
+	var (
+		ctx      = r.Context()
+		template = httpmw.TemplateParam(r)
+	)
+	rollout, ok := api.templateVersionRolloutParam(rw, r)
+	if !ok {
+		return
+	}
+
+	var req codersdk.UpdateTemplateVersionRolloutRequest
+	if !httpapi.Read(ctx, rw, r, &req) {
+		return
+	}
+	if !codersdk.TemplateVersionRolloutStatus(rollout.Status).Active() {
+		httpapi.Write(ctx, rw, http.StatusBadRequest, codersdk.Response{
+			Message: fmt.Sprintf("The rollout is %s and can no longer be changed.", rollout.Status),
+		})
+		return
+	}
+
+	params := database.UpdateTemplateVersionRolloutByIDParams{
+		ID:               rollout.ID,
+		Percentage:       rollout.Percentage,
+		GroupIDs:         rollout.GroupIDs,
+		FailureThreshold: rollout.FailureThreshold,
+		MinSamples:       rollout.MinSamples,
+		FailureAction:    rollout.FailureAction,
+		UpdatedAt:        dbtime.Now(),
+	}
+	if req.Percentage != nil {
+		params.Percentage = *req.Percentage
+	}
+	if req.GroupIDs != nil {
+		params.GroupIDs = *req.GroupIDs
+		if params.GroupIDs == nil {
+			params.GroupIDs = []uuid.UUID{}
+		}
+	}
+	if req.FailureThreshold != nil {
+		params.FailureThreshold = *req.FailureThreshold
+	}
+	if req.MinSamples != nil {
+		params.MinSamples = *req.MinSamples
+	}
+	if req.FailureAction != nil {
+		params.FailureAction = database.TemplateVersionRolloutFailureAction(*req.FailureAction)
+	}
+	validations := validateTemplateVersionRollout(params.Percentage, params.GroupIDs, params.FailureThreshold, params.MinSamples, codersdk.TemplateVersionRolloutFailureAction(params.FailureAction))
+	if req.Status != nil && !req.Status.Active() {
+		validations = append(validations, codersdk.ValidationError{
+			Field:  "status",
+			Detail: "Status must be in_progress or paused. Use the rollback endpoint to end a rollout.",
+		})
+	}
+	if len(validations) > 0 {
+		httpapi.Write(ctx, rw, http.StatusBadRequest, codersdk.Response{
+			Message:     "Invalid template version rollout.",
+			Validations: validations,
+		})
+		return
+	}
+	if req.GroupIDs != nil && !api.validateTemplateVersionRolloutGroups(ctx, rw, template, params.GroupIDs) {
+		return
+	}
+
+	err := api.Database.InTx(func(tx database.Store) error {
+		var err error
+		rollout, err = tx.UpdateTemplateVersionRolloutByID(ctx, params)
+		if err != nil {
+			return xerrors.Errorf("update rollout: %w", err)
+		}
+		if req.Status == nil || database.TemplateVersionRolloutStatus(*req.Status) == rollout.Status {
+			return nil
+		}
+
+		statusParams := database.UpdateTemplateVersionRolloutStatusByIDParams{
+			ID:            rollout.ID,
+			Status:        database.TemplateVersionRolloutStatus(*req.Status),
+			StatusMessage: "",
+			UpdatedAt:     params.UpdatedAt,
+			StartedAt:     rollout.StartedAt,
+			FinishedAt:    sql.NullTime{},
+		}
+		// Builds and agents from before the rollout was resumed are not
+		// counted again, otherwise a rollout that was paused automatically
+		// would be paused again right away.
+		if statusParams.Status == database.TemplateVersionRolloutStatusInProgress {
+			statusParams.StartedAt = params.UpdatedAt
+		}
+		rollout, err = tx.UpdateTemplateVersionRolloutStatusByID(ctx, statusParams)
+		if err != nil {
+			return xerrors.Errorf("update rollout status: %w", err)
+		}
+		return nil
+	}, nil)
+	if httpapi.IsUnauthorizedError(err) {
+		httpapi.Forbidden(rw)
+		return
+	}
+	if err != nil {
+		httpapi.Write(ctx, rw, http.StatusInternalServerError, codersdk.Response{
+			Message: "Internal error updating template version rollout.",
+			Detail:  err.Error(),
+		})
+		return
+	}
+
+	api.writeTemplateVersionRollout(ctx, rw, http.StatusOK, rollout)
+}
+
+// @Summary Roll back template version rollout
+// @ID roll-back-template-version-rollout
+// @Security CoderSessionToken
+// @Produce json
+// @Tags Templates
+// @Param template path string true "Template ID" format(uuid)
+// @Param rollout path string true "Rollout ID" format(uuid)
+// @Success 200 {object} codersdk.TemplateVersionRollout
+// @Router /api/v2/templates/{template}/rollouts/{rollout}/rollback [post]
+func (api *API) postTemplateVersionRolloutRollback(rw http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	rollout, ok := api.templateVersionRolloutParam(rw, r)
+	if !ok {
+		return
+	}
+	if !codersdk.TemplateVersionRolloutStatus(rollout.Status).Active() {
+		httpapi.Write(ctx, rw, http.StatusBadRequest, codersdk.Response{
+			Message: fmt.Sprintf("The rollout is %s and can no longer be rolled back.", rollout.Status),
+		})
+		return
+	}
+
+	now := dbtime.Now()
+	rollout, err := api.Database.UpdateTemplateVersionRolloutStatusByID(ctx, database.UpdateTemplateVersionRolloutStatusByIDParams{
+		ID:            rollout.ID,
+		Status:        database.TemplateVersionRolloutStatusRolledBack,
+		StatusMessage: "",
+		UpdatedAt:     now,
+		StartedAt:     rollout.StartedAt,
+		FinishedAt:    sql.NullTime{Time: now, Valid: true},
+	})
+	if httpapi.IsUnauthorizedError(err) {
+		httpapi.Forbidden(rw)
+		return
+	}
+	if err != nil {
+		httpapi.Write(ctx, rw, http.StatusInternalServerError, codersdk.Response{
+			Message: "Internal error rolling back template version rollout.",
+			Detail:  err.Error(),
+		})
+		return
+	}
+
+	api.writeTemplateVersionRollout(ctx, rw, http.StatusOK, rollout)
+}
+
+// templateVersionRolloutParam fetches the rollout in the URL and checks that it
+// belongs to the template in the URL.
+func (api *API) templateVersionRolloutParam(rw http.ResponseWriter, r *http.Request) (database.TemplateVersionRollout, bool) {
+	var (
+		ctx      = r.Context()
+		template = httpmw.TemplateParam(r)
+	)
+	id, ok := httpmw.ParseUUIDParam(rw, r, "rollout")
+	if !ok {
+		return database.TemplateVersionRollout{}, false
+	}
+
+	rollout, err := api.Database.GetTemplateVersionRolloutByID(ctx, id)
+	if httpapi.Is404Error(err) || (err == nil && rollout.TemplateID != template.ID) {
+		httpapi.ResourceNotFound(rw)
+		return database.TemplateVersionRollout{}, false
+	}
+	if err != nil {
+		httpapi.Write(ctx, rw, http.StatusInternalServerError, codersdk.Response{
+			Message: "Internal error fetching template version rollout.",
+			Detail:  err.Error(),
+		})
+		return database.TemplateVersionRollout{}, false
+	}
+	return rollout, true
+}
+
+// validateTemplateVersionRolloutGroups checks that the groups targeted by a
+// rollout exist in the organization of the template.
+func (api *API) validateTemplateVersionRolloutGroups(ctx context.Context, rw http.ResponseWriter, template database.Template, groupIDs []uuid.UUID) bool {
+	for _, id := range groupIDs {
+		group, err := api.Database.GetGroupByID(ctx, id)
+		if httpapi.Is404Error(err) || (err == nil && group.OrganizationID != template.OrganizationID) {
+			httpapi.Write(ctx, rw, http.StatusBadRequest, codersdk.Response{
+				Message: fmt.Sprintf("Group %s not found in the organization of the template.", id),
+			})
+			return false
+		}
+		if err != nil {
+			httpapi.Write(ctx, rw, http.StatusInternalServerError, codersdk.Response{
+				Message: "Internal error fetching group.",
+				Detail:  err.Error(),
+			})
+			return false
+		}
+	}
+	return true
+}
+
+func validateTemplateVersionRollout(percentage int32, groupIDs []uuid.UUID, failureThreshold float64, minSamples int32, failureAction codersdk.TemplateVersionRolloutFailureAction) []codersdk.ValidationError {
+	var validations []codersdk.ValidationError
+	if percentage < 0 || percentage > 100 {
+		validations = append(validations, codersdk.ValidationError{
+			Field:  "percentage",
+			Detail: "Must be between 0 and 100.",
+		})
+	}
+	if percentage == 0 && len(groupIDs) == 0 {
+		validations = append(validations, codersdk.ValidationError{
+			Field:  "percentage",
+			Detail: "A percentage or at least one group is required.",
+		})
+	}
+	if failureThreshold <= 0 || failureThreshold > 1 {
+		validations = append(validations, codersdk.ValidationError{
+			Field:  "failure_threshold",
+			Detail: "Must be greater than 0 and at most 1.",
+		})
+	}
+	if minSamples < 1 {
+		validations = append(validations, codersdk.ValidationError{
+			Field:  "min_samples",
+			Detail: "Must be at least 1.",
+		})
+	}
+	switch failureAction {
+	case codersdk.TemplateVersionRolloutFailureActionPause, codersdk.TemplateVersionRolloutFailureActionRollback:
+	default:
+		validations = append(validations, codersdk.ValidationError{
+			Field:  "failure_action",
+			Detail: fmt.Sprintf("Must be %q or %q.", codersdk.TemplateVersionRolloutFailureActionPause, codersdk.TemplateVersionRolloutFailureActionRollback),
+		})
+	}
+	return validations
+}
+
+func (api *API) writeTemplateVersionRollout(ctx context.Context, rw http.ResponseWriter, status int, rollout database.TemplateVersionRollout) {
+	res, err := api.convertTemplateVersionRollout(ctx, rollout)
+	if err != nil {
+		httpapi.Write(ctx, rw, http.StatusInternalServerError, codersdk.Response{
+			Message: "Internal error converting template version rollout.",
+			Detail:  err.Error(),
+		})
+		return
+	}
+	httpapi.Write(ctx, rw, status, res)
+}
+
+func (api *API) convertTemplateVersionRollout(ctx context.Context, rollout database.TemplateVersionRollout) (codersdk.TemplateVersionRollout, error) {
+	version, err := api.Database.GetTemplateVersionByID(ctx, rollout.TemplateVersionID)
+	if err != nil {
+		return codersdk.TemplateVersionRollout{}, xerrors.Errorf("get template version: %w", err)
+	}
+	// The progress counts the builds of every workspace of the template, some
+	// of which the user may not be able to read.
+	//nolint:gocritic // The counts do not reveal the workspaces themselves.
+	stats, err := api.Database.GetTemplateVersionRolloutStats(dbauthz.AsSystemRestricted(ctx), database.GetTemplateVersionRolloutStatsParams{
+		TemplateVersionID: rollout.TemplateVersionID,
+		StartedAt:         rollout.StartedAt,
+	})
+	if err != nil {
+		return codersdk.TemplateVersionRollout{}, xerrors.Errorf("get rollout stats: %w", err)
+	}
+
+	res := codersdk.TemplateVersionRollout{
+		ID:                        rollout.ID,
+		TemplateID:                rollout.TemplateID,
+		TemplateVersionID:         rollout.TemplateVersionID,
+		TemplateVersionName:       version.Name,
+		PreviousTemplateVersionID: rollout.PreviousTemplateVersionID,
+		Percentage:                rollout.Percentage,
+		GroupIDs:                  rollout.GroupIDs,
+		FailureThreshold:          rollout.FailureThreshold,
+		MinSamples:                rollout.MinSamples,
+		FailureAction:             codersdk.TemplateVersionRolloutFailureAction(rollout.FailureAction),
+		Status:                    codersdk.TemplateVersionRolloutStatus(rollout.Status),
+		StatusMessage:             rollout.StatusMessage,
+		Progress: codersdk.TemplateVersionRolloutProgress{
+			BuildsSucceeded: stats.BuildsSucceeded,
+			BuildsFailed:    stats.BuildsFailed,
+			AgentsReady:     stats.AgentsReady,
+			AgentsFailed:    stats.AgentsFailed,
+		},
+		CreatedBy: rollout.CreatedBy,
+		CreatedAt: rollout.CreatedAt,
+		UpdatedAt: rollout.UpdatedAt,
+		StartedAt: rollout.StartedAt,
+	}
+	if res.GroupIDs == nil {
+		res.GroupIDs = []uuid.UUID{}
+	}
+	if rollout.FinishedAt.Valid {
+		res.FinishedAt = &rollout.FinishedAt.Time
+	}
+	return res, nil
+}
@@ -0,0 +1,200 @@
+package coderd_test
+
+import (
+	"net/http"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/require"
+
+	"github.com/coder/coder/v2/coderd/coderdtest"
+	"github.com/coder/coder/v2/coderd/util/ptr"
+	"github.com/coder/coder/v2/codersdk"
+	"github.com/coder/coder/v2/testutil"
+)
+
+func TestTemplateVersionRollouts(t *testing.T) {
+	t.Parallel()
+
+	setup := func(t *testing.T) (*codersdk.Client, codersdk.CreateFirstUserResponse, codersdk.Template, codersdk.TemplateVersion) {
+		t.Helper()
+
+		client := coderdtest.New(t, &coderdtest.Options{IncludeProvisionerDaemon: true})
+		owner := coderdtest.CreateFirstUser(t, client)
+		version := coderdtest.CreateTemplateVersion(t, client, owner.OrganizationID, nil)
+		coderdtest.AwaitTemplateVersionJobCompleted(t, client, version.ID)
+		template := coderdtest.CreateTemplate(t, client, owner.OrganizationID, version.ID)
+		canary := coderdtest.UpdateTemplateVersion(t, client, owner.OrganizationID, nil, template.ID)
+		coderdtest.AwaitTemplateVersionJobCompleted(t, client, canary.ID)
+		return client, owner, template, canary
+	}
+
+	t.Run("Lifecycle", func(t *testing.T) {
+		t.Parallel()
+
+		client, owner, template, canary := setup(t)
+		ctx := testutil.Context(t, testutil.WaitLong)
+
+		rollout, err := client.CreateTemplateVersionRollout(ctx, template.ID, codersdk.CreateTemplateVersionRolloutRequest{
+			TemplateVersionID: canary.ID,
+			Percentage:        10,
+		})
+		require.NoError(t, err)
+		require.Equal(t, canary.ID, rollout.TemplateVersionID)
+		require.Equal(t, canary.Name, rollout.TemplateVersionName)
+		require.Equal(t, template.ActiveVersionID, rollout.PreviousTemplateVersionID)
+		require.Equal(t, owner.UserID, rollout.CreatedBy)
+		require.Equal(t, codersdk.TemplateVersionRolloutStatusInProgress, rollout.Status)
+		require.Equal(t, codersdk.TemplateVersionRolloutFailureActionPause, rollout.FailureAction)
+		require.InDelta(t, 0.2, rollout.FailureThreshold, 0.0001)
+		require.EqualValues(t, 5, rollout.MinSamples)
+		require.Empty(t, rollout.GroupIDs)
+		require.Nil(t, rollout.FinishedAt)
+
+		// Only one rollout can be in progress at a time.
+		_, err = client.CreateTemplateVersionRollout(ctx, template.ID, codersdk.CreateTemplateVersionRolloutRequest{
+			TemplateVersionID: canary.ID,
+			Percentage:        10,
+		})
+		var apiErr *codersdk.Error
+		require.ErrorAs(t, err, &apiErr)
+		require.Equal(t, http.StatusConflict, apiErr.StatusCode())
+
+		rollout, err = client.UpdateTemplateVersionRollout(ctx, template.ID, rollout.ID, codersdk.UpdateTemplateVersionRolloutRequest{
+			Percentage: ptr.Ref[int32](50),
+			Status:     ptr.Ref(codersdk.TemplateVersionRolloutStatusPaused),
+		})
+		require.NoError(t, err)
+		require.EqualValues(t, 50, rollout.Percentage)
+		require.Equal(t, codersdk.TemplateVersionRolloutStatusPaused, rollout.Status)
+
+		paused := rollout
+		rollout, err = client.UpdateTemplateVersionRollout(ctx, template.ID, rollout.ID, codersdk.UpdateTemplateVersionRolloutRequest{
+			Status: ptr.Ref(codersdk.TemplateVersionRolloutStatusInProgress),
+		})
+		require.NoError(t, err)
+		require.Equal(t, codersdk.TemplateVersionRolloutStatusInProgress, rollout.Status)
+		require.False(t, rollout.StartedAt.Before(paused.StartedAt), "resuming should reset the start time")
+
+		rollout, err = client.RollbackTemplateVersionRollout(ctx, template.ID, rollout.ID)
+		require.NoError(t, err)
+		require.Equal(t, codersdk.TemplateVersionRolloutStatusRolledBack, rollout.Status)
+		require.NotNil(t, rollout.FinishedAt)
+
+		// Finished rollouts can no longer be changed.
+		_, err = client.UpdateTemplateVersionRollout(ctx, template.ID, rollout.ID, codersdk.UpdateTemplateVersionRolloutRequest{
+			Status: ptr.Ref(codersdk.TemplateVersionRolloutStatusInProgress),
+		})
+		require.ErrorAs(t, err, &apiErr)
+		require.Equal(t, http.StatusBadRequest, apiErr.StatusCode())
+
+		rollouts, err := client.TemplateVersionRollouts(ctx, template.ID)
+		require.NoError(t, err)
+		require.Len(t, rollouts, 1)
+		require.Equal(t, rollout.ID, rollouts[0].ID)
+	})
+
+	t.Run("Invalid", func(t *testing.T) {
+		t.Parallel()
+
+		client, _, template, canary := setup(t)
+		ctx := testutil.Context(t, testutil.WaitLong)
+
+		for _, req := range []codersdk.CreateTemplateVersionRolloutRequest{
+			// No workspaces are targeted.
+			{TemplateVersionID: canary.ID},
+			{TemplateVersionID: canary.ID, Percentage: 101},
+			{TemplateVersionID: canary.ID, Percentage: 10, FailureThreshold: 1.5},
+			{TemplateVersionID: canary.ID, Percentage: 10, FailureAction: "ignore"},
+			{TemplateVersionID: canary.ID, GroupIDs: []uuid.UUID{uuid.New()}},
+			// The active version cannot be rolled out.
+			{TemplateVersionID: template.ActiveVersionID, Percentage: 10},
+		} {
+			_, err := client.CreateTemplateVersionRollout(ctx, template.ID, req)
+			var apiErr *codersdk.Error
+			require.ErrorAs(t, err, &apiErr)
+			require.Equal(t, http.StatusBadRequest, apiErr.StatusCode())
+		}
+	})
+
+	t.Run("MemberCannotCreate", func(t *testing.T) {
+		t.Parallel()
+
+		client, owner, template, canary := setup(t)
+		memberClient, _ := coderdtest.CreateAnotherUser(t, client, owner.OrganizationID)
+		ctx := testutil.Context(t, testutil.WaitLong)
+
+		_, err := memberClient.CreateTemplateVersionRollout(ctx, template.ID, codersdk.CreateTemplateVersionRolloutRequest{
+			TemplateVersionID: canary.ID,
+			Percentage:        10,
+		})
+		var apiErr *codersdk.Error
+		require.ErrorAs(t, err, &apiErr)
+		require.Equal(t, http.StatusForbidden, apiErr.StatusCode())
+	})
+
+	t.Run("PromoteCompletes", func(t *testing.T) {
+		t.Parallel()
+
+		client, _, template, canary := setup(t)
+		ctx := testutil.Context(t, testutil.WaitLong)
+
+		rollout, err := client.CreateTemplateVersionRollout(ctx, template.ID, codersdk.CreateTemplateVersionRolloutRequest{
+			TemplateVersionID: canary.ID,
+			Percentage:        10,
+		})
+		require.NoError(t, err)
+
+		err = client.UpdateActiveTemplateVersion(ctx, template.ID, codersdk.UpdateActiveTemplateVersion{ID: canary.ID})
+		require.NoError(t, err)
+
+		rollout, err = client.TemplateVersionRollout(ctx, template.ID, rollout.ID)
+		require.NoError(t, err)
+		require.Equal(t, codersdk.TemplateVersionRolloutStatusCompleted, rollout.Status)
+		require.NotNil(t, rollout.FinishedAt)
+	})
+
+	t.Run("WorkspaceStart", func(t *testing.T) {
+		t.Parallel()
+
+		client, _, template, canary := setup(t)
+		ctx := testutil.Context(t, testutil.WaitLong)
+
+		following := coderdtest.CreateWorkspace(t, client, template.ID, func(req *codersdk.CreateWorkspaceRequest) {
+			req.AutomaticUpdates = codersdk.AutomaticUpdatesAlways
+		})
+		coderdtest.AwaitWorkspaceBuildJobCompleted(t, client, following.LatestBuild.ID)
+		pinned := coderdtest.CreateWorkspace(t, client, template.ID)
+		coderdtest.AwaitWorkspaceBuildJobCompleted(t, client, pinned.LatestBuild.ID)
+
+		rollout, err := client.CreateTemplateVersionRollout(ctx, template.ID, codersdk.CreateTemplateVersionRolloutRequest{
+			TemplateVersionID: canary.ID,
+			Percentage:        100,
+		})
+		require.NoError(t, err)
+
+		// Clients start workspaces with the active version, which is
+		// replaced by the rollout version for workspaces that follow the
+		// active version.
+		start := func(req *codersdk.CreateWorkspaceBuildRequest) {
+			req.TemplateVersionID = template.ActiveVersionID
+		}
+		following = coderdtest.MustTransitionWorkspace(t, client, following.ID, codersdk.WorkspaceTransitionStart, codersdk.WorkspaceTransitionStop)
+		following = coderdtest.MustTransitionWorkspace(t, client, following.ID, codersdk.WorkspaceTransitionStop, codersdk.WorkspaceTransitionStart, start)
+		require.Equal(t, canary.ID, following.LatestBuild.TemplateVersionID)
+		pinned = coderdtest.MustTransitionWorkspace(t, client, pinned.ID, codersdk.WorkspaceTransitionStart, codersdk.WorkspaceTransitionStop)
+		pinned = coderdtest.MustTransitionWorkspace(t, client, pinned.ID, codersdk.WorkspaceTransitionStop, codersdk.WorkspaceTransitionStart, start)
+		require.Equal(t, template.ActiveVersionID, pinned.LatestBuild.TemplateVersionID)
+
+		rollout, err = client.TemplateVersionRollout(ctx, template.ID, rollout.ID)
+		require.NoError(t, err)
+		require.EqualValues(t, 1, rollout.Progress.BuildsSucceeded)
+
+		// Rolling back moves the workspace back to the active version.
+		_, err = client.RollbackTemplateVersionRollout(ctx, template.ID, rollout.ID)
+		require.NoError(t, err)
+		following = coderdtest.MustTransitionWorkspace(t, client, following.ID, codersdk.WorkspaceTransitionStart, codersdk.WorkspaceTransitionStop)
+		following = coderdtest.MustTransitionWorkspace(t, client, following.ID, codersdk.WorkspaceTransitionStop, codersdk.WorkspaceTransitionStart, start)
+		require.Equal(t, template.ActiveVersionID, following.LatestBuild.TemplateVersionID)
+	})
+}
@@ -703,6 +703,11 @@ func (api *API) patchTemplateMeta(rw http.ResponseWriter, r *http.Request) {
 	} else if resolved.timeTilAutostopNotifyMillis != 0 && time.Duration(resolved.timeTilAutostopNotifyMillis)*time.Millisecond < time.Minute {
 		validErrs = append(validErrs, codersdk.ValidationError{Field: "time_til_autostop_notify_ms", Detail: "Must be 0 (disabled) or at least one minute."})
 	}
+	if resolved.driftCheckIntervalMillis < 0 {
+		validErrs = append(validErrs, codersdk.ValidationError{Field: "drift_check_interval_ms", Detail: "Must be a positive integer."})
+	} else if resolved.driftCheckIntervalMillis != 0 && time.Duration(resolved.driftCheckIntervalMillis)*time.Millisecond < time.Hour {
+		validErrs = append(validErrs, codersdk.ValidationError{Field: "drift_check_interval_ms", Detail: "Must be 0 (disabled) or at least one hour."})
+	}
 	if resolved.autostopRequirementWeeks > schedule.MaxTemplateAutostopRequirementWeeks {
 		validErrs = append(validErrs, codersdk.ValidationError{Field: "autostop_requirement.weeks", Detail: fmt.Sprintf("Must be less than %d.", schedule.MaxTemplateAutostopRequirementWeeks)})
 	}
@@ -782,6 +787,7 @@ func (api *API) patchTemplateMeta(rw http.ResponseWriter, r *http.Request) {
 			CorsBehavior:                 resolved.corsBehavior,
 			DisableModuleCache:           resolved.disableModuleCache,
 			AgentsAllowed:                resolved.agentsAllowed,
+			DriftCheckInterval:           int64(time.Duration(resolved.driftCheckIntervalMillis) * time.Millisecond),
 		})
 		if err != nil {
 			return xerrors.Errorf("update template metadata: %w", err)
@@ -1050,15 +1056,16 @@ func (api *API) convertTemplate(
 			DaysOfWeek: codersdk.BitmapToWeekdays(template.AutostartAllowedDays()),
 		},
 		// These values depend on entitlements and come from the templateAccessControl
-		RequireActiveVersion:    templateAccessControl.RequireActiveVersion,
-		Deprecated:              templateAccessControl.IsDeprecated(),
-		DeprecationMessage:      templateAccessControl.Deprecated,
-		Deleted:                 template.Deleted,
-		MaxPortShareLevel:       maxPortShareLevel,
-		UseClassicParameterFlow: template.UseClassicParameterFlow,
-		CORSBehavior:            codersdk.CORSBehavior(template.CorsBehavior),
-		DisableModuleCache:      template.DisableModuleCache,
-		AgentsAllowed:           template.AgentsAllowed,
+		RequireActiveVersion:     templateAccessControl.RequireActiveVersion,
+		Deprecated:               templateAccessControl.IsDeprecated(),
+		DeprecationMessage:       templateAccessControl.Deprecated,
+		Deleted:                  template.Deleted,
+		MaxPortShareLevel:        maxPortShareLevel,
+		UseClassicParameterFlow:  template.UseClassicParameterFlow,
+		CORSBehavior:             codersdk.CORSBehavior(template.CorsBehavior),
+		DisableModuleCache:       template.DisableModuleCache,
+		AgentsAllowed:            template.AgentsAllowed,
+		DriftCheckIntervalMillis: time.Duration(template.DriftCheckInterval).Milliseconds(),
 	}
 }
 
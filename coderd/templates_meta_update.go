@@ -34,6 +34,7 @@ type templateMetaUpdate struct {
 	deprecationMessage                   string
 	useClassicTemplateFlow               bool
 	disableModuleCache                   bool
+	driftCheckIntervalMillis             int64
 	corsBehavior                         database.CorsBehavior
 	autostopRequirementDaysOfWeekParsed  uint8
 	autostartRequirementDaysOfWeekParsed uint8
@@ -87,6 +88,7 @@ func resolveTemplateMetaUpdate(
 		deprecationMessage:             ptr.NilToDefault(req.DeprecationMessage, template.Deprecated),
 		useClassicTemplateFlow:         ptr.NilToDefault(req.UseClassicParameterFlow, template.UseClassicParameterFlow),
 		disableModuleCache:             ptr.NilToDefault(req.DisableModuleCache, template.DisableModuleCache),
+		driftCheckIntervalMillis:       ptr.NilToDefault(req.DriftCheckIntervalMillis, time.Duration(template.DriftCheckInterval).Milliseconds()),
 		groupACL:                       template.GroupACL,
 
 		// Default to the original values
@@ -44,6 +44,7 @@ func baselineTemplate() database.Template {
 		UseClassicParameterFlow:       true,
 		CorsBehavior:                  database.CorsBehaviorPassthru,
 		DisableModuleCache:            true,
+		DriftCheckInterval:            int64(24 * 60 * 60 * 1000 * 1000 * 1000), // 1 day in ns
 		GroupACL: database.TemplateACL{
 			orgID.String(): {"read"},
 		},
@@ -87,6 +88,7 @@ func baselineResolved() templateMetaUpdate {
 		deprecationMessage:                   tpl.Deprecated,
 		useClassicTemplateFlow:               tpl.UseClassicParameterFlow,
 		disableModuleCache:                   tpl.DisableModuleCache,
+		driftCheckIntervalMillis:             tpl.DriftCheckInterval / 1e6,
 		corsBehavior:                         tpl.CorsBehavior,
 		autostopRequirementDaysOfWeekParsed:  0b0000001,
 		autostartRequirementDaysOfWeekParsed: 0b1000000,
@@ -234,6 +236,20 @@ func TestResolveTemplateMetaUpdate(t *testing.T) {
 				r.agentsAllowed = false
 			}},
 		},
+		{
+			name: "DriftCheckIntervalMillis",
+			req:  codersdk.UpdateTemplateMeta{DriftCheckIntervalMillis: ptr.Ref(int64(7_200_000))},
+			expected: expected{override: func(r *templateMetaUpdate) {
+				r.driftCheckIntervalMillis = 7_200_000
+			}},
+		},
+		{
+			name: "DriftCheckIntervalMillisZeroExplicit",
+			req:  codersdk.UpdateTemplateMeta{DriftCheckIntervalMillis: ptr.Ref(int64(0))},
+			expected: expected{override: func(r *templateMetaUpdate) {
+				r.driftCheckIntervalMillis = 0
+			}},
+		},
 		{
 			name: "FailureTTLMillis",
 			req:  codersdk.UpdateTemplateMeta{FailureTTLMillis: ptr.Ref(int64(3_600_000))},
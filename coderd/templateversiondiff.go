@@ -0,0 +1,417 @@
+package coderd
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io/fs"
+	"net/http"
+	"reflect"
+	"slices"
+	"strings"
+	"unicode/utf8"
+
+	"github.com/aymanbagabas/go-udiff"
+	"github.com/google/uuid"
+	"golang.org/x/xerrors"
+
+	"github.com/coder/coder/v2/coderd/database"
+	"github.com/coder/coder/v2/coderd/database/db2sdk"
+	"github.com/coder/coder/v2/coderd/files"
+	"github.com/coder/coder/v2/coderd/httpapi"
+	"github.com/coder/coder/v2/coderd/httpapi/httperror"
+	"github.com/coder/coder/v2/coderd/httpmw"
+	"github.com/coder/coder/v2/codersdk"
+)
+
+// @Summary Compare template versions
+// @ID compare-template-versions
+// @Security CoderSessionToken
+// @Produce json
+// @Tags Templates
+// @Param templateversion path string true "Template version ID" format(uuid)
+// @Param base query string false "Template version ID to compare against. Defaults to the active version of the template." format(uuid)
+// @Success 200 {object} codersdk.TemplateVersionDiff
+// @Router /api/v2/templateversions/{templateversion}/diff [get]
+func (api *API) templateVersionDiff(rw http.ResponseWriter, r *http.Request) {
+	var (
+		ctx             = r.Context()
+		templateVersion = httpmw.TemplateVersionParam(r)
+		queryParams     = r.URL.Query()
+		parser          = httpapi.NewQueryParamParser()
+		baseID          = parser.UUID(queryParams, uuid.Nil, "base")
+	)
+	parser.ErrorExcessParams(queryParams)
+	if len(parser.Errors) > 0 {
+		httpapi.Write(ctx, rw, http.StatusBadRequest, codersdk.Response{
+			Message:     "Query parameters have invalid values.",
+			Validations: parser.Errors,
+		})
+		return
+	}
+
+	if baseID == uuid.Nil {
+		if !templateVersion.TemplateID.Valid {
+			httpapi.Write(ctx, rw, http.StatusBadRequest, codersdk.Response{
+				Message: "Template version is not attached to a template, a base version must be specified.",
+			})
+			return
+		}
+		template, err := api.Database.GetTemplateByID(ctx, templateVersion.TemplateID.UUID)
+		if httpapi.Is404Error(err) {
+			httpapi.ResourceNotFound(rw)
+			return
+		}
+		if err != nil {
+			httpapi.Write(ctx, rw, http.StatusInternalServerError, codersdk.Response{
+				Message: "Internal error fetching template.",
+				Detail:  err.Error(),
+			})
+			return
+		}
+		baseID = template.ActiveVersionID
+	}
+
+	baseVersion, err := api.Database.GetTemplateVersionByID(ctx, baseID)
+	if httpapi.Is404Error(err) {
+		httpapi.Write(ctx, rw, http.StatusBadRequest, codersdk.Response{
+			Message: fmt.Sprintf("Base template version %q not found.", baseID),
+		})
+		return
+	}
+	if err != nil {
+		httpapi.Write(ctx, rw, http.StatusInternalServerError, codersdk.Response{
+			Message: "Internal error fetching base template version.",
+			Detail:  err.Error(),
+		})
+		return
+	}
+
+	oldSnapshot, err := api.templateVersionSnapshot(ctx, baseVersion)
+	if err != nil {
+		httperror.WriteResponseError(ctx, rw, err)
+		return
+	}
+	defer oldSnapshot.files.Close()
+	newSnapshot, err := api.templateVersionSnapshot(ctx, templateVersion)
+	if err != nil {
+		httperror.WriteResponseError(ctx, rw, err)
+		return
+	}
+	defer newSnapshot.files.Close()
+
+	fileDiffs, err := diffTemplateFiles(oldSnapshot.files, newSnapshot.files)
+	if err != nil {
+		httpapi.Write(ctx, rw, http.StatusInternalServerError, codersdk.Response{
+			Message: "Internal error comparing template files.",
+			Detail:  err.Error(),
+		})
+		return
+	}
+
+	httpapi.Write(ctx, rw, http.StatusOK, codersdk.TemplateVersionDiff{
+		BaseTemplateVersionID: baseVersion.ID,
+		TemplateVersionID:     templateVersion.ID,
+		Files:                 fileDiffs,
+		RichParameters:        diffTemplateVersionParameters(oldSnapshot.parameters, newSnapshot.parameters),
+		Variables:             diffTemplateVersionVariables(oldSnapshot.variables, newSnapshot.variables),
+		Presets:               diffTemplateVersionPresets(oldSnapshot.presets, newSnapshot.presets),
+		WorkspaceTags:         diffTemplateVersionWorkspaceTags(oldSnapshot.workspaceTags, newSnapshot.workspaceTags),
+		ExternalAuth:          diffTemplateVersionExternalAuth(oldSnapshot.externalAuth, newSnapshot.externalAuth),
+	})
+}
+
+// templateVersionSnapshot holds everything about a template version that is
+// compared by templateVersionDiff.
+type templateVersionSnapshot struct {
+	files         *files.CloseFS
+	parameters    []codersdk.TemplateVersionParameter
+	variables     []codersdk.TemplateVersionVariable
+	presets       []codersdk.Preset
+	workspaceTags []database.TemplateVersionWorkspaceTag
+	externalAuth  []database.ExternalAuthProvider
+}
+
+// templateVersionSnapshot loads a template version for comparison. Errors are
+// returned as httperror response errors suitable for writing directly to an
+// API response. On success, the caller must close the returned files.
+func (api *API) templateVersionSnapshot(ctx context.Context, templateVersion database.TemplateVersion) (templateVersionSnapshot, error) {
+	internalError := func(message string, err error) error {
+		return httperror.NewResponseError(http.StatusInternalServerError, codersdk.Response{
+			Message: message,
+			Detail:  err.Error(),
+		})
+	}
+
+	job, err := api.Database.GetProvisionerJobByID(ctx, templateVersion.JobID)
+	if err != nil {
+		return templateVersionSnapshot{}, internalError("Internal error fetching provisioner job.", err)
+	}
+	// Parameters and the other template metadata are only known once the
+	// template has been imported.
+	if job.JobStatus != database.ProvisionerJobStatusSucceeded {
+		return templateVersionSnapshot{}, httperror.NewResponseError(http.StatusTooEarly, codersdk.Response{
+			Message: fmt.Sprintf("Template version %q has not been imported successfully.", templateVersion.Name),
+			Detail:  fmt.Sprintf("The import job is %s.", job.JobStatus),
+		})
+	}
+
+	dbParameters, err := api.Database.GetTemplateVersionParameters(ctx, templateVersion.ID)
+	if err != nil {
+		return templateVersionSnapshot{}, internalError("Internal error fetching template version parameters.", err)
+	}
+	parameters, err := db2sdk.TemplateVersionParameters(dbParameters)
+	if err != nil {
+		return templateVersionSnapshot{}, internalError("Internal error converting template version parameters.", err)
+	}
+	dbVariables, err := api.Database.GetTemplateVersionVariables(ctx, templateVersion.ID)
+	if err != nil {
+		return templateVersionSnapshot{}, internalError("Internal error fetching template version variables.", err)
+	}
+	dbPresets, err := api.Database.GetPresetsByTemplateVersionID(ctx, templateVersion.ID)
+	if err != nil {
+		return templateVersionSnapshot{}, internalError("Internal error fetching template version presets.", err)
+	}
+	dbPresetParams, err := api.Database.GetPresetParametersByTemplateVersionID(ctx, templateVersion.ID)
+	if err != nil {
+		return templateVersionSnapshot{}, internalError("Internal error fetching template version presets.", err)
+	}
+	workspaceTags, err := api.Database.GetTemplateVersionWorkspaceTags(ctx, templateVersion.ID)
+	if err != nil {
+		return templateVersionSnapshot{}, internalError("Internal error fetching template version workspace tags.", err)
+	}
+	var externalAuth []database.ExternalAuthProvider
+	err = json.Unmarshal(templateVersion.ExternalAuthProviders, &externalAuth)
+	if err != nil {
+		return templateVersionSnapshot{}, internalError("Internal error reading auth config from database.", err)
+	}
+
+	// Reading the archive requires permission to read the file, just like
+	// downloading it with `coder templates pull`.
+	templateFS, err := api.FileCache.Acquire(ctx, api.Database, job.FileID)
+	if httpapi.IsUnauthorizedError(err) {
+		return templateVersionSnapshot{}, httperror.NewResponseError(http.StatusForbidden, httpapi.ResourceForbiddenResponse)
+	}
+	if err != nil {
+		return templateVersionSnapshot{}, internalError("Internal error fetching template files.", err)
+	}
+
+	return templateVersionSnapshot{
+		files:         templateFS,
+		parameters:    parameters,
+		variables:     convertTemplateVersionVariables(dbVariables),
+		presets:       convertPresets(dbPresets, dbPresetParams),
+		workspaceTags: workspaceTags,
+		externalAuth:  externalAuth,
+	}, nil
+}
+
+// diffTemplateFiles returns a unified diff of every regular file that differs
+// between the two template archives, sorted by path.
+func diffTemplateFiles(oldFS, newFS fs.FS) ([]codersdk.TemplateVersionFileDiff, error) {
+	oldFiles, err := readTemplateFiles(oldFS)
+	if err != nil {
+		return nil, xerrors.Errorf("read base template files: %w", err)
+	}
+	newFiles, err := readTemplateFiles(newFS)
+	if err != nil {
+		return nil, xerrors.Errorf("read template files: %w", err)
+	}
+
+	paths := make([]string, 0, len(oldFiles)+len(newFiles))
+	for path := range oldFiles {
+		paths = append(paths, path)
+	}
+	for path := range newFiles {
+		if _, ok := oldFiles[path]; !ok {
+			paths = append(paths, path)
+		}
+	}
+	slices.Sort(paths)
+
+	diffs := make([]codersdk.TemplateVersionFileDiff, 0)
+	for _, path := range paths {
+		oldContent, inOld := oldFiles[path]
+		newContent, inNew := newFiles[path]
+		oldName, newName := "a/"+path, "b/"+path
+
+		var change codersdk.TemplateVersionDiffChange
+		switch {
+		case !inOld:
+			change = codersdk.TemplateVersionDiffChangeAdded
+			oldName = "/dev/null"
+		case !inNew:
+			change = codersdk.TemplateVersionDiffChangeRemoved
+			newName = "/dev/null"
+		case bytes.Equal(oldContent, newContent):
+			continue
+		default:
+			change = codersdk.TemplateVersionDiffChangeChanged
+		}
+
+		fileDiff := codersdk.TemplateVersionFileDiff{
+			Path:   path,
+			Change: change,
+			Binary: isBinary(oldContent) || isBinary(newContent),
+		}
+		if !fileDiff.Binary {
+			// udiff.Unified calls log.Fatalf on its internal error, so
+			// route through Lines + ToUnified instead.
+			edits := udiff.Lines(string(oldContent), string(newContent))
+			fileDiff.Diff, err = udiff.ToUnified(oldName, newName, string(oldContent), edits, udiff.DefaultContextLines)
+			if err != nil {
+				return nil, xerrors.Errorf("diff %q: %w", path, err)
+			}
+		}
+		diffs = append(diffs, fileDiff)
+	}
+	return diffs, nil
+}
+
+// readTemplateFiles reads all regular files of a template archive.
+func readTemplateFiles(templateFS fs.FS) (map[string][]byte, error) {
+	contents := make(map[string][]byte)
+	err := fs.WalkDir(templateFS, ".", func(path string, d fs.DirEntry, err error) error {
+		if err != nil {
+			return err
+		}
+		if !d.Type().IsRegular() {
+			return nil
+		}
+		content, err := fs.ReadFile(templateFS, path)
+		if err != nil {
+			return xerrors.Errorf("read %q: %w", path, err)
+		}
+		contents[path] = content
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return contents, nil
+}
+
+func isBinary(content []byte) bool {
+	return bytes.IndexByte(content, 0) >= 0 || !utf8.Valid(content)
+}
+
+// diffByKey matches the items of two versions by key and returns the items
+// that were added, removed or changed, sorted by key.
+func diffByKey[T any, D any](oldItems, newItems []T, key func(T) string, equal func(a, b T) bool, result func(key string, change codersdk.TemplateVersionDiffChange, oldItem, newItem *T) D) []D {
+	oldByKey := make(map[string]T, len(oldItems))
+	for _, item := range oldItems {
+		oldByKey[key(item)] = item
+	}
+	newByKey := make(map[string]T, len(newItems))
+	for _, item := range newItems {
+		newByKey[key(item)] = item
+	}
+
+	keys := make([]string, 0, len(oldByKey)+len(newByKey))
+	for k := range oldByKey {
+		keys = append(keys, k)
+	}
+	for k := range newByKey {
+		if _, ok := oldByKey[k]; !ok {
+			keys = append(keys, k)
+		}
+	}
+	slices.Sort(keys)
+
+	diffs := make([]D, 0)
+	for _, k := range keys {
+		oldItem, inOld := oldByKey[k]
+		newItem, inNew := newByKey[k]
+		switch {
+		case !inOld:
+			diffs = append(diffs, result(k, codersdk.TemplateVersionDiffChangeAdded, nil, &newItem))
+		case !inNew:
+			diffs = append(diffs, result(k, codersdk.TemplateVersionDiffChangeRemoved, &oldItem, nil))
+		case !equal(oldItem, newItem):
+			diffs = append(diffs, result(k, codersdk.TemplateVersionDiffChangeChanged, &oldItem, &newItem))
+		}
+	}
+	return diffs
+}
+
+func diffTemplateVersionParameters(oldParams, newParams []codersdk.TemplateVersionParameter) []codersdk.TemplateVersionParameterDiff {
+	return diffByKey(oldParams, newParams,
+		func(p codersdk.TemplateVersionParameter) string { return p.Name },
+		func(a, b codersdk.TemplateVersionParameter) bool { return reflect.DeepEqual(a, b) },
+		func(name string, change codersdk.TemplateVersionDiffChange, oldParam, newParam *codersdk.TemplateVersionParameter) codersdk.TemplateVersionParameterDiff {
+			return codersdk.TemplateVersionParameterDiff{Name: name, Change: change, Old: oldParam, New: newParam}
+		},
+	)
+}
+
+func diffTemplateVersionVariables(oldVars, newVars []codersdk.TemplateVersionVariable) []codersdk.TemplateVersionVariableDiff {
+	return diffByKey(oldVars, newVars,
+		func(v codersdk.TemplateVersionVariable) string { return v.Name },
+		// Values of sensitive variables are redacted, so a change of value
+		// alone is not reported for them.
+		func(a, b codersdk.TemplateVersionVariable) bool { return a == b },
+		func(name string, change codersdk.TemplateVersionDiffChange, oldVar, newVar *codersdk.TemplateVersionVariable) codersdk.TemplateVersionVariableDiff {
+			return codersdk.TemplateVersionVariableDiff{Name: name, Change: change, Old: oldVar, New: newVar}
+		},
+	)
+}
+
+func diffTemplateVersionPresets(oldPresets, newPresets []codersdk.Preset) []codersdk.TemplateVersionPresetDiff {
+	return diffByKey(oldPresets, newPresets,
+		func(p codersdk.Preset) string { return p.Name },
+		func(a, b codersdk.Preset) bool {
+			// Every version has its own preset IDs, and the order of preset
+			// parameters is not meaningful.
+			a.ID, b.ID = uuid.Nil, uuid.Nil
+			a.Parameters = sortedPresetParameters(a.Parameters)
+			b.Parameters = sortedPresetParameters(b.Parameters)
+			return reflect.DeepEqual(a, b)
+		},
+		func(name string, change codersdk.TemplateVersionDiffChange, oldPreset, newPreset *codersdk.Preset) codersdk.TemplateVersionPresetDiff {
+			return codersdk.TemplateVersionPresetDiff{Name: name, Change: change, Old: oldPreset, New: newPreset}
+		},
+	)
+}
+
+func sortedPresetParameters(params []codersdk.PresetParameter) []codersdk.PresetParameter {
+	params = slices.Clone(params)
+	slices.SortFunc(params, func(a, b codersdk.PresetParameter) int {
+		return strings.Compare(a.Name, b.Name)
+	})
+	return params
+}
+
+func diffTemplateVersionWorkspaceTags(oldTags, newTags []database.TemplateVersionWorkspaceTag) []codersdk.TemplateVersionWorkspaceTagDiff {
+	return diffByKey(oldTags, newTags,
+		func(t database.TemplateVersionWorkspaceTag) string { return t.Key },
+		func(a, b database.TemplateVersionWorkspaceTag) bool { return a.Value == b.Value },
+		func(key string, change codersdk.TemplateVersionDiffChange, oldTag, newTag *database.TemplateVersionWorkspaceTag) codersdk.TemplateVersionWorkspaceTagDiff {
+			diff := codersdk.TemplateVersionWorkspaceTagDiff{Key: key, Change: change}
+			if oldTag != nil {
+				diff.OldValue = oldTag.Value
+			}
+			if newTag != nil {
+				diff.NewValue = newTag.Value
+			}
+			return diff
+		},
+	)
+}
+
+func diffTemplateVersionExternalAuth(oldProviders, newProviders []database.ExternalAuthProvider) []codersdk.TemplateVersionExternalAuthDiff {
+	return diffByKey(oldProviders, newProviders,
+		func(p database.ExternalAuthProvider) string { return p.ID },
+		func(a, b database.ExternalAuthProvider) bool { return a.Optional == b.Optional },
+		func(id string, change codersdk.TemplateVersionDiffChange, oldProvider, newProvider *database.ExternalAuthProvider) codersdk.TemplateVersionExternalAuthDiff {
+			diff := codersdk.TemplateVersionExternalAuthDiff{ID: id, Change: change}
+			if oldProvider != nil {
+				diff.OldOptional = &oldProvider.Optional
+			}
+			if newProvider != nil {
+				diff.NewOptional = &newProvider.Optional
+			}
+			return diff
+		},
+	)
+}
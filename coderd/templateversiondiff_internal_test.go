@@ -0,0 +1,124 @@
+package coderd
+
+import (
+	"testing"
+	"testing/fstest"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/require"
+
+	"github.com/coder/coder/v2/coderd/database"
+	"github.com/coder/coder/v2/codersdk"
+)
+
+func TestDiffTemplateFiles(t *testing.T) {
+	t.Parallel()
+
+	oldFS := fstest.MapFS{
+		"main.tf":           {Data: []byte("resource \"null_resource\" \"a\" {}\n")},
+		"README.md":         {Data: []byte("# Template\n")},
+		"scripts/init.sh":   {Data: []byte("#!/bin/sh\necho hello\n")},
+		"images/logo.png":   {Data: []byte{0x89, 'P', 'N', 'G', 0x00, 0x01}},
+		"unchanged/file.tf": {Data: []byte("locals {}\n")},
+	}
+	newFS := fstest.MapFS{
+		"main.tf":           {Data: []byte("resource \"null_resource\" \"b\" {}\n")},
+		"scripts/init.sh":   {Data: []byte("#!/bin/sh\necho hello\n")},
+		"images/logo.png":   {Data: []byte{0x89, 'P', 'N', 'G', 0x00, 0x02}},
+		"unchanged/file.tf": {Data: []byte("locals {}\n")},
+		"variables.tf":      {Data: []byte("variable \"region\" {}\n")},
+	}
+
+	diffs, err := diffTemplateFiles(oldFS, newFS)
+	require.NoError(t, err)
+	require.Len(t, diffs, 4)
+
+	require.Equal(t, "README.md", diffs[0].Path)
+	require.Equal(t, codersdk.TemplateVersionDiffChangeRemoved, diffs[0].Change)
+	require.Contains(t, diffs[0].Diff, "--- a/README.md\n+++ /dev/null\n")
+	require.Contains(t, diffs[0].Diff, "-# Template\n")
+
+	require.Equal(t, "images/logo.png", diffs[1].Path)
+	require.Equal(t, codersdk.TemplateVersionDiffChangeChanged, diffs[1].Change)
+	require.True(t, diffs[1].Binary)
+	require.Empty(t, diffs[1].Diff)
+
+	require.Equal(t, "main.tf", diffs[2].Path)
+	require.Equal(t, codersdk.TemplateVersionDiffChangeChanged, diffs[2].Change)
+	require.False(t, diffs[2].Binary)
+	require.Contains(t, diffs[2].Diff, "--- a/main.tf\n+++ b/main.tf\n")
+	require.Contains(t, diffs[2].Diff, "-resource \"null_resource\" \"a\" {}\n+resource \"null_resource\" \"b\" {}\n")
+
+	require.Equal(t, "variables.tf", diffs[3].Path)
+	require.Equal(t, codersdk.TemplateVersionDiffChangeAdded, diffs[3].Change)
+	require.Contains(t, diffs[3].Diff, "--- /dev/null\n+++ b/variables.tf\n")
+}
+
+func TestDiffTemplateVersionPresets(t *testing.T) {
+	t.Parallel()
+
+	oldPresets := []codersdk.Preset{
+		{
+			ID:   uuid.New(),
+			Name: "small",
+			Parameters: []codersdk.PresetParameter{
+				{Name: "cpu", Value: "2"},
+				{Name: "memory", Value: "4"},
+			},
+		},
+		{ID: uuid.New(), Name: "large", Parameters: []codersdk.PresetParameter{{Name: "cpu", Value: "8"}}},
+		{ID: uuid.New(), Name: "legacy"},
+	}
+	newPresets := []codersdk.Preset{
+		{
+			// Only the ID and the parameter order differ.
+			ID:   uuid.New(),
+			Name: "small",
+			Parameters: []codersdk.PresetParameter{
+				{Name: "memory", Value: "4"},
+				{Name: "cpu", Value: "2"},
+			},
+		},
+		{ID: uuid.New(), Name: "large", Parameters: []codersdk.PresetParameter{{Name: "cpu", Value: "16"}}},
+		{ID: uuid.New(), Name: "gpu"},
+	}
+
+	diffs := diffTemplateVersionPresets(oldPresets, newPresets)
+	require.Len(t, diffs, 3)
+	require.Equal(t, "gpu", diffs[0].Name)
+	require.Equal(t, codersdk.TemplateVersionDiffChangeAdded, diffs[0].Change)
+	require.Nil(t, diffs[0].Old)
+	require.NotNil(t, diffs[0].New)
+	require.Equal(t, "large", diffs[1].Name)
+	require.Equal(t, codersdk.TemplateVersionDiffChangeChanged, diffs[1].Change)
+	require.Equal(t, "16", diffs[1].New.Parameters[0].Value)
+	require.Equal(t, "legacy", diffs[2].Name)
+	require.Equal(t, codersdk.TemplateVersionDiffChangeRemoved, diffs[2].Change)
+	require.Nil(t, diffs[2].New)
+}
+
+func TestDiffTemplateVersionWorkspaceTagsAndExternalAuth(t *testing.T) {
+	t.Parallel()
+
+	tags := diffTemplateVersionWorkspaceTags(
+		[]database.TemplateVersionWorkspaceTag{{Key: "region", Value: "us"}, {Key: "os", Value: "linux"}},
+		[]database.TemplateVersionWorkspaceTag{{Key: "region", Value: "eu"}, {Key: "os", Value: "linux"}, {Key: "arch", Value: "arm64"}},
+	)
+	require.Equal(t, []codersdk.TemplateVersionWorkspaceTagDiff{
+		{Key: "arch", Change: codersdk.TemplateVersionDiffChangeAdded, NewValue: "arm64"},
+		{Key: "region", Change: codersdk.TemplateVersionDiffChangeChanged, OldValue: "us", NewValue: "eu"},
+	}, tags)
+
+	externalAuth := diffTemplateVersionExternalAuth(
+		[]database.ExternalAuthProvider{{ID: "github", Optional: true}, {ID: "gitlab"}},
+		[]database.ExternalAuthProvider{{ID: "github", Optional: false}},
+	)
+	require.Len(t, externalAuth, 2)
+	require.Equal(t, "github", externalAuth[0].ID)
+	require.Equal(t, codersdk.TemplateVersionDiffChangeChanged, externalAuth[0].Change)
+	require.True(t, *externalAuth[0].OldOptional)
+	require.False(t, *externalAuth[0].NewOptional)
+	require.Equal(t, "gitlab", externalAuth[1].ID)
+	require.Equal(t, codersdk.TemplateVersionDiffChangeRemoved, externalAuth[1].Change)
+	require.Nil(t, externalAuth[1].NewOptional)
+}
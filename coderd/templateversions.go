@@ -36,6 +36,7 @@ import (
 	"github.com/coder/coder/v2/coderd/provisionerdserver"
 	"github.com/coder/coder/v2/coderd/rbac"
 	"github.com/coder/coder/v2/coderd/rbac/policy"
+	"github.com/coder/coder/v2/coderd/templaterollout"
 	"github.com/coder/coder/v2/coderd/tracing"
 	"github.com/coder/coder/v2/coderd/util/namesgenerator"
 	"github.com/coder/coder/v2/coderd/util/ptr"
@@ -590,6 +591,8 @@ func (api *API) postTemplateVersionDryRun(rw http.ResponseWriter, r *http.Reques
 			RawMessage: metadataRaw,
 		},
 		LogsOverflowed: false,
+		Priority:       codersdk.ProvisionerJobPriorityInteractive,
+		TemplateID:     templateVersion.TemplateID.UUID,
 	})
 	if err != nil {
 		httpapi.Write(ctx, rw, http.StatusInternalServerError, codersdk.Response{
@@ -1447,14 +1450,20 @@ func (api *API) patchActiveTemplateVersion(rw http.ResponseWriter, r *http.Reque
 	}
 
 	err = api.Database.InTx(func(store database.Store) error {
+		now := dbtime.Now()
 		err = store.UpdateTemplateActiveVersionByID(ctx, database.UpdateTemplateActiveVersionByIDParams{
 			ID:              template.ID,
 			ActiveVersionID: req.ID,
-			UpdatedAt:       dbtime.Now(),
+			UpdatedAt:       now,
 		})
 		if err != nil {
 			return xerrors.Errorf("update active version: %w", err)
 		}
+		// Promoting a version replaces any rollout of the template.
+		err = templaterollout.Finish(ctx, store, template.ID, req.ID, now)
+		if err != nil {
+			return xerrors.Errorf("finish template version rollout: %w", err)
+		}
 		return nil
 	}, nil)
 	if err != nil {
@@ -1689,6 +1698,8 @@ func (api *API) postTemplateVersionsByOrganization(rw http.ResponseWriter, r *ht
 				RawMessage: traceMetadataRaw,
 			},
 			LogsOverflowed: false,
+			Priority:       codersdk.ProvisionerJobPriorityTemplateImport,
+			TemplateID:     req.TemplateID,
 		})
 		if err != nil {
 			httpapi.Write(ctx, rw, http.StatusInternalServerError, codersdk.Response{
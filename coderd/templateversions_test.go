@@ -2571,6 +2571,105 @@ func TestTemplateVersionParameters_Order(t *testing.T) {
 	require.Equal(t, thirdParameterName, templateRichParameters[4].Name)
 }
 
+func TestTemplateVersionDiff(t *testing.T) {
+	t.Parallel()
+
+	graph := func(params ...*proto.RichParameter) []*proto.Response {
+		return []*proto.Response{{
+			Type: &proto.Response_Graph{
+				Graph: &proto.GraphComplete{Parameters: params},
+			},
+		}}
+	}
+
+	client := coderdtest.New(t, &coderdtest.Options{IncludeProvisionerDaemon: true})
+	owner := coderdtest.CreateFirstUser(t, client)
+	templateAdmin, _ := coderdtest.CreateAnotherUser(t, client, owner.OrganizationID, rbac.RoleTemplateAdmin())
+
+	version := coderdtest.CreateTemplateVersion(t, templateAdmin, owner.OrganizationID, &echo.Responses{
+		Parse:          echo.ParseComplete,
+		ProvisionGraph: graph(&proto.RichParameter{Name: "region", Type: "string", DefaultValue: "us"}, &proto.RichParameter{Name: "legacy", Type: "bool"}),
+		ProvisionApply: echo.ApplyComplete,
+		ExtraFiles:     map[string][]byte{"main.tf": []byte("locals {\n  name = \"a\"\n}\n")},
+	})
+	coderdtest.AwaitTemplateVersionJobCompleted(t, templateAdmin, version.ID)
+	template := coderdtest.CreateTemplate(t, templateAdmin, owner.OrganizationID, version.ID)
+
+	updated := coderdtest.UpdateTemplateVersion(t, templateAdmin, owner.OrganizationID, &echo.Responses{
+		Parse:          echo.ParseComplete,
+		ProvisionGraph: graph(&proto.RichParameter{Name: "region", Type: "string", DefaultValue: "eu"}, &proto.RichParameter{Name: "size", Type: "number"}),
+		ProvisionApply: echo.ApplyComplete,
+		ExtraFiles:     map[string][]byte{"main.tf": []byte("locals {\n  name = \"b\"\n}\n")},
+	}, template.ID)
+	coderdtest.AwaitTemplateVersionJobCompleted(t, templateAdmin, updated.ID)
+
+	t.Run("ActiveVersion", func(t *testing.T) {
+		t.Parallel()
+		ctx := testutil.Context(t, testutil.WaitLong)
+
+		diff, err := templateAdmin.TemplateVersionDiff(ctx, updated.ID, uuid.Nil)
+		require.NoError(t, err)
+		require.Equal(t, version.ID, diff.BaseTemplateVersionID)
+		require.Equal(t, updated.ID, diff.TemplateVersionID)
+
+		require.Len(t, diff.RichParameters, 3)
+		require.Equal(t, "legacy", diff.RichParameters[0].Name)
+		require.Equal(t, codersdk.TemplateVersionDiffChangeRemoved, diff.RichParameters[0].Change)
+		require.Equal(t, "region", diff.RichParameters[1].Name)
+		require.Equal(t, codersdk.TemplateVersionDiffChangeChanged, diff.RichParameters[1].Change)
+		require.Equal(t, "us", diff.RichParameters[1].Old.DefaultValue)
+		require.Equal(t, "eu", diff.RichParameters[1].New.DefaultValue)
+		require.Equal(t, "size", diff.RichParameters[2].Name)
+		require.Equal(t, codersdk.TemplateVersionDiffChangeAdded, diff.RichParameters[2].Change)
+
+		var mainTF *codersdk.TemplateVersionFileDiff
+		for i := range diff.Files {
+			if diff.Files[i].Path == "main.tf" {
+				mainTF = &diff.Files[i]
+			}
+		}
+		require.NotNil(t, mainTF, "main.tf should be in the diff")
+		require.Equal(t, codersdk.TemplateVersionDiffChangeChanged, mainTF.Change)
+		require.Contains(t, mainTF.Diff, "-  name = \"a\"\n+  name = \"b\"\n")
+	})
+
+	t.Run("SameVersion", func(t *testing.T) {
+		t.Parallel()
+		ctx := testutil.Context(t, testutil.WaitLong)
+
+		diff, err := templateAdmin.TemplateVersionDiff(ctx, version.ID, version.ID)
+		require.NoError(t, err)
+		require.True(t, diff.Empty())
+	})
+
+	t.Run("BaseNotFound", func(t *testing.T) {
+		t.Parallel()
+		ctx := testutil.Context(t, testutil.WaitLong)
+
+		_, err := templateAdmin.TemplateVersionDiff(ctx, updated.ID, uuid.New())
+		var apiErr *codersdk.Error
+		require.ErrorAs(t, err, &apiErr)
+		require.Equal(t, http.StatusBadRequest, apiErr.StatusCode())
+	})
+
+	t.Run("NotImported", func(t *testing.T) {
+		t.Parallel()
+		ctx := testutil.Context(t, testutil.WaitLong)
+
+		failed := coderdtest.UpdateTemplateVersion(t, templateAdmin, owner.OrganizationID, &echo.Responses{
+			Parse: []*proto.Response{{
+				Type: &proto.Response_Parse{Parse: &proto.ParseComplete{Error: "parse failed"}},
+			}},
+		}, template.ID)
+		coderdtest.AwaitTemplateVersionJobCompleted(t, templateAdmin, failed.ID)
+
+		_, err := templateAdmin.TemplateVersionDiff(ctx, failed.ID, uuid.Nil)
+		var apiErr *codersdk.Error
+		require.ErrorAs(t, err, &apiErr)
+		require.Equal(t, http.StatusTooEarly, apiErr.StatusCode())
+	})
+}
+
 func TestTemplateArchiveVersions(t *testing.T) {
 	t.Parallel()
 
@@ -16,6 +16,7 @@ import (
 	"time"
 
 	"github.com/coreos/go-oidc/v3/oidc"
+	"github.com/go-chi/chi/v5"
 	"github.com/go-jose/go-jose/v4"
 	"github.com/go-jose/go-jose/v4/jwt"
 	"github.com/google/go-github/v43/github"
@@ -810,6 +811,15 @@ func (api *API) userAuthMethods(rw http.ResponseWriter, r *http.Request) {
 		iconURL = api.OIDCConfig.IconURL
 	}
 
+	var oidcProviders []codersdk.OIDCProviderAuthMethod
+	for _, provider := range api.OIDCProviders {
+		oidcProviders = append(oidcProviders, codersdk.OIDCProviderAuthMethod{
+			ID:         provider.ID,
+			SignInText: provider.SignInText,
+			IconURL:    provider.IconURL,
+		})
+	}
+
 	httpapi.Write(r.Context(), rw, http.StatusOK, codersdk.AuthMethods{
 		TermsOfServiceURL: api.DeploymentValues.TermsOfServiceURL.Value(),
 		Password: codersdk.AuthMethod{
@@ -824,6 +834,7 @@ func (api *API) userAuthMethods(rw http.ResponseWriter, r *http.Request) {
 			SignInText: signInText,
 			IconURL:    iconURL,
 		},
+		OIDCProviders: oidcProviders,
 	})
 }
 
@@ -1207,6 +1218,15 @@ type OIDCConfig struct {
 	// scheme (e.g. "http") rather than the original client-facing scheme,
 	// which would produce a redirect_uri the IdP rejects.
 	RedirectDefaultScheme string
+
+	// ID identifies a named OIDC provider and is stored on the user links
+	// it creates. It is empty for the deployment's primary provider.
+	ID string
+	// SyncSettings are the static IdP sync settings of a named provider.
+	SyncSettings idpsync.DeploymentSyncSettings
+	// IDPSync syncs the users of a named provider. The primary provider
+	// uses API.IDPSync instead.
+	IDPSync idpsync.IDPSync
 }
 
 // PKCESupported is to prevent nil pointer dereference.
@@ -1217,6 +1237,17 @@ func (o *OIDCConfig) PKCESupported() []promoauth.Oauth2PKCEChallengeMethod {
 	return o.PKCEMethods
 }
 
+// OIDCProviderOAuth2Configs returns the OAuth2 configs of the named OIDC
+// providers keyed by ID, which is the form the API key middleware needs to
+// refresh their tokens.
+func OIDCProviderOAuth2Configs(providers []*OIDCConfig) map[string]promoauth.OAuth2Config {
+	configs := make(map[string]promoauth.OAuth2Config, len(providers))
+	for _, provider := range providers {
+		configs[provider.ID] = provider
+	}
+	return configs
+}
+
 // @Summary OpenID Connect Callback
 // @ID openid-connect-callback
 // @Security CoderSessionToken
@@ -1224,6 +1255,28 @@ func (o *OIDCConfig) PKCESupported() []promoauth.Oauth2PKCEChallengeMethod {
 // @Success 307
 // @Router /api/v2/users/oidc/callback [get]
 func (api *API) userOIDC(rw http.ResponseWriter, r *http.Request) {
+	api.handleOIDCCallback(rw, r, api.OIDCConfig, api.IDPSync)
+}
+
+// @Summary OpenID Connect Callback for a named provider
+// @ID openid-connect-callback-for-a-named-provider
+// @Security CoderSessionToken
+// @Tags Users
+// @Param provider path string true "OIDC provider ID"
+// @Success 307
+// @Router /api/v2/users/oidc/{provider}/callback [get]
+func (api *API) userOIDCProvider(rw http.ResponseWriter, r *http.Request) {
+	handler, ok := api.oidcProviderCallbacks[chi.URLParam(r, "provider")]
+	if !ok {
+		httpapi.Write(r.Context(), rw, http.StatusNotFound, codersdk.Response{
+			Message: fmt.Sprintf("OIDC provider %q is not configured.", chi.URLParam(r, "provider")),
+		})
+		return
+	}
+	handler.ServeHTTP(rw, r)
+}
+
+func (api *API) handleOIDCCallback(rw http.ResponseWriter, r *http.Request, oidcConfig *OIDCConfig, idpSync idpsync.IDPSync) {
 	var (
 		// userOIDC is a system function.
 		//nolint:gocritic
@@ -1249,7 +1302,7 @@ func (api *API) userOIDC(rw http.ResponseWriter, r *http.Request) {
 		return
 	}
 
-	idToken, err := api.OIDCConfig.Verifier.Verify(ctx, rawIDToken)
+	idToken, err := oidcConfig.Verifier.Verify(ctx, rawIDToken)
 	if err != nil {
 		httpapi.Write(ctx, rw, http.StatusBadRequest, codersdk.Response{
 			Message: "Failed to verify OIDC token.",
@@ -1308,9 +1361,9 @@ func (api *API) userOIDC(rw http.ResponseWriter, r *http.Request) {
 	// If user info is skipped, the idtokenClaims are the claims.
 	mergedClaims := idtokenClaims
 	supplementaryClaims := make(map[string]interface{})
-	switch api.OIDCConfig.SecondaryClaims {
+	switch oidcConfig.SecondaryClaims {
 	case MergedClaimsSourceUserInfo:
-		supplementaryClaims, ok = api.userInfoClaims(ctx, rw, state, logger)
+		supplementaryClaims, ok = api.userInfoClaims(ctx, rw, oidcConfig, state, logger)
 		if !ok {
 			return
 		}
@@ -1320,7 +1373,7 @@ func (api *API) userOIDC(rw http.ResponseWriter, r *http.Request) {
 		// important?
 		mergedClaims = mergeClaims(idtokenClaims, supplementaryClaims)
 	case MergedClaimsSourceAccessToken:
-		supplementaryClaims, ok = api.accessTokenClaims(ctx, rw, state, logger)
+		supplementaryClaims, ok = api.accessTokenClaims(ctx, rw, oidcConfig, state, logger)
 		if !ok {
 			return
 		}
@@ -1334,18 +1387,18 @@ func (api *API) userOIDC(rw http.ResponseWriter, r *http.Request) {
 		// This should never happen and is a developer error
 		httpapi.Write(ctx, rw, http.StatusInternalServerError, codersdk.Response{
 			Message: "Invalid source for secondary user claims.",
-			Detail:  fmt.Sprintf("invalid source: %q", api.OIDCConfig.SecondaryClaims),
+			Detail:  fmt.Sprintf("invalid source: %q", oidcConfig.SecondaryClaims),
 		})
 		return // Invalid MergedClaimsSource
 	}
 
-	usernameRaw, ok := mergedClaims[api.OIDCConfig.UsernameField]
+	usernameRaw, ok := mergedClaims[oidcConfig.UsernameField]
 	var username string
 	if ok {
 		username, _ = usernameRaw.(string)
 	}
 
-	emailRaw, ok := mergedClaims[api.OIDCConfig.EmailField]
+	emailRaw, ok := mergedClaims[oidcConfig.EmailField]
 	if !ok {
 		// Email is an optional claim in OIDC and
 		// instead the email is frequently sent in
@@ -1386,7 +1439,7 @@ func (api *API) userOIDC(rw http.ResponseWriter, r *http.Request) {
 	}
 
 	if !emailVerified {
-		if !api.OIDCConfig.IgnoreEmailVerified {
+		if !oidcConfig.IgnoreEmailVerified {
 			site.RenderStaticErrorPage(rw, r, site.ErrorPageData{
 				Status:     http.StatusForbidden,
 				HideStatus: true,
@@ -1418,7 +1471,7 @@ func (api *API) userOIDC(rw http.ResponseWriter, r *http.Request) {
 		username = codersdk.UsernameFrom(username)
 	}
 
-	if len(api.OIDCConfig.EmailDomain) > 0 {
+	if len(oidcConfig.EmailDomain) > 0 {
 		ok = false
 		emailSp := strings.Split(email, "@")
 		if len(emailSp) == 1 {
@@ -1437,7 +1490,7 @@ func (api *API) userOIDC(rw http.ResponseWriter, r *http.Request) {
 			return
 		}
 		userEmailDomain := emailSp[len(emailSp)-1]
-		for _, domain := range api.OIDCConfig.EmailDomain {
+		for _, domain := range oidcConfig.EmailDomain {
 			// Folks sometimes enter EmailDomain with a leading '@'.
 			domain = strings.TrimPrefix(domain, "@")
 			if strings.EqualFold(userEmailDomain, domain) {
@@ -1465,7 +1518,7 @@ func (api *API) userOIDC(rw http.ResponseWriter, r *http.Request) {
 	// The 'name' is an optional property in Coder. If not specified,
 	// it will be left blank.
 	var name string
-	nameRaw, ok := mergedClaims[api.OIDCConfig.NameField]
+	nameRaw, ok := mergedClaims[oidcConfig.NameField]
 	if ok {
 		name, _ = nameRaw.(string)
 		name = codersdk.NormalizeRealUsername(name)
@@ -1478,7 +1531,7 @@ func (api *API) userOIDC(rw http.ResponseWriter, r *http.Request) {
 	}
 	ctx = slog.With(ctx, slog.F("email", email), slog.F("username", username), slog.F("name", name))
 
-	user, link, err := findLinkedUser(ctx, api.Database, oidcLinkedID(idToken), database.LoginTypeOIDC, api.OIDCConfig.EmailFallback, email)
+	user, link, err := findLinkedUser(ctx, api.Database, oidcLinkedID(idToken), database.LoginTypeOIDC, oidcConfig.EmailFallback, email)
 	if errors.Is(err, errLinkedIDAlreadyBound) {
 		logger.Warn(ctx, "oauth2: blocked login, account already linked to different identity",
 			slog.F("email", email),
@@ -1503,19 +1556,19 @@ func (api *API) userOIDC(rw http.ResponseWriter, r *http.Request) {
 		return
 	}
 
-	orgSync, orgSyncErr := api.IDPSync.ParseOrganizationClaims(ctx, mergedClaims)
+	orgSync, orgSyncErr := idpSync.ParseOrganizationClaims(ctx, mergedClaims)
 	if orgSyncErr != nil {
 		orgSyncErr.Write(rw, r)
 		return
 	}
 
-	groupSync, groupSyncErr := api.IDPSync.ParseGroupClaims(ctx, mergedClaims)
+	groupSync, groupSyncErr := idpSync.ParseGroupClaims(ctx, mergedClaims)
 	if groupSyncErr != nil {
 		groupSyncErr.Write(rw, r)
 		return
 	}
 
-	roleSync, roleSyncErr := api.IDPSync.ParseRoleClaims(ctx, mergedClaims)
+	roleSync, roleSyncErr := idpSync.ParseRoleClaims(ctx, mergedClaims)
 	if roleSyncErr != nil {
 		roleSyncErr.Write(rw, r)
 		return
@@ -1533,7 +1586,7 @@ func (api *API) userOIDC(rw http.ResponseWriter, r *http.Request) {
 		State:            state,
 		LinkedID:         oidcLinkedID(idToken),
 		LoginType:        database.LoginTypeOIDC,
-		AllowSignups:     api.OIDCConfig.AllowSignups,
+		AllowSignups:     oidcConfig.AllowSignups,
 		Email:            email,
 		Username:         username,
 		Name:             name,
@@ -1546,7 +1599,10 @@ func (api *API) userOIDC(rw http.ResponseWriter, r *http.Request) {
 			UserInfoClaims: supplementaryClaims,
 			MergedClaims:   mergedClaims,
 		},
-		AllowInsecureLinkedIDMismatch: api.OIDCConfig.EmailFallback,
+		AllowInsecureLinkedIDMismatch: oidcConfig.EmailFallback,
+		OIDCProviderID:                oidcConfig.ID,
+		IDPSync:                       idpSync,
+		SignupsDisabledText:           oidcConfig.SignupsDisabledText,
 	}).SetInitAuditRequest(func(params *audit.RequestParams) (*audit.Request[database.User], func()) {
 		return audit.InitRequest[database.User](rw, params)
 	})
@@ -1578,9 +1634,9 @@ func (api *API) userOIDC(rw http.ResponseWriter, r *http.Request) {
 	http.Redirect(rw, r, redirect, http.StatusTemporaryRedirect)
 }
 
-func (api *API) accessTokenClaims(ctx context.Context, rw http.ResponseWriter, state httpmw.OAuth2State, logger slog.Logger) (accessTokenClaims map[string]interface{}, ok bool) {
+func (api *API) accessTokenClaims(ctx context.Context, rw http.ResponseWriter, oidcConfig *OIDCConfig, state httpmw.OAuth2State, logger slog.Logger) (accessTokenClaims map[string]interface{}, ok bool) {
 	// Assume the access token is a jwt, and signed by the provider.
-	accessToken, err := api.OIDCConfig.Verifier.Verify(ctx, state.Token.AccessToken)
+	accessToken, err := oidcConfig.Verifier.Verify(ctx, state.Token.AccessToken)
 	if err != nil {
 		logger.Error(ctx, "oauth2: unable to verify access token as secondary claims source", slog.Error(err))
 		httpapi.Write(ctx, rw, http.StatusBadRequest, codersdk.Response{
@@ -1604,9 +1660,9 @@ func (api *API) accessTokenClaims(ctx context.Context, rw http.ResponseWriter, s
 	return rawClaims, true
 }
 
-func (api *API) userInfoClaims(ctx context.Context, rw http.ResponseWriter, state httpmw.OAuth2State, logger slog.Logger) (userInfoClaims map[string]interface{}, ok bool) {
+func (api *API) userInfoClaims(ctx context.Context, rw http.ResponseWriter, oidcConfig *OIDCConfig, state httpmw.OAuth2State, logger slog.Logger) (userInfoClaims map[string]interface{}, ok bool) {
 	userInfoClaims = make(map[string]interface{})
-	userInfo, err := api.OIDCConfig.Provider.UserInfo(ctx, oauth2.StaticTokenSource(state.Token))
+	userInfo, err := oidcConfig.Provider.UserInfo(ctx, oauth2.StaticTokenSource(state.Token))
 	switch {
 	case err == nil:
 		err = userInfo.Claims(&userInfoClaims)
@@ -1707,6 +1763,16 @@ type oauthLoginParams struct {
 	// same user across connections.
 	AllowInsecureLinkedIDMismatch bool
 
+	// OIDCProviderID is stored on the user link with the tokens to record
+	// which named OIDC provider the user signed in with, so refreshes use
+	// that provider's config. It is empty for the primary provider.
+	OIDCProviderID string
+	// IDPSync overrides API.IDPSync for logins through a named OIDC provider.
+	IDPSync idpsync.IDPSync
+	// SignupsDisabledText overrides the primary OIDC provider's text shown
+	// when signups are disabled.
+	SignupsDisabledText string
+
 	commitLock       sync.Mutex
 	initAuditRequest func(params *audit.RequestParams) *audit.Request[database.User]
 	commits          []func()
@@ -1751,6 +1817,11 @@ func (api *API) oauthLogin(r *http.Request, params *oauthLoginParams) ([]*http.C
 		})
 	)
 
+	idpSync := api.IDPSync
+	if params.IDPSync != nil {
+		idpSync = params.IDPSync
+	}
+
 	var isConvertLoginType bool
 	err := api.Database.InTx(func(tx database.Store) error {
 		var (
@@ -1786,7 +1857,9 @@ func (api *API) oauthLogin(r *http.Request, params *oauthLoginParams) ([]*http.C
 
 		if user.ID == uuid.Nil && !allowSignup {
 			signupsDisabledText := "Please contact your Coder administrator to request access."
-			if api.OIDCConfig != nil && api.OIDCConfig.SignupsDisabledText != "" {
+			if params.SignupsDisabledText != "" {
+				signupsDisabledText = render.HTMLFromMarkdown(params.SignupsDisabledText)
+			} else if api.OIDCConfig != nil && api.OIDCConfig.SignupsDisabledText != "" {
 				signupsDisabledText = render.HTMLFromMarkdown(api.OIDCConfig.SignupsDisabledText)
 			}
 			return &idpsync.HTTPError{
@@ -1932,6 +2005,7 @@ func (api *API) oauthLogin(r *http.Request, params *oauthLoginParams) ([]*http.C
 				OAuthRefreshTokenKeyID: sql.NullString{}, // set by dbcrypt if required
 				OAuthExpiry:            params.State.Token.Expiry,
 				Claims:                 params.UserClaims,
+				OIDCProviderID:         params.OIDCProviderID,
 			})
 			if err != nil {
 				return xerrors.Errorf("insert user link: %w", err)
@@ -1949,6 +2023,7 @@ func (api *API) oauthLogin(r *http.Request, params *oauthLoginParams) ([]*http.C
 				OAuthRefreshTokenKeyID: sql.NullString{}, // set by dbcrypt if required
 				OAuthExpiry:            params.State.Token.Expiry,
 				Claims:                 params.UserClaims,
+				OIDCProviderID:         params.OIDCProviderID,
 			})
 			if err != nil {
 				return xerrors.Errorf("update user link: %w", err)
@@ -1973,9 +2048,10 @@ func (api *API) oauthLogin(r *http.Request, params *oauthLoginParams) ([]*http.C
 			if link.LinkedID == "" && params.LinkedID != "" {
 				//nolint:gocritic // System needs to update the user link.
 				link, err = tx.UpdateUserLinkedID(dbauthz.AsSystemRestricted(ctx), database.UpdateUserLinkedIDParams{
-					LinkedID:  params.LinkedID,
-					UserID:    user.ID,
-					LoginType: params.LoginType,
+					LinkedID:       params.LinkedID,
+					OIDCProviderID: params.OIDCProviderID,
+					UserID:         user.ID,
+					LoginType:      params.LoginType,
 				})
 				if err != nil {
 					return xerrors.Errorf("backfill user linked id: %w", err)
@@ -1983,20 +2059,20 @@ func (api *API) oauthLogin(r *http.Request, params *oauthLoginParams) ([]*http.C
 			}
 		}
 
-		err = api.IDPSync.SyncOrganizations(ctx, tx, user, params.OrganizationSync)
+		err = idpSync.SyncOrganizations(ctx, tx, user, params.OrganizationSync)
 		if err != nil {
 			return xerrors.Errorf("sync organizations: %w", err)
 		}
 
 		// Group sync needs to occur after org sync, since a user can join an org,
 		// then have their groups sync to said org.
-		err = api.IDPSync.SyncGroups(ctx, tx, user, params.GroupSync)
+		err = idpSync.SyncGroups(ctx, tx, user, params.GroupSync)
 		if err != nil {
 			return xerrors.Errorf("sync groups: %w", err)
 		}
 
 		// Role sync needs to occur after org sync.
-		err = api.IDPSync.SyncRoles(ctx, tx, user, params.RoleSync)
+		err = idpSync.SyncRoles(ctx, tx, user, params.RoleSync)
 		if err != nil {
 			return xerrors.Errorf("sync roles: %w", err)
 		}
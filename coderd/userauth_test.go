@@ -2782,6 +2782,103 @@ func TestOIDCDomainErrorMessage(t *testing.T) {
 	})
 }
 
+func TestOIDCNamedProviders(t *testing.T) {
+	t.Parallel()
+
+	primary := oidctest.NewFakeIDP(t, oidctest.WithServing())
+	contractors := oidctest.NewFakeIDP(t,
+		oidctest.WithServing(),
+		oidctest.WithCallbackPath("/api/v2/users/oidc/contractors/callback"),
+	)
+
+	client, db := coderdtest.NewWithDatabase(t, &coderdtest.Options{
+		OIDCConfig: primary.OIDCConfig(t, nil, func(cfg *coderd.OIDCConfig) {
+			cfg.AllowSignups = true
+		}),
+		OIDCProviders: []*coderd.OIDCConfig{
+			contractors.OIDCConfig(t, nil, func(cfg *coderd.OIDCConfig) {
+				cfg.ID = "contractors"
+				cfg.AllowSignups = true
+				cfg.SignInText = "Contractors"
+			}),
+		},
+	})
+	ctx := testutil.Context(t, testutil.WaitLong)
+
+	methods, err := client.AuthMethods(ctx)
+	require.NoError(t, err)
+	require.True(t, methods.OIDC.Enabled)
+	require.Equal(t, []codersdk.OIDCProviderAuthMethod{{
+		ID:         "contractors",
+		SignInText: "Contractors",
+	}}, methods.OIDCProviders)
+
+	contractorClient, resp := contractors.Login(t, client, jwt.MapClaims{
+		"email":          "contractor@example.com",
+		"email_verified": true,
+		"sub":            uuid.NewString(),
+	})
+	_ = resp.Body.Close()
+	contractor, err := contractorClient.User(ctx, codersdk.Me)
+	require.NoError(t, err)
+	require.Equal(t, codersdk.LoginTypeOIDC, contractor.LoginType)
+
+	claims, err := contractorClient.UserOIDCClaims(ctx)
+	require.NoError(t, err)
+	require.Equal(t, "contractors", claims.Provider)
+	require.Equal(t, "contractor@example.com", claims.Claims["email"])
+
+	link, err := db.GetUserLinkByUserIDLoginType(dbauthz.AsSystemRestricted(ctx), database.GetUserLinkByUserIDLoginTypeParams{
+		UserID:    contractor.ID,
+		LoginType: database.LoginTypeOIDC,
+	})
+	require.NoError(t, err)
+	require.Equal(t, "contractors", link.OIDCProviderID)
+
+	employeeClient, resp := primary.Login(t, client, jwt.MapClaims{
+		"email":          "employee@coder.com",
+		"email_verified": true,
+		"sub":            uuid.NewString(),
+	})
+	_ = resp.Body.Close()
+	claims, err = employeeClient.UserOIDCClaims(ctx)
+	require.NoError(t, err)
+	require.Empty(t, claims.Provider)
+
+	// Signing in through another provider that knows the same subject moves
+	// the link, and its tokens, to that provider.
+	sharedClaims := jwt.MapClaims{
+		"email":          "shared@coder.com",
+		"email_verified": true,
+		"sub":            uuid.NewString(),
+	}
+	sharedClient, resp := primary.Login(t, client, sharedClaims)
+	_ = resp.Body.Close()
+	shared, err := sharedClient.User(ctx, codersdk.Me)
+	require.NoError(t, err)
+	for _, provider := range []struct {
+		idp *oidctest.FakeIDP
+		id  string
+	}{
+		{idp: contractors, id: "contractors"},
+		{idp: primary, id: ""},
+	} {
+		_, resp = provider.idp.Login(t, client, sharedClaims)
+		_ = resp.Body.Close()
+		link, err = db.GetUserLinkByUserIDLoginType(dbauthz.AsSystemRestricted(ctx), database.GetUserLinkByUserIDLoginTypeParams{
+			UserID:    shared.ID,
+			LoginType: database.LoginTypeOIDC,
+		})
+		require.NoError(t, err)
+		require.Equal(t, provider.id, link.OIDCProviderID)
+	}
+
+	res, err := client.Request(ctx, http.MethodGet, "/api/v2/users/oidc/unknown/callback", nil)
+	require.NoError(t, err)
+	defer res.Body.Close()
+	require.Equal(t, http.StatusNotFound, res.StatusCode)
+}
+
 func TestOIDCSkipIssuer(t *testing.T) {
 	t.Parallel()
 	const primaryURLString = "https://primary.com"
@@ -126,7 +126,8 @@ func (api *API) userOIDCClaims(rw http.ResponseWriter, r *http.Request) {
 		claims = map[string]interface{}{}
 	}
 	httpapi.Write(ctx, rw, http.StatusOK, codersdk.OIDCClaimsResponse{
-		Claims: claims,
+		Claims:   claims,
+		Provider: link.OIDCProviderID,
 	})
 }
 
@@ -594,7 +595,7 @@ func (api *API) postUser(rw http.ResponseWriter, r *http.Request) {
 		}
 		loginType = database.LoginTypePassword
 	case codersdk.LoginTypeOIDC:
-		if api.OIDCConfig == nil {
+		if api.OIDCConfig == nil && len(api.OIDCProviders) == 0 {
 			httpapi.Write(ctx, rw, http.StatusBadRequest, codersdk.Response{
 				Message: "You must configure OIDC before creating OIDC users.",
 			})
@@ -0,0 +1,520 @@
+package coderd
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"net/http"
+
+	"github.com/google/uuid"
+	"golang.org/x/xerrors"
+
+	"cdr.dev/slog/v3"
+	"github.com/coder/coder/v2/agent/agentssh"
+	"github.com/coder/coder/v2/coderd/apikey"
+	"github.com/coder/coder/v2/coderd/database"
+	"github.com/coder/coder/v2/coderd/database/dbauthz"
+	"github.com/coder/coder/v2/coderd/database/dbtime"
+	dbpubsub "github.com/coder/coder/v2/coderd/database/pubsub"
+	"github.com/coder/coder/v2/coderd/httpapi"
+	"github.com/coder/coder/v2/coderd/httpmw"
+	"github.com/coder/coder/v2/coderd/pubsub"
+	"github.com/coder/coder/v2/coderd/rbac"
+	"github.com/coder/coder/v2/coderd/rbac/acl"
+	"github.com/coder/coder/v2/coderd/rbac/policy"
+	"github.com/coder/coder/v2/coderd/util/ptr"
+	"github.com/coder/coder/v2/coderd/workspaceapps"
+	"github.com/coder/coder/v2/codersdk"
+	"github.com/coder/coder/v2/codersdk/workspacesdk"
+	"github.com/coder/websocket"
+)
+
+// ptyShareTokenLength is the length of the secret handed to viewers of a
+// shared reconnecting PTY session.
+const ptyShareTokenLength = 32
+
+// @Summary Share workspace agent reconnecting PTY session
+// @ID share-workspace-agent-reconnecting-pty-session
+// @Security CoderSessionToken
+// @Accept json
+// @Produce json
+// @Tags Agents
+// @Param workspaceagent path string true "Workspace agent ID" format(uuid)
+// @Param request body codersdk.CreateWorkspaceAgentPTYShareRequest true "Share request"
+// @Success 201 {object} codersdk.CreateWorkspaceAgentPTYShareResponse
+// @Router /api/v2/workspaceagents/{workspaceagent}/pty-shares [post]
+func (api *API) postWorkspaceAgentPTYShare(rw http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	waws := httpmw.WorkspaceAgentAndWorkspaceParam(r)
+	apiKey := httpmw.APIKey(r)
+
+	if !api.allowWorkspaceSharing(ctx, rw, waws.WorkspaceTable.OrganizationID) {
+		return
+	}
+	if !api.Authorize(r, policy.ActionShare, waws.WorkspaceTable) {
+		httpapi.Forbidden(rw)
+		return
+	}
+
+	var req codersdk.CreateWorkspaceAgentPTYShareRequest
+	if !httpapi.Read(ctx, rw, r, &req) {
+		return
+	}
+	if req.Lifetime == 0 {
+		req.Lifetime = codersdk.DefaultWorkspaceAgentPTYShareLifetime
+	}
+	if req.Lifetime < 0 || req.Lifetime > codersdk.MaxWorkspaceAgentPTYShareLifetime {
+		httpapi.Write(ctx, rw, http.StatusBadRequest, codersdk.Response{
+			Message: fmt.Sprintf("Lifetime must be between 0 and %s.", codersdk.MaxWorkspaceAgentPTYShareLifetime),
+			Validations: []codersdk.ValidationError{
+				{Field: "lifetime", Detail: "Lifetime is out of range."},
+			},
+		})
+		return
+	}
+
+	validErrs := acl.Validate(ctx, api.Database, ptyShareInviteValidator(req.UserIDs))
+	if len(validErrs) > 0 {
+		httpapi.Write(ctx, rw, http.StatusBadRequest, codersdk.Response{
+			Message:     "Invalid request to share reconnecting PTY session.",
+			Validations: validErrs,
+		})
+		return
+	}
+	// Only users the workspace is already shared with can be invited, so the
+	// workspace ACL stays the single source of truth for who may see it.
+	for _, userID := range req.UserIDs {
+		ok, err := api.canReadWorkspace(ctx, userID, waws.WorkspaceTable)
+		if err != nil {
+			httpapi.InternalServerError(rw, err)
+			return
+		}
+		if !ok {
+			httpapi.Write(ctx, rw, http.StatusBadRequest, codersdk.Response{
+				Message: "Invalid request to share reconnecting PTY session.",
+				Validations: []codersdk.ValidationError{{
+					Field:  ptyShareInviteUsersFieldName,
+					Detail: fmt.Sprintf("workspace is not shared with user %s", userID),
+				}},
+			})
+			return
+		}
+	}
+
+	token, hashed, err := apikey.GenerateSecret(ptyShareTokenLength)
+	if err != nil {
+		httpapi.InternalServerError(rw, err)
+		return
+	}
+	now := dbtime.Now()
+	share, err := api.Database.InsertWorkspaceAgentPTYShare(ctx, database.InsertWorkspaceAgentPTYShareParams{
+		ID:          uuid.New(),
+		AgentID:     waws.WorkspaceAgent.ID,
+		ReconnectID: req.ReconnectID,
+		CreatedBy:   apiKey.UserID,
+		HashedToken: hashed,
+		UserIds:     req.UserIDs,
+		CreatedAt:   now,
+		ExpiresAt:   now.Add(req.Lifetime),
+	})
+	if err != nil {
+		httpapi.InternalServerError(rw, err)
+		return
+	}
+
+	httpapi.Write(ctx, rw, http.StatusCreated, codersdk.CreateWorkspaceAgentPTYShareResponse{
+		Share: convertPTYShare(share, nil),
+		Token: token,
+	})
+}
+
+// @Summary Get workspace agent reconnecting PTY shares
+// @ID get-workspace-agent-reconnecting-pty-shares
+// @Security CoderSessionToken
+// @Produce json
+// @Tags Agents
+// @Param workspaceagent path string true "Workspace agent ID" format(uuid)
+// @Success 200 {array} codersdk.WorkspaceAgentPTYShare
+// @Router /api/v2/workspaceagents/{workspaceagent}/pty-shares [get]
+func (api *API) workspaceAgentPTYShares(rw http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	waws := httpmw.WorkspaceAgentAndWorkspaceParam(r)
+
+	shares, err := api.Database.GetWorkspaceAgentPTYSharesByAgentID(ctx, waws.WorkspaceAgent.ID)
+	if dbauthz.IsNotAuthorizedError(err) {
+		httpapi.Forbidden(rw)
+		return
+	}
+	if err != nil {
+		httpapi.InternalServerError(rw, err)
+		return
+	}
+	viewers, err := api.Database.GetWorkspaceAgentPTYShareViewersByAgentID(ctx, waws.WorkspaceAgent.ID)
+	if err != nil {
+		httpapi.InternalServerError(rw, err)
+		return
+	}
+
+	viewersByShare := make(map[uuid.UUID][]database.GetWorkspaceAgentPTYShareViewersByAgentIDRow)
+	for _, viewer := range viewers {
+		viewersByShare[viewer.ShareID] = append(viewersByShare[viewer.ShareID], viewer)
+	}
+	converted := make([]codersdk.WorkspaceAgentPTYShare, 0, len(shares))
+	for _, share := range shares {
+		converted = append(converted, convertPTYShare(share, viewersByShare[share.ID]))
+	}
+	httpapi.Write(ctx, rw, http.StatusOK, converted)
+}
+
+// @Summary Revoke workspace agent reconnecting PTY share
+// @ID revoke-workspace-agent-reconnecting-pty-share
+// @Security CoderSessionToken
+// @Tags Agents
+// @Param workspaceagent path string true "Workspace agent ID" format(uuid)
+// @Param ptyshare path string true "Share ID" format(uuid)
+// @Success 204
+// @Router /api/v2/workspaceagents/{workspaceagent}/pty-shares/{ptyshare} [delete]
+func (api *API) deleteWorkspaceAgentPTYShare(rw http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	waws := httpmw.WorkspaceAgentAndWorkspaceParam(r)
+	shareID, ok := httpmw.ParseUUIDParam(rw, r, "ptyshare")
+	if !ok {
+		return
+	}
+
+	share, err := api.Database.GetWorkspaceAgentPTYShareByID(ctx, shareID)
+	if httpapi.Is404Error(err) || (err == nil && share.AgentID != waws.WorkspaceAgent.ID) {
+		httpapi.ResourceNotFound(rw)
+		return
+	}
+	if err != nil {
+		httpapi.InternalServerError(rw, err)
+		return
+	}
+
+	err = api.Database.RevokeWorkspaceAgentPTYShare(ctx, database.RevokeWorkspaceAgentPTYShareParams{
+		RevokedAt: dbtime.Now(),
+		ID:        share.ID,
+	})
+	if err != nil {
+		httpapi.InternalServerError(rw, err)
+		return
+	}
+
+	// Notify subscribers that this share was revoked so viewers are
+	// disconnected. Publishing is best effort; a failure does not leave the
+	// share usable by new viewers.
+	if err := api.Pubsub.Publish(pubsub.WorkspaceAgentPTYShareRevokedChannel(share.ID), nil); err != nil {
+		api.Logger.Warn(ctx, "failed to publish pty share revocation",
+			slog.F("share_id", share.ID), slog.Error(err))
+	}
+	rw.WriteHeader(http.StatusNoContent)
+}
+
+// @Summary Watch shared reconnecting PTY session
+// @ID watch-shared-reconnecting-pty-session
+// @Security CoderSessionToken
+// @Tags Agents
+// @Param token query string true "Share token"
+// @Param width query int false "Terminal width"
+// @Param height query int false "Terminal height"
+// @Success 101
+// @Router /api/v2/pty-shares/watch [get]
+func (api *API) watchSharedWorkspaceAgentPTY(rw http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	apiKey := httpmw.APIKey(r)
+
+	values := r.URL.Query()
+	parser := httpapi.NewQueryParamParser()
+	token := parser.RequiredNotEmpty(codersdk.WorkspaceAgentPTYShareTokenQueryParameter).
+		String(values, "", codersdk.WorkspaceAgentPTYShareTokenQueryParameter)
+	height := parser.UInt(values, 80, "height")
+	width := parser.UInt(values, 80, "width")
+	parser.ErrorExcessParams(values)
+	if len(parser.Errors) > 0 {
+		httpapi.Write(ctx, rw, http.StatusBadRequest, codersdk.Response{
+			Message:     "Invalid query parameters.",
+			Validations: parser.Errors,
+		})
+		return
+	}
+
+	// The viewer is not allowed to manage the share, so it's looked up as the
+	// system and checked against the viewer below.
+	// nolint:gocritic // Viewers cannot read shares of other users' workspaces.
+	share, err := api.Database.GetWorkspaceAgentPTYShareByHashedToken(dbauthz.AsSystemRestricted(ctx), apikey.HashSecret(token))
+	if httpapi.Is404Error(err) {
+		httpapi.ResourceNotFound(rw)
+		return
+	}
+	if err != nil {
+		httpapi.InternalServerError(rw, err)
+		return
+	}
+	if share.RevokedAt.Valid || !dbtime.Now().Before(share.ExpiresAt) {
+		httpapi.Write(ctx, rw, http.StatusGone, codersdk.Response{
+			Message: "This session share has expired or was revoked.",
+		})
+		return
+	}
+	invited := false
+	for _, userID := range share.UserIds {
+		if userID == apiKey.UserID {
+			invited = true
+			break
+		}
+	}
+	if !invited {
+		httpapi.ResourceNotFound(rw)
+		return
+	}
+
+	// Reading the workspace as the viewer re-checks the workspace ACL, so
+	// removing a user from it also removes their access to shared sessions.
+	waws, err := api.Database.GetWorkspaceAgentAndWorkspaceByID(ctx, share.AgentID)
+	if httpapi.Is404Error(err) {
+		httpapi.ResourceNotFound(rw)
+		return
+	}
+	if err != nil {
+		httpapi.InternalServerError(rw, err)
+		return
+	}
+	if !api.allowWorkspaceSharing(ctx, rw, waws.WorkspaceTable.OrganizationID) {
+		return
+	}
+
+	logger := api.Logger.With(
+		slog.F("agent_id", share.AgentID),
+		slog.F("share_id", share.ID),
+		slog.F("viewer_id", apiKey.UserID),
+	)
+
+	// Viewers are disconnected when the share is revoked or expires, so
+	// cancel the session once either happens.
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+
+	shareRevoked := func(ctx context.Context) (bool, error) {
+		// nolint:gocritic // Viewers cannot read shares of other users' workspaces.
+		current, err := api.Database.GetWorkspaceAgentPTYShareByID(dbauthz.AsSystemRestricted(ctx), share.ID)
+		if err != nil {
+			return false, err
+		}
+		return current.RevokedAt.Valid, nil
+	}
+	closeSubscribe, err := api.Pubsub.SubscribeWithErr(
+		pubsub.WorkspaceAgentPTYShareRevokedChannel(share.ID),
+		func(_ context.Context, _ []byte, subErr error) {
+			// ErrDroppedMessages means the Postgres listener reconnected; a
+			// revocation published during the outage may not have been
+			// delivered, so query the share directly instead of relying on
+			// the notification.
+			if xerrors.Is(subErr, dbpubsub.ErrDroppedMessages) {
+				revoked, err := shareRevoked(ctx)
+				if err != nil {
+					logger.Warn(ctx, "failed to re-check pty share after dropped messages", slog.Error(err))
+					return
+				}
+				if !revoked {
+					return
+				}
+			}
+			logger.Info(ctx, "pty share revoked, disconnecting viewer")
+			cancel()
+		},
+	)
+	if err != nil {
+		httpapi.InternalServerError(rw, xerrors.Errorf("subscribe to pty share revocation: %w", err))
+		return
+	}
+	defer closeSubscribe()
+
+	// Postgres LISTEN/NOTIFY does not deliver notifications published before
+	// registration, so re-check after subscribing.
+	if revoked, err := shareRevoked(ctx); err != nil {
+		httpapi.InternalServerError(rw, err)
+		return
+	} else if revoked {
+		httpapi.Write(ctx, rw, http.StatusGone, codersdk.Response{
+			Message: "This session share has expired or was revoked.",
+		})
+		return
+	}
+
+	expired := api.Clock.AfterFunc(api.Clock.Until(share.ExpiresAt), cancel, "watchSharedWorkspaceAgentPTY")
+	defer expired.Stop()
+
+	agentConn, release, err := api.agentProvider.AgentConn(ctx, share.AgentID)
+	if err != nil {
+		httpapi.Write(ctx, rw, http.StatusBadGateway, codersdk.Response{
+			Message: "Failed to dial workspace agent.",
+			Detail:  err.Error(),
+		})
+		return
+	}
+	defer release()
+
+	// #nosec G115 - Safe conversion for terminal height/width which are expected to be within uint16 range (0-65535)
+	ptNetConn, err := agentConn.ReconnectingPTY(ctx, share.ReconnectID, uint16(height), uint16(width), "", workspacesdk.AgentReconnectingPTYInitWithReadOnly())
+	if err != nil {
+		httpapi.Write(ctx, rw, http.StatusBadGateway, codersdk.Response{
+			Message: "Failed to dial reconnecting PTY.",
+			Detail:  err.Error(),
+		})
+		return
+	}
+	defer ptNetConn.Close()
+
+	conn, err := websocket.Accept(rw, r, &websocket.AcceptOptions{
+		CompressionMode: websocket.CompressionDisabled,
+	})
+	if err != nil {
+		logger.Error(ctx, "failed to accept websocket", slog.Error(err))
+		return
+	}
+
+	ctx, wsNetConn := workspaceapps.WebsocketNetConn(ctx, conn, websocket.MessageBinary)
+	defer wsNetConn.Close()
+
+	ctx = api.wsWatcher.Watch(ctx, logger, conn)
+
+	// nolint:gocritic // Viewers cannot write viewer records themselves.
+	viewer, err := api.Database.InsertWorkspaceAgentPTYShareViewer(dbauthz.AsSystemRestricted(ctx), database.InsertWorkspaceAgentPTYShareViewerParams{
+		ID:          uuid.New(),
+		ShareID:     share.ID,
+		UserID:      apiKey.UserID,
+		Ip:          database.ParseIP(r.RemoteAddr),
+		ConnectedAt: dbtime.Now(),
+	})
+	if err != nil {
+		logger.Error(ctx, "insert pty share viewer", slog.Error(err))
+		_ = conn.Close(websocket.StatusInternalError, httpapi.WebsocketCloseSprintf("record viewer: %s", err))
+		return
+	}
+	api.logPTYShareViewerConnection(ctx, logger, r, waws, viewer, database.ConnectionStatusConnected)
+	defer func() {
+		// The request context is likely done by now.
+		ctx := context.WithoutCancel(ctx)
+		// nolint:gocritic // Viewers cannot write viewer records themselves.
+		err := api.Database.UpdateWorkspaceAgentPTYShareViewerDisconnectedAt(dbauthz.AsSystemRestricted(ctx), database.UpdateWorkspaceAgentPTYShareViewerDisconnectedAtParams{
+			DisconnectedAt: dbtime.Now(),
+			ID:             viewer.ID,
+		})
+		if err != nil {
+			logger.Error(ctx, "update pty share viewer disconnected at", slog.Error(err))
+		}
+		api.logPTYShareViewerConnection(ctx, logger, r, waws, viewer, database.ConnectionStatusDisconnected)
+	}()
+
+	agentssh.Bicopy(ctx, wsNetConn, ptNetConn)
+	logger.Debug(ctx, "shared pty Bicopy finished")
+}
+
+// logPTYShareViewerConnection records a connection log entry for a viewer of
+// a shared reconnecting PTY session. The viewer ID is used as the connection
+// ID so the disconnect updates the same entry.
+func (api *API) logPTYShareViewerConnection(ctx context.Context, logger slog.Logger, r *http.Request, waws database.GetWorkspaceAgentAndWorkspaceByIDRow, viewer database.WorkspaceAgentPTYShareViewer, status database.ConnectionStatus) {
+	connLogger := api.ConnectionLogger.Load()
+	if connLogger == nil {
+		return
+	}
+	var (
+		code   sql.NullInt32
+		reason sql.NullString
+	)
+	if status == database.ConnectionStatusDisconnected {
+		code = sql.NullInt32{Int32: 0, Valid: true}
+		reason = sql.NullString{String: "viewer disconnected", Valid: true}
+	}
+	userAgent := r.UserAgent()
+	err := (*connLogger).Upsert(ctx, database.UpsertConnectionLogParams{
+		ID:               uuid.New(),
+		Time:             dbtime.Now(),
+		OrganizationID:   waws.WorkspaceTable.OrganizationID,
+		WorkspaceOwnerID: waws.WorkspaceTable.OwnerID,
+		WorkspaceID:      waws.WorkspaceTable.ID,
+		WorkspaceName:    waws.WorkspaceTable.Name,
+		AgentName:        waws.WorkspaceAgent.Name,
+		Type:             database.ConnectionTypeReconnectingPty,
+		IP:               viewer.Ip,
+		Code:             code,
+		UserAgent:        sql.NullString{String: userAgent, Valid: userAgent != ""},
+		UserID:           uuid.NullUUID{UUID: viewer.UserID, Valid: true},
+		SlugOrPort:       sql.NullString{},
+		ConnectionID:     uuid.NullUUID{UUID: viewer.ID, Valid: true},
+		DisconnectReason: reason,
+		ConnectionStatus: status,
+	})
+	if err != nil {
+		logger.Error(ctx, "upsert pty share viewer connection log", slog.Error(err))
+	}
+}
+
+// canReadWorkspace reports whether the given user can read the workspace,
+// either as its owner or through the workspace ACL.
+func (api *API) canReadWorkspace(ctx context.Context, userID uuid.UUID, workspace database.WorkspaceTable) (bool, error) {
+	subject, _, err := httpmw.UserRBACSubject(ctx, api.Database, userID, rbac.ScopeAll)
+	if err != nil {
+		return false, xerrors.Errorf("get user rbac subject: %w", err)
+	}
+	err = api.Authorizer.Authorize(ctx, subject, policy.ActionRead, workspace.RBACObject())
+	return err == nil, nil
+}
+
+func convertPTYShare(share database.WorkspaceAgentPTYShare, viewers []database.GetWorkspaceAgentPTYShareViewersByAgentIDRow) codersdk.WorkspaceAgentPTYShare {
+	converted := codersdk.WorkspaceAgentPTYShare{
+		ID:          share.ID,
+		AgentID:     share.AgentID,
+		ReconnectID: share.ReconnectID,
+		CreatedBy:   share.CreatedBy,
+		UserIDs:     share.UserIds,
+		CreatedAt:   share.CreatedAt,
+		ExpiresAt:   share.ExpiresAt,
+		Viewers:     make([]codersdk.WorkspaceAgentPTYShareViewer, 0, len(viewers)),
+	}
+	if share.RevokedAt.Valid {
+		converted.RevokedAt = ptr.Ref(share.RevokedAt.Time)
+	}
+	for _, viewer := range viewers {
+		v := codersdk.WorkspaceAgentPTYShareViewer{
+			ID:          viewer.ID,
+			UserID:      viewer.UserID,
+			Username:    viewer.Username,
+			AvatarURL:   viewer.AvatarURL,
+			ConnectedAt: viewer.ConnectedAt,
+		}
+		if viewer.Ip.Valid {
+			v.IP = viewer.Ip.IPNet.IP.String()
+		}
+		if viewer.DisconnectedAt.Valid {
+			v.DisconnectedAt = ptr.Ref(viewer.DisconnectedAt.Time)
+		}
+		converted.Viewers = append(converted.Viewers, v)
+	}
+	return converted
+}
+
+// ptyShareInviteValidator validates the users invited to watch a shared
+// reconnecting PTY session.
+type ptyShareInviteValidator []uuid.UUID
+
+const ptyShareInviteUsersFieldName = "user_ids"
+
+var _ acl.UpdateValidator[codersdk.WorkspaceRole] = ptyShareInviteValidator{}
+
+func (v ptyShareInviteValidator) Users() (map[string]codersdk.WorkspaceRole, string) {
+	users := make(map[string]codersdk.WorkspaceRole, len(v))
+	for _, id := range v {
+		users[id.String()] = codersdk.WorkspaceRoleUse
+	}
+	return users, ptyShareInviteUsersFieldName
+}
+
+func (ptyShareInviteValidator) Groups() (map[string]codersdk.WorkspaceRole, string) {
+	return map[string]codersdk.WorkspaceRole{}, "groups"
+}
+
+func (ptyShareInviteValidator) ValidateRole(codersdk.WorkspaceRole) error {
+	return nil
+}
@@ -0,0 +1,357 @@
+package coderd_test
+
+import (
+	"encoding/json"
+	"io"
+	"net/http"
+	"runtime"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/require"
+
+	"github.com/coder/coder/v2/agent/agenttest"
+	"github.com/coder/coder/v2/coderd/coderdtest"
+	"github.com/coder/coder/v2/coderd/connectionlog"
+	"github.com/coder/coder/v2/coderd/database"
+	"github.com/coder/coder/v2/coderd/database/dbfake"
+	"github.com/coder/coder/v2/coderd/rbac/policy"
+	"github.com/coder/coder/v2/codersdk"
+	"github.com/coder/coder/v2/codersdk/workspacesdk"
+	"github.com/coder/coder/v2/testutil"
+)
+
+func TestWorkspaceAgentPTYShares(t *testing.T) {
+	t.Parallel()
+
+	setup := func(t *testing.T) (ownerClient, friendClient *codersdk.Client, friend codersdk.User, agentID uuid.UUID, strangerID uuid.UUID) {
+		t.Helper()
+		client, db := coderdtest.NewWithDatabase(t, nil)
+		admin := coderdtest.CreateFirstUser(t, client)
+		ownerClient, owner := coderdtest.CreateAnotherUser(t, client, admin.OrganizationID)
+		friendClient, friend = coderdtest.CreateAnotherUser(t, client, admin.OrganizationID)
+		_, stranger := coderdtest.CreateAnotherUser(t, client, admin.OrganizationID)
+
+		r := dbfake.WorkspaceBuild(t, db, database.WorkspaceTable{
+			OrganizationID: admin.OrganizationID,
+			OwnerID:        owner.ID,
+			UserACL: database.WorkspaceACL{
+				friend.ID.String(): database.WorkspaceACLEntry{
+					Permissions: []policy.Action{policy.ActionRead},
+				},
+			},
+		}).WithAgent().Do()
+		return ownerClient, friendClient, friend, r.Agents[0].ID, stranger.ID
+	}
+
+	t.Run("OK", func(t *testing.T) {
+		t.Parallel()
+		ownerClient, _, friend, agentID, _ := setup(t)
+		ctx := testutil.Context(t, testutil.WaitMedium)
+
+		reconnectID := uuid.New()
+		res, err := ownerClient.CreateWorkspaceAgentPTYShare(ctx, agentID, codersdk.CreateWorkspaceAgentPTYShareRequest{
+			ReconnectID: reconnectID,
+			UserIDs:     []uuid.UUID{friend.ID},
+		})
+		require.NoError(t, err)
+		require.NotEmpty(t, res.Token)
+		require.Equal(t, reconnectID, res.Share.ReconnectID)
+		require.Equal(t, []uuid.UUID{friend.ID}, res.Share.UserIDs)
+		require.WithinDuration(t, res.Share.CreatedAt.Add(codersdk.DefaultWorkspaceAgentPTYShareLifetime), res.Share.ExpiresAt, time.Second)
+		require.True(t, res.Share.Active(time.Now()))
+
+		shares, err := ownerClient.WorkspaceAgentPTYShares(ctx, agentID)
+		require.NoError(t, err)
+		require.Len(t, shares, 1)
+		require.Equal(t, res.Share.ID, shares[0].ID)
+		require.Empty(t, shares[0].Viewers)
+
+		err = ownerClient.RevokeWorkspaceAgentPTYShare(ctx, agentID, res.Share.ID)
+		require.NoError(t, err)
+
+		shares, err = ownerClient.WorkspaceAgentPTYShares(ctx, agentID)
+		require.NoError(t, err)
+		require.Len(t, shares, 1)
+		require.NotNil(t, shares[0].RevokedAt)
+		require.False(t, shares[0].Active(time.Now()))
+	})
+
+	t.Run("InviteeWithoutAccess", func(t *testing.T) {
+		t.Parallel()
+		ownerClient, _, _, agentID, strangerID := setup(t)
+		ctx := testutil.Context(t, testutil.WaitMedium)
+
+		_, err := ownerClient.CreateWorkspaceAgentPTYShare(ctx, agentID, codersdk.CreateWorkspaceAgentPTYShareRequest{
+			ReconnectID: uuid.New(),
+			UserIDs:     []uuid.UUID{strangerID},
+		})
+		var sdkErr *codersdk.Error
+		require.ErrorAs(t, err, &sdkErr)
+		require.Equal(t, http.StatusBadRequest, sdkErr.StatusCode())
+		require.Len(t, sdkErr.Validations, 1)
+		require.Equal(t, "user_ids", sdkErr.Validations[0].Field)
+	})
+
+	t.Run("UnknownInvitee", func(t *testing.T) {
+		t.Parallel()
+		ownerClient, _, _, agentID, _ := setup(t)
+		ctx := testutil.Context(t, testutil.WaitMedium)
+
+		_, err := ownerClient.CreateWorkspaceAgentPTYShare(ctx, agentID, codersdk.CreateWorkspaceAgentPTYShareRequest{
+			ReconnectID: uuid.New(),
+			UserIDs:     []uuid.UUID{uuid.New()},
+		})
+		var sdkErr *codersdk.Error
+		require.ErrorAs(t, err, &sdkErr)
+		require.Equal(t, http.StatusBadRequest, sdkErr.StatusCode())
+	})
+
+	t.Run("LifetimeTooLong", func(t *testing.T) {
+		t.Parallel()
+		ownerClient, _, friend, agentID, _ := setup(t)
+		ctx := testutil.Context(t, testutil.WaitMedium)
+
+		_, err := ownerClient.CreateWorkspaceAgentPTYShare(ctx, agentID, codersdk.CreateWorkspaceAgentPTYShareRequest{
+			ReconnectID: uuid.New(),
+			UserIDs:     []uuid.UUID{friend.ID},
+			Lifetime:    codersdk.MaxWorkspaceAgentPTYShareLifetime + time.Hour,
+		})
+		var sdkErr *codersdk.Error
+		require.ErrorAs(t, err, &sdkErr)
+		require.Equal(t, http.StatusBadRequest, sdkErr.StatusCode())
+	})
+
+	t.Run("ViewerCannotShare", func(t *testing.T) {
+		t.Parallel()
+		_, friendClient, friend, agentID, _ := setup(t)
+		ctx := testutil.Context(t, testutil.WaitMedium)
+
+		_, err := friendClient.CreateWorkspaceAgentPTYShare(ctx, agentID, codersdk.CreateWorkspaceAgentPTYShareRequest{
+			ReconnectID: uuid.New(),
+			UserIDs:     []uuid.UUID{friend.ID},
+		})
+		var sdkErr *codersdk.Error
+		require.ErrorAs(t, err, &sdkErr)
+		require.Equal(t, http.StatusForbidden, sdkErr.StatusCode())
+	})
+
+	t.Run("WatchRevoked", func(t *testing.T) {
+		t.Parallel()
+		ownerClient, friendClient, friend, agentID, _ := setup(t)
+		ctx := testutil.Context(t, testutil.WaitMedium)
+
+		res, err := ownerClient.CreateWorkspaceAgentPTYShare(ctx, agentID, codersdk.CreateWorkspaceAgentPTYShareRequest{
+			ReconnectID: uuid.New(),
+			UserIDs:     []uuid.UUID{friend.ID},
+		})
+		require.NoError(t, err)
+		err = ownerClient.RevokeWorkspaceAgentPTYShare(ctx, agentID, res.Share.ID)
+		require.NoError(t, err)
+
+		_, err = workspacesdk.New(friendClient).WatchSharedReconnectingPTY(ctx, workspacesdk.WatchSharedReconnectingPTYOpts{
+			Token: res.Token,
+		})
+		var sdkErr *codersdk.Error
+		require.ErrorAs(t, err, &sdkErr)
+		require.Equal(t, http.StatusGone, sdkErr.StatusCode())
+	})
+
+	t.Run("WatchNotInvited", func(t *testing.T) {
+		t.Parallel()
+		ownerClient, _, friend, agentID, _ := setup(t)
+		ctx := testutil.Context(t, testutil.WaitMedium)
+
+		res, err := ownerClient.CreateWorkspaceAgentPTYShare(ctx, agentID, codersdk.CreateWorkspaceAgentPTYShareRequest{
+			ReconnectID: uuid.New(),
+			UserIDs:     []uuid.UUID{friend.ID},
+		})
+		require.NoError(t, err)
+
+		// The owner was not invited, so even they cannot use the token.
+		_, err = workspacesdk.New(ownerClient).WatchSharedReconnectingPTY(ctx, workspacesdk.WatchSharedReconnectingPTYOpts{
+			Token: res.Token,
+		})
+		var sdkErr *codersdk.Error
+		require.ErrorAs(t, err, &sdkErr)
+		require.Equal(t, http.StatusNotFound, sdkErr.StatusCode())
+	})
+}
+
+func TestWorkspaceAgentPTYShareWatch(t *testing.T) {
+	t.Parallel()
+	if runtime.GOOS == "windows" {
+		t.Skip("ConPTY appears to be inconsistent on Windows.")
+	}
+
+	connLogger := connectionlog.NewFake()
+	client, db := coderdtest.NewWithDatabase(t, &coderdtest.Options{
+		ConnectionLogger: connLogger,
+	})
+	admin := coderdtest.CreateFirstUser(t, client)
+	ownerClient, owner := coderdtest.CreateAnotherUser(t, client, admin.OrganizationID)
+	friendClient, friend := coderdtest.CreateAnotherUser(t, client, admin.OrganizationID)
+
+	r := dbfake.WorkspaceBuild(t, db, database.WorkspaceTable{
+		OrganizationID: admin.OrganizationID,
+		OwnerID:        owner.ID,
+		UserACL: database.WorkspaceACL{
+			friend.ID.String(): database.WorkspaceACLEntry{
+				Permissions: []policy.Action{policy.ActionRead},
+			},
+		},
+	}).WithAgent().Do()
+	_ = agenttest.New(t, client.URL, r.AgentToken)
+	resources := coderdtest.AwaitWorkspaceAgents(t, ownerClient, r.Workspace.ID)
+	agentID := resources[0].Agents[0].ID
+
+	ctx := testutil.Context(t, testutil.WaitLong)
+
+	reconnectID := uuid.New()
+	ownerConn, err := workspacesdk.New(ownerClient).AgentReconnectingPTY(ctx, workspacesdk.WorkspaceAgentReconnectingPTYOpts{
+		AgentID:   agentID,
+		Reconnect: reconnectID,
+		Width:     80,
+		Height:    80,
+		Command:   "bash --norc",
+	})
+	require.NoError(t, err)
+	defer ownerConn.Close()
+	ownerReader := testutil.NewTerminalReader(t, ownerConn)
+	matchPrompt := func(line string) bool {
+		return strings.Contains(line, "$ ") || strings.Contains(line, "# ")
+	}
+	require.NoError(t, ownerReader.ReadUntil(ctx, matchPrompt), "find prompt")
+
+	res, err := ownerClient.CreateWorkspaceAgentPTYShare(ctx, agentID, codersdk.CreateWorkspaceAgentPTYShareRequest{
+		ReconnectID: reconnectID,
+		UserIDs:     []uuid.UUID{friend.ID},
+	})
+	require.NoError(t, err)
+
+	viewerConn, err := workspacesdk.New(friendClient).WatchSharedReconnectingPTY(ctx, workspacesdk.WatchSharedReconnectingPTYOpts{
+		Token:  res.Token,
+		Width:  80,
+		Height: 80,
+	})
+	require.NoError(t, err)
+	defer viewerConn.Close()
+	viewerReader := testutil.NewTerminalReader(t, viewerConn)
+
+	data, err := json.Marshal(workspacesdk.ReconnectingPTYRequest{
+		Data: "echo shared-output\r",
+	})
+	require.NoError(t, err)
+	_, err = ownerConn.Write(data)
+	require.NoError(t, err)
+	require.NoError(t, viewerReader.ReadUntil(ctx, func(line string) bool {
+		return strings.Contains(line, "shared-output") && !strings.Contains(line, "echo")
+	}), "find echo output")
+
+	shares, err := ownerClient.WorkspaceAgentPTYShares(ctx, agentID)
+	require.NoError(t, err)
+	require.Len(t, shares, 1)
+	require.Len(t, shares[0].Viewers, 1)
+	viewer := shares[0].Viewers[0]
+	require.Equal(t, friend.ID, viewer.UserID)
+	require.Equal(t, friend.Username, viewer.Username)
+	require.Nil(t, viewer.DisconnectedAt)
+
+	require.True(t, connLogger.Contains(t, database.UpsertConnectionLogParams{
+		OrganizationID:   admin.OrganizationID,
+		WorkspaceOwnerID: owner.ID,
+		WorkspaceID:      r.Workspace.ID,
+		WorkspaceName:    r.Workspace.Name,
+		AgentName:        resources[0].Agents[0].Name,
+		Type:             database.ConnectionTypeReconnectingPty,
+		ConnectionStatus: database.ConnectionStatusConnected,
+		UserID:           uuid.NullUUID{UUID: friend.ID, Valid: true},
+		ConnectionID:     uuid.NullUUID{UUID: viewer.ID, Valid: true},
+	}))
+
+	_ = viewerConn.Close()
+	require.Eventually(t, func() bool {
+		return connLogger.Contains(t, database.UpsertConnectionLogParams{
+			Type:             database.ConnectionTypeReconnectingPty,
+			ConnectionStatus: database.ConnectionStatusDisconnected,
+			UserID:           uuid.NullUUID{UUID: friend.ID, Valid: true},
+			ConnectionID:     uuid.NullUUID{UUID: viewer.ID, Valid: true},
+		})
+	}, testutil.WaitShort, testutil.IntervalFast)
+}
+
+func TestWorkspaceAgentPTYShareWatchRevoked(t *testing.T) {
+	t.Parallel()
+	if runtime.GOOS == "windows" {
+		t.Skip("ConPTY appears to be inconsistent on Windows.")
+	}
+
+	client, db := coderdtest.NewWithDatabase(t, nil)
+	admin := coderdtest.CreateFirstUser(t, client)
+	ownerClient, owner := coderdtest.CreateAnotherUser(t, client, admin.OrganizationID)
+	friendClient, friend := coderdtest.CreateAnotherUser(t, client, admin.OrganizationID)
+
+	r := dbfake.WorkspaceBuild(t, db, database.WorkspaceTable{
+		OrganizationID: admin.OrganizationID,
+		OwnerID:        owner.ID,
+		UserACL: database.WorkspaceACL{
+			friend.ID.String(): database.WorkspaceACLEntry{
+				Permissions: []policy.Action{policy.ActionRead},
+			},
+		},
+	}).WithAgent().Do()
+	_ = agenttest.New(t, client.URL, r.AgentToken)
+	resources := coderdtest.AwaitWorkspaceAgents(t, ownerClient, r.Workspace.ID)
+	agentID := resources[0].Agents[0].ID
+
+	ctx := testutil.Context(t, testutil.WaitLong)
+
+	reconnectID := uuid.New()
+	ownerConn, err := workspacesdk.New(ownerClient).AgentReconnectingPTY(ctx, workspacesdk.WorkspaceAgentReconnectingPTYOpts{
+		AgentID:   agentID,
+		Reconnect: reconnectID,
+		Width:     80,
+		Height:    80,
+		Command:   "bash --norc",
+	})
+	require.NoError(t, err)
+	defer ownerConn.Close()
+	require.NoError(t, testutil.NewTerminalReader(t, ownerConn).ReadUntil(ctx, func(line string) bool {
+		return strings.Contains(line, "$ ") || strings.Contains(line, "# ")
+	}), "find prompt")
+
+	res, err := ownerClient.CreateWorkspaceAgentPTYShare(ctx, agentID, codersdk.CreateWorkspaceAgentPTYShareRequest{
+		ReconnectID: reconnectID,
+		UserIDs:     []uuid.UUID{friend.ID},
+	})
+	require.NoError(t, err)
+
+	viewerConn, err := workspacesdk.New(friendClient).WatchSharedReconnectingPTY(ctx, workspacesdk.WatchSharedReconnectingPTYOpts{
+		Token:  res.Token,
+		Width:  80,
+		Height: 80,
+	})
+	require.NoError(t, err)
+	defer viewerConn.Close()
+
+	// Wait for the viewer to be recorded so the session is established
+	// before the share is revoked.
+	require.Eventually(t, func() bool {
+		shares, err := ownerClient.WorkspaceAgentPTYShares(ctx, agentID)
+		return err == nil && len(shares) == 1 && len(shares[0].Viewers) == 1
+	}, testutil.WaitShort, testutil.IntervalFast)
+
+	closed := make(chan struct{})
+	go func() {
+		defer close(closed)
+		_, _ = io.Copy(io.Discard, viewerConn)
+	}()
+
+	err = ownerClient.RevokeWorkspaceAgentPTYShare(ctx, agentID, res.Share.ID)
+	require.NoError(t, err)
+
+	// Revoking the share disconnects viewers that are already watching.
+	testutil.TryReceive(ctx, t, closed)
+}
@@ -376,6 +376,7 @@ func (api *API) postWorkspaceBuildsInternal(
 	createBuild codersdk.CreateWorkspaceBuildRequest,
 	authorize func(action policy.Action, object rbac.Objecter) bool,
 	workspaceBuildBaggage audit.WorkspaceBuildBaggage,
+	builderOpts ...func(wsbuilder.Builder) wsbuilder.Builder,
 ) (
 	codersdk.WorkspaceBuild,
 	error,
@@ -417,6 +418,9 @@ func (api *API) postWorkspaceBuildsInternal(
 	if (transition == database.WorkspaceTransitionStart || transition == database.WorkspaceTransitionStop) && createBuild.Reason != "" {
 		builder = builder.Reason(database.BuildReason(createBuild.Reason))
 	}
+	for _, opt := range builderOpts {
+		builder = opt(builder)
+	}
 
 	var (
 		previousWorkspaceBuild database.WorkspaceBuild
@@ -473,6 +477,23 @@ func (api *API) postWorkspaceBuildsInternal(
 
 		if createBuild.TemplateVersionID != uuid.Nil {
 			builder = builder.VersionID(createBuild.TemplateVersionID)
+
+			// Clients start workspaces that follow the active version with
+			// the active version of the template. Let the builder resolve it
+			// instead, so that workspaces that are part of a rollout are
+			// updated to, or stay on, the rollout version.
+			if transition == database.WorkspaceTransitionStart {
+				startsActiveVersion, err := api.startsActiveVersion(ctx, tx, workspace, createBuild.TemplateVersionID)
+				if err != nil {
+					return httperror.NewResponseError(http.StatusInternalServerError, codersdk.Response{
+						Message: "Internal error fetching template.",
+						Detail:  err.Error(),
+					})
+				}
+				if startsActiveVersion {
+					builder = builder.ActiveVersion()
+				}
+			}
 		}
 
 		if createBuild.Orphan {
@@ -703,6 +724,21 @@ func validateCreateWorkspaceBuildOnSuccess(createBuild codersdk.CreateWorkspaceB
 	return nil
 }
 
+// startsActiveVersion returns whether starting the workspace with the given
+// version starts it with the active version of its template, and the workspace
+// follows the active version.
+func (api *API) startsActiveVersion(ctx context.Context, db database.Store, workspace database.Workspace, versionID uuid.UUID) (bool, error) {
+	template, err := db.GetTemplateByID(ctx, workspace.TemplateID)
+	if err != nil {
+		return false, xerrors.Errorf("get template: %w", err)
+	}
+	if template.ActiveVersionID != versionID {
+		return false, nil
+	}
+	templateAccessControl := (*(api.AccessControlStore.Load())).GetTemplateAccessControl(template)
+	return templateAccessControl.RequireActiveVersion || workspace.AutomaticUpdates == database.AutomaticUpdatesAlways, nil
+}
+
 func (api *API) notifyWorkspaceUpdated(
 	ctx context.Context,
 	initiatorID uuid.UUID,
@@ -0,0 +1,464 @@
+package coderd
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"net/http"
+
+	"github.com/google/uuid"
+	"golang.org/x/xerrors"
+
+	"github.com/coder/coder/v2/coderd/audit"
+	"github.com/coder/coder/v2/coderd/database"
+	"github.com/coder/coder/v2/coderd/database/dbtime"
+	"github.com/coder/coder/v2/coderd/httpapi"
+	"github.com/coder/coder/v2/coderd/httpmw"
+	"github.com/coder/coder/v2/coderd/rbac/policy"
+	"github.com/coder/coder/v2/coderd/searchquery"
+	"github.com/coder/coder/v2/codersdk"
+)
+
+// workspaceBulkOperationsLimit is the number of bulk operations returned by
+// the list endpoint.
+const workspaceBulkOperationsLimit = 50
+
+// @Summary Create workspace bulk operation
+// @ID create-workspace-bulk-operation
+// @Security CoderSessionToken
+// @Accept json
+// @Produce json
+// @Tags Workspaces
+// @Param request body codersdk.CreateWorkspaceBulkOperationRequest true "Bulk operation request"
+// @Success 201 {object} codersdk.WorkspaceBulkOperation
+// @Router /api/v2/workspacebulkoperations [post]
+func (api *API) postWorkspaceBulkOperation(rw http.ResponseWriter, r *http.Request) {
+	var (
+		ctx               = r.Context()
+		apiKey            = httpmw.APIKey(r)
+		auditor           = api.Auditor.Load()
+		aReq, commitAudit = audit.InitRequest[database.WorkspaceBulkOperation](rw, &audit.RequestParams{
+			Audit:   *auditor,
+			Log:     api.Logger,
+			Request: r,
+			Action:  database.AuditActionCreate,
+		})
+	)
+	defer commitAudit()
+
+	var req codersdk.CreateWorkspaceBulkOperationRequest
+	if !httpapi.Read(ctx, rw, r, &req) {
+		return
+	}
+	if req.Concurrency == 0 {
+		req.Concurrency = codersdk.DefaultWorkspaceBulkOperationConcurrency
+	}
+	validations := validateWorkspaceBulkOperation(req)
+	if len(validations) > 0 {
+		httpapi.Write(ctx, rw, http.StatusBadRequest, codersdk.Response{
+			Message:     "Invalid workspace bulk operation.",
+			Validations: validations,
+		})
+		return
+	}
+
+	filter, errs := searchquery.Workspaces(ctx, api.Database, req.Query, codersdk.Pagination{}, api.AgentInactiveDisconnectTimeout, apiKey.UserID)
+	if len(errs) > 0 {
+		httpapi.Write(ctx, rw, http.StatusBadRequest, codersdk.Response{
+			Message:     "Invalid workspace search query.",
+			Validations: errs,
+		})
+		return
+	}
+	if filter.OwnerUsername == "me" {
+		filter.OwnerID = apiKey.UserID
+		filter.OwnerUsername = ""
+	}
+
+	// GetWorkspaces authorizes the query itself, so only the workspaces the
+	// user can read are part of the operation. Whether the user can apply the
+	// action is checked for each workspace below, and again when it is
+	// processed.
+	rows, err := api.Database.GetWorkspaces(ctx, filter)
+	if err != nil {
+		httpapi.Write(ctx, rw, http.StatusInternalServerError, codersdk.Response{
+			Message: "Internal error fetching workspaces.",
+			Detail:  err.Error(),
+		})
+		return
+	}
+	workspaces, err := database.ConvertWorkspaceRows(rows)
+	if err != nil {
+		httpapi.Write(ctx, rw, http.StatusInternalServerError, codersdk.Response{
+			Message: "Internal error converting workspaces.",
+			Detail:  err.Error(),
+		})
+		return
+	}
+	workspaceIDs := make([]uuid.UUID, 0, len(workspaces))
+	for _, workspace := range workspaces {
+		// Prebuilt workspaces are managed by the reconciliation loop.
+		if workspace.IsPrebuild() {
+			continue
+		}
+		if !api.Authorize(r, workspaceBulkOperationRBACAction(req.Action, workspace), workspace) {
+			httpapi.Write(ctx, rw, http.StatusForbidden, codersdk.Response{
+				Message: fmt.Sprintf("You are not authorized to %s the workspace %q.", req.Action, workspace.OwnerUsername+"/"+workspace.Name),
+				Detail:  "Narrow the search query to the workspaces you can change.",
+			})
+			return
+		}
+		workspaceIDs = append(workspaceIDs, workspace.ID)
+	}
+	if len(workspaceIDs) == 0 {
+		httpapi.Write(ctx, rw, http.StatusBadRequest, codersdk.Response{
+			Message: "No workspaces match the search query.",
+		})
+		return
+	}
+
+	automaticUpdates := database.AutomaticUpdatesNever
+	if req.Action == codersdk.WorkspaceBulkOperationActionAutomaticUpdates {
+		automaticUpdates = database.AutomaticUpdates(req.AutomaticUpdates)
+	}
+
+	var op database.WorkspaceBulkOperation
+	err = api.Database.InTx(func(tx database.Store) error {
+		now := dbtime.Now()
+		var err error
+		op, err = tx.InsertWorkspaceBulkOperation(ctx, database.InsertWorkspaceBulkOperationParams{
+			ID:                uuid.New(),
+			InitiatorID:       apiKey.UserID,
+			Action:            database.WorkspaceBulkOperationAction(req.Action),
+			Query:             req.Query,
+			AutostartSchedule: req.AutostartSchedule,
+			TTLMillis:         req.TTLMillis,
+			AutomaticUpdates:  automaticUpdates,
+			Concurrency:       req.Concurrency,
+			// #nosec G115 - Safe conversion, workspace counts fit in an int32.
+			WorkspaceCount: int32(len(workspaceIDs)),
+			CreatedAt:      now,
+			UpdatedAt:      now,
+			// The operation can do no more than the API key that created it.
+			APIKeyScopes:    apiKey.Scopes,
+			APIKeyAllowList: apiKey.AllowList,
+		})
+		if err != nil {
+			return xerrors.Errorf("insert workspace bulk operation: %w", err)
+		}
+		err = tx.InsertWorkspaceBulkOperationWorkspaces(ctx, database.InsertWorkspaceBulkOperationWorkspacesParams{
+			OperationID:  op.ID,
+			WorkspaceIds: workspaceIDs,
+			UpdatedAt:    now,
+		})
+		if err != nil {
+			return xerrors.Errorf("insert workspace bulk operation workspaces: %w", err)
+		}
+		return nil
+	}, nil)
+	if httpapi.IsUnauthorizedError(err) {
+		httpapi.Forbidden(rw)
+		return
+	}
+	if err != nil {
+		httpapi.Write(ctx, rw, http.StatusInternalServerError, codersdk.Response{
+			Message: "Internal error creating workspace bulk operation.",
+			Detail:  err.Error(),
+		})
+		return
+	}
+	aReq.New = op
+	api.workspaceBulkRunner.wake()
+
+	api.writeWorkspaceBulkOperation(ctx, rw, http.StatusCreated, op)
+}
+
+// @Summary Get workspace bulk operations
+// @ID get-workspace-bulk-operations
+// @Security CoderSessionToken
+// @Produce json
+// @Tags Workspaces
+// @Success 200 {array} codersdk.WorkspaceBulkOperation
+// @Router /api/v2/workspacebulkoperations [get]
+func (api *API) workspaceBulkOperations(rw http.ResponseWriter, r *http.Request) {
+	var (
+		ctx    = r.Context()
+		apiKey = httpmw.APIKey(r)
+	)
+
+	ops, err := api.Database.GetWorkspaceBulkOperationsByInitiatorID(ctx, database.GetWorkspaceBulkOperationsByInitiatorIDParams{
+		InitiatorID: apiKey.UserID,
+		LimitOpt:    workspaceBulkOperationsLimit,
+	})
+	if err != nil {
+		httpapi.Write(ctx, rw, http.StatusInternalServerError, codersdk.Response{
+			Message: "Internal error fetching workspace bulk operations.",
+			Detail:  err.Error(),
+		})
+		return
+	}
+
+	res := make([]codersdk.WorkspaceBulkOperation, 0, len(ops))
+	for _, op := range ops {
+		converted, err := api.convertWorkspaceBulkOperation(ctx, op)
+		if err != nil {
+			httpapi.Write(ctx, rw, http.StatusInternalServerError, codersdk.Response{
+				Message: "Internal error converting workspace bulk operation.",
+				Detail:  err.Error(),
+			})
+			return
+		}
+		res = append(res, converted)
+	}
+
+	httpapi.Write(ctx, rw, http.StatusOK, res)
+}
+
+// @Summary Get workspace bulk operation
+// @ID get-workspace-bulk-operation
+// @Security CoderSessionToken
+// @Produce json
+// @Tags Workspaces
+// @Param operation path string true "Bulk operation ID" format(uuid)
+// @Success 200 {object} codersdk.WorkspaceBulkOperation
+// @Router /api/v2/workspacebulkoperations/{operation} [get]
+func (api *API) workspaceBulkOperation(rw http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	op, ok := api.workspaceBulkOperationParam(rw, r)
+	if !ok {
+		return
+	}
+
+	api.writeWorkspaceBulkOperation(ctx, rw, http.StatusOK, op)
+}
+
+// @Summary Get workspace bulk operation workspaces
+// @ID get-workspace-bulk-operation-workspaces
+// @Security CoderSessionToken
+// @Produce json
+// @Tags Workspaces
+// @Param operation path string true "Bulk operation ID" format(uuid)
+// @Success 200 {array} codersdk.WorkspaceBulkOperationWorkspace
+// @Router /api/v2/workspacebulkoperations/{operation}/workspaces [get]
+func (api *API) workspaceBulkOperationWorkspaces(rw http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	op, ok := api.workspaceBulkOperationParam(rw, r)
+	if !ok {
+		return
+	}
+
+	rows, err := api.Database.GetWorkspaceBulkOperationWorkspaces(ctx, op.ID)
+	if err != nil {
+		httpapi.Write(ctx, rw, http.StatusInternalServerError, codersdk.Response{
+			Message: "Internal error fetching workspace bulk operation workspaces.",
+			Detail:  err.Error(),
+		})
+		return
+	}
+
+	res := make([]codersdk.WorkspaceBulkOperationWorkspace, 0, len(rows))
+	for _, row := range rows {
+		res = append(res, convertWorkspaceBulkOperationWorkspace(row))
+	}
+
+	httpapi.Write(ctx, rw, http.StatusOK, res)
+}
+
+// @Summary Cancel workspace bulk operation
+// @ID cancel-workspace-bulk-operation
+// @Security CoderSessionToken
+// @Produce json
+// @Tags Workspaces
+// @Param operation path string true "Bulk operation ID" format(uuid)
+// @Success 200 {object} codersdk.WorkspaceBulkOperation
+// @Router /api/v2/workspacebulkoperations/{operation}/cancel [post]
+func (api *API) postWorkspaceBulkOperationCancel(rw http.ResponseWriter, r *http.Request) {
+	var (
+		ctx               = r.Context()
+		auditor           = api.Auditor.Load()
+		aReq, commitAudit = audit.InitRequest[database.WorkspaceBulkOperation](rw, &audit.RequestParams{
+			Audit:   *auditor,
+			Log:     api.Logger,
+			Request: r,
+			Action:  database.AuditActionWrite,
+		})
+	)
+	defer commitAudit()
+
+	op, ok := api.workspaceBulkOperationParam(rw, r)
+	if !ok {
+		return
+	}
+	aReq.Old = op
+
+	canceled, err := api.Database.UpdateWorkspaceBulkOperationCanceled(ctx, database.UpdateWorkspaceBulkOperationCanceledParams{
+		Error: "Operation was canceled.",
+		Now:   dbtime.Now(),
+		ID:    op.ID,
+	})
+	if xerrors.Is(err, sql.ErrNoRows) {
+		httpapi.Write(ctx, rw, http.StatusConflict, codersdk.Response{
+			Message: fmt.Sprintf("The bulk operation is %s and cannot be canceled.", op.Status),
+		})
+		return
+	}
+	if httpapi.IsUnauthorizedError(err) {
+		httpapi.Forbidden(rw)
+		return
+	}
+	if err != nil {
+		httpapi.Write(ctx, rw, http.StatusInternalServerError, codersdk.Response{
+			Message: "Internal error canceling workspace bulk operation.",
+			Detail:  err.Error(),
+		})
+		return
+	}
+	aReq.New = canceled
+
+	api.writeWorkspaceBulkOperation(ctx, rw, http.StatusOK, canceled)
+}
+
+// workspaceBulkOperationParam fetches the bulk operation in the URL.
+func (api *API) workspaceBulkOperationParam(rw http.ResponseWriter, r *http.Request) (database.WorkspaceBulkOperation, bool) {
+	ctx := r.Context()
+	id, ok := httpmw.ParseUUIDParam(rw, r, "operation")
+	if !ok {
+		return database.WorkspaceBulkOperation{}, false
+	}
+
+	op, err := api.Database.GetWorkspaceBulkOperationByID(ctx, id)
+	if httpapi.Is404Error(err) {
+		httpapi.ResourceNotFound(rw)
+		return database.WorkspaceBulkOperation{}, false
+	}
+	if err != nil {
+		httpapi.Write(ctx, rw, http.StatusInternalServerError, codersdk.Response{
+			Message: "Internal error fetching workspace bulk operation.",
+			Detail:  err.Error(),
+		})
+		return database.WorkspaceBulkOperation{}, false
+	}
+	return op, true
+}
+
+func validateWorkspaceBulkOperation(req codersdk.CreateWorkspaceBulkOperationRequest) []codersdk.ValidationError {
+	var validations []codersdk.ValidationError
+	if req.Concurrency < 1 || req.Concurrency > codersdk.MaxWorkspaceBulkOperationConcurrency {
+		validations = append(validations, codersdk.ValidationError{
+			Field:  "concurrency",
+			Detail: fmt.Sprintf("Must be between 1 and %d.", codersdk.MaxWorkspaceBulkOperationConcurrency),
+		})
+	}
+	switch req.Action {
+	case codersdk.WorkspaceBulkOperationActionStart, codersdk.WorkspaceBulkOperationActionStop,
+		codersdk.WorkspaceBulkOperationActionUpdate, codersdk.WorkspaceBulkOperationActionDelete:
+	case codersdk.WorkspaceBulkOperationActionAutostart:
+		if _, err := validWorkspaceSchedule(&req.AutostartSchedule); err != nil {
+			validations = append(validations, codersdk.ValidationError{
+				Field:  "autostart_schedule",
+				Detail: err.Error(),
+			})
+		}
+	case codersdk.WorkspaceBulkOperationActionTTL:
+		if _, err := validWorkspaceTTLMillis(&req.TTLMillis, 0); err != nil {
+			validations = append(validations, codersdk.ValidationError{
+				Field:  "ttl_ms",
+				Detail: err.Error(),
+			})
+		}
+	case codersdk.WorkspaceBulkOperationActionAutomaticUpdates:
+		if !database.AutomaticUpdates(req.AutomaticUpdates).Valid() {
+			validations = append(validations, codersdk.ValidationError{
+				Field:  "automatic_updates",
+				Detail: "Must be always or never.",
+			})
+		}
+	default:
+		validations = append(validations, codersdk.ValidationError{
+			Field:  "action",
+			Detail: fmt.Sprintf("Must be one of %v.", codersdk.WorkspaceBulkOperationActions),
+		})
+	}
+	return validations
+}
+
+func (api *API) writeWorkspaceBulkOperation(ctx context.Context, rw http.ResponseWriter, status int, op database.WorkspaceBulkOperation) {
+	res, err := api.convertWorkspaceBulkOperation(ctx, op)
+	if err != nil {
+		httpapi.Write(ctx, rw, http.StatusInternalServerError, codersdk.Response{
+			Message: "Internal error converting workspace bulk operation.",
+			Detail:  err.Error(),
+		})
+		return
+	}
+	httpapi.Write(ctx, rw, status, res)
+}
+
+func (api *API) convertWorkspaceBulkOperation(ctx context.Context, op database.WorkspaceBulkOperation) (codersdk.WorkspaceBulkOperation, error) {
+	progress, err := api.Database.GetWorkspaceBulkOperationProgress(ctx, op.ID)
+	if err != nil {
+		return codersdk.WorkspaceBulkOperation{}, xerrors.Errorf("get progress: %w", err)
+	}
+
+	res := codersdk.WorkspaceBulkOperation{
+		ID:                op.ID,
+		InitiatorID:       op.InitiatorID,
+		Action:            codersdk.WorkspaceBulkOperationAction(op.Action),
+		Query:             op.Query,
+		AutostartSchedule: op.AutostartSchedule,
+		TTLMillis:         op.TTLMillis,
+		Concurrency:       op.Concurrency,
+		Status:            codersdk.WorkspaceBulkOperationStatus(op.Status),
+		Progress: codersdk.WorkspaceBulkOperationProgress{
+			Total:     int64(op.WorkspaceCount),
+			Pending:   progress.Pending,
+			Running:   progress.Running,
+			Succeeded: progress.Succeeded,
+			Failed:    progress.Failed,
+			Skipped:   progress.Skipped,
+		},
+		CreatedAt: op.CreatedAt,
+		UpdatedAt: op.UpdatedAt,
+	}
+	if op.Action == database.WorkspaceBulkOperationActionAutomaticUpdates {
+		res.AutomaticUpdates = codersdk.AutomaticUpdates(op.AutomaticUpdates)
+	}
+	if op.CompletedAt.Valid {
+		res.CompletedAt = &op.CompletedAt.Time
+	}
+	return res, nil
+}
+
+func convertWorkspaceBulkOperationWorkspace(row database.GetWorkspaceBulkOperationWorkspacesRow) codersdk.WorkspaceBulkOperationWorkspace {
+	res := codersdk.WorkspaceBulkOperationWorkspace{
+		WorkspaceID:        row.WorkspaceID,
+		WorkspaceName:      row.WorkspaceName,
+		WorkspaceOwnerName: row.WorkspaceOwnerUsername,
+		Status:             codersdk.WorkspaceBulkOperationWorkspaceStatus(row.Status),
+		Error:              row.Error,
+		UpdatedAt:          row.UpdatedAt,
+	}
+	if row.BuildID.Valid {
+		res.BuildID = &row.BuildID.UUID
+	}
+	return res
+}
+
+// workspaceBulkOperationRBACAction returns the action a user needs on a
+// workspace to apply a bulk operation action to it, matching the checks of
+// wsbuilder for builds.
+func workspaceBulkOperationRBACAction(action codersdk.WorkspaceBulkOperationAction, workspace database.Workspace) policy.Action {
+	switch action {
+	case codersdk.WorkspaceBulkOperationActionStart:
+		if workspace.DormantAt.Valid {
+			return policy.ActionUpdate
+		}
+		return policy.ActionWorkspaceStart
+	case codersdk.WorkspaceBulkOperationActionStop:
+		return policy.ActionWorkspaceStop
+	case codersdk.WorkspaceBulkOperationActionDelete:
+		return policy.ActionDelete
+	default:
+		return policy.ActionUpdate
+	}
+}
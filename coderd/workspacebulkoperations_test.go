@@ -0,0 +1,250 @@
+package coderd_test
+
+import (
+	"net/http"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/require"
+
+	"github.com/coder/coder/v2/coderd/audit"
+	"github.com/coder/coder/v2/coderd/coderdtest"
+	"github.com/coder/coder/v2/coderd/database"
+	"github.com/coder/coder/v2/codersdk"
+	"github.com/coder/coder/v2/testutil"
+)
+
+func TestWorkspaceBulkOperations(t *testing.T) {
+	t.Parallel()
+
+	setup := func(t *testing.T, auditor audit.Auditor) (*codersdk.Client, codersdk.CreateFirstUserResponse, codersdk.Template, []codersdk.Workspace) {
+		t.Helper()
+
+		client := coderdtest.New(t, &coderdtest.Options{IncludeProvisionerDaemon: true, Auditor: auditor})
+		owner := coderdtest.CreateFirstUser(t, client)
+		version := coderdtest.CreateTemplateVersion(t, client, owner.OrganizationID, nil)
+		coderdtest.AwaitTemplateVersionJobCompleted(t, client, version.ID)
+		template := coderdtest.CreateTemplate(t, client, owner.OrganizationID, version.ID)
+		workspaces := make([]codersdk.Workspace, 0, 3)
+		for range 3 {
+			workspace := coderdtest.CreateWorkspace(t, client, template.ID)
+			coderdtest.AwaitWorkspaceBuildJobCompleted(t, client, workspace.LatestBuild.ID)
+			workspaces = append(workspaces, workspace)
+		}
+		return client, owner, template, workspaces
+	}
+
+	awaitCompleted := func(t *testing.T, client *codersdk.Client, id uuid.UUID) codersdk.WorkspaceBulkOperation {
+		t.Helper()
+
+		ctx := testutil.Context(t, testutil.WaitLong)
+		var op codersdk.WorkspaceBulkOperation
+		require.Eventually(t, func() bool {
+			var err error
+			op, err = client.WorkspaceBulkOperation(ctx, id)
+			require.NoError(t, err)
+			return op.Status == codersdk.WorkspaceBulkOperationStatusCompleted
+		}, testutil.WaitLong, testutil.IntervalFast)
+		return op
+	}
+
+	t.Run("Stop", func(t *testing.T) {
+		t.Parallel()
+
+		auditor := audit.NewMock()
+		client, owner, template, workspaces := setup(t, auditor)
+		ctx := testutil.Context(t, testutil.WaitLong)
+
+		op, err := client.CreateWorkspaceBulkOperation(ctx, codersdk.CreateWorkspaceBulkOperationRequest{
+			Query:       "template:" + template.Name,
+			Action:      codersdk.WorkspaceBulkOperationActionStop,
+			Concurrency: 2,
+		})
+		require.NoError(t, err)
+		require.Equal(t, owner.UserID, op.InitiatorID)
+		require.EqualValues(t, 2, op.Concurrency)
+		require.EqualValues(t, 3, op.Progress.Total)
+		require.True(t, auditor.Contains(t, database.AuditLog{
+			ResourceType: database.ResourceTypeWorkspaceBulkOperation,
+			ResourceID:   op.ID,
+			Action:       database.AuditActionCreate,
+		}))
+
+		op = awaitCompleted(t, client, op.ID)
+		require.EqualValues(t, 3, op.Progress.Succeeded)
+		require.NotNil(t, op.CompletedAt)
+
+		results, err := client.WorkspaceBulkOperationWorkspaces(ctx, op.ID)
+		require.NoError(t, err)
+		require.Len(t, results, 3)
+		for _, result := range results {
+			require.Equal(t, codersdk.WorkspaceBulkOperationWorkspaceStatusSucceeded, result.Status)
+			require.NotNil(t, result.BuildID)
+			build, err := client.WorkspaceBuild(ctx, *result.BuildID)
+			require.NoError(t, err)
+			require.Equal(t, codersdk.WorkspaceTransitionStop, build.Transition)
+			require.Equal(t, owner.UserID, build.InitiatorID)
+			// Bulk builds are queued behind builds that users are waiting for.
+			require.Equal(t, codersdk.ProvisionerJobPriorityAutomated, build.Job.Priority)
+		}
+
+		// The workspaces are already stopped, so a second stop skips them.
+		op, err = client.CreateWorkspaceBulkOperation(ctx, codersdk.CreateWorkspaceBulkOperationRequest{
+			Query:  "template:" + template.Name,
+			Action: codersdk.WorkspaceBulkOperationActionStop,
+		})
+		require.NoError(t, err)
+		op = awaitCompleted(t, client, op.ID)
+		require.EqualValues(t, 3, op.Progress.Skipped)
+
+		ops, err := client.WorkspaceBulkOperations(ctx)
+		require.NoError(t, err)
+		require.Len(t, ops, 2)
+		require.Equal(t, op.ID, ops[0].ID)
+
+		for _, workspace := range workspaces {
+			workspace, err := client.Workspace(ctx, workspace.ID)
+			require.NoError(t, err)
+			require.Equal(t, codersdk.WorkspaceTransitionStop, workspace.LatestBuild.Transition)
+		}
+	})
+
+	t.Run("Schedule", func(t *testing.T) {
+		t.Parallel()
+
+		client, _, template, workspaces := setup(t, nil)
+		ctx := testutil.Context(t, testutil.WaitLong)
+
+		op, err := client.CreateWorkspaceBulkOperation(ctx, codersdk.CreateWorkspaceBulkOperationRequest{
+			Query:             "template:" + template.Name,
+			Action:            codersdk.WorkspaceBulkOperationActionAutostart,
+			AutostartSchedule: "CRON_TZ=UTC 30 9 * * 1-5",
+		})
+		require.NoError(t, err)
+		op = awaitCompleted(t, client, op.ID)
+		require.EqualValues(t, 3, op.Progress.Succeeded)
+
+		for _, workspace := range workspaces {
+			workspace, err := client.Workspace(ctx, workspace.ID)
+			require.NoError(t, err)
+			require.NotNil(t, workspace.AutostartSchedule)
+			require.Equal(t, "CRON_TZ=UTC 30 9 * * 1-5", *workspace.AutostartSchedule)
+		}
+	})
+
+	t.Run("Cancel", func(t *testing.T) {
+		t.Parallel()
+
+		client, _, template, _ := setup(t, nil)
+		ctx := testutil.Context(t, testutil.WaitLong)
+
+		op, err := client.CreateWorkspaceBulkOperation(ctx, codersdk.CreateWorkspaceBulkOperationRequest{
+			Query:       "template:" + template.Name,
+			Action:      codersdk.WorkspaceBulkOperationActionStop,
+			Concurrency: 1,
+		})
+		require.NoError(t, err)
+
+		op, err = client.CancelWorkspaceBulkOperation(ctx, op.ID)
+		if err != nil {
+			// The operation completed before it could be canceled.
+			var apiErr *codersdk.Error
+			require.ErrorAs(t, err, &apiErr)
+			require.Equal(t, http.StatusConflict, apiErr.StatusCode())
+			return
+		}
+		require.Equal(t, codersdk.WorkspaceBulkOperationStatusCanceled, op.Status)
+		require.NotNil(t, op.CompletedAt)
+		require.Zero(t, op.Progress.Pending)
+
+		_, err = client.CancelWorkspaceBulkOperation(ctx, op.ID)
+		var apiErr *codersdk.Error
+		require.ErrorAs(t, err, &apiErr)
+		require.Equal(t, http.StatusConflict, apiErr.StatusCode())
+	})
+
+	t.Run("Invalid", func(t *testing.T) {
+		t.Parallel()
+
+		client := coderdtest.New(t, nil)
+		_ = coderdtest.CreateFirstUser(t, client)
+		ctx := testutil.Context(t, testutil.WaitLong)
+
+		for _, req := range []codersdk.CreateWorkspaceBulkOperationRequest{
+			{Query: "owner:me", Action: "restart"},
+			{Query: "owner:me", Action: codersdk.WorkspaceBulkOperationActionStop, Concurrency: codersdk.MaxWorkspaceBulkOperationConcurrency + 1},
+			{Query: "owner:me", Action: codersdk.WorkspaceBulkOperationActionAutostart, AutostartSchedule: "not a schedule"},
+			{Query: "owner:me", Action: codersdk.WorkspaceBulkOperationActionAutomaticUpdates},
+			{Query: "owner:me", Action: codersdk.WorkspaceBulkOperationActionStop},
+		} {
+			_, err := client.CreateWorkspaceBulkOperation(ctx, req)
+			var apiErr *codersdk.Error
+			require.ErrorAs(t, err, &apiErr)
+			require.Equal(t, http.StatusBadRequest, apiErr.StatusCode())
+		}
+	})
+
+	t.Run("OtherUser", func(t *testing.T) {
+		t.Parallel()
+
+		client, owner, template, _ := setup(t, nil)
+		member, _ := coderdtest.CreateAnotherUser(t, client, owner.OrganizationID)
+		ctx := testutil.Context(t, testutil.WaitLong)
+
+		op, err := client.CreateWorkspaceBulkOperation(ctx, codersdk.CreateWorkspaceBulkOperationRequest{
+			Query:  "template:" + template.Name,
+			Action: codersdk.WorkspaceBulkOperationActionStop,
+		})
+		require.NoError(t, err)
+
+		// Members cannot see the operations of other users.
+		_, err = member.WorkspaceBulkOperation(ctx, op.ID)
+		var apiErr *codersdk.Error
+		require.ErrorAs(t, err, &apiErr)
+		require.Equal(t, http.StatusNotFound, apiErr.StatusCode())
+
+		// Nor can they act on workspaces they cannot see.
+		_, err = member.CreateWorkspaceBulkOperation(ctx, codersdk.CreateWorkspaceBulkOperationRequest{
+			Query:  "template:" + template.Name,
+			Action: codersdk.WorkspaceBulkOperationActionStop,
+		})
+		require.ErrorAs(t, err, &apiErr)
+		require.Equal(t, http.StatusBadRequest, apiErr.StatusCode())
+	})
+
+	t.Run("ScopedToken", func(t *testing.T) {
+		t.Parallel()
+
+		client, _, template, workspaces := setup(t, nil)
+		ctx := testutil.Context(t, testutil.WaitLong)
+
+		// The token can read workspaces and create operations, but not
+		// delete workspaces.
+		token, err := client.CreateToken(ctx, codersdk.Me, codersdk.CreateTokenRequest{
+			Scopes: []codersdk.APIKeyScope{
+				codersdk.APIKeyScopeUserRead,
+				codersdk.APIKeyScopeUserUpdatePersonal,
+				codersdk.APIKeyScopeWorkspaceRead,
+			},
+		})
+		require.NoError(t, err)
+		scoped := codersdk.New(client.URL, codersdk.WithSessionToken(token.Key))
+
+		_, err = scoped.CreateWorkspaceBulkOperation(ctx, codersdk.CreateWorkspaceBulkOperationRequest{
+			Query:  "template:" + template.Name,
+			Action: codersdk.WorkspaceBulkOperationActionDelete,
+		})
+		var apiErr *codersdk.Error
+		require.ErrorAs(t, err, &apiErr)
+		require.Equal(t, http.StatusForbidden, apiErr.StatusCode())
+
+		ops, err := client.WorkspaceBulkOperations(ctx)
+		require.NoError(t, err)
+		require.Empty(t, ops)
+		for _, workspace := range workspaces {
+			workspace, err := client.Workspace(ctx, workspace.ID)
+			require.NoError(t, err)
+			require.Equal(t, codersdk.WorkspaceTransitionStart, workspace.LatestBuild.Transition)
+		}
+	})
+}
@@ -0,0 +1,506 @@
+package coderd
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"net/http"
+	"sync"
+	"time"
+
+	"github.com/google/uuid"
+	"golang.org/x/xerrors"
+
+	"cdr.dev/slog/v3"
+	"github.com/coder/coder/v2/coderd/audit"
+	"github.com/coder/coder/v2/coderd/database"
+	"github.com/coder/coder/v2/coderd/database/dbauthz"
+	"github.com/coder/coder/v2/coderd/database/dbtime"
+	"github.com/coder/coder/v2/coderd/httpapi/httperror"
+	"github.com/coder/coder/v2/coderd/httpmw"
+	"github.com/coder/coder/v2/coderd/pproflabel"
+	"github.com/coder/coder/v2/coderd/rbac"
+	"github.com/coder/coder/v2/coderd/rbac/policy"
+	"github.com/coder/coder/v2/coderd/wsbuilder"
+	"github.com/coder/coder/v2/codersdk"
+)
+
+const (
+	// workspaceBulkPollInterval is how often running operations are checked
+	// for workspaces to process, in case a wake up was missed or came from
+	// another replica.
+	workspaceBulkPollInterval = 5 * time.Second
+	// workspaceBulkJobPollInterval is how often the build of a workspace is
+	// checked for completion.
+	workspaceBulkJobPollInterval = 2 * time.Second
+	// workspaceBulkHeartbeatInterval is how often a workspace that is being
+	// processed is marked as updated, so that it is not considered stale.
+	workspaceBulkHeartbeatInterval = 30 * time.Second
+	// workspaceBulkStaleAfter is how long a workspace can be running without
+	// an update before another replica processes it again.
+	workspaceBulkStaleAfter = 2 * time.Minute
+)
+
+// workspaceBulkRunner processes the workspaces of running bulk operations,
+// no more than the concurrency of each operation at a time. Each workspace
+// is processed with the permissions of the user that created the operation,
+// limited to the scope of the API key they created it with.
+type workspaceBulkRunner struct {
+	api    *API
+	logger slog.Logger
+
+	wakeCh chan struct{}
+
+	startOnce sync.Once
+	cancel    context.CancelFunc
+	// wg tracks the run loop and the goroutines processing workspaces.
+	wg sync.WaitGroup
+}
+
+func newWorkspaceBulkRunner(api *API) *workspaceBulkRunner {
+	return &workspaceBulkRunner{
+		api:    api,
+		logger: api.Logger.Named("workspace_bulk_operations"),
+		wakeCh: make(chan struct{}, 1),
+	}
+}
+
+// Start launches the run loop. Only the first call has any effect.
+func (b *workspaceBulkRunner) Start(ctx context.Context) {
+	b.startOnce.Do(func() {
+		ctx, b.cancel = context.WithCancel(ctx)
+		b.wg.Add(1)
+		pproflabel.Go(ctx, pproflabel.Service(pproflabel.ServiceWorkspaceBulkOperations), func(ctx context.Context) {
+			defer b.wg.Done()
+			b.run(ctx)
+		})
+	})
+}
+
+// Close stops the runner and waits for the workspaces being processed to
+// return. Workspaces interrupted by Close are processed again once they are
+// stale.
+func (b *workspaceBulkRunner) Close() {
+	if b.cancel != nil {
+		b.cancel()
+	}
+	b.wg.Wait()
+}
+
+func (b *workspaceBulkRunner) wake() {
+	select {
+	case b.wakeCh <- struct{}{}:
+	default:
+	}
+}
+
+func (b *workspaceBulkRunner) run(ctx context.Context) {
+	ticker := b.api.Clock.NewTicker(workspaceBulkPollInterval)
+	defer ticker.Stop()
+
+	for {
+		if ctx.Err() != nil {
+			return
+		}
+
+		rows, err := b.acquire(ctx)
+		if err != nil && ctx.Err() == nil {
+			b.logger.Error(ctx, "failed to acquire bulk operation workspaces", slog.Error(err))
+		}
+		for _, row := range rows {
+			b.wg.Add(1)
+			go func() {
+				defer b.wg.Done()
+				b.process(ctx, row)
+			}()
+		}
+
+		select {
+		case <-b.wakeCh:
+		case <-ticker.C:
+		case <-ctx.Done():
+			return
+		}
+	}
+}
+
+// acquire marks the next workspaces of every running operation as running.
+func (b *workspaceBulkRunner) acquire(ctx context.Context) ([]database.WorkspaceBulkOperationWorkspace, error) {
+	//nolint:gocritic // The runner processes the operations of every user.
+	ctx = dbauthz.AsSystemRestricted(ctx)
+
+	var rows []database.WorkspaceBulkOperationWorkspace
+	err := b.api.Database.InTx(func(tx database.Store) error {
+		// The concurrency of an operation is only respected if a single
+		// replica acquires workspaces at a time.
+		ok, err := tx.TryAcquireLock(ctx, database.LockIDWorkspaceBulkOperations)
+		if err != nil {
+			return xerrors.Errorf("acquire lock: %w", err)
+		}
+		if !ok {
+			return nil
+		}
+
+		now := dbtime.Time(b.api.Clock.Now())
+		rows, err = tx.AcquireWorkspaceBulkOperationWorkspaces(ctx, database.AcquireWorkspaceBulkOperationWorkspacesParams{
+			StaleBefore: now.Add(-workspaceBulkStaleAfter),
+			Now:         now,
+		})
+		if err != nil {
+			return xerrors.Errorf("acquire workspaces: %w", err)
+		}
+		return nil
+	}, nil)
+	return rows, err
+}
+
+// workspaceBulkResult is the outcome of a bulk operation for a workspace.
+type workspaceBulkResult struct {
+	status  database.WorkspaceBulkOperationWorkspaceStatus
+	buildID uuid.NullUUID
+	err     string
+}
+
+func workspaceBulkSkipped(reason string) workspaceBulkResult {
+	return workspaceBulkResult{status: database.WorkspaceBulkOperationWorkspaceStatusSkipped, err: reason}
+}
+
+func workspaceBulkFailed(err error) workspaceBulkResult {
+	return workspaceBulkResult{status: database.WorkspaceBulkOperationWorkspaceStatusFailed, err: workspaceBulkErrorMessage(err)}
+}
+
+func (b *workspaceBulkRunner) process(ctx context.Context, row database.WorkspaceBulkOperationWorkspace) {
+	//nolint:gocritic // The runner records the outcome of every workspace.
+	sysCtx := dbauthz.AsSystemRestricted(ctx)
+	logger := b.logger.With(slog.F("operation_id", row.OperationID), slog.F("workspace_id", row.WorkspaceID))
+
+	result := b.apply(ctx, row)
+	if ctx.Err() != nil {
+		// The runner is closing. The workspace is processed again once it is
+		// stale, which waits on the build it may have created.
+		return
+	}
+
+	err := b.api.Database.UpdateWorkspaceBulkOperationWorkspace(sysCtx, database.UpdateWorkspaceBulkOperationWorkspaceParams{
+		Status:      result.status,
+		BuildID:     result.buildID,
+		Error:       result.err,
+		UpdatedAt:   dbtime.Time(b.api.Clock.Now()),
+		OperationID: row.OperationID,
+		WorkspaceID: row.WorkspaceID,
+	})
+	if err != nil {
+		logger.Error(ctx, "failed to update bulk operation workspace", slog.Error(err))
+		return
+	}
+	err = b.api.Database.UpdateWorkspaceBulkOperationCompleted(sysCtx, database.UpdateWorkspaceBulkOperationCompletedParams{
+		Now: dbtime.Time(b.api.Clock.Now()),
+		ID:  row.OperationID,
+	})
+	if err != nil {
+		logger.Error(ctx, "failed to complete bulk operation", slog.Error(err))
+	}
+	// A slot of the operation is free for its next workspace.
+	b.wake()
+}
+
+func (b *workspaceBulkRunner) apply(ctx context.Context, row database.WorkspaceBulkOperationWorkspace) workspaceBulkResult {
+	//nolint:gocritic // The operation is read on behalf of its initiator.
+	op, err := b.api.Database.GetWorkspaceBulkOperationByID(dbauthz.AsSystemRestricted(ctx), row.OperationID)
+	if err != nil {
+		return workspaceBulkFailed(xerrors.Errorf("get bulk operation: %w", err))
+	}
+
+	// The operation is limited to the scope of the API key that created it,
+	// so a narrowly scoped token cannot act with all of its user's permissions.
+	actor, status, err := httpmw.UserRBACSubject(ctx, b.api.Database, op.InitiatorID, database.APIKeyScopeSet{
+		Scopes:    op.APIKeyScopes,
+		AllowList: op.APIKeyAllowList,
+	})
+	if err != nil {
+		return workspaceBulkFailed(xerrors.Errorf("load initiator authorization: %w", err))
+	}
+	if status == database.UserStatusSuspended {
+		return workspaceBulkFailed(xerrors.New("The initiator of the operation is suspended."))
+	}
+	ctx = dbauthz.As(ctx, actor)
+
+	workspace, err := b.api.Database.GetWorkspaceByID(ctx, row.WorkspaceID)
+	if xerrors.Is(err, sql.ErrNoRows) || (err == nil && workspace.Deleted) {
+		return workspaceBulkSkipped("Workspace was deleted.")
+	}
+	if err != nil {
+		return workspaceBulkFailed(xerrors.Errorf("get workspace: %w", err))
+	}
+
+	// The workspace was being processed by a replica that stopped after it
+	// created a build, so wait on that build instead of creating another.
+	if row.BuildID.Valid {
+		return b.waitForBuild(ctx, row, row.BuildID.UUID)
+	}
+
+	switch op.Action {
+	case database.WorkspaceBulkOperationActionStart, database.WorkspaceBulkOperationActionStop,
+		database.WorkspaceBulkOperationActionUpdate, database.WorkspaceBulkOperationActionDelete:
+		return b.build(ctx, actor, op, row, workspace)
+	case database.WorkspaceBulkOperationActionAutostart, database.WorkspaceBulkOperationActionTtl,
+		database.WorkspaceBulkOperationActionAutomaticUpdates:
+		return b.schedule(ctx, op, workspace)
+	default:
+		return workspaceBulkFailed(xerrors.Errorf("unknown action %q", op.Action))
+	}
+}
+
+// build creates a build for the action of the operation unless the workspace
+// is already in the requested state, and waits for the build to finish.
+func (b *workspaceBulkRunner) build(ctx context.Context, actor rbac.Subject, op database.WorkspaceBulkOperation, row database.WorkspaceBulkOperationWorkspace, workspace database.Workspace) workspaceBulkResult {
+	latestBuild, latestJob, err := b.latestBuild(ctx, workspace.ID)
+	if err != nil {
+		return workspaceBulkFailed(err)
+	}
+	// A build that is already in progress would fail the new build, so wait
+	// for it first.
+	if !workspaceBulkJobDone(latestJob) {
+		_, err = b.waitForJob(ctx, row, uuid.NullUUID{}, latestJob.ID)
+		if err != nil {
+			return workspaceBulkFailed(err)
+		}
+		latestBuild, latestJob, err = b.latestBuild(ctx, workspace.ID)
+		if err != nil {
+			return workspaceBulkFailed(err)
+		}
+	}
+	succeeded := latestJob.JobStatus == database.ProvisionerJobStatusSucceeded
+
+	req := codersdk.CreateWorkspaceBuildRequest{}
+	switch op.Action {
+	case database.WorkspaceBulkOperationActionStart:
+		if latestBuild.Transition == database.WorkspaceTransitionStart && succeeded {
+			return workspaceBulkSkipped("Workspace is already started.")
+		}
+		req.Transition = codersdk.WorkspaceTransitionStart
+		template, err := b.api.Database.GetTemplateByID(ctx, workspace.TemplateID)
+		if err != nil {
+			return workspaceBulkFailed(xerrors.Errorf("get template: %w", err))
+		}
+		templateAccessControl := (*(b.api.AccessControlStore.Load())).GetTemplateAccessControl(template)
+		if templateAccessControl.RequireActiveVersion || workspace.AutomaticUpdates == database.AutomaticUpdatesAlways {
+			req.TemplateVersionID = template.ActiveVersionID
+		}
+	case database.WorkspaceBulkOperationActionStop:
+		if latestBuild.Transition == database.WorkspaceTransitionStop && succeeded {
+			return workspaceBulkSkipped("Workspace is already stopped.")
+		}
+		req.Transition = codersdk.WorkspaceTransitionStop
+	case database.WorkspaceBulkOperationActionUpdate:
+		template, err := b.api.Database.GetTemplateByID(ctx, workspace.TemplateID)
+		if err != nil {
+			return workspaceBulkFailed(xerrors.Errorf("get template: %w", err))
+		}
+		if latestBuild.TemplateVersionID == template.ActiveVersionID {
+			return workspaceBulkSkipped("Workspace is already on the active version.")
+		}
+		req.Transition = codersdk.WorkspaceTransitionStart
+		req.TemplateVersionID = template.ActiveVersionID
+	case database.WorkspaceBulkOperationActionDelete:
+		req.Transition = codersdk.WorkspaceTransitionDelete
+	}
+
+	apiBuild, err := b.api.postWorkspaceBuildsInternal(
+		ctx,
+		// The builds are initiated by the user that created the operation.
+		database.APIKey{UserID: op.InitiatorID},
+		workspace,
+		req,
+		func(action policy.Action, object rbac.Objecter) bool {
+			return b.api.HTTPAuth.Authorizer.Authorize(ctx, actor, action, object.RBACObject()) == nil
+		},
+		audit.WorkspaceBuildBaggage{},
+		// No one is waiting on an individual build of a bulk operation, so
+		// they must not hold up builds that users are waiting for.
+		wsbuilder.Builder.Automated,
+	)
+	if err != nil {
+		return workspaceBulkFailed(err)
+	}
+
+	buildID := uuid.NullUUID{UUID: apiBuild.ID, Valid: true}
+	job, err := b.waitForJob(ctx, row, buildID, apiBuild.Job.ID)
+	if err != nil {
+		return workspaceBulkResult{status: database.WorkspaceBulkOperationWorkspaceStatusFailed, buildID: buildID, err: workspaceBulkErrorMessage(err)}
+	}
+	return workspaceBulkJobResult(buildID, job)
+}
+
+// waitForBuild waits for a build created by an earlier attempt to process the
+// workspace.
+func (b *workspaceBulkRunner) waitForBuild(ctx context.Context, row database.WorkspaceBulkOperationWorkspace, buildID uuid.UUID) workspaceBulkResult {
+	nullBuildID := uuid.NullUUID{UUID: buildID, Valid: true}
+	build, err := b.api.Database.GetWorkspaceBuildByID(ctx, buildID)
+	if err != nil {
+		return workspaceBulkResult{status: database.WorkspaceBulkOperationWorkspaceStatusFailed, buildID: nullBuildID, err: workspaceBulkErrorMessage(xerrors.Errorf("get workspace build: %w", err))}
+	}
+	job, err := b.waitForJob(ctx, row, nullBuildID, build.JobID)
+	if err != nil {
+		return workspaceBulkResult{status: database.WorkspaceBulkOperationWorkspaceStatusFailed, buildID: nullBuildID, err: workspaceBulkErrorMessage(err)}
+	}
+	return workspaceBulkJobResult(nullBuildID, job)
+}
+
+func (b *workspaceBulkRunner) latestBuild(ctx context.Context, workspaceID uuid.UUID) (database.WorkspaceBuild, database.ProvisionerJob, error) {
+	build, err := b.api.Database.GetLatestWorkspaceBuildByWorkspaceID(ctx, workspaceID)
+	if err != nil {
+		return database.WorkspaceBuild{}, database.ProvisionerJob{}, xerrors.Errorf("get latest workspace build: %w", err)
+	}
+	job, err := b.api.Database.GetProvisionerJobByID(ctx, build.JobID)
+	if err != nil {
+		return database.WorkspaceBuild{}, database.ProvisionerJob{}, xerrors.Errorf("get provisioner job: %w", err)
+	}
+	return build, job, nil
+}
+
+// waitForJob polls a provisioner job until it is done. The workspace is kept
+// from becoming stale while the job runs.
+func (b *workspaceBulkRunner) waitForJob(ctx context.Context, row database.WorkspaceBulkOperationWorkspace, buildID uuid.NullUUID, jobID uuid.UUID) (database.ProvisionerJob, error) {
+	//nolint:gocritic // The runner records the progress of every workspace.
+	sysCtx := dbauthz.AsSystemRestricted(ctx)
+	ticker := b.api.Clock.NewTicker(workspaceBulkJobPollInterval)
+	defer ticker.Stop()
+
+	var lastHeartbeat time.Time
+	for {
+		job, err := b.api.Database.GetProvisionerJobByID(ctx, jobID)
+		if err != nil {
+			return database.ProvisionerJob{}, xerrors.Errorf("get provisioner job: %w", err)
+		}
+		if workspaceBulkJobDone(job) {
+			return job, nil
+		}
+
+		now := b.api.Clock.Now()
+		if now.Sub(lastHeartbeat) >= workspaceBulkHeartbeatInterval {
+			err = b.api.Database.UpdateWorkspaceBulkOperationWorkspace(sysCtx, database.UpdateWorkspaceBulkOperationWorkspaceParams{
+				Status:      database.WorkspaceBulkOperationWorkspaceStatusRunning,
+				BuildID:     buildID,
+				UpdatedAt:   dbtime.Time(now),
+				OperationID: row.OperationID,
+				WorkspaceID: row.WorkspaceID,
+			})
+			if err != nil {
+				return database.ProvisionerJob{}, xerrors.Errorf("update bulk operation workspace: %w", err)
+			}
+			lastHeartbeat = now
+		}
+
+		select {
+		case <-ticker.C:
+		case <-ctx.Done():
+			return database.ProvisionerJob{}, ctx.Err()
+		}
+	}
+}
+
+func workspaceBulkJobDone(job database.ProvisionerJob) bool {
+	switch job.JobStatus {
+	case database.ProvisionerJobStatusSucceeded, database.ProvisionerJobStatusFailed, database.ProvisionerJobStatusCanceled:
+		return true
+	default:
+		return false
+	}
+}
+
+func workspaceBulkJobResult(buildID uuid.NullUUID, job database.ProvisionerJob) workspaceBulkResult {
+	switch job.JobStatus {
+	case database.ProvisionerJobStatusSucceeded:
+		return workspaceBulkResult{status: database.WorkspaceBulkOperationWorkspaceStatusSucceeded, buildID: buildID}
+	case database.ProvisionerJobStatusCanceled:
+		return workspaceBulkResult{status: database.WorkspaceBulkOperationWorkspaceStatusFailed, buildID: buildID, err: "Build was canceled."}
+	default:
+		msg := "Build failed."
+		if job.Error.Valid && job.Error.String != "" {
+			msg = job.Error.String
+		}
+		return workspaceBulkResult{status: database.WorkspaceBulkOperationWorkspaceStatusFailed, buildID: buildID, err: msg}
+	}
+}
+
+// schedule applies the schedule change of the operation to the workspace and
+// audits it like the equivalent workspace endpoint does.
+func (b *workspaceBulkRunner) schedule(ctx context.Context, op database.WorkspaceBulkOperation, workspace database.Workspace) workspaceBulkResult {
+	newWorkspace := workspace
+	switch op.Action {
+	case database.WorkspaceBulkOperationActionAutostart:
+		if workspace.AutostartSchedule.String == op.AutostartSchedule {
+			return workspaceBulkSkipped("Workspace already has the autostart schedule.")
+		}
+		dbSched, err := b.api.updateWorkspaceAutostart(ctx, workspace, &op.AutostartSchedule)
+		if err != nil {
+			return workspaceBulkFailed(err)
+		}
+		newWorkspace.AutostartSchedule = dbSched
+	case database.WorkspaceBulkOperationActionTtl:
+		if workspace.Ttl.Int64 == int64((time.Duration(op.TTLMillis) * time.Millisecond).Truncate(time.Minute)) {
+			return workspaceBulkSkipped("Workspace already has the autostop TTL.")
+		}
+		dbTTL, err := b.api.updateWorkspaceTTL(ctx, workspace, &op.TTLMillis)
+		if err != nil {
+			return workspaceBulkFailed(err)
+		}
+		newWorkspace.Ttl = dbTTL
+	case database.WorkspaceBulkOperationActionAutomaticUpdates:
+		if workspace.AutomaticUpdates == op.AutomaticUpdates {
+			return workspaceBulkSkipped("Workspace already has the automatic updates policy.")
+		}
+		err := b.api.Database.UpdateWorkspaceAutomaticUpdates(ctx, database.UpdateWorkspaceAutomaticUpdatesParams{
+			ID:               workspace.ID,
+			AutomaticUpdates: op.AutomaticUpdates,
+		})
+		if err != nil {
+			return workspaceBulkFailed(xerrors.Errorf("update workspace automatic updates: %w", err))
+		}
+		newWorkspace.AutomaticUpdates = op.AutomaticUpdates
+	}
+
+	auditor := b.api.Auditor.Load()
+	audit.BackgroundAudit(ctx, &audit.BackgroundAuditParams[database.WorkspaceTable]{
+		Audit:          *auditor,
+		Log:            b.api.Logger,
+		UserID:         op.InitiatorID,
+		OrganizationID: workspace.OrganizationID,
+		// The changes of an operation share its ID, so they can be found
+		// from the audit log entry of the operation.
+		RequestID: op.ID,
+		Action:    database.AuditActionWrite,
+		Status:    http.StatusOK,
+		Old:       workspace.WorkspaceTable(),
+		New:       newWorkspace.WorkspaceTable(),
+	})
+	return workspaceBulkResult{status: database.WorkspaceBulkOperationWorkspaceStatusSucceeded}
+}
+
+// workspaceBulkErrorMessage returns the error to be stored for a workspace.
+// Response errors have cleaner messages than Error().
+func workspaceBulkErrorMessage(err error) string {
+	if xerrors.Is(err, sql.ErrNoRows) {
+		return "Resource not found or you do not have access to it."
+	}
+	if dbauthz.IsNotAuthorizedError(err) {
+		return "You are not authorized to apply the operation to this workspace."
+	}
+	responder, ok := httperror.IsResponder(err)
+	if !ok {
+		return err.Error()
+	}
+	_, response := responder.Response()
+	detail := response.Detail
+	if detail == "" && len(response.Validations) > 0 {
+		detail = response.Validations[0].Detail
+	}
+	if detail != "" && detail != response.Message {
+		return fmt.Sprintf("%s: %s", response.Message, detail)
+	}
+	if response.Message != "" {
+		return response.Message
+	}
+	return err.Error()
+}
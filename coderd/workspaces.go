@@ -38,6 +38,7 @@ import (
 	"github.com/coder/coder/v2/coderd/schedule/cron"
 	"github.com/coder/coder/v2/coderd/searchquery"
 	"github.com/coder/coder/v2/coderd/telemetry"
+	"github.com/coder/coder/v2/coderd/templaterollout"
 	"github.com/coder/coder/v2/coderd/util/ptr"
 	"github.com/coder/coder/v2/coderd/util/slice"
 	"github.com/coder/coder/v2/coderd/wsbuilder"
@@ -124,6 +125,8 @@ func (api *API) workspace(rw http.ResponseWriter, r *http.Request) {
 		data.templates[0],
 		api.AllowWorkspaceRenames,
 		appStatus,
+		data.driftCheck(workspace.ID),
+		data.nextSkippedAutostart(workspace.ID),
 	)
 	if err != nil {
 		httpapi.Write(ctx, rw, http.StatusInternalServerError, codersdk.Response{
@@ -341,6 +344,8 @@ func (api *API) workspaceByOwnerAndName(rw http.ResponseWriter, r *http.Request)
 		data.templates[0],
 		api.AllowWorkspaceRenames,
 		appStatus,
+		data.driftCheck(workspace.ID),
+		data.nextSkippedAutostart(workspace.ID),
 	)
 	if err != nil {
 		httpapi.Write(ctx, rw, http.StatusInternalServerError, codersdk.Response{
@@ -853,6 +858,8 @@ func createWorkspace(
 		template,
 		api.AllowWorkspaceRenames,
 		codersdk.WorkspaceAppStatus{},
+		nil,
+		nil,
 	)
 	if err != nil {
 		return codersdk.Workspace{}, httperror.NewResponseError(http.StatusInternalServerError, codersdk.Response{
@@ -1238,41 +1245,54 @@ func (api *API) putWorkspaceAutostart(rw http.ResponseWriter, r *http.Request) {
 		return
 	}
 
+	dbSched, err := api.updateWorkspaceAutostart(ctx, workspace, req.Schedule)
+	if err != nil {
+		httperror.WriteResponseError(ctx, rw, err)
+		return
+	}
+
+	newWorkspace := workspace
+	newWorkspace.AutostartSchedule = dbSched
+	aReq.New = newWorkspace.WorkspaceTable()
+
+	rw.WriteHeader(http.StatusNoContent)
+}
+
+// updateWorkspaceAutostart validates and sets the autostart schedule of the
+// workspace. A nil or empty schedule disables autostart. Errors are
+// httperror response errors.
+func (api *API) updateWorkspaceAutostart(ctx context.Context, workspace database.Workspace, sched *string) (sql.NullString, error) {
 	// Autostart configuration is not supported for prebuilt workspaces.
 	// Prebuild lifecycle is managed by the reconciliation loop, with scheduling behavior
 	// defined per preset at the template level, not per workspace.
 	if workspace.IsPrebuild() {
-		httpapi.Write(ctx, rw, http.StatusConflict, codersdk.Response{
+		return sql.NullString{}, httperror.NewResponseError(http.StatusConflict, codersdk.Response{
 			Message: "Autostart is not supported for prebuilt workspaces",
 			Detail:  "Prebuilt workspace scheduling is configured per preset at the template level. Workspace-level overrides are not supported.",
 		})
-		return
 	}
 
-	dbSched, err := validWorkspaceSchedule(req.Schedule)
+	dbSched, err := validWorkspaceSchedule(sched)
 	if err != nil {
-		httpapi.Write(ctx, rw, http.StatusBadRequest, codersdk.Response{
+		return sql.NullString{}, httperror.NewResponseError(http.StatusBadRequest, codersdk.Response{
 			Message:     "Invalid autostart schedule.",
 			Validations: []codersdk.ValidationError{{Field: "schedule", Detail: err.Error()}},
 		})
-		return
 	}
 
 	// Check if the template allows users to configure autostart.
 	templateSchedule, err := (*api.TemplateScheduleStore.Load()).Get(ctx, api.Database, workspace.TemplateID)
 	if err != nil {
-		httpapi.Write(ctx, rw, http.StatusInternalServerError, codersdk.Response{
+		return sql.NullString{}, httperror.NewResponseError(http.StatusInternalServerError, codersdk.Response{
 			Message: "Internal error getting template schedule options.",
 			Detail:  err.Error(),
 		})
-		return
 	}
 	if !templateSchedule.UserAutostartEnabled {
-		httpapi.Write(ctx, rw, http.StatusBadRequest, codersdk.Response{
+		return sql.NullString{}, httperror.NewResponseError(http.StatusBadRequest, codersdk.Response{
 			Message:     "Autostart is not allowed for workspaces using this template.",
 			Validations: []codersdk.ValidationError{{Field: "schedule", Detail: "Autostart is not allowed for workspaces using this template."}},
 		})
-		return
 	}
 
 	// Use injected Clock to allow time mocking in tests
@@ -1280,15 +1300,24 @@ func (api *API) putWorkspaceAutostart(rw http.ResponseWriter, r *http.Request) {
 
 	nextStartAt := sql.NullTime{}
 	if dbSched.Valid {
-		next, err := schedule.NextAllowedAutostart(now, dbSched.String, templateSchedule)
+		exceptions, err := schedule.GetAutostartExceptions(ctx, api.Database, workspace.ID, now)
 		if err != nil {
-			httpapi.Write(ctx, rw, http.StatusInternalServerError, codersdk.Response{
+			return sql.NullString{}, httperror.NewResponseError(http.StatusInternalServerError, codersdk.Response{
+				Message: "Internal error fetching workspace autostart exceptions.",
+				Detail:  err.Error(),
+			})
+		}
+		if _, err := schedule.NextAllowedAutostart(now, dbSched.String, templateSchedule); err != nil {
+			return sql.NullString{}, httperror.NewResponseError(http.StatusInternalServerError, codersdk.Response{
 				Message: "Internal error calculating workspace autostart schedule.",
 				Detail:  err.Error(),
 			})
-			return
 		}
-		nextStartAt = sql.NullTime{Valid: true, Time: dbtime.Time(next.UTC())}
+		// Autostarts on exception dates are skipped. If every autostart in
+		// the next year is skipped, next_start_at is left unset.
+		if next, err := schedule.NextAllowedAutostartWithExceptions(now, dbSched.String, templateSchedule, exceptions); err == nil {
+			nextStartAt = sql.NullTime{Valid: true, Time: dbtime.Time(next.UTC())}
+		}
 	}
 
 	err = api.Database.UpdateWorkspaceAutostart(ctx, database.UpdateWorkspaceAutostartParams{
@@ -1297,18 +1326,12 @@ func (api *API) putWorkspaceAutostart(rw http.ResponseWriter, r *http.Request) {
 		NextStartAt:       nextStartAt,
 	})
 	if err != nil {
-		httpapi.Write(ctx, rw, http.StatusInternalServerError, codersdk.Response{
+		return sql.NullString{}, httperror.NewResponseError(http.StatusInternalServerError, codersdk.Response{
 			Message: "Internal error updating workspace autostart schedule.",
 			Detail:  err.Error(),
 		})
-		return
 	}
-
-	newWorkspace := workspace
-	newWorkspace.AutostartSchedule = dbSched
-	aReq.New = newWorkspace.WorkspaceTable()
-
-	rw.WriteHeader(http.StatusNoContent)
+	return dbSched, nil
 }
 
 // @Summary Update workspace TTL by ID
@@ -1341,15 +1364,30 @@ func (api *API) putWorkspaceTTL(rw http.ResponseWriter, r *http.Request) {
 		return
 	}
 
+	dbTTL, err := api.updateWorkspaceTTL(ctx, workspace, req.TTLMillis)
+	if err != nil {
+		httperror.WriteResponseError(ctx, rw, err)
+		return
+	}
+
+	newWorkspace := workspace
+	newWorkspace.Ttl = dbTTL
+	aReq.New = newWorkspace.WorkspaceTable()
+
+	rw.WriteHeader(http.StatusNoContent)
+}
+
+// updateWorkspaceTTL validates and sets the autostop TTL of the workspace. A
+// nil or zero TTL disables autostop. Errors are httperror response errors.
+func (api *API) updateWorkspaceTTL(ctx context.Context, workspace database.Workspace, ttlMillis *int64) (sql.NullInt64, error) {
 	// TTL updates are not supported for prebuilt workspaces.
 	// Prebuild lifecycle is managed by the reconciliation loop, with TTL behavior
 	// defined per preset at the template level, not per workspace.
 	if workspace.IsPrebuild() {
-		httpapi.Write(ctx, rw, http.StatusConflict, codersdk.Response{
+		return sql.NullInt64{}, httperror.NewResponseError(http.StatusConflict, codersdk.Response{
 			Message: "TTL updates are not supported for prebuilt workspaces",
 			Detail:  "Prebuilt workspace TTL is configured per preset at the template level. Workspace-level overrides are not supported.",
 		})
-		return
 	}
 
 	var dbTTL sql.NullInt64
@@ -1366,7 +1404,7 @@ func (api *API) putWorkspaceTTL(rw http.ResponseWriter, r *http.Request) {
 		// don't override 0 ttl with template default here because it indicates
 		// disabled autostop
 		var validityErr error
-		dbTTL, validityErr = validWorkspaceTTLMillis(req.TTLMillis, 0)
+		dbTTL, validityErr = validWorkspaceTTLMillis(ttlMillis, 0)
 		if validityErr != nil {
 			return codersdk.ValidationError{Field: "ttl_ms", Detail: validityErr.Error()}
 		}
@@ -1423,20 +1461,13 @@ func (api *API) putWorkspaceTTL(rw http.ResponseWriter, r *http.Request) {
 		var validErr codersdk.ValidationError
 		if errors.As(err, &validErr) {
 			resp.Validations = []codersdk.ValidationError{validErr}
-			httpapi.Write(ctx, rw, http.StatusBadRequest, resp)
-			return
+			return sql.NullInt64{}, httperror.NewResponseError(http.StatusBadRequest, resp)
 		}
 
 		resp.Detail = err.Error()
-		httpapi.Write(ctx, rw, http.StatusInternalServerError, resp)
-		return
+		return sql.NullInt64{}, httperror.NewResponseError(http.StatusInternalServerError, resp)
 	}
-
-	newWorkspace := workspace
-	newWorkspace.Ttl = dbTTL
-	aReq.New = newWorkspace.WorkspaceTable()
-
-	rw.WriteHeader(http.StatusNoContent)
+	return dbTTL, nil
 }
 
 // @Summary Update workspace dormancy status by id.
@@ -1595,6 +1626,8 @@ func (api *API) putWorkspaceDormant(rw http.ResponseWriter, r *http.Request) {
 		data.templates[0],
 		api.AllowWorkspaceRenames,
 		appStatus,
+		data.driftCheck(workspace.ID),
+		data.nextSkippedAutostart(workspace.ID),
 	)
 	if err != nil {
 		httpapi.Write(ctx, rw, http.StatusInternalServerError, codersdk.Response{
@@ -2022,12 +2055,21 @@ func (api *API) resolveAutostart(rw http.ResponseWriter, r *http.Request) {
 		return
 	}
 
-	if build.TemplateVersionID == template.ActiveVersionID {
+	activeVersionID, err := templaterollout.VersionForWorkspace(ctx, api.Database, template, workspace, build.TemplateVersionID)
+	if err != nil {
+		httpapi.Write(ctx, rw, http.StatusInternalServerError, codersdk.Response{
+			Message: "Internal error fetching template version rollout.",
+			Detail:  err.Error(),
+		})
+		return
+	}
+
+	if build.TemplateVersionID == activeVersionID {
 		httpapi.Write(ctx, rw, http.StatusOK, codersdk.ResolveAutostartResponse{})
 		return
 	}
 
-	version, err := api.Database.GetTemplateVersionByID(ctx, template.ActiveVersionID)
+	version, err := api.Database.GetTemplateVersionByID(ctx, activeVersionID)
 	if err != nil {
 		httpapi.Write(ctx, rw, http.StatusInternalServerError, codersdk.Response{
 			Message: "Internal error fetching template version.",
@@ -2174,6 +2216,8 @@ func (api *API) watchWorkspace(
 			data.templates[0],
 			api.AllowWorkspaceRenames,
 			appStatus,
+			data.driftCheck(workspace.ID),
+			data.nextSkippedAutostart(workspace.ID),
 		)
 		if err != nil {
 			_ = sendEvent(codersdk.ServerSentEvent{
@@ -2596,10 +2640,32 @@ func (api *API) patchWorkspaceACL(rw http.ResponseWriter, r *http.Request) {
 }
 
 type workspaceData struct {
-	templates    []database.Template
-	builds       []codersdk.WorkspaceBuild
-	appStatuses  []codersdk.WorkspaceAppStatus
-	allowRenames bool
+	templates             []database.Template
+	builds                []codersdk.WorkspaceBuild
+	appStatuses           []codersdk.WorkspaceAppStatus
+	driftChecks           map[uuid.UUID]codersdk.WorkspaceDriftCheck
+	nextSkippedAutostarts map[uuid.UUID]time.Time
+	allowRenames          bool
+}
+
+// driftCheck returns the latest drift check of the workspace, or nil if it
+// has none.
+func (d workspaceData) driftCheck(workspaceID uuid.UUID) *codersdk.WorkspaceDriftCheck {
+	check, ok := d.driftChecks[workspaceID]
+	if !ok {
+		return nil
+	}
+	return &check
+}
+
+// nextSkippedAutostart returns the next autostart of the workspace that will
+// be skipped due to an exception date, or nil if there is none.
+func (d workspaceData) nextSkippedAutostart(workspaceID uuid.UUID) *time.Time {
+	next, ok := d.nextSkippedAutostarts[workspaceID]
+	if !ok {
+		return nil
+	}
+	return &next
 }
 
 // @Summary Completely clears the workspace's user and group ACLs.
@@ -2681,15 +2747,22 @@ func (api *API) allowWorkspaceSharing(ctx context.Context, rw http.ResponseWrite
 func (api *API) workspaceData(ctx context.Context, workspaces []database.Workspace, cfg workspaceRelated) (workspaceData, error) {
 	workspaceIDs := make([]uuid.UUID, 0, len(workspaces))
 	templateIDs := make([]uuid.UUID, 0, len(workspaces))
+	autostartWorkspaceIDs := make([]uuid.UUID, 0, len(workspaces))
 	for _, workspace := range workspaces {
 		workspaceIDs = append(workspaceIDs, workspace.ID)
 		templateIDs = append(templateIDs, workspace.TemplateID)
+		if workspace.AutostartSchedule.Valid {
+			autostartWorkspaceIDs = append(autostartWorkspaceIDs, workspace.ID)
+		}
 	}
 
 	var (
 		templates   []database.Template
 		builds      []database.WorkspaceBuild
 		appStatuses []database.WorkspaceAppStatus
+		driftChecks []database.WorkspaceDriftCheck
+		exceptions  []database.GetWorkspaceAutostartExceptionsByWorkspaceIDsRow
+		now         time.Time
 		eg          errgroup.Group
 	)
 	if cfg.Template {
@@ -2725,11 +2798,44 @@ func (api *API) workspaceData(ctx context.Context, workspaces []database.Workspa
 			return nil
 		})
 	}
+	if cfg.LatestBuild != nil && cfg.LatestBuild.DriftCheck {
+		eg.Go(func() (err error) {
+			// This query must be run as system restricted to be efficient.
+			// nolint:gocritic
+			driftChecks, err = api.Database.GetLatestWorkspaceDriftChecksByWorkspaceIDs(dbauthz.AsSystemRestricted(ctx), workspaceIDs)
+			if err != nil && !errors.Is(err, sql.ErrNoRows) {
+				return xerrors.Errorf("get workspace drift checks: %w", err)
+			}
+			return nil
+		})
+	}
+	if cfg.NextSkippedAutostart && len(autostartWorkspaceIDs) > 0 {
+		now = api.Clock.Now()
+		eg.Go(func() (err error) {
+			// Exceptions are loaded from the day before, since dates are
+			// compared in the timezone of each workspace's schedule.
+			// This query must be run as system restricted to be efficient.
+			// nolint:gocritic
+			exceptions, err = api.Database.GetWorkspaceAutostartExceptionsByWorkspaceIDs(dbauthz.AsSystemRestricted(ctx), database.GetWorkspaceAutostartExceptionsByWorkspaceIDsParams{
+				WorkspaceIDs: autostartWorkspaceIDs,
+				After:        now.AddDate(0, 0, -1),
+			})
+			if err != nil && !errors.Is(err, sql.ErrNoRows) {
+				return xerrors.Errorf("get workspace autostart exceptions: %w", err)
+			}
+			return nil
+		})
+	}
 	err := eg.Wait()
 	if err != nil {
 		return workspaceData{}, err
 	}
 
+	nextSkippedAutostarts, err := api.nextSkippedAutostarts(ctx, workspaces, schedule.AutostartExceptionsByWorkspace(exceptions), now)
+	if err != nil {
+		return workspaceData{}, xerrors.Errorf("get next skipped autostarts: %w", err)
+	}
+
 	var data workspaceBuildsData
 	if cfg.LatestBuild != nil {
 		data, err = api.workspaceBuildsData(ctx, builds, *cfg.LatestBuild)
@@ -2756,14 +2862,55 @@ func (api *API) workspaceData(ctx context.Context, workspaces []database.Workspa
 		return workspaceData{}, xerrors.Errorf("convert workspace builds: %w", err)
 	}
 
+	apiDriftChecks := make(map[uuid.UUID]codersdk.WorkspaceDriftCheck, len(driftChecks))
+	for _, check := range driftChecks {
+		apiDriftChecks[check.WorkspaceID], err = convertWorkspaceDriftCheck(check)
+		if err != nil {
+			return workspaceData{}, xerrors.Errorf("convert workspace drift check: %w", err)
+		}
+	}
+
 	return workspaceData{
-		templates:    templates,
-		appStatuses:  db2sdk.WorkspaceAppStatuses(appStatuses),
-		builds:       apiBuilds,
-		allowRenames: api.AllowWorkspaceRenames,
+		templates:             templates,
+		appStatuses:           db2sdk.WorkspaceAppStatuses(appStatuses),
+		builds:                apiBuilds,
+		driftChecks:           apiDriftChecks,
+		nextSkippedAutostarts: nextSkippedAutostarts,
+		allowRenames:          api.AllowWorkspaceRenames,
 	}, nil
 }
 
+// nextSkippedAutostarts returns the next autostart of each workspace that
+// will be skipped because it falls on an exception date. Workspaces without
+// one are omitted.
+func (api *API) nextSkippedAutostarts(ctx context.Context, workspaces []database.Workspace, exceptions map[uuid.UUID]schedule.AutostartExceptions, now time.Time) (map[uuid.UUID]time.Time, error) {
+	next := make(map[uuid.UUID]time.Time)
+	templateSchedules := make(map[uuid.UUID]schedule.TemplateScheduleOptions)
+	for _, workspace := range workspaces {
+		if !workspace.AutostartSchedule.Valid || len(exceptions[workspace.ID]) == 0 {
+			continue
+		}
+		templateSchedule, ok := templateSchedules[workspace.TemplateID]
+		if !ok {
+			var err error
+			// The caller may read the workspace but not its template.
+			// nolint:gocritic
+			templateSchedule, err = (*api.TemplateScheduleStore.Load()).Get(dbauthz.AsSystemRestricted(ctx), api.Database, workspace.TemplateID)
+			if err != nil {
+				return nil, xerrors.Errorf("get template schedule options: %w", err)
+			}
+			templateSchedules[workspace.TemplateID] = templateSchedule
+		}
+		if !templateSchedule.UserAutostartEnabled {
+			continue
+		}
+		if skipped, _, ok := schedule.NextSkippedAutostart(now, workspace.AutostartSchedule.String, templateSchedule, exceptions[workspace.ID]); ok {
+			next[workspace.ID] = skipped
+		}
+	}
+	return next, nil
+}
+
 // attachAgentMetadata maps the agent metadata the workspaces query
 // aggregated per workspace onto the agents in the converted response.
 // Each aggregated datum carries its workspace_agent_id. An unparsable
@@ -2848,6 +2995,8 @@ func convertWorkspaces(
 			template,
 			data.allowRenames,
 			appStatus,
+			data.driftCheck(workspace.ID),
+			data.nextSkippedAutostart(workspace.ID),
 		)
 		if err != nil {
 			return nil, xerrors.Errorf("convert workspace: %w", err)
@@ -2867,6 +3016,8 @@ func convertWorkspace(
 	template database.Template,
 	allowRenames bool,
 	latestAppStatus codersdk.WorkspaceAppStatus,
+	latestDriftCheck *codersdk.WorkspaceDriftCheck,
+	nextSkippedAutostart *time.Time,
 ) (codersdk.Workspace, error) {
 	if requesterID == uuid.Nil {
 		return codersdk.Workspace{}, xerrors.Errorf("developer error: requesterID cannot be uuid.Nil!")
@@ -2952,13 +3103,31 @@ func convertWorkspace(
 			Healthy:       len(failingAgents) == 0,
 			FailingAgents: failingAgents,
 		},
-		AutomaticUpdates: codersdk.AutomaticUpdates(workspace.AutomaticUpdates),
-		AllowRenames:     allowRenames,
-		Favorite:         requesterFavorite,
-		NextStartAt:      nextStartAt,
-		IsPrebuild:       workspace.IsPrebuild(),
-		TaskID:           workspace.TaskID,
-		SharedWith:       sharedWorkspaceActors(ctx, logger, workspace),
+		AutomaticUpdates:     codersdk.AutomaticUpdates(workspace.AutomaticUpdates),
+		AllowRenames:         allowRenames,
+		Favorite:             requesterFavorite,
+		NextStartAt:          nextStartAt,
+		IsPrebuild:           workspace.IsPrebuild(),
+		TaskID:               workspace.TaskID,
+		SharedWith:           sharedWorkspaceActors(ctx, logger, workspace),
+		LatestDriftCheck:     latestDriftCheck,
+		NextSkippedAutostart: nextSkippedAutostart,
+	}, nil
+}
+
+func convertWorkspaceDriftCheck(check database.WorkspaceDriftCheck) (codersdk.WorkspaceDriftCheck, error) {
+	resources := []codersdk.WorkspaceResourceDrift{}
+	err := json.Unmarshal(check.ResourceDrift, &resources)
+	if err != nil {
+		return codersdk.WorkspaceDriftCheck{}, xerrors.Errorf("unmarshal resource drift: %w", err)
+	}
+	return codersdk.WorkspaceDriftCheck{
+		ID:               check.ID,
+		WorkspaceBuildID: check.WorkspaceBuildID,
+		JobID:            check.JobID,
+		CheckedAt:        check.CheckedAt.Time,
+		Drifted:          check.Drifted,
+		Resources:        resources,
 	}, nil
 }
 
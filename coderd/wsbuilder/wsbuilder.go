@@ -30,6 +30,7 @@ import (
 	"github.com/coder/coder/v2/coderd/provisionerdserver"
 	"github.com/coder/coder/v2/coderd/rbac"
 	"github.com/coder/coder/v2/coderd/rbac/policy"
+	"github.com/coder/coder/v2/coderd/templaterollout"
 	"github.com/coder/coder/v2/coderd/tracing"
 	"github.com/coder/coder/v2/coderd/util/ptr"
 	"github.com/coder/coder/v2/coderd/util/slice"
@@ -63,6 +64,7 @@ type Builder struct {
 	richParameterValues     []codersdk.WorkspaceBuildParameter
 	initiator               uuid.UUID
 	reason                  database.BuildReason
+	automated               bool
 	templateVersionPresetID uuid.UUID
 
 	// used during build, makes function arguments less verbose
@@ -120,7 +122,9 @@ func (NoopUsageChecker) CheckBuildUsage(_ context.Context, _ database.Store, _ *
 // The zero value of this struct means to use the version from the last build.  If there is no last build,
 // the build will fail.
 //
-// setting active: true means to use the active version from the template.
+// setting active: true means to use the active version from the template, or the version of an in-progress
+// rollout of the template if the workspace follows the active version and is part of the rollout. New workspaces
+// always use the active version.
 //
 // setting specific to a non-nil value means to use the provided template version ID.
 //
@@ -207,6 +211,15 @@ func (b Builder) Reason(r database.BuildReason) Builder {
 	return b
 }
 
+// Automated indicates that no one is actively waiting for the build, for
+// example because it is part of a bulk operation, so its job is queued
+// behind builds started by users.
+func (b Builder) Automated() Builder {
+	// nolint: revive
+	b.automated = true
+	return b
+}
+
 func (b Builder) RichParameterValues(p []codersdk.WorkspaceBuildParameter) Builder {
 	// nolint: revive
 	b.richParameterValues = p
@@ -457,6 +470,8 @@ func (b *Builder) buildTx(authFunc func(action policy.Action, object rbac.Object
 			RawMessage: traceMetadataRaw,
 		},
 		LogsOverflowed: false,
+		Priority:       b.jobPriority(),
+		TemplateID:     template.ID,
 	})
 	if err != nil {
 		return nil, nil, nil, BuildError{http.StatusInternalServerError, "insert provisioner job", err}
@@ -680,7 +695,30 @@ func (b *Builder) getTemplateVersionID() (uuid.UUID, error) {
 		if err != nil {
 			return uuid.Nil, xerrors.Errorf("get template so we can get active version: %w", err)
 		}
-		return t.ActiveVersionID, nil
+		// New workspaces are always created from the active version. Existing
+		// workspaces may be part of a rollout of a newer version.
+		first, err := b.firstBuild()
+		if err != nil {
+			return uuid.Nil, xerrors.Errorf("check if first build: %w", err)
+		}
+		if first {
+			return t.ActiveVersionID, nil
+		}
+		// Only workspaces that follow the active version take part in
+		// rollouts. Manually updating any other workspace builds the active
+		// version.
+		if !t.RequireActiveVersion && b.workspace.AutomaticUpdates != database.AutomaticUpdatesAlways {
+			return t.ActiveVersionID, nil
+		}
+		bld, err := b.getLastBuild()
+		if err != nil {
+			return uuid.Nil, xerrors.Errorf("get last build so we can get version: %w", err)
+		}
+		id, err := templaterollout.VersionForWorkspace(b.ctx, b.store, *t, b.workspace, bld.TemplateVersionID)
+		if err != nil {
+			return uuid.Nil, xerrors.Errorf("get rollout version: %w", err)
+		}
+		return id, nil
 	}
 	// default is prior version
 	bld, err := b.getLastBuild()
@@ -1075,6 +1113,25 @@ func (b *Builder) getLastBuildJob() (*database.ProvisionerJob, error) {
 	return b.lastBuildJob, nil
 }
 
+// jobPriority returns the priority class of the provisioner job. Builds that
+// no one is actively waiting for are queued behind builds started by users.
+func (b *Builder) jobPriority() int32 {
+	if b.automated || b.initiator == database.PrebuildsSystemUserID {
+		return codersdk.ProvisionerJobPriorityAutomated
+	}
+	switch b.reason {
+	case database.BuildReasonAutostart,
+		database.BuildReasonAutostop,
+		database.BuildReasonDormancy,
+		database.BuildReasonFailedstop,
+		database.BuildReasonAutodelete,
+		database.BuildReasonTaskAutoPause:
+		return codersdk.ProvisionerJobPriorityAutomated
+	default:
+		return codersdk.ProvisionerJobPriorityInteractive
+	}
+}
+
 func (b *Builder) getProvisionerTags() (map[string]string, error) {
 	if b.workspaceTags != nil {
 		return *b.workspaceTags, nil
@@ -249,6 +249,51 @@ func TestBuilder_Reason(t *testing.T) {
 	req.NoError(err)
 }
 
+func TestBuilder_Automated(t *testing.T) {
+	t.Parallel()
+	req := require.New(t)
+	asrt := assert.New(t)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	mDB := expectDB(t,
+		// Inputs
+		withTemplate,
+		withInactiveVersion(nil),
+		withLastBuildFound,
+		withLastBuildState,
+		withTemplateVersionVariables(inactiveVersionID, nil),
+		withRichParameters(nil),
+		withParameterSchemas(inactiveJobID, nil),
+		withWorkspaceTags(inactiveVersionID, nil),
+		withProvisionerDaemons([]database.GetEligibleProvisionerDaemonsByProvisionerJobIDsRow{}),
+
+		// Outputs
+		expectProvisionerJob(func(job database.InsertProvisionerJobParams) {
+			asrt.Equal(codersdk.ProvisionerJobPriorityAutomated, job.Priority)
+		}),
+		withInTx,
+		expectFindMatchingPresetID(uuid.Nil, sql.ErrNoRows),
+		expectBuild(func(bld database.InsertWorkspaceBuildParams) {
+			asrt.Equal(database.BuildReasonInitiator, bld.Reason)
+		}),
+		expectBuildParameters(func(params database.InsertWorkspaceBuildParametersParams) {
+		}),
+		withBuild,
+		withNoTask,
+	)
+	fc := files.New(prometheus.NewRegistry(), &coderdtest.FakeAuthorizer{})
+
+	ws := database.Workspace{ID: workspaceID, TemplateID: templateID, OwnerID: userID}
+	uut := wsbuilder.New(ws, database.WorkspaceTransitionStart, wsbuilder.NoopUsageChecker{}).
+		Initiator(userID).
+		Automated()
+	// nolint: dogsled
+	_, _, _, err := uut.Build(ctx, mDB, fc, nil, audit.WorkspaceBuildBaggage{})
+	req.NoError(err)
+}
+
 func TestBuilder_ActiveVersion(t *testing.T) {
 	t.Parallel()
 	req := require.New(t)
@@ -296,6 +341,126 @@ func TestBuilder_ActiveVersion(t *testing.T) {
 	req.NoError(err)
 }
 
+func TestBuilder_ActiveVersionRollout(t *testing.T) {
+	t.Parallel()
+	req := require.New(t)
+	asrt := assert.New(t)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	mDB := expectDB(t,
+		// Inputs
+		withTemplate,
+		withInactiveVersion(nil),
+		withLastBuildFound,
+		withLastBuildState,
+		withTemplateVersionVariables(inactiveVersionID, nil),
+		withRichParameters(nil),
+		withParameterSchemas(inactiveJobID, nil),
+		withWorkspaceTags(inactiveVersionID, nil),
+		withProvisionerDaemons([]database.GetEligibleProvisionerDaemonsByProvisionerJobIDsRow{}),
+		// The workspace was updated to the version of a rollout that has
+		// since been paused, so it stays on that version.
+		func(mTx *dbmock.MockStore) {
+			mTx.EXPECT().GetActiveTemplateVersionRolloutByTemplateID(gomock.Any(), templateID).
+				Times(1).
+				Return(database.TemplateVersionRollout{
+					ID:                        uuid.New(),
+					TemplateID:                templateID,
+					TemplateVersionID:         inactiveVersionID,
+					PreviousTemplateVersionID: activeVersionID,
+					Status:                    database.TemplateVersionRolloutStatusPaused,
+				}, nil)
+		},
+
+		// Outputs
+		expectProvisionerJob(func(job database.InsertProvisionerJobParams) {
+			asrt.Equal(inactiveFileID, job.FileID)
+		}),
+
+		withInTx,
+		expectFindMatchingPresetID(uuid.Nil, sql.ErrNoRows),
+		expectBuild(func(bld database.InsertWorkspaceBuildParams) {
+			asrt.Equal(inactiveVersionID, bld.TemplateVersionID)
+			asrt.Equal(int32(2), bld.BuildNumber)
+		}),
+		withBuild,
+		withNoTask,
+		expectBuildParameters(func(params database.InsertWorkspaceBuildParametersParams) {
+		}),
+	)
+	fc := files.New(prometheus.NewRegistry(), &coderdtest.FakeAuthorizer{})
+
+	ws := database.Workspace{
+		ID:               workspaceID,
+		TemplateID:       templateID,
+		OwnerID:          userID,
+		AutomaticUpdates: database.AutomaticUpdatesAlways,
+	}
+	uut := wsbuilder.New(ws, database.WorkspaceTransitionStart, wsbuilder.NoopUsageChecker{}).
+		ActiveVersion()
+	// nolint: dogsled
+	_, _, _, err := uut.Build(ctx, mDB, fc, nil, audit.WorkspaceBuildBaggage{})
+	req.NoError(err)
+}
+
+func TestBuilder_ActiveVersionManualUpdate(t *testing.T) {
+	t.Parallel()
+	req := require.New(t)
+	asrt := assert.New(t)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	// The workspace doesn't follow the active version, so a manual update
+	// builds the active version and never looks up the rollout.
+	mDB := expectDB(t,
+		// Inputs
+		withTemplate,
+		withActiveVersion(nil),
+		withLastBuildFound,
+		withLastBuildState,
+		withTemplateVersionVariables(activeVersionID, nil),
+		withRichParameters(nil),
+		withParameterSchemas(activeJobID, nil),
+		withWorkspaceTags(activeVersionID, nil),
+		withProvisionerDaemons([]database.GetEligibleProvisionerDaemonsByProvisionerJobIDsRow{}),
+		func(mTx *dbmock.MockStore) {
+			mTx.EXPECT().GetActiveTemplateVersionRolloutByTemplateID(gomock.Any(), gomock.Any()).Times(0)
+		},
+
+		// Outputs
+		expectProvisionerJob(func(job database.InsertProvisionerJobParams) {
+			asrt.Equal(activeFileID, job.FileID)
+		}),
+
+		withInTx,
+		expectFindMatchingPresetID(uuid.Nil, sql.ErrNoRows),
+		expectBuild(func(bld database.InsertWorkspaceBuildParams) {
+			asrt.Equal(activeVersionID, bld.TemplateVersionID)
+			asrt.Equal(int32(2), bld.BuildNumber)
+		}),
+		withBuild,
+		withNoTask,
+		expectBuildParameters(func(params database.InsertWorkspaceBuildParametersParams) {
+		}),
+	)
+	fc := files.New(prometheus.NewRegistry(), &coderdtest.FakeAuthorizer{})
+
+	ws := database.Workspace{
+		ID:               workspaceID,
+		TemplateID:       templateID,
+		OwnerID:          userID,
+		AutomaticUpdates: database.AutomaticUpdatesNever,
+	}
+	uut := wsbuilder.New(ws, database.WorkspaceTransitionStart, wsbuilder.NoopUsageChecker{}).
+		ActiveVersion()
+	// nolint: dogsled
+	_, _, _, err := uut.Build(ctx, mDB, fc, nil, audit.WorkspaceBuildBaggage{})
+	req.NoError(err)
+}
+
 func TestWorkspaceBuildWithTags(t *testing.T) {
 	t.Parallel()
 
@@ -18,14 +18,16 @@ package coderd
 // The trailing comment on each field is that node's dotted path from the root
 // of the tree, e.g. latest_build.resources.agents.
 type workspaceRelated struct {
-	Template    bool                // template
-	LatestBuild *latestBuildRelated // latest_build
+	Template             bool                // template
+	LatestBuild          *latestBuildRelated // latest_build
+	NextSkippedAutostart bool                // next_skipped_autostart
 }
 
 type latestBuildRelated struct {
 	Job             *jobRelated       // latest_build.job
 	Resources       *resourcesRelated // latest_build.resources
 	TemplateVersion bool              // latest_build.template_version
+	DriftCheck      bool              // latest_build.drift_check
 }
 
 type jobRelated struct {
@@ -53,8 +55,9 @@ type appsRelated struct {
 func allWorkspaceRelated() workspaceRelated {
 	latestBuild := allLatestBuildRelated()
 	return workspaceRelated{
-		Template:    true,
-		LatestBuild: &latestBuild,
+		Template:             true,
+		LatestBuild:          &latestBuild,
+		NextSkippedAutostart: true,
 	}
 }
 
@@ -72,6 +75,7 @@ func allLatestBuildRelated() latestBuildRelated {
 			},
 		},
 		TemplateVersion: true,
+		DriftCheck:      true,
 	}
 }
 
@@ -1,6 +1,7 @@
 package coderd
 
 import (
+	"database/sql"
 	"testing"
 
 	"github.com/google/uuid"
@@ -10,6 +11,7 @@ import (
 	"github.com/coder/coder/v2/coderd/database"
 	"github.com/coder/coder/v2/coderd/database/dbmock"
 	"github.com/coder/coder/v2/testutil"
+	"github.com/coder/quartz"
 )
 
 // TestAllWorkspaceRelated verifies that allWorkspaceRelated selects every node
@@ -19,10 +21,12 @@ func TestAllWorkspaceRelated(t *testing.T) {
 
 	all := allWorkspaceRelated()
 	require.True(t, all.Template)
+	require.True(t, all.NextSkippedAutostart)
 	require.NotNil(t, all.LatestBuild)
 	require.NotNil(t, all.LatestBuild.Job)
 	require.True(t, all.LatestBuild.Job.QueuePosition)
 	require.True(t, all.LatestBuild.TemplateVersion)
+	require.True(t, all.LatestBuild.DriftCheck)
 	require.NotNil(t, all.LatestBuild.Resources)
 	require.True(t, all.LatestBuild.Resources.Metadata)
 	require.NotNil(t, all.LatestBuild.Resources.Agents)
@@ -230,7 +234,11 @@ func TestWorkspaceBuildsDataResourcesShortCircuit(t *testing.T) {
 func TestWorkspaceDataQueryGating(t *testing.T) {
 	t.Parallel()
 
-	workspace := database.Workspace{ID: uuid.New(), TemplateID: uuid.New()}
+	workspace := database.Workspace{
+		ID:                uuid.New(),
+		TemplateID:        uuid.New(),
+		AutostartSchedule: sql.NullString{Valid: true, String: "CRON_TZ=UTC 0 9 * * 1-5"},
+	}
 
 	cases := []struct {
 		name  string
@@ -259,6 +267,25 @@ func TestWorkspaceDataQueryGating(t *testing.T) {
 					Return([]database.WorkspaceBuild{}, nil)
 			},
 		},
+		{
+			name: "DriftCheck",
+			cfg:  workspaceRelated{LatestBuild: &latestBuildRelated{DriftCheck: true}},
+			setup: func(db *dbmock.MockStore) {
+				db.EXPECT().GetLatestWorkspaceBuildsByWorkspaceIDs(gomock.Any(), gomock.Any()).
+					Return([]database.WorkspaceBuild{}, nil)
+				db.EXPECT().GetLatestWorkspaceDriftChecksByWorkspaceIDs(gomock.Any(), gomock.Any()).
+					Return([]database.WorkspaceDriftCheck{}, nil)
+			},
+		},
+		{
+			name: "NextSkippedAutostart",
+			cfg:  workspaceRelated{NextSkippedAutostart: true},
+			setup: func(db *dbmock.MockStore) {
+				// No exceptions returned, so no template schedule is needed.
+				db.EXPECT().GetWorkspaceAutostartExceptionsByWorkspaceIDs(gomock.Any(), gomock.Any()).
+					Return([]database.GetWorkspaceAutostartExceptionsByWorkspaceIDsRow{}, nil)
+			},
+		},
 		{
 			name: "AppStatuses",
 			cfg: workspaceRelated{LatestBuild: &latestBuildRelated{
@@ -286,7 +313,7 @@ func TestWorkspaceDataQueryGating(t *testing.T) {
 			db := dbmock.NewMockStore(ctrl)
 			tc.setup(db)
 
-			api := &API{Options: &Options{Database: db}}
+			api := &API{Options: &Options{Database: db, Clock: quartz.NewMock(t)}}
 			_, err := api.workspaceData(ctx, []database.Workspace{workspace}, tc.cfg)
 			require.NoError(t, err)
 		})
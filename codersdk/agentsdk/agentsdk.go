@@ -135,6 +135,15 @@ type Manifest struct {
 	Metadata                 []codersdk.WorkspaceAgentMetadataDescription `json:"metadata"`
 	Scripts                  []codersdk.WorkspaceAgentScript              `json:"scripts"`
 	Devcontainers            []codersdk.WorkspaceAgentDevcontainer        `json:"devcontainers"`
+	LogTails                 []LogTail                                    `json:"log_tails"`
+}
+
+// LogTail is a set of log files and journald units that the agent tails
+// into a log source. Paths may contain glob patterns.
+type LogTail struct {
+	LogSourceID   uuid.UUID `json:"log_source_id"`
+	Paths         []string  `json:"paths"`
+	JournaldUnits []string  `json:"journald_units"`
 }
 
 // WorkspaceSecret is a user secret for injection into a workspace.
@@ -48,6 +48,10 @@ func ManifestFromProto(manifest *proto.Manifest) (Manifest, error) {
 	if err != nil {
 		return Manifest{}, xerrors.Errorf("error converting workspace agent devcontainers: %w", err)
 	}
+	logTails, err := LogTailsFromProto(manifest.LogTails)
+	if err != nil {
+		return Manifest{}, xerrors.Errorf("error converting workspace agent log tails: %w", err)
+	}
 	return Manifest{
 		ParentID:                 parentID,
 		AgentID:                  agentID,
@@ -67,6 +71,7 @@ func ManifestFromProto(manifest *proto.Manifest) (Manifest, error) {
 		DisableDirectConnections: manifest.DisableDirectConnections,
 		Metadata:                 MetadataDescriptionsFromProto(manifest.Metadata),
 		Devcontainers:            devcontainers,
+		LogTails:                 logTails,
 	}, nil
 }
 
@@ -98,6 +103,7 @@ func ProtoFromManifest(manifest Manifest) (*proto.Manifest, error) {
 		Apps:                     apps,
 		Metadata:                 ProtoFromMetadataDescriptions(manifest.Metadata),
 		Devcontainers:            ProtoFromDevcontainers(manifest.Devcontainers),
+		LogTails:                 ProtoFromLogTails(manifest.LogTails),
 	}, nil
 }
 
@@ -275,6 +281,8 @@ func AppFromProto(protoApp *proto.WorkspaceApp) (codersdk.WorkspaceApp, error) {
 		SharingLevel:  sharingLevel,
 		Healthcheck: codersdk.Healthcheck{
 			URL:       protoApp.Healthcheck.Url,
+			Address:   protoApp.Healthcheck.Address,
+			Command:   protoApp.Healthcheck.Command,
 			Interval:  int32(protoApp.Healthcheck.Interval.AsDuration().Seconds()),
 			Threshold: protoApp.Healthcheck.Threshold,
 		},
@@ -307,6 +315,8 @@ func ProtoFromApp(a codersdk.WorkspaceApp) (*proto.WorkspaceApp, error) {
 			Url:       a.Healthcheck.URL,
 			Interval:  durationpb.New(time.Duration(a.Healthcheck.Interval) * time.Second),
 			Threshold: a.Healthcheck.Threshold,
+			Address:   a.Healthcheck.Address,
+			Command:   a.Healthcheck.Command,
 		},
 		Health: proto.WorkspaceApp_Health(health),
 		Hidden: a.Hidden,
@@ -473,6 +483,34 @@ func ProtoFromDevcontainer(dc codersdk.WorkspaceAgentDevcontainer) *proto.Worksp
 	}
 }
 
+func LogTailsFromProto(plts []*proto.WorkspaceAgentLogTail) ([]LogTail, error) {
+	ret := make([]LogTail, len(plts))
+	for i, plt := range plts {
+		logSourceID, err := uuid.FromBytes(plt.LogSourceId)
+		if err != nil {
+			return nil, xerrors.Errorf("parse log tail %v log source id: %w", i, err)
+		}
+		ret[i] = LogTail{
+			LogSourceID:   logSourceID,
+			Paths:         plt.Paths,
+			JournaldUnits: plt.JournaldUnits,
+		}
+	}
+	return ret, nil
+}
+
+func ProtoFromLogTails(lts []LogTail) []*proto.WorkspaceAgentLogTail {
+	ret := make([]*proto.WorkspaceAgentLogTail, len(lts))
+	for i, lt := range lts {
+		ret[i] = &proto.WorkspaceAgentLogTail{
+			LogSourceId:   lt.LogSourceID[:],
+			Paths:         lt.Paths,
+			JournaldUnits: lt.JournaldUnits,
+		}
+	}
+	return ret
+}
+
 func ProtoFromPatchAppStatus(pas PatchAppStatus) (*proto.UpdateAppStatusRequest, error) {
 	state, ok := proto.UpdateAppStatusRequest_AppStatusState_value[strings.ToUpper(string(pas.State))]
 	if !ok {
@@ -66,6 +66,30 @@ func TestManifest(t *testing.T) {
 				Health: codersdk.WorkspaceAppHealthInitializing,
 				Hidden: true,
 			},
+			{
+				ID:           uuid.New(),
+				Slug:         "postgres",
+				DisplayName:  "Postgres",
+				SharingLevel: codersdk.WorkspaceAppSharingLevelOwner,
+				Healthcheck: codersdk.Healthcheck{
+					Address:   "localhost:5432",
+					Interval:  5,
+					Threshold: 6,
+				},
+				Health: codersdk.WorkspaceAppHealthInitializing,
+			},
+			{
+				ID:           uuid.New(),
+				Slug:         "language-server",
+				DisplayName:  "Language Server",
+				SharingLevel: codersdk.WorkspaceAppSharingLevelOwner,
+				Healthcheck: codersdk.Healthcheck{
+					Command:   "pgrep -x gopls",
+					Interval:  10,
+					Threshold: 3,
+				},
+				Health: codersdk.WorkspaceAppHealthInitializing,
+			},
 		},
 		DERPMap: &tailcfg.DERPMap{
 			HomeParams: &tailcfg.DERPHomeParams{RegionScore: map[int]float64{999: 0.025}},
@@ -139,6 +163,16 @@ func TestManifest(t *testing.T) {
 				SubagentID:      uuid.NullUUID{Valid: true, UUID: uuid.New()},
 			},
 		},
+		LogTails: []agentsdk.LogTail{
+			{
+				LogSourceID: uuid.New(),
+				Paths:       []string{"/var/log/nginx/*.log"},
+			},
+			{
+				LogSourceID:   uuid.New(),
+				JournaldUnits: []string{"docker.service", "containerd.service"},
+			},
+		},
 	}
 	p, err := agentsdk.ProtoFromManifest(manifest)
 	require.NoError(t, err)
@@ -163,6 +197,7 @@ func TestManifest(t *testing.T) {
 	require.Equal(t, manifest.Metadata, back.Metadata)
 	require.Equal(t, manifest.Scripts, back.Scripts)
 	require.Equal(t, manifest.Devcontainers, back.Devcontainers)
+	require.Equal(t, manifest.LogTails, back.LogTails)
 }
 
 func TestSubsystems(t *testing.T) {
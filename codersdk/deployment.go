@@ -703,6 +703,7 @@ type DeploymentValues struct {
 	PostgresConnMaxIdle                     serpent.String                       `json:"pg_conn_max_idle,omitempty" typescript:",notnull"`
 	OAuth2                                  OAuth2Config                         `json:"oauth2,omitempty" typescript:",notnull"`
 	OIDC                                    OIDCConfig                           `json:"oidc,omitempty" typescript:",notnull"`
+	OIDCProviders                           serpent.Struct[[]OIDCProviderConfig] `json:"oidc_providers,omitempty" typescript:",notnull"`
 	Telemetry                               TelemetryConfig                      `json:"telemetry,omitempty" typescript:",notnull"`
 	TLS                                     TLSConfig                            `json:"tls,omitempty" typescript:",notnull"`
 	Trace                                   TraceConfig                          `json:"trace,omitempty" typescript:",notnull"`
@@ -743,6 +744,7 @@ type DeploymentValues struct {
 	AllowWorkspaceRenames                   serpent.Bool                         `json:"allow_workspace_renames,omitempty" typescript:",notnull"`
 	Healthcheck                             HealthcheckConfig                    `json:"healthcheck,omitempty" typescript:",notnull"`
 	Retention                               RetentionConfig                      `json:"retention,omitempty" typescript:",notnull"`
+	AuditLogStreaming                       AuditLogStreamingConfig              `json:"audit_log_streaming,omitempty" typescript:",notnull"`
 	CLIUpgradeMessage                       serpent.String                       `json:"cli_upgrade_message,omitempty" typescript:",notnull"`
 	TermsOfServiceURL                       serpent.String                       `json:"terms_of_service_url,omitempty" typescript:",notnull"`
 	Notifications                           NotificationsConfig                  `json:"notifications,omitempty" typescript:",notnull"`
@@ -1056,6 +1058,110 @@ type OIDCConfig struct {
 	RedirectAllowedHosts serpent.StringArray `json:"redirect_allowed_hosts" typescript:",notnull"`
 }
 
+// OIDCProviderConfig configures an additional, named OpenID Connect
+// provider. Users can sign in through any configured provider, and each
+// provider carries its own client, claim mapping and IdP sync settings.
+type OIDCProviderConfig struct {
+	// ID is a unique identifier for the provider. It is used in the
+	// callback URL: /api/v2/users/oidc/{id}/callback.
+	ID           string `json:"id" yaml:"id"`
+	ClientID     string `json:"client_id" yaml:"client_id"`
+	ClientSecret string `json:"-" yaml:"client_secret"`
+	// ClientKeyFile & ClientCertFile are used in place of ClientSecret for PKI auth.
+	ClientKeyFile             string                 `json:"client_key_file" yaml:"client_key_file"`
+	ClientCertFile            string                 `json:"client_cert_file" yaml:"client_cert_file"`
+	IssuerURL                 string                 `json:"issuer_url" yaml:"issuer_url"`
+	Scopes                    []string               `json:"scopes" yaml:"scopes"`
+	EmailDomain               []string               `json:"email_domain" yaml:"email_domain"`
+	AllowSignups              bool                   `json:"allow_signups" yaml:"allow_signups"`
+	IgnoreEmailVerified       bool                   `json:"ignore_email_verified" yaml:"ignore_email_verified"`
+	UsernameField             string                 `json:"username_field" yaml:"username_field"`
+	NameField                 string                 `json:"name_field" yaml:"name_field"`
+	EmailField                string                 `json:"email_field" yaml:"email_field"`
+	AuthURLParams             map[string]string      `json:"auth_url_params" yaml:"auth_url_params"`
+	IgnoreUserInfo            bool                   `json:"ignore_user_info" yaml:"ignore_user_info"`
+	UserInfoFromAccessToken   bool                   `json:"source_user_info_from_access_token" yaml:"source_user_info_from_access_token"`
+	SkipIssuerChecks          bool                   `json:"skip_issuer_checks" yaml:"skip_issuer_checks"`
+	SignInText                string                 `json:"sign_in_text" yaml:"sign_in_text"`
+	IconURL                   string                 `json:"icon_url" yaml:"icon_url"`
+	SignupsDisabledText       string                 `json:"signups_disabled_text" yaml:"signups_disabled_text"`
+	EmailFallback             bool                   `json:"email_fallback" yaml:"email_fallback"`
+	OrganizationField         string                 `json:"organization_field" yaml:"organization_field"`
+	OrganizationMapping       map[string][]uuid.UUID `json:"organization_mapping" yaml:"organization_mapping"`
+	OrganizationAssignDefault bool                   `json:"organization_assign_default" yaml:"organization_assign_default"`
+	GroupAutoCreate           bool                   `json:"group_auto_create" yaml:"group_auto_create"`
+	GroupRegexFilter          string                 `json:"group_regex_filter" yaml:"group_regex_filter"`
+	GroupAllowList            []string               `json:"group_allow_list" yaml:"group_allow_list"`
+	GroupField                string                 `json:"groups_field" yaml:"groups_field"`
+	GroupMapping              map[string]string      `json:"group_mapping" yaml:"group_mapping"`
+	UserRoleField             string                 `json:"user_role_field" yaml:"user_role_field"`
+	UserRoleMapping           map[string][]string    `json:"user_role_mapping" yaml:"user_role_mapping"`
+	UserRolesDefault          []string               `json:"user_roles_default" yaml:"user_roles_default"`
+}
+
+// OIDCConfig converts the provider into the option set used by the
+// deployment's primary OIDC provider, so both can share the same setup
+// and IdP sync code paths. Unset claim fields and scopes get the same
+// defaults as the primary provider.
+func (c OIDCProviderConfig) OIDCConfig() (OIDCConfig, error) {
+	cfg := OIDCConfig{
+		AllowSignups:              serpent.Bool(c.AllowSignups),
+		ClientID:                  serpent.String(c.ClientID),
+		ClientSecret:              serpent.String(c.ClientSecret),
+		ClientKeyFile:             serpent.String(c.ClientKeyFile),
+		ClientCertFile:            serpent.String(c.ClientCertFile),
+		EmailDomain:               serpent.StringArray(c.EmailDomain),
+		IssuerURL:                 serpent.String(c.IssuerURL),
+		Scopes:                    serpent.StringArray(c.Scopes),
+		IgnoreEmailVerified:       serpent.Bool(c.IgnoreEmailVerified),
+		UsernameField:             serpent.String(c.UsernameField),
+		NameField:                 serpent.String(c.NameField),
+		EmailField:                serpent.String(c.EmailField),
+		AuthURLParams:             serpent.Struct[map[string]string]{Value: c.AuthURLParams},
+		IgnoreUserInfo:            serpent.Bool(c.IgnoreUserInfo),
+		UserInfoFromAccessToken:   serpent.Bool(c.UserInfoFromAccessToken),
+		OrganizationField:         serpent.String(c.OrganizationField),
+		OrganizationMapping:       serpent.Struct[map[string][]uuid.UUID]{Value: c.OrganizationMapping},
+		OrganizationAssignDefault: serpent.Bool(c.OrganizationAssignDefault),
+		GroupAutoCreate:           serpent.Bool(c.GroupAutoCreate),
+		GroupAllowList:            serpent.StringArray(c.GroupAllowList),
+		GroupField:                serpent.String(c.GroupField),
+		GroupMapping:              serpent.Struct[map[string]string]{Value: c.GroupMapping},
+		UserRoleField:             serpent.String(c.UserRoleField),
+		UserRoleMapping:           serpent.Struct[map[string][]string]{Value: c.UserRoleMapping},
+		UserRolesDefault:          serpent.StringArray(c.UserRolesDefault),
+		SignInText:                serpent.String(c.SignInText),
+		SignupsDisabledText:       serpent.String(c.SignupsDisabledText),
+		SkipIssuerChecks:          serpent.Bool(c.SkipIssuerChecks),
+		EmailFallback:             serpent.Bool(c.EmailFallback),
+	}
+	if len(cfg.Scopes) == 0 {
+		cfg.Scopes = []string{oidc.ScopeOpenID, "profile", "email"}
+	}
+	if cfg.UsernameField == "" {
+		cfg.UsernameField = "preferred_username"
+	}
+	if cfg.NameField == "" {
+		cfg.NameField = "name"
+	}
+	if cfg.EmailField == "" {
+		cfg.EmailField = "email"
+	}
+	groupRegexFilter := c.GroupRegexFilter
+	if groupRegexFilter == "" {
+		groupRegexFilter = ".*"
+	}
+	if err := cfg.GroupRegexFilter.Set(groupRegexFilter); err != nil {
+		return OIDCConfig{}, xerrors.Errorf("parse group regex filter: %w", err)
+	}
+	if c.IconURL != "" {
+		if err := cfg.IconURL.Set(c.IconURL); err != nil {
+			return OIDCConfig{}, xerrors.Errorf("parse icon url: %w", err)
+		}
+	}
+	return cfg, nil
+}
+
 type TelemetryConfig struct {
 	Enable serpent.Bool `json:"enable" typescript:",notnull"`
 	Trace  serpent.Bool `json:"trace" typescript:",notnull"`
@@ -1276,6 +1382,32 @@ type HealthcheckConfig struct {
 	ThresholdDatabase serpent.Duration `json:"threshold_database" typescript:",notnull"`
 }
 
+// AuditLogStreamingConfig configures backends that receive each audit log as
+// it is recorded. A backend is enabled by setting its address, URL or path.
+// Each backend has its own filter, a list of "key:value" terms where terms
+// sharing a key are ORed and different keys are ANDed. Supported keys are
+// "resource_type" and "action".
+type AuditLogStreamingConfig struct {
+	// SyslogAddress is the host:port of an RFC 5424 syslog receiver.
+	SyslogAddress    serpent.String      `json:"syslog_address" typescript:",notnull"`
+	SyslogTLS        serpent.Bool        `json:"syslog_tls" typescript:",notnull"`
+	SyslogTLSCAFile  serpent.String      `json:"syslog_tls_ca_file" typescript:",notnull"`
+	SyslogFacility   serpent.Int64       `json:"syslog_facility" typescript:",notnull"`
+	SyslogFilter     serpent.StringArray `json:"syslog_filter" typescript:",notnull"`
+	WebhookURL       serpent.URL         `json:"webhook_url" typescript:",notnull"`
+	WebhookSecret    serpent.String      `json:"webhook_secret" typescript:",notnull"`
+	WebhookBatchSize serpent.Int64       `json:"webhook_batch_size" typescript:",notnull"`
+	// WebhookFlushInterval is how long audit logs wait for a batch to fill.
+	WebhookFlushInterval serpent.Duration    `json:"webhook_flush_interval" typescript:",notnull"`
+	WebhookMaxRetries    serpent.Int64       `json:"webhook_max_retries" typescript:",notnull"`
+	WebhookFilter        serpent.StringArray `json:"webhook_filter" typescript:",notnull"`
+	// FilePath is the JSONL file audit logs are appended to.
+	FilePath       serpent.String      `json:"file_path" typescript:",notnull"`
+	FileMaxSizeMB  serpent.Int64       `json:"file_max_size_mb" typescript:",notnull"`
+	FileMaxBackups serpent.Int64       `json:"file_max_backups" typescript:",notnull"`
+	FileFilter     serpent.StringArray `json:"file_filter" typescript:",notnull"`
+}
+
 // RetentionConfig contains configuration for data retention policies.
 // These settings control how long various types of data are retained in the database
 // before being automatically purged. Setting a value to 0 disables retention for that
@@ -1302,6 +1434,10 @@ type RetentionConfig struct {
 	// deletion (keep indefinitely). Adjust to match your
 	// organization's regulatory requirements.
 	BoundaryLogs serpent.Duration `json:"boundary_logs" typescript:",notnull"`
+	// ArchiveURL is a local directory or S3 URL that audit logs, connection
+	// logs, workspace agent logs and AI Bridge records are archived to
+	// before they are purged. Unset to disable archiving.
+	ArchiveURL serpent.String `json:"archive_url" typescript:",notnull"`
 }
 
 type NotificationsConfig struct {
@@ -1340,13 +1476,19 @@ type NotificationsConfig struct {
 	SMTP NotificationsEmailConfig `json:"email" typescript:",notnull"`
 	// Webhook settings.
 	Webhook NotificationsWebhookConfig `json:"webhook" typescript:",notnull"`
+	// Slack settings.
+	Slack NotificationsSlackConfig `json:"slack" typescript:",notnull"`
+	// Microsoft Teams settings.
+	Teams NotificationsTeamsConfig `json:"msteams" typescript:",notnull"`
 	// Inbox settings.
 	Inbox NotificationsInboxConfig `json:"inbox" typescript:",notnull"`
 }
 
 // Are either of the notification methods enabled?
 func (n *NotificationsConfig) Enabled() bool {
-	return n.SMTP.Smarthost != "" || n.Webhook.Endpoint != serpent.URL{}
+	return n.SMTP.Smarthost != "" || n.Webhook.Endpoint != serpent.URL{} ||
+		n.Slack.BotToken != "" || n.Slack.WebhookURL != serpent.URL{} ||
+		n.Teams.WebhookURL != serpent.URL{}
 }
 
 type NotificationsInboxConfig struct {
@@ -1408,6 +1550,22 @@ type NotificationsWebhookConfig struct {
 	Endpoint serpent.URL `json:"endpoint" typescript:",notnull"`
 }
 
+type NotificationsSlackConfig struct {
+	// The bot token used to send direct messages via chat.postMessage.
+	BotToken serpent.String `json:"bot_token" typescript:",notnull"`
+	// The incoming webhook to post to when a user's Slack ID is unknown.
+	WebhookURL serpent.URL `json:"webhook_url" typescript:",notnull"`
+	// The claim from a user's login which holds their Slack user ID.
+	UserIDClaim serpent.String `json:"user_id_claim" typescript:",notnull"`
+}
+
+type NotificationsTeamsConfig struct {
+	// The incoming webhook or Workflows URL to which Adaptive Cards are posted.
+	WebhookURL serpent.URL `json:"webhook_url" typescript:",notnull"`
+	// The claim from a user's login which identifies them in Microsoft Teams.
+	UserIDClaim serpent.String `json:"user_id_claim" typescript:",notnull"`
+}
+
 type PrebuildsConfig struct {
 	// ReconciliationInterval defines how often the workspace prebuilds state should be reconciled.
 	ReconciliationInterval serpent.Duration `json:"reconciliation_interval" typescript:",notnull"`
@@ -1678,6 +1836,16 @@ communicating directly.`,
 			Parent: &deploymentGroupNotifications,
 			YAML:   "webhook",
 		}
+		deploymentGroupNotificationsSlack = serpent.Group{
+			Name:   "Slack",
+			Parent: &deploymentGroupNotifications,
+			YAML:   "slack",
+		}
+		deploymentGroupNotificationsTeams = serpent.Group{
+			Name:   "Microsoft Teams",
+			Parent: &deploymentGroupNotifications,
+			YAML:   "msteams",
+		}
 		deploymentGroupPrebuilds = serpent.Group{
 			Name:        "Workspace Prebuilds",
 			YAML:        "workspace_prebuilds",
@@ -1714,6 +1882,11 @@ communicating directly.`,
 			Description: "Configure data retention policies for various database tables. Retention policies automatically purge old data to reduce database size and improve performance. Setting a retention duration to 0 disables automatic purging for that data type.",
 			YAML:        "retention",
 		}
+		deploymentGroupAuditLogStreaming = serpent.Group{
+			Name:        "Audit Log Streaming",
+			Description: "Stream audit logs to external systems as they are recorded. Each backend is enabled by setting its address, URL or path, and has its own filter of \"key:value\" terms. Terms sharing a key are ORed and different keys are ANDed. Supported keys are \"resource_type\" and \"action\".",
+			YAML:        "auditLogStreaming",
+		}
 		deploymentGroupTemplateBuilder = serpent.Group{
 			Name: "Template Builder",
 			YAML: "templateBuilder",
@@ -3156,6 +3329,16 @@ communicating directly.`,
 			// Niche feature for multi-domain deployments. Surface only to operators who need it.
 			Hidden: true,
 		},
+		{
+			// Env handling is done in cli.ReadOIDCProvidersFromEnv
+			Name:        "OIDC Providers",
+			Description: "Additional named OIDC providers, each shown as its own login button.",
+			Flag:        "oidc-providers",
+			YAML:        "providers",
+			Value:       &c.OIDCProviders,
+			Group:       &deploymentGroupOIDC,
+			Hidden:      true,
+		},
 		// Telemetry settings
 		telemetryEnable,
 		{
@@ -4003,7 +4186,7 @@ Write out the current server config as YAML to stdout.`,
 		// Notifications Options
 		{
 			Name:        "Notifications: Method",
-			Description: "Which delivery method to use (available options: 'smtp', 'webhook').",
+			Description: "Which delivery method to use (available options: 'smtp', 'webhook', 'slack', 'msteams').",
 			Flag:        "notifications-method",
 			Env:         "CODER_NOTIFICATIONS_METHOD",
 			Value:       &c.Notifications.Method,
@@ -4171,6 +4354,53 @@ Write out the current server config as YAML to stdout.`,
 			Group:       &deploymentGroupNotificationsWebhook,
 			YAML:        "endpoint",
 		},
+		{
+			Name:        "Notifications: Slack: Bot Token",
+			Description: "The token of a Slack app with the chat:write scope, used to send notifications to users as direct messages.",
+			Flag:        "notifications-slack-bot-token",
+			Env:         "CODER_NOTIFICATIONS_SLACK_BOT_TOKEN",
+			Value:       &c.Notifications.Slack.BotToken,
+			Group:       &deploymentGroupNotificationsSlack,
+			Annotations: serpent.Annotations{}.Mark(annotationSecretKey, "true"),
+		},
+		{
+			Name:        "Notifications: Slack: Webhook URL",
+			Description: "A Slack incoming webhook URL. Notifications are posted here when no bot token is configured or the recipient's Slack user ID is unknown.",
+			Flag:        "notifications-slack-webhook-url",
+			Env:         "CODER_NOTIFICATIONS_SLACK_WEBHOOK_URL",
+			Value:       &c.Notifications.Slack.WebhookURL,
+			Group:       &deploymentGroupNotificationsSlack,
+			YAML:        "webhookURL",
+		},
+		{
+			Name:        "Notifications: Slack: User ID Claim",
+			Description: "The OIDC claim holding a user's Slack user ID. It is read from the claims stored when the user last logged in.",
+			Flag:        "notifications-slack-user-id-claim",
+			Env:         "CODER_NOTIFICATIONS_SLACK_USER_ID_CLAIM",
+			Value:       &c.Notifications.Slack.UserIDClaim,
+			Default:     "https://slack.com/user_id",
+			Group:       &deploymentGroupNotificationsSlack,
+			YAML:        "userIDClaim",
+		},
+		{
+			Name:        "Notifications: Microsoft Teams: Webhook URL",
+			Description: "A Microsoft Teams incoming webhook or Workflows URL to which notifications are posted as Adaptive Cards.",
+			Flag:        "notifications-msteams-webhook-url",
+			Env:         "CODER_NOTIFICATIONS_MSTEAMS_WEBHOOK_URL",
+			Value:       &c.Notifications.Teams.WebhookURL,
+			Group:       &deploymentGroupNotificationsTeams,
+			YAML:        "webhookURL",
+		},
+		{
+			Name:        "Notifications: Microsoft Teams: User ID Claim",
+			Description: "The OIDC claim holding a user's Microsoft Entra ID object ID, used to @mention them. Their email address is used when the claim is absent.",
+			Flag:        "notifications-msteams-user-id-claim",
+			Env:         "CODER_NOTIFICATIONS_MSTEAMS_USER_ID_CLAIM",
+			Value:       &c.Notifications.Teams.UserIDClaim,
+			Default:     "oid",
+			Group:       &deploymentGroupNotificationsTeams,
+			YAML:        "userIDClaim",
+		},
 		{
 			Name:        "Notifications: Inbox: Enabled",
 			Description: "Enable Coder Inbox.",
@@ -4952,6 +5182,175 @@ Write out the current server config as YAML to stdout.`,
 			YAML:        "boundary_logs",
 			Annotations: serpent.Annotations{}.Mark(annotationFormatDuration, "true"),
 		},
+		{
+			Name:        "Retention Archive URL",
+			Description: "Where to archive audit logs, connection logs, workspace agent logs and AI Bridge records as compressed JSON Lines before they are purged. Either a local directory, or an S3 compatible bucket such as s3://bucket/prefix?endpoint=https://minio.example.com&region=us-east-1&use_path_style=true using the standard AWS credential environment variables. Records are not purged while archiving fails. Unset to disable archiving.",
+			Flag:        "retention-archive-url",
+			Env:         "CODER_RETENTION_ARCHIVE_URL",
+			Value:       &c.Retention.ArchiveURL,
+			Group:       &deploymentGroupRetention,
+			YAML:        "archive_url",
+		},
+		{
+			Name:        "Audit Syslog Address",
+			Description: "The host:port of an RFC 5424 syslog receiver to stream audit logs to over TCP. Unset to disable.",
+			Flag:        "audit-syslog-address",
+			Env:         "CODER_AUDIT_SYSLOG_ADDRESS",
+			Value:       &c.AuditLogStreaming.SyslogAddress,
+			Group:       &deploymentGroupAuditLogStreaming,
+			YAML:        "syslogAddress",
+			Annotations: serpent.Annotations{}.Mark(annotationEnterpriseKey, "true"),
+		},
+		{
+			Name:        "Audit Syslog TLS",
+			Description: "Connect to the syslog receiver over TLS.",
+			Flag:        "audit-syslog-tls",
+			Env:         "CODER_AUDIT_SYSLOG_TLS",
+			Value:       &c.AuditLogStreaming.SyslogTLS,
+			Default:     "false",
+			Group:       &deploymentGroupAuditLogStreaming,
+			YAML:        "syslogTLS",
+			Annotations: serpent.Annotations{}.Mark(annotationEnterpriseKey, "true"),
+		},
+		{
+			Name:        "Audit Syslog TLS CA File",
+			Description: "Path to a PEM-encoded CA certificate used to verify the syslog receiver. Defaults to the system certificate pool.",
+			Flag:        "audit-syslog-tls-ca-file",
+			Env:         "CODER_AUDIT_SYSLOG_TLS_CA_FILE",
+			Value:       &c.AuditLogStreaming.SyslogTLSCAFile,
+			Group:       &deploymentGroupAuditLogStreaming,
+			YAML:        "syslogTLSCAFile",
+			Annotations: serpent.Annotations{}.Mark(annotationEnterpriseKey, "true"),
+		},
+		{
+			Name:        "Audit Syslog Facility",
+			Description: "The syslog facility code audit logs are sent with. Defaults to local0.",
+			Flag:        "audit-syslog-facility",
+			Env:         "CODER_AUDIT_SYSLOG_FACILITY",
+			Value:       &c.AuditLogStreaming.SyslogFacility,
+			Default:     "16",
+			Group:       &deploymentGroupAuditLogStreaming,
+			YAML:        "syslogFacility",
+			Annotations: serpent.Annotations{}.Mark(annotationEnterpriseKey, "true"),
+		},
+		{
+			Name:        "Audit Syslog Filter",
+			Description: "Filter terms for audit logs sent to syslog, e.g. \"resource_type:workspace,action:delete\". Empty sends all audit logs.",
+			Flag:        "audit-syslog-filter",
+			Env:         "CODER_AUDIT_SYSLOG_FILTER",
+			Value:       &c.AuditLogStreaming.SyslogFilter,
+			Group:       &deploymentGroupAuditLogStreaming,
+			YAML:        "syslogFilter",
+			Annotations: serpent.Annotations{}.Mark(annotationEnterpriseKey, "true"),
+		},
+		{
+			Name:        "Audit Webhook URL",
+			Description: "An HTTPS endpoint that receives batches of audit logs as a JSON array in a POST body. Unset to disable.",
+			Flag:        "audit-webhook-url",
+			Env:         "CODER_AUDIT_WEBHOOK_URL",
+			Value:       &c.AuditLogStreaming.WebhookURL,
+			Group:       &deploymentGroupAuditLogStreaming,
+			YAML:        "webhookURL",
+			Annotations: serpent.Annotations{}.Mark(annotationEnterpriseKey, "true"),
+		},
+		{
+			Name:        "Audit Webhook Secret",
+			Description: "Secret used to sign webhook request bodies with HMAC-SHA256. The hex-encoded signature is sent in the X-Coder-Signature header, prefixed with \"sha256=\".",
+			Flag:        "audit-webhook-secret",
+			Env:         "CODER_AUDIT_WEBHOOK_SECRET",
+			Value:       &c.AuditLogStreaming.WebhookSecret,
+			Group:       &deploymentGroupAuditLogStreaming,
+			Annotations: serpent.Annotations{}.
+				Mark(annotationEnterpriseKey, "true").
+				Mark(annotationSecretKey, "true"),
+		},
+		{
+			Name:        "Audit Webhook Batch Size",
+			Description: "The maximum number of audit logs sent in a single webhook request.",
+			Flag:        "audit-webhook-batch-size",
+			Env:         "CODER_AUDIT_WEBHOOK_BATCH_SIZE",
+			Value:       &c.AuditLogStreaming.WebhookBatchSize,
+			Default:     "100",
+			Group:       &deploymentGroupAuditLogStreaming,
+			YAML:        "webhookBatchSize",
+			Annotations: serpent.Annotations{}.Mark(annotationEnterpriseKey, "true"),
+		},
+		{
+			Name:        "Audit Webhook Flush Interval",
+			Description: "How long audit logs wait for a webhook batch to fill before being sent.",
+			Flag:        "audit-webhook-flush-interval",
+			Env:         "CODER_AUDIT_WEBHOOK_FLUSH_INTERVAL",
+			Value:       &c.AuditLogStreaming.WebhookFlushInterval,
+			Default:     "5s",
+			Group:       &deploymentGroupAuditLogStreaming,
+			YAML:        "webhookFlushInterval",
+			Annotations: serpent.Annotations{}.
+				Mark(annotationEnterpriseKey, "true").
+				Mark(annotationFormatDuration, "true"),
+		},
+		{
+			Name:        "Audit Webhook Max Retries",
+			Description: "The number of times a failed webhook request is retried with exponential backoff before the batch is dropped. Set to 0 to disable retries.",
+			Flag:        "audit-webhook-max-retries",
+			Env:         "CODER_AUDIT_WEBHOOK_MAX_RETRIES",
+			Value:       &c.AuditLogStreaming.WebhookMaxRetries,
+			Default:     "5",
+			Group:       &deploymentGroupAuditLogStreaming,
+			YAML:        "webhookMaxRetries",
+			Annotations: serpent.Annotations{}.Mark(annotationEnterpriseKey, "true"),
+		},
+		{
+			Name:        "Audit Webhook Filter",
+			Description: "Filter terms for audit logs sent to the webhook, e.g. \"resource_type:workspace,action:delete\". Empty sends all audit logs.",
+			Flag:        "audit-webhook-filter",
+			Env:         "CODER_AUDIT_WEBHOOK_FILTER",
+			Value:       &c.AuditLogStreaming.WebhookFilter,
+			Group:       &deploymentGroupAuditLogStreaming,
+			YAML:        "webhookFilter",
+			Annotations: serpent.Annotations{}.Mark(annotationEnterpriseKey, "true"),
+		},
+		{
+			Name:        "Audit File Path",
+			Description: "Path to a file that audit logs are appended to, one JSON object per line. Unset to disable.",
+			Flag:        "audit-file-path",
+			Env:         "CODER_AUDIT_FILE_PATH",
+			Value:       &c.AuditLogStreaming.FilePath,
+			Group:       &deploymentGroupAuditLogStreaming,
+			YAML:        "filePath",
+			Annotations: serpent.Annotations{}.Mark(annotationEnterpriseKey, "true"),
+		},
+		{
+			Name:        "Audit File Max Size",
+			Description: "The size in megabytes at which the audit log file is rotated.",
+			Flag:        "audit-file-max-size",
+			Env:         "CODER_AUDIT_FILE_MAX_SIZE",
+			Value:       &c.AuditLogStreaming.FileMaxSizeMB,
+			Default:     "100",
+			Group:       &deploymentGroupAuditLogStreaming,
+			YAML:        "fileMaxSize",
+			Annotations: serpent.Annotations{}.Mark(annotationEnterpriseKey, "true"),
+		},
+		{
+			Name:        "Audit File Max Backups",
+			Description: "The number of rotated audit log files to keep. Set to 0 to keep all of them.",
+			Flag:        "audit-file-max-backups",
+			Env:         "CODER_AUDIT_FILE_MAX_BACKUPS",
+			Value:       &c.AuditLogStreaming.FileMaxBackups,
+			Default:     "10",
+			Group:       &deploymentGroupAuditLogStreaming,
+			YAML:        "fileMaxBackups",
+			Annotations: serpent.Annotations{}.Mark(annotationEnterpriseKey, "true"),
+		},
+		{
+			Name:        "Audit File Filter",
+			Description: "Filter terms for audit logs written to the file, e.g. \"resource_type:workspace,action:delete\". Empty writes all audit logs.",
+			Flag:        "audit-file-filter",
+			Env:         "CODER_AUDIT_FILE_FILTER",
+			Value:       &c.AuditLogStreaming.FileFilter,
+			Group:       &deploymentGroupAuditLogStreaming,
+			YAML:        "fileFilter",
+			Annotations: serpent.Annotations{}.Mark(annotationEnterpriseKey, "true"),
+		},
 		{
 			Name: "Enable Authorization Recordings",
 			Description: "All api requests will have a header including all authorization calls made during the request. " +
@@ -88,6 +88,12 @@ func TestDeploymentValues_HighlyConfigurable(t *testing.T) {
 		"Notifications: Email Auth: Password": {
 			yaml: true,
 		},
+		"Audit Webhook Secret": {
+			yaml: true,
+		},
+		"Notifications: Slack: Bot Token": {
+			yaml: true,
+		},
 		// We don't want these to be configurable via YAML because they are secrets.
 		// However, we do want to allow them to be shown in documentation.
 		"AI Gateway OpenAI Key": {
@@ -1351,6 +1357,26 @@ func TestNotificationsCanBeDisabled(t *testing.T) {
 			},
 			expectNotificationsEnabled: true,
 		},
+		{
+			name: "Slack_DeliveryMethodSet",
+			environment: []serpent.EnvVar{
+				{
+					Name:  "CODER_NOTIFICATIONS_SLACK_BOT_TOKEN",
+					Value: "xoxb-token",
+				},
+			},
+			expectNotificationsEnabled: true,
+		},
+		{
+			name: "Teams_DeliveryMethodSet",
+			environment: []serpent.EnvVar{
+				{
+					Name:  "CODER_NOTIFICATIONS_MSTEAMS_WEBHOOK_URL",
+					Value: "https://example.com/teams",
+				},
+			},
+			expectNotificationsEnabled: true,
+		},
 	}
 
 	for _, tt := range tests {
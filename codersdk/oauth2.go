@@ -19,6 +19,9 @@ type OAuth2ProviderApp struct {
 	Name        string    `json:"name"`
 	CallbackURL string    `json:"callback_url"`
 	Icon        string    `json:"icon"`
+	// ClientCredentialsUserID is the service account that tokens issued by
+	// the client_credentials grant act as. The grant is disabled when unset.
+	ClientCredentialsUserID *uuid.UUID `json:"client_credentials_user_id,omitempty" format:"uuid"`
 
 	// Endpoints are included in the app response for easier discovery. The OAuth2
 	// spec does not have a defined place to find these (for comparison, OIDC has
@@ -79,6 +82,9 @@ type PostOAuth2ProviderAppRequest struct {
 	Name        string `json:"name" validate:"required,oauth2_app_name"`
 	CallbackURL string `json:"callback_url" validate:"required,http_url"`
 	Icon        string `json:"icon" validate:"omitempty"`
+	// ClientCredentialsUserID enables the client_credentials grant, issuing
+	// tokens for the given service account.
+	ClientCredentialsUserID *uuid.UUID `json:"client_credentials_user_id,omitempty" format:"uuid"`
 }
 
 // PostOAuth2ProviderApp adds an application that can authenticate using Coder
@@ -100,6 +106,10 @@ type PutOAuth2ProviderAppRequest struct {
 	Name        string `json:"name" validate:"required,oauth2_app_name"`
 	CallbackURL string `json:"callback_url" validate:"required,http_url"`
 	Icon        string `json:"icon" validate:"omitempty"`
+	// ClientCredentialsUserID enables the client_credentials grant, issuing
+	// tokens for the given service account. Omitting it keeps the current
+	// service account, and the nil UUID disables the grant.
+	ClientCredentialsUserID *uuid.UUID `json:"client_credentials_user_id,omitempty" format:"uuid"`
 }
 
 // PutOAuth2ProviderApp updates an application that can authenticate using Coder
@@ -229,13 +239,14 @@ func (c *Client) PutOAuth2ProviderSettings(ctx context.Context, settings OAuth2P
 
 type OAuth2ProviderGrantType string
 
-// OAuth2ProviderGrantType values (RFC 6749).
+// OAuth2ProviderGrantType values (RFC 6749, RFC 8628).
 const (
 	OAuth2ProviderGrantTypeAuthorizationCode OAuth2ProviderGrantType = "authorization_code"
 	OAuth2ProviderGrantTypeRefreshToken      OAuth2ProviderGrantType = "refresh_token"
 	OAuth2ProviderGrantTypePassword          OAuth2ProviderGrantType = "password"
 	OAuth2ProviderGrantTypeClientCredentials OAuth2ProviderGrantType = "client_credentials"
 	OAuth2ProviderGrantTypeImplicit          OAuth2ProviderGrantType = "implicit"
+	OAuth2ProviderGrantTypeDeviceCode        OAuth2ProviderGrantType = "urn:ietf:params:oauth:grant-type:device_code"
 )
 
 func (e OAuth2ProviderGrantType) Valid() bool {
@@ -244,7 +255,8 @@ func (e OAuth2ProviderGrantType) Valid() bool {
 		OAuth2ProviderGrantTypeRefreshToken,
 		OAuth2ProviderGrantTypePassword,
 		OAuth2ProviderGrantTypeClientCredentials,
-		OAuth2ProviderGrantTypeImplicit:
+		OAuth2ProviderGrantTypeImplicit,
+		OAuth2ProviderGrantTypeDeviceCode:
 		return true
 	}
 	return false
@@ -373,7 +385,7 @@ func (h OAuth2RevocationTokenTypeHint) Valid() bool {
 
 type OAuth2ErrorCode string
 
-// OAuth2 error codes per RFC 6749, RFC 7009, RFC 8707.
+// OAuth2 error codes per RFC 6749, RFC 7009, RFC 8707, RFC 8628.
 // This is not comprehensive; it includes only codes relevant to this implementation.
 const (
 	// RFC 6749 - Token endpoint errors.
@@ -395,6 +407,11 @@ const (
 
 	// RFC 8707 - Resource indicator errors.
 	OAuth2ErrorCodeInvalidTarget OAuth2ErrorCode = "invalid_target"
+
+	// RFC 8628 - Device authorization grant errors.
+	OAuth2ErrorCodeAuthorizationPending OAuth2ErrorCode = "authorization_pending"
+	OAuth2ErrorCodeSlowDown             OAuth2ErrorCode = "slow_down"
+	OAuth2ErrorCodeExpiredToken         OAuth2ErrorCode = "expired_token"
 )
 
 func (c OAuth2ErrorCode) Valid() bool {
@@ -410,7 +427,10 @@ func (c OAuth2ErrorCode) Valid() bool {
 		OAuth2ErrorCodeServerError,
 		OAuth2ErrorCodeTemporarilyUnavailable,
 		OAuth2ErrorCodeUnsupportedTokenType,
-		OAuth2ErrorCodeInvalidTarget:
+		OAuth2ErrorCodeInvalidTarget,
+		OAuth2ErrorCodeAuthorizationPending,
+		OAuth2ErrorCodeSlowDown,
+		OAuth2ErrorCodeExpiredToken:
 		return true
 	}
 	return false
@@ -433,10 +453,31 @@ type OAuth2TokenRequest struct {
 	ClientSecret string                  `json:"client_secret,omitempty"`
 	CodeVerifier string                  `json:"code_verifier,omitempty"`
 	RefreshToken string                  `json:"refresh_token,omitempty"`
+	DeviceCode   string                  `json:"device_code,omitempty"`
 	Resource     string                  `json:"resource,omitempty"`
 	Scope        string                  `json:"scope,omitempty"`
 }
 
+// OAuth2DeviceAuthorizationRequest represents a device authorization request
+// per RFC 8628 §3.1. The actual wire format is
+// application/x-www-form-urlencoded; this struct is for SDK docs.
+type OAuth2DeviceAuthorizationRequest struct {
+	ClientID string `json:"client_id"`
+	Scope    string `json:"scope,omitempty"`
+	Resource string `json:"resource,omitempty"`
+}
+
+// OAuth2DeviceAuthorizationResponse represents a device authorization
+// response per RFC 8628 §3.2.
+type OAuth2DeviceAuthorizationResponse struct {
+	DeviceCode              string `json:"device_code"`
+	UserCode                string `json:"user_code"`
+	VerificationURI         string `json:"verification_uri"`
+	VerificationURIComplete string `json:"verification_uri_complete,omitempty"`
+	ExpiresIn               int64  `json:"expires_in"`
+	Interval                int64  `json:"interval,omitempty"`
+}
+
 // OAuth2TokenResponse represents a successful token response per RFC 6749.
 type OAuth2TokenResponse struct {
 	AccessToken  string          `json:"access_token"`
@@ -506,6 +547,7 @@ type OAuth2AuthorizationServerMetadata struct {
 	TokenEndpoint                     string                          `json:"token_endpoint"`
 	RegistrationEndpoint              string                          `json:"registration_endpoint,omitempty"`
 	RevocationEndpoint                string                          `json:"revocation_endpoint,omitempty"`
+	DeviceAuthorizationEndpoint       string                          `json:"device_authorization_endpoint,omitempty"`
 	ResponseTypesSupported            []OAuth2ProviderResponseType    `json:"response_types_supported"`
 	GrantTypesSupported               []OAuth2ProviderGrantType       `json:"grant_types_supported,omitempty"`
 	CodeChallengeMethodsSupported     []OAuth2PKCECodeChallengeMethod `json:"code_challenge_methods_supported,omitempty"`
@@ -221,7 +221,7 @@ func validateGrantTypes(grantTypes []OAuth2ProviderGrantType) error {
 
 func isSupportedGrantType(grant OAuth2ProviderGrantType) bool {
 	switch grant {
-	case OAuth2ProviderGrantTypeAuthorizationCode, OAuth2ProviderGrantTypeRefreshToken:
+	case OAuth2ProviderGrantTypeAuthorizationCode, OAuth2ProviderGrantTypeRefreshToken, OAuth2ProviderGrantTypeDeviceCode:
 		return true
 	}
 	return false
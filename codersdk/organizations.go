@@ -485,6 +485,40 @@ func (c *Client) OrganizationProvisionerJob(ctx context.Context, organizationID,
 	return job, ReadBodyAsJSON(res, &job)
 }
 
+func (c *Client) OrganizationProvisionerJobPriorities(ctx context.Context, organizationID uuid.UUID) ([]ProvisionerJobPriority, error) {
+	res, err := c.Request(ctx, http.MethodGet,
+		fmt.Sprintf("/api/v2/organizations/%s/provisionerjobs/priorities", organizationID.String()),
+		nil,
+	)
+	if err != nil {
+		return nil, xerrors.Errorf("make request: %w", err)
+	}
+	defer res.Body.Close()
+
+	if res.StatusCode != http.StatusOK {
+		return nil, ReadBodyAsError(res)
+	}
+	var priorities []ProvisionerJobPriority
+	return priorities, ReadBodyAsJSON(res, &priorities)
+}
+
+func (c *Client) UpdateOrganizationProvisionerJobPriority(ctx context.Context, organizationID uuid.UUID, req UpdateProvisionerJobPriorityRequest) (ProvisionerJobPriority, error) {
+	res, err := c.Request(ctx, http.MethodPut,
+		fmt.Sprintf("/api/v2/organizations/%s/provisionerjobs/priorities", organizationID.String()),
+		req,
+	)
+	if err != nil {
+		return ProvisionerJobPriority{}, xerrors.Errorf("make request: %w", err)
+	}
+	defer res.Body.Close()
+
+	if res.StatusCode != http.StatusOK {
+		return ProvisionerJobPriority{}, ReadBodyAsError(res)
+	}
+	var priority ProvisionerJobPriority
+	return priority, ReadBodyAsJSON(res, &priority)
+}
+
 func joinSlice[T ~string](s []T) string {
 	var ss []string
 	for _, v := range s {
@@ -159,6 +159,7 @@ const (
 	ProvisionerJobTypeTemplateVersionImport ProvisionerJobType = "template_version_import"
 	ProvisionerJobTypeWorkspaceBuild        ProvisionerJobType = "workspace_build"
 	ProvisionerJobTypeTemplateVersionDryRun ProvisionerJobType = "template_version_dry_run"
+	ProvisionerJobTypeWorkspaceDriftCheck   ProvisionerJobType = "workspace_drift_check"
 )
 
 // JobErrorCode defines the error code returned by job runner.
@@ -204,6 +205,7 @@ type ProvisionerJob struct {
 	Tags             map[string]string      `json:"tags" table:"tags"`
 	QueuePosition    int                    `json:"queue_position" table:"queue position"`
 	QueueSize        int                    `json:"queue_size" table:"queue size"`
+	Priority         int32                  `json:"priority" table:"priority"`
 	OrganizationID   uuid.UUID              `json:"organization_id" format:"uuid" table:"organization id"`
 	InitiatorID      uuid.UUID              `json:"initiator_id" format:"uuid" table:"initiator id"`
 	Input            ProvisionerJobInput    `json:"input" table:"input,recursive_inline"`
@@ -213,6 +215,41 @@ type ProvisionerJob struct {
 	LogsOverflowed   bool                   `json:"logs_overflowed" table:"logs overflowed"`
 }
 
+// ProvisionerJobPriorityAutomated is the priority class of builds that no one
+// is waiting for, such as autostart, autostop and prebuilds.
+const ProvisionerJobPriorityAutomated int32 = 0
+
+// ProvisionerJobPriorityTemplateImport is the priority class of template
+// version imports.
+const ProvisionerJobPriorityTemplateImport int32 = 1000
+
+// ProvisionerJobPriorityInteractive is the priority class of builds and
+// dry-runs started by a user.
+const ProvisionerJobPriorityInteractive int32 = 2000
+
+// MaxProvisionerJobPriorityBoost limits organization and template priority
+// boosts. Both boosts added together are smaller than the distance between
+// two priority classes, so boosts only reorder jobs within a class.
+const MaxProvisionerJobPriorityBoost int32 = 100
+
+// ProvisionerJobPriority is a priority boost added to the priority class of
+// provisioner jobs in an organization, or of a single template.
+type ProvisionerJobPriority struct {
+	OrganizationID uuid.UUID `json:"organization_id" format:"uuid" table:"organization id"`
+	// TemplateID is empty if the boost applies to the whole organization.
+	TemplateID *uuid.UUID `json:"template_id,omitempty" format:"uuid" table:"template id"`
+	Priority   int32      `json:"priority" table:"priority"`
+	UpdatedAt  time.Time  `json:"updated_at" format:"date-time" table:"updated at"`
+}
+
+// UpdateProvisionerJobPriorityRequest sets the priority boost of an
+// organization, or of a template if TemplateID is set. A priority of zero
+// removes the boost.
+type UpdateProvisionerJobPriorityRequest struct {
+	TemplateID *uuid.UUID `json:"template_id,omitempty" format:"uuid"`
+	Priority   int32      `json:"priority" validate:"min=-100,max=100"`
+}
+
 // ProvisionerJobLog represents the provisioner log entry annotated with source and level.
 type ProvisionerJobLog struct {
 	ID        int64     `json:"id"`
@@ -0,0 +1,243 @@
+package codersdk
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// ScheduleExceptionDateFormat is the format of the dates in schedule
+// exceptions.
+const ScheduleExceptionDateFormat = time.DateOnly
+
+// ScheduleExceptionDateRange is a range of dates on which workspaces do not
+// autostart. Dates are formatted as YYYY-MM-DD and are interpreted in the
+// timezone of each workspace's autostart schedule.
+type ScheduleExceptionDateRange struct {
+	StartDate string `json:"start_date"`
+	// EndDate is inclusive. It defaults to StartDate.
+	EndDate string `json:"end_date,omitempty"`
+	Summary string `json:"summary,omitempty"`
+}
+
+// ScheduleExceptionCalendar is an organization's list of dates on which
+// workspaces of the templates it is attached to do not autostart, such as
+// public holidays.
+type ScheduleExceptionCalendar struct {
+	ID             uuid.UUID `json:"id" format:"uuid"`
+	OrganizationID uuid.UUID `json:"organization_id" format:"uuid"`
+	Name           string    `json:"name"`
+	Description    string    `json:"description"`
+	// Dates is only included when fetching a single calendar.
+	Dates     []ScheduleExceptionDateRange `json:"dates,omitempty"`
+	CreatedAt time.Time                    `json:"created_at" format:"date-time"`
+	UpdatedAt time.Time                    `json:"updated_at" format:"date-time"`
+}
+
+// CreateScheduleExceptionCalendarRequest creates an exception calendar from
+// date ranges, events in an iCalendar file, or both.
+type CreateScheduleExceptionCalendarRequest struct {
+	Name        string                       `json:"name" validate:"required"`
+	Description string                       `json:"description,omitempty"`
+	Dates       []ScheduleExceptionDateRange `json:"dates,omitempty"`
+	// ICal is the contents of an iCalendar (.ics) file. All-day events and
+	// yearly recurring events are supported.
+	ICal string `json:"ical,omitempty"`
+}
+
+// UpdateScheduleExceptionCalendarRequest replaces the name, description and
+// dates of an exception calendar.
+type UpdateScheduleExceptionCalendarRequest struct {
+	Name        string                       `json:"name" validate:"required"`
+	Description string                       `json:"description,omitempty"`
+	Dates       []ScheduleExceptionDateRange `json:"dates,omitempty"`
+	ICal        string                       `json:"ical,omitempty"`
+}
+
+// UpdateTemplateScheduleExceptionCalendarsRequest replaces the exception
+// calendars attached to a template.
+type UpdateTemplateScheduleExceptionCalendarsRequest struct {
+	CalendarIDs []uuid.UUID `json:"calendar_ids" format:"uuid"`
+}
+
+// OutOfOfficeRange is a range of dates on which none of a user's workspaces
+// autostart.
+type OutOfOfficeRange struct {
+	ID        uuid.UUID `json:"id" format:"uuid"`
+	UserID    uuid.UUID `json:"user_id" format:"uuid"`
+	StartDate string    `json:"start_date"`
+	EndDate   string    `json:"end_date"`
+	Reason    string    `json:"reason"`
+	CreatedAt time.Time `json:"created_at" format:"date-time"`
+}
+
+type CreateOutOfOfficeRangeRequest struct {
+	StartDate string `json:"start_date" validate:"required"`
+	// EndDate is inclusive. It defaults to StartDate.
+	EndDate string `json:"end_date,omitempty"`
+	Reason  string `json:"reason,omitempty"`
+}
+
+// WorkspaceAutostartException is a range of dates on which a workspace does
+// not autostart.
+type WorkspaceAutostartException struct {
+	StartDate string `json:"start_date"`
+	EndDate   string `json:"end_date"`
+	// Source is the name of the exception calendar, or "out-of-office" for
+	// the workspace owner's own ranges.
+	Source  string `json:"source"`
+	Summary string `json:"summary"`
+}
+
+type WorkspaceAutostartExceptions struct {
+	// Exceptions that have not ended, soonest first.
+	Exceptions []WorkspaceAutostartException `json:"exceptions"`
+	// NextSkippedAutostart is the next scheduled autostart within a year that
+	// will be skipped because it falls on an exception date.
+	NextSkippedAutostart *time.Time `json:"next_skipped_autostart,omitempty" format:"date-time"`
+}
+
+func (c *Client) ScheduleExceptionCalendars(ctx context.Context, organizationID uuid.UUID) ([]ScheduleExceptionCalendar, error) {
+	res, err := c.Request(ctx, http.MethodGet, fmt.Sprintf("/api/v2/organizations/%s/schedule-exception-calendars", organizationID), nil)
+	if err != nil {
+		return nil, err
+	}
+	defer res.Body.Close()
+	if res.StatusCode != http.StatusOK {
+		return nil, ReadBodyAsError(res)
+	}
+	var calendars []ScheduleExceptionCalendar
+	return calendars, json.NewDecoder(res.Body).Decode(&calendars)
+}
+
+func (c *Client) CreateScheduleExceptionCalendar(ctx context.Context, organizationID uuid.UUID, req CreateScheduleExceptionCalendarRequest) (ScheduleExceptionCalendar, error) {
+	res, err := c.Request(ctx, http.MethodPost, fmt.Sprintf("/api/v2/organizations/%s/schedule-exception-calendars", organizationID), req)
+	if err != nil {
+		return ScheduleExceptionCalendar{}, err
+	}
+	defer res.Body.Close()
+	if res.StatusCode != http.StatusCreated {
+		return ScheduleExceptionCalendar{}, ReadBodyAsError(res)
+	}
+	var calendar ScheduleExceptionCalendar
+	return calendar, json.NewDecoder(res.Body).Decode(&calendar)
+}
+
+func (c *Client) ScheduleExceptionCalendar(ctx context.Context, organizationID, calendarID uuid.UUID) (ScheduleExceptionCalendar, error) {
+	res, err := c.Request(ctx, http.MethodGet, fmt.Sprintf("/api/v2/organizations/%s/schedule-exception-calendars/%s", organizationID, calendarID), nil)
+	if err != nil {
+		return ScheduleExceptionCalendar{}, err
+	}
+	defer res.Body.Close()
+	if res.StatusCode != http.StatusOK {
+		return ScheduleExceptionCalendar{}, ReadBodyAsError(res)
+	}
+	var calendar ScheduleExceptionCalendar
+	return calendar, json.NewDecoder(res.Body).Decode(&calendar)
+}
+
+func (c *Client) UpdateScheduleExceptionCalendar(ctx context.Context, organizationID, calendarID uuid.UUID, req UpdateScheduleExceptionCalendarRequest) (ScheduleExceptionCalendar, error) {
+	res, err := c.Request(ctx, http.MethodPut, fmt.Sprintf("/api/v2/organizations/%s/schedule-exception-calendars/%s", organizationID, calendarID), req)
+	if err != nil {
+		return ScheduleExceptionCalendar{}, err
+	}
+	defer res.Body.Close()
+	if res.StatusCode != http.StatusOK {
+		return ScheduleExceptionCalendar{}, ReadBodyAsError(res)
+	}
+	var calendar ScheduleExceptionCalendar
+	return calendar, json.NewDecoder(res.Body).Decode(&calendar)
+}
+
+func (c *Client) DeleteScheduleExceptionCalendar(ctx context.Context, organizationID, calendarID uuid.UUID) error {
+	res, err := c.Request(ctx, http.MethodDelete, fmt.Sprintf("/api/v2/organizations/%s/schedule-exception-calendars/%s", organizationID, calendarID), nil)
+	if err != nil {
+		return err
+	}
+	defer res.Body.Close()
+	if res.StatusCode != http.StatusNoContent {
+		return ReadBodyAsError(res)
+	}
+	return nil
+}
+
+func (c *Client) TemplateScheduleExceptionCalendars(ctx context.Context, templateID uuid.UUID) ([]ScheduleExceptionCalendar, error) {
+	res, err := c.Request(ctx, http.MethodGet, fmt.Sprintf("/api/v2/templates/%s/schedule-exception-calendars", templateID), nil)
+	if err != nil {
+		return nil, err
+	}
+	defer res.Body.Close()
+	if res.StatusCode != http.StatusOK {
+		return nil, ReadBodyAsError(res)
+	}
+	var calendars []ScheduleExceptionCalendar
+	return calendars, json.NewDecoder(res.Body).Decode(&calendars)
+}
+
+func (c *Client) UpdateTemplateScheduleExceptionCalendars(ctx context.Context, templateID uuid.UUID, req UpdateTemplateScheduleExceptionCalendarsRequest) ([]ScheduleExceptionCalendar, error) {
+	res, err := c.Request(ctx, http.MethodPut, fmt.Sprintf("/api/v2/templates/%s/schedule-exception-calendars", templateID), req)
+	if err != nil {
+		return nil, err
+	}
+	defer res.Body.Close()
+	if res.StatusCode != http.StatusOK {
+		return nil, ReadBodyAsError(res)
+	}
+	var calendars []ScheduleExceptionCalendar
+	return calendars, json.NewDecoder(res.Body).Decode(&calendars)
+}
+
+func (c *Client) OutOfOfficeRanges(ctx context.Context, user string) ([]OutOfOfficeRange, error) {
+	res, err := c.Request(ctx, http.MethodGet, fmt.Sprintf("/api/v2/users/%s/out-of-office", user), nil)
+	if err != nil {
+		return nil, err
+	}
+	defer res.Body.Close()
+	if res.StatusCode != http.StatusOK {
+		return nil, ReadBodyAsError(res)
+	}
+	var ranges []OutOfOfficeRange
+	return ranges, json.NewDecoder(res.Body).Decode(&ranges)
+}
+
+func (c *Client) CreateOutOfOfficeRange(ctx context.Context, user string, req CreateOutOfOfficeRangeRequest) (OutOfOfficeRange, error) {
+	res, err := c.Request(ctx, http.MethodPost, fmt.Sprintf("/api/v2/users/%s/out-of-office", user), req)
+	if err != nil {
+		return OutOfOfficeRange{}, err
+	}
+	defer res.Body.Close()
+	if res.StatusCode != http.StatusCreated {
+		return OutOfOfficeRange{}, ReadBodyAsError(res)
+	}
+	var ooo OutOfOfficeRange
+	return ooo, json.NewDecoder(res.Body).Decode(&ooo)
+}
+
+func (c *Client) DeleteOutOfOfficeRange(ctx context.Context, user string, id uuid.UUID) error {
+	res, err := c.Request(ctx, http.MethodDelete, fmt.Sprintf("/api/v2/users/%s/out-of-office/%s", user, id), nil)
+	if err != nil {
+		return err
+	}
+	defer res.Body.Close()
+	if res.StatusCode != http.StatusNoContent {
+		return ReadBodyAsError(res)
+	}
+	return nil
+}
+
+func (c *Client) WorkspaceAutostartExceptions(ctx context.Context, workspaceID uuid.UUID) (WorkspaceAutostartExceptions, error) {
+	res, err := c.Request(ctx, http.MethodGet, fmt.Sprintf("/api/v2/workspaces/%s/autostart-exceptions", workspaceID), nil)
+	if err != nil {
+		return WorkspaceAutostartExceptions{}, err
+	}
+	defer res.Body.Close()
+	if res.StatusCode != http.StatusOK {
+		return WorkspaceAutostartExceptions{}, ReadBodyAsError(res)
+	}
+	var exceptions WorkspaceAutostartExceptions
+	return exceptions, json.NewDecoder(res.Body).Decode(&exceptions)
+}
@@ -0,0 +1,186 @@
+package codersdk
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+type TemplateVersionRolloutStatus string
+
+const (
+	TemplateVersionRolloutStatusInProgress TemplateVersionRolloutStatus = "in_progress"
+	TemplateVersionRolloutStatusPaused     TemplateVersionRolloutStatus = "paused"
+	TemplateVersionRolloutStatusCompleted  TemplateVersionRolloutStatus = "completed"
+	TemplateVersionRolloutStatusRolledBack TemplateVersionRolloutStatus = "rolled_back"
+	TemplateVersionRolloutStatusCanceled   TemplateVersionRolloutStatus = "canceled"
+)
+
+// Active returns whether the rollout still applies to workspaces, i.e. it is
+// in progress or paused.
+func (s TemplateVersionRolloutStatus) Active() bool {
+	return s == TemplateVersionRolloutStatusInProgress || s == TemplateVersionRolloutStatusPaused
+}
+
+// TemplateVersionRolloutFailureAction is what happens to a rollout when the
+// failure ratio of its builds or agents exceeds its threshold.
+type TemplateVersionRolloutFailureAction string
+
+const (
+	// TemplateVersionRolloutFailureActionPause stops the rollout from reaching
+	// more workspaces. Workspaces that were already updated stay on the
+	// rollout version.
+	TemplateVersionRolloutFailureActionPause TemplateVersionRolloutFailureAction = "pause"
+	// TemplateVersionRolloutFailureActionRollback ends the rollout. Workspaces
+	// that were updated are moved back to the active version on their next
+	// start.
+	TemplateVersionRolloutFailureActionRollback TemplateVersionRolloutFailureAction = "rollback"
+)
+
+// TemplateVersionRollout is a staged rollout of a template version to a subset
+// of the workspaces that follow the active version of the template. The active
+// version of the template does not change until the rollout version is
+// promoted.
+type TemplateVersionRollout struct {
+	ID                        uuid.UUID `json:"id" format:"uuid"`
+	TemplateID                uuid.UUID `json:"template_id" format:"uuid"`
+	TemplateVersionID         uuid.UUID `json:"template_version_id" format:"uuid"`
+	TemplateVersionName       string    `json:"template_version_name"`
+	PreviousTemplateVersionID uuid.UUID `json:"previous_template_version_id" format:"uuid"`
+	// Percentage is the percentage of workspaces that are updated to the
+	// rollout version.
+	Percentage int32 `json:"percentage"`
+	// GroupIDs are the groups whose members' workspaces are updated to the
+	// rollout version, regardless of the percentage.
+	GroupIDs []uuid.UUID `json:"group_ids" format:"uuid"`
+	// FailureThreshold is the ratio of failed builds or agents, between 0 and
+	// 1, above which the failure action is taken.
+	FailureThreshold float64 `json:"failure_threshold"`
+	// MinSamples is the minimum number of finished builds or agents before
+	// the failure ratio is evaluated.
+	MinSamples    int32                               `json:"min_samples"`
+	FailureAction TemplateVersionRolloutFailureAction `json:"failure_action" enums:"pause,rollback"`
+	Status        TemplateVersionRolloutStatus        `json:"status" enums:"in_progress,paused,completed,rolled_back,canceled"`
+	// StatusMessage explains why the rollout was paused or rolled back
+	// automatically.
+	StatusMessage string                         `json:"status_message"`
+	Progress      TemplateVersionRolloutProgress `json:"progress"`
+	CreatedBy     uuid.UUID                      `json:"created_by" format:"uuid"`
+	CreatedAt     time.Time                      `json:"created_at" format:"date-time"`
+	UpdatedAt     time.Time                      `json:"updated_at" format:"date-time"`
+	// StartedAt is the time since which builds and agents are counted
+	// towards the progress. It is reset when a paused rollout is resumed.
+	StartedAt  time.Time  `json:"started_at" format:"date-time"`
+	FinishedAt *time.Time `json:"finished_at,omitempty" format:"date-time"`
+}
+
+// TemplateVersionRolloutProgress is the outcome of the start builds and agents
+// of the rollout version since the rollout was started.
+type TemplateVersionRolloutProgress struct {
+	BuildsSucceeded int64 `json:"builds_succeeded"`
+	BuildsFailed    int64 `json:"builds_failed"`
+	AgentsReady     int64 `json:"agents_ready"`
+	AgentsFailed    int64 `json:"agents_failed"`
+}
+
+// CreateTemplateVersionRolloutRequest starts a rollout of a template version.
+// Omitted thresholds use their defaults.
+type CreateTemplateVersionRolloutRequest struct {
+	TemplateVersionID uuid.UUID   `json:"template_version_id" validate:"required" format:"uuid"`
+	Percentage        int32       `json:"percentage"`
+	GroupIDs          []uuid.UUID `json:"group_ids,omitempty" format:"uuid"`
+	// FailureThreshold defaults to 0.2.
+	FailureThreshold float64 `json:"failure_threshold,omitempty"`
+	// MinSamples defaults to 5.
+	MinSamples int32 `json:"min_samples,omitempty"`
+	// FailureAction defaults to pause.
+	FailureAction TemplateVersionRolloutFailureAction `json:"failure_action,omitempty" enums:"pause,rollback"`
+}
+
+// UpdateTemplateVersionRolloutRequest changes a rollout that is in progress or
+// paused. Setting the status pauses or resumes the rollout. Omitted fields are
+// left unchanged.
+type UpdateTemplateVersionRolloutRequest struct {
+	Percentage       *int32                               `json:"percentage,omitempty"`
+	GroupIDs         *[]uuid.UUID                         `json:"group_ids,omitempty" format:"uuid"`
+	FailureThreshold *float64                             `json:"failure_threshold,omitempty"`
+	MinSamples       *int32                               `json:"min_samples,omitempty"`
+	FailureAction    *TemplateVersionRolloutFailureAction `json:"failure_action,omitempty" enums:"pause,rollback"`
+	Status           *TemplateVersionRolloutStatus        `json:"status,omitempty" enums:"in_progress,paused"`
+}
+
+// CreateTemplateVersionRollout starts a rollout of a version of the template.
+// A template can only have one rollout that is in progress or paused.
+func (c *Client) CreateTemplateVersionRollout(ctx context.Context, template uuid.UUID, req CreateTemplateVersionRolloutRequest) (TemplateVersionRollout, error) {
+	res, err := c.Request(ctx, http.MethodPost, fmt.Sprintf("/api/v2/templates/%s/rollouts", template), req)
+	if err != nil {
+		return TemplateVersionRollout{}, err
+	}
+	defer res.Body.Close()
+	if res.StatusCode != http.StatusCreated {
+		return TemplateVersionRollout{}, ReadBodyAsError(res)
+	}
+	var rollout TemplateVersionRollout
+	return rollout, ReadBodyAsJSON(res, &rollout)
+}
+
+// TemplateVersionRollouts lists the rollouts of the template, newest first.
+func (c *Client) TemplateVersionRollouts(ctx context.Context, template uuid.UUID) ([]TemplateVersionRollout, error) {
+	res, err := c.Request(ctx, http.MethodGet, fmt.Sprintf("/api/v2/templates/%s/rollouts", template), nil)
+	if err != nil {
+		return nil, err
+	}
+	defer res.Body.Close()
+	if res.StatusCode != http.StatusOK {
+		return nil, ReadBodyAsError(res)
+	}
+	var rollouts []TemplateVersionRollout
+	return rollouts, ReadBodyAsJSON(res, &rollouts)
+}
+
+// TemplateVersionRollout returns a rollout of the template.
+func (c *Client) TemplateVersionRollout(ctx context.Context, template uuid.UUID, rollout uuid.UUID) (TemplateVersionRollout, error) {
+	res, err := c.Request(ctx, http.MethodGet, fmt.Sprintf("/api/v2/templates/%s/rollouts/%s", template, rollout), nil)
+	if err != nil {
+		return TemplateVersionRollout{}, err
+	}
+	defer res.Body.Close()
+	if res.StatusCode != http.StatusOK {
+		return TemplateVersionRollout{}, ReadBodyAsError(res)
+	}
+	var r TemplateVersionRollout
+	return r, ReadBodyAsJSON(res, &r)
+}
+
+// UpdateTemplateVersionRollout changes, pauses or resumes a rollout.
+func (c *Client) UpdateTemplateVersionRollout(ctx context.Context, template uuid.UUID, rollout uuid.UUID, req UpdateTemplateVersionRolloutRequest) (TemplateVersionRollout, error) {
+	res, err := c.Request(ctx, http.MethodPatch, fmt.Sprintf("/api/v2/templates/%s/rollouts/%s", template, rollout), req)
+	if err != nil {
+		return TemplateVersionRollout{}, err
+	}
+	defer res.Body.Close()
+	if res.StatusCode != http.StatusOK {
+		return TemplateVersionRollout{}, ReadBodyAsError(res)
+	}
+	var r TemplateVersionRollout
+	return r, ReadBodyAsJSON(res, &r)
+}
+
+// RollbackTemplateVersionRollout ends a rollout. Workspaces that were updated
+// to the rollout version are moved back to the active version on their next
+// start.
+func (c *Client) RollbackTemplateVersionRollout(ctx context.Context, template uuid.UUID, rollout uuid.UUID) (TemplateVersionRollout, error) {
+	res, err := c.Request(ctx, http.MethodPost, fmt.Sprintf("/api/v2/templates/%s/rollouts/%s/rollback", template, rollout), nil)
+	if err != nil {
+		return TemplateVersionRollout{}, err
+	}
+	defer res.Body.Close()
+	if res.StatusCode != http.StatusOK {
+		return TemplateVersionRollout{}, ReadBodyAsError(res)
+	}
+	var r TemplateVersionRollout
+	return r, ReadBodyAsJSON(res, &r)
+}
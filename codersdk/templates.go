@@ -73,6 +73,11 @@ type Template struct {
 	// DisableModuleCache disables the use of cached Terraform modules during
 	// provisioning.
 	DisableModuleCache bool `json:"disable_module_cache"`
+
+	// DriftCheckIntervalMillis is how often running workspaces are checked
+	// for resources that changed outside of Coder. Zero disables drift
+	// detection for the template.
+	DriftCheckIntervalMillis int64 `json:"drift_check_interval_ms"`
 }
 
 // WeekdaysToBitmap converts a list of weekdays to a bitmap in accordance with
@@ -286,6 +291,11 @@ type UpdateTemplateMeta struct {
 	// AgentsAllowed controls whether Coder Agents can create workspaces using
 	// this template. If omitted, the current value is preserved.
 	AgentsAllowed *bool `json:"agents_allowed,omitempty"`
+	// DriftCheckIntervalMillis allows optionally specifying how often running
+	// workspaces are checked for resources that changed outside of Coder.
+	// Set to 0 to disable drift detection. Omitting the field keeps the
+	// existing value.
+	DriftCheckIntervalMillis *int64 `json:"drift_check_interval_ms,omitempty"`
 }
 
 type TemplateExample struct {
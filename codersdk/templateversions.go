@@ -96,6 +96,101 @@ type TemplateVersionVariable struct {
 	Sensitive    bool   `json:"sensitive"`
 }
 
+// TemplateVersionDiffChange describes how an item differs between two
+// template versions.
+type TemplateVersionDiffChange string
+
+const (
+	TemplateVersionDiffChangeAdded   TemplateVersionDiffChange = "added"
+	TemplateVersionDiffChangeRemoved TemplateVersionDiffChange = "removed"
+	TemplateVersionDiffChangeChanged TemplateVersionDiffChange = "changed"
+)
+
+// TemplateVersionDiff describes what changes for users when a template moves
+// from the base version to the compared version. Items that are identical in
+// both versions are omitted.
+type TemplateVersionDiff struct {
+	BaseTemplateVersionID uuid.UUID                         `json:"base_template_version_id" format:"uuid"`
+	TemplateVersionID     uuid.UUID                         `json:"template_version_id" format:"uuid"`
+	Files                 []TemplateVersionFileDiff         `json:"files"`
+	RichParameters        []TemplateVersionParameterDiff    `json:"rich_parameters"`
+	Variables             []TemplateVersionVariableDiff     `json:"variables"`
+	Presets               []TemplateVersionPresetDiff       `json:"presets"`
+	WorkspaceTags         []TemplateVersionWorkspaceTagDiff `json:"workspace_tags"`
+	ExternalAuth          []TemplateVersionExternalAuthDiff `json:"external_auth"`
+}
+
+// Empty returns true if the two versions are identical.
+func (d TemplateVersionDiff) Empty() bool {
+	return len(d.Files) == 0 &&
+		len(d.RichParameters) == 0 &&
+		len(d.Variables) == 0 &&
+		len(d.Presets) == 0 &&
+		len(d.WorkspaceTags) == 0 &&
+		len(d.ExternalAuth) == 0
+}
+
+// TemplateVersionFileDiff is a file of the template archive that differs
+// between two template versions.
+type TemplateVersionFileDiff struct {
+	Path   string                    `json:"path"`
+	Change TemplateVersionDiffChange `json:"change" enums:"added,removed,changed"`
+	// Binary is true if either version of the file is not valid UTF-8 text.
+	// No diff is computed for binary files.
+	Binary bool `json:"binary"`
+	// Diff is the unified diff of the file contents.
+	Diff string `json:"diff,omitempty"`
+}
+
+// TemplateVersionParameterDiff is a rich parameter that differs between two
+// template versions. Old is nil for added parameters, New is nil for removed
+// parameters.
+type TemplateVersionParameterDiff struct {
+	Name   string                    `json:"name"`
+	Change TemplateVersionDiffChange `json:"change" enums:"added,removed,changed"`
+	Old    *TemplateVersionParameter `json:"old,omitempty"`
+	New    *TemplateVersionParameter `json:"new,omitempty"`
+}
+
+// TemplateVersionVariableDiff is a template variable that differs between two
+// template versions. Values of sensitive variables are redacted.
+type TemplateVersionVariableDiff struct {
+	Name   string                    `json:"name"`
+	Change TemplateVersionDiffChange `json:"change" enums:"added,removed,changed"`
+	Old    *TemplateVersionVariable  `json:"old,omitempty"`
+	New    *TemplateVersionVariable  `json:"new,omitempty"`
+}
+
+// TemplateVersionPresetDiff is a preset that differs between two template
+// versions. Presets are matched by name.
+type TemplateVersionPresetDiff struct {
+	Name   string                    `json:"name"`
+	Change TemplateVersionDiffChange `json:"change" enums:"added,removed,changed"`
+	Old    *Preset                   `json:"old,omitempty"`
+	New    *Preset                   `json:"new,omitempty"`
+}
+
+// TemplateVersionWorkspaceTagDiff is a workspace tag that differs between two
+// template versions.
+type TemplateVersionWorkspaceTagDiff struct {
+	Key      string                    `json:"key"`
+	Change   TemplateVersionDiffChange `json:"change" enums:"added,removed,changed"`
+	OldValue string                    `json:"old_value,omitempty"`
+	NewValue string                    `json:"new_value,omitempty"`
+}
+
+// TemplateVersionExternalAuthDiff is an external auth provider whose
+// requirement differs between two template versions.
+type TemplateVersionExternalAuthDiff struct {
+	ID     string                    `json:"id"`
+	Change TemplateVersionDiffChange `json:"change" enums:"added,removed,changed"`
+	// OldOptional and NewOptional report whether the provider is optional in
+	// the base and compared version. They are nil for the version that does
+	// not require the provider.
+	OldOptional *bool `json:"old_optional,omitempty"`
+	NewOptional *bool `json:"new_optional,omitempty"`
+}
+
 type PatchTemplateVersionRequest struct {
 	Name    string  `json:"name" validate:"omitempty,template_version_name"`
 	Message *string `json:"message,omitempty" validate:"omitempty,lt=1048577"`
@@ -184,6 +279,26 @@ func (c *Client) TemplateVersionVariables(ctx context.Context, version uuid.UUID
 	return variables, ReadBodyAsJSON(res, &variables)
 }
 
+// TemplateVersionDiff compares a template version against a base version. If
+// base is uuid.Nil, the version is compared against the active version of its
+// template.
+func (c *Client) TemplateVersionDiff(ctx context.Context, version uuid.UUID, base uuid.UUID) (TemplateVersionDiff, error) {
+	path := fmt.Sprintf("/api/v2/templateversions/%s/diff", version)
+	if base != uuid.Nil {
+		path += "?base=" + base.String()
+	}
+	res, err := c.Request(ctx, http.MethodGet, path, nil)
+	if err != nil {
+		return TemplateVersionDiff{}, err
+	}
+	defer res.Body.Close()
+	if res.StatusCode != http.StatusOK {
+		return TemplateVersionDiff{}, ReadBodyAsError(res)
+	}
+	var diff TemplateVersionDiff
+	return diff, ReadBodyAsJSON(res, &diff)
+}
+
 // TemplateVersionLogsAfter streams logs for a template version that occurred after a specific log ID.
 func (c *Client) TemplateVersionLogsAfter(ctx context.Context, version uuid.UUID, after int64) (<-chan ProvisionerJobLog, io.Closer, error) {
 	return c.provisionerJobLogsAfter(ctx, fmt.Sprintf("/api/v2/templateversions/%s/logs", version), after)
@@ -452,6 +452,9 @@ type AuthMethods struct {
 	Password          AuthMethod       `json:"password"`
 	Github            GithubAuthMethod `json:"github"`
 	OIDC              OIDCAuthMethod   `json:"oidc"`
+	// OIDCProviders are the named OIDC providers. Each gets its own login
+	// button that starts at /api/v2/users/oidc/{id}/callback.
+	OIDCProviders []OIDCProviderAuthMethod `json:"oidc_providers,omitempty"`
 }
 
 type AuthMethod struct {
@@ -473,12 +476,21 @@ type OIDCAuthMethod struct {
 	IconURL    string `json:"iconUrl"`
 }
 
+type OIDCProviderAuthMethod struct {
+	ID         string `json:"id"`
+	SignInText string `json:"signInText"`
+	IconURL    string `json:"iconUrl"`
+}
+
 // OIDCClaimsResponse represents the merged OIDC claims for a user.
 type OIDCClaimsResponse struct {
 	// Claims are the merged claims from the OIDC provider. These
 	// are the union of the ID token claims and the userinfo claims,
 	// where userinfo claims take precedence on conflict.
 	Claims map[string]interface{} `json:"claims"`
+	// Provider is the ID of the named OIDC provider the user is linked
+	// to. It is empty for the deployment's primary OIDC provider.
+	Provider string `json:"provider,omitempty"`
 }
 
 type UserParameter struct {
@@ -0,0 +1,103 @@
+package codersdk
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+const (
+	// DefaultWorkspaceAgentPTYShareLifetime is used when a share is created
+	// without a lifetime.
+	DefaultWorkspaceAgentPTYShareLifetime = time.Hour
+	// MaxWorkspaceAgentPTYShareLifetime is the longest a share can be valid
+	// for.
+	MaxWorkspaceAgentPTYShareLifetime = 24 * time.Hour
+
+	// WorkspaceAgentPTYShareTokenQueryParameter is the query parameter used
+	// to pass a share token when watching a shared session.
+	WorkspaceAgentPTYShareTokenQueryParameter = "token"
+)
+
+// CreateWorkspaceAgentPTYShareRequest invites users to watch a reconnecting
+// PTY session. Viewers can see the session output but cannot send input.
+type CreateWorkspaceAgentPTYShareRequest struct {
+	ReconnectID uuid.UUID   `json:"reconnect_id" format:"uuid" validate:"required"`
+	UserIDs     []uuid.UUID `json:"user_ids" format:"uuid" validate:"required,min=1"`
+	// Lifetime is how long the share is valid for. Defaults to one hour.
+	Lifetime time.Duration `json:"lifetime,omitempty"`
+}
+
+type CreateWorkspaceAgentPTYShareResponse struct {
+	Share WorkspaceAgentPTYShare `json:"share"`
+	// Token is only returned once and must be passed to the invited users.
+	Token string `json:"token"`
+}
+
+type WorkspaceAgentPTYShare struct {
+	ID          uuid.UUID                      `json:"id" format:"uuid"`
+	AgentID     uuid.UUID                      `json:"agent_id" format:"uuid"`
+	ReconnectID uuid.UUID                      `json:"reconnect_id" format:"uuid"`
+	CreatedBy   uuid.UUID                      `json:"created_by" format:"uuid"`
+	UserIDs     []uuid.UUID                    `json:"user_ids" format:"uuid"`
+	CreatedAt   time.Time                      `json:"created_at" format:"date-time"`
+	ExpiresAt   time.Time                      `json:"expires_at" format:"date-time"`
+	RevokedAt   *time.Time                     `json:"revoked_at,omitempty" format:"date-time"`
+	Viewers     []WorkspaceAgentPTYShareViewer `json:"viewers"`
+}
+
+type WorkspaceAgentPTYShareViewer struct {
+	ID             uuid.UUID  `json:"id" format:"uuid"`
+	UserID         uuid.UUID  `json:"user_id" format:"uuid"`
+	Username       string     `json:"username"`
+	AvatarURL      string     `json:"avatar_url"`
+	IP             string     `json:"ip"`
+	ConnectedAt    time.Time  `json:"connected_at" format:"date-time"`
+	DisconnectedAt *time.Time `json:"disconnected_at,omitempty" format:"date-time"`
+}
+
+// Active returns whether the share can still be used to watch the session.
+func (s WorkspaceAgentPTYShare) Active(now time.Time) bool {
+	return s.RevokedAt == nil && now.Before(s.ExpiresAt)
+}
+
+func (c *Client) CreateWorkspaceAgentPTYShare(ctx context.Context, agentID uuid.UUID, req CreateWorkspaceAgentPTYShareRequest) (CreateWorkspaceAgentPTYShareResponse, error) {
+	var resp CreateWorkspaceAgentPTYShareResponse
+	res, err := c.Request(ctx, http.MethodPost, fmt.Sprintf("/api/v2/workspaceagents/%s/pty-shares", agentID), req)
+	if err != nil {
+		return resp, err
+	}
+	defer res.Body.Close()
+	if res.StatusCode != http.StatusCreated {
+		return resp, ReadBodyAsError(res)
+	}
+	return resp, ReadBodyAsJSON(res, &resp)
+}
+
+func (c *Client) WorkspaceAgentPTYShares(ctx context.Context, agentID uuid.UUID) ([]WorkspaceAgentPTYShare, error) {
+	res, err := c.Request(ctx, http.MethodGet, fmt.Sprintf("/api/v2/workspaceagents/%s/pty-shares", agentID), nil)
+	if err != nil {
+		return nil, err
+	}
+	defer res.Body.Close()
+	if res.StatusCode != http.StatusOK {
+		return nil, ReadBodyAsError(res)
+	}
+	var shares []WorkspaceAgentPTYShare
+	return shares, ReadBodyAsJSON(res, &shares)
+}
+
+func (c *Client) RevokeWorkspaceAgentPTYShare(ctx context.Context, agentID, shareID uuid.UUID) error {
+	res, err := c.Request(ctx, http.MethodDelete, fmt.Sprintf("/api/v2/workspaceagents/%s/pty-shares/%s", agentID, shareID), nil)
+	if err != nil {
+		return err
+	}
+	defer res.Body.Close()
+	if res.StatusCode != http.StatusNoContent {
+		return ReadBodyAsError(res)
+	}
+	return nil
+}
@@ -6,6 +6,7 @@ import (
 	"fmt"
 	"io"
 	"net/http"
+	"slices"
 	"strings"
 	"time"
 
@@ -467,6 +468,9 @@ type WorkspaceAgentDevcontainer struct {
 	Dirty     bool                             `json:"dirty"`
 	Container *WorkspaceAgentContainer         `json:"container,omitempty"`
 	Agent     *WorkspaceAgentDevcontainerAgent `json:"agent,omitempty"`
+	// Compose is set for devcontainers defined with a Docker Compose
+	// file, and describes the other services of the Compose project.
+	Compose *WorkspaceAgentDevcontainerCompose `json:"compose,omitempty"`
 
 	Error string `json:"error,omitempty"`
 }
@@ -482,6 +486,8 @@ func (d WorkspaceAgentDevcontainer) Equals(other WorkspaceAgentDevcontainer) boo
 			(d.Container != nil && other.Container != nil && d.Container.ID == other.Container.ID)) &&
 		(d.Agent == nil && other.Agent == nil ||
 			(d.Agent != nil && other.Agent != nil && *d.Agent == *other.Agent)) &&
+		(d.Compose == nil && other.Compose == nil ||
+			(d.Compose != nil && other.Compose != nil && d.Compose.Equals(*other.Compose))) &&
 		d.Error == other.Error
 }
 
@@ -491,6 +497,27 @@ func (d WorkspaceAgentDevcontainer) IsTerraformDefined() bool {
 	return d.SubagentID.Valid
 }
 
+// WorkspaceAgentDevcontainerCompose describes the Docker Compose project
+// of a devcontainer. The devcontainer container runs the primary
+// service, and the containers of the other services run alongside it.
+type WorkspaceAgentDevcontainerCompose struct {
+	// Project is the name of the Compose project.
+	Project string `json:"project"`
+	// Service is the name of the primary service, the one the
+	// devcontainer agent runs in.
+	Service string `json:"service"`
+	// Sidecars are the containers of the other services in the project.
+	Sidecars []WorkspaceAgentContainer `json:"sidecars"`
+}
+
+func (c WorkspaceAgentDevcontainerCompose) Equals(other WorkspaceAgentDevcontainerCompose) bool {
+	return c.Project == other.Project &&
+		c.Service == other.Service &&
+		slices.EqualFunc(c.Sidecars, other.Sidecars, func(a, b WorkspaceAgentContainer) bool {
+			return a.ID == b.ID && a.Running == b.Running && a.Health == b.Health
+		})
+}
+
 // WorkspaceAgentDevcontainerAgent represents the sub agent for a
 // devcontainer.
 type WorkspaceAgentDevcontainerAgent struct {
@@ -522,6 +549,10 @@ type WorkspaceAgentContainer struct {
 	// implementation-dependent, but should generally be a human-readable
 	// string.
 	Status string `json:"status"`
+	// Health is the status of the container healthcheck, e.g. "starting",
+	// "healthy" or "unhealthy". It is empty if the container has no
+	// healthcheck.
+	Health string `json:"health,omitempty"`
 	// Volumes is a map of "things" mounted into the container. Again, this
 	// is somewhat implementation-dependent.
 	Volumes map[string]string `json:"volumes"`
@@ -97,15 +97,47 @@ type WorkspaceApp struct {
 	Statuses []WorkspaceAppStatus `json:"statuses"`
 }
 
+// Healthcheck configures how the agent checks app health. Exactly one of URL,
+// Address or Command is set, and it selects the type of check.
 type Healthcheck struct {
 	// URL specifies the endpoint to check for the app health.
 	URL string `json:"url"`
+	// Address specifies a host:port that must accept TCP connections for
+	// the app to be healthy.
+	Address string `json:"address,omitempty"`
+	// Command specifies a command run in the workspace that must exit with
+	// status 0 for the app to be healthy.
+	Command string `json:"command,omitempty"`
 	// Interval specifies the seconds between each health check.
 	Interval int32 `json:"interval"`
 	// Threshold specifies the number of consecutive failed health checks before returning "unhealthy".
 	Threshold int32 `json:"threshold"`
 }
 
+// HealthcheckType is the type of check a Healthcheck performs.
+type HealthcheckType string
+
+const (
+	HealthcheckTypeHTTP HealthcheckType = "http"
+	HealthcheckTypeTCP  HealthcheckType = "tcp"
+	HealthcheckTypeExec HealthcheckType = "exec"
+)
+
+// Type returns the type of check the healthcheck performs, or an empty
+// string if no check is configured.
+func (h Healthcheck) Type() HealthcheckType {
+	switch {
+	case h.URL != "":
+		return HealthcheckTypeHTTP
+	case h.Address != "":
+		return HealthcheckTypeTCP
+	case h.Command != "":
+		return HealthcheckTypeExec
+	default:
+		return ""
+	}
+}
+
 type WorkspaceAppStatus struct {
 	ID          uuid.UUID               `json:"id" format:"uuid"`
 	CreatedAt   time.Time               `json:"created_at" format:"date-time"`
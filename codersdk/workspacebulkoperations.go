@@ -0,0 +1,226 @@
+package codersdk
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+const (
+	// DefaultWorkspaceBulkOperationConcurrency is the number of workspaces of
+	// a bulk operation that are processed at the same time when the request
+	// does not set a concurrency.
+	DefaultWorkspaceBulkOperationConcurrency = 5
+	// MaxWorkspaceBulkOperationConcurrency is the highest concurrency a bulk
+	// operation can be created with.
+	MaxWorkspaceBulkOperationConcurrency = 50
+)
+
+// WorkspaceBulkOperationAction is the change a bulk operation applies to each
+// of its workspaces.
+type WorkspaceBulkOperationAction string
+
+const (
+	WorkspaceBulkOperationActionStart  WorkspaceBulkOperationAction = "start"
+	WorkspaceBulkOperationActionStop   WorkspaceBulkOperationAction = "stop"
+	WorkspaceBulkOperationActionUpdate WorkspaceBulkOperationAction = "update"
+	WorkspaceBulkOperationActionDelete WorkspaceBulkOperationAction = "delete"
+	// WorkspaceBulkOperationActionAutostart sets the autostart schedule of the
+	// workspaces.
+	WorkspaceBulkOperationActionAutostart WorkspaceBulkOperationAction = "autostart"
+	// WorkspaceBulkOperationActionTTL sets the autostop TTL of the workspaces.
+	WorkspaceBulkOperationActionTTL WorkspaceBulkOperationAction = "ttl"
+	// WorkspaceBulkOperationActionAutomaticUpdates sets the automatic updates
+	// policy of the workspaces.
+	WorkspaceBulkOperationActionAutomaticUpdates WorkspaceBulkOperationAction = "automatic_updates"
+)
+
+// WorkspaceBulkOperationActions lists every action a bulk operation can apply.
+var WorkspaceBulkOperationActions = []WorkspaceBulkOperationAction{
+	WorkspaceBulkOperationActionStart,
+	WorkspaceBulkOperationActionStop,
+	WorkspaceBulkOperationActionUpdate,
+	WorkspaceBulkOperationActionDelete,
+	WorkspaceBulkOperationActionAutostart,
+	WorkspaceBulkOperationActionTTL,
+	WorkspaceBulkOperationActionAutomaticUpdates,
+}
+
+// Builds returns whether the action creates a workspace build.
+func (a WorkspaceBulkOperationAction) Builds() bool {
+	switch a {
+	case WorkspaceBulkOperationActionStart, WorkspaceBulkOperationActionStop,
+		WorkspaceBulkOperationActionUpdate, WorkspaceBulkOperationActionDelete:
+		return true
+	default:
+		return false
+	}
+}
+
+type WorkspaceBulkOperationStatus string
+
+const (
+	WorkspaceBulkOperationStatusRunning   WorkspaceBulkOperationStatus = "running"
+	WorkspaceBulkOperationStatusCompleted WorkspaceBulkOperationStatus = "completed"
+	WorkspaceBulkOperationStatusCanceled  WorkspaceBulkOperationStatus = "canceled"
+)
+
+type WorkspaceBulkOperationWorkspaceStatus string
+
+const (
+	WorkspaceBulkOperationWorkspaceStatusPending   WorkspaceBulkOperationWorkspaceStatus = "pending"
+	WorkspaceBulkOperationWorkspaceStatusRunning   WorkspaceBulkOperationWorkspaceStatus = "running"
+	WorkspaceBulkOperationWorkspaceStatusSucceeded WorkspaceBulkOperationWorkspaceStatus = "succeeded"
+	WorkspaceBulkOperationWorkspaceStatusFailed    WorkspaceBulkOperationWorkspaceStatus = "failed"
+	// WorkspaceBulkOperationWorkspaceStatusSkipped is used for workspaces that
+	// were already in the requested state, were deleted before they were
+	// processed, or were still pending when the operation was canceled.
+	WorkspaceBulkOperationWorkspaceStatusSkipped WorkspaceBulkOperationWorkspaceStatus = "skipped"
+)
+
+// CreateWorkspaceBulkOperationRequest applies an action to every workspace
+// that matches a search query. The query is evaluated once, when the
+// operation is created, with the permissions of the requester.
+type CreateWorkspaceBulkOperationRequest struct {
+	// Query uses the same syntax as the workspaces search query, e.g.
+	// `template:docker owner:alice`.
+	Query  string                       `json:"query" validate:"required"`
+	Action WorkspaceBulkOperationAction `json:"action" validate:"required" enums:"start,stop,update,delete,autostart,ttl,automatic_updates"`
+	// AutostartSchedule is used by the autostart action. It has the same
+	// form as UpdateWorkspaceAutostartRequest.Schedule. Empty disables
+	// autostart.
+	AutostartSchedule string `json:"autostart_schedule,omitempty"`
+	// TTLMillis is used by the ttl action. Zero disables autostop.
+	TTLMillis int64 `json:"ttl_ms,omitempty"`
+	// AutomaticUpdates is required by the automatic_updates action.
+	AutomaticUpdates AutomaticUpdates `json:"automatic_updates,omitempty" enums:"always,never"`
+	// Concurrency is the number of workspaces that are processed at the same
+	// time. Defaults to 5.
+	Concurrency int32 `json:"concurrency,omitempty"`
+}
+
+// WorkspaceBulkOperation is an action applied to every workspace that matched
+// a search query when the operation was created.
+type WorkspaceBulkOperation struct {
+	ID                uuid.UUID                      `json:"id" format:"uuid"`
+	InitiatorID       uuid.UUID                      `json:"initiator_id" format:"uuid"`
+	Action            WorkspaceBulkOperationAction   `json:"action" enums:"start,stop,update,delete,autostart,ttl,automatic_updates"`
+	Query             string                         `json:"query"`
+	AutostartSchedule string                         `json:"autostart_schedule,omitempty"`
+	TTLMillis         int64                          `json:"ttl_ms,omitempty"`
+	AutomaticUpdates  AutomaticUpdates               `json:"automatic_updates,omitempty" enums:"always,never"`
+	Concurrency       int32                          `json:"concurrency"`
+	Status            WorkspaceBulkOperationStatus   `json:"status" enums:"running,completed,canceled"`
+	Progress          WorkspaceBulkOperationProgress `json:"progress"`
+	CreatedAt         time.Time                      `json:"created_at" format:"date-time"`
+	UpdatedAt         time.Time                      `json:"updated_at" format:"date-time"`
+	CompletedAt       *time.Time                     `json:"completed_at,omitempty" format:"date-time"`
+}
+
+// WorkspaceBulkOperationProgress counts the workspaces of a bulk operation by
+// status.
+type WorkspaceBulkOperationProgress struct {
+	Total     int64 `json:"total"`
+	Pending   int64 `json:"pending"`
+	Running   int64 `json:"running"`
+	Succeeded int64 `json:"succeeded"`
+	Failed    int64 `json:"failed"`
+	Skipped   int64 `json:"skipped"`
+}
+
+// Done returns whether none of the workspaces are pending or running.
+func (p WorkspaceBulkOperationProgress) Done() bool {
+	return p.Pending == 0 && p.Running == 0
+}
+
+// WorkspaceBulkOperationWorkspace is the outcome of a bulk operation for one of
+// its workspaces.
+type WorkspaceBulkOperationWorkspace struct {
+	WorkspaceID        uuid.UUID                             `json:"workspace_id" format:"uuid"`
+	WorkspaceName      string                                `json:"workspace_name"`
+	WorkspaceOwnerName string                                `json:"workspace_owner_name"`
+	Status             WorkspaceBulkOperationWorkspaceStatus `json:"status" enums:"pending,running,succeeded,failed,skipped"`
+	// BuildID is the build created for the workspace by the start, stop,
+	// update and delete actions.
+	BuildID   *uuid.UUID `json:"build_id,omitempty" format:"uuid"`
+	Error     string     `json:"error,omitempty"`
+	UpdatedAt time.Time  `json:"updated_at" format:"date-time"`
+}
+
+// CreateWorkspaceBulkOperation applies an action to every workspace that
+// matches the query of the request. The workspaces are processed in the
+// background; poll the operation for its progress.
+func (c *Client) CreateWorkspaceBulkOperation(ctx context.Context, req CreateWorkspaceBulkOperationRequest) (WorkspaceBulkOperation, error) {
+	res, err := c.Request(ctx, http.MethodPost, "/api/v2/workspacebulkoperations", req)
+	if err != nil {
+		return WorkspaceBulkOperation{}, err
+	}
+	defer res.Body.Close()
+	if res.StatusCode != http.StatusCreated {
+		return WorkspaceBulkOperation{}, ReadBodyAsError(res)
+	}
+	var op WorkspaceBulkOperation
+	return op, ReadBodyAsJSON(res, &op)
+}
+
+// WorkspaceBulkOperations lists the most recent bulk operations created by the
+// authenticated user, newest first.
+func (c *Client) WorkspaceBulkOperations(ctx context.Context) ([]WorkspaceBulkOperation, error) {
+	res, err := c.Request(ctx, http.MethodGet, "/api/v2/workspacebulkoperations", nil)
+	if err != nil {
+		return nil, err
+	}
+	defer res.Body.Close()
+	if res.StatusCode != http.StatusOK {
+		return nil, ReadBodyAsError(res)
+	}
+	var ops []WorkspaceBulkOperation
+	return ops, ReadBodyAsJSON(res, &ops)
+}
+
+// WorkspaceBulkOperation returns a bulk operation and its progress.
+func (c *Client) WorkspaceBulkOperation(ctx context.Context, id uuid.UUID) (WorkspaceBulkOperation, error) {
+	res, err := c.Request(ctx, http.MethodGet, fmt.Sprintf("/api/v2/workspacebulkoperations/%s", id), nil)
+	if err != nil {
+		return WorkspaceBulkOperation{}, err
+	}
+	defer res.Body.Close()
+	if res.StatusCode != http.StatusOK {
+		return WorkspaceBulkOperation{}, ReadBodyAsError(res)
+	}
+	var op WorkspaceBulkOperation
+	return op, ReadBodyAsJSON(res, &op)
+}
+
+// WorkspaceBulkOperationWorkspaces returns the outcome of a bulk operation for
+// each of its workspaces.
+func (c *Client) WorkspaceBulkOperationWorkspaces(ctx context.Context, id uuid.UUID) ([]WorkspaceBulkOperationWorkspace, error) {
+	res, err := c.Request(ctx, http.MethodGet, fmt.Sprintf("/api/v2/workspacebulkoperations/%s/workspaces", id), nil)
+	if err != nil {
+		return nil, err
+	}
+	defer res.Body.Close()
+	if res.StatusCode != http.StatusOK {
+		return nil, ReadBodyAsError(res)
+	}
+	var workspaces []WorkspaceBulkOperationWorkspace
+	return workspaces, ReadBodyAsJSON(res, &workspaces)
+}
+
+// CancelWorkspaceBulkOperation stops a bulk operation from processing more
+// workspaces. Workspaces that are already being processed are left to finish.
+func (c *Client) CancelWorkspaceBulkOperation(ctx context.Context, id uuid.UUID) (WorkspaceBulkOperation, error) {
+	res, err := c.Request(ctx, http.MethodPost, fmt.Sprintf("/api/v2/workspacebulkoperations/%s/cancel", id), nil)
+	if err != nil {
+		return WorkspaceBulkOperation{}, err
+	}
+	defer res.Body.Close()
+	if res.StatusCode != http.StatusOK {
+		return WorkspaceBulkOperation{}, ReadBodyAsError(res)
+	}
+	var op WorkspaceBulkOperation
+	return op, ReadBodyAsJSON(res, &op)
+}
@@ -78,6 +78,12 @@ type Workspace struct {
 	// TaskID, if set, indicates that the workspace is relevant to the given codersdk.Task.
 	TaskID     uuid.NullUUID          `json:"task_id,omitempty"`
 	SharedWith []SharedWorkspaceActor `json:"shared_with,omitempty"`
+	// LatestDriftCheck is the most recent completed drift check of the
+	// workspace's latest build. It is nil if the build was never checked.
+	LatestDriftCheck *WorkspaceDriftCheck `json:"latest_drift_check,omitempty"`
+	// NextSkippedAutostart is the next scheduled autostart within a year that
+	// will be skipped because it falls on an exception date.
+	NextSkippedAutostart *time.Time `json:"next_skipped_autostart,omitempty" format:"date-time"`
 }
 
 func (w Workspace) FullName() string {
@@ -89,6 +95,29 @@ type WorkspaceHealth struct {
 	FailingAgents []uuid.UUID `json:"failing_agents" format:"uuid"` // FailingAgents lists the IDs of the agents that are failing, if any.
 }
 
+// WorkspaceDriftCheck is the result of checking the resources of a running
+// workspace for changes made outside of Coder.
+type WorkspaceDriftCheck struct {
+	ID               uuid.UUID                `json:"id" format:"uuid"`
+	WorkspaceBuildID uuid.UUID                `json:"workspace_build_id" format:"uuid"`
+	JobID            uuid.UUID                `json:"job_id" format:"uuid"`
+	CheckedAt        time.Time                `json:"checked_at" format:"date-time"`
+	Drifted          bool                     `json:"drifted"`
+	Resources        []WorkspaceResourceDrift `json:"resources"`
+}
+
+// WorkspaceResourceDrift describes a resource that changed outside of Coder.
+type WorkspaceResourceDrift struct {
+	Address string `json:"address"`
+	Type    string `json:"type"`
+	Name    string `json:"name"`
+	// Action is "update" for resources that were modified and "delete" for
+	// resources that no longer exist.
+	Action string `json:"action"`
+	// Attributes are the names of the top-level attributes that changed.
+	Attributes []string `json:"attributes,omitempty"`
+}
+
 type WorkspacesRequest struct {
 	SearchQuery string `json:"q,omitempty"`
 	Pagination
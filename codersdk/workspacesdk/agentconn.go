@@ -116,11 +116,17 @@ type AgentConn interface {
 	RecreateDevcontainer(ctx context.Context, devcontainerID string) (codersdk.Response, error)
 	SignalProcess(ctx context.Context, id string, signal string) error
 	StartProcess(ctx context.Context, req StartProcessRequest) (StartProcessResponse, error)
+	StartService(ctx context.Context, req StartServiceRequest) (ServiceInfo, error)
+	ListServices(ctx context.Context) (ListServicesResponse, error)
+	ServiceOutput(ctx context.Context, name string) (ServiceOutputResponse, error)
+	StopService(ctx context.Context, name string) error
 	LS(ctx context.Context, path string, req LSRequest) (LSResponse, error)
 	ResolvePath(ctx context.Context, path string) (string, error)
+	Find(ctx context.Context, req FindRequest) (FindResponse, error)
 	ReadFile(ctx context.Context, path string, offset, limit int64) (io.ReadCloser, string, error)
 	ReadFileLines(ctx context.Context, path string, offset, limit int64, limits ReadFileLinesLimits) (ReadFileLinesResponse, error)
 	WriteFile(ctx context.Context, path string, reader io.Reader) error
+	RemovePath(ctx context.Context, path string) error
 	EditFiles(ctx context.Context, edits FileEditRequest) (FileEditResponse, error)
 	BundleFiles(ctx context.Context, req BundleFilesRequest) ([]byte, error)
 	SSH(ctx context.Context) (*gonet.TCPConn, error)
@@ -130,10 +136,16 @@ type AgentConn interface {
 	Speedtest(ctx context.Context, direction speedtest.Direction, duration time.Duration) ([]speedtest.Result, error)
 	WatchContainers(ctx context.Context, logger slog.Logger) (<-chan codersdk.WorkspaceAgentListContainersResponse, io.Closer, error)
 	WatchGit(ctx context.Context, logger slog.Logger, chatID uuid.UUID) (*wsjson.Stream[codersdk.WorkspaceAgentGitServerMessage, codersdk.WorkspaceAgentGitClientMessage], error)
+	WatchFind(ctx context.Context, logger slog.Logger, req FindRequest) (<-chan FindResponse, io.Closer, error)
+	WatchSync(ctx context.Context, logger slog.Logger, root string) (<-chan SyncUpdate, io.Closer, error)
 	ConnectDesktopVNC(ctx context.Context) (net.Conn, error)
 	ExecuteDesktopAction(ctx context.Context, action DesktopAction) (DesktopActionResponse, error)
 	StartDesktopRecording(ctx context.Context, req StartDesktopRecordingRequest) error
 	StopDesktopRecording(ctx context.Context, req StopDesktopRecordingRequest) (StopDesktopRecordingResponse, error)
+	CreateImmortalStream(ctx context.Context, req CreateImmortalStreamRequest) (ImmortalStream, error)
+	ListImmortalStreams(ctx context.Context) ([]ImmortalStream, error)
+	DeleteImmortalStream(ctx context.Context, id uuid.UUID) error
+	ConnectImmortalStream(ctx context.Context, id uuid.UUID, readerSeqNum uint64) (net.Conn, uint64, error)
 }
 
 // AgentConn represents a connection to a workspace agent.
@@ -214,6 +226,9 @@ type AgentReconnectingPTYInit struct {
 	ContainerUser string
 
 	BackendType string
+	// ReadOnly attaches to an existing session without forwarding input or
+	// resizing the PTY. Read-only connections never start a new session.
+	ReadOnly bool
 }
 
 // AgentReconnectingPTYInitOption is a functional option for AgentReconnectingPTYInit.
@@ -227,6 +242,14 @@ func AgentReconnectingPTYInitWithContainer(container, containerUser string) Agen
 	}
 }
 
+// AgentReconnectingPTYInitWithReadOnly attaches to an existing reconnecting
+// PTY session as a viewer that cannot send input.
+func AgentReconnectingPTYInitWithReadOnly() AgentReconnectingPTYInitOption {
+	return func(init *AgentReconnectingPTYInit) {
+		init.ReadOnly = true
+	}
+}
+
 // ReconnectingPTYRequest is sent from the client to the server
 // to pipe data to a PTY.
 // @typescript-ignore ReconnectingPTYRequest
@@ -968,6 +991,119 @@ type SignalProcessRequest struct {
 	Signal string `json:"signal"`
 }
 
+// ServiceRestartPolicy controls whether a supervised service is
+// restarted after its process exits.
+type ServiceRestartPolicy string
+
+const (
+	// ServiceRestartAlways restarts the service whenever it exits.
+	ServiceRestartAlways ServiceRestartPolicy = "always"
+	// ServiceRestartOnFailure restarts the service when it exits
+	// with a non-zero code. This is the default.
+	ServiceRestartOnFailure ServiceRestartPolicy = "on-failure"
+	// ServiceRestartNever runs the service once.
+	ServiceRestartNever ServiceRestartPolicy = "never"
+)
+
+// ServiceState is the lifecycle state of a supervised service.
+type ServiceState string
+
+const (
+	// ServiceStateRunning means the service process is running.
+	ServiceStateRunning ServiceState = "running"
+	// ServiceStateBackoff means the service exited and is waiting
+	// to be restarted.
+	ServiceStateBackoff ServiceState = "backoff"
+	// ServiceStateExited means the service exited and its restart
+	// policy does not restart it.
+	ServiceStateExited ServiceState = "exited"
+	// ServiceStateFailed means the service kept exiting and reached
+	// its maximum number of restarts.
+	ServiceStateFailed ServiceState = "failed"
+	// ServiceStateStopped means the service was stopped.
+	ServiceStateStopped ServiceState = "stopped"
+)
+
+// StartServiceRequest is the request body for starting a supervised
+// service on the workspace agent. The agent restarts the service
+// according to its restart policy, backing off exponentially between
+// restarts.
+type StartServiceRequest struct {
+	// Name identifies the service on the agent. Starting a service
+	// with the name of one that is still active fails.
+	Name    string               `json:"name"`
+	Command string               `json:"command"`
+	WorkDir string               `json:"workdir,omitempty"`
+	Env     map[string]string    `json:"env,omitempty"`
+	Restart ServiceRestartPolicy `json:"restart,omitempty"`
+	// MaxRestarts is the number of consecutive restarts after which
+	// the service is marked as failed. Zero means no limit. A run
+	// that stays up for a minute resets the count.
+	MaxRestarts int `json:"max_restarts,omitempty"`
+	// LogRetention is the number of runs whose output is kept,
+	// including the current one. Defaults to 3.
+	LogRetention int `json:"log_retention,omitempty"`
+	// Readiness optionally probes the service while it runs.
+	Readiness *ServiceReadiness `json:"readiness,omitempty"`
+}
+
+// ServiceReadiness configures a readiness probe for a service.
+type ServiceReadiness struct {
+	// Healthcheck is probed every interval once the service starts.
+	// The service is ready after one successful check, and no longer
+	// ready after threshold consecutive failed checks.
+	Healthcheck codersdk.Healthcheck `json:"healthcheck"`
+	// AppSlug is the workspace app whose status reports the
+	// readiness of the service, if any.
+	AppSlug string `json:"app_slug,omitempty"`
+}
+
+// ServiceRestart records a restart of a service.
+type ServiceRestart struct {
+	RestartedAt int64 `json:"restarted_at_unix"`
+	// ExitCode is the exit code of the run that was restarted.
+	ExitCode int `json:"exit_code"`
+}
+
+// ServiceInfo describes a supervised service on the agent.
+type ServiceInfo struct {
+	Name    string               `json:"name"`
+	Command string               `json:"command"`
+	Restart ServiceRestartPolicy `json:"restart"`
+	State   ServiceState         `json:"state"`
+	// Ready is whether the readiness probe of the current run
+	// passes. It is always false for services without a probe.
+	Ready bool `json:"ready"`
+	// Restarts is the total number of times the service has been
+	// restarted.
+	Restarts int `json:"restarts"`
+	// RecentRestarts lists the most recent restarts, oldest first.
+	RecentRestarts []ServiceRestart `json:"recent_restarts"`
+	StartedAt      int64            `json:"started_at_unix"`
+	ExitCode       *int             `json:"exit_code,omitempty"`
+}
+
+// ListServicesResponse contains the supervised services on the
+// workspace agent.
+type ListServicesResponse struct {
+	Services []ServiceInfo `json:"services"`
+}
+
+// ServiceRun is the output of one run of a service.
+type ServiceRun struct {
+	StartedAt int64              `json:"started_at_unix"`
+	ExitedAt  *int64             `json:"exited_at_unix,omitempty"`
+	ExitCode  *int               `json:"exit_code,omitempty"`
+	Output    string             `json:"output"`
+	Truncated *ProcessTruncation `json:"truncated,omitempty"`
+}
+
+// ServiceOutputResponse contains the retained output of a service,
+// oldest run first.
+type ServiceOutputResponse struct {
+	Runs []ServiceRun `json:"runs"`
+}
+
 type LSRequest struct {
 	// e.g. [], ["repos", "coder"],
 	Path []string `json:"path"`
@@ -1024,6 +1160,96 @@ func (c *agentConn) LS(ctx context.Context, path string, req LSRequest) (LSRespo
 	return m, nil
 }
 
+// FindRequest is a fuzzy search for files and directories in the workspace.
+type FindRequest struct {
+	Query string `json:"query"`
+	// Root is the absolute path of the directory to search. The agent's
+	// working directory is used if empty.
+	Root string `json:"root"`
+	// Limit is the maximum number of results. The agent's default is used
+	// if zero.
+	Limit int `json:"limit"`
+}
+
+func (r FindRequest) values() neturl.Values {
+	v := neturl.Values{
+		"query": []string{r.Query},
+	}
+	if r.Root != "" {
+		v.Set("root", r.Root)
+	}
+	if r.Limit > 0 {
+		v.Set("limit", strconv.Itoa(r.Limit))
+	}
+	return v
+}
+
+type FindResponse struct {
+	// Root is the absolute path of the directory that was searched.
+	Root string `json:"root"`
+	// Results are ordered by score, best match first.
+	Results []FindResult `json:"results"`
+}
+
+type FindResult struct {
+	// Path is slash separated and relative to the root.
+	Path         string  `json:"path"`
+	AbsolutePath string  `json:"absolute_path"`
+	IsDir        bool    `json:"is_dir"`
+	Score        float32 `json:"score"`
+}
+
+// Find fuzzy searches the file index of the agent. The first search in a
+// root indexes it, which can take a while in large directories.
+func (c *agentConn) Find(ctx context.Context, req FindRequest) (FindResponse, error) {
+	ctx, span := tracing.StartSpan(ctx)
+	defer span.End()
+
+	res, err := c.apiRequest(ctx, http.MethodGet, agentAPIPath("/api/v0/find", req.values()), nil)
+	if err != nil {
+		return FindResponse{}, xerrors.Errorf("do request: %w", err)
+	}
+	defer res.Body.Close()
+	if res.StatusCode != http.StatusOK {
+		return FindResponse{}, codersdk.ReadBodyAsError(res)
+	}
+
+	var m FindResponse
+	if err := decodeAgentJSON(res, &m); err != nil {
+		return FindResponse{}, xerrors.Errorf("decode response body: %w", err)
+	}
+	return m, nil
+}
+
+// WatchFind runs a fuzzy search like Find, then runs it again and sends
+// the new results whenever the file index changes.
+func (c *agentConn) WatchFind(ctx context.Context, logger slog.Logger, req FindRequest) (<-chan FindResponse, io.Closer, error) {
+	ctx, span := tracing.StartSpan(ctx)
+	defer span.End()
+
+	host := net.JoinHostPort(c.agentAddress().String(), strconv.Itoa(AgentHTTPAPIServerPort))
+	url := fmt.Sprintf("http://%s%s", host, agentAPIPath("/api/v0/find/watch", req.values()))
+
+	conn, res, err := websocket.Dial(ctx, url, &websocket.DialOptions{
+		HTTPClient:      c.apiClient(ctx),
+		CompressionMode: websocket.CompressionNoContextTakeover,
+	})
+	if err != nil {
+		if res == nil {
+			return nil, nil, err
+		}
+		return nil, nil, codersdk.ReadBodyAsError(res)
+	}
+	if res != nil && res.Body != nil {
+		defer res.Body.Close()
+	}
+
+	conn.SetReadLimit(1 << 22) // 4MiB
+
+	d := wsjson.NewDecoder[FindResponse](conn, websocket.MessageText, logger)
+	return d.Chan(), d, nil
+}
+
 // ResolvePathResponse is the response from the agent's path-resolution endpoint.
 type ResolvePathResponse struct {
 	ResolvedPath string `json:"resolved_path"`
@@ -1134,6 +1360,84 @@ func (c *agentConn) WriteFile(ctx context.Context, path string, reader io.Reader
 	return nil
 }
 
+// RemovePath removes a file or a directory and everything in it. Removing a
+// path that does not exist is not an error.
+func (c *agentConn) RemovePath(ctx context.Context, path string) error {
+	ctx, span := tracing.StartSpan(ctx)
+	defer span.End()
+
+	res, err := c.apiRequest(ctx, http.MethodPost, agentAPIPath("/api/v0/remove-path", neturl.Values{
+		"path": []string{path},
+	}), nil)
+	if err != nil {
+		return xerrors.Errorf("do request: %w", err)
+	}
+	defer res.Body.Close()
+	if res.StatusCode != http.StatusOK {
+		return codersdk.ReadBodyAsError(res)
+	}
+
+	var m codersdk.Response
+	if err := decodeAgentJSON(res, &m); err != nil {
+		return xerrors.Errorf("decode response body: %w", err)
+	}
+	return nil
+}
+
+// SyncUpdate describes files below a directory that is being synced.
+type SyncUpdate struct {
+	// Root is the absolute path of the directory.
+	Root string `json:"root"`
+	// Initial is set on the first update, which lists every file below
+	// Root. Later updates only list files that changed.
+	Initial bool        `json:"initial"`
+	Entries []SyncEntry `json:"entries"`
+}
+
+// SyncEntry is the state of a regular file below a synced directory.
+type SyncEntry struct {
+	// Path is slash separated and relative to the root.
+	Path string `json:"path"`
+	// Deleted is set when the path no longer exists. If the path was a
+	// directory, everything in it was deleted too.
+	Deleted bool   `json:"deleted,omitempty"`
+	Size    int64  `json:"size,omitempty"`
+	SHA256  string `json:"sha256,omitempty"`
+}
+
+// WatchSync sends the files below root, and then sends the files that
+// change. A relative root is resolved against the agent's working
+// directory, and root is created if it does not exist.
+func (c *agentConn) WatchSync(ctx context.Context, logger slog.Logger, root string) (<-chan SyncUpdate, io.Closer, error) {
+	ctx, span := tracing.StartSpan(ctx)
+	defer span.End()
+
+	host := net.JoinHostPort(c.agentAddress().String(), strconv.Itoa(AgentHTTPAPIServerPort))
+	url := fmt.Sprintf("http://%s%s", host, agentAPIPath("/api/v0/sync/watch", neturl.Values{
+		"root": []string{root},
+	}))
+
+	conn, res, err := websocket.Dial(ctx, url, &websocket.DialOptions{
+		HTTPClient:      c.apiClient(ctx),
+		CompressionMode: websocket.CompressionNoContextTakeover,
+	})
+	if err != nil {
+		if res == nil {
+			return nil, nil, err
+		}
+		return nil, nil, codersdk.ReadBodyAsError(res)
+	}
+	if res != nil && res.Body != nil {
+		defer res.Body.Close()
+	}
+
+	// The initial update lists every file in the directory.
+	conn.SetReadLimit(1 << 26) // 64MiB
+
+	d := wsjson.NewDecoder[SyncUpdate](conn, websocket.MessageText, logger)
+	return d.Chan(), d, nil
+}
+
 // ReadFileLinesResponse is the response from the line-based file reader.
 type ReadFileLinesResponse struct {
 	Success    bool   `json:"success"`
@@ -1370,6 +1674,73 @@ func (c *agentConn) SignalProcess(ctx context.Context, id string, signal string)
 	return nil
 }
 
+// StartService starts a supervised service on the workspace agent.
+func (c *agentConn) StartService(ctx context.Context, req StartServiceRequest) (ServiceInfo, error) {
+	ctx, span := tracing.StartSpan(ctx)
+	defer span.End()
+	res, err := c.apiRequest(ctx, http.MethodPost, "/api/v0/processes/services", req)
+	if err != nil {
+		return ServiceInfo{}, xerrors.Errorf("do request: %w", err)
+	}
+	defer res.Body.Close()
+	if res.StatusCode != http.StatusOK {
+		return ServiceInfo{}, codersdk.ReadBodyAsError(res)
+	}
+	var resp ServiceInfo
+	return resp, decodeAgentJSON(res, &resp)
+}
+
+// ListServices returns the supervised services on the agent.
+func (c *agentConn) ListServices(ctx context.Context) (ListServicesResponse, error) {
+	ctx, span := tracing.StartSpan(ctx)
+	defer span.End()
+	res, err := c.apiRequest(ctx, http.MethodGet, "/api/v0/processes/services", nil)
+	if err != nil {
+		return ListServicesResponse{}, xerrors.Errorf("do request: %w", err)
+	}
+	defer res.Body.Close()
+	if res.StatusCode != http.StatusOK {
+		return ListServicesResponse{}, codersdk.ReadBodyAsError(res)
+	}
+	var resp ListServicesResponse
+	return resp, decodeAgentJSON(res, &resp)
+}
+
+// ServiceOutput returns the retained output of a supervised service.
+func (c *agentConn) ServiceOutput(ctx context.Context, name string) (ServiceOutputResponse, error) {
+	ctx, span := tracing.StartSpan(ctx)
+	defer span.End()
+	res, err := c.apiRequest(ctx, http.MethodGet, "/api/v0/processes/services/"+neturl.PathEscape(name)+"/output", nil)
+	if err != nil {
+		return ServiceOutputResponse{}, xerrors.Errorf("do request: %w", err)
+	}
+	defer res.Body.Close()
+	if res.StatusCode != http.StatusOK {
+		return ServiceOutputResponse{}, codersdk.ReadBodyAsError(res)
+	}
+	var resp ServiceOutputResponse
+	return resp, decodeAgentJSON(res, &resp)
+}
+
+// StopService stops a supervised service and its process.
+func (c *agentConn) StopService(ctx context.Context, name string) error {
+	ctx, span := tracing.StartSpan(ctx)
+	defer span.End()
+	res, err := c.apiRequest(ctx, http.MethodPost, "/api/v0/processes/services/"+neturl.PathEscape(name)+"/stop", nil)
+	if err != nil {
+		return xerrors.Errorf("do request: %w", err)
+	}
+	defer res.Body.Close()
+	if res.StatusCode != http.StatusOK {
+		return codersdk.ReadBodyAsError(res)
+	}
+	var m codersdk.Response
+	if err := decodeAgentJSON(res, &m); err != nil {
+		return xerrors.Errorf("decode response body: %w", err)
+	}
+	return nil
+}
+
 // EditFiles performs search and replace edits on one or more files.
 // When edits.IncludeDiff is true, the returned FileEditResponse
 // carries a unified diff per edited file.
@@ -1393,6 +1764,111 @@ func (c *agentConn) EditFiles(ctx context.Context, edits FileEditRequest) (FileE
 	return resp, nil
 }
 
+// CreateImmortalStream asks the agent to open a TCP connection to a port in
+// the workspace and hold it open as an immortal stream.
+func (c *agentConn) CreateImmortalStream(ctx context.Context, req CreateImmortalStreamRequest) (ImmortalStream, error) {
+	ctx, span := tracing.StartSpan(ctx)
+	defer span.End()
+	res, err := c.apiRequest(ctx, http.MethodPost, "/api/v0/immortal-streams", req)
+	if err != nil {
+		return ImmortalStream{}, xerrors.Errorf("do request: %w", err)
+	}
+	defer res.Body.Close()
+	if res.StatusCode != http.StatusCreated {
+		return ImmortalStream{}, codersdk.ReadBodyAsError(res)
+	}
+	var stream ImmortalStream
+	return stream, decodeAgentJSON(res, &stream)
+}
+
+// ListImmortalStreams returns the immortal streams held open by the agent.
+func (c *agentConn) ListImmortalStreams(ctx context.Context) ([]ImmortalStream, error) {
+	ctx, span := tracing.StartSpan(ctx)
+	defer span.End()
+	res, err := c.apiRequest(ctx, http.MethodGet, "/api/v0/immortal-streams", nil)
+	if err != nil {
+		return nil, xerrors.Errorf("do request: %w", err)
+	}
+	defer res.Body.Close()
+	if res.StatusCode != http.StatusOK {
+		return nil, codersdk.ReadBodyAsError(res)
+	}
+	var streams []ImmortalStream
+	return streams, decodeAgentJSON(res, &streams)
+}
+
+// DeleteImmortalStream closes an immortal stream and its connection to the
+// port in the workspace.
+func (c *agentConn) DeleteImmortalStream(ctx context.Context, id uuid.UUID) error {
+	ctx, span := tracing.StartSpan(ctx)
+	defer span.End()
+	res, err := c.apiRequest(ctx, http.MethodDelete, "/api/v0/immortal-streams/"+id.String(), nil)
+	if err != nil {
+		return xerrors.Errorf("do request: %w", err)
+	}
+	defer res.Body.Close()
+	if res.StatusCode != http.StatusNoContent {
+		return codersdk.ReadBodyAsError(res)
+	}
+	return nil
+}
+
+// ConnectImmortalStream attaches to an immortal stream over a WebSocket.
+// readerSeqNum is the number of bytes already received from the stream, from
+// which the agent replays. The agent's own reader sequence number is returned
+// so the caller can replay the bytes the agent has not received.
+//
+// ErrImmortalStreamClosed is returned once the stream has ended and every
+// byte it produced has been received.
+func (c *agentConn) ConnectImmortalStream(ctx context.Context, id uuid.UUID, readerSeqNum uint64) (net.Conn, uint64, error) {
+	ctx, span := tracing.StartSpan(ctx)
+	defer span.End()
+
+	host := net.JoinHostPort(c.agentAddress().String(), strconv.Itoa(AgentHTTPAPIServerPort))
+
+	dialOpts := &websocket.DialOptions{
+		HTTPClient:      c.apiClient(ctx),
+		CompressionMode: websocket.CompressionDisabled,
+		HTTPHeader:      http.Header{},
+	}
+	c.headersMu.RLock()
+	for key, values := range c.extraHeaders {
+		for _, value := range values {
+			dialOpts.HTTPHeader.Add(key, value)
+		}
+	}
+	c.headersMu.RUnlock()
+	dialOpts.HTTPHeader.Set(ImmortalStreamSequenceNumHeader, strconv.FormatUint(readerSeqNum, 10))
+
+	url := fmt.Sprintf("http://%s/api/v0/immortal-streams/%s", host, id)
+	conn, res, err := websocket.Dial(ctx, url, dialOpts)
+	if err != nil {
+		if res == nil {
+			return nil, 0, err
+		}
+		if res.StatusCode == http.StatusNotFound || res.StatusCode == http.StatusGone {
+			return nil, 0, ErrImmortalStreamClosed
+		}
+		return nil, 0, codersdk.ReadBodyAsError(res)
+	}
+	if res != nil && res.Body != nil {
+		defer res.Body.Close()
+	}
+
+	remoteSeqNum, err := strconv.ParseUint(res.Header.Get(ImmortalStreamSequenceNumHeader), 10, 64)
+	if err != nil {
+		_ = conn.Close(websocket.StatusProtocolError, "missing sequence number")
+		return nil, 0, xerrors.Errorf("parse agent sequence number: %w", err)
+	}
+
+	// Replays can be much larger than the default message size limit.
+	conn.SetReadLimit(-1)
+
+	// The connection outlives the dial, so it must not be bound to a
+	// context that is canceled once the dial returns.
+	return websocket.NetConn(context.WithoutCancel(ctx), conn, websocket.MessageBinary), remoteSeqNum, nil
+}
+
 func agentAPIPath(path string, query neturl.Values) string {
 	if len(query) == 0 {
 		return path
@@ -143,6 +143,22 @@ func (mr *MockAgentConnMockRecorder) ConnectDesktopVNC(ctx any) *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ConnectDesktopVNC", reflect.TypeOf((*MockAgentConn)(nil).ConnectDesktopVNC), ctx)
 }
 
+// ConnectImmortalStream mocks base method.
+func (m *MockAgentConn) ConnectImmortalStream(ctx context.Context, id uuid.UUID, readerSeqNum uint64) (net.Conn, uint64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ConnectImmortalStream", ctx, id, readerSeqNum)
+	ret0, _ := ret[0].(net.Conn)
+	ret1, _ := ret[1].(uint64)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// ConnectImmortalStream indicates an expected call of ConnectImmortalStream.
+func (mr *MockAgentConnMockRecorder) ConnectImmortalStream(ctx, id, readerSeqNum any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ConnectImmortalStream", reflect.TypeOf((*MockAgentConn)(nil).ConnectImmortalStream), ctx, id, readerSeqNum)
+}
+
 // ContextConfig mocks base method.
 func (m *MockAgentConn) ContextConfig(ctx context.Context) (workspacesdk.ContextConfigResponse, error) {
 	m.ctrl.T.Helper()
@@ -158,6 +174,21 @@ func (mr *MockAgentConnMockRecorder) ContextConfig(ctx any) *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ContextConfig", reflect.TypeOf((*MockAgentConn)(nil).ContextConfig), ctx)
 }
 
+// CreateImmortalStream mocks base method.
+func (m *MockAgentConn) CreateImmortalStream(ctx context.Context, req workspacesdk.CreateImmortalStreamRequest) (workspacesdk.ImmortalStream, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateImmortalStream", ctx, req)
+	ret0, _ := ret[0].(workspacesdk.ImmortalStream)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateImmortalStream indicates an expected call of CreateImmortalStream.
+func (mr *MockAgentConnMockRecorder) CreateImmortalStream(ctx, req any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateImmortalStream", reflect.TypeOf((*MockAgentConn)(nil).CreateImmortalStream), ctx, req)
+}
+
 // DebugLogs mocks base method.
 func (m *MockAgentConn) DebugLogs(ctx context.Context, opts ...workspacesdk.DebugLogsOption) ([]byte, error) {
 	m.ctrl.T.Helper()
@@ -222,6 +253,20 @@ func (mr *MockAgentConnMockRecorder) DeleteDevcontainer(ctx, devcontainerID any)
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteDevcontainer", reflect.TypeOf((*MockAgentConn)(nil).DeleteDevcontainer), ctx, devcontainerID)
 }
 
+// DeleteImmortalStream mocks base method.
+func (m *MockAgentConn) DeleteImmortalStream(ctx context.Context, id uuid.UUID) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteImmortalStream", ctx, id)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteImmortalStream indicates an expected call of DeleteImmortalStream.
+func (mr *MockAgentConnMockRecorder) DeleteImmortalStream(ctx, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteImmortalStream", reflect.TypeOf((*MockAgentConn)(nil).DeleteImmortalStream), ctx, id)
+}
+
 // DialContext mocks base method.
 func (m *MockAgentConn) DialContext(ctx context.Context, network, addr string) (net.Conn, error) {
 	m.ctrl.T.Helper()
@@ -267,6 +312,21 @@ func (mr *MockAgentConnMockRecorder) ExecuteDesktopAction(ctx, action any) *gomo
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ExecuteDesktopAction", reflect.TypeOf((*MockAgentConn)(nil).ExecuteDesktopAction), ctx, action)
 }
 
+// Find mocks base method.
+func (m *MockAgentConn) Find(ctx context.Context, req workspacesdk.FindRequest) (workspacesdk.FindResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Find", ctx, req)
+	ret0, _ := ret[0].(workspacesdk.FindResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Find indicates an expected call of Find.
+func (mr *MockAgentConnMockRecorder) Find(ctx, req any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Find", reflect.TypeOf((*MockAgentConn)(nil).Find), ctx, req)
+}
+
 // GetPeerDiagnostics mocks base method.
 func (m *MockAgentConn) GetPeerDiagnostics() tailnet.PeerDiagnostics {
 	m.ctrl.T.Helper()
@@ -311,6 +371,21 @@ func (mr *MockAgentConnMockRecorder) ListContainers(ctx any) *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListContainers", reflect.TypeOf((*MockAgentConn)(nil).ListContainers), ctx)
 }
 
+// ListImmortalStreams mocks base method.
+func (m *MockAgentConn) ListImmortalStreams(ctx context.Context) ([]workspacesdk.ImmortalStream, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListImmortalStreams", ctx)
+	ret0, _ := ret[0].([]workspacesdk.ImmortalStream)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListImmortalStreams indicates an expected call of ListImmortalStreams.
+func (mr *MockAgentConnMockRecorder) ListImmortalStreams(ctx any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListImmortalStreams", reflect.TypeOf((*MockAgentConn)(nil).ListImmortalStreams), ctx)
+}
+
 // ListProcesses mocks base method.
 func (m *MockAgentConn) ListProcesses(ctx context.Context) (workspacesdk.ListProcessesResponse, error) {
 	m.ctrl.T.Helper()
@@ -326,6 +401,21 @@ func (mr *MockAgentConnMockRecorder) ListProcesses(ctx any) *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListProcesses", reflect.TypeOf((*MockAgentConn)(nil).ListProcesses), ctx)
 }
 
+// ListServices mocks base method.
+func (m *MockAgentConn) ListServices(ctx context.Context) (workspacesdk.ListServicesResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListServices", ctx)
+	ret0, _ := ret[0].(workspacesdk.ListServicesResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListServices indicates an expected call of ListServices.
+func (mr *MockAgentConnMockRecorder) ListServices(ctx any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListServices", reflect.TypeOf((*MockAgentConn)(nil).ListServices), ctx)
+}
+
 // ListeningPorts mocks base method.
 func (m *MockAgentConn) ListeningPorts(ctx context.Context) (codersdk.WorkspaceAgentListeningPortsResponse, error) {
 	m.ctrl.T.Helper()
@@ -469,6 +559,20 @@ func (mr *MockAgentConnMockRecorder) RecreateDevcontainer(ctx, devcontainerID an
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RecreateDevcontainer", reflect.TypeOf((*MockAgentConn)(nil).RecreateDevcontainer), ctx, devcontainerID)
 }
 
+// RemovePath mocks base method.
+func (m *MockAgentConn) RemovePath(ctx context.Context, path string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RemovePath", ctx, path)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// RemovePath indicates an expected call of RemovePath.
+func (mr *MockAgentConnMockRecorder) RemovePath(ctx, path any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RemovePath", reflect.TypeOf((*MockAgentConn)(nil).RemovePath), ctx, path)
+}
+
 // ResolvePath mocks base method.
 func (m *MockAgentConn) ResolvePath(ctx context.Context, path string) (string, error) {
 	m.ctrl.T.Helper()
@@ -544,6 +648,21 @@ func (mr *MockAgentConnMockRecorder) SSHOnPort(ctx, port any) *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SSHOnPort", reflect.TypeOf((*MockAgentConn)(nil).SSHOnPort), ctx, port)
 }
 
+// ServiceOutput mocks base method.
+func (m *MockAgentConn) ServiceOutput(ctx context.Context, name string) (workspacesdk.ServiceOutputResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ServiceOutput", ctx, name)
+	ret0, _ := ret[0].(workspacesdk.ServiceOutputResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ServiceOutput indicates an expected call of ServiceOutput.
+func (mr *MockAgentConnMockRecorder) ServiceOutput(ctx, name any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ServiceOutput", reflect.TypeOf((*MockAgentConn)(nil).ServiceOutput), ctx, name)
+}
+
 // SetExtraHeaders mocks base method.
 func (m *MockAgentConn) SetExtraHeaders(h http.Header) {
 	m.ctrl.T.Helper()
@@ -614,6 +733,21 @@ func (mr *MockAgentConnMockRecorder) StartProcess(ctx, req any) *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "StartProcess", reflect.TypeOf((*MockAgentConn)(nil).StartProcess), ctx, req)
 }
 
+// StartService mocks base method.
+func (m *MockAgentConn) StartService(ctx context.Context, req workspacesdk.StartServiceRequest) (workspacesdk.ServiceInfo, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "StartService", ctx, req)
+	ret0, _ := ret[0].(workspacesdk.ServiceInfo)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// StartService indicates an expected call of StartService.
+func (mr *MockAgentConnMockRecorder) StartService(ctx, req any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "StartService", reflect.TypeOf((*MockAgentConn)(nil).StartService), ctx, req)
+}
+
 // StopDesktopRecording mocks base method.
 func (m *MockAgentConn) StopDesktopRecording(ctx context.Context, req workspacesdk.StopDesktopRecordingRequest) (workspacesdk.StopDesktopRecordingResponse, error) {
 	m.ctrl.T.Helper()
@@ -629,6 +763,20 @@ func (mr *MockAgentConnMockRecorder) StopDesktopRecording(ctx, req any) *gomock.
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "StopDesktopRecording", reflect.TypeOf((*MockAgentConn)(nil).StopDesktopRecording), ctx, req)
 }
 
+// StopService mocks base method.
+func (m *MockAgentConn) StopService(ctx context.Context, name string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "StopService", ctx, name)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// StopService indicates an expected call of StopService.
+func (mr *MockAgentConnMockRecorder) StopService(ctx, name any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "StopService", reflect.TypeOf((*MockAgentConn)(nil).StopService), ctx, name)
+}
+
 // TailnetConn mocks base method.
 func (m *MockAgentConn) TailnetConn() *tailnet.Conn {
 	m.ctrl.T.Helper()
@@ -659,6 +807,22 @@ func (mr *MockAgentConnMockRecorder) WatchContainers(ctx, logger any) *gomock.Ca
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "WatchContainers", reflect.TypeOf((*MockAgentConn)(nil).WatchContainers), ctx, logger)
 }
 
+// WatchFind mocks base method.
+func (m *MockAgentConn) WatchFind(ctx context.Context, logger slog.Logger, req workspacesdk.FindRequest) (<-chan workspacesdk.FindResponse, io.Closer, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "WatchFind", ctx, logger, req)
+	ret0, _ := ret[0].(<-chan workspacesdk.FindResponse)
+	ret1, _ := ret[1].(io.Closer)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// WatchFind indicates an expected call of WatchFind.
+func (mr *MockAgentConnMockRecorder) WatchFind(ctx, logger, req any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "WatchFind", reflect.TypeOf((*MockAgentConn)(nil).WatchFind), ctx, logger, req)
+}
+
 // WatchGit mocks base method.
 func (m *MockAgentConn) WatchGit(ctx context.Context, logger slog.Logger, chatID uuid.UUID) (*wsjson.Stream[codersdk.WorkspaceAgentGitServerMessage, codersdk.WorkspaceAgentGitClientMessage], error) {
 	m.ctrl.T.Helper()
@@ -674,6 +838,22 @@ func (mr *MockAgentConnMockRecorder) WatchGit(ctx, logger, chatID any) *gomock.C
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "WatchGit", reflect.TypeOf((*MockAgentConn)(nil).WatchGit), ctx, logger, chatID)
 }
 
+// WatchSync mocks base method.
+func (m *MockAgentConn) WatchSync(ctx context.Context, logger slog.Logger, root string) (<-chan workspacesdk.SyncUpdate, io.Closer, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "WatchSync", ctx, logger, root)
+	ret0, _ := ret[0].(<-chan workspacesdk.SyncUpdate)
+	ret1, _ := ret[1].(io.Closer)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// WatchSync indicates an expected call of WatchSync.
+func (mr *MockAgentConnMockRecorder) WatchSync(ctx, logger, root any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "WatchSync", reflect.TypeOf((*MockAgentConn)(nil).WatchSync), ctx, logger, root)
+}
+
 // WriteFile mocks base method.
 func (m *MockAgentConn) WriteFile(ctx context.Context, path string, reader io.Reader) error {
 	m.ctrl.T.Helper()
@@ -0,0 +1,257 @@
+package workspacesdk
+
+import (
+	"context"
+	"errors"
+	"io"
+	"net"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"github.com/google/uuid"
+	"golang.org/x/xerrors"
+
+	"cdr.dev/slog/v3"
+	"github.com/coder/coder/v2/agent/immortalstreams/backedpipe"
+)
+
+const (
+	// ImmortalStreamSequenceNumHeader carries a reader sequence number when
+	// connecting to an immortal stream: the number of bytes the sender has
+	// received from the other side. The client sends its own in the request
+	// and the agent responds with its own, so each side knows where to
+	// replay from.
+	ImmortalStreamSequenceNumHeader = "Coder-Immortal-Stream-Sequence-Num"
+
+	// ImmortalStreamBufferSize is the number of outbound bytes each side of
+	// an immortal stream keeps for replay.
+	ImmortalStreamBufferSize = 4 << 20
+
+	immortalStreamDialTimeout    = 30 * time.Second
+	immortalStreamRetryInterval  = time.Second
+	immortalStreamDeleteTimeout  = 10 * time.Second
+	immortalStreamAddressNetwork = "immortal-stream"
+)
+
+// ErrImmortalStreamClosed is returned when connecting to an immortal stream
+// that no longer exists, or has ended and has no data left to deliver.
+var ErrImmortalStreamClosed = xerrors.New("immortal stream closed")
+
+// ImmortalStream is a TCP connection to a port in the workspace which the
+// agent holds open while clients disconnect and reconnect.
+type ImmortalStream struct {
+	ID        uuid.UUID `json:"id" format:"uuid"`
+	TCPPort   uint16    `json:"tcp_port"`
+	CreatedAt time.Time `json:"created_at" format:"date-time"`
+	Connected bool      `json:"connected"`
+	// LastDisconnectedAt is when the last client connection was lost. It is
+	// nil while a client is connected.
+	LastDisconnectedAt *time.Time `json:"last_disconnected_at,omitempty" format:"date-time"`
+}
+
+type CreateImmortalStreamRequest struct {
+	TCPPort uint16 `json:"tcp_port"`
+}
+
+// ImmortalStreamConn is a client connection to an immortal stream. When its
+// connection to the agent fails, for example because the network changed, it
+// reconnects in the background and both sides replay the bytes the other has
+// not received. Reads and writes block while it is disconnected.
+type ImmortalStreamConn struct {
+	conn   AgentConn
+	id     uuid.UUID
+	logger slog.Logger
+	pipe   *backedpipe.BackedPipe
+
+	ctx    context.Context
+	cancel context.CancelFunc
+	done   chan struct{}
+	// ended is set once the agent reports that the stream is closed.
+	ended atomic.Bool
+
+	lastErrMu sync.Mutex
+	lastErr   error
+
+	closeOnce sync.Once
+	closeErr  error
+}
+
+var _ net.Conn = (*ImmortalStreamConn)(nil)
+
+// DialImmortalStream creates an immortal stream to a TCP port in the workspace
+// and connects to it. ctx only bounds the initial connection.
+func DialImmortalStream(ctx context.Context, conn AgentConn, port uint16, logger slog.Logger) (*ImmortalStreamConn, error) {
+	stream, err := conn.CreateImmortalStream(ctx, CreateImmortalStreamRequest{TCPPort: port})
+	if err != nil {
+		return nil, xerrors.Errorf("create immortal stream: %w", err)
+	}
+
+	c := &ImmortalStreamConn{
+		conn:   conn,
+		id:     stream.ID,
+		logger: logger.With(slog.F("immortal_stream_id", stream.ID), slog.F("tcp_port", port)),
+		done:   make(chan struct{}),
+	}
+	c.ctx, c.cancel = context.WithCancel(context.WithoutCancel(ctx))
+	c.pipe = backedpipe.NewBackedPipeWithBufferSize(c.ctx, immortalStreamReconnector{c: c}, ImmortalStreamBufferSize)
+
+	stop := context.AfterFunc(ctx, c.cancel)
+	err = c.pipe.Connect()
+	if !stop() && err == nil {
+		err = ctx.Err()
+	}
+	if err != nil {
+		close(c.done)
+		if lastErr := c.takeLastErr(); lastErr != nil {
+			err = lastErr
+		}
+		_ = c.Close()
+		return nil, xerrors.Errorf("connect to immortal stream: %w", err)
+	}
+
+	go c.supervise()
+	return c, nil
+}
+
+// ID returns the ID of the stream on the agent.
+func (c *ImmortalStreamConn) ID() uuid.UUID {
+	return c.id
+}
+
+// supervise retries connecting while the pipe is disconnected. The pipe
+// reconnects once by itself when its connection fails, but gives up if the
+// agent is unreachable at that moment.
+func (c *ImmortalStreamConn) supervise() {
+	defer close(c.done)
+
+	ticker := time.NewTicker(immortalStreamRetryInterval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-c.ctx.Done():
+			return
+		case <-ticker.C:
+		}
+
+		if !c.ended.Load() && !c.pipe.Connected() {
+			err := c.pipe.ForceReconnect()
+			if err != nil && !errors.Is(err, backedpipe.ErrReconnectionInProgress) {
+				c.logger.Debug(c.ctx, "reconnect immortal stream", slog.Error(err), slog.F("cause", c.takeLastErr()))
+			} else if err == nil {
+				c.logger.Debug(c.ctx, "reconnected immortal stream")
+			}
+		}
+		if c.ended.Load() {
+			// Every byte has been read, so closing the pipe makes reads
+			// return io.EOF.
+			_ = c.pipe.Close()
+			return
+		}
+	}
+}
+
+func (c *ImmortalStreamConn) setLastErr(err error) {
+	c.lastErrMu.Lock()
+	defer c.lastErrMu.Unlock()
+	c.lastErr = err
+}
+
+func (c *ImmortalStreamConn) takeLastErr() error {
+	c.lastErrMu.Lock()
+	defer c.lastErrMu.Unlock()
+	err := c.lastErr
+	c.lastErr = nil
+	return err
+}
+
+func (c *ImmortalStreamConn) Read(p []byte) (int, error) {
+	return c.pipe.Read(p)
+}
+
+func (c *ImmortalStreamConn) Write(p []byte) (int, error) {
+	n, err := c.pipe.Write(p)
+	if err != nil && c.ended.Load() {
+		return n, net.ErrClosed
+	}
+	return n, err
+}
+
+// Close closes the connection and the stream on the agent.
+func (c *ImmortalStreamConn) Close() error {
+	c.closeOnce.Do(func() {
+		// Canceling first aborts any reconnect in progress, which holds the
+		// pipe's lock.
+		c.cancel()
+		c.closeErr = c.pipe.Close()
+		<-c.done
+
+		if c.ended.Load() {
+			return
+		}
+		ctx, cancel := context.WithTimeout(context.Background(), immortalStreamDeleteTimeout)
+		defer cancel()
+		if err := c.conn.DeleteImmortalStream(ctx, c.id); err != nil {
+			// The agent closes streams that stay disconnected, so this is
+			// not fatal.
+			c.logger.Debug(ctx, "delete immortal stream", slog.Error(err))
+		}
+	})
+	return c.closeErr
+}
+
+func (c *ImmortalStreamConn) LocalAddr() net.Addr {
+	return immortalStreamAddr{id: c.id}
+}
+
+func (c *ImmortalStreamConn) RemoteAddr() net.Addr {
+	return immortalStreamAddr{id: c.id}
+}
+
+// SetDeadline is not supported, since reads and writes intentionally block
+// while the stream reconnects.
+func (*ImmortalStreamConn) SetDeadline(time.Time) error {
+	return xerrors.New("deadlines are not supported on immortal streams")
+}
+
+func (*ImmortalStreamConn) SetReadDeadline(time.Time) error {
+	return xerrors.New("deadlines are not supported on immortal streams")
+}
+
+func (*ImmortalStreamConn) SetWriteDeadline(time.Time) error {
+	return xerrors.New("deadlines are not supported on immortal streams")
+}
+
+type immortalStreamAddr struct {
+	id uuid.UUID
+}
+
+func (immortalStreamAddr) Network() string {
+	return immortalStreamAddressNetwork
+}
+
+func (a immortalStreamAddr) String() string {
+	return a.id.String()
+}
+
+// immortalStreamReconnector connects the pipe to the agent's end of the
+// stream.
+type immortalStreamReconnector struct {
+	c *ImmortalStreamConn
+}
+
+func (r immortalStreamReconnector) Reconnect(ctx context.Context, readerSeqNum uint64) (io.ReadWriteCloser, uint64, error) {
+	// ctx is the pipe's, which is canceled when the conn is closed.
+	ctx, cancel := context.WithTimeout(ctx, immortalStreamDialTimeout)
+	defer cancel()
+
+	conn, remoteReaderSeqNum, err := r.c.conn.ConnectImmortalStream(ctx, r.c.id, readerSeqNum)
+	if err != nil {
+		if errors.Is(err, ErrImmortalStreamClosed) {
+			r.c.ended.Store(true)
+		}
+		r.c.setLastErr(err)
+		return nil, 0, err
+	}
+	return conn, remoteReaderSeqNum, nil
+}
@@ -394,6 +394,54 @@ func (c *Client) AgentReconnectingPTY(ctx context.Context, opts WorkspaceAgentRe
 	return websocket.NetConn(context.Background(), conn, websocket.MessageBinary), nil
 }
 
+// @typescript-ignore:WatchSharedReconnectingPTYOpts
+type WatchSharedReconnectingPTYOpts struct {
+	// Token is the share token returned when the session was shared.
+	Token string
+	// Width and Height size the viewer's terminal. They never resize the
+	// shared PTY itself.
+	Width  uint16
+	Height uint16
+}
+
+// WatchSharedReconnectingPTY attaches to a reconnecting PTY session that was
+// shared with the current user. The returned connection only carries PTY
+// output; anything written to it is discarded by the agent.
+func (c *Client) WatchSharedReconnectingPTY(ctx context.Context, opts WatchSharedReconnectingPTYOpts) (net.Conn, error) {
+	serverURL, err := c.client.URL.Parse("/api/v2/pty-shares/watch")
+	if err != nil {
+		return nil, xerrors.Errorf("parse url: %w", err)
+	}
+	q := serverURL.Query()
+	q.Set(codersdk.WorkspaceAgentPTYShareTokenQueryParameter, opts.Token)
+	if opts.Width != 0 {
+		q.Set("width", strconv.Itoa(int(opts.Width)))
+	}
+	if opts.Height != 0 {
+		q.Set("height", strconv.Itoa(int(opts.Height)))
+	}
+	serverURL.RawQuery = q.Encode()
+
+	// See AgentReconnectingPTY for why the cookie jar is dropped.
+	wsHTTPClient := *c.client.HTTPClient
+	wsHTTPClient.Jar = nil
+
+	headers := http.Header{}
+	headers.Set(codersdk.SessionTokenHeader, c.client.SessionToken())
+	//nolint:bodyclose
+	conn, res, err := websocket.Dial(ctx, serverURL.String(), &websocket.DialOptions{
+		HTTPClient: &wsHTTPClient,
+		HTTPHeader: headers,
+	})
+	if err != nil {
+		if res == nil {
+			return nil, err
+		}
+		return nil, codersdk.ReadBodyAsError(res)
+	}
+	return websocket.NetConn(context.Background(), conn, websocket.MessageBinary), nil
+}
+
 func WithTestOnlyCoderContextResolver(ctx context.Context, r Resolver) context.Context {
 	return context.WithValue(ctx, dnsResolverContextKey{}, r)
 }
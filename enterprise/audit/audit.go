@@ -3,6 +3,7 @@ package audit
 import (
 	"context"
 	"database/sql"
+	"errors"
 
 	"github.com/google/uuid"
 	"golang.org/x/xerrors"
@@ -63,6 +64,8 @@ func (a *auditor) Export(ctx context.Context, alog database.AuditLog) error {
 		return err
 	}
 
+	// A failing backend must not keep the audit log from the others.
+	var errs []error
 	for _, backend := range a.backends {
 		if decision&backend.Decision() != backend.Decision() {
 			continue
@@ -74,11 +77,9 @@ func (a *auditor) Export(ctx context.Context, alog database.AuditLog) error {
 			Username: actor.Username,
 		}})
 		if err != nil {
-			// naively return the first error. should probably make this smarter
-			// by returning multiple errors.
-			return xerrors.Errorf("export audit log to backend: %w", err)
+			errs = append(errs, xerrors.Errorf("export audit log to backend: %w", err))
 		}
 	}
 
-	return nil
+	return errors.Join(errs...)
 }
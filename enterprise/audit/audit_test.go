@@ -1,16 +1,24 @@
 package audit_test
 
 import (
+	"bytes"
 	"context"
+	"net"
+	"os"
+	"path/filepath"
+	"strings"
 	"testing"
 
 	"github.com/stretchr/testify/require"
 	"golang.org/x/xerrors"
 
+	"cdr.dev/slog/v3/sloggers/slogtest"
 	"github.com/coder/coder/v2/coderd/database"
 	"github.com/coder/coder/v2/coderd/database/dbtestutil"
 	"github.com/coder/coder/v2/enterprise/audit"
 	"github.com/coder/coder/v2/enterprise/audit/audittest"
+	"github.com/coder/coder/v2/enterprise/audit/backends"
+	"github.com/coder/coder/v2/testutil"
 )
 
 func TestAuditor(t *testing.T) {
@@ -111,6 +119,60 @@ func TestAuditor(t *testing.T) {
 	}
 }
 
+func TestAuditorStalledBackend(t *testing.T) {
+	t.Parallel()
+
+	ctx := testutil.Context(t, testutil.WaitShort)
+	db, _ := dbtestutil.NewDB(t)
+
+	// The syslog receiver accepts connections without reading from them, so
+	// its backend stalls once the socket buffers fill up.
+	ln, err := net.Listen("tcp", "127.0.0.1:0")
+	require.NoError(t, err)
+	defer ln.Close()
+	go func() {
+		for {
+			conn, err := ln.Accept()
+			if err != nil {
+				return
+			}
+			defer conn.Close()
+		}
+	}()
+	stalled, closeStalled, err := backends.NewSyslog(backends.SyslogOptions{
+		Address:     ln.Addr().String(),
+		DialTimeout: testutil.IntervalSlow,
+		BufferSize:  1,
+		Logger:      slogtest.Make(t, &slogtest.Options{IgnoreErrors: true}),
+	})
+	require.NoError(t, err)
+
+	path := filepath.Join(t.TempDir(), "audit.jsonl")
+	jsonl, closeJSONL, err := backends.NewJSONL(backends.JSONLOptions{Path: path})
+	require.NoError(t, err)
+
+	exporter := audit.NewAuditor(db, audit.FilterFunc(func(context.Context, database.AuditLog) (audit.FilterDecision, error) {
+		return audit.FilterDecisionExport, nil
+	}), stalled, jsonl)
+
+	// Every audit log reaches the JSONL backend, even once the syslog
+	// backend is dropping them.
+	const count = 10
+	for range count {
+		alog := audittest.RandomLog()
+		alog.AdditionalFields = []byte(`{"padding":"` + strings.Repeat("x", 1<<20) + `"}`)
+		require.NoError(t, exporter.Export(ctx, alog))
+	}
+	require.NoError(t, closeJSONL())
+
+	data, err := os.ReadFile(path)
+	require.NoError(t, err)
+	require.Equal(t, count, bytes.Count(data, []byte("\n")))
+
+	ln.Close()
+	require.NoError(t, closeStalled())
+}
+
 type testBackend struct {
 	decision audit.FilterDecision
 	err      error
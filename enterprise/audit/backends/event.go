@@ -0,0 +1,79 @@
+package backends
+
+import (
+	"context"
+	"encoding/json"
+	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/coder/coder/v2/coderd/database"
+	"github.com/coder/coder/v2/enterprise/audit"
+)
+
+// Event is the JSON representation of an audit log shared by the streaming
+// backends (syslog, webhook and JSONL).
+type Event struct {
+	ID               uuid.UUID             `json:"id"`
+	Time             time.Time             `json:"time"`
+	OrganizationID   uuid.UUID             `json:"organization_id"`
+	UserID           uuid.UUID             `json:"user_id"`
+	Actor            *audit.Actor          `json:"actor,omitempty"`
+	IP               string                `json:"ip"`
+	UserAgent        string                `json:"user_agent"`
+	ResourceType     database.ResourceType `json:"resource_type"`
+	ResourceID       uuid.UUID             `json:"resource_id"`
+	ResourceTarget   string                `json:"resource_target"`
+	ResourceIcon     string                `json:"resource_icon"`
+	Action           database.AuditAction  `json:"action"`
+	Diff             json.RawMessage       `json:"diff"`
+	StatusCode       int32                 `json:"status_code"`
+	AdditionalFields json.RawMessage       `json:"additional_fields"`
+	RequestID        uuid.UUID             `json:"request_id"`
+}
+
+// NewEvent converts an audit log and its details into an Event.
+func NewEvent(alog database.AuditLog, details audit.BackendDetails) Event {
+	ev := Event{
+		ID:               alog.ID,
+		Time:             alog.Time.UTC(),
+		OrganizationID:   alog.OrganizationID,
+		UserID:           alog.UserID,
+		Actor:            details.Actor,
+		UserAgent:        alog.UserAgent.String,
+		ResourceType:     alog.ResourceType,
+		ResourceID:       alog.ResourceID,
+		ResourceTarget:   alog.ResourceTarget,
+		ResourceIcon:     alog.ResourceIcon,
+		Action:           alog.Action,
+		Diff:             rawJSONOrNull(alog.Diff),
+		StatusCode:       alog.StatusCode,
+		AdditionalFields: rawJSONOrNull(alog.AdditionalFields),
+		RequestID:        alog.RequestID,
+	}
+	if alog.Ip.Valid {
+		ev.IP = alog.Ip.IPNet.IP.String()
+	}
+	return ev
+}
+
+// rawJSONOrNull avoids producing invalid JSON when a column is empty.
+func rawJSONOrNull(b json.RawMessage) json.RawMessage {
+	if len(b) == 0 {
+		return json.RawMessage("null")
+	}
+	return b
+}
+
+// shouldExport runs the backend's own filter against the audit log. A nil
+// filter exports everything.
+func shouldExport(ctx context.Context, filter audit.Filter, alog database.AuditLog) (bool, error) {
+	if filter == nil {
+		return true, nil
+	}
+	decision, err := filter.Check(ctx, alog)
+	if err != nil {
+		return false, err
+	}
+	return decision&audit.FilterDecisionExport == audit.FilterDecisionExport, nil
+}
@@ -0,0 +1,96 @@
+package backends
+
+import (
+	"context"
+	"encoding/json"
+	"sync"
+
+	"golang.org/x/xerrors"
+	"gopkg.in/natefinch/lumberjack.v2"
+
+	"github.com/coder/coder/v2/coderd/database"
+	"github.com/coder/coder/v2/enterprise/audit"
+)
+
+// JSONLOptions configures the JSONL file backend.
+type JSONLOptions struct {
+	// Path is the file audit logs are appended to.
+	Path string
+	// MaxSizeMB is the size at which the file is rotated. Defaults to 100.
+	MaxSizeMB int
+	// MaxBackups is the number of rotated files to keep. Zero keeps all of
+	// them.
+	MaxBackups int
+	// Filter decides which audit logs are written. Nil writes everything.
+	Filter audit.Filter
+}
+
+type jsonlBackend struct {
+	filter audit.Filter
+
+	mu     sync.Mutex
+	writer *lumberjack.Logger
+	closed bool
+}
+
+// NewJSONL returns a backend that appends each audit log as a single line of
+// JSON to a file, rotating it once it reaches the configured size.
+func NewJSONL(opts JSONLOptions) (audit.Backend, func() error, error) {
+	if opts.Path == "" {
+		return nil, nil, xerrors.New("path is required")
+	}
+	if opts.MaxSizeMB <= 0 {
+		opts.MaxSizeMB = 100
+	}
+
+	b := &jsonlBackend{
+		filter: opts.Filter,
+		writer: &lumberjack.Logger{
+			Filename:   opts.Path,
+			MaxSize:    opts.MaxSizeMB,
+			MaxBackups: opts.MaxBackups,
+		},
+	}
+	return b, b.Close, nil
+}
+
+func (*jsonlBackend) Decision() audit.FilterDecision {
+	return audit.FilterDecisionExport
+}
+
+func (b *jsonlBackend) Export(ctx context.Context, alog database.AuditLog, details audit.BackendDetails) error {
+	ok, err := shouldExport(ctx, b.filter, alog)
+	if err != nil {
+		return xerrors.Errorf("check filter: %w", err)
+	}
+	if !ok {
+		return nil
+	}
+
+	line, err := json.Marshal(NewEvent(alog, details))
+	if err != nil {
+		return xerrors.Errorf("marshal audit log: %w", err)
+	}
+	line = append(line, '\n')
+
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	if b.closed {
+		return xerrors.New("backend is closed")
+	}
+	// A single write keeps lines intact across rotations.
+	if _, err := b.writer.Write(line); err != nil {
+		return xerrors.Errorf("write audit log: %w", err)
+	}
+	return nil
+}
+
+func (b *jsonlBackend) Close() error {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	if b.closed {
+		return nil
+	}
+	b.closed = true
+	return b.writer.Close()
+}
@@ -0,0 +1,97 @@
+package backends_test
+
+import (
+	"bufio"
+	"context"
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/require"
+
+	"github.com/coder/coder/v2/coderd/database"
+	"github.com/coder/coder/v2/enterprise/audit"
+	"github.com/coder/coder/v2/enterprise/audit/audittest"
+	"github.com/coder/coder/v2/enterprise/audit/backends"
+)
+
+func TestJSONLBackend(t *testing.T) {
+	t.Parallel()
+
+	t.Run("OK", func(t *testing.T) {
+		t.Parallel()
+
+		var (
+			ctx, cancel = context.WithCancel(context.Background())
+			path        = filepath.Join(t.TempDir(), "audit.jsonl")
+			actor       = &audit.Actor{ID: uuid.New(), Username: "coadler", Email: "doug@coder.com"}
+			first       = audittest.RandomLog()
+			second      = audittest.RandomLog()
+		)
+		defer cancel()
+
+		backend, closeFn, err := backends.NewJSONL(backends.JSONLOptions{Path: path})
+		require.NoError(t, err)
+
+		require.NoError(t, backend.Export(ctx, first, audit.BackendDetails{Actor: actor}))
+		require.NoError(t, backend.Export(ctx, second, audit.BackendDetails{}))
+		require.NoError(t, closeFn())
+
+		events := readJSONL(t, path)
+		require.Len(t, events, 2)
+		require.Equal(t, first.ID, events[0].ID)
+		require.Equal(t, actor, events[0].Actor)
+		require.Equal(t, "127.0.0.1", events[0].IP)
+		require.Equal(t, second.ID, events[1].ID)
+		require.Nil(t, events[1].Actor)
+
+		// Writing after close must not reopen the file.
+		require.Error(t, backend.Export(ctx, first, audit.BackendDetails{}))
+	})
+
+	t.Run("Filter", func(t *testing.T) {
+		t.Parallel()
+
+		var (
+			ctx, cancel = context.WithCancel(context.Background())
+			path        = filepath.Join(t.TempDir(), "audit.jsonl")
+		)
+		defer cancel()
+
+		filter, err := audit.ParseFilter([]string{"resource_type:workspace"})
+		require.NoError(t, err)
+		backend, closeFn, err := backends.NewJSONL(backends.JSONLOptions{Path: path, Filter: filter})
+		require.NoError(t, err)
+
+		dropped := audittest.RandomLog()
+		kept := audittest.RandomLog()
+		kept.ResourceType = database.ResourceTypeWorkspace
+		require.NoError(t, backend.Export(ctx, dropped, audit.BackendDetails{}))
+		require.NoError(t, backend.Export(ctx, kept, audit.BackendDetails{}))
+		require.NoError(t, closeFn())
+
+		events := readJSONL(t, path)
+		require.Len(t, events, 1)
+		require.Equal(t, kept.ID, events[0].ID)
+	})
+}
+
+func readJSONL(t *testing.T, path string) []backends.Event {
+	t.Helper()
+
+	f, err := os.Open(path)
+	require.NoError(t, err)
+	defer f.Close()
+
+	var events []backends.Event
+	scanner := bufio.NewScanner(f)
+	for scanner.Scan() {
+		var ev backends.Event
+		require.NoError(t, json.Unmarshal(scanner.Bytes(), &ev))
+		events = append(events, ev)
+	}
+	require.NoError(t, scanner.Err())
+	return events
+}
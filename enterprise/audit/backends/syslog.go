@@ -0,0 +1,264 @@
+package backends
+
+import (
+	"context"
+	"crypto/tls"
+	"encoding/json"
+	"fmt"
+	"net"
+	"os"
+	"sync"
+	"time"
+
+	"golang.org/x/xerrors"
+
+	"cdr.dev/slog/v3"
+	"github.com/coder/coder/v2/coderd/database"
+	"github.com/coder/coder/v2/enterprise/audit"
+)
+
+const (
+	// SyslogFacilityLocal0 is the default facility audit logs are sent with.
+	SyslogFacilityLocal0 = 16
+
+	syslogSeverityWarning = 4
+	syslogSeverityInfo    = 6
+)
+
+// SyslogOptions configures the syslog backend.
+type SyslogOptions struct {
+	// Address is the host:port of the syslog receiver.
+	Address string
+	// TLSConfig enables TLS when non-nil.
+	TLSConfig *tls.Config
+	// Facility is the syslog facility code. Defaults to local0.
+	Facility int
+	// Hostname is sent as the HOSTNAME field. Defaults to os.Hostname.
+	Hostname string
+	// AppName is sent as the APP-NAME field. Defaults to "coder".
+	AppName string
+	// DialTimeout bounds connecting to the receiver and each write. Defaults
+	// to 10 seconds.
+	DialTimeout time.Duration
+	// BufferSize is the number of audit logs that may be queued before new
+	// ones are dropped. Defaults to 1000.
+	BufferSize int
+	// Filter decides which audit logs are sent. Nil sends everything.
+	Filter audit.Filter
+
+	Logger slog.Logger
+}
+
+type syslogBackend struct {
+	opts SyslogOptions
+
+	frames chan []byte
+	done   chan struct{}
+	// conn is only used by the goroutine that sends frames.
+	conn net.Conn
+
+	closeOnce sync.Once
+	// closeMu guards sending on frames against closing it.
+	closeMu sync.RWMutex
+	closed  bool
+}
+
+// NewSyslog returns a backend that sends each audit log to a syslog receiver
+// as an RFC 5424 message over TCP or TLS. Messages are framed with octet
+// counting (RFC 6587), and the connection is re-established on failure.
+// Messages are queued and sent in the background so a slow or unreachable
+// receiver never holds up the request that produced the audit log.
+func NewSyslog(opts SyslogOptions) (audit.Backend, func() error, error) {
+	if opts.Address == "" {
+		return nil, nil, xerrors.New("address is required")
+	}
+	if _, _, err := net.SplitHostPort(opts.Address); err != nil {
+		return nil, nil, xerrors.Errorf("invalid address %q: %w", opts.Address, err)
+	}
+	if opts.Facility == 0 {
+		opts.Facility = SyslogFacilityLocal0
+	}
+	if opts.Facility < 0 || opts.Facility > 23 {
+		return nil, nil, xerrors.Errorf("invalid facility %d: must be between 0 and 23", opts.Facility)
+	}
+	if opts.Hostname == "" {
+		hostname, err := os.Hostname()
+		if err != nil || hostname == "" {
+			hostname = "-"
+		}
+		opts.Hostname = hostname
+	}
+	if opts.AppName == "" {
+		opts.AppName = "coder"
+	}
+	if opts.DialTimeout == 0 {
+		opts.DialTimeout = 10 * time.Second
+	}
+	if opts.BufferSize <= 0 {
+		opts.BufferSize = 1000
+	}
+
+	b := &syslogBackend{
+		opts:   opts,
+		frames: make(chan []byte, opts.BufferSize),
+		done:   make(chan struct{}),
+	}
+	go b.run()
+	return b, b.Close, nil
+}
+
+func (*syslogBackend) Decision() audit.FilterDecision {
+	return audit.FilterDecisionExport
+}
+
+func (b *syslogBackend) Export(ctx context.Context, alog database.AuditLog, details audit.BackendDetails) error {
+	ok, err := shouldExport(ctx, b.opts.Filter, alog)
+	if err != nil {
+		return xerrors.Errorf("check filter: %w", err)
+	}
+	if !ok {
+		return nil
+	}
+
+	msg, err := b.format(alog, details)
+	if err != nil {
+		return err
+	}
+	frame := []byte(fmt.Sprintf("%d %s", len(msg), msg))
+
+	b.closeMu.RLock()
+	defer b.closeMu.RUnlock()
+	// Audit logs are streamed on a best effort basis, so a slow or
+	// unreachable receiver must not fail the export to other backends.
+	if b.closed {
+		b.opts.Logger.Warn(ctx, "syslog backend is closed, dropping audit log", slog.F("audit_log_id", alog.ID))
+		return nil
+	}
+	// Never block the request that produced the audit log on a slow
+	// receiver.
+	select {
+	case b.frames <- frame:
+	default:
+		b.opts.Logger.Warn(ctx, "syslog buffer is full, dropping audit log", slog.F("audit_log_id", alog.ID))
+	}
+	return nil
+}
+
+// Close stops accepting audit logs and waits for queued ones to be sent.
+func (b *syslogBackend) Close() error {
+	b.closeOnce.Do(func() {
+		b.closeMu.Lock()
+		b.closed = true
+		close(b.frames)
+		b.closeMu.Unlock()
+	})
+	<-b.done
+	return nil
+}
+
+func (b *syslogBackend) run() {
+	defer close(b.done)
+	defer b.resetConn()
+
+	ctx := context.Background()
+	dropping := false
+	for frame := range b.frames {
+		// Once the receiver failed during shutdown, drop what is left
+		// instead of waiting for a dial timeout per message.
+		if dropping {
+			continue
+		}
+		err := b.send(ctx, frame)
+		if err == nil {
+			continue
+		}
+		b.opts.Logger.Error(ctx, "send audit log to syslog", slog.Error(err))
+		b.closeMu.RLock()
+		dropping = b.closed
+		b.closeMu.RUnlock()
+	}
+}
+
+// send writes a frame, retrying once on a fresh connection since the
+// receiver may have closed an idle one.
+func (b *syslogBackend) send(ctx context.Context, frame []byte) error {
+	for attempt := 0; ; attempt++ {
+		err := b.write(ctx, frame)
+		if err == nil {
+			return nil
+		}
+		b.resetConn()
+		if attempt > 0 {
+			return err
+		}
+	}
+}
+
+// format renders the audit log as an RFC 5424 message with a JSON body.
+func (b *syslogBackend) format(alog database.AuditLog, details audit.BackendDetails) ([]byte, error) {
+	body, err := json.Marshal(NewEvent(alog, details))
+	if err != nil {
+		return nil, xerrors.Errorf("marshal audit log: %w", err)
+	}
+
+	severity := syslogSeverityInfo
+	if alog.StatusCode >= 400 {
+		severity = syslogSeverityWarning
+	}
+	pri := b.opts.Facility*8 + severity
+
+	// <PRI>VERSION TIMESTAMP HOSTNAME APP-NAME PROCID MSGID STRUCTURED-DATA MSG
+	header := fmt.Sprintf("<%d>1 %s %s %s - %s - ",
+		pri,
+		alog.Time.UTC().Format(time.RFC3339Nano),
+		syslogField(b.opts.Hostname, 255),
+		syslogField(b.opts.AppName, 48),
+		syslogField(string(alog.Action), 32),
+	)
+	return append([]byte(header), body...), nil
+}
+
+func (b *syslogBackend) write(ctx context.Context, frame []byte) error {
+	if b.conn == nil {
+		conn, err := b.dial(ctx)
+		if err != nil {
+			return err
+		}
+		b.conn = conn
+	}
+	_ = b.conn.SetWriteDeadline(time.Now().Add(b.opts.DialTimeout))
+	_, err := b.conn.Write(frame)
+	return err
+}
+
+func (b *syslogBackend) dial(ctx context.Context) (net.Conn, error) {
+	dialer := &net.Dialer{Timeout: b.opts.DialTimeout}
+	if b.opts.TLSConfig != nil {
+		tlsDialer := &tls.Dialer{NetDialer: dialer, Config: b.opts.TLSConfig}
+		return tlsDialer.DialContext(ctx, "tcp", b.opts.Address)
+	}
+	return dialer.DialContext(ctx, "tcp", b.opts.Address)
+}
+
+func (b *syslogBackend) resetConn() {
+	if b.conn != nil {
+		_ = b.conn.Close()
+		b.conn = nil
+	}
+}
+
+// syslogField returns a header field that is safe to send: printable ASCII
+// without spaces, truncated to the maximum length, or "-" when empty.
+func syslogField(s string, maxLen int) string {
+	out := make([]byte, 0, len(s))
+	for i := 0; i < len(s) && len(out) < maxLen; i++ {
+		c := s[i]
+		if c > 32 && c < 127 {
+			out = append(out, c)
+		}
+	}
+	if len(out) == 0 {
+		return "-"
+	}
+	return string(out)
+}
@@ -0,0 +1,132 @@
+package backends_test
+
+import (
+	"bufio"
+	"encoding/json"
+	"io"
+	"net"
+	"strconv"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"cdr.dev/slog/v3/sloggers/slogtest"
+	"github.com/coder/coder/v2/enterprise/audit"
+	"github.com/coder/coder/v2/enterprise/audit/audittest"
+	"github.com/coder/coder/v2/enterprise/audit/backends"
+	"github.com/coder/coder/v2/testutil"
+)
+
+func TestSyslogBackend(t *testing.T) {
+	t.Parallel()
+
+	var (
+		ctx      = testutil.Context(t, testutil.WaitShort)
+		messages = make(chan string, 2)
+	)
+
+	ln, err := net.Listen("tcp", "127.0.0.1:0")
+	require.NoError(t, err)
+	defer ln.Close()
+	go func() {
+		conn, err := ln.Accept()
+		if err != nil {
+			return
+		}
+		defer conn.Close()
+		r := bufio.NewReader(conn)
+		for {
+			msg, err := readOctetCounted(r)
+			if err != nil {
+				return
+			}
+			messages <- msg
+		}
+	}()
+
+	backend, closeFn, err := backends.NewSyslog(backends.SyslogOptions{
+		Address:  ln.Addr().String(),
+		Hostname: "coder-host",
+		Logger:   slogtest.Make(t, nil),
+	})
+	require.NoError(t, err)
+	defer closeFn()
+
+	alog := audittest.RandomLog()
+	alog.Time = time.Date(2024, 1, 2, 3, 4, 5, 0, time.UTC)
+	require.NoError(t, backend.Export(ctx, alog, audit.BackendDetails{}))
+
+	msg := testutil.RequireReceive(ctx, t, messages)
+	// local0 (16) * 8 + info (6) = 134.
+	header := "<134>1 2024-01-02T03:04:05Z coder-host coder - delete - "
+	require.True(t, strings.HasPrefix(msg, header), "unexpected message %q", msg)
+
+	var ev backends.Event
+	require.NoError(t, json.Unmarshal([]byte(strings.TrimPrefix(msg, header)), &ev))
+	require.Equal(t, alog.ID, ev.ID)
+	require.Equal(t, alog.ResourceTarget, ev.ResourceTarget)
+
+	// Failed requests are logged with warning severity.
+	alog.StatusCode = 403
+	require.NoError(t, backend.Export(ctx, alog, audit.BackendDetails{}))
+	msg = testutil.RequireReceive(ctx, t, messages)
+	require.True(t, strings.HasPrefix(msg, "<132>1 "), "unexpected message %q", msg)
+}
+
+func TestSyslogBackendUnreachable(t *testing.T) {
+	t.Parallel()
+
+	ctx := testutil.Context(t, testutil.WaitShort)
+
+	// Accept connections without reading from them, so writes stall once
+	// the socket buffers fill up.
+	ln, err := net.Listen("tcp", "127.0.0.1:0")
+	require.NoError(t, err)
+	defer ln.Close()
+	go func() {
+		for {
+			conn, err := ln.Accept()
+			if err != nil {
+				return
+			}
+			defer conn.Close()
+		}
+	}()
+
+	backend, closeFn, err := backends.NewSyslog(backends.SyslogOptions{
+		Address:     ln.Addr().String(),
+		DialTimeout: testutil.IntervalSlow,
+		BufferSize:  1,
+		Logger:      slogtest.Make(t, &slogtest.Options{IgnoreErrors: true}),
+	})
+	require.NoError(t, err)
+
+	// Exporting never blocks on the receiver or fails. Once the queue is
+	// full, audit logs are dropped.
+	alog := audittest.RandomLog()
+	alog.AdditionalFields = []byte(`{"padding":"` + strings.Repeat("x", 1<<20) + `"}`)
+	for range 10 {
+		require.NoError(t, backend.Export(ctx, alog, audit.BackendDetails{}))
+	}
+
+	ln.Close()
+	require.NoError(t, closeFn())
+}
+
+func readOctetCounted(r *bufio.Reader) (string, error) {
+	prefix, err := r.ReadString(' ')
+	if err != nil {
+		return "", err
+	}
+	n, err := strconv.Atoi(strings.TrimSpace(prefix))
+	if err != nil {
+		return "", err
+	}
+	buf := make([]byte, n)
+	if _, err := io.ReadFull(r, buf); err != nil {
+		return "", err
+	}
+	return string(buf), nil
+}
@@ -0,0 +1,269 @@
+package backends
+
+import (
+	"bytes"
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/url"
+	"sync"
+	"time"
+
+	"golang.org/x/xerrors"
+
+	"cdr.dev/slog/v3"
+	"github.com/coder/coder/v2/coderd/database"
+	"github.com/coder/coder/v2/enterprise/audit"
+	"github.com/coder/quartz"
+)
+
+// WebhookSignatureHeader carries the hex-encoded HMAC-SHA256 of the request
+// body, prefixed with "sha256=", when a signing secret is configured.
+const WebhookSignatureHeader = "X-Coder-Signature"
+
+// WebhookOptions configures the webhook backend.
+type WebhookOptions struct {
+	// URL receives batches of audit logs as a JSON array in a POST body.
+	URL *url.URL
+	// Secret signs each request body with HMAC-SHA256. Optional.
+	Secret string
+	// BatchSize is the maximum number of audit logs per request. Defaults
+	// to 100.
+	BatchSize int
+	// FlushInterval is how long audit logs wait for a batch to fill before
+	// being sent. Defaults to 5 seconds.
+	FlushInterval time.Duration
+	// MaxRetries is the number of times a failed request is retried with
+	// exponential backoff before the batch is dropped. Zero disables
+	// retries.
+	MaxRetries int
+	// BufferSize is the number of audit logs that may be queued before new
+	// ones are dropped. Defaults to 10 batches.
+	BufferSize int
+	// Filter decides which audit logs are sent. Nil sends everything.
+	Filter audit.Filter
+
+	HTTPClient *http.Client
+	Logger     slog.Logger
+	Clock      quartz.Clock
+}
+
+type webhookBackend struct {
+	opts WebhookOptions
+
+	events chan Event
+	done   chan struct{}
+	// closing is closed by Close to interrupt retry backoffs.
+	closing chan struct{}
+
+	closeOnce sync.Once
+	// closeMu guards sending on events against closing it.
+	closeMu sync.RWMutex
+	closed  bool
+}
+
+// NewWebhook returns a backend that POSTs batches of audit logs to an HTTP
+// endpoint. Batches are sent when full or after the flush interval, and failed
+// requests are retried with exponential backoff.
+func NewWebhook(opts WebhookOptions) (audit.Backend, func() error, error) {
+	if opts.URL == nil || opts.URL.String() == "" {
+		return nil, nil, xerrors.New("url is required")
+	}
+	if opts.BatchSize <= 0 {
+		opts.BatchSize = 100
+	}
+	if opts.FlushInterval <= 0 {
+		opts.FlushInterval = 5 * time.Second
+	}
+	if opts.MaxRetries < 0 {
+		return nil, nil, xerrors.Errorf("invalid max retries %d: must not be negative", opts.MaxRetries)
+	}
+	if opts.BufferSize <= 0 {
+		opts.BufferSize = opts.BatchSize * 10
+	}
+	if opts.HTTPClient == nil {
+		opts.HTTPClient = &http.Client{Timeout: 30 * time.Second}
+	}
+	if opts.Clock == nil {
+		opts.Clock = quartz.NewReal()
+	}
+
+	b := &webhookBackend{
+		opts:    opts,
+		events:  make(chan Event, opts.BufferSize),
+		done:    make(chan struct{}),
+		closing: make(chan struct{}),
+	}
+	go b.run()
+	return b, b.Close, nil
+}
+
+func (*webhookBackend) Decision() audit.FilterDecision {
+	return audit.FilterDecisionExport
+}
+
+func (b *webhookBackend) Export(ctx context.Context, alog database.AuditLog, details audit.BackendDetails) error {
+	ok, err := shouldExport(ctx, b.opts.Filter, alog)
+	if err != nil {
+		return xerrors.Errorf("check filter: %w", err)
+	}
+	if !ok {
+		return nil
+	}
+
+	b.closeMu.RLock()
+	defer b.closeMu.RUnlock()
+	// Audit logs are streamed on a best effort basis, so a slow or
+	// unreachable receiver must not fail the export to other backends.
+	if b.closed {
+		b.opts.Logger.Warn(ctx, "webhook backend is closed, dropping audit log", slog.F("audit_log_id", alog.ID))
+		return nil
+	}
+	// Never block the request that produced the audit log on a slow
+	// receiver.
+	select {
+	case b.events <- NewEvent(alog, details):
+	default:
+		b.opts.Logger.Warn(ctx, "webhook buffer is full, dropping audit log", slog.F("audit_log_id", alog.ID))
+	}
+	return nil
+}
+
+// Close stops accepting audit logs and waits for queued ones to be sent.
+func (b *webhookBackend) Close() error {
+	b.closeOnce.Do(func() {
+		b.closeMu.Lock()
+		b.closed = true
+		close(b.events)
+		b.closeMu.Unlock()
+		close(b.closing)
+	})
+	<-b.done
+	return nil
+}
+
+func (b *webhookBackend) isClosed() bool {
+	b.closeMu.RLock()
+	defer b.closeMu.RUnlock()
+	return b.closed
+}
+
+func (b *webhookBackend) run() {
+	defer close(b.done)
+
+	ticker := b.opts.Clock.NewTicker(b.opts.FlushInterval, "webhook", "flush")
+	defer ticker.Stop()
+
+	batch := make([]Event, 0, b.opts.BatchSize)
+	flush := func() {
+		if len(batch) == 0 {
+			return
+		}
+		b.send(batch)
+		batch = make([]Event, 0, b.opts.BatchSize)
+	}
+
+	for {
+		select {
+		case ev, ok := <-b.events:
+			if !ok {
+				flush()
+				return
+			}
+			batch = append(batch, ev)
+			if len(batch) >= b.opts.BatchSize {
+				flush()
+			}
+		case <-ticker.C:
+			flush()
+		}
+	}
+}
+
+// send delivers a batch, retrying with exponential backoff. Batches that still
+// fail are logged and dropped so a dead receiver cannot grow memory unbounded.
+func (b *webhookBackend) send(batch []Event) {
+	ctx := context.Background()
+	body, err := json.Marshal(batch)
+	if err != nil {
+		b.opts.Logger.Error(ctx, "marshal audit log batch", slog.Error(err))
+		return
+	}
+
+	backoff := time.Second
+	for attempt := 0; ; attempt++ {
+		retry, err := b.post(ctx, body)
+		if err == nil {
+			return
+		}
+		// Once closed, make a single attempt per batch so shutdown is not
+		// held up by an unreachable receiver.
+		if !retry || attempt >= b.opts.MaxRetries || b.isClosed() {
+			b.opts.Logger.Error(ctx, "send audit logs to webhook",
+				slog.F("count", len(batch)),
+				slog.F("attempts", attempt+1),
+				slog.Error(err),
+			)
+			return
+		}
+		b.opts.Logger.Warn(ctx, "send audit logs to webhook, retrying",
+			slog.F("backoff", backoff),
+			slog.Error(err),
+		)
+		timer := b.opts.Clock.NewTimer(backoff, "webhook", "backoff")
+		select {
+		case <-timer.C:
+		case <-b.closing:
+			// Don't hold up shutdown waiting to retry.
+			timer.Stop()
+			b.opts.Logger.Error(ctx, "send audit logs to webhook, backend closed while retrying",
+				slog.F("count", len(batch)),
+				slog.F("attempts", attempt+1),
+				slog.Error(err),
+			)
+			return
+		}
+		backoff *= 2
+		if backoff > time.Minute {
+			backoff = time.Minute
+		}
+	}
+}
+
+// post sends a single request. The returned bool reports whether the failure
+// is worth retrying.
+func (b *webhookBackend) post(ctx context.Context, body []byte) (bool, error) {
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, b.opts.URL.String(), bytes.NewReader(body))
+	if err != nil {
+		return false, xerrors.Errorf("create request: %w", err)
+	}
+	req.Header.Set("Content-Type", "application/json")
+	if b.opts.Secret != "" {
+		req.Header.Set(WebhookSignatureHeader, "sha256="+SignWebhookBody(b.opts.Secret, body))
+	}
+
+	res, err := b.opts.HTTPClient.Do(req)
+	if err != nil {
+		return true, xerrors.Errorf("post: %w", err)
+	}
+	defer res.Body.Close()
+	_, _ = io.Copy(io.Discard, io.LimitReader(res.Body, 4096))
+
+	if res.StatusCode >= 200 && res.StatusCode < 300 {
+		return false, nil
+	}
+	retry := res.StatusCode == http.StatusTooManyRequests || res.StatusCode >= 500
+	return retry, xerrors.Errorf("unexpected status code %d", res.StatusCode)
+}
+
+// SignWebhookBody returns the hex-encoded HMAC-SHA256 of body. Receivers can
+// use it to verify the WebhookSignatureHeader.
+func SignWebhookBody(secret string, body []byte) string {
+	mac := hmac.New(sha256.New, []byte(secret))
+	_, _ = mac.Write(body)
+	return hex.EncodeToString(mac.Sum(nil))
+}
@@ -0,0 +1,254 @@
+package backends_test
+
+import (
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"cdr.dev/slog/v3/sloggers/slogtest"
+	"github.com/coder/coder/v2/enterprise/audit"
+	"github.com/coder/coder/v2/enterprise/audit/audittest"
+	"github.com/coder/coder/v2/enterprise/audit/backends"
+	"github.com/coder/coder/v2/testutil"
+	"github.com/coder/quartz"
+)
+
+type webhookRequest struct {
+	signature string
+	events    []backends.Event
+}
+
+func newWebhookServer(t *testing.T, statusCodes ...int) (*url.URL, <-chan webhookRequest) {
+	t.Helper()
+
+	var (
+		requests = make(chan webhookRequest, 10)
+		count    atomic.Int64
+	)
+	srv := httptest.NewServer(http.HandlerFunc(func(rw http.ResponseWriter, r *http.Request) {
+		body, err := io.ReadAll(r.Body)
+		if !assertNoError(t, err) {
+			return
+		}
+		n := int(count.Add(1)) - 1
+		if n < len(statusCodes) && statusCodes[n] != http.StatusOK {
+			rw.WriteHeader(statusCodes[n])
+			return
+		}
+		var events []backends.Event
+		if !assertNoError(t, json.Unmarshal(body, &events)) {
+			return
+		}
+		// Verify the signature here, since the body is consumed.
+		sig := r.Header.Get(backends.WebhookSignatureHeader)
+		if sig != "" && sig != "sha256="+backends.SignWebhookBody("secret", body) {
+			sig = "invalid"
+		}
+		requests <- webhookRequest{signature: sig, events: events}
+		rw.WriteHeader(http.StatusNoContent)
+	}))
+	t.Cleanup(srv.Close)
+
+	u, err := url.Parse(srv.URL)
+	require.NoError(t, err)
+	return u, requests
+}
+
+func assertNoError(t *testing.T, err error) bool {
+	t.Helper()
+	if err != nil {
+		t.Errorf("unexpected error: %v", err)
+		return false
+	}
+	return true
+}
+
+func TestWebhookBackend(t *testing.T) {
+	t.Parallel()
+
+	t.Run("BatchSize", func(t *testing.T) {
+		t.Parallel()
+
+		ctx := testutil.Context(t, testutil.WaitShort)
+		u, requests := newWebhookServer(t)
+
+		backend, closeFn, err := backends.NewWebhook(backends.WebhookOptions{
+			URL:           u,
+			Secret:        "secret",
+			BatchSize:     2,
+			FlushInterval: time.Hour,
+			Logger:        slogtest.Make(t, nil),
+		})
+		require.NoError(t, err)
+		defer closeFn()
+
+		first, second := audittest.RandomLog(), audittest.RandomLog()
+		require.NoError(t, backend.Export(ctx, first, audit.BackendDetails{}))
+		require.NoError(t, backend.Export(ctx, second, audit.BackendDetails{}))
+
+		req := testutil.RequireReceive(ctx, t, requests)
+		require.NotEmpty(t, req.signature)
+		require.NotEqual(t, "invalid", req.signature)
+		require.Len(t, req.events, 2)
+		require.Equal(t, first.ID, req.events[0].ID)
+		require.Equal(t, second.ID, req.events[1].ID)
+	})
+
+	t.Run("FlushInterval", func(t *testing.T) {
+		t.Parallel()
+
+		ctx := testutil.Context(t, testutil.WaitShort)
+		u, requests := newWebhookServer(t)
+		clock := quartz.NewMock(t)
+		tickerTrap := clock.Trap().NewTicker("webhook", "flush")
+		defer tickerTrap.Close()
+
+		backend, closeFn, err := backends.NewWebhook(backends.WebhookOptions{
+			URL:           u,
+			FlushInterval: time.Minute,
+			Logger:        slogtest.Make(t, nil),
+			Clock:         clock,
+		})
+		require.NoError(t, err)
+		defer closeFn()
+		tickerTrap.MustWait(ctx).MustRelease(ctx)
+
+		alog := audittest.RandomLog()
+		require.NoError(t, backend.Export(ctx, alog, audit.BackendDetails{}))
+		// The tick may race with the event being read from the queue, so keep
+		// ticking until the batch arrives.
+		var req webhookRequest
+		require.Eventually(t, func() bool {
+			clock.Advance(time.Minute).MustWait(ctx)
+			select {
+			case req = <-requests:
+				return true
+			default:
+				return false
+			}
+		}, testutil.WaitShort, testutil.IntervalFast)
+		require.Empty(t, req.signature)
+		require.Len(t, req.events, 1)
+		require.Equal(t, alog.ID, req.events[0].ID)
+	})
+
+	t.Run("Retry", func(t *testing.T) {
+		t.Parallel()
+
+		ctx := testutil.Context(t, testutil.WaitShort)
+		u, requests := newWebhookServer(t, http.StatusServiceUnavailable, http.StatusOK)
+		clock := quartz.NewMock(t)
+		backoffTrap := clock.Trap().NewTimer("webhook", "backoff")
+		defer backoffTrap.Close()
+
+		backend, closeFn, err := backends.NewWebhook(backends.WebhookOptions{
+			URL:        u,
+			BatchSize:  1,
+			MaxRetries: 1,
+			Logger:     slogtest.Make(t, &slogtest.Options{IgnoreErrors: true}),
+			Clock:      clock,
+		})
+		require.NoError(t, err)
+		defer closeFn()
+
+		alog := audittest.RandomLog()
+		require.NoError(t, backend.Export(ctx, alog, audit.BackendDetails{}))
+
+		backoffTrap.MustWait(ctx).MustRelease(ctx)
+		clock.Advance(time.Second).MustWait(ctx)
+
+		req := testutil.RequireReceive(ctx, t, requests)
+		require.Len(t, req.events, 1)
+		require.Equal(t, alog.ID, req.events[0].ID)
+	})
+
+	t.Run("CloseDuringBackoff", func(t *testing.T) {
+		t.Parallel()
+
+		ctx := testutil.Context(t, testutil.WaitShort)
+		u, _ := newWebhookServer(t, http.StatusServiceUnavailable)
+		clock := quartz.NewMock(t)
+		backoffTrap := clock.Trap().NewTimer("webhook", "backoff")
+		defer backoffTrap.Close()
+
+		backend, closeFn, err := backends.NewWebhook(backends.WebhookOptions{
+			URL:        u,
+			BatchSize:  1,
+			MaxRetries: 5,
+			Logger:     slogtest.Make(t, &slogtest.Options{IgnoreErrors: true}),
+			Clock:      clock,
+		})
+		require.NoError(t, err)
+
+		require.NoError(t, backend.Export(ctx, audittest.RandomLog(), audit.BackendDetails{}))
+		backoffTrap.MustWait(ctx).MustRelease(ctx)
+
+		// The clock is never advanced, so Close only returns if it
+		// interrupts the backoff.
+		closed := make(chan error, 1)
+		go func() {
+			closed <- closeFn()
+		}()
+		require.NoError(t, testutil.RequireReceive(ctx, t, closed))
+	})
+
+	t.Run("NoRetries", func(t *testing.T) {
+		t.Parallel()
+
+		ctx := testutil.Context(t, testutil.WaitShort)
+		u, requests := newWebhookServer(t, http.StatusServiceUnavailable, http.StatusOK)
+
+		// The mock clock is never advanced, so a retry would block the
+		// second batch forever.
+		backend, closeFn, err := backends.NewWebhook(backends.WebhookOptions{
+			URL:       u,
+			BatchSize: 1,
+			Logger:    slogtest.Make(t, &slogtest.Options{IgnoreErrors: true}),
+			Clock:     quartz.NewMock(t),
+		})
+		require.NoError(t, err)
+		defer closeFn()
+
+		dropped, sent := audittest.RandomLog(), audittest.RandomLog()
+		require.NoError(t, backend.Export(ctx, dropped, audit.BackendDetails{}))
+		require.NoError(t, backend.Export(ctx, sent, audit.BackendDetails{}))
+
+		req := testutil.RequireReceive(ctx, t, requests)
+		require.Len(t, req.events, 1)
+		require.Equal(t, sent.ID, req.events[0].ID)
+	})
+
+	t.Run("Filter", func(t *testing.T) {
+		t.Parallel()
+
+		ctx := testutil.Context(t, testutil.WaitShort)
+		u, requests := newWebhookServer(t)
+
+		filter, err := audit.ParseFilter([]string{"action:create"})
+		require.NoError(t, err)
+		backend, closeFn, err := backends.NewWebhook(backends.WebhookOptions{
+			URL:           u,
+			BatchSize:     1,
+			FlushInterval: time.Hour,
+			Filter:        filter,
+			Logger:        slogtest.Make(t, nil),
+		})
+		require.NoError(t, err)
+
+		// RandomLog is a delete, so it is dropped.
+		require.NoError(t, backend.Export(ctx, audittest.RandomLog(), audit.BackendDetails{}))
+		require.NoError(t, closeFn())
+		select {
+		case <-requests:
+			t.Fatal("unexpected request")
+		default:
+		}
+	})
+}
@@ -2,6 +2,9 @@ package audit
 
 import (
 	"context"
+	"strings"
+
+	"golang.org/x/xerrors"
 
 	"github.com/coder/coder/v2/coderd/database"
 )
@@ -40,3 +43,55 @@ type FilterFunc func(ctx context.Context, alog database.AuditLog) (FilterDecisio
 func (f FilterFunc) Check(ctx context.Context, alog database.AuditLog) (FilterDecision, error) {
 	return f(ctx, alog)
 }
+
+// ParseFilter constructs a Filter from a list of "key:value" terms. Terms
+// sharing a key are ORed together, and terms with different keys are ANDed.
+// Supported keys are "resource_type" and "action". An empty list allows every
+// audit log to be stored and exported.
+//
+// For example, ["resource_type:workspace", "resource_type:template",
+// "action:delete"] matches deleted workspaces and templates.
+func ParseFilter(terms []string) (Filter, error) {
+	if len(terms) == 0 {
+		return DefaultFilter, nil
+	}
+
+	resourceTypes := map[database.ResourceType]struct{}{}
+	actions := map[database.AuditAction]struct{}{}
+	for _, term := range terms {
+		key, value, ok := strings.Cut(strings.TrimSpace(term), ":")
+		if !ok || value == "" {
+			return nil, xerrors.Errorf("invalid filter term %q: expected \"key:value\"", term)
+		}
+		switch key {
+		case "resource_type":
+			rt := database.ResourceType(value)
+			if !rt.Valid() {
+				return nil, xerrors.Errorf("invalid resource type %q", value)
+			}
+			resourceTypes[rt] = struct{}{}
+		case "action":
+			action := database.AuditAction(value)
+			if !action.Valid() {
+				return nil, xerrors.Errorf("invalid audit action %q", value)
+			}
+			actions[action] = struct{}{}
+		default:
+			return nil, xerrors.Errorf("unknown filter key %q", key)
+		}
+	}
+
+	return FilterFunc(func(_ context.Context, alog database.AuditLog) (FilterDecision, error) {
+		if len(resourceTypes) > 0 {
+			if _, ok := resourceTypes[alog.ResourceType]; !ok {
+				return FilterDecisionDrop, nil
+			}
+		}
+		if len(actions) > 0 {
+			if _, ok := actions[alog.Action]; !ok {
+				return FilterDecisionDrop, nil
+			}
+		}
+		return FilterDecisionStore | FilterDecisionExport, nil
+	}), nil
+}
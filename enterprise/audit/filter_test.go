@@ -0,0 +1,102 @@
+package audit_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/coder/coder/v2/coderd/database"
+	"github.com/coder/coder/v2/enterprise/audit"
+	"github.com/coder/coder/v2/enterprise/audit/audittest"
+)
+
+func TestParseFilter(t *testing.T) {
+	t.Parallel()
+
+	allow := audit.FilterDecisionStore | audit.FilterDecisionExport
+
+	tests := []struct {
+		name         string
+		terms        []string
+		resourceType database.ResourceType
+		action       database.AuditAction
+		expected     audit.FilterDecision
+		expectErr    bool
+	}{
+		{
+			name:         "Empty",
+			resourceType: database.ResourceTypeUser,
+			action:       database.AuditActionCreate,
+			expected:     allow,
+		},
+		{
+			name:         "ResourceTypeMatch",
+			terms:        []string{"resource_type:workspace", "resource_type:template"},
+			resourceType: database.ResourceTypeTemplate,
+			action:       database.AuditActionWrite,
+			expected:     allow,
+		},
+		{
+			name:         "ResourceTypeMismatch",
+			terms:        []string{"resource_type:workspace"},
+			resourceType: database.ResourceTypeUser,
+			action:       database.AuditActionWrite,
+			expected:     audit.FilterDecisionDrop,
+		},
+		{
+			name:         "ResourceTypeAndAction",
+			terms:        []string{"resource_type:workspace", "action:delete"},
+			resourceType: database.ResourceTypeWorkspace,
+			action:       database.AuditActionDelete,
+			expected:     allow,
+		},
+		{
+			name:         "ActionMismatch",
+			terms:        []string{"resource_type:workspace", "action:delete"},
+			resourceType: database.ResourceTypeWorkspace,
+			action:       database.AuditActionCreate,
+			expected:     audit.FilterDecisionDrop,
+		},
+		{
+			name:      "UnknownKey",
+			terms:     []string{"user:admin"},
+			expectErr: true,
+		},
+		{
+			name:      "InvalidResourceType",
+			terms:     []string{"resource_type:spaceship"},
+			expectErr: true,
+		},
+		{
+			name:      "InvalidAction",
+			terms:     []string{"action:explode"},
+			expectErr: true,
+		},
+		{
+			name:      "Malformed",
+			terms:     []string{"workspace"},
+			expectErr: true,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+
+			filter, err := audit.ParseFilter(test.terms)
+			if test.expectErr {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+
+			alog := audittest.RandomLog()
+			alog.ResourceType = test.resourceType
+			alog.Action = test.action
+			decision, err := filter.Check(context.Background(), alog)
+			require.NoError(t, err)
+			require.Equal(t, test.expected, decision)
+		})
+	}
+}
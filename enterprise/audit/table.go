@@ -39,6 +39,7 @@ var AuditActionMap = map[string][]codersdk.AuditAction{
 	"UserSecret":                    {codersdk.AuditActionCreate, codersdk.AuditActionWrite, codersdk.AuditActionDelete},
 	"UserSkill":                     {codersdk.AuditActionCreate, codersdk.AuditActionWrite, codersdk.AuditActionDelete},
 	"ChatInstructionSettings":       {codersdk.AuditActionWrite},
+	"WorkspaceBulkOperation":        {codersdk.AuditActionCreate, codersdk.AuditActionWrite},
 }
 
 type Action string
@@ -134,6 +135,7 @@ var auditableResourcesTypes = map[any]map[string]Action{
 		"disable_module_cache":              ActionTrack,
 		"time_til_autostop_notify":          ActionTrack,
 		"agents_allowed":                    ActionTrack,
+		"drift_check_interval":              ActionTrack,
 	},
 	&database.TemplateVersion{}: {
 		"id":                      ActionTrack,
@@ -346,8 +348,9 @@ var auditableResourcesTypes = map[any]map[string]Action{
 		"software_id":                ActionTrack,  // Client software identification
 		"software_version":           ActionTrack,  // Client software version
 		// RFC 7592 Management fields - sensitive data
-		"registration_access_token": ActionSecret, // Secret token for client management
-		"registration_client_uri":   ActionTrack,  // Management endpoint URI
+		"registration_access_token":  ActionSecret, // Secret token for client management
+		"registration_client_uri":    ActionTrack,  // Management endpoint URI
+		"client_credentials_user_id": ActionTrack,  // Security relevant - identity for client_credentials tokens
 	},
 	&database.OAuth2ProviderAppSecret{}: {
 		"id":             ActionIgnore,
@@ -562,6 +565,23 @@ var auditableResourcesTypes = map[any]map[string]Action{
 		"created_at":   ActionIgnore,
 		"updated_at":   ActionIgnore,
 	},
+	&database.WorkspaceBulkOperation{}: {
+		"id":                 ActionTrack,
+		"initiator_id":       ActionTrack,
+		"action":             ActionTrack,
+		"query":              ActionTrack,
+		"autostart_schedule": ActionTrack,
+		"ttl_ms":             ActionTrack,
+		"automatic_updates":  ActionTrack,
+		"concurrency":        ActionTrack,
+		"workspace_count":    ActionTrack,
+		"status":             ActionTrack,
+		"created_at":         ActionIgnore,
+		"updated_at":         ActionIgnore,
+		"completed_at":       ActionIgnore,
+		"api_key_scopes":     ActionTrack,
+		"api_key_allow_list": ActionTrack,
+	},
 }
 
 // auditMap converts a map of struct pointers to a map of struct names as
@@ -4,10 +4,13 @@ package cli
 
 import (
 	"context"
+	"crypto/tls"
+	"crypto/x509"
 	"database/sql"
 	"encoding/base64"
 	"errors"
 	"io"
+	"os"
 	"time"
 
 	"golang.org/x/xerrors"
@@ -72,11 +75,17 @@ func (r *RootCmd) Server(_ func()) *serpent.Command {
 			options.DERPServer.SetMeshKey(meshKey)
 		}
 
+		streamingBackends, auditClosers, err := auditStreamingBackends(options)
+		if err != nil {
+			return nil, nil, xerrors.Errorf("configure audit log streaming: %w", err)
+		}
 		options.Auditor = audit.NewAuditor(
 			options.Database,
 			audit.DefaultFilter,
-			backends.NewPostgres(options.Database, true),
-			backends.NewSlog(options.Logger),
+			append([]audit.Backend{
+				backends.NewPostgres(options.Database, true),
+				backends.NewSlog(options.Logger),
+			}, streamingBackends...)...,
 		)
 
 		options.TrialGenerator = trialer.New(options.Database, trialer.LicenseRequestURL, coderd.Keys).Generate
@@ -123,9 +132,13 @@ func (r *RootCmd) Server(_ func()) *serpent.Command {
 		// Create the enterprise API.
 		api, err := coderd.New(ctx, o)
 		if err != nil {
+			_ = auditClosers.Close()
 			return nil, nil, err
 		}
 		closers.Add(api)
+		// Streaming backends are closed after the API so they can flush the
+		// audit logs it produces while shutting down.
+		closers.Add(auditClosers)
 
 		// Start the enterprise usage publisher routine. This won't do anything
 		// unless the deployment is licensed and one of the licenses has usage
@@ -201,6 +214,102 @@ func (r *RootCmd) Server(_ func()) *serpent.Command {
 	return cmd
 }
 
+// auditStreamingBackends constructs the audit backends enabled by the audit log
+// streaming deployment values, each with its own filter.
+func auditStreamingBackends(options *agplcoderd.Options) ([]audit.Backend, *multiCloser, error) {
+	var (
+		cfg       = options.DeploymentValues.AuditLogStreaming
+		logger    = options.Logger.Named("audit")
+		closers   = &multiCloser{}
+		streaming []audit.Backend
+	)
+	fail := func(err error) ([]audit.Backend, *multiCloser, error) {
+		_ = closers.Close()
+		return nil, nil, err
+	}
+
+	if addr := cfg.SyslogAddress.Value(); addr != "" {
+		filter, err := audit.ParseFilter(cfg.SyslogFilter.Value())
+		if err != nil {
+			return fail(xerrors.Errorf("parse syslog filter: %w", err))
+		}
+		var tlsConfig *tls.Config
+		if cfg.SyslogTLS.Value() {
+			tlsConfig = &tls.Config{MinVersion: tls.VersionTLS12}
+			if caFile := cfg.SyslogTLSCAFile.Value(); caFile != "" {
+				pem, err := os.ReadFile(caFile)
+				if err != nil {
+					return fail(xerrors.Errorf("read syslog CA file: %w", err))
+				}
+				pool := x509.NewCertPool()
+				if !pool.AppendCertsFromPEM(pem) {
+					return fail(xerrors.Errorf("no certificates found in syslog CA file %q", caFile))
+				}
+				tlsConfig.RootCAs = pool
+			}
+		}
+		backend, closeFn, err := backends.NewSyslog(backends.SyslogOptions{
+			Address:   addr,
+			TLSConfig: tlsConfig,
+			Facility:  int(cfg.SyslogFacility.Value()),
+			Filter:    filter,
+			Logger:    logger.Named("syslog"),
+		})
+		if err != nil {
+			return fail(xerrors.Errorf("create syslog backend: %w", err))
+		}
+		streaming = append(streaming, backend)
+		closers.Add(closerFunc(closeFn))
+	}
+
+	if u := cfg.WebhookURL.Value(); u != nil && u.String() != "" {
+		filter, err := audit.ParseFilter(cfg.WebhookFilter.Value())
+		if err != nil {
+			return fail(xerrors.Errorf("parse webhook filter: %w", err))
+		}
+		backend, closeFn, err := backends.NewWebhook(backends.WebhookOptions{
+			URL:           u,
+			Secret:        cfg.WebhookSecret.Value(),
+			BatchSize:     int(cfg.WebhookBatchSize.Value()),
+			FlushInterval: cfg.WebhookFlushInterval.Value(),
+			MaxRetries:    int(cfg.WebhookMaxRetries.Value()),
+			Filter:        filter,
+			Logger:        logger.Named("webhook"),
+		})
+		if err != nil {
+			return fail(xerrors.Errorf("create webhook backend: %w", err))
+		}
+		streaming = append(streaming, backend)
+		closers.Add(closerFunc(closeFn))
+	}
+
+	if path := cfg.FilePath.Value(); path != "" {
+		filter, err := audit.ParseFilter(cfg.FileFilter.Value())
+		if err != nil {
+			return fail(xerrors.Errorf("parse file filter: %w", err))
+		}
+		backend, closeFn, err := backends.NewJSONL(backends.JSONLOptions{
+			Path:       path,
+			MaxSizeMB:  int(cfg.FileMaxSizeMB.Value()),
+			MaxBackups: int(cfg.FileMaxBackups.Value()),
+			Filter:     filter,
+		})
+		if err != nil {
+			return fail(xerrors.Errorf("create file backend: %w", err))
+		}
+		streaming = append(streaming, backend)
+		closers.Add(closerFunc(closeFn))
+	}
+
+	return streaming, closers, nil
+}
+
+type closerFunc func() error
+
+func (f closerFunc) Close() error {
+	return f()
+}
+
 type multiCloser struct {
 	closers []io.Closer
 }
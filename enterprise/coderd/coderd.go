@@ -146,6 +146,11 @@ func New(ctx context.Context, options *Options) (_ *API, err error) {
 	if options.IDPSync == nil {
 		options.IDPSync = enidpsync.NewSync(options.Logger, options.RuntimeConfig, options.Entitlements, idpsync.FromDeploymentValues(options.DeploymentValues))
 	}
+	for _, provider := range options.OIDCProviders {
+		if provider.IDPSync == nil {
+			provider.IDPSync = enidpsync.NewSync(options.Logger.With(slog.F("oidc_provider", provider.ID)), options.RuntimeConfig, options.Entitlements, provider.SyncSettings)
+		}
+	}
 
 	if options.ConnectionLogger == nil {
 		connLogger := connectionlog.New(
@@ -264,8 +269,9 @@ func New(ctx context.Context, options *Options) (_ *API, err error) {
 	}
 
 	oauthConfigs := &httpmw.OAuth2Configs{
-		Github: options.GithubOAuth2Config,
-		OIDC:   options.OIDCConfig,
+		Github:        options.GithubOAuth2Config,
+		OIDC:          options.OIDCConfig,
+		OIDCProviders: coderd.OIDCProviderOAuth2Configs(options.OIDCProviders),
 	}
 	apiKeyMiddleware := httpmw.ExtractAPIKeyMW(httpmw.ExtractAPIKeyConfig{
 		DB:                            options.Database,
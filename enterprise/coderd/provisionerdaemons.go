@@ -18,6 +18,7 @@ import (
 	"storj.io/drpc/drpcserver"
 
 	"cdr.dev/slog/v3"
+	"github.com/coder/coder/v2/coderd"
 	"github.com/coder/coder/v2/coderd/database"
 	"github.com/coder/coder/v2/coderd/database/dbauthz"
 	"github.com/coder/coder/v2/coderd/database/dbtime"
@@ -356,6 +357,7 @@ func (api *API) provisionerDaemonServe(rw http.ResponseWriter, r *http.Request)
 		provisionerdserver.Options{
 			ExternalAuthConfigs: api.ExternalAuthConfigs,
 			OIDCConfig:          api.OIDCConfig,
+			OIDCProviders:       coderd.OIDCProviderOAuth2Configs(api.OIDCProviders),
 			AISeatTracker:       api.AGPL.AISeatTracker,
 			Clock:               api.Clock,
 			KeyID:               authRes.keyID,
@@ -240,6 +240,22 @@ func (s *EnterpriseTemplateScheduleStore) Set(ctx context.Context, db database.S
 			return database.Template{}, xerrors.Errorf("get workspaces by template id: %w", err)
 		}
 
+		ids := make([]uuid.UUID, 0, len(workspaces))
+		for _, workspace := range workspaces {
+			ids = append(ids, workspace.ID)
+		}
+		// Exceptions are loaded from the day before, since dates are compared
+		// in the timezone of each workspace's schedule.
+		//nolint:gocritic // We need to be able to read the schedules of all workspaces.
+		exceptionRows, err := db.GetWorkspaceAutostartExceptionsByWorkspaceIDs(dbauthz.AsSystemRestricted(ctx), database.GetWorkspaceAutostartExceptionsByWorkspaceIDsParams{
+			WorkspaceIDs: ids,
+			After:        s.now().AddDate(0, 0, -1),
+		})
+		if err != nil {
+			return database.Template{}, xerrors.Errorf("get workspace autostart exceptions: %w", err)
+		}
+		exceptions := agpl.AutostartExceptionsByWorkspace(exceptionRows)
+
 		workspaceIDs := []uuid.UUID{}
 		nextStartAts := []time.Time{}
 
@@ -250,7 +266,7 @@ func (s *EnterpriseTemplateScheduleStore) Set(ctx context.Context, db database.S
 			}
 			nextStartAt := time.Time{}
 			if workspace.AutostartSchedule.Valid {
-				next, err := agpl.NextAllowedAutostart(s.now(), workspace.AutostartSchedule.String, templateSchedule)
+				next, err := agpl.NextAllowedAutostartWithExceptions(s.now(), workspace.AutostartSchedule.String, templateSchedule, exceptions[workspace.ID])
 				if err == nil {
 					nextStartAt = dbtime.Time(next.UTC())
 				}
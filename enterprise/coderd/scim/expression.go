@@ -1,6 +1,10 @@
 package scim
 
 import (
+	"fmt"
+
+	scimErrors "github.com/elimity-com/scim/errors"
+	"github.com/google/uuid"
 	"github.com/scim2/filter-parser/v2"
 	"golang.org/x/xerrors"
 
@@ -37,3 +41,64 @@ func userQuery(expr filter.Expression) (database.GetUsersParams, error) {
 
 	return getUsers, nil
 }
+
+// groupQuery only supports queries of a singular attribute expression on
+// the group display name or ID. Okta and Entra ID look up groups by
+// displayName before creating them.
+// Eg: displayName eq "developers"
+func groupQuery(expr filter.Expression) (database.GetGroupsParams, error) {
+	if expr == nil {
+		return database.GetGroupsParams{}, nil
+	}
+
+	attrExpr, ok := expr.(*filter.AttributeExpression)
+	if !ok {
+		return database.GetGroupsParams{}, xerrors.Errorf("expected attribute expression")
+	}
+
+	if attrExpr.Operator != filter.EQ {
+		return database.GetGroupsParams{}, xerrors.Errorf("unsupported filter operator: %s", attrExpr.Operator)
+	}
+
+	attrValue, ok := attrExpr.CompareValue.(string)
+	if !ok {
+		return database.GetGroupsParams{}, xerrors.Errorf("expected string compare value")
+	}
+
+	var getGroups database.GetGroupsParams
+	switch attrExpr.AttributePath.AttributeName {
+	case "displayName":
+		getGroups.DisplayNames = []string{attrValue}
+	case "id":
+		id, err := uuid.Parse(attrValue)
+		if err != nil {
+			return database.GetGroupsParams{}, xerrors.Errorf("invalid group id %q: %w", attrValue, err)
+		}
+		getGroups.GroupIds = []uuid.UUID{id}
+	default:
+		return database.GetGroupsParams{}, xerrors.Errorf("unsupported filter attribute: %s", attrExpr.AttributePath.AttributeName)
+	}
+
+	return getGroups, nil
+}
+
+// memberFilterID extracts the user ID from a member value filter as sent
+// by Entra ID when removing a member.
+// Eg: members[value eq "2819c223-7f76-453a-919d-413861904646"]
+func memberFilterID(expr filter.Expression) (uuid.UUID, error) {
+	attrExpr, ok := expr.(*filter.AttributeExpression)
+	if !ok || attrExpr.Operator != filter.EQ || attrExpr.AttributePath.AttributeName != "value" {
+		return uuid.Nil, scimErrors.ScimErrorBadRequest(fmt.Sprintf("unsupported member filter: %s", expr))
+	}
+
+	attrValue, ok := attrExpr.CompareValue.(string)
+	if !ok {
+		return uuid.Nil, scimErrors.ScimErrorBadRequest("expected string compare value")
+	}
+
+	id, err := uuid.Parse(attrValue)
+	if err != nil {
+		return uuid.Nil, scimErrors.ScimErrorBadRequest(fmt.Sprintf("member %q is not a valid user id", attrValue))
+	}
+	return id, nil
+}
@@ -0,0 +1,844 @@
+package scim
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+	"fmt"
+	"math"
+	"net/http"
+	"regexp"
+	"slices"
+	"strings"
+
+	"github.com/elimity-com/scim"
+	scimErrors "github.com/elimity-com/scim/errors"
+	"github.com/elimity-com/scim/optional"
+	"github.com/elimity-com/scim/schema"
+	"github.com/google/uuid"
+	"github.com/scim2/filter-parser/v2"
+	"golang.org/x/xerrors"
+
+	"github.com/coder/coder/v2/coderd/audit"
+	"github.com/coder/coder/v2/coderd/database"
+	"github.com/coder/coder/v2/codersdk"
+)
+
+// GroupExtensionSchemaID is the SCIM schema extension that carries the
+// Coder specific group fields. IdPs that do not know about it get groups
+// in the default organization with no quota allowance.
+const GroupExtensionSchemaID = "urn:ietf:params:scim:schemas:extension:coder:2.0:Group"
+
+var _ scim.ResourceHandler = (*ResourceGroup)(nil)
+
+// GroupExtensionSchema describes the Coder group extension attributes.
+func GroupExtensionSchema() schema.Schema {
+	return schema.Schema{
+		ID:          GroupExtensionSchemaID,
+		Name:        optional.NewString("CoderGroup"),
+		Description: optional.NewString("Coder group extension"),
+		Attributes: []schema.CoreAttribute{
+			schema.SimpleCoreAttribute(schema.SimpleStringParams(schema.StringParams{
+				Description: optional.NewString("ID or name of the organization the group belongs to. Defaults to the default organization."),
+				Mutability:  schema.AttributeMutabilityImmutable(),
+				Name:        "organization",
+			})),
+			schema.SimpleCoreAttribute(schema.SimpleNumberParams(schema.NumberParams{
+				Description: optional.NewString("Workspace quota allowance granted to each member of the group."),
+				Name:        "quotaAllowance",
+				Type:        schema.AttributeTypeInteger(),
+			})),
+		},
+	}
+}
+
+// ResourceGroup maps SCIM groups onto Coder groups. The SCIM displayName is
+// the Coder group display name, and a unique group name is derived from it
+// when the group is created. Members are referenced by Coder user ID.
+type ResourceGroup struct {
+	store database.Store
+	opts  *Options
+}
+
+// auditGroup emits an audit log for a SCIM group operation. See auditUser
+// for why BackgroundAudit is used.
+func (rg *ResourceGroup) auditGroup(ctx context.Context, r *http.Request, action database.AuditAction, old, changed database.AuditableGroup) {
+	raw, _ := json.Marshal(map[string]string{
+		"automatic_actor":     "coder",
+		"automatic_subsystem": "scim",
+	})
+	auditor := *rg.opts.Auditor.Load()
+
+	orgID := changed.OrganizationID
+	if orgID == uuid.Nil {
+		orgID = old.OrganizationID
+	}
+
+	audit.BackgroundAudit(ctx, &audit.BackgroundAuditParams[database.AuditableGroup]{
+		Audit:            auditor,
+		Log:              rg.opts.Logger,
+		UserID:           uuid.Nil, // SCIM provisioner, not a real user
+		OrganizationID:   orgID,
+		Action:           action,
+		Old:              old,
+		New:              changed,
+		IP:               r.RemoteAddr,
+		UserAgent:        r.UserAgent(),
+		AdditionalFields: raw,
+		Status:           http.StatusOK,
+	})
+}
+
+// Create implements scim.ResourceHandler. Creates a new Coder group and
+// adds the listed members to it.
+func (rg *ResourceGroup) Create(r *http.Request, attributes scim.ResourceAttributes) (scim.Resource, error) {
+	ctx := r.Context()
+
+	displayName, _ := attributeAsString(attributes, "displayName")
+	if err := validGroupDisplayName(displayName); err != nil {
+		return scim.Resource{}, err
+	}
+
+	org, err := rg.organization(ctx, attributes)
+	if err != nil {
+		return scim.Resource{}, err
+	}
+
+	quota, _, err := quotaAllowance(attributes)
+	if err != nil {
+		return scim.Resource{}, err
+	}
+
+	rawMembers, _ := attribute(attributes, "members")
+	members, err := memberIDs(rawMembers)
+	if err != nil {
+		return scim.Resource{}, err
+	}
+
+	var group database.Group
+	err = rg.store.InTx(func(tx database.Store) error {
+		if err := checkDisplayNameUnique(ctx, tx, org.ID, uuid.Nil, displayName); err != nil {
+			return err
+		}
+		name, err := uniqueGroupName(ctx, tx, org.ID, displayName)
+		if err != nil {
+			return err
+		}
+		group, err = tx.InsertGroup(ctx, database.InsertGroupParams{
+			ID:             uuid.New(),
+			Name:           name,
+			DisplayName:    displayName,
+			OrganizationID: org.ID,
+			QuotaAllowance: quota,
+		})
+		if err != nil {
+			return err
+		}
+		return addGroupMembers(ctx, tx, group, members)
+	}, nil)
+	if database.IsUniqueViolation(err) {
+		return scim.Resource{}, scimErrors.ScimError{
+			ScimType: scimErrors.ScimTypeUniqueness,
+			Detail:   fmt.Sprintf("group %q already exists in organization %q", displayName, org.Name),
+			Status:   http.StatusConflict,
+		}
+	}
+	if err != nil {
+		return scim.Resource{}, err
+	}
+
+	current, err := rg.members(ctx, group.ID)
+	if err != nil {
+		return scim.Resource{}, err
+	}
+
+	rg.auditGroup(ctx, r, database.AuditActionCreate, database.AuditableGroup{}, group.Auditable(current))
+	return groupResource(group, org.Name, current), nil
+}
+
+// Get implements scim.ResourceHandler. Returns a single group by ID.
+func (rg *ResourceGroup) Get(r *http.Request, idStr string) (scim.Resource, error) {
+	ctx := r.Context()
+
+	group, err := rg.group(ctx, idStr)
+	if err != nil {
+		return scim.Resource{}, err
+	}
+
+	return rg.resource(ctx, group)
+}
+
+// GetAll implements scim.ResourceHandler. Returns a paginated list of
+// groups across all organizations. The "Everyone" groups are implicit and
+// never listed.
+func (rg *ResourceGroup) GetAll(r *http.Request, params scim.ListRequestParams) (scim.Page, error) {
+	ctx := r.Context()
+
+	var qry database.GetGroupsParams
+	if params.FilterValidator != nil {
+		var err error
+		qry, err = groupQuery(params.FilterValidator.GetFilter())
+		if err != nil {
+			return scim.Page{}, scimErrors.ScimErrorBadRequest(fmt.Sprintf("invalid filter: %v", err))
+		}
+	}
+
+	rows, err := getGroups(ctx, rg.store, qry)
+	if err != nil {
+		return scim.Page{}, err
+	}
+	rows = slices.DeleteFunc(rows, func(row database.GetGroupsRow) bool {
+		return row.Group.IsEveryone()
+	})
+
+	// GetGroups does not support offsets, so paginate in memory. Group
+	// counts are small compared to users.
+	total := len(rows)
+	start := min(max(params.StartIndex-1, 0), total)
+	end := total
+	if params.Count >= 0 {
+		end = min(start+params.Count, total)
+	}
+
+	resources := make([]scim.Resource, 0, end-start)
+	for _, row := range rows[start:end] {
+		members, err := rg.members(ctx, row.Group.ID)
+		if err != nil {
+			return scim.Page{}, err
+		}
+		resources = append(resources, groupResource(row.Group, row.OrganizationName, members))
+	}
+
+	return scim.Page{
+		TotalResults: total,
+		Resources:    resources,
+	}, nil
+}
+
+// Replace implements scim.ResourceHandler (PUT). Changes the display name,
+// sets the quota allowance and replaces the full member list.
+func (rg *ResourceGroup) Replace(r *http.Request, idStr string, attributes scim.ResourceAttributes) (scim.Resource, error) {
+	ctx := r.Context()
+
+	group, err := rg.group(ctx, idStr)
+	if err != nil {
+		return scim.Resource{}, err
+	}
+
+	displayName, _ := attributeAsString(attributes, "displayName")
+	if err := validGroupDisplayName(displayName); err != nil {
+		return scim.Resource{}, err
+	}
+
+	rawMembers, _ := attribute(attributes, "members")
+	members, err := memberIDs(rawMembers)
+	if err != nil {
+		return scim.Resource{}, err
+	}
+
+	before, err := rg.members(ctx, group.ID)
+	if err != nil {
+		return scim.Resource{}, err
+	}
+
+	change := newGroupChange(before)
+	change.displayName = &displayName
+	change.setMembers(members)
+	// The quota allowance is Coder specific, so IdPs that do not send the
+	// extension keep the allowance configured in Coder.
+	quota, ok, err := quotaAllowance(attributes)
+	if err != nil {
+		return scim.Resource{}, err
+	}
+	if ok {
+		change.quotaAllowance = &quota
+	}
+
+	return rg.update(ctx, r, group, before, change)
+}
+
+// Delete implements scim.ResourceHandler. Deletes the group. Members keep
+// their accounts and organization membership.
+func (rg *ResourceGroup) Delete(r *http.Request, idStr string) error {
+	ctx := r.Context()
+
+	group, err := rg.group(ctx, idStr)
+	if err != nil {
+		return err
+	}
+
+	members, err := rg.members(ctx, group.ID)
+	if err != nil {
+		return err
+	}
+
+	err = rg.store.DeleteGroupByID(ctx, group.ID)
+	if err != nil {
+		return err
+	}
+
+	rg.auditGroup(ctx, r, database.AuditActionDelete, group.Auditable(members), database.AuditableGroup{})
+	return nil
+}
+
+// Patch implements scim.ResourceHandler. Supports changing the display
+// name, changing the quota allowance and adding, removing or replacing members.
+// Both Okta and Entra ID style member removal are handled:
+//   - {"op":"remove","path":"members","value":[{"value":"<id>"}]}
+//   - {"op":"remove","path":"members[value eq \"<id>\"]"}
+func (rg *ResourceGroup) Patch(r *http.Request, idStr string, operations []scim.PatchOperation) (scim.Resource, error) {
+	ctx := r.Context()
+
+	group, err := rg.group(ctx, idStr)
+	if err != nil {
+		return scim.Resource{}, err
+	}
+
+	before, err := rg.members(ctx, group.ID)
+	if err != nil {
+		return scim.Resource{}, err
+	}
+
+	change := newGroupChange(before)
+	for _, op := range operations {
+		if op.Path == nil {
+			// No path means the value is a map of attribute paths to values.
+			values, ok := op.Value.(map[string]interface{})
+			if !ok {
+				return scim.Resource{}, scimErrors.ScimErrorBadRequest("operation without a path must have an object value")
+			}
+			for key, value := range values {
+				path, err := filter.ParsePath([]byte(key))
+				if err != nil {
+					return scim.Resource{}, scimErrors.ScimErrorBadRequest(fmt.Sprintf("invalid attribute path %q", key))
+				}
+				err = change.apply(op.Op, &path, value)
+				if err != nil {
+					return scim.Resource{}, err
+				}
+			}
+			continue
+		}
+
+		err = change.apply(op.Op, op.Path, op.Value)
+		if err != nil {
+			return scim.Resource{}, err
+		}
+	}
+
+	return rg.update(ctx, r, group, before, change)
+}
+
+// groupChange accumulates the changes of a PATCH or PUT request so they can
+// be applied in a single transaction.
+type groupChange struct {
+	displayName    *string
+	quotaAllowance *int32
+	// members is the desired membership of the group. It starts from the
+	// current members, and each operation is applied to it in order as
+	// SCIM requires.
+	members []uuid.UUID
+}
+
+func newGroupChange(current []database.GroupMember) groupChange {
+	members := make([]uuid.UUID, 0, len(current))
+	for _, m := range current {
+		members = append(members, m.UserID)
+	}
+	return groupChange{members: members}
+}
+
+func (c *groupChange) addMembers(ids []uuid.UUID) {
+	for _, id := range ids {
+		if !slices.Contains(c.members, id) {
+			c.members = append(c.members, id)
+		}
+	}
+}
+
+func (c *groupChange) removeMembers(ids []uuid.UUID) {
+	c.members = slices.DeleteFunc(c.members, func(id uuid.UUID) bool {
+		return slices.Contains(ids, id)
+	})
+}
+
+func (c *groupChange) setMembers(ids []uuid.UUID) {
+	c.members = nil
+	c.addMembers(ids)
+}
+
+func (c *groupChange) apply(op string, path *filter.Path, value interface{}) error {
+	attr := path.AttributePath.AttributeName
+	if uri := path.AttributePath.URI(); uri != "" && !strings.EqualFold(uri, GroupExtensionSchemaID) {
+		return scimErrors.ScimErrorBadRequest(fmt.Sprintf("unsupported attribute %q", path.String()))
+	}
+
+	switch {
+	case strings.EqualFold(attr, "displayName"):
+		if !strings.EqualFold(op, scim.PatchOperationReplace) && !strings.EqualFold(op, scim.PatchOperationAdd) {
+			return scimErrors.ScimErrorBadRequest("displayName can only be replaced")
+		}
+		displayName, ok := value.(string)
+		if !ok {
+			return scimErrors.ScimErrorBadRequest("displayName must be a string")
+		}
+		if err := validGroupDisplayName(displayName); err != nil {
+			return err
+		}
+		c.displayName = &displayName
+	case strings.EqualFold(attr, "quotaAllowance"):
+		var quota int32
+		if !strings.EqualFold(op, scim.PatchOperationRemove) {
+			var err error
+			quota, err = quotaValue(value)
+			if err != nil {
+				return err
+			}
+		}
+		c.quotaAllowance = &quota
+	case strings.EqualFold(attr, "members"):
+		ids, err := memberIDs(value)
+		if err != nil {
+			return err
+		}
+		if path.ValueExpression != nil {
+			id, err := memberFilterID(path.ValueExpression)
+			if err != nil {
+				return err
+			}
+			ids = append(ids, id)
+		}
+
+		switch strings.ToLower(op) {
+		case scim.PatchOperationAdd:
+			c.addMembers(ids)
+		case scim.PatchOperationRemove:
+			if len(ids) == 0 {
+				// Removing "members" without a value or filter clears the group.
+				c.setMembers(nil)
+				break
+			}
+			c.removeMembers(ids)
+		case scim.PatchOperationReplace:
+			c.setMembers(ids)
+		default:
+			return scimErrors.ScimErrorBadRequest(fmt.Sprintf("unsupported operation %q", op))
+		}
+	default:
+		return scimErrors.ScimErrorBadRequest(fmt.Sprintf("unsupported attribute %q", path.String()))
+	}
+	return nil
+}
+
+// update applies a groupChange to the group with the given members in a
+// transaction and audits the result.
+func (rg *ResourceGroup) update(ctx context.Context, r *http.Request, group database.Group, before []database.GroupMember, change groupChange) (scim.Resource, error) {
+	original := group
+	err := rg.store.InTx(func(tx database.Store) error {
+		var err error
+		params := database.UpdateGroupByIDParams{
+			ID:             group.ID,
+			Name:           group.Name,
+			DisplayName:    group.DisplayName,
+			AvatarURL:      group.AvatarURL,
+			QuotaAllowance: group.QuotaAllowance,
+		}
+		// The group name is derived once on creation, so that renaming the
+		// group in the IdP does not break references to it in Coder.
+		if change.displayName != nil && *change.displayName != group.DisplayName {
+			if err := checkDisplayNameUnique(ctx, tx, group.OrganizationID, group.ID, *change.displayName); err != nil {
+				return err
+			}
+			params.DisplayName = *change.displayName
+		}
+		if change.quotaAllowance != nil {
+			params.QuotaAllowance = *change.quotaAllowance
+		}
+		if params.DisplayName != group.DisplayName || params.QuotaAllowance != group.QuotaAllowance {
+			group, err = tx.UpdateGroupByID(ctx, params)
+			if err != nil {
+				return xerrors.Errorf("update group: %w", err)
+			}
+		}
+
+		current := make(map[uuid.UUID]bool, len(before))
+		for _, m := range before {
+			current[m.UserID] = true
+		}
+
+		var toAdd []uuid.UUID
+		for _, id := range change.members {
+			if !current[id] {
+				toAdd = append(toAdd, id)
+			}
+		}
+		if err := addGroupMembers(ctx, tx, group, toAdd); err != nil {
+			return err
+		}
+
+		for _, m := range before {
+			if slices.Contains(change.members, m.UserID) {
+				continue
+			}
+			err := tx.DeleteGroupMemberFromGroup(ctx, database.DeleteGroupMemberFromGroupParams{
+				UserID:  m.UserID,
+				GroupID: group.ID,
+			})
+			if err != nil {
+				return xerrors.Errorf("remove group member %q: %w", m.UserID, err)
+			}
+		}
+		return nil
+	}, nil)
+	if database.IsUniqueViolation(err) {
+		return scim.Resource{}, scimErrors.ScimError{
+			ScimType: scimErrors.ScimTypeUniqueness,
+			Detail:   fmt.Sprintf("a group with this name already exists in the organization: %v", err),
+			Status:   http.StatusConflict,
+		}
+	}
+	if err != nil {
+		return scim.Resource{}, err
+	}
+
+	after, err := rg.members(ctx, group.ID)
+	if err != nil {
+		return scim.Resource{}, err
+	}
+
+	rg.auditGroup(ctx, r, database.AuditActionWrite, original.Auditable(before), group.Auditable(after))
+
+	org, err := rg.store.GetOrganizationByID(ctx, group.OrganizationID)
+	if err != nil {
+		return scim.Resource{}, xerrors.Errorf("get organization: %w", err)
+	}
+	return groupResource(group, org.Name, after), nil
+}
+
+func (rg *ResourceGroup) group(ctx context.Context, idStr string) (database.Group, error) {
+	id, err := uuid.Parse(idStr)
+	if err != nil {
+		return database.Group{}, badUUID(idStr, err)
+	}
+
+	group, err := rg.store.GetGroupByID(ctx, id)
+	if err != nil {
+		if xerrors.Is(err, sql.ErrNoRows) {
+			return database.Group{}, scimErrors.ScimErrorResourceNotFound(idStr)
+		}
+		return database.Group{}, err
+	}
+
+	// Everyone groups are managed by organization membership, not SCIM.
+	if group.IsEveryone() {
+		return database.Group{}, scimErrors.ScimErrorResourceNotFound(idStr)
+	}
+
+	return group, nil
+}
+
+func (rg *ResourceGroup) members(ctx context.Context, groupID uuid.UUID) ([]database.GroupMember, error) {
+	members, err := rg.store.GetGroupMembersByGroupID(ctx, database.GetGroupMembersByGroupIDParams{
+		GroupID:       groupID,
+		IncludeSystem: false,
+	})
+	if err != nil {
+		return nil, xerrors.Errorf("get group members: %w", err)
+	}
+	return members, nil
+}
+
+func (rg *ResourceGroup) resource(ctx context.Context, group database.Group) (scim.Resource, error) {
+	members, err := rg.members(ctx, group.ID)
+	if err != nil {
+		return scim.Resource{}, err
+	}
+
+	org, err := rg.store.GetOrganizationByID(ctx, group.OrganizationID)
+	if err != nil {
+		return scim.Resource{}, xerrors.Errorf("get organization: %w", err)
+	}
+
+	return groupResource(group, org.Name, members), nil
+}
+
+// organization resolves the organization from the Coder group extension,
+// falling back to the default organization.
+func (rg *ResourceGroup) organization(ctx context.Context, attributes scim.ResourceAttributes) (database.Organization, error) {
+	var orgRef string
+	if ext, ok := attribute(attributes, GroupExtensionSchemaID); ok {
+		if m, ok := ext.(map[string]interface{}); ok {
+			orgRef, _ = attributeAsString(m, "organization")
+		}
+	}
+
+	if orgRef == "" {
+		org, err := rg.store.GetDefaultOrganization(ctx)
+		if err != nil {
+			return database.Organization{}, xerrors.Errorf("get default organization: %w", err)
+		}
+		return org, nil
+	}
+
+	var (
+		org database.Organization
+		err error
+	)
+	if id, parseErr := uuid.Parse(orgRef); parseErr == nil {
+		org, err = rg.store.GetOrganizationByID(ctx, id)
+	} else {
+		org, err = rg.store.GetOrganizationByName(ctx, database.GetOrganizationByNameParams{
+			Name:    orgRef,
+			Deleted: false,
+		})
+	}
+	if xerrors.Is(err, sql.ErrNoRows) || (err == nil && org.Deleted) {
+		return database.Organization{}, scimErrors.ScimErrorBadRequest(fmt.Sprintf("organization %q does not exist", orgRef))
+	}
+	if err != nil {
+		return database.Organization{}, xerrors.Errorf("get organization: %w", err)
+	}
+	return org, nil
+}
+
+// addGroupMembers inserts group members. Like the groups API, users must
+// already be members of the group's organization.
+func addGroupMembers(ctx context.Context, tx database.Store, group database.Group, ids []uuid.UUID) error {
+	for _, id := range ids {
+		_, err := database.ExpectOne(tx.OrganizationMembers(ctx, database.OrganizationMembersParams{
+			OrganizationID: group.OrganizationID,
+			UserID:         id,
+			IncludeSystem:  false,
+		}))
+		if xerrors.Is(err, sql.ErrNoRows) {
+			return scimErrors.ScimErrorBadRequest(fmt.Sprintf("user %q is not a member of the group's organization", id))
+		}
+		if err != nil {
+			return xerrors.Errorf("get organization member %q: %w", id, err)
+		}
+
+		err = tx.InsertGroupMember(ctx, database.InsertGroupMemberParams{
+			GroupID: group.ID,
+			UserID:  id,
+		})
+		if err != nil {
+			return xerrors.Errorf("insert group member %q: %w", id, err)
+		}
+	}
+	return nil
+}
+
+// groupResource converts a database.Group into a SCIM Resource.
+func groupResource(g database.Group, orgName string, members []database.GroupMember) scim.Resource {
+	scimMembers := make([]map[string]interface{}, 0, len(members))
+	for _, m := range members {
+		scimMembers = append(scimMembers, map[string]interface{}{
+			"value":   m.UserID.String(),
+			"display": m.UserUsername,
+			"type":    "User",
+		})
+	}
+
+	return scim.Resource{
+		ID:         g.ID.String(),
+		ExternalID: optional.String{},
+		Attributes: scim.ResourceAttributes{
+			"displayName": groupDisplayName(g),
+			"members":     scimMembers,
+			GroupExtensionSchemaID: map[string]interface{}{
+				"organization":   orgName,
+				"quotaAllowance": g.QuotaAllowance,
+			},
+		},
+	}
+}
+
+// groupDisplayName returns the SCIM displayName of a group. Groups created
+// in Coder may not have a display name, so the name is used instead.
+func groupDisplayName(g database.Group) string {
+	if g.DisplayName == "" {
+		return g.Name
+	}
+	return g.DisplayName
+}
+
+func validGroupDisplayName(displayName string) error {
+	if displayName == "" {
+		return scimErrors.ScimErrorBadRequest("missing required 'displayName' field")
+	}
+	if displayName == database.EveryoneGroup {
+		return scimErrors.ScimErrorBadRequest(fmt.Sprintf("%q is a reserved group name", displayName))
+	}
+	if len(displayName) > maxGroupNameLength {
+		return scimErrors.ScimErrorBadRequest(fmt.Sprintf("displayName must be <= %d characters", maxGroupNameLength))
+	}
+	return nil
+}
+
+// maxGroupNameLength matches the limit of codersdk.GroupNameValid.
+const maxGroupNameLength = 255
+
+var groupNameReplace = regexp.MustCompile("[^a-z0-9]+")
+
+// getGroups is like GetGroups, but groups without a display name match
+// display names by their name, like groupDisplayName.
+func getGroups(ctx context.Context, store database.Store, qry database.GetGroupsParams) ([]database.GetGroupsRow, error) {
+	rows, err := store.GetGroups(ctx, qry)
+	if err != nil {
+		return nil, xerrors.Errorf("get groups: %w", err)
+	}
+	if len(qry.DisplayNames) == 0 {
+		return rows, nil
+	}
+
+	qry.GroupNames, qry.DisplayNames = qry.DisplayNames, nil
+	named, err := store.GetGroups(ctx, qry)
+	if err != nil {
+		return nil, xerrors.Errorf("get groups by name: %w", err)
+	}
+	for _, row := range named {
+		if row.Group.DisplayName == "" {
+			rows = append(rows, row)
+		}
+	}
+	return rows, nil
+}
+
+// checkDisplayNameUnique returns a conflict when another group in the
+// organization has the display name. IdPs look groups up by displayName, so
+// it must identify a single group.
+func checkDisplayNameUnique(ctx context.Context, tx database.Store, orgID, groupID uuid.UUID, displayName string) error {
+	rows, err := getGroups(ctx, tx, database.GetGroupsParams{
+		OrganizationID: orgID,
+		DisplayNames:   []string{displayName},
+	})
+	if err != nil {
+		return err
+	}
+	for _, row := range rows {
+		if row.Group.ID != groupID {
+			return scimErrors.ScimError{
+				ScimType: scimErrors.ScimTypeUniqueness,
+				Detail:   fmt.Sprintf("group %q already exists in the organization", displayName),
+				Status:   http.StatusConflict,
+			}
+		}
+	}
+	return nil
+}
+
+// uniqueGroupName derives a valid group name from a SCIM displayName, e.g.
+// "Platform Engineers" becomes "platform-engineers". A suffix is added when
+// the name is taken by another group in the organization.
+func uniqueGroupName(ctx context.Context, tx database.Store, orgID uuid.UUID, displayName string) (string, error) {
+	base := strings.Trim(groupNameReplace.ReplaceAllString(strings.ToLower(displayName), "-"), "-")
+	// Leave room for the suffix.
+	if len(base) > maxGroupNameLength-9 {
+		base = strings.TrimRight(base[:maxGroupNameLength-9], "-")
+	}
+	if codersdk.GroupNameValid(base) != nil {
+		base = "group"
+	}
+
+	for i := 1; ; i++ {
+		name := base
+		switch {
+		case i > 10:
+			// Give up on readable suffixes, a random one will not be taken.
+			name = fmt.Sprintf("%s-%s", base, uuid.NewString()[:8])
+		case i > 1:
+			name = fmt.Sprintf("%s-%d", base, i)
+		}
+		_, err := tx.GetGroupByOrgAndName(ctx, database.GetGroupByOrgAndNameParams{
+			OrganizationID: orgID,
+			Name:           name,
+		})
+		if xerrors.Is(err, sql.ErrNoRows) {
+			return name, nil
+		}
+		if err != nil {
+			return "", xerrors.Errorf("get group by name: %w", err)
+		}
+		if i > 10 {
+			return "", xerrors.Errorf("no unique group name found for %q", displayName)
+		}
+	}
+}
+
+// quotaAllowance returns the quota allowance from the Coder group extension
+// and whether it was set.
+func quotaAllowance(attributes scim.ResourceAttributes) (int32, bool, error) {
+	ext, ok := attribute(attributes, GroupExtensionSchemaID)
+	if !ok {
+		return 0, false, nil
+	}
+	m, ok := ext.(map[string]interface{})
+	if !ok {
+		return 0, false, nil
+	}
+	v, ok := attribute(m, "quotaAllowance")
+	if !ok {
+		return 0, false, nil
+	}
+	quota, err := quotaValue(v)
+	return quota, err == nil, err
+}
+
+func quotaValue(v interface{}) (int32, error) {
+	var n int64
+	switch q := v.(type) {
+	case int64:
+		n = q
+	case int:
+		n = int64(q)
+	case float64:
+		n = int64(q)
+	case json.Number:
+		var err error
+		n, err = q.Int64()
+		if err != nil {
+			return 0, scimErrors.ScimErrorBadRequest(fmt.Sprintf("invalid quotaAllowance %q", q))
+		}
+	default:
+		return 0, scimErrors.ScimErrorBadRequest(fmt.Sprintf("invalid quotaAllowance %v", v))
+	}
+	if n < 0 || n > math.MaxInt32 {
+		return 0, scimErrors.ScimErrorBadRequest(fmt.Sprintf("quotaAllowance %d is out of range", n))
+	}
+	return int32(n), nil
+}
+
+// memberIDs extracts user IDs from a SCIM members value. The value is
+// either a list of {"value": "<id>"} objects or a single object.
+func memberIDs(v interface{}) ([]uuid.UUID, error) {
+	var raw []interface{}
+	switch m := v.(type) {
+	case nil:
+		return nil, nil
+	case []interface{}:
+		raw = m
+	case []map[string]interface{}:
+		for _, e := range m {
+			raw = append(raw, e)
+		}
+	case map[string]interface{}:
+		raw = []interface{}{m}
+	default:
+		return nil, scimErrors.ScimErrorBadRequest(fmt.Sprintf("invalid members value: %v", v))
+	}
+
+	ids := make([]uuid.UUID, 0, len(raw))
+	for _, e := range raw {
+		member, ok := e.(map[string]interface{})
+		if !ok {
+			return nil, scimErrors.ScimErrorBadRequest(fmt.Sprintf("invalid member: %v", e))
+		}
+		idStr, _ := attributeAsString(member, "value")
+		id, err := uuid.Parse(idStr)
+		if err != nil {
+			return nil, scimErrors.ScimErrorBadRequest(fmt.Sprintf("member %q is not a valid user id", idStr))
+		}
+		ids = append(ids, id)
+	}
+	return ids, nil
+}
@@ -0,0 +1,386 @@
+package scim
+
+import (
+	"context"
+	"net/http"
+	"sync/atomic"
+	"testing"
+
+	"github.com/elimity-com/scim"
+	scimErrors "github.com/elimity-com/scim/errors"
+	scimfilter "github.com/elimity-com/scim/filter"
+	"github.com/elimity-com/scim/schema"
+	"github.com/google/uuid"
+	"github.com/prometheus/client_golang/prometheus"
+	filter "github.com/scim2/filter-parser/v2"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"cdr.dev/slog/v3"
+	"cdr.dev/slog/v3/sloggers/slogtest"
+	"github.com/coder/coder/v2/coderd/audit"
+	"github.com/coder/coder/v2/coderd/coderdtest"
+	"github.com/coder/coder/v2/coderd/database"
+	"github.com/coder/coder/v2/coderd/database/dbauthz"
+	"github.com/coder/coder/v2/coderd/database/dbgen"
+	"github.com/coder/coder/v2/coderd/database/dbtestutil"
+	"github.com/coder/coder/v2/coderd/rbac"
+)
+
+// setupSCIMGroups creates a ResourceGroup backed by a real database with a
+// default organization. The ResourceGroup goes through dbauthz like the SCIM
+// handler does, the returned store does not.
+func setupSCIMGroups(t *testing.T) (*ResourceGroup, database.Store, database.Organization, *audit.MockAuditor) {
+	t.Helper()
+
+	db, _ := dbtestutil.NewDB(t)
+	authzDB := dbauthz.New(db, rbac.NewStrictAuthorizer(prometheus.NewRegistry()), slogtest.Make(t, nil), coderdtest.AccessControlStorePointer())
+	mockAudit := audit.NewMock()
+	auditorPtr := atomic.Pointer[audit.Auditor]{}
+	var a audit.Auditor = mockAudit
+	auditorPtr.Store(&a)
+
+	org := dbgen.Organization(t, db, database.Organization{IsDefault: true})
+	rg := &ResourceGroup{
+		store: authzDB,
+		opts: &Options{
+			DB:      authzDB,
+			Auditor: &auditorPtr,
+			Logger:  slogtest.Make(t, &slogtest.Options{IgnoreErrors: true}).Leveled(slog.LevelDebug),
+		},
+	}
+	return rg, db, org, mockAudit
+}
+
+// seedOrgUser creates a user that is a member of the given organization.
+func seedOrgUser(t *testing.T, db database.Store, orgID uuid.UUID) database.User {
+	t.Helper()
+	user := seedUser(t, db, database.User{LoginType: database.LoginTypeOIDC})
+	dbgen.OrganizationMember(t, db, database.OrganizationMember{UserID: user.ID, OrganizationID: orgID})
+	return user
+}
+
+func memberValues(t *testing.T, res scim.Resource) []string {
+	t.Helper()
+	members, ok := res.Attributes["members"].([]map[string]interface{})
+	require.True(t, ok, "members attribute has unexpected type %T", res.Attributes["members"])
+	values := make([]string, 0, len(members))
+	for _, m := range members {
+		values = append(values, m["value"].(string))
+	}
+	return values
+}
+
+func TestGroupQuery(t *testing.T) {
+	t.Parallel()
+
+	id := uuid.New()
+	tests := []struct {
+		name     string
+		filter   string
+		expected database.GetGroupsParams
+		wantErr  bool
+	}{
+		{name: "displayName", filter: `displayName eq "devs"`, expected: database.GetGroupsParams{DisplayNames: []string{"devs"}}},
+		{name: "id", filter: `id eq "` + id.String() + `"`, expected: database.GetGroupsParams{GroupIds: []uuid.UUID{id}}},
+		{name: "bad-id", filter: `id eq "nope"`, wantErr: true},
+		{name: "operator", filter: `displayName sw "dev"`, wantErr: true},
+		{name: "attribute", filter: `externalId eq "devs"`, wantErr: true},
+		{name: "logical", filter: `displayName eq "a" or displayName eq "b"`, wantErr: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			expr, err := filter.ParseFilter([]byte(tt.filter))
+			require.NoError(t, err)
+
+			got, err := groupQuery(expr)
+			if tt.wantErr {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tt.expected, got)
+		})
+	}
+}
+
+func TestGroupChange(t *testing.T) {
+	t.Parallel()
+
+	a, b := uuid.New(), uuid.New()
+	members := []interface{}{
+		map[string]interface{}{"value": a.String()},
+		map[string]interface{}{"value": b.String()},
+	}
+
+	t.Run("AddRemove", func(t *testing.T) {
+		t.Parallel()
+		var c groupChange
+		require.NoError(t, c.apply("add", mustPath("members"), members))
+		require.NoError(t, c.apply("remove", mustPath(`members[value eq "`+a.String()+`"]`), nil))
+		assert.Equal(t, []uuid.UUID{b}, c.members)
+	})
+
+	t.Run("RemoveAdd", func(t *testing.T) {
+		t.Parallel()
+		c := newGroupChange([]database.GroupMember{{UserID: a}})
+		require.NoError(t, c.apply("remove", mustPath(`members[value eq "`+a.String()+`"]`), nil))
+		require.NoError(t, c.apply("add", mustPath("members"), members[:1]))
+		assert.Equal(t, []uuid.UUID{a}, c.members)
+	})
+
+	t.Run("ReplaceMembers", func(t *testing.T) {
+		t.Parallel()
+		c := newGroupChange([]database.GroupMember{{UserID: b}})
+		require.NoError(t, c.apply("replace", mustPath("members"), members[:1]))
+		assert.Equal(t, []uuid.UUID{a}, c.members)
+		require.NoError(t, c.apply("add", mustPath("members"), members[1:]))
+		assert.Equal(t, []uuid.UUID{a, b}, c.members)
+	})
+
+	t.Run("RemoveAllMembers", func(t *testing.T) {
+		t.Parallel()
+		c := newGroupChange([]database.GroupMember{{UserID: a}, {UserID: b}})
+		require.NoError(t, c.apply("remove", mustPath("members"), nil))
+		assert.Empty(t, c.members)
+	})
+
+	t.Run("Rename", func(t *testing.T) {
+		t.Parallel()
+		var c groupChange
+		require.NoError(t, c.apply("replace", mustPath("displayName"), "Platform Engineers"))
+		require.NotNil(t, c.displayName)
+		assert.Equal(t, "Platform Engineers", *c.displayName)
+	})
+
+	t.Run("Quota", func(t *testing.T) {
+		t.Parallel()
+		var c groupChange
+		require.NoError(t, c.apply("replace", mustPath(GroupExtensionSchemaID+":quotaAllowance"), int64(5)))
+		require.NotNil(t, c.quotaAllowance)
+		assert.Equal(t, int32(5), *c.quotaAllowance)
+	})
+
+	t.Run("Errors", func(t *testing.T) {
+		t.Parallel()
+		var c groupChange
+		require.Error(t, c.apply("replace", mustPath("displayName"), database.EveryoneGroup))
+		require.Error(t, c.apply("add", mustPath("members"), []interface{}{map[string]interface{}{"value": "bad"}}))
+		require.Error(t, c.apply("remove", mustPath(`members[display eq "bob"]`), nil))
+		require.Error(t, c.apply("replace", mustPath("externalId"), "x"))
+		require.Error(t, c.apply("replace", mustPath(GroupExtensionSchemaID+":quotaAllowance"), int64(-1)))
+	})
+}
+
+func TestResourceGroup_Lifecycle(t *testing.T) {
+	t.Parallel()
+
+	rg, db, org, mockAudit := setupSCIMGroups(t)
+	alice := seedOrgUser(t, db, org.ID)
+	bob := seedOrgUser(t, db, org.ID)
+	r := scimRequest(t)
+
+	// Create with a member. IdP group names are not valid Coder group names,
+	// so the name is derived from the display name.
+	res, err := rg.Create(r, scim.ResourceAttributes{
+		"displayName": "Platform Engineers",
+		"members": []interface{}{
+			map[string]interface{}{"value": alice.ID.String()},
+		},
+		GroupExtensionSchemaID: map[string]interface{}{"quotaAllowance": int64(3)},
+	})
+	require.NoError(t, err)
+	assert.Equal(t, "Platform Engineers", res.Attributes["displayName"])
+	assert.ElementsMatch(t, []string{alice.ID.String()}, memberValues(t, res))
+	groupID := res.ID
+
+	group, err := db.GetGroupByID(dbauthz.AsSCIMProvisioner(context.Background()), uuid.MustParse(groupID))
+	require.NoError(t, err)
+	assert.Equal(t, "platform-engineers", group.Name)
+	assert.Equal(t, "Platform Engineers", group.DisplayName)
+	assert.Equal(t, org.ID, group.OrganizationID)
+	assert.Equal(t, int32(3), group.QuotaAllowance)
+
+	// Creating the same group again conflicts.
+	_, err = rg.Create(r, scim.ResourceAttributes{"displayName": "Platform Engineers"})
+	var scimErr scimErrors.ScimError
+	require.ErrorAs(t, err, &scimErr)
+	assert.Equal(t, http.StatusConflict, scimErr.Status)
+
+	// A display name deriving the same name gets a unique name.
+	other, err := rg.Create(r, scim.ResourceAttributes{"displayName": "platform engineers"})
+	require.NoError(t, err)
+	group, err = db.GetGroupByID(dbauthz.AsSCIMProvisioner(context.Background()), uuid.MustParse(other.ID))
+	require.NoError(t, err)
+	assert.Equal(t, "platform-engineers-2", group.Name)
+	require.NoError(t, rg.Delete(r, other.ID))
+
+	// Add bob, remove alice.
+	res, err = rg.Patch(r, groupID, []scim.PatchOperation{
+		{Op: "add", Path: mustPath("members"), Value: []interface{}{map[string]interface{}{"value": bob.ID.String()}}},
+		{Op: "remove", Path: mustPath(`members[value eq "` + alice.ID.String() + `"]`)},
+	})
+	require.NoError(t, err)
+	assert.ElementsMatch(t, []string{bob.ID.String()}, memberValues(t, res))
+
+	// Adding an existing member is a no-op.
+	res, err = rg.Patch(r, groupID, []scim.PatchOperation{
+		{Op: "add", Path: mustPath("members"), Value: []interface{}{map[string]interface{}{"value": bob.ID.String()}}},
+	})
+	require.NoError(t, err)
+	assert.ElementsMatch(t, []string{bob.ID.String()}, memberValues(t, res))
+
+	// Operations are applied in order.
+	for _, tc := range []struct {
+		name       string
+		operations []scim.PatchOperation
+		expected   []string
+	}{
+		{
+			name: "ReplaceThenAdd",
+			operations: []scim.PatchOperation{
+				{Op: "replace", Path: mustPath("members"), Value: []interface{}{map[string]interface{}{"value": alice.ID.String()}}},
+				{Op: "add", Path: mustPath("members"), Value: []interface{}{map[string]interface{}{"value": bob.ID.String()}}},
+			},
+			expected: []string{alice.ID.String(), bob.ID.String()},
+		},
+		{
+			name: "RemoveThenAdd",
+			operations: []scim.PatchOperation{
+				{Op: "remove", Path: mustPath(`members[value eq "` + alice.ID.String() + `"]`)},
+				{Op: "add", Path: mustPath("members"), Value: []interface{}{map[string]interface{}{"value": alice.ID.String()}}},
+			},
+			expected: []string{alice.ID.String(), bob.ID.String()},
+		},
+		{
+			name: "ClearThenAdd",
+			operations: []scim.PatchOperation{
+				{Op: "remove", Path: mustPath("members")},
+				{Op: "add", Path: mustPath("members"), Value: []interface{}{map[string]interface{}{"value": bob.ID.String()}}},
+			},
+			expected: []string{bob.ID.String()},
+		},
+		{
+			name: "AddThenRemove",
+			operations: []scim.PatchOperation{
+				{Op: "add", Path: mustPath("members"), Value: []interface{}{map[string]interface{}{"value": alice.ID.String()}}},
+				{Op: "remove", Path: mustPath(`members[value eq "` + alice.ID.String() + `"]`)},
+			},
+			expected: []string{bob.ID.String()},
+		},
+	} {
+		res, err = rg.Patch(r, groupID, tc.operations)
+		require.NoError(t, err, tc.name)
+		assert.ElementsMatch(t, tc.expected, memberValues(t, res), tc.name)
+	}
+
+	// Replace renames and resets membership, keeping the name and quota.
+	res, err = rg.Replace(r, groupID, scim.ResourceAttributes{
+		"displayName": "Engineers",
+		"members": []interface{}{
+			map[string]interface{}{"value": alice.ID.String()},
+		},
+	})
+	require.NoError(t, err)
+	assert.Equal(t, "Engineers", res.Attributes["displayName"])
+	assert.ElementsMatch(t, []string{alice.ID.String()}, memberValues(t, res))
+	group, err = db.GetGroupByID(dbauthz.AsSCIMProvisioner(context.Background()), uuid.MustParse(groupID))
+	require.NoError(t, err)
+	assert.Equal(t, "platform-engineers", group.Name)
+	assert.Equal(t, int32(3), group.QuotaAllowance)
+
+	// Filtered listing.
+	validator, err := scimfilter.NewValidator(`displayName eq "Engineers"`, schema.CoreGroupSchema())
+	require.NoError(t, err)
+	page, err := rg.GetAll(r, scim.ListRequestParams{Count: 10, StartIndex: 1, FilterValidator: &validator})
+	require.NoError(t, err)
+	require.Len(t, page.Resources, 1)
+	assert.Equal(t, groupID, page.Resources[0].ID)
+
+	// Delete.
+	require.NoError(t, rg.Delete(r, groupID))
+	_, err = rg.Get(r, groupID)
+	require.ErrorAs(t, err, &scimErr)
+	assert.Equal(t, http.StatusNotFound, scimErr.Status)
+
+	// 2 creates, 1 delete, 7 writes, delete
+	assert.Len(t, mockAudit.AuditLogs(), 11)
+}
+
+func TestResourceGroup_Errors(t *testing.T) {
+	t.Parallel()
+
+	rg, db, org, _ := setupSCIMGroups(t)
+	r := scimRequest(t)
+	outsider := seedUser(t, db, database.User{LoginType: database.LoginTypeOIDC})
+	everyone := dbgen.Group(t, db, database.Group{ID: org.ID, Name: database.EveryoneGroup, OrganizationID: org.ID})
+
+	tests := []struct {
+		name       string
+		run        func() error
+		wantStatus int
+	}{
+		{
+			name:       "Get/non-UUID",
+			run:        func() error { _, err := rg.Get(r, "not-a-uuid"); return err },
+			wantStatus: http.StatusNotFound,
+		},
+		{
+			name:       "Get/missing",
+			run:        func() error { _, err := rg.Get(r, uuid.NewString()); return err },
+			wantStatus: http.StatusNotFound,
+		},
+		{
+			name:       "Get/everyone",
+			run:        func() error { _, err := rg.Get(r, everyone.ID.String()); return err },
+			wantStatus: http.StatusNotFound,
+		},
+		{
+			name: "Create/reserved",
+			run: func() error {
+				_, err := rg.Create(r, scim.ResourceAttributes{"displayName": database.EveryoneGroup})
+				return err
+			},
+			wantStatus: http.StatusBadRequest,
+		},
+		{
+			name: "Create/unknown-organization",
+			run: func() error {
+				_, err := rg.Create(r, scim.ResourceAttributes{
+					"displayName":          "ops",
+					GroupExtensionSchemaID: map[string]interface{}{"organization": "does-not-exist"},
+				})
+				return err
+			},
+			wantStatus: http.StatusBadRequest,
+		},
+		{
+			name: "Create/non-member",
+			run: func() error {
+				_, err := rg.Create(r, scim.ResourceAttributes{
+					"displayName": "outsiders",
+					"members":     []interface{}{map[string]interface{}{"value": outsider.ID.String()}},
+				})
+				return err
+			},
+			wantStatus: http.StatusBadRequest,
+		},
+		{
+			name:       "Delete/missing",
+			run:        func() error { return rg.Delete(r, uuid.NewString()) },
+			wantStatus: http.StatusNotFound,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			err := tt.run()
+			require.Error(t, err)
+			var scimErr scimErrors.ScimError
+			require.ErrorAs(t, err, &scimErr)
+			assert.Equal(t, tt.wantStatus, scimErr.Status)
+		})
+	}
+}
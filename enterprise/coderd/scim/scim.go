@@ -47,6 +47,10 @@ func New(opts *Options) (*Handler, error) {
 		store: opts.DB,
 		opts:  opts,
 	}
+	groupHandler := &ResourceGroup{
+		store: opts.DB,
+		opts:  opts,
+	}
 
 	args := &scim.ServerArgs{
 		ServiceProviderConfig: &scim.ServiceProviderConfig{
@@ -79,6 +83,17 @@ func New(opts *Options) (*Handler, error) {
 				Handler:          userHandler,
 				SchemaExtensions: nil,
 			},
+			{
+				ID:          optional.NewString("Group"),
+				Name:        "Group",
+				Description: optional.NewString("Group"),
+				Endpoint:    "/Groups",
+				Schema:      schema.CoreGroupSchema(),
+				Handler:     groupHandler,
+				SchemaExtensions: []scim.SchemaExtension{
+					{Schema: GroupExtensionSchema(), Required: false},
+				},
+			},
 		},
 	}
 
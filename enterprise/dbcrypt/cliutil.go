@@ -45,6 +45,7 @@ func Rotate(ctx context.Context, log slog.Logger, sqlDB *sql.DB, ciphers []Ciphe
 					UserID:                 uid,
 					LoginType:              userLink.LoginType,
 					Claims:                 userLink.Claims,
+					OIDCProviderID:         userLink.OIDCProviderID,
 				}); err != nil {
 					return xerrors.Errorf("update user link user_id=%s linked_id=%s: %w", userLink.UserID, userLink.LinkedID, err)
 				}
@@ -261,6 +262,7 @@ func Decrypt(ctx context.Context, log slog.Logger, sqlDB *sql.DB, ciphers []Ciph
 					UserID:                 uid,
 					LoginType:              userLink.LoginType,
 					Claims:                 userLink.Claims,
+					OIDCProviderID:         userLink.OIDCProviderID,
 				}); err != nil {
 					return xerrors.Errorf("update user link user_id=%s linked_id=%s: %w", userLink.UserID, userLink.LinkedID, err)
 				}
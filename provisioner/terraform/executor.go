@@ -307,7 +307,10 @@ func (e *executor) plan(ctx, killCtx context.Context, env, vars []string, logr l
 		"-out=" + planfilePath,
 	}
 	destroy := metadata.GetWorkspaceTransition() == proto.WorkspaceTransition_DESTROY
-	if destroy {
+	switch {
+	case req.GetRefreshOnly():
+		args = append(args, "-refresh-only")
+	case destroy:
 		args = append(args, "-destroy")
 	}
 	for _, variable := range vars {
@@ -338,6 +341,17 @@ func (e *executor) plan(ctx, killCtx context.Context, env, vars []string, logr l
 		return nil, xerrors.Errorf("marshal plan: %w", err)
 	}
 
+	// A refresh-only plan never gets applied, so there is nothing to
+	// replace or provision. All we care about is what drifted.
+	if req.GetRefreshOnly() {
+		drift := findResourceDrift(plan)
+		logResourceDrift(drift, logr)
+		return &proto.PlanComplete{
+			Plan:          planJSON,
+			ResourceDrift: drift,
+		}, nil
+	}
+
 	isPrebuildClaimAttempt := !destroy &&
 		metadata.GetPrebuiltWorkspaceBuildStage().IsPrebuiltWorkspaceClaim()
 	if isPrebuildClaimAttempt {
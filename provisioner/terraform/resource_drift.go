@@ -0,0 +1,111 @@
+package terraform
+
+import (
+	"fmt"
+	"reflect"
+	"sort"
+	"strings"
+
+	tfjson "github.com/hashicorp/terraform-json"
+
+	"github.com/coder/coder/v2/provisionersdk/proto"
+)
+
+// findResourceDrift returns the managed resources that Terraform found to have
+// changed outside of Terraform while refreshing the state, sorted by address.
+//
+// Only the names of the changed top-level attributes are returned. The values
+// are left out on purpose: they may contain secrets, and the result is stored
+// and shown to users who may not be allowed to see them.
+func findResourceDrift(plan *tfjson.Plan) []*proto.ResourceDrift {
+	if plan == nil {
+		return nil
+	}
+
+	var drift []*proto.ResourceDrift
+	for _, ch := range plan.ResourceDrift {
+		if ch == nil || ch.Change == nil || ch.Change.Actions.NoOp() {
+			continue
+		}
+		if ch.Mode != tfjson.ManagedResourceMode {
+			continue
+		}
+		// Our own resources are "virtual" and only exist in the state, so
+		// they can not be changed outside of Terraform.
+		if strings.HasPrefix(ch.Type, "coder_") {
+			continue
+		}
+
+		drift = append(drift, &proto.ResourceDrift{
+			Address:    ch.Address,
+			Type:       ch.Type,
+			Name:       ch.Name,
+			Action:     driftAction(ch.Change.Actions),
+			Attributes: changedAttributes(ch.Change.Before, ch.Change.After),
+		})
+	}
+
+	sort.Slice(drift, func(i, j int) bool {
+		return drift[i].Address < drift[j].Address
+	})
+	return drift
+}
+
+// driftAction summarizes the actions of a drifted resource. Terraform reports
+// resources that were deleted outside of Terraform as "delete", and resources
+// that were modified as "update".
+func driftAction(actions tfjson.Actions) string {
+	switch {
+	case actions.Delete():
+		return string(tfjson.ActionDelete)
+	case actions.Update():
+		return string(tfjson.ActionUpdate)
+	}
+	names := make([]string, 0, len(actions))
+	for _, action := range actions {
+		names = append(names, string(action))
+	}
+	return strings.Join(names, ",")
+}
+
+// changedAttributes returns the sorted names of the top-level attributes that
+// differ between the before and after values of a resource change.
+func changedAttributes(before, after interface{}) []string {
+	beforeAttrs, _ := before.(map[string]interface{})
+	afterAttrs, _ := after.(map[string]interface{})
+	// A deleted resource has no attributes left to compare.
+	if afterAttrs == nil {
+		return nil
+	}
+
+	var changed []string
+	for name, value := range afterAttrs {
+		if !reflect.DeepEqual(beforeAttrs[name], value) {
+			changed = append(changed, name)
+		}
+	}
+	for name := range beforeAttrs {
+		if _, ok := afterAttrs[name]; !ok {
+			changed = append(changed, name)
+		}
+	}
+	sort.Strings(changed)
+	return changed
+}
+
+// logResourceDrift writes a compact summary of the drifted resources to the
+// job logs.
+func logResourceDrift(drift []*proto.ResourceDrift, sink logSink) {
+	if len(drift) == 0 {
+		sink.ProvisionLog(proto.LogLevel_INFO, "No resources changed outside of Terraform.")
+		return
+	}
+
+	sink.ProvisionLog(proto.LogLevel_WARN, "Resources changed outside of Terraform:")
+	for _, d := range drift {
+		sink.ProvisionLog(proto.LogLevel_WARN, fmt.Sprintf("  ~ %s (%s)", d.Address, d.Action))
+		for _, attr := range d.Attributes {
+			sink.ProvisionLog(proto.LogLevel_WARN, fmt.Sprintf("      ~ %s", attr))
+		}
+	}
+}
@@ -0,0 +1,160 @@
+package terraform
+
+import (
+	"testing"
+
+	tfjson "github.com/hashicorp/terraform-json"
+	"github.com/stretchr/testify/require"
+
+	"github.com/coder/coder/v2/provisionersdk/proto"
+)
+
+func TestFindResourceDrift(t *testing.T) {
+	t.Parallel()
+
+	cases := []struct {
+		name     string
+		plan     *tfjson.Plan
+		expected []*proto.ResourceDrift
+	}{
+		{
+			name: "nil plan",
+		},
+		{
+			name: "no drift",
+			plan: &tfjson.Plan{},
+		},
+		{
+			name: "no-op and nil changes",
+			plan: &tfjson.Plan{
+				ResourceDrift: []*tfjson.ResourceChange{
+					{
+						Address: "docker_container.workspace",
+						Mode:    tfjson.ManagedResourceMode,
+					},
+					{
+						Address: "docker_volume.home",
+						Mode:    tfjson.ManagedResourceMode,
+						Change: &tfjson.Change{
+							Actions: tfjson.Actions{tfjson.ActionNoop},
+						},
+					},
+				},
+			},
+		},
+		{
+			name: "data sources and coder resources are ignored",
+			plan: &tfjson.Plan{
+				ResourceDrift: []*tfjson.ResourceChange{
+					{
+						Address: "data.coder_workspace.me",
+						Mode:    tfjson.DataResourceMode,
+						Type:    "coder_workspace",
+						Change: &tfjson.Change{
+							Actions: tfjson.Actions{tfjson.ActionUpdate},
+						},
+					},
+					{
+						Address: "coder_agent.main",
+						Mode:    tfjson.ManagedResourceMode,
+						Type:    "coder_agent",
+						Change: &tfjson.Change{
+							Actions: tfjson.Actions{tfjson.ActionUpdate},
+						},
+					},
+				},
+			},
+		},
+		{
+			name: "updated and deleted resources",
+			plan: &tfjson.Plan{
+				ResourceDrift: []*tfjson.ResourceChange{
+					{
+						Address: "docker_volume.home",
+						Mode:    tfjson.ManagedResourceMode,
+						Type:    "docker_volume",
+						Name:    "home",
+						Change: &tfjson.Change{
+							Actions: tfjson.Actions{tfjson.ActionDelete},
+							Before:  map[string]interface{}{"name": "home"},
+						},
+					},
+					{
+						Address: "docker_container.workspace[0]",
+						Mode:    tfjson.ManagedResourceMode,
+						Type:    "docker_container",
+						Name:    "workspace",
+						Change: &tfjson.Change{
+							Actions: tfjson.Actions{tfjson.ActionUpdate},
+							Before: map[string]interface{}{
+								"image":  "ubuntu:22.04",
+								"labels": []interface{}{"a"},
+								"memory": 1024,
+								"name":   "workspace",
+							},
+							After: map[string]interface{}{
+								"image":  "ubuntu:24.04",
+								"labels": []interface{}{"a", "b"},
+								"name":   "workspace",
+								"env":    nil,
+							},
+						},
+					},
+				},
+			},
+			expected: []*proto.ResourceDrift{
+				{
+					Address:    "docker_container.workspace[0]",
+					Type:       "docker_container",
+					Name:       "workspace",
+					Action:     "update",
+					Attributes: []string{"image", "labels", "memory"},
+				},
+				{
+					Address: "docker_volume.home",
+					Type:    "docker_volume",
+					Name:    "home",
+					Action:  "delete",
+				},
+			},
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			require.Equal(t, tc.expected, findResourceDrift(tc.plan))
+		})
+	}
+}
+
+func TestLogResourceDrift(t *testing.T) {
+	t.Parallel()
+
+	t.Run("NoDrift", func(t *testing.T) {
+		t.Parallel()
+
+		logr := &mockLogger{}
+		logResourceDrift(nil, logr)
+		require.Equal(t, []*proto.Log{
+			{Level: proto.LogLevel_INFO, Output: "No resources changed outside of Terraform."},
+		}, logr.logs)
+	})
+
+	t.Run("Drift", func(t *testing.T) {
+		t.Parallel()
+
+		logr := &mockLogger{}
+		logResourceDrift([]*proto.ResourceDrift{
+			{Address: "docker_container.workspace[0]", Action: "update", Attributes: []string{"image"}},
+			{Address: "docker_volume.home", Action: "delete"},
+		}, logr)
+		require.Equal(t, []*proto.Log{
+			{Level: proto.LogLevel_WARN, Output: "Resources changed outside of Terraform:"},
+			{Level: proto.LogLevel_WARN, Output: "  ~ docker_container.workspace[0] (update)"},
+			{Level: proto.LogLevel_WARN, Output: "      ~ image"},
+			{Level: proto.LogLevel_WARN, Output: "  ~ docker_volume.home (delete)"},
+		}, logr.logs)
+	})
+}
@@ -71,8 +71,11 @@ type agentDevcontainerAttributes struct {
 }
 
 type agentResourcesMonitoring struct {
-	Memory  []agentMemoryResourceMonitor `mapstructure:"memory"`
-	Volumes []agentVolumeResourceMonitor `mapstructure:"volume"`
+	Memory    []agentMemoryResourceMonitor  `mapstructure:"memory"`
+	Volumes   []agentVolumeResourceMonitor  `mapstructure:"volume"`
+	CPU       []agentCPUResourceMonitor     `mapstructure:"cpu"`
+	Processes []agentProcessResourceMonitor `mapstructure:"processes"`
+	Inodes    []agentVolumeResourceMonitor  `mapstructure:"inode"`
 }
 
 type agentMemoryResourceMonitor struct {
@@ -86,6 +89,16 @@ type agentVolumeResourceMonitor struct {
 	Threshold int32  `mapstructure:"threshold"`
 }
 
+type agentCPUResourceMonitor struct {
+	Enabled   bool  `mapstructure:"enabled"`
+	Threshold int32 `mapstructure:"threshold"`
+}
+
+type agentProcessResourceMonitor struct {
+	Enabled   bool  `mapstructure:"enabled"`
+	Threshold int32 `mapstructure:"threshold"`
+}
+
 type agentDisplayAppsAttributes struct {
 	VSCode               bool `mapstructure:"vscode"`
 	VSCodeInsiders       bool `mapstructure:"vscode_insiders"`
@@ -138,9 +151,19 @@ type agentScriptAttributes struct {
 	TimeoutSeconds   int32  `mapstructure:"timeout"`
 }
 
+type agentLogSourceAttributes struct {
+	AgentID       string   `mapstructure:"agent_id"`
+	DisplayName   string   `mapstructure:"display_name"`
+	Icon          string   `mapstructure:"icon"`
+	Paths         []string `mapstructure:"paths"`
+	JournaldUnits []string `mapstructure:"journald_units"`
+}
+
 // A mapping of attributes on the "healthcheck" resource.
 type appHealthcheckAttributes struct {
 	URL       string `mapstructure:"url"`
+	Address   string `mapstructure:"address"`
+	Command   string `mapstructure:"command"`
 	Interval  int32  `mapstructure:"interval"`
 	Threshold int32  `mapstructure:"threshold"`
 }
@@ -335,6 +358,28 @@ func ConvertState(ctx context.Context, modules []*tfjson.StateModule, rawGraph s
 			}
 		}
 
+		for _, resource := range attrs.ResourcesMonitoring {
+			for _, cpuResource := range resource.CPU {
+				resourcesMonitoring.Cpu = &proto.CPUResourceMonitor{
+					Enabled:   cpuResource.Enabled,
+					Threshold: cpuResource.Threshold,
+				}
+			}
+			for _, processResource := range resource.Processes {
+				resourcesMonitoring.Processes = &proto.ProcessResourceMonitor{
+					Enabled:   processResource.Enabled,
+					Threshold: processResource.Threshold,
+				}
+			}
+			for _, inode := range resource.Inodes {
+				resourcesMonitoring.Inodes = append(resourcesMonitoring.Inodes, &proto.VolumeResourceMonitor{
+					Path:      inode.Path,
+					Enabled:   inode.Enabled,
+					Threshold: inode.Threshold,
+				})
+			}
+		}
+
 		agent := &proto.Agent{
 			Name:                     tfResource.Name,
 			Id:                       attrs.ID,
@@ -531,10 +576,24 @@ func ConvertState(ctx context.Context, modules []*tfjson.StateModule, rawGraph s
 
 		var healthcheck *proto.Healthcheck
 		if len(attrs.Healthcheck) != 0 {
+			hc := attrs.Healthcheck[0]
+			// The check type is selected by which target is set, so more
+			// than one would be ambiguous.
+			targets := 0
+			for _, target := range []string{hc.URL, hc.Address, hc.Command} {
+				if target != "" {
+					targets++
+				}
+			}
+			if targets > 1 {
+				return nil, xerrors.Errorf("app %q healthcheck must set only one of url, address or command", attrs.Slug)
+			}
 			healthcheck = &proto.Healthcheck{
-				Url:       attrs.Healthcheck[0].URL,
-				Interval:  attrs.Healthcheck[0].Interval,
-				Threshold: attrs.Healthcheck[0].Threshold,
+				Url:       hc.URL,
+				Address:   hc.Address,
+				Command:   hc.Command,
+				Interval:  hc.Interval,
+				Threshold: hc.Threshold,
 			}
 		}
 
@@ -678,6 +737,36 @@ func ConvertState(ctx context.Context, modules []*tfjson.StateModule, rawGraph s
 			}
 		}
 	}
+	// Associate log sources with agents. The agent tails the given files
+	// and journald units into a log source of its own.
+	for _, resource := range sortedResources["coder_log_source"] {
+		var attrs agentLogSourceAttributes
+		err = mapstructure.Decode(resource.AttributeValues, &attrs)
+		if err != nil {
+			return nil, xerrors.Errorf("decode log source attributes: %w", err)
+		}
+		if len(attrs.Paths) == 0 && len(attrs.JournaldUnits) == 0 {
+			return nil, xerrors.Errorf("log source %q must set at least one of paths or journald_units", attrs.DisplayName)
+		}
+
+		logTail := &proto.LogTail{
+			DisplayName:   attrs.DisplayName,
+			Icon:          attrs.Icon,
+			Paths:         attrs.Paths,
+			JournaldUnits: attrs.JournaldUnits,
+		}
+
+	logSourceAgentLoop:
+		for _, agents := range resourceAgents {
+			for _, agent := range agents {
+				if dependsOnAgent(graph, agent, attrs.AgentID, resource) {
+					agent.LogTails = append(agent.LogTails, logTail)
+					break logSourceAgentLoop
+				}
+			}
+		}
+	}
+
 	// Associate metadata blocks with resources.
 	resourceMetadata := map[string][]*proto.Resource_Metadata{}
 	resourceHidden := map[string]bool{}
@@ -1131,7 +1220,7 @@ func managedNonCoderResources(byType map[string][]*tfjson.StateResource) []*tfjs
 	skip := map[string]bool{
 		"coder_script": true, "coder_agent": true,
 		"coder_agent_instance": true, "coder_app": true,
-		"coder_metadata": true,
+		"coder_metadata": true, "coder_log_source": true,
 	}
 	var result []*tfjson.StateResource
 	for resourceType, resources := range byType {
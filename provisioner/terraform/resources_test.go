@@ -1327,6 +1327,248 @@ func TestAppSlugDuplicate(t *testing.T) {
 	require.ErrorContains(t, err, "duplicate app slug")
 }
 
+func TestAppHealthcheckTypes(t *testing.T) {
+	t.Parallel()
+	ctx, logger := ctxAndLogger(t)
+
+	// nolint:dogsled
+	_, filename, _, _ := runtime.Caller(0)
+
+	dir := filepath.Join(filepath.Dir(filename), "testdata", "resources", "multiple-apps")
+	tfPlanRaw, err := os.ReadFile(filepath.Join(dir, "multiple-apps.tfplan.json"))
+	require.NoError(t, err)
+	tfPlanGraph, err := os.ReadFile(filepath.Join(dir, "multiple-apps.tfplan.dot"))
+	require.NoError(t, err)
+
+	cases := []struct {
+		name        string
+		healthcheck map[string]any
+		expected    *proto.Healthcheck
+		errContains string
+	}{
+		{
+			name:        "TCP",
+			healthcheck: map[string]any{"address": "localhost:5432", "interval": 5, "threshold": 6},
+			expected:    &proto.Healthcheck{Address: "localhost:5432", Interval: 5, Threshold: 6},
+		},
+		{
+			name:        "Exec",
+			healthcheck: map[string]any{"command": "pg_isready", "interval": 10, "threshold": 3},
+			expected:    &proto.Healthcheck{Command: "pg_isready", Interval: 10, Threshold: 3},
+		},
+		{
+			name:        "Ambiguous",
+			healthcheck: map[string]any{"url": "http://localhost:13337/healthz", "address": "localhost:13337", "interval": 5, "threshold": 6},
+			errContains: "must set only one of url, address or command",
+		},
+	}
+
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			t.Parallel()
+
+			var tfPlan tfjson.Plan
+			err := json.Unmarshal(tfPlanRaw, &tfPlan)
+			require.NoError(t, err)
+			for _, resource := range tfPlan.PlannedValues.RootModule.Resources {
+				if resource.Type == "coder_app" && resource.AttributeValues["slug"] == "app2" {
+					resource.AttributeValues["healthcheck"] = []any{c.healthcheck}
+				}
+			}
+
+			state, err := terraform.ConvertState(ctx, []*tfjson.StateModule{tfPlan.PlannedValues.RootModule}, string(tfPlanGraph), logger)
+			if c.errContains != "" {
+				require.ErrorContains(t, err, c.errContains)
+				return
+			}
+			require.NoError(t, err)
+			var found bool
+			for _, resource := range state.Resources {
+				for _, agent := range resource.Agents {
+					for _, app := range agent.Apps {
+						if app.Slug == "app2" {
+							require.Equal(t, c.expected.Address, app.Healthcheck.Address)
+							require.Equal(t, c.expected.Command, app.Healthcheck.Command)
+							require.Empty(t, app.Healthcheck.Url)
+							require.Equal(t, c.expected.Interval, app.Healthcheck.Interval)
+							require.Equal(t, c.expected.Threshold, app.Healthcheck.Threshold)
+							found = true
+						}
+					}
+				}
+			}
+			require.True(t, found, "app2 not found")
+		})
+	}
+}
+
+func TestLogSources(t *testing.T) {
+	t.Parallel()
+	ctx, logger := ctxAndLogger(t)
+
+	// nolint:dogsled
+	_, filename, _, _ := runtime.Caller(0)
+
+	dir := filepath.Join(filepath.Dir(filename), "testdata", "resources", "multiple-agents-multiple-scripts")
+	tfStateRaw, err := os.ReadFile(filepath.Join(dir, "multiple-agents-multiple-scripts.tfstate.json"))
+	require.NoError(t, err)
+	tfStateGraph, err := os.ReadFile(filepath.Join(dir, "multiple-agents-multiple-scripts.tfstate.dot"))
+	require.NoError(t, err)
+
+	cases := []struct {
+		name        string
+		attrs       map[string]any
+		expected    *proto.LogTail
+		errContains string
+	}{
+		{
+			name: "Paths",
+			attrs: map[string]any{
+				"display_name": "Nginx",
+				"icon":         "/icon/nginx.svg",
+				"paths":        []any{"/var/log/nginx/*.log"},
+			},
+			expected: &proto.LogTail{
+				DisplayName: "Nginx",
+				Icon:        "/icon/nginx.svg",
+				Paths:       []string{"/var/log/nginx/*.log"},
+			},
+		},
+		{
+			name: "JournaldUnits",
+			attrs: map[string]any{
+				"display_name":   "Docker",
+				"journald_units": []any{"docker.service", "containerd.service"},
+			},
+			expected: &proto.LogTail{
+				DisplayName:   "Docker",
+				JournaldUnits: []string{"docker.service", "containerd.service"},
+			},
+		},
+		{
+			name:        "Empty",
+			attrs:       map[string]any{"display_name": "Nothing"},
+			errContains: "must set at least one of paths or journald_units",
+		},
+	}
+
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			t.Parallel()
+
+			var tfState tfjson.State
+			err := json.Unmarshal(tfStateRaw, &tfState)
+			require.NoError(t, err)
+
+			// Attach the log source to the second agent.
+			var agentID string
+			for _, resource := range tfState.Values.RootModule.Resources {
+				if resource.Type == "coder_agent" && resource.Name == "dev2" {
+					agentID, _ = resource.AttributeValues["id"].(string)
+				}
+			}
+			require.NotEmpty(t, agentID)
+			attrs := map[string]any{"agent_id": agentID}
+			for k, v := range c.attrs {
+				attrs[k] = v
+			}
+			tfState.Values.RootModule.Resources = append(tfState.Values.RootModule.Resources, &tfjson.StateResource{
+				Address:         "coder_log_source.logs",
+				Mode:            tfjson.ManagedResourceMode,
+				Type:            "coder_log_source",
+				Name:            "logs",
+				ProviderName:    "registry.terraform.io/coder/coder",
+				AttributeValues: attrs,
+			})
+
+			state, err := terraform.ConvertState(ctx, []*tfjson.StateModule{tfState.Values.RootModule}, string(tfStateGraph), logger)
+			if c.errContains != "" {
+				require.ErrorContains(t, err, c.errContains)
+				return
+			}
+			require.NoError(t, err)
+
+			logTails := map[string][]*proto.LogTail{}
+			for _, resource := range state.Resources {
+				// The log source is not a resource of its own.
+				require.NotEqual(t, "coder_log_source", resource.Type)
+				for _, agent := range resource.Agents {
+					logTails[agent.Name] = agent.LogTails
+				}
+			}
+			require.Empty(t, logTails["dev1"])
+			require.Len(t, logTails["dev2"], 1)
+			got := logTails["dev2"][0]
+			require.Equal(t, c.expected.DisplayName, got.DisplayName)
+			require.Equal(t, c.expected.Icon, got.Icon)
+			require.Equal(t, c.expected.Paths, got.Paths)
+			require.Equal(t, c.expected.JournaldUnits, got.JournaldUnits)
+		})
+	}
+}
+
+func TestResourcesMonitoringCPUProcessesInodes(t *testing.T) {
+	t.Parallel()
+	ctx, logger := ctxAndLogger(t)
+
+	// nolint:dogsled
+	_, filename, _, _ := runtime.Caller(0)
+
+	dir := filepath.Join(filepath.Dir(filename), "testdata", "resources", "multiple-agents-multiple-monitors")
+	tfStateRaw, err := os.ReadFile(filepath.Join(dir, "multiple-agents-multiple-monitors.tfstate.json"))
+	require.NoError(t, err)
+	tfStateGraph, err := os.ReadFile(filepath.Join(dir, "multiple-agents-multiple-monitors.tfstate.dot"))
+	require.NoError(t, err)
+
+	var tfState tfjson.State
+	err = json.Unmarshal(tfStateRaw, &tfState)
+	require.NoError(t, err)
+
+	found := false
+	for _, resource := range tfState.Values.RootModule.Resources {
+		if resource.Type != "coder_agent" || resource.Name != "dev1" {
+			continue
+		}
+		found = true
+		resource.AttributeValues["resources_monitoring"] = []any{
+			map[string]any{
+				"memory": []any{map[string]any{"enabled": true, "threshold": 80}},
+				"cpu":    []any{map[string]any{"enabled": true, "threshold": 90}},
+				"processes": []any{
+					map[string]any{"enabled": true, "threshold": 75},
+				},
+				"inode": []any{
+					map[string]any{"path": "/home/coder", "enabled": true, "threshold": 85},
+					map[string]any{"path": "/tmp", "enabled": false, "threshold": 50},
+				},
+			},
+		}
+	}
+	require.True(t, found)
+
+	state, err := terraform.ConvertState(ctx, []*tfjson.StateModule{tfState.Values.RootModule}, string(tfStateGraph), logger)
+	require.NoError(t, err)
+
+	var monitoring *proto.ResourcesMonitoring
+	for _, resource := range state.Resources {
+		for _, agent := range resource.Agents {
+			if agent.Name == "dev1" {
+				monitoring = agent.ResourcesMonitoring
+			}
+		}
+	}
+	require.NotNil(t, monitoring)
+	require.True(t, protobuf.Equal(&proto.ResourcesMonitoring{
+		Memory:    &proto.MemoryResourceMonitor{Enabled: true, Threshold: 80},
+		Cpu:       &proto.CPUResourceMonitor{Enabled: true, Threshold: 90},
+		Processes: &proto.ProcessResourceMonitor{Enabled: true, Threshold: 75},
+		Inodes: []*proto.VolumeResourceMonitor{
+			{Path: "/home/coder", Enabled: true, Threshold: 85},
+			{Path: "/tmp", Enabled: false, Threshold: 50},
+		},
+	}, monitoring), "unexpected resources monitoring: %v", monitoring)
+}
+
 //nolint:tparallel
 func TestAgentNameInvalid(t *testing.T) {
 	t.Parallel()
@@ -92,9 +92,28 @@ import "github.com/coder/coder/v2/apiversion"
 //     `coder_secret` Terraform integration is being removed; user secrets are
 //     still delivered to running workspaces via the agent manifest path, which
 //     is independent of this proto.
+//
+// API v1.19:
+//   - Added `address` and `command` fields to `provisioner.Healthcheck` for
+//     TCP and exec app healthchecks.
+//
+// API v1.20:
+//   - Added `log_tails` to `provisioner.Agent` and the `LogTail` message, for
+//     log files and journald units tailed by the agent into log sources.
+//
+// API v1.21:
+//   - Added `cpu`, `processes` and `inodes` to `provisioner.ResourcesMonitoring`,
+//     along with the `CPUResourceMonitor` and `ProcessResourceMonitor` messages.
+//
+// API v1.22:
+//   - Added the `WorkspaceDriftCheck` job type to `AcquiredJob`, `FailedJob`
+//     and `CompletedJob`, for refresh-only plans that detect resources changed
+//     outside of Coder.
+//   - Added `refresh_only` to `PlanRequest`, and `resource_drift` to
+//     `PlanComplete` along with the `ResourceDrift` message.
 const (
 	CurrentMajor = 1
-	CurrentMinor = 18
+	CurrentMinor = 22
 )
 
 // CurrentVersion is the current provisionerd API version.
@@ -532,6 +532,76 @@ func TestProvisionerd(t *testing.T) {
 		assert.True(t, didComplete.Load(), "should complete the job")
 	})
 
+	t.Run("WorkspaceDriftCheck", func(t *testing.T) {
+		t.Parallel()
+		done := make(chan struct{})
+		t.Cleanup(func() {
+			close(done)
+		})
+		var (
+			completed   = make(chan *proto.CompletedJob, 1)
+			refreshOnly atomic.Bool
+			acq         = newAcquireOne(t, &proto.AcquiredJob{
+				JobId:       "test",
+				Provisioner: "someprovisioner",
+				TemplateSourceArchive: testutil.CreateTar(t, map[string]string{
+					"test.txt": "content",
+				}),
+				Type: &proto.AcquiredJob_WorkspaceDriftCheck_{
+					WorkspaceDriftCheck: &proto.AcquiredJob_WorkspaceDriftCheck{
+						Metadata: &sdkproto.Metadata{},
+						State:    []byte("state"),
+					},
+				},
+			})
+		)
+
+		closer := createProvisionerd(t, func(ctx context.Context) (proto.DRPCProvisionerDaemonClient, error) {
+			return createProvisionerDaemonClient(t, done, provisionerDaemonTestServer{
+				acquireJobWithCancel: acq.acquireWithCancel,
+				updateJob:            noopUpdateJob,
+				completeJob: func(ctx context.Context, job *proto.CompletedJob) (*proto.Empty, error) {
+					completed <- job
+					return &proto.Empty{}, nil
+				},
+			}), nil
+		}, provisionerd.LocalProvisioners{
+			"someprovisioner": createProvisionerClient(t, done, provisionerTestServer{
+				init: func(s *provisionersdk.Session, r *provisionersdk.InitRequest, canceledOrComplete <-chan struct{}) *sdkproto.InitComplete {
+					return &sdkproto.InitComplete{}
+				},
+				plan: func(
+					_ *provisionersdk.Session,
+					r *sdkproto.PlanRequest,
+					_ <-chan struct{},
+				) *sdkproto.PlanComplete {
+					refreshOnly.Store(r.RefreshOnly && string(r.State) == "state")
+					return &sdkproto.PlanComplete{
+						ResourceDrift: []*sdkproto.ResourceDrift{{
+							Address: "docker_container.workspace[0]",
+							Action:  "update",
+						}},
+					}
+				},
+				apply: func(
+					_ *provisionersdk.Session,
+					_ *sdkproto.ApplyRequest,
+					_ <-chan struct{},
+				) *sdkproto.ApplyComplete {
+					t.Error("drift checks must never apply")
+					return &sdkproto.ApplyComplete{}
+				},
+			}),
+		})
+		require.Condition(t, closedWithin(acq.complete, testutil.WaitShort))
+		require.NoError(t, closer.Close())
+		assert.True(t, refreshOnly.Load(), "should run a refresh-only plan against the build state")
+		job := testutil.RequireReceive(testutil.Context(t, testutil.WaitShort), t, completed)
+		drift := job.GetWorkspaceDriftCheck().GetResourceDrift()
+		require.Len(t, drift, 1)
+		assert.Equal(t, "docker_container.workspace[0]", drift[0].Address)
+	})
+
 	t.Run("WorkspaceBuildQuotaExceeded", func(t *testing.T) {
 		t.Parallel()
 		done := make(chan struct{})
@@ -453,6 +453,12 @@ func (r *Runner) do(ctx context.Context) (*proto.CompletedJob, *proto.FailedJob)
 			slog.F("variable_values", redactVariableValues(jobType.WorkspaceBuild.VariableValues)),
 		)
 		return r.runWorkspaceBuild(ctx)
+	case *proto.AcquiredJob_WorkspaceDriftCheck_:
+		r.logger.Debug(context.Background(), "acquired job is workspace drift check",
+			slog.F("workspace_build_id", jobType.WorkspaceDriftCheck.WorkspaceBuildId),
+			slog.F("state_length", len(jobType.WorkspaceDriftCheck.State)),
+		)
+		return r.runWorkspaceDriftCheck(ctx)
 	default:
 		return nil, r.failedJobf("unknown job type %q; ensure your provisioner daemon is up-to-date",
 			reflect.TypeOf(r.job.Type).String())
@@ -1096,6 +1102,81 @@ func (r *Runner) runWorkspaceBuild(ctx context.Context) (*proto.CompletedJob, *p
 	}, nil
 }
 
+// runWorkspaceDriftCheck runs a refresh-only plan against the state of a
+// workspace build. Nothing is applied, so the workspace is left untouched.
+func (r *Runner) runWorkspaceDriftCheck(ctx context.Context) (*proto.CompletedJob, *proto.FailedJob) {
+	ctx, span := r.startTrace(ctx, tracing.FuncName())
+	defer span.End()
+
+	driftCheck := r.job.GetWorkspaceDriftCheck()
+	failedJob := r.configure(&sdkproto.Config{
+		ProvisionerLogLevel: driftCheck.LogLevel,
+		TemplateId:          strings2.EmptyToNil(driftCheck.Metadata.TemplateId),
+		TemplateVersionId:   strings2.EmptyToNil(driftCheck.Metadata.TemplateVersionId),
+	})
+	if failedJob != nil {
+		return nil, failedJob
+	}
+
+	var cachedModulesTar []byte
+	if driftCheck.Metadata.TemplateVersionModulesFile != "" {
+		fileID, err := uuid.Parse(driftCheck.Metadata.TemplateVersionModulesFile)
+		if err != nil {
+			return nil, r.failedWorkspaceDriftCheckf("invalid template version modules file ID: %s", err)
+		}
+		cachedModulesTar, err = r.fileDownloader.DownloadFile(ctx, &proto.FileRequest{
+			FileId:     fileID.String(),
+			UploadType: sdkproto.DataUploadType_UPLOAD_TYPE_MODULE_FILES,
+		})
+		if err != nil {
+			return nil, r.failedWorkspaceDriftCheckf("failed to download template version modules file: %s", err)
+		}
+	}
+
+	initComplete, failedJob := r.init(ctx, true, r.job.GetTemplateSourceArchive(), cachedModulesTar)
+	if failedJob != nil {
+		return nil, failedJob
+	}
+	if initComplete == nil {
+		return nil, r.failedWorkspaceDriftCheckf("invalid message type received from provisioner during init")
+	}
+	if initComplete.Error != "" {
+		return nil, r.failedWorkspaceDriftCheckf("%s", initComplete.Error)
+	}
+
+	planComplete, failed := r.plan(ctx, "Detecting drift", &sdkproto.PlanRequest{
+		Metadata:              driftCheck.Metadata,
+		RichParameterValues:   driftCheck.RichParameterValues,
+		VariableValues:        driftCheck.VariableValues,
+		ExternalAuthProviders: driftCheck.ExternalAuthProviders,
+		State:                 driftCheck.State,
+		RefreshOnly:           true,
+	})
+	if failed != nil {
+		return nil, failed
+	}
+	if planComplete == nil {
+		return nil, r.failedWorkspaceDriftCheckf("invalid message type received from provisioner during plan")
+	}
+	if planComplete.Error != "" {
+		return nil, r.failedWorkspaceDriftCheckf("%s", planComplete.Error)
+	}
+
+	r.logger.Info(context.Background(), "drift check successful",
+		slog.F("drifted_resource_count", len(planComplete.ResourceDrift)),
+	)
+	r.flushQueuedLogs(ctx)
+
+	return &proto.CompletedJob{
+		JobId: r.job.JobId,
+		Type: &proto.CompletedJob_WorkspaceDriftCheck_{
+			WorkspaceDriftCheck: &proto.CompletedJob_WorkspaceDriftCheck{
+				ResourceDrift: planComplete.ResourceDrift,
+			},
+		},
+	}, nil
+}
+
 func resourceNames(rs []*sdkproto.Resource) []string {
 	var sb strings.Builder
 	names := make([]string, 0, len(rs))
@@ -1115,6 +1196,12 @@ func (r *Runner) failedWorkspaceBuildf(format string, args ...interface{}) *prot
 	return failedJob
 }
 
+func (r *Runner) failedWorkspaceDriftCheckf(format string, args ...interface{}) *proto.FailedJob {
+	failedJob := r.failedJobf(format, args...)
+	failedJob.Type = &proto.FailedJob_WorkspaceDriftCheck_{}
+	return failedJob
+}
+
 func (r *Runner) failedWorkspaceBuildfCode(
 	code string,
 	format string,
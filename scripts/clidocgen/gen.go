@@ -87,7 +87,8 @@ func init() {
 				case *serpent.EnumArray:
 					return fmt.Sprintf("[%s]", strings.Join(v.Choices, "\\|"))
 				default:
-					return v.Type()
+					// Escape pipes so that they don't split the table cell.
+					return strings.ReplaceAll(v.Type(), "|", "\\|")
 				}
 			},
 		},
@@ -739,6 +739,7 @@ const createTemplateVersionTar = async (
 			timings: [],
 			plan: emptyPlan,
 			resourceReplacements: [],
+			resourceDrift: [],
 			...response.plan,
 		} as PlanComplete;
 		tar.addFile(
@@ -790,6 +791,7 @@ const createTemplateVersionTar = async (
 						token: randomUUID(),
 						devcontainers: [],
 						apiKeyScope: "all",
+						logTails: [],
 						...agent,
 					} as Agent;
 
@@ -189,6 +189,23 @@ export interface ResourceReplacement {
   paths: string[];
 }
 
+/**
+ * ResourceDrift describes a resource that was changed outside of Terraform
+ * since it was last applied.
+ */
+export interface ResourceDrift {
+  address: string;
+  type: string;
+  name: string;
+  /** action is the change Terraform detected, e.g. "update" or "delete". */
+  action: string;
+  /**
+   * attributes are the names of the attributes that changed. Values are
+   * omitted as they may be sensitive.
+   */
+  attributes: string[];
+}
+
 /** VariableValue holds the key/value mapping of a Terraform variable. */
 export interface VariableValue {
   name: string;
@@ -244,6 +261,7 @@ export interface Agent {
   resourcesMonitoring: ResourcesMonitoring | undefined;
   devcontainers: Devcontainer[];
   apiKeyScope: string;
+  logTails: LogTail[];
 }
 
 export interface Agent_Metadata {
@@ -263,6 +281,9 @@ export interface Agent_EnvEntry {
 export interface ResourcesMonitoring {
   memory: MemoryResourceMonitor | undefined;
   volumes: VolumeResourceMonitor[];
+  cpu: CPUResourceMonitor | undefined;
+  processes: ProcessResourceMonitor | undefined;
+  inodes: VolumeResourceMonitor[];
 }
 
 export interface MemoryResourceMonitor {
@@ -276,6 +297,16 @@ export interface VolumeResourceMonitor {
   threshold: number;
 }
 
+export interface CPUResourceMonitor {
+  enabled: boolean;
+  threshold: number;
+}
+
+export interface ProcessResourceMonitor {
+  enabled: boolean;
+  threshold: number;
+}
+
 export interface DisplayApps {
   vscode: boolean;
   vscodeInsiders: boolean;
@@ -308,6 +339,17 @@ export interface Script {
   logPath: string;
 }
 
+/**
+ * LogTail represents log files and journald units that the agent tails
+ * into a log source. Paths may contain glob patterns.
+ */
+export interface LogTail {
+  displayName: string;
+  icon: string;
+  paths: string[];
+  journaldUnits: string[];
+}
+
 export interface Devcontainer {
   workspaceFolder: string;
   configPath: string;
@@ -343,11 +385,17 @@ export interface App {
   tooltip: string;
 }
 
-/** Healthcheck represents configuration for checking for app readiness. */
+/**
+ * Healthcheck represents configuration for checking for app readiness.
+ * Exactly one of url, address or command is set, and it selects the type of
+ * check: an HTTP request, a TCP connection, or a command run in the workspace.
+ */
 export interface Healthcheck {
   url: string;
   interval: number;
   threshold: number;
+  address: string;
+  command: string;
 }
 
 /** Resource represents created infrastructure. */
@@ -486,6 +534,11 @@ export interface PlanRequest {
   previousParameterValues: RichParameterValue[];
   /** state is the provisioner state (if any) */
   state: Uint8Array;
+  /**
+   * refresh_only only refreshes the state to detect changes made outside of
+   * Terraform. Nothing is planned for apply.
+   */
+  refreshOnly: boolean;
 }
 
 /** PlanComplete indicates a request to plan completed. */
@@ -496,6 +549,7 @@ export interface PlanComplete {
   dailyCost: number;
   resourceReplacements: ResourceReplacement[];
   aiTaskCount: number;
+  resourceDrift: ResourceDrift[];
 }
 
 /**
@@ -825,6 +879,27 @@ export const ResourceReplacement = {
   },
 };
 
+export const ResourceDrift = {
+  encode(message: ResourceDrift, writer: _m0.Writer = _m0.Writer.create()): _m0.Writer {
+    if (message.address !== "") {
+      writer.uint32(10).string(message.address);
+    }
+    if (message.type !== "") {
+      writer.uint32(18).string(message.type);
+    }
+    if (message.name !== "") {
+      writer.uint32(26).string(message.name);
+    }
+    if (message.action !== "") {
+      writer.uint32(34).string(message.action);
+    }
+    for (const v of message.attributes) {
+      writer.uint32(42).string(v!);
+    }
+    return writer;
+  },
+};
+
 export const VariableValue = {
   encode(message: VariableValue, writer: _m0.Writer = _m0.Writer.create()): _m0.Writer {
     if (message.name !== "") {
@@ -947,6 +1022,9 @@ export const Agent = {
     if (message.apiKeyScope !== "") {
       writer.uint32(210).string(message.apiKeyScope);
     }
+    for (const v of message.logTails) {
+      LogTail.encode(v!, writer.uint32(218).fork()).ldelim();
+    }
     return writer;
   },
 };
@@ -995,6 +1073,15 @@ export const ResourcesMonitoring = {
     for (const v of message.volumes) {
       VolumeResourceMonitor.encode(v!, writer.uint32(18).fork()).ldelim();
     }
+    if (message.cpu !== undefined) {
+      CPUResourceMonitor.encode(message.cpu, writer.uint32(26).fork()).ldelim();
+    }
+    if (message.processes !== undefined) {
+      ProcessResourceMonitor.encode(message.processes, writer.uint32(34).fork()).ldelim();
+    }
+    for (const v of message.inodes) {
+      VolumeResourceMonitor.encode(v!, writer.uint32(42).fork()).ldelim();
+    }
     return writer;
   },
 };
@@ -1026,6 +1113,30 @@ export const VolumeResourceMonitor = {
   },
 };
 
+export const CPUResourceMonitor = {
+  encode(message: CPUResourceMonitor, writer: _m0.Writer = _m0.Writer.create()): _m0.Writer {
+    if (message.enabled !== false) {
+      writer.uint32(8).bool(message.enabled);
+    }
+    if (message.threshold !== 0) {
+      writer.uint32(16).int32(message.threshold);
+    }
+    return writer;
+  },
+};
+
+export const ProcessResourceMonitor = {
+  encode(message: ProcessResourceMonitor, writer: _m0.Writer = _m0.Writer.create()): _m0.Writer {
+    if (message.enabled !== false) {
+      writer.uint32(8).bool(message.enabled);
+    }
+    if (message.threshold !== 0) {
+      writer.uint32(16).int32(message.threshold);
+    }
+    return writer;
+  },
+};
+
 export const DisplayApps = {
   encode(message: DisplayApps, writer: _m0.Writer = _m0.Writer.create()): _m0.Writer {
     if (message.vscode !== false) {
@@ -1095,6 +1206,24 @@ export const Script = {
   },
 };
 
+export const LogTail = {
+  encode(message: LogTail, writer: _m0.Writer = _m0.Writer.create()): _m0.Writer {
+    if (message.displayName !== "") {
+      writer.uint32(10).string(message.displayName);
+    }
+    if (message.icon !== "") {
+      writer.uint32(18).string(message.icon);
+    }
+    for (const v of message.paths) {
+      writer.uint32(26).string(v!);
+    }
+    for (const v of message.journaldUnits) {
+      writer.uint32(34).string(v!);
+    }
+    return writer;
+  },
+};
+
 export const Devcontainer = {
   encode(message: Devcontainer, writer: _m0.Writer = _m0.Writer.create()): _m0.Writer {
     if (message.workspaceFolder !== "") {
@@ -1187,6 +1316,12 @@ export const Healthcheck = {
     if (message.threshold !== 0) {
       writer.uint32(24).int32(message.threshold);
     }
+    if (message.address !== "") {
+      writer.uint32(34).string(message.address);
+    }
+    if (message.command !== "") {
+      writer.uint32(42).string(message.command);
+    }
     return writer;
   },
 };
@@ -1496,6 +1631,9 @@ export const PlanRequest = {
     if (message.state.length !== 0) {
       writer.uint32(50).bytes(message.state);
     }
+    if (message.refreshOnly !== false) {
+      writer.uint32(64).bool(message.refreshOnly);
+    }
     return writer;
   },
 };
@@ -1520,6 +1658,9 @@ export const PlanComplete = {
     if (message.aiTaskCount !== 0) {
       writer.uint32(48).int32(message.aiTaskCount);
     }
+    for (const v of message.resourceDrift) {
+      ResourceDrift.encode(v!, writer.uint32(58).fork()).ldelim();
+    }
     return writer;
   },
 };
@@ -55,6 +55,11 @@ var (
 	oauthHTML string
 
 	oauthTemplate *htmltemplate.Template
+
+	//go:embed static/oauth2device.html
+	oauthDeviceHTML string
+
+	oauthDeviceTemplate *htmltemplate.Template
 )
 
 func init() {
@@ -68,6 +73,11 @@ func init() {
 	if err != nil {
 		panic(err)
 	}
+
+	oauthDeviceTemplate, err = htmltemplate.New("device").Parse(oauthDeviceHTML)
+	if err != nil {
+		panic(err)
+	}
 }
 
 type Options struct {
@@ -822,3 +832,36 @@ func RenderOAuthAllowPage(rw http.ResponseWriter, r *http.Request, data RenderOA
 		return
 	}
 }
+
+// RenderOAuthDeviceData contains the variables that are found in
+// site/static/oauth2device.html. The page asks for a user code when AppName
+// is empty, asks for consent when it is set, and shows Message alone when
+// that is set.
+type RenderOAuthDeviceData struct {
+	AppIcon      string
+	AppName      string
+	UserCode     string
+	Error        string
+	Message      string
+	DashboardURL string
+	CSRFToken    string
+	Username     string
+}
+
+// RenderOAuthDevicePage renders the static page for a user to approve a
+// device that is signing in with the OAuth2 device authorization grant.
+func RenderOAuthDevicePage(rw http.ResponseWriter, r *http.Request, data RenderOAuthDeviceData) {
+	rw.Header().Set("Content-Type", "text/html; charset=utf-8")
+
+	// Same clickjacking protection as the consent page.
+	rw.Header().Set("Content-Security-Policy", "frame-ancestors 'none'")
+	rw.Header().Set("X-Frame-Options", "DENY")
+
+	err := oauthDeviceTemplate.Execute(rw, data)
+	if err != nil {
+		httpapi.Write(r.Context(), rw, http.StatusOK, codersdk.Response{
+			Message: "Failed to render oauth device page: " + err.Error(),
+		})
+		return
+	}
+}
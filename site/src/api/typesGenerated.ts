@@ -1684,6 +1684,41 @@ export interface AuditLogResponse {
 	readonly count_cap: number;
 }
 
+// From codersdk/deployment.go
+/**
+ * AuditLogStreamingConfig configures backends that receive each audit log as
+ * it is recorded. A backend is enabled by setting its address, URL or path.
+ * Each backend has its own filter, a list of "key:value" terms where terms
+ * sharing a key are ORed and different keys are ANDed. Supported keys are
+ * "resource_type" and "action".
+ */
+export interface AuditLogStreamingConfig {
+	/**
+	 * SyslogAddress is the host:port of an RFC 5424 syslog receiver.
+	 */
+	readonly syslog_address: string;
+	readonly syslog_tls: boolean;
+	readonly syslog_tls_ca_file: string;
+	readonly syslog_facility: number;
+	readonly syslog_filter: string;
+	readonly webhook_url: string;
+	readonly webhook_secret: string;
+	readonly webhook_batch_size: number;
+	/**
+	 * WebhookFlushInterval is how long audit logs wait for a batch to fill.
+	 */
+	readonly webhook_flush_interval: number;
+	readonly webhook_max_retries: number;
+	readonly webhook_filter: string;
+	/**
+	 * FilePath is the JSONL file audit logs are appended to.
+	 */
+	readonly file_path: string;
+	readonly file_max_size_mb: number;
+	readonly file_max_backups: number;
+	readonly file_filter: string;
+}
+
 // From codersdk/audit.go
 export interface AuditLogsRequest extends Pagination {
 	readonly q?: string;
@@ -1703,6 +1738,11 @@ export interface AuthMethods {
 	readonly password: AuthMethod;
 	readonly github: GithubAuthMethod;
 	readonly oidc: OIDCAuthMethod;
+	/**
+	 * OIDCProviders are the named OIDC providers. Each gets its own login
+	 * button that starts at /api/v2/users/oidc/{id}/callback.
+	 */
+	readonly oidc_providers?: readonly OIDCProviderAuthMethod[];
 }
 
 // From codersdk/authorization.go
@@ -4027,6 +4067,16 @@ export interface CreateOrganizationRequest {
 	readonly icon?: string;
 }
 
+// From codersdk/scheduleexceptions.go
+export interface CreateOutOfOfficeRangeRequest {
+	readonly start_date: string;
+	/**
+	 * EndDate is inclusive. It defaults to StartDate.
+	 */
+	readonly end_date?: string;
+	readonly reason?: string;
+}
+
 // From codersdk/provisionerdaemons.go
 export interface CreateProvisionerKeyRequest {
 	readonly name: string;
@@ -4038,6 +4088,22 @@ export interface CreateProvisionerKeyResponse {
 	readonly key: string;
 }
 
+// From codersdk/scheduleexceptions.go
+/**
+ * CreateScheduleExceptionCalendarRequest creates an exception calendar from
+ * date ranges, events in an iCalendar file, or both.
+ */
+export interface CreateScheduleExceptionCalendarRequest {
+	readonly name: string;
+	readonly description?: string;
+	readonly dates?: readonly ScheduleExceptionDateRange[];
+	/**
+	 * ICal is the contents of an iCalendar (.ics) file. All-day events and
+	 * yearly recurring events are supported.
+	 */
+	readonly ical?: string;
+}
+
 // From codersdk/aitasks.go
 /**
  * CreateTaskRequest represents the request to create a new task.
@@ -4208,6 +4274,29 @@ export interface CreateTemplateVersionRequest {
 	readonly user_variable_values?: readonly VariableValue[];
 }
 
+// From codersdk/templaterollouts.go
+/**
+ * CreateTemplateVersionRolloutRequest starts a rollout of a template version.
+ * Omitted thresholds use their defaults.
+ */
+export interface CreateTemplateVersionRolloutRequest {
+	readonly template_version_id: string;
+	readonly percentage: number;
+	readonly group_ids?: readonly string[];
+	/**
+	 * FailureThreshold defaults to 0.2.
+	 */
+	readonly failure_threshold?: number;
+	/**
+	 * MinSamples defaults to 5.
+	 */
+	readonly min_samples?: number;
+	/**
+	 * FailureAction defaults to pause.
+	 */
+	readonly failure_action?: TemplateVersionRolloutFailureAction;
+}
+
 // From codersdk/audit.go
 export interface CreateTestAuditLogRequest {
 	readonly action?: AuditAction;
@@ -4331,6 +4420,23 @@ export interface CreateUserSkillRequest {
 	readonly content: string;
 }
 
+// From codersdk/workspaceagentptyshares.go
+/**
+ * CreateWorkspaceAgentPTYShareRequest invites users to watch a reconnecting
+ * PTY session. Viewers can see the session output but cannot send input.
+ */
+export interface CreateWorkspaceAgentPTYShareRequest {
+	readonly reconnect_id: string;
+	readonly user_ids: readonly string[];
+	readonly lifetime?: number; // Lifetime is how long the share is valid for. Defaults to one hour.
+}
+
+// From codersdk/workspaceagentptyshares.go
+export interface CreateWorkspaceAgentPTYShareResponse {
+	readonly share: WorkspaceAgentPTYShare;
+	readonly token: string; // Token is only returned once and must be passed to the invited users.
+}
+
 // From codersdk/workspaces.go
 /**
  * CreateWorkspaceBuildOnSuccessRequest queues a follow-up build that
@@ -4425,6 +4531,34 @@ export interface CreateWorkspaceBuildRequest {
 	readonly on_success?: CreateWorkspaceBuildOnSuccessRequest;
 }
 
+// From codersdk/workspacebulkoperations.go
+/**
+ * CreateWorkspaceBulkOperationRequest applies an action to every workspace
+ * that matches a search query. The query is evaluated once, when the
+ * operation is created, with the permissions of the requester.
+ */
+export interface CreateWorkspaceBulkOperationRequest {
+	/**
+	 * Query uses the same syntax as the workspaces search query, e.g.
+	 * `template:docker owner:alice`.
+	 */
+	readonly query: string;
+	readonly action: WorkspaceBulkOperationAction;
+	/**
+	 * AutostartSchedule is used by the autostart action. It has the same
+	 * form as UpdateWorkspaceAutostartRequest.Schedule. Empty disables
+	 * autostart.
+	 */
+	readonly autostart_schedule?: string;
+	readonly ttl_ms?: number; // TTLMillis is used by the ttl action. Zero disables autostop.
+	readonly automatic_updates?: AutomaticUpdates; // AutomaticUpdates is required by the automatic_updates action.
+	/**
+	 * Concurrency is the number of workspaces that are processed at the same
+	 * time. Defaults to 5.
+	 */
+	readonly concurrency?: number;
+}
+
 // From codersdk/workspaceproxy.go
 export interface CreateWorkspaceProxyRequest {
 	readonly name: string;
@@ -4696,6 +4830,14 @@ export const DefaultChatDebugRetentionDays = 30;
  */
 export const DefaultChatWorkspaceTTL = 0;
 
+// From codersdk/workspacebulkoperations.go
+/**
+ * DefaultWorkspaceBulkOperationConcurrency is the number of workspaces of
+ * a bulk operation that are processed at the same time when the request
+ * does not set a concurrency.
+ */
+export const DefaultWorkspaceBulkOperationConcurrency = 5;
+
 // From codersdk/externalauth.go
 export interface DeleteExternalAuthByIDResponse {
 	/**
@@ -4775,6 +4917,7 @@ export interface DeploymentValues {
 	readonly pg_conn_max_idle?: string;
 	readonly oauth2?: OAuth2Config;
 	readonly oidc?: OIDCConfig;
+	readonly oidc_providers?: SerpentStruct<OIDCProviderConfig[]>;
 	readonly telemetry?: TelemetryConfig;
 	readonly tls?: TLSConfig;
 	readonly trace?: TraceConfig;
@@ -4815,6 +4958,7 @@ export interface DeploymentValues {
 	readonly allow_workspace_renames?: boolean;
 	readonly healthcheck?: HealthcheckConfig;
 	readonly retention?: RetentionConfig;
+	readonly audit_log_streaming?: AuditLogStreamingConfig;
 	readonly cli_upgrade_message?: string;
 	readonly terms_of_service_url?: string;
 	readonly notifications?: NotificationsConfig;
@@ -5673,11 +5817,25 @@ export const HealthSeverities: HealthSeverity[] = ["error", "ok", "warning"];
 export type HealthSeverity = "error" | "ok" | "warning";
 
 // From codersdk/workspaceapps.go
+/**
+ * Healthcheck configures how the agent checks app health. Exactly one of URL,
+ * Address or Command is set, and it selects the type of check.
+ */
 export interface Healthcheck {
 	/**
 	 * URL specifies the endpoint to check for the app health.
 	 */
 	readonly url: string;
+	/**
+	 * Address specifies a host:port that must accept TCP connections for
+	 * the app to be healthy.
+	 */
+	readonly address?: string;
+	/**
+	 * Command specifies a command run in the workspace that must exit with
+	 * status 0 for the app to be healthy.
+	 */
+	readonly command?: string;
 	/**
 	 * Interval specifies the seconds between each health check.
 	 */
@@ -5727,6 +5885,14 @@ export interface HealthcheckReport {
 	readonly coder_version: string;
 }
 
+// From codersdk/workspaceapps.go
+/**
+ * HealthcheckType is the type of check a Healthcheck performs.
+ */
+export type HealthcheckType = "exec" | "http" | "tcp";
+
+export const HealthcheckTypes: HealthcheckType[] = ["exec", "http", "tcp"];
+
 // From codersdk/idpsync.go
 export interface IDPSyncMapping<ResourceIdType extends string> {
 	/**
@@ -5836,6 +6002,11 @@ export interface License {
 	 */
 	// empty interface{} type, falling back to unknown
 	readonly claims: Record<string, unknown>;
+	/**
+	 * Provider is the ID of the named OIDC provider the user is linked
+	 * to. It is empty for the deployment's primary OIDC provider.
+	 */
+	readonly provider?: string;
 }
 
 // From codersdk/licenses.go
@@ -6136,6 +6307,14 @@ export const MaxChatFileIDs = 50;
  */
 export const MaxChatFileSizeBytes = 10485760;
 
+// From codersdk/provisionerdaemons.go
+/**
+ * MaxProvisionerJobPriorityBoost limits organization and template priority
+ * boosts. Both boosts added together are smaller than the distance between
+ * two priority classes, so boosts only reorder jobs within a class.
+ */
+export const MaxProvisionerJobPriorityBoost = 100;
+
 // From codersdk/usersecretsimport.go
 /**
  * MaxSecretsFileBytes bounds the raw size of a secrets file before parsing.
@@ -6242,6 +6421,13 @@ export const MaxUserSecretsPerUserCount = 50;
  */
 export const MaxUserSecretsTotalValueBytes = 204800; // 200 KiB
 
+// From codersdk/workspacebulkoperations.go
+/**
+ * MaxWorkspaceBulkOperationConcurrency is the highest concurrency a bulk
+ * operation can be created with.
+ */
+export const MaxWorkspaceBulkOperationConcurrency = 50;
+
 // From codersdk/organizations.go
 export interface MinimalOrganization {
 	readonly id: string;
@@ -6395,6 +6581,14 @@ export interface NotificationsConfig {
 	 * Webhook settings.
 	 */
 	readonly webhook: NotificationsWebhookConfig;
+	/**
+	 * Slack settings.
+	 */
+	readonly slack: NotificationsSlackConfig;
+	/**
+	 * Microsoft Teams settings.
+	 */
+	readonly msteams: NotificationsTeamsConfig;
 	/**
 	 * Inbox settings.
 	 */
@@ -6487,6 +6681,34 @@ export interface NotificationsSettings {
 	readonly notifier_paused: boolean;
 }
 
+// From codersdk/deployment.go
+export interface NotificationsSlackConfig {
+	/**
+	 * The bot token used to send direct messages via chat.postMessage.
+	 */
+	readonly bot_token: string;
+	/**
+	 * The incoming webhook to post to when a user's Slack ID is unknown.
+	 */
+	readonly webhook_url: string;
+	/**
+	 * The claim from a user's login which holds their Slack user ID.
+	 */
+	readonly user_id_claim: string;
+}
+
+// From codersdk/deployment.go
+export interface NotificationsTeamsConfig {
+	/**
+	 * The incoming webhook or Workflows URL to which Adaptive Cards are posted.
+	 */
+	readonly webhook_url: string;
+	/**
+	 * The claim from a user's login which identifies them in Microsoft Teams.
+	 */
+	readonly user_id_claim: string;
+}
+
 // From codersdk/deployment.go
 export interface NotificationsWebhookConfig {
 	/**
@@ -6525,6 +6747,7 @@ export interface OAuth2AuthorizationServerMetadata {
 	readonly token_endpoint: string;
 	readonly registration_endpoint?: string;
 	readonly revocation_endpoint?: string;
+	readonly device_authorization_endpoint?: string;
 	readonly response_types_supported: readonly OAuth2ProviderResponseType[];
 	readonly grant_types_supported?: readonly OAuth2ProviderGrantType[];
 	readonly code_challenge_methods_supported?: readonly OAuth2PKCECodeChallengeMethod[];
@@ -6620,6 +6843,32 @@ export interface OAuth2Config {
 	readonly github: OAuth2GithubConfig;
 }
 
+// From codersdk/oauth2.go
+/**
+ * OAuth2DeviceAuthorizationRequest represents a device authorization request
+ * per RFC 8628 §3.1. The actual wire format is
+ * application/x-www-form-urlencoded; this struct is for SDK docs.
+ */
+export interface OAuth2DeviceAuthorizationRequest {
+	readonly client_id: string;
+	readonly scope?: string;
+	readonly resource?: string;
+}
+
+// From codersdk/oauth2.go
+/**
+ * OAuth2DeviceAuthorizationResponse represents a device authorization
+ * response per RFC 8628 §3.2.
+ */
+export interface OAuth2DeviceAuthorizationResponse {
+	readonly device_code: string;
+	readonly user_code: string;
+	readonly verification_uri: string;
+	readonly verification_uri_complete?: string;
+	readonly expires_in: number;
+	readonly interval?: number;
+}
+
 // From codersdk/oauth2.go
 export interface OAuth2DeviceFlowCallbackResponse {
 	readonly redirect_url: string;
@@ -6638,12 +6887,15 @@ export interface OAuth2Error {
 // From codersdk/oauth2.go
 export type OAuth2ErrorCode =
 	| "access_denied"
+	| "authorization_pending"
+	| "expired_token"
 	| "invalid_client"
 	| "invalid_grant"
 	| "invalid_request"
 	| "invalid_scope"
 	| "invalid_target"
 	| "server_error"
+	| "slow_down"
 	| "temporarily_unavailable"
 	| "unauthorized_client"
 	| "unsupported_grant_type"
@@ -6652,12 +6904,15 @@ export type OAuth2ErrorCode =
 
 export const OAuth2ErrorCodes: OAuth2ErrorCode[] = [
 	"access_denied",
+	"authorization_pending",
+	"expired_token",
 	"invalid_client",
 	"invalid_grant",
 	"invalid_request",
 	"invalid_scope",
 	"invalid_target",
 	"server_error",
+	"slow_down",
 	"temporarily_unavailable",
 	"unauthorized_client",
 	"unsupported_grant_type",
@@ -6711,6 +6966,11 @@ export interface OAuth2ProviderApp {
 	readonly name: string;
 	readonly callback_url: string;
 	readonly icon: string;
+	/**
+	 * ClientCredentialsUserID is the service account that tokens issued by
+	 * the client_credentials grant act as. The grant is disabled when unset.
+	 */
+	readonly client_credentials_user_id?: string;
 	/**
 	 * Endpoints are included in the app response for easier discovery. The OAuth2
 	 * spec does not have a defined place to find these (for comparison, OIDC has
@@ -6743,7 +7003,8 @@ export type OAuth2ProviderGrantType =
 	| "client_credentials"
 	| "implicit"
 	| "password"
-	| "refresh_token";
+	| "refresh_token"
+	| "urn:ietf:params:oauth:grant-type:device_code";
 
 export const OAuth2ProviderGrantTypes: OAuth2ProviderGrantType[] = [
 	"authorization_code",
@@ -6751,6 +7012,7 @@ export const OAuth2ProviderGrantTypes: OAuth2ProviderGrantType[] = [
 	"implicit",
 	"password",
 	"refresh_token",
+	"urn:ietf:params:oauth:grant-type:device_code",
 ];
 
 // From codersdk/oauth2.go
@@ -6831,6 +7093,7 @@ export interface OAuth2TokenRequest {
 	readonly client_secret?: string;
 	readonly code_verifier?: string;
 	readonly refresh_token?: string;
+	readonly device_code?: string;
 	readonly resource?: string;
 	readonly scope?: string;
 }
@@ -6971,6 +7234,60 @@ export interface OIDCConfig {
 	readonly redirect_allowed_hosts: string;
 }
 
+// From codersdk/deployment.go
+/**
+ * OIDCProviderConfig configures an additional, named OpenID Connect
+ * provider. Users can sign in through any configured provider, and each
+ * provider carries its own client, claim mapping and IdP sync settings.
+ */
+export interface OIDCProviderConfig {
+	/**
+	 * ID is a unique identifier for the provider. It is used in the
+	 * callback URL: /api/v2/users/oidc/{id}/callback.
+	 */
+	readonly id: string;
+	readonly client_id: string;
+	/**
+	 * ClientKeyFile & ClientCertFile are used in place of ClientSecret for PKI auth.
+	 */
+	readonly client_key_file: string;
+	readonly client_cert_file: string;
+	readonly issuer_url: string;
+	readonly scopes: readonly string[];
+	readonly email_domain: readonly string[];
+	readonly allow_signups: boolean;
+	readonly ignore_email_verified: boolean;
+	readonly username_field: string;
+	readonly name_field: string;
+	readonly email_field: string;
+	readonly auth_url_params: Record<string, string>;
+	readonly ignore_user_info: boolean;
+	readonly source_user_info_from_access_token: boolean;
+	readonly skip_issuer_checks: boolean;
+	readonly sign_in_text: string;
+	readonly icon_url: string;
+	readonly signups_disabled_text: string;
+	readonly email_fallback: boolean;
+	readonly organization_field: string;
+	readonly organization_mapping: Record<string, readonly string[]>;
+	readonly organization_assign_default: boolean;
+	readonly group_auto_create: boolean;
+	readonly group_regex_filter: string;
+	readonly group_allow_list: readonly string[];
+	readonly groups_field: string;
+	readonly group_mapping: Record<string, string>;
+	readonly user_role_field: string;
+	readonly user_role_mapping: Record<string, readonly string[]>;
+	readonly user_roles_default: readonly string[];
+}
+
+// From codersdk/users.go
+export interface OIDCProviderAuthMethod {
+	readonly id: string;
+	readonly signInText: string;
+	readonly iconUrl: string;
+}
+
 // From codersdk/parameters.go
 export type OptionType = "bool" | "list(string)" | "number" | "string";
 
@@ -7106,6 +7423,20 @@ export interface OrganizationSyncSettings {
 	readonly organization_assign_default: boolean;
 }
 
+// From codersdk/scheduleexceptions.go
+/**
+ * OutOfOfficeRange is a range of dates on which none of a user's workspaces
+ * autostart.
+ */
+export interface OutOfOfficeRange {
+	readonly id: string;
+	readonly user_id: string;
+	readonly start_date: string;
+	readonly end_date: string;
+	readonly reason: string;
+	readonly created_at: string;
+}
+
 // From codersdk/groups.go
 /**
  * PaginatedGroup is a group summary returned by the paginated groups endpoint.
@@ -7319,6 +7650,11 @@ export interface PostOAuth2ProviderAppRequest {
 	readonly name: string;
 	readonly callback_url: string;
 	readonly icon: string;
+	/**
+	 * ClientCredentialsUserID enables the client_credentials grant, issuing
+	 * tokens for the given service account.
+	 */
+	readonly client_credentials_user_id?: string;
 }
 
 // From codersdk/workspaces.go
@@ -7641,6 +7977,7 @@ export interface ProvisionerJob {
 	readonly tags: Record<string, string>;
 	readonly queue_position: number;
 	readonly queue_size: number;
+	readonly priority: number;
 	readonly organization_id: string;
 	readonly initiator_id: string;
 	readonly input: ProvisionerJobInput;
@@ -7688,6 +8025,42 @@ export interface ProvisionerJobMetadata {
 	readonly workspace_build_transition?: WorkspaceTransition;
 }
 
+// From codersdk/provisionerdaemons.go
+/**
+ * ProvisionerJobPriority is a priority boost added to the priority class of
+ * provisioner jobs in an organization, or of a single template.
+ */
+export interface ProvisionerJobPriority {
+	readonly organization_id: string;
+	/**
+	 * TemplateID is empty if the boost applies to the whole organization.
+	 */
+	readonly template_id?: string;
+	readonly priority: number;
+	readonly updated_at: string;
+}
+
+// From codersdk/provisionerdaemons.go
+/**
+ * ProvisionerJobPriorityAutomated is the priority class of builds that no one
+ * is waiting for, such as autostart, autostop and prebuilds.
+ */
+export const ProvisionerJobPriorityAutomated = 0;
+
+// From codersdk/provisionerdaemons.go
+/**
+ * ProvisionerJobPriorityInteractive is the priority class of builds and
+ * dry-runs started by a user.
+ */
+export const ProvisionerJobPriorityInteractive = 2000;
+
+// From codersdk/provisionerdaemons.go
+/**
+ * ProvisionerJobPriorityTemplateImport is the priority class of template
+ * version imports.
+ */
+export const ProvisionerJobPriorityTemplateImport = 1000;
+
 // From codersdk/provisionerdaemons.go
 export type ProvisionerJobStatus =
 	| "canceled"
@@ -7712,12 +8085,14 @@ export const ProvisionerJobStatuses: ProvisionerJobStatus[] = [
 export type ProvisionerJobType =
 	| "template_version_dry_run"
 	| "template_version_import"
-	| "workspace_build";
+	| "workspace_build"
+	| "workspace_drift_check";
 
 export const ProvisionerJobTypes: ProvisionerJobType[] = [
 	"template_version_dry_run",
 	"template_version_import",
 	"workspace_build",
+	"workspace_drift_check",
 ];
 
 // From codersdk/provisionerdaemons.go
@@ -7827,6 +8202,12 @@ export interface PutOAuth2ProviderAppRequest {
 	readonly name: string;
 	readonly callback_url: string;
 	readonly icon: string;
+	/**
+	 * ClientCredentialsUserID enables the client_credentials grant, issuing
+	 * tokens for the given service account. Omitting it keeps the current
+	 * service account, and the nil UUID disables the grant.
+	 */
+	readonly client_credentials_user_id?: string;
 }
 
 // From codersdk/rbacresources_gen.go
@@ -8123,6 +8504,7 @@ export type ResourceType =
 	| "workspace_agent"
 	| "workspace_app"
 	| "workspace_build"
+	| "workspace_bulk_operation"
 	| "workspace_proxy";
 
 export const ResourceTypes: ResourceType[] = [
@@ -8163,6 +8545,7 @@ export const ResourceTypes: ResourceType[] = [
 	"workspace_agent",
 	"workspace_app",
 	"workspace_build",
+	"workspace_bulk_operation",
 	"workspace_proxy",
 ];
 
@@ -8242,6 +8625,12 @@ export interface RetentionConfig {
 	 * organization's regulatory requirements.
 	 */
 	readonly boundary_logs: number;
+	/**
+	 * ArchiveURL is a local directory or S3 URL that audit logs, connection
+	 * logs, workspace agent logs and AI Bridge records are archived to
+	 * before they are purged. Unset to disable archiving.
+	 */
+	readonly archive_url: string;
 }
 
 // From codersdk/roles.go
@@ -8397,6 +8786,47 @@ export interface STUNReport {
 	readonly Error: string | null;
 }
 
+// From codersdk/scheduleexceptions.go
+/**
+ * ScheduleExceptionCalendar is an organization's list of dates on which
+ * workspaces of the templates it is attached to do not autostart, such as
+ * public holidays.
+ */
+export interface ScheduleExceptionCalendar {
+	readonly id: string;
+	readonly organization_id: string;
+	readonly name: string;
+	readonly description: string;
+	/**
+	 * Dates is only included when fetching a single calendar.
+	 */
+	readonly dates?: readonly ScheduleExceptionDateRange[];
+	readonly created_at: string;
+	readonly updated_at: string;
+}
+
+// From codersdk/scheduleexceptions.go
+/**
+ * ScheduleExceptionDateFormat is the format of the dates in schedule
+ * exceptions.
+ */
+export const ScheduleExceptionDateFormat = "2006-01-02";
+
+// From codersdk/scheduleexceptions.go
+/**
+ * ScheduleExceptionDateRange is a range of dates on which workspaces do not
+ * autostart. Dates are formatted as YYYY-MM-DD and are interpreted in the
+ * timezone of each workspace's autostart schedule.
+ */
+export interface ScheduleExceptionDateRange {
+	readonly start_date: string;
+	/**
+	 * EndDate is inclusive. It defaults to StartDate.
+	 */
+	readonly end_date?: string;
+	readonly summary?: string;
+}
+
 // From codersdk/usersecretsimport.go
 export type SecretsFileFormat = "env" | "json" | "yaml";
 
@@ -9099,6 +9529,12 @@ export interface Template {
 	 * provisioning.
 	 */
 	readonly disable_module_cache: boolean;
+	/**
+	 * DriftCheckIntervalMillis is how often running workspaces are checked
+	 * for resources that changed outside of Coder. Zero disables drift
+	 * detection for the template.
+	 */
+	readonly drift_check_interval_ms: number;
 }
 
 // From codersdk/templates.go
@@ -9465,6 +9901,32 @@ export interface TemplateVersion {
 	readonly has_external_agent: boolean;
 }
 
+// From codersdk/templateversions.go
+/**
+ * TemplateVersionDiff describes what changes for users when a template moves
+ * from the base version to the compared version. Items that are identical in
+ * both versions are omitted.
+ */
+export interface TemplateVersionDiff {
+	readonly base_template_version_id: string;
+	readonly template_version_id: string;
+	readonly files: readonly TemplateVersionFileDiff[];
+	readonly rich_parameters: readonly TemplateVersionParameterDiff[];
+	readonly variables: readonly TemplateVersionVariableDiff[];
+	readonly presets: readonly TemplateVersionPresetDiff[];
+	readonly workspace_tags: readonly TemplateVersionWorkspaceTagDiff[];
+	readonly external_auth: readonly TemplateVersionExternalAuthDiff[];
+}
+
+// From codersdk/templateversions.go
+export type TemplateVersionDiffChange = "added" | "changed" | "removed";
+
+export const TemplateVersionDiffChanges: TemplateVersionDiffChange[] = [
+	"added",
+	"changed",
+	"removed",
+];
+
 // From codersdk/templateversions.go
 export interface TemplateVersionExternalAuth {
 	readonly id: string;
@@ -9476,6 +9938,42 @@ export interface TemplateVersionExternalAuth {
 	readonly optional?: boolean;
 }
 
+// From codersdk/templateversions.go
+/**
+ * TemplateVersionExternalAuthDiff is an external auth provider whose
+ * requirement differs between two template versions.
+ */
+export interface TemplateVersionExternalAuthDiff {
+	readonly id: string;
+	readonly change: TemplateVersionDiffChange;
+	/**
+	 * OldOptional and NewOptional report whether the provider is optional in
+	 * the base and compared version. They are nil for the version that does
+	 * not require the provider.
+	 */
+	readonly old_optional?: boolean;
+	readonly new_optional?: boolean;
+}
+
+// From codersdk/templateversions.go
+/**
+ * TemplateVersionFileDiff is a file of the template archive that differs
+ * between two template versions.
+ */
+export interface TemplateVersionFileDiff {
+	readonly path: string;
+	readonly change: TemplateVersionDiffChange;
+	/**
+	 * Binary is true if either version of the file is not valid UTF-8 text.
+	 * No diff is computed for binary files.
+	 */
+	readonly binary: boolean;
+	/**
+	 * Diff is the unified diff of the file contents.
+	 */
+	readonly diff?: string;
+}
+
 // From codersdk/templateversions.go
 /**
  * TemplateVersionParameter represents a parameter for a template version.
@@ -9504,6 +10002,19 @@ export interface TemplateVersionParameter {
 	readonly ephemeral: boolean;
 }
 
+// From codersdk/templateversions.go
+/**
+ * TemplateVersionParameterDiff is a rich parameter that differs between two
+ * template versions. Old is nil for added parameters, New is nil for removed
+ * parameters.
+ */
+export interface TemplateVersionParameterDiff {
+	readonly name: string;
+	readonly change: TemplateVersionDiffChange;
+	readonly old?: TemplateVersionParameter;
+	readonly new?: TemplateVersionParameter;
+}
+
 // From codersdk/templateversions.go
 /**
  * TemplateVersionParameterOption represents a selectable option for a template parameter.
@@ -9515,6 +10026,108 @@ export interface TemplateVersionParameterOption {
 	readonly icon: string;
 }
 
+// From codersdk/templateversions.go
+/**
+ * TemplateVersionPresetDiff is a preset that differs between two template
+ * versions. Presets are matched by name.
+ */
+export interface TemplateVersionPresetDiff {
+	readonly name: string;
+	readonly change: TemplateVersionDiffChange;
+	readonly old?: Preset;
+	readonly new?: Preset;
+}
+
+// From codersdk/templaterollouts.go
+/**
+ * TemplateVersionRollout is a staged rollout of a template version to a subset
+ * of the workspaces that follow the active version of the template. The active
+ * version of the template does not change until the rollout version is
+ * promoted.
+ */
+export interface TemplateVersionRollout {
+	readonly id: string;
+	readonly template_id: string;
+	readonly template_version_id: string;
+	readonly template_version_name: string;
+	readonly previous_template_version_id: string;
+	/**
+	 * Percentage is the percentage of workspaces that are updated to the
+	 * rollout version.
+	 */
+	readonly percentage: number;
+	/**
+	 * GroupIDs are the groups whose members' workspaces are updated to the
+	 * rollout version, regardless of the percentage.
+	 */
+	readonly group_ids: readonly string[];
+	/**
+	 * FailureThreshold is the ratio of failed builds or agents, between 0 and
+	 * 1, above which the failure action is taken.
+	 */
+	readonly failure_threshold: number;
+	/**
+	 * MinSamples is the minimum number of finished builds or agents before
+	 * the failure ratio is evaluated.
+	 */
+	readonly min_samples: number;
+	readonly failure_action: TemplateVersionRolloutFailureAction;
+	readonly status: TemplateVersionRolloutStatus;
+	/**
+	 * StatusMessage explains why the rollout was paused or rolled back
+	 * automatically.
+	 */
+	readonly status_message: string;
+	readonly progress: TemplateVersionRolloutProgress;
+	readonly created_by: string;
+	readonly created_at: string;
+	readonly updated_at: string;
+	/**
+	 * StartedAt is the time since which builds and agents are counted
+	 * towards the progress. It is reset when a paused rollout is resumed.
+	 */
+	readonly started_at: string;
+	readonly finished_at?: string;
+}
+
+// From codersdk/templaterollouts.go
+/**
+ * TemplateVersionRolloutFailureAction is what happens to a rollout when the
+ * failure ratio of its builds or agents exceeds its threshold.
+ */
+export type TemplateVersionRolloutFailureAction = "pause" | "rollback";
+
+export const TemplateVersionRolloutFailureActions: TemplateVersionRolloutFailureAction[] =
+	["pause", "rollback"];
+
+// From codersdk/templaterollouts.go
+/**
+ * TemplateVersionRolloutProgress is the outcome of the start builds and agents
+ * of the rollout version since the rollout was started.
+ */
+export interface TemplateVersionRolloutProgress {
+	readonly builds_succeeded: number;
+	readonly builds_failed: number;
+	readonly agents_ready: number;
+	readonly agents_failed: number;
+}
+
+// From codersdk/templaterollouts.go
+export type TemplateVersionRolloutStatus =
+	| "canceled"
+	| "completed"
+	| "in_progress"
+	| "paused"
+	| "rolled_back";
+
+export const TemplateVersionRolloutStatuses: TemplateVersionRolloutStatus[] = [
+	"canceled",
+	"completed",
+	"in_progress",
+	"paused",
+	"rolled_back",
+];
+
 // From codersdk/templateversions.go
 /**
  * TemplateVersionVariable represents a managed template variable.
@@ -9529,6 +10142,18 @@ export interface TemplateVersionVariable {
 	readonly sensitive: boolean;
 }
 
+// From codersdk/templateversions.go
+/**
+ * TemplateVersionVariableDiff is a template variable that differs between two
+ * template versions. Values of sensitive variables are redacted.
+ */
+export interface TemplateVersionVariableDiff {
+	readonly name: string;
+	readonly change: TemplateVersionDiffChange;
+	readonly old?: TemplateVersionVariable;
+	readonly new?: TemplateVersionVariable;
+}
+
 // From codersdk/templateversions.go
 export type TemplateVersionWarning = "UNSUPPORTED_WORKSPACES";
 
@@ -9536,6 +10161,18 @@ export const TemplateVersionWarnings: TemplateVersionWarning[] = [
 	"UNSUPPORTED_WORKSPACES",
 ];
 
+// From codersdk/templateversions.go
+/**
+ * TemplateVersionWorkspaceTagDiff is a workspace tag that differs between two
+ * template versions.
+ */
+export interface TemplateVersionWorkspaceTagDiff {
+	readonly key: string;
+	readonly change: TemplateVersionDiffChange;
+	readonly old_value?: string;
+	readonly new_value?: string;
+}
+
 // From codersdk/templates.go
 /**
  * TemplateVersionsByTemplateRequest defines the request parameters for
@@ -9975,11 +10612,34 @@ export interface UpdateOrganizationRequest {
 	readonly default_org_member_roles?: string[];
 }
 
+// From codersdk/provisionerdaemons.go
+/**
+ * UpdateProvisionerJobPriorityRequest sets the priority boost of an
+ * organization, or of a template if TemplateID is set. A priority of zero
+ * removes the boost.
+ */
+export interface UpdateProvisionerJobPriorityRequest {
+	readonly template_id?: string;
+	readonly priority: number;
+}
+
 // From codersdk/users.go
 export interface UpdateRoles {
 	readonly roles: readonly string[];
 }
 
+// From codersdk/scheduleexceptions.go
+/**
+ * UpdateScheduleExceptionCalendarRequest replaces the name, description and
+ * dates of an exception calendar.
+ */
+export interface UpdateScheduleExceptionCalendarRequest {
+	readonly name: string;
+	readonly description?: string;
+	readonly dates?: readonly ScheduleExceptionDateRange[];
+	readonly ical?: string;
+}
+
 // From codersdk/aitasks.go
 /**
  * UpdateTaskInputRequest is used to update a task's input.
@@ -10096,6 +10756,37 @@ export interface UpdateTemplateMeta {
 	 * this template. If omitted, the current value is preserved.
 	 */
 	readonly agents_allowed?: boolean;
+	/**
+	 * DriftCheckIntervalMillis allows optionally specifying how often running
+	 * workspaces are checked for resources that changed outside of Coder.
+	 * Set to 0 to disable drift detection. Omitting the field keeps the
+	 * existing value.
+	 */
+	readonly drift_check_interval_ms?: number;
+}
+
+// From codersdk/scheduleexceptions.go
+/**
+ * UpdateTemplateScheduleExceptionCalendarsRequest replaces the exception
+ * calendars attached to a template.
+ */
+export interface UpdateTemplateScheduleExceptionCalendarsRequest {
+	readonly calendar_ids: readonly string[];
+}
+
+// From codersdk/templaterollouts.go
+/**
+ * UpdateTemplateVersionRolloutRequest changes a rollout that is in progress or
+ * paused. Setting the status pauses or resumes the rollout. Omitted fields are
+ * left unchanged.
+ */
+export interface UpdateTemplateVersionRolloutRequest {
+	readonly percentage?: number;
+	readonly group_ids?: readonly string[];
+	readonly failure_threshold?: number;
+	readonly min_samples?: number;
+	readonly failure_action?: TemplateVersionRolloutFailureAction;
+	readonly status?: TemplateVersionRolloutStatus;
 }
 
 // From codersdk/users.go
@@ -10942,6 +11633,16 @@ export interface Workspace {
 	 */
 	readonly task_id?: string;
 	readonly shared_with?: readonly SharedWorkspaceActor[];
+	/**
+	 * LatestDriftCheck is the most recent completed drift check of the
+	 * workspace's latest build. It is nil if the build was never checked.
+	 */
+	readonly latest_drift_check?: WorkspaceDriftCheck;
+	/**
+	 * NextSkippedAutostart is the next scheduled autostart within a year that
+	 * will be skipped because it falls on an exception date.
+	 */
+	readonly next_skipped_autostart?: string;
 }
 
 // From codersdk/workspaces.go
@@ -11044,6 +11745,12 @@ export interface WorkspaceAgentContainer {
 	 * string.
 	 */
 	readonly status: string;
+	/**
+	 * Health is the status of the container healthcheck, e.g. "starting",
+	 * "healthy" or "unhealthy". It is empty if the container has no
+	 * healthcheck.
+	 */
+	readonly health?: string;
 	/**
 	 * Volumes is a map of "things" mounted into the container. Again, this
 	 * is somewhat implementation-dependent.
@@ -11093,6 +11800,11 @@ export interface WorkspaceAgentDevcontainer {
 	readonly dirty: boolean;
 	readonly container?: WorkspaceAgentContainer;
 	readonly agent?: WorkspaceAgentDevcontainerAgent;
+	/**
+	 * Compose is set for devcontainers defined with a Docker Compose
+	 * file, and describes the other services of the Compose project.
+	 */
+	readonly compose?: WorkspaceAgentDevcontainerCompose;
 	readonly error?: string;
 }
 
@@ -11107,6 +11819,28 @@ export interface WorkspaceAgentDevcontainerAgent {
 	readonly directory: string;
 }
 
+// From codersdk/workspaceagents.go
+/**
+ * WorkspaceAgentDevcontainerCompose describes the Docker Compose project
+ * of a devcontainer. The devcontainer container runs the primary
+ * service, and the containers of the other services run alongside it.
+ */
+export interface WorkspaceAgentDevcontainerCompose {
+	/**
+	 * Project is the name of the Compose project.
+	 */
+	readonly project: string;
+	/**
+	 * Service is the name of the primary service, the one the
+	 * devcontainer agent runs in.
+	 */
+	readonly service: string;
+	/**
+	 * Sidecars are the containers of the other services in the project.
+	 */
+	readonly sidecars: readonly WorkspaceAgentContainer[];
+}
+
 // From codersdk/workspaceagents.go
 export type WorkspaceAgentDevcontainerStatus =
 	| "deleting"
@@ -11271,6 +12005,37 @@ export interface WorkspaceAgentMetadataResult {
 	readonly error: string;
 }
 
+// From codersdk/workspaceagentptyshares.go
+export interface WorkspaceAgentPTYShare {
+	readonly id: string;
+	readonly agent_id: string;
+	readonly reconnect_id: string;
+	readonly created_by: string;
+	readonly user_ids: readonly string[];
+	readonly created_at: string;
+	readonly expires_at: string;
+	readonly revoked_at?: string;
+	readonly viewers: readonly WorkspaceAgentPTYShareViewer[];
+}
+
+// From codersdk/workspaceagentptyshares.go
+/**
+ * WorkspaceAgentPTYShareTokenQueryParameter is the query parameter used
+ * to pass a share token when watching a shared session.
+ */
+export const WorkspaceAgentPTYShareTokenQueryParameter = "token";
+
+// From codersdk/workspaceagentptyshares.go
+export interface WorkspaceAgentPTYShareViewer {
+	readonly id: string;
+	readonly user_id: string;
+	readonly username: string;
+	readonly avatar_url: string;
+	readonly ip: string;
+	readonly connected_at: string;
+	readonly disconnected_at?: string;
+}
+
 // From codersdk/workspaceagentportshare.go
 export interface WorkspaceAgentPortShare {
 	readonly workspace_id: string;
@@ -11502,6 +12267,35 @@ export const WorkspaceAppStatusStates: WorkspaceAppStatusState[] = [
 	"working",
 ];
 
+// From codersdk/scheduleexceptions.go
+/**
+ * WorkspaceAutostartException is a range of dates on which a workspace does
+ * not autostart.
+ */
+export interface WorkspaceAutostartException {
+	readonly start_date: string;
+	readonly end_date: string;
+	/**
+	 * Source is the name of the exception calendar, or "out-of-office" for
+	 * the workspace owner's own ranges.
+	 */
+	readonly source: string;
+	readonly summary: string;
+}
+
+// From codersdk/scheduleexceptions.go
+export interface WorkspaceAutostartExceptions {
+	/**
+	 * Exceptions that have not ended, soonest first.
+	 */
+	readonly exceptions: readonly WorkspaceAutostartException[];
+	/**
+	 * NextSkippedAutostart is the next scheduled autostart within a year that
+	 * will be skipped because it falls on an exception date.
+	 */
+	readonly next_skipped_autostart?: string;
+}
+
 // From codersdk/workspacebuilds.go
 /**
  * WorkspaceBuild is an at-point representation of a workspace state.
@@ -11588,6 +12382,100 @@ export interface WorkspaceBuildsRequest extends Pagination {
 	readonly since?: string;
 }
 
+// From codersdk/workspacebulkoperations.go
+/**
+ * WorkspaceBulkOperation is an action applied to every workspace that matched
+ * a search query when the operation was created.
+ */
+export interface WorkspaceBulkOperation {
+	readonly id: string;
+	readonly initiator_id: string;
+	readonly action: WorkspaceBulkOperationAction;
+	readonly query: string;
+	readonly autostart_schedule?: string;
+	readonly ttl_ms?: number;
+	readonly automatic_updates?: AutomaticUpdates;
+	readonly concurrency: number;
+	readonly status: WorkspaceBulkOperationStatus;
+	readonly progress: WorkspaceBulkOperationProgress;
+	readonly created_at: string;
+	readonly updated_at: string;
+	readonly completed_at?: string;
+}
+
+// From codersdk/workspacebulkoperations.go
+export type WorkspaceBulkOperationAction =
+	| "automatic_updates"
+	| "autostart"
+	| "delete"
+	| "start"
+	| "stop"
+	| "ttl"
+	| "update";
+
+export const WorkspaceBulkOperationActions: WorkspaceBulkOperationAction[] = [
+	"automatic_updates",
+	"autostart",
+	"delete",
+	"start",
+	"stop",
+	"ttl",
+	"update",
+];
+
+// From codersdk/workspacebulkoperations.go
+/**
+ * WorkspaceBulkOperationProgress counts the workspaces of a bulk operation by
+ * status.
+ */
+export interface WorkspaceBulkOperationProgress {
+	readonly total: number;
+	readonly pending: number;
+	readonly running: number;
+	readonly succeeded: number;
+	readonly failed: number;
+	readonly skipped: number;
+}
+
+// From codersdk/workspacebulkoperations.go
+export type WorkspaceBulkOperationStatus = "canceled" | "completed" | "running";
+
+export const WorkspaceBulkOperationStatuses: WorkspaceBulkOperationStatus[] = [
+	"canceled",
+	"completed",
+	"running",
+];
+
+// From codersdk/workspacebulkoperations.go
+/**
+ * WorkspaceBulkOperationWorkspace is the outcome of a bulk operation for one of
+ * its workspaces.
+ */
+export interface WorkspaceBulkOperationWorkspace {
+	readonly workspace_id: string;
+	readonly workspace_name: string;
+	readonly workspace_owner_name: string;
+	readonly status: WorkspaceBulkOperationWorkspaceStatus;
+	/**
+	 * BuildID is the build created for the workspace by the start, stop,
+	 * update and delete actions.
+	 */
+	readonly build_id?: string;
+	readonly error?: string;
+	readonly updated_at: string;
+}
+
+// From codersdk/workspacebulkoperations.go
+export type WorkspaceBulkOperationWorkspaceStatus =
+	| "failed"
+	| "pending"
+	| "running"
+	| "skipped"
+	| "succeeded";
+
+export const WorkspaceBulkOperationWorkspaceStatuses: WorkspaceBulkOperationWorkspaceStatus[] =
+	["failed", "pending", "running", "skipped", "succeeded"];
+
 // From codersdk/deployment.go
 export interface WorkspaceConnectionLatencyMS {
 	readonly P50: number;
@@ -11606,6 +12494,20 @@ export interface WorkspaceDeploymentStats {
 	readonly tx_bytes: number;
 }
 
+// From codersdk/workspaces.go
+/**
+ * WorkspaceDriftCheck is the result of checking the resources of a running
+ * workspace for changes made outside of Coder.
+ */
+export interface WorkspaceDriftCheck {
+	readonly id: string;
+	readonly workspace_build_id: string;
+	readonly job_id: string;
+	readonly checked_at: string;
+	readonly drifted: boolean;
+	readonly resources: readonly WorkspaceResourceDrift[];
+}
+
 // From codersdk/workspaces.go
 export interface WorkspaceFilter {
 	/**
@@ -11705,6 +12607,25 @@ export interface WorkspaceResource {
 	readonly daily_cost: number;
 }
 
+// From codersdk/workspaces.go
+/**
+ * WorkspaceResourceDrift describes a resource that changed outside of Coder.
+ */
+export interface WorkspaceResourceDrift {
+	readonly address: string;
+	readonly type: string;
+	readonly name: string;
+	/**
+	 * Action is "update" for resources that were modified and "delete" for
+	 * resources that no longer exist.
+	 */
+	readonly action: string;
+	/**
+	 * Attributes are the names of the top-level attributes that changed.
+	 */
+	readonly attributes?: readonly string[];
+}
+
 // From codersdk/workspacebuilds.go
 /**
  * WorkspaceResourceMetadata annotates the workspace resource with custom key-value pairs.
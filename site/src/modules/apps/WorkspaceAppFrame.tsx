@@ -16,7 +16,7 @@ import {
 import { Spinner } from "#/components/Spinner/Spinner";
 import { useProxy } from "#/contexts/ProxyContext";
 import { cn } from "#/utils/cn";
-import { isAppBlockedByMissingWildcard } from "./apps";
+import { getHealthcheckCommand, isAppBlockedByMissingWildcard } from "./apps";
 import { useAppLink } from "./useAppLink";
 import { WorkspaceWildcardWarning } from "./WorkspaceWildcardWarning";
 import type { WorkspaceAppWithAgent } from "./workspaceApps";
@@ -38,6 +38,8 @@ export const WorkspaceAppFrame: FC<WorkspaceAppFrameProps> = ({
 		workspace,
 	});
 	const proxy = useProxy();
+	const healthcheckCommand =
+		app.healthcheck && getHealthcheckCommand(app.healthcheck);
 	const frameRef = useRef<HTMLIFrameElement>(null);
 	const shouldDisplayWildcardWarning = isAppBlockedByMissingWildcard(
 		app,
@@ -109,14 +111,14 @@ export const WorkspaceAppFrame: FC<WorkspaceAppFrameProps> = ({
 							Here are some troubleshooting steps you can take:
 						</span>
 						<ul className="m-0 pt-4 flex flex-col gap-4">
-							{app.healthcheck && (
+							{healthcheckCommand && (
 								<li>
 									<span className="block font-medium text-content-primary mb-1">
 										Verify healthcheck
 									</span>
 									Try running the following inside your workspace:{" "}
 									<code className="font-mono text-content-primary select-all">
-										curl -v "{app.healthcheck.url}"
+										{healthcheckCommand}
 									</code>
 								</li>
 							)}
@@ -6,6 +6,7 @@ import {
 } from "#/testHelpers/entities";
 import {
 	getAppHref,
+	getHealthcheckCommand,
 	getVSCodeHref,
 	isAppBlockedByMissingWildcard,
 	isAppUrlValid,
@@ -311,3 +312,31 @@ describe("isAppBlockedByMissingWildcard", () => {
 		expect(isAppBlockedByMissingWildcard(pathApp, undefined)).toBe(false);
 	});
 });
+
+describe("getHealthcheckCommand", () => {
+	it("returns a command for each healthcheck type", () => {
+		const healthcheck = { interval: 5, threshold: 6 };
+
+		expect(
+			getHealthcheckCommand({
+				...healthcheck,
+				url: "http://localhost:13337/healthz",
+			}),
+		).toBe('curl -v "http://localhost:13337/healthz"');
+		expect(
+			getHealthcheckCommand({
+				...healthcheck,
+				url: "",
+				address: "localhost:5432",
+			}),
+		).toBe("nc -zv localhost 5432");
+		expect(
+			getHealthcheckCommand({
+				...healthcheck,
+				url: "",
+				command: "pg_isready",
+			}),
+		).toBe("pg_isready");
+		expect(getHealthcheckCommand({ ...healthcheck, url: "" })).toBeUndefined();
+	});
+});
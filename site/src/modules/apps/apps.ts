@@ -1,5 +1,6 @@
 import { toast } from "sonner";
 import type {
+	Healthcheck,
 	Workspace,
 	WorkspaceAgent,
 	WorkspaceApp,
@@ -229,3 +230,25 @@ export const isAppBlockedByMissingWildcard = (
 ): boolean => {
 	return app.subdomain && !wildcardHostname;
 };
+
+/**
+ * Returns a command the user can run inside the workspace to reproduce an app
+ * healthcheck, or undefined if the app has no healthcheck.
+ */
+export const getHealthcheckCommand = (
+	healthcheck: Healthcheck,
+): string | undefined => {
+	if (healthcheck.url) {
+		return `curl -v "${healthcheck.url}"`;
+	}
+	if (healthcheck.address) {
+		const separator = healthcheck.address.lastIndexOf(":");
+		const host = healthcheck.address.slice(0, separator);
+		const port = healthcheck.address.slice(separator + 1);
+		return `nc -zv ${host} ${port}`;
+	}
+	if (healthcheck.command) {
+		return healthcheck.command;
+	}
+	return undefined;
+};
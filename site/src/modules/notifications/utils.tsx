@@ -1,22 +1,26 @@
-import { MailIcon, WebhookIcon } from "lucide-react";
+import { HashIcon, MailIcon, UsersIcon, WebhookIcon } from "lucide-react";
 import type {
 	NotificationPreference,
 	NotificationTemplate,
 } from "#/api/typesGenerated";
 
 // TODO: This should be provided by the auto generated types from codersdk
-const notificationMethods = ["smtp", "webhook"] as const;
+const notificationMethods = ["smtp", "webhook", "slack", "msteams"] as const;
 
 export type NotificationMethod = (typeof notificationMethods)[number];
 
 export const methodIcons: Record<NotificationMethod, typeof MailIcon> = {
 	smtp: MailIcon,
 	webhook: WebhookIcon,
+	slack: HashIcon,
+	msteams: UsersIcon,
 };
 
 export const methodLabels: Record<NotificationMethod, string> = {
 	smtp: "SMTP",
 	webhook: "Webhook",
+	slack: "Slack",
+	msteams: "Microsoft Teams",
 };
 
 export const castNotificationMethod = (value: string) => {
@@ -71,6 +71,34 @@ export const WithPorts: Story = {
 	},
 };
 
+export const WithComposeSidecars: Story = {
+	args: {
+		devcontainer: {
+			...MockWorkspaceAgentDevcontainer,
+			compose: {
+				project: "myproject",
+				service: "app",
+				sidecars: [
+					{
+						...MockWorkspaceAgentContainer,
+						id: "db",
+						name: "myproject-db-1",
+						labels: { "com.docker.compose.service": "db" },
+						health: "healthy",
+					},
+					{
+						...MockWorkspaceAgentContainer,
+						id: "queue",
+						name: "myproject-queue-1",
+						labels: { "com.docker.compose.service": "queue" },
+						status: "running",
+					},
+				],
+			},
+		},
+	},
+};
+
 export const Dirty: Story = {
 	args: {
 		devcontainer: {
@@ -293,6 +293,28 @@ export const AgentDevcontainerCard: FC<AgentDevcontainerCardProps> = ({
 				</div>
 			)}
 
+			{devcontainer.compose && devcontainer.compose.sidecars.length > 0 && (
+				<section className="flex flex-wrap gap-x-6 gap-y-1 px-8 pt-2 text-xs text-content-secondary">
+					<h3 className="sr-only">Compose services</h3>
+					{devcontainer.compose.sidecars.map((sidecar) => (
+						<span key={sidecar.id} className="flex items-center gap-1.5">
+							<span
+								className={cn(
+									"size-1.5 rounded-full bg-content-disabled",
+									sidecar.running && "bg-content-success",
+									sidecar.health === "unhealthy" && "bg-content-destructive",
+									sidecar.health === "starting" && "bg-content-warning",
+								)}
+							/>
+							<span className="text-content-primary">
+								{sidecar.labels["com.docker.compose.service"] ?? sidecar.name}
+							</span>
+							<span>{sidecar.health || sidecar.status}</span>
+						</span>
+					))}
+				</section>
+			)}
+
 			{(showSubAgentApps || showSubAgentAppsPlaceholders) && (
 				<div className="flex flex-col gap-8 px-8 pt-4">
 					{subAgent &&
@@ -0,0 +1,25 @@
+import type { Meta, StoryObj } from "@storybook/react-vite";
+import { userEvent, within } from "storybook/test";
+import { MockWorkspaceDriftCheck } from "#/testHelpers/entities";
+import { WorkspaceDriftBadge } from "./WorkspaceDriftBadge";
+
+const meta: Meta<typeof WorkspaceDriftBadge> = {
+	title: "modules/workspaces/WorkspaceDriftBadge",
+	component: WorkspaceDriftBadge,
+	args: {
+		driftCheck: MockWorkspaceDriftCheck,
+	},
+};
+
+export default meta;
+type Story = StoryObj<typeof WorkspaceDriftBadge>;
+
+export const Default: Story = {
+	play: async ({ canvasElement, step }) => {
+		const canvas = within(canvasElement);
+
+		await step("Open tooltip", async () => {
+			await userEvent.hover(canvas.getByRole("status"));
+		});
+	},
+};
@@ -0,0 +1,39 @@
+import type { FC } from "react";
+import type { WorkspaceDriftCheck } from "#/api/typesGenerated";
+import { Badge } from "#/components/Badge/Badge";
+import {
+	Tooltip,
+	TooltipContent,
+	TooltipTrigger,
+} from "#/components/Tooltip/Tooltip";
+import { relativeTime } from "#/utils/time";
+
+type WorkspaceDriftBadgeProps = {
+	driftCheck: WorkspaceDriftCheck;
+};
+
+export const WorkspaceDriftBadge: FC<WorkspaceDriftBadgeProps> = ({
+	driftCheck,
+}) => {
+	return (
+		<Tooltip>
+			<TooltipTrigger asChild>
+				<Badge role="status" variant="warning" size="xs">
+					Drifted
+				</Badge>
+			</TooltipTrigger>
+			<TooltipContent side="bottom" className="max-w-xs">
+				A drift check {relativeTime(driftCheck.checked_at)} found resources
+				that were changed outside of Coder:
+				<ul className="m-0 mt-1 pl-4">
+					{driftCheck.resources.map((resource) => (
+						<li key={resource.address}>
+							<code>{resource.address}</code> ({resource.action})
+						</li>
+					))}
+				</ul>
+				Restart the workspace to restore them.
+			</TooltipContent>
+		</Tooltip>
+	);
+};
@@ -1,5 +1,9 @@
 import type { Meta, StoryObj } from "@storybook/react-vite";
-import { MockDormantWorkspace, MockWorkspace } from "#/testHelpers/entities";
+import {
+	MockDormantWorkspace,
+	MockDriftedWorkspace,
+	MockWorkspace,
+} from "#/testHelpers/entities";
 import { WorkspaceStatus } from "./WorkspaceStatus";
 
 const meta: Meta<typeof WorkspaceStatus> = {
@@ -20,3 +24,9 @@ export const Dormant: Story = {
 		workspace: MockDormantWorkspace,
 	},
 };
+
+export const Drifted: Story = {
+	args: {
+		workspace: MockDriftedWorkspace,
+	},
+};
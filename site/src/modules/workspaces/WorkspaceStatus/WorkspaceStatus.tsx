@@ -2,6 +2,7 @@ import type { FC } from "react";
 import type { Workspace } from "#/api/typesGenerated";
 import { lastUsedMessage } from "#/utils/workspace";
 import { WorkspaceDormantBadge } from "../WorkspaceDormantBadge/WorkspaceDormantBadge";
+import { WorkspaceDriftBadge } from "../WorkspaceDriftBadge/WorkspaceDriftBadge";
 import { WorkspaceStatusIndicator } from "../WorkspaceStatusIndicator/WorkspaceStatusIndicator";
 
 type WorkspaceStatusProps = {
@@ -15,6 +16,9 @@ export const WorkspaceStatus: FC<WorkspaceStatusProps> = ({ workspace }) => {
 				{workspace.dormant_at && (
 					<WorkspaceDormantBadge workspace={workspace} />
 				)}
+				{workspace.latest_drift_check?.drifted && (
+					<WorkspaceDriftBadge driftCheck={workspace.latest_drift_check} />
+				)}
 			</WorkspaceStatusIndicator>
 			<time
 				dateTime={workspace.last_used_at}
@@ -159,6 +159,10 @@ export const useResourceTypeFilterMenu = ({
 			label = "Chat Instruction Settings";
 		}
 
+		if (type === "workspace_bulk_operation") {
+			label = "Workspace Bulk Operation";
+		}
+
 		return {
 			value: type,
 			label,
@@ -59,6 +59,24 @@ export const NotificationEvents: FC<NotificationEventsProps> = ({
 		"hello",
 	]);
 
+	// Slack
+	const hasSlackNotifications = Object.values(templatesByGroup)
+		.flat()
+		.some((t) => t.method === "slack");
+	const slackValues = deploymentConfig.notifications?.slack ?? {};
+	const isSlackConfigured =
+		requiredFieldsArePresent(slackValues, ["bot_token"]) ||
+		requiredFieldsArePresent(slackValues, ["webhook_url"]);
+
+	// Microsoft Teams
+	const hasTeamsNotifications = Object.values(templatesByGroup)
+		.flat()
+		.some((t) => t.method === "msteams");
+	const teamsValues = deploymentConfig.notifications?.msteams ?? {};
+	const isTeamsConfigured = requiredFieldsArePresent(teamsValues, [
+		"webhook_url",
+	]);
+
 	return (
 		<div className="flex flex-col gap-8">
 			{hasWebhookNotifications && !isWebhookConfigured && (
@@ -101,6 +119,47 @@ export const NotificationEvents: FC<NotificationEventsProps> = ({
 				</Alert>
 			)}
 
+			{hasSlackNotifications && !isSlackConfigured && (
+				<Alert
+					severity="warning"
+					prominent
+					actions={
+						<Button size="sm" asChild>
+							<a
+								target="_blank"
+								rel="noreferrer"
+								href={docs("/admin/monitoring/notifications#slack")}
+							>
+								Read the docs
+							</a>
+						</Button>
+					}
+				>
+					Slack notifications are enabled but not properly configured.
+				</Alert>
+			)}
+
+			{hasTeamsNotifications && !isTeamsConfigured && (
+				<Alert
+					severity="warning"
+					prominent
+					actions={
+						<Button size="sm" asChild>
+							<a
+								target="_blank"
+								rel="noreferrer"
+								href={docs("/admin/monitoring/notifications#microsoft-teams")}
+							>
+								Read the docs
+							</a>
+						</Button>
+					}
+				>
+					Microsoft Teams notifications are enabled but not properly
+					configured.
+				</Alert>
+			)}
+
 			{Object.entries(templatesByGroup).map(([group, templates]) => (
 				<article
 					key={group}
@@ -183,6 +183,10 @@ export const EditOAuth2AppPageView: FC = () => {
 						value={app.endpoints.authorization}
 					/>
 					<EndpointField label="Token URL" value={app.endpoints.token} />
+					<EndpointField
+						label="Device Authorization URL"
+						value={app.endpoints.device_authorization}
+					/>
 				</dl>
 
 				{secretsQuery.error ? (
@@ -198,7 +202,13 @@ export const EditOAuth2AppPageView: FC = () => {
 							try {
 								const updated = await putAppMutation.mutateAsync({
 									id: appId,
-									req,
+									// The form does not edit the client credentials
+									// user, so keep the current one.
+									req: {
+										...req,
+										client_credentials_user_id:
+											app.client_credentials_user_id,
+									},
 								});
 								toast.success(
 									`Successfully updated the OAuth2 application "${updated.name}".`,
@@ -60,6 +60,31 @@ export const OAuthSignInForm: FC<OAuthSignInFormProps> = ({
 					</a>
 				</Button>
 			)}
+
+			{authMethods?.oidc_providers?.map((provider) => (
+				<Button
+					key={provider.id}
+					variant="outline"
+					asChild
+					className="w-full"
+					size="lg"
+					disabled={isSigningIn}
+					type="submit"
+				>
+					<a
+						href={`/api/v2/users/oidc/${encodeURIComponent(
+							provider.id,
+						)}/callback?redirect=${encodeURIComponent(redirectTo)}`}
+					>
+						{provider.iconUrl ? (
+							<OidcIcon iconUrl={provider.iconUrl} />
+						) : (
+							<KeyIcon />
+						)}
+						{provider.signInText || provider.id}
+					</a>
+				</Button>
+			))}
 		</div>
 	);
 };
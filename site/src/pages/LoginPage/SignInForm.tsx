@@ -24,7 +24,9 @@ export const SignInForm: FC<SignInFormProps> = ({
 	onSubmit,
 }) => {
 	const oAuthEnabled = Boolean(
-		authMethods?.github.enabled || authMethods?.oidc.enabled,
+		authMethods?.github.enabled ||
+			authMethods?.oidc.enabled ||
+			authMethods?.oidc_providers?.length,
 	);
 	const passwordEnabled = authMethods?.password.enabled ?? true;
 	const applicationName = getApplicationName();
@@ -28,6 +28,7 @@ import {
 } from "#/components/Tooltip/Tooltip";
 import { useDashboard } from "#/modules/dashboard/useDashboard";
 import { linkToTemplate, useLinks } from "#/modules/navigation";
+import { WorkspaceDriftBadge } from "#/modules/workspaces/WorkspaceDriftBadge/WorkspaceDriftBadge";
 import { WorkspaceStatusIndicator } from "#/modules/workspaces/WorkspaceStatusIndicator/WorkspaceStatusIndicator";
 import { cn } from "#/utils/cn";
 import { displayDormantDeletion } from "#/utils/dormant";
@@ -232,7 +233,11 @@ export const WorkspaceTopbar: FC<WorkspaceTopbarProps> = ({
 						onActivateWorkspace={handleDormantActivate}
 					/>
 
-					<WorkspaceStatusIndicator workspace={workspace} />
+					<WorkspaceStatusIndicator workspace={workspace}>
+						{workspace.latest_drift_check?.drifted && (
+							<WorkspaceDriftBadge driftCheck={workspace.latest_drift_check} />
+						)}
+					</WorkspaceStatusIndicator>
 
 					<WorkspaceActions
 						workspace={workspace}
@@ -803,6 +803,7 @@ export const MockProvisionerJob: TypesGen.ProvisionerJob = {
 	},
 	queue_position: 0,
 	queue_size: 0,
+	priority: 2000,
 	input: {
 		template_version_id: "test-template-version", // MockTemplateVersion.id
 	},
@@ -963,6 +964,7 @@ export const MockTemplate: TypesGen.Template = {
 	use_classic_parameter_flow: false,
 	cors_behavior: "simple",
 	disable_module_cache: false,
+	drift_check_interval_ms: 0,
 };
 
 const _MockTemplateVersionFiles: TemplateVersionFiles = {
@@ -1801,6 +1803,29 @@ export const MockDormantWorkspace: TypesGen.Workspace = {
 	dormant_at: new Date().toISOString(),
 };
 
+export const MockWorkspaceDriftCheck: TypesGen.WorkspaceDriftCheck = {
+	id: "test-drift-check",
+	workspace_build_id: MockWorkspaceBuild.id,
+	job_id: "test-drift-check-job",
+	checked_at: "2022-05-17T17:39:01.382927298Z",
+	drifted: true,
+	resources: [
+		{
+			address: "docker_container.workspace[0]",
+			type: "docker_container",
+			name: "workspace",
+			action: "update",
+			attributes: ["memory"],
+		},
+	],
+};
+
+export const MockDriftedWorkspace: TypesGen.Workspace = {
+	...MockWorkspace,
+	id: "test-drifted-workspace",
+	latest_drift_check: MockWorkspaceDriftCheck,
+};
+
 export const MockDormantOutdatedWorkspace: TypesGen.Workspace = {
 	...MockStoppedWorkspace,
 	id: "test-dormant-outdated-workspace",
@@ -4914,7 +4939,7 @@ export const MockOAuth2ProviderApps: TypesGen.OAuth2ProviderApp[] = [
 		endpoints: {
 			authorization: "http://127.0.0.1:3001/oauth2/authorize",
 			token: "http://127.0.0.1:3001/oauth2/token",
-			device_authorization: "",
+			device_authorization: "http://127.0.0.1:3001/oauth2/device/code",
 			token_revoke: "http://127.0.0.1:3001/oauth2/revoke",
 		},
 	},